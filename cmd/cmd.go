@@ -42,6 +42,7 @@ func (c *VersionCmd) Run() error {
 
 type ToolsCmd struct {
 	DB            ToolsDBCmd            `cmd:""`
+	Documents     ToolsDocumentsCmd     `cmd:""`
 	Notifications ToolsNotificationsCmd `cmd:""`
 	Sync          ToolsSyncCmd          `cmd:""`
 }
@@ -65,6 +65,7 @@ import (
 	pbmailer "github.com/fivenet-app/fivenet/v2026/services/mailer"
 	pbnotifications "github.com/fivenet-app/fivenet/v2026/services/notifications"
 	pbqualifications "github.com/fivenet-app/fivenet/v2026/services/qualifications"
+	pbsearch "github.com/fivenet-app/fivenet/v2026/services/search"
 	pbsettings "github.com/fivenet-app/fivenet/v2026/services/settings"
 	pbstats "github.com/fivenet-app/fivenet/v2026/services/stats"
 	pbsync "github.com/fivenet-app/fivenet/v2026/services/sync"
@@ -81,8 +82,10 @@ import (
 	mailerstore "github.com/fivenet-app/fivenet/v2026/stores/mailer"
 	notificationsstore "github.com/fivenet-app/fivenet/v2026/stores/notifications"
 	qualificationsstore "github.com/fivenet-app/fivenet/v2026/stores/qualifications"
+	searchstore "github.com/fivenet-app/fivenet/v2026/stores/search"
 	settingsstore "github.com/fivenet-app/fivenet/v2026/stores/settings"
 	statsstore "github.com/fivenet-app/fivenet/v2026/stores/stats"
+	syncstore "github.com/fivenet-app/fivenet/v2026/stores/sync"
 	usersstore "github.com/fivenet-app/fivenet/v2026/stores/users"
 	vehiclesstore "github.com/fivenet-app/fivenet/v2026/stores/vehicles"
 	wikistore "github.com/fivenet-app/fivenet/v2026/stores/wiki"
@@ -165,6 +168,7 @@ func GetFxBaseOpts(startTimeout time.Duration, withServer bool, withConfig bool)
 
 		pbcitizens.HousekeeperModule,
 		pbjobs.HousekeeperModule,
+		pbqualifications.HousekeeperModule,
 		pbvehicles.HousekeeperModule,
 		pbdocuments.WorkflowModule,
 		pkgfilestore.Module,
@@ -197,6 +201,7 @@ func GetFxBaseOpts(startTimeout time.Duration, withServer bool, withConfig bool)
 			// HTTP Services
 			server.AsService(api.New),
 			server.AsService(filestore.New),
+			server.AsService(filestore.NewDocumentPDF),
 			server.AsService(icons.New),
 			server.AsService(images.New),
 			server.AsService(oauth2.New),
@@ -215,8 +220,10 @@ func GetFxBaseOpts(startTimeout time.Duration, withServer bool, withConfig bool)
 			mailerstore.New,
 			notificationsstore.New,
 			qualificationsstore.New,
+			searchstore.New,
 			settingsstore.New,
 			statsstore.New,
+			syncstore.New,
 			documentsstore.New,
 			usersstore.New,
 			vehiclesstore.New,
@@ -239,6 +246,7 @@ func GetFxBaseOpts(startTimeout time.Duration, withServer bool, withConfig bool)
 			grpc.AsService(pbmailer.NewServer),
 			grpc.AsService(pbnotifications.NewServer),
 			grpc.AsService(pbqualifications.NewServer),
+			pbsearch.NewServer,
 			grpc.AsService(pbsettings.NewServer),
 			grpc.AsService(pbstats.NewServer),
 			pbsync.NewServer,
@@ -0,0 +1,151 @@
+//nolint:forbidigo // This is part of a CLI tool that uses `fmt.Println` for output
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/cmd/envs"
+	"github.com/fivenet-app/fivenet/v2026/cmd/fxopts"
+	"github.com/fivenet-app/fivenet/v2026/pkg/config"
+	"github.com/fivenet-app/fivenet/v2026/pkg/docarchive"
+	"github.com/fivenet-app/fivenet/v2026/pkg/storage"
+	"go.uber.org/fx"
+)
+
+type ToolsDocumentsCmd struct {
+	Export ToolsDocumentsExportCmd `cmd:"" help:"Export documents to a portable archive."`
+	Import ToolsDocumentsImportCmd `cmd:"" help:"Import documents from a portable archive."`
+}
+
+type ToolsDocumentsExportCmd struct {
+	Output         string    `help:"Path of the archive file to write."                                 required:"" short:"o" type:"path"`
+	Job            string    `help:"Only export documents created by this job."`
+	Category       []int64   `help:"Only export documents in these categories (IDs)."`
+	Document       []int64   `help:"Only export these documents (IDs)."`
+	From           time.Time `help:"Only export documents created on or after this date (YYYY-MM-DD)." format:"2006-01-02"`
+	To             time.Time `help:"Only export documents created before this date (YYYY-MM-DD)."     format:"2006-01-02"`
+	IncludeDeleted bool      `help:"Include deleted documents."`
+}
+
+func (c *ToolsDocumentsExportCmd) Run() error {
+	return runWithArchiver(func(ctx context.Context, archiver *docarchive.Archiver) error {
+		filter := &docarchive.Filter{
+			Job:            c.Job,
+			CategoryIDs:    c.Category,
+			DocumentIDs:    c.Document,
+			IncludeDeleted: c.IncludeDeleted,
+		}
+		if !c.From.IsZero() {
+			filter.From = &c.From
+		}
+		if !c.To.IsZero() {
+			to := c.To.Add(-time.Millisecond)
+			filter.To = &to
+		}
+
+		f, err := os.Create(c.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		manifest, err := archiver.Export(ctx, f, filter)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf(
+			"Exported %d documents with %d files to %s\n",
+			manifest.Documents,
+			manifest.Files,
+			c.Output,
+		)
+		return nil
+	})
+}
+
+type ToolsDocumentsImportCmd struct {
+	Input   string   `help:"Path of the archive file to import."                                    arg:"" type:"existingfile"`
+	MapUser []string `help:"Map a user ID of the source to a user ID of this instance (old=new)."   sep:"none"`
+	MapJob  []string `help:"Map a job name of the source to a job name of this instance (old=new)." sep:"none"`
+}
+
+func (c *ToolsDocumentsImportCmd) Run() error {
+	users, err := docarchive.ParseUserMappings(c.MapUser)
+	if err != nil {
+		return err
+	}
+	jobs, err := docarchive.ParseJobMappings(c.MapJob)
+	if err != nil {
+		return err
+	}
+
+	return runWithArchiver(func(ctx context.Context, archiver *docarchive.Archiver) error {
+		f, err := os.Open(c.Input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+
+		result, err := archiver.Import(ctx, f, info.Size(), &docarchive.ImportOptions{
+			Users: users,
+			Jobs:  jobs,
+		})
+		if result != nil {
+			fmt.Printf(
+				"Imported %d documents with %d files and %d references\n",
+				result.Documents,
+				result.Files,
+				result.References,
+			)
+			if len(result.SkippedUsers) > 0 {
+				fmt.Println(
+					"Users not found on this instance (creators removed, relations and access skipped):",
+					result.SkippedUsers,
+				)
+			}
+		}
+		return err
+	})
+}
+
+func runWithArchiver(fn func(ctx context.Context, archiver *docarchive.Archiver) error) error {
+	fxOpts := fxopts.GetFxBaseOpts(12*time.Hour, false, true)
+
+	if err := os.Setenv(envs.SkipDBMigrationsEnv, "true"); err != nil {
+		return err
+	}
+
+	fxOpts = append(
+		fxOpts,
+		fx.Invoke(
+			func(lifecycle fx.Lifecycle, _ *config.Config, db *sql.DB, storage storage.IStorage, shutdowner fx.Shutdowner) {
+				lifecycle.Append(fx.StartHook(func(ctx context.Context) error {
+					go func() {
+						exitCode := 0
+						if err := fn(ctx, docarchive.New(db, storage)); err != nil {
+							exitCode = 1
+							fmt.Println("Error running documents command:", err)
+						}
+						_ = shutdowner.Shutdown(fx.ExitCode(exitCode))
+					}()
+					return nil
+				}))
+			},
+		),
+	)
+
+	app := fx.New(fxOpts...)
+	app.Run()
+
+	return nil
+}
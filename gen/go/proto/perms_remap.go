@@ -31,6 +31,8 @@
 // source: services/notifications/notifications.proto
 // source: services/qualifications/exam.proto
 // source: services/qualifications/qualifications.proto
+// source: services/qualifications/training.proto
+// source: services/search/search.proto
 // source: services/settings/accounts.proto
 // source: services/settings/config.proto
 // source: services/settings/cron.proto
@@ -50,6 +52,7 @@ import (
 	permscitizens "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/citizens/perms"
 	permsdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents/perms"
 	permsjobs "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/jobs/perms"
+	permslivemap "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap/perms"
 	permsmailer "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/mailer/perms"
 	permsqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications/perms"
 	permssettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/settings/perms"
@@ -113,6 +116,9 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	"centrum.CentrumService/GetDispatchHeatmap": {
 		permscentrum.CentrumService.TakeControl.Perm,
 	},
+	"centrum.CentrumService/GetDispatchStats": {
+		permscentrum.CentrumService.TakeControl.Perm,
+	},
 	"centrum.CentrumService/GetSettings": {
 		permscentrum.CentrumService.Stream.Perm,
 	},
@@ -121,15 +127,30 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	"centrum.DispatchesService/AssignDispatch": {
 		permscentrum.CentrumService.TakeControl.Perm,
 	},
+	"centrum.DispatchesService/CreateOrUpdateDispatchTemplate": {
+		permscentrum.CentrumService.UpdateSettings.Perm,
+	},
+	"centrum.DispatchesService/DeleteDispatchTemplate": {
+		permscentrum.CentrumService.UpdateSettings.Perm,
+	},
+	"centrum.DispatchesService/ExportDispatchTimeline": {
+		permscentrum.CentrumService.Stream.Perm,
+	},
 	"centrum.DispatchesService/GetDispatch": {
 		permscentrum.CentrumService.Stream.Perm,
 	},
+	"centrum.DispatchesService/GetDispatchTimeline": {
+		permscentrum.CentrumService.Stream.Perm,
+	},
 	"centrum.DispatchesService/ListDispatchActivity": {
 		permscentrum.CentrumService.Stream.Perm,
 	},
 	"centrum.DispatchesService/ListDispatchTargetJobs": {
 		permscentrum.DispatchesService.CreateDispatch.Perm,
 	},
+	"centrum.DispatchesService/ListDispatchTemplates": {
+		permscentrum.DispatchesService.CreateDispatch.Perm,
+	},
 	"centrum.DispatchesService/ListDispatches": {
 		permscentrum.CentrumService.Stream.Perm,
 	},
@@ -141,12 +162,24 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	"centrum.UnitsService/AssignUnit": {
 		permscentrum.CentrumService.TakeControl.Perm,
 	},
+	"centrum.UnitsService/CreateOrUpdateUnitRoster": {
+		permscentrum.UnitsService.CreateOrUpdateUnit.Perm,
+	},
+	"centrum.UnitsService/DeleteUnitRoster": {
+		permscentrum.UnitsService.CreateOrUpdateUnit.Perm,
+	},
+	"centrum.UnitsService/GenerateUnitRosters": {
+		permscentrum.UnitsService.CreateOrUpdateUnit.Perm,
+	},
 	"centrum.UnitsService/JoinUnit": {
 		permscentrum.CentrumService.Stream.Perm,
 	},
 	"centrum.UnitsService/ListUnitActivity": {
 		permscentrum.CentrumService.Stream.Perm,
 	},
+	"centrum.UnitsService/ListUnitRosters": {
+		permscentrum.CentrumService.Stream.Perm,
+	},
 	"centrum.UnitsService/ListUnits": {
 		permscentrum.CentrumService.Stream.Perm,
 	},
@@ -200,9 +233,15 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	"documents.ApprovalService/DecideApproval": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
+	"documents.ApprovalService/DeleteApprovalPolicyTemplate": {
+		permsdocuments.ApprovalService.CreateOrUpdateApprovalPolicyTemplate.Perm,
+	},
 	"documents.ApprovalService/ListApprovalPolicies": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
+	"documents.ApprovalService/ListApprovalPolicyTemplates": {
+		permsdocuments.ApprovalService.CreateOrUpdateApprovalPolicyTemplate.Perm,
+	},
 	"documents.ApprovalService/ListApprovalTasks": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
@@ -245,30 +284,51 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	"documents.DocumentsService/CreateDocument": {
 		permsdocuments.DocumentsService.UpdateDocument.Perm,
 	},
+	"documents.DocumentsService/GetBulkJob": {
+		permsdocuments.DocumentsService.BulkUpdateDocuments.Perm,
+	},
 	"documents.DocumentsService/GetDocument": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
 	"documents.DocumentsService/GetDocumentAccess": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
+	"documents.DocumentsService/GetDocumentPDF": {
+		permsdocuments.DocumentsService.ListDocuments.Perm,
+	},
 	"documents.DocumentsService/GetDocumentReferences": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
 	"documents.DocumentsService/GetDocumentRelations": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
+	"documents.DocumentsService/GetDocumentVersion": {
+		permsdocuments.DocumentsService.ListDocumentActivity.Perm,
+	},
+	"documents.DocumentsService/ListBulkJobs": {
+		permsdocuments.DocumentsService.BulkUpdateDocuments.Perm,
+	},
 	"documents.DocumentsService/ListDocumentPins": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
+	"documents.DocumentsService/ListDocumentVersions": {
+		permsdocuments.DocumentsService.ListDocumentActivity.Perm,
+	},
 	"documents.DocumentsService/RemoveDocumentReference": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
 	"documents.DocumentsService/RemoveDocumentRelation": {
 		permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
+	"documents.DocumentsService/RestoreDocumentVersion": {
+		permsdocuments.DocumentsService.UpdateDocument.Perm,
+	},
 	"documents.DocumentsService/SetDocumentAccess": {
 		permsdocuments.DocumentsService.UpdateDocument.Perm,
 	},
+	"documents.DocumentsService/TransitionDocumentState": {
+		permsdocuments.DocumentsService.ToggleDocument.Perm,
+	},
 	"documents.DocumentsService/UpdateDocument": {
 		permsdocuments.DocumentsService.UpdateDocument.Perm, permsdocuments.DocumentsService.ListDocuments.Perm,
 	},
@@ -402,9 +462,23 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	},
 
 	// Service: jobs.TimeclockService
+	"jobs.TimeclockService/CreateTimeclockCorrection": {
+		permsjobs.TimeclockService.ListTimeclock.Perm,
+	},
 	"jobs.TimeclockService/GetTimeclockStats": {
 		permsjobs.TimeclockService.ListTimeclock.Perm,
 	},
+	"jobs.TimeclockService/ListTimeclockCorrections": {
+		permsjobs.TimeclockService.ListTimeclock.Perm,
+	},
+
+	// Service: livemap.LivemapService
+	"livemap.LivemapService/GetPositionTrails": {
+		permslivemap.LivemapService.Stream.Perm,
+	},
+	"livemap.LivemapService/SetStreamViewport": {
+		permslivemap.LivemapService.Stream.Perm,
+	},
 
 	// Service: mailer.MailerService
 	"mailer.MailerService/GetEmail": {
@@ -484,6 +558,9 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	"qualifications.ExamService/GetUserExam": {
 		permsqualifications.QualificationsService.ListQualifications.Perm,
 	},
+	"qualifications.ExamService/ReportExamFocusLost": {
+		permsqualifications.QualificationsService.ListQualifications.Perm,
+	},
 	"qualifications.ExamService/SubmitExam": {
 		permsqualifications.QualificationsService.ListQualifications.Perm,
 	},
@@ -520,6 +597,29 @@ var PermsRemap = map[string][]perms.PermissionRef{
 		permsqualifications.QualificationsService.UpdateQualification.Perm,
 	},
 
+	// Service: qualifications.TrainingService
+	"qualifications.TrainingService/CreateTrainingPath": {
+		permsqualifications.QualificationsService.UpdateQualification.Perm,
+	},
+	"qualifications.TrainingService/DeleteTrainingPath": {
+		permsqualifications.QualificationsService.UpdateQualification.Perm,
+	},
+	"qualifications.TrainingService/GetTrainingMatrix": {
+		permsqualifications.QualificationsService.ListQualifications.Perm,
+	},
+	"qualifications.TrainingService/GetTrainingPath": {
+		permsqualifications.QualificationsService.ListQualifications.Perm,
+	},
+	"qualifications.TrainingService/GetTrainingProgress": {
+		permsqualifications.QualificationsService.ListQualifications.Perm,
+	},
+	"qualifications.TrainingService/ListTrainingPaths": {
+		permsqualifications.QualificationsService.ListQualifications.Perm,
+	},
+	"qualifications.TrainingService/UpdateTrainingPath": {
+		permsqualifications.QualificationsService.UpdateQualification.Perm,
+	},
+
 	// Service: settings.AccountsService
 	"settings.AccountsService/CreateAccount": {
 		perms.PermConfigAdminRef,
@@ -597,6 +697,9 @@ var PermsRemap = map[string][]perms.PermissionRef{
 	"settings.SystemService/DeleteFaction": {
 		perms.PermJobAdminRef,
 	},
+	"settings.SystemService/ExportDocumentsArchive": {
+		perms.PermJobAdminRef,
+	},
 	"settings.SystemService/GetAllPermissions": {
 		perms.PermJobAdminRef,
 	},
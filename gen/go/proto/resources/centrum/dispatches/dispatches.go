@@ -91,6 +91,12 @@ func (x *Dispatch) Merge(in *Dispatch) *Dispatch {
 		}
 	}
 
+	if in.GetRequirements() != nil {
+		x.Requirements = in.GetRequirements()
+	}
+
+	x.RequirementsState = in.GetRequirementsState()
+
 	return x
 }
 
@@ -175,3 +181,61 @@ func (x *DispatchAttributes) Remove(attribute DispatchAttribute) bool {
 
 	return true
 }
+
+// HasUnitRequirements returns true if a minimum amount of units and/or units with specific skills are required.
+func (x *DispatchRequirements) HasUnitRequirements() bool {
+	return x.GetUnits() > 0 || len(x.GetUnitSkills()) > 0
+}
+
+// ComputeRequirementsState returns the fulfillment state of the dispatch's unit requirements based on the
+// assigned units. A unit counts towards every skill requirement it has the skill for.
+// Returns nil if the dispatch has no unit requirements.
+func (x *Dispatch) ComputeRequirementsState() *DispatchRequirementsState {
+	reqs := x.GetRequirements()
+	if !reqs.HasUnitRequirements() {
+		return nil
+	}
+
+	state := &DispatchRequirementsState{
+		Met:           true,
+		RequiredUnits: reqs.GetUnits(),
+		AssignedUnits: int32(len(x.GetUnits())),
+		UnitSkills:    make([]*DispatchUnitRequirementState, 0, len(reqs.GetUnitSkills())),
+	}
+
+	for _, req := range reqs.GetUnitSkills() {
+		skillState := &DispatchUnitRequirementState{
+			Skill:    req.GetSkill(),
+			Required: req.GetCount(),
+		}
+		for _, ua := range x.GetUnits() {
+			if ua.GetUnit().GetAttributes().HasSkill(req.GetSkill()) {
+				skillState.Assigned++
+			}
+		}
+
+		state.RequiredUnits = max(state.RequiredUnits, skillState.GetRequired())
+		if skillState.GetAssigned() < skillState.GetRequired() {
+			state.Met = false
+		}
+		state.UnitSkills = append(state.UnitSkills, skillState)
+	}
+
+	if state.GetAssignedUnits() < state.GetRequiredUnits() {
+		state.Met = false
+	}
+
+	return state
+}
+
+// MissingSkills returns the skills of which not enough units have been assigned yet.
+func (x *DispatchRequirementsState) MissingSkills() []string {
+	missing := []string{}
+	for _, skill := range x.GetUnitSkills() {
+		if skill.GetAssigned() < skill.GetRequired() {
+			missing = append(missing, skill.GetSkill())
+		}
+	}
+
+	return missing
+}
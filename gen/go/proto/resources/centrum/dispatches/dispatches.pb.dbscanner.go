@@ -72,3 +72,35 @@ func (x *DispatchReferences) Value() (driver.Value, error) {
 	out, err := protoutils.MarshalToJSON(x)
 	return string(out), err
 }
+
+// Scan implements driver.Valuer for protobuf DispatchRequirements.
+func (x *DispatchRequirements) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the DispatchRequirements value into driver.Valuer.
+func (x *DispatchRequirements) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
@@ -75,6 +75,24 @@ func (m *Dispatch) Sanitize() error {
 		}
 	}
 
+	// Field: Requirements
+	if m.Requirements != nil {
+		if v, ok := any(m.GetRequirements()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: RequirementsState
+	if m.RequirementsState != nil {
+		if v, ok := any(m.GetRequirementsState()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: Status
 	if m.Status != nil {
 		if v, ok := any(m.GetStatus()).(interface{ Sanitize() error }); ok {
@@ -208,6 +226,58 @@ func (m *DispatchReferences) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchRequirements) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Skills
+	for idx, item := range m.Skills {
+		_, _ = idx, item
+
+		m.Skills[idx] = htmlsanitizer.StripHTMLTags(m.Skills[idx])
+
+	}
+
+	// Field: UnitSkills
+	for idx, item := range m.UnitSkills {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchRequirementsState) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: UnitSkills
+	for idx, item := range m.UnitSkills {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *DispatchStatus) Sanitize() error {
@@ -264,3 +334,29 @@ func (m *DispatchStatus) Sanitize() error {
 
 	return nil
 }
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchUnitRequirement) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Skill
+	m.Skill = htmlsanitizer.StripHTMLTags(m.Skill)
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchUnitRequirementState) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Skill
+	m.Skill = htmlsanitizer.SanitizeAndUnescape(m.Skill)
+
+	return nil
+}
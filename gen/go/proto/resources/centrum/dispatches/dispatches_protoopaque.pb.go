@@ -48,6 +48,7 @@ const (
 	StatusDispatch_STATUS_DISPATCH_CANCELLED       StatusDispatch = 12
 	StatusDispatch_STATUS_DISPATCH_ARCHIVED        StatusDispatch = 13
 	StatusDispatch_STATUS_DISPATCH_DELETED         StatusDispatch = 14
+	StatusDispatch_STATUS_DISPATCH_ESCALATED       StatusDispatch = 15
 )
 
 // Enum value maps for StatusDispatch.
@@ -68,6 +69,7 @@ var (
 		12: "STATUS_DISPATCH_CANCELLED",
 		13: "STATUS_DISPATCH_ARCHIVED",
 		14: "STATUS_DISPATCH_DELETED",
+		15: "STATUS_DISPATCH_ESCALATED",
 	}
 	StatusDispatch_value = map[string]int32{
 		"STATUS_DISPATCH_UNSPECIFIED":     0,
@@ -85,6 +87,7 @@ var (
 		"STATUS_DISPATCH_CANCELLED":       12,
 		"STATUS_DISPATCH_ARCHIVED":        13,
 		"STATUS_DISPATCH_DELETED":         14,
+		"STATUS_DISPATCH_ESCALATED":       15,
 	}
 )
 
@@ -207,11 +210,12 @@ func (x DispatchReferenceType) Number() protoreflect.EnumNumber {
 type DispatchAttribute int32
 
 const (
-	DispatchAttribute_DISPATCH_ATTRIBUTE_UNSPECIFIED DispatchAttribute = 0
-	DispatchAttribute_DISPATCH_ATTRIBUTE_MULTIPLE    DispatchAttribute = 1
-	DispatchAttribute_DISPATCH_ATTRIBUTE_DUPLICATE   DispatchAttribute = 2
-	DispatchAttribute_DISPATCH_ATTRIBUTE_TOO_OLD     DispatchAttribute = 3
-	DispatchAttribute_DISPATCH_ATTRIBUTE_AUTOMATIC   DispatchAttribute = 4
+	DispatchAttribute_DISPATCH_ATTRIBUTE_UNSPECIFIED  DispatchAttribute = 0
+	DispatchAttribute_DISPATCH_ATTRIBUTE_MULTIPLE     DispatchAttribute = 1
+	DispatchAttribute_DISPATCH_ATTRIBUTE_DUPLICATE    DispatchAttribute = 2
+	DispatchAttribute_DISPATCH_ATTRIBUTE_TOO_OLD      DispatchAttribute = 3
+	DispatchAttribute_DISPATCH_ATTRIBUTE_AUTOMATIC    DispatchAttribute = 4
+	DispatchAttribute_DISPATCH_ATTRIBUTE_SLA_BREACHED DispatchAttribute = 5
 )
 
 // Enum value maps for DispatchAttribute.
@@ -222,13 +226,15 @@ var (
 		2: "DISPATCH_ATTRIBUTE_DUPLICATE",
 		3: "DISPATCH_ATTRIBUTE_TOO_OLD",
 		4: "DISPATCH_ATTRIBUTE_AUTOMATIC",
+		5: "DISPATCH_ATTRIBUTE_SLA_BREACHED",
 	}
 	DispatchAttribute_value = map[string]int32{
-		"DISPATCH_ATTRIBUTE_UNSPECIFIED": 0,
-		"DISPATCH_ATTRIBUTE_MULTIPLE":    1,
-		"DISPATCH_ATTRIBUTE_DUPLICATE":   2,
-		"DISPATCH_ATTRIBUTE_TOO_OLD":     3,
-		"DISPATCH_ATTRIBUTE_AUTOMATIC":   4,
+		"DISPATCH_ATTRIBUTE_UNSPECIFIED":  0,
+		"DISPATCH_ATTRIBUTE_MULTIPLE":     1,
+		"DISPATCH_ATTRIBUTE_DUPLICATE":    2,
+		"DISPATCH_ATTRIBUTE_TOO_OLD":      3,
+		"DISPATCH_ATTRIBUTE_AUTOMATIC":    4,
+		"DISPATCH_ATTRIBUTE_SLA_BREACHED": 5,
 	}
 )
 
@@ -255,28 +261,30 @@ func (x DispatchAttribute) Number() protoreflect.EnumNumber {
 }
 
 type Dispatch struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          int64                  `protobuf:"varint,1,opt,name=id,proto3"`
-	xxx_hidden_CreatedAt   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof"`
-	xxx_hidden_UpdatedAt   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof"`
-	xxx_hidden_Job         string                 `protobuf:"bytes,4,opt,name=job,proto3"`
-	xxx_hidden_Jobs        *centrum.JobList       `protobuf:"bytes,18,opt,name=jobs,proto3"`
-	xxx_hidden_Status      *DispatchStatus        `protobuf:"bytes,5,opt,name=status,proto3,oneof"`
-	xxx_hidden_Message     string                 `protobuf:"bytes,7,opt,name=message,proto3"`
-	xxx_hidden_Description *string                `protobuf:"bytes,8,opt,name=description,proto3,oneof"`
-	xxx_hidden_Attributes  *DispatchAttributes    `protobuf:"bytes,9,opt,name=attributes,proto3,oneof"`
-	xxx_hidden_X           float64                `protobuf:"fixed64,10,opt,name=x,proto3"`
-	xxx_hidden_Y           float64                `protobuf:"fixed64,11,opt,name=y,proto3"`
-	xxx_hidden_Postal      *string                `protobuf:"bytes,12,opt,name=postal,proto3,oneof"`
-	xxx_hidden_Anon        bool                   `protobuf:"varint,13,opt,name=anon,proto3"`
-	xxx_hidden_CreatorId   int32                  `protobuf:"varint,14,opt,name=creator_id,json=creatorId,proto3,oneof"`
-	xxx_hidden_Creator     *users.User            `protobuf:"bytes,15,opt,name=creator,proto3,oneof"`
-	xxx_hidden_Units       *[]*DispatchAssignment `protobuf:"bytes,16,rep,name=units,proto3"`
-	xxx_hidden_References  *DispatchReferences    `protobuf:"bytes,17,opt,name=references,proto3,oneof"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                        protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_Id                int64                      `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_CreatedAt         *timestamp.Timestamp       `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof"`
+	xxx_hidden_UpdatedAt         *timestamp.Timestamp       `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof"`
+	xxx_hidden_Job               string                     `protobuf:"bytes,4,opt,name=job,proto3"`
+	xxx_hidden_Jobs              *centrum.JobList           `protobuf:"bytes,18,opt,name=jobs,proto3"`
+	xxx_hidden_Status            *DispatchStatus            `protobuf:"bytes,5,opt,name=status,proto3,oneof"`
+	xxx_hidden_Message           string                     `protobuf:"bytes,7,opt,name=message,proto3"`
+	xxx_hidden_Description       *string                    `protobuf:"bytes,8,opt,name=description,proto3,oneof"`
+	xxx_hidden_Attributes        *DispatchAttributes        `protobuf:"bytes,9,opt,name=attributes,proto3,oneof"`
+	xxx_hidden_X                 float64                    `protobuf:"fixed64,10,opt,name=x,proto3"`
+	xxx_hidden_Y                 float64                    `protobuf:"fixed64,11,opt,name=y,proto3"`
+	xxx_hidden_Postal            *string                    `protobuf:"bytes,12,opt,name=postal,proto3,oneof"`
+	xxx_hidden_Anon              bool                       `protobuf:"varint,13,opt,name=anon,proto3"`
+	xxx_hidden_CreatorId         int32                      `protobuf:"varint,14,opt,name=creator_id,json=creatorId,proto3,oneof"`
+	xxx_hidden_Creator           *users.User                `protobuf:"bytes,15,opt,name=creator,proto3,oneof"`
+	xxx_hidden_Units             *[]*DispatchAssignment     `protobuf:"bytes,16,rep,name=units,proto3"`
+	xxx_hidden_References        *DispatchReferences        `protobuf:"bytes,17,opt,name=references,proto3,oneof"`
+	xxx_hidden_Requirements      *DispatchRequirements      `protobuf:"bytes,19,opt,name=requirements,proto3,oneof"`
+	xxx_hidden_RequirementsState *DispatchRequirementsState `protobuf:"bytes,20,opt,name=requirements_state,json=requirementsState,proto3,oneof"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *Dispatch) Reset() {
@@ -432,6 +440,20 @@ func (x *Dispatch) GetReferences() *DispatchReferences {
 	return nil
 }
 
+func (x *Dispatch) GetRequirements() *DispatchRequirements {
+	if x != nil {
+		return x.xxx_hidden_Requirements
+	}
+	return nil
+}
+
+func (x *Dispatch) GetRequirementsState() *DispatchRequirementsState {
+	if x != nil {
+		return x.xxx_hidden_RequirementsState
+	}
+	return nil
+}
+
 func (x *Dispatch) SetId(v int64) {
 	x.xxx_hidden_Id = v
 }
@@ -463,7 +485,7 @@ func (x *Dispatch) SetMessage(v string) {
 
 func (x *Dispatch) SetDescription(v string) {
 	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 17)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 19)
 }
 
 func (x *Dispatch) SetAttributes(v *DispatchAttributes) {
@@ -480,7 +502,7 @@ func (x *Dispatch) SetY(v float64) {
 
 func (x *Dispatch) SetPostal(v string) {
 	x.xxx_hidden_Postal = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 17)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 19)
 }
 
 func (x *Dispatch) SetAnon(v bool) {
@@ -489,7 +511,7 @@ func (x *Dispatch) SetAnon(v bool) {
 
 func (x *Dispatch) SetCreatorId(v int32) {
 	x.xxx_hidden_CreatorId = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 17)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 19)
 }
 
 func (x *Dispatch) SetCreator(v *users.User) {
@@ -504,6 +526,14 @@ func (x *Dispatch) SetReferences(v *DispatchReferences) {
 	x.xxx_hidden_References = v
 }
 
+func (x *Dispatch) SetRequirements(v *DispatchRequirements) {
+	x.xxx_hidden_Requirements = v
+}
+
+func (x *Dispatch) SetRequirementsState(v *DispatchRequirementsState) {
+	x.xxx_hidden_RequirementsState = v
+}
+
 func (x *Dispatch) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -574,6 +604,20 @@ func (x *Dispatch) HasReferences() bool {
 	return x.xxx_hidden_References != nil
 }
 
+func (x *Dispatch) HasRequirements() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Requirements != nil
+}
+
+func (x *Dispatch) HasRequirementsState() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RequirementsState != nil
+}
+
 func (x *Dispatch) ClearCreatedAt() {
 	x.xxx_hidden_CreatedAt = nil
 }
@@ -617,6 +661,14 @@ func (x *Dispatch) ClearReferences() {
 	x.xxx_hidden_References = nil
 }
 
+func (x *Dispatch) ClearRequirements() {
+	x.xxx_hidden_Requirements = nil
+}
+
+func (x *Dispatch) ClearRequirementsState() {
+	x.xxx_hidden_RequirementsState = nil
+}
+
 type Dispatch_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -624,20 +676,23 @@ type Dispatch_builder struct {
 	CreatedAt *timestamp.Timestamp
 	UpdatedAt *timestamp.Timestamp
 	// Deprecated: Marked as deprecated in resources/centrum/dispatches/dispatches.proto.
-	Job         string
-	Jobs        *centrum.JobList
-	Status      *DispatchStatus
-	Message     string
-	Description *string
-	Attributes  *DispatchAttributes
-	X           float64
-	Y           float64
-	Postal      *string
-	Anon        bool
-	CreatorId   *int32
-	Creator     *users.User
-	Units       []*DispatchAssignment
-	References  *DispatchReferences
+	Job          string
+	Jobs         *centrum.JobList
+	Status       *DispatchStatus
+	Message      string
+	Description  *string
+	Attributes   *DispatchAttributes
+	X            float64
+	Y            float64
+	Postal       *string
+	Anon         bool
+	CreatorId    *int32
+	Creator      *users.User
+	Units        []*DispatchAssignment
+	References   *DispatchReferences
+	Requirements *DispatchRequirements
+	// Fulfillment state of the unit requirements, computed from the assigned units (not stored in the database)
+	RequirementsState *DispatchRequirementsState
 }
 
 func (b0 Dispatch_builder) Build() *Dispatch {
@@ -652,24 +707,26 @@ func (b0 Dispatch_builder) Build() *Dispatch {
 	x.xxx_hidden_Status = b.Status
 	x.xxx_hidden_Message = b.Message
 	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 17)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 19)
 		x.xxx_hidden_Description = b.Description
 	}
 	x.xxx_hidden_Attributes = b.Attributes
 	x.xxx_hidden_X = b.X
 	x.xxx_hidden_Y = b.Y
 	if b.Postal != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 17)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 19)
 		x.xxx_hidden_Postal = b.Postal
 	}
 	x.xxx_hidden_Anon = b.Anon
 	if b.CreatorId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 17)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 19)
 		x.xxx_hidden_CreatorId = *b.CreatorId
 	}
 	x.xxx_hidden_Creator = b.Creator
 	x.xxx_hidden_Units = &b.Units
 	x.xxx_hidden_References = b.References
+	x.xxx_hidden_Requirements = b.Requirements
+	x.xxx_hidden_RequirementsState = b.RequirementsState
 	return m0
 }
 
@@ -1507,11 +1564,378 @@ func (b0 DispatchAttributes_builder) Build() *DispatchAttributes {
 	return m0
 }
 
+type DispatchRequirements struct {
+	state                  protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_Skills      []string                    `protobuf:"bytes,1,rep,name=skills,proto3"`
+	xxx_hidden_Units       int32                       `protobuf:"varint,2,opt,name=units,proto3,oneof"`
+	xxx_hidden_UnitSkills  *[]*DispatchUnitRequirement `protobuf:"bytes,3,rep,name=unit_skills,json=unitSkills,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DispatchRequirements) Reset() {
+	*x = DispatchRequirements{}
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchRequirements) ProtoMessage() {}
+
+func (x *DispatchRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchRequirements) GetSkills() []string {
+	if x != nil {
+		return x.xxx_hidden_Skills
+	}
+	return nil
+}
+
+func (x *DispatchRequirements) GetUnits() int32 {
+	if x != nil {
+		return x.xxx_hidden_Units
+	}
+	return 0
+}
+
+func (x *DispatchRequirements) GetUnitSkills() []*DispatchUnitRequirement {
+	if x != nil {
+		if x.xxx_hidden_UnitSkills != nil {
+			return *x.xxx_hidden_UnitSkills
+		}
+	}
+	return nil
+}
+
+func (x *DispatchRequirements) SetSkills(v []string) {
+	x.xxx_hidden_Skills = v
+}
+
+func (x *DispatchRequirements) SetUnits(v int32) {
+	x.xxx_hidden_Units = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *DispatchRequirements) SetUnitSkills(v []*DispatchUnitRequirement) {
+	x.xxx_hidden_UnitSkills = &v
+}
+
+func (x *DispatchRequirements) HasUnits() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DispatchRequirements) ClearUnits() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Units = 0
+}
+
+type DispatchRequirements_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Skills units should have to be preferred by the dispatch auto assignment
+	Skills []string
+	// Minimum amount of units that should be assigned to the dispatch
+	Units *int32
+	// Amount of units with a specific skill that should be assigned, e.g., "1 unit with skill X"
+	UnitSkills []*DispatchUnitRequirement
+}
+
+func (b0 DispatchRequirements_builder) Build() *DispatchRequirements {
+	m0 := &DispatchRequirements{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Skills = b.Skills
+	if b.Units != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Units = *b.Units
+	}
+	x.xxx_hidden_UnitSkills = &b.UnitSkills
+	return m0
+}
+
+type DispatchUnitRequirement struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Skill string                 `protobuf:"bytes,1,opt,name=skill,proto3"`
+	xxx_hidden_Count int32                  `protobuf:"varint,2,opt,name=count,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DispatchUnitRequirement) Reset() {
+	*x = DispatchUnitRequirement{}
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchUnitRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchUnitRequirement) ProtoMessage() {}
+
+func (x *DispatchUnitRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchUnitRequirement) GetSkill() string {
+	if x != nil {
+		return x.xxx_hidden_Skill
+	}
+	return ""
+}
+
+func (x *DispatchUnitRequirement) GetCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *DispatchUnitRequirement) SetSkill(v string) {
+	x.xxx_hidden_Skill = v
+}
+
+func (x *DispatchUnitRequirement) SetCount(v int32) {
+	x.xxx_hidden_Count = v
+}
+
+type DispatchUnitRequirement_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Skill string
+	Count int32
+}
+
+func (b0 DispatchUnitRequirement_builder) Build() *DispatchUnitRequirement {
+	m0 := &DispatchUnitRequirement{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Skill = b.Skill
+	x.xxx_hidden_Count = b.Count
+	return m0
+}
+
+type DispatchRequirementsState struct {
+	state                    protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_Met           bool                             `protobuf:"varint,1,opt,name=met,proto3"`
+	xxx_hidden_RequiredUnits int32                            `protobuf:"varint,2,opt,name=required_units,json=requiredUnits,proto3"`
+	xxx_hidden_AssignedUnits int32                            `protobuf:"varint,3,opt,name=assigned_units,json=assignedUnits,proto3"`
+	xxx_hidden_UnitSkills    *[]*DispatchUnitRequirementState `protobuf:"bytes,4,rep,name=unit_skills,json=unitSkills,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *DispatchRequirementsState) Reset() {
+	*x = DispatchRequirementsState{}
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchRequirementsState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchRequirementsState) ProtoMessage() {}
+
+func (x *DispatchRequirementsState) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchRequirementsState) GetMet() bool {
+	if x != nil {
+		return x.xxx_hidden_Met
+	}
+	return false
+}
+
+func (x *DispatchRequirementsState) GetRequiredUnits() int32 {
+	if x != nil {
+		return x.xxx_hidden_RequiredUnits
+	}
+	return 0
+}
+
+func (x *DispatchRequirementsState) GetAssignedUnits() int32 {
+	if x != nil {
+		return x.xxx_hidden_AssignedUnits
+	}
+	return 0
+}
+
+func (x *DispatchRequirementsState) GetUnitSkills() []*DispatchUnitRequirementState {
+	if x != nil {
+		if x.xxx_hidden_UnitSkills != nil {
+			return *x.xxx_hidden_UnitSkills
+		}
+	}
+	return nil
+}
+
+func (x *DispatchRequirementsState) SetMet(v bool) {
+	x.xxx_hidden_Met = v
+}
+
+func (x *DispatchRequirementsState) SetRequiredUnits(v int32) {
+	x.xxx_hidden_RequiredUnits = v
+}
+
+func (x *DispatchRequirementsState) SetAssignedUnits(v int32) {
+	x.xxx_hidden_AssignedUnits = v
+}
+
+func (x *DispatchRequirementsState) SetUnitSkills(v []*DispatchUnitRequirementState) {
+	x.xxx_hidden_UnitSkills = &v
+}
+
+type DispatchRequirementsState_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// All unit requirements are fulfilled by the assigned units
+	Met           bool
+	RequiredUnits int32
+	// Assigned units, including units that haven't accepted the assignment yet
+	AssignedUnits int32
+	UnitSkills    []*DispatchUnitRequirementState
+}
+
+func (b0 DispatchRequirementsState_builder) Build() *DispatchRequirementsState {
+	m0 := &DispatchRequirementsState{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Met = b.Met
+	x.xxx_hidden_RequiredUnits = b.RequiredUnits
+	x.xxx_hidden_AssignedUnits = b.AssignedUnits
+	x.xxx_hidden_UnitSkills = &b.UnitSkills
+	return m0
+}
+
+type DispatchUnitRequirementState struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Skill    string                 `protobuf:"bytes,1,opt,name=skill,proto3"`
+	xxx_hidden_Required int32                  `protobuf:"varint,2,opt,name=required,proto3"`
+	xxx_hidden_Assigned int32                  `protobuf:"varint,3,opt,name=assigned,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DispatchUnitRequirementState) Reset() {
+	*x = DispatchUnitRequirementState{}
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchUnitRequirementState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchUnitRequirementState) ProtoMessage() {}
+
+func (x *DispatchUnitRequirementState) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_dispatches_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchUnitRequirementState) GetSkill() string {
+	if x != nil {
+		return x.xxx_hidden_Skill
+	}
+	return ""
+}
+
+func (x *DispatchUnitRequirementState) GetRequired() int32 {
+	if x != nil {
+		return x.xxx_hidden_Required
+	}
+	return 0
+}
+
+func (x *DispatchUnitRequirementState) GetAssigned() int32 {
+	if x != nil {
+		return x.xxx_hidden_Assigned
+	}
+	return 0
+}
+
+func (x *DispatchUnitRequirementState) SetSkill(v string) {
+	x.xxx_hidden_Skill = v
+}
+
+func (x *DispatchUnitRequirementState) SetRequired(v int32) {
+	x.xxx_hidden_Required = v
+}
+
+func (x *DispatchUnitRequirementState) SetAssigned(v int32) {
+	x.xxx_hidden_Assigned = v
+}
+
+type DispatchUnitRequirementState_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Skill    string
+	Required int32
+	Assigned int32
+}
+
+func (b0 DispatchUnitRequirementState_builder) Build() *DispatchUnitRequirementState {
+	m0 := &DispatchUnitRequirementState{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Skill = b.Skill
+	x.xxx_hidden_Required = b.Required
+	x.xxx_hidden_Assigned = b.Assigned
+	return m0
+}
+
 var File_resources_centrum_dispatches_dispatches_proto protoreflect.FileDescriptor
 
 const file_resources_centrum_dispatches_dispatches_proto_rawDesc = "" +
 	"\n" +
-	"-resources/centrum/dispatches/dispatches.proto\x12\x1cresources.centrum.dispatches\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1fresources/centrum/joblist.proto\x1a#resources/centrum/units/units.proto\x1a*resources/jobs/colleagues/colleagues.proto\x1a#resources/timestamp/timestamp.proto\x1a\x1aresources/users/user.proto\x1a\x13tagger/tagger.proto\"\xc9\a\n" +
+	"-resources/centrum/dispatches/dispatches.proto\x12\x1cresources.centrum.dispatches\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1fresources/centrum/joblist.proto\x1a#resources/centrum/units/units.proto\x1a*resources/jobs/colleagues/colleagues.proto\x1a#resources/timestamp/timestamp.proto\x1a\x1aresources/users/user.proto\x1a\x13tagger/tagger.proto\"\xbb\t\n" +
 	"\bDispatch\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -1537,7 +1961,10 @@ const file_resources_centrum_dispatches_dispatches_proto_rawDesc = "" +
 	"\x05units\x18\x10 \x03(\v20.resources.centrum.dispatches.DispatchAssignmentR\x05units\x12U\n" +
 	"\n" +
 	"references\x18\x11 \x01(\v20.resources.centrum.dispatches.DispatchReferencesH\bR\n" +
-	"references\x88\x01\x01B\r\n" +
+	"references\x88\x01\x01\x12[\n" +
+	"\frequirements\x18\x13 \x01(\v22.resources.centrum.dispatches.DispatchRequirementsH\tR\frequirements\x88\x01\x01\x12k\n" +
+	"\x12requirements_state\x18\x14 \x01(\v27.resources.centrum.dispatches.DispatchRequirementsStateH\n" +
+	"R\x11requirementsState\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\t\n" +
 	"\a_statusB\x0e\n" +
@@ -1547,7 +1974,9 @@ const file_resources_centrum_dispatches_dispatches_proto_rawDesc = "" +
 	"\v_creator_idB\n" +
 	"\n" +
 	"\b_creatorB\r\n" +
-	"\v_references\"\x90\x01\n" +
+	"\v_referencesB\x0f\n" +
+	"\r_requirementsB\x15\n" +
+	"\x13_requirements_state\"\x90\x01\n" +
 	"\x13DispatchAssignments\x12\x1f\n" +
 	"\vdispatch_id\x18\x01 \x01(\x03R\n" +
 	"dispatchId\x12\x10\n" +
@@ -1606,7 +2035,26 @@ const file_resources_centrum_dispatches_dispatches_proto_rawDesc = "" +
 	"\x12target_dispatch_id\x18\x01 \x01(\x03R\x10targetDispatchId\x12Z\n" +
 	"\x0ereference_type\x18\x02 \x01(\x0e23.resources.centrum.dispatches.DispatchReferenceTypeR\rreferenceType\"a\n" +
 	"\x12DispatchAttributes\x12C\n" +
-	"\x04list\x18\x01 \x03(\x0e2/.resources.centrum.dispatches.DispatchAttributeR\x04list:\x06\xe2\xf3\x18\x02\b\x01*\xef\x03\n" +
+	"\x04list\x18\x01 \x03(\x0e2/.resources.centrum.dispatches.DispatchAttributeR\x04list:\x06\xe2\xf3\x18\x02\b\x01\"\xbd\x01\n" +
+	"\x14DispatchRequirements\x12 \n" +
+	"\x06skills\x18\x01 \x03(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x06skills\x12\x19\n" +
+	"\x05units\x18\x02 \x01(\x05H\x00R\x05units\x88\x01\x01\x12V\n" +
+	"\vunit_skills\x18\x03 \x03(\v25.resources.centrum.dispatches.DispatchUnitRequirementR\n" +
+	"unitSkills:\x06\xe2\xf3\x18\x02\b\x01B\b\n" +
+	"\x06_units\"O\n" +
+	"\x17DispatchUnitRequirement\x12\x1e\n" +
+	"\x05skill\x18\x01 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x05skill\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xd8\x01\n" +
+	"\x19DispatchRequirementsState\x12\x10\n" +
+	"\x03met\x18\x01 \x01(\bR\x03met\x12%\n" +
+	"\x0erequired_units\x18\x02 \x01(\x05R\rrequiredUnits\x12%\n" +
+	"\x0eassigned_units\x18\x03 \x01(\x05R\rassignedUnits\x12[\n" +
+	"\vunit_skills\x18\x04 \x03(\v2:.resources.centrum.dispatches.DispatchUnitRequirementStateR\n" +
+	"unitSkills\"l\n" +
+	"\x1cDispatchUnitRequirementState\x12\x14\n" +
+	"\x05skill\x18\x01 \x01(\tR\x05skill\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\x05R\brequired\x12\x1a\n" +
+	"\bassigned\x18\x03 \x01(\x05R\bassigned*\x8e\x04\n" +
 	"\x0eStatusDispatch\x12\x1f\n" +
 	"\x1bSTATUS_DISPATCH_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13STATUS_DISPATCH_NEW\x10\x01\x12\x1e\n" +
@@ -1623,7 +2071,8 @@ const file_resources_centrum_dispatches_dispatches_proto_rawDesc = "" +
 	"\x19STATUS_DISPATCH_COMPLETED\x10\v\x12\x1d\n" +
 	"\x19STATUS_DISPATCH_CANCELLED\x10\f\x12\x1c\n" +
 	"\x18STATUS_DISPATCH_ARCHIVED\x10\r\x12\x1b\n" +
-	"\x17STATUS_DISPATCH_DELETED\x10\x0e*\x98\x01\n" +
+	"\x17STATUS_DISPATCH_DELETED\x10\x0e\x12\x1d\n" +
+	"\x19STATUS_DISPATCH_ESCALATED\x10\x0f*\x98\x01\n" +
 	"\x10TakeDispatchResp\x12\"\n" +
 	"\x1eTAKE_DISPATCH_RESP_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aTAKE_DISPATCH_RESP_TIMEOUT\x10\x01\x12\x1f\n" +
@@ -1633,59 +2082,68 @@ const file_resources_centrum_dispatches_dispatches_proto_rawDesc = "" +
 	"#DISPATCH_REFERENCE_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
 	"\"DISPATCH_REFERENCE_TYPE_REFERENCED\x10\x01\x12)\n" +
 	"%DISPATCH_REFERENCE_TYPE_DUPLICATED_BY\x10\x02\x12(\n" +
-	"$DISPATCH_REFERENCE_TYPE_DUPLICATE_OF\x10\x03*\xbc\x01\n" +
+	"$DISPATCH_REFERENCE_TYPE_DUPLICATE_OF\x10\x03*\xe1\x01\n" +
 	"\x11DispatchAttribute\x12\"\n" +
 	"\x1eDISPATCH_ATTRIBUTE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bDISPATCH_ATTRIBUTE_MULTIPLE\x10\x01\x12 \n" +
 	"\x1cDISPATCH_ATTRIBUTE_DUPLICATE\x10\x02\x12\x1e\n" +
 	"\x1aDISPATCH_ATTRIBUTE_TOO_OLD\x10\x03\x12 \n" +
-	"\x1cDISPATCH_ATTRIBUTE_AUTOMATIC\x10\x04BbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches;centrumdispatchesb\x06proto3"
+	"\x1cDISPATCH_ATTRIBUTE_AUTOMATIC\x10\x04\x12#\n" +
+	"\x1fDISPATCH_ATTRIBUTE_SLA_BREACHED\x10\x05BbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches;centrumdispatchesb\x06proto3"
 
 var file_resources_centrum_dispatches_dispatches_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_resources_centrum_dispatches_dispatches_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_resources_centrum_dispatches_dispatches_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_resources_centrum_dispatches_dispatches_proto_goTypes = []any{
-	(StatusDispatch)(0),          // 0: resources.centrum.dispatches.StatusDispatch
-	(TakeDispatchResp)(0),        // 1: resources.centrum.dispatches.TakeDispatchResp
-	(DispatchReferenceType)(0),   // 2: resources.centrum.dispatches.DispatchReferenceType
-	(DispatchAttribute)(0),       // 3: resources.centrum.dispatches.DispatchAttribute
-	(*Dispatch)(nil),             // 4: resources.centrum.dispatches.Dispatch
-	(*DispatchAssignments)(nil),  // 5: resources.centrum.dispatches.DispatchAssignments
-	(*DispatchAssignment)(nil),   // 6: resources.centrum.dispatches.DispatchAssignment
-	(*DispatchStatus)(nil),       // 7: resources.centrum.dispatches.DispatchStatus
-	(*DispatchReferences)(nil),   // 8: resources.centrum.dispatches.DispatchReferences
-	(*DispatchReference)(nil),    // 9: resources.centrum.dispatches.DispatchReference
-	(*DispatchAttributes)(nil),   // 10: resources.centrum.dispatches.DispatchAttributes
-	(*timestamp.Timestamp)(nil),  // 11: resources.timestamp.Timestamp
-	(*centrum.JobList)(nil),      // 12: resources.centrum.JobList
-	(*users.User)(nil),           // 13: resources.users.User
-	(*units.Unit)(nil),           // 14: resources.centrum.units.Unit
-	(*colleagues.Colleague)(nil), // 15: resources.jobs.colleagues.Colleague
+	(StatusDispatch)(0),                  // 0: resources.centrum.dispatches.StatusDispatch
+	(TakeDispatchResp)(0),                // 1: resources.centrum.dispatches.TakeDispatchResp
+	(DispatchReferenceType)(0),           // 2: resources.centrum.dispatches.DispatchReferenceType
+	(DispatchAttribute)(0),               // 3: resources.centrum.dispatches.DispatchAttribute
+	(*Dispatch)(nil),                     // 4: resources.centrum.dispatches.Dispatch
+	(*DispatchAssignments)(nil),          // 5: resources.centrum.dispatches.DispatchAssignments
+	(*DispatchAssignment)(nil),           // 6: resources.centrum.dispatches.DispatchAssignment
+	(*DispatchStatus)(nil),               // 7: resources.centrum.dispatches.DispatchStatus
+	(*DispatchReferences)(nil),           // 8: resources.centrum.dispatches.DispatchReferences
+	(*DispatchReference)(nil),            // 9: resources.centrum.dispatches.DispatchReference
+	(*DispatchAttributes)(nil),           // 10: resources.centrum.dispatches.DispatchAttributes
+	(*DispatchRequirements)(nil),         // 11: resources.centrum.dispatches.DispatchRequirements
+	(*DispatchUnitRequirement)(nil),      // 12: resources.centrum.dispatches.DispatchUnitRequirement
+	(*DispatchRequirementsState)(nil),    // 13: resources.centrum.dispatches.DispatchRequirementsState
+	(*DispatchUnitRequirementState)(nil), // 14: resources.centrum.dispatches.DispatchUnitRequirementState
+	(*timestamp.Timestamp)(nil),          // 15: resources.timestamp.Timestamp
+	(*centrum.JobList)(nil),              // 16: resources.centrum.JobList
+	(*users.User)(nil),                   // 17: resources.users.User
+	(*units.Unit)(nil),                   // 18: resources.centrum.units.Unit
+	(*colleagues.Colleague)(nil),         // 19: resources.jobs.colleagues.Colleague
 }
 var file_resources_centrum_dispatches_dispatches_proto_depIdxs = []int32{
-	11, // 0: resources.centrum.dispatches.Dispatch.created_at:type_name -> resources.timestamp.Timestamp
-	11, // 1: resources.centrum.dispatches.Dispatch.updated_at:type_name -> resources.timestamp.Timestamp
-	12, // 2: resources.centrum.dispatches.Dispatch.jobs:type_name -> resources.centrum.JobList
+	15, // 0: resources.centrum.dispatches.Dispatch.created_at:type_name -> resources.timestamp.Timestamp
+	15, // 1: resources.centrum.dispatches.Dispatch.updated_at:type_name -> resources.timestamp.Timestamp
+	16, // 2: resources.centrum.dispatches.Dispatch.jobs:type_name -> resources.centrum.JobList
 	7,  // 3: resources.centrum.dispatches.Dispatch.status:type_name -> resources.centrum.dispatches.DispatchStatus
 	10, // 4: resources.centrum.dispatches.Dispatch.attributes:type_name -> resources.centrum.dispatches.DispatchAttributes
-	13, // 5: resources.centrum.dispatches.Dispatch.creator:type_name -> resources.users.User
+	17, // 5: resources.centrum.dispatches.Dispatch.creator:type_name -> resources.users.User
 	6,  // 6: resources.centrum.dispatches.Dispatch.units:type_name -> resources.centrum.dispatches.DispatchAssignment
 	8,  // 7: resources.centrum.dispatches.Dispatch.references:type_name -> resources.centrum.dispatches.DispatchReferences
-	6,  // 8: resources.centrum.dispatches.DispatchAssignments.units:type_name -> resources.centrum.dispatches.DispatchAssignment
-	14, // 9: resources.centrum.dispatches.DispatchAssignment.unit:type_name -> resources.centrum.units.Unit
-	11, // 10: resources.centrum.dispatches.DispatchAssignment.created_at:type_name -> resources.timestamp.Timestamp
-	11, // 11: resources.centrum.dispatches.DispatchAssignment.expires_at:type_name -> resources.timestamp.Timestamp
-	11, // 12: resources.centrum.dispatches.DispatchStatus.created_at:type_name -> resources.timestamp.Timestamp
-	14, // 13: resources.centrum.dispatches.DispatchStatus.unit:type_name -> resources.centrum.units.Unit
-	0,  // 14: resources.centrum.dispatches.DispatchStatus.status:type_name -> resources.centrum.dispatches.StatusDispatch
-	15, // 15: resources.centrum.dispatches.DispatchStatus.user:type_name -> resources.jobs.colleagues.Colleague
-	9,  // 16: resources.centrum.dispatches.DispatchReferences.references:type_name -> resources.centrum.dispatches.DispatchReference
-	2,  // 17: resources.centrum.dispatches.DispatchReference.reference_type:type_name -> resources.centrum.dispatches.DispatchReferenceType
-	3,  // 18: resources.centrum.dispatches.DispatchAttributes.list:type_name -> resources.centrum.dispatches.DispatchAttribute
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	11, // 8: resources.centrum.dispatches.Dispatch.requirements:type_name -> resources.centrum.dispatches.DispatchRequirements
+	13, // 9: resources.centrum.dispatches.Dispatch.requirements_state:type_name -> resources.centrum.dispatches.DispatchRequirementsState
+	6,  // 10: resources.centrum.dispatches.DispatchAssignments.units:type_name -> resources.centrum.dispatches.DispatchAssignment
+	18, // 11: resources.centrum.dispatches.DispatchAssignment.unit:type_name -> resources.centrum.units.Unit
+	15, // 12: resources.centrum.dispatches.DispatchAssignment.created_at:type_name -> resources.timestamp.Timestamp
+	15, // 13: resources.centrum.dispatches.DispatchAssignment.expires_at:type_name -> resources.timestamp.Timestamp
+	15, // 14: resources.centrum.dispatches.DispatchStatus.created_at:type_name -> resources.timestamp.Timestamp
+	18, // 15: resources.centrum.dispatches.DispatchStatus.unit:type_name -> resources.centrum.units.Unit
+	0,  // 16: resources.centrum.dispatches.DispatchStatus.status:type_name -> resources.centrum.dispatches.StatusDispatch
+	19, // 17: resources.centrum.dispatches.DispatchStatus.user:type_name -> resources.jobs.colleagues.Colleague
+	9,  // 18: resources.centrum.dispatches.DispatchReferences.references:type_name -> resources.centrum.dispatches.DispatchReference
+	2,  // 19: resources.centrum.dispatches.DispatchReference.reference_type:type_name -> resources.centrum.dispatches.DispatchReferenceType
+	3,  // 20: resources.centrum.dispatches.DispatchAttributes.list:type_name -> resources.centrum.dispatches.DispatchAttribute
+	12, // 21: resources.centrum.dispatches.DispatchRequirements.unit_skills:type_name -> resources.centrum.dispatches.DispatchUnitRequirement
+	14, // 22: resources.centrum.dispatches.DispatchRequirementsState.unit_skills:type_name -> resources.centrum.dispatches.DispatchUnitRequirementState
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_resources_centrum_dispatches_dispatches_proto_init() }
@@ -1696,13 +2154,14 @@ func file_resources_centrum_dispatches_dispatches_proto_init() {
 	file_resources_centrum_dispatches_dispatches_proto_msgTypes[0].OneofWrappers = []any{}
 	file_resources_centrum_dispatches_dispatches_proto_msgTypes[2].OneofWrappers = []any{}
 	file_resources_centrum_dispatches_dispatches_proto_msgTypes[3].OneofWrappers = []any{}
+	file_resources_centrum_dispatches_dispatches_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_centrum_dispatches_dispatches_proto_rawDesc), len(file_resources_centrum_dispatches_dispatches_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
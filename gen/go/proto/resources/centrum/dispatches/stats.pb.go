@@ -0,0 +1,449 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/centrum/dispatches/stats.proto
+
+//go:build !protoopaque
+
+package centrumdispatches
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DispatchStatsDimension int32
+
+const (
+	DispatchStatsDimension_DISPATCH_STATS_DIMENSION_UNSPECIFIED DispatchStatsDimension = 0
+	DispatchStatsDimension_DISPATCH_STATS_DIMENSION_JOB         DispatchStatsDimension = 1
+	DispatchStatsDimension_DISPATCH_STATS_DIMENSION_UNIT        DispatchStatsDimension = 2
+	DispatchStatsDimension_DISPATCH_STATS_DIMENSION_POSTAL      DispatchStatsDimension = 3
+)
+
+// Enum value maps for DispatchStatsDimension.
+var (
+	DispatchStatsDimension_name = map[int32]string{
+		0: "DISPATCH_STATS_DIMENSION_UNSPECIFIED",
+		1: "DISPATCH_STATS_DIMENSION_JOB",
+		2: "DISPATCH_STATS_DIMENSION_UNIT",
+		3: "DISPATCH_STATS_DIMENSION_POSTAL",
+	}
+	DispatchStatsDimension_value = map[string]int32{
+		"DISPATCH_STATS_DIMENSION_UNSPECIFIED": 0,
+		"DISPATCH_STATS_DIMENSION_JOB":         1,
+		"DISPATCH_STATS_DIMENSION_UNIT":        2,
+		"DISPATCH_STATS_DIMENSION_POSTAL":      3,
+	}
+)
+
+func (x DispatchStatsDimension) Enum() *DispatchStatsDimension {
+	p := new(DispatchStatsDimension)
+	*p = x
+	return p
+}
+
+func (x DispatchStatsDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DispatchStatsDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_centrum_dispatches_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (DispatchStatsDimension) Type() protoreflect.EnumType {
+	return &file_resources_centrum_dispatches_stats_proto_enumTypes[0]
+}
+
+func (x DispatchStatsDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type DispatchStats struct {
+	state     protoimpl.MessageState `protogen:"hybrid.v1"`
+	Dimension DispatchStatsDimension `protobuf:"varint,1,opt,name=dimension,proto3,enum=resources.centrum.dispatches.DispatchStatsDimension" json:"dimension,omitempty"`
+	// Unit ID or postal code, empty for the job dimension
+	Key        string  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label      *string `protobuf:"bytes,3,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Dispatches int64   `protobuf:"varint,4,opt,name=dispatches,proto3" json:"dispatches,omitempty"`
+	// Dispatch creation to first unit assignment
+	TimeToAssign *DispatchDurationStat `protobuf:"bytes,5,opt,name=time_to_assign,json=timeToAssign,proto3" json:"time_to_assign,omitempty"`
+	// Unit assignment to unit en route
+	TimeToEnRoute *DispatchDurationStat `protobuf:"bytes,6,opt,name=time_to_en_route,json=timeToEnRoute,proto3" json:"time_to_en_route,omitempty"`
+	// Unit on scene to dispatch completion
+	TimeOnScene *DispatchDurationStat `protobuf:"bytes,7,opt,name=time_on_scene,json=timeOnScene,proto3" json:"time_on_scene,omitempty"`
+	// Dispatch creation to dispatch completion
+	HandlingTime  *DispatchDurationStat `protobuf:"bytes,8,opt,name=handling_time,json=handlingTime,proto3" json:"handling_time,omitempty"`
+	SlaBreaches   int64                 `protobuf:"varint,9,opt,name=sla_breaches,json=slaBreaches,proto3" json:"sla_breaches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DispatchStats) Reset() {
+	*x = DispatchStats{}
+	mi := &file_resources_centrum_dispatches_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchStats) ProtoMessage() {}
+
+func (x *DispatchStats) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchStats) GetDimension() DispatchStatsDimension {
+	if x != nil {
+		return x.Dimension
+	}
+	return DispatchStatsDimension_DISPATCH_STATS_DIMENSION_UNSPECIFIED
+}
+
+func (x *DispatchStats) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DispatchStats) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *DispatchStats) GetDispatches() int64 {
+	if x != nil {
+		return x.Dispatches
+	}
+	return 0
+}
+
+func (x *DispatchStats) GetTimeToAssign() *DispatchDurationStat {
+	if x != nil {
+		return x.TimeToAssign
+	}
+	return nil
+}
+
+func (x *DispatchStats) GetTimeToEnRoute() *DispatchDurationStat {
+	if x != nil {
+		return x.TimeToEnRoute
+	}
+	return nil
+}
+
+func (x *DispatchStats) GetTimeOnScene() *DispatchDurationStat {
+	if x != nil {
+		return x.TimeOnScene
+	}
+	return nil
+}
+
+func (x *DispatchStats) GetHandlingTime() *DispatchDurationStat {
+	if x != nil {
+		return x.HandlingTime
+	}
+	return nil
+}
+
+func (x *DispatchStats) GetSlaBreaches() int64 {
+	if x != nil {
+		return x.SlaBreaches
+	}
+	return 0
+}
+
+func (x *DispatchStats) SetDimension(v DispatchStatsDimension) {
+	x.Dimension = v
+}
+
+func (x *DispatchStats) SetKey(v string) {
+	x.Key = v
+}
+
+func (x *DispatchStats) SetLabel(v string) {
+	x.Label = &v
+}
+
+func (x *DispatchStats) SetDispatches(v int64) {
+	x.Dispatches = v
+}
+
+func (x *DispatchStats) SetTimeToAssign(v *DispatchDurationStat) {
+	x.TimeToAssign = v
+}
+
+func (x *DispatchStats) SetTimeToEnRoute(v *DispatchDurationStat) {
+	x.TimeToEnRoute = v
+}
+
+func (x *DispatchStats) SetTimeOnScene(v *DispatchDurationStat) {
+	x.TimeOnScene = v
+}
+
+func (x *DispatchStats) SetHandlingTime(v *DispatchDurationStat) {
+	x.HandlingTime = v
+}
+
+func (x *DispatchStats) SetSlaBreaches(v int64) {
+	x.SlaBreaches = v
+}
+
+func (x *DispatchStats) HasLabel() bool {
+	if x == nil {
+		return false
+	}
+	return x.Label != nil
+}
+
+func (x *DispatchStats) HasTimeToAssign() bool {
+	if x == nil {
+		return false
+	}
+	return x.TimeToAssign != nil
+}
+
+func (x *DispatchStats) HasTimeToEnRoute() bool {
+	if x == nil {
+		return false
+	}
+	return x.TimeToEnRoute != nil
+}
+
+func (x *DispatchStats) HasTimeOnScene() bool {
+	if x == nil {
+		return false
+	}
+	return x.TimeOnScene != nil
+}
+
+func (x *DispatchStats) HasHandlingTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.HandlingTime != nil
+}
+
+func (x *DispatchStats) ClearLabel() {
+	x.Label = nil
+}
+
+func (x *DispatchStats) ClearTimeToAssign() {
+	x.TimeToAssign = nil
+}
+
+func (x *DispatchStats) ClearTimeToEnRoute() {
+	x.TimeToEnRoute = nil
+}
+
+func (x *DispatchStats) ClearTimeOnScene() {
+	x.TimeOnScene = nil
+}
+
+func (x *DispatchStats) ClearHandlingTime() {
+	x.HandlingTime = nil
+}
+
+type DispatchStats_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Dimension DispatchStatsDimension
+	// Unit ID or postal code, empty for the job dimension
+	Key        string
+	Label      *string
+	Dispatches int64
+	// Dispatch creation to first unit assignment
+	TimeToAssign *DispatchDurationStat
+	// Unit assignment to unit en route
+	TimeToEnRoute *DispatchDurationStat
+	// Unit on scene to dispatch completion
+	TimeOnScene *DispatchDurationStat
+	// Dispatch creation to dispatch completion
+	HandlingTime *DispatchDurationStat
+	SlaBreaches  int64
+}
+
+func (b0 DispatchStats_builder) Build() *DispatchStats {
+	m0 := &DispatchStats{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Dimension = b.Dimension
+	x.Key = b.Key
+	x.Label = b.Label
+	x.Dispatches = b.Dispatches
+	x.TimeToAssign = b.TimeToAssign
+	x.TimeToEnRoute = b.TimeToEnRoute
+	x.TimeOnScene = b.TimeOnScene
+	x.HandlingTime = b.HandlingTime
+	x.SlaBreaches = b.SlaBreaches
+	return m0
+}
+
+type DispatchDurationStat struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	AvgSeconds    float64                `protobuf:"fixed64,2,opt,name=avg_seconds,json=avgSeconds,proto3" json:"avg_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DispatchDurationStat) Reset() {
+	*x = DispatchDurationStat{}
+	mi := &file_resources_centrum_dispatches_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchDurationStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchDurationStat) ProtoMessage() {}
+
+func (x *DispatchDurationStat) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchDurationStat) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DispatchDurationStat) GetAvgSeconds() float64 {
+	if x != nil {
+		return x.AvgSeconds
+	}
+	return 0
+}
+
+func (x *DispatchDurationStat) SetCount(v int64) {
+	x.Count = v
+}
+
+func (x *DispatchDurationStat) SetAvgSeconds(v float64) {
+	x.AvgSeconds = v
+}
+
+type DispatchDurationStat_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Count      int64
+	AvgSeconds float64
+}
+
+func (b0 DispatchDurationStat_builder) Build() *DispatchDurationStat {
+	m0 := &DispatchDurationStat{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Count = b.Count
+	x.AvgSeconds = b.AvgSeconds
+	return m0
+}
+
+var File_resources_centrum_dispatches_stats_proto protoreflect.FileDescriptor
+
+const file_resources_centrum_dispatches_stats_proto_rawDesc = "" +
+	"\n" +
+	"(resources/centrum/dispatches/stats.proto\x12\x1cresources.centrum.dispatches\"\xc5\x04\n" +
+	"\rDispatchStats\x12R\n" +
+	"\tdimension\x18\x01 \x01(\x0e24.resources.centrum.dispatches.DispatchStatsDimensionR\tdimension\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x19\n" +
+	"\x05label\x18\x03 \x01(\tH\x00R\x05label\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"dispatches\x18\x04 \x01(\x03R\n" +
+	"dispatches\x12X\n" +
+	"\x0etime_to_assign\x18\x05 \x01(\v22.resources.centrum.dispatches.DispatchDurationStatR\ftimeToAssign\x12[\n" +
+	"\x10time_to_en_route\x18\x06 \x01(\v22.resources.centrum.dispatches.DispatchDurationStatR\rtimeToEnRoute\x12V\n" +
+	"\rtime_on_scene\x18\a \x01(\v22.resources.centrum.dispatches.DispatchDurationStatR\vtimeOnScene\x12W\n" +
+	"\rhandling_time\x18\b \x01(\v22.resources.centrum.dispatches.DispatchDurationStatR\fhandlingTime\x12!\n" +
+	"\fsla_breaches\x18\t \x01(\x03R\vslaBreachesB\b\n" +
+	"\x06_label\"M\n" +
+	"\x14DispatchDurationStat\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vavg_seconds\x18\x02 \x01(\x01R\n" +
+	"avgSeconds*\xac\x01\n" +
+	"\x16DispatchStatsDimension\x12(\n" +
+	"$DISPATCH_STATS_DIMENSION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDISPATCH_STATS_DIMENSION_JOB\x10\x01\x12!\n" +
+	"\x1dDISPATCH_STATS_DIMENSION_UNIT\x10\x02\x12#\n" +
+	"\x1fDISPATCH_STATS_DIMENSION_POSTAL\x10\x03BbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches;centrumdispatchesb\x06proto3"
+
+var file_resources_centrum_dispatches_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_centrum_dispatches_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_resources_centrum_dispatches_stats_proto_goTypes = []any{
+	(DispatchStatsDimension)(0),  // 0: resources.centrum.dispatches.DispatchStatsDimension
+	(*DispatchStats)(nil),        // 1: resources.centrum.dispatches.DispatchStats
+	(*DispatchDurationStat)(nil), // 2: resources.centrum.dispatches.DispatchDurationStat
+}
+var file_resources_centrum_dispatches_stats_proto_depIdxs = []int32{
+	0, // 0: resources.centrum.dispatches.DispatchStats.dimension:type_name -> resources.centrum.dispatches.DispatchStatsDimension
+	2, // 1: resources.centrum.dispatches.DispatchStats.time_to_assign:type_name -> resources.centrum.dispatches.DispatchDurationStat
+	2, // 2: resources.centrum.dispatches.DispatchStats.time_to_en_route:type_name -> resources.centrum.dispatches.DispatchDurationStat
+	2, // 3: resources.centrum.dispatches.DispatchStats.time_on_scene:type_name -> resources.centrum.dispatches.DispatchDurationStat
+	2, // 4: resources.centrum.dispatches.DispatchStats.handling_time:type_name -> resources.centrum.dispatches.DispatchDurationStat
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_resources_centrum_dispatches_stats_proto_init() }
+func file_resources_centrum_dispatches_stats_proto_init() {
+	if File_resources_centrum_dispatches_stats_proto != nil {
+		return
+	}
+	file_resources_centrum_dispatches_stats_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_centrum_dispatches_stats_proto_rawDesc), len(file_resources_centrum_dispatches_stats_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_centrum_dispatches_stats_proto_goTypes,
+		DependencyIndexes: file_resources_centrum_dispatches_stats_proto_depIdxs,
+		EnumInfos:         file_resources_centrum_dispatches_stats_proto_enumTypes,
+		MessageInfos:      file_resources_centrum_dispatches_stats_proto_msgTypes,
+	}.Build()
+	File_resources_centrum_dispatches_stats_proto = out.File
+	file_resources_centrum_dispatches_stats_proto_goTypes = nil
+	file_resources_centrum_dispatches_stats_proto_depIdxs = nil
+}
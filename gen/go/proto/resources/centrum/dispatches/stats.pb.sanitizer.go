@@ -0,0 +1,62 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/centrum/dispatches/stats.proto
+
+package centrumdispatches
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchStats) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: HandlingTime
+	if m.HandlingTime != nil {
+		if v, ok := any(m.GetHandlingTime()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Key
+	m.Key = htmlsanitizer.SanitizeAndUnescape(m.Key)
+
+	// Field: Label
+	if m.Label != nil {
+		*m.Label = htmlsanitizer.SanitizeAndUnescape(*m.Label)
+	}
+
+	// Field: TimeOnScene
+	if m.TimeOnScene != nil {
+		if v, ok := any(m.GetTimeOnScene()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: TimeToAssign
+	if m.TimeToAssign != nil {
+		if v, ok := any(m.GetTimeToAssign()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: TimeToEnRoute
+	if m.TimeToEnRoute != nil {
+		if v, ok := any(m.GetTimeToEnRoute()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/centrum/dispatches/templates.proto
+
+//go:build !protoopaque
+
+package centrumdispatches
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
+	centrum "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Job-scoped template for recurring call types, dispatches can be quick-created by the template's code.
+type DispatchTemplate struct {
+	state     protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" alias:"id" sql:"primary_key"`
+	CreatedAt *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	Job       string                 `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`
+	// Unique (per job) code, e.g., "10-50"
+	Code         string                `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Message      string                `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Description  *string               `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Attributes   *DispatchAttributes   `protobuf:"bytes,8,opt,name=attributes,proto3,oneof" json:"attributes,omitempty"`
+	Requirements *DispatchRequirements `protobuf:"bytes,9,opt,name=requirements,proto3,oneof" json:"requirements,omitempty"`
+	// Target jobs of the dispatch, if empty the template's job is used
+	Jobs          *centrum.JobList `protobuf:"bytes,10,opt,name=jobs,proto3,oneof" json:"jobs,omitempty"`
+	CreatorId     *int32           `protobuf:"varint,11,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DispatchTemplate) Reset() {
+	*x = DispatchTemplate{}
+	mi := &file_resources_centrum_dispatches_templates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchTemplate) ProtoMessage() {}
+
+func (x *DispatchTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_templates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DispatchTemplate) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DispatchTemplate) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *DispatchTemplate) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *DispatchTemplate) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DispatchTemplate) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DispatchTemplate) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *DispatchTemplate) GetAttributes() *DispatchAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *DispatchTemplate) GetRequirements() *DispatchRequirements {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *DispatchTemplate) GetJobs() *centrum.JobList {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *DispatchTemplate) GetCreatorId() int32 {
+	if x != nil && x.CreatorId != nil {
+		return *x.CreatorId
+	}
+	return 0
+}
+
+func (x *DispatchTemplate) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *DispatchTemplate) SetCreatedAt(v *timestamp.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *DispatchTemplate) SetUpdatedAt(v *timestamp.Timestamp) {
+	x.UpdatedAt = v
+}
+
+func (x *DispatchTemplate) SetJob(v string) {
+	x.Job = v
+}
+
+func (x *DispatchTemplate) SetCode(v string) {
+	x.Code = v
+}
+
+func (x *DispatchTemplate) SetMessage(v string) {
+	x.Message = v
+}
+
+func (x *DispatchTemplate) SetDescription(v string) {
+	x.Description = &v
+}
+
+func (x *DispatchTemplate) SetAttributes(v *DispatchAttributes) {
+	x.Attributes = v
+}
+
+func (x *DispatchTemplate) SetRequirements(v *DispatchRequirements) {
+	x.Requirements = v
+}
+
+func (x *DispatchTemplate) SetJobs(v *centrum.JobList) {
+	x.Jobs = v
+}
+
+func (x *DispatchTemplate) SetCreatorId(v int32) {
+	x.CreatorId = &v
+}
+
+func (x *DispatchTemplate) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *DispatchTemplate) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.UpdatedAt != nil
+}
+
+func (x *DispatchTemplate) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return x.Description != nil
+}
+
+func (x *DispatchTemplate) HasAttributes() bool {
+	if x == nil {
+		return false
+	}
+	return x.Attributes != nil
+}
+
+func (x *DispatchTemplate) HasRequirements() bool {
+	if x == nil {
+		return false
+	}
+	return x.Requirements != nil
+}
+
+func (x *DispatchTemplate) HasJobs() bool {
+	if x == nil {
+		return false
+	}
+	return x.Jobs != nil
+}
+
+func (x *DispatchTemplate) HasCreatorId() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatorId != nil
+}
+
+func (x *DispatchTemplate) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *DispatchTemplate) ClearUpdatedAt() {
+	x.UpdatedAt = nil
+}
+
+func (x *DispatchTemplate) ClearDescription() {
+	x.Description = nil
+}
+
+func (x *DispatchTemplate) ClearAttributes() {
+	x.Attributes = nil
+}
+
+func (x *DispatchTemplate) ClearRequirements() {
+	x.Requirements = nil
+}
+
+func (x *DispatchTemplate) ClearJobs() {
+	x.Jobs = nil
+}
+
+func (x *DispatchTemplate) ClearCreatorId() {
+	x.CreatorId = nil
+}
+
+type DispatchTemplate_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        int64
+	CreatedAt *timestamp.Timestamp
+	UpdatedAt *timestamp.Timestamp
+	Job       string
+	// Unique (per job) code, e.g., "10-50"
+	Code         string
+	Message      string
+	Description  *string
+	Attributes   *DispatchAttributes
+	Requirements *DispatchRequirements
+	// Target jobs of the dispatch, if empty the template's job is used
+	Jobs      *centrum.JobList
+	CreatorId *int32
+}
+
+func (b0 DispatchTemplate_builder) Build() *DispatchTemplate {
+	m0 := &DispatchTemplate{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.CreatedAt = b.CreatedAt
+	x.UpdatedAt = b.UpdatedAt
+	x.Job = b.Job
+	x.Code = b.Code
+	x.Message = b.Message
+	x.Description = b.Description
+	x.Attributes = b.Attributes
+	x.Requirements = b.Requirements
+	x.Jobs = b.Jobs
+	x.CreatorId = b.CreatorId
+	return m0
+}
+
+var File_resources_centrum_dispatches_templates_proto protoreflect.FileDescriptor
+
+const file_resources_centrum_dispatches_templates_proto_rawDesc = "" +
+	"\n" +
+	",resources/centrum/dispatches/templates.proto\x12\x1cresources.centrum.dispatches\x1a!codegen/sanitizer/sanitizer.proto\x1a-resources/centrum/dispatches/dispatches.proto\x1a\x1fresources/centrum/joblist.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xc1\x05\n" +
+	"\x10DispatchTemplate\x121\n" +
+	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tupdatedAt\x88\x01\x01\x12\x10\n" +
+	"\x03job\x18\x04 \x01(\tR\x03job\x12\x1c\n" +
+	"\x04code\x18\x05 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x04code\x12 \n" +
+	"\amessage\x18\x06 \x01(\tB\x06\xda\xf3\x18\x02\b\x01R\amessage\x12-\n" +
+	"\vdescription\x18\a \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x02R\vdescription\x88\x01\x01\x12U\n" +
+	"\n" +
+	"attributes\x18\b \x01(\v20.resources.centrum.dispatches.DispatchAttributesH\x03R\n" +
+	"attributes\x88\x01\x01\x12[\n" +
+	"\frequirements\x18\t \x01(\v22.resources.centrum.dispatches.DispatchRequirementsH\x04R\frequirements\x88\x01\x01\x123\n" +
+	"\x04jobs\x18\n" +
+	" \x01(\v2\x1a.resources.centrum.JobListH\x05R\x04jobs\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"creator_id\x18\v \x01(\x05H\x06R\tcreatorId\x88\x01\x01B\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_attributesB\x0f\n" +
+	"\r_requirementsB\a\n" +
+	"\x05_jobsB\r\n" +
+	"\v_creator_idBbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches;centrumdispatchesb\x06proto3"
+
+var file_resources_centrum_dispatches_templates_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resources_centrum_dispatches_templates_proto_goTypes = []any{
+	(*DispatchTemplate)(nil),     // 0: resources.centrum.dispatches.DispatchTemplate
+	(*timestamp.Timestamp)(nil),  // 1: resources.timestamp.Timestamp
+	(*DispatchAttributes)(nil),   // 2: resources.centrum.dispatches.DispatchAttributes
+	(*DispatchRequirements)(nil), // 3: resources.centrum.dispatches.DispatchRequirements
+	(*centrum.JobList)(nil),      // 4: resources.centrum.JobList
+}
+var file_resources_centrum_dispatches_templates_proto_depIdxs = []int32{
+	1, // 0: resources.centrum.dispatches.DispatchTemplate.created_at:type_name -> resources.timestamp.Timestamp
+	1, // 1: resources.centrum.dispatches.DispatchTemplate.updated_at:type_name -> resources.timestamp.Timestamp
+	2, // 2: resources.centrum.dispatches.DispatchTemplate.attributes:type_name -> resources.centrum.dispatches.DispatchAttributes
+	3, // 3: resources.centrum.dispatches.DispatchTemplate.requirements:type_name -> resources.centrum.dispatches.DispatchRequirements
+	4, // 4: resources.centrum.dispatches.DispatchTemplate.jobs:type_name -> resources.centrum.JobList
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_resources_centrum_dispatches_templates_proto_init() }
+func file_resources_centrum_dispatches_templates_proto_init() {
+	if File_resources_centrum_dispatches_templates_proto != nil {
+		return
+	}
+	file_resources_centrum_dispatches_dispatches_proto_init()
+	file_resources_centrum_dispatches_templates_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_centrum_dispatches_templates_proto_rawDesc), len(file_resources_centrum_dispatches_templates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_centrum_dispatches_templates_proto_goTypes,
+		DependencyIndexes: file_resources_centrum_dispatches_templates_proto_depIdxs,
+		MessageInfos:      file_resources_centrum_dispatches_templates_proto_msgTypes,
+	}.Build()
+	File_resources_centrum_dispatches_templates_proto = out.File
+	file_resources_centrum_dispatches_templates_proto_goTypes = nil
+	file_resources_centrum_dispatches_templates_proto_depIdxs = nil
+}
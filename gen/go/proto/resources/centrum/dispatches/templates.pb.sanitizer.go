@@ -0,0 +1,77 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/centrum/dispatches/templates.proto
+
+package centrumdispatches
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchTemplate) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Attributes
+	if m.Attributes != nil {
+		if v, ok := any(m.GetAttributes()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Code
+	m.Code = htmlsanitizer.StripHTMLTags(m.Code)
+
+	// Field: CreatedAt
+	if m.CreatedAt != nil {
+		if v, ok := any(m.GetCreatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Description
+	if m.Description != nil {
+		*m.Description = htmlsanitizer.SanitizeAndUnescape(*m.Description)
+	}
+
+	// Field: Job
+	m.Job = htmlsanitizer.SanitizeAndUnescape(m.Job)
+
+	// Field: Jobs
+	if m.Jobs != nil {
+		if v, ok := any(m.GetJobs()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Message
+	m.Message = htmlsanitizer.SanitizeAndUnescape(m.Message)
+
+	// Field: Requirements
+	if m.Requirements != nil {
+		if v, ok := any(m.GetRequirements()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: UpdatedAt
+	if m.UpdatedAt != nil {
+		if v, ok := any(m.GetUpdatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
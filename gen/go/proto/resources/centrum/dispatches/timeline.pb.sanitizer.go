@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/centrum/dispatches/timeline.proto
+
+package centrumdispatches
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchTimeline) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Dispatch
+	if m.Dispatch != nil {
+		if v, ok := any(m.GetDispatch()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: End
+	if m.End != nil {
+		if v, ok := any(m.GetEnd()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Entries
+	for idx, item := range m.Entries {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: Start
+	if m.Start != nil {
+		if v, ok := any(m.GetStart()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Units
+	for idx, item := range m.Units {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DispatchTimelineEntry) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: DispatchStatus
+	switch v := m.Data.(type) {
+
+	case *DispatchTimelineEntry_DispatchStatus:
+
+		if v.DispatchStatus != nil {
+			if s, ok := any(v.DispatchStatus).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field: Marker
+	case *DispatchTimelineEntry_Marker:
+
+		if v.Marker != nil {
+			if s, ok := any(v.Marker).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+	}
+
+	// Field: Time
+	if m.Time != nil {
+		if v, ok := any(m.GetTime()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: UnitPosition
+	switch v := m.Data.(type) {
+
+	case *DispatchTimelineEntry_UnitPosition:
+
+		if v.UnitPosition != nil {
+			if s, ok := any(v.UnitPosition).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field: UnitStatus
+	case *DispatchTimelineEntry_UnitStatus:
+
+		if v.UnitStatus != nil {
+			if s, ok := any(v.UnitStatus).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
@@ -0,0 +1,686 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/centrum/dispatches/timeline.proto
+
+//go:build protoopaque
+
+package centrumdispatches
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/dbscanner"
+	units "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	markers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Ordered timeline of everything that happened during a dispatch's incident window, used for after-action reviews.
+type DispatchTimeline struct {
+	state                protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Dispatch  *Dispatch                 `protobuf:"bytes,1,opt,name=dispatch,proto3"`
+	xxx_hidden_Start     *timestamp.Timestamp      `protobuf:"bytes,2,opt,name=start,proto3"`
+	xxx_hidden_End       *timestamp.Timestamp      `protobuf:"bytes,3,opt,name=end,proto3"`
+	xxx_hidden_Units     *[]*units.Unit            `protobuf:"bytes,4,rep,name=units,proto3"`
+	xxx_hidden_Entries   *[]*DispatchTimelineEntry `protobuf:"bytes,5,rep,name=entries,proto3"`
+	xxx_hidden_Truncated bool                      `protobuf:"varint,6,opt,name=truncated,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DispatchTimeline) Reset() {
+	*x = DispatchTimeline{}
+	mi := &file_resources_centrum_dispatches_timeline_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchTimeline) ProtoMessage() {}
+
+func (x *DispatchTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_timeline_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchTimeline) GetDispatch() *Dispatch {
+	if x != nil {
+		return x.xxx_hidden_Dispatch
+	}
+	return nil
+}
+
+func (x *DispatchTimeline) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return nil
+}
+
+func (x *DispatchTimeline) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_End
+	}
+	return nil
+}
+
+func (x *DispatchTimeline) GetUnits() []*units.Unit {
+	if x != nil {
+		if x.xxx_hidden_Units != nil {
+			return *x.xxx_hidden_Units
+		}
+	}
+	return nil
+}
+
+func (x *DispatchTimeline) GetEntries() []*DispatchTimelineEntry {
+	if x != nil {
+		if x.xxx_hidden_Entries != nil {
+			return *x.xxx_hidden_Entries
+		}
+	}
+	return nil
+}
+
+func (x *DispatchTimeline) GetTruncated() bool {
+	if x != nil {
+		return x.xxx_hidden_Truncated
+	}
+	return false
+}
+
+func (x *DispatchTimeline) SetDispatch(v *Dispatch) {
+	x.xxx_hidden_Dispatch = v
+}
+
+func (x *DispatchTimeline) SetStart(v *timestamp.Timestamp) {
+	x.xxx_hidden_Start = v
+}
+
+func (x *DispatchTimeline) SetEnd(v *timestamp.Timestamp) {
+	x.xxx_hidden_End = v
+}
+
+func (x *DispatchTimeline) SetUnits(v []*units.Unit) {
+	x.xxx_hidden_Units = &v
+}
+
+func (x *DispatchTimeline) SetEntries(v []*DispatchTimelineEntry) {
+	x.xxx_hidden_Entries = &v
+}
+
+func (x *DispatchTimeline) SetTruncated(v bool) {
+	x.xxx_hidden_Truncated = v
+}
+
+func (x *DispatchTimeline) HasDispatch() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Dispatch != nil
+}
+
+func (x *DispatchTimeline) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Start != nil
+}
+
+func (x *DispatchTimeline) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_End != nil
+}
+
+func (x *DispatchTimeline) ClearDispatch() {
+	x.xxx_hidden_Dispatch = nil
+}
+
+func (x *DispatchTimeline) ClearStart() {
+	x.xxx_hidden_Start = nil
+}
+
+func (x *DispatchTimeline) ClearEnd() {
+	x.xxx_hidden_End = nil
+}
+
+type DispatchTimeline_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Dispatch *Dispatch
+	Start    *timestamp.Timestamp
+	End      *timestamp.Timestamp
+	// Units that have been assigned to the dispatch during the incident window
+	Units   []*units.Unit
+	Entries []*DispatchTimelineEntry
+	// Entries have been cut off because the timeline exceeded the max. entry count
+	Truncated bool
+}
+
+func (b0 DispatchTimeline_builder) Build() *DispatchTimeline {
+	m0 := &DispatchTimeline{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Dispatch = b.Dispatch
+	x.xxx_hidden_Start = b.Start
+	x.xxx_hidden_End = b.End
+	x.xxx_hidden_Units = &b.Units
+	x.xxx_hidden_Entries = &b.Entries
+	x.xxx_hidden_Truncated = b.Truncated
+	return m0
+}
+
+type DispatchTimelineEntry struct {
+	state           protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Time *timestamp.Timestamp         `protobuf:"bytes,1,opt,name=time,proto3"`
+	xxx_hidden_Data isDispatchTimelineEntry_Data `protobuf_oneof:"data"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DispatchTimelineEntry) Reset() {
+	*x = DispatchTimelineEntry{}
+	mi := &file_resources_centrum_dispatches_timeline_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchTimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchTimelineEntry) ProtoMessage() {}
+
+func (x *DispatchTimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_timeline_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchTimelineEntry) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *DispatchTimelineEntry) GetDispatchStatus() *DispatchStatus {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_DispatchStatus); ok {
+			return x.DispatchStatus
+		}
+	}
+	return nil
+}
+
+func (x *DispatchTimelineEntry) GetUnitStatus() *units.UnitStatus {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_UnitStatus); ok {
+			return x.UnitStatus
+		}
+	}
+	return nil
+}
+
+func (x *DispatchTimelineEntry) GetUnitPosition() *DispatchTimelinePosition {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_UnitPosition); ok {
+			return x.UnitPosition
+		}
+	}
+	return nil
+}
+
+func (x *DispatchTimelineEntry) GetMarker() *markers.MarkerMarker {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_Marker); ok {
+			return x.Marker
+		}
+	}
+	return nil
+}
+
+func (x *DispatchTimelineEntry) SetTime(v *timestamp.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *DispatchTimelineEntry) SetDispatchStatus(v *DispatchStatus) {
+	if v == nil {
+		x.xxx_hidden_Data = nil
+		return
+	}
+	x.xxx_hidden_Data = &dispatchTimelineEntry_DispatchStatus{v}
+}
+
+func (x *DispatchTimelineEntry) SetUnitStatus(v *units.UnitStatus) {
+	if v == nil {
+		x.xxx_hidden_Data = nil
+		return
+	}
+	x.xxx_hidden_Data = &dispatchTimelineEntry_UnitStatus{v}
+}
+
+func (x *DispatchTimelineEntry) SetUnitPosition(v *DispatchTimelinePosition) {
+	if v == nil {
+		x.xxx_hidden_Data = nil
+		return
+	}
+	x.xxx_hidden_Data = &dispatchTimelineEntry_UnitPosition{v}
+}
+
+func (x *DispatchTimelineEntry) SetMarker(v *markers.MarkerMarker) {
+	if v == nil {
+		x.xxx_hidden_Data = nil
+		return
+	}
+	x.xxx_hidden_Data = &dispatchTimelineEntry_Marker{v}
+}
+
+func (x *DispatchTimelineEntry) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *DispatchTimelineEntry) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Data != nil
+}
+
+func (x *DispatchTimelineEntry) HasDispatchStatus() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_DispatchStatus)
+	return ok
+}
+
+func (x *DispatchTimelineEntry) HasUnitStatus() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_UnitStatus)
+	return ok
+}
+
+func (x *DispatchTimelineEntry) HasUnitPosition() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_UnitPosition)
+	return ok
+}
+
+func (x *DispatchTimelineEntry) HasMarker() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_Marker)
+	return ok
+}
+
+func (x *DispatchTimelineEntry) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *DispatchTimelineEntry) ClearData() {
+	x.xxx_hidden_Data = nil
+}
+
+func (x *DispatchTimelineEntry) ClearDispatchStatus() {
+	if _, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_DispatchStatus); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
+func (x *DispatchTimelineEntry) ClearUnitStatus() {
+	if _, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_UnitStatus); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
+func (x *DispatchTimelineEntry) ClearUnitPosition() {
+	if _, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_UnitPosition); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
+func (x *DispatchTimelineEntry) ClearMarker() {
+	if _, ok := x.xxx_hidden_Data.(*dispatchTimelineEntry_Marker); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
+const DispatchTimelineEntry_Data_not_set_case case_DispatchTimelineEntry_Data = 0
+const DispatchTimelineEntry_DispatchStatus_case case_DispatchTimelineEntry_Data = 2
+const DispatchTimelineEntry_UnitStatus_case case_DispatchTimelineEntry_Data = 3
+const DispatchTimelineEntry_UnitPosition_case case_DispatchTimelineEntry_Data = 4
+const DispatchTimelineEntry_Marker_case case_DispatchTimelineEntry_Data = 5
+
+func (x *DispatchTimelineEntry) WhichData() case_DispatchTimelineEntry_Data {
+	if x == nil {
+		return DispatchTimelineEntry_Data_not_set_case
+	}
+	switch x.xxx_hidden_Data.(type) {
+	case *dispatchTimelineEntry_DispatchStatus:
+		return DispatchTimelineEntry_DispatchStatus_case
+	case *dispatchTimelineEntry_UnitStatus:
+		return DispatchTimelineEntry_UnitStatus_case
+	case *dispatchTimelineEntry_UnitPosition:
+		return DispatchTimelineEntry_UnitPosition_case
+	case *dispatchTimelineEntry_Marker:
+		return DispatchTimelineEntry_Marker_case
+	default:
+		return DispatchTimelineEntry_Data_not_set_case
+	}
+}
+
+type DispatchTimelineEntry_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Time *timestamp.Timestamp
+	// Fields of oneof xxx_hidden_Data:
+	DispatchStatus *DispatchStatus
+	UnitStatus     *units.UnitStatus
+	UnitPosition   *DispatchTimelinePosition
+	Marker         *markers.MarkerMarker
+	// -- end of xxx_hidden_Data
+}
+
+func (b0 DispatchTimelineEntry_builder) Build() *DispatchTimelineEntry {
+	m0 := &DispatchTimelineEntry{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.DispatchStatus != nil {
+		x.xxx_hidden_Data = &dispatchTimelineEntry_DispatchStatus{b.DispatchStatus}
+	}
+	if b.UnitStatus != nil {
+		x.xxx_hidden_Data = &dispatchTimelineEntry_UnitStatus{b.UnitStatus}
+	}
+	if b.UnitPosition != nil {
+		x.xxx_hidden_Data = &dispatchTimelineEntry_UnitPosition{b.UnitPosition}
+	}
+	if b.Marker != nil {
+		x.xxx_hidden_Data = &dispatchTimelineEntry_Marker{b.Marker}
+	}
+	return m0
+}
+
+type case_DispatchTimelineEntry_Data protoreflect.FieldNumber
+
+func (x case_DispatchTimelineEntry_Data) String() string {
+	md := file_resources_centrum_dispatches_timeline_proto_msgTypes[1].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isDispatchTimelineEntry_Data interface {
+	isDispatchTimelineEntry_Data()
+}
+
+type dispatchTimelineEntry_DispatchStatus struct {
+	DispatchStatus *DispatchStatus `protobuf:"bytes,2,opt,name=dispatch_status,json=dispatchStatus,proto3,oneof"`
+}
+
+type dispatchTimelineEntry_UnitStatus struct {
+	UnitStatus *units.UnitStatus `protobuf:"bytes,3,opt,name=unit_status,json=unitStatus,proto3,oneof"`
+}
+
+type dispatchTimelineEntry_UnitPosition struct {
+	UnitPosition *DispatchTimelinePosition `protobuf:"bytes,4,opt,name=unit_position,json=unitPosition,proto3,oneof"`
+}
+
+type dispatchTimelineEntry_Marker struct {
+	Marker *markers.MarkerMarker `protobuf:"bytes,5,opt,name=marker,proto3,oneof"`
+}
+
+func (*dispatchTimelineEntry_DispatchStatus) isDispatchTimelineEntry_Data() {}
+
+func (*dispatchTimelineEntry_UnitStatus) isDispatchTimelineEntry_Data() {}
+
+func (*dispatchTimelineEntry_UnitPosition) isDispatchTimelineEntry_Data() {}
+
+func (*dispatchTimelineEntry_Marker) isDispatchTimelineEntry_Data() {}
+
+// Position sample of an assigned unit (or one of its members).
+type DispatchTimelinePosition struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UnitId      int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId,proto3"`
+	xxx_hidden_UserId      int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof"`
+	xxx_hidden_X           float64                `protobuf:"fixed64,3,opt,name=x,proto3"`
+	xxx_hidden_Y           float64                `protobuf:"fixed64,4,opt,name=y,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DispatchTimelinePosition) Reset() {
+	*x = DispatchTimelinePosition{}
+	mi := &file_resources_centrum_dispatches_timeline_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchTimelinePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchTimelinePosition) ProtoMessage() {}
+
+func (x *DispatchTimelinePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_dispatches_timeline_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DispatchTimelinePosition) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *DispatchTimelinePosition) GetUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *DispatchTimelinePosition) GetX() float64 {
+	if x != nil {
+		return x.xxx_hidden_X
+	}
+	return 0
+}
+
+func (x *DispatchTimelinePosition) GetY() float64 {
+	if x != nil {
+		return x.xxx_hidden_Y
+	}
+	return 0
+}
+
+func (x *DispatchTimelinePosition) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+}
+
+func (x *DispatchTimelinePosition) SetUserId(v int32) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *DispatchTimelinePosition) SetX(v float64) {
+	x.xxx_hidden_X = v
+}
+
+func (x *DispatchTimelinePosition) SetY(v float64) {
+	x.xxx_hidden_Y = v
+}
+
+func (x *DispatchTimelinePosition) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DispatchTimelinePosition) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UserId = 0
+}
+
+type DispatchTimelinePosition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UnitId int64
+	UserId *int32
+	X      float64
+	Y      float64
+}
+
+func (b0 DispatchTimelinePosition_builder) Build() *DispatchTimelinePosition {
+	m0 := &DispatchTimelinePosition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UnitId = b.UnitId
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	x.xxx_hidden_X = b.X
+	x.xxx_hidden_Y = b.Y
+	return m0
+}
+
+var File_resources_centrum_dispatches_timeline_proto protoreflect.FileDescriptor
+
+const file_resources_centrum_dispatches_timeline_proto_rawDesc = "" +
+	"\n" +
+	"+resources/centrum/dispatches/timeline.proto\x12\x1cresources.centrum.dispatches\x1a!codegen/dbscanner/dbscanner.proto\x1a-resources/centrum/dispatches/dispatches.proto\x1a#resources/centrum/units/units.proto\x1a-resources/livemap/markers/marker_marker.proto\x1a#resources/timestamp/timestamp.proto\"\xe8\x02\n" +
+	"\x10DispatchTimeline\x12B\n" +
+	"\bdispatch\x18\x01 \x01(\v2&.resources.centrum.dispatches.DispatchR\bdispatch\x124\n" +
+	"\x05start\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampR\x05start\x120\n" +
+	"\x03end\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampR\x03end\x123\n" +
+	"\x05units\x18\x04 \x03(\v2\x1d.resources.centrum.units.UnitR\x05units\x12M\n" +
+	"\aentries\x18\x05 \x03(\v23.resources.centrum.dispatches.DispatchTimelineEntryR\aentries\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated:\x06\xe2\xf3\x18\x02\b\x01\"\x96\x03\n" +
+	"\x15DispatchTimelineEntry\x122\n" +
+	"\x04time\x18\x01 \x01(\v2\x1e.resources.timestamp.TimestampR\x04time\x12W\n" +
+	"\x0fdispatch_status\x18\x02 \x01(\v2,.resources.centrum.dispatches.DispatchStatusH\x00R\x0edispatchStatus\x12F\n" +
+	"\vunit_status\x18\x03 \x01(\v2#.resources.centrum.units.UnitStatusH\x00R\n" +
+	"unitStatus\x12]\n" +
+	"\runit_position\x18\x04 \x01(\v26.resources.centrum.dispatches.DispatchTimelinePositionH\x00R\funitPosition\x12A\n" +
+	"\x06marker\x18\x05 \x01(\v2'.resources.livemap.markers.MarkerMarkerH\x00R\x06markerB\x06\n" +
+	"\x04data\"y\n" +
+	"\x18DispatchTimelinePosition\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\x05H\x00R\x06userId\x88\x01\x01\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x01R\x01yB\n" +
+	"\n" +
+	"\b_user_idBbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches;centrumdispatchesb\x06proto3"
+
+var file_resources_centrum_dispatches_timeline_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_resources_centrum_dispatches_timeline_proto_goTypes = []any{
+	(*DispatchTimeline)(nil),         // 0: resources.centrum.dispatches.DispatchTimeline
+	(*DispatchTimelineEntry)(nil),    // 1: resources.centrum.dispatches.DispatchTimelineEntry
+	(*DispatchTimelinePosition)(nil), // 2: resources.centrum.dispatches.DispatchTimelinePosition
+	(*Dispatch)(nil),                 // 3: resources.centrum.dispatches.Dispatch
+	(*timestamp.Timestamp)(nil),      // 4: resources.timestamp.Timestamp
+	(*units.Unit)(nil),               // 5: resources.centrum.units.Unit
+	(*DispatchStatus)(nil),           // 6: resources.centrum.dispatches.DispatchStatus
+	(*units.UnitStatus)(nil),         // 7: resources.centrum.units.UnitStatus
+	(*markers.MarkerMarker)(nil),     // 8: resources.livemap.markers.MarkerMarker
+}
+var file_resources_centrum_dispatches_timeline_proto_depIdxs = []int32{
+	3,  // 0: resources.centrum.dispatches.DispatchTimeline.dispatch:type_name -> resources.centrum.dispatches.Dispatch
+	4,  // 1: resources.centrum.dispatches.DispatchTimeline.start:type_name -> resources.timestamp.Timestamp
+	4,  // 2: resources.centrum.dispatches.DispatchTimeline.end:type_name -> resources.timestamp.Timestamp
+	5,  // 3: resources.centrum.dispatches.DispatchTimeline.units:type_name -> resources.centrum.units.Unit
+	1,  // 4: resources.centrum.dispatches.DispatchTimeline.entries:type_name -> resources.centrum.dispatches.DispatchTimelineEntry
+	4,  // 5: resources.centrum.dispatches.DispatchTimelineEntry.time:type_name -> resources.timestamp.Timestamp
+	6,  // 6: resources.centrum.dispatches.DispatchTimelineEntry.dispatch_status:type_name -> resources.centrum.dispatches.DispatchStatus
+	7,  // 7: resources.centrum.dispatches.DispatchTimelineEntry.unit_status:type_name -> resources.centrum.units.UnitStatus
+	2,  // 8: resources.centrum.dispatches.DispatchTimelineEntry.unit_position:type_name -> resources.centrum.dispatches.DispatchTimelinePosition
+	8,  // 9: resources.centrum.dispatches.DispatchTimelineEntry.marker:type_name -> resources.livemap.markers.MarkerMarker
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_resources_centrum_dispatches_timeline_proto_init() }
+func file_resources_centrum_dispatches_timeline_proto_init() {
+	if File_resources_centrum_dispatches_timeline_proto != nil {
+		return
+	}
+	file_resources_centrum_dispatches_dispatches_proto_init()
+	file_resources_centrum_dispatches_timeline_proto_msgTypes[1].OneofWrappers = []any{
+		(*dispatchTimelineEntry_DispatchStatus)(nil),
+		(*dispatchTimelineEntry_UnitStatus)(nil),
+		(*dispatchTimelineEntry_UnitPosition)(nil),
+		(*dispatchTimelineEntry_Marker)(nil),
+	}
+	file_resources_centrum_dispatches_timeline_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_centrum_dispatches_timeline_proto_rawDesc), len(file_resources_centrum_dispatches_timeline_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_centrum_dispatches_timeline_proto_goTypes,
+		DependencyIndexes: file_resources_centrum_dispatches_timeline_proto_depIdxs,
+		MessageInfos:      file_resources_centrum_dispatches_timeline_proto_msgTypes,
+	}.Build()
+	File_resources_centrum_dispatches_timeline_proto = out.File
+	file_resources_centrum_dispatches_timeline_proto_goTypes = nil
+	file_resources_centrum_dispatches_timeline_proto_depIdxs = nil
+}
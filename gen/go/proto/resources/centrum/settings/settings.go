@@ -1,13 +1,20 @@
 package centrumsettings
 
 import (
+	"strconv"
 	"time"
 
 	centrumaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/access"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-const defaultDeduplicationDuration = 3 * time.Minute
+const (
+	defaultDeduplicationDuration = 3 * time.Minute
+
+	defaultAutoAssignDistanceWeight = 50
+	defaultAutoAssignLoadWeight     = 30
+	defaultAutoAssignSkillsWeight   = 20
+)
 
 func (x *Settings) Default(job string) {
 	x.Job = job
@@ -33,6 +40,9 @@ func (x *Settings) Default(job string) {
 	if x.GetTimings().GetRequireUnitReminderSeconds() <= 0 {
 		x.Timings.RequireUnitReminderSeconds = 180
 	}
+	if x.GetTimings().GetEscalation() != nil {
+		x.Timings.Escalation.Default()
+	}
 
 	if x.GetConfiguration() == nil {
 		x.Configuration = &Configuration{
@@ -43,6 +53,33 @@ func (x *Settings) Default(job string) {
 	} else if x.GetConfiguration().GetDeduplicationDuration() == nil {
 		x.Configuration.DeduplicationDuration = durationpb.New(defaultDeduplicationDuration)
 	}
+
+	if x.GetConfiguration().GetAutoAssign() == nil {
+		x.Configuration.AutoAssign = &AutoAssign{}
+	}
+	x.Configuration.AutoAssign.Default()
+}
+
+func (x *AutoAssign) Default() {
+	if x.GetStrategy() <= AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_UNSPECIFIED {
+		x.Strategy = AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_RANDOM
+	}
+
+	// Fallback to default weights when none are set
+	if x.GetDistanceWeight() <= 0 && x.GetLoadWeight() <= 0 && x.GetSkillsWeight() <= 0 {
+		x.DistanceWeight = defaultAutoAssignDistanceWeight
+		x.LoadWeight = defaultAutoAssignLoadWeight
+		x.SkillsWeight = defaultAutoAssignSkillsWeight
+	}
+}
+
+func (x *Escalation) Default() {
+	// Steps created before steps had IDs use their index, which is what their runs were tracked by
+	for idx, step := range x.GetSteps() {
+		if step.GetId() == "" {
+			step.Id = strconv.Itoa(idx)
+		}
+	}
 }
 
 func (x *Settings) Merge(in *Settings) *Settings {
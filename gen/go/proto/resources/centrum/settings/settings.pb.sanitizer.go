@@ -14,6 +14,15 @@ func (m *Configuration) Sanitize() error {
 		return nil
 	}
 
+	// Field: AutoAssign
+	if m.AutoAssign != nil {
+		if v, ok := any(m.GetAutoAssign()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: DeduplicationDuration
 	if m.DeduplicationDuration != nil {
 		if v, ok := any(m.GetDeduplicationDuration()).(interface{ Sanitize() error }); ok {
@@ -67,6 +76,46 @@ func (m *EffectiveDispatchAccess) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *Escalation) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Steps
+	for idx, item := range m.Steps {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *EscalationStep) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Id
+	m.Id = htmlsanitizer.StripHTMLTags(m.Id)
+
+	// Field: TargetJob
+	if m.TargetJob != nil {
+		*m.TargetJob = htmlsanitizer.SanitizeAndUnescape(*m.TargetJob)
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *JobAccessEntry) Sanitize() error {
@@ -177,3 +226,22 @@ func (m *Settings) Sanitize() error {
 
 	return nil
 }
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *Timings) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Escalation
+	if m.Escalation != nil {
+		if v, ok := any(m.GetEscalation()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -121,6 +121,100 @@ func (x CentrumMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+type EscalationAction int32
+
+const (
+	EscalationAction_ESCALATION_ACTION_UNSPECIFIED        EscalationAction = 0
+	EscalationAction_ESCALATION_ACTION_NOTIFY_DISPATCHERS EscalationAction = 1
+	EscalationAction_ESCALATION_ACTION_OFFER_TO_JOB       EscalationAction = 2
+	EscalationAction_ESCALATION_ACTION_MARK_BREACHED      EscalationAction = 3
+)
+
+// Enum value maps for EscalationAction.
+var (
+	EscalationAction_name = map[int32]string{
+		0: "ESCALATION_ACTION_UNSPECIFIED",
+		1: "ESCALATION_ACTION_NOTIFY_DISPATCHERS",
+		2: "ESCALATION_ACTION_OFFER_TO_JOB",
+		3: "ESCALATION_ACTION_MARK_BREACHED",
+	}
+	EscalationAction_value = map[string]int32{
+		"ESCALATION_ACTION_UNSPECIFIED":        0,
+		"ESCALATION_ACTION_NOTIFY_DISPATCHERS": 1,
+		"ESCALATION_ACTION_OFFER_TO_JOB":       2,
+		"ESCALATION_ACTION_MARK_BREACHED":      3,
+	}
+)
+
+func (x EscalationAction) Enum() *EscalationAction {
+	p := new(EscalationAction)
+	*p = x
+	return p
+}
+
+func (x EscalationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EscalationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_centrum_settings_settings_proto_enumTypes[2].Descriptor()
+}
+
+func (EscalationAction) Type() protoreflect.EnumType {
+	return &file_resources_centrum_settings_settings_proto_enumTypes[2]
+}
+
+func (x EscalationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type AutoAssignStrategy int32
+
+const (
+	AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_UNSPECIFIED AutoAssignStrategy = 0
+	AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_RANDOM      AutoAssignStrategy = 1
+	AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_NEAREST     AutoAssignStrategy = 2
+	AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_WEIGHTED    AutoAssignStrategy = 3
+)
+
+// Enum value maps for AutoAssignStrategy.
+var (
+	AutoAssignStrategy_name = map[int32]string{
+		0: "AUTO_ASSIGN_STRATEGY_UNSPECIFIED",
+		1: "AUTO_ASSIGN_STRATEGY_RANDOM",
+		2: "AUTO_ASSIGN_STRATEGY_NEAREST",
+		3: "AUTO_ASSIGN_STRATEGY_WEIGHTED",
+	}
+	AutoAssignStrategy_value = map[string]int32{
+		"AUTO_ASSIGN_STRATEGY_UNSPECIFIED": 0,
+		"AUTO_ASSIGN_STRATEGY_RANDOM":      1,
+		"AUTO_ASSIGN_STRATEGY_NEAREST":     2,
+		"AUTO_ASSIGN_STRATEGY_WEIGHTED":    3,
+	}
+)
+
+func (x AutoAssignStrategy) Enum() *AutoAssignStrategy {
+	p := new(AutoAssignStrategy)
+	*p = x
+	return p
+}
+
+func (x AutoAssignStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AutoAssignStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_centrum_settings_settings_proto_enumTypes[3].Descriptor()
+}
+
+func (AutoAssignStrategy) Type() protoreflect.EnumType {
+	return &file_resources_centrum_settings_settings_proto_enumTypes[3]
+}
+
+func (x AutoAssignStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type Settings struct {
 	state                       protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Job              string                 `protobuf:"bytes,1,opt,name=job,proto3"`
@@ -474,6 +568,7 @@ type Timings struct {
 	xxx_hidden_DispatchMaxWait            int64                  `protobuf:"varint,1,opt,name=dispatch_max_wait,json=dispatchMaxWait,proto3"`
 	xxx_hidden_RequireUnit                bool                   `protobuf:"varint,2,opt,name=require_unit,json=requireUnit,proto3"`
 	xxx_hidden_RequireUnitReminderSeconds int64                  `protobuf:"varint,3,opt,name=require_unit_reminder_seconds,json=requireUnitReminderSeconds,proto3"`
+	xxx_hidden_Escalation                 *Escalation            `protobuf:"bytes,4,opt,name=escalation,proto3,oneof"`
 	unknownFields                         protoimpl.UnknownFields
 	sizeCache                             protoimpl.SizeCache
 }
@@ -524,6 +619,13 @@ func (x *Timings) GetRequireUnitReminderSeconds() int64 {
 	return 0
 }
 
+func (x *Timings) GetEscalation() *Escalation {
+	if x != nil {
+		return x.xxx_hidden_Escalation
+	}
+	return nil
+}
+
 func (x *Timings) SetDispatchMaxWait(v int64) {
 	x.xxx_hidden_DispatchMaxWait = v
 }
@@ -536,12 +638,28 @@ func (x *Timings) SetRequireUnitReminderSeconds(v int64) {
 	x.xxx_hidden_RequireUnitReminderSeconds = v
 }
 
+func (x *Timings) SetEscalation(v *Escalation) {
+	x.xxx_hidden_Escalation = v
+}
+
+func (x *Timings) HasEscalation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Escalation != nil
+}
+
+func (x *Timings) ClearEscalation() {
+	x.xxx_hidden_Escalation = nil
+}
+
 type Timings_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	DispatchMaxWait            int64
 	RequireUnit                bool
 	RequireUnitReminderSeconds int64
+	Escalation                 *Escalation
 }
 
 func (b0 Timings_builder) Build() *Timings {
@@ -551,6 +669,203 @@ func (b0 Timings_builder) Build() *Timings {
 	x.xxx_hidden_DispatchMaxWait = b.DispatchMaxWait
 	x.xxx_hidden_RequireUnit = b.RequireUnit
 	x.xxx_hidden_RequireUnitReminderSeconds = b.RequireUnitReminderSeconds
+	x.xxx_hidden_Escalation = b.Escalation
+	return m0
+}
+
+type Escalation struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3"`
+	xxx_hidden_Steps   *[]*EscalationStep     `protobuf:"bytes,2,rep,name=steps,proto3"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Escalation) Reset() {
+	*x = Escalation{}
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Escalation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Escalation) ProtoMessage() {}
+
+func (x *Escalation) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Escalation) GetEnabled() bool {
+	if x != nil {
+		return x.xxx_hidden_Enabled
+	}
+	return false
+}
+
+func (x *Escalation) GetSteps() []*EscalationStep {
+	if x != nil {
+		if x.xxx_hidden_Steps != nil {
+			return *x.xxx_hidden_Steps
+		}
+	}
+	return nil
+}
+
+func (x *Escalation) SetEnabled(v bool) {
+	x.xxx_hidden_Enabled = v
+}
+
+func (x *Escalation) SetSteps(v []*EscalationStep) {
+	x.xxx_hidden_Steps = &v
+}
+
+type Escalation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Enabled bool
+	Steps   []*EscalationStep
+}
+
+func (b0 Escalation_builder) Build() *Escalation {
+	m0 := &Escalation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Enabled = b.Enabled
+	x.xxx_hidden_Steps = &b.Steps
+	return m0
+}
+
+type EscalationStep struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AfterSeconds int64                  `protobuf:"varint,1,opt,name=after_seconds,json=afterSeconds,proto3"`
+	xxx_hidden_Action       EscalationAction       `protobuf:"varint,2,opt,name=action,proto3,enum=resources.centrum.settings.EscalationAction"`
+	xxx_hidden_TargetJob    *string                `protobuf:"bytes,3,opt,name=target_job,json=targetJob,proto3,oneof"`
+	xxx_hidden_Id           string                 `protobuf:"bytes,4,opt,name=id,proto3"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EscalationStep) Reset() {
+	*x = EscalationStep{}
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationStep) ProtoMessage() {}
+
+func (x *EscalationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EscalationStep) GetAfterSeconds() int64 {
+	if x != nil {
+		return x.xxx_hidden_AfterSeconds
+	}
+	return 0
+}
+
+func (x *EscalationStep) GetAction() EscalationAction {
+	if x != nil {
+		return x.xxx_hidden_Action
+	}
+	return EscalationAction_ESCALATION_ACTION_UNSPECIFIED
+}
+
+func (x *EscalationStep) GetTargetJob() string {
+	if x != nil {
+		if x.xxx_hidden_TargetJob != nil {
+			return *x.xxx_hidden_TargetJob
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *EscalationStep) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *EscalationStep) SetAfterSeconds(v int64) {
+	x.xxx_hidden_AfterSeconds = v
+}
+
+func (x *EscalationStep) SetAction(v EscalationAction) {
+	x.xxx_hidden_Action = v
+}
+
+func (x *EscalationStep) SetTargetJob(v string) {
+	x.xxx_hidden_TargetJob = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *EscalationStep) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *EscalationStep) HasTargetJob() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *EscalationStep) ClearTargetJob() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_TargetJob = nil
+}
+
+type EscalationStep_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Seconds since the dispatch has been created while it is still unassigned
+	AfterSeconds int64
+	Action       EscalationAction
+	// Job the dispatch is offered to, must be one of the dispatch target jobs (only used by `ESCALATION_ACTION_OFFER_TO_JOB`)
+	TargetJob *string
+	// Stable ID of the step, used to track which steps have been run for a dispatch (set by the server)
+	Id string
+}
+
+func (b0 EscalationStep_builder) Build() *EscalationStep {
+	m0 := &EscalationStep{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_AfterSeconds = b.AfterSeconds
+	x.xxx_hidden_Action = b.Action
+	if b.TargetJob != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_TargetJob = b.TargetJob
+	}
+	x.xxx_hidden_Id = b.Id
 	return m0
 }
 
@@ -559,13 +874,14 @@ type Configuration struct {
 	xxx_hidden_DeduplicationEnabled  bool                   `protobuf:"varint,1,opt,name=deduplication_enabled,json=deduplicationEnabled,proto3"`
 	xxx_hidden_DeduplicationRadius   int64                  `protobuf:"varint,2,opt,name=deduplication_radius,json=deduplicationRadius,proto3"`
 	xxx_hidden_DeduplicationDuration *durationpb.Duration   `protobuf:"bytes,3,opt,name=deduplication_duration,json=deduplicationDuration,proto3,oneof"`
+	xxx_hidden_AutoAssign            *AutoAssign            `protobuf:"bytes,4,opt,name=auto_assign,json=autoAssign,proto3,oneof"`
 	unknownFields                    protoimpl.UnknownFields
 	sizeCache                        protoimpl.SizeCache
 }
 
 func (x *Configuration) Reset() {
 	*x = Configuration{}
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[3]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +893,7 @@ func (x *Configuration) String() string {
 func (*Configuration) ProtoMessage() {}
 
 func (x *Configuration) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[3]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,6 +925,13 @@ func (x *Configuration) GetDeduplicationDuration() *durationpb.Duration {
 	return nil
 }
 
+func (x *Configuration) GetAutoAssign() *AutoAssign {
+	if x != nil {
+		return x.xxx_hidden_AutoAssign
+	}
+	return nil
+}
+
 func (x *Configuration) SetDeduplicationEnabled(v bool) {
 	x.xxx_hidden_DeduplicationEnabled = v
 }
@@ -621,6 +944,10 @@ func (x *Configuration) SetDeduplicationDuration(v *durationpb.Duration) {
 	x.xxx_hidden_DeduplicationDuration = v
 }
 
+func (x *Configuration) SetAutoAssign(v *AutoAssign) {
+	x.xxx_hidden_AutoAssign = v
+}
+
 func (x *Configuration) HasDeduplicationDuration() bool {
 	if x == nil {
 		return false
@@ -628,16 +955,28 @@ func (x *Configuration) HasDeduplicationDuration() bool {
 	return x.xxx_hidden_DeduplicationDuration != nil
 }
 
+func (x *Configuration) HasAutoAssign() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_AutoAssign != nil
+}
+
 func (x *Configuration) ClearDeduplicationDuration() {
 	x.xxx_hidden_DeduplicationDuration = nil
 }
 
+func (x *Configuration) ClearAutoAssign() {
+	x.xxx_hidden_AutoAssign = nil
+}
+
 type Configuration_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	DeduplicationEnabled  bool
 	DeduplicationRadius   int64
 	DeduplicationDuration *durationpb.Duration
+	AutoAssign            *AutoAssign
 }
 
 func (b0 Configuration_builder) Build() *Configuration {
@@ -647,6 +986,122 @@ func (b0 Configuration_builder) Build() *Configuration {
 	x.xxx_hidden_DeduplicationEnabled = b.DeduplicationEnabled
 	x.xxx_hidden_DeduplicationRadius = b.DeduplicationRadius
 	x.xxx_hidden_DeduplicationDuration = b.DeduplicationDuration
+	x.xxx_hidden_AutoAssign = b.AutoAssign
+	return m0
+}
+
+type AutoAssign struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Strategy       AutoAssignStrategy     `protobuf:"varint,1,opt,name=strategy,proto3,enum=resources.centrum.settings.AutoAssignStrategy"`
+	xxx_hidden_MaxDistance    int64                  `protobuf:"varint,2,opt,name=max_distance,json=maxDistance,proto3"`
+	xxx_hidden_DistanceWeight int32                  `protobuf:"varint,3,opt,name=distance_weight,json=distanceWeight,proto3"`
+	xxx_hidden_LoadWeight     int32                  `protobuf:"varint,4,opt,name=load_weight,json=loadWeight,proto3"`
+	xxx_hidden_SkillsWeight   int32                  `protobuf:"varint,5,opt,name=skills_weight,json=skillsWeight,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *AutoAssign) Reset() {
+	*x = AutoAssign{}
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoAssign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoAssign) ProtoMessage() {}
+
+func (x *AutoAssign) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AutoAssign) GetStrategy() AutoAssignStrategy {
+	if x != nil {
+		return x.xxx_hidden_Strategy
+	}
+	return AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_UNSPECIFIED
+}
+
+func (x *AutoAssign) GetMaxDistance() int64 {
+	if x != nil {
+		return x.xxx_hidden_MaxDistance
+	}
+	return 0
+}
+
+func (x *AutoAssign) GetDistanceWeight() int32 {
+	if x != nil {
+		return x.xxx_hidden_DistanceWeight
+	}
+	return 0
+}
+
+func (x *AutoAssign) GetLoadWeight() int32 {
+	if x != nil {
+		return x.xxx_hidden_LoadWeight
+	}
+	return 0
+}
+
+func (x *AutoAssign) GetSkillsWeight() int32 {
+	if x != nil {
+		return x.xxx_hidden_SkillsWeight
+	}
+	return 0
+}
+
+func (x *AutoAssign) SetStrategy(v AutoAssignStrategy) {
+	x.xxx_hidden_Strategy = v
+}
+
+func (x *AutoAssign) SetMaxDistance(v int64) {
+	x.xxx_hidden_MaxDistance = v
+}
+
+func (x *AutoAssign) SetDistanceWeight(v int32) {
+	x.xxx_hidden_DistanceWeight = v
+}
+
+func (x *AutoAssign) SetLoadWeight(v int32) {
+	x.xxx_hidden_LoadWeight = v
+}
+
+func (x *AutoAssign) SetSkillsWeight(v int32) {
+	x.xxx_hidden_SkillsWeight = v
+}
+
+type AutoAssign_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Strategy AutoAssignStrategy
+	// Maximum distance between a unit and the dispatch, `0` means no limit
+	MaxDistance int64
+	// Weights used by the weighted strategy
+	DistanceWeight int32
+	LoadWeight     int32
+	SkillsWeight   int32
+}
+
+func (b0 AutoAssign_builder) Build() *AutoAssign {
+	m0 := &AutoAssign{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Strategy = b.Strategy
+	x.xxx_hidden_MaxDistance = b.MaxDistance
+	x.xxx_hidden_DistanceWeight = b.DistanceWeight
+	x.xxx_hidden_LoadWeight = b.LoadWeight
+	x.xxx_hidden_SkillsWeight = b.SkillsWeight
 	return m0
 }
 
@@ -659,7 +1114,7 @@ type EffectiveAccess struct {
 
 func (x *EffectiveAccess) Reset() {
 	*x = EffectiveAccess{}
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[4]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -671,7 +1126,7 @@ func (x *EffectiveAccess) String() string {
 func (*EffectiveAccess) ProtoMessage() {}
 
 func (x *EffectiveAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[4]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +1182,7 @@ type EffectiveDispatchAccess struct {
 
 func (x *EffectiveDispatchAccess) Reset() {
 	*x = EffectiveDispatchAccess{}
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[5]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -739,7 +1194,7 @@ func (x *EffectiveDispatchAccess) String() string {
 func (*EffectiveDispatchAccess) ProtoMessage() {}
 
 func (x *EffectiveDispatchAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[5]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1245,7 @@ type JobAccessEntry struct {
 
 func (x *JobAccessEntry) Reset() {
 	*x = JobAccessEntry{}
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[6]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +1257,7 @@ func (x *JobAccessEntry) String() string {
 func (*JobAccessEntry) ProtoMessage() {}
 
 func (x *JobAccessEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_centrum_settings_settings_proto_msgTypes[6]
+	mi := &file_resources_centrum_settings_settings_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,16 +1364,42 @@ const file_resources_centrum_settings_settings_proto_rawDesc = "" +
 	"\x10PredefinedStatus\x12)\n" +
 	"\vunit_status\x18\x01 \x03(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\n" +
 	"unitStatus\x121\n" +
-	"\x0fdispatch_status\x18\x02 \x03(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x0edispatchStatus:\x06\xe2\xf3\x18\x02\b\x01\"\xa3\x01\n" +
+	"\x0fdispatch_status\x18\x02 \x03(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x0edispatchStatus:\x06\xe2\xf3\x18\x02\b\x01\"\xff\x01\n" +
 	"\aTimings\x12*\n" +
 	"\x11dispatch_max_wait\x18\x01 \x01(\x03R\x0fdispatchMaxWait\x12!\n" +
 	"\frequire_unit\x18\x02 \x01(\bR\vrequireUnit\x12A\n" +
-	"\x1drequire_unit_reminder_seconds\x18\x03 \x01(\x03R\x1arequireUnitReminderSeconds:\x06\xe2\xf3\x18\x02\b\x01\"\xf1\x01\n" +
+	"\x1drequire_unit_reminder_seconds\x18\x03 \x01(\x03R\x1arequireUnitReminderSeconds\x12K\n" +
+	"\n" +
+	"escalation\x18\x04 \x01(\v2&.resources.centrum.settings.EscalationH\x00R\n" +
+	"escalation\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\r\n" +
+	"\v_escalation\"h\n" +
+	"\n" +
+	"Escalation\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12@\n" +
+	"\x05steps\x18\x02 \x03(\v2*.resources.centrum.settings.EscalationStepR\x05steps\"\xc8\x01\n" +
+	"\x0eEscalationStep\x12#\n" +
+	"\rafter_seconds\x18\x01 \x01(\x03R\fafterSeconds\x12D\n" +
+	"\x06action\x18\x02 \x01(\x0e2,.resources.centrum.settings.EscalationActionR\x06action\x12\"\n" +
+	"\n" +
+	"target_job\x18\x03 \x01(\tH\x00R\ttargetJob\x88\x01\x01\x12\x18\n" +
+	"\x02id\x18\x04 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x02idB\r\n" +
+	"\v_target_job\"\xcf\x02\n" +
 	"\rConfiguration\x123\n" +
 	"\x15deduplication_enabled\x18\x01 \x01(\bR\x14deduplicationEnabled\x121\n" +
 	"\x14deduplication_radius\x18\x02 \x01(\x03R\x13deduplicationRadius\x12U\n" +
-	"\x16deduplication_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationH\x00R\x15deduplicationDuration\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\x19\n" +
-	"\x17_deduplication_duration\"f\n" +
+	"\x16deduplication_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationH\x00R\x15deduplicationDuration\x88\x01\x01\x12L\n" +
+	"\vauto_assign\x18\x04 \x01(\v2&.resources.centrum.settings.AutoAssignH\x01R\n" +
+	"autoAssign\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\x19\n" +
+	"\x17_deduplication_durationB\x0e\n" +
+	"\f_auto_assign\"\xea\x01\n" +
+	"\n" +
+	"AutoAssign\x12J\n" +
+	"\bstrategy\x18\x01 \x01(\x0e2..resources.centrum.settings.AutoAssignStrategyR\bstrategy\x12!\n" +
+	"\fmax_distance\x18\x02 \x01(\x03R\vmaxDistance\x12'\n" +
+	"\x0fdistance_weight\x18\x03 \x01(\x05R\x0edistanceWeight\x12\x1f\n" +
+	"\vload_weight\x18\x04 \x01(\x05R\n" +
+	"loadWeight\x12#\n" +
+	"\rskills_weight\x18\x05 \x01(\x05R\fskillsWeight\"f\n" +
 	"\x0fEffectiveAccess\x12S\n" +
 	"\n" +
 	"dispatches\x18\x01 \x01(\v23.resources.centrum.settings.EffectiveDispatchAccessR\n" +
@@ -940,43 +1421,63 @@ const file_resources_centrum_settings_settings_proto_rawDesc = "" +
 	"\x13CENTRUM_MODE_MANUAL\x10\x01\x12 \n" +
 	"\x1cCENTRUM_MODE_CENTRAL_COMMAND\x10\x02\x12!\n" +
 	"\x1dCENTRUM_MODE_AUTO_ROUND_ROBIN\x10\x03\x12\x1b\n" +
-	"\x17CENTRUM_MODE_SIMPLIFIED\x10\x04B^Z\\github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings;centrumsettingsb\x06proto3"
-
-var file_resources_centrum_settings_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_resources_centrum_settings_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+	"\x17CENTRUM_MODE_SIMPLIFIED\x10\x04*\xa8\x01\n" +
+	"\x10EscalationAction\x12!\n" +
+	"\x1dESCALATION_ACTION_UNSPECIFIED\x10\x00\x12(\n" +
+	"$ESCALATION_ACTION_NOTIFY_DISPATCHERS\x10\x01\x12\"\n" +
+	"\x1eESCALATION_ACTION_OFFER_TO_JOB\x10\x02\x12#\n" +
+	"\x1fESCALATION_ACTION_MARK_BREACHED\x10\x03*\xa0\x01\n" +
+	"\x12AutoAssignStrategy\x12$\n" +
+	" AUTO_ASSIGN_STRATEGY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bAUTO_ASSIGN_STRATEGY_RANDOM\x10\x01\x12 \n" +
+	"\x1cAUTO_ASSIGN_STRATEGY_NEAREST\x10\x02\x12!\n" +
+	"\x1dAUTO_ASSIGN_STRATEGY_WEIGHTED\x10\x03B^Z\\github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings;centrumsettingsb\x06proto3"
+
+var file_resources_centrum_settings_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_resources_centrum_settings_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_resources_centrum_settings_settings_proto_goTypes = []any{
 	(CentrumType)(0),                // 0: resources.centrum.settings.CentrumType
 	(CentrumMode)(0),                // 1: resources.centrum.settings.CentrumMode
-	(*Settings)(nil),                // 2: resources.centrum.settings.Settings
-	(*PredefinedStatus)(nil),        // 3: resources.centrum.settings.PredefinedStatus
-	(*Timings)(nil),                 // 4: resources.centrum.settings.Timings
-	(*Configuration)(nil),           // 5: resources.centrum.settings.Configuration
-	(*EffectiveAccess)(nil),         // 6: resources.centrum.settings.EffectiveAccess
-	(*EffectiveDispatchAccess)(nil), // 7: resources.centrum.settings.EffectiveDispatchAccess
-	(*JobAccessEntry)(nil),          // 8: resources.centrum.settings.JobAccessEntry
-	(*access.CentrumAccess)(nil),    // 9: resources.centrum.access.CentrumAccess
-	(*durationpb.Duration)(nil),     // 10: google.protobuf.Duration
-	(access.CentrumAccessLevel)(0),  // 11: resources.centrum.access.CentrumAccessLevel
+	(EscalationAction)(0),           // 2: resources.centrum.settings.EscalationAction
+	(AutoAssignStrategy)(0),         // 3: resources.centrum.settings.AutoAssignStrategy
+	(*Settings)(nil),                // 4: resources.centrum.settings.Settings
+	(*PredefinedStatus)(nil),        // 5: resources.centrum.settings.PredefinedStatus
+	(*Timings)(nil),                 // 6: resources.centrum.settings.Timings
+	(*Escalation)(nil),              // 7: resources.centrum.settings.Escalation
+	(*EscalationStep)(nil),          // 8: resources.centrum.settings.EscalationStep
+	(*Configuration)(nil),           // 9: resources.centrum.settings.Configuration
+	(*AutoAssign)(nil),              // 10: resources.centrum.settings.AutoAssign
+	(*EffectiveAccess)(nil),         // 11: resources.centrum.settings.EffectiveAccess
+	(*EffectiveDispatchAccess)(nil), // 12: resources.centrum.settings.EffectiveDispatchAccess
+	(*JobAccessEntry)(nil),          // 13: resources.centrum.settings.JobAccessEntry
+	(*access.CentrumAccess)(nil),    // 14: resources.centrum.access.CentrumAccess
+	(*durationpb.Duration)(nil),     // 15: google.protobuf.Duration
+	(access.CentrumAccessLevel)(0),  // 16: resources.centrum.access.CentrumAccessLevel
 }
 var file_resources_centrum_settings_settings_proto_depIdxs = []int32{
 	0,  // 0: resources.centrum.settings.Settings.type:type_name -> resources.centrum.settings.CentrumType
 	1,  // 1: resources.centrum.settings.Settings.mode:type_name -> resources.centrum.settings.CentrumMode
 	1,  // 2: resources.centrum.settings.Settings.fallback_mode:type_name -> resources.centrum.settings.CentrumMode
-	3,  // 3: resources.centrum.settings.Settings.predefined_status:type_name -> resources.centrum.settings.PredefinedStatus
-	4,  // 4: resources.centrum.settings.Settings.timings:type_name -> resources.centrum.settings.Timings
-	5,  // 5: resources.centrum.settings.Settings.configuration:type_name -> resources.centrum.settings.Configuration
-	9,  // 6: resources.centrum.settings.Settings.access:type_name -> resources.centrum.access.CentrumAccess
-	9,  // 7: resources.centrum.settings.Settings.offered_access:type_name -> resources.centrum.access.CentrumAccess
-	6,  // 8: resources.centrum.settings.Settings.effective_access:type_name -> resources.centrum.settings.EffectiveAccess
-	10, // 9: resources.centrum.settings.Configuration.deduplication_duration:type_name -> google.protobuf.Duration
-	7,  // 10: resources.centrum.settings.EffectiveAccess.dispatches:type_name -> resources.centrum.settings.EffectiveDispatchAccess
-	8,  // 11: resources.centrum.settings.EffectiveDispatchAccess.jobs:type_name -> resources.centrum.settings.JobAccessEntry
-	11, // 12: resources.centrum.settings.JobAccessEntry.access:type_name -> resources.centrum.access.CentrumAccessLevel
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 3: resources.centrum.settings.Settings.predefined_status:type_name -> resources.centrum.settings.PredefinedStatus
+	6,  // 4: resources.centrum.settings.Settings.timings:type_name -> resources.centrum.settings.Timings
+	9,  // 5: resources.centrum.settings.Settings.configuration:type_name -> resources.centrum.settings.Configuration
+	14, // 6: resources.centrum.settings.Settings.access:type_name -> resources.centrum.access.CentrumAccess
+	14, // 7: resources.centrum.settings.Settings.offered_access:type_name -> resources.centrum.access.CentrumAccess
+	11, // 8: resources.centrum.settings.Settings.effective_access:type_name -> resources.centrum.settings.EffectiveAccess
+	7,  // 9: resources.centrum.settings.Timings.escalation:type_name -> resources.centrum.settings.Escalation
+	8,  // 10: resources.centrum.settings.Escalation.steps:type_name -> resources.centrum.settings.EscalationStep
+	2,  // 11: resources.centrum.settings.EscalationStep.action:type_name -> resources.centrum.settings.EscalationAction
+	15, // 12: resources.centrum.settings.Configuration.deduplication_duration:type_name -> google.protobuf.Duration
+	10, // 13: resources.centrum.settings.Configuration.auto_assign:type_name -> resources.centrum.settings.AutoAssign
+	3,  // 14: resources.centrum.settings.AutoAssign.strategy:type_name -> resources.centrum.settings.AutoAssignStrategy
+	12, // 15: resources.centrum.settings.EffectiveAccess.dispatches:type_name -> resources.centrum.settings.EffectiveDispatchAccess
+	13, // 16: resources.centrum.settings.EffectiveDispatchAccess.jobs:type_name -> resources.centrum.settings.JobAccessEntry
+	16, // 17: resources.centrum.settings.JobAccessEntry.access:type_name -> resources.centrum.access.CentrumAccessLevel
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_resources_centrum_settings_settings_proto_init() }
@@ -985,15 +1486,17 @@ func file_resources_centrum_settings_settings_proto_init() {
 		return
 	}
 	file_resources_centrum_settings_settings_proto_msgTypes[0].OneofWrappers = []any{}
-	file_resources_centrum_settings_settings_proto_msgTypes[3].OneofWrappers = []any{}
-	file_resources_centrum_settings_settings_proto_msgTypes[6].OneofWrappers = []any{}
+	file_resources_centrum_settings_settings_proto_msgTypes[2].OneofWrappers = []any{}
+	file_resources_centrum_settings_settings_proto_msgTypes[4].OneofWrappers = []any{}
+	file_resources_centrum_settings_settings_proto_msgTypes[5].OneofWrappers = []any{}
+	file_resources_centrum_settings_settings_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_centrum_settings_settings_proto_rawDesc), len(file_resources_centrum_settings_settings_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
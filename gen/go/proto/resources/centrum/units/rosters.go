@@ -0,0 +1,7 @@
+package centrumunits
+
+// IsRunning returns true if the roster's shift has been started and not ended yet, its members might be assigned to
+// the unit.
+func (x *UnitRoster) IsRunning() bool {
+	return x.GetStartedAt() != nil && x.GetEndedAt() == nil
+}
@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/centrum/units/rosters.proto
+
+//go:build !protoopaque
+
+package centrumunits
+
+import (
+	colleagues "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A roster assigns colleagues to a unit for a shift (time window).
+// Members are joined to the unit at shift start and removed (and their timeclock closed) at shift end.
+type UnitRoster struct {
+	state     protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" alias:"id" sql:"primary_key"`
+	CreatedAt *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	Job       string                 `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`
+	UnitId    int64                  `protobuf:"varint,5,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	StartTime *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Set when the roster has been generated from a calendar entry
+	CalendarEntryId *int64               `protobuf:"varint,8,opt,name=calendar_entry_id,json=calendarEntryId,proto3,oneof" json:"calendar_entry_id,omitempty"`
+	CreatorId       *int32               `protobuf:"varint,9,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
+	StartedAt       *timestamp.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	EndedAt         *timestamp.Timestamp `protobuf:"bytes,11,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
+	Members         []*UnitRosterMember  `protobuf:"bytes,12,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnitRoster) Reset() {
+	*x = UnitRoster{}
+	mi := &file_resources_centrum_units_rosters_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitRoster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitRoster) ProtoMessage() {}
+
+func (x *UnitRoster) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_units_rosters_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnitRoster) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UnitRoster) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UnitRoster) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UnitRoster) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *UnitRoster) GetUnitId() int64 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *UnitRoster) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UnitRoster) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *UnitRoster) GetCalendarEntryId() int64 {
+	if x != nil && x.CalendarEntryId != nil {
+		return *x.CalendarEntryId
+	}
+	return 0
+}
+
+func (x *UnitRoster) GetCreatorId() int32 {
+	if x != nil && x.CreatorId != nil {
+		return *x.CreatorId
+	}
+	return 0
+}
+
+func (x *UnitRoster) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *UnitRoster) GetEndedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *UnitRoster) GetMembers() []*UnitRosterMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *UnitRoster) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *UnitRoster) SetCreatedAt(v *timestamp.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *UnitRoster) SetUpdatedAt(v *timestamp.Timestamp) {
+	x.UpdatedAt = v
+}
+
+func (x *UnitRoster) SetJob(v string) {
+	x.Job = v
+}
+
+func (x *UnitRoster) SetUnitId(v int64) {
+	x.UnitId = v
+}
+
+func (x *UnitRoster) SetStartTime(v *timestamp.Timestamp) {
+	x.StartTime = v
+}
+
+func (x *UnitRoster) SetEndTime(v *timestamp.Timestamp) {
+	x.EndTime = v
+}
+
+func (x *UnitRoster) SetCalendarEntryId(v int64) {
+	x.CalendarEntryId = &v
+}
+
+func (x *UnitRoster) SetCreatorId(v int32) {
+	x.CreatorId = &v
+}
+
+func (x *UnitRoster) SetStartedAt(v *timestamp.Timestamp) {
+	x.StartedAt = v
+}
+
+func (x *UnitRoster) SetEndedAt(v *timestamp.Timestamp) {
+	x.EndedAt = v
+}
+
+func (x *UnitRoster) SetMembers(v []*UnitRosterMember) {
+	x.Members = v
+}
+
+func (x *UnitRoster) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *UnitRoster) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.UpdatedAt != nil
+}
+
+func (x *UnitRoster) HasStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.StartTime != nil
+}
+
+func (x *UnitRoster) HasEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.EndTime != nil
+}
+
+func (x *UnitRoster) HasCalendarEntryId() bool {
+	if x == nil {
+		return false
+	}
+	return x.CalendarEntryId != nil
+}
+
+func (x *UnitRoster) HasCreatorId() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatorId != nil
+}
+
+func (x *UnitRoster) HasStartedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.StartedAt != nil
+}
+
+func (x *UnitRoster) HasEndedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.EndedAt != nil
+}
+
+func (x *UnitRoster) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *UnitRoster) ClearUpdatedAt() {
+	x.UpdatedAt = nil
+}
+
+func (x *UnitRoster) ClearStartTime() {
+	x.StartTime = nil
+}
+
+func (x *UnitRoster) ClearEndTime() {
+	x.EndTime = nil
+}
+
+func (x *UnitRoster) ClearCalendarEntryId() {
+	x.CalendarEntryId = nil
+}
+
+func (x *UnitRoster) ClearCreatorId() {
+	x.CreatorId = nil
+}
+
+func (x *UnitRoster) ClearStartedAt() {
+	x.StartedAt = nil
+}
+
+func (x *UnitRoster) ClearEndedAt() {
+	x.EndedAt = nil
+}
+
+type UnitRoster_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        int64
+	CreatedAt *timestamp.Timestamp
+	UpdatedAt *timestamp.Timestamp
+	Job       string
+	UnitId    int64
+	StartTime *timestamp.Timestamp
+	EndTime   *timestamp.Timestamp
+	// Set when the roster has been generated from a calendar entry
+	CalendarEntryId *int64
+	CreatorId       *int32
+	StartedAt       *timestamp.Timestamp
+	EndedAt         *timestamp.Timestamp
+	Members         []*UnitRosterMember
+}
+
+func (b0 UnitRoster_builder) Build() *UnitRoster {
+	m0 := &UnitRoster{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.CreatedAt = b.CreatedAt
+	x.UpdatedAt = b.UpdatedAt
+	x.Job = b.Job
+	x.UnitId = b.UnitId
+	x.StartTime = b.StartTime
+	x.EndTime = b.EndTime
+	x.CalendarEntryId = b.CalendarEntryId
+	x.CreatorId = b.CreatorId
+	x.StartedAt = b.StartedAt
+	x.EndedAt = b.EndedAt
+	x.Members = b.Members
+	return m0
+}
+
+type UnitRosterMember struct {
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	RosterId int64                  `protobuf:"varint,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty" alias:"roster_id" sql:"primary_key"`
+	UserId   int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" alias:"user_id" sql:"primary_key"`
+	User     *colleagues.Colleague  `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	// Set when the member has been joined to the unit by the roster
+	AssignedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=assigned_at,json=assignedAt,proto3,oneof" json:"assigned_at,omitempty"`
+	// The member has an absence set that overlaps with the roster's time window
+	AbsenceConflict bool `protobuf:"varint,5,opt,name=absence_conflict,json=absenceConflict,proto3" json:"absence_conflict,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnitRosterMember) Reset() {
+	*x = UnitRosterMember{}
+	mi := &file_resources_centrum_units_rosters_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitRosterMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitRosterMember) ProtoMessage() {}
+
+func (x *UnitRosterMember) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_centrum_units_rosters_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UnitRosterMember) GetRosterId() int64 {
+	if x != nil {
+		return x.RosterId
+	}
+	return 0
+}
+
+func (x *UnitRosterMember) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnitRosterMember) GetUser() *colleagues.Colleague {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UnitRosterMember) GetAssignedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *UnitRosterMember) GetAbsenceConflict() bool {
+	if x != nil {
+		return x.AbsenceConflict
+	}
+	return false
+}
+
+func (x *UnitRosterMember) SetRosterId(v int64) {
+	x.RosterId = v
+}
+
+func (x *UnitRosterMember) SetUserId(v int32) {
+	x.UserId = v
+}
+
+func (x *UnitRosterMember) SetUser(v *colleagues.Colleague) {
+	x.User = v
+}
+
+func (x *UnitRosterMember) SetAssignedAt(v *timestamp.Timestamp) {
+	x.AssignedAt = v
+}
+
+func (x *UnitRosterMember) SetAbsenceConflict(v bool) {
+	x.AbsenceConflict = v
+}
+
+func (x *UnitRosterMember) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.User != nil
+}
+
+func (x *UnitRosterMember) HasAssignedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.AssignedAt != nil
+}
+
+func (x *UnitRosterMember) ClearUser() {
+	x.User = nil
+}
+
+func (x *UnitRosterMember) ClearAssignedAt() {
+	x.AssignedAt = nil
+}
+
+type UnitRosterMember_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RosterId int64
+	UserId   int32
+	User     *colleagues.Colleague
+	// Set when the member has been joined to the unit by the roster
+	AssignedAt *timestamp.Timestamp
+	// The member has an absence set that overlaps with the roster's time window
+	AbsenceConflict bool
+}
+
+func (b0 UnitRosterMember_builder) Build() *UnitRosterMember {
+	m0 := &UnitRosterMember{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.RosterId = b.RosterId
+	x.UserId = b.UserId
+	x.User = b.User
+	x.AssignedAt = b.AssignedAt
+	x.AbsenceConflict = b.AbsenceConflict
+	return m0
+}
+
+var File_resources_centrum_units_rosters_proto protoreflect.FileDescriptor
+
+const file_resources_centrum_units_rosters_proto_rawDesc = "" +
+	"\n" +
+	"%resources/centrum/units/rosters.proto\x12\x17resources.centrum.units\x1a*resources/jobs/colleagues/colleagues.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xe9\x05\n" +
+	"\n" +
+	"UnitRoster\x121\n" +
+	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tupdatedAt\x88\x01\x01\x12\x10\n" +
+	"\x03job\x18\x04 \x01(\tR\x03job\x12\x17\n" +
+	"\aunit_id\x18\x05 \x01(\x03R\x06unitId\x12=\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1e.resources.timestamp.TimestampR\tstartTime\x129\n" +
+	"\bend_time\x18\a \x01(\v2\x1e.resources.timestamp.TimestampR\aendTime\x12/\n" +
+	"\x11calendar_entry_id\x18\b \x01(\x03H\x02R\x0fcalendarEntryId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"creator_id\x18\t \x01(\x05H\x03R\tcreatorId\x88\x01\x01\x12B\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\tstartedAt\x88\x01\x01\x12>\n" +
+	"\bended_at\x18\v \x01(\v2\x1e.resources.timestamp.TimestampH\x05R\aendedAt\x88\x01\x01\x12C\n" +
+	"\amembers\x18\f \x03(\v2).resources.centrum.units.UnitRosterMemberR\amembersB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\x14\n" +
+	"\x12_calendar_entry_idB\r\n" +
+	"\v_creator_idB\r\n" +
+	"\v_started_atB\v\n" +
+	"\t_ended_at\"\xe3\x02\n" +
+	"\x10UnitRosterMember\x12E\n" +
+	"\troster_id\x18\x01 \x01(\x03B(\x9a\x84\x9e\x03#sql:\"primary_key\" alias:\"roster_id\"R\brosterId\x12?\n" +
+	"\auser_id\x18\x02 \x01(\x05B&\x9a\x84\x9e\x03!sql:\"primary_key\" alias:\"user_id\"R\x06userId\x12=\n" +
+	"\x04user\x18\x03 \x01(\v2$.resources.jobs.colleagues.ColleagueH\x00R\x04user\x88\x01\x01\x12D\n" +
+	"\vassigned_at\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\n" +
+	"assignedAt\x88\x01\x01\x12)\n" +
+	"\x10absence_conflict\x18\x05 \x01(\bR\x0fabsenceConflictB\a\n" +
+	"\x05_userB\x0e\n" +
+	"\f_assigned_atBXZVgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units;centrumunitsb\x06proto3"
+
+var file_resources_centrum_units_rosters_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_resources_centrum_units_rosters_proto_goTypes = []any{
+	(*UnitRoster)(nil),           // 0: resources.centrum.units.UnitRoster
+	(*UnitRosterMember)(nil),     // 1: resources.centrum.units.UnitRosterMember
+	(*timestamp.Timestamp)(nil),  // 2: resources.timestamp.Timestamp
+	(*colleagues.Colleague)(nil), // 3: resources.jobs.colleagues.Colleague
+}
+var file_resources_centrum_units_rosters_proto_depIdxs = []int32{
+	2, // 0: resources.centrum.units.UnitRoster.created_at:type_name -> resources.timestamp.Timestamp
+	2, // 1: resources.centrum.units.UnitRoster.updated_at:type_name -> resources.timestamp.Timestamp
+	2, // 2: resources.centrum.units.UnitRoster.start_time:type_name -> resources.timestamp.Timestamp
+	2, // 3: resources.centrum.units.UnitRoster.end_time:type_name -> resources.timestamp.Timestamp
+	2, // 4: resources.centrum.units.UnitRoster.started_at:type_name -> resources.timestamp.Timestamp
+	2, // 5: resources.centrum.units.UnitRoster.ended_at:type_name -> resources.timestamp.Timestamp
+	1, // 6: resources.centrum.units.UnitRoster.members:type_name -> resources.centrum.units.UnitRosterMember
+	3, // 7: resources.centrum.units.UnitRosterMember.user:type_name -> resources.jobs.colleagues.Colleague
+	2, // 8: resources.centrum.units.UnitRosterMember.assigned_at:type_name -> resources.timestamp.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_resources_centrum_units_rosters_proto_init() }
+func file_resources_centrum_units_rosters_proto_init() {
+	if File_resources_centrum_units_rosters_proto != nil {
+		return
+	}
+	file_resources_centrum_units_rosters_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_centrum_units_rosters_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_centrum_units_rosters_proto_rawDesc), len(file_resources_centrum_units_rosters_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_centrum_units_rosters_proto_goTypes,
+		DependencyIndexes: file_resources_centrum_units_rosters_proto_depIdxs,
+		MessageInfos:      file_resources_centrum_units_rosters_proto_msgTypes,
+	}.Build()
+	File_resources_centrum_units_rosters_proto = out.File
+	file_resources_centrum_units_rosters_proto_goTypes = nil
+	file_resources_centrum_units_rosters_proto_depIdxs = nil
+}
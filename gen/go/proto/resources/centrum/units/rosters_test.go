@@ -0,0 +1,20 @@
+package centrumunits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRosterIsRunning(t *testing.T) {
+	t.Parallel()
+
+	now := timestamp.New(time.Now())
+
+	assert.False(t, (*UnitRoster)(nil).IsRunning())
+	assert.False(t, (&UnitRoster{}).IsRunning())
+	assert.True(t, (&UnitRoster{StartedAt: now}).IsRunning())
+	assert.False(t, (&UnitRoster{StartedAt: now, EndedAt: now}).IsRunning())
+}
@@ -2,6 +2,7 @@ package centrumunits
 
 import (
 	"slices"
+	"strings"
 
 	"github.com/paulmach/orb"
 	"google.golang.org/protobuf/proto"
@@ -80,6 +81,7 @@ func (x *Unit) Merge(in *Unit) *Unit {
 			x.Attributes = in.GetAttributes()
 		} else {
 			x.Attributes.List = in.GetAttributes().GetList()
+			x.Attributes.Skills = in.GetAttributes().GetSkills()
 		}
 	}
 
@@ -133,3 +135,9 @@ func (x *UnitAttributes) Remove(attribute UnitAttribute) bool {
 
 	return true
 }
+
+func (x *UnitAttributes) HasSkill(skill string) bool {
+	return slices.ContainsFunc(x.GetSkills(), func(s string) bool {
+		return strings.EqualFold(s, skill)
+	})
+}
@@ -1064,8 +1064,10 @@ func (b0 UnitStatus_builder) Build() *UnitStatus {
 }
 
 type UnitAttributes struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	List          []UnitAttribute        `protobuf:"varint,1,rep,packed,name=list,proto3,enum=resources.centrum.units.UnitAttribute" json:"list,omitempty"`
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	List  []UnitAttribute        `protobuf:"varint,1,rep,packed,name=list,proto3,enum=resources.centrum.units.UnitAttribute" json:"list,omitempty"`
+	// Skills of the unit, matched against dispatch requirements by the auto assignment
+	Skills        []string `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1102,14 +1104,27 @@ func (x *UnitAttributes) GetList() []UnitAttribute {
 	return nil
 }
 
+func (x *UnitAttributes) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
 func (x *UnitAttributes) SetList(v []UnitAttribute) {
 	x.List = v
 }
 
+func (x *UnitAttributes) SetSkills(v []string) {
+	x.Skills = v
+}
+
 type UnitAttributes_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	List []UnitAttribute
+	// Skills of the unit, matched against dispatch requirements by the auto assignment
+	Skills []string
 }
 
 func (b0 UnitAttributes_builder) Build() *UnitAttributes {
@@ -1117,6 +1132,7 @@ func (b0 UnitAttributes_builder) Build() *UnitAttributes {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.List = b.List
+	x.Skills = b.Skills
 	return m0
 }
 
@@ -1201,9 +1217,10 @@ const file_resources_centrum_units_units_proto_rawDesc = "" +
 	"\v_creator_idB\n" +
 	"\n" +
 	"\b_creatorB\x0e\n" +
-	"\f_creator_job\"T\n" +
+	"\f_creator_job\"v\n" +
 	"\x0eUnitAttributes\x12:\n" +
-	"\x04list\x18\x01 \x03(\x0e2&.resources.centrum.units.UnitAttributeR\x04list:\x06\xe2\xf3\x18\x02\b\x01*\xe4\x01\n" +
+	"\x04list\x18\x01 \x03(\x0e2&.resources.centrum.units.UnitAttributeR\x04list\x12 \n" +
+	"\x06skills\x18\x02 \x03(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x06skills:\x06\xe2\xf3\x18\x02\b\x01*\xe4\x01\n" +
 	"\n" +
 	"StatusUnit\x12\x1b\n" +
 	"\x17STATUS_UNIT_UNSPECIFIED\x10\x00\x12\x17\n" +
@@ -163,6 +163,14 @@ func (m *UnitAttributes) Sanitize() error {
 
 	}
 
+	// Field: Skills
+	for idx, item := range m.Skills {
+		_, _ = idx, item
+
+		m.Skills[idx] = htmlsanitizer.StripHTMLTags(m.Skills[idx])
+
+	}
+
 	return nil
 }
 
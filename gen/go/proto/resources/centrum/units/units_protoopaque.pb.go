@@ -1156,10 +1156,11 @@ func (b0 UnitStatus_builder) Build() *UnitStatus {
 }
 
 type UnitAttributes struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_List []UnitAttribute        `protobuf:"varint,1,rep,packed,name=list,proto3,enum=resources.centrum.units.UnitAttribute"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_List   []UnitAttribute        `protobuf:"varint,1,rep,packed,name=list,proto3,enum=resources.centrum.units.UnitAttribute"`
+	xxx_hidden_Skills []string               `protobuf:"bytes,2,rep,name=skills,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UnitAttributes) Reset() {
@@ -1194,14 +1195,27 @@ func (x *UnitAttributes) GetList() []UnitAttribute {
 	return nil
 }
 
+func (x *UnitAttributes) GetSkills() []string {
+	if x != nil {
+		return x.xxx_hidden_Skills
+	}
+	return nil
+}
+
 func (x *UnitAttributes) SetList(v []UnitAttribute) {
 	x.xxx_hidden_List = v
 }
 
+func (x *UnitAttributes) SetSkills(v []string) {
+	x.xxx_hidden_Skills = v
+}
+
 type UnitAttributes_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	List []UnitAttribute
+	// Skills of the unit, matched against dispatch requirements by the auto assignment
+	Skills []string
 }
 
 func (b0 UnitAttributes_builder) Build() *UnitAttributes {
@@ -1209,6 +1223,7 @@ func (b0 UnitAttributes_builder) Build() *UnitAttributes {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_List = b.List
+	x.xxx_hidden_Skills = b.Skills
 	return m0
 }
 
@@ -1293,9 +1308,10 @@ const file_resources_centrum_units_units_proto_rawDesc = "" +
 	"\v_creator_idB\n" +
 	"\n" +
 	"\b_creatorB\x0e\n" +
-	"\f_creator_job\"T\n" +
+	"\f_creator_job\"v\n" +
 	"\x0eUnitAttributes\x12:\n" +
-	"\x04list\x18\x01 \x03(\x0e2&.resources.centrum.units.UnitAttributeR\x04list:\x06\xe2\xf3\x18\x02\b\x01*\xe4\x01\n" +
+	"\x04list\x18\x01 \x03(\x0e2&.resources.centrum.units.UnitAttributeR\x04list\x12 \n" +
+	"\x06skills\x18\x02 \x03(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x06skills:\x06\xe2\xf3\x18\x02\b\x01*\xe4\x01\n" +
 	"\n" +
 	"StatusUnit\x12\x1b\n" +
 	"\x17STATUS_UNIT_UNSPECIFIED\x10\x00\x12\x17\n" +
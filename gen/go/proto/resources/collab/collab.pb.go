@@ -1098,8 +1098,10 @@ func (b0 CollabHandshake_builder) Build() *CollabHandshake {
 }
 
 type TargetSaved struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	TargetId      int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	TargetId int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// ID of the version created by the save (if the target is versioned)
+	VersionId     *int64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3,oneof" json:"version_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1136,14 +1138,38 @@ func (x *TargetSaved) GetTargetId() int64 {
 	return 0
 }
 
+func (x *TargetSaved) GetVersionId() int64 {
+	if x != nil && x.VersionId != nil {
+		return *x.VersionId
+	}
+	return 0
+}
+
 func (x *TargetSaved) SetTargetId(v int64) {
 	x.TargetId = v
 }
 
+func (x *TargetSaved) SetVersionId(v int64) {
+	x.VersionId = &v
+}
+
+func (x *TargetSaved) HasVersionId() bool {
+	if x == nil {
+		return false
+	}
+	return x.VersionId != nil
+}
+
+func (x *TargetSaved) ClearVersionId() {
+	x.VersionId = nil
+}
+
 type TargetSaved_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	TargetId int64
+	// ID of the version created by the save (if the target is versioned)
+	VersionId *int64
 }
 
 func (b0 TargetSaved_builder) Build() *TargetSaved {
@@ -1151,6 +1177,7 @@ func (b0 TargetSaved_builder) Build() *TargetSaved {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.TargetId = b.TargetId
+	x.VersionId = b.VersionId
 	return m0
 }
 
@@ -1330,9 +1357,12 @@ const file_resources_collab_collab_proto_rawDesc = "" +
 	"\rclient_update\x18\b \x01(\v2\x1e.resources.collab.ClientUpdateH\x00R\fclientUpdateB\x05\n" +
 	"\x03msg\"4\n" +
 	"\x0fCollabHandshake\x12\x1b\n" +
-	"\tclient_id\x18\x01 \x01(\x04R\bclientIdJ\x04\b\x02\x10\x03\"*\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientIdJ\x04\b\x02\x10\x03\"]\n" +
 	"\vTargetSaved\x12\x1b\n" +
-	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\"\x0e\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\"\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x03H\x00R\tversionId\x88\x01\x01B\r\n" +
+	"\v_version_id\"\x0e\n" +
 	"\fFirstPromote\"h\n" +
 	"\fClientUpdate\x12\x16\n" +
 	"\x06joined\x18\x01 \x01(\bR\x06joined\x12\x1b\n" +
@@ -1400,6 +1430,7 @@ func file_resources_collab_collab_proto_init() {
 		(*ServerPacket_Promote)(nil),
 		(*ServerPacket_ClientUpdate)(nil),
 	}
+	file_resources_collab_collab_proto_msgTypes[7].OneofWrappers = []any{}
 	file_resources_collab_collab_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
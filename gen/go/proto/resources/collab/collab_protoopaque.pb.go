@@ -1075,10 +1075,13 @@ func (b0 CollabHandshake_builder) Build() *CollabHandshake {
 }
 
 type TargetSaved struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TargetId int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TargetId    int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3"`
+	xxx_hidden_VersionId   int64                  `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *TargetSaved) Reset() {
@@ -1113,14 +1116,40 @@ func (x *TargetSaved) GetTargetId() int64 {
 	return 0
 }
 
+func (x *TargetSaved) GetVersionId() int64 {
+	if x != nil {
+		return x.xxx_hidden_VersionId
+	}
+	return 0
+}
+
 func (x *TargetSaved) SetTargetId(v int64) {
 	x.xxx_hidden_TargetId = v
 }
 
+func (x *TargetSaved) SetVersionId(v int64) {
+	x.xxx_hidden_VersionId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *TargetSaved) HasVersionId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TargetSaved) ClearVersionId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_VersionId = 0
+}
+
 type TargetSaved_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	TargetId int64
+	// ID of the version created by the save (if the target is versioned)
+	VersionId *int64
 }
 
 func (b0 TargetSaved_builder) Build() *TargetSaved {
@@ -1128,6 +1157,10 @@ func (b0 TargetSaved_builder) Build() *TargetSaved {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_TargetId = b.TargetId
+	if b.VersionId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_VersionId = *b.VersionId
+	}
 	return m0
 }
 
@@ -1317,9 +1350,12 @@ const file_resources_collab_collab_proto_rawDesc = "" +
 	"\rclient_update\x18\b \x01(\v2\x1e.resources.collab.ClientUpdateH\x00R\fclientUpdateB\x05\n" +
 	"\x03msg\"4\n" +
 	"\x0fCollabHandshake\x12\x1b\n" +
-	"\tclient_id\x18\x01 \x01(\x04R\bclientIdJ\x04\b\x02\x10\x03\"*\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientIdJ\x04\b\x02\x10\x03\"]\n" +
 	"\vTargetSaved\x12\x1b\n" +
-	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\"\x0e\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\"\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x03H\x00R\tversionId\x88\x01\x01B\r\n" +
+	"\v_version_id\"\x0e\n" +
 	"\fFirstPromote\"h\n" +
 	"\fClientUpdate\x12\x16\n" +
 	"\x06joined\x18\x01 \x01(\bR\x06joined\x12\x1b\n" +
@@ -1387,6 +1423,7 @@ func file_resources_collab_collab_proto_init() {
 		(*serverPacket_Promote)(nil),
 		(*serverPacket_ClientUpdate)(nil),
 	}
+	file_resources_collab_collab_proto_msgTypes[7].OneofWrappers = []any{}
 	file_resources_collab_collab_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -272,3 +272,26 @@ func TestExtractFromTiptapWhitespaceNormalization(t *testing.T) {
 	assert.Equal(t, "alpha beta\ngamma\n\ndelta", got.GetText())
 	assert.Equal(t, uint32(4), got.GetWordCount())
 }
+
+func TestContentExtract(t *testing.T) {
+	t.Parallel()
+
+	var nilContent *Content
+	require.NotNil(t, nilContent.Extract())
+	assert.Empty(t, nilContent.Extract().GetText())
+
+	c := &Content{
+		TiptapJson: mustStruct(t, map[string]any{
+			"type": "doc",
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "Hello world"},
+					},
+				},
+			},
+		}),
+	}
+	assert.Equal(t, "Hello world", c.Extract().GetText())
+}
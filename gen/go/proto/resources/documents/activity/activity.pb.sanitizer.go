@@ -230,6 +230,17 @@ func (m *DocActivityData) Sanitize() error {
 			}
 		}
 
+		// Field: StateChanged
+	case *DocActivityData_StateChanged:
+
+		if v.StateChanged != nil {
+			if s, ok := any(v.StateChanged).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
 		// Field: Updated
 	case *DocActivityData_Updated:
 
@@ -296,6 +307,28 @@ func (m *DocSigningRequested) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DocStateChanged) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Effects
+	for idx, item := range m.Effects {
+		_, _ = idx, item
+
+	}
+
+	// Field: FromState
+	m.FromState = htmlsanitizer.SanitizeAndUnescape(m.FromState)
+
+	// Field: ToState
+	m.ToState = htmlsanitizer.SanitizeAndUnescape(m.ToState)
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *DocUpdated) Sanitize() error {
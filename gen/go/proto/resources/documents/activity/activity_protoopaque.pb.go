@@ -14,6 +14,7 @@ import (
 	access1 "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
 	content "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/content"
 	access "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	category "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	short "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
 	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
@@ -45,6 +46,10 @@ const (
 	DocActivityType_DOC_ACTIVITY_TYPE_OWNER_CHANGED      DocActivityType = 8
 	DocActivityType_DOC_ACTIVITY_TYPE_DELETED            DocActivityType = 9
 	DocActivityType_DOC_ACTIVITY_TYPE_DRAFT_TOGGLED      DocActivityType = 19
+	DocActivityType_DOC_ACTIVITY_TYPE_STATE_CHANGED      DocActivityType = 23
+	DocActivityType_DOC_ACTIVITY_TYPE_LEGAL_HOLD_SET     DocActivityType = 24
+	DocActivityType_DOC_ACTIVITY_TYPE_LEGAL_HOLD_REMOVED DocActivityType = 25
+	DocActivityType_DOC_ACTIVITY_TYPE_ARCHIVED           DocActivityType = 26
 	// Comments
 	DocActivityType_DOC_ACTIVITY_TYPE_COMMENT_ADDED    DocActivityType = 10
 	DocActivityType_DOC_ACTIVITY_TYPE_COMMENT_UPDATED  DocActivityType = 11
@@ -80,6 +85,10 @@ var (
 		8:  "DOC_ACTIVITY_TYPE_OWNER_CHANGED",
 		9:  "DOC_ACTIVITY_TYPE_DELETED",
 		19: "DOC_ACTIVITY_TYPE_DRAFT_TOGGLED",
+		23: "DOC_ACTIVITY_TYPE_STATE_CHANGED",
+		24: "DOC_ACTIVITY_TYPE_LEGAL_HOLD_SET",
+		25: "DOC_ACTIVITY_TYPE_LEGAL_HOLD_REMOVED",
+		26: "DOC_ACTIVITY_TYPE_ARCHIVED",
 		10: "DOC_ACTIVITY_TYPE_COMMENT_ADDED",
 		11: "DOC_ACTIVITY_TYPE_COMMENT_UPDATED",
 		12: "DOC_ACTIVITY_TYPE_COMMENT_DELETED",
@@ -109,6 +118,10 @@ var (
 		"DOC_ACTIVITY_TYPE_OWNER_CHANGED":          8,
 		"DOC_ACTIVITY_TYPE_DELETED":                9,
 		"DOC_ACTIVITY_TYPE_DRAFT_TOGGLED":          19,
+		"DOC_ACTIVITY_TYPE_STATE_CHANGED":          23,
+		"DOC_ACTIVITY_TYPE_LEGAL_HOLD_SET":         24,
+		"DOC_ACTIVITY_TYPE_LEGAL_HOLD_REMOVED":     25,
+		"DOC_ACTIVITY_TYPE_ARCHIVED":               26,
 		"DOC_ACTIVITY_TYPE_COMMENT_ADDED":          10,
 		"DOC_ACTIVITY_TYPE_COMMENT_UPDATED":        11,
 		"DOC_ACTIVITY_TYPE_COMMENT_DELETED":        12,
@@ -499,6 +512,15 @@ func (x *DocActivityData) GetSigningRequested() *DocSigningRequested {
 	return nil
 }
 
+func (x *DocActivityData) GetStateChanged() *DocStateChanged {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Data.(*docActivityData_StateChanged); ok {
+			return x.StateChanged
+		}
+	}
+	return nil
+}
+
 func (x *DocActivityData) SetUpdated(v *DocUpdated) {
 	if v == nil {
 		x.xxx_hidden_Data = nil
@@ -539,6 +561,14 @@ func (x *DocActivityData) SetSigningRequested(v *DocSigningRequested) {
 	x.xxx_hidden_Data = &docActivityData_SigningRequested{v}
 }
 
+func (x *DocActivityData) SetStateChanged(v *DocStateChanged) {
+	if v == nil {
+		x.xxx_hidden_Data = nil
+		return
+	}
+	x.xxx_hidden_Data = &docActivityData_StateChanged{v}
+}
+
 func (x *DocActivityData) HasData() bool {
 	if x == nil {
 		return false
@@ -586,6 +616,14 @@ func (x *DocActivityData) HasSigningRequested() bool {
 	return ok
 }
 
+func (x *DocActivityData) HasStateChanged() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*docActivityData_StateChanged)
+	return ok
+}
+
 func (x *DocActivityData) ClearData() {
 	x.xxx_hidden_Data = nil
 }
@@ -620,12 +658,19 @@ func (x *DocActivityData) ClearSigningRequested() {
 	}
 }
 
+func (x *DocActivityData) ClearStateChanged() {
+	if _, ok := x.xxx_hidden_Data.(*docActivityData_StateChanged); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
 const DocActivityData_Data_not_set_case case_DocActivityData_Data = 0
 const DocActivityData_Updated_case case_DocActivityData_Data = 1
 const DocActivityData_OwnerChanged_case case_DocActivityData_Data = 2
 const DocActivityData_AccessUpdated_case case_DocActivityData_Data = 4
 const DocActivityData_AccessRequested_case case_DocActivityData_Data = 5
 const DocActivityData_SigningRequested_case case_DocActivityData_Data = 6
+const DocActivityData_StateChanged_case case_DocActivityData_Data = 7
 
 func (x *DocActivityData) WhichData() case_DocActivityData_Data {
 	if x == nil {
@@ -642,6 +687,8 @@ func (x *DocActivityData) WhichData() case_DocActivityData_Data {
 		return DocActivityData_AccessRequested_case
 	case *docActivityData_SigningRequested:
 		return DocActivityData_SigningRequested_case
+	case *docActivityData_StateChanged:
+		return DocActivityData_StateChanged_case
 	default:
 		return DocActivityData_Data_not_set_case
 	}
@@ -656,6 +703,7 @@ type DocActivityData_builder struct {
 	AccessUpdated    *DocAccessUpdated
 	AccessRequested  *DocAccessRequested
 	SigningRequested *DocSigningRequested
+	StateChanged     *DocStateChanged
 	// -- end of xxx_hidden_Data
 }
 
@@ -678,6 +726,9 @@ func (b0 DocActivityData_builder) Build() *DocActivityData {
 	if b.SigningRequested != nil {
 		x.xxx_hidden_Data = &docActivityData_SigningRequested{b.SigningRequested}
 	}
+	if b.StateChanged != nil {
+		x.xxx_hidden_Data = &docActivityData_StateChanged{b.StateChanged}
+	}
 	return m0
 }
 
@@ -715,6 +766,10 @@ type docActivityData_SigningRequested struct {
 	SigningRequested *DocSigningRequested `protobuf:"bytes,6,opt,name=signing_requested,json=signingRequested,proto3,oneof"`
 }
 
+type docActivityData_StateChanged struct {
+	StateChanged *DocStateChanged `protobuf:"bytes,7,opt,name=state_changed,json=stateChanged,proto3,oneof"`
+}
+
 func (*docActivityData_Updated) isDocActivityData_Data() {}
 
 func (*docActivityData_OwnerChanged) isDocActivityData_Data() {}
@@ -725,6 +780,8 @@ func (*docActivityData_AccessRequested) isDocActivityData_Data() {}
 
 func (*docActivityData_SigningRequested) isDocActivityData_Data() {}
 
+func (*docActivityData_StateChanged) isDocActivityData_Data() {}
+
 type DocUpdated struct {
 	state                   protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_TitleDiff    *string                `protobuf:"bytes,1,opt,name=title_diff,json=titleDiff,proto3,oneof"`
@@ -969,6 +1026,91 @@ func (b0 DocUpdated_builder) Build() *DocUpdated {
 	return m0
 }
 
+type DocStateChanged struct {
+	state                protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_FromState string                           `protobuf:"bytes,1,opt,name=from_state,json=fromState,proto3"`
+	xxx_hidden_ToState   string                           `protobuf:"bytes,2,opt,name=to_state,json=toState,proto3"`
+	xxx_hidden_Effects   []category.StateTransitionEffect `protobuf:"varint,3,rep,packed,name=effects,proto3,enum=resources.documents.category.StateTransitionEffect"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DocStateChanged) Reset() {
+	*x = DocStateChanged{}
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocStateChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocStateChanged) ProtoMessage() {}
+
+func (x *DocStateChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DocStateChanged) GetFromState() string {
+	if x != nil {
+		return x.xxx_hidden_FromState
+	}
+	return ""
+}
+
+func (x *DocStateChanged) GetToState() string {
+	if x != nil {
+		return x.xxx_hidden_ToState
+	}
+	return ""
+}
+
+func (x *DocStateChanged) GetEffects() []category.StateTransitionEffect {
+	if x != nil {
+		return x.xxx_hidden_Effects
+	}
+	return nil
+}
+
+func (x *DocStateChanged) SetFromState(v string) {
+	x.xxx_hidden_FromState = v
+}
+
+func (x *DocStateChanged) SetToState(v string) {
+	x.xxx_hidden_ToState = v
+}
+
+func (x *DocStateChanged) SetEffects(v []category.StateTransitionEffect) {
+	x.xxx_hidden_Effects = v
+}
+
+type DocStateChanged_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	FromState string
+	ToState   string
+	Effects   []category.StateTransitionEffect
+}
+
+func (b0 DocStateChanged_builder) Build() *DocStateChanged {
+	m0 := &DocStateChanged{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_FromState = b.FromState
+	x.xxx_hidden_ToState = b.ToState
+	x.xxx_hidden_Effects = b.Effects
+	return m0
+}
+
 type DocFilesChange struct {
 	state              protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Added   int64                  `protobuf:"varint,1,opt,name=added,proto3"`
@@ -979,7 +1121,7 @@ type DocFilesChange struct {
 
 func (x *DocFilesChange) Reset() {
 	*x = DocFilesChange{}
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[3]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -991,7 +1133,7 @@ func (x *DocFilesChange) String() string {
 func (*DocFilesChange) ProtoMessage() {}
 
 func (x *DocFilesChange) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[3]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,7 +1192,7 @@ type DocOwnerChanged struct {
 
 func (x *DocOwnerChanged) Reset() {
 	*x = DocOwnerChanged{}
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[4]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1062,7 +1204,7 @@ func (x *DocOwnerChanged) String() string {
 func (*DocOwnerChanged) ProtoMessage() {}
 
 func (x *DocOwnerChanged) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[4]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1273,7 @@ type DocAccessRequested struct {
 
 func (x *DocAccessRequested) Reset() {
 	*x = DocAccessRequested{}
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[5]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1143,7 +1285,7 @@ func (x *DocAccessRequested) String() string {
 func (*DocAccessRequested) ProtoMessage() {}
 
 func (x *DocAccessRequested) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[5]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1331,7 @@ type DocAccessUpdated struct {
 
 func (x *DocAccessUpdated) Reset() {
 	*x = DocAccessUpdated{}
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[6]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1201,7 +1343,7 @@ func (x *DocAccessUpdated) String() string {
 func (*DocAccessUpdated) ProtoMessage() {}
 
 func (x *DocAccessUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[6]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,7 +1425,7 @@ type DocAccessJobsDiff struct {
 
 func (x *DocAccessJobsDiff) Reset() {
 	*x = DocAccessJobsDiff{}
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[7]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1295,7 +1437,7 @@ func (x *DocAccessJobsDiff) String() string {
 func (*DocAccessJobsDiff) ProtoMessage() {}
 
 func (x *DocAccessJobsDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[7]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1516,7 @@ type DocAccessUsersDiff struct {
 
 func (x *DocAccessUsersDiff) Reset() {
 	*x = DocAccessUsersDiff{}
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[8]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1386,7 +1528,7 @@ func (x *DocAccessUsersDiff) String() string {
 func (*DocAccessUsersDiff) ProtoMessage() {}
 
 func (x *DocAccessUsersDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[8]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,7 +1606,7 @@ type DocSigningRequested struct {
 
 func (x *DocSigningRequested) Reset() {
 	*x = DocSigningRequested{}
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[9]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1476,7 +1618,7 @@ func (x *DocSigningRequested) String() string {
 func (*DocSigningRequested) ProtoMessage() {}
 
 func (x *DocSigningRequested) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_documents_activity_activity_proto_msgTypes[9]
+	mi := &file_resources_documents_activity_activity_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,7 +1684,7 @@ var File_resources_documents_activity_activity_proto protoreflect.FileDescriptor
 
 const file_resources_documents_activity_activity_proto_rawDesc = "" +
 	"\n" +
-	"+resources/documents/activity/activity.proto\x12\x1cresources.documents.activity\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a,resources/common/content/diff_activity.proto\x1a'resources/documents/access/access.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xc2\x04\n" +
+	"+resources/documents/activity/activity.proto\x12\x1cresources.documents.activity\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a,resources/common/content/diff_activity.proto\x1a'resources/documents/access/access.proto\x1a+resources/documents/category/category.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xc2\x04\n" +
 	"\vDocActivity\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12=\n" +
 	"\n" +
@@ -1563,13 +1705,14 @@ const file_resources_documents_activity_activity_proto_rawDesc = "" +
 	"\n" +
 	"\b_creatorB\x14\n" +
 	"\x12_creator_job_labelB\t\n" +
-	"\a_reason\"\xd7\x03\n" +
+	"\a_reason\"\xad\x04\n" +
 	"\x0fDocActivityData\x12D\n" +
 	"\aupdated\x18\x01 \x01(\v2(.resources.documents.activity.DocUpdatedH\x00R\aupdated\x12T\n" +
 	"\rowner_changed\x18\x02 \x01(\v2-.resources.documents.activity.DocOwnerChangedH\x00R\fownerChanged\x12W\n" +
 	"\x0eaccess_updated\x18\x04 \x01(\v2..resources.documents.activity.DocAccessUpdatedH\x00R\raccessUpdated\x12]\n" +
 	"\x10access_requested\x18\x05 \x01(\v20.resources.documents.activity.DocAccessRequestedH\x00R\x0faccessRequested\x12`\n" +
-	"\x11signing_requested\x18\x06 \x01(\v21.resources.documents.activity.DocSigningRequestedH\x00R\x10signingRequested:\x06\xe2\xf3\x18\x02\b\x01B\x06\n" +
+	"\x11signing_requested\x18\x06 \x01(\v21.resources.documents.activity.DocSigningRequestedH\x00R\x10signingRequested\x12T\n" +
+	"\rstate_changed\x18\a \x01(\v2-.resources.documents.activity.DocStateChangedH\x00R\fstateChanged:\x06\xe2\xf3\x18\x02\b\x01B\x06\n" +
 	"\x04data\"\xaf\x04\n" +
 	"\n" +
 	"DocUpdated\x12\"\n" +
@@ -1590,7 +1733,12 @@ const file_resources_documents_activity_activity_proto_rawDesc = "" +
 	"\x0e_content_cdiffB\r\n" +
 	"\v_state_diffB\x0e\n" +
 	"\f_state_cdiffB\x0f\n" +
-	"\r_files_change\"@\n" +
+	"\r_files_change\"\x9a\x01\n" +
+	"\x0fDocStateChanged\x12\x1d\n" +
+	"\n" +
+	"from_state\x18\x01 \x01(\tR\tfromState\x12\x19\n" +
+	"\bto_state\x18\x02 \x01(\tR\atoState\x12M\n" +
+	"\aeffects\x18\x03 \x03(\x0e23.resources.documents.category.StateTransitionEffectR\aeffects\"@\n" +
 	"\x0eDocFilesChange\x12\x14\n" +
 	"\x05added\x18\x01 \x01(\x03R\x05added\x12\x18\n" +
 	"\adeleted\x18\x02 \x01(\x03R\adeleted\"r\n" +
@@ -1614,7 +1762,7 @@ const file_resources_documents_activity_activity_proto_rawDesc = "" +
 	"\x13DocSigningRequested\x12?\n" +
 	"\bdeadline\x18\x01 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\bdeadline\x88\x01\x01\x12>\n" +
 	"\tapprovers\x18\x02 \x03(\v2 .resources.users.short.UserShortR\tapproversB\v\n" +
-	"\t_deadline*\xac\b\n" +
+	"\t_deadline*\xc1\t\n" +
 	"\x0fDocActivityType\x12!\n" +
 	"\x1dDOC_ACTIVITY_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19DOC_ACTIVITY_TYPE_CREATED\x10\x01\x12!\n" +
@@ -1627,6 +1775,10 @@ const file_resources_documents_activity_activity_proto_rawDesc = "" +
 	"\x1fDOC_ACTIVITY_TYPE_OWNER_CHANGED\x10\b\x12\x1d\n" +
 	"\x19DOC_ACTIVITY_TYPE_DELETED\x10\t\x12#\n" +
 	"\x1fDOC_ACTIVITY_TYPE_DRAFT_TOGGLED\x10\x13\x12#\n" +
+	"\x1fDOC_ACTIVITY_TYPE_STATE_CHANGED\x10\x17\x12$\n" +
+	" DOC_ACTIVITY_TYPE_LEGAL_HOLD_SET\x10\x18\x12(\n" +
+	"$DOC_ACTIVITY_TYPE_LEGAL_HOLD_REMOVED\x10\x19\x12\x1e\n" +
+	"\x1aDOC_ACTIVITY_TYPE_ARCHIVED\x10\x1a\x12#\n" +
 	"\x1fDOC_ACTIVITY_TYPE_COMMENT_ADDED\x10\n" +
 	"\x12%\n" +
 	"!DOC_ACTIVITY_TYPE_COMMENT_UPDATED\x10\v\x12%\n" +
@@ -1646,57 +1798,61 @@ const file_resources_documents_activity_activity_proto_rawDesc = "" +
 	"\"DOC_ACTIVITY_TYPE_APPROVAL_REMOVED\x10,\"\x04\b\x15\x10\x15BbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity;documentsactivityb\x06proto3"
 
 var file_resources_documents_activity_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_resources_documents_activity_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_resources_documents_activity_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_resources_documents_activity_activity_proto_goTypes = []any{
-	(DocActivityType)(0),        // 0: resources.documents.activity.DocActivityType
-	(*DocActivity)(nil),         // 1: resources.documents.activity.DocActivity
-	(*DocActivityData)(nil),     // 2: resources.documents.activity.DocActivityData
-	(*DocUpdated)(nil),          // 3: resources.documents.activity.DocUpdated
-	(*DocFilesChange)(nil),      // 4: resources.documents.activity.DocFilesChange
-	(*DocOwnerChanged)(nil),     // 5: resources.documents.activity.DocOwnerChanged
-	(*DocAccessRequested)(nil),  // 6: resources.documents.activity.DocAccessRequested
-	(*DocAccessUpdated)(nil),    // 7: resources.documents.activity.DocAccessUpdated
-	(*DocAccessJobsDiff)(nil),   // 8: resources.documents.activity.DocAccessJobsDiff
-	(*DocAccessUsersDiff)(nil),  // 9: resources.documents.activity.DocAccessUsersDiff
-	(*DocSigningRequested)(nil), // 10: resources.documents.activity.DocSigningRequested
-	(*timestamp.Timestamp)(nil), // 11: resources.timestamp.Timestamp
-	(*short.UserShort)(nil),     // 12: resources.users.short.UserShort
-	(*content.ContentDiff)(nil), // 13: resources.common.content.ContentDiff
-	(access.AccessLevel)(0),     // 14: resources.documents.access.AccessLevel
-	(*access1.JobAccess)(nil),   // 15: resources.access.JobAccess
-	(*access1.UserAccess)(nil),  // 16: resources.access.UserAccess
+	(DocActivityType)(0),                // 0: resources.documents.activity.DocActivityType
+	(*DocActivity)(nil),                 // 1: resources.documents.activity.DocActivity
+	(*DocActivityData)(nil),             // 2: resources.documents.activity.DocActivityData
+	(*DocUpdated)(nil),                  // 3: resources.documents.activity.DocUpdated
+	(*DocStateChanged)(nil),             // 4: resources.documents.activity.DocStateChanged
+	(*DocFilesChange)(nil),              // 5: resources.documents.activity.DocFilesChange
+	(*DocOwnerChanged)(nil),             // 6: resources.documents.activity.DocOwnerChanged
+	(*DocAccessRequested)(nil),          // 7: resources.documents.activity.DocAccessRequested
+	(*DocAccessUpdated)(nil),            // 8: resources.documents.activity.DocAccessUpdated
+	(*DocAccessJobsDiff)(nil),           // 9: resources.documents.activity.DocAccessJobsDiff
+	(*DocAccessUsersDiff)(nil),          // 10: resources.documents.activity.DocAccessUsersDiff
+	(*DocSigningRequested)(nil),         // 11: resources.documents.activity.DocSigningRequested
+	(*timestamp.Timestamp)(nil),         // 12: resources.timestamp.Timestamp
+	(*short.UserShort)(nil),             // 13: resources.users.short.UserShort
+	(*content.ContentDiff)(nil),         // 14: resources.common.content.ContentDiff
+	(category.StateTransitionEffect)(0), // 15: resources.documents.category.StateTransitionEffect
+	(access.AccessLevel)(0),             // 16: resources.documents.access.AccessLevel
+	(*access1.JobAccess)(nil),           // 17: resources.access.JobAccess
+	(*access1.UserAccess)(nil),          // 18: resources.access.UserAccess
 }
 var file_resources_documents_activity_activity_proto_depIdxs = []int32{
-	11, // 0: resources.documents.activity.DocActivity.created_at:type_name -> resources.timestamp.Timestamp
+	12, // 0: resources.documents.activity.DocActivity.created_at:type_name -> resources.timestamp.Timestamp
 	0,  // 1: resources.documents.activity.DocActivity.activity_type:type_name -> resources.documents.activity.DocActivityType
-	12, // 2: resources.documents.activity.DocActivity.creator:type_name -> resources.users.short.UserShort
+	13, // 2: resources.documents.activity.DocActivity.creator:type_name -> resources.users.short.UserShort
 	2,  // 3: resources.documents.activity.DocActivity.data:type_name -> resources.documents.activity.DocActivityData
 	3,  // 4: resources.documents.activity.DocActivityData.updated:type_name -> resources.documents.activity.DocUpdated
-	5,  // 5: resources.documents.activity.DocActivityData.owner_changed:type_name -> resources.documents.activity.DocOwnerChanged
-	7,  // 6: resources.documents.activity.DocActivityData.access_updated:type_name -> resources.documents.activity.DocAccessUpdated
-	6,  // 7: resources.documents.activity.DocActivityData.access_requested:type_name -> resources.documents.activity.DocAccessRequested
-	10, // 8: resources.documents.activity.DocActivityData.signing_requested:type_name -> resources.documents.activity.DocSigningRequested
-	13, // 9: resources.documents.activity.DocUpdated.title_cdiff:type_name -> resources.common.content.ContentDiff
-	13, // 10: resources.documents.activity.DocUpdated.content_cdiff:type_name -> resources.common.content.ContentDiff
-	13, // 11: resources.documents.activity.DocUpdated.state_cdiff:type_name -> resources.common.content.ContentDiff
-	4,  // 12: resources.documents.activity.DocUpdated.files_change:type_name -> resources.documents.activity.DocFilesChange
-	12, // 13: resources.documents.activity.DocOwnerChanged.new_owner:type_name -> resources.users.short.UserShort
-	14, // 14: resources.documents.activity.DocAccessRequested.level:type_name -> resources.documents.access.AccessLevel
-	8,  // 15: resources.documents.activity.DocAccessUpdated.jobs:type_name -> resources.documents.activity.DocAccessJobsDiff
-	9,  // 16: resources.documents.activity.DocAccessUpdated.users:type_name -> resources.documents.activity.DocAccessUsersDiff
-	15, // 17: resources.documents.activity.DocAccessJobsDiff.to_create:type_name -> resources.access.JobAccess
-	15, // 18: resources.documents.activity.DocAccessJobsDiff.to_update:type_name -> resources.access.JobAccess
-	15, // 19: resources.documents.activity.DocAccessJobsDiff.to_delete:type_name -> resources.access.JobAccess
-	16, // 20: resources.documents.activity.DocAccessUsersDiff.to_create:type_name -> resources.access.UserAccess
-	16, // 21: resources.documents.activity.DocAccessUsersDiff.to_update:type_name -> resources.access.UserAccess
-	16, // 22: resources.documents.activity.DocAccessUsersDiff.to_delete:type_name -> resources.access.UserAccess
-	11, // 23: resources.documents.activity.DocSigningRequested.deadline:type_name -> resources.timestamp.Timestamp
-	12, // 24: resources.documents.activity.DocSigningRequested.approvers:type_name -> resources.users.short.UserShort
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	6,  // 5: resources.documents.activity.DocActivityData.owner_changed:type_name -> resources.documents.activity.DocOwnerChanged
+	8,  // 6: resources.documents.activity.DocActivityData.access_updated:type_name -> resources.documents.activity.DocAccessUpdated
+	7,  // 7: resources.documents.activity.DocActivityData.access_requested:type_name -> resources.documents.activity.DocAccessRequested
+	11, // 8: resources.documents.activity.DocActivityData.signing_requested:type_name -> resources.documents.activity.DocSigningRequested
+	4,  // 9: resources.documents.activity.DocActivityData.state_changed:type_name -> resources.documents.activity.DocStateChanged
+	14, // 10: resources.documents.activity.DocUpdated.title_cdiff:type_name -> resources.common.content.ContentDiff
+	14, // 11: resources.documents.activity.DocUpdated.content_cdiff:type_name -> resources.common.content.ContentDiff
+	14, // 12: resources.documents.activity.DocUpdated.state_cdiff:type_name -> resources.common.content.ContentDiff
+	5,  // 13: resources.documents.activity.DocUpdated.files_change:type_name -> resources.documents.activity.DocFilesChange
+	15, // 14: resources.documents.activity.DocStateChanged.effects:type_name -> resources.documents.category.StateTransitionEffect
+	13, // 15: resources.documents.activity.DocOwnerChanged.new_owner:type_name -> resources.users.short.UserShort
+	16, // 16: resources.documents.activity.DocAccessRequested.level:type_name -> resources.documents.access.AccessLevel
+	9,  // 17: resources.documents.activity.DocAccessUpdated.jobs:type_name -> resources.documents.activity.DocAccessJobsDiff
+	10, // 18: resources.documents.activity.DocAccessUpdated.users:type_name -> resources.documents.activity.DocAccessUsersDiff
+	17, // 19: resources.documents.activity.DocAccessJobsDiff.to_create:type_name -> resources.access.JobAccess
+	17, // 20: resources.documents.activity.DocAccessJobsDiff.to_update:type_name -> resources.access.JobAccess
+	17, // 21: resources.documents.activity.DocAccessJobsDiff.to_delete:type_name -> resources.access.JobAccess
+	18, // 22: resources.documents.activity.DocAccessUsersDiff.to_create:type_name -> resources.access.UserAccess
+	18, // 23: resources.documents.activity.DocAccessUsersDiff.to_update:type_name -> resources.access.UserAccess
+	18, // 24: resources.documents.activity.DocAccessUsersDiff.to_delete:type_name -> resources.access.UserAccess
+	12, // 25: resources.documents.activity.DocSigningRequested.deadline:type_name -> resources.timestamp.Timestamp
+	13, // 26: resources.documents.activity.DocSigningRequested.approvers:type_name -> resources.users.short.UserShort
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_activity_activity_proto_init() }
@@ -1711,16 +1867,17 @@ func file_resources_documents_activity_activity_proto_init() {
 		(*docActivityData_AccessUpdated)(nil),
 		(*docActivityData_AccessRequested)(nil),
 		(*docActivityData_SigningRequested)(nil),
+		(*docActivityData_StateChanged)(nil),
 	}
 	file_resources_documents_activity_activity_proto_msgTypes[2].OneofWrappers = []any{}
-	file_resources_documents_activity_activity_proto_msgTypes[9].OneofWrappers = []any{}
+	file_resources_documents_activity_activity_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_activity_activity_proto_rawDesc), len(file_resources_documents_activity_activity_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
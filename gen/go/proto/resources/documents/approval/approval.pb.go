@@ -14,6 +14,7 @@ import (
 	stamps "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/stamps"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	short "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -286,8 +287,12 @@ type ApprovalPolicy struct {
 	CreatedAt          *timestamp.Timestamp   `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt          *timestamp.Timestamp   `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
 	DeletedAt          *timestamp.Timestamp   `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Set if the policy has been created from an approval policy template
+	PolicyTemplateId *int64 `protobuf:"varint,20,opt,name=policy_template_id,json=policyTemplateId,proto3,oneof" json:"policy_template_id,omitempty"`
+	// Current stage of the policy template (1-based)
+	CurrentStage  int32 `protobuf:"varint,21,opt,name=current_stage,json=currentStage,proto3" json:"current_stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApprovalPolicy) Reset() {
@@ -434,6 +439,20 @@ func (x *ApprovalPolicy) GetDeletedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *ApprovalPolicy) GetPolicyTemplateId() int64 {
+	if x != nil && x.PolicyTemplateId != nil {
+		return *x.PolicyTemplateId
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetCurrentStage() int32 {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return 0
+}
+
 func (x *ApprovalPolicy) SetDocumentId(v int64) {
 	x.DocumentId = v
 }
@@ -502,6 +521,14 @@ func (x *ApprovalPolicy) SetDeletedAt(v *timestamp.Timestamp) {
 	x.DeletedAt = v
 }
 
+func (x *ApprovalPolicy) SetPolicyTemplateId(v int64) {
+	x.PolicyTemplateId = &v
+}
+
+func (x *ApprovalPolicy) SetCurrentStage(v int32) {
+	x.CurrentStage = v
+}
+
 func (x *ApprovalPolicy) HasSnapshotDate() bool {
 	if x == nil {
 		return false
@@ -551,6 +578,13 @@ func (x *ApprovalPolicy) HasDeletedAt() bool {
 	return x.DeletedAt != nil
 }
 
+func (x *ApprovalPolicy) HasPolicyTemplateId() bool {
+	if x == nil {
+		return false
+	}
+	return x.PolicyTemplateId != nil
+}
+
 func (x *ApprovalPolicy) ClearSnapshotDate() {
 	x.SnapshotDate = nil
 }
@@ -579,6 +613,10 @@ func (x *ApprovalPolicy) ClearDeletedAt() {
 	x.DeletedAt = nil
 }
 
+func (x *ApprovalPolicy) ClearPolicyTemplateId() {
+	x.PolicyTemplateId = nil
+}
+
 type ApprovalPolicy_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -599,6 +637,10 @@ type ApprovalPolicy_builder struct {
 	CreatedAt          *timestamp.Timestamp
 	UpdatedAt          *timestamp.Timestamp
 	DeletedAt          *timestamp.Timestamp
+	// Set if the policy has been created from an approval policy template
+	PolicyTemplateId *int64
+	// Current stage of the policy template (1-based)
+	CurrentStage int32
 }
 
 func (b0 ApprovalPolicy_builder) Build() *ApprovalPolicy {
@@ -622,6 +664,8 @@ func (b0 ApprovalPolicy_builder) Build() *ApprovalPolicy {
 	x.CreatedAt = b.CreatedAt
 	x.UpdatedAt = b.UpdatedAt
 	x.DeletedAt = b.DeletedAt
+	x.PolicyTemplateId = b.PolicyTemplateId
+	x.CurrentStage = b.CurrentStage
 	return m0
 }
 
@@ -655,8 +699,12 @@ type ApprovalTask struct {
 	CreatorJob      string                   `protobuf:"bytes,24,opt,name=creator_job,json=creatorJob,proto3" json:"creator_job,omitempty"`
 	CreatorJobLabel *string                  `protobuf:"bytes,25,opt,name=creator_job_label,json=creatorJobLabel,proto3,oneof" json:"creator_job_label,omitempty"`
 	Document        *documents.DocumentShort `protobuf:"bytes,26,opt,name=document,proto3,oneof" json:"document,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Set when the task was reassigned to the absent assignee's delegate (`user_id`)
+	OriginalUserId *int32               `protobuf:"varint,27,opt,name=original_user_id,json=originalUserId,proto3,oneof" json:"original_user_id,omitempty"`
+	OriginalUser   *short.UserShort     `protobuf:"bytes,28,opt,name=original_user,json=originalUser,proto3,oneof" json:"original_user,omitempty" alias:"original_user"`
+	DelegatedAt    *timestamp.Timestamp `protobuf:"bytes,29,opt,name=delegated_at,json=delegatedAt,proto3,oneof" json:"delegated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ApprovalTask) Reset() {
@@ -859,6 +907,27 @@ func (x *ApprovalTask) GetDocument() *documents.DocumentShort {
 	return nil
 }
 
+func (x *ApprovalTask) GetOriginalUserId() int32 {
+	if x != nil && x.OriginalUserId != nil {
+		return *x.OriginalUserId
+	}
+	return 0
+}
+
+func (x *ApprovalTask) GetOriginalUser() *short.UserShort {
+	if x != nil {
+		return x.OriginalUser
+	}
+	return nil
+}
+
+func (x *ApprovalTask) GetDelegatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DelegatedAt
+	}
+	return nil
+}
+
 func (x *ApprovalTask) SetId(v int64) {
 	x.Id = v
 }
@@ -959,6 +1028,18 @@ func (x *ApprovalTask) SetDocument(v *documents.DocumentShort) {
 	x.Document = v
 }
 
+func (x *ApprovalTask) SetOriginalUserId(v int32) {
+	x.OriginalUserId = &v
+}
+
+func (x *ApprovalTask) SetOriginalUser(v *short.UserShort) {
+	x.OriginalUser = v
+}
+
+func (x *ApprovalTask) SetDelegatedAt(v *timestamp.Timestamp) {
+	x.DelegatedAt = v
+}
+
 func (x *ApprovalTask) HasSnapshotDate() bool {
 	if x == nil {
 		return false
@@ -1071,6 +1152,27 @@ func (x *ApprovalTask) HasDocument() bool {
 	return x.Document != nil
 }
 
+func (x *ApprovalTask) HasOriginalUserId() bool {
+	if x == nil {
+		return false
+	}
+	return x.OriginalUserId != nil
+}
+
+func (x *ApprovalTask) HasOriginalUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.OriginalUser != nil
+}
+
+func (x *ApprovalTask) HasDelegatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.DelegatedAt != nil
+}
+
 func (x *ApprovalTask) ClearSnapshotDate() {
 	x.SnapshotDate = nil
 }
@@ -1135,6 +1237,18 @@ func (x *ApprovalTask) ClearDocument() {
 	x.Document = nil
 }
 
+func (x *ApprovalTask) ClearOriginalUserId() {
+	x.OriginalUserId = nil
+}
+
+func (x *ApprovalTask) ClearOriginalUser() {
+	x.OriginalUser = nil
+}
+
+func (x *ApprovalTask) ClearDelegatedAt() {
+	x.DelegatedAt = nil
+}
+
 type ApprovalTask_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1166,6 +1280,10 @@ type ApprovalTask_builder struct {
 	CreatorJob      string
 	CreatorJobLabel *string
 	Document        *documents.DocumentShort
+	// Set when the task was reassigned to the absent assignee's delegate (`user_id`)
+	OriginalUserId *int32
+	OriginalUser   *short.UserShort
+	DelegatedAt    *timestamp.Timestamp
 }
 
 func (b0 ApprovalTask_builder) Build() *ApprovalTask {
@@ -1197,6 +1315,9 @@ func (b0 ApprovalTask_builder) Build() *ApprovalTask {
 	x.CreatorJob = b.CreatorJob
 	x.CreatorJobLabel = b.CreatorJobLabel
 	x.Document = b.Document
+	x.OriginalUserId = b.OriginalUserId
+	x.OriginalUser = b.OriginalUser
+	x.DelegatedAt = b.DelegatedAt
 	return m0
 }
 
@@ -1643,7 +1764,7 @@ var File_resources_documents_approval_approval_proto protoreflect.FileDescriptor
 
 const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\n" +
-	"+resources/documents/approval/approval.proto\x12\x1cresources.documents.approval\x1a!codegen/sanitizer/sanitizer.proto\x1a#resources/documents/documents.proto\x1a&resources/documents/stamps/stamp.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\"\x95\b\n" +
+	"+resources/documents/approval/approval.proto\x12\x1cresources.documents.approval\x1a!codegen/sanitizer/sanitizer.proto\x1a#resources/documents/documents.proto\x1a&resources/documents/stamps/stamp.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\x84\t\n" +
 	"\x0eApprovalPolicy\x12\x1f\n" +
 	"\vdocument_id\x18\x03 \x01(\x03R\n" +
 	"documentId\x12C\n" +
@@ -1667,13 +1788,15 @@ const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x11 \x01(\v2\x1e.resources.timestamp.TimestampH\x03R\tupdatedAt\x88\x01\x01\x12B\n" +
 	"\n" +
-	"deleted_at\x18\x12 \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\tdeletedAt\x88\x01\x01B\x11\n" +
+	"deleted_at\x18\x12 \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\tdeletedAt\x88\x01\x01\x121\n" +
+	"\x12policy_template_id\x18\x14 \x01(\x03H\x05R\x10policyTemplateId\x88\x01\x01\x12#\n" +
+	"\rcurrent_stage\x18\x15 \x01(\x05R\fcurrentStageB\x11\n" +
 	"\x0f_required_countB\r\n" +
 	"\v_started_atB\x0f\n" +
 	"\r_completed_atB\r\n" +
 	"\v_updated_atB\r\n" +
-	"\v_deleted_atJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\xf3\n" +
-	"\n" +
+	"\v_deleted_atB\x15\n" +
+	"\x13_policy_template_idJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\x8a\r\n" +
 	"\fApprovalTask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vdocument_id\x18\x03 \x01(\x03R\n" +
@@ -1706,7 +1829,10 @@ const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\vcreator_job\x18\x18 \x01(\tR\n" +
 	"creatorJob\x12/\n" +
 	"\x11creator_job_label\x18\x19 \x01(\tH\fR\x0fcreatorJobLabel\x88\x01\x01\x12C\n" +
-	"\bdocument\x18\x1a \x01(\v2\".resources.documents.DocumentShortH\rR\bdocument\x88\x01\x01B\n" +
+	"\bdocument\x18\x1a \x01(\v2\".resources.documents.DocumentShortH\rR\bdocument\x88\x01\x01\x12-\n" +
+	"\x10original_user_id\x18\x1b \x01(\x05H\x0eR\x0eoriginalUserId\x88\x01\x01\x12f\n" +
+	"\roriginal_user\x18\x1c \x01(\v2 .resources.users.short.UserShortB\x1a\x9a\x84\x9e\x03\x15alias:\"original_user\"H\x0fR\foriginalUser\x88\x01\x01\x12F\n" +
+	"\fdelegated_at\x18\x1d \x01(\v2\x1e.resources.timestamp.TimestampH\x10R\vdelegatedAt\x88\x01\x01B\n" +
 	"\n" +
 	"\b_user_idB\a\n" +
 	"\x05_userB\x06\n" +
@@ -1724,7 +1850,10 @@ const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\n" +
 	"\b_creatorB\x14\n" +
 	"\x12_creator_job_labelB\v\n" +
-	"\t_documentJ\x04\b\x02\x10\x03\"\xcb\a\n" +
+	"\t_documentB\x13\n" +
+	"\x11_original_user_idB\x10\n" +
+	"\x0e_original_userB\x0f\n" +
+	"\r_delegated_atJ\x04\b\x02\x10\x03\"\xcb\a\n" +
 	"\bApproval\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vdocument_id\x18\x03 \x01(\x03R\n" +
@@ -1825,17 +1954,19 @@ var file_resources_documents_approval_approval_proto_depIdxs = []int32{
 	8,  // 14: resources.documents.approval.ApprovalTask.due_at:type_name -> resources.timestamp.Timestamp
 	9,  // 15: resources.documents.approval.ApprovalTask.creator:type_name -> resources.users.short.UserShort
 	10, // 16: resources.documents.approval.ApprovalTask.document:type_name -> resources.documents.DocumentShort
-	8,  // 17: resources.documents.approval.Approval.snapshot_date:type_name -> resources.timestamp.Timestamp
-	9,  // 18: resources.documents.approval.Approval.user:type_name -> resources.users.short.UserShort
-	11, // 19: resources.documents.approval.Approval.stamp:type_name -> resources.documents.stamps.Stamp
-	4,  // 20: resources.documents.approval.Approval.status:type_name -> resources.documents.approval.ApprovalStatus
-	8,  // 21: resources.documents.approval.Approval.created_at:type_name -> resources.timestamp.Timestamp
-	8,  // 22: resources.documents.approval.Approval.revoked_at:type_name -> resources.timestamp.Timestamp
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	9,  // 17: resources.documents.approval.ApprovalTask.original_user:type_name -> resources.users.short.UserShort
+	8,  // 18: resources.documents.approval.ApprovalTask.delegated_at:type_name -> resources.timestamp.Timestamp
+	8,  // 19: resources.documents.approval.Approval.snapshot_date:type_name -> resources.timestamp.Timestamp
+	9,  // 20: resources.documents.approval.Approval.user:type_name -> resources.users.short.UserShort
+	11, // 21: resources.documents.approval.Approval.stamp:type_name -> resources.documents.stamps.Stamp
+	4,  // 22: resources.documents.approval.Approval.status:type_name -> resources.documents.approval.ApprovalStatus
+	8,  // 23: resources.documents.approval.Approval.created_at:type_name -> resources.timestamp.Timestamp
+	8,  // 24: resources.documents.approval.Approval.revoked_at:type_name -> resources.timestamp.Timestamp
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_approval_approval_proto_init() }
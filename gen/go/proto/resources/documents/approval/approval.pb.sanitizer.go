@@ -198,6 +198,15 @@ func (m *ApprovalTask) Sanitize() error {
 		*m.CreatorJobLabel = htmlsanitizer.SanitizeAndUnescape(*m.CreatorJobLabel)
 	}
 
+	// Field: DelegatedAt
+	if m.DelegatedAt != nil {
+		if v, ok := any(m.GetDelegatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: Document
 	if m.Document != nil {
 		if v, ok := any(m.GetDocument()).(interface{ Sanitize() error }); ok {
@@ -236,6 +245,15 @@ func (m *ApprovalTask) Sanitize() error {
 		*m.Label = htmlsanitizer.StripHTMLTags(*m.Label)
 	}
 
+	// Field: OriginalUser
+	if m.OriginalUser != nil {
+		if v, ok := any(m.GetOriginalUser()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: SnapshotDate
 	if m.SnapshotDate != nil {
 		if v, ok := any(m.GetSnapshotDate()).(interface{ Sanitize() error }); ok {
@@ -14,6 +14,7 @@ import (
 	stamps "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/stamps"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	short "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -286,6 +287,8 @@ type ApprovalPolicy struct {
 	xxx_hidden_CreatedAt          *timestamp.Timestamp   `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3"`
 	xxx_hidden_UpdatedAt          *timestamp.Timestamp   `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3,oneof"`
 	xxx_hidden_DeletedAt          *timestamp.Timestamp   `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3,oneof"`
+	xxx_hidden_PolicyTemplateId   int64                  `protobuf:"varint,20,opt,name=policy_template_id,json=policyTemplateId,proto3,oneof"`
+	xxx_hidden_CurrentStage       int32                  `protobuf:"varint,21,opt,name=current_stage,json=currentStage,proto3"`
 	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
 	XXX_presence                  [1]uint32
 	unknownFields                 protoimpl.UnknownFields
@@ -436,6 +439,20 @@ func (x *ApprovalPolicy) GetDeletedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *ApprovalPolicy) GetPolicyTemplateId() int64 {
+	if x != nil {
+		return x.xxx_hidden_PolicyTemplateId
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetCurrentStage() int32 {
+	if x != nil {
+		return x.xxx_hidden_CurrentStage
+	}
+	return 0
+}
+
 func (x *ApprovalPolicy) SetDocumentId(v int64) {
 	x.xxx_hidden_DocumentId = v
 }
@@ -454,7 +471,7 @@ func (x *ApprovalPolicy) SetRuleKind(v ApprovalRuleKind) {
 
 func (x *ApprovalPolicy) SetRequiredCount(v int32) {
 	x.xxx_hidden_RequiredCount = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 17)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 19)
 }
 
 func (x *ApprovalPolicy) SetSignatureRequired(v bool) {
@@ -505,6 +522,15 @@ func (x *ApprovalPolicy) SetDeletedAt(v *timestamp.Timestamp) {
 	x.xxx_hidden_DeletedAt = v
 }
 
+func (x *ApprovalPolicy) SetPolicyTemplateId(v int64) {
+	x.xxx_hidden_PolicyTemplateId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 19)
+}
+
+func (x *ApprovalPolicy) SetCurrentStage(v int32) {
+	x.xxx_hidden_CurrentStage = v
+}
+
 func (x *ApprovalPolicy) HasSnapshotDate() bool {
 	if x == nil {
 		return false
@@ -554,6 +580,13 @@ func (x *ApprovalPolicy) HasDeletedAt() bool {
 	return x.xxx_hidden_DeletedAt != nil
 }
 
+func (x *ApprovalPolicy) HasPolicyTemplateId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
 func (x *ApprovalPolicy) ClearSnapshotDate() {
 	x.xxx_hidden_SnapshotDate = nil
 }
@@ -583,6 +616,11 @@ func (x *ApprovalPolicy) ClearDeletedAt() {
 	x.xxx_hidden_DeletedAt = nil
 }
 
+func (x *ApprovalPolicy) ClearPolicyTemplateId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_PolicyTemplateId = 0
+}
+
 type ApprovalPolicy_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -603,6 +641,10 @@ type ApprovalPolicy_builder struct {
 	CreatedAt          *timestamp.Timestamp
 	UpdatedAt          *timestamp.Timestamp
 	DeletedAt          *timestamp.Timestamp
+	// Set if the policy has been created from an approval policy template
+	PolicyTemplateId *int64
+	// Current stage of the policy template (1-based)
+	CurrentStage int32
 }
 
 func (b0 ApprovalPolicy_builder) Build() *ApprovalPolicy {
@@ -614,7 +656,7 @@ func (b0 ApprovalPolicy_builder) Build() *ApprovalPolicy {
 	x.xxx_hidden_OnEditBehavior = b.OnEditBehavior
 	x.xxx_hidden_RuleKind = b.RuleKind
 	if b.RequiredCount != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 17)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 19)
 		x.xxx_hidden_RequiredCount = *b.RequiredCount
 	}
 	x.xxx_hidden_SignatureRequired = b.SignatureRequired
@@ -629,6 +671,11 @@ func (b0 ApprovalPolicy_builder) Build() *ApprovalPolicy {
 	x.xxx_hidden_CreatedAt = b.CreatedAt
 	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	x.xxx_hidden_DeletedAt = b.DeletedAt
+	if b.PolicyTemplateId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 19)
+		x.xxx_hidden_PolicyTemplateId = *b.PolicyTemplateId
+	}
+	x.xxx_hidden_CurrentStage = b.CurrentStage
 	return m0
 }
 
@@ -659,6 +706,9 @@ type ApprovalTask struct {
 	xxx_hidden_CreatorJob        string                   `protobuf:"bytes,24,opt,name=creator_job,json=creatorJob,proto3"`
 	xxx_hidden_CreatorJobLabel   *string                  `protobuf:"bytes,25,opt,name=creator_job_label,json=creatorJobLabel,proto3,oneof"`
 	xxx_hidden_Document          *documents.DocumentShort `protobuf:"bytes,26,opt,name=document,proto3,oneof"`
+	xxx_hidden_OriginalUserId    int32                    `protobuf:"varint,27,opt,name=original_user_id,json=originalUserId,proto3,oneof"`
+	xxx_hidden_OriginalUser      *short.UserShort         `protobuf:"bytes,28,opt,name=original_user,json=originalUser,proto3,oneof"`
+	xxx_hidden_DelegatedAt       *timestamp.Timestamp     `protobuf:"bytes,29,opt,name=delegated_at,json=delegatedAt,proto3,oneof"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
 	unknownFields                protoimpl.UnknownFields
@@ -883,6 +933,27 @@ func (x *ApprovalTask) GetDocument() *documents.DocumentShort {
 	return nil
 }
 
+func (x *ApprovalTask) GetOriginalUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_OriginalUserId
+	}
+	return 0
+}
+
+func (x *ApprovalTask) GetOriginalUser() *short.UserShort {
+	if x != nil {
+		return x.xxx_hidden_OriginalUser
+	}
+	return nil
+}
+
+func (x *ApprovalTask) GetDelegatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DelegatedAt
+	}
+	return nil
+}
+
 func (x *ApprovalTask) SetId(v int64) {
 	x.xxx_hidden_Id = v
 }
@@ -901,7 +972,7 @@ func (x *ApprovalTask) SetAssigneeKind(v ApprovalAssigneeKind) {
 
 func (x *ApprovalTask) SetUserId(v int32) {
 	x.xxx_hidden_UserId = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 28)
 }
 
 func (x *ApprovalTask) SetUser(v *short.UserShort) {
@@ -910,27 +981,27 @@ func (x *ApprovalTask) SetUser(v *short.UserShort) {
 
 func (x *ApprovalTask) SetJob(v string) {
 	x.xxx_hidden_Job = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 28)
 }
 
 func (x *ApprovalTask) SetJobLabel(v string) {
 	x.xxx_hidden_JobLabel = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 28)
 }
 
 func (x *ApprovalTask) SetMinimumGrade(v int32) {
 	x.xxx_hidden_MinimumGrade = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 28)
 }
 
 func (x *ApprovalTask) SetJobGradeLabel(v string) {
 	x.xxx_hidden_JobGradeLabel = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 28)
 }
 
 func (x *ApprovalTask) SetLabel(v string) {
 	x.xxx_hidden_Label = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 28)
 }
 
 func (x *ApprovalTask) SetSignatureRequired(v bool) {
@@ -947,7 +1018,7 @@ func (x *ApprovalTask) SetStatus(v ApprovalTaskStatus) {
 
 func (x *ApprovalTask) SetComment(v string) {
 	x.xxx_hidden_Comment = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 28)
 }
 
 func (x *ApprovalTask) SetCreatedAt(v *timestamp.Timestamp) {
@@ -968,7 +1039,7 @@ func (x *ApprovalTask) SetDecisionCount(v int32) {
 
 func (x *ApprovalTask) SetApprovalId(v int64) {
 	x.xxx_hidden_ApprovalId = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 28)
 }
 
 func (x *ApprovalTask) SetCreatorId(v int32) {
@@ -985,13 +1056,26 @@ func (x *ApprovalTask) SetCreatorJob(v string) {
 
 func (x *ApprovalTask) SetCreatorJobLabel(v string) {
 	x.xxx_hidden_CreatorJobLabel = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 23, 25)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 23, 28)
 }
 
 func (x *ApprovalTask) SetDocument(v *documents.DocumentShort) {
 	x.xxx_hidden_Document = v
 }
 
+func (x *ApprovalTask) SetOriginalUserId(v int32) {
+	x.xxx_hidden_OriginalUserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 25, 28)
+}
+
+func (x *ApprovalTask) SetOriginalUser(v *short.UserShort) {
+	x.xxx_hidden_OriginalUser = v
+}
+
+func (x *ApprovalTask) SetDelegatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_DelegatedAt = v
+}
+
 func (x *ApprovalTask) HasSnapshotDate() bool {
 	if x == nil {
 		return false
@@ -1104,6 +1188,27 @@ func (x *ApprovalTask) HasDocument() bool {
 	return x.xxx_hidden_Document != nil
 }
 
+func (x *ApprovalTask) HasOriginalUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 25)
+}
+
+func (x *ApprovalTask) HasOriginalUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_OriginalUser != nil
+}
+
+func (x *ApprovalTask) HasDelegatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DelegatedAt != nil
+}
+
 func (x *ApprovalTask) ClearSnapshotDate() {
 	x.xxx_hidden_SnapshotDate = nil
 }
@@ -1177,6 +1282,19 @@ func (x *ApprovalTask) ClearDocument() {
 	x.xxx_hidden_Document = nil
 }
 
+func (x *ApprovalTask) ClearOriginalUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 25)
+	x.xxx_hidden_OriginalUserId = 0
+}
+
+func (x *ApprovalTask) ClearOriginalUser() {
+	x.xxx_hidden_OriginalUser = nil
+}
+
+func (x *ApprovalTask) ClearDelegatedAt() {
+	x.xxx_hidden_DelegatedAt = nil
+}
+
 type ApprovalTask_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1208,6 +1326,10 @@ type ApprovalTask_builder struct {
 	CreatorJob      string
 	CreatorJobLabel *string
 	Document        *documents.DocumentShort
+	// Set when the task was reassigned to the absent assignee's delegate (`user_id`)
+	OriginalUserId *int32
+	OriginalUser   *short.UserShort
+	DelegatedAt    *timestamp.Timestamp
 }
 
 func (b0 ApprovalTask_builder) Build() *ApprovalTask {
@@ -1219,35 +1341,35 @@ func (b0 ApprovalTask_builder) Build() *ApprovalTask {
 	x.xxx_hidden_SnapshotDate = b.SnapshotDate
 	x.xxx_hidden_AssigneeKind = b.AssigneeKind
 	if b.UserId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 28)
 		x.xxx_hidden_UserId = *b.UserId
 	}
 	x.xxx_hidden_User = b.User
 	if b.Job != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 28)
 		x.xxx_hidden_Job = b.Job
 	}
 	if b.JobLabel != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 28)
 		x.xxx_hidden_JobLabel = b.JobLabel
 	}
 	if b.MinimumGrade != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 28)
 		x.xxx_hidden_MinimumGrade = *b.MinimumGrade
 	}
 	if b.JobGradeLabel != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 28)
 		x.xxx_hidden_JobGradeLabel = b.JobGradeLabel
 	}
 	if b.Label != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 28)
 		x.xxx_hidden_Label = b.Label
 	}
 	x.xxx_hidden_SignatureRequired = b.SignatureRequired
 	x.xxx_hidden_SlotNo = b.SlotNo
 	x.xxx_hidden_Status = b.Status
 	if b.Comment != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 28)
 		x.xxx_hidden_Comment = b.Comment
 	}
 	x.xxx_hidden_CreatedAt = b.CreatedAt
@@ -1255,17 +1377,23 @@ func (b0 ApprovalTask_builder) Build() *ApprovalTask {
 	x.xxx_hidden_DueAt = b.DueAt
 	x.xxx_hidden_DecisionCount = b.DecisionCount
 	if b.ApprovalId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 28)
 		x.xxx_hidden_ApprovalId = *b.ApprovalId
 	}
 	x.xxx_hidden_CreatorId = b.CreatorId
 	x.xxx_hidden_Creator = b.Creator
 	x.xxx_hidden_CreatorJob = b.CreatorJob
 	if b.CreatorJobLabel != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 23, 25)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 23, 28)
 		x.xxx_hidden_CreatorJobLabel = b.CreatorJobLabel
 	}
 	x.xxx_hidden_Document = b.Document
+	if b.OriginalUserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 25, 28)
+		x.xxx_hidden_OriginalUserId = *b.OriginalUserId
+	}
+	x.xxx_hidden_OriginalUser = b.OriginalUser
+	x.xxx_hidden_DelegatedAt = b.DelegatedAt
 	return m0
 }
 
@@ -1772,7 +1900,7 @@ var File_resources_documents_approval_approval_proto protoreflect.FileDescriptor
 
 const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\n" +
-	"+resources/documents/approval/approval.proto\x12\x1cresources.documents.approval\x1a!codegen/sanitizer/sanitizer.proto\x1a#resources/documents/documents.proto\x1a&resources/documents/stamps/stamp.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\"\x95\b\n" +
+	"+resources/documents/approval/approval.proto\x12\x1cresources.documents.approval\x1a!codegen/sanitizer/sanitizer.proto\x1a#resources/documents/documents.proto\x1a&resources/documents/stamps/stamp.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\x84\t\n" +
 	"\x0eApprovalPolicy\x12\x1f\n" +
 	"\vdocument_id\x18\x03 \x01(\x03R\n" +
 	"documentId\x12C\n" +
@@ -1796,13 +1924,15 @@ const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x11 \x01(\v2\x1e.resources.timestamp.TimestampH\x03R\tupdatedAt\x88\x01\x01\x12B\n" +
 	"\n" +
-	"deleted_at\x18\x12 \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\tdeletedAt\x88\x01\x01B\x11\n" +
+	"deleted_at\x18\x12 \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\tdeletedAt\x88\x01\x01\x121\n" +
+	"\x12policy_template_id\x18\x14 \x01(\x03H\x05R\x10policyTemplateId\x88\x01\x01\x12#\n" +
+	"\rcurrent_stage\x18\x15 \x01(\x05R\fcurrentStageB\x11\n" +
 	"\x0f_required_countB\r\n" +
 	"\v_started_atB\x0f\n" +
 	"\r_completed_atB\r\n" +
 	"\v_updated_atB\r\n" +
-	"\v_deleted_atJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\xf3\n" +
-	"\n" +
+	"\v_deleted_atB\x15\n" +
+	"\x13_policy_template_idJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\x8a\r\n" +
 	"\fApprovalTask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vdocument_id\x18\x03 \x01(\x03R\n" +
@@ -1835,7 +1965,10 @@ const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\vcreator_job\x18\x18 \x01(\tR\n" +
 	"creatorJob\x12/\n" +
 	"\x11creator_job_label\x18\x19 \x01(\tH\fR\x0fcreatorJobLabel\x88\x01\x01\x12C\n" +
-	"\bdocument\x18\x1a \x01(\v2\".resources.documents.DocumentShortH\rR\bdocument\x88\x01\x01B\n" +
+	"\bdocument\x18\x1a \x01(\v2\".resources.documents.DocumentShortH\rR\bdocument\x88\x01\x01\x12-\n" +
+	"\x10original_user_id\x18\x1b \x01(\x05H\x0eR\x0eoriginalUserId\x88\x01\x01\x12f\n" +
+	"\roriginal_user\x18\x1c \x01(\v2 .resources.users.short.UserShortB\x1a\x9a\x84\x9e\x03\x15alias:\"original_user\"H\x0fR\foriginalUser\x88\x01\x01\x12F\n" +
+	"\fdelegated_at\x18\x1d \x01(\v2\x1e.resources.timestamp.TimestampH\x10R\vdelegatedAt\x88\x01\x01B\n" +
 	"\n" +
 	"\b_user_idB\a\n" +
 	"\x05_userB\x06\n" +
@@ -1853,7 +1986,10 @@ const file_resources_documents_approval_approval_proto_rawDesc = "" +
 	"\n" +
 	"\b_creatorB\x14\n" +
 	"\x12_creator_job_labelB\v\n" +
-	"\t_documentJ\x04\b\x02\x10\x03\"\xcb\a\n" +
+	"\t_documentB\x13\n" +
+	"\x11_original_user_idB\x10\n" +
+	"\x0e_original_userB\x0f\n" +
+	"\r_delegated_atJ\x04\b\x02\x10\x03\"\xcb\a\n" +
 	"\bApproval\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vdocument_id\x18\x03 \x01(\x03R\n" +
@@ -1954,17 +2090,19 @@ var file_resources_documents_approval_approval_proto_depIdxs = []int32{
 	8,  // 14: resources.documents.approval.ApprovalTask.due_at:type_name -> resources.timestamp.Timestamp
 	9,  // 15: resources.documents.approval.ApprovalTask.creator:type_name -> resources.users.short.UserShort
 	10, // 16: resources.documents.approval.ApprovalTask.document:type_name -> resources.documents.DocumentShort
-	8,  // 17: resources.documents.approval.Approval.snapshot_date:type_name -> resources.timestamp.Timestamp
-	9,  // 18: resources.documents.approval.Approval.user:type_name -> resources.users.short.UserShort
-	11, // 19: resources.documents.approval.Approval.stamp:type_name -> resources.documents.stamps.Stamp
-	4,  // 20: resources.documents.approval.Approval.status:type_name -> resources.documents.approval.ApprovalStatus
-	8,  // 21: resources.documents.approval.Approval.created_at:type_name -> resources.timestamp.Timestamp
-	8,  // 22: resources.documents.approval.Approval.revoked_at:type_name -> resources.timestamp.Timestamp
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	9,  // 17: resources.documents.approval.ApprovalTask.original_user:type_name -> resources.users.short.UserShort
+	8,  // 18: resources.documents.approval.ApprovalTask.delegated_at:type_name -> resources.timestamp.Timestamp
+	8,  // 19: resources.documents.approval.Approval.snapshot_date:type_name -> resources.timestamp.Timestamp
+	9,  // 20: resources.documents.approval.Approval.user:type_name -> resources.users.short.UserShort
+	11, // 21: resources.documents.approval.Approval.stamp:type_name -> resources.documents.stamps.Stamp
+	4,  // 22: resources.documents.approval.Approval.status:type_name -> resources.documents.approval.ApprovalStatus
+	8,  // 23: resources.documents.approval.Approval.created_at:type_name -> resources.timestamp.Timestamp
+	8,  // 24: resources.documents.approval.Approval.revoked_at:type_name -> resources.timestamp.Timestamp
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_approval_approval_proto_init() }
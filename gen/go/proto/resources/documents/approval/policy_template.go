@@ -0,0 +1,67 @@
+package documentsapproval
+
+import (
+	"errors"
+
+	documentsdata "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+)
+
+// Validate checks that the policy template is bound to a category or template and that templates
+// with multiple stages require all tasks to be approved.
+func (x *ApprovalPolicyTemplate) Validate() error {
+	if x.GetCategoryId() <= 0 && x.GetTemplateId() <= 0 {
+		return errors.New("policy template must be bound to a category or template")
+	}
+
+	if len(x.GetStages().GetStages()) > 1 &&
+		x.GetRuleKind() == ApprovalRuleKind_APPROVAL_RULE_KIND_QUORUM_ANY {
+		return errors.New("policy templates with multiple stages must require all approvals")
+	}
+
+	return nil
+}
+
+// NextStage returns the first stage after the given (1-based) stage number whose conditions match
+// the document's data. Returns 0 and nil if there is no further stage.
+func (x *ApprovalPolicyTemplate) NextStage(
+	after int32,
+	data *documentsdata.DocumentData,
+) (int32, *ApprovalStage) {
+	stages := x.GetStages().GetStages()
+	for idx := max(int(after), 0); idx < len(stages); idx++ {
+		if stages[idx].Matches(data) {
+			//nolint:gosec // G115: there can't be more than 5 stages due to API validation
+			return int32(idx + 1), stages[idx]
+		}
+	}
+
+	return 0, nil
+}
+
+// Matches returns true if all conditions of the stage match the document's data.
+func (x *ApprovalStage) Matches(data *documentsdata.DocumentData) bool {
+	for _, condition := range x.GetConditions() {
+		if !condition.Matches(data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Matches evaluates the condition against the document's data.
+func (x *ApprovalCondition) Matches(data *documentsdata.DocumentData) bool {
+	switch c := x.GetCondition().(type) {
+	case *ApprovalCondition_Field:
+		return c.Field.Matches(data.GetFields())
+
+	case *ApprovalCondition_PenaltyFine:
+		total := data.GetPenaltyCalculator().GetTotal()
+		if total == nil || total.Fine == nil {
+			return false
+		}
+		return c.PenaltyFine.Contains(float64(total.GetFine()))
+	}
+
+	return true
+}
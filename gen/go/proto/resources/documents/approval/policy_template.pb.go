@@ -0,0 +1,1066 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/documents/approval/policy_template.proto
+
+//go:build !protoopaque
+
+package documentsapproval
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/dbscanner"
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
+	forms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Reusable approval policy which is applied to documents of a category or template automatically
+// when they are published or enter the trigger state.
+type ApprovalPolicyTemplate struct {
+	state       protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	DeletedAt   *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	Job         string                 `protobuf:"bytes,5,opt,name=job,proto3" json:"job,omitempty"`
+	CreatorId   *int32                 `protobuf:"varint,6,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
+	Name        string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Policy templates bound to a document template take precedence over category bound ones
+	CategoryId *int64 `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	TemplateId *int64 `protobuf:"varint,10,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	// State (of the category's state machine) which starts the approval, unset = on publish
+	TriggerState       *string          `protobuf:"bytes,11,opt,name=trigger_state,json=triggerState,proto3,oneof" json:"trigger_state,omitempty"`
+	RuleKind           ApprovalRuleKind `protobuf:"varint,12,opt,name=rule_kind,json=ruleKind,proto3,enum=resources.documents.approval.ApprovalRuleKind" json:"rule_kind,omitempty"`
+	OnEditBehavior     OnEditBehavior   `protobuf:"varint,13,opt,name=on_edit_behavior,json=onEditBehavior,proto3,enum=resources.documents.approval.OnEditBehavior" json:"on_edit_behavior,omitempty"`
+	RequiredCount      *int32           `protobuf:"varint,14,opt,name=required_count,json=requiredCount,proto3,oneof" json:"required_count,omitempty"`
+	SignatureRequired  bool             `protobuf:"varint,15,opt,name=signature_required,json=signatureRequired,proto3" json:"signature_required,omitempty"`
+	SelfApproveAllowed bool             `protobuf:"varint,16,opt,name=self_approve_allowed,json=selfApproveAllowed,proto3" json:"self_approve_allowed,omitempty"`
+	Stages             *ApprovalStages  `protobuf:"bytes,17,opt,name=stages,proto3" json:"stages,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ApprovalPolicyTemplate) Reset() {
+	*x = ApprovalPolicyTemplate{}
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalPolicyTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalPolicyTemplate) ProtoMessage() {}
+
+func (x *ApprovalPolicyTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApprovalPolicyTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApprovalPolicyTemplate) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApprovalPolicyTemplate) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ApprovalPolicyTemplate) GetDeletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ApprovalPolicyTemplate) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *ApprovalPolicyTemplate) GetCreatorId() int32 {
+	if x != nil && x.CreatorId != nil {
+		return *x.CreatorId
+	}
+	return 0
+}
+
+func (x *ApprovalPolicyTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApprovalPolicyTemplate) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ApprovalPolicyTemplate) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *ApprovalPolicyTemplate) GetTemplateId() int64 {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return 0
+}
+
+func (x *ApprovalPolicyTemplate) GetTriggerState() string {
+	if x != nil && x.TriggerState != nil {
+		return *x.TriggerState
+	}
+	return ""
+}
+
+func (x *ApprovalPolicyTemplate) GetRuleKind() ApprovalRuleKind {
+	if x != nil {
+		return x.RuleKind
+	}
+	return ApprovalRuleKind_APPROVAL_RULE_KIND_UNSPECIFIED
+}
+
+func (x *ApprovalPolicyTemplate) GetOnEditBehavior() OnEditBehavior {
+	if x != nil {
+		return x.OnEditBehavior
+	}
+	return OnEditBehavior_ON_EDIT_BEHAVIOR_UNSPECIFIED
+}
+
+func (x *ApprovalPolicyTemplate) GetRequiredCount() int32 {
+	if x != nil && x.RequiredCount != nil {
+		return *x.RequiredCount
+	}
+	return 0
+}
+
+func (x *ApprovalPolicyTemplate) GetSignatureRequired() bool {
+	if x != nil {
+		return x.SignatureRequired
+	}
+	return false
+}
+
+func (x *ApprovalPolicyTemplate) GetSelfApproveAllowed() bool {
+	if x != nil {
+		return x.SelfApproveAllowed
+	}
+	return false
+}
+
+func (x *ApprovalPolicyTemplate) GetStages() *ApprovalStages {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *ApprovalPolicyTemplate) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *ApprovalPolicyTemplate) SetCreatedAt(v *timestamp.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *ApprovalPolicyTemplate) SetUpdatedAt(v *timestamp.Timestamp) {
+	x.UpdatedAt = v
+}
+
+func (x *ApprovalPolicyTemplate) SetDeletedAt(v *timestamp.Timestamp) {
+	x.DeletedAt = v
+}
+
+func (x *ApprovalPolicyTemplate) SetJob(v string) {
+	x.Job = v
+}
+
+func (x *ApprovalPolicyTemplate) SetCreatorId(v int32) {
+	x.CreatorId = &v
+}
+
+func (x *ApprovalPolicyTemplate) SetName(v string) {
+	x.Name = v
+}
+
+func (x *ApprovalPolicyTemplate) SetDescription(v string) {
+	x.Description = &v
+}
+
+func (x *ApprovalPolicyTemplate) SetCategoryId(v int64) {
+	x.CategoryId = &v
+}
+
+func (x *ApprovalPolicyTemplate) SetTemplateId(v int64) {
+	x.TemplateId = &v
+}
+
+func (x *ApprovalPolicyTemplate) SetTriggerState(v string) {
+	x.TriggerState = &v
+}
+
+func (x *ApprovalPolicyTemplate) SetRuleKind(v ApprovalRuleKind) {
+	x.RuleKind = v
+}
+
+func (x *ApprovalPolicyTemplate) SetOnEditBehavior(v OnEditBehavior) {
+	x.OnEditBehavior = v
+}
+
+func (x *ApprovalPolicyTemplate) SetRequiredCount(v int32) {
+	x.RequiredCount = &v
+}
+
+func (x *ApprovalPolicyTemplate) SetSignatureRequired(v bool) {
+	x.SignatureRequired = v
+}
+
+func (x *ApprovalPolicyTemplate) SetSelfApproveAllowed(v bool) {
+	x.SelfApproveAllowed = v
+}
+
+func (x *ApprovalPolicyTemplate) SetStages(v *ApprovalStages) {
+	x.Stages = v
+}
+
+func (x *ApprovalPolicyTemplate) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.UpdatedAt != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasDeletedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.DeletedAt != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasCreatorId() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatorId != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return x.Description != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return x.CategoryId != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasTemplateId() bool {
+	if x == nil {
+		return false
+	}
+	return x.TemplateId != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasTriggerState() bool {
+	if x == nil {
+		return false
+	}
+	return x.TriggerState != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasRequiredCount() bool {
+	if x == nil {
+		return false
+	}
+	return x.RequiredCount != nil
+}
+
+func (x *ApprovalPolicyTemplate) HasStages() bool {
+	if x == nil {
+		return false
+	}
+	return x.Stages != nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearUpdatedAt() {
+	x.UpdatedAt = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearDeletedAt() {
+	x.DeletedAt = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearCreatorId() {
+	x.CreatorId = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearDescription() {
+	x.Description = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearCategoryId() {
+	x.CategoryId = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearTemplateId() {
+	x.TemplateId = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearTriggerState() {
+	x.TriggerState = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearRequiredCount() {
+	x.RequiredCount = nil
+}
+
+func (x *ApprovalPolicyTemplate) ClearStages() {
+	x.Stages = nil
+}
+
+type ApprovalPolicyTemplate_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          int64
+	CreatedAt   *timestamp.Timestamp
+	UpdatedAt   *timestamp.Timestamp
+	DeletedAt   *timestamp.Timestamp
+	Job         string
+	CreatorId   *int32
+	Name        string
+	Description *string
+	// Policy templates bound to a document template take precedence over category bound ones
+	CategoryId *int64
+	TemplateId *int64
+	// State (of the category's state machine) which starts the approval, unset = on publish
+	TriggerState       *string
+	RuleKind           ApprovalRuleKind
+	OnEditBehavior     OnEditBehavior
+	RequiredCount      *int32
+	SignatureRequired  bool
+	SelfApproveAllowed bool
+	Stages             *ApprovalStages
+}
+
+func (b0 ApprovalPolicyTemplate_builder) Build() *ApprovalPolicyTemplate {
+	m0 := &ApprovalPolicyTemplate{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.CreatedAt = b.CreatedAt
+	x.UpdatedAt = b.UpdatedAt
+	x.DeletedAt = b.DeletedAt
+	x.Job = b.Job
+	x.CreatorId = b.CreatorId
+	x.Name = b.Name
+	x.Description = b.Description
+	x.CategoryId = b.CategoryId
+	x.TemplateId = b.TemplateId
+	x.TriggerState = b.TriggerState
+	x.RuleKind = b.RuleKind
+	x.OnEditBehavior = b.OnEditBehavior
+	x.RequiredCount = b.RequiredCount
+	x.SignatureRequired = b.SignatureRequired
+	x.SelfApproveAllowed = b.SelfApproveAllowed
+	x.Stages = b.Stages
+	return m0
+}
+
+type ApprovalStages struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Stages are worked through in order, the tasks of a stage are only created once all tasks of
+	// the previous stage have been approved.
+	Stages        []*ApprovalStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStages) Reset() {
+	*x = ApprovalStages{}
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStages) ProtoMessage() {}
+
+func (x *ApprovalStages) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApprovalStages) GetStages() []*ApprovalStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *ApprovalStages) SetStages(v []*ApprovalStage) {
+	x.Stages = v
+}
+
+type ApprovalStages_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Stages are worked through in order, the tasks of a stage are only created once all tasks of
+	// the previous stage have been approved.
+	Stages []*ApprovalStage
+}
+
+func (b0 ApprovalStages_builder) Build() *ApprovalStages {
+	m0 := &ApprovalStages{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Stages = b.Stages
+	return m0
+}
+
+type ApprovalStage struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Label *string                `protobuf:"bytes,1,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// All conditions must match the document's data, otherwise the stage is skipped
+	Conditions    []*ApprovalCondition     `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Assignees     []*ApprovalStageAssignee `protobuf:"bytes,3,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStage) Reset() {
+	*x = ApprovalStage{}
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStage) ProtoMessage() {}
+
+func (x *ApprovalStage) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApprovalStage) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ApprovalStage) GetConditions() []*ApprovalCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *ApprovalStage) GetAssignees() []*ApprovalStageAssignee {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+func (x *ApprovalStage) SetLabel(v string) {
+	x.Label = &v
+}
+
+func (x *ApprovalStage) SetConditions(v []*ApprovalCondition) {
+	x.Conditions = v
+}
+
+func (x *ApprovalStage) SetAssignees(v []*ApprovalStageAssignee) {
+	x.Assignees = v
+}
+
+func (x *ApprovalStage) HasLabel() bool {
+	if x == nil {
+		return false
+	}
+	return x.Label != nil
+}
+
+func (x *ApprovalStage) ClearLabel() {
+	x.Label = nil
+}
+
+type ApprovalStage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Label *string
+	// All conditions must match the document's data, otherwise the stage is skipped
+	Conditions []*ApprovalCondition
+	Assignees  []*ApprovalStageAssignee
+}
+
+func (b0 ApprovalStage_builder) Build() *ApprovalStage {
+	m0 := &ApprovalStage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Label = b.Label
+	x.Conditions = b.Conditions
+	x.Assignees = b.Assignees
+	return m0
+}
+
+type ApprovalStageAssignee struct {
+	state  protoimpl.MessageState `protogen:"hybrid.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// If user_id == 0 -> JOB task, an empty job is replaced by the document creator's job
+	Job          string `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	MinimumGrade int32  `protobuf:"varint,3,opt,name=minimum_grade,json=minimumGrade,proto3" json:"minimum_grade,omitempty"`
+	// Label of task
+	Label             *string `protobuf:"bytes,4,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	SignatureRequired bool    `protobuf:"varint,5,opt,name=signature_required,json=signatureRequired,proto3" json:"signature_required,omitempty"`
+	// Only for JOB tasks; number of PENDING slots to ensure (>=1)
+	Slots int32 `protobuf:"varint,6,opt,name=slots,proto3" json:"slots,omitempty"`
+	// Optional default due date for created slots
+	DueInDays     *int32 `protobuf:"varint,7,opt,name=due_in_days,json=dueInDays,proto3,oneof" json:"due_in_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStageAssignee) Reset() {
+	*x = ApprovalStageAssignee{}
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStageAssignee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStageAssignee) ProtoMessage() {}
+
+func (x *ApprovalStageAssignee) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApprovalStageAssignee) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApprovalStageAssignee) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *ApprovalStageAssignee) GetMinimumGrade() int32 {
+	if x != nil {
+		return x.MinimumGrade
+	}
+	return 0
+}
+
+func (x *ApprovalStageAssignee) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ApprovalStageAssignee) GetSignatureRequired() bool {
+	if x != nil {
+		return x.SignatureRequired
+	}
+	return false
+}
+
+func (x *ApprovalStageAssignee) GetSlots() int32 {
+	if x != nil {
+		return x.Slots
+	}
+	return 0
+}
+
+func (x *ApprovalStageAssignee) GetDueInDays() int32 {
+	if x != nil && x.DueInDays != nil {
+		return *x.DueInDays
+	}
+	return 0
+}
+
+func (x *ApprovalStageAssignee) SetUserId(v int32) {
+	x.UserId = v
+}
+
+func (x *ApprovalStageAssignee) SetJob(v string) {
+	x.Job = v
+}
+
+func (x *ApprovalStageAssignee) SetMinimumGrade(v int32) {
+	x.MinimumGrade = v
+}
+
+func (x *ApprovalStageAssignee) SetLabel(v string) {
+	x.Label = &v
+}
+
+func (x *ApprovalStageAssignee) SetSignatureRequired(v bool) {
+	x.SignatureRequired = v
+}
+
+func (x *ApprovalStageAssignee) SetSlots(v int32) {
+	x.Slots = v
+}
+
+func (x *ApprovalStageAssignee) SetDueInDays(v int32) {
+	x.DueInDays = &v
+}
+
+func (x *ApprovalStageAssignee) HasLabel() bool {
+	if x == nil {
+		return false
+	}
+	return x.Label != nil
+}
+
+func (x *ApprovalStageAssignee) HasDueInDays() bool {
+	if x == nil {
+		return false
+	}
+	return x.DueInDays != nil
+}
+
+func (x *ApprovalStageAssignee) ClearLabel() {
+	x.Label = nil
+}
+
+func (x *ApprovalStageAssignee) ClearDueInDays() {
+	x.DueInDays = nil
+}
+
+type ApprovalStageAssignee_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int32
+	// If user_id == 0 -> JOB task, an empty job is replaced by the document creator's job
+	Job          string
+	MinimumGrade int32
+	// Label of task
+	Label             *string
+	SignatureRequired bool
+	// Only for JOB tasks; number of PENDING slots to ensure (>=1)
+	Slots int32
+	// Optional default due date for created slots
+	DueInDays *int32
+}
+
+func (b0 ApprovalStageAssignee_builder) Build() *ApprovalStageAssignee {
+	m0 := &ApprovalStageAssignee{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UserId = b.UserId
+	x.Job = b.Job
+	x.MinimumGrade = b.MinimumGrade
+	x.Label = b.Label
+	x.SignatureRequired = b.SignatureRequired
+	x.Slots = b.Slots
+	x.DueInDays = b.DueInDays
+	return m0
+}
+
+// Condition evaluated against the document's data.
+type ApprovalCondition struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Types that are valid to be assigned to Condition:
+	//
+	//	*ApprovalCondition_Field
+	//	*ApprovalCondition_PenaltyFine
+	Condition     isApprovalCondition_Condition `protobuf_oneof:"condition"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalCondition) Reset() {
+	*x = ApprovalCondition{}
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalCondition) ProtoMessage() {}
+
+func (x *ApprovalCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_approval_policy_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ApprovalCondition) GetCondition() isApprovalCondition_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *ApprovalCondition) GetField() *forms.FormFieldFilter {
+	if x != nil {
+		if x, ok := x.Condition.(*ApprovalCondition_Field); ok {
+			return x.Field
+		}
+	}
+	return nil
+}
+
+func (x *ApprovalCondition) GetPenaltyFine() *forms.NumberRange {
+	if x != nil {
+		if x, ok := x.Condition.(*ApprovalCondition_PenaltyFine); ok {
+			return x.PenaltyFine
+		}
+	}
+	return nil
+}
+
+func (x *ApprovalCondition) SetField(v *forms.FormFieldFilter) {
+	if v == nil {
+		x.Condition = nil
+		return
+	}
+	x.Condition = &ApprovalCondition_Field{v}
+}
+
+func (x *ApprovalCondition) SetPenaltyFine(v *forms.NumberRange) {
+	if v == nil {
+		x.Condition = nil
+		return
+	}
+	x.Condition = &ApprovalCondition_PenaltyFine{v}
+}
+
+func (x *ApprovalCondition) HasCondition() bool {
+	if x == nil {
+		return false
+	}
+	return x.Condition != nil
+}
+
+func (x *ApprovalCondition) HasField() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Condition.(*ApprovalCondition_Field)
+	return ok
+}
+
+func (x *ApprovalCondition) HasPenaltyFine() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Condition.(*ApprovalCondition_PenaltyFine)
+	return ok
+}
+
+func (x *ApprovalCondition) ClearCondition() {
+	x.Condition = nil
+}
+
+func (x *ApprovalCondition) ClearField() {
+	if _, ok := x.Condition.(*ApprovalCondition_Field); ok {
+		x.Condition = nil
+	}
+}
+
+func (x *ApprovalCondition) ClearPenaltyFine() {
+	if _, ok := x.Condition.(*ApprovalCondition_PenaltyFine); ok {
+		x.Condition = nil
+	}
+}
+
+const ApprovalCondition_Condition_not_set_case case_ApprovalCondition_Condition = 0
+const ApprovalCondition_Field_case case_ApprovalCondition_Condition = 1
+const ApprovalCondition_PenaltyFine_case case_ApprovalCondition_Condition = 2
+
+func (x *ApprovalCondition) WhichCondition() case_ApprovalCondition_Condition {
+	if x == nil {
+		return ApprovalCondition_Condition_not_set_case
+	}
+	switch x.Condition.(type) {
+	case *ApprovalCondition_Field:
+		return ApprovalCondition_Field_case
+	case *ApprovalCondition_PenaltyFine:
+		return ApprovalCondition_PenaltyFine_case
+	default:
+		return ApprovalCondition_Condition_not_set_case
+	}
+}
+
+type ApprovalCondition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof Condition:
+	Field *forms.FormFieldFilter
+	// Total fine of the penalty calculator
+	PenaltyFine *forms.NumberRange
+	// -- end of Condition
+}
+
+func (b0 ApprovalCondition_builder) Build() *ApprovalCondition {
+	m0 := &ApprovalCondition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Field != nil {
+		x.Condition = &ApprovalCondition_Field{b.Field}
+	}
+	if b.PenaltyFine != nil {
+		x.Condition = &ApprovalCondition_PenaltyFine{b.PenaltyFine}
+	}
+	return m0
+}
+
+type case_ApprovalCondition_Condition protoreflect.FieldNumber
+
+func (x case_ApprovalCondition_Condition) String() string {
+	md := file_resources_documents_approval_policy_template_proto_msgTypes[4].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isApprovalCondition_Condition interface {
+	isApprovalCondition_Condition()
+}
+
+type ApprovalCondition_Field struct {
+	Field *forms.FormFieldFilter `protobuf:"bytes,1,opt,name=field,proto3,oneof"`
+}
+
+type ApprovalCondition_PenaltyFine struct {
+	// Total fine of the penalty calculator
+	PenaltyFine *forms.NumberRange `protobuf:"bytes,2,opt,name=penalty_fine,json=penaltyFine,proto3,oneof"`
+}
+
+func (*ApprovalCondition_Field) isApprovalCondition_Condition() {}
+
+func (*ApprovalCondition_PenaltyFine) isApprovalCondition_Condition() {}
+
+var File_resources_documents_approval_policy_template_proto protoreflect.FileDescriptor
+
+const file_resources_documents_approval_policy_template_proto_rawDesc = "" +
+	"\n" +
+	"2resources/documents/approval/policy_template.proto\x12\x1cresources.documents.approval\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a+resources/documents/approval/approval.proto\x1a%resources/documents/forms/forms.proto\x1a#resources/timestamp/timestamp.proto\"\xfe\a\n" +
+	"\x16ApprovalPolicyTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12B\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tupdatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\tdeletedAt\x88\x01\x01\x12\x10\n" +
+	"\x03job\x18\x05 \x01(\tR\x03job\x12\"\n" +
+	"\n" +
+	"creator_id\x18\x06 \x01(\x05H\x03R\tcreatorId\x88\x01\x01\x12\x1a\n" +
+	"\x04name\x18\a \x01(\tB\x06\xda\xf3\x18\x02\b\x01R\x04name\x12-\n" +
+	"\vdescription\x18\b \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x04R\vdescription\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\t \x01(\x03H\x05R\n" +
+	"categoryId\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\n" +
+	" \x01(\x03H\x06R\n" +
+	"templateId\x88\x01\x01\x122\n" +
+	"\rtrigger_state\x18\v \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\aR\ftriggerState\x88\x01\x01\x12K\n" +
+	"\trule_kind\x18\f \x01(\x0e2..resources.documents.approval.ApprovalRuleKindR\bruleKind\x12V\n" +
+	"\x10on_edit_behavior\x18\r \x01(\x0e2,.resources.documents.approval.OnEditBehaviorR\x0eonEditBehavior\x12*\n" +
+	"\x0erequired_count\x18\x0e \x01(\x05H\bR\rrequiredCount\x88\x01\x01\x12-\n" +
+	"\x12signature_required\x18\x0f \x01(\bR\x11signatureRequired\x120\n" +
+	"\x14self_approve_allowed\x18\x10 \x01(\bR\x12selfApproveAllowed\x12D\n" +
+	"\x06stages\x18\x11 \x01(\v2,.resources.documents.approval.ApprovalStagesR\x06stagesB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_deleted_atB\r\n" +
+	"\v_creator_idB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_category_idB\x0e\n" +
+	"\f_template_idB\x10\n" +
+	"\x0e_trigger_stateB\x11\n" +
+	"\x0f_required_count\"]\n" +
+	"\x0eApprovalStages\x12C\n" +
+	"\x06stages\x18\x01 \x03(\v2+.resources.documents.approval.ApprovalStageR\x06stages:\x06\xe2\xf3\x18\x02\b\x01\"\xe2\x01\n" +
+	"\rApprovalStage\x12#\n" +
+	"\x05label\x18\x01 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x00R\x05label\x88\x01\x01\x12O\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2/.resources.documents.approval.ApprovalConditionR\n" +
+	"conditions\x12Q\n" +
+	"\tassignees\x18\x03 \x03(\v23.resources.documents.approval.ApprovalStageAssigneeR\tassigneesB\b\n" +
+	"\x06_label\"\x90\x02\n" +
+	"\x15ApprovalStageAssignee\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03job\x18\x02 \x01(\tR\x03job\x12#\n" +
+	"\rminimum_grade\x18\x03 \x01(\x05R\fminimumGrade\x12#\n" +
+	"\x05label\x18\x04 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x00R\x05label\x88\x01\x01\x12-\n" +
+	"\x12signature_required\x18\x05 \x01(\bR\x11signatureRequired\x12\x14\n" +
+	"\x05slots\x18\x06 \x01(\x05R\x05slots\x12#\n" +
+	"\vdue_in_days\x18\a \x01(\x05H\x01R\tdueInDays\x88\x01\x01B\b\n" +
+	"\x06_labelB\x0e\n" +
+	"\f_due_in_days\"\xb1\x01\n" +
+	"\x11ApprovalCondition\x12B\n" +
+	"\x05field\x18\x01 \x01(\v2*.resources.documents.forms.FormFieldFilterH\x00R\x05field\x12K\n" +
+	"\fpenalty_fine\x18\x02 \x01(\v2&.resources.documents.forms.NumberRangeH\x00R\vpenaltyFineB\v\n" +
+	"\tconditionBbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval;documentsapprovalb\x06proto3"
+
+var file_resources_documents_approval_policy_template_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_resources_documents_approval_policy_template_proto_goTypes = []any{
+	(*ApprovalPolicyTemplate)(nil), // 0: resources.documents.approval.ApprovalPolicyTemplate
+	(*ApprovalStages)(nil),         // 1: resources.documents.approval.ApprovalStages
+	(*ApprovalStage)(nil),          // 2: resources.documents.approval.ApprovalStage
+	(*ApprovalStageAssignee)(nil),  // 3: resources.documents.approval.ApprovalStageAssignee
+	(*ApprovalCondition)(nil),      // 4: resources.documents.approval.ApprovalCondition
+	(*timestamp.Timestamp)(nil),    // 5: resources.timestamp.Timestamp
+	(ApprovalRuleKind)(0),          // 6: resources.documents.approval.ApprovalRuleKind
+	(OnEditBehavior)(0),            // 7: resources.documents.approval.OnEditBehavior
+	(*forms.FormFieldFilter)(nil),  // 8: resources.documents.forms.FormFieldFilter
+	(*forms.NumberRange)(nil),      // 9: resources.documents.forms.NumberRange
+}
+var file_resources_documents_approval_policy_template_proto_depIdxs = []int32{
+	5,  // 0: resources.documents.approval.ApprovalPolicyTemplate.created_at:type_name -> resources.timestamp.Timestamp
+	5,  // 1: resources.documents.approval.ApprovalPolicyTemplate.updated_at:type_name -> resources.timestamp.Timestamp
+	5,  // 2: resources.documents.approval.ApprovalPolicyTemplate.deleted_at:type_name -> resources.timestamp.Timestamp
+	6,  // 3: resources.documents.approval.ApprovalPolicyTemplate.rule_kind:type_name -> resources.documents.approval.ApprovalRuleKind
+	7,  // 4: resources.documents.approval.ApprovalPolicyTemplate.on_edit_behavior:type_name -> resources.documents.approval.OnEditBehavior
+	1,  // 5: resources.documents.approval.ApprovalPolicyTemplate.stages:type_name -> resources.documents.approval.ApprovalStages
+	2,  // 6: resources.documents.approval.ApprovalStages.stages:type_name -> resources.documents.approval.ApprovalStage
+	4,  // 7: resources.documents.approval.ApprovalStage.conditions:type_name -> resources.documents.approval.ApprovalCondition
+	3,  // 8: resources.documents.approval.ApprovalStage.assignees:type_name -> resources.documents.approval.ApprovalStageAssignee
+	8,  // 9: resources.documents.approval.ApprovalCondition.field:type_name -> resources.documents.forms.FormFieldFilter
+	9,  // 10: resources.documents.approval.ApprovalCondition.penalty_fine:type_name -> resources.documents.forms.NumberRange
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_resources_documents_approval_policy_template_proto_init() }
+func file_resources_documents_approval_policy_template_proto_init() {
+	if File_resources_documents_approval_policy_template_proto != nil {
+		return
+	}
+	file_resources_documents_approval_approval_proto_init()
+	file_resources_documents_approval_policy_template_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_documents_approval_policy_template_proto_msgTypes[2].OneofWrappers = []any{}
+	file_resources_documents_approval_policy_template_proto_msgTypes[3].OneofWrappers = []any{}
+	file_resources_documents_approval_policy_template_proto_msgTypes[4].OneofWrappers = []any{
+		(*ApprovalCondition_Field)(nil),
+		(*ApprovalCondition_PenaltyFine)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_approval_policy_template_proto_rawDesc), len(file_resources_documents_approval_policy_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_documents_approval_policy_template_proto_goTypes,
+		DependencyIndexes: file_resources_documents_approval_policy_template_proto_depIdxs,
+		MessageInfos:      file_resources_documents_approval_policy_template_proto_msgTypes,
+	}.Build()
+	File_resources_documents_approval_policy_template_proto = out.File
+	file_resources_documents_approval_policy_template_proto_goTypes = nil
+	file_resources_documents_approval_policy_template_proto_depIdxs = nil
+}
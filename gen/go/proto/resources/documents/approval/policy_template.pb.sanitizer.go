@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/documents/approval/policy_template.proto
+
+package documentsapproval
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ApprovalCondition) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Field
+	switch v := m.Condition.(type) {
+
+	case *ApprovalCondition_Field:
+
+		if v.Field != nil {
+			if s, ok := any(v.Field).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field: PenaltyFine
+	case *ApprovalCondition_PenaltyFine:
+
+		if v.PenaltyFine != nil {
+			if s, ok := any(v.PenaltyFine).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ApprovalPolicyTemplate) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: CreatedAt
+	if m.CreatedAt != nil {
+		if v, ok := any(m.GetCreatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: DeletedAt
+	if m.DeletedAt != nil {
+		if v, ok := any(m.GetDeletedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Description
+	if m.Description != nil {
+		*m.Description = htmlsanitizer.SanitizeAndUnescape(*m.Description)
+	}
+
+	// Field: Job
+	m.Job = htmlsanitizer.SanitizeAndUnescape(m.Job)
+
+	// Field: Name
+	m.Name = htmlsanitizer.SanitizeAndUnescape(m.Name)
+
+	// Field: Stages
+	if m.Stages != nil {
+		if v, ok := any(m.GetStages()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: TriggerState
+	if m.TriggerState != nil {
+		*m.TriggerState = htmlsanitizer.StripHTMLTags(*m.TriggerState)
+	}
+
+	// Field: UpdatedAt
+	if m.UpdatedAt != nil {
+		if v, ok := any(m.GetUpdatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ApprovalStage) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Assignees
+	for idx, item := range m.Assignees {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: Conditions
+	for idx, item := range m.Conditions {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: Label
+	if m.Label != nil {
+		*m.Label = htmlsanitizer.StripHTMLTags(*m.Label)
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ApprovalStageAssignee) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Job
+	m.Job = htmlsanitizer.SanitizeAndUnescape(m.Job)
+
+	// Field: Label
+	if m.Label != nil {
+		*m.Label = htmlsanitizer.StripHTMLTags(*m.Label)
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ApprovalStages) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Stages
+	for idx, item := range m.Stages {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
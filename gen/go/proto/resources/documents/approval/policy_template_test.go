@@ -0,0 +1,105 @@
+package documentsapproval
+
+import (
+	"testing"
+
+	documentsdata "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testPolicyTemplate() *ApprovalPolicyTemplate {
+	return &ApprovalPolicyTemplate{
+		CategoryId: proto.Int64(1),
+		RuleKind:   ApprovalRuleKind_APPROVAL_RULE_KIND_REQUIRE_ALL,
+		Stages: &ApprovalStages{
+			Stages: []*ApprovalStage{
+				{
+					Label:     proto.String("Leadership"),
+					Assignees: []*ApprovalStageAssignee{{MinimumGrade: 5, Slots: 1}},
+				},
+				{
+					Label: proto.String("Felony"),
+					Conditions: []*ApprovalCondition{
+						{
+							Condition: &ApprovalCondition_Field{
+								Field: &documentsforms.FormFieldFilter{
+									Key: "severity",
+									Filter: &documentsforms.FormFieldFilter_Option{
+										Option: "felony",
+									},
+								},
+							},
+						},
+					},
+					Assignees: []*ApprovalStageAssignee{{Job: "doj", Slots: 1}},
+				},
+				{
+					Label: proto.String("High Fine"),
+					Conditions: []*ApprovalCondition{
+						{
+							Condition: &ApprovalCondition_PenaltyFine{
+								PenaltyFine: &documentsforms.NumberRange{Min: proto.Float64(10000)},
+							},
+						},
+					},
+					Assignees: []*ApprovalStageAssignee{{Job: "doj", MinimumGrade: 10, Slots: 1}},
+				},
+			},
+		},
+	}
+}
+
+func TestApprovalPolicyTemplateValidate(t *testing.T) {
+	tmpl := testPolicyTemplate()
+	require.NoError(t, tmpl.Validate())
+
+	tmpl.CategoryId = nil
+	require.Error(t, tmpl.Validate())
+
+	tmpl.TemplateId = proto.Int64(2)
+	require.NoError(t, tmpl.Validate())
+
+	tmpl.RuleKind = ApprovalRuleKind_APPROVAL_RULE_KIND_QUORUM_ANY
+	require.Error(t, tmpl.Validate())
+}
+
+func TestApprovalPolicyTemplateNextStage(t *testing.T) {
+	tmpl := testPolicyTemplate()
+
+	data := &documentsdata.DocumentData{
+		Fields: map[string]*documentsforms.FormFieldValue{
+			"severity": {Value: &documentsforms.FormFieldValue_Option{Option: "misdemeanor"}},
+		},
+		PenaltyCalculator: &documentsdata.PenaltyCalculatorData{
+			Total: &documentsdata.PenaltyCalculatorTotal{Fine: proto.Uint32(25000)},
+		},
+	}
+
+	stage, s := tmpl.NextStage(0, data)
+	assert.Equal(t, int32(1), stage)
+	assert.Equal(t, "Leadership", s.GetLabel())
+
+	// The felony stage is skipped as its condition doesn't match
+	stage, s = tmpl.NextStage(1, data)
+	assert.Equal(t, int32(3), stage)
+	assert.Equal(t, "High Fine", s.GetLabel())
+
+	stage, s = tmpl.NextStage(3, data)
+	assert.Equal(t, int32(0), stage)
+	assert.Nil(t, s)
+
+	// Documents without penalty calculator data don't match fine conditions
+	data.PenaltyCalculator = nil
+	data.Fields["severity"] = &documentsforms.FormFieldValue{
+		Value: &documentsforms.FormFieldValue_Option{Option: "felony"},
+	}
+	stage, s = tmpl.NextStage(1, data)
+	assert.Equal(t, int32(2), stage)
+	assert.Equal(t, "Felony", s.GetLabel())
+
+	stage, _ = tmpl.NextStage(2, data)
+	assert.Equal(t, int32(0), stage)
+}
@@ -0,0 +1,58 @@
+package documentsbulk
+
+import (
+	"slices"
+
+	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
+	"google.golang.org/protobuf/proto"
+)
+
+// IsEmpty returns true if the selector neither has document IDs nor a filter.
+func (x *BulkSelector) IsEmpty() bool {
+	return len(x.GetDocumentIds()) == 0 && x.GetFilter() == nil
+}
+
+// IsFinished returns true if the job won't be processed any further.
+func (x BulkJobStatus) IsFinished() bool {
+	return x == BulkJobStatus_BULK_JOB_STATUS_COMPLETED ||
+		x == BulkJobStatus_BULK_JOB_STATUS_FAILED ||
+		x == BulkJobStatus_BULK_JOB_STATUS_CANCELLED
+}
+
+// Apply merges the grants and revokes into a copy of the current access. Revokes remove all entries
+// of a job/ user, grants replace existing entries for the same job and minimum grade/ user.
+func (x *BulkAccessOperation) Apply(current *resourcesaccess.Access) *resourcesaccess.Access {
+	out := &resourcesaccess.Access{}
+	if current != nil {
+		out, _ = proto.Clone(current).(*resourcesaccess.Access)
+	}
+
+	out.Jobs = slices.DeleteFunc(out.Jobs, func(ja *resourcesaccess.JobAccess) bool {
+		return slices.Contains(x.GetRevokeJobs(), ja.GetJob()) ||
+			slices.ContainsFunc(x.GetGrantJobs(), func(g *resourcesaccess.JobAccess) bool {
+				return g.GetJob() == ja.GetJob() && g.GetMinimumGrade() == ja.GetMinimumGrade()
+			})
+	})
+	for _, ja := range x.GetGrantJobs() {
+		out.Jobs = append(out.Jobs, &resourcesaccess.JobAccess{
+			Job:          ja.GetJob(),
+			MinimumGrade: ja.GetMinimumGrade(),
+			Access:       ja.GetAccess(),
+		})
+	}
+
+	out.Users = slices.DeleteFunc(out.Users, func(ua *resourcesaccess.UserAccess) bool {
+		return slices.Contains(x.GetRevokeUsers(), ua.GetUserId()) ||
+			slices.ContainsFunc(x.GetGrantUsers(), func(g *resourcesaccess.UserAccess) bool {
+				return g.GetUserId() == ua.GetUserId()
+			})
+	})
+	for _, ua := range x.GetGrantUsers() {
+		out.Users = append(out.Users, &resourcesaccess.UserAccess{
+			UserId: ua.GetUserId(),
+			Access: ua.GetAccess(),
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,74 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/documents/bulk/bulk.proto
+
+package documentsbulk
+
+import (
+	"database/sql/driver"
+
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+)
+
+// Scan implements driver.Valuer for protobuf BulkOperation.
+func (x *BulkOperation) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the BulkOperation value into driver.Valuer.
+func (x *BulkOperation) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
+
+// Scan implements driver.Valuer for protobuf BulkSelector.
+func (x *BulkSelector) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the BulkSelector value into driver.Valuer.
+func (x *BulkSelector) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
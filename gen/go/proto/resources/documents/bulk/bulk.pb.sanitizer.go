@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/documents/bulk/bulk.proto
+
+package documentsbulk
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkAccessOperation) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: GrantJobs
+	for idx, item := range m.GrantJobs {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: GrantUsers
+	for idx, item := range m.GrantUsers {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: RevokeJobs
+	for idx, item := range m.RevokeJobs {
+		_, _ = idx, item
+
+		m.RevokeJobs[idx] = htmlsanitizer.SanitizeAndUnescape(m.RevokeJobs[idx])
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkDeleteOperation) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Reason
+	m.Reason = htmlsanitizer.StripHTMLTags(m.Reason)
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkFilter) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: FormFields
+	for idx, item := range m.FormFields {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: From
+	if m.From != nil {
+		if v, ok := any(m.GetFrom()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Search
+	if m.Search != nil {
+		*m.Search = htmlsanitizer.SanitizeAndUnescape(*m.Search)
+	}
+
+	// Field: To
+	if m.To != nil {
+		if v, ok := any(m.GetTo()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkJob) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: CompletedAt
+	if m.CompletedAt != nil {
+		if v, ok := any(m.GetCompletedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: CreatedAt
+	if m.CreatedAt != nil {
+		if v, ok := any(m.GetCreatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Creator
+	if m.Creator != nil {
+		if v, ok := any(m.GetCreator()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Error
+	if m.Error != nil {
+		*m.Error = htmlsanitizer.SanitizeAndUnescape(*m.Error)
+	}
+
+	// Field: Job
+	m.Job = htmlsanitizer.SanitizeAndUnescape(m.Job)
+
+	// Field: Operation
+	if m.Operation != nil {
+		if v, ok := any(m.GetOperation()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Selector
+	if m.Selector != nil {
+		if v, ok := any(m.GetSelector()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: StartedAt
+	if m.StartedAt != nil {
+		if v, ok := any(m.GetStartedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: UpdatedAt
+	if m.UpdatedAt != nil {
+		if v, ok := any(m.GetUpdatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkOperation) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Access
+	switch v := m.Operation.(type) {
+
+	case *BulkOperation_Access:
+
+		if v.Access != nil {
+			if s, ok := any(v.Access).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field: ChangeCategory
+	case *BulkOperation_ChangeCategory:
+
+		if v.ChangeCategory != nil {
+			if s, ok := any(v.ChangeCategory).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field: ChangeOwner
+	case *BulkOperation_ChangeOwner:
+
+		if v.ChangeOwner != nil {
+			if s, ok := any(v.ChangeOwner).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field: Delete
+	case *BulkOperation_Delete:
+
+		if v.Delete != nil {
+			if s, ok := any(v.Delete).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field: Toggle
+	case *BulkOperation_Toggle:
+
+		if v.Toggle != nil {
+			if s, ok := any(v.Toggle).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkSelector) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Filter
+	if m.Filter != nil {
+		if v, ok := any(m.GetFilter()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1628 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/documents/bulk/bulk.proto
+
+//go:build protoopaque
+
+package documentsbulk
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/dbscanner"
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
+	access "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
+	forms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	short "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BulkJobStatus int32
+
+const (
+	BulkJobStatus_BULK_JOB_STATUS_UNSPECIFIED BulkJobStatus = 0
+	BulkJobStatus_BULK_JOB_STATUS_PENDING     BulkJobStatus = 1
+	BulkJobStatus_BULK_JOB_STATUS_RUNNING     BulkJobStatus = 2
+	BulkJobStatus_BULK_JOB_STATUS_COMPLETED   BulkJobStatus = 3
+	BulkJobStatus_BULK_JOB_STATUS_FAILED      BulkJobStatus = 4
+	BulkJobStatus_BULK_JOB_STATUS_CANCELLED   BulkJobStatus = 5
+)
+
+// Enum value maps for BulkJobStatus.
+var (
+	BulkJobStatus_name = map[int32]string{
+		0: "BULK_JOB_STATUS_UNSPECIFIED",
+		1: "BULK_JOB_STATUS_PENDING",
+		2: "BULK_JOB_STATUS_RUNNING",
+		3: "BULK_JOB_STATUS_COMPLETED",
+		4: "BULK_JOB_STATUS_FAILED",
+		5: "BULK_JOB_STATUS_CANCELLED",
+	}
+	BulkJobStatus_value = map[string]int32{
+		"BULK_JOB_STATUS_UNSPECIFIED": 0,
+		"BULK_JOB_STATUS_PENDING":     1,
+		"BULK_JOB_STATUS_RUNNING":     2,
+		"BULK_JOB_STATUS_COMPLETED":   3,
+		"BULK_JOB_STATUS_FAILED":      4,
+		"BULK_JOB_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x BulkJobStatus) Enum() *BulkJobStatus {
+	p := new(BulkJobStatus)
+	*p = x
+	return p
+}
+
+func (x BulkJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_documents_bulk_bulk_proto_enumTypes[0].Descriptor()
+}
+
+func (BulkJobStatus) Type() protoreflect.EnumType {
+	return &file_resources_documents_bulk_bulk_proto_enumTypes[0]
+}
+
+func (x BulkJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Bulk operation on a set of documents, processed in the background in the name of its creator.
+type BulkJob struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id             int64                  `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_CreatedAt      *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt      *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof"`
+	xxx_hidden_StartedAt      *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3,oneof"`
+	xxx_hidden_CompletedAt    *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3,oneof"`
+	xxx_hidden_Job            string                 `protobuf:"bytes,6,opt,name=job,proto3"`
+	xxx_hidden_CreatorId      int32                  `protobuf:"varint,7,opt,name=creator_id,json=creatorId,proto3"`
+	xxx_hidden_Creator        *short.UserShort       `protobuf:"bytes,8,opt,name=creator,proto3,oneof"`
+	xxx_hidden_Status         BulkJobStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=resources.documents.bulk.BulkJobStatus"`
+	xxx_hidden_Selector       *BulkSelector          `protobuf:"bytes,10,opt,name=selector,proto3"`
+	xxx_hidden_Operation      *BulkOperation         `protobuf:"bytes,11,opt,name=operation,proto3"`
+	xxx_hidden_TotalCount     int64                  `protobuf:"varint,12,opt,name=total_count,json=totalCount,proto3"`
+	xxx_hidden_ProcessedCount int64                  `protobuf:"varint,13,opt,name=processed_count,json=processedCount,proto3"`
+	xxx_hidden_SucceededCount int64                  `protobuf:"varint,14,opt,name=succeeded_count,json=succeededCount,proto3"`
+	xxx_hidden_FailedCount    int64                  `protobuf:"varint,15,opt,name=failed_count,json=failedCount,proto3"`
+	xxx_hidden_LastDocumentId int64                  `protobuf:"varint,16,opt,name=last_document_id,json=lastDocumentId,proto3"`
+	xxx_hidden_Error          *string                `protobuf:"bytes,17,opt,name=error,proto3,oneof"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *BulkJob) Reset() {
+	*x = BulkJob{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkJob) ProtoMessage() {}
+
+func (x *BulkJob) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkJob) GetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *BulkJob) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *BulkJob) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *BulkJob) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartedAt
+	}
+	return nil
+}
+
+func (x *BulkJob) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CompletedAt
+	}
+	return nil
+}
+
+func (x *BulkJob) GetJob() string {
+	if x != nil {
+		return x.xxx_hidden_Job
+	}
+	return ""
+}
+
+func (x *BulkJob) GetCreatorId() int32 {
+	if x != nil {
+		return x.xxx_hidden_CreatorId
+	}
+	return 0
+}
+
+func (x *BulkJob) GetCreator() *short.UserShort {
+	if x != nil {
+		return x.xxx_hidden_Creator
+	}
+	return nil
+}
+
+func (x *BulkJob) GetStatus() BulkJobStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return BulkJobStatus_BULK_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *BulkJob) GetSelector() *BulkSelector {
+	if x != nil {
+		return x.xxx_hidden_Selector
+	}
+	return nil
+}
+
+func (x *BulkJob) GetOperation() *BulkOperation {
+	if x != nil {
+		return x.xxx_hidden_Operation
+	}
+	return nil
+}
+
+func (x *BulkJob) GetTotalCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalCount
+	}
+	return 0
+}
+
+func (x *BulkJob) GetProcessedCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_ProcessedCount
+	}
+	return 0
+}
+
+func (x *BulkJob) GetSucceededCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_SucceededCount
+	}
+	return 0
+}
+
+func (x *BulkJob) GetFailedCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_FailedCount
+	}
+	return 0
+}
+
+func (x *BulkJob) GetLastDocumentId() int64 {
+	if x != nil {
+		return x.xxx_hidden_LastDocumentId
+	}
+	return 0
+}
+
+func (x *BulkJob) GetError() string {
+	if x != nil {
+		if x.xxx_hidden_Error != nil {
+			return *x.xxx_hidden_Error
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BulkJob) SetId(v int64) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *BulkJob) SetCreatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *BulkJob) SetUpdatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *BulkJob) SetStartedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_StartedAt = v
+}
+
+func (x *BulkJob) SetCompletedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_CompletedAt = v
+}
+
+func (x *BulkJob) SetJob(v string) {
+	x.xxx_hidden_Job = v
+}
+
+func (x *BulkJob) SetCreatorId(v int32) {
+	x.xxx_hidden_CreatorId = v
+}
+
+func (x *BulkJob) SetCreator(v *short.UserShort) {
+	x.xxx_hidden_Creator = v
+}
+
+func (x *BulkJob) SetStatus(v BulkJobStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *BulkJob) SetSelector(v *BulkSelector) {
+	x.xxx_hidden_Selector = v
+}
+
+func (x *BulkJob) SetOperation(v *BulkOperation) {
+	x.xxx_hidden_Operation = v
+}
+
+func (x *BulkJob) SetTotalCount(v int64) {
+	x.xxx_hidden_TotalCount = v
+}
+
+func (x *BulkJob) SetProcessedCount(v int64) {
+	x.xxx_hidden_ProcessedCount = v
+}
+
+func (x *BulkJob) SetSucceededCount(v int64) {
+	x.xxx_hidden_SucceededCount = v
+}
+
+func (x *BulkJob) SetFailedCount(v int64) {
+	x.xxx_hidden_FailedCount = v
+}
+
+func (x *BulkJob) SetLastDocumentId(v int64) {
+	x.xxx_hidden_LastDocumentId = v
+}
+
+func (x *BulkJob) SetError(v string) {
+	x.xxx_hidden_Error = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 17)
+}
+
+func (x *BulkJob) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *BulkJob) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *BulkJob) HasStartedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartedAt != nil
+}
+
+func (x *BulkJob) HasCompletedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CompletedAt != nil
+}
+
+func (x *BulkJob) HasCreator() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Creator != nil
+}
+
+func (x *BulkJob) HasSelector() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Selector != nil
+}
+
+func (x *BulkJob) HasOperation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Operation != nil
+}
+
+func (x *BulkJob) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *BulkJob) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *BulkJob) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *BulkJob) ClearStartedAt() {
+	x.xxx_hidden_StartedAt = nil
+}
+
+func (x *BulkJob) ClearCompletedAt() {
+	x.xxx_hidden_CompletedAt = nil
+}
+
+func (x *BulkJob) ClearCreator() {
+	x.xxx_hidden_Creator = nil
+}
+
+func (x *BulkJob) ClearSelector() {
+	x.xxx_hidden_Selector = nil
+}
+
+func (x *BulkJob) ClearOperation() {
+	x.xxx_hidden_Operation = nil
+}
+
+func (x *BulkJob) ClearError() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_Error = nil
+}
+
+type BulkJob_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          int64
+	CreatedAt   *timestamp.Timestamp
+	UpdatedAt   *timestamp.Timestamp
+	StartedAt   *timestamp.Timestamp
+	CompletedAt *timestamp.Timestamp
+	Job         string
+	CreatorId   int32
+	Creator     *short.UserShort
+	Status      BulkJobStatus
+	Selector    *BulkSelector
+	Operation   *BulkOperation
+	// Number of documents matched by the selector, set once the job has been started
+	TotalCount     int64
+	ProcessedCount int64
+	SucceededCount int64
+	FailedCount    int64
+	// Documents are processed in ID order, the last processed document is used to resume the job
+	LastDocumentId int64
+	Error          *string
+}
+
+func (b0 BulkJob_builder) Build() *BulkJob {
+	m0 := &BulkJob{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_StartedAt = b.StartedAt
+	x.xxx_hidden_CompletedAt = b.CompletedAt
+	x.xxx_hidden_Job = b.Job
+	x.xxx_hidden_CreatorId = b.CreatorId
+	x.xxx_hidden_Creator = b.Creator
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_Selector = b.Selector
+	x.xxx_hidden_Operation = b.Operation
+	x.xxx_hidden_TotalCount = b.TotalCount
+	x.xxx_hidden_ProcessedCount = b.ProcessedCount
+	x.xxx_hidden_SucceededCount = b.SucceededCount
+	x.xxx_hidden_FailedCount = b.FailedCount
+	x.xxx_hidden_LastDocumentId = b.LastDocumentId
+	if b.Error != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 17)
+		x.xxx_hidden_Error = b.Error
+	}
+	return m0
+}
+
+// Documents a bulk operation is applied to, either a list of document IDs or a filter.
+type BulkSelector struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DocumentIds []int64                `protobuf:"varint,1,rep,packed,name=document_ids,json=documentIds,proto3"`
+	xxx_hidden_Filter      *BulkFilter            `protobuf:"bytes,2,opt,name=filter,proto3,oneof"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BulkSelector) Reset() {
+	*x = BulkSelector{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkSelector) ProtoMessage() {}
+
+func (x *BulkSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkSelector) GetDocumentIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_DocumentIds
+	}
+	return nil
+}
+
+func (x *BulkSelector) GetFilter() *BulkFilter {
+	if x != nil {
+		return x.xxx_hidden_Filter
+	}
+	return nil
+}
+
+func (x *BulkSelector) SetDocumentIds(v []int64) {
+	x.xxx_hidden_DocumentIds = v
+}
+
+func (x *BulkSelector) SetFilter(v *BulkFilter) {
+	x.xxx_hidden_Filter = v
+}
+
+func (x *BulkSelector) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Filter != nil
+}
+
+func (x *BulkSelector) ClearFilter() {
+	x.xxx_hidden_Filter = nil
+}
+
+type BulkSelector_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DocumentIds []int64
+	Filter      *BulkFilter
+}
+
+func (b0 BulkSelector_builder) Build() *BulkSelector {
+	m0 := &BulkSelector{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DocumentIds = b.DocumentIds
+	x.xxx_hidden_Filter = b.Filter
+	return m0
+}
+
+// Same search params as the `ListDocuments` request.
+type BulkFilter struct {
+	state                  protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Search      *string                   `protobuf:"bytes,1,opt,name=search,proto3,oneof"`
+	xxx_hidden_CategoryIds []int64                   `protobuf:"varint,2,rep,packed,name=category_ids,json=categoryIds,proto3"`
+	xxx_hidden_CreatorIds  []int32                   `protobuf:"varint,3,rep,packed,name=creator_ids,json=creatorIds,proto3"`
+	xxx_hidden_From        *timestamp.Timestamp      `protobuf:"bytes,4,opt,name=from,proto3,oneof"`
+	xxx_hidden_To          *timestamp.Timestamp      `protobuf:"bytes,5,opt,name=to,proto3,oneof"`
+	xxx_hidden_Closed      bool                      `protobuf:"varint,6,opt,name=closed,proto3,oneof"`
+	xxx_hidden_OnlyDrafts  bool                      `protobuf:"varint,7,opt,name=only_drafts,json=onlyDrafts,proto3,oneof"`
+	xxx_hidden_FormFields  *[]*forms.FormFieldFilter `protobuf:"bytes,8,rep,name=form_fields,json=formFields,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BulkFilter) Reset() {
+	*x = BulkFilter{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFilter) ProtoMessage() {}
+
+func (x *BulkFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkFilter) GetSearch() string {
+	if x != nil {
+		if x.xxx_hidden_Search != nil {
+			return *x.xxx_hidden_Search
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BulkFilter) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_CategoryIds
+	}
+	return nil
+}
+
+func (x *BulkFilter) GetCreatorIds() []int32 {
+	if x != nil {
+		return x.xxx_hidden_CreatorIds
+	}
+	return nil
+}
+
+func (x *BulkFilter) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_From
+	}
+	return nil
+}
+
+func (x *BulkFilter) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_To
+	}
+	return nil
+}
+
+func (x *BulkFilter) GetClosed() bool {
+	if x != nil {
+		return x.xxx_hidden_Closed
+	}
+	return false
+}
+
+func (x *BulkFilter) GetOnlyDrafts() bool {
+	if x != nil {
+		return x.xxx_hidden_OnlyDrafts
+	}
+	return false
+}
+
+func (x *BulkFilter) GetFormFields() []*forms.FormFieldFilter {
+	if x != nil {
+		if x.xxx_hidden_FormFields != nil {
+			return *x.xxx_hidden_FormFields
+		}
+	}
+	return nil
+}
+
+func (x *BulkFilter) SetSearch(v string) {
+	x.xxx_hidden_Search = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *BulkFilter) SetCategoryIds(v []int64) {
+	x.xxx_hidden_CategoryIds = v
+}
+
+func (x *BulkFilter) SetCreatorIds(v []int32) {
+	x.xxx_hidden_CreatorIds = v
+}
+
+func (x *BulkFilter) SetFrom(v *timestamp.Timestamp) {
+	x.xxx_hidden_From = v
+}
+
+func (x *BulkFilter) SetTo(v *timestamp.Timestamp) {
+	x.xxx_hidden_To = v
+}
+
+func (x *BulkFilter) SetClosed(v bool) {
+	x.xxx_hidden_Closed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *BulkFilter) SetOnlyDrafts(v bool) {
+	x.xxx_hidden_OnlyDrafts = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *BulkFilter) SetFormFields(v []*forms.FormFieldFilter) {
+	x.xxx_hidden_FormFields = &v
+}
+
+func (x *BulkFilter) HasSearch() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *BulkFilter) HasFrom() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_From != nil
+}
+
+func (x *BulkFilter) HasTo() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_To != nil
+}
+
+func (x *BulkFilter) HasClosed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *BulkFilter) HasOnlyDrafts() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *BulkFilter) ClearSearch() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Search = nil
+}
+
+func (x *BulkFilter) ClearFrom() {
+	x.xxx_hidden_From = nil
+}
+
+func (x *BulkFilter) ClearTo() {
+	x.xxx_hidden_To = nil
+}
+
+func (x *BulkFilter) ClearClosed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Closed = false
+}
+
+func (x *BulkFilter) ClearOnlyDrafts() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_OnlyDrafts = false
+}
+
+type BulkFilter_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Search      *string
+	CategoryIds []int64
+	CreatorIds  []int32
+	From        *timestamp.Timestamp
+	To          *timestamp.Timestamp
+	Closed      *bool
+	OnlyDrafts  *bool
+	FormFields  []*forms.FormFieldFilter
+}
+
+func (b0 BulkFilter_builder) Build() *BulkFilter {
+	m0 := &BulkFilter{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Search != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Search = b.Search
+	}
+	x.xxx_hidden_CategoryIds = b.CategoryIds
+	x.xxx_hidden_CreatorIds = b.CreatorIds
+	x.xxx_hidden_From = b.From
+	x.xxx_hidden_To = b.To
+	if b.Closed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_Closed = *b.Closed
+	}
+	if b.OnlyDrafts != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_OnlyDrafts = *b.OnlyDrafts
+	}
+	x.xxx_hidden_FormFields = &b.FormFields
+	return m0
+}
+
+type BulkOperation struct {
+	state                protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Operation isBulkOperation_Operation `protobuf_oneof:"operation"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BulkOperation) Reset() {
+	*x = BulkOperation{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOperation) ProtoMessage() {}
+
+func (x *BulkOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkOperation) GetToggle() *BulkToggleOperation {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Operation.(*bulkOperation_Toggle); ok {
+			return x.Toggle
+		}
+	}
+	return nil
+}
+
+func (x *BulkOperation) GetChangeCategory() *BulkChangeCategoryOperation {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Operation.(*bulkOperation_ChangeCategory); ok {
+			return x.ChangeCategory
+		}
+	}
+	return nil
+}
+
+func (x *BulkOperation) GetChangeOwner() *BulkChangeOwnerOperation {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Operation.(*bulkOperation_ChangeOwner); ok {
+			return x.ChangeOwner
+		}
+	}
+	return nil
+}
+
+func (x *BulkOperation) GetAccess() *BulkAccessOperation {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Operation.(*bulkOperation_Access); ok {
+			return x.Access
+		}
+	}
+	return nil
+}
+
+func (x *BulkOperation) GetDelete() *BulkDeleteOperation {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Operation.(*bulkOperation_Delete); ok {
+			return x.Delete
+		}
+	}
+	return nil
+}
+
+func (x *BulkOperation) SetToggle(v *BulkToggleOperation) {
+	if v == nil {
+		x.xxx_hidden_Operation = nil
+		return
+	}
+	x.xxx_hidden_Operation = &bulkOperation_Toggle{v}
+}
+
+func (x *BulkOperation) SetChangeCategory(v *BulkChangeCategoryOperation) {
+	if v == nil {
+		x.xxx_hidden_Operation = nil
+		return
+	}
+	x.xxx_hidden_Operation = &bulkOperation_ChangeCategory{v}
+}
+
+func (x *BulkOperation) SetChangeOwner(v *BulkChangeOwnerOperation) {
+	if v == nil {
+		x.xxx_hidden_Operation = nil
+		return
+	}
+	x.xxx_hidden_Operation = &bulkOperation_ChangeOwner{v}
+}
+
+func (x *BulkOperation) SetAccess(v *BulkAccessOperation) {
+	if v == nil {
+		x.xxx_hidden_Operation = nil
+		return
+	}
+	x.xxx_hidden_Operation = &bulkOperation_Access{v}
+}
+
+func (x *BulkOperation) SetDelete(v *BulkDeleteOperation) {
+	if v == nil {
+		x.xxx_hidden_Operation = nil
+		return
+	}
+	x.xxx_hidden_Operation = &bulkOperation_Delete{v}
+}
+
+func (x *BulkOperation) HasOperation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Operation != nil
+}
+
+func (x *BulkOperation) HasToggle() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Operation.(*bulkOperation_Toggle)
+	return ok
+}
+
+func (x *BulkOperation) HasChangeCategory() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Operation.(*bulkOperation_ChangeCategory)
+	return ok
+}
+
+func (x *BulkOperation) HasChangeOwner() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Operation.(*bulkOperation_ChangeOwner)
+	return ok
+}
+
+func (x *BulkOperation) HasAccess() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Operation.(*bulkOperation_Access)
+	return ok
+}
+
+func (x *BulkOperation) HasDelete() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Operation.(*bulkOperation_Delete)
+	return ok
+}
+
+func (x *BulkOperation) ClearOperation() {
+	x.xxx_hidden_Operation = nil
+}
+
+func (x *BulkOperation) ClearToggle() {
+	if _, ok := x.xxx_hidden_Operation.(*bulkOperation_Toggle); ok {
+		x.xxx_hidden_Operation = nil
+	}
+}
+
+func (x *BulkOperation) ClearChangeCategory() {
+	if _, ok := x.xxx_hidden_Operation.(*bulkOperation_ChangeCategory); ok {
+		x.xxx_hidden_Operation = nil
+	}
+}
+
+func (x *BulkOperation) ClearChangeOwner() {
+	if _, ok := x.xxx_hidden_Operation.(*bulkOperation_ChangeOwner); ok {
+		x.xxx_hidden_Operation = nil
+	}
+}
+
+func (x *BulkOperation) ClearAccess() {
+	if _, ok := x.xxx_hidden_Operation.(*bulkOperation_Access); ok {
+		x.xxx_hidden_Operation = nil
+	}
+}
+
+func (x *BulkOperation) ClearDelete() {
+	if _, ok := x.xxx_hidden_Operation.(*bulkOperation_Delete); ok {
+		x.xxx_hidden_Operation = nil
+	}
+}
+
+const BulkOperation_Operation_not_set_case case_BulkOperation_Operation = 0
+const BulkOperation_Toggle_case case_BulkOperation_Operation = 1
+const BulkOperation_ChangeCategory_case case_BulkOperation_Operation = 2
+const BulkOperation_ChangeOwner_case case_BulkOperation_Operation = 3
+const BulkOperation_Access_case case_BulkOperation_Operation = 4
+const BulkOperation_Delete_case case_BulkOperation_Operation = 5
+
+func (x *BulkOperation) WhichOperation() case_BulkOperation_Operation {
+	if x == nil {
+		return BulkOperation_Operation_not_set_case
+	}
+	switch x.xxx_hidden_Operation.(type) {
+	case *bulkOperation_Toggle:
+		return BulkOperation_Toggle_case
+	case *bulkOperation_ChangeCategory:
+		return BulkOperation_ChangeCategory_case
+	case *bulkOperation_ChangeOwner:
+		return BulkOperation_ChangeOwner_case
+	case *bulkOperation_Access:
+		return BulkOperation_Access_case
+	case *bulkOperation_Delete:
+		return BulkOperation_Delete_case
+	default:
+		return BulkOperation_Operation_not_set_case
+	}
+}
+
+type BulkOperation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Operation:
+	Toggle         *BulkToggleOperation
+	ChangeCategory *BulkChangeCategoryOperation
+	ChangeOwner    *BulkChangeOwnerOperation
+	Access         *BulkAccessOperation
+	Delete         *BulkDeleteOperation
+	// -- end of xxx_hidden_Operation
+}
+
+func (b0 BulkOperation_builder) Build() *BulkOperation {
+	m0 := &BulkOperation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Toggle != nil {
+		x.xxx_hidden_Operation = &bulkOperation_Toggle{b.Toggle}
+	}
+	if b.ChangeCategory != nil {
+		x.xxx_hidden_Operation = &bulkOperation_ChangeCategory{b.ChangeCategory}
+	}
+	if b.ChangeOwner != nil {
+		x.xxx_hidden_Operation = &bulkOperation_ChangeOwner{b.ChangeOwner}
+	}
+	if b.Access != nil {
+		x.xxx_hidden_Operation = &bulkOperation_Access{b.Access}
+	}
+	if b.Delete != nil {
+		x.xxx_hidden_Operation = &bulkOperation_Delete{b.Delete}
+	}
+	return m0
+}
+
+type case_BulkOperation_Operation protoreflect.FieldNumber
+
+func (x case_BulkOperation_Operation) String() string {
+	md := file_resources_documents_bulk_bulk_proto_msgTypes[3].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isBulkOperation_Operation interface {
+	isBulkOperation_Operation()
+}
+
+type bulkOperation_Toggle struct {
+	Toggle *BulkToggleOperation `protobuf:"bytes,1,opt,name=toggle,proto3,oneof"`
+}
+
+type bulkOperation_ChangeCategory struct {
+	ChangeCategory *BulkChangeCategoryOperation `protobuf:"bytes,2,opt,name=change_category,json=changeCategory,proto3,oneof"`
+}
+
+type bulkOperation_ChangeOwner struct {
+	ChangeOwner *BulkChangeOwnerOperation `protobuf:"bytes,3,opt,name=change_owner,json=changeOwner,proto3,oneof"`
+}
+
+type bulkOperation_Access struct {
+	Access *BulkAccessOperation `protobuf:"bytes,4,opt,name=access,proto3,oneof"`
+}
+
+type bulkOperation_Delete struct {
+	Delete *BulkDeleteOperation `protobuf:"bytes,5,opt,name=delete,proto3,oneof"`
+}
+
+func (*bulkOperation_Toggle) isBulkOperation_Operation() {}
+
+func (*bulkOperation_ChangeCategory) isBulkOperation_Operation() {}
+
+func (*bulkOperation_ChangeOwner) isBulkOperation_Operation() {}
+
+func (*bulkOperation_Access) isBulkOperation_Operation() {}
+
+func (*bulkOperation_Delete) isBulkOperation_Operation() {}
+
+type BulkToggleOperation struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Closed bool                   `protobuf:"varint,1,opt,name=closed,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BulkToggleOperation) Reset() {
+	*x = BulkToggleOperation{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkToggleOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkToggleOperation) ProtoMessage() {}
+
+func (x *BulkToggleOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkToggleOperation) GetClosed() bool {
+	if x != nil {
+		return x.xxx_hidden_Closed
+	}
+	return false
+}
+
+func (x *BulkToggleOperation) SetClosed(v bool) {
+	x.xxx_hidden_Closed = v
+}
+
+type BulkToggleOperation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Closed bool
+}
+
+func (b0 BulkToggleOperation_builder) Build() *BulkToggleOperation {
+	m0 := &BulkToggleOperation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Closed = b.Closed
+	return m0
+}
+
+type BulkChangeCategoryOperation struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CategoryId  int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BulkChangeCategoryOperation) Reset() {
+	*x = BulkChangeCategoryOperation{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkChangeCategoryOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkChangeCategoryOperation) ProtoMessage() {}
+
+func (x *BulkChangeCategoryOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkChangeCategoryOperation) GetCategoryId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CategoryId
+	}
+	return 0
+}
+
+func (x *BulkChangeCategoryOperation) SetCategoryId(v int64) {
+	x.xxx_hidden_CategoryId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *BulkChangeCategoryOperation) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *BulkChangeCategoryOperation) ClearCategoryId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CategoryId = 0
+}
+
+type BulkChangeCategoryOperation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unset removes the category from the documents
+	CategoryId *int64
+}
+
+func (b0 BulkChangeCategoryOperation_builder) Build() *BulkChangeCategoryOperation {
+	m0 := &BulkChangeCategoryOperation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CategoryId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_CategoryId = *b.CategoryId
+	}
+	return m0
+}
+
+type BulkChangeOwnerOperation struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NewUserId   int32                  `protobuf:"varint,1,opt,name=new_user_id,json=newUserId,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BulkChangeOwnerOperation) Reset() {
+	*x = BulkChangeOwnerOperation{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkChangeOwnerOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkChangeOwnerOperation) ProtoMessage() {}
+
+func (x *BulkChangeOwnerOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkChangeOwnerOperation) GetNewUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_NewUserId
+	}
+	return 0
+}
+
+func (x *BulkChangeOwnerOperation) SetNewUserId(v int32) {
+	x.xxx_hidden_NewUserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *BulkChangeOwnerOperation) HasNewUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *BulkChangeOwnerOperation) ClearNewUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_NewUserId = 0
+}
+
+type BulkChangeOwnerOperation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Only job admins can transfer documents to other users, defaults to the creator of the bulk job
+	NewUserId *int32
+}
+
+func (b0 BulkChangeOwnerOperation_builder) Build() *BulkChangeOwnerOperation {
+	m0 := &BulkChangeOwnerOperation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.NewUserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_NewUserId = *b.NewUserId
+	}
+	return m0
+}
+
+// Grants and revokes are merged into the current access of each document.
+type BulkAccessOperation struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GrantJobs   *[]*access.JobAccess   `protobuf:"bytes,1,rep,name=grant_jobs,json=grantJobs,proto3"`
+	xxx_hidden_GrantUsers  *[]*access.UserAccess  `protobuf:"bytes,2,rep,name=grant_users,json=grantUsers,proto3"`
+	xxx_hidden_RevokeJobs  []string               `protobuf:"bytes,3,rep,name=revoke_jobs,json=revokeJobs,proto3"`
+	xxx_hidden_RevokeUsers []int32                `protobuf:"varint,4,rep,packed,name=revoke_users,json=revokeUsers,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BulkAccessOperation) Reset() {
+	*x = BulkAccessOperation{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAccessOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAccessOperation) ProtoMessage() {}
+
+func (x *BulkAccessOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkAccessOperation) GetGrantJobs() []*access.JobAccess {
+	if x != nil {
+		if x.xxx_hidden_GrantJobs != nil {
+			return *x.xxx_hidden_GrantJobs
+		}
+	}
+	return nil
+}
+
+func (x *BulkAccessOperation) GetGrantUsers() []*access.UserAccess {
+	if x != nil {
+		if x.xxx_hidden_GrantUsers != nil {
+			return *x.xxx_hidden_GrantUsers
+		}
+	}
+	return nil
+}
+
+func (x *BulkAccessOperation) GetRevokeJobs() []string {
+	if x != nil {
+		return x.xxx_hidden_RevokeJobs
+	}
+	return nil
+}
+
+func (x *BulkAccessOperation) GetRevokeUsers() []int32 {
+	if x != nil {
+		return x.xxx_hidden_RevokeUsers
+	}
+	return nil
+}
+
+func (x *BulkAccessOperation) SetGrantJobs(v []*access.JobAccess) {
+	x.xxx_hidden_GrantJobs = &v
+}
+
+func (x *BulkAccessOperation) SetGrantUsers(v []*access.UserAccess) {
+	x.xxx_hidden_GrantUsers = &v
+}
+
+func (x *BulkAccessOperation) SetRevokeJobs(v []string) {
+	x.xxx_hidden_RevokeJobs = v
+}
+
+func (x *BulkAccessOperation) SetRevokeUsers(v []int32) {
+	x.xxx_hidden_RevokeUsers = v
+}
+
+type BulkAccessOperation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GrantJobs   []*access.JobAccess
+	GrantUsers  []*access.UserAccess
+	RevokeJobs  []string
+	RevokeUsers []int32
+}
+
+func (b0 BulkAccessOperation_builder) Build() *BulkAccessOperation {
+	m0 := &BulkAccessOperation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_GrantJobs = &b.GrantJobs
+	x.xxx_hidden_GrantUsers = &b.GrantUsers
+	x.xxx_hidden_RevokeJobs = b.RevokeJobs
+	x.xxx_hidden_RevokeUsers = b.RevokeUsers
+	return m0
+}
+
+type BulkDeleteOperation struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Reason string                 `protobuf:"bytes,1,opt,name=reason,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BulkDeleteOperation) Reset() {
+	*x = BulkDeleteOperation{}
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteOperation) ProtoMessage() {}
+
+func (x *BulkDeleteOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_bulk_bulk_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkDeleteOperation) GetReason() string {
+	if x != nil {
+		return x.xxx_hidden_Reason
+	}
+	return ""
+}
+
+func (x *BulkDeleteOperation) SetReason(v string) {
+	x.xxx_hidden_Reason = v
+}
+
+type BulkDeleteOperation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Reason string
+}
+
+func (b0 BulkDeleteOperation_builder) Build() *BulkDeleteOperation {
+	m0 := &BulkDeleteOperation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Reason = b.Reason
+	return m0
+}
+
+var File_resources_documents_bulk_bulk_proto protoreflect.FileDescriptor
+
+const file_resources_documents_bulk_bulk_proto_rawDesc = "" +
+	"\n" +
+	"#resources/documents/bulk/bulk.proto\x12\x18resources.documents.bulk\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a%resources/documents/forms/forms.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\x9c\a\n" +
+	"\aBulkJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12=\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampR\tcreatedAt\x12B\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tupdatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tstartedAt\x88\x01\x01\x12F\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\vcompletedAt\x88\x01\x01\x12\x10\n" +
+	"\x03job\x18\x06 \x01(\tR\x03job\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\a \x01(\x05R\tcreatorId\x12U\n" +
+	"\acreator\x18\b \x01(\v2 .resources.users.short.UserShortB\x14\x9a\x84\x9e\x03\x0falias:\"creator\"H\x03R\acreator\x88\x01\x01\x12?\n" +
+	"\x06status\x18\t \x01(\x0e2'.resources.documents.bulk.BulkJobStatusR\x06status\x12B\n" +
+	"\bselector\x18\n" +
+	" \x01(\v2&.resources.documents.bulk.BulkSelectorR\bselector\x12E\n" +
+	"\toperation\x18\v \x01(\v2'.resources.documents.bulk.BulkOperationR\toperation\x12\x1f\n" +
+	"\vtotal_count\x18\f \x01(\x03R\n" +
+	"totalCount\x12'\n" +
+	"\x0fprocessed_count\x18\r \x01(\x03R\x0eprocessedCount\x12'\n" +
+	"\x0fsucceeded_count\x18\x0e \x01(\x03R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x0f \x01(\x03R\vfailedCount\x12(\n" +
+	"\x10last_document_id\x18\x10 \x01(\x03R\x0elastDocumentId\x12\x19\n" +
+	"\x05error\x18\x11 \x01(\tH\x04R\x05error\x88\x01\x01B\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_started_atB\x0f\n" +
+	"\r_completed_atB\n" +
+	"\n" +
+	"\b_creatorB\b\n" +
+	"\x06_error\"\x87\x01\n" +
+	"\fBulkSelector\x12!\n" +
+	"\fdocument_ids\x18\x01 \x03(\x03R\vdocumentIds\x12A\n" +
+	"\x06filter\x18\x02 \x01(\v2$.resources.documents.bulk.BulkFilterH\x00R\x06filter\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\t\n" +
+	"\a_filter\"\xa1\x03\n" +
+	"\n" +
+	"BulkFilter\x12\x1b\n" +
+	"\x06search\x18\x01 \x01(\tH\x00R\x06search\x88\x01\x01\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\x03R\vcategoryIds\x12\x1f\n" +
+	"\vcreator_ids\x18\x03 \x03(\x05R\n" +
+	"creatorIds\x127\n" +
+	"\x04from\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\x04from\x88\x01\x01\x123\n" +
+	"\x02to\x18\x05 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\x02to\x88\x01\x01\x12\x1b\n" +
+	"\x06closed\x18\x06 \x01(\bH\x03R\x06closed\x88\x01\x01\x12$\n" +
+	"\vonly_drafts\x18\a \x01(\bH\x04R\n" +
+	"onlyDrafts\x88\x01\x01\x12K\n" +
+	"\vform_fields\x18\b \x03(\v2*.resources.documents.forms.FormFieldFilterR\n" +
+	"formFieldsB\t\n" +
+	"\a_searchB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\t\n" +
+	"\a_closedB\x0e\n" +
+	"\f_only_drafts\"\xba\x03\n" +
+	"\rBulkOperation\x12G\n" +
+	"\x06toggle\x18\x01 \x01(\v2-.resources.documents.bulk.BulkToggleOperationH\x00R\x06toggle\x12`\n" +
+	"\x0fchange_category\x18\x02 \x01(\v25.resources.documents.bulk.BulkChangeCategoryOperationH\x00R\x0echangeCategory\x12W\n" +
+	"\fchange_owner\x18\x03 \x01(\v22.resources.documents.bulk.BulkChangeOwnerOperationH\x00R\vchangeOwner\x12G\n" +
+	"\x06access\x18\x04 \x01(\v2-.resources.documents.bulk.BulkAccessOperationH\x00R\x06access\x12G\n" +
+	"\x06delete\x18\x05 \x01(\v2-.resources.documents.bulk.BulkDeleteOperationH\x00R\x06delete:\x06\xe2\xf3\x18\x02\b\x01B\v\n" +
+	"\toperation\"-\n" +
+	"\x13BulkToggleOperation\x12\x16\n" +
+	"\x06closed\x18\x01 \x01(\bR\x06closed\"S\n" +
+	"\x1bBulkChangeCategoryOperation\x12$\n" +
+	"\vcategory_id\x18\x01 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01B\x0e\n" +
+	"\f_category_id\"O\n" +
+	"\x18BulkChangeOwnerOperation\x12#\n" +
+	"\vnew_user_id\x18\x01 \x01(\x05H\x00R\tnewUserId\x88\x01\x01B\x0e\n" +
+	"\f_new_user_id\"\xd4\x01\n" +
+	"\x13BulkAccessOperation\x12:\n" +
+	"\n" +
+	"grant_jobs\x18\x01 \x03(\v2\x1b.resources.access.JobAccessR\tgrantJobs\x12=\n" +
+	"\vgrant_users\x18\x02 \x03(\v2\x1c.resources.access.UserAccessR\n" +
+	"grantUsers\x12\x1f\n" +
+	"\vrevoke_jobs\x18\x03 \x03(\tR\n" +
+	"revokeJobs\x12!\n" +
+	"\frevoke_users\x18\x04 \x03(\x05R\vrevokeUsers\"7\n" +
+	"\x13BulkDeleteOperation\x12 \n" +
+	"\x06reason\x18\x01 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x06reason*\xc4\x01\n" +
+	"\rBulkJobStatus\x12\x1f\n" +
+	"\x1bBULK_JOB_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17BULK_JOB_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17BULK_JOB_STATUS_RUNNING\x10\x02\x12\x1d\n" +
+	"\x19BULK_JOB_STATUS_COMPLETED\x10\x03\x12\x1a\n" +
+	"\x16BULK_JOB_STATUS_FAILED\x10\x04\x12\x1d\n" +
+	"\x19BULK_JOB_STATUS_CANCELLED\x10\x05BZZXgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/bulk;documentsbulkb\x06proto3"
+
+var file_resources_documents_bulk_bulk_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_documents_bulk_bulk_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_resources_documents_bulk_bulk_proto_goTypes = []any{
+	(BulkJobStatus)(0),                  // 0: resources.documents.bulk.BulkJobStatus
+	(*BulkJob)(nil),                     // 1: resources.documents.bulk.BulkJob
+	(*BulkSelector)(nil),                // 2: resources.documents.bulk.BulkSelector
+	(*BulkFilter)(nil),                  // 3: resources.documents.bulk.BulkFilter
+	(*BulkOperation)(nil),               // 4: resources.documents.bulk.BulkOperation
+	(*BulkToggleOperation)(nil),         // 5: resources.documents.bulk.BulkToggleOperation
+	(*BulkChangeCategoryOperation)(nil), // 6: resources.documents.bulk.BulkChangeCategoryOperation
+	(*BulkChangeOwnerOperation)(nil),    // 7: resources.documents.bulk.BulkChangeOwnerOperation
+	(*BulkAccessOperation)(nil),         // 8: resources.documents.bulk.BulkAccessOperation
+	(*BulkDeleteOperation)(nil),         // 9: resources.documents.bulk.BulkDeleteOperation
+	(*timestamp.Timestamp)(nil),         // 10: resources.timestamp.Timestamp
+	(*short.UserShort)(nil),             // 11: resources.users.short.UserShort
+	(*forms.FormFieldFilter)(nil),       // 12: resources.documents.forms.FormFieldFilter
+	(*access.JobAccess)(nil),            // 13: resources.access.JobAccess
+	(*access.UserAccess)(nil),           // 14: resources.access.UserAccess
+}
+var file_resources_documents_bulk_bulk_proto_depIdxs = []int32{
+	10, // 0: resources.documents.bulk.BulkJob.created_at:type_name -> resources.timestamp.Timestamp
+	10, // 1: resources.documents.bulk.BulkJob.updated_at:type_name -> resources.timestamp.Timestamp
+	10, // 2: resources.documents.bulk.BulkJob.started_at:type_name -> resources.timestamp.Timestamp
+	10, // 3: resources.documents.bulk.BulkJob.completed_at:type_name -> resources.timestamp.Timestamp
+	11, // 4: resources.documents.bulk.BulkJob.creator:type_name -> resources.users.short.UserShort
+	0,  // 5: resources.documents.bulk.BulkJob.status:type_name -> resources.documents.bulk.BulkJobStatus
+	2,  // 6: resources.documents.bulk.BulkJob.selector:type_name -> resources.documents.bulk.BulkSelector
+	4,  // 7: resources.documents.bulk.BulkJob.operation:type_name -> resources.documents.bulk.BulkOperation
+	3,  // 8: resources.documents.bulk.BulkSelector.filter:type_name -> resources.documents.bulk.BulkFilter
+	10, // 9: resources.documents.bulk.BulkFilter.from:type_name -> resources.timestamp.Timestamp
+	10, // 10: resources.documents.bulk.BulkFilter.to:type_name -> resources.timestamp.Timestamp
+	12, // 11: resources.documents.bulk.BulkFilter.form_fields:type_name -> resources.documents.forms.FormFieldFilter
+	5,  // 12: resources.documents.bulk.BulkOperation.toggle:type_name -> resources.documents.bulk.BulkToggleOperation
+	6,  // 13: resources.documents.bulk.BulkOperation.change_category:type_name -> resources.documents.bulk.BulkChangeCategoryOperation
+	7,  // 14: resources.documents.bulk.BulkOperation.change_owner:type_name -> resources.documents.bulk.BulkChangeOwnerOperation
+	8,  // 15: resources.documents.bulk.BulkOperation.access:type_name -> resources.documents.bulk.BulkAccessOperation
+	9,  // 16: resources.documents.bulk.BulkOperation.delete:type_name -> resources.documents.bulk.BulkDeleteOperation
+	13, // 17: resources.documents.bulk.BulkAccessOperation.grant_jobs:type_name -> resources.access.JobAccess
+	14, // 18: resources.documents.bulk.BulkAccessOperation.grant_users:type_name -> resources.access.UserAccess
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_resources_documents_bulk_bulk_proto_init() }
+func file_resources_documents_bulk_bulk_proto_init() {
+	if File_resources_documents_bulk_bulk_proto != nil {
+		return
+	}
+	file_resources_documents_bulk_bulk_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_documents_bulk_bulk_proto_msgTypes[1].OneofWrappers = []any{}
+	file_resources_documents_bulk_bulk_proto_msgTypes[2].OneofWrappers = []any{}
+	file_resources_documents_bulk_bulk_proto_msgTypes[3].OneofWrappers = []any{
+		(*bulkOperation_Toggle)(nil),
+		(*bulkOperation_ChangeCategory)(nil),
+		(*bulkOperation_ChangeOwner)(nil),
+		(*bulkOperation_Access)(nil),
+		(*bulkOperation_Delete)(nil),
+	}
+	file_resources_documents_bulk_bulk_proto_msgTypes[5].OneofWrappers = []any{}
+	file_resources_documents_bulk_bulk_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_bulk_bulk_proto_rawDesc), len(file_resources_documents_bulk_bulk_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_documents_bulk_bulk_proto_goTypes,
+		DependencyIndexes: file_resources_documents_bulk_bulk_proto_depIdxs,
+		EnumInfos:         file_resources_documents_bulk_bulk_proto_enumTypes,
+		MessageInfos:      file_resources_documents_bulk_bulk_proto_msgTypes,
+	}.Build()
+	File_resources_documents_bulk_bulk_proto = out.File
+	file_resources_documents_bulk_bulk_proto_goTypes = nil
+	file_resources_documents_bulk_bulk_proto_depIdxs = nil
+}
@@ -0,0 +1,58 @@
+package documentsbulk
+
+import (
+	"testing"
+
+	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkAccessOperationApply(t *testing.T) {
+	current := &resourcesaccess.Access{
+		Jobs: []*resourcesaccess.JobAccess{
+			{Id: 1, Job: "police", MinimumGrade: 0, Access: 2},
+			{Id: 2, Job: "police", MinimumGrade: 5, Access: 4},
+			{Id: 3, Job: "ambulance", MinimumGrade: 0, Access: 2},
+		},
+		Users: []*resourcesaccess.UserAccess{
+			{Id: 4, UserId: 1, Access: 2},
+			{Id: 5, UserId: 2, Access: 2},
+		},
+	}
+
+	op := &BulkAccessOperation{
+		GrantJobs: []*resourcesaccess.JobAccess{
+			{Job: "police", MinimumGrade: 0, Access: 3},
+			{Job: "doj", MinimumGrade: 2, Access: 2},
+		},
+		GrantUsers:  []*resourcesaccess.UserAccess{{UserId: 3, Access: 4}},
+		RevokeJobs:  []string{"ambulance"},
+		RevokeUsers: []int32{2},
+	}
+
+	out := op.Apply(current)
+	require.Len(t, out.GetJobs(), 3)
+	assert.Equal(t, int64(2), out.GetJobs()[0].GetId())
+	assert.Equal(t, "police", out.GetJobs()[1].GetJob())
+	assert.Equal(t, int32(3), out.GetJobs()[1].GetAccess())
+	assert.Equal(t, "doj", out.GetJobs()[2].GetJob())
+
+	require.Len(t, out.GetUsers(), 2)
+	assert.Equal(t, int32(1), out.GetUsers()[0].GetUserId())
+	assert.Equal(t, int32(3), out.GetUsers()[1].GetUserId())
+
+	// Current access is left untouched
+	assert.Len(t, current.GetJobs(), 3)
+	assert.Len(t, current.GetUsers(), 2)
+
+	out = op.Apply(nil)
+	assert.Len(t, out.GetJobs(), 2)
+	assert.Len(t, out.GetUsers(), 1)
+}
+
+func TestBulkSelectorIsEmpty(t *testing.T) {
+	assert.True(t, (&BulkSelector{}).IsEmpty())
+	assert.False(t, (&BulkSelector{DocumentIds: []int64{1}}).IsEmpty())
+	assert.False(t, (&BulkSelector{Filter: &BulkFilter{}}).IsEmpty())
+}
@@ -0,0 +1,74 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/documents/category/category.proto
+
+package documentscategory
+
+import (
+	"database/sql/driver"
+
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+)
+
+// Scan implements driver.Valuer for protobuf RetentionPolicy.
+func (x *RetentionPolicy) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the RetentionPolicy value into driver.Valuer.
+func (x *RetentionPolicy) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
+
+// Scan implements driver.Valuer for protobuf StateMachine.
+func (x *StateMachine) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the StateMachine value into driver.Valuer.
+func (x *StateMachine) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
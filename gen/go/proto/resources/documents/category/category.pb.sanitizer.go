@@ -55,5 +55,118 @@ func (m *Category) Sanitize() error {
 	// Field: Name
 	m.Name = htmlsanitizer.SanitizeAndUnescape(m.Name)
 
+	// Field: RetentionPolicy
+	if m.RetentionPolicy != nil {
+		if v, ok := any(m.GetRetentionPolicy()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: StateMachine
+	if m.StateMachine != nil {
+		if v, ok := any(m.GetStateMachine()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DocumentState) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Color
+	if m.Color != nil {
+		*m.Color = htmlsanitizer.StripHTMLTags(*m.Color)
+	}
+
+	// Field: Name
+	m.Name = htmlsanitizer.StripHTMLTags(m.Name)
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *RetentionReport) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Job
+	m.Job = htmlsanitizer.SanitizeAndUnescape(m.Job)
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *StateMachine) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: InitialState
+	m.InitialState = htmlsanitizer.SanitizeAndUnescape(m.InitialState)
+
+	// Field: States
+	for idx, item := range m.States {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: Transitions
+	for idx, item := range m.Transitions {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *StateTransition) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Effects
+	for idx, item := range m.Effects {
+		_, _ = idx, item
+
+	}
+
+	// Field: From
+	m.From = htmlsanitizer.SanitizeAndUnescape(m.From)
+
+	// Field: Label
+	if m.Label != nil {
+		*m.Label = htmlsanitizer.StripHTMLTags(*m.Label)
+	}
+
+	// Field: To
+	m.To = htmlsanitizer.SanitizeAndUnescape(m.To)
+
 	return nil
 }
@@ -9,6 +9,7 @@
 package documentscategory
 
 import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/dbscanner"
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -24,20 +25,123 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StateTransitionEffect int32
+
+const (
+	StateTransitionEffect_STATE_TRANSITION_EFFECT_UNSPECIFIED StateTransitionEffect = 0
+	// Document must be approved (at least one approval policy fully satisfied)
+	StateTransitionEffect_STATE_TRANSITION_EFFECT_REQUIRE_APPROVAL StateTransitionEffect = 1
+	StateTransitionEffect_STATE_TRANSITION_EFFECT_LOCK_CONTENT     StateTransitionEffect = 2
+	StateTransitionEffect_STATE_TRANSITION_EFFECT_UNLOCK_CONTENT   StateTransitionEffect = 3
+	StateTransitionEffect_STATE_TRANSITION_EFFECT_NOTIFY_OWNER     StateTransitionEffect = 4
+	StateTransitionEffect_STATE_TRANSITION_EFFECT_CLOSE            StateTransitionEffect = 5
+)
+
+// Enum value maps for StateTransitionEffect.
+var (
+	StateTransitionEffect_name = map[int32]string{
+		0: "STATE_TRANSITION_EFFECT_UNSPECIFIED",
+		1: "STATE_TRANSITION_EFFECT_REQUIRE_APPROVAL",
+		2: "STATE_TRANSITION_EFFECT_LOCK_CONTENT",
+		3: "STATE_TRANSITION_EFFECT_UNLOCK_CONTENT",
+		4: "STATE_TRANSITION_EFFECT_NOTIFY_OWNER",
+		5: "STATE_TRANSITION_EFFECT_CLOSE",
+	}
+	StateTransitionEffect_value = map[string]int32{
+		"STATE_TRANSITION_EFFECT_UNSPECIFIED":      0,
+		"STATE_TRANSITION_EFFECT_REQUIRE_APPROVAL": 1,
+		"STATE_TRANSITION_EFFECT_LOCK_CONTENT":     2,
+		"STATE_TRANSITION_EFFECT_UNLOCK_CONTENT":   3,
+		"STATE_TRANSITION_EFFECT_NOTIFY_OWNER":     4,
+		"STATE_TRANSITION_EFFECT_CLOSE":            5,
+	}
+)
+
+func (x StateTransitionEffect) Enum() *StateTransitionEffect {
+	p := new(StateTransitionEffect)
+	*p = x
+	return p
+}
+
+func (x StateTransitionEffect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateTransitionEffect) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_documents_category_category_proto_enumTypes[0].Descriptor()
+}
+
+func (StateTransitionEffect) Type() protoreflect.EnumType {
+	return &file_resources_documents_category_category_proto_enumTypes[0]
+}
+
+func (x StateTransitionEffect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type RetentionAction int32
+
+const (
+	RetentionAction_RETENTION_ACTION_UNSPECIFIED RetentionAction = 0
+	RetentionAction_RETENTION_ACTION_CLOSE       RetentionAction = 1
+	RetentionAction_RETENTION_ACTION_ARCHIVE     RetentionAction = 2
+	RetentionAction_RETENTION_ACTION_DELETE      RetentionAction = 3
+)
+
+// Enum value maps for RetentionAction.
+var (
+	RetentionAction_name = map[int32]string{
+		0: "RETENTION_ACTION_UNSPECIFIED",
+		1: "RETENTION_ACTION_CLOSE",
+		2: "RETENTION_ACTION_ARCHIVE",
+		3: "RETENTION_ACTION_DELETE",
+	}
+	RetentionAction_value = map[string]int32{
+		"RETENTION_ACTION_UNSPECIFIED": 0,
+		"RETENTION_ACTION_CLOSE":       1,
+		"RETENTION_ACTION_ARCHIVE":     2,
+		"RETENTION_ACTION_DELETE":      3,
+	}
+)
+
+func (x RetentionAction) Enum() *RetentionAction {
+	p := new(RetentionAction)
+	*p = x
+	return p
+}
+
+func (x RetentionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetentionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_documents_category_category_proto_enumTypes[1].Descriptor()
+}
+
+func (RetentionAction) Type() protoreflect.EnumType {
+	return &file_resources_documents_category_category_proto_enumTypes[1]
+}
+
+func (x RetentionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type Category struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          int64                  `protobuf:"varint,1,opt,name=id,proto3"`
-	xxx_hidden_CreatedAt   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3"`
-	xxx_hidden_DeletedAt   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3,oneof"`
-	xxx_hidden_Name        string                 `protobuf:"bytes,4,opt,name=name,proto3"`
-	xxx_hidden_Description *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof"`
-	xxx_hidden_Job         *string                `protobuf:"bytes,6,opt,name=job,proto3,oneof"`
-	xxx_hidden_Color       *string                `protobuf:"bytes,7,opt,name=color,proto3,oneof"`
-	xxx_hidden_Icon        *string                `protobuf:"bytes,8,opt,name=icon,proto3,oneof"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id              int64                  `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_DeletedAt       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3,oneof"`
+	xxx_hidden_Name            string                 `protobuf:"bytes,4,opt,name=name,proto3"`
+	xxx_hidden_Description     *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof"`
+	xxx_hidden_Job             *string                `protobuf:"bytes,6,opt,name=job,proto3,oneof"`
+	xxx_hidden_Color           *string                `protobuf:"bytes,7,opt,name=color,proto3,oneof"`
+	xxx_hidden_Icon            *string                `protobuf:"bytes,8,opt,name=icon,proto3,oneof"`
+	xxx_hidden_StateMachine    *StateMachine          `protobuf:"bytes,9,opt,name=state_machine,json=stateMachine,proto3,oneof"`
+	xxx_hidden_RetentionPolicy *RetentionPolicy       `protobuf:"bytes,10,opt,name=retention_policy,json=retentionPolicy,proto3,oneof"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
@@ -133,6 +237,20 @@ func (x *Category) GetIcon() string {
 	return ""
 }
 
+func (x *Category) GetStateMachine() *StateMachine {
+	if x != nil {
+		return x.xxx_hidden_StateMachine
+	}
+	return nil
+}
+
+func (x *Category) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.xxx_hidden_RetentionPolicy
+	}
+	return nil
+}
+
 func (x *Category) SetId(v int64) {
 	x.xxx_hidden_Id = v
 }
@@ -151,22 +269,30 @@ func (x *Category) SetName(v string) {
 
 func (x *Category) SetDescription(v string) {
 	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 10)
 }
 
 func (x *Category) SetJob(v string) {
 	x.xxx_hidden_Job = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 10)
 }
 
 func (x *Category) SetColor(v string) {
 	x.xxx_hidden_Color = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 10)
 }
 
 func (x *Category) SetIcon(v string) {
 	x.xxx_hidden_Icon = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 10)
+}
+
+func (x *Category) SetStateMachine(v *StateMachine) {
+	x.xxx_hidden_StateMachine = v
+}
+
+func (x *Category) SetRetentionPolicy(v *RetentionPolicy) {
+	x.xxx_hidden_RetentionPolicy = v
 }
 
 func (x *Category) HasCreatedAt() bool {
@@ -211,6 +337,20 @@ func (x *Category) HasIcon() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
 }
 
+func (x *Category) HasStateMachine() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StateMachine != nil
+}
+
+func (x *Category) HasRetentionPolicy() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RetentionPolicy != nil
+}
+
 func (x *Category) ClearCreatedAt() {
 	x.xxx_hidden_CreatedAt = nil
 }
@@ -239,17 +379,27 @@ func (x *Category) ClearIcon() {
 	x.xxx_hidden_Icon = nil
 }
 
+func (x *Category) ClearStateMachine() {
+	x.xxx_hidden_StateMachine = nil
+}
+
+func (x *Category) ClearRetentionPolicy() {
+	x.xxx_hidden_RetentionPolicy = nil
+}
+
 type Category_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id          int64
-	CreatedAt   *timestamp.Timestamp
-	DeletedAt   *timestamp.Timestamp
-	Name        string
-	Description *string
-	Job         *string
-	Color       *string
-	Icon        *string
+	Id              int64
+	CreatedAt       *timestamp.Timestamp
+	DeletedAt       *timestamp.Timestamp
+	Name            string
+	Description     *string
+	Job             *string
+	Color           *string
+	Icon            *string
+	StateMachine    *StateMachine
+	RetentionPolicy *RetentionPolicy
 }
 
 func (b0 Category_builder) Build() *Category {
@@ -261,21 +411,666 @@ func (b0 Category_builder) Build() *Category {
 	x.xxx_hidden_DeletedAt = b.DeletedAt
 	x.xxx_hidden_Name = b.Name
 	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 10)
 		x.xxx_hidden_Description = b.Description
 	}
 	if b.Job != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 10)
 		x.xxx_hidden_Job = b.Job
 	}
 	if b.Color != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 10)
 		x.xxx_hidden_Color = b.Color
 	}
 	if b.Icon != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 10)
 		x.xxx_hidden_Icon = b.Icon
 	}
+	x.xxx_hidden_StateMachine = b.StateMachine
+	x.xxx_hidden_RetentionPolicy = b.RetentionPolicy
+	return m0
+}
+
+// State machine which restricts the states and state transitions of the category's documents.
+type StateMachine struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Enabled      bool                   `protobuf:"varint,1,opt,name=enabled,proto3"`
+	xxx_hidden_InitialState string                 `protobuf:"bytes,2,opt,name=initial_state,json=initialState,proto3"`
+	xxx_hidden_States       *[]*DocumentState      `protobuf:"bytes,3,rep,name=states,proto3"`
+	xxx_hidden_Transitions  *[]*StateTransition    `protobuf:"bytes,4,rep,name=transitions,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *StateMachine) Reset() {
+	*x = StateMachine{}
+	mi := &file_resources_documents_category_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateMachine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMachine) ProtoMessage() {}
+
+func (x *StateMachine) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_category_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StateMachine) GetEnabled() bool {
+	if x != nil {
+		return x.xxx_hidden_Enabled
+	}
+	return false
+}
+
+func (x *StateMachine) GetInitialState() string {
+	if x != nil {
+		return x.xxx_hidden_InitialState
+	}
+	return ""
+}
+
+func (x *StateMachine) GetStates() []*DocumentState {
+	if x != nil {
+		if x.xxx_hidden_States != nil {
+			return *x.xxx_hidden_States
+		}
+	}
+	return nil
+}
+
+func (x *StateMachine) GetTransitions() []*StateTransition {
+	if x != nil {
+		if x.xxx_hidden_Transitions != nil {
+			return *x.xxx_hidden_Transitions
+		}
+	}
+	return nil
+}
+
+func (x *StateMachine) SetEnabled(v bool) {
+	x.xxx_hidden_Enabled = v
+}
+
+func (x *StateMachine) SetInitialState(v string) {
+	x.xxx_hidden_InitialState = v
+}
+
+func (x *StateMachine) SetStates(v []*DocumentState) {
+	x.xxx_hidden_States = &v
+}
+
+func (x *StateMachine) SetTransitions(v []*StateTransition) {
+	x.xxx_hidden_Transitions = &v
+}
+
+type StateMachine_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Enabled bool
+	// State new documents of the category start in
+	InitialState string
+	States       []*DocumentState
+	Transitions  []*StateTransition
+}
+
+func (b0 StateMachine_builder) Build() *StateMachine {
+	m0 := &StateMachine{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Enabled = b.Enabled
+	x.xxx_hidden_InitialState = b.InitialState
+	x.xxx_hidden_States = &b.States
+	x.xxx_hidden_Transitions = &b.Transitions
+	return m0
+}
+
+type DocumentState struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Color       *string                `protobuf:"bytes,2,opt,name=color,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DocumentState) Reset() {
+	*x = DocumentState{}
+	mi := &file_resources_documents_category_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentState) ProtoMessage() {}
+
+func (x *DocumentState) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_category_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DocumentState) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *DocumentState) GetColor() string {
+	if x != nil {
+		if x.xxx_hidden_Color != nil {
+			return *x.xxx_hidden_Color
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DocumentState) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *DocumentState) SetColor(v string) {
+	x.xxx_hidden_Color = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *DocumentState) HasColor() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DocumentState) ClearColor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Color = nil
+}
+
+type DocumentState_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name  string
+	Color *string
+}
+
+func (b0 DocumentState_builder) Build() *DocumentState {
+	m0 := &DocumentState{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	if b.Color != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Color = b.Color
+	}
+	return m0
+}
+
+type StateTransition struct {
+	state                   protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_From         string                  `protobuf:"bytes,1,opt,name=from,proto3"`
+	xxx_hidden_To           string                  `protobuf:"bytes,2,opt,name=to,proto3"`
+	xxx_hidden_Label        *string                 `protobuf:"bytes,3,opt,name=label,proto3,oneof"`
+	xxx_hidden_MinimumGrade int32                   `protobuf:"varint,4,opt,name=minimum_grade,json=minimumGrade,proto3,oneof"`
+	xxx_hidden_Effects      []StateTransitionEffect `protobuf:"varint,5,rep,packed,name=effects,proto3,enum=resources.documents.category.StateTransitionEffect"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *StateTransition) Reset() {
+	*x = StateTransition{}
+	mi := &file_resources_documents_category_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateTransition) ProtoMessage() {}
+
+func (x *StateTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_category_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StateTransition) GetFrom() string {
+	if x != nil {
+		return x.xxx_hidden_From
+	}
+	return ""
+}
+
+func (x *StateTransition) GetTo() string {
+	if x != nil {
+		return x.xxx_hidden_To
+	}
+	return ""
+}
+
+func (x *StateTransition) GetLabel() string {
+	if x != nil {
+		if x.xxx_hidden_Label != nil {
+			return *x.xxx_hidden_Label
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *StateTransition) GetMinimumGrade() int32 {
+	if x != nil {
+		return x.xxx_hidden_MinimumGrade
+	}
+	return 0
+}
+
+func (x *StateTransition) GetEffects() []StateTransitionEffect {
+	if x != nil {
+		return x.xxx_hidden_Effects
+	}
+	return nil
+}
+
+func (x *StateTransition) SetFrom(v string) {
+	x.xxx_hidden_From = v
+}
+
+func (x *StateTransition) SetTo(v string) {
+	x.xxx_hidden_To = v
+}
+
+func (x *StateTransition) SetLabel(v string) {
+	x.xxx_hidden_Label = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *StateTransition) SetMinimumGrade(v int32) {
+	x.xxx_hidden_MinimumGrade = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *StateTransition) SetEffects(v []StateTransitionEffect) {
+	x.xxx_hidden_Effects = v
+}
+
+func (x *StateTransition) HasLabel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *StateTransition) HasMinimumGrade() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *StateTransition) ClearLabel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Label = nil
+}
+
+func (x *StateTransition) ClearMinimumGrade() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MinimumGrade = 0
+}
+
+type StateTransition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Empty matches any state
+	From  string
+	To    string
+	Label *string
+	// Minimum job grade (of the category's job) required to perform the transition
+	MinimumGrade *int32
+	Effects      []StateTransitionEffect
+}
+
+func (b0 StateTransition_builder) Build() *StateTransition {
+	m0 := &StateTransition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_From = b.From
+	x.xxx_hidden_To = b.To
+	if b.Label != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Label = b.Label
+	}
+	if b.MinimumGrade != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_MinimumGrade = *b.MinimumGrade
+	}
+	x.xxx_hidden_Effects = b.Effects
+	return m0
+}
+
+// Retention policy which is applied to the category's documents by a background job.
+// The ages are based on the document's creation date, documents on legal hold are skipped.
+type RetentionPolicy struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Enabled          bool                   `protobuf:"varint,1,opt,name=enabled,proto3"`
+	xxx_hidden_DryRun           bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3"`
+	xxx_hidden_CloseAfterDays   int32                  `protobuf:"varint,3,opt,name=close_after_days,json=closeAfterDays,proto3,oneof"`
+	xxx_hidden_ArchiveAfterDays int32                  `protobuf:"varint,4,opt,name=archive_after_days,json=archiveAfterDays,proto3,oneof"`
+	xxx_hidden_DeleteAfterDays  int32                  `protobuf:"varint,5,opt,name=delete_after_days,json=deleteAfterDays,proto3,oneof"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_resources_documents_category_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_category_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RetentionPolicy) GetEnabled() bool {
+	if x != nil {
+		return x.xxx_hidden_Enabled
+	}
+	return false
+}
+
+func (x *RetentionPolicy) GetDryRun() bool {
+	if x != nil {
+		return x.xxx_hidden_DryRun
+	}
+	return false
+}
+
+func (x *RetentionPolicy) GetCloseAfterDays() int32 {
+	if x != nil {
+		return x.xxx_hidden_CloseAfterDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetArchiveAfterDays() int32 {
+	if x != nil {
+		return x.xxx_hidden_ArchiveAfterDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetDeleteAfterDays() int32 {
+	if x != nil {
+		return x.xxx_hidden_DeleteAfterDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) SetEnabled(v bool) {
+	x.xxx_hidden_Enabled = v
+}
+
+func (x *RetentionPolicy) SetDryRun(v bool) {
+	x.xxx_hidden_DryRun = v
+}
+
+func (x *RetentionPolicy) SetCloseAfterDays(v int32) {
+	x.xxx_hidden_CloseAfterDays = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *RetentionPolicy) SetArchiveAfterDays(v int32) {
+	x.xxx_hidden_ArchiveAfterDays = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *RetentionPolicy) SetDeleteAfterDays(v int32) {
+	x.xxx_hidden_DeleteAfterDays = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *RetentionPolicy) HasCloseAfterDays() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RetentionPolicy) HasArchiveAfterDays() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *RetentionPolicy) HasDeleteAfterDays() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *RetentionPolicy) ClearCloseAfterDays() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CloseAfterDays = 0
+}
+
+func (x *RetentionPolicy) ClearArchiveAfterDays() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ArchiveAfterDays = 0
+}
+
+func (x *RetentionPolicy) ClearDeleteAfterDays() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_DeleteAfterDays = 0
+}
+
+type RetentionPolicy_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Enabled bool
+	// Only report the documents the policy would act on without changing them
+	DryRun           bool
+	CloseAfterDays   *int32
+	ArchiveAfterDays *int32
+	DeleteAfterDays  *int32
+}
+
+func (b0 RetentionPolicy_builder) Build() *RetentionPolicy {
+	m0 := &RetentionPolicy{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Enabled = b.Enabled
+	x.xxx_hidden_DryRun = b.DryRun
+	if b.CloseAfterDays != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_CloseAfterDays = *b.CloseAfterDays
+	}
+	if b.ArchiveAfterDays != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_ArchiveAfterDays = *b.ArchiveAfterDays
+	}
+	if b.DeleteAfterDays != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_DeleteAfterDays = *b.DeleteAfterDays
+	}
+	return m0
+}
+
+// Report of a retention policy run for a category, stored in the audit log.
+type RetentionReport struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CategoryId  int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3"`
+	xxx_hidden_Job         string                 `protobuf:"bytes,2,opt,name=job,proto3"`
+	xxx_hidden_Action      RetentionAction        `protobuf:"varint,3,opt,name=action,proto3,enum=resources.documents.category.RetentionAction"`
+	xxx_hidden_DryRun      bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3"`
+	xxx_hidden_Count       int64                  `protobuf:"varint,5,opt,name=count,proto3"`
+	xxx_hidden_DocumentIds []int64                `protobuf:"varint,6,rep,packed,name=document_ids,json=documentIds,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RetentionReport) Reset() {
+	*x = RetentionReport{}
+	mi := &file_resources_documents_category_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionReport) ProtoMessage() {}
+
+func (x *RetentionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_category_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RetentionReport) GetCategoryId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CategoryId
+	}
+	return 0
+}
+
+func (x *RetentionReport) GetJob() string {
+	if x != nil {
+		return x.xxx_hidden_Job
+	}
+	return ""
+}
+
+func (x *RetentionReport) GetAction() RetentionAction {
+	if x != nil {
+		return x.xxx_hidden_Action
+	}
+	return RetentionAction_RETENTION_ACTION_UNSPECIFIED
+}
+
+func (x *RetentionReport) GetDryRun() bool {
+	if x != nil {
+		return x.xxx_hidden_DryRun
+	}
+	return false
+}
+
+func (x *RetentionReport) GetCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *RetentionReport) GetDocumentIds() []int64 {
+	if x != nil {
+		return x.xxx_hidden_DocumentIds
+	}
+	return nil
+}
+
+func (x *RetentionReport) SetCategoryId(v int64) {
+	x.xxx_hidden_CategoryId = v
+}
+
+func (x *RetentionReport) SetJob(v string) {
+	x.xxx_hidden_Job = v
+}
+
+func (x *RetentionReport) SetAction(v RetentionAction) {
+	x.xxx_hidden_Action = v
+}
+
+func (x *RetentionReport) SetDryRun(v bool) {
+	x.xxx_hidden_DryRun = v
+}
+
+func (x *RetentionReport) SetCount(v int64) {
+	x.xxx_hidden_Count = v
+}
+
+func (x *RetentionReport) SetDocumentIds(v []int64) {
+	x.xxx_hidden_DocumentIds = v
+}
+
+type RetentionReport_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CategoryId  int64
+	Job         string
+	Action      RetentionAction
+	DryRun      bool
+	Count       int64
+	DocumentIds []int64
+}
+
+func (b0 RetentionReport_builder) Build() *RetentionReport {
+	m0 := &RetentionReport{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_CategoryId = b.CategoryId
+	x.xxx_hidden_Job = b.Job
+	x.xxx_hidden_Action = b.Action
+	x.xxx_hidden_DryRun = b.DryRun
+	x.xxx_hidden_Count = b.Count
+	x.xxx_hidden_DocumentIds = b.DocumentIds
 	return m0
 }
 
@@ -283,7 +1078,7 @@ var File_resources_documents_category_category_proto protoreflect.FileDescriptor
 
 const file_resources_documents_category_category_proto_rawDesc = "" +
 	"\n" +
-	"+resources/documents/category/category.proto\x12\x1cresources.documents.category\x1a!codegen/sanitizer/sanitizer.proto\x1a#resources/timestamp/timestamp.proto\"\x81\x03\n" +
+	"+resources/documents/category/category.proto\x12\x1cresources.documents.category\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a#resources/timestamp/timestamp.proto\"\xdd\x04\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12=\n" +
 	"\n" +
@@ -294,26 +1089,91 @@ const file_resources_documents_category_category_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x01R\vdescription\x88\x01\x01\x12\x15\n" +
 	"\x03job\x18\x06 \x01(\tH\x02R\x03job\x88\x01\x01\x12#\n" +
 	"\x05color\x18\a \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x03R\x05color\x88\x01\x01\x12!\n" +
-	"\x04icon\x18\b \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x04R\x04icon\x88\x01\x01B\r\n" +
+	"\x04icon\x18\b \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x04R\x04icon\x88\x01\x01\x12T\n" +
+	"\rstate_machine\x18\t \x01(\v2*.resources.documents.category.StateMachineH\x05R\fstateMachine\x88\x01\x01\x12]\n" +
+	"\x10retention_policy\x18\n" +
+	" \x01(\v2-.resources.documents.category.RetentionPolicyH\x06R\x0fretentionPolicy\x88\x01\x01B\r\n" +
 	"\v_deleted_atB\x0e\n" +
 	"\f_descriptionB\x06\n" +
 	"\x04_jobB\b\n" +
 	"\x06_colorB\a\n" +
-	"\x05_iconBbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category;documentscategoryb\x06proto3"
+	"\x05_iconB\x10\n" +
+	"\x0e_state_machineB\x13\n" +
+	"\x11_retention_policy\"\xeb\x01\n" +
+	"\fStateMachine\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rinitial_state\x18\x02 \x01(\tR\finitialState\x12C\n" +
+	"\x06states\x18\x03 \x03(\v2+.resources.documents.category.DocumentStateR\x06states\x12O\n" +
+	"\vtransitions\x18\x04 \x03(\v2-.resources.documents.category.StateTransitionR\vtransitions:\x06\xe2\xf3\x18\x02\b\x01\"\\\n" +
+	"\rDocumentState\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x04name\x12#\n" +
+	"\x05color\x18\x02 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x00R\x05color\x88\x01\x01B\b\n" +
+	"\x06_color\"\xef\x01\n" +
+	"\x0fStateTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12#\n" +
+	"\x05label\x18\x03 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x00R\x05label\x88\x01\x01\x12(\n" +
+	"\rminimum_grade\x18\x04 \x01(\x05H\x01R\fminimumGrade\x88\x01\x01\x12M\n" +
+	"\aeffects\x18\x05 \x03(\x0e23.resources.documents.category.StateTransitionEffectR\aeffectsB\b\n" +
+	"\x06_labelB\x10\n" +
+	"\x0e_minimum_grade\"\xa1\x02\n" +
+	"\x0fRetentionPolicy\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12-\n" +
+	"\x10close_after_days\x18\x03 \x01(\x05H\x00R\x0ecloseAfterDays\x88\x01\x01\x121\n" +
+	"\x12archive_after_days\x18\x04 \x01(\x05H\x01R\x10archiveAfterDays\x88\x01\x01\x12/\n" +
+	"\x11delete_after_days\x18\x05 \x01(\x05H\x02R\x0fdeleteAfterDays\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\x13\n" +
+	"\x11_close_after_daysB\x15\n" +
+	"\x13_archive_after_daysB\x14\n" +
+	"\x12_delete_after_days\"\xdd\x01\n" +
+	"\x0fRetentionReport\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x10\n" +
+	"\x03job\x18\x02 \x01(\tR\x03job\x12E\n" +
+	"\x06action\x18\x03 \x01(\x0e2-.resources.documents.category.RetentionActionR\x06action\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x03R\x05count\x12!\n" +
+	"\fdocument_ids\x18\x06 \x03(\x03R\vdocumentIds*\x91\x02\n" +
+	"\x15StateTransitionEffect\x12'\n" +
+	"#STATE_TRANSITION_EFFECT_UNSPECIFIED\x10\x00\x12,\n" +
+	"(STATE_TRANSITION_EFFECT_REQUIRE_APPROVAL\x10\x01\x12(\n" +
+	"$STATE_TRANSITION_EFFECT_LOCK_CONTENT\x10\x02\x12*\n" +
+	"&STATE_TRANSITION_EFFECT_UNLOCK_CONTENT\x10\x03\x12(\n" +
+	"$STATE_TRANSITION_EFFECT_NOTIFY_OWNER\x10\x04\x12!\n" +
+	"\x1dSTATE_TRANSITION_EFFECT_CLOSE\x10\x05*\x8a\x01\n" +
+	"\x0fRetentionAction\x12 \n" +
+	"\x1cRETENTION_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RETENTION_ACTION_CLOSE\x10\x01\x12\x1c\n" +
+	"\x18RETENTION_ACTION_ARCHIVE\x10\x02\x12\x1b\n" +
+	"\x17RETENTION_ACTION_DELETE\x10\x03BbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category;documentscategoryb\x06proto3"
 
-var file_resources_documents_category_category_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resources_documents_category_category_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_resources_documents_category_category_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_resources_documents_category_category_proto_goTypes = []any{
-	(*Category)(nil),            // 0: resources.documents.category.Category
-	(*timestamp.Timestamp)(nil), // 1: resources.timestamp.Timestamp
+	(StateTransitionEffect)(0),  // 0: resources.documents.category.StateTransitionEffect
+	(RetentionAction)(0),        // 1: resources.documents.category.RetentionAction
+	(*Category)(nil),            // 2: resources.documents.category.Category
+	(*StateMachine)(nil),        // 3: resources.documents.category.StateMachine
+	(*DocumentState)(nil),       // 4: resources.documents.category.DocumentState
+	(*StateTransition)(nil),     // 5: resources.documents.category.StateTransition
+	(*RetentionPolicy)(nil),     // 6: resources.documents.category.RetentionPolicy
+	(*RetentionReport)(nil),     // 7: resources.documents.category.RetentionReport
+	(*timestamp.Timestamp)(nil), // 8: resources.timestamp.Timestamp
 }
 var file_resources_documents_category_category_proto_depIdxs = []int32{
-	1, // 0: resources.documents.category.Category.created_at:type_name -> resources.timestamp.Timestamp
-	1, // 1: resources.documents.category.Category.deleted_at:type_name -> resources.timestamp.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8, // 0: resources.documents.category.Category.created_at:type_name -> resources.timestamp.Timestamp
+	8, // 1: resources.documents.category.Category.deleted_at:type_name -> resources.timestamp.Timestamp
+	3, // 2: resources.documents.category.Category.state_machine:type_name -> resources.documents.category.StateMachine
+	6, // 3: resources.documents.category.Category.retention_policy:type_name -> resources.documents.category.RetentionPolicy
+	4, // 4: resources.documents.category.StateMachine.states:type_name -> resources.documents.category.DocumentState
+	5, // 5: resources.documents.category.StateMachine.transitions:type_name -> resources.documents.category.StateTransition
+	0, // 6: resources.documents.category.StateTransition.effects:type_name -> resources.documents.category.StateTransitionEffect
+	1, // 7: resources.documents.category.RetentionReport.action:type_name -> resources.documents.category.RetentionAction
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_category_category_proto_init() }
@@ -322,18 +1182,22 @@ func file_resources_documents_category_category_proto_init() {
 		return
 	}
 	file_resources_documents_category_category_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_documents_category_category_proto_msgTypes[2].OneofWrappers = []any{}
+	file_resources_documents_category_category_proto_msgTypes[3].OneofWrappers = []any{}
+	file_resources_documents_category_category_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_category_category_proto_rawDesc), len(file_resources_documents_category_category_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_resources_documents_category_category_proto_goTypes,
 		DependencyIndexes: file_resources_documents_category_category_proto_depIdxs,
+		EnumInfos:         file_resources_documents_category_category_proto_enumTypes,
 		MessageInfos:      file_resources_documents_category_category_proto_msgTypes,
 	}.Build()
 	File_resources_documents_category_category_proto = out.File
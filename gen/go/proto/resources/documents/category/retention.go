@@ -0,0 +1,64 @@
+package documentscategory
+
+import "fmt"
+
+// RetentionActions lists the retention actions in the order they are applied.
+var RetentionActions = []RetentionAction{
+	RetentionAction_RETENTION_ACTION_CLOSE,
+	RetentionAction_RETENTION_ACTION_ARCHIVE,
+	RetentionAction_RETENTION_ACTION_DELETE,
+}
+
+// IsActive returns true if the retention policy is enabled and has at least one action configured.
+func (x *RetentionPolicy) IsActive() bool {
+	return x != nil && x.GetEnabled() &&
+		(x.CloseAfterDays != nil || x.ArchiveAfterDays != nil || x.DeleteAfterDays != nil)
+}
+
+// AfterDays returns the document age in days after which the action is applied, false if the
+// action isn't configured.
+func (x *RetentionPolicy) AfterDays(action RetentionAction) (int32, bool) {
+	var days *int32
+	switch action {
+	case RetentionAction_RETENTION_ACTION_CLOSE:
+		days = x.CloseAfterDays
+	case RetentionAction_RETENTION_ACTION_ARCHIVE:
+		days = x.ArchiveAfterDays
+	case RetentionAction_RETENTION_ACTION_DELETE:
+		days = x.DeleteAfterDays
+	}
+
+	if days == nil || *days <= 0 {
+		return 0, false
+	}
+
+	return *days, true
+}
+
+// Validate checks that the configured actions are applied in order, e.g., a document can't be
+// deleted before it would be archived.
+func (x *RetentionPolicy) Validate() error {
+	if !x.IsActive() {
+		return nil
+	}
+
+	var prevAction RetentionAction
+	var prevDays int32
+	for _, action := range RetentionActions {
+		days, ok := x.AfterDays(action)
+		if !ok {
+			continue
+		}
+
+		if days < prevDays {
+			return fmt.Errorf(
+				"%s after %d days is before %s after %d days",
+				action.String(), days, prevAction.String(), prevDays,
+			)
+		}
+		prevAction = action
+		prevDays = days
+	}
+
+	return nil
+}
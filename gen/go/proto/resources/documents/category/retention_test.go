@@ -0,0 +1,63 @@
+package documentscategory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRetentionPolicyIsActive(t *testing.T) {
+	var policy *RetentionPolicy
+	assert.False(t, policy.IsActive())
+
+	assert.False(t, (&RetentionPolicy{Enabled: true}).IsActive())
+	assert.False(t, (&RetentionPolicy{CloseAfterDays: proto.Int32(30)}).IsActive())
+	assert.True(t, (&RetentionPolicy{Enabled: true, CloseAfterDays: proto.Int32(30)}).IsActive())
+}
+
+func TestRetentionPolicyAfterDays(t *testing.T) {
+	policy := &RetentionPolicy{
+		Enabled:         true,
+		CloseAfterDays:  proto.Int32(30),
+		DeleteAfterDays: proto.Int32(1095),
+	}
+
+	days, ok := policy.AfterDays(RetentionAction_RETENTION_ACTION_CLOSE)
+	assert.True(t, ok)
+	assert.Equal(t, int32(30), days)
+
+	_, ok = policy.AfterDays(RetentionAction_RETENTION_ACTION_ARCHIVE)
+	assert.False(t, ok)
+
+	days, ok = policy.AfterDays(RetentionAction_RETENTION_ACTION_DELETE)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1095), days)
+
+	_, ok = policy.AfterDays(RetentionAction_RETENTION_ACTION_UNSPECIFIED)
+	assert.False(t, ok)
+}
+
+func TestRetentionPolicyValidate(t *testing.T) {
+	policy := &RetentionPolicy{
+		Enabled:          true,
+		CloseAfterDays:   proto.Int32(30),
+		ArchiveAfterDays: proto.Int32(365),
+		DeleteAfterDays:  proto.Int32(1095),
+	}
+	require.NoError(t, policy.Validate())
+
+	// Disabled policies aren't validated
+	policy.Enabled = false
+	policy.DeleteAfterDays = proto.Int32(10)
+	require.NoError(t, policy.Validate())
+
+	policy.Enabled = true
+	require.Error(t, policy.Validate())
+
+	// Unset actions are skipped
+	policy.ArchiveAfterDays = nil
+	policy.DeleteAfterDays = proto.Int32(60)
+	require.NoError(t, policy.Validate())
+}
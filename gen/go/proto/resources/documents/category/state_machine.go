@@ -0,0 +1,88 @@
+package documentscategory
+
+import (
+	"fmt"
+	"slices"
+)
+
+// IsActive returns true if the state machine is enabled and has at least one state.
+func (x *StateMachine) IsActive() bool {
+	return x != nil && x.GetEnabled() && len(x.GetStates()) > 0
+}
+
+// HasState checks if the state machine contains a state with the given name.
+func (x *StateMachine) HasState(name string) bool {
+	return slices.ContainsFunc(x.GetStates(), func(s *DocumentState) bool {
+		return s.GetName() == name
+	})
+}
+
+// Validate checks that the state names are unique and that the initial state and all transitions
+// reference existing states. An empty transition "from" state matches any state.
+func (x *StateMachine) Validate() error {
+	if !x.IsActive() {
+		return nil
+	}
+
+	names := make(map[string]struct{}, len(x.GetStates()))
+	for _, state := range x.GetStates() {
+		if _, ok := names[state.GetName()]; ok {
+			return fmt.Errorf("duplicate state %q", state.GetName())
+		}
+		names[state.GetName()] = struct{}{}
+	}
+
+	if _, ok := names[x.GetInitialState()]; !ok {
+		return fmt.Errorf("unknown initial state %q", x.GetInitialState())
+	}
+
+	transitions := make(map[[2]string]struct{}, len(x.GetTransitions()))
+	for _, t := range x.GetTransitions() {
+		if _, ok := names[t.GetFrom()]; !ok && t.GetFrom() != "" {
+			return fmt.Errorf("transition from unknown state %q", t.GetFrom())
+		}
+		if _, ok := names[t.GetTo()]; !ok {
+			return fmt.Errorf("transition to unknown state %q", t.GetTo())
+		}
+		if t.GetFrom() == t.GetTo() {
+			return fmt.Errorf("transition from %q to itself", t.GetTo())
+		}
+
+		key := [2]string{t.GetFrom(), t.GetTo()}
+		if _, ok := transitions[key]; ok {
+			return fmt.Errorf("duplicate transition from %q to %q", t.GetFrom(), t.GetTo())
+		}
+		transitions[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// FindTransition returns the transition between the two states, transitions explicitly
+// starting at the from state take precedence over the ones matching any state.
+// Documents without a (known) state are treated as being in the initial state.
+func (x *StateMachine) FindTransition(from string, to string) *StateTransition {
+	if !x.HasState(from) {
+		from = x.GetInitialState()
+	}
+
+	var wildcard *StateTransition
+	for _, t := range x.GetTransitions() {
+		if t.GetTo() != to {
+			continue
+		}
+
+		if t.GetFrom() == from {
+			return t
+		} else if t.GetFrom() == "" && wildcard == nil && from != to {
+			wildcard = t
+		}
+	}
+
+	return wildcard
+}
+
+// HasEffect checks if the transition has the given side effect.
+func (x *StateTransition) HasEffect(effect StateTransitionEffect) bool {
+	return slices.Contains(x.GetEffects(), effect)
+}
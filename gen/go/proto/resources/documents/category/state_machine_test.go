@@ -0,0 +1,85 @@
+package documentscategory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStateMachine() *StateMachine {
+	return &StateMachine{
+		Enabled:      true,
+		InitialState: "draft",
+		States: []*DocumentState{
+			{Name: "draft"},
+			{Name: "review"},
+			{Name: "final"},
+			{Name: "archived"},
+		},
+		Transitions: []*StateTransition{
+			{From: "draft", To: "review"},
+			{
+				From:    "review",
+				To:      "final",
+				Effects: []StateTransitionEffect{StateTransitionEffect_STATE_TRANSITION_EFFECT_LOCK_CONTENT},
+			},
+			{From: "review", To: "draft"},
+			{From: "", To: "archived"},
+		},
+	}
+}
+
+func TestStateMachineValidate(t *testing.T) {
+	require.NoError(t, testStateMachine().Validate())
+	require.NoError(t, (*StateMachine)(nil).Validate())
+
+	sm := testStateMachine()
+	sm.States = append(sm.States, &DocumentState{Name: "draft"})
+	require.ErrorContains(t, sm.Validate(), "duplicate state")
+
+	sm = testStateMachine()
+	sm.InitialState = "unknown"
+	require.ErrorContains(t, sm.Validate(), "unknown initial state")
+
+	sm = testStateMachine()
+	sm.Transitions = append(sm.Transitions, &StateTransition{From: "draft", To: "unknown"})
+	require.ErrorContains(t, sm.Validate(), "to unknown state")
+
+	sm = testStateMachine()
+	sm.Transitions = append(sm.Transitions, &StateTransition{From: "unknown", To: "draft"})
+	require.ErrorContains(t, sm.Validate(), "from unknown state")
+
+	sm = testStateMachine()
+	sm.Transitions = append(sm.Transitions, &StateTransition{From: "draft", To: "review"})
+	require.ErrorContains(t, sm.Validate(), "duplicate transition")
+
+	sm = testStateMachine()
+	sm.Transitions = append(sm.Transitions, &StateTransition{From: "final", To: "final"})
+	require.ErrorContains(t, sm.Validate(), "to itself")
+
+	// Disabled state machines aren't validated
+	sm.Enabled = false
+	require.NoError(t, sm.Validate())
+}
+
+func TestStateMachineFindTransition(t *testing.T) {
+	sm := testStateMachine()
+
+	tr := sm.FindTransition("review", "final")
+	require.NotNil(t, tr)
+	assert.True(t, tr.HasEffect(StateTransitionEffect_STATE_TRANSITION_EFFECT_LOCK_CONTENT))
+	assert.False(t, tr.HasEffect(StateTransitionEffect_STATE_TRANSITION_EFFECT_CLOSE))
+
+	assert.Nil(t, sm.FindTransition("draft", "final"))
+	assert.Nil(t, sm.FindTransition("final", "draft"))
+
+	// Empty and unknown states are treated as the initial state
+	assert.NotNil(t, sm.FindTransition("", "review"))
+	assert.NotNil(t, sm.FindTransition("Open", "review"))
+	assert.Nil(t, sm.FindTransition("", "final"))
+
+	// Wildcard transitions match any state but the target state itself
+	assert.NotNil(t, sm.FindTransition("final", "archived"))
+	assert.Nil(t, sm.FindTransition("archived", "archived"))
+}
@@ -10,6 +10,18 @@ func (m *DocumentData) Sanitize() error {
 		return nil
 	}
 
+	// Field: Fields
+	for idx, item := range m.Fields {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// Field: PenaltyCalculator
 	if m.PenaltyCalculator != nil {
 		if v, ok := any(m.GetPenaltyCalculator()).(interface{ Sanitize() error }); ok {
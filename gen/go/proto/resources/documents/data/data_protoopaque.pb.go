@@ -10,6 +10,7 @@ package documentsdata
 
 import (
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/dbscanner"
+	forms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -24,8 +25,9 @@ const (
 )
 
 type DocumentData struct {
-	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_PenaltyCalculator *PenaltyCalculatorData `protobuf:"bytes,2,opt,name=penalty_calculator,json=penaltyCalculator,proto3,oneof"`
+	state                        protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_PenaltyCalculator *PenaltyCalculatorData           `protobuf:"bytes,2,opt,name=penalty_calculator,json=penaltyCalculator,proto3,oneof"`
+	xxx_hidden_Fields            map[string]*forms.FormFieldValue `protobuf:"bytes,3,rep,name=fields,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -62,10 +64,21 @@ func (x *DocumentData) GetPenaltyCalculator() *PenaltyCalculatorData {
 	return nil
 }
 
+func (x *DocumentData) GetFields() map[string]*forms.FormFieldValue {
+	if x != nil {
+		return x.xxx_hidden_Fields
+	}
+	return nil
+}
+
 func (x *DocumentData) SetPenaltyCalculator(v *PenaltyCalculatorData) {
 	x.xxx_hidden_PenaltyCalculator = v
 }
 
+func (x *DocumentData) SetFields(v map[string]*forms.FormFieldValue) {
+	x.xxx_hidden_Fields = v
+}
+
 func (x *DocumentData) HasPenaltyCalculator() bool {
 	if x == nil {
 		return false
@@ -81,6 +94,8 @@ type DocumentData_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	PenaltyCalculator *PenaltyCalculatorData
+	// Form field values keyed by the template's field keys
+	Fields map[string]*forms.FormFieldValue
 }
 
 func (b0 DocumentData_builder) Build() *DocumentData {
@@ -88,6 +103,7 @@ func (b0 DocumentData_builder) Build() *DocumentData {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_PenaltyCalculator = b.PenaltyCalculator
+	x.xxx_hidden_Fields = b.Fields
 	return m0
 }
 
@@ -429,9 +445,13 @@ var File_resources_documents_data_data_proto protoreflect.FileDescriptor
 
 const file_resources_documents_data_data_proto_rawDesc = "" +
 	"\n" +
-	"#resources/documents/data/data.proto\x12\x18resources.documents.data\x1a!codegen/dbscanner/dbscanner.proto\"\x92\x01\n" +
+	"#resources/documents/data/data.proto\x12\x18resources.documents.data\x1a!codegen/dbscanner/dbscanner.proto\x1a%resources/documents/forms/forms.proto\"\xc4\x02\n" +
 	"\fDocumentData\x12c\n" +
-	"\x12penalty_calculator\x18\x02 \x01(\v2/.resources.documents.data.PenaltyCalculatorDataH\x00R\x11penaltyCalculator\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\x15\n" +
+	"\x12penalty_calculator\x18\x02 \x01(\v2/.resources.documents.data.PenaltyCalculatorDataH\x00R\x11penaltyCalculator\x88\x01\x01\x12J\n" +
+	"\x06fields\x18\x03 \x03(\v22.resources.documents.data.DocumentData.FieldsEntryR\x06fields\x1ad\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).resources.documents.forms.FormFieldValueR\x05value:\x028\x01:\x06\xe2\xf3\x18\x02\b\x01B\x15\n" +
 	"\x13_penalty_calculator\"\xd3\x01\n" +
 	"\x15PenaltyCalculatorData\x12\x1c\n" +
 	"\treduction\x18\x01 \x01(\x05R\treduction\x12E\n" +
@@ -453,22 +473,26 @@ const file_resources_documents_data_data_proto_rawDesc = "" +
 	"\x0f_detention_timeB\x0e\n" +
 	"\f_stvo_pointsBZZXgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data;documentsdatab\x06proto3"
 
-var file_resources_documents_data_data_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_resources_documents_data_data_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_resources_documents_data_data_proto_goTypes = []any{
 	(*DocumentData)(nil),           // 0: resources.documents.data.DocumentData
 	(*PenaltyCalculatorData)(nil),  // 1: resources.documents.data.PenaltyCalculatorData
 	(*SelectedPenalty)(nil),        // 2: resources.documents.data.SelectedPenalty
 	(*PenaltyCalculatorTotal)(nil), // 3: resources.documents.data.PenaltyCalculatorTotal
+	nil,                            // 4: resources.documents.data.DocumentData.FieldsEntry
+	(*forms.FormFieldValue)(nil),   // 5: resources.documents.forms.FormFieldValue
 }
 var file_resources_documents_data_data_proto_depIdxs = []int32{
 	1, // 0: resources.documents.data.DocumentData.penalty_calculator:type_name -> resources.documents.data.PenaltyCalculatorData
-	2, // 1: resources.documents.data.PenaltyCalculatorData.selected:type_name -> resources.documents.data.SelectedPenalty
-	3, // 2: resources.documents.data.PenaltyCalculatorData.total:type_name -> resources.documents.data.PenaltyCalculatorTotal
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 1: resources.documents.data.DocumentData.fields:type_name -> resources.documents.data.DocumentData.FieldsEntry
+	2, // 2: resources.documents.data.PenaltyCalculatorData.selected:type_name -> resources.documents.data.SelectedPenalty
+	3, // 3: resources.documents.data.PenaltyCalculatorData.total:type_name -> resources.documents.data.PenaltyCalculatorTotal
+	5, // 4: resources.documents.data.DocumentData.FieldsEntry.value:type_name -> resources.documents.forms.FormFieldValue
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_data_data_proto_init() }
@@ -485,7 +509,7 @@ func file_resources_documents_data_data_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_data_data_proto_rawDesc), len(file_resources_documents_data_data_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1060,6 +1060,12 @@ type DocumentMeta struct {
 	Draft        bool                   `protobuf:"varint,4,opt,name=draft,proto3" json:"draft,omitempty"`
 	Public       bool                   `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`
 	State        string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// Content is locked by a state transition of the category's state machine
+	Locked bool `protobuf:"varint,8,opt,name=locked,proto3" json:"locked,omitempty"`
+	// Document can't be edited or deleted while on legal hold
+	LegalHold bool `protobuf:"varint,9,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	// Set by the category's retention policy, archived documents are read-only
+	ArchivedAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
 	// Overall aggregates - At least one approval policy fully satisfied
 	Approved *bool `protobuf:"varint,7,opt,name=approved,proto3,oneof" json:"approved,omitempty"`
 	// Approval rollups
@@ -1150,6 +1156,27 @@ func (x *DocumentMeta) GetState() string {
 	return ""
 }
 
+func (x *DocumentMeta) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *DocumentMeta) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *DocumentMeta) GetArchivedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
 func (x *DocumentMeta) GetApproved() bool {
 	if x != nil && x.Approved != nil {
 		return *x.Approved
@@ -1237,6 +1264,18 @@ func (x *DocumentMeta) SetState(v string) {
 	x.State = v
 }
 
+func (x *DocumentMeta) SetLocked(v bool) {
+	x.Locked = v
+}
+
+func (x *DocumentMeta) SetLegalHold(v bool) {
+	x.LegalHold = v
+}
+
+func (x *DocumentMeta) SetArchivedAt(v *timestamp.Timestamp) {
+	x.ArchivedAt = v
+}
+
 func (x *DocumentMeta) SetApproved(v bool) {
 	x.Approved = &v
 }
@@ -1280,6 +1319,13 @@ func (x *DocumentMeta) HasRecomputedAt() bool {
 	return x.RecomputedAt != nil
 }
 
+func (x *DocumentMeta) HasArchivedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ArchivedAt != nil
+}
+
 func (x *DocumentMeta) HasApproved() bool {
 	if x == nil {
 		return false
@@ -1347,6 +1393,10 @@ func (x *DocumentMeta) ClearRecomputedAt() {
 	x.RecomputedAt = nil
 }
 
+func (x *DocumentMeta) ClearArchivedAt() {
+	x.ArchivedAt = nil
+}
+
 func (x *DocumentMeta) ClearApproved() {
 	x.Approved = nil
 }
@@ -1392,6 +1442,12 @@ type DocumentMeta_builder struct {
 	Draft        bool
 	Public       bool
 	State        string
+	// Content is locked by a state transition of the category's state machine
+	Locked bool
+	// Document can't be edited or deleted while on legal hold
+	LegalHold bool
+	// Set by the category's retention policy, archived documents are read-only
+	ArchivedAt *timestamp.Timestamp
 	// Overall aggregates - At least one approval policy fully satisfied
 	Approved *bool
 	// Approval rollups
@@ -1423,6 +1479,9 @@ func (b0 DocumentMeta_builder) Build() *DocumentMeta {
 	x.Draft = b.Draft
 	x.Public = b.Public
 	x.State = b.State
+	x.Locked = b.Locked
+	x.LegalHold = b.LegalHold
+	x.ArchivedAt = b.ArchivedAt
 	x.Approved = b.Approved
 	x.ApRequiredTotal = b.ApRequiredTotal
 	x.ApCollectedApproved = b.ApCollectedApproved
@@ -1529,7 +1588,7 @@ const file_resources_documents_documents_proto_rawDesc = "" +
 	"\x12_creator_job_labelB\x06\n" +
 	"\x04_pinB\x11\n" +
 	"\x0f_workflow_stateB\x10\n" +
-	"\x0e_workflow_user\"\xdc\x06\n" +
+	"\x0e_workflow_user\"\xe9\a\n" +
 	"\fDocumentMeta\x12\x1f\n" +
 	"\vdocument_id\x18\x01 \x01(\x03R\n" +
 	"documentId\x12H\n" +
@@ -1537,17 +1596,25 @@ const file_resources_documents_documents_proto_rawDesc = "" +
 	"\x06closed\x18\x03 \x01(\bR\x06closed\x12\x14\n" +
 	"\x05draft\x18\x04 \x01(\bR\x05draft\x12\x16\n" +
 	"\x06public\x18\x05 \x01(\bR\x06public\x12\x1c\n" +
-	"\x05state\x18\x06 \x01(\tB\x06\xda\xf3\x18\x02\b\x01R\x05state\x12\x1f\n" +
-	"\bapproved\x18\a \x01(\bH\x01R\bapproved\x88\x01\x01\x12/\n" +
-	"\x11ap_required_total\x18\x10 \x01(\x05H\x02R\x0fapRequiredTotal\x88\x01\x01\x127\n" +
-	"\x15ap_collected_approved\x18\x11 \x01(\x05H\x03R\x13apCollectedApproved\x88\x01\x01\x127\n" +
-	"\x15ap_required_remaining\x18\x12 \x01(\x05H\x04R\x13apRequiredRemaining\x88\x01\x01\x12/\n" +
-	"\x11ap_declined_count\x18\x13 \x01(\x05H\x05R\x0fapDeclinedCount\x88\x01\x01\x12-\n" +
-	"\x10ap_pending_count\x18\x14 \x01(\x05H\x06R\x0eapPendingCount\x88\x01\x01\x12+\n" +
-	"\x0fap_any_declined\x18\x15 \x01(\bH\aR\rapAnyDeclined\x88\x01\x01\x121\n" +
-	"\x12ap_policies_active\x18\x16 \x01(\x05H\bR\x10apPoliciesActive\x88\x01\x01\x12(\n" +
-	"\rcomment_count\x18\x17 \x01(\x05H\tR\fcommentCount\x88\x01\x01B\x10\n" +
-	"\x0e_recomputed_atB\v\n" +
+	"\x05state\x18\x06 \x01(\tB\x06\xda\xf3\x18\x02\b\x01R\x05state\x12\x16\n" +
+	"\x06locked\x18\b \x01(\bR\x06locked\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\t \x01(\bR\tlegalHold\x12D\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\n" +
+	"archivedAt\x88\x01\x01\x12\x1f\n" +
+	"\bapproved\x18\a \x01(\bH\x02R\bapproved\x88\x01\x01\x12/\n" +
+	"\x11ap_required_total\x18\x10 \x01(\x05H\x03R\x0fapRequiredTotal\x88\x01\x01\x127\n" +
+	"\x15ap_collected_approved\x18\x11 \x01(\x05H\x04R\x13apCollectedApproved\x88\x01\x01\x127\n" +
+	"\x15ap_required_remaining\x18\x12 \x01(\x05H\x05R\x13apRequiredRemaining\x88\x01\x01\x12/\n" +
+	"\x11ap_declined_count\x18\x13 \x01(\x05H\x06R\x0fapDeclinedCount\x88\x01\x01\x12-\n" +
+	"\x10ap_pending_count\x18\x14 \x01(\x05H\aR\x0eapPendingCount\x88\x01\x01\x12+\n" +
+	"\x0fap_any_declined\x18\x15 \x01(\bH\bR\rapAnyDeclined\x88\x01\x01\x121\n" +
+	"\x12ap_policies_active\x18\x16 \x01(\x05H\tR\x10apPoliciesActive\x88\x01\x01\x12(\n" +
+	"\rcomment_count\x18\x17 \x01(\x05H\n" +
+	"R\fcommentCount\x88\x01\x01B\x10\n" +
+	"\x0e_recomputed_atB\x0e\n" +
+	"\f_archived_atB\v\n" +
 	"\t_approvedB\x14\n" +
 	"\x12_ap_required_totalB\x18\n" +
 	"\x16_ap_collected_approvedB\x18\n" +
@@ -1600,11 +1667,12 @@ var file_resources_documents_documents_proto_depIdxs = []int32{
 	10, // 22: resources.documents.DocumentShort.workflow_state:type_name -> resources.documents.workflow.WorkflowState
 	11, // 23: resources.documents.DocumentShort.workflow_user:type_name -> resources.documents.workflow.WorkflowUserState
 	3,  // 24: resources.documents.DocumentMeta.recomputed_at:type_name -> resources.timestamp.Timestamp
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	3,  // 25: resources.documents.DocumentMeta.archived_at:type_name -> resources.timestamp.Timestamp
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_documents_proto_init() }
@@ -151,6 +151,15 @@ func (m *DocumentMeta) Sanitize() error {
 		return nil
 	}
 
+	// Field: ArchivedAt
+	if m.ArchivedAt != nil {
+		if v, ok := any(m.GetArchivedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: RecomputedAt
 	if m.RecomputedAt != nil {
 		if v, ok := any(m.GetRecomputedAt()).(interface{ Sanitize() error }); ok {
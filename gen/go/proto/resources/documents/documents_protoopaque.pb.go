@@ -1129,6 +1129,9 @@ type DocumentMeta struct {
 	xxx_hidden_Draft               bool                   `protobuf:"varint,4,opt,name=draft,proto3"`
 	xxx_hidden_Public              bool                   `protobuf:"varint,5,opt,name=public,proto3"`
 	xxx_hidden_State               string                 `protobuf:"bytes,6,opt,name=state,proto3"`
+	xxx_hidden_Locked              bool                   `protobuf:"varint,8,opt,name=locked,proto3"`
+	xxx_hidden_LegalHold           bool                   `protobuf:"varint,9,opt,name=legal_hold,json=legalHold,proto3"`
+	xxx_hidden_ArchivedAt          *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof"`
 	xxx_hidden_Approved            bool                   `protobuf:"varint,7,opt,name=approved,proto3,oneof"`
 	xxx_hidden_ApRequiredTotal     int32                  `protobuf:"varint,16,opt,name=ap_required_total,json=apRequiredTotal,proto3,oneof"`
 	xxx_hidden_ApCollectedApproved int32                  `protobuf:"varint,17,opt,name=ap_collected_approved,json=apCollectedApproved,proto3,oneof"`
@@ -1211,6 +1214,27 @@ func (x *DocumentMeta) GetState() string {
 	return ""
 }
 
+func (x *DocumentMeta) GetLocked() bool {
+	if x != nil {
+		return x.xxx_hidden_Locked
+	}
+	return false
+}
+
+func (x *DocumentMeta) GetLegalHold() bool {
+	if x != nil {
+		return x.xxx_hidden_LegalHold
+	}
+	return false
+}
+
+func (x *DocumentMeta) GetArchivedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ArchivedAt
+	}
+	return nil
+}
+
 func (x *DocumentMeta) GetApproved() bool {
 	if x != nil {
 		return x.xxx_hidden_Approved
@@ -1298,49 +1322,61 @@ func (x *DocumentMeta) SetState(v string) {
 	x.xxx_hidden_State = v
 }
 
+func (x *DocumentMeta) SetLocked(v bool) {
+	x.xxx_hidden_Locked = v
+}
+
+func (x *DocumentMeta) SetLegalHold(v bool) {
+	x.xxx_hidden_LegalHold = v
+}
+
+func (x *DocumentMeta) SetArchivedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_ArchivedAt = v
+}
+
 func (x *DocumentMeta) SetApproved(v bool) {
 	x.xxx_hidden_Approved = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 18)
 }
 
 func (x *DocumentMeta) SetApRequiredTotal(v int32) {
 	x.xxx_hidden_ApRequiredTotal = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 18)
 }
 
 func (x *DocumentMeta) SetApCollectedApproved(v int32) {
 	x.xxx_hidden_ApCollectedApproved = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 18)
 }
 
 func (x *DocumentMeta) SetApRequiredRemaining(v int32) {
 	x.xxx_hidden_ApRequiredRemaining = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 18)
 }
 
 func (x *DocumentMeta) SetApDeclinedCount(v int32) {
 	x.xxx_hidden_ApDeclinedCount = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 18)
 }
 
 func (x *DocumentMeta) SetApPendingCount(v int32) {
 	x.xxx_hidden_ApPendingCount = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 18)
 }
 
 func (x *DocumentMeta) SetApAnyDeclined(v bool) {
 	x.xxx_hidden_ApAnyDeclined = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 18)
 }
 
 func (x *DocumentMeta) SetApPoliciesActive(v int32) {
 	x.xxx_hidden_ApPoliciesActive = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 18)
 }
 
 func (x *DocumentMeta) SetCommentCount(v int32) {
 	x.xxx_hidden_CommentCount = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 18)
 }
 
 func (x *DocumentMeta) HasRecomputedAt() bool {
@@ -1350,115 +1386,126 @@ func (x *DocumentMeta) HasRecomputedAt() bool {
 	return x.xxx_hidden_RecomputedAt != nil
 }
 
+func (x *DocumentMeta) HasArchivedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ArchivedAt != nil
+}
+
 func (x *DocumentMeta) HasApproved() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
 }
 
 func (x *DocumentMeta) HasApRequiredTotal() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
 }
 
 func (x *DocumentMeta) HasApCollectedApproved() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
 }
 
 func (x *DocumentMeta) HasApRequiredRemaining() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
 }
 
 func (x *DocumentMeta) HasApDeclinedCount() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
 }
 
 func (x *DocumentMeta) HasApPendingCount() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
 }
 
 func (x *DocumentMeta) HasApAnyDeclined() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
 }
 
 func (x *DocumentMeta) HasApPoliciesActive() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
 }
 
 func (x *DocumentMeta) HasCommentCount() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
 }
 
 func (x *DocumentMeta) ClearRecomputedAt() {
 	x.xxx_hidden_RecomputedAt = nil
 }
 
+func (x *DocumentMeta) ClearArchivedAt() {
+	x.xxx_hidden_ArchivedAt = nil
+}
+
 func (x *DocumentMeta) ClearApproved() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
 	x.xxx_hidden_Approved = false
 }
 
 func (x *DocumentMeta) ClearApRequiredTotal() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
 	x.xxx_hidden_ApRequiredTotal = 0
 }
 
 func (x *DocumentMeta) ClearApCollectedApproved() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
 	x.xxx_hidden_ApCollectedApproved = 0
 }
 
 func (x *DocumentMeta) ClearApRequiredRemaining() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
 	x.xxx_hidden_ApRequiredRemaining = 0
 }
 
 func (x *DocumentMeta) ClearApDeclinedCount() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
 	x.xxx_hidden_ApDeclinedCount = 0
 }
 
 func (x *DocumentMeta) ClearApPendingCount() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
 	x.xxx_hidden_ApPendingCount = 0
 }
 
 func (x *DocumentMeta) ClearApAnyDeclined() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
 	x.xxx_hidden_ApAnyDeclined = false
 }
 
 func (x *DocumentMeta) ClearApPoliciesActive() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
 	x.xxx_hidden_ApPoliciesActive = 0
 }
 
 func (x *DocumentMeta) ClearCommentCount() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
 	x.xxx_hidden_CommentCount = 0
 }
 
@@ -1471,6 +1518,12 @@ type DocumentMeta_builder struct {
 	Draft        bool
 	Public       bool
 	State        string
+	// Content is locked by a state transition of the category's state machine
+	Locked bool
+	// Document can't be edited or deleted while on legal hold
+	LegalHold bool
+	// Set by the category's retention policy, archived documents are read-only
+	ArchivedAt *timestamp.Timestamp
 	// Overall aggregates - At least one approval policy fully satisfied
 	Approved *bool
 	// Approval rollups
@@ -1502,40 +1555,43 @@ func (b0 DocumentMeta_builder) Build() *DocumentMeta {
 	x.xxx_hidden_Draft = b.Draft
 	x.xxx_hidden_Public = b.Public
 	x.xxx_hidden_State = b.State
+	x.xxx_hidden_Locked = b.Locked
+	x.xxx_hidden_LegalHold = b.LegalHold
+	x.xxx_hidden_ArchivedAt = b.ArchivedAt
 	if b.Approved != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 18)
 		x.xxx_hidden_Approved = *b.Approved
 	}
 	if b.ApRequiredTotal != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 18)
 		x.xxx_hidden_ApRequiredTotal = *b.ApRequiredTotal
 	}
 	if b.ApCollectedApproved != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 18)
 		x.xxx_hidden_ApCollectedApproved = *b.ApCollectedApproved
 	}
 	if b.ApRequiredRemaining != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 18)
 		x.xxx_hidden_ApRequiredRemaining = *b.ApRequiredRemaining
 	}
 	if b.ApDeclinedCount != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 18)
 		x.xxx_hidden_ApDeclinedCount = *b.ApDeclinedCount
 	}
 	if b.ApPendingCount != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 18)
 		x.xxx_hidden_ApPendingCount = *b.ApPendingCount
 	}
 	if b.ApAnyDeclined != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 18)
 		x.xxx_hidden_ApAnyDeclined = *b.ApAnyDeclined
 	}
 	if b.ApPoliciesActive != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 18)
 		x.xxx_hidden_ApPoliciesActive = *b.ApPoliciesActive
 	}
 	if b.CommentCount != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 18)
 		x.xxx_hidden_CommentCount = *b.CommentCount
 	}
 	return m0
@@ -1635,7 +1691,7 @@ const file_resources_documents_documents_proto_rawDesc = "" +
 	"\x12_creator_job_labelB\x06\n" +
 	"\x04_pinB\x11\n" +
 	"\x0f_workflow_stateB\x10\n" +
-	"\x0e_workflow_user\"\xdc\x06\n" +
+	"\x0e_workflow_user\"\xe9\a\n" +
 	"\fDocumentMeta\x12\x1f\n" +
 	"\vdocument_id\x18\x01 \x01(\x03R\n" +
 	"documentId\x12H\n" +
@@ -1643,17 +1699,25 @@ const file_resources_documents_documents_proto_rawDesc = "" +
 	"\x06closed\x18\x03 \x01(\bR\x06closed\x12\x14\n" +
 	"\x05draft\x18\x04 \x01(\bR\x05draft\x12\x16\n" +
 	"\x06public\x18\x05 \x01(\bR\x06public\x12\x1c\n" +
-	"\x05state\x18\x06 \x01(\tB\x06\xda\xf3\x18\x02\b\x01R\x05state\x12\x1f\n" +
-	"\bapproved\x18\a \x01(\bH\x01R\bapproved\x88\x01\x01\x12/\n" +
-	"\x11ap_required_total\x18\x10 \x01(\x05H\x02R\x0fapRequiredTotal\x88\x01\x01\x127\n" +
-	"\x15ap_collected_approved\x18\x11 \x01(\x05H\x03R\x13apCollectedApproved\x88\x01\x01\x127\n" +
-	"\x15ap_required_remaining\x18\x12 \x01(\x05H\x04R\x13apRequiredRemaining\x88\x01\x01\x12/\n" +
-	"\x11ap_declined_count\x18\x13 \x01(\x05H\x05R\x0fapDeclinedCount\x88\x01\x01\x12-\n" +
-	"\x10ap_pending_count\x18\x14 \x01(\x05H\x06R\x0eapPendingCount\x88\x01\x01\x12+\n" +
-	"\x0fap_any_declined\x18\x15 \x01(\bH\aR\rapAnyDeclined\x88\x01\x01\x121\n" +
-	"\x12ap_policies_active\x18\x16 \x01(\x05H\bR\x10apPoliciesActive\x88\x01\x01\x12(\n" +
-	"\rcomment_count\x18\x17 \x01(\x05H\tR\fcommentCount\x88\x01\x01B\x10\n" +
-	"\x0e_recomputed_atB\v\n" +
+	"\x05state\x18\x06 \x01(\tB\x06\xda\xf3\x18\x02\b\x01R\x05state\x12\x16\n" +
+	"\x06locked\x18\b \x01(\bR\x06locked\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\t \x01(\bR\tlegalHold\x12D\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\n" +
+	"archivedAt\x88\x01\x01\x12\x1f\n" +
+	"\bapproved\x18\a \x01(\bH\x02R\bapproved\x88\x01\x01\x12/\n" +
+	"\x11ap_required_total\x18\x10 \x01(\x05H\x03R\x0fapRequiredTotal\x88\x01\x01\x127\n" +
+	"\x15ap_collected_approved\x18\x11 \x01(\x05H\x04R\x13apCollectedApproved\x88\x01\x01\x127\n" +
+	"\x15ap_required_remaining\x18\x12 \x01(\x05H\x05R\x13apRequiredRemaining\x88\x01\x01\x12/\n" +
+	"\x11ap_declined_count\x18\x13 \x01(\x05H\x06R\x0fapDeclinedCount\x88\x01\x01\x12-\n" +
+	"\x10ap_pending_count\x18\x14 \x01(\x05H\aR\x0eapPendingCount\x88\x01\x01\x12+\n" +
+	"\x0fap_any_declined\x18\x15 \x01(\bH\bR\rapAnyDeclined\x88\x01\x01\x121\n" +
+	"\x12ap_policies_active\x18\x16 \x01(\x05H\tR\x10apPoliciesActive\x88\x01\x01\x12(\n" +
+	"\rcomment_count\x18\x17 \x01(\x05H\n" +
+	"R\fcommentCount\x88\x01\x01B\x10\n" +
+	"\x0e_recomputed_atB\x0e\n" +
+	"\f_archived_atB\v\n" +
 	"\t_approvedB\x14\n" +
 	"\x12_ap_required_totalB\x18\n" +
 	"\x16_ap_collected_approvedB\x18\n" +
@@ -1706,11 +1770,12 @@ var file_resources_documents_documents_proto_depIdxs = []int32{
 	10, // 22: resources.documents.DocumentShort.workflow_state:type_name -> resources.documents.workflow.WorkflowState
 	11, // 23: resources.documents.DocumentShort.workflow_user:type_name -> resources.documents.workflow.WorkflowUserState
 	3,  // 24: resources.documents.DocumentMeta.recomputed_at:type_name -> resources.timestamp.Timestamp
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	3,  // 25: resources.documents.DocumentMeta.archived_at:type_name -> resources.timestamp.Timestamp
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_documents_proto_init() }
@@ -0,0 +1,297 @@
+package documentsforms
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DateFormat is the format of date field values.
+const DateFormat = "2006-01-02"
+
+// FieldError is returned when a field definition or value is invalid.
+type FieldError struct {
+	Key    string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("form field %q: %s", e.Key, e.Reason)
+}
+
+// ValidateFields checks the (template) field definitions for duplicate keys and invalid type
+// specific settings.
+func ValidateFields(fields []*FormField) error {
+	keys := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if _, ok := keys[field.GetKey()]; ok {
+			return &FieldError{Key: field.GetKey(), Reason: "duplicate key"}
+		}
+		keys[field.GetKey()] = struct{}{}
+
+		switch field.GetType() {
+		case FormFieldType_FORM_FIELD_TYPE_TEXT:
+			if field.MinLength != nil && field.MaxLength != nil &&
+				field.GetMinLength() > field.GetMaxLength() {
+				return &FieldError{Key: field.GetKey(), Reason: "min length is greater than max length"}
+			}
+
+		case FormFieldType_FORM_FIELD_TYPE_NUMBER:
+			if field.Min != nil && field.Max != nil && field.GetMin() > field.GetMax() {
+				return &FieldError{Key: field.GetKey(), Reason: "min is greater than max"}
+			}
+
+		case FormFieldType_FORM_FIELD_TYPE_SELECT:
+			if len(field.GetOptions()) == 0 {
+				return &FieldError{Key: field.GetKey(), Reason: "select field without options"}
+			}
+
+			values := make(map[string]struct{}, len(field.GetOptions()))
+			for _, opt := range field.GetOptions() {
+				if _, ok := values[opt.GetValue()]; ok {
+					return &FieldError{Key: field.GetKey(), Reason: "duplicate option value"}
+				}
+				values[opt.GetValue()] = struct{}{}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateValues validates the values against the field definitions. Values of unknown fields
+// are rejected, required fields are only checked if checkRequired is set (drafts can be
+// incomplete).
+func ValidateValues(
+	fields []*FormField,
+	values map[string]*FormFieldValue,
+	checkRequired bool,
+) error {
+	byKey := make(map[string]*FormField, len(fields))
+	for _, field := range fields {
+		byKey[field.GetKey()] = field
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		field, ok := byKey[key]
+		if !ok {
+			return &FieldError{Key: key, Reason: "unknown field"}
+		}
+
+		if err := field.validateValue(values[key]); err != nil {
+			return err
+		}
+	}
+
+	if checkRequired {
+		for _, field := range fields {
+			if field.GetRequired() && values[field.GetKey()].isEmpty() {
+				return &FieldError{Key: field.GetKey(), Reason: "value is required"}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (x *FormField) validateValue(value *FormFieldValue) error {
+	if value.GetValue() == nil {
+		return &FieldError{Key: x.GetKey(), Reason: "value is empty"}
+	}
+
+	typeMismatch := &FieldError{Key: x.GetKey(), Reason: "value doesn't match field type"}
+
+	switch x.GetType() {
+	case FormFieldType_FORM_FIELD_TYPE_TEXT:
+		v, ok := value.GetValue().(*FormFieldValue_Text)
+		if !ok {
+			return typeMismatch
+		}
+
+		length := int32(utf8.RuneCountInString(v.Text))
+		if x.MinLength != nil && length < x.GetMinLength() {
+			return &FieldError{Key: x.GetKey(), Reason: "text is too short"}
+		}
+		if x.MaxLength != nil && length > x.GetMaxLength() {
+			return &FieldError{Key: x.GetKey(), Reason: "text is too long"}
+		}
+
+	case FormFieldType_FORM_FIELD_TYPE_NUMBER:
+		v, ok := value.GetValue().(*FormFieldValue_Number)
+		if !ok {
+			return typeMismatch
+		}
+
+		if math.IsNaN(v.Number) || math.IsInf(v.Number, 0) {
+			return &FieldError{Key: x.GetKey(), Reason: "number is not finite"}
+		}
+		if x.Min != nil && v.Number < x.GetMin() {
+			return &FieldError{Key: x.GetKey(), Reason: "number is too small"}
+		}
+		if x.Max != nil && v.Number > x.GetMax() {
+			return &FieldError{Key: x.GetKey(), Reason: "number is too large"}
+		}
+
+	case FormFieldType_FORM_FIELD_TYPE_DATE:
+		v, ok := value.GetValue().(*FormFieldValue_Date)
+		if !ok {
+			return typeMismatch
+		}
+
+		if _, err := time.Parse(DateFormat, v.Date); err != nil {
+			return &FieldError{Key: x.GetKey(), Reason: "invalid date"}
+		}
+
+	case FormFieldType_FORM_FIELD_TYPE_CITIZEN:
+		if _, ok := value.GetValue().(*FormFieldValue_UserId); !ok {
+			return typeMismatch
+		}
+
+	case FormFieldType_FORM_FIELD_TYPE_VEHICLE:
+		if _, ok := value.GetValue().(*FormFieldValue_Plate); !ok {
+			return typeMismatch
+		}
+
+	case FormFieldType_FORM_FIELD_TYPE_LAW:
+		if _, ok := value.GetValue().(*FormFieldValue_LawId); !ok {
+			return typeMismatch
+		}
+
+	case FormFieldType_FORM_FIELD_TYPE_SELECT:
+		v, ok := value.GetValue().(*FormFieldValue_Option)
+		if !ok {
+			return typeMismatch
+		}
+
+		if !slices.ContainsFunc(x.GetOptions(), func(opt *FormFieldOption) bool {
+			return opt.GetValue() == v.Option
+		}) {
+			return &FieldError{Key: x.GetKey(), Reason: "unknown option"}
+		}
+
+	default:
+		return &FieldError{Key: x.GetKey(), Reason: "unknown field type"}
+	}
+
+	return nil
+}
+
+func (x *FormFieldValue) isEmpty() bool {
+	switch v := x.GetValue().(type) {
+	case nil:
+		return true
+	case *FormFieldValue_Text:
+		return v.Text == ""
+	case *FormFieldValue_Plate:
+		return v.Plate == ""
+	}
+
+	return false
+}
+
+// References returns the citizens, vehicles and laws referenced by the values.
+func References(values map[string]*FormFieldValue) ([]int32, []string, []int64) {
+	userIds := []int32{}
+	plates := []string{}
+	lawIds := []int64{}
+
+	for _, value := range values {
+		switch v := value.GetValue().(type) {
+		case *FormFieldValue_UserId:
+			if !slices.Contains(userIds, v.UserId) {
+				userIds = append(userIds, v.UserId)
+			}
+		case *FormFieldValue_Plate:
+			if !slices.Contains(plates, v.Plate) {
+				plates = append(plates, v.Plate)
+			}
+		case *FormFieldValue_LawId:
+			if !slices.Contains(lawIds, v.LawId) {
+				lawIds = append(lawIds, v.LawId)
+			}
+		}
+	}
+
+	return userIds, plates, lawIds
+}
+
+// Matches checks the filter against the (document's) form field values, mirroring the database
+// filter: text values must contain the words of the filter in order, strings are compared case
+// insensitive.
+func (x *FormFieldFilter) Matches(values map[string]*FormFieldValue) bool {
+	value, ok := values[x.GetKey()]
+	if !ok {
+		return false
+	}
+
+	switch f := x.GetFilter().(type) {
+	case *FormFieldFilter_Text:
+		v, ok := value.GetValue().(*FormFieldValue_Text)
+		return ok && containsWords(v.Text, f.Text)
+
+	case *FormFieldFilter_Number:
+		v, ok := value.GetValue().(*FormFieldValue_Number)
+		return ok && f.Number.Contains(v.Number)
+
+	case *FormFieldFilter_Date:
+		v, ok := value.GetValue().(*FormFieldValue_Date)
+		if !ok {
+			return false
+		}
+		// Dates are stored as `YYYY-MM-DD` so they can be compared as strings
+		if f.Date.From != nil && v.Date < f.Date.GetFrom() {
+			return false
+		}
+		if f.Date.To != nil && v.Date > f.Date.GetTo() {
+			return false
+		}
+		return true
+
+	case *FormFieldFilter_UserId:
+		v, ok := value.GetValue().(*FormFieldValue_UserId)
+		return ok && v.UserId == f.UserId
+
+	case *FormFieldFilter_Plate:
+		v, ok := value.GetValue().(*FormFieldValue_Plate)
+		return ok && strings.EqualFold(v.Plate, f.Plate)
+
+	case *FormFieldFilter_LawId:
+		v, ok := value.GetValue().(*FormFieldValue_LawId)
+		return ok && v.LawId == f.LawId
+
+	case *FormFieldFilter_Option:
+		v, ok := value.GetValue().(*FormFieldValue_Option)
+		return ok && strings.EqualFold(v.Option, f.Option)
+	}
+
+	return true
+}
+
+// Contains returns true if the number is within the (inclusive) range.
+func (x *NumberRange) Contains(number float64) bool {
+	if x.Min != nil && number < x.GetMin() {
+		return false
+	}
+	if x.Max != nil && number > x.GetMax() {
+		return false
+	}
+
+	return true
+}
+
+func containsWords(text string, search string) bool {
+	text = strings.ToLower(text)
+	for word := range strings.FieldsSeq(strings.ToLower(search)) {
+		idx := strings.Index(text, word)
+		if idx < 0 {
+			return false
+		}
+		text = text[idx+len(word):]
+	}
+
+	return true
+}
@@ -9,6 +9,7 @@
 package documentsforms
 
 import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,6 +23,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FormFieldType int32
+
+const (
+	FormFieldType_FORM_FIELD_TYPE_UNSPECIFIED FormFieldType = 0
+	FormFieldType_FORM_FIELD_TYPE_TEXT        FormFieldType = 1
+	FormFieldType_FORM_FIELD_TYPE_NUMBER      FormFieldType = 2
+	FormFieldType_FORM_FIELD_TYPE_DATE        FormFieldType = 3
+	FormFieldType_FORM_FIELD_TYPE_CITIZEN     FormFieldType = 4
+	FormFieldType_FORM_FIELD_TYPE_VEHICLE     FormFieldType = 5
+	FormFieldType_FORM_FIELD_TYPE_LAW         FormFieldType = 6
+	FormFieldType_FORM_FIELD_TYPE_SELECT      FormFieldType = 7
+)
+
+// Enum value maps for FormFieldType.
+var (
+	FormFieldType_name = map[int32]string{
+		0: "FORM_FIELD_TYPE_UNSPECIFIED",
+		1: "FORM_FIELD_TYPE_TEXT",
+		2: "FORM_FIELD_TYPE_NUMBER",
+		3: "FORM_FIELD_TYPE_DATE",
+		4: "FORM_FIELD_TYPE_CITIZEN",
+		5: "FORM_FIELD_TYPE_VEHICLE",
+		6: "FORM_FIELD_TYPE_LAW",
+		7: "FORM_FIELD_TYPE_SELECT",
+	}
+	FormFieldType_value = map[string]int32{
+		"FORM_FIELD_TYPE_UNSPECIFIED": 0,
+		"FORM_FIELD_TYPE_TEXT":        1,
+		"FORM_FIELD_TYPE_NUMBER":      2,
+		"FORM_FIELD_TYPE_DATE":        3,
+		"FORM_FIELD_TYPE_CITIZEN":     4,
+		"FORM_FIELD_TYPE_VEHICLE":     5,
+		"FORM_FIELD_TYPE_LAW":         6,
+		"FORM_FIELD_TYPE_SELECT":      7,
+	}
+)
+
+func (x FormFieldType) Enum() *FormFieldType {
+	p := new(FormFieldType)
+	*p = x
+	return p
+}
+
+func (x FormFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FormFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_documents_forms_forms_proto_enumTypes[0].Descriptor()
+}
+
+func (FormFieldType) Type() protoreflect.EnumType {
+	return &file_resources_documents_forms_forms_proto_enumTypes[0]
+}
+
+func (x FormFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type Form struct {
 	state         protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id int64                  `protobuf:"varint,1,opt,name=id,proto3"`
@@ -79,43 +139,1453 @@ func (b0 Form_builder) Build() *Form {
 	return m0
 }
 
-var File_resources_documents_forms_forms_proto protoreflect.FileDescriptor
+// Typed field declared by a template, the value is stored in the document's data.
+type FormField struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Key         string                 `protobuf:"bytes,1,opt,name=key,proto3"`
+	xxx_hidden_Label       string                 `protobuf:"bytes,2,opt,name=label,proto3"`
+	xxx_hidden_Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof"`
+	xxx_hidden_Type        FormFieldType          `protobuf:"varint,4,opt,name=type,proto3,enum=resources.documents.forms.FormFieldType"`
+	xxx_hidden_Required    bool                   `protobuf:"varint,5,opt,name=required,proto3"`
+	xxx_hidden_MinLength   int32                  `protobuf:"varint,6,opt,name=min_length,json=minLength,proto3,oneof"`
+	xxx_hidden_MaxLength   int32                  `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3,oneof"`
+	xxx_hidden_Min         float64                `protobuf:"fixed64,8,opt,name=min,proto3,oneof"`
+	xxx_hidden_Max         float64                `protobuf:"fixed64,9,opt,name=max,proto3,oneof"`
+	xxx_hidden_Options     *[]*FormFieldOption    `protobuf:"bytes,10,rep,name=options,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
 
-const file_resources_documents_forms_forms_proto_rawDesc = "" +
-	"\n" +
-	"%resources/documents/forms/forms.proto\x12\x19resources.documents.forms\"\x16\n" +
-	"\x04Form\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02idB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms;documentsformsb\x06proto3"
+func (x *FormField) Reset() {
+	*x = FormField{}
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var file_resources_documents_forms_forms_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_resources_documents_forms_forms_proto_goTypes = []any{
-	(*Form)(nil), // 0: resources.documents.forms.Form
+func (x *FormField) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_resources_documents_forms_forms_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (*FormField) ProtoMessage() {}
+
+func (x *FormField) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_resources_documents_forms_forms_proto_init() }
-func file_resources_documents_forms_forms_proto_init() {
-	if File_resources_documents_forms_forms_proto != nil {
-		return
+func (x *FormField) GetKey() string {
+	if x != nil {
+		return x.xxx_hidden_Key
+	}
+	return ""
+}
+
+func (x *FormField) GetLabel() string {
+	if x != nil {
+		return x.xxx_hidden_Label
+	}
+	return ""
+}
+
+func (x *FormField) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FormField) GetType() FormFieldType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return FormFieldType_FORM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *FormField) GetRequired() bool {
+	if x != nil {
+		return x.xxx_hidden_Required
+	}
+	return false
+}
+
+func (x *FormField) GetMinLength() int32 {
+	if x != nil {
+		return x.xxx_hidden_MinLength
+	}
+	return 0
+}
+
+func (x *FormField) GetMaxLength() int32 {
+	if x != nil {
+		return x.xxx_hidden_MaxLength
+	}
+	return 0
+}
+
+func (x *FormField) GetMin() float64 {
+	if x != nil {
+		return x.xxx_hidden_Min
+	}
+	return 0
+}
+
+func (x *FormField) GetMax() float64 {
+	if x != nil {
+		return x.xxx_hidden_Max
+	}
+	return 0
+}
+
+func (x *FormField) GetOptions() []*FormFieldOption {
+	if x != nil {
+		if x.xxx_hidden_Options != nil {
+			return *x.xxx_hidden_Options
+		}
+	}
+	return nil
+}
+
+func (x *FormField) SetKey(v string) {
+	x.xxx_hidden_Key = v
+}
+
+func (x *FormField) SetLabel(v string) {
+	x.xxx_hidden_Label = v
+}
+
+func (x *FormField) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 10)
+}
+
+func (x *FormField) SetType(v FormFieldType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *FormField) SetRequired(v bool) {
+	x.xxx_hidden_Required = v
+}
+
+func (x *FormField) SetMinLength(v int32) {
+	x.xxx_hidden_MinLength = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 10)
+}
+
+func (x *FormField) SetMaxLength(v int32) {
+	x.xxx_hidden_MaxLength = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 10)
+}
+
+func (x *FormField) SetMin(v float64) {
+	x.xxx_hidden_Min = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 10)
+}
+
+func (x *FormField) SetMax(v float64) {
+	x.xxx_hidden_Max = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 10)
+}
+
+func (x *FormField) SetOptions(v []*FormFieldOption) {
+	x.xxx_hidden_Options = &v
+}
+
+func (x *FormField) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *FormField) HasMinLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *FormField) HasMaxLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *FormField) HasMin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *FormField) HasMax() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *FormField) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *FormField) ClearMinLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_MinLength = 0
+}
+
+func (x *FormField) ClearMaxLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_MaxLength = 0
+}
+
+func (x *FormField) ClearMin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Min = 0
+}
+
+func (x *FormField) ClearMax() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Max = 0
+}
+
+type FormField_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Key of the field's value in the document data
+	Key         string
+	Label       string
+	Description *string
+	Type        FormFieldType
+	Required    bool
+	// Text fields only
+	MinLength *int32
+	MaxLength *int32
+	// Number fields only
+	Min *float64
+	Max *float64
+	// Select fields only
+	Options []*FormFieldOption
+}
+
+func (b0 FormField_builder) Build() *FormField {
+	m0 := &FormField{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Key = b.Key
+	x.xxx_hidden_Label = b.Label
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 10)
+		x.xxx_hidden_Description = b.Description
+	}
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_Required = b.Required
+	if b.MinLength != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 10)
+		x.xxx_hidden_MinLength = *b.MinLength
+	}
+	if b.MaxLength != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 10)
+		x.xxx_hidden_MaxLength = *b.MaxLength
+	}
+	if b.Min != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 10)
+		x.xxx_hidden_Min = *b.Min
+	}
+	if b.Max != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 10)
+		x.xxx_hidden_Max = *b.Max
+	}
+	x.xxx_hidden_Options = &b.Options
+	return m0
+}
+
+type FormFieldOption struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Value string                 `protobuf:"bytes,1,opt,name=value,proto3"`
+	xxx_hidden_Label string                 `protobuf:"bytes,2,opt,name=label,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FormFieldOption) Reset() {
+	*x = FormFieldOption{}
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormFieldOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormFieldOption) ProtoMessage() {}
+
+func (x *FormFieldOption) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FormFieldOption) GetValue() string {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return ""
+}
+
+func (x *FormFieldOption) GetLabel() string {
+	if x != nil {
+		return x.xxx_hidden_Label
+	}
+	return ""
+}
+
+func (x *FormFieldOption) SetValue(v string) {
+	x.xxx_hidden_Value = v
+}
+
+func (x *FormFieldOption) SetLabel(v string) {
+	x.xxx_hidden_Label = v
+}
+
+type FormFieldOption_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Value string
+	Label string
+}
+
+func (b0 FormFieldOption_builder) Build() *FormFieldOption {
+	m0 := &FormFieldOption{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Value = b.Value
+	x.xxx_hidden_Label = b.Label
+	return m0
+}
+
+type FormFieldValue struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Value isFormFieldValue_Value `protobuf_oneof:"value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FormFieldValue) Reset() {
+	*x = FormFieldValue{}
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormFieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormFieldValue) ProtoMessage() {}
+
+func (x *FormFieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FormFieldValue) GetText() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Value.(*formFieldValue_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *FormFieldValue) GetNumber() float64 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Value.(*formFieldValue_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *FormFieldValue) GetDate() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Value.(*formFieldValue_Date); ok {
+			return x.Date
+		}
+	}
+	return ""
+}
+
+func (x *FormFieldValue) GetUserId() int32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Value.(*formFieldValue_UserId); ok {
+			return x.UserId
+		}
+	}
+	return 0
+}
+
+func (x *FormFieldValue) GetPlate() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Value.(*formFieldValue_Plate); ok {
+			return x.Plate
+		}
+	}
+	return ""
+}
+
+func (x *FormFieldValue) GetLawId() int64 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Value.(*formFieldValue_LawId); ok {
+			return x.LawId
+		}
+	}
+	return 0
+}
+
+func (x *FormFieldValue) GetOption() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Value.(*formFieldValue_Option); ok {
+			return x.Option
+		}
+	}
+	return ""
+}
+
+func (x *FormFieldValue) SetText(v string) {
+	x.xxx_hidden_Value = &formFieldValue_Text{v}
+}
+
+func (x *FormFieldValue) SetNumber(v float64) {
+	x.xxx_hidden_Value = &formFieldValue_Number{v}
+}
+
+func (x *FormFieldValue) SetDate(v string) {
+	x.xxx_hidden_Value = &formFieldValue_Date{v}
+}
+
+func (x *FormFieldValue) SetUserId(v int32) {
+	x.xxx_hidden_Value = &formFieldValue_UserId{v}
+}
+
+func (x *FormFieldValue) SetPlate(v string) {
+	x.xxx_hidden_Value = &formFieldValue_Plate{v}
+}
+
+func (x *FormFieldValue) SetLawId(v int64) {
+	x.xxx_hidden_Value = &formFieldValue_LawId{v}
+}
+
+func (x *FormFieldValue) SetOption(v string) {
+	x.xxx_hidden_Value = &formFieldValue_Option{v}
+}
+
+func (x *FormFieldValue) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Value != nil
+}
+
+func (x *FormFieldValue) HasText() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Value.(*formFieldValue_Text)
+	return ok
+}
+
+func (x *FormFieldValue) HasNumber() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Value.(*formFieldValue_Number)
+	return ok
+}
+
+func (x *FormFieldValue) HasDate() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Value.(*formFieldValue_Date)
+	return ok
+}
+
+func (x *FormFieldValue) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Value.(*formFieldValue_UserId)
+	return ok
+}
+
+func (x *FormFieldValue) HasPlate() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Value.(*formFieldValue_Plate)
+	return ok
+}
+
+func (x *FormFieldValue) HasLawId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Value.(*formFieldValue_LawId)
+	return ok
+}
+
+func (x *FormFieldValue) HasOption() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Value.(*formFieldValue_Option)
+	return ok
+}
+
+func (x *FormFieldValue) ClearValue() {
+	x.xxx_hidden_Value = nil
+}
+
+func (x *FormFieldValue) ClearText() {
+	if _, ok := x.xxx_hidden_Value.(*formFieldValue_Text); ok {
+		x.xxx_hidden_Value = nil
+	}
+}
+
+func (x *FormFieldValue) ClearNumber() {
+	if _, ok := x.xxx_hidden_Value.(*formFieldValue_Number); ok {
+		x.xxx_hidden_Value = nil
+	}
+}
+
+func (x *FormFieldValue) ClearDate() {
+	if _, ok := x.xxx_hidden_Value.(*formFieldValue_Date); ok {
+		x.xxx_hidden_Value = nil
+	}
+}
+
+func (x *FormFieldValue) ClearUserId() {
+	if _, ok := x.xxx_hidden_Value.(*formFieldValue_UserId); ok {
+		x.xxx_hidden_Value = nil
+	}
+}
+
+func (x *FormFieldValue) ClearPlate() {
+	if _, ok := x.xxx_hidden_Value.(*formFieldValue_Plate); ok {
+		x.xxx_hidden_Value = nil
+	}
+}
+
+func (x *FormFieldValue) ClearLawId() {
+	if _, ok := x.xxx_hidden_Value.(*formFieldValue_LawId); ok {
+		x.xxx_hidden_Value = nil
+	}
+}
+
+func (x *FormFieldValue) ClearOption() {
+	if _, ok := x.xxx_hidden_Value.(*formFieldValue_Option); ok {
+		x.xxx_hidden_Value = nil
+	}
+}
+
+const FormFieldValue_Value_not_set_case case_FormFieldValue_Value = 0
+const FormFieldValue_Text_case case_FormFieldValue_Value = 1
+const FormFieldValue_Number_case case_FormFieldValue_Value = 2
+const FormFieldValue_Date_case case_FormFieldValue_Value = 3
+const FormFieldValue_UserId_case case_FormFieldValue_Value = 4
+const FormFieldValue_Plate_case case_FormFieldValue_Value = 5
+const FormFieldValue_LawId_case case_FormFieldValue_Value = 6
+const FormFieldValue_Option_case case_FormFieldValue_Value = 7
+
+func (x *FormFieldValue) WhichValue() case_FormFieldValue_Value {
+	if x == nil {
+		return FormFieldValue_Value_not_set_case
+	}
+	switch x.xxx_hidden_Value.(type) {
+	case *formFieldValue_Text:
+		return FormFieldValue_Text_case
+	case *formFieldValue_Number:
+		return FormFieldValue_Number_case
+	case *formFieldValue_Date:
+		return FormFieldValue_Date_case
+	case *formFieldValue_UserId:
+		return FormFieldValue_UserId_case
+	case *formFieldValue_Plate:
+		return FormFieldValue_Plate_case
+	case *formFieldValue_LawId:
+		return FormFieldValue_LawId_case
+	case *formFieldValue_Option:
+		return FormFieldValue_Option_case
+	default:
+		return FormFieldValue_Value_not_set_case
+	}
+}
+
+type FormFieldValue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Value:
+	Text   *string
+	Number *float64
+	// Date in `YYYY-MM-DD` format
+	Date   *string
+	UserId *int32
+	Plate  *string
+	LawId  *int64
+	Option *string
+	// -- end of xxx_hidden_Value
+}
+
+func (b0 FormFieldValue_builder) Build() *FormFieldValue {
+	m0 := &FormFieldValue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Text != nil {
+		x.xxx_hidden_Value = &formFieldValue_Text{*b.Text}
+	}
+	if b.Number != nil {
+		x.xxx_hidden_Value = &formFieldValue_Number{*b.Number}
+	}
+	if b.Date != nil {
+		x.xxx_hidden_Value = &formFieldValue_Date{*b.Date}
+	}
+	if b.UserId != nil {
+		x.xxx_hidden_Value = &formFieldValue_UserId{*b.UserId}
+	}
+	if b.Plate != nil {
+		x.xxx_hidden_Value = &formFieldValue_Plate{*b.Plate}
+	}
+	if b.LawId != nil {
+		x.xxx_hidden_Value = &formFieldValue_LawId{*b.LawId}
+	}
+	if b.Option != nil {
+		x.xxx_hidden_Value = &formFieldValue_Option{*b.Option}
+	}
+	return m0
+}
+
+type case_FormFieldValue_Value protoreflect.FieldNumber
+
+func (x case_FormFieldValue_Value) String() string {
+	md := file_resources_documents_forms_forms_proto_msgTypes[3].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isFormFieldValue_Value interface {
+	isFormFieldValue_Value()
+}
+
+type formFieldValue_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type formFieldValue_Number struct {
+	Number float64 `protobuf:"fixed64,2,opt,name=number,proto3,oneof"`
+}
+
+type formFieldValue_Date struct {
+	// Date in `YYYY-MM-DD` format
+	Date string `protobuf:"bytes,3,opt,name=date,proto3,oneof"`
+}
+
+type formFieldValue_UserId struct {
+	UserId int32 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type formFieldValue_Plate struct {
+	Plate string `protobuf:"bytes,5,opt,name=plate,proto3,oneof"`
+}
+
+type formFieldValue_LawId struct {
+	LawId int64 `protobuf:"varint,6,opt,name=law_id,json=lawId,proto3,oneof"`
+}
+
+type formFieldValue_Option struct {
+	Option string `protobuf:"bytes,7,opt,name=option,proto3,oneof"`
+}
+
+func (*formFieldValue_Text) isFormFieldValue_Value() {}
+
+func (*formFieldValue_Number) isFormFieldValue_Value() {}
+
+func (*formFieldValue_Date) isFormFieldValue_Value() {}
+
+func (*formFieldValue_UserId) isFormFieldValue_Value() {}
+
+func (*formFieldValue_Plate) isFormFieldValue_Value() {}
+
+func (*formFieldValue_LawId) isFormFieldValue_Value() {}
+
+func (*formFieldValue_Option) isFormFieldValue_Value() {}
+
+// Filter on a form field value of documents.
+type FormFieldFilter struct {
+	state             protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_Key    string                   `protobuf:"bytes,1,opt,name=key,proto3"`
+	xxx_hidden_Filter isFormFieldFilter_Filter `protobuf_oneof:"filter"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FormFieldFilter) Reset() {
+	*x = FormFieldFilter{}
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormFieldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormFieldFilter) ProtoMessage() {}
+
+func (x *FormFieldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FormFieldFilter) GetKey() string {
+	if x != nil {
+		return x.xxx_hidden_Key
+	}
+	return ""
+}
+
+func (x *FormFieldFilter) GetText() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Filter.(*formFieldFilter_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *FormFieldFilter) GetNumber() *NumberRange {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Filter.(*formFieldFilter_Number); ok {
+			return x.Number
+		}
+	}
+	return nil
+}
+
+func (x *FormFieldFilter) GetDate() *DateRange {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Filter.(*formFieldFilter_Date); ok {
+			return x.Date
+		}
+	}
+	return nil
+}
+
+func (x *FormFieldFilter) GetUserId() int32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Filter.(*formFieldFilter_UserId); ok {
+			return x.UserId
+		}
+	}
+	return 0
+}
+
+func (x *FormFieldFilter) GetPlate() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Filter.(*formFieldFilter_Plate); ok {
+			return x.Plate
+		}
+	}
+	return ""
+}
+
+func (x *FormFieldFilter) GetLawId() int64 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Filter.(*formFieldFilter_LawId); ok {
+			return x.LawId
+		}
+	}
+	return 0
+}
+
+func (x *FormFieldFilter) GetOption() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Filter.(*formFieldFilter_Option); ok {
+			return x.Option
+		}
+	}
+	return ""
+}
+
+func (x *FormFieldFilter) SetKey(v string) {
+	x.xxx_hidden_Key = v
+}
+
+func (x *FormFieldFilter) SetText(v string) {
+	x.xxx_hidden_Filter = &formFieldFilter_Text{v}
+}
+
+func (x *FormFieldFilter) SetNumber(v *NumberRange) {
+	if v == nil {
+		x.xxx_hidden_Filter = nil
+		return
+	}
+	x.xxx_hidden_Filter = &formFieldFilter_Number{v}
+}
+
+func (x *FormFieldFilter) SetDate(v *DateRange) {
+	if v == nil {
+		x.xxx_hidden_Filter = nil
+		return
+	}
+	x.xxx_hidden_Filter = &formFieldFilter_Date{v}
+}
+
+func (x *FormFieldFilter) SetUserId(v int32) {
+	x.xxx_hidden_Filter = &formFieldFilter_UserId{v}
+}
+
+func (x *FormFieldFilter) SetPlate(v string) {
+	x.xxx_hidden_Filter = &formFieldFilter_Plate{v}
+}
+
+func (x *FormFieldFilter) SetLawId(v int64) {
+	x.xxx_hidden_Filter = &formFieldFilter_LawId{v}
+}
+
+func (x *FormFieldFilter) SetOption(v string) {
+	x.xxx_hidden_Filter = &formFieldFilter_Option{v}
+}
+
+func (x *FormFieldFilter) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Filter != nil
+}
+
+func (x *FormFieldFilter) HasText() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Filter.(*formFieldFilter_Text)
+	return ok
+}
+
+func (x *FormFieldFilter) HasNumber() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Filter.(*formFieldFilter_Number)
+	return ok
+}
+
+func (x *FormFieldFilter) HasDate() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Filter.(*formFieldFilter_Date)
+	return ok
+}
+
+func (x *FormFieldFilter) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Filter.(*formFieldFilter_UserId)
+	return ok
+}
+
+func (x *FormFieldFilter) HasPlate() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Filter.(*formFieldFilter_Plate)
+	return ok
+}
+
+func (x *FormFieldFilter) HasLawId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Filter.(*formFieldFilter_LawId)
+	return ok
+}
+
+func (x *FormFieldFilter) HasOption() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Filter.(*formFieldFilter_Option)
+	return ok
+}
+
+func (x *FormFieldFilter) ClearFilter() {
+	x.xxx_hidden_Filter = nil
+}
+
+func (x *FormFieldFilter) ClearText() {
+	if _, ok := x.xxx_hidden_Filter.(*formFieldFilter_Text); ok {
+		x.xxx_hidden_Filter = nil
+	}
+}
+
+func (x *FormFieldFilter) ClearNumber() {
+	if _, ok := x.xxx_hidden_Filter.(*formFieldFilter_Number); ok {
+		x.xxx_hidden_Filter = nil
+	}
+}
+
+func (x *FormFieldFilter) ClearDate() {
+	if _, ok := x.xxx_hidden_Filter.(*formFieldFilter_Date); ok {
+		x.xxx_hidden_Filter = nil
+	}
+}
+
+func (x *FormFieldFilter) ClearUserId() {
+	if _, ok := x.xxx_hidden_Filter.(*formFieldFilter_UserId); ok {
+		x.xxx_hidden_Filter = nil
+	}
+}
+
+func (x *FormFieldFilter) ClearPlate() {
+	if _, ok := x.xxx_hidden_Filter.(*formFieldFilter_Plate); ok {
+		x.xxx_hidden_Filter = nil
+	}
+}
+
+func (x *FormFieldFilter) ClearLawId() {
+	if _, ok := x.xxx_hidden_Filter.(*formFieldFilter_LawId); ok {
+		x.xxx_hidden_Filter = nil
+	}
+}
+
+func (x *FormFieldFilter) ClearOption() {
+	if _, ok := x.xxx_hidden_Filter.(*formFieldFilter_Option); ok {
+		x.xxx_hidden_Filter = nil
+	}
+}
+
+const FormFieldFilter_Filter_not_set_case case_FormFieldFilter_Filter = 0
+const FormFieldFilter_Text_case case_FormFieldFilter_Filter = 2
+const FormFieldFilter_Number_case case_FormFieldFilter_Filter = 3
+const FormFieldFilter_Date_case case_FormFieldFilter_Filter = 4
+const FormFieldFilter_UserId_case case_FormFieldFilter_Filter = 5
+const FormFieldFilter_Plate_case case_FormFieldFilter_Filter = 6
+const FormFieldFilter_LawId_case case_FormFieldFilter_Filter = 7
+const FormFieldFilter_Option_case case_FormFieldFilter_Filter = 8
+
+func (x *FormFieldFilter) WhichFilter() case_FormFieldFilter_Filter {
+	if x == nil {
+		return FormFieldFilter_Filter_not_set_case
+	}
+	switch x.xxx_hidden_Filter.(type) {
+	case *formFieldFilter_Text:
+		return FormFieldFilter_Text_case
+	case *formFieldFilter_Number:
+		return FormFieldFilter_Number_case
+	case *formFieldFilter_Date:
+		return FormFieldFilter_Date_case
+	case *formFieldFilter_UserId:
+		return FormFieldFilter_UserId_case
+	case *formFieldFilter_Plate:
+		return FormFieldFilter_Plate_case
+	case *formFieldFilter_LawId:
+		return FormFieldFilter_LawId_case
+	case *formFieldFilter_Option:
+		return FormFieldFilter_Option_case
+	default:
+		return FormFieldFilter_Filter_not_set_case
+	}
+}
+
+type FormFieldFilter_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Key string
+	// Fields of oneof xxx_hidden_Filter:
+	// Text fields are matched by "contains"
+	Text   *string
+	Number *NumberRange
+	Date   *DateRange
+	UserId *int32
+	Plate  *string
+	LawId  *int64
+	Option *string
+	// -- end of xxx_hidden_Filter
+}
+
+func (b0 FormFieldFilter_builder) Build() *FormFieldFilter {
+	m0 := &FormFieldFilter{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Key = b.Key
+	if b.Text != nil {
+		x.xxx_hidden_Filter = &formFieldFilter_Text{*b.Text}
+	}
+	if b.Number != nil {
+		x.xxx_hidden_Filter = &formFieldFilter_Number{b.Number}
+	}
+	if b.Date != nil {
+		x.xxx_hidden_Filter = &formFieldFilter_Date{b.Date}
+	}
+	if b.UserId != nil {
+		x.xxx_hidden_Filter = &formFieldFilter_UserId{*b.UserId}
+	}
+	if b.Plate != nil {
+		x.xxx_hidden_Filter = &formFieldFilter_Plate{*b.Plate}
+	}
+	if b.LawId != nil {
+		x.xxx_hidden_Filter = &formFieldFilter_LawId{*b.LawId}
+	}
+	if b.Option != nil {
+		x.xxx_hidden_Filter = &formFieldFilter_Option{*b.Option}
+	}
+	return m0
+}
+
+type case_FormFieldFilter_Filter protoreflect.FieldNumber
+
+func (x case_FormFieldFilter_Filter) String() string {
+	md := file_resources_documents_forms_forms_proto_msgTypes[4].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isFormFieldFilter_Filter interface {
+	isFormFieldFilter_Filter()
+}
+
+type formFieldFilter_Text struct {
+	// Text fields are matched by "contains"
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type formFieldFilter_Number struct {
+	Number *NumberRange `protobuf:"bytes,3,opt,name=number,proto3,oneof"`
+}
+
+type formFieldFilter_Date struct {
+	Date *DateRange `protobuf:"bytes,4,opt,name=date,proto3,oneof"`
+}
+
+type formFieldFilter_UserId struct {
+	UserId int32 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type formFieldFilter_Plate struct {
+	Plate string `protobuf:"bytes,6,opt,name=plate,proto3,oneof"`
+}
+
+type formFieldFilter_LawId struct {
+	LawId int64 `protobuf:"varint,7,opt,name=law_id,json=lawId,proto3,oneof"`
+}
+
+type formFieldFilter_Option struct {
+	Option string `protobuf:"bytes,8,opt,name=option,proto3,oneof"`
+}
+
+func (*formFieldFilter_Text) isFormFieldFilter_Filter() {}
+
+func (*formFieldFilter_Number) isFormFieldFilter_Filter() {}
+
+func (*formFieldFilter_Date) isFormFieldFilter_Filter() {}
+
+func (*formFieldFilter_UserId) isFormFieldFilter_Filter() {}
+
+func (*formFieldFilter_Plate) isFormFieldFilter_Filter() {}
+
+func (*formFieldFilter_LawId) isFormFieldFilter_Filter() {}
+
+func (*formFieldFilter_Option) isFormFieldFilter_Filter() {}
+
+type NumberRange struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Min         float64                `protobuf:"fixed64,1,opt,name=min,proto3,oneof"`
+	xxx_hidden_Max         float64                `protobuf:"fixed64,2,opt,name=max,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NumberRange) Reset() {
+	*x = NumberRange{}
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumberRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberRange) ProtoMessage() {}
+
+func (x *NumberRange) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *NumberRange) GetMin() float64 {
+	if x != nil {
+		return x.xxx_hidden_Min
+	}
+	return 0
+}
+
+func (x *NumberRange) GetMax() float64 {
+	if x != nil {
+		return x.xxx_hidden_Max
+	}
+	return 0
+}
+
+func (x *NumberRange) SetMin(v float64) {
+	x.xxx_hidden_Min = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *NumberRange) SetMax(v float64) {
+	x.xxx_hidden_Max = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *NumberRange) HasMin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *NumberRange) HasMax() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *NumberRange) ClearMin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Min = 0
+}
+
+func (x *NumberRange) ClearMax() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Max = 0
+}
+
+type NumberRange_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Min *float64
+	Max *float64
+}
+
+func (b0 NumberRange_builder) Build() *NumberRange {
+	m0 := &NumberRange{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Min != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Min = *b.Min
+	}
+	if b.Max != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Max = *b.Max
+	}
+	return m0
+}
+
+type DateRange struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_From        *string                `protobuf:"bytes,1,opt,name=from,proto3,oneof"`
+	xxx_hidden_To          *string                `protobuf:"bytes,2,opt,name=to,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_forms_forms_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DateRange) GetFrom() string {
+	if x != nil {
+		if x.xxx_hidden_From != nil {
+			return *x.xxx_hidden_From
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DateRange) GetTo() string {
+	if x != nil {
+		if x.xxx_hidden_To != nil {
+			return *x.xxx_hidden_To
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DateRange) SetFrom(v string) {
+	x.xxx_hidden_From = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *DateRange) SetTo(v string) {
+	x.xxx_hidden_To = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *DateRange) HasFrom() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DateRange) HasTo() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DateRange) ClearFrom() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_From = nil
+}
+
+func (x *DateRange) ClearTo() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_To = nil
+}
+
+type DateRange_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Dates in `YYYY-MM-DD` format
+	From *string
+	To   *string
+}
+
+func (b0 DateRange_builder) Build() *DateRange {
+	m0 := &DateRange{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.From != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_From = b.From
+	}
+	if b.To != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_To = b.To
+	}
+	return m0
+}
+
+var File_resources_documents_forms_forms_proto protoreflect.FileDescriptor
+
+const file_resources_documents_forms_forms_proto_rawDesc = "" +
+	"\n" +
+	"%resources/documents/forms/forms.proto\x12\x19resources.documents.forms\x1a!codegen/sanitizer/sanitizer.proto\"\x16\n" +
+	"\x04Form\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc2\x03\n" +
+	"\tFormField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1e\n" +
+	"\x05label\x18\x02 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x05label\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x00R\vdescription\x88\x01\x01\x12<\n" +
+	"\x04type\x18\x04 \x01(\x0e2(.resources.documents.forms.FormFieldTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\"\n" +
+	"\n" +
+	"min_length\x18\x06 \x01(\x05H\x01R\tminLength\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_length\x18\a \x01(\x05H\x02R\tmaxLength\x88\x01\x01\x12\x15\n" +
+	"\x03min\x18\b \x01(\x01H\x03R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\t \x01(\x01H\x04R\x03max\x88\x01\x01\x12D\n" +
+	"\aoptions\x18\n" +
+	" \x03(\v2*.resources.documents.forms.FormFieldOptionR\aoptionsB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_min_lengthB\r\n" +
+	"\v_max_lengthB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"G\n" +
+	"\x0fFormFieldOption\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1e\n" +
+	"\x05label\x18\x02 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x05label\"\xcf\x01\n" +
+	"\x0eFormFieldValue\x12\x1e\n" +
+	"\x04text\x18\x01 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x00R\x04text\x12\x18\n" +
+	"\x06number\x18\x02 \x01(\x01H\x00R\x06number\x12\x14\n" +
+	"\x04date\x18\x03 \x01(\tH\x00R\x04date\x12\x19\n" +
+	"\auser_id\x18\x04 \x01(\x05H\x00R\x06userId\x12\x16\n" +
+	"\x05plate\x18\x05 \x01(\tH\x00R\x05plate\x12\x17\n" +
+	"\x06law_id\x18\x06 \x01(\x03H\x00R\x05lawId\x12\x18\n" +
+	"\x06option\x18\a \x01(\tH\x00R\x06optionB\a\n" +
+	"\x05value\"\xa7\x02\n" +
+	"\x0fFormFieldFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04text\x12@\n" +
+	"\x06number\x18\x03 \x01(\v2&.resources.documents.forms.NumberRangeH\x00R\x06number\x12:\n" +
+	"\x04date\x18\x04 \x01(\v2$.resources.documents.forms.DateRangeH\x00R\x04date\x12\x19\n" +
+	"\auser_id\x18\x05 \x01(\x05H\x00R\x06userId\x12\x16\n" +
+	"\x05plate\x18\x06 \x01(\tH\x00R\x05plate\x12\x17\n" +
+	"\x06law_id\x18\a \x01(\x03H\x00R\x05lawId\x12\x18\n" +
+	"\x06option\x18\b \x01(\tH\x00R\x06optionB\b\n" +
+	"\x06filter\"K\n" +
+	"\vNumberRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"I\n" +
+	"\tDateRange\x12\x17\n" +
+	"\x04from\x18\x01 \x01(\tH\x00R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x02 \x01(\tH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to*\xef\x01\n" +
+	"\rFormFieldType\x12\x1f\n" +
+	"\x1bFORM_FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14FORM_FIELD_TYPE_TEXT\x10\x01\x12\x1a\n" +
+	"\x16FORM_FIELD_TYPE_NUMBER\x10\x02\x12\x18\n" +
+	"\x14FORM_FIELD_TYPE_DATE\x10\x03\x12\x1b\n" +
+	"\x17FORM_FIELD_TYPE_CITIZEN\x10\x04\x12\x1b\n" +
+	"\x17FORM_FIELD_TYPE_VEHICLE\x10\x05\x12\x17\n" +
+	"\x13FORM_FIELD_TYPE_LAW\x10\x06\x12\x1a\n" +
+	"\x16FORM_FIELD_TYPE_SELECT\x10\aB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms;documentsformsb\x06proto3"
+
+var file_resources_documents_forms_forms_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_documents_forms_forms_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_resources_documents_forms_forms_proto_goTypes = []any{
+	(FormFieldType)(0),      // 0: resources.documents.forms.FormFieldType
+	(*Form)(nil),            // 1: resources.documents.forms.Form
+	(*FormField)(nil),       // 2: resources.documents.forms.FormField
+	(*FormFieldOption)(nil), // 3: resources.documents.forms.FormFieldOption
+	(*FormFieldValue)(nil),  // 4: resources.documents.forms.FormFieldValue
+	(*FormFieldFilter)(nil), // 5: resources.documents.forms.FormFieldFilter
+	(*NumberRange)(nil),     // 6: resources.documents.forms.NumberRange
+	(*DateRange)(nil),       // 7: resources.documents.forms.DateRange
+}
+var file_resources_documents_forms_forms_proto_depIdxs = []int32{
+	0, // 0: resources.documents.forms.FormField.type:type_name -> resources.documents.forms.FormFieldType
+	3, // 1: resources.documents.forms.FormField.options:type_name -> resources.documents.forms.FormFieldOption
+	6, // 2: resources.documents.forms.FormFieldFilter.number:type_name -> resources.documents.forms.NumberRange
+	7, // 3: resources.documents.forms.FormFieldFilter.date:type_name -> resources.documents.forms.DateRange
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_resources_documents_forms_forms_proto_init() }
+func file_resources_documents_forms_forms_proto_init() {
+	if File_resources_documents_forms_forms_proto != nil {
+		return
+	}
+	file_resources_documents_forms_forms_proto_msgTypes[1].OneofWrappers = []any{}
+	file_resources_documents_forms_forms_proto_msgTypes[3].OneofWrappers = []any{
+		(*formFieldValue_Text)(nil),
+		(*formFieldValue_Number)(nil),
+		(*formFieldValue_Date)(nil),
+		(*formFieldValue_UserId)(nil),
+		(*formFieldValue_Plate)(nil),
+		(*formFieldValue_LawId)(nil),
+		(*formFieldValue_Option)(nil),
+	}
+	file_resources_documents_forms_forms_proto_msgTypes[4].OneofWrappers = []any{
+		(*formFieldFilter_Text)(nil),
+		(*formFieldFilter_Number)(nil),
+		(*formFieldFilter_Date)(nil),
+		(*formFieldFilter_UserId)(nil),
+		(*formFieldFilter_Plate)(nil),
+		(*formFieldFilter_LawId)(nil),
+		(*formFieldFilter_Option)(nil),
 	}
+	file_resources_documents_forms_forms_proto_msgTypes[5].OneofWrappers = []any{}
+	file_resources_documents_forms_forms_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_forms_forms_proto_rawDesc), len(file_resources_documents_forms_forms_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_resources_documents_forms_forms_proto_goTypes,
 		DependencyIndexes: file_resources_documents_forms_forms_proto_depIdxs,
+		EnumInfos:         file_resources_documents_forms_forms_proto_enumTypes,
 		MessageInfos:      file_resources_documents_forms_forms_proto_msgTypes,
 	}.Build()
 	File_resources_documents_forms_forms_proto = out.File
@@ -0,0 +1,277 @@
+package documentsforms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testFields() []*FormField {
+	return []*FormField{
+		{
+			Key:       "summary",
+			Type:      FormFieldType_FORM_FIELD_TYPE_TEXT,
+			Required:  true,
+			MaxLength: proto.Int32(5),
+		},
+		{
+			Key:  "fine",
+			Type: FormFieldType_FORM_FIELD_TYPE_NUMBER,
+			Min:  proto.Float64(0),
+			Max:  proto.Float64(1000),
+		},
+		{
+			Key:  "incident_date",
+			Type: FormFieldType_FORM_FIELD_TYPE_DATE,
+		},
+		{
+			Key:  "suspect",
+			Type: FormFieldType_FORM_FIELD_TYPE_CITIZEN,
+		},
+		{
+			Key:  "severity",
+			Type: FormFieldType_FORM_FIELD_TYPE_SELECT,
+			Options: []*FormFieldOption{
+				{Value: "low", Label: "Low"},
+				{Value: "high", Label: "High"},
+			},
+		},
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateFields(testFields()))
+
+	err := ValidateFields(append(testFields(), &FormField{
+		Key:  "fine",
+		Type: FormFieldType_FORM_FIELD_TYPE_NUMBER,
+	}))
+	require.Error(t, err)
+	var fieldErr *FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "fine", fieldErr.Key)
+
+	err = ValidateFields([]*FormField{{
+		Key:  "severity",
+		Type: FormFieldType_FORM_FIELD_TYPE_SELECT,
+	}})
+	require.Error(t, err)
+
+	err = ValidateFields([]*FormField{{
+		Key:  "fine",
+		Type: FormFieldType_FORM_FIELD_TYPE_NUMBER,
+		Min:  proto.Float64(10),
+		Max:  proto.Float64(1),
+	}})
+	require.Error(t, err)
+}
+
+func TestValidateValues(t *testing.T) {
+	t.Parallel()
+
+	fields := testFields()
+
+	tests := []struct {
+		name          string
+		values        map[string]*FormFieldValue
+		checkRequired bool
+		errKey        string
+	}{
+		{
+			name: "valid values",
+			values: map[string]*FormFieldValue{
+				"summary":       {Value: &FormFieldValue_Text{Text: "Theft"}},
+				"fine":          {Value: &FormFieldValue_Number{Number: 500}},
+				"incident_date": {Value: &FormFieldValue_Date{Date: "2026-01-31"}},
+				"suspect":       {Value: &FormFieldValue_UserId{UserId: 3}},
+				"severity":      {Value: &FormFieldValue_Option{Option: "high"}},
+			},
+			checkRequired: true,
+		},
+		{
+			name:          "missing required value on draft",
+			values:        map[string]*FormFieldValue{},
+			checkRequired: false,
+		},
+		{
+			name:          "missing required value",
+			values:        map[string]*FormFieldValue{},
+			checkRequired: true,
+			errKey:        "summary",
+		},
+		{
+			name: "unknown field",
+			values: map[string]*FormFieldValue{
+				"unknown": {Value: &FormFieldValue_Text{Text: "value"}},
+			},
+			errKey: "unknown",
+		},
+		{
+			name: "type mismatch",
+			values: map[string]*FormFieldValue{
+				"fine": {Value: &FormFieldValue_Text{Text: "500"}},
+			},
+			errKey: "fine",
+		},
+		{
+			name: "text too long",
+			values: map[string]*FormFieldValue{
+				"summary": {Value: &FormFieldValue_Text{Text: "Robbery"}},
+			},
+			errKey: "summary",
+		},
+		{
+			name: "number out of range",
+			values: map[string]*FormFieldValue{
+				"fine": {Value: &FormFieldValue_Number{Number: 1001}},
+			},
+			errKey: "fine",
+		},
+		{
+			name: "invalid date",
+			values: map[string]*FormFieldValue{
+				"incident_date": {Value: &FormFieldValue_Date{Date: "2026-02-31"}},
+			},
+			errKey: "incident_date",
+		},
+		{
+			name: "unknown option",
+			values: map[string]*FormFieldValue{
+				"severity": {Value: &FormFieldValue_Option{Option: "medium"}},
+			},
+			errKey: "severity",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateValues(fields, tc.values, tc.checkRequired)
+			if tc.errKey == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			assert.Equal(t, tc.errKey, fieldErr.Key)
+		})
+	}
+}
+
+func TestReferences(t *testing.T) {
+	t.Parallel()
+
+	userIds, plates, lawIds := References(map[string]*FormFieldValue{
+		"suspect": {Value: &FormFieldValue_UserId{UserId: 3}},
+		"witness": {Value: &FormFieldValue_UserId{UserId: 3}},
+		"vehicle": {Value: &FormFieldValue_Plate{Plate: "ABC 123"}},
+		"law":     {Value: &FormFieldValue_LawId{LawId: 7}},
+		"notes":   {Value: &FormFieldValue_Text{Text: "text"}},
+	})
+	assert.Equal(t, []int32{3}, userIds)
+	assert.Equal(t, []string{"ABC 123"}, plates)
+	assert.Equal(t, []int64{7}, lawIds)
+}
+
+func TestFormFieldFilterMatches(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]*FormFieldValue{
+		"summary":       {Value: &FormFieldValue_Text{Text: "Armed robbery at the bank"}},
+		"fine":          {Value: &FormFieldValue_Number{Number: 500}},
+		"incident_date": {Value: &FormFieldValue_Date{Date: "2026-05-01"}},
+		"suspect":       {Value: &FormFieldValue_UserId{UserId: 3}},
+		"severity":      {Value: &FormFieldValue_Option{Option: "high"}},
+	}
+
+	cases := []struct {
+		name   string
+		filter *FormFieldFilter
+		match  bool
+	}{
+		{
+			name: "text words in order",
+			filter: &FormFieldFilter{
+				Key:    "summary",
+				Filter: &FormFieldFilter_Text{Text: "armed BANK"},
+			},
+			match: true,
+		},
+		{
+			name: "text words out of order",
+			filter: &FormFieldFilter{
+				Key:    "summary",
+				Filter: &FormFieldFilter_Text{Text: "bank armed"},
+			},
+		},
+		{
+			name: "number in range",
+			filter: &FormFieldFilter{
+				Key:    "fine",
+				Filter: &FormFieldFilter_Number{Number: &NumberRange{Min: proto.Float64(500)}},
+			},
+			match: true,
+		},
+		{
+			name: "number out of range",
+			filter: &FormFieldFilter{
+				Key:    "fine",
+				Filter: &FormFieldFilter_Number{Number: &NumberRange{Max: proto.Float64(499.5)}},
+			},
+		},
+		{
+			name: "date in range",
+			filter: &FormFieldFilter{
+				Key: "incident_date",
+				Filter: &FormFieldFilter_Date{Date: &DateRange{
+					From: proto.String("2026-05-01"),
+					To:   proto.String("2026-05-31"),
+				}},
+			},
+			match: true,
+		},
+		{
+			name: "other citizen",
+			filter: &FormFieldFilter{
+				Key:    "suspect",
+				Filter: &FormFieldFilter_UserId{UserId: 4},
+			},
+		},
+		{
+			name: "option",
+			filter: &FormFieldFilter{
+				Key:    "severity",
+				Filter: &FormFieldFilter_Option{Option: "high"},
+			},
+			match: true,
+		},
+		{
+			name: "type mismatch",
+			filter: &FormFieldFilter{
+				Key:    "severity",
+				Filter: &FormFieldFilter_Text{Text: "high"},
+			},
+		},
+		{
+			name: "missing value",
+			filter: &FormFieldFilter{
+				Key:    "vehicle",
+				Filter: &FormFieldFilter_Plate{Plate: "ABC 123"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.match, tc.filter.Matches(values))
+		})
+	}
+}
@@ -9,6 +9,7 @@
 package documentsreferences
 
 import (
+	dispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
 	documents "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	short "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
@@ -323,11 +324,204 @@ func (b0 DocumentReference_builder) Build() *DocumentReference {
 	return m0
 }
 
+// Snapshot of a dispatch's timeline attached to a document.
+type DocumentDispatchTimeline struct {
+	state                  protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Id          int64                        `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_CreatedAt   *timestamp.Timestamp         `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof"`
+	xxx_hidden_DocumentId  int64                        `protobuf:"varint,3,opt,name=document_id,json=documentId,proto3"`
+	xxx_hidden_DispatchId  int64                        `protobuf:"varint,4,opt,name=dispatch_id,json=dispatchId,proto3"`
+	xxx_hidden_CreatorId   int32                        `protobuf:"varint,5,opt,name=creator_id,json=creatorId,proto3,oneof"`
+	xxx_hidden_Creator     *short.UserShort             `protobuf:"bytes,6,opt,name=creator,proto3,oneof"`
+	xxx_hidden_Timeline    *dispatches.DispatchTimeline `protobuf:"bytes,7,opt,name=timeline,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DocumentDispatchTimeline) Reset() {
+	*x = DocumentDispatchTimeline{}
+	mi := &file_resources_documents_references_references_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentDispatchTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentDispatchTimeline) ProtoMessage() {}
+
+func (x *DocumentDispatchTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_references_references_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DocumentDispatchTimeline) GetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *DocumentDispatchTimeline) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *DocumentDispatchTimeline) GetDocumentId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DocumentId
+	}
+	return 0
+}
+
+func (x *DocumentDispatchTimeline) GetDispatchId() int64 {
+	if x != nil {
+		return x.xxx_hidden_DispatchId
+	}
+	return 0
+}
+
+func (x *DocumentDispatchTimeline) GetCreatorId() int32 {
+	if x != nil {
+		return x.xxx_hidden_CreatorId
+	}
+	return 0
+}
+
+func (x *DocumentDispatchTimeline) GetCreator() *short.UserShort {
+	if x != nil {
+		return x.xxx_hidden_Creator
+	}
+	return nil
+}
+
+func (x *DocumentDispatchTimeline) GetTimeline() *dispatches.DispatchTimeline {
+	if x != nil {
+		return x.xxx_hidden_Timeline
+	}
+	return nil
+}
+
+func (x *DocumentDispatchTimeline) SetId(v int64) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *DocumentDispatchTimeline) SetCreatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *DocumentDispatchTimeline) SetDocumentId(v int64) {
+	x.xxx_hidden_DocumentId = v
+}
+
+func (x *DocumentDispatchTimeline) SetDispatchId(v int64) {
+	x.xxx_hidden_DispatchId = v
+}
+
+func (x *DocumentDispatchTimeline) SetCreatorId(v int32) {
+	x.xxx_hidden_CreatorId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *DocumentDispatchTimeline) SetCreator(v *short.UserShort) {
+	x.xxx_hidden_Creator = v
+}
+
+func (x *DocumentDispatchTimeline) SetTimeline(v *dispatches.DispatchTimeline) {
+	x.xxx_hidden_Timeline = v
+}
+
+func (x *DocumentDispatchTimeline) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *DocumentDispatchTimeline) HasCreatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DocumentDispatchTimeline) HasCreator() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Creator != nil
+}
+
+func (x *DocumentDispatchTimeline) HasTimeline() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Timeline != nil
+}
+
+func (x *DocumentDispatchTimeline) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *DocumentDispatchTimeline) ClearCreatorId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_CreatorId = 0
+}
+
+func (x *DocumentDispatchTimeline) ClearCreator() {
+	x.xxx_hidden_Creator = nil
+}
+
+func (x *DocumentDispatchTimeline) ClearTimeline() {
+	x.xxx_hidden_Timeline = nil
+}
+
+type DocumentDispatchTimeline_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id         int64
+	CreatedAt  *timestamp.Timestamp
+	DocumentId int64
+	DispatchId int64
+	CreatorId  *int32
+	Creator    *short.UserShort
+	Timeline   *dispatches.DispatchTimeline
+}
+
+func (b0 DocumentDispatchTimeline_builder) Build() *DocumentDispatchTimeline {
+	m0 := &DocumentDispatchTimeline{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_DocumentId = b.DocumentId
+	x.xxx_hidden_DispatchId = b.DispatchId
+	if b.CreatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_CreatorId = *b.CreatorId
+	}
+	x.xxx_hidden_Creator = b.Creator
+	x.xxx_hidden_Timeline = b.Timeline
+	return m0
+}
+
 var File_resources_documents_references_references_proto protoreflect.FileDescriptor
 
 const file_resources_documents_references_references_proto_rawDesc = "" +
 	"\n" +
-	"/resources/documents/references/references.proto\x12\x1eresources.documents.references\x1a#resources/documents/documents.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xa6\x06\n" +
+	"/resources/documents/references/references.proto\x12\x1eresources.documents.references\x1a+resources/centrum/dispatches/timeline.proto\x1a#resources/documents/documents.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xa6\x06\n" +
 	"\x11DocumentReference\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x03H\x00R\x02id\x88\x01\x01\x12B\n" +
 	"\n" +
@@ -346,6 +540,22 @@ const file_resources_documents_references_references_proto_rawDesc = "" +
 	"\x10_target_documentB\r\n" +
 	"\v_creator_idB\n" +
 	"\n" +
+	"\b_creator\"\xa1\x03\n" +
+	"\x18DocumentDispatchTimeline\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12B\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\x03R\n" +
+	"documentId\x12\x1f\n" +
+	"\vdispatch_id\x18\x04 \x01(\x03R\n" +
+	"dispatchId\x12\"\n" +
+	"\n" +
+	"creator_id\x18\x05 \x01(\x05H\x01R\tcreatorId\x88\x01\x01\x12U\n" +
+	"\acreator\x18\x06 \x01(\v2 .resources.users.short.UserShortB\x14\x9a\x84\x9e\x03\x0falias:\"creator\"H\x02R\acreator\x88\x01\x01\x12J\n" +
+	"\btimeline\x18\a \x01(\v2..resources.centrum.dispatches.DispatchTimelineR\btimelineB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_creator_idB\n" +
+	"\n" +
 	"\b_creator*\x99\x01\n" +
 	"\fDocReference\x12\x1d\n" +
 	"\x19DOC_REFERENCE_UNSPECIFIED\x10\x00\x12\x18\n" +
@@ -355,25 +565,30 @@ const file_resources_documents_references_references_proto_rawDesc = "" +
 	"\x18DOC_REFERENCE_DEPRECATES\x10\x04BfZdgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/references;documentsreferencesb\x06proto3"
 
 var file_resources_documents_references_references_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_resources_documents_references_references_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resources_documents_references_references_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_resources_documents_references_references_proto_goTypes = []any{
-	(DocReference)(0),               // 0: resources.documents.references.DocReference
-	(*DocumentReference)(nil),       // 1: resources.documents.references.DocumentReference
-	(*timestamp.Timestamp)(nil),     // 2: resources.timestamp.Timestamp
-	(*documents.DocumentShort)(nil), // 3: resources.documents.DocumentShort
-	(*short.UserShort)(nil),         // 4: resources.users.short.UserShort
+	(DocReference)(0),                   // 0: resources.documents.references.DocReference
+	(*DocumentReference)(nil),           // 1: resources.documents.references.DocumentReference
+	(*DocumentDispatchTimeline)(nil),    // 2: resources.documents.references.DocumentDispatchTimeline
+	(*timestamp.Timestamp)(nil),         // 3: resources.timestamp.Timestamp
+	(*documents.DocumentShort)(nil),     // 4: resources.documents.DocumentShort
+	(*short.UserShort)(nil),             // 5: resources.users.short.UserShort
+	(*dispatches.DispatchTimeline)(nil), // 6: resources.centrum.dispatches.DispatchTimeline
 }
 var file_resources_documents_references_references_proto_depIdxs = []int32{
-	2, // 0: resources.documents.references.DocumentReference.created_at:type_name -> resources.timestamp.Timestamp
-	3, // 1: resources.documents.references.DocumentReference.source_document:type_name -> resources.documents.DocumentShort
+	3, // 0: resources.documents.references.DocumentReference.created_at:type_name -> resources.timestamp.Timestamp
+	4, // 1: resources.documents.references.DocumentReference.source_document:type_name -> resources.documents.DocumentShort
 	0, // 2: resources.documents.references.DocumentReference.reference:type_name -> resources.documents.references.DocReference
-	3, // 3: resources.documents.references.DocumentReference.target_document:type_name -> resources.documents.DocumentShort
-	4, // 4: resources.documents.references.DocumentReference.creator:type_name -> resources.users.short.UserShort
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	4, // 3: resources.documents.references.DocumentReference.target_document:type_name -> resources.documents.DocumentShort
+	5, // 4: resources.documents.references.DocumentReference.creator:type_name -> resources.users.short.UserShort
+	3, // 5: resources.documents.references.DocumentDispatchTimeline.created_at:type_name -> resources.timestamp.Timestamp
+	5, // 6: resources.documents.references.DocumentDispatchTimeline.creator:type_name -> resources.users.short.UserShort
+	6, // 7: resources.documents.references.DocumentDispatchTimeline.timeline:type_name -> resources.centrum.dispatches.DispatchTimeline
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_references_references_proto_init() }
@@ -382,13 +597,14 @@ func file_resources_documents_references_references_proto_init() {
 		return
 	}
 	file_resources_documents_references_references_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_documents_references_references_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_references_references_proto_rawDesc), len(file_resources_documents_references_references_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
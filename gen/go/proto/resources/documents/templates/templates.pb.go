@@ -15,6 +15,7 @@ import (
 	documents "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
 	approval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
 	category "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
+	forms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
 	workflow "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/workflow"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	users "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users"
@@ -789,8 +790,10 @@ func (b0 TemplateShort_builder) Build() *TemplateShort {
 }
 
 type TemplateSchema struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Requirements  *TemplateRequirements  `protobuf:"bytes,1,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	state        protoimpl.MessageState `protogen:"hybrid.v1"`
+	Requirements *TemplateRequirements  `protobuf:"bytes,1,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	// Typed fields, the values are stored in the document's data
+	Fields        []*forms.FormField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -827,10 +830,21 @@ func (x *TemplateSchema) GetRequirements() *TemplateRequirements {
 	return nil
 }
 
+func (x *TemplateSchema) GetFields() []*forms.FormField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 func (x *TemplateSchema) SetRequirements(v *TemplateRequirements) {
 	x.Requirements = v
 }
 
+func (x *TemplateSchema) SetFields(v []*forms.FormField) {
+	x.Fields = v
+}
+
 func (x *TemplateSchema) HasRequirements() bool {
 	if x == nil {
 		return false
@@ -846,6 +860,8 @@ type TemplateSchema_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Requirements *TemplateRequirements
+	// Typed fields, the values are stored in the document's data
+	Fields []*forms.FormField
 }
 
 func (b0 TemplateSchema_builder) Build() *TemplateSchema {
@@ -853,6 +869,7 @@ func (b0 TemplateSchema_builder) Build() *TemplateSchema {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Requirements = b.Requirements
+	x.Fields = b.Fields
 	return m0
 }
 
@@ -1624,7 +1641,7 @@ var File_resources_documents_templates_templates_proto protoreflect.FileDescript
 
 const file_resources_documents_templates_templates_proto_rawDesc = "" +
 	"\n" +
-	"-resources/documents/templates/templates.proto\x12\x1dresources.documents.templates\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a+resources/documents/approval/approval.proto\x1a+resources/documents/category/category.proto\x1a#resources/documents/documents.proto\x1a+resources/documents/workflow/workflow.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x1aresources/users/user.proto\x1a!resources/vehicles/vehicles.proto\x1a\x13tagger/tagger.proto\"\xda\t\n" +
+	"-resources/documents/templates/templates.proto\x12\x1dresources.documents.templates\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a+resources/documents/approval/approval.proto\x1a+resources/documents/category/category.proto\x1a#resources/documents/documents.proto\x1a%resources/documents/forms/forms.proto\x1a+resources/documents/workflow/workflow.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x1aresources/users/user.proto\x1a!resources/vehicles/vehicles.proto\x1a\x13tagger/tagger.proto\"\xda\t\n" +
 	"\bTemplate\x12\x1f\n" +
 	"\x02id\x18\x01 \x01(\x03B\x0f\x9a\x84\x9e\x03\n" +
 	"alias:\"id\"R\x02id\x12B\n" +
@@ -1686,9 +1703,10 @@ const file_resources_documents_templates_templates_proto_rawDesc = "" +
 	"\x06_colorB\a\n" +
 	"\x05_iconB\x14\n" +
 	"\x12_creator_job_labelB\v\n" +
-	"\t_workflowJ\x04\b\x06\x10\a\"q\n" +
+	"\t_workflowJ\x04\b\x06\x10\a\"\xaf\x01\n" +
 	"\x0eTemplateSchema\x12W\n" +
-	"\frequirements\x18\x01 \x01(\v23.resources.documents.templates.TemplateRequirementsR\frequirements:\x06\xe2\xf3\x18\x02\b\x01\"\x9e\x02\n" +
+	"\frequirements\x18\x01 \x01(\v23.resources.documents.templates.TemplateRequirementsR\frequirements\x12<\n" +
+	"\x06fields\x18\x02 \x03(\v2$.resources.documents.forms.FormFieldR\x06fields:\x06\xe2\xf3\x18\x02\b\x01\"\x9e\x02\n" +
 	"\x14TemplateRequirements\x12M\n" +
 	"\tdocuments\x18\x01 \x01(\v2*.resources.documents.templates.ObjectSpecsH\x00R\tdocuments\x88\x01\x01\x12E\n" +
 	"\x05users\x18\x02 \x01(\v2*.resources.documents.templates.ObjectSpecsH\x01R\x05users\x88\x01\x01\x12K\n" +
@@ -1752,12 +1770,13 @@ var file_resources_documents_templates_templates_proto_goTypes = []any{
 	(*access.JobAccess)(nil),         // 11: resources.access.JobAccess
 	(*access.Access)(nil),            // 12: resources.access.Access
 	(*workflow.Workflow)(nil),        // 13: resources.documents.workflow.Workflow
-	(*users.User)(nil),               // 14: resources.users.User
-	(*documents.DocumentShort)(nil),  // 15: resources.documents.DocumentShort
-	(*short.UserShort)(nil),          // 16: resources.users.short.UserShort
-	(*vehicles.Vehicle)(nil),         // 17: resources.vehicles.Vehicle
-	(approval.ApprovalRuleKind)(0),   // 18: resources.documents.approval.ApprovalRuleKind
-	(approval.OnEditBehavior)(0),     // 19: resources.documents.approval.OnEditBehavior
+	(*forms.FormField)(nil),          // 14: resources.documents.forms.FormField
+	(*users.User)(nil),               // 15: resources.users.User
+	(*documents.DocumentShort)(nil),  // 16: resources.documents.DocumentShort
+	(*short.UserShort)(nil),          // 17: resources.users.short.UserShort
+	(*vehicles.Vehicle)(nil),         // 18: resources.vehicles.Vehicle
+	(approval.ApprovalRuleKind)(0),   // 19: resources.documents.approval.ApprovalRuleKind
+	(approval.OnEditBehavior)(0),     // 20: resources.documents.approval.OnEditBehavior
 }
 var file_resources_documents_templates_templates_proto_depIdxs = []int32{
 	9,  // 0: resources.documents.templates.Template.created_at:type_name -> resources.timestamp.Timestamp
@@ -1776,22 +1795,23 @@ var file_resources_documents_templates_templates_proto_depIdxs = []int32{
 	2,  // 13: resources.documents.templates.TemplateShort.schema:type_name -> resources.documents.templates.TemplateSchema
 	13, // 14: resources.documents.templates.TemplateShort.workflow:type_name -> resources.documents.workflow.Workflow
 	3,  // 15: resources.documents.templates.TemplateSchema.requirements:type_name -> resources.documents.templates.TemplateRequirements
-	4,  // 16: resources.documents.templates.TemplateRequirements.documents:type_name -> resources.documents.templates.ObjectSpecs
-	4,  // 17: resources.documents.templates.TemplateRequirements.users:type_name -> resources.documents.templates.ObjectSpecs
-	4,  // 18: resources.documents.templates.TemplateRequirements.vehicles:type_name -> resources.documents.templates.ObjectSpecs
-	14, // 19: resources.documents.templates.TemplateData.active_char:type_name -> resources.users.User
-	15, // 20: resources.documents.templates.TemplateData.documents:type_name -> resources.documents.DocumentShort
-	16, // 21: resources.documents.templates.TemplateData.users:type_name -> resources.users.short.UserShort
-	17, // 22: resources.documents.templates.TemplateData.vehicles:type_name -> resources.vehicles.Vehicle
-	7,  // 23: resources.documents.templates.TemplateApproval.policy:type_name -> resources.documents.templates.TemplateApprovalPolicy
-	8,  // 24: resources.documents.templates.TemplateApproval.tasks:type_name -> resources.documents.templates.TemplateApprovalTaskSeed
-	18, // 25: resources.documents.templates.TemplateApprovalPolicy.rule_kind:type_name -> resources.documents.approval.ApprovalRuleKind
-	19, // 26: resources.documents.templates.TemplateApprovalPolicy.on_edit_behavior:type_name -> resources.documents.approval.OnEditBehavior
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	14, // 16: resources.documents.templates.TemplateSchema.fields:type_name -> resources.documents.forms.FormField
+	4,  // 17: resources.documents.templates.TemplateRequirements.documents:type_name -> resources.documents.templates.ObjectSpecs
+	4,  // 18: resources.documents.templates.TemplateRequirements.users:type_name -> resources.documents.templates.ObjectSpecs
+	4,  // 19: resources.documents.templates.TemplateRequirements.vehicles:type_name -> resources.documents.templates.ObjectSpecs
+	15, // 20: resources.documents.templates.TemplateData.active_char:type_name -> resources.users.User
+	16, // 21: resources.documents.templates.TemplateData.documents:type_name -> resources.documents.DocumentShort
+	17, // 22: resources.documents.templates.TemplateData.users:type_name -> resources.users.short.UserShort
+	18, // 23: resources.documents.templates.TemplateData.vehicles:type_name -> resources.vehicles.Vehicle
+	7,  // 24: resources.documents.templates.TemplateApproval.policy:type_name -> resources.documents.templates.TemplateApprovalPolicy
+	8,  // 25: resources.documents.templates.TemplateApproval.tasks:type_name -> resources.documents.templates.TemplateApprovalTaskSeed
+	19, // 26: resources.documents.templates.TemplateApprovalPolicy.rule_kind:type_name -> resources.documents.approval.ApprovalRuleKind
+	20, // 27: resources.documents.templates.TemplateApprovalPolicy.on_edit_behavior:type_name -> resources.documents.approval.OnEditBehavior
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_resources_documents_templates_templates_proto_init() }
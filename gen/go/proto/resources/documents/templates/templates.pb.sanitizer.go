@@ -287,6 +287,18 @@ func (m *TemplateSchema) Sanitize() error {
 		return nil
 	}
 
+	// Field: Fields
+	for idx, item := range m.Fields {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// Field: Requirements
 	if m.Requirements != nil {
 		if v, ok := any(m.GetRequirements()).(interface{ Sanitize() error }); ok {
@@ -0,0 +1,42 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/documents/versions/versions.proto
+
+package documentsversions
+
+import (
+	"database/sql/driver"
+
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+)
+
+// Scan implements driver.Valuer for protobuf DocumentVersionContributors.
+func (x *DocumentVersionContributors) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the DocumentVersionContributors value into driver.Valuer.
+func (x *DocumentVersionContributors) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
@@ -0,0 +1,636 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/documents/versions/versions.proto
+
+//go:build !protoopaque
+
+package documentsversions
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/dbscanner"
+	content "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/content"
+	data "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	short "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Saved revision of a document's title, content and data.
+type DocumentVersion struct {
+	state           protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DocumentId      int64                  `protobuf:"varint,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	CreatorId       *int32                 `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
+	Creator         *short.UserShort       `protobuf:"bytes,5,opt,name=creator,proto3,oneof" json:"creator,omitempty" alias:"creator"`
+	CreatorJob      string                 `protobuf:"bytes,6,opt,name=creator_job,json=creatorJob,proto3" json:"creator_job,omitempty"`
+	CreatorJobLabel *string                `protobuf:"bytes,7,opt,name=creator_job_label,json=creatorJobLabel,proto3,oneof" json:"creator_job_label,omitempty"`
+	Title           string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	// Only set when a single version is requested
+	Content      *content.Content             `protobuf:"bytes,9,opt,name=content,proto3,oneof" json:"content,omitempty" alias:"content_json"`
+	Data         *data.DocumentData           `protobuf:"bytes,10,opt,name=data,proto3,oneof" json:"data,omitempty" alias:"data"`
+	Contributors *DocumentVersionContributors `protobuf:"bytes,11,opt,name=contributors,proto3,oneof" json:"contributors,omitempty"`
+	// Set if the version has been created by restoring another version
+	RestoredFromId *int64 `protobuf:"varint,12,opt,name=restored_from_id,json=restoredFromId,proto3,oneof" json:"restored_from_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DocumentVersion) Reset() {
+	*x = DocumentVersion{}
+	mi := &file_resources_documents_versions_versions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentVersion) ProtoMessage() {}
+
+func (x *DocumentVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_versions_versions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DocumentVersion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocumentVersion) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DocumentVersion) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *DocumentVersion) GetCreatorId() int32 {
+	if x != nil && x.CreatorId != nil {
+		return *x.CreatorId
+	}
+	return 0
+}
+
+func (x *DocumentVersion) GetCreator() *short.UserShort {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *DocumentVersion) GetCreatorJob() string {
+	if x != nil {
+		return x.CreatorJob
+	}
+	return ""
+}
+
+func (x *DocumentVersion) GetCreatorJobLabel() string {
+	if x != nil && x.CreatorJobLabel != nil {
+		return *x.CreatorJobLabel
+	}
+	return ""
+}
+
+func (x *DocumentVersion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DocumentVersion) GetContent() *content.Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DocumentVersion) GetData() *data.DocumentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DocumentVersion) GetContributors() *DocumentVersionContributors {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+func (x *DocumentVersion) GetRestoredFromId() int64 {
+	if x != nil && x.RestoredFromId != nil {
+		return *x.RestoredFromId
+	}
+	return 0
+}
+
+func (x *DocumentVersion) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *DocumentVersion) SetCreatedAt(v *timestamp.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *DocumentVersion) SetDocumentId(v int64) {
+	x.DocumentId = v
+}
+
+func (x *DocumentVersion) SetCreatorId(v int32) {
+	x.CreatorId = &v
+}
+
+func (x *DocumentVersion) SetCreator(v *short.UserShort) {
+	x.Creator = v
+}
+
+func (x *DocumentVersion) SetCreatorJob(v string) {
+	x.CreatorJob = v
+}
+
+func (x *DocumentVersion) SetCreatorJobLabel(v string) {
+	x.CreatorJobLabel = &v
+}
+
+func (x *DocumentVersion) SetTitle(v string) {
+	x.Title = v
+}
+
+func (x *DocumentVersion) SetContent(v *content.Content) {
+	x.Content = v
+}
+
+func (x *DocumentVersion) SetData(v *data.DocumentData) {
+	x.Data = v
+}
+
+func (x *DocumentVersion) SetContributors(v *DocumentVersionContributors) {
+	x.Contributors = v
+}
+
+func (x *DocumentVersion) SetRestoredFromId(v int64) {
+	x.RestoredFromId = &v
+}
+
+func (x *DocumentVersion) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *DocumentVersion) HasCreatorId() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatorId != nil
+}
+
+func (x *DocumentVersion) HasCreator() bool {
+	if x == nil {
+		return false
+	}
+	return x.Creator != nil
+}
+
+func (x *DocumentVersion) HasCreatorJobLabel() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatorJobLabel != nil
+}
+
+func (x *DocumentVersion) HasContent() bool {
+	if x == nil {
+		return false
+	}
+	return x.Content != nil
+}
+
+func (x *DocumentVersion) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.Data != nil
+}
+
+func (x *DocumentVersion) HasContributors() bool {
+	if x == nil {
+		return false
+	}
+	return x.Contributors != nil
+}
+
+func (x *DocumentVersion) HasRestoredFromId() bool {
+	if x == nil {
+		return false
+	}
+	return x.RestoredFromId != nil
+}
+
+func (x *DocumentVersion) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *DocumentVersion) ClearCreatorId() {
+	x.CreatorId = nil
+}
+
+func (x *DocumentVersion) ClearCreator() {
+	x.Creator = nil
+}
+
+func (x *DocumentVersion) ClearCreatorJobLabel() {
+	x.CreatorJobLabel = nil
+}
+
+func (x *DocumentVersion) ClearContent() {
+	x.Content = nil
+}
+
+func (x *DocumentVersion) ClearData() {
+	x.Data = nil
+}
+
+func (x *DocumentVersion) ClearContributors() {
+	x.Contributors = nil
+}
+
+func (x *DocumentVersion) ClearRestoredFromId() {
+	x.RestoredFromId = nil
+}
+
+type DocumentVersion_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id              int64
+	CreatedAt       *timestamp.Timestamp
+	DocumentId      int64
+	CreatorId       *int32
+	Creator         *short.UserShort
+	CreatorJob      string
+	CreatorJobLabel *string
+	Title           string
+	// Only set when a single version is requested
+	Content      *content.Content
+	Data         *data.DocumentData
+	Contributors *DocumentVersionContributors
+	// Set if the version has been created by restoring another version
+	RestoredFromId *int64
+}
+
+func (b0 DocumentVersion_builder) Build() *DocumentVersion {
+	m0 := &DocumentVersion{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.CreatedAt = b.CreatedAt
+	x.DocumentId = b.DocumentId
+	x.CreatorId = b.CreatorId
+	x.Creator = b.Creator
+	x.CreatorJob = b.CreatorJob
+	x.CreatorJobLabel = b.CreatorJobLabel
+	x.Title = b.Title
+	x.Content = b.Content
+	x.Data = b.Data
+	x.Contributors = b.Contributors
+	x.RestoredFromId = b.RestoredFromId
+	return m0
+}
+
+type DocumentVersionContributors struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Users that were co-editing the document (collaborative editing session) when it was saved
+	UserIds       []int32 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentVersionContributors) Reset() {
+	*x = DocumentVersionContributors{}
+	mi := &file_resources_documents_versions_versions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentVersionContributors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentVersionContributors) ProtoMessage() {}
+
+func (x *DocumentVersionContributors) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_versions_versions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DocumentVersionContributors) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *DocumentVersionContributors) SetUserIds(v []int32) {
+	x.UserIds = v
+}
+
+type DocumentVersionContributors_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Users that were co-editing the document (collaborative editing session) when it was saved
+	UserIds []int32
+}
+
+func (b0 DocumentVersionContributors_builder) Build() *DocumentVersionContributors {
+	m0 := &DocumentVersionContributors{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UserIds = b.UserIds
+	return m0
+}
+
+type DocumentVersionDiff struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Version the diff has been computed against, unset when compared to an empty document
+	BaseVersionId *int64               `protobuf:"varint,1,opt,name=base_version_id,json=baseVersionId,proto3,oneof" json:"base_version_id,omitempty"`
+	TitleCdiff    *content.ContentDiff `protobuf:"bytes,2,opt,name=title_cdiff,json=titleCdiff,proto3,oneof" json:"title_cdiff,omitempty"`
+	ContentCdiff  *content.ContentDiff `protobuf:"bytes,3,opt,name=content_cdiff,json=contentCdiff,proto3,oneof" json:"content_cdiff,omitempty"`
+	DataCdiff     *content.ContentDiff `protobuf:"bytes,4,opt,name=data_cdiff,json=dataCdiff,proto3,oneof" json:"data_cdiff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentVersionDiff) Reset() {
+	*x = DocumentVersionDiff{}
+	mi := &file_resources_documents_versions_versions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentVersionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentVersionDiff) ProtoMessage() {}
+
+func (x *DocumentVersionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_documents_versions_versions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DocumentVersionDiff) GetBaseVersionId() int64 {
+	if x != nil && x.BaseVersionId != nil {
+		return *x.BaseVersionId
+	}
+	return 0
+}
+
+func (x *DocumentVersionDiff) GetTitleCdiff() *content.ContentDiff {
+	if x != nil {
+		return x.TitleCdiff
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) GetContentCdiff() *content.ContentDiff {
+	if x != nil {
+		return x.ContentCdiff
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) GetDataCdiff() *content.ContentDiff {
+	if x != nil {
+		return x.DataCdiff
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) SetBaseVersionId(v int64) {
+	x.BaseVersionId = &v
+}
+
+func (x *DocumentVersionDiff) SetTitleCdiff(v *content.ContentDiff) {
+	x.TitleCdiff = v
+}
+
+func (x *DocumentVersionDiff) SetContentCdiff(v *content.ContentDiff) {
+	x.ContentCdiff = v
+}
+
+func (x *DocumentVersionDiff) SetDataCdiff(v *content.ContentDiff) {
+	x.DataCdiff = v
+}
+
+func (x *DocumentVersionDiff) HasBaseVersionId() bool {
+	if x == nil {
+		return false
+	}
+	return x.BaseVersionId != nil
+}
+
+func (x *DocumentVersionDiff) HasTitleCdiff() bool {
+	if x == nil {
+		return false
+	}
+	return x.TitleCdiff != nil
+}
+
+func (x *DocumentVersionDiff) HasContentCdiff() bool {
+	if x == nil {
+		return false
+	}
+	return x.ContentCdiff != nil
+}
+
+func (x *DocumentVersionDiff) HasDataCdiff() bool {
+	if x == nil {
+		return false
+	}
+	return x.DataCdiff != nil
+}
+
+func (x *DocumentVersionDiff) ClearBaseVersionId() {
+	x.BaseVersionId = nil
+}
+
+func (x *DocumentVersionDiff) ClearTitleCdiff() {
+	x.TitleCdiff = nil
+}
+
+func (x *DocumentVersionDiff) ClearContentCdiff() {
+	x.ContentCdiff = nil
+}
+
+func (x *DocumentVersionDiff) ClearDataCdiff() {
+	x.DataCdiff = nil
+}
+
+type DocumentVersionDiff_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Version the diff has been computed against, unset when compared to an empty document
+	BaseVersionId *int64
+	TitleCdiff    *content.ContentDiff
+	ContentCdiff  *content.ContentDiff
+	DataCdiff     *content.ContentDiff
+}
+
+func (b0 DocumentVersionDiff_builder) Build() *DocumentVersionDiff {
+	m0 := &DocumentVersionDiff{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.BaseVersionId = b.BaseVersionId
+	x.TitleCdiff = b.TitleCdiff
+	x.ContentCdiff = b.ContentCdiff
+	x.DataCdiff = b.DataCdiff
+	return m0
+}
+
+var File_resources_documents_versions_versions_proto protoreflect.FileDescriptor
+
+const file_resources_documents_versions_versions_proto_rawDesc = "" +
+	"\n" +
+	"+resources/documents/versions/versions.proto\x12\x1cresources.documents.versions\x1a!codegen/dbscanner/dbscanner.proto\x1a&resources/common/content/content.proto\x1a,resources/common/content/diff_activity.proto\x1a#resources/documents/data/data.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\x94\x06\n" +
+	"\x0fDocumentVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12=\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\x03R\n" +
+	"documentId\x12\"\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\x05H\x00R\tcreatorId\x88\x01\x01\x12U\n" +
+	"\acreator\x18\x05 \x01(\v2 .resources.users.short.UserShortB\x14\x9a\x84\x9e\x03\x0falias:\"creator\"H\x01R\acreator\x88\x01\x01\x12\x1f\n" +
+	"\vcreator_job\x18\x06 \x01(\tR\n" +
+	"creatorJob\x12/\n" +
+	"\x11creator_job_label\x18\a \x01(\tH\x02R\x0fcreatorJobLabel\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\b \x01(\tR\x05title\x12[\n" +
+	"\acontent\x18\t \x01(\v2!.resources.common.content.ContentB\x19\x9a\x84\x9e\x03\x14alias:\"content_json\"H\x03R\acontent\x88\x01\x01\x12R\n" +
+	"\x04data\x18\n" +
+	" \x01(\v2&.resources.documents.data.DocumentDataB\x11\x9a\x84\x9e\x03\falias:\"data\"H\x04R\x04data\x88\x01\x01\x12b\n" +
+	"\fcontributors\x18\v \x01(\v29.resources.documents.versions.DocumentVersionContributorsH\x05R\fcontributors\x88\x01\x01\x12-\n" +
+	"\x10restored_from_id\x18\f \x01(\x03H\x06R\x0erestoredFromId\x88\x01\x01B\r\n" +
+	"\v_creator_idB\n" +
+	"\n" +
+	"\b_creatorB\x14\n" +
+	"\x12_creator_job_labelB\n" +
+	"\n" +
+	"\b_contentB\a\n" +
+	"\x05_dataB\x0f\n" +
+	"\r_contributorsB\x13\n" +
+	"\x11_restored_from_id\"@\n" +
+	"\x1bDocumentVersionContributors\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x05R\auserIds:\x06\xe2\xf3\x18\x02\b\x01\"\xf0\x02\n" +
+	"\x13DocumentVersionDiff\x12+\n" +
+	"\x0fbase_version_id\x18\x01 \x01(\x03H\x00R\rbaseVersionId\x88\x01\x01\x12K\n" +
+	"\vtitle_cdiff\x18\x02 \x01(\v2%.resources.common.content.ContentDiffH\x01R\n" +
+	"titleCdiff\x88\x01\x01\x12O\n" +
+	"\rcontent_cdiff\x18\x03 \x01(\v2%.resources.common.content.ContentDiffH\x02R\fcontentCdiff\x88\x01\x01\x12I\n" +
+	"\n" +
+	"data_cdiff\x18\x04 \x01(\v2%.resources.common.content.ContentDiffH\x03R\tdataCdiff\x88\x01\x01B\x12\n" +
+	"\x10_base_version_idB\x0e\n" +
+	"\f_title_cdiffB\x10\n" +
+	"\x0e_content_cdiffB\r\n" +
+	"\v_data_cdiffBbZ`github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/versions;documentsversionsb\x06proto3"
+
+var file_resources_documents_versions_versions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_resources_documents_versions_versions_proto_goTypes = []any{
+	(*DocumentVersion)(nil),             // 0: resources.documents.versions.DocumentVersion
+	(*DocumentVersionContributors)(nil), // 1: resources.documents.versions.DocumentVersionContributors
+	(*DocumentVersionDiff)(nil),         // 2: resources.documents.versions.DocumentVersionDiff
+	(*timestamp.Timestamp)(nil),         // 3: resources.timestamp.Timestamp
+	(*short.UserShort)(nil),             // 4: resources.users.short.UserShort
+	(*content.Content)(nil),             // 5: resources.common.content.Content
+	(*data.DocumentData)(nil),           // 6: resources.documents.data.DocumentData
+	(*content.ContentDiff)(nil),         // 7: resources.common.content.ContentDiff
+}
+var file_resources_documents_versions_versions_proto_depIdxs = []int32{
+	3, // 0: resources.documents.versions.DocumentVersion.created_at:type_name -> resources.timestamp.Timestamp
+	4, // 1: resources.documents.versions.DocumentVersion.creator:type_name -> resources.users.short.UserShort
+	5, // 2: resources.documents.versions.DocumentVersion.content:type_name -> resources.common.content.Content
+	6, // 3: resources.documents.versions.DocumentVersion.data:type_name -> resources.documents.data.DocumentData
+	1, // 4: resources.documents.versions.DocumentVersion.contributors:type_name -> resources.documents.versions.DocumentVersionContributors
+	7, // 5: resources.documents.versions.DocumentVersionDiff.title_cdiff:type_name -> resources.common.content.ContentDiff
+	7, // 6: resources.documents.versions.DocumentVersionDiff.content_cdiff:type_name -> resources.common.content.ContentDiff
+	7, // 7: resources.documents.versions.DocumentVersionDiff.data_cdiff:type_name -> resources.common.content.ContentDiff
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_resources_documents_versions_versions_proto_init() }
+func file_resources_documents_versions_versions_proto_init() {
+	if File_resources_documents_versions_versions_proto != nil {
+		return
+	}
+	file_resources_documents_versions_versions_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_documents_versions_versions_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_documents_versions_versions_proto_rawDesc), len(file_resources_documents_versions_versions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_documents_versions_versions_proto_goTypes,
+		DependencyIndexes: file_resources_documents_versions_versions_proto_depIdxs,
+		MessageInfos:      file_resources_documents_versions_versions_proto_msgTypes,
+	}.Build()
+	File_resources_documents_versions_versions_proto = out.File
+	file_resources_documents_versions_versions_proto_goTypes = nil
+	file_resources_documents_versions_versions_proto_depIdxs = nil
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/documents/versions/versions.proto
+
+package documentsversions
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DocumentVersion) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Content
+	if m.Content != nil {
+		if v, ok := any(m.GetContent()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Contributors
+	if m.Contributors != nil {
+		if v, ok := any(m.GetContributors()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: CreatedAt
+	if m.CreatedAt != nil {
+		if v, ok := any(m.GetCreatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Creator
+	if m.Creator != nil {
+		if v, ok := any(m.GetCreator()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: CreatorJob
+	m.CreatorJob = htmlsanitizer.SanitizeAndUnescape(m.CreatorJob)
+
+	// Field: CreatorJobLabel
+	if m.CreatorJobLabel != nil {
+		*m.CreatorJobLabel = htmlsanitizer.SanitizeAndUnescape(*m.CreatorJobLabel)
+	}
+
+	// Field: Data
+	if m.Data != nil {
+		if v, ok := any(m.GetData()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Title
+	m.Title = htmlsanitizer.SanitizeAndUnescape(m.Title)
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *DocumentVersionDiff) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: ContentCdiff
+	if m.ContentCdiff != nil {
+		if v, ok := any(m.GetContentCdiff()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: DataCdiff
+	if m.DataCdiff != nil {
+		if v, ok := any(m.GetDataCdiff()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: TitleCdiff
+	if m.TitleCdiff != nil {
+		if v, ok := any(m.GetTitleCdiff()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
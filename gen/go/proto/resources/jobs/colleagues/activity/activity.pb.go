@@ -13,6 +13,7 @@ import (
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
 	colleagues "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues"
 	labels "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/labels"
+	timeclock "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/timeclock"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -31,15 +32,16 @@ const (
 type ColleagueActivityType int32
 
 const (
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_UNSPECIFIED  ColleagueActivityType = 0
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_HIRED        ColleagueActivityType = 1
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_FIRED        ColleagueActivityType = 2
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_PROMOTED     ColleagueActivityType = 3
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_DEMOTED      ColleagueActivityType = 4
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_ABSENCE_DATE ColleagueActivityType = 5
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_NOTE         ColleagueActivityType = 6
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_LABELS       ColleagueActivityType = 7
-	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_NAME         ColleagueActivityType = 8
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_UNSPECIFIED          ColleagueActivityType = 0
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_HIRED                ColleagueActivityType = 1
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_FIRED                ColleagueActivityType = 2
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_PROMOTED             ColleagueActivityType = 3
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_DEMOTED              ColleagueActivityType = 4
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_ABSENCE_DATE         ColleagueActivityType = 5
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_NOTE                 ColleagueActivityType = 6
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_LABELS               ColleagueActivityType = 7
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_NAME                 ColleagueActivityType = 8
+	ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_TIMECLOCK_CORRECTION ColleagueActivityType = 9
 )
 
 // Enum value maps for ColleagueActivityType.
@@ -54,17 +56,19 @@ var (
 		6: "COLLEAGUE_ACTIVITY_TYPE_NOTE",
 		7: "COLLEAGUE_ACTIVITY_TYPE_LABELS",
 		8: "COLLEAGUE_ACTIVITY_TYPE_NAME",
+		9: "COLLEAGUE_ACTIVITY_TYPE_TIMECLOCK_CORRECTION",
 	}
 	ColleagueActivityType_value = map[string]int32{
-		"COLLEAGUE_ACTIVITY_TYPE_UNSPECIFIED":  0,
-		"COLLEAGUE_ACTIVITY_TYPE_HIRED":        1,
-		"COLLEAGUE_ACTIVITY_TYPE_FIRED":        2,
-		"COLLEAGUE_ACTIVITY_TYPE_PROMOTED":     3,
-		"COLLEAGUE_ACTIVITY_TYPE_DEMOTED":      4,
-		"COLLEAGUE_ACTIVITY_TYPE_ABSENCE_DATE": 5,
-		"COLLEAGUE_ACTIVITY_TYPE_NOTE":         6,
-		"COLLEAGUE_ACTIVITY_TYPE_LABELS":       7,
-		"COLLEAGUE_ACTIVITY_TYPE_NAME":         8,
+		"COLLEAGUE_ACTIVITY_TYPE_UNSPECIFIED":          0,
+		"COLLEAGUE_ACTIVITY_TYPE_HIRED":                1,
+		"COLLEAGUE_ACTIVITY_TYPE_FIRED":                2,
+		"COLLEAGUE_ACTIVITY_TYPE_PROMOTED":             3,
+		"COLLEAGUE_ACTIVITY_TYPE_DEMOTED":              4,
+		"COLLEAGUE_ACTIVITY_TYPE_ABSENCE_DATE":         5,
+		"COLLEAGUE_ACTIVITY_TYPE_NOTE":                 6,
+		"COLLEAGUE_ACTIVITY_TYPE_LABELS":               7,
+		"COLLEAGUE_ACTIVITY_TYPE_NAME":                 8,
+		"COLLEAGUE_ACTIVITY_TYPE_TIMECLOCK_CORRECTION": 9,
 	}
 )
 
@@ -336,6 +340,7 @@ type ColleagueActivityData struct {
 	//	*ColleagueActivityData_GradeChange
 	//	*ColleagueActivityData_LabelsChange
 	//	*ColleagueActivityData_NameChange
+	//	*ColleagueActivityData_TimeclockCorrection
 	Data          isColleagueActivityData_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -409,6 +414,15 @@ func (x *ColleagueActivityData) GetNameChange() *NameChange {
 	return nil
 }
 
+func (x *ColleagueActivityData) GetTimeclockCorrection() *TimeclockCorrectionChange {
+	if x != nil {
+		if x, ok := x.Data.(*ColleagueActivityData_TimeclockCorrection); ok {
+			return x.TimeclockCorrection
+		}
+	}
+	return nil
+}
+
 func (x *ColleagueActivityData) SetAbsenceDate(v *AbsenceDateChange) {
 	if v == nil {
 		x.Data = nil
@@ -441,6 +455,14 @@ func (x *ColleagueActivityData) SetNameChange(v *NameChange) {
 	x.Data = &ColleagueActivityData_NameChange{v}
 }
 
+func (x *ColleagueActivityData) SetTimeclockCorrection(v *TimeclockCorrectionChange) {
+	if v == nil {
+		x.Data = nil
+		return
+	}
+	x.Data = &ColleagueActivityData_TimeclockCorrection{v}
+}
+
 func (x *ColleagueActivityData) HasData() bool {
 	if x == nil {
 		return false
@@ -480,6 +502,14 @@ func (x *ColleagueActivityData) HasNameChange() bool {
 	return ok
 }
 
+func (x *ColleagueActivityData) HasTimeclockCorrection() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Data.(*ColleagueActivityData_TimeclockCorrection)
+	return ok
+}
+
 func (x *ColleagueActivityData) ClearData() {
 	x.Data = nil
 }
@@ -508,11 +538,18 @@ func (x *ColleagueActivityData) ClearNameChange() {
 	}
 }
 
+func (x *ColleagueActivityData) ClearTimeclockCorrection() {
+	if _, ok := x.Data.(*ColleagueActivityData_TimeclockCorrection); ok {
+		x.Data = nil
+	}
+}
+
 const ColleagueActivityData_Data_not_set_case case_ColleagueActivityData_Data = 0
 const ColleagueActivityData_AbsenceDate_case case_ColleagueActivityData_Data = 1
 const ColleagueActivityData_GradeChange_case case_ColleagueActivityData_Data = 2
 const ColleagueActivityData_LabelsChange_case case_ColleagueActivityData_Data = 3
 const ColleagueActivityData_NameChange_case case_ColleagueActivityData_Data = 4
+const ColleagueActivityData_TimeclockCorrection_case case_ColleagueActivityData_Data = 5
 
 func (x *ColleagueActivityData) WhichData() case_ColleagueActivityData_Data {
 	if x == nil {
@@ -527,6 +564,8 @@ func (x *ColleagueActivityData) WhichData() case_ColleagueActivityData_Data {
 		return ColleagueActivityData_LabelsChange_case
 	case *ColleagueActivityData_NameChange:
 		return ColleagueActivityData_NameChange_case
+	case *ColleagueActivityData_TimeclockCorrection:
+		return ColleagueActivityData_TimeclockCorrection_case
 	default:
 		return ColleagueActivityData_Data_not_set_case
 	}
@@ -536,10 +575,11 @@ type ColleagueActivityData_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	// Fields of oneof Data:
-	AbsenceDate  *AbsenceDateChange
-	GradeChange  *GradeChange
-	LabelsChange *LabelsChange
-	NameChange   *NameChange
+	AbsenceDate         *AbsenceDateChange
+	GradeChange         *GradeChange
+	LabelsChange        *LabelsChange
+	NameChange          *NameChange
+	TimeclockCorrection *TimeclockCorrectionChange
 	// -- end of Data
 }
 
@@ -559,6 +599,9 @@ func (b0 ColleagueActivityData_builder) Build() *ColleagueActivityData {
 	if b.NameChange != nil {
 		x.Data = &ColleagueActivityData_NameChange{b.NameChange}
 	}
+	if b.TimeclockCorrection != nil {
+		x.Data = &ColleagueActivityData_TimeclockCorrection{b.TimeclockCorrection}
+	}
 	return m0
 }
 
@@ -592,6 +635,10 @@ type ColleagueActivityData_NameChange struct {
 	NameChange *NameChange `protobuf:"bytes,4,opt,name=name_change,json=nameChange,proto3,oneof"`
 }
 
+type ColleagueActivityData_TimeclockCorrection struct {
+	TimeclockCorrection *TimeclockCorrectionChange `protobuf:"bytes,5,opt,name=timeclock_correction,json=timeclockCorrection,proto3,oneof"`
+}
+
 func (*ColleagueActivityData_AbsenceDate) isColleagueActivityData_Data() {}
 
 func (*ColleagueActivityData_GradeChange) isColleagueActivityData_Data() {}
@@ -600,12 +647,15 @@ func (*ColleagueActivityData_LabelsChange) isColleagueActivityData_Data() {}
 
 func (*ColleagueActivityData_NameChange) isColleagueActivityData_Data() {}
 
+func (*ColleagueActivityData_TimeclockCorrection) isColleagueActivityData_Data() {}
+
 type AbsenceDateChange struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	AbsenceBegin  *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=absence_begin,json=absenceBegin,proto3" json:"absence_begin,omitempty"`
-	AbsenceEnd    *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=absence_end,json=absenceEnd,proto3" json:"absence_end,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"hybrid.v1"`
+	AbsenceBegin      *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=absence_begin,json=absenceBegin,proto3" json:"absence_begin,omitempty"`
+	AbsenceEnd        *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=absence_end,json=absenceEnd,proto3" json:"absence_end,omitempty"`
+	AbsenceDelegateId *int32                 `protobuf:"varint,3,opt,name=absence_delegate_id,json=absenceDelegateId,proto3,oneof" json:"absence_delegate_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AbsenceDateChange) Reset() {
@@ -647,6 +697,13 @@ func (x *AbsenceDateChange) GetAbsenceEnd() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *AbsenceDateChange) GetAbsenceDelegateId() int32 {
+	if x != nil && x.AbsenceDelegateId != nil {
+		return *x.AbsenceDelegateId
+	}
+	return 0
+}
+
 func (x *AbsenceDateChange) SetAbsenceBegin(v *timestamp.Timestamp) {
 	x.AbsenceBegin = v
 }
@@ -655,6 +712,10 @@ func (x *AbsenceDateChange) SetAbsenceEnd(v *timestamp.Timestamp) {
 	x.AbsenceEnd = v
 }
 
+func (x *AbsenceDateChange) SetAbsenceDelegateId(v int32) {
+	x.AbsenceDelegateId = &v
+}
+
 func (x *AbsenceDateChange) HasAbsenceBegin() bool {
 	if x == nil {
 		return false
@@ -669,6 +730,13 @@ func (x *AbsenceDateChange) HasAbsenceEnd() bool {
 	return x.AbsenceEnd != nil
 }
 
+func (x *AbsenceDateChange) HasAbsenceDelegateId() bool {
+	if x == nil {
+		return false
+	}
+	return x.AbsenceDelegateId != nil
+}
+
 func (x *AbsenceDateChange) ClearAbsenceBegin() {
 	x.AbsenceBegin = nil
 }
@@ -677,11 +745,16 @@ func (x *AbsenceDateChange) ClearAbsenceEnd() {
 	x.AbsenceEnd = nil
 }
 
+func (x *AbsenceDateChange) ClearAbsenceDelegateId() {
+	x.AbsenceDelegateId = nil
+}
+
 type AbsenceDateChange_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	AbsenceBegin *timestamp.Timestamp
-	AbsenceEnd   *timestamp.Timestamp
+	AbsenceBegin      *timestamp.Timestamp
+	AbsenceEnd        *timestamp.Timestamp
+	AbsenceDelegateId *int32
 }
 
 func (b0 AbsenceDateChange_builder) Build() *AbsenceDateChange {
@@ -690,6 +763,7 @@ func (b0 AbsenceDateChange_builder) Build() *AbsenceDateChange {
 	_, _ = b, x
 	x.AbsenceBegin = b.AbsenceBegin
 	x.AbsenceEnd = b.AbsenceEnd
+	x.AbsenceDelegateId = b.AbsenceDelegateId
 	return m0
 }
 
@@ -928,11 +1002,246 @@ func (b0 NameChange_builder) Build() *NameChange {
 	return m0
 }
 
+type TimeclockCorrectionChange struct {
+	state             protoimpl.MessageState            `protogen:"hybrid.v1"`
+	CorrectionId      int64                             `protobuf:"varint,1,opt,name=correction_id,json=correctionId,proto3" json:"correction_id,omitempty"`
+	Type              timeclock.TimeclockCorrectionType `protobuf:"varint,2,opt,name=type,proto3,enum=resources.jobs.timeclock.TimeclockCorrectionType" json:"type,omitempty"`
+	Date              *timestamp.Timestamp              `protobuf:"bytes,3,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	OriginalStartTime *timestamp.Timestamp              `protobuf:"bytes,4,opt,name=original_start_time,json=originalStartTime,proto3,oneof" json:"original_start_time,omitempty"`
+	OriginalEndTime   *timestamp.Timestamp              `protobuf:"bytes,5,opt,name=original_end_time,json=originalEndTime,proto3,oneof" json:"original_end_time,omitempty"`
+	OriginalSpentTime *float32                          `protobuf:"fixed32,6,opt,name=original_spent_time,json=originalSpentTime,proto3,oneof" json:"original_spent_time,omitempty"`
+	StartTime         *timestamp.Timestamp              `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime           *timestamp.Timestamp              `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	SpentTime         float32                           `protobuf:"fixed32,9,opt,name=spent_time,json=spentTime,proto3" json:"spent_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TimeclockCorrectionChange) Reset() {
+	*x = TimeclockCorrectionChange{}
+	mi := &file_resources_jobs_colleagues_activity_activity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeclockCorrectionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeclockCorrectionChange) ProtoMessage() {}
+
+func (x *TimeclockCorrectionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_jobs_colleagues_activity_activity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TimeclockCorrectionChange) GetCorrectionId() int64 {
+	if x != nil {
+		return x.CorrectionId
+	}
+	return 0
+}
+
+func (x *TimeclockCorrectionChange) GetType() timeclock.TimeclockCorrectionType {
+	if x != nil {
+		return x.Type
+	}
+	return timeclock.TimeclockCorrectionType(0)
+}
+
+func (x *TimeclockCorrectionChange) GetDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *TimeclockCorrectionChange) GetOriginalStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.OriginalStartTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrectionChange) GetOriginalEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.OriginalEndTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrectionChange) GetOriginalSpentTime() float32 {
+	if x != nil && x.OriginalSpentTime != nil {
+		return *x.OriginalSpentTime
+	}
+	return 0
+}
+
+func (x *TimeclockCorrectionChange) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrectionChange) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrectionChange) GetSpentTime() float32 {
+	if x != nil {
+		return x.SpentTime
+	}
+	return 0
+}
+
+func (x *TimeclockCorrectionChange) SetCorrectionId(v int64) {
+	x.CorrectionId = v
+}
+
+func (x *TimeclockCorrectionChange) SetType(v timeclock.TimeclockCorrectionType) {
+	x.Type = v
+}
+
+func (x *TimeclockCorrectionChange) SetDate(v *timestamp.Timestamp) {
+	x.Date = v
+}
+
+func (x *TimeclockCorrectionChange) SetOriginalStartTime(v *timestamp.Timestamp) {
+	x.OriginalStartTime = v
+}
+
+func (x *TimeclockCorrectionChange) SetOriginalEndTime(v *timestamp.Timestamp) {
+	x.OriginalEndTime = v
+}
+
+func (x *TimeclockCorrectionChange) SetOriginalSpentTime(v float32) {
+	x.OriginalSpentTime = &v
+}
+
+func (x *TimeclockCorrectionChange) SetStartTime(v *timestamp.Timestamp) {
+	x.StartTime = v
+}
+
+func (x *TimeclockCorrectionChange) SetEndTime(v *timestamp.Timestamp) {
+	x.EndTime = v
+}
+
+func (x *TimeclockCorrectionChange) SetSpentTime(v float32) {
+	x.SpentTime = v
+}
+
+func (x *TimeclockCorrectionChange) HasDate() bool {
+	if x == nil {
+		return false
+	}
+	return x.Date != nil
+}
+
+func (x *TimeclockCorrectionChange) HasOriginalStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.OriginalStartTime != nil
+}
+
+func (x *TimeclockCorrectionChange) HasOriginalEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.OriginalEndTime != nil
+}
+
+func (x *TimeclockCorrectionChange) HasOriginalSpentTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.OriginalSpentTime != nil
+}
+
+func (x *TimeclockCorrectionChange) HasStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.StartTime != nil
+}
+
+func (x *TimeclockCorrectionChange) HasEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.EndTime != nil
+}
+
+func (x *TimeclockCorrectionChange) ClearDate() {
+	x.Date = nil
+}
+
+func (x *TimeclockCorrectionChange) ClearOriginalStartTime() {
+	x.OriginalStartTime = nil
+}
+
+func (x *TimeclockCorrectionChange) ClearOriginalEndTime() {
+	x.OriginalEndTime = nil
+}
+
+func (x *TimeclockCorrectionChange) ClearOriginalSpentTime() {
+	x.OriginalSpentTime = nil
+}
+
+func (x *TimeclockCorrectionChange) ClearStartTime() {
+	x.StartTime = nil
+}
+
+func (x *TimeclockCorrectionChange) ClearEndTime() {
+	x.EndTime = nil
+}
+
+type TimeclockCorrectionChange_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CorrectionId      int64
+	Type              timeclock.TimeclockCorrectionType
+	Date              *timestamp.Timestamp
+	OriginalStartTime *timestamp.Timestamp
+	OriginalEndTime   *timestamp.Timestamp
+	OriginalSpentTime *float32
+	StartTime         *timestamp.Timestamp
+	EndTime           *timestamp.Timestamp
+	SpentTime         float32
+}
+
+func (b0 TimeclockCorrectionChange_builder) Build() *TimeclockCorrectionChange {
+	m0 := &TimeclockCorrectionChange{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.CorrectionId = b.CorrectionId
+	x.Type = b.Type
+	x.Date = b.Date
+	x.OriginalStartTime = b.OriginalStartTime
+	x.OriginalEndTime = b.OriginalEndTime
+	x.OriginalSpentTime = b.OriginalSpentTime
+	x.StartTime = b.StartTime
+	x.EndTime = b.EndTime
+	x.SpentTime = b.SpentTime
+	return m0
+}
+
 var File_resources_jobs_colleagues_activity_activity_proto protoreflect.FileDescriptor
 
 const file_resources_jobs_colleagues_activity_activity_proto_rawDesc = "" +
 	"\n" +
-	"1resources/jobs/colleagues/activity/activity.proto\x12\"resources.jobs.colleagues.activity\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a*resources/jobs/colleagues/colleagues.proto\x1a\"resources/jobs/labels/labels.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xb5\x05\n" +
+	"1resources/jobs/colleagues/activity/activity.proto\x12\"resources.jobs.colleagues.activity\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a*resources/jobs/colleagues/colleagues.proto\x1a\"resources/jobs/labels/labels.proto\x1a(resources/jobs/timeclock/timeclock.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xb5\x05\n" +
 	"\x11ColleagueActivity\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -950,18 +1259,21 @@ const file_resources_jobs_colleagues_activity_activity_proto_rawDesc = "" +
 	"\x04data\x18\v \x01(\v29.resources.jobs.colleagues.activity.ColleagueActivityDataR\x04dataB\r\n" +
 	"\v_created_atB\x11\n" +
 	"\x0f_source_user_idB\x0e\n" +
-	"\f_source_user\"\x85\x03\n" +
+	"\f_source_user\"\xf9\x03\n" +
 	"\x15ColleagueActivityData\x12Z\n" +
 	"\fabsence_date\x18\x01 \x01(\v25.resources.jobs.colleagues.activity.AbsenceDateChangeH\x00R\vabsenceDate\x12T\n" +
 	"\fgrade_change\x18\x02 \x01(\v2/.resources.jobs.colleagues.activity.GradeChangeH\x00R\vgradeChange\x12W\n" +
 	"\rlabels_change\x18\x03 \x01(\v20.resources.jobs.colleagues.activity.LabelsChangeH\x00R\flabelsChange\x12Q\n" +
 	"\vname_change\x18\x04 \x01(\v2..resources.jobs.colleagues.activity.NameChangeH\x00R\n" +
-	"nameChange:\x06\xe2\xf3\x18\x02\b\x01B\x06\n" +
-	"\x04data\"\x99\x01\n" +
+	"nameChange\x12r\n" +
+	"\x14timeclock_correction\x18\x05 \x01(\v2=.resources.jobs.colleagues.activity.TimeclockCorrectionChangeH\x00R\x13timeclockCorrection:\x06\xe2\xf3\x18\x02\b\x01B\x06\n" +
+	"\x04data\"\xe6\x01\n" +
 	"\x11AbsenceDateChange\x12C\n" +
 	"\rabsence_begin\x18\x01 \x01(\v2\x1e.resources.timestamp.TimestampR\fabsenceBegin\x12?\n" +
 	"\vabsence_end\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampR\n" +
-	"absenceEnd\"D\n" +
+	"absenceEnd\x123\n" +
+	"\x13absence_delegate_id\x18\x03 \x01(\x05H\x00R\x11absenceDelegateId\x88\x01\x01B\x16\n" +
+	"\x14_absence_delegate_id\"D\n" +
 	"\vGradeChange\x12\x14\n" +
 	"\x05grade\x18\x01 \x01(\x05R\x05grade\x12\x1f\n" +
 	"\vgrade_label\x18\x02 \x01(\tR\n" +
@@ -974,7 +1286,25 @@ const file_resources_jobs_colleagues_activity_activity_proto_rawDesc = "" +
 	"\x06prefix\x18\x01 \x01(\tH\x00R\x06prefix\x88\x01\x01\x12\x1b\n" +
 	"\x06suffix\x18\x02 \x01(\tH\x01R\x06suffix\x88\x01\x01B\t\n" +
 	"\a_prefixB\t\n" +
-	"\a_suffix*\xe3\x02\n" +
+	"\a_suffix\"\xa9\x05\n" +
+	"\x19TimeclockCorrectionChange\x12#\n" +
+	"\rcorrection_id\x18\x01 \x01(\x03R\fcorrectionId\x12E\n" +
+	"\x04type\x18\x02 \x01(\x0e21.resources.jobs.timeclock.TimeclockCorrectionTypeR\x04type\x127\n" +
+	"\x04date\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\x04date\x88\x01\x01\x12S\n" +
+	"\x13original_start_time\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\x11originalStartTime\x88\x01\x01\x12O\n" +
+	"\x11original_end_time\x18\x05 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\x0foriginalEndTime\x88\x01\x01\x123\n" +
+	"\x13original_spent_time\x18\x06 \x01(\x02H\x03R\x11originalSpentTime\x88\x01\x01\x12B\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\tstartTime\x88\x01\x01\x12>\n" +
+	"\bend_time\x18\b \x01(\v2\x1e.resources.timestamp.TimestampH\x05R\aendTime\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"spent_time\x18\t \x01(\x02R\tspentTimeB\a\n" +
+	"\x05_dateB\x16\n" +
+	"\x14_original_start_timeB\x14\n" +
+	"\x12_original_end_timeB\x16\n" +
+	"\x14_original_spent_timeB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time*\x95\x03\n" +
 	"\x15ColleagueActivityType\x12'\n" +
 	"#COLLEAGUE_ACTIVITY_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dCOLLEAGUE_ACTIVITY_TYPE_HIRED\x10\x01\x12!\n" +
@@ -984,41 +1314,51 @@ const file_resources_jobs_colleagues_activity_activity_proto_rawDesc = "" +
 	"$COLLEAGUE_ACTIVITY_TYPE_ABSENCE_DATE\x10\x05\x12 \n" +
 	"\x1cCOLLEAGUE_ACTIVITY_TYPE_NOTE\x10\x06\x12\"\n" +
 	"\x1eCOLLEAGUE_ACTIVITY_TYPE_LABELS\x10\a\x12 \n" +
-	"\x1cCOLLEAGUE_ACTIVITY_TYPE_NAME\x10\bBiZggithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues/activity;colleaguesactivityb\x06proto3"
+	"\x1cCOLLEAGUE_ACTIVITY_TYPE_NAME\x10\b\x120\n" +
+	",COLLEAGUE_ACTIVITY_TYPE_TIMECLOCK_CORRECTION\x10\tBiZggithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues/activity;colleaguesactivityb\x06proto3"
 
 var file_resources_jobs_colleagues_activity_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_resources_jobs_colleagues_activity_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_resources_jobs_colleagues_activity_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_resources_jobs_colleagues_activity_activity_proto_goTypes = []any{
-	(ColleagueActivityType)(0),    // 0: resources.jobs.colleagues.activity.ColleagueActivityType
-	(*ColleagueActivity)(nil),     // 1: resources.jobs.colleagues.activity.ColleagueActivity
-	(*ColleagueActivityData)(nil), // 2: resources.jobs.colleagues.activity.ColleagueActivityData
-	(*AbsenceDateChange)(nil),     // 3: resources.jobs.colleagues.activity.AbsenceDateChange
-	(*GradeChange)(nil),           // 4: resources.jobs.colleagues.activity.GradeChange
-	(*LabelsChange)(nil),          // 5: resources.jobs.colleagues.activity.LabelsChange
-	(*NameChange)(nil),            // 6: resources.jobs.colleagues.activity.NameChange
-	(*timestamp.Timestamp)(nil),   // 7: resources.timestamp.Timestamp
-	(*colleagues.Colleague)(nil),  // 8: resources.jobs.colleagues.Colleague
-	(*labels.Label)(nil),          // 9: resources.jobs.labels.Label
+	(ColleagueActivityType)(0),             // 0: resources.jobs.colleagues.activity.ColleagueActivityType
+	(*ColleagueActivity)(nil),              // 1: resources.jobs.colleagues.activity.ColleagueActivity
+	(*ColleagueActivityData)(nil),          // 2: resources.jobs.colleagues.activity.ColleagueActivityData
+	(*AbsenceDateChange)(nil),              // 3: resources.jobs.colleagues.activity.AbsenceDateChange
+	(*GradeChange)(nil),                    // 4: resources.jobs.colleagues.activity.GradeChange
+	(*LabelsChange)(nil),                   // 5: resources.jobs.colleagues.activity.LabelsChange
+	(*NameChange)(nil),                     // 6: resources.jobs.colleagues.activity.NameChange
+	(*TimeclockCorrectionChange)(nil),      // 7: resources.jobs.colleagues.activity.TimeclockCorrectionChange
+	(*timestamp.Timestamp)(nil),            // 8: resources.timestamp.Timestamp
+	(*colleagues.Colleague)(nil),           // 9: resources.jobs.colleagues.Colleague
+	(*labels.Label)(nil),                   // 10: resources.jobs.labels.Label
+	(timeclock.TimeclockCorrectionType)(0), // 11: resources.jobs.timeclock.TimeclockCorrectionType
 }
 var file_resources_jobs_colleagues_activity_activity_proto_depIdxs = []int32{
-	7,  // 0: resources.jobs.colleagues.activity.ColleagueActivity.created_at:type_name -> resources.timestamp.Timestamp
-	8,  // 1: resources.jobs.colleagues.activity.ColleagueActivity.source_user:type_name -> resources.jobs.colleagues.Colleague
-	8,  // 2: resources.jobs.colleagues.activity.ColleagueActivity.target_user:type_name -> resources.jobs.colleagues.Colleague
+	8,  // 0: resources.jobs.colleagues.activity.ColleagueActivity.created_at:type_name -> resources.timestamp.Timestamp
+	9,  // 1: resources.jobs.colleagues.activity.ColleagueActivity.source_user:type_name -> resources.jobs.colleagues.Colleague
+	9,  // 2: resources.jobs.colleagues.activity.ColleagueActivity.target_user:type_name -> resources.jobs.colleagues.Colleague
 	0,  // 3: resources.jobs.colleagues.activity.ColleagueActivity.activity_type:type_name -> resources.jobs.colleagues.activity.ColleagueActivityType
 	2,  // 4: resources.jobs.colleagues.activity.ColleagueActivity.data:type_name -> resources.jobs.colleagues.activity.ColleagueActivityData
 	3,  // 5: resources.jobs.colleagues.activity.ColleagueActivityData.absence_date:type_name -> resources.jobs.colleagues.activity.AbsenceDateChange
 	4,  // 6: resources.jobs.colleagues.activity.ColleagueActivityData.grade_change:type_name -> resources.jobs.colleagues.activity.GradeChange
 	5,  // 7: resources.jobs.colleagues.activity.ColleagueActivityData.labels_change:type_name -> resources.jobs.colleagues.activity.LabelsChange
 	6,  // 8: resources.jobs.colleagues.activity.ColleagueActivityData.name_change:type_name -> resources.jobs.colleagues.activity.NameChange
-	7,  // 9: resources.jobs.colleagues.activity.AbsenceDateChange.absence_begin:type_name -> resources.timestamp.Timestamp
-	7,  // 10: resources.jobs.colleagues.activity.AbsenceDateChange.absence_end:type_name -> resources.timestamp.Timestamp
-	9,  // 11: resources.jobs.colleagues.activity.LabelsChange.added:type_name -> resources.jobs.labels.Label
-	9,  // 12: resources.jobs.colleagues.activity.LabelsChange.removed:type_name -> resources.jobs.labels.Label
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	7,  // 9: resources.jobs.colleagues.activity.ColleagueActivityData.timeclock_correction:type_name -> resources.jobs.colleagues.activity.TimeclockCorrectionChange
+	8,  // 10: resources.jobs.colleagues.activity.AbsenceDateChange.absence_begin:type_name -> resources.timestamp.Timestamp
+	8,  // 11: resources.jobs.colleagues.activity.AbsenceDateChange.absence_end:type_name -> resources.timestamp.Timestamp
+	10, // 12: resources.jobs.colleagues.activity.LabelsChange.added:type_name -> resources.jobs.labels.Label
+	10, // 13: resources.jobs.colleagues.activity.LabelsChange.removed:type_name -> resources.jobs.labels.Label
+	11, // 14: resources.jobs.colleagues.activity.TimeclockCorrectionChange.type:type_name -> resources.jobs.timeclock.TimeclockCorrectionType
+	8,  // 15: resources.jobs.colleagues.activity.TimeclockCorrectionChange.date:type_name -> resources.timestamp.Timestamp
+	8,  // 16: resources.jobs.colleagues.activity.TimeclockCorrectionChange.original_start_time:type_name -> resources.timestamp.Timestamp
+	8,  // 17: resources.jobs.colleagues.activity.TimeclockCorrectionChange.original_end_time:type_name -> resources.timestamp.Timestamp
+	8,  // 18: resources.jobs.colleagues.activity.TimeclockCorrectionChange.start_time:type_name -> resources.timestamp.Timestamp
+	8,  // 19: resources.jobs.colleagues.activity.TimeclockCorrectionChange.end_time:type_name -> resources.timestamp.Timestamp
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_resources_jobs_colleagues_activity_activity_proto_init() }
@@ -1032,15 +1372,18 @@ func file_resources_jobs_colleagues_activity_activity_proto_init() {
 		(*ColleagueActivityData_GradeChange)(nil),
 		(*ColleagueActivityData_LabelsChange)(nil),
 		(*ColleagueActivityData_NameChange)(nil),
+		(*ColleagueActivityData_TimeclockCorrection)(nil),
 	}
+	file_resources_jobs_colleagues_activity_activity_proto_msgTypes[2].OneofWrappers = []any{}
 	file_resources_jobs_colleagues_activity_activity_proto_msgTypes[5].OneofWrappers = []any{}
+	file_resources_jobs_colleagues_activity_activity_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_jobs_colleagues_activity_activity_proto_rawDesc), len(file_resources_jobs_colleagues_activity_activity_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -140,6 +140,17 @@ func (m *ColleagueActivityData) Sanitize() error {
 			}
 		}
 
+		// Field: TimeclockCorrection
+	case *ColleagueActivityData_TimeclockCorrection:
+
+		if v.TimeclockCorrection != nil {
+			if s, ok := any(v.TimeclockCorrection).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
 	}
 
 	return nil
@@ -211,3 +222,58 @@ func (m *NameChange) Sanitize() error {
 
 	return nil
 }
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *TimeclockCorrectionChange) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Date
+	if m.Date != nil {
+		if v, ok := any(m.GetDate()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: EndTime
+	if m.EndTime != nil {
+		if v, ok := any(m.GetEndTime()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: OriginalEndTime
+	if m.OriginalEndTime != nil {
+		if v, ok := any(m.GetOriginalEndTime()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: OriginalStartTime
+	if m.OriginalStartTime != nil {
+		if v, ok := any(m.GetOriginalStartTime()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: StartTime
+	if m.StartTime != nil {
+		if v, ok := any(m.GetStartTime()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
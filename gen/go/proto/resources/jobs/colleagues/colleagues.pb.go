@@ -329,18 +329,20 @@ func (b0 Colleague_builder) Build() *Colleague {
 }
 
 type ColleagueProps struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Job           string                 `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
-	DeletedAt     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
-	AbsenceBegin  *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=absence_begin,json=absenceBegin,proto3,oneof" json:"absence_begin,omitempty"`
-	AbsenceEnd    *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=absence_end,json=absenceEnd,proto3,oneof" json:"absence_end,omitempty"`
-	Note          *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	Labels        *labels.Labels         `protobuf:"bytes,7,opt,name=labels,proto3,oneof" json:"labels,omitempty"`
-	NamePrefix    *string                `protobuf:"bytes,8,opt,name=name_prefix,json=namePrefix,proto3,oneof" json:"name_prefix,omitempty"`
-	NameSuffix    *string                `protobuf:"bytes,9,opt,name=name_suffix,json=nameSuffix,proto3,oneof" json:"name_suffix,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"hybrid.v1"`
+	UserId       int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Job          string                 `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	DeletedAt    *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	AbsenceBegin *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=absence_begin,json=absenceBegin,proto3,oneof" json:"absence_begin,omitempty"`
+	AbsenceEnd   *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=absence_end,json=absenceEnd,proto3,oneof" json:"absence_end,omitempty"`
+	Note         *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	Labels       *labels.Labels         `protobuf:"bytes,7,opt,name=labels,proto3,oneof" json:"labels,omitempty"`
+	NamePrefix   *string                `protobuf:"bytes,8,opt,name=name_prefix,json=namePrefix,proto3,oneof" json:"name_prefix,omitempty"`
+	NameSuffix   *string                `protobuf:"bytes,9,opt,name=name_suffix,json=nameSuffix,proto3,oneof" json:"name_suffix,omitempty"`
+	// Colleague that approval tasks are delegated to during the absence
+	AbsenceDelegateId *int32 `protobuf:"varint,10,opt,name=absence_delegate_id,json=absenceDelegateId,proto3,oneof" json:"absence_delegate_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ColleagueProps) Reset() {
@@ -431,6 +433,13 @@ func (x *ColleagueProps) GetNameSuffix() string {
 	return ""
 }
 
+func (x *ColleagueProps) GetAbsenceDelegateId() int32 {
+	if x != nil && x.AbsenceDelegateId != nil {
+		return *x.AbsenceDelegateId
+	}
+	return 0
+}
+
 func (x *ColleagueProps) SetUserId(v int32) {
 	x.UserId = v
 }
@@ -467,6 +476,10 @@ func (x *ColleagueProps) SetNameSuffix(v string) {
 	x.NameSuffix = &v
 }
 
+func (x *ColleagueProps) SetAbsenceDelegateId(v int32) {
+	x.AbsenceDelegateId = &v
+}
+
 func (x *ColleagueProps) HasDeletedAt() bool {
 	if x == nil {
 		return false
@@ -516,6 +529,13 @@ func (x *ColleagueProps) HasNameSuffix() bool {
 	return x.NameSuffix != nil
 }
 
+func (x *ColleagueProps) HasAbsenceDelegateId() bool {
+	if x == nil {
+		return false
+	}
+	return x.AbsenceDelegateId != nil
+}
+
 func (x *ColleagueProps) ClearDeletedAt() {
 	x.DeletedAt = nil
 }
@@ -544,6 +564,10 @@ func (x *ColleagueProps) ClearNameSuffix() {
 	x.NameSuffix = nil
 }
 
+func (x *ColleagueProps) ClearAbsenceDelegateId() {
+	x.AbsenceDelegateId = nil
+}
+
 type ColleagueProps_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -556,6 +580,8 @@ type ColleagueProps_builder struct {
 	Labels       *labels.Labels
 	NamePrefix   *string
 	NameSuffix   *string
+	// Colleague that approval tasks are delegated to during the absence
+	AbsenceDelegateId *int32
 }
 
 func (b0 ColleagueProps_builder) Build() *ColleagueProps {
@@ -571,6 +597,7 @@ func (b0 ColleagueProps_builder) Build() *ColleagueProps {
 	x.Labels = b.Labels
 	x.NamePrefix = b.NamePrefix
 	x.NameSuffix = b.NameSuffix
+	x.AbsenceDelegateId = b.AbsenceDelegateId
 	return m0
 }
 
@@ -600,7 +627,7 @@ const file_resources_jobs_colleagues_colleagues_proto_rawDesc = "" +
 	"\r_phone_numberB\x1a\n" +
 	"\x18_profile_picture_file_idB\x12\n" +
 	"\x10_profile_pictureB\b\n" +
-	"\x06_emailJ\x04\b\x02\x10\x03\"\x9f\x04\n" +
+	"\x06_emailJ\x04\b\x02\x10\x03\"\xec\x04\n" +
 	"\x0eColleagueProps\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x10\n" +
 	"\x03job\x18\x02 \x01(\tR\x03job\x12B\n" +
@@ -614,14 +641,17 @@ const file_resources_jobs_colleagues_colleagues_proto_rawDesc = "" +
 	"\vname_prefix\x18\b \x01(\tH\x05R\n" +
 	"namePrefix\x88\x01\x01\x12$\n" +
 	"\vname_suffix\x18\t \x01(\tH\x06R\n" +
-	"nameSuffix\x88\x01\x01B\r\n" +
+	"nameSuffix\x88\x01\x01\x123\n" +
+	"\x13absence_delegate_id\x18\n" +
+	" \x01(\x05H\aR\x11absenceDelegateId\x88\x01\x01B\r\n" +
 	"\v_deleted_atB\x10\n" +
 	"\x0e_absence_beginB\x0e\n" +
 	"\f_absence_endB\a\n" +
 	"\x05_noteB\t\n" +
 	"\a_labelsB\x0e\n" +
 	"\f_name_prefixB\x0e\n" +
-	"\f_name_suffixB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues;jobscolleaguesb\x06proto3"
+	"\f_name_suffixB\x16\n" +
+	"\x14_absence_delegate_idB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues;jobscolleaguesb\x06proto3"
 
 var file_resources_jobs_colleagues_colleagues_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_resources_jobs_colleagues_colleagues_proto_goTypes = []any{
@@ -376,20 +376,21 @@ func (b0 Colleague_builder) Build() *Colleague {
 }
 
 type ColleagueProps struct {
-	state                   protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_UserId       int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
-	xxx_hidden_Job          string                 `protobuf:"bytes,2,opt,name=job,proto3"`
-	xxx_hidden_DeletedAt    *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3,oneof"`
-	xxx_hidden_AbsenceBegin *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=absence_begin,json=absenceBegin,proto3,oneof"`
-	xxx_hidden_AbsenceEnd   *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=absence_end,json=absenceEnd,proto3,oneof"`
-	xxx_hidden_Note         *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof"`
-	xxx_hidden_Labels       *labels.Labels         `protobuf:"bytes,7,opt,name=labels,proto3,oneof"`
-	xxx_hidden_NamePrefix   *string                `protobuf:"bytes,8,opt,name=name_prefix,json=namePrefix,proto3,oneof"`
-	xxx_hidden_NameSuffix   *string                `protobuf:"bytes,9,opt,name=name_suffix,json=nameSuffix,proto3,oneof"`
-	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
-	XXX_presence            [1]uint32
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId            int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_Job               string                 `protobuf:"bytes,2,opt,name=job,proto3"`
+	xxx_hidden_DeletedAt         *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3,oneof"`
+	xxx_hidden_AbsenceBegin      *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=absence_begin,json=absenceBegin,proto3,oneof"`
+	xxx_hidden_AbsenceEnd        *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=absence_end,json=absenceEnd,proto3,oneof"`
+	xxx_hidden_Note              *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof"`
+	xxx_hidden_Labels            *labels.Labels         `protobuf:"bytes,7,opt,name=labels,proto3,oneof"`
+	xxx_hidden_NamePrefix        *string                `protobuf:"bytes,8,opt,name=name_prefix,json=namePrefix,proto3,oneof"`
+	xxx_hidden_NameSuffix        *string                `protobuf:"bytes,9,opt,name=name_suffix,json=nameSuffix,proto3,oneof"`
+	xxx_hidden_AbsenceDelegateId int32                  `protobuf:"varint,10,opt,name=absence_delegate_id,json=absenceDelegateId,proto3,oneof"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *ColleagueProps) Reset() {
@@ -489,6 +490,13 @@ func (x *ColleagueProps) GetNameSuffix() string {
 	return ""
 }
 
+func (x *ColleagueProps) GetAbsenceDelegateId() int32 {
+	if x != nil {
+		return x.xxx_hidden_AbsenceDelegateId
+	}
+	return 0
+}
+
 func (x *ColleagueProps) SetUserId(v int32) {
 	x.xxx_hidden_UserId = v
 }
@@ -511,7 +519,7 @@ func (x *ColleagueProps) SetAbsenceEnd(v *timestamp.Timestamp) {
 
 func (x *ColleagueProps) SetNote(v string) {
 	x.xxx_hidden_Note = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 10)
 }
 
 func (x *ColleagueProps) SetLabels(v *labels.Labels) {
@@ -520,12 +528,17 @@ func (x *ColleagueProps) SetLabels(v *labels.Labels) {
 
 func (x *ColleagueProps) SetNamePrefix(v string) {
 	x.xxx_hidden_NamePrefix = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 10)
 }
 
 func (x *ColleagueProps) SetNameSuffix(v string) {
 	x.xxx_hidden_NameSuffix = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 10)
+}
+
+func (x *ColleagueProps) SetAbsenceDelegateId(v int32) {
+	x.xxx_hidden_AbsenceDelegateId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 10)
 }
 
 func (x *ColleagueProps) HasDeletedAt() bool {
@@ -577,6 +590,13 @@ func (x *ColleagueProps) HasNameSuffix() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
 }
 
+func (x *ColleagueProps) HasAbsenceDelegateId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
 func (x *ColleagueProps) ClearDeletedAt() {
 	x.xxx_hidden_DeletedAt = nil
 }
@@ -608,6 +628,11 @@ func (x *ColleagueProps) ClearNameSuffix() {
 	x.xxx_hidden_NameSuffix = nil
 }
 
+func (x *ColleagueProps) ClearAbsenceDelegateId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_AbsenceDelegateId = 0
+}
+
 type ColleagueProps_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -620,6 +645,8 @@ type ColleagueProps_builder struct {
 	Labels       *labels.Labels
 	NamePrefix   *string
 	NameSuffix   *string
+	// Colleague that approval tasks are delegated to during the absence
+	AbsenceDelegateId *int32
 }
 
 func (b0 ColleagueProps_builder) Build() *ColleagueProps {
@@ -632,18 +659,22 @@ func (b0 ColleagueProps_builder) Build() *ColleagueProps {
 	x.xxx_hidden_AbsenceBegin = b.AbsenceBegin
 	x.xxx_hidden_AbsenceEnd = b.AbsenceEnd
 	if b.Note != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 10)
 		x.xxx_hidden_Note = b.Note
 	}
 	x.xxx_hidden_Labels = b.Labels
 	if b.NamePrefix != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 10)
 		x.xxx_hidden_NamePrefix = b.NamePrefix
 	}
 	if b.NameSuffix != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 10)
 		x.xxx_hidden_NameSuffix = b.NameSuffix
 	}
+	if b.AbsenceDelegateId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 10)
+		x.xxx_hidden_AbsenceDelegateId = *b.AbsenceDelegateId
+	}
 	return m0
 }
 
@@ -673,7 +704,7 @@ const file_resources_jobs_colleagues_colleagues_proto_rawDesc = "" +
 	"\r_phone_numberB\x1a\n" +
 	"\x18_profile_picture_file_idB\x12\n" +
 	"\x10_profile_pictureB\b\n" +
-	"\x06_emailJ\x04\b\x02\x10\x03\"\x9f\x04\n" +
+	"\x06_emailJ\x04\b\x02\x10\x03\"\xec\x04\n" +
 	"\x0eColleagueProps\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x10\n" +
 	"\x03job\x18\x02 \x01(\tR\x03job\x12B\n" +
@@ -687,14 +718,17 @@ const file_resources_jobs_colleagues_colleagues_proto_rawDesc = "" +
 	"\vname_prefix\x18\b \x01(\tH\x05R\n" +
 	"namePrefix\x88\x01\x01\x12$\n" +
 	"\vname_suffix\x18\t \x01(\tH\x06R\n" +
-	"nameSuffix\x88\x01\x01B\r\n" +
+	"nameSuffix\x88\x01\x01\x123\n" +
+	"\x13absence_delegate_id\x18\n" +
+	" \x01(\x05H\aR\x11absenceDelegateId\x88\x01\x01B\r\n" +
 	"\v_deleted_atB\x10\n" +
 	"\x0e_absence_beginB\x0e\n" +
 	"\f_absence_endB\a\n" +
 	"\x05_noteB\t\n" +
 	"\a_labelsB\x0e\n" +
 	"\f_name_prefixB\x0e\n" +
-	"\f_name_suffixB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues;jobscolleaguesb\x06proto3"
+	"\f_name_suffixB\x16\n" +
+	"\x14_absence_delegate_idB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues;jobscolleaguesb\x06proto3"
 
 var file_resources_jobs_colleagues_colleagues_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_resources_jobs_colleagues_colleagues_proto_goTypes = []any{
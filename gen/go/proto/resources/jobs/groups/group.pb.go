@@ -1811,8 +1811,13 @@ type GroupQualificationRule struct {
 	QualificationIds []int64 `protobuf:"varint,2,rep,packed,name=qualification_ids,json=qualificationIds,proto3" json:"qualification_ids,omitempty"`
 	// If true, only completed/successful qualifications count.
 	RequireCompleted bool `protobuf:"varint,3,opt,name=require_completed,json=requireCompleted,proto3" json:"require_completed,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Training path whose steps are checked instead of the listed qualifications.
+	TrainingPathId *int64 `protobuf:"varint,4,opt,name=training_path_id,json=trainingPathId,proto3,oneof" json:"training_path_id,omitempty"`
+	// If true, only users below the training path's grade match (promotion readiness).
+	// Requires a training path, the ALL rule type and completed qualifications.
+	PromotionReady bool `protobuf:"varint,5,opt,name=promotion_ready,json=promotionReady,proto3" json:"promotion_ready,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GroupQualificationRule) Reset() {
@@ -1861,6 +1866,20 @@ func (x *GroupQualificationRule) GetRequireCompleted() bool {
 	return false
 }
 
+func (x *GroupQualificationRule) GetTrainingPathId() int64 {
+	if x != nil && x.TrainingPathId != nil {
+		return *x.TrainingPathId
+	}
+	return 0
+}
+
+func (x *GroupQualificationRule) GetPromotionReady() bool {
+	if x != nil {
+		return x.PromotionReady
+	}
+	return false
+}
+
 func (x *GroupQualificationRule) SetType(v GroupQualificationRuleType) {
 	x.Type = v
 }
@@ -1873,6 +1892,25 @@ func (x *GroupQualificationRule) SetRequireCompleted(v bool) {
 	x.RequireCompleted = v
 }
 
+func (x *GroupQualificationRule) SetTrainingPathId(v int64) {
+	x.TrainingPathId = &v
+}
+
+func (x *GroupQualificationRule) SetPromotionReady(v bool) {
+	x.PromotionReady = v
+}
+
+func (x *GroupQualificationRule) HasTrainingPathId() bool {
+	if x == nil {
+		return false
+	}
+	return x.TrainingPathId != nil
+}
+
+func (x *GroupQualificationRule) ClearTrainingPathId() {
+	x.TrainingPathId = nil
+}
+
 type GroupQualificationRule_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1882,6 +1920,11 @@ type GroupQualificationRule_builder struct {
 	QualificationIds []int64
 	// If true, only completed/successful qualifications count.
 	RequireCompleted bool
+	// Training path whose steps are checked instead of the listed qualifications.
+	TrainingPathId *int64
+	// If true, only users below the training path's grade match (promotion readiness).
+	// Requires a training path, the ALL rule type and completed qualifications.
+	PromotionReady bool
 }
 
 func (b0 GroupQualificationRule_builder) Build() *GroupQualificationRule {
@@ -1891,6 +1934,8 @@ func (b0 GroupQualificationRule_builder) Build() *GroupQualificationRule {
 	x.Type = b.Type
 	x.QualificationIds = b.QualificationIds
 	x.RequireCompleted = b.RequireCompleted
+	x.TrainingPathId = b.TrainingPathId
+	x.PromotionReady = b.PromotionReady
 	return m0
 }
 
@@ -2661,11 +2706,14 @@ const file_resources_jobs_groups_group_proto_rawDesc = "" +
 	"_max_gradeB\x0e\n" +
 	"\f_grade_labelB\x12\n" +
 	"\x10_min_grade_labelB\x12\n" +
-	"\x10_max_grade_label\"\xb9\x01\n" +
+	"\x10_max_grade_label\"\xa6\x02\n" +
 	"\x16GroupQualificationRule\x12E\n" +
 	"\x04type\x18\x01 \x01(\x0e21.resources.jobs.groups.GroupQualificationRuleTypeR\x04type\x12+\n" +
 	"\x11qualification_ids\x18\x02 \x03(\x03R\x10qualificationIds\x12+\n" +
-	"\x11require_completed\x18\x03 \x01(\bR\x10requireCompleted\"\xdc\x04\n" +
+	"\x11require_completed\x18\x03 \x01(\bR\x10requireCompleted\x12-\n" +
+	"\x10training_path_id\x18\x04 \x01(\x03H\x00R\x0etrainingPathId\x88\x01\x01\x12'\n" +
+	"\x0fpromotion_ready\x18\x05 \x01(\bR\x0epromotionReadyB\x13\n" +
+	"\x11_training_path_id\"\xdc\x04\n" +
 	"\tGroupRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\x12\x18\n" +
@@ -2825,6 +2873,7 @@ func file_resources_jobs_groups_group_proto_init() {
 	file_resources_jobs_groups_group_proto_msgTypes[2].OneofWrappers = []any{}
 	file_resources_jobs_groups_group_proto_msgTypes[3].OneofWrappers = []any{}
 	file_resources_jobs_groups_group_proto_msgTypes[4].OneofWrappers = []any{}
+	file_resources_jobs_groups_group_proto_msgTypes[5].OneofWrappers = []any{}
 	file_resources_jobs_groups_group_proto_msgTypes[6].OneofWrappers = []any{
 		(*GroupRule_Grade)(nil),
 		(*GroupRule_Qualification)(nil),
@@ -1888,6 +1888,10 @@ type GroupQualificationRule struct {
 	xxx_hidden_Type             GroupQualificationRuleType `protobuf:"varint,1,opt,name=type,proto3,enum=resources.jobs.groups.GroupQualificationRuleType"`
 	xxx_hidden_QualificationIds []int64                    `protobuf:"varint,2,rep,packed,name=qualification_ids,json=qualificationIds,proto3"`
 	xxx_hidden_RequireCompleted bool                       `protobuf:"varint,3,opt,name=require_completed,json=requireCompleted,proto3"`
+	xxx_hidden_TrainingPathId   int64                      `protobuf:"varint,4,opt,name=training_path_id,json=trainingPathId,proto3,oneof"`
+	xxx_hidden_PromotionReady   bool                       `protobuf:"varint,5,opt,name=promotion_ready,json=promotionReady,proto3"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
@@ -1938,6 +1942,20 @@ func (x *GroupQualificationRule) GetRequireCompleted() bool {
 	return false
 }
 
+func (x *GroupQualificationRule) GetTrainingPathId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrainingPathId
+	}
+	return 0
+}
+
+func (x *GroupQualificationRule) GetPromotionReady() bool {
+	if x != nil {
+		return x.xxx_hidden_PromotionReady
+	}
+	return false
+}
+
 func (x *GroupQualificationRule) SetType(v GroupQualificationRuleType) {
 	x.xxx_hidden_Type = v
 }
@@ -1950,6 +1968,27 @@ func (x *GroupQualificationRule) SetRequireCompleted(v bool) {
 	x.xxx_hidden_RequireCompleted = v
 }
 
+func (x *GroupQualificationRule) SetTrainingPathId(v int64) {
+	x.xxx_hidden_TrainingPathId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *GroupQualificationRule) SetPromotionReady(v bool) {
+	x.xxx_hidden_PromotionReady = v
+}
+
+func (x *GroupQualificationRule) HasTrainingPathId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GroupQualificationRule) ClearTrainingPathId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_TrainingPathId = 0
+}
+
 type GroupQualificationRule_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1959,6 +1998,11 @@ type GroupQualificationRule_builder struct {
 	QualificationIds []int64
 	// If true, only completed/successful qualifications count.
 	RequireCompleted bool
+	// Training path whose steps are checked instead of the listed qualifications.
+	TrainingPathId *int64
+	// If true, only users below the training path's grade match (promotion readiness).
+	// Requires a training path, the ALL rule type and completed qualifications.
+	PromotionReady bool
 }
 
 func (b0 GroupQualificationRule_builder) Build() *GroupQualificationRule {
@@ -1968,6 +2012,11 @@ func (b0 GroupQualificationRule_builder) Build() *GroupQualificationRule {
 	x.xxx_hidden_Type = b.Type
 	x.xxx_hidden_QualificationIds = b.QualificationIds
 	x.xxx_hidden_RequireCompleted = b.RequireCompleted
+	if b.TrainingPathId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_TrainingPathId = *b.TrainingPathId
+	}
+	x.xxx_hidden_PromotionReady = b.PromotionReady
 	return m0
 }
 
@@ -2736,11 +2785,14 @@ const file_resources_jobs_groups_group_proto_rawDesc = "" +
 	"_max_gradeB\x0e\n" +
 	"\f_grade_labelB\x12\n" +
 	"\x10_min_grade_labelB\x12\n" +
-	"\x10_max_grade_label\"\xb9\x01\n" +
+	"\x10_max_grade_label\"\xa6\x02\n" +
 	"\x16GroupQualificationRule\x12E\n" +
 	"\x04type\x18\x01 \x01(\x0e21.resources.jobs.groups.GroupQualificationRuleTypeR\x04type\x12+\n" +
 	"\x11qualification_ids\x18\x02 \x03(\x03R\x10qualificationIds\x12+\n" +
-	"\x11require_completed\x18\x03 \x01(\bR\x10requireCompleted\"\xdc\x04\n" +
+	"\x11require_completed\x18\x03 \x01(\bR\x10requireCompleted\x12-\n" +
+	"\x10training_path_id\x18\x04 \x01(\x03H\x00R\x0etrainingPathId\x88\x01\x01\x12'\n" +
+	"\x0fpromotion_ready\x18\x05 \x01(\bR\x0epromotionReadyB\x13\n" +
+	"\x11_training_path_id\"\xdc\x04\n" +
 	"\tGroupRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\x12\x18\n" +
@@ -2900,6 +2952,7 @@ func file_resources_jobs_groups_group_proto_init() {
 	file_resources_jobs_groups_group_proto_msgTypes[2].OneofWrappers = []any{}
 	file_resources_jobs_groups_group_proto_msgTypes[3].OneofWrappers = []any{}
 	file_resources_jobs_groups_group_proto_msgTypes[4].OneofWrappers = []any{}
+	file_resources_jobs_groups_group_proto_msgTypes[5].OneofWrappers = []any{}
 	file_resources_jobs_groups_group_proto_msgTypes[6].OneofWrappers = []any{
 		(*groupRule_Grade)(nil),
 		(*groupRule_Qualification)(nil),
@@ -0,0 +1,60 @@
+package jobstimeclock
+
+import (
+	"math"
+	"time"
+)
+
+// MaxCorrectionDuration is the longest shift a correction can add or change.
+const MaxCorrectionDuration = 24 * time.Hour
+
+// CorrectedStartTime returns the start time the corrected timeclock entry will have, when closing
+// a shift without a new start time the entry's original start time is kept.
+func (x *TimeclockCorrection) CorrectedStartTime() *time.Time {
+	if x.GetStartTime() != nil {
+		t := x.GetStartTime().AsTime()
+		return &t
+	}
+
+	if x.GetType() == TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE &&
+		x.GetOriginalStartTime() != nil {
+		t := x.GetOriginalStartTime().AsTime()
+		return &t
+	}
+
+	return nil
+}
+
+// Duration returns the length of the corrected shift, zero if it has no start or end time.
+func (x *TimeclockCorrection) Duration() time.Duration {
+	start := x.CorrectedStartTime()
+	if start == nil || x.GetEndTime() == nil {
+		return 0
+	}
+
+	return x.GetEndTime().AsTime().Sub(*start)
+}
+
+// SpentTime returns the spent time (in hours) of the corrected timeclock entry.
+// Trimming replaces the original shift's duration, closing adds the shift's duration to the
+// time already recorded for the entry.
+func (x *TimeclockCorrection) SpentTime() float32 {
+	spent := x.Duration().Hours()
+
+	switch x.GetType() {
+	case TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM:
+		original := float64(x.GetOriginalSpentTime())
+		if x.GetOriginalStartTime() != nil && x.GetOriginalEndTime() != nil {
+			original -= x.GetOriginalEndTime().AsTime().
+				Sub(x.GetOriginalStartTime().AsTime()).
+				Hours()
+		}
+		spent += original
+
+	case TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE:
+		spent += float64(x.GetOriginalSpentTime())
+	}
+
+	// Spent time is stored with two decimals
+	return float32(math.Max(0, math.Round(spent*100)/100))
+}
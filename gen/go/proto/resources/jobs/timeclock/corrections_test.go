@@ -0,0 +1,56 @@
+package jobstimeclock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTimeclockCorrectionSpentTime(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 10, 1, 18, 0, 0, 0, time.UTC)
+
+	add := &TimeclockCorrection{
+		Type:      TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD,
+		StartTime: timestamp.New(start),
+		EndTime:   timestamp.New(start.Add(90 * time.Minute)),
+	}
+	assert.Equal(t, 90*time.Minute, add.Duration())
+	assert.InDelta(t, 1.5, add.SpentTime(), 0.001)
+
+	// Trimming a 2 hour shift to 1 hour, with 3 hours already recorded for the day
+	trim := &TimeclockCorrection{
+		Type:              TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM,
+		OriginalStartTime: timestamp.New(start),
+		OriginalEndTime:   timestamp.New(start.Add(2 * time.Hour)),
+		OriginalSpentTime: proto.Float32(5),
+		StartTime:         timestamp.New(start),
+		EndTime:           timestamp.New(start.Add(time.Hour)),
+	}
+	assert.InDelta(t, 4, trim.SpentTime(), 0.001)
+
+	// Never below zero
+	trim.OriginalSpentTime = proto.Float32(0.5)
+	assert.Zero(t, trim.SpentTime())
+
+	// Closing keeps the original start time
+	closing := &TimeclockCorrection{
+		Type:              TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE,
+		OriginalStartTime: timestamp.New(start),
+		OriginalSpentTime: proto.Float32(1.25),
+		EndTime:           timestamp.New(start.Add(20 * time.Minute)),
+	}
+	require.NotNil(t, closing.CorrectedStartTime())
+	assert.Equal(t, start, *closing.CorrectedStartTime())
+	assert.InDelta(t, 1.58, closing.SpentTime(), 0.001)
+
+	// Entries without a start time can't be closed without a new one
+	closing.OriginalStartTime = nil
+	assert.Nil(t, closing.CorrectedStartTime())
+	assert.Zero(t, closing.Duration())
+}
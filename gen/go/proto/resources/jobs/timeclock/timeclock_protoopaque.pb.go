@@ -9,6 +9,7 @@
 package jobstimeclock
 
 import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
 	colleagues "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
@@ -119,6 +120,103 @@ func (x TimeclockViewMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+type TimeclockCorrectionType int32
+
+const (
+	TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_UNSPECIFIED TimeclockCorrectionType = 0
+	// Add a shift that is missing, e.g., because the server crashed
+	TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD TimeclockCorrectionType = 1
+	// Change the start and/or end time of a shift
+	TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM TimeclockCorrectionType = 2
+	// Close a shift that has been left open
+	TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE TimeclockCorrectionType = 3
+)
+
+// Enum value maps for TimeclockCorrectionType.
+var (
+	TimeclockCorrectionType_name = map[int32]string{
+		0: "TIMECLOCK_CORRECTION_TYPE_UNSPECIFIED",
+		1: "TIMECLOCK_CORRECTION_TYPE_ADD",
+		2: "TIMECLOCK_CORRECTION_TYPE_TRIM",
+		3: "TIMECLOCK_CORRECTION_TYPE_CLOSE",
+	}
+	TimeclockCorrectionType_value = map[string]int32{
+		"TIMECLOCK_CORRECTION_TYPE_UNSPECIFIED": 0,
+		"TIMECLOCK_CORRECTION_TYPE_ADD":         1,
+		"TIMECLOCK_CORRECTION_TYPE_TRIM":        2,
+		"TIMECLOCK_CORRECTION_TYPE_CLOSE":       3,
+	}
+)
+
+func (x TimeclockCorrectionType) Enum() *TimeclockCorrectionType {
+	p := new(TimeclockCorrectionType)
+	*p = x
+	return p
+}
+
+func (x TimeclockCorrectionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeclockCorrectionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_jobs_timeclock_timeclock_proto_enumTypes[2].Descriptor()
+}
+
+func (TimeclockCorrectionType) Type() protoreflect.EnumType {
+	return &file_resources_jobs_timeclock_timeclock_proto_enumTypes[2]
+}
+
+func (x TimeclockCorrectionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type TimeclockCorrectionStatus int32
+
+const (
+	TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_UNSPECIFIED TimeclockCorrectionStatus = 0
+	TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_PENDING     TimeclockCorrectionStatus = 1
+	TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_APPROVED    TimeclockCorrectionStatus = 2
+	TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_REJECTED    TimeclockCorrectionStatus = 3
+)
+
+// Enum value maps for TimeclockCorrectionStatus.
+var (
+	TimeclockCorrectionStatus_name = map[int32]string{
+		0: "TIMECLOCK_CORRECTION_STATUS_UNSPECIFIED",
+		1: "TIMECLOCK_CORRECTION_STATUS_PENDING",
+		2: "TIMECLOCK_CORRECTION_STATUS_APPROVED",
+		3: "TIMECLOCK_CORRECTION_STATUS_REJECTED",
+	}
+	TimeclockCorrectionStatus_value = map[string]int32{
+		"TIMECLOCK_CORRECTION_STATUS_UNSPECIFIED": 0,
+		"TIMECLOCK_CORRECTION_STATUS_PENDING":     1,
+		"TIMECLOCK_CORRECTION_STATUS_APPROVED":    2,
+		"TIMECLOCK_CORRECTION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x TimeclockCorrectionStatus) Enum() *TimeclockCorrectionStatus {
+	p := new(TimeclockCorrectionStatus)
+	*p = x
+	return p
+}
+
+func (x TimeclockCorrectionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeclockCorrectionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_jobs_timeclock_timeclock_proto_enumTypes[3].Descriptor()
+}
+
+func (TimeclockCorrectionStatus) Type() protoreflect.EnumType {
+	return &file_resources_jobs_timeclock_timeclock_proto_enumTypes[3]
+}
+
+func (x TimeclockCorrectionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type TimeclockEntry struct {
 	state                protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_UserId    int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
@@ -516,11 +614,486 @@ func (b0 TimeclockWeeklyStats_builder) Build() *TimeclockWeeklyStats {
 	return m0
 }
 
+// Request to correct a colleague's timeclock, the original entry values are kept once approved.
+type TimeclockCorrection struct {
+	state                        protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Id                int64                     `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_CreatedAt         *timestamp.Timestamp      `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof"`
+	xxx_hidden_UpdatedAt         *timestamp.Timestamp      `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof"`
+	xxx_hidden_Job               string                    `protobuf:"bytes,4,opt,name=job,proto3"`
+	xxx_hidden_UserId            int32                     `protobuf:"varint,5,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_User              *colleagues.Colleague     `protobuf:"bytes,6,opt,name=user,proto3,oneof"`
+	xxx_hidden_Type              TimeclockCorrectionType   `protobuf:"varint,7,opt,name=type,proto3,enum=resources.jobs.timeclock.TimeclockCorrectionType"`
+	xxx_hidden_Status            TimeclockCorrectionStatus `protobuf:"varint,8,opt,name=status,proto3,enum=resources.jobs.timeclock.TimeclockCorrectionStatus"`
+	xxx_hidden_Date              *timestamp.Timestamp      `protobuf:"bytes,9,opt,name=date,proto3,oneof"`
+	xxx_hidden_OriginalStartTime *timestamp.Timestamp      `protobuf:"bytes,10,opt,name=original_start_time,json=originalStartTime,proto3,oneof"`
+	xxx_hidden_OriginalEndTime   *timestamp.Timestamp      `protobuf:"bytes,11,opt,name=original_end_time,json=originalEndTime,proto3,oneof"`
+	xxx_hidden_OriginalSpentTime float32                   `protobuf:"fixed32,12,opt,name=original_spent_time,json=originalSpentTime,proto3,oneof"`
+	xxx_hidden_StartTime         *timestamp.Timestamp      `protobuf:"bytes,13,opt,name=start_time,json=startTime,proto3,oneof"`
+	xxx_hidden_EndTime           *timestamp.Timestamp      `protobuf:"bytes,14,opt,name=end_time,json=endTime,proto3,oneof"`
+	xxx_hidden_Reason            string                    `protobuf:"bytes,15,opt,name=reason,proto3"`
+	xxx_hidden_ReviewerId        int32                     `protobuf:"varint,16,opt,name=reviewer_id,json=reviewerId,proto3,oneof"`
+	xxx_hidden_Reviewer          *colleagues.Colleague     `protobuf:"bytes,17,opt,name=reviewer,proto3,oneof"`
+	xxx_hidden_ReviewedAt        *timestamp.Timestamp      `protobuf:"bytes,18,opt,name=reviewed_at,json=reviewedAt,proto3,oneof"`
+	xxx_hidden_ReviewComment     *string                   `protobuf:"bytes,19,opt,name=review_comment,json=reviewComment,proto3,oneof"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *TimeclockCorrection) Reset() {
+	*x = TimeclockCorrection{}
+	mi := &file_resources_jobs_timeclock_timeclock_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeclockCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeclockCorrection) ProtoMessage() {}
+
+func (x *TimeclockCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_jobs_timeclock_timeclock_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TimeclockCorrection) GetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *TimeclockCorrection) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetJob() string {
+	if x != nil {
+		return x.xxx_hidden_Job
+	}
+	return ""
+}
+
+func (x *TimeclockCorrection) GetUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *TimeclockCorrection) GetUser() *colleagues.Colleague {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetType() TimeclockCorrectionType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_UNSPECIFIED
+}
+
+func (x *TimeclockCorrection) GetStatus() TimeclockCorrectionStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_UNSPECIFIED
+}
+
+func (x *TimeclockCorrection) GetDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Date
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetOriginalStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_OriginalStartTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetOriginalEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_OriginalEndTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetOriginalSpentTime() float32 {
+	if x != nil {
+		return x.xxx_hidden_OriginalSpentTime
+	}
+	return 0
+}
+
+func (x *TimeclockCorrection) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_EndTime
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetReason() string {
+	if x != nil {
+		return x.xxx_hidden_Reason
+	}
+	return ""
+}
+
+func (x *TimeclockCorrection) GetReviewerId() int32 {
+	if x != nil {
+		return x.xxx_hidden_ReviewerId
+	}
+	return 0
+}
+
+func (x *TimeclockCorrection) GetReviewer() *colleagues.Colleague {
+	if x != nil {
+		return x.xxx_hidden_Reviewer
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetReviewedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ReviewedAt
+	}
+	return nil
+}
+
+func (x *TimeclockCorrection) GetReviewComment() string {
+	if x != nil {
+		if x.xxx_hidden_ReviewComment != nil {
+			return *x.xxx_hidden_ReviewComment
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TimeclockCorrection) SetId(v int64) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *TimeclockCorrection) SetCreatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *TimeclockCorrection) SetUpdatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *TimeclockCorrection) SetJob(v string) {
+	x.xxx_hidden_Job = v
+}
+
+func (x *TimeclockCorrection) SetUserId(v int32) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *TimeclockCorrection) SetUser(v *colleagues.Colleague) {
+	x.xxx_hidden_User = v
+}
+
+func (x *TimeclockCorrection) SetType(v TimeclockCorrectionType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *TimeclockCorrection) SetStatus(v TimeclockCorrectionStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *TimeclockCorrection) SetDate(v *timestamp.Timestamp) {
+	x.xxx_hidden_Date = v
+}
+
+func (x *TimeclockCorrection) SetOriginalStartTime(v *timestamp.Timestamp) {
+	x.xxx_hidden_OriginalStartTime = v
+}
+
+func (x *TimeclockCorrection) SetOriginalEndTime(v *timestamp.Timestamp) {
+	x.xxx_hidden_OriginalEndTime = v
+}
+
+func (x *TimeclockCorrection) SetOriginalSpentTime(v float32) {
+	x.xxx_hidden_OriginalSpentTime = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 19)
+}
+
+func (x *TimeclockCorrection) SetStartTime(v *timestamp.Timestamp) {
+	x.xxx_hidden_StartTime = v
+}
+
+func (x *TimeclockCorrection) SetEndTime(v *timestamp.Timestamp) {
+	x.xxx_hidden_EndTime = v
+}
+
+func (x *TimeclockCorrection) SetReason(v string) {
+	x.xxx_hidden_Reason = v
+}
+
+func (x *TimeclockCorrection) SetReviewerId(v int32) {
+	x.xxx_hidden_ReviewerId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 19)
+}
+
+func (x *TimeclockCorrection) SetReviewer(v *colleagues.Colleague) {
+	x.xxx_hidden_Reviewer = v
+}
+
+func (x *TimeclockCorrection) SetReviewedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_ReviewedAt = v
+}
+
+func (x *TimeclockCorrection) SetReviewComment(v string) {
+	x.xxx_hidden_ReviewComment = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 19)
+}
+
+func (x *TimeclockCorrection) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *TimeclockCorrection) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *TimeclockCorrection) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *TimeclockCorrection) HasDate() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Date != nil
+}
+
+func (x *TimeclockCorrection) HasOriginalStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_OriginalStartTime != nil
+}
+
+func (x *TimeclockCorrection) HasOriginalEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_OriginalEndTime != nil
+}
+
+func (x *TimeclockCorrection) HasOriginalSpentTime() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *TimeclockCorrection) HasStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartTime != nil
+}
+
+func (x *TimeclockCorrection) HasEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndTime != nil
+}
+
+func (x *TimeclockCorrection) HasReviewerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *TimeclockCorrection) HasReviewer() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Reviewer != nil
+}
+
+func (x *TimeclockCorrection) HasReviewedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ReviewedAt != nil
+}
+
+func (x *TimeclockCorrection) HasReviewComment() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *TimeclockCorrection) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *TimeclockCorrection) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *TimeclockCorrection) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *TimeclockCorrection) ClearDate() {
+	x.xxx_hidden_Date = nil
+}
+
+func (x *TimeclockCorrection) ClearOriginalStartTime() {
+	x.xxx_hidden_OriginalStartTime = nil
+}
+
+func (x *TimeclockCorrection) ClearOriginalEndTime() {
+	x.xxx_hidden_OriginalEndTime = nil
+}
+
+func (x *TimeclockCorrection) ClearOriginalSpentTime() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_OriginalSpentTime = 0
+}
+
+func (x *TimeclockCorrection) ClearStartTime() {
+	x.xxx_hidden_StartTime = nil
+}
+
+func (x *TimeclockCorrection) ClearEndTime() {
+	x.xxx_hidden_EndTime = nil
+}
+
+func (x *TimeclockCorrection) ClearReviewerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_ReviewerId = 0
+}
+
+func (x *TimeclockCorrection) ClearReviewer() {
+	x.xxx_hidden_Reviewer = nil
+}
+
+func (x *TimeclockCorrection) ClearReviewedAt() {
+	x.xxx_hidden_ReviewedAt = nil
+}
+
+func (x *TimeclockCorrection) ClearReviewComment() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_ReviewComment = nil
+}
+
+type TimeclockCorrection_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        int64
+	CreatedAt *timestamp.Timestamp
+	UpdatedAt *timestamp.Timestamp
+	Job       string
+	UserId    int32
+	User      *colleagues.Colleague
+	Type      TimeclockCorrectionType
+	Status    TimeclockCorrectionStatus
+	// Date of the timeclock entry to correct, ignored when adding a shift
+	Date *timestamp.Timestamp
+	// Start time of the timeclock entry to correct, unset for entries without a start time
+	OriginalStartTime *timestamp.Timestamp
+	OriginalEndTime   *timestamp.Timestamp
+	OriginalSpentTime *float32
+	StartTime         *timestamp.Timestamp
+	EndTime           *timestamp.Timestamp
+	Reason            string
+	ReviewerId        *int32
+	Reviewer          *colleagues.Colleague
+	ReviewedAt        *timestamp.Timestamp
+	ReviewComment     *string
+}
+
+func (b0 TimeclockCorrection_builder) Build() *TimeclockCorrection {
+	m0 := &TimeclockCorrection{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_Job = b.Job
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_Date = b.Date
+	x.xxx_hidden_OriginalStartTime = b.OriginalStartTime
+	x.xxx_hidden_OriginalEndTime = b.OriginalEndTime
+	if b.OriginalSpentTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 19)
+		x.xxx_hidden_OriginalSpentTime = *b.OriginalSpentTime
+	}
+	x.xxx_hidden_StartTime = b.StartTime
+	x.xxx_hidden_EndTime = b.EndTime
+	x.xxx_hidden_Reason = b.Reason
+	if b.ReviewerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 19)
+		x.xxx_hidden_ReviewerId = *b.ReviewerId
+	}
+	x.xxx_hidden_Reviewer = b.Reviewer
+	x.xxx_hidden_ReviewedAt = b.ReviewedAt
+	if b.ReviewComment != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 19)
+		x.xxx_hidden_ReviewComment = b.ReviewComment
+	}
+	return m0
+}
+
 var File_resources_jobs_timeclock_timeclock_proto protoreflect.FileDescriptor
 
 const file_resources_jobs_timeclock_timeclock_proto_rawDesc = "" +
 	"\n" +
-	"(resources/jobs/timeclock/timeclock.proto\x12\x18resources.jobs.timeclock\x1a*resources/jobs/colleagues/colleagues.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xbe\x03\n" +
+	"(resources/jobs/timeclock/timeclock.proto\x12\x18resources.jobs.timeclock\x1a!codegen/sanitizer/sanitizer.proto\x1a*resources/jobs/colleagues/colleagues.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xbe\x03\n" +
 	"\x0eTimeclockEntry\x12/\n" +
 	"\auser_id\x18\x01 \x01(\x05B\x16\x9a\x84\x9e\x03\x11sql:\"primary_key\"R\x06userId\x12\x10\n" +
 	"\x03job\x18\x02 \x01(\tR\x03job\x12J\n" +
@@ -544,7 +1117,48 @@ const file_resources_jobs_timeclock_timeclock_proto_rawDesc = "" +
 	"\rcalendar_week\x18\x02 \x01(\x05R\fcalendarWeek\x12\x10\n" +
 	"\x03sum\x18\x03 \x01(\x02R\x03sum\x12\x10\n" +
 	"\x03avg\x18\x04 \x01(\x02R\x03avg\x12\x10\n" +
-	"\x03max\x18\x05 \x01(\x02R\x03max*\x9b\x01\n" +
+	"\x03max\x18\x05 \x01(\x02R\x03max\"\xe9\n" +
+	"\n" +
+	"\x13TimeclockCorrection\x121\n" +
+	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tupdatedAt\x88\x01\x01\x12\x10\n" +
+	"\x03job\x18\x04 \x01(\tR\x03job\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x05R\x06userId\x12P\n" +
+	"\x04user\x18\x06 \x01(\v2$.resources.jobs.colleagues.ColleagueB\x11\x9a\x84\x9e\x03\falias:\"user\"H\x02R\x04user\x88\x01\x01\x12E\n" +
+	"\x04type\x18\a \x01(\x0e21.resources.jobs.timeclock.TimeclockCorrectionTypeR\x04type\x12K\n" +
+	"\x06status\x18\b \x01(\x0e23.resources.jobs.timeclock.TimeclockCorrectionStatusR\x06status\x127\n" +
+	"\x04date\x18\t \x01(\v2\x1e.resources.timestamp.TimestampH\x03R\x04date\x88\x01\x01\x12S\n" +
+	"\x13original_start_time\x18\n" +
+	" \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\x11originalStartTime\x88\x01\x01\x12O\n" +
+	"\x11original_end_time\x18\v \x01(\v2\x1e.resources.timestamp.TimestampH\x05R\x0foriginalEndTime\x88\x01\x01\x123\n" +
+	"\x13original_spent_time\x18\f \x01(\x02H\x06R\x11originalSpentTime\x88\x01\x01\x12B\n" +
+	"\n" +
+	"start_time\x18\r \x01(\v2\x1e.resources.timestamp.TimestampH\aR\tstartTime\x88\x01\x01\x12>\n" +
+	"\bend_time\x18\x0e \x01(\v2\x1e.resources.timestamp.TimestampH\bR\aendTime\x88\x01\x01\x12\x1e\n" +
+	"\x06reason\x18\x0f \x01(\tB\x06\xda\xf3\x18\x02\b\x01R\x06reason\x12$\n" +
+	"\vreviewer_id\x18\x10 \x01(\x05H\tR\n" +
+	"reviewerId\x88\x01\x01\x12\\\n" +
+	"\breviewer\x18\x11 \x01(\v2$.resources.jobs.colleagues.ColleagueB\x15\x9a\x84\x9e\x03\x10alias:\"reviewer\"H\n" +
+	"R\breviewer\x88\x01\x01\x12D\n" +
+	"\vreviewed_at\x18\x12 \x01(\v2\x1e.resources.timestamp.TimestampH\vR\n" +
+	"reviewedAt\x88\x01\x01\x122\n" +
+	"\x0ereview_comment\x18\x13 \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\fR\rreviewComment\x88\x01\x01B\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\a\n" +
+	"\x05_userB\a\n" +
+	"\x05_dateB\x16\n" +
+	"\x14_original_start_timeB\x14\n" +
+	"\x12_original_end_timeB\x16\n" +
+	"\x14_original_spent_timeB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\x0e\n" +
+	"\f_reviewer_idB\v\n" +
+	"\t_reviewerB\x0e\n" +
+	"\f_reviewed_atB\x11\n" +
+	"\x0f_review_comment*\x9b\x01\n" +
 	"\rTimeclockMode\x12\x1e\n" +
 	"\x1aTIMECLOCK_MODE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14TIMECLOCK_MODE_DAILY\x10\x01\x12\x19\n" +
@@ -554,29 +1168,54 @@ const file_resources_jobs_timeclock_timeclock_proto_rawDesc = "" +
 	"\x11TimeclockViewMode\x12#\n" +
 	"\x1fTIMECLOCK_VIEW_MODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18TIMECLOCK_VIEW_MODE_SELF\x10\x01\x12\x1b\n" +
-	"\x17TIMECLOCK_VIEW_MODE_ALL\x10\x02BZZXgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/timeclock;jobstimeclockb\x06proto3"
-
-var file_resources_jobs_timeclock_timeclock_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_resources_jobs_timeclock_timeclock_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+	"\x17TIMECLOCK_VIEW_MODE_ALL\x10\x02*\xb0\x01\n" +
+	"\x17TimeclockCorrectionType\x12)\n" +
+	"%TIMECLOCK_CORRECTION_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dTIMECLOCK_CORRECTION_TYPE_ADD\x10\x01\x12\"\n" +
+	"\x1eTIMECLOCK_CORRECTION_TYPE_TRIM\x10\x02\x12#\n" +
+	"\x1fTIMECLOCK_CORRECTION_TYPE_CLOSE\x10\x03*\xc5\x01\n" +
+	"\x19TimeclockCorrectionStatus\x12+\n" +
+	"'TIMECLOCK_CORRECTION_STATUS_UNSPECIFIED\x10\x00\x12'\n" +
+	"#TIMECLOCK_CORRECTION_STATUS_PENDING\x10\x01\x12(\n" +
+	"$TIMECLOCK_CORRECTION_STATUS_APPROVED\x10\x02\x12(\n" +
+	"$TIMECLOCK_CORRECTION_STATUS_REJECTED\x10\x03BZZXgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/timeclock;jobstimeclockb\x06proto3"
+
+var file_resources_jobs_timeclock_timeclock_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_resources_jobs_timeclock_timeclock_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_resources_jobs_timeclock_timeclock_proto_goTypes = []any{
-	(TimeclockMode)(0),           // 0: resources.jobs.timeclock.TimeclockMode
-	(TimeclockViewMode)(0),       // 1: resources.jobs.timeclock.TimeclockViewMode
-	(*TimeclockEntry)(nil),       // 2: resources.jobs.timeclock.TimeclockEntry
-	(*TimeclockStats)(nil),       // 3: resources.jobs.timeclock.TimeclockStats
-	(*TimeclockWeeklyStats)(nil), // 4: resources.jobs.timeclock.TimeclockWeeklyStats
-	(*timestamp.Timestamp)(nil),  // 5: resources.timestamp.Timestamp
-	(*colleagues.Colleague)(nil), // 6: resources.jobs.colleagues.Colleague
+	(TimeclockMode)(0),             // 0: resources.jobs.timeclock.TimeclockMode
+	(TimeclockViewMode)(0),         // 1: resources.jobs.timeclock.TimeclockViewMode
+	(TimeclockCorrectionType)(0),   // 2: resources.jobs.timeclock.TimeclockCorrectionType
+	(TimeclockCorrectionStatus)(0), // 3: resources.jobs.timeclock.TimeclockCorrectionStatus
+	(*TimeclockEntry)(nil),         // 4: resources.jobs.timeclock.TimeclockEntry
+	(*TimeclockStats)(nil),         // 5: resources.jobs.timeclock.TimeclockStats
+	(*TimeclockWeeklyStats)(nil),   // 6: resources.jobs.timeclock.TimeclockWeeklyStats
+	(*TimeclockCorrection)(nil),    // 7: resources.jobs.timeclock.TimeclockCorrection
+	(*timestamp.Timestamp)(nil),    // 8: resources.timestamp.Timestamp
+	(*colleagues.Colleague)(nil),   // 9: resources.jobs.colleagues.Colleague
 }
 var file_resources_jobs_timeclock_timeclock_proto_depIdxs = []int32{
-	5, // 0: resources.jobs.timeclock.TimeclockEntry.date:type_name -> resources.timestamp.Timestamp
-	6, // 1: resources.jobs.timeclock.TimeclockEntry.user:type_name -> resources.jobs.colleagues.Colleague
-	5, // 2: resources.jobs.timeclock.TimeclockEntry.start_time:type_name -> resources.timestamp.Timestamp
-	5, // 3: resources.jobs.timeclock.TimeclockEntry.end_time:type_name -> resources.timestamp.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	8,  // 0: resources.jobs.timeclock.TimeclockEntry.date:type_name -> resources.timestamp.Timestamp
+	9,  // 1: resources.jobs.timeclock.TimeclockEntry.user:type_name -> resources.jobs.colleagues.Colleague
+	8,  // 2: resources.jobs.timeclock.TimeclockEntry.start_time:type_name -> resources.timestamp.Timestamp
+	8,  // 3: resources.jobs.timeclock.TimeclockEntry.end_time:type_name -> resources.timestamp.Timestamp
+	8,  // 4: resources.jobs.timeclock.TimeclockCorrection.created_at:type_name -> resources.timestamp.Timestamp
+	8,  // 5: resources.jobs.timeclock.TimeclockCorrection.updated_at:type_name -> resources.timestamp.Timestamp
+	9,  // 6: resources.jobs.timeclock.TimeclockCorrection.user:type_name -> resources.jobs.colleagues.Colleague
+	2,  // 7: resources.jobs.timeclock.TimeclockCorrection.type:type_name -> resources.jobs.timeclock.TimeclockCorrectionType
+	3,  // 8: resources.jobs.timeclock.TimeclockCorrection.status:type_name -> resources.jobs.timeclock.TimeclockCorrectionStatus
+	8,  // 9: resources.jobs.timeclock.TimeclockCorrection.date:type_name -> resources.timestamp.Timestamp
+	8,  // 10: resources.jobs.timeclock.TimeclockCorrection.original_start_time:type_name -> resources.timestamp.Timestamp
+	8,  // 11: resources.jobs.timeclock.TimeclockCorrection.original_end_time:type_name -> resources.timestamp.Timestamp
+	8,  // 12: resources.jobs.timeclock.TimeclockCorrection.start_time:type_name -> resources.timestamp.Timestamp
+	8,  // 13: resources.jobs.timeclock.TimeclockCorrection.end_time:type_name -> resources.timestamp.Timestamp
+	9,  // 14: resources.jobs.timeclock.TimeclockCorrection.reviewer:type_name -> resources.jobs.colleagues.Colleague
+	8,  // 15: resources.jobs.timeclock.TimeclockCorrection.reviewed_at:type_name -> resources.timestamp.Timestamp
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_resources_jobs_timeclock_timeclock_proto_init() }
@@ -585,13 +1224,14 @@ func file_resources_jobs_timeclock_timeclock_proto_init() {
 		return
 	}
 	file_resources_jobs_timeclock_timeclock_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_jobs_timeclock_timeclock_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_jobs_timeclock_timeclock_proto_rawDesc), len(file_resources_jobs_timeclock_timeclock_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   3,
+			NumEnums:      4,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/livemap/history/history.proto
+
+//go:build !protoopaque
+
+package livemaphistory
+
+import (
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Downsampled position of a tracked user written by the tracker.
+type PositionSample struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Job           string                 `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
+	JobGrade      int32                  `protobuf:"varint,4,opt,name=job_grade,json=jobGrade,proto3" json:"job_grade,omitempty"`
+	UnitId        *int64                 `protobuf:"varint,5,opt,name=unit_id,json=unitId,proto3,oneof" json:"unit_id,omitempty"`
+	X             float64                `protobuf:"fixed64,6,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,7,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionSample) Reset() {
+	*x = PositionSample{}
+	mi := &file_resources_livemap_history_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionSample) ProtoMessage() {}
+
+func (x *PositionSample) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_livemap_history_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PositionSample) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PositionSample) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PositionSample) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *PositionSample) GetJobGrade() int32 {
+	if x != nil {
+		return x.JobGrade
+	}
+	return 0
+}
+
+func (x *PositionSample) GetUnitId() int64 {
+	if x != nil && x.UnitId != nil {
+		return *x.UnitId
+	}
+	return 0
+}
+
+func (x *PositionSample) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PositionSample) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PositionSample) SetCreatedAt(v *timestamp.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *PositionSample) SetUserId(v int32) {
+	x.UserId = v
+}
+
+func (x *PositionSample) SetJob(v string) {
+	x.Job = v
+}
+
+func (x *PositionSample) SetJobGrade(v int32) {
+	x.JobGrade = v
+}
+
+func (x *PositionSample) SetUnitId(v int64) {
+	x.UnitId = &v
+}
+
+func (x *PositionSample) SetX(v float64) {
+	x.X = v
+}
+
+func (x *PositionSample) SetY(v float64) {
+	x.Y = v
+}
+
+func (x *PositionSample) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *PositionSample) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return x.UnitId != nil
+}
+
+func (x *PositionSample) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *PositionSample) ClearUnitId() {
+	x.UnitId = nil
+}
+
+type PositionSample_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CreatedAt *timestamp.Timestamp
+	UserId    int32
+	Job       string
+	JobGrade  int32
+	UnitId    *int64
+	X         float64
+	Y         float64
+}
+
+func (b0 PositionSample_builder) Build() *PositionSample {
+	m0 := &PositionSample{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.CreatedAt = b.CreatedAt
+	x.UserId = b.UserId
+	x.Job = b.Job
+	x.JobGrade = b.JobGrade
+	x.UnitId = b.UnitId
+	x.X = b.X
+	x.Y = b.Y
+	return m0
+}
+
+// Polyline of a user's positions inside a time window.
+type PositionTrail struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Job           string                 `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	Points        []*PositionTrailPoint  `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionTrail) Reset() {
+	*x = PositionTrail{}
+	mi := &file_resources_livemap_history_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionTrail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionTrail) ProtoMessage() {}
+
+func (x *PositionTrail) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_livemap_history_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PositionTrail) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PositionTrail) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *PositionTrail) GetPoints() []*PositionTrailPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *PositionTrail) SetUserId(v int32) {
+	x.UserId = v
+}
+
+func (x *PositionTrail) SetJob(v string) {
+	x.Job = v
+}
+
+func (x *PositionTrail) SetPoints(v []*PositionTrailPoint) {
+	x.Points = v
+}
+
+type PositionTrail_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int32
+	Job    string
+	Points []*PositionTrailPoint
+}
+
+func (b0 PositionTrail_builder) Build() *PositionTrail {
+	m0 := &PositionTrail{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UserId = b.UserId
+	x.Job = b.Job
+	x.Points = b.Points
+	return m0
+}
+
+type PositionTrailPoint struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Time          *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	UnitId        *int64                 `protobuf:"varint,4,opt,name=unit_id,json=unitId,proto3,oneof" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionTrailPoint) Reset() {
+	*x = PositionTrailPoint{}
+	mi := &file_resources_livemap_history_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionTrailPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionTrailPoint) ProtoMessage() {}
+
+func (x *PositionTrailPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_livemap_history_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PositionTrailPoint) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *PositionTrailPoint) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PositionTrailPoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PositionTrailPoint) GetUnitId() int64 {
+	if x != nil && x.UnitId != nil {
+		return *x.UnitId
+	}
+	return 0
+}
+
+func (x *PositionTrailPoint) SetTime(v *timestamp.Timestamp) {
+	x.Time = v
+}
+
+func (x *PositionTrailPoint) SetX(v float64) {
+	x.X = v
+}
+
+func (x *PositionTrailPoint) SetY(v float64) {
+	x.Y = v
+}
+
+func (x *PositionTrailPoint) SetUnitId(v int64) {
+	x.UnitId = &v
+}
+
+func (x *PositionTrailPoint) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.Time != nil
+}
+
+func (x *PositionTrailPoint) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return x.UnitId != nil
+}
+
+func (x *PositionTrailPoint) ClearTime() {
+	x.Time = nil
+}
+
+func (x *PositionTrailPoint) ClearUnitId() {
+	x.UnitId = nil
+}
+
+type PositionTrailPoint_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Time   *timestamp.Timestamp
+	X      float64
+	Y      float64
+	UnitId *int64
+}
+
+func (b0 PositionTrailPoint_builder) Build() *PositionTrailPoint {
+	m0 := &PositionTrailPoint{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Time = b.Time
+	x.X = b.X
+	x.Y = b.Y
+	x.UnitId = b.UnitId
+	return m0
+}
+
+var File_resources_livemap_history_history_proto protoreflect.FileDescriptor
+
+const file_resources_livemap_history_history_proto_rawDesc = "" +
+	"\n" +
+	"'resources/livemap/history/history.proto\x12\x19resources.livemap.history\x1a#resources/timestamp/timestamp.proto\"\xdd\x01\n" +
+	"\x0ePositionSample\x12=\n" +
+	"\n" +
+	"created_at\x18\x01 \x01(\v2\x1e.resources.timestamp.TimestampR\tcreatedAt\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03job\x18\x03 \x01(\tR\x03job\x12\x1b\n" +
+	"\tjob_grade\x18\x04 \x01(\x05R\bjobGrade\x12\x1c\n" +
+	"\aunit_id\x18\x05 \x01(\x03H\x00R\x06unitId\x88\x01\x01\x12\f\n" +
+	"\x01x\x18\x06 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\a \x01(\x01R\x01yB\n" +
+	"\n" +
+	"\b_unit_id\"\x81\x01\n" +
+	"\rPositionTrail\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03job\x18\x02 \x01(\tR\x03job\x12E\n" +
+	"\x06points\x18\x03 \x03(\v2-.resources.livemap.history.PositionTrailPointR\x06points\"\x8e\x01\n" +
+	"\x12PositionTrailPoint\x122\n" +
+	"\x04time\x18\x01 \x01(\v2\x1e.resources.timestamp.TimestampR\x04time\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x1c\n" +
+	"\aunit_id\x18\x04 \x01(\x03H\x00R\x06unitId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_unit_idB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history;livemaphistoryb\x06proto3"
+
+var file_resources_livemap_history_history_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_resources_livemap_history_history_proto_goTypes = []any{
+	(*PositionSample)(nil),      // 0: resources.livemap.history.PositionSample
+	(*PositionTrail)(nil),       // 1: resources.livemap.history.PositionTrail
+	(*PositionTrailPoint)(nil),  // 2: resources.livemap.history.PositionTrailPoint
+	(*timestamp.Timestamp)(nil), // 3: resources.timestamp.Timestamp
+}
+var file_resources_livemap_history_history_proto_depIdxs = []int32{
+	3, // 0: resources.livemap.history.PositionSample.created_at:type_name -> resources.timestamp.Timestamp
+	2, // 1: resources.livemap.history.PositionTrail.points:type_name -> resources.livemap.history.PositionTrailPoint
+	3, // 2: resources.livemap.history.PositionTrailPoint.time:type_name -> resources.timestamp.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_resources_livemap_history_history_proto_init() }
+func file_resources_livemap_history_history_proto_init() {
+	if File_resources_livemap_history_history_proto != nil {
+		return
+	}
+	file_resources_livemap_history_history_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_livemap_history_history_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_livemap_history_history_proto_rawDesc), len(file_resources_livemap_history_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_livemap_history_history_proto_goTypes,
+		DependencyIndexes: file_resources_livemap_history_history_proto_depIdxs,
+		MessageInfos:      file_resources_livemap_history_history_proto_msgTypes,
+	}.Build()
+	File_resources_livemap_history_history_proto = out.File
+	file_resources_livemap_history_history_proto_goTypes = nil
+	file_resources_livemap_history_history_proto_depIdxs = nil
+}
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/livemap/markers/cluster.proto
+
+//go:build protoopaque
+
+package livemapmarkers
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Aggregate of nearby user markers of the same job, sent instead of the individual markers at low zoom levels.
+type MarkerCluster struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_X        float64                `protobuf:"fixed64,1,opt,name=x,proto3"`
+	xxx_hidden_Y        float64                `protobuf:"fixed64,2,opt,name=y,proto3"`
+	xxx_hidden_Count    int32                  `protobuf:"varint,3,opt,name=count,proto3"`
+	xxx_hidden_Job      string                 `protobuf:"bytes,4,opt,name=job,proto3"`
+	xxx_hidden_JobLabel string                 `protobuf:"bytes,5,opt,name=job_label,json=jobLabel,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MarkerCluster) Reset() {
+	*x = MarkerCluster{}
+	mi := &file_resources_livemap_markers_cluster_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkerCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkerCluster) ProtoMessage() {}
+
+func (x *MarkerCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_livemap_markers_cluster_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MarkerCluster) GetX() float64 {
+	if x != nil {
+		return x.xxx_hidden_X
+	}
+	return 0
+}
+
+func (x *MarkerCluster) GetY() float64 {
+	if x != nil {
+		return x.xxx_hidden_Y
+	}
+	return 0
+}
+
+func (x *MarkerCluster) GetCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *MarkerCluster) GetJob() string {
+	if x != nil {
+		return x.xxx_hidden_Job
+	}
+	return ""
+}
+
+func (x *MarkerCluster) GetJobLabel() string {
+	if x != nil {
+		return x.xxx_hidden_JobLabel
+	}
+	return ""
+}
+
+func (x *MarkerCluster) SetX(v float64) {
+	x.xxx_hidden_X = v
+}
+
+func (x *MarkerCluster) SetY(v float64) {
+	x.xxx_hidden_Y = v
+}
+
+func (x *MarkerCluster) SetCount(v int32) {
+	x.xxx_hidden_Count = v
+}
+
+func (x *MarkerCluster) SetJob(v string) {
+	x.xxx_hidden_Job = v
+}
+
+func (x *MarkerCluster) SetJobLabel(v string) {
+	x.xxx_hidden_JobLabel = v
+}
+
+type MarkerCluster_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Centroid of the clustered markers
+	X        float64
+	Y        float64
+	Count    int32
+	Job      string
+	JobLabel string
+}
+
+func (b0 MarkerCluster_builder) Build() *MarkerCluster {
+	m0 := &MarkerCluster{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_X = b.X
+	x.xxx_hidden_Y = b.Y
+	x.xxx_hidden_Count = b.Count
+	x.xxx_hidden_Job = b.Job
+	x.xxx_hidden_JobLabel = b.JobLabel
+	return m0
+}
+
+var File_resources_livemap_markers_cluster_proto protoreflect.FileDescriptor
+
+const file_resources_livemap_markers_cluster_proto_rawDesc = "" +
+	"\n" +
+	"'resources/livemap/markers/cluster.proto\x12\x19resources.livemap.markers\"p\n" +
+	"\rMarkerCluster\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x10\n" +
+	"\x03job\x18\x04 \x01(\tR\x03job\x12\x1b\n" +
+	"\tjob_label\x18\x05 \x01(\tR\bjobLabelB\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers;livemapmarkersb\x06proto3"
+
+var file_resources_livemap_markers_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resources_livemap_markers_cluster_proto_goTypes = []any{
+	(*MarkerCluster)(nil), // 0: resources.livemap.markers.MarkerCluster
+}
+var file_resources_livemap_markers_cluster_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_resources_livemap_markers_cluster_proto_init() }
+func file_resources_livemap_markers_cluster_proto_init() {
+	if File_resources_livemap_markers_cluster_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_livemap_markers_cluster_proto_rawDesc), len(file_resources_livemap_markers_cluster_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_livemap_markers_cluster_proto_goTypes,
+		DependencyIndexes: file_resources_livemap_markers_cluster_proto_depIdxs,
+		MessageInfos:      file_resources_livemap_markers_cluster_proto_msgTypes,
+	}.Build()
+	File_resources_livemap_markers_cluster_proto = out.File
+	file_resources_livemap_markers_cluster_proto_goTypes = nil
+	file_resources_livemap_markers_cluster_proto_depIdxs = nil
+}
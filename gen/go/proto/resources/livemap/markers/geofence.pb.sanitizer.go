@@ -0,0 +1,42 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/livemap/markers/geofence.proto
+
+package livemapmarkers
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GeofenceEvent) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Geofence
+	if m.Geofence != nil {
+		if v, ok := any(m.GetGeofence()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Job
+	m.Job = htmlsanitizer.SanitizeAndUnescape(m.Job)
+
+	// Field: MarkerName
+	m.MarkerName = htmlsanitizer.SanitizeAndUnescape(m.MarkerName)
+
+	// Field: Time
+	if m.Time != nil {
+		if v, ok := any(m.GetTime()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/livemap/markers/geofence.proto
+
+//go:build protoopaque
+
+package livemapmarkers
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/dbscanner"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GeofenceEventType int32
+
+const (
+	GeofenceEventType_GEOFENCE_EVENT_TYPE_UNSPECIFIED GeofenceEventType = 0
+	GeofenceEventType_GEOFENCE_EVENT_TYPE_ENTER       GeofenceEventType = 1
+	GeofenceEventType_GEOFENCE_EVENT_TYPE_EXIT        GeofenceEventType = 2
+)
+
+// Enum value maps for GeofenceEventType.
+var (
+	GeofenceEventType_name = map[int32]string{
+		0: "GEOFENCE_EVENT_TYPE_UNSPECIFIED",
+		1: "GEOFENCE_EVENT_TYPE_ENTER",
+		2: "GEOFENCE_EVENT_TYPE_EXIT",
+	}
+	GeofenceEventType_value = map[string]int32{
+		"GEOFENCE_EVENT_TYPE_UNSPECIFIED": 0,
+		"GEOFENCE_EVENT_TYPE_ENTER":       1,
+		"GEOFENCE_EVENT_TYPE_EXIT":        2,
+	}
+)
+
+func (x GeofenceEventType) Enum() *GeofenceEventType {
+	p := new(GeofenceEventType)
+	*p = x
+	return p
+}
+
+func (x GeofenceEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GeofenceEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_livemap_markers_geofence_proto_enumTypes[0].Descriptor()
+}
+
+func (GeofenceEventType) Type() protoreflect.EnumType {
+	return &file_resources_livemap_markers_geofence_proto_enumTypes[0]
+}
+
+func (x GeofenceEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Geofence settings of a circle or polygon marker.
+// On-duty users of the marker's job crossing the marker's shape cause enter/exit events.
+type MarkerGeofence struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AutoOnScene bool                   `protobuf:"varint,1,opt,name=auto_on_scene,json=autoOnScene,proto3"`
+	xxx_hidden_Notify      bool                   `protobuf:"varint,2,opt,name=notify,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MarkerGeofence) Reset() {
+	*x = MarkerGeofence{}
+	mi := &file_resources_livemap_markers_geofence_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkerGeofence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkerGeofence) ProtoMessage() {}
+
+func (x *MarkerGeofence) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_livemap_markers_geofence_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MarkerGeofence) GetAutoOnScene() bool {
+	if x != nil {
+		return x.xxx_hidden_AutoOnScene
+	}
+	return false
+}
+
+func (x *MarkerGeofence) GetNotify() bool {
+	if x != nil {
+		return x.xxx_hidden_Notify
+	}
+	return false
+}
+
+func (x *MarkerGeofence) SetAutoOnScene(v bool) {
+	x.xxx_hidden_AutoOnScene = v
+}
+
+func (x *MarkerGeofence) SetNotify(v bool) {
+	x.xxx_hidden_Notify = v
+}
+
+type MarkerGeofence_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Set the user's unit "on scene" for assigned dispatches located inside the zone
+	AutoOnScene bool
+	// Notify users when they enter or exit the zone
+	Notify bool
+}
+
+func (b0 MarkerGeofence_builder) Build() *MarkerGeofence {
+	m0 := &MarkerGeofence{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_AutoOnScene = b.AutoOnScene
+	x.xxx_hidden_Notify = b.Notify
+	return m0
+}
+
+type GeofenceEvent struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type        GeofenceEventType      `protobuf:"varint,1,opt,name=type,proto3,enum=resources.livemap.markers.GeofenceEventType"`
+	xxx_hidden_Time        *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=time,proto3"`
+	xxx_hidden_MarkerId    int64                  `protobuf:"varint,3,opt,name=marker_id,json=markerId,proto3"`
+	xxx_hidden_MarkerName  string                 `protobuf:"bytes,4,opt,name=marker_name,json=markerName,proto3"`
+	xxx_hidden_Job         string                 `protobuf:"bytes,5,opt,name=job,proto3"`
+	xxx_hidden_Geofence    *MarkerGeofence        `protobuf:"bytes,6,opt,name=geofence,proto3"`
+	xxx_hidden_UserId      int32                  `protobuf:"varint,7,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_UnitId      int64                  `protobuf:"varint,8,opt,name=unit_id,json=unitId,proto3,oneof"`
+	xxx_hidden_X           float64                `protobuf:"fixed64,9,opt,name=x,proto3"`
+	xxx_hidden_Y           float64                `protobuf:"fixed64,10,opt,name=y,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GeofenceEvent) Reset() {
+	*x = GeofenceEvent{}
+	mi := &file_resources_livemap_markers_geofence_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeofenceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeofenceEvent) ProtoMessage() {}
+
+func (x *GeofenceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_livemap_markers_geofence_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GeofenceEvent) GetType() GeofenceEventType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return GeofenceEventType_GEOFENCE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *GeofenceEvent) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *GeofenceEvent) GetMarkerId() int64 {
+	if x != nil {
+		return x.xxx_hidden_MarkerId
+	}
+	return 0
+}
+
+func (x *GeofenceEvent) GetMarkerName() string {
+	if x != nil {
+		return x.xxx_hidden_MarkerName
+	}
+	return ""
+}
+
+func (x *GeofenceEvent) GetJob() string {
+	if x != nil {
+		return x.xxx_hidden_Job
+	}
+	return ""
+}
+
+func (x *GeofenceEvent) GetGeofence() *MarkerGeofence {
+	if x != nil {
+		return x.xxx_hidden_Geofence
+	}
+	return nil
+}
+
+func (x *GeofenceEvent) GetUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *GeofenceEvent) GetUnitId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnitId
+	}
+	return 0
+}
+
+func (x *GeofenceEvent) GetX() float64 {
+	if x != nil {
+		return x.xxx_hidden_X
+	}
+	return 0
+}
+
+func (x *GeofenceEvent) GetY() float64 {
+	if x != nil {
+		return x.xxx_hidden_Y
+	}
+	return 0
+}
+
+func (x *GeofenceEvent) SetType(v GeofenceEventType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *GeofenceEvent) SetTime(v *timestamp.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *GeofenceEvent) SetMarkerId(v int64) {
+	x.xxx_hidden_MarkerId = v
+}
+
+func (x *GeofenceEvent) SetMarkerName(v string) {
+	x.xxx_hidden_MarkerName = v
+}
+
+func (x *GeofenceEvent) SetJob(v string) {
+	x.xxx_hidden_Job = v
+}
+
+func (x *GeofenceEvent) SetGeofence(v *MarkerGeofence) {
+	x.xxx_hidden_Geofence = v
+}
+
+func (x *GeofenceEvent) SetUserId(v int32) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *GeofenceEvent) SetUnitId(v int64) {
+	x.xxx_hidden_UnitId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 10)
+}
+
+func (x *GeofenceEvent) SetX(v float64) {
+	x.xxx_hidden_X = v
+}
+
+func (x *GeofenceEvent) SetY(v float64) {
+	x.xxx_hidden_Y = v
+}
+
+func (x *GeofenceEvent) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *GeofenceEvent) HasGeofence() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Geofence != nil
+}
+
+func (x *GeofenceEvent) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *GeofenceEvent) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *GeofenceEvent) ClearGeofence() {
+	x.xxx_hidden_Geofence = nil
+}
+
+func (x *GeofenceEvent) ClearUnitId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_UnitId = 0
+}
+
+type GeofenceEvent_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Type       GeofenceEventType
+	Time       *timestamp.Timestamp
+	MarkerId   int64
+	MarkerName string
+	Job        string
+	Geofence   *MarkerGeofence
+	UserId     int32
+	UnitId     *int64
+	X          float64
+	Y          float64
+}
+
+func (b0 GeofenceEvent_builder) Build() *GeofenceEvent {
+	m0 := &GeofenceEvent{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_Time = b.Time
+	x.xxx_hidden_MarkerId = b.MarkerId
+	x.xxx_hidden_MarkerName = b.MarkerName
+	x.xxx_hidden_Job = b.Job
+	x.xxx_hidden_Geofence = b.Geofence
+	x.xxx_hidden_UserId = b.UserId
+	if b.UnitId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 10)
+		x.xxx_hidden_UnitId = *b.UnitId
+	}
+	x.xxx_hidden_X = b.X
+	x.xxx_hidden_Y = b.Y
+	return m0
+}
+
+var File_resources_livemap_markers_geofence_proto protoreflect.FileDescriptor
+
+const file_resources_livemap_markers_geofence_proto_rawDesc = "" +
+	"\n" +
+	"(resources/livemap/markers/geofence.proto\x12\x19resources.livemap.markers\x1a!codegen/dbscanner/dbscanner.proto\x1a#resources/timestamp/timestamp.proto\"T\n" +
+	"\x0eMarkerGeofence\x12\"\n" +
+	"\rauto_on_scene\x18\x01 \x01(\bR\vautoOnScene\x12\x16\n" +
+	"\x06notify\x18\x02 \x01(\bR\x06notify:\x06\xe2\xf3\x18\x02\b\x01\"\xfb\x02\n" +
+	"\rGeofenceEvent\x12@\n" +
+	"\x04type\x18\x01 \x01(\x0e2,.resources.livemap.markers.GeofenceEventTypeR\x04type\x122\n" +
+	"\x04time\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampR\x04time\x12\x1b\n" +
+	"\tmarker_id\x18\x03 \x01(\x03R\bmarkerId\x12\x1f\n" +
+	"\vmarker_name\x18\x04 \x01(\tR\n" +
+	"markerName\x12\x10\n" +
+	"\x03job\x18\x05 \x01(\tR\x03job\x12E\n" +
+	"\bgeofence\x18\x06 \x01(\v2).resources.livemap.markers.MarkerGeofenceR\bgeofence\x12\x17\n" +
+	"\auser_id\x18\a \x01(\x05R\x06userId\x12\x1c\n" +
+	"\aunit_id\x18\b \x01(\x03H\x00R\x06unitId\x88\x01\x01\x12\f\n" +
+	"\x01x\x18\t \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\n" +
+	" \x01(\x01R\x01yB\n" +
+	"\n" +
+	"\b_unit_id*u\n" +
+	"\x11GeofenceEventType\x12#\n" +
+	"\x1fGEOFENCE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19GEOFENCE_EVENT_TYPE_ENTER\x10\x01\x12\x1c\n" +
+	"\x18GEOFENCE_EVENT_TYPE_EXIT\x10\x02B\\ZZgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers;livemapmarkersb\x06proto3"
+
+var file_resources_livemap_markers_geofence_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_livemap_markers_geofence_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_resources_livemap_markers_geofence_proto_goTypes = []any{
+	(GeofenceEventType)(0),      // 0: resources.livemap.markers.GeofenceEventType
+	(*MarkerGeofence)(nil),      // 1: resources.livemap.markers.MarkerGeofence
+	(*GeofenceEvent)(nil),       // 2: resources.livemap.markers.GeofenceEvent
+	(*timestamp.Timestamp)(nil), // 3: resources.timestamp.Timestamp
+}
+var file_resources_livemap_markers_geofence_proto_depIdxs = []int32{
+	0, // 0: resources.livemap.markers.GeofenceEvent.type:type_name -> resources.livemap.markers.GeofenceEventType
+	3, // 1: resources.livemap.markers.GeofenceEvent.time:type_name -> resources.timestamp.Timestamp
+	1, // 2: resources.livemap.markers.GeofenceEvent.geofence:type_name -> resources.livemap.markers.MarkerGeofence
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_resources_livemap_markers_geofence_proto_init() }
+func file_resources_livemap_markers_geofence_proto_init() {
+	if File_resources_livemap_markers_geofence_proto != nil {
+		return
+	}
+	file_resources_livemap_markers_geofence_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_livemap_markers_geofence_proto_rawDesc), len(file_resources_livemap_markers_geofence_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_livemap_markers_geofence_proto_goTypes,
+		DependencyIndexes: file_resources_livemap_markers_geofence_proto_depIdxs,
+		EnumInfos:         file_resources_livemap_markers_geofence_proto_enumTypes,
+		MessageInfos:      file_resources_livemap_markers_geofence_proto_msgTypes,
+	}.Build()
+	File_resources_livemap_markers_geofence_proto = out.File
+	file_resources_livemap_markers_geofence_proto_goTypes = nil
+	file_resources_livemap_markers_geofence_proto_depIdxs = nil
+}
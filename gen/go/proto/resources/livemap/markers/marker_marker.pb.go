@@ -85,25 +85,27 @@ func (x MarkerType) Number() protoreflect.EnumNumber {
 }
 
 type MarkerMarker struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
-	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
-	DeletedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
-	Name          string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Postal        *string                `protobuf:"bytes,10,opt,name=postal,proto3,oneof" json:"postal,omitempty"`
-	Color         *string                `protobuf:"bytes,11,opt,name=color,proto3,oneof" json:"color,omitempty"`
-	Job           string                 `protobuf:"bytes,12,opt,name=job,proto3" json:"job,omitempty"`
-	JobLabel      string                 `protobuf:"bytes,13,opt,name=job_label,json=jobLabel,proto3" json:"job_label,omitempty"`
-	Public        *bool                  `protobuf:"varint,18,opt,name=public,proto3,oneof" json:"public,omitempty"`
-	Type          MarkerType             `protobuf:"varint,14,opt,name=type,proto3,enum=resources.livemap.markers.MarkerType" json:"type,omitempty" alias:"markerType"`
-	Data          *MarkerData            `protobuf:"bytes,15,opt,name=data,proto3" json:"data,omitempty" alias:"markerData"`
-	CreatorId     *int32                 `protobuf:"varint,16,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
-	Creator       *short.UserShort       `protobuf:"bytes,17,opt,name=creator,proto3,oneof" json:"creator,omitempty"`
+	state       protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X           float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y           float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	CreatedAt   *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt   *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	ExpiresAt   *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	DeletedAt   *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	Name        string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Postal      *string                `protobuf:"bytes,10,opt,name=postal,proto3,oneof" json:"postal,omitempty"`
+	Color       *string                `protobuf:"bytes,11,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Job         string                 `protobuf:"bytes,12,opt,name=job,proto3" json:"job,omitempty"`
+	JobLabel    string                 `protobuf:"bytes,13,opt,name=job_label,json=jobLabel,proto3" json:"job_label,omitempty"`
+	Public      *bool                  `protobuf:"varint,18,opt,name=public,proto3,oneof" json:"public,omitempty"`
+	Type        MarkerType             `protobuf:"varint,14,opt,name=type,proto3,enum=resources.livemap.markers.MarkerType" json:"type,omitempty" alias:"markerType"`
+	Data        *MarkerData            `protobuf:"bytes,15,opt,name=data,proto3" json:"data,omitempty" alias:"markerData"`
+	// Only supported by circle and polygon markers
+	Geofence      *MarkerGeofence  `protobuf:"bytes,19,opt,name=geofence,proto3,oneof" json:"geofence,omitempty"`
+	CreatorId     *int32           `protobuf:"varint,16,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
+	Creator       *short.UserShort `protobuf:"bytes,17,opt,name=creator,proto3,oneof" json:"creator,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -245,6 +247,13 @@ func (x *MarkerMarker) GetData() *MarkerData {
 	return nil
 }
 
+func (x *MarkerMarker) GetGeofence() *MarkerGeofence {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
 func (x *MarkerMarker) GetCreatorId() int32 {
 	if x != nil && x.CreatorId != nil {
 		return *x.CreatorId
@@ -323,6 +332,10 @@ func (x *MarkerMarker) SetData(v *MarkerData) {
 	x.Data = v
 }
 
+func (x *MarkerMarker) SetGeofence(v *MarkerGeofence) {
+	x.Geofence = v
+}
+
 func (x *MarkerMarker) SetCreatorId(v int32) {
 	x.CreatorId = &v
 }
@@ -394,6 +407,13 @@ func (x *MarkerMarker) HasData() bool {
 	return x.Data != nil
 }
 
+func (x *MarkerMarker) HasGeofence() bool {
+	if x == nil {
+		return false
+	}
+	return x.Geofence != nil
+}
+
 func (x *MarkerMarker) HasCreatorId() bool {
 	if x == nil {
 		return false
@@ -444,6 +464,10 @@ func (x *MarkerMarker) ClearData() {
 	x.Data = nil
 }
 
+func (x *MarkerMarker) ClearGeofence() {
+	x.Geofence = nil
+}
+
 func (x *MarkerMarker) ClearCreatorId() {
 	x.CreatorId = nil
 }
@@ -471,8 +495,10 @@ type MarkerMarker_builder struct {
 	Public      *bool
 	Type        MarkerType
 	Data        *MarkerData
-	CreatorId   *int32
-	Creator     *short.UserShort
+	// Only supported by circle and polygon markers
+	Geofence  *MarkerGeofence
+	CreatorId *int32
+	Creator   *short.UserShort
 }
 
 func (b0 MarkerMarker_builder) Build() *MarkerMarker {
@@ -495,6 +521,7 @@ func (b0 MarkerMarker_builder) Build() *MarkerMarker {
 	x.Public = b.Public
 	x.Type = b.Type
 	x.Data = b.Data
+	x.Geofence = b.Geofence
 	x.CreatorId = b.CreatorId
 	x.Creator = b.Creator
 	return m0
@@ -1270,7 +1297,7 @@ var File_resources_livemap_markers_marker_marker_proto protoreflect.FileDescript
 
 const file_resources_livemap_markers_marker_marker_proto_rawDesc = "" +
 	"\n" +
-	"-resources/livemap/markers/marker_marker.proto\x12\x19resources.livemap.markers\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1eresources/livemap/coords.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xc1\a\n" +
+	"-resources/livemap/markers/marker_marker.proto\x12\x19resources.livemap.markers\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1eresources/livemap/coords.proto\x1a(resources/livemap/markers/geofence.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\x9a\b\n" +
 	"\fMarkerMarker\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
@@ -1292,10 +1319,12 @@ const file_resources_livemap_markers_marker_marker_proto_rawDesc = "" +
 	"\tjob_label\x18\r \x01(\tR\bjobLabel\x12\x1b\n" +
 	"\x06public\x18\x12 \x01(\bH\aR\x06public\x88\x01\x01\x12R\n" +
 	"\x04type\x18\x0e \x01(\x0e2%.resources.livemap.markers.MarkerTypeB\x17\x9a\x84\x9e\x03\x12alias:\"markerType\"R\x04type\x12R\n" +
-	"\x04data\x18\x0f \x01(\v2%.resources.livemap.markers.MarkerDataB\x17\x9a\x84\x9e\x03\x12alias:\"markerData\"R\x04data\x12\"\n" +
+	"\x04data\x18\x0f \x01(\v2%.resources.livemap.markers.MarkerDataB\x17\x9a\x84\x9e\x03\x12alias:\"markerData\"R\x04data\x12J\n" +
+	"\bgeofence\x18\x13 \x01(\v2).resources.livemap.markers.MarkerGeofenceH\bR\bgeofence\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"creator_id\x18\x10 \x01(\x05H\bR\tcreatorId\x88\x01\x01\x12?\n" +
-	"\acreator\x18\x11 \x01(\v2 .resources.users.short.UserShortH\tR\acreator\x88\x01\x01B\r\n" +
+	"creator_id\x18\x10 \x01(\x05H\tR\tcreatorId\x88\x01\x01\x12?\n" +
+	"\acreator\x18\x11 \x01(\v2 .resources.users.short.UserShortH\n" +
+	"R\acreator\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_expires_atB\r\n" +
@@ -1303,7 +1332,8 @@ const file_resources_livemap_markers_marker_marker_proto_rawDesc = "" +
 	"\f_descriptionB\t\n" +
 	"\a_postalB\b\n" +
 	"\x06_colorB\t\n" +
-	"\a_publicB\r\n" +
+	"\a_publicB\v\n" +
+	"\t_geofenceB\r\n" +
 	"\v_creator_idB\n" +
 	"\n" +
 	"\b_creator\"\xf9\x02\n" +
@@ -1364,8 +1394,9 @@ var file_resources_livemap_markers_marker_marker_proto_goTypes = []any{
 	(*PolygonMarker)(nil),       // 6: resources.livemap.markers.PolygonMarker
 	(*PolylineMarker)(nil),      // 7: resources.livemap.markers.PolylineMarker
 	(*timestamp.Timestamp)(nil), // 8: resources.timestamp.Timestamp
-	(*short.UserShort)(nil),     // 9: resources.users.short.UserShort
-	(*livemap.Coords)(nil),      // 10: resources.livemap.Coords
+	(*MarkerGeofence)(nil),      // 9: resources.livemap.markers.MarkerGeofence
+	(*short.UserShort)(nil),     // 10: resources.users.short.UserShort
+	(*livemap.Coords)(nil),      // 11: resources.livemap.Coords
 }
 var file_resources_livemap_markers_marker_marker_proto_depIdxs = []int32{
 	8,  // 0: resources.livemap.markers.MarkerMarker.created_at:type_name -> resources.timestamp.Timestamp
@@ -1374,19 +1405,20 @@ var file_resources_livemap_markers_marker_marker_proto_depIdxs = []int32{
 	8,  // 3: resources.livemap.markers.MarkerMarker.deleted_at:type_name -> resources.timestamp.Timestamp
 	0,  // 4: resources.livemap.markers.MarkerMarker.type:type_name -> resources.livemap.markers.MarkerType
 	2,  // 5: resources.livemap.markers.MarkerMarker.data:type_name -> resources.livemap.markers.MarkerData
-	9,  // 6: resources.livemap.markers.MarkerMarker.creator:type_name -> resources.users.short.UserShort
-	3,  // 7: resources.livemap.markers.MarkerData.circle:type_name -> resources.livemap.markers.CircleMarker
-	4,  // 8: resources.livemap.markers.MarkerData.icon:type_name -> resources.livemap.markers.IconMarker
-	5,  // 9: resources.livemap.markers.MarkerData.rectangle:type_name -> resources.livemap.markers.RectangleMarker
-	6,  // 10: resources.livemap.markers.MarkerData.polygon:type_name -> resources.livemap.markers.PolygonMarker
-	7,  // 11: resources.livemap.markers.MarkerData.polyline:type_name -> resources.livemap.markers.PolylineMarker
-	10, // 12: resources.livemap.markers.PolygonMarker.points:type_name -> resources.livemap.Coords
-	10, // 13: resources.livemap.markers.PolylineMarker.points:type_name -> resources.livemap.Coords
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	9,  // 6: resources.livemap.markers.MarkerMarker.geofence:type_name -> resources.livemap.markers.MarkerGeofence
+	10, // 7: resources.livemap.markers.MarkerMarker.creator:type_name -> resources.users.short.UserShort
+	3,  // 8: resources.livemap.markers.MarkerData.circle:type_name -> resources.livemap.markers.CircleMarker
+	4,  // 9: resources.livemap.markers.MarkerData.icon:type_name -> resources.livemap.markers.IconMarker
+	5,  // 10: resources.livemap.markers.MarkerData.rectangle:type_name -> resources.livemap.markers.RectangleMarker
+	6,  // 11: resources.livemap.markers.MarkerData.polygon:type_name -> resources.livemap.markers.PolygonMarker
+	7,  // 12: resources.livemap.markers.MarkerData.polyline:type_name -> resources.livemap.markers.PolylineMarker
+	11, // 13: resources.livemap.markers.PolygonMarker.points:type_name -> resources.livemap.Coords
+	11, // 14: resources.livemap.markers.PolylineMarker.points:type_name -> resources.livemap.Coords
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_resources_livemap_markers_marker_marker_proto_init() }
@@ -1394,6 +1426,7 @@ func file_resources_livemap_markers_marker_marker_proto_init() {
 	if File_resources_livemap_markers_marker_marker_proto != nil {
 		return
 	}
+	file_resources_livemap_markers_geofence_proto_init()
 	file_resources_livemap_markers_marker_marker_proto_msgTypes[0].OneofWrappers = []any{}
 	file_resources_livemap_markers_marker_marker_proto_msgTypes[1].OneofWrappers = []any{
 		(*MarkerData_Circle)(nil),
@@ -151,6 +151,15 @@ func (m *MarkerMarker) Sanitize() error {
 		}
 	}
 
+	// Field: Geofence
+	if m.Geofence != nil {
+		if v, ok := any(m.GetGeofence()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: Job
 	m.Job = htmlsanitizer.SanitizeAndUnescape(m.Job)
 
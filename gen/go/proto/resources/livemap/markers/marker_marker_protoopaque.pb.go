@@ -102,6 +102,7 @@ type MarkerMarker struct {
 	xxx_hidden_Public      bool                   `protobuf:"varint,18,opt,name=public,proto3,oneof"`
 	xxx_hidden_Type        MarkerType             `protobuf:"varint,14,opt,name=type,proto3,enum=resources.livemap.markers.MarkerType"`
 	xxx_hidden_Data        *MarkerData            `protobuf:"bytes,15,opt,name=data,proto3"`
+	xxx_hidden_Geofence    *MarkerGeofence        `protobuf:"bytes,19,opt,name=geofence,proto3,oneof"`
 	xxx_hidden_CreatorId   int32                  `protobuf:"varint,16,opt,name=creator_id,json=creatorId,proto3,oneof"`
 	xxx_hidden_Creator     *short.UserShort       `protobuf:"bytes,17,opt,name=creator,proto3,oneof"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
@@ -256,6 +257,13 @@ func (x *MarkerMarker) GetData() *MarkerData {
 	return nil
 }
 
+func (x *MarkerMarker) GetGeofence() *MarkerGeofence {
+	if x != nil {
+		return x.xxx_hidden_Geofence
+	}
+	return nil
+}
+
 func (x *MarkerMarker) GetCreatorId() int32 {
 	if x != nil {
 		return x.xxx_hidden_CreatorId
@@ -304,17 +312,17 @@ func (x *MarkerMarker) SetName(v string) {
 
 func (x *MarkerMarker) SetDescription(v string) {
 	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 18)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 19)
 }
 
 func (x *MarkerMarker) SetPostal(v string) {
 	x.xxx_hidden_Postal = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 18)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 19)
 }
 
 func (x *MarkerMarker) SetColor(v string) {
 	x.xxx_hidden_Color = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 18)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 19)
 }
 
 func (x *MarkerMarker) SetJob(v string) {
@@ -327,7 +335,7 @@ func (x *MarkerMarker) SetJobLabel(v string) {
 
 func (x *MarkerMarker) SetPublic(v bool) {
 	x.xxx_hidden_Public = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 18)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 19)
 }
 
 func (x *MarkerMarker) SetType(v MarkerType) {
@@ -338,9 +346,13 @@ func (x *MarkerMarker) SetData(v *MarkerData) {
 	x.xxx_hidden_Data = v
 }
 
+func (x *MarkerMarker) SetGeofence(v *MarkerGeofence) {
+	x.xxx_hidden_Geofence = v
+}
+
 func (x *MarkerMarker) SetCreatorId(v int32) {
 	x.xxx_hidden_CreatorId = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 18)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 19)
 }
 
 func (x *MarkerMarker) SetCreator(v *short.UserShort) {
@@ -410,11 +422,18 @@ func (x *MarkerMarker) HasData() bool {
 	return x.xxx_hidden_Data != nil
 }
 
+func (x *MarkerMarker) HasGeofence() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Geofence != nil
+}
+
 func (x *MarkerMarker) HasCreatorId() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
 }
 
 func (x *MarkerMarker) HasCreator() bool {
@@ -464,8 +483,12 @@ func (x *MarkerMarker) ClearData() {
 	x.xxx_hidden_Data = nil
 }
 
+func (x *MarkerMarker) ClearGeofence() {
+	x.xxx_hidden_Geofence = nil
+}
+
 func (x *MarkerMarker) ClearCreatorId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
 	x.xxx_hidden_CreatorId = 0
 }
 
@@ -492,8 +515,10 @@ type MarkerMarker_builder struct {
 	Public      *bool
 	Type        MarkerType
 	Data        *MarkerData
-	CreatorId   *int32
-	Creator     *short.UserShort
+	// Only supported by circle and polygon markers
+	Geofence  *MarkerGeofence
+	CreatorId *int32
+	Creator   *short.UserShort
 }
 
 func (b0 MarkerMarker_builder) Build() *MarkerMarker {
@@ -509,27 +534,28 @@ func (b0 MarkerMarker_builder) Build() *MarkerMarker {
 	x.xxx_hidden_DeletedAt = b.DeletedAt
 	x.xxx_hidden_Name = b.Name
 	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 18)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 19)
 		x.xxx_hidden_Description = b.Description
 	}
 	if b.Postal != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 18)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 19)
 		x.xxx_hidden_Postal = b.Postal
 	}
 	if b.Color != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 18)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 19)
 		x.xxx_hidden_Color = b.Color
 	}
 	x.xxx_hidden_Job = b.Job
 	x.xxx_hidden_JobLabel = b.JobLabel
 	if b.Public != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 18)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 19)
 		x.xxx_hidden_Public = *b.Public
 	}
 	x.xxx_hidden_Type = b.Type
 	x.xxx_hidden_Data = b.Data
+	x.xxx_hidden_Geofence = b.Geofence
 	if b.CreatorId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 18)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 19)
 		x.xxx_hidden_CreatorId = *b.CreatorId
 	}
 	x.xxx_hidden_Creator = b.Creator
@@ -1332,7 +1358,7 @@ var File_resources_livemap_markers_marker_marker_proto protoreflect.FileDescript
 
 const file_resources_livemap_markers_marker_marker_proto_rawDesc = "" +
 	"\n" +
-	"-resources/livemap/markers/marker_marker.proto\x12\x19resources.livemap.markers\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1eresources/livemap/coords.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xc1\a\n" +
+	"-resources/livemap/markers/marker_marker.proto\x12\x19resources.livemap.markers\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1eresources/livemap/coords.proto\x1a(resources/livemap/markers/geofence.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\x9a\b\n" +
 	"\fMarkerMarker\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
@@ -1354,10 +1380,12 @@ const file_resources_livemap_markers_marker_marker_proto_rawDesc = "" +
 	"\tjob_label\x18\r \x01(\tR\bjobLabel\x12\x1b\n" +
 	"\x06public\x18\x12 \x01(\bH\aR\x06public\x88\x01\x01\x12R\n" +
 	"\x04type\x18\x0e \x01(\x0e2%.resources.livemap.markers.MarkerTypeB\x17\x9a\x84\x9e\x03\x12alias:\"markerType\"R\x04type\x12R\n" +
-	"\x04data\x18\x0f \x01(\v2%.resources.livemap.markers.MarkerDataB\x17\x9a\x84\x9e\x03\x12alias:\"markerData\"R\x04data\x12\"\n" +
+	"\x04data\x18\x0f \x01(\v2%.resources.livemap.markers.MarkerDataB\x17\x9a\x84\x9e\x03\x12alias:\"markerData\"R\x04data\x12J\n" +
+	"\bgeofence\x18\x13 \x01(\v2).resources.livemap.markers.MarkerGeofenceH\bR\bgeofence\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"creator_id\x18\x10 \x01(\x05H\bR\tcreatorId\x88\x01\x01\x12?\n" +
-	"\acreator\x18\x11 \x01(\v2 .resources.users.short.UserShortH\tR\acreator\x88\x01\x01B\r\n" +
+	"creator_id\x18\x10 \x01(\x05H\tR\tcreatorId\x88\x01\x01\x12?\n" +
+	"\acreator\x18\x11 \x01(\v2 .resources.users.short.UserShortH\n" +
+	"R\acreator\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_expires_atB\r\n" +
@@ -1365,7 +1393,8 @@ const file_resources_livemap_markers_marker_marker_proto_rawDesc = "" +
 	"\f_descriptionB\t\n" +
 	"\a_postalB\b\n" +
 	"\x06_colorB\t\n" +
-	"\a_publicB\r\n" +
+	"\a_publicB\v\n" +
+	"\t_geofenceB\r\n" +
 	"\v_creator_idB\n" +
 	"\n" +
 	"\b_creator\"\xf9\x02\n" +
@@ -1426,8 +1455,9 @@ var file_resources_livemap_markers_marker_marker_proto_goTypes = []any{
 	(*PolygonMarker)(nil),       // 6: resources.livemap.markers.PolygonMarker
 	(*PolylineMarker)(nil),      // 7: resources.livemap.markers.PolylineMarker
 	(*timestamp.Timestamp)(nil), // 8: resources.timestamp.Timestamp
-	(*short.UserShort)(nil),     // 9: resources.users.short.UserShort
-	(*livemap.Coords)(nil),      // 10: resources.livemap.Coords
+	(*MarkerGeofence)(nil),      // 9: resources.livemap.markers.MarkerGeofence
+	(*short.UserShort)(nil),     // 10: resources.users.short.UserShort
+	(*livemap.Coords)(nil),      // 11: resources.livemap.Coords
 }
 var file_resources_livemap_markers_marker_marker_proto_depIdxs = []int32{
 	8,  // 0: resources.livemap.markers.MarkerMarker.created_at:type_name -> resources.timestamp.Timestamp
@@ -1436,19 +1466,20 @@ var file_resources_livemap_markers_marker_marker_proto_depIdxs = []int32{
 	8,  // 3: resources.livemap.markers.MarkerMarker.deleted_at:type_name -> resources.timestamp.Timestamp
 	0,  // 4: resources.livemap.markers.MarkerMarker.type:type_name -> resources.livemap.markers.MarkerType
 	2,  // 5: resources.livemap.markers.MarkerMarker.data:type_name -> resources.livemap.markers.MarkerData
-	9,  // 6: resources.livemap.markers.MarkerMarker.creator:type_name -> resources.users.short.UserShort
-	3,  // 7: resources.livemap.markers.MarkerData.circle:type_name -> resources.livemap.markers.CircleMarker
-	4,  // 8: resources.livemap.markers.MarkerData.icon:type_name -> resources.livemap.markers.IconMarker
-	5,  // 9: resources.livemap.markers.MarkerData.rectangle:type_name -> resources.livemap.markers.RectangleMarker
-	6,  // 10: resources.livemap.markers.MarkerData.polygon:type_name -> resources.livemap.markers.PolygonMarker
-	7,  // 11: resources.livemap.markers.MarkerData.polyline:type_name -> resources.livemap.markers.PolylineMarker
-	10, // 12: resources.livemap.markers.PolygonMarker.points:type_name -> resources.livemap.Coords
-	10, // 13: resources.livemap.markers.PolylineMarker.points:type_name -> resources.livemap.Coords
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	9,  // 6: resources.livemap.markers.MarkerMarker.geofence:type_name -> resources.livemap.markers.MarkerGeofence
+	10, // 7: resources.livemap.markers.MarkerMarker.creator:type_name -> resources.users.short.UserShort
+	3,  // 8: resources.livemap.markers.MarkerData.circle:type_name -> resources.livemap.markers.CircleMarker
+	4,  // 9: resources.livemap.markers.MarkerData.icon:type_name -> resources.livemap.markers.IconMarker
+	5,  // 10: resources.livemap.markers.MarkerData.rectangle:type_name -> resources.livemap.markers.RectangleMarker
+	6,  // 11: resources.livemap.markers.MarkerData.polygon:type_name -> resources.livemap.markers.PolygonMarker
+	7,  // 12: resources.livemap.markers.MarkerData.polyline:type_name -> resources.livemap.markers.PolylineMarker
+	11, // 13: resources.livemap.markers.PolygonMarker.points:type_name -> resources.livemap.Coords
+	11, // 14: resources.livemap.markers.PolylineMarker.points:type_name -> resources.livemap.Coords
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_resources_livemap_markers_marker_marker_proto_init() }
@@ -1456,6 +1487,7 @@ func file_resources_livemap_markers_marker_marker_proto_init() {
 	if File_resources_livemap_markers_marker_marker_proto != nil {
 		return
 	}
+	file_resources_livemap_markers_geofence_proto_init()
 	file_resources_livemap_markers_marker_marker_proto_msgTypes[0].OneofWrappers = []any{}
 	file_resources_livemap_markers_marker_marker_proto_msgTypes[1].OneofWrappers = []any{
 		(*markerData_Circle)(nil),
@@ -1,7 +1,9 @@
 package qualificationsexam
 
 import (
+	"math/rand/v2"
 	"slices"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 )
@@ -120,3 +122,128 @@ func (e *ExamQuestions) Grade(
 
 	return earnedPoints, grading
 }
+
+// DrawQuestions selects the questions for a new exam attempt based on the pool rules and shuffles
+// the choices if enabled. Returns nil when the attempt uses all questions as they are.
+func (e *ExamQuestions) DrawQuestions(
+	settings *QualificationExamSettings,
+	rnd *rand.Rand,
+) *ExamUserQuestions {
+	if len(settings.GetPoolRules()) == 0 && !settings.GetShuffleChoices() {
+		return nil
+	}
+
+	selected := map[int64]bool{}
+	if len(settings.GetPoolRules()) == 0 {
+		for _, question := range e.GetQuestions() {
+			selected[question.GetId()] = true
+		}
+	} else {
+		// Questions without tags aren't part of a pool and are always used
+		for _, question := range e.GetQuestions() {
+			if len(question.GetTags().GetTags()) == 0 {
+				selected[question.GetId()] = true
+			}
+		}
+
+		for _, rule := range settings.GetPoolRules() {
+			pool := []int64{}
+			for _, question := range e.GetQuestions() {
+				if !selected[question.GetId()] &&
+					slices.Contains(question.GetTags().GetTags(), rule.GetTag()) {
+					pool = append(pool, question.GetId())
+				}
+			}
+
+			rnd.Shuffle(len(pool), func(i, j int) {
+				pool[i], pool[j] = pool[j], pool[i]
+			})
+			for _, id := range pool[:min(int(rule.GetCount()), len(pool))] {
+				selected[id] = true
+			}
+		}
+	}
+
+	drawn := &ExamUserQuestions{
+		Questions: []*ExamUserQuestion{},
+	}
+	// Keep the configured order of the questions
+	for _, question := range e.GetQuestions() {
+		if !selected[question.GetId()] {
+			continue
+		}
+
+		q := &ExamUserQuestion{
+			QuestionId: question.GetId(),
+		}
+		if settings.GetShuffleChoices() {
+			var choices []string
+			switch {
+			case question.GetData().GetSingleChoice() != nil:
+				choices = slices.Clone(question.GetData().GetSingleChoice().GetChoices())
+			case question.GetData().GetMultipleChoice() != nil:
+				choices = slices.Clone(question.GetData().GetMultipleChoice().GetChoices())
+			}
+
+			rnd.Shuffle(len(choices), func(i, j int) {
+				choices[i], choices[j] = choices[j], choices[i]
+			})
+			q.Choices = choices
+		}
+
+		drawn.Questions = append(drawn.Questions, q)
+	}
+
+	return drawn
+}
+
+// ForAttempt returns the questions of an exam attempt in the order and with the choices as they
+// were shown to the user. Questions that have been deleted since are skipped.
+func (e *ExamQuestions) ForAttempt(drawn *ExamUserQuestions) *ExamQuestions {
+	if drawn == nil {
+		return e
+	}
+
+	out := &ExamQuestions{
+		Questions: []*ExamQuestion{},
+	}
+	for _, dq := range drawn.GetQuestions() {
+		idx := slices.IndexFunc(e.GetQuestions(), func(q *ExamQuestion) bool {
+			return q.GetId() == dq.GetQuestionId()
+		})
+		if idx == -1 {
+			continue
+		}
+
+		question := e.GetQuestions()[idx]
+		if len(dq.GetChoices()) > 0 {
+			question = proto.Clone(question).(*ExamQuestion)
+			switch {
+			case question.GetData().GetSingleChoice() != nil:
+				question.Data.GetSingleChoice().Choices = slices.Clone(dq.GetChoices())
+			case question.GetData().GetMultipleChoice() != nil:
+				question.Data.GetMultipleChoice().Choices = slices.Clone(dq.GetChoices())
+			}
+		}
+
+		out.Questions = append(out.Questions, question)
+	}
+
+	return out
+}
+
+// AttemptsExhausted returns true when the user has failed the exam as often as it may be taken.
+func (x *QualificationExamSettings) AttemptsExhausted(failed int64) bool {
+	return x.GetMaxAttempts() > 0 && failed >= int64(x.GetMaxAttempts())
+}
+
+// NextAttemptAt returns when the exam may be retried after the last failed attempt, nil when
+// there is no retry cooldown.
+func (x *QualificationExamSettings) NextAttemptAt(lastFailedAt *time.Time) *time.Time {
+	if lastFailedAt == nil || x.GetRetryCooldown().AsDuration() <= 0 {
+		return nil
+	}
+
+	next := lastFailedAt.Add(x.GetRetryCooldown().AsDuration())
+	return &next
+}
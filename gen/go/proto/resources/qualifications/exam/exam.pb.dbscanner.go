@@ -41,6 +41,38 @@ func (x *ExamGrading) Value() (driver.Value, error) {
 	return string(out), err
 }
 
+// Scan implements driver.Valuer for protobuf ExamLogEntryData.
+func (x *ExamLogEntryData) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the ExamLogEntryData value into driver.Valuer.
+func (x *ExamLogEntryData) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
+
 // Scan implements driver.Valuer for protobuf ExamQuestionAnswerData.
 func (x *ExamQuestionAnswerData) Scan(value any) error {
 	switch t := value.(type) {
@@ -105,6 +137,38 @@ func (x *ExamQuestionData) Value() (driver.Value, error) {
 	return string(out), err
 }
 
+// Scan implements driver.Valuer for protobuf ExamQuestionTags.
+func (x *ExamQuestionTags) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the ExamQuestionTags value into driver.Valuer.
+func (x *ExamQuestionTags) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
+
 // Scan implements driver.Valuer for protobuf ExamResponses.
 func (x *ExamResponses) Scan(value any) error {
 	switch t := value.(type) {
@@ -137,6 +201,38 @@ func (x *ExamResponses) Value() (driver.Value, error) {
 	return string(out), err
 }
 
+// Scan implements driver.Valuer for protobuf ExamUserQuestions.
+func (x *ExamUserQuestions) Scan(value any) error {
+	switch t := value.(type) {
+	case string:
+		if t == "" {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(t), x)
+	case *string:
+		if t == nil {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON([]byte(*t), x)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return protoutils.UnmarshalPartialJSON(t, x)
+	}
+	return nil
+}
+
+// Value marshals the ExamUserQuestions value into driver.Valuer.
+func (x *ExamUserQuestions) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	out, err := protoutils.MarshalToJSON(x)
+	return string(out), err
+}
+
 // Scan implements driver.Valuer for protobuf QualificationExamSettings.
 func (x *QualificationExamSettings) Scan(value any) error {
 	switch t := value.(type) {
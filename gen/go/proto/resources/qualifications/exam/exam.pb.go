@@ -118,12 +118,69 @@ func (x AutoGradeMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+type ExamLogEventType int32
+
+const (
+	ExamLogEventType_EXAM_LOG_EVENT_TYPE_UNSPECIFIED  ExamLogEventType = 0
+	ExamLogEventType_EXAM_LOG_EVENT_TYPE_STARTED      ExamLogEventType = 1
+	ExamLogEventType_EXAM_LOG_EVENT_TYPE_ANSWER_SAVED ExamLogEventType = 2
+	ExamLogEventType_EXAM_LOG_EVENT_TYPE_FOCUS_LOST   ExamLogEventType = 3
+	ExamLogEventType_EXAM_LOG_EVENT_TYPE_SUBMITTED    ExamLogEventType = 4
+)
+
+// Enum value maps for ExamLogEventType.
+var (
+	ExamLogEventType_name = map[int32]string{
+		0: "EXAM_LOG_EVENT_TYPE_UNSPECIFIED",
+		1: "EXAM_LOG_EVENT_TYPE_STARTED",
+		2: "EXAM_LOG_EVENT_TYPE_ANSWER_SAVED",
+		3: "EXAM_LOG_EVENT_TYPE_FOCUS_LOST",
+		4: "EXAM_LOG_EVENT_TYPE_SUBMITTED",
+	}
+	ExamLogEventType_value = map[string]int32{
+		"EXAM_LOG_EVENT_TYPE_UNSPECIFIED":  0,
+		"EXAM_LOG_EVENT_TYPE_STARTED":      1,
+		"EXAM_LOG_EVENT_TYPE_ANSWER_SAVED": 2,
+		"EXAM_LOG_EVENT_TYPE_FOCUS_LOST":   3,
+		"EXAM_LOG_EVENT_TYPE_SUBMITTED":    4,
+	}
+)
+
+func (x ExamLogEventType) Enum() *ExamLogEventType {
+	p := new(ExamLogEventType)
+	*p = x
+	return p
+}
+
+func (x ExamLogEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExamLogEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_qualifications_exam_exam_proto_enumTypes[2].Descriptor()
+}
+
+func (ExamLogEventType) Type() protoreflect.EnumType {
+	return &file_resources_qualifications_exam_exam_proto_enumTypes[2]
+}
+
+func (x ExamLogEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type QualificationExamSettings struct {
 	state         protoimpl.MessageState `protogen:"hybrid.v1"`
 	Time          *durationpb.Duration   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
 	AutoGrade     bool                   `protobuf:"varint,2,opt,name=auto_grade,json=autoGrade,proto3" json:"auto_grade,omitempty"`
 	AutoGradeMode AutoGradeMode          `protobuf:"varint,3,opt,name=auto_grade_mode,json=autoGradeMode,proto3,enum=resources.qualifications.exam.AutoGradeMode" json:"auto_grade_mode,omitempty"`
 	MinimumPoints int32                  `protobuf:"varint,4,opt,name=minimum_points,json=minimumPoints,proto3" json:"minimum_points,omitempty"`
+	// Draw a random set of questions per attempt, questions without tags are always part of the exam
+	PoolRules      []*ExamQuestionPoolRule `protobuf:"bytes,5,rep,name=pool_rules,json=poolRules,proto3" json:"pool_rules,omitempty"`
+	ShuffleChoices bool                    `protobuf:"varint,6,opt,name=shuffle_choices,json=shuffleChoices,proto3" json:"shuffle_choices,omitempty"`
+	// Maximum amount of failed attempts, deleting a failed result grants another attempt
+	MaxAttempts *int32 `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3,oneof" json:"max_attempts,omitempty"`
+	// Time a user has to wait after a failed attempt before retrying the exam
+	RetryCooldown *durationpb.Duration `protobuf:"bytes,8,opt,name=retry_cooldown,json=retryCooldown,proto3,oneof" json:"retry_cooldown,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,6 +238,34 @@ func (x *QualificationExamSettings) GetMinimumPoints() int32 {
 	return 0
 }
 
+func (x *QualificationExamSettings) GetPoolRules() []*ExamQuestionPoolRule {
+	if x != nil {
+		return x.PoolRules
+	}
+	return nil
+}
+
+func (x *QualificationExamSettings) GetShuffleChoices() bool {
+	if x != nil {
+		return x.ShuffleChoices
+	}
+	return false
+}
+
+func (x *QualificationExamSettings) GetMaxAttempts() int32 {
+	if x != nil && x.MaxAttempts != nil {
+		return *x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *QualificationExamSettings) GetRetryCooldown() *durationpb.Duration {
+	if x != nil {
+		return x.RetryCooldown
+	}
+	return nil
+}
+
 func (x *QualificationExamSettings) SetTime(v *durationpb.Duration) {
 	x.Time = v
 }
@@ -197,6 +282,22 @@ func (x *QualificationExamSettings) SetMinimumPoints(v int32) {
 	x.MinimumPoints = v
 }
 
+func (x *QualificationExamSettings) SetPoolRules(v []*ExamQuestionPoolRule) {
+	x.PoolRules = v
+}
+
+func (x *QualificationExamSettings) SetShuffleChoices(v bool) {
+	x.ShuffleChoices = v
+}
+
+func (x *QualificationExamSettings) SetMaxAttempts(v int32) {
+	x.MaxAttempts = &v
+}
+
+func (x *QualificationExamSettings) SetRetryCooldown(v *durationpb.Duration) {
+	x.RetryCooldown = v
+}
+
 func (x *QualificationExamSettings) HasTime() bool {
 	if x == nil {
 		return false
@@ -204,10 +305,32 @@ func (x *QualificationExamSettings) HasTime() bool {
 	return x.Time != nil
 }
 
+func (x *QualificationExamSettings) HasMaxAttempts() bool {
+	if x == nil {
+		return false
+	}
+	return x.MaxAttempts != nil
+}
+
+func (x *QualificationExamSettings) HasRetryCooldown() bool {
+	if x == nil {
+		return false
+	}
+	return x.RetryCooldown != nil
+}
+
 func (x *QualificationExamSettings) ClearTime() {
 	x.Time = nil
 }
 
+func (x *QualificationExamSettings) ClearMaxAttempts() {
+	x.MaxAttempts = nil
+}
+
+func (x *QualificationExamSettings) ClearRetryCooldown() {
+	x.RetryCooldown = nil
+}
+
 type QualificationExamSettings_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -215,6 +338,13 @@ type QualificationExamSettings_builder struct {
 	AutoGrade     bool
 	AutoGradeMode AutoGradeMode
 	MinimumPoints int32
+	// Draw a random set of questions per attempt, questions without tags are always part of the exam
+	PoolRules      []*ExamQuestionPoolRule
+	ShuffleChoices bool
+	// Maximum amount of failed attempts, deleting a failed result grants another attempt
+	MaxAttempts *int32
+	// Time a user has to wait after a failed attempt before retrying the exam
+	RetryCooldown *durationpb.Duration
 }
 
 func (b0 QualificationExamSettings_builder) Build() *QualificationExamSettings {
@@ -225,6 +355,81 @@ func (b0 QualificationExamSettings_builder) Build() *QualificationExamSettings {
 	x.AutoGrade = b.AutoGrade
 	x.AutoGradeMode = b.AutoGradeMode
 	x.MinimumPoints = b.MinimumPoints
+	x.PoolRules = b.PoolRules
+	x.ShuffleChoices = b.ShuffleChoices
+	x.MaxAttempts = b.MaxAttempts
+	x.RetryCooldown = b.RetryCooldown
+	return m0
+}
+
+type ExamQuestionPoolRule struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExamQuestionPoolRule) Reset() {
+	*x = ExamQuestionPoolRule{}
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamQuestionPoolRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamQuestionPoolRule) ProtoMessage() {}
+
+func (x *ExamQuestionPoolRule) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExamQuestionPoolRule) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ExamQuestionPoolRule) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExamQuestionPoolRule) SetTag(v string) {
+	x.Tag = v
+}
+
+func (x *ExamQuestionPoolRule) SetCount(v int32) {
+	x.Count = v
+}
+
+type ExamQuestionPoolRule_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tag   string
+	Count int32
+}
+
+func (b0 ExamQuestionPoolRule_builder) Build() *ExamQuestionPoolRule {
+	m0 := &ExamQuestionPoolRule{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Tag = b.Tag
+	x.Count = b.Count
 	return m0
 }
 
@@ -237,7 +442,7 @@ type ExamQuestions struct {
 
 func (x *ExamQuestions) Reset() {
 	*x = ExamQuestions{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[1]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -249,7 +454,7 @@ func (x *ExamQuestions) String() string {
 func (*ExamQuestions) ProtoMessage() {}
 
 func (x *ExamQuestions) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[1]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,13 +502,14 @@ type ExamQuestion struct {
 	Answer          *ExamQuestionAnswerData `protobuf:"bytes,8,opt,name=answer,proto3,oneof" json:"answer,omitempty"`
 	Points          *int32                  `protobuf:"varint,9,opt,name=points,proto3,oneof" json:"points,omitempty"`
 	Order           int32                   `protobuf:"varint,10,opt,name=order,proto3" json:"order,omitempty"`
+	Tags            *ExamQuestionTags       `protobuf:"bytes,11,opt,name=tags,proto3,oneof" json:"tags,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ExamQuestion) Reset() {
 	*x = ExamQuestion{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[2]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +521,7 @@ func (x *ExamQuestion) String() string {
 func (*ExamQuestion) ProtoMessage() {}
 
 func (x *ExamQuestion) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[2]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,6 +602,13 @@ func (x *ExamQuestion) GetOrder() int32 {
 	return 0
 }
 
+func (x *ExamQuestion) GetTags() *ExamQuestionTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 func (x *ExamQuestion) SetId(v int64) {
 	x.Id = v
 }
@@ -436,6 +649,10 @@ func (x *ExamQuestion) SetOrder(v int32) {
 	x.Order = v
 }
 
+func (x *ExamQuestion) SetTags(v *ExamQuestionTags) {
+	x.Tags = v
+}
+
 func (x *ExamQuestion) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -478,6 +695,13 @@ func (x *ExamQuestion) HasPoints() bool {
 	return x.Points != nil
 }
 
+func (x *ExamQuestion) HasTags() bool {
+	if x == nil {
+		return false
+	}
+	return x.Tags != nil
+}
+
 func (x *ExamQuestion) ClearCreatedAt() {
 	x.CreatedAt = nil
 }
@@ -502,6 +726,10 @@ func (x *ExamQuestion) ClearPoints() {
 	x.Points = nil
 }
 
+func (x *ExamQuestion) ClearTags() {
+	x.Tags = nil
+}
+
 type ExamQuestion_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -515,6 +743,7 @@ type ExamQuestion_builder struct {
 	Answer          *ExamQuestionAnswerData
 	Points          *int32
 	Order           int32
+	Tags            *ExamQuestionTags
 }
 
 func (b0 ExamQuestion_builder) Build() *ExamQuestion {
@@ -531,6 +760,64 @@ func (b0 ExamQuestion_builder) Build() *ExamQuestion {
 	x.Answer = b.Answer
 	x.Points = b.Points
 	x.Order = b.Order
+	x.Tags = b.Tags
+	return m0
+}
+
+type ExamQuestionTags struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExamQuestionTags) Reset() {
+	*x = ExamQuestionTags{}
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamQuestionTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamQuestionTags) ProtoMessage() {}
+
+func (x *ExamQuestionTags) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExamQuestionTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExamQuestionTags) SetTags(v []string) {
+	x.Tags = v
+}
+
+type ExamQuestionTags_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tags []string
+}
+
+func (b0 ExamQuestionTags_builder) Build() *ExamQuestionTags {
+	m0 := &ExamQuestionTags{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Tags = b.Tags
 	return m0
 }
 
@@ -551,7 +838,7 @@ type ExamQuestionData struct {
 
 func (x *ExamQuestionData) Reset() {
 	*x = ExamQuestionData{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[3]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -563,7 +850,7 @@ func (x *ExamQuestionData) String() string {
 func (*ExamQuestionData) ProtoMessage() {}
 
 func (x *ExamQuestionData) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[3]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,7 +1136,7 @@ func (b0 ExamQuestionData_builder) Build() *ExamQuestionData {
 type case_ExamQuestionData_Data protoreflect.FieldNumber
 
 func (x case_ExamQuestionData_Data) String() string {
-	md := file_resources_qualifications_exam_exam_proto_msgTypes[3].Descriptor()
+	md := file_resources_qualifications_exam_exam_proto_msgTypes[5].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
@@ -904,7 +1191,7 @@ type ExamQuestionSeparator struct {
 
 func (x *ExamQuestionSeparator) Reset() {
 	*x = ExamQuestionSeparator{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[4]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -916,7 +1203,7 @@ func (x *ExamQuestionSeparator) String() string {
 func (*ExamQuestionSeparator) ProtoMessage() {}
 
 func (x *ExamQuestionSeparator) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[4]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1236,7 @@ type ExamQuestionImage struct {
 
 func (x *ExamQuestionImage) Reset() {
 	*x = ExamQuestionImage{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[5]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -961,7 +1248,7 @@ func (x *ExamQuestionImage) String() string {
 func (*ExamQuestionImage) ProtoMessage() {}
 
 func (x *ExamQuestionImage) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[5]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1327,7 @@ type ExamQuestionYesNo struct {
 
 func (x *ExamQuestionYesNo) Reset() {
 	*x = ExamQuestionYesNo{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[6]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1052,7 +1339,7 @@ func (x *ExamQuestionYesNo) String() string {
 func (*ExamQuestionYesNo) ProtoMessage() {}
 
 func (x *ExamQuestionYesNo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[6]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1372,7 @@ type ExamQuestionText struct {
 
 func (x *ExamQuestionText) Reset() {
 	*x = ExamQuestionText{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[7]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1097,7 +1384,7 @@ func (x *ExamQuestionText) String() string {
 func (*ExamQuestionText) ProtoMessage() {}
 
 func (x *ExamQuestionText) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[7]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,7 +1442,7 @@ type ExamQuestionSingleChoice struct {
 
 func (x *ExamQuestionSingleChoice) Reset() {
 	*x = ExamQuestionSingleChoice{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[8]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1167,7 +1454,7 @@ func (x *ExamQuestionSingleChoice) String() string {
 func (*ExamQuestionSingleChoice) ProtoMessage() {}
 
 func (x *ExamQuestionSingleChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[8]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,7 +1500,7 @@ type ExamQuestionMultipleChoice struct {
 
 func (x *ExamQuestionMultipleChoice) Reset() {
 	*x = ExamQuestionMultipleChoice{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[9]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1225,7 +1512,7 @@ func (x *ExamQuestionMultipleChoice) String() string {
 func (*ExamQuestionMultipleChoice) ProtoMessage() {}
 
 func (x *ExamQuestionMultipleChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[9]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,7 +1588,7 @@ type ExamQuestionAnswerData struct {
 
 func (x *ExamQuestionAnswerData) Reset() {
 	*x = ExamQuestionAnswerData{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[10]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1313,7 +1600,7 @@ func (x *ExamQuestionAnswerData) String() string {
 func (*ExamQuestionAnswerData) ProtoMessage() {}
 
 func (x *ExamQuestionAnswerData) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[10]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +1823,7 @@ func (b0 ExamQuestionAnswerData_builder) Build() *ExamQuestionAnswerData {
 type case_ExamQuestionAnswerData_Answer protoreflect.FieldNumber
 
 func (x case_ExamQuestionAnswerData_Answer) String() string {
-	md := file_resources_qualifications_exam_exam_proto_msgTypes[10].Descriptor()
+	md := file_resources_qualifications_exam_exam_proto_msgTypes[12].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
@@ -1579,13 +1866,15 @@ type ExamUser struct {
 	StartedAt       *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
 	EndsAt          *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`
 	EndedAt         *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Questions drawn for this attempt, unset when the attempt uses all questions
+	Questions     *ExamUserQuestions `protobuf:"bytes,7,opt,name=questions,proto3,oneof" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExamUser) Reset() {
 	*x = ExamUser{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[11]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1597,7 +1886,7 @@ func (x *ExamUser) String() string {
 func (*ExamUser) ProtoMessage() {}
 
 func (x *ExamUser) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[11]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,6 +1939,13 @@ func (x *ExamUser) GetEndedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *ExamUser) GetQuestions() *ExamUserQuestions {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
 func (x *ExamUser) SetQualificationId(v int64) {
 	x.QualificationId = v
 }
@@ -1674,6 +1970,10 @@ func (x *ExamUser) SetEndedAt(v *timestamp.Timestamp) {
 	x.EndedAt = v
 }
 
+func (x *ExamUser) SetQuestions(v *ExamUserQuestions) {
+	x.Questions = v
+}
+
 func (x *ExamUser) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -1702,6 +2002,13 @@ func (x *ExamUser) HasEndedAt() bool {
 	return x.EndedAt != nil
 }
 
+func (x *ExamUser) HasQuestions() bool {
+	if x == nil {
+		return false
+	}
+	return x.Questions != nil
+}
+
 func (x *ExamUser) ClearCreatedAt() {
 	x.CreatedAt = nil
 }
@@ -1718,6 +2025,10 @@ func (x *ExamUser) ClearEndedAt() {
 	x.EndedAt = nil
 }
 
+func (x *ExamUser) ClearQuestions() {
+	x.Questions = nil
+}
+
 type ExamUser_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1727,6 +2038,8 @@ type ExamUser_builder struct {
 	StartedAt       *timestamp.Timestamp
 	EndsAt          *timestamp.Timestamp
 	EndedAt         *timestamp.Timestamp
+	// Questions drawn for this attempt, unset when the attempt uses all questions
+	Questions *ExamUserQuestions
 }
 
 func (b0 ExamUser_builder) Build() *ExamUser {
@@ -1739,33 +2052,37 @@ func (b0 ExamUser_builder) Build() *ExamUser {
 	x.StartedAt = b.StartedAt
 	x.EndsAt = b.EndsAt
 	x.EndedAt = b.EndedAt
+	x.Questions = b.Questions
 	return m0
 }
 
-type ExamResponses struct {
+type ExamLogEntry struct {
 	state           protoimpl.MessageState `protogen:"hybrid.v1"`
-	QualificationId int64                  `protobuf:"varint,1,opt,name=qualification_id,json=qualificationId,proto3" json:"qualification_id,omitempty"`
-	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Responses       []*ExamResponse        `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	QualificationId int64                  `protobuf:"varint,3,opt,name=qualification_id,json=qualificationId,proto3" json:"qualification_id,omitempty"`
+	UserId          int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type            ExamLogEventType       `protobuf:"varint,5,opt,name=type,proto3,enum=resources.qualifications.exam.ExamLogEventType" json:"type,omitempty"`
+	Data            *ExamLogEntryData      `protobuf:"bytes,6,opt,name=data,proto3,oneof" json:"data,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ExamResponses) Reset() {
-	*x = ExamResponses{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[12]
+func (x *ExamLogEntry) Reset() {
+	*x = ExamLogEntry{}
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExamResponses) String() string {
+func (x *ExamLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamResponses) ProtoMessage() {}
+func (*ExamLogEntry) ProtoMessage() {}
 
-func (x *ExamResponses) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[12]
+func (x *ExamLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1776,25 +2093,398 @@ func (x *ExamResponses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ExamResponses) GetQualificationId() int64 {
+func (x *ExamLogEntry) GetId() int64 {
 	if x != nil {
-		return x.QualificationId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ExamResponses) GetUserId() int32 {
+func (x *ExamLogEntry) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *ExamResponses) GetResponses() []*ExamResponse {
+func (x *ExamLogEntry) GetQualificationId() int64 {
 	if x != nil {
-		return x.Responses
+		return x.QualificationId
 	}
-	return nil
+	return 0
+}
+
+func (x *ExamLogEntry) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExamLogEntry) GetType() ExamLogEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ExamLogEventType_EXAM_LOG_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ExamLogEntry) GetData() *ExamLogEntryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExamLogEntry) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *ExamLogEntry) SetCreatedAt(v *timestamp.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *ExamLogEntry) SetQualificationId(v int64) {
+	x.QualificationId = v
+}
+
+func (x *ExamLogEntry) SetUserId(v int32) {
+	x.UserId = v
+}
+
+func (x *ExamLogEntry) SetType(v ExamLogEventType) {
+	x.Type = v
+}
+
+func (x *ExamLogEntry) SetData(v *ExamLogEntryData) {
+	x.Data = v
+}
+
+func (x *ExamLogEntry) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *ExamLogEntry) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.Data != nil
+}
+
+func (x *ExamLogEntry) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *ExamLogEntry) ClearData() {
+	x.Data = nil
+}
+
+type ExamLogEntry_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id              int64
+	CreatedAt       *timestamp.Timestamp
+	QualificationId int64
+	UserId          int32
+	Type            ExamLogEventType
+	Data            *ExamLogEntryData
+}
+
+func (b0 ExamLogEntry_builder) Build() *ExamLogEntry {
+	m0 := &ExamLogEntry{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.CreatedAt = b.CreatedAt
+	x.QualificationId = b.QualificationId
+	x.UserId = b.UserId
+	x.Type = b.Type
+	x.Data = b.Data
+	return m0
+}
+
+type ExamLogEntryData struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Amount of answered questions when the responses have been saved or submitted
+	Answered *int32 `protobuf:"varint,1,opt,name=answered,proto3,oneof" json:"answered,omitempty"`
+	// How long the exam wasn't focused, as reported by the client
+	Duration      *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExamLogEntryData) Reset() {
+	*x = ExamLogEntryData{}
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamLogEntryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamLogEntryData) ProtoMessage() {}
+
+func (x *ExamLogEntryData) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExamLogEntryData) GetAnswered() int32 {
+	if x != nil && x.Answered != nil {
+		return *x.Answered
+	}
+	return 0
+}
+
+func (x *ExamLogEntryData) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ExamLogEntryData) SetAnswered(v int32) {
+	x.Answered = &v
+}
+
+func (x *ExamLogEntryData) SetDuration(v *durationpb.Duration) {
+	x.Duration = v
+}
+
+func (x *ExamLogEntryData) HasAnswered() bool {
+	if x == nil {
+		return false
+	}
+	return x.Answered != nil
+}
+
+func (x *ExamLogEntryData) HasDuration() bool {
+	if x == nil {
+		return false
+	}
+	return x.Duration != nil
+}
+
+func (x *ExamLogEntryData) ClearAnswered() {
+	x.Answered = nil
+}
+
+func (x *ExamLogEntryData) ClearDuration() {
+	x.Duration = nil
+}
+
+type ExamLogEntryData_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Amount of answered questions when the responses have been saved or submitted
+	Answered *int32
+	// How long the exam wasn't focused, as reported by the client
+	Duration *durationpb.Duration
+}
+
+func (b0 ExamLogEntryData_builder) Build() *ExamLogEntryData {
+	m0 := &ExamLogEntryData{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Answered = b.Answered
+	x.Duration = b.Duration
+	return m0
+}
+
+type ExamUserQuestions struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Questions     []*ExamUserQuestion    `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExamUserQuestions) Reset() {
+	*x = ExamUserQuestions{}
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamUserQuestions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamUserQuestions) ProtoMessage() {}
+
+func (x *ExamUserQuestions) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExamUserQuestions) GetQuestions() []*ExamUserQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ExamUserQuestions) SetQuestions(v []*ExamUserQuestion) {
+	x.Questions = v
+}
+
+type ExamUserQuestions_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Questions []*ExamUserQuestion
+}
+
+func (b0 ExamUserQuestions_builder) Build() *ExamUserQuestions {
+	m0 := &ExamUserQuestions{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Questions = b.Questions
+	return m0
+}
+
+type ExamUserQuestion struct {
+	state      protoimpl.MessageState `protogen:"hybrid.v1"`
+	QuestionId int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// Choices in the order they are shown to the user
+	Choices       []string `protobuf:"bytes,2,rep,name=choices,proto3" json:"choices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExamUserQuestion) Reset() {
+	*x = ExamUserQuestion{}
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamUserQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamUserQuestion) ProtoMessage() {}
+
+func (x *ExamUserQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExamUserQuestion) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ExamUserQuestion) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *ExamUserQuestion) SetQuestionId(v int64) {
+	x.QuestionId = v
+}
+
+func (x *ExamUserQuestion) SetChoices(v []string) {
+	x.Choices = v
+}
+
+type ExamUserQuestion_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	QuestionId int64
+	// Choices in the order they are shown to the user
+	Choices []string
+}
+
+func (b0 ExamUserQuestion_builder) Build() *ExamUserQuestion {
+	m0 := &ExamUserQuestion{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.QuestionId = b.QuestionId
+	x.Choices = b.Choices
+	return m0
+}
+
+type ExamResponses struct {
+	state           protoimpl.MessageState `protogen:"hybrid.v1"`
+	QualificationId int64                  `protobuf:"varint,1,opt,name=qualification_id,json=qualificationId,proto3" json:"qualification_id,omitempty"`
+	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Responses       []*ExamResponse        `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExamResponses) Reset() {
+	*x = ExamResponses{}
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamResponses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamResponses) ProtoMessage() {}
+
+func (x *ExamResponses) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExamResponses) GetQualificationId() int64 {
+	if x != nil {
+		return x.QualificationId
+	}
+	return 0
+}
+
+func (x *ExamResponses) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExamResponses) GetResponses() []*ExamResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
 }
 
 func (x *ExamResponses) SetQualificationId(v int64) {
@@ -1839,7 +2529,7 @@ type ExamResponse struct {
 
 func (x *ExamResponse) Reset() {
 	*x = ExamResponse{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[13]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1851,7 +2541,7 @@ func (x *ExamResponse) String() string {
 func (*ExamResponse) ProtoMessage() {}
 
 func (x *ExamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[13]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,7 +2654,7 @@ type ExamResponseData struct {
 
 func (x *ExamResponseData) Reset() {
 	*x = ExamResponseData{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[14]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1976,7 +2666,7 @@ func (x *ExamResponseData) String() string {
 func (*ExamResponseData) ProtoMessage() {}
 
 func (x *ExamResponseData) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[14]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2914,7 @@ func (b0 ExamResponseData_builder) Build() *ExamResponseData {
 type case_ExamResponseData_Response protoreflect.FieldNumber
 
 func (x case_ExamResponseData_Response) String() string {
-	md := file_resources_qualifications_exam_exam_proto_msgTypes[14].Descriptor()
+	md := file_resources_qualifications_exam_exam_proto_msgTypes[20].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
@@ -2273,7 +2963,7 @@ type ExamResponseSeparator struct {
 
 func (x *ExamResponseSeparator) Reset() {
 	*x = ExamResponseSeparator{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[15]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2285,7 +2975,7 @@ func (x *ExamResponseSeparator) String() string {
 func (*ExamResponseSeparator) ProtoMessage() {}
 
 func (x *ExamResponseSeparator) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[15]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,7 +3007,7 @@ type ExamResponseYesNo struct {
 
 func (x *ExamResponseYesNo) Reset() {
 	*x = ExamResponseYesNo{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[16]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2329,7 +3019,7 @@ func (x *ExamResponseYesNo) String() string {
 func (*ExamResponseYesNo) ProtoMessage() {}
 
 func (x *ExamResponseYesNo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[16]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2374,7 +3064,7 @@ type ExamResponseText struct {
 
 func (x *ExamResponseText) Reset() {
 	*x = ExamResponseText{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[17]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2386,7 +3076,7 @@ func (x *ExamResponseText) String() string {
 func (*ExamResponseText) ProtoMessage() {}
 
 func (x *ExamResponseText) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[17]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2431,7 +3121,7 @@ type ExamResponseSingleChoice struct {
 
 func (x *ExamResponseSingleChoice) Reset() {
 	*x = ExamResponseSingleChoice{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[18]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2443,7 +3133,7 @@ func (x *ExamResponseSingleChoice) String() string {
 func (*ExamResponseSingleChoice) ProtoMessage() {}
 
 func (x *ExamResponseSingleChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[18]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2488,7 +3178,7 @@ type ExamResponseMultipleChoice struct {
 
 func (x *ExamResponseMultipleChoice) Reset() {
 	*x = ExamResponseMultipleChoice{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[19]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2500,7 +3190,7 @@ func (x *ExamResponseMultipleChoice) String() string {
 func (*ExamResponseMultipleChoice) ProtoMessage() {}
 
 func (x *ExamResponseMultipleChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[19]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2545,7 +3235,7 @@ type ExamGrading struct {
 
 func (x *ExamGrading) Reset() {
 	*x = ExamGrading{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[20]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2557,7 +3247,7 @@ func (x *ExamGrading) String() string {
 func (*ExamGrading) ProtoMessage() {}
 
 func (x *ExamGrading) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[20]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2604,7 +3294,7 @@ type ExamGradingResponse struct {
 
 func (x *ExamGradingResponse) Reset() {
 	*x = ExamGradingResponse{}
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[21]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2616,7 +3306,7 @@ func (x *ExamGradingResponse) String() string {
 func (*ExamGradingResponse) ProtoMessage() {}
 
 func (x *ExamGradingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[21]
+	mi := &file_resources_qualifications_exam_exam_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2693,15 +3383,25 @@ var File_resources_qualifications_exam_exam_proto protoreflect.FileDescriptor
 
 const file_resources_qualifications_exam_exam_proto_rawDesc = "" +
 	"\n" +
-	"(resources/qualifications/exam/exam.proto\x12\x1dresources.qualifications.exam\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19resources/file/file.proto\x1a#resources/timestamp/timestamp.proto\"\xee\x01\n" +
+	"(resources/qualifications/exam/exam.proto\x12\x1dresources.qualifications.exam\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19resources/file/file.proto\x1a#resources/timestamp/timestamp.proto\"\xfe\x03\n" +
 	"\x19QualificationExamSettings\x12-\n" +
 	"\x04time\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x04time\x12\x1d\n" +
 	"\n" +
 	"auto_grade\x18\x02 \x01(\bR\tautoGrade\x12T\n" +
 	"\x0fauto_grade_mode\x18\x03 \x01(\x0e2,.resources.qualifications.exam.AutoGradeModeR\rautoGradeMode\x12%\n" +
-	"\x0eminimum_points\x18\x04 \x01(\x05R\rminimumPoints:\x06\xe2\xf3\x18\x02\b\x01\"Z\n" +
+	"\x0eminimum_points\x18\x04 \x01(\x05R\rminimumPoints\x12R\n" +
+	"\n" +
+	"pool_rules\x18\x05 \x03(\v23.resources.qualifications.exam.ExamQuestionPoolRuleR\tpoolRules\x12'\n" +
+	"\x0fshuffle_choices\x18\x06 \x01(\bR\x0eshuffleChoices\x12&\n" +
+	"\fmax_attempts\x18\a \x01(\x05H\x00R\vmaxAttempts\x88\x01\x01\x12E\n" +
+	"\x0eretry_cooldown\x18\b \x01(\v2\x19.google.protobuf.DurationH\x01R\rretryCooldown\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\x0f\n" +
+	"\r_max_attemptsB\x11\n" +
+	"\x0f_retry_cooldown\"H\n" +
+	"\x14ExamQuestionPoolRule\x12\x1a\n" +
+	"\x03tag\x18\x01 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x03tag\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"Z\n" +
 	"\rExamQuestions\x12I\n" +
-	"\tquestions\x18\x01 \x03(\v2+.resources.qualifications.exam.ExamQuestionR\tquestions\"\xb2\x04\n" +
+	"\tquestions\x18\x01 \x03(\v2+.resources.qualifications.exam.ExamQuestionR\tquestions\"\x85\x05\n" +
 	"\fExamQuestion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
 	"\x10qualification_id\x18\x02 \x01(\x03R\x0fqualificationId\x12B\n" +
@@ -2715,12 +3415,16 @@ const file_resources_qualifications_exam_exam_proto_rawDesc = "" +
 	"\x06answer\x18\b \x01(\v25.resources.qualifications.exam.ExamQuestionAnswerDataH\x03R\x06answer\x88\x01\x01\x12\x1b\n" +
 	"\x06points\x18\t \x01(\x05H\x04R\x06points\x88\x01\x01\x12\x14\n" +
 	"\x05order\x18\n" +
-	" \x01(\x05R\x05orderB\r\n" +
+	" \x01(\x05R\x05order\x12H\n" +
+	"\x04tags\x18\v \x01(\v2/.resources.qualifications.exam.ExamQuestionTagsH\x05R\x04tags\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\x0e\n" +
 	"\f_descriptionB\t\n" +
 	"\a_answerB\t\n" +
-	"\a_points\"\xa2\x04\n" +
+	"\a_pointsB\a\n" +
+	"\x05_tags\"8\n" +
+	"\x10ExamQuestionTags\x12\x1c\n" +
+	"\x04tags\x18\x01 \x03(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x04tags:\x06\xe2\xf3\x18\x02\b\x01\"\xa2\x04\n" +
 	"\x10ExamQuestionData\x12T\n" +
 	"\tseparator\x18\x01 \x01(\v24.resources.qualifications.exam.ExamQuestionSeparatorH\x00R\tseparator\x12H\n" +
 	"\x05image\x18\x06 \x01(\v20.resources.qualifications.exam.ExamQuestionImageH\x00R\x05image\x12H\n" +
@@ -2753,7 +3457,7 @@ const file_resources_qualifications_exam_exam_proto_rawDesc = "" +
 	"\tfree_text\x18\x05 \x01(\v2/.resources.qualifications.exam.ExamResponseTextH\x00R\bfreeText\x12^\n" +
 	"\rsingle_choice\x18\x06 \x01(\v27.resources.qualifications.exam.ExamResponseSingleChoiceH\x00R\fsingleChoice\x12d\n" +
 	"\x0fmultiple_choice\x18\a \x01(\v29.resources.qualifications.exam.ExamResponseMultipleChoiceH\x00R\x0emultipleChoice:\x06\xe2\xf3\x18\x02\b\x01B\b\n" +
-	"\x06answer\"\x8b\x03\n" +
+	"\x06answer\"\xee\x03\n" +
 	"\bExamUser\x12)\n" +
 	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12B\n" +
@@ -2762,12 +3466,36 @@ const file_resources_qualifications_exam_exam_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tstartedAt\x88\x01\x01\x12<\n" +
 	"\aends_at\x18\x05 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\x06endsAt\x88\x01\x01\x12>\n" +
-	"\bended_at\x18\x06 \x01(\v2\x1e.resources.timestamp.TimestampH\x03R\aendedAt\x88\x01\x01B\r\n" +
+	"\bended_at\x18\x06 \x01(\v2\x1e.resources.timestamp.TimestampH\x03R\aendedAt\x88\x01\x01\x12S\n" +
+	"\tquestions\x18\a \x01(\v20.resources.qualifications.exam.ExamUserQuestionsH\x04R\tquestions\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_started_atB\n" +
 	"\n" +
 	"\b_ends_atB\v\n" +
-	"\t_ended_at\"\xa6\x01\n" +
+	"\t_ended_atB\f\n" +
+	"\n" +
+	"_questions\"\xcd\x02\n" +
+	"\fExamLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12B\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12)\n" +
+	"\x10qualification_id\x18\x03 \x01(\x03R\x0fqualificationId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x05R\x06userId\x12C\n" +
+	"\x04type\x18\x05 \x01(\x0e2/.resources.qualifications.exam.ExamLogEventTypeR\x04type\x12H\n" +
+	"\x04data\x18\x06 \x01(\v2/.resources.qualifications.exam.ExamLogEntryDataH\x01R\x04data\x88\x01\x01B\r\n" +
+	"\v_created_atB\a\n" +
+	"\x05_data\"\x91\x01\n" +
+	"\x10ExamLogEntryData\x12\x1f\n" +
+	"\banswered\x18\x01 \x01(\x05H\x00R\banswered\x88\x01\x01\x12:\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x01R\bduration\x88\x01\x01:\x06\xe2\xf3\x18\x02\b\x01B\v\n" +
+	"\t_answeredB\v\n" +
+	"\t_duration\"j\n" +
+	"\x11ExamUserQuestions\x12M\n" +
+	"\tquestions\x18\x01 \x03(\v2/.resources.qualifications.exam.ExamUserQuestionR\tquestions:\x06\xe2\xf3\x18\x02\b\x01\"M\n" +
+	"\x10ExamUserQuestion\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x18\n" +
+	"\achoices\x18\x02 \x03(\tR\achoices\"\xa6\x01\n" +
 	"\rExamResponses\x12)\n" +
 	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12I\n" +
@@ -2812,76 +3540,98 @@ const file_resources_qualifications_exam_exam_proto_rawDesc = "" +
 	"\rAutoGradeMode\x12\x1f\n" +
 	"\x1bAUTO_GRADE_MODE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16AUTO_GRADE_MODE_STRICT\x10\x01\x12\"\n" +
-	"\x1eAUTO_GRADE_MODE_PARTIAL_CREDIT\x10\x02BdZbgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/exam;qualificationsexamb\x06proto3"
-
-var file_resources_qualifications_exam_exam_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_resources_qualifications_exam_exam_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+	"\x1eAUTO_GRADE_MODE_PARTIAL_CREDIT\x10\x02*\xc5\x01\n" +
+	"\x10ExamLogEventType\x12#\n" +
+	"\x1fEXAM_LOG_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bEXAM_LOG_EVENT_TYPE_STARTED\x10\x01\x12$\n" +
+	" EXAM_LOG_EVENT_TYPE_ANSWER_SAVED\x10\x02\x12\"\n" +
+	"\x1eEXAM_LOG_EVENT_TYPE_FOCUS_LOST\x10\x03\x12!\n" +
+	"\x1dEXAM_LOG_EVENT_TYPE_SUBMITTED\x10\x04BdZbgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/exam;qualificationsexamb\x06proto3"
+
+var file_resources_qualifications_exam_exam_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_resources_qualifications_exam_exam_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_resources_qualifications_exam_exam_proto_goTypes = []any{
 	(QualificationExamMode)(0),         // 0: resources.qualifications.exam.QualificationExamMode
 	(AutoGradeMode)(0),                 // 1: resources.qualifications.exam.AutoGradeMode
-	(*QualificationExamSettings)(nil),  // 2: resources.qualifications.exam.QualificationExamSettings
-	(*ExamQuestions)(nil),              // 3: resources.qualifications.exam.ExamQuestions
-	(*ExamQuestion)(nil),               // 4: resources.qualifications.exam.ExamQuestion
-	(*ExamQuestionData)(nil),           // 5: resources.qualifications.exam.ExamQuestionData
-	(*ExamQuestionSeparator)(nil),      // 6: resources.qualifications.exam.ExamQuestionSeparator
-	(*ExamQuestionImage)(nil),          // 7: resources.qualifications.exam.ExamQuestionImage
-	(*ExamQuestionYesNo)(nil),          // 8: resources.qualifications.exam.ExamQuestionYesNo
-	(*ExamQuestionText)(nil),           // 9: resources.qualifications.exam.ExamQuestionText
-	(*ExamQuestionSingleChoice)(nil),   // 10: resources.qualifications.exam.ExamQuestionSingleChoice
-	(*ExamQuestionMultipleChoice)(nil), // 11: resources.qualifications.exam.ExamQuestionMultipleChoice
-	(*ExamQuestionAnswerData)(nil),     // 12: resources.qualifications.exam.ExamQuestionAnswerData
-	(*ExamUser)(nil),                   // 13: resources.qualifications.exam.ExamUser
-	(*ExamResponses)(nil),              // 14: resources.qualifications.exam.ExamResponses
-	(*ExamResponse)(nil),               // 15: resources.qualifications.exam.ExamResponse
-	(*ExamResponseData)(nil),           // 16: resources.qualifications.exam.ExamResponseData
-	(*ExamResponseSeparator)(nil),      // 17: resources.qualifications.exam.ExamResponseSeparator
-	(*ExamResponseYesNo)(nil),          // 18: resources.qualifications.exam.ExamResponseYesNo
-	(*ExamResponseText)(nil),           // 19: resources.qualifications.exam.ExamResponseText
-	(*ExamResponseSingleChoice)(nil),   // 20: resources.qualifications.exam.ExamResponseSingleChoice
-	(*ExamResponseMultipleChoice)(nil), // 21: resources.qualifications.exam.ExamResponseMultipleChoice
-	(*ExamGrading)(nil),                // 22: resources.qualifications.exam.ExamGrading
-	(*ExamGradingResponse)(nil),        // 23: resources.qualifications.exam.ExamGradingResponse
-	(*durationpb.Duration)(nil),        // 24: google.protobuf.Duration
-	(*timestamp.Timestamp)(nil),        // 25: resources.timestamp.Timestamp
-	(*file.File)(nil),                  // 26: resources.file.File
+	(ExamLogEventType)(0),              // 2: resources.qualifications.exam.ExamLogEventType
+	(*QualificationExamSettings)(nil),  // 3: resources.qualifications.exam.QualificationExamSettings
+	(*ExamQuestionPoolRule)(nil),       // 4: resources.qualifications.exam.ExamQuestionPoolRule
+	(*ExamQuestions)(nil),              // 5: resources.qualifications.exam.ExamQuestions
+	(*ExamQuestion)(nil),               // 6: resources.qualifications.exam.ExamQuestion
+	(*ExamQuestionTags)(nil),           // 7: resources.qualifications.exam.ExamQuestionTags
+	(*ExamQuestionData)(nil),           // 8: resources.qualifications.exam.ExamQuestionData
+	(*ExamQuestionSeparator)(nil),      // 9: resources.qualifications.exam.ExamQuestionSeparator
+	(*ExamQuestionImage)(nil),          // 10: resources.qualifications.exam.ExamQuestionImage
+	(*ExamQuestionYesNo)(nil),          // 11: resources.qualifications.exam.ExamQuestionYesNo
+	(*ExamQuestionText)(nil),           // 12: resources.qualifications.exam.ExamQuestionText
+	(*ExamQuestionSingleChoice)(nil),   // 13: resources.qualifications.exam.ExamQuestionSingleChoice
+	(*ExamQuestionMultipleChoice)(nil), // 14: resources.qualifications.exam.ExamQuestionMultipleChoice
+	(*ExamQuestionAnswerData)(nil),     // 15: resources.qualifications.exam.ExamQuestionAnswerData
+	(*ExamUser)(nil),                   // 16: resources.qualifications.exam.ExamUser
+	(*ExamLogEntry)(nil),               // 17: resources.qualifications.exam.ExamLogEntry
+	(*ExamLogEntryData)(nil),           // 18: resources.qualifications.exam.ExamLogEntryData
+	(*ExamUserQuestions)(nil),          // 19: resources.qualifications.exam.ExamUserQuestions
+	(*ExamUserQuestion)(nil),           // 20: resources.qualifications.exam.ExamUserQuestion
+	(*ExamResponses)(nil),              // 21: resources.qualifications.exam.ExamResponses
+	(*ExamResponse)(nil),               // 22: resources.qualifications.exam.ExamResponse
+	(*ExamResponseData)(nil),           // 23: resources.qualifications.exam.ExamResponseData
+	(*ExamResponseSeparator)(nil),      // 24: resources.qualifications.exam.ExamResponseSeparator
+	(*ExamResponseYesNo)(nil),          // 25: resources.qualifications.exam.ExamResponseYesNo
+	(*ExamResponseText)(nil),           // 26: resources.qualifications.exam.ExamResponseText
+	(*ExamResponseSingleChoice)(nil),   // 27: resources.qualifications.exam.ExamResponseSingleChoice
+	(*ExamResponseMultipleChoice)(nil), // 28: resources.qualifications.exam.ExamResponseMultipleChoice
+	(*ExamGrading)(nil),                // 29: resources.qualifications.exam.ExamGrading
+	(*ExamGradingResponse)(nil),        // 30: resources.qualifications.exam.ExamGradingResponse
+	(*durationpb.Duration)(nil),        // 31: google.protobuf.Duration
+	(*timestamp.Timestamp)(nil),        // 32: resources.timestamp.Timestamp
+	(*file.File)(nil),                  // 33: resources.file.File
 }
 var file_resources_qualifications_exam_exam_proto_depIdxs = []int32{
-	24, // 0: resources.qualifications.exam.QualificationExamSettings.time:type_name -> google.protobuf.Duration
+	31, // 0: resources.qualifications.exam.QualificationExamSettings.time:type_name -> google.protobuf.Duration
 	1,  // 1: resources.qualifications.exam.QualificationExamSettings.auto_grade_mode:type_name -> resources.qualifications.exam.AutoGradeMode
-	4,  // 2: resources.qualifications.exam.ExamQuestions.questions:type_name -> resources.qualifications.exam.ExamQuestion
-	25, // 3: resources.qualifications.exam.ExamQuestion.created_at:type_name -> resources.timestamp.Timestamp
-	25, // 4: resources.qualifications.exam.ExamQuestion.updated_at:type_name -> resources.timestamp.Timestamp
-	5,  // 5: resources.qualifications.exam.ExamQuestion.data:type_name -> resources.qualifications.exam.ExamQuestionData
-	12, // 6: resources.qualifications.exam.ExamQuestion.answer:type_name -> resources.qualifications.exam.ExamQuestionAnswerData
-	6,  // 7: resources.qualifications.exam.ExamQuestionData.separator:type_name -> resources.qualifications.exam.ExamQuestionSeparator
-	7,  // 8: resources.qualifications.exam.ExamQuestionData.image:type_name -> resources.qualifications.exam.ExamQuestionImage
-	8,  // 9: resources.qualifications.exam.ExamQuestionData.yesno:type_name -> resources.qualifications.exam.ExamQuestionYesNo
-	9,  // 10: resources.qualifications.exam.ExamQuestionData.free_text:type_name -> resources.qualifications.exam.ExamQuestionText
-	10, // 11: resources.qualifications.exam.ExamQuestionData.single_choice:type_name -> resources.qualifications.exam.ExamQuestionSingleChoice
-	11, // 12: resources.qualifications.exam.ExamQuestionData.multiple_choice:type_name -> resources.qualifications.exam.ExamQuestionMultipleChoice
-	26, // 13: resources.qualifications.exam.ExamQuestionImage.image:type_name -> resources.file.File
-	18, // 14: resources.qualifications.exam.ExamQuestionAnswerData.yesno:type_name -> resources.qualifications.exam.ExamResponseYesNo
-	19, // 15: resources.qualifications.exam.ExamQuestionAnswerData.free_text:type_name -> resources.qualifications.exam.ExamResponseText
-	20, // 16: resources.qualifications.exam.ExamQuestionAnswerData.single_choice:type_name -> resources.qualifications.exam.ExamResponseSingleChoice
-	21, // 17: resources.qualifications.exam.ExamQuestionAnswerData.multiple_choice:type_name -> resources.qualifications.exam.ExamResponseMultipleChoice
-	25, // 18: resources.qualifications.exam.ExamUser.created_at:type_name -> resources.timestamp.Timestamp
-	25, // 19: resources.qualifications.exam.ExamUser.started_at:type_name -> resources.timestamp.Timestamp
-	25, // 20: resources.qualifications.exam.ExamUser.ends_at:type_name -> resources.timestamp.Timestamp
-	25, // 21: resources.qualifications.exam.ExamUser.ended_at:type_name -> resources.timestamp.Timestamp
-	15, // 22: resources.qualifications.exam.ExamResponses.responses:type_name -> resources.qualifications.exam.ExamResponse
-	4,  // 23: resources.qualifications.exam.ExamResponse.question:type_name -> resources.qualifications.exam.ExamQuestion
-	16, // 24: resources.qualifications.exam.ExamResponse.response:type_name -> resources.qualifications.exam.ExamResponseData
-	17, // 25: resources.qualifications.exam.ExamResponseData.separator:type_name -> resources.qualifications.exam.ExamResponseSeparator
-	18, // 26: resources.qualifications.exam.ExamResponseData.yesno:type_name -> resources.qualifications.exam.ExamResponseYesNo
-	19, // 27: resources.qualifications.exam.ExamResponseData.free_text:type_name -> resources.qualifications.exam.ExamResponseText
-	20, // 28: resources.qualifications.exam.ExamResponseData.single_choice:type_name -> resources.qualifications.exam.ExamResponseSingleChoice
-	21, // 29: resources.qualifications.exam.ExamResponseData.multiple_choice:type_name -> resources.qualifications.exam.ExamResponseMultipleChoice
-	23, // 30: resources.qualifications.exam.ExamGrading.responses:type_name -> resources.qualifications.exam.ExamGradingResponse
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	4,  // 2: resources.qualifications.exam.QualificationExamSettings.pool_rules:type_name -> resources.qualifications.exam.ExamQuestionPoolRule
+	31, // 3: resources.qualifications.exam.QualificationExamSettings.retry_cooldown:type_name -> google.protobuf.Duration
+	6,  // 4: resources.qualifications.exam.ExamQuestions.questions:type_name -> resources.qualifications.exam.ExamQuestion
+	32, // 5: resources.qualifications.exam.ExamQuestion.created_at:type_name -> resources.timestamp.Timestamp
+	32, // 6: resources.qualifications.exam.ExamQuestion.updated_at:type_name -> resources.timestamp.Timestamp
+	8,  // 7: resources.qualifications.exam.ExamQuestion.data:type_name -> resources.qualifications.exam.ExamQuestionData
+	15, // 8: resources.qualifications.exam.ExamQuestion.answer:type_name -> resources.qualifications.exam.ExamQuestionAnswerData
+	7,  // 9: resources.qualifications.exam.ExamQuestion.tags:type_name -> resources.qualifications.exam.ExamQuestionTags
+	9,  // 10: resources.qualifications.exam.ExamQuestionData.separator:type_name -> resources.qualifications.exam.ExamQuestionSeparator
+	10, // 11: resources.qualifications.exam.ExamQuestionData.image:type_name -> resources.qualifications.exam.ExamQuestionImage
+	11, // 12: resources.qualifications.exam.ExamQuestionData.yesno:type_name -> resources.qualifications.exam.ExamQuestionYesNo
+	12, // 13: resources.qualifications.exam.ExamQuestionData.free_text:type_name -> resources.qualifications.exam.ExamQuestionText
+	13, // 14: resources.qualifications.exam.ExamQuestionData.single_choice:type_name -> resources.qualifications.exam.ExamQuestionSingleChoice
+	14, // 15: resources.qualifications.exam.ExamQuestionData.multiple_choice:type_name -> resources.qualifications.exam.ExamQuestionMultipleChoice
+	33, // 16: resources.qualifications.exam.ExamQuestionImage.image:type_name -> resources.file.File
+	25, // 17: resources.qualifications.exam.ExamQuestionAnswerData.yesno:type_name -> resources.qualifications.exam.ExamResponseYesNo
+	26, // 18: resources.qualifications.exam.ExamQuestionAnswerData.free_text:type_name -> resources.qualifications.exam.ExamResponseText
+	27, // 19: resources.qualifications.exam.ExamQuestionAnswerData.single_choice:type_name -> resources.qualifications.exam.ExamResponseSingleChoice
+	28, // 20: resources.qualifications.exam.ExamQuestionAnswerData.multiple_choice:type_name -> resources.qualifications.exam.ExamResponseMultipleChoice
+	32, // 21: resources.qualifications.exam.ExamUser.created_at:type_name -> resources.timestamp.Timestamp
+	32, // 22: resources.qualifications.exam.ExamUser.started_at:type_name -> resources.timestamp.Timestamp
+	32, // 23: resources.qualifications.exam.ExamUser.ends_at:type_name -> resources.timestamp.Timestamp
+	32, // 24: resources.qualifications.exam.ExamUser.ended_at:type_name -> resources.timestamp.Timestamp
+	19, // 25: resources.qualifications.exam.ExamUser.questions:type_name -> resources.qualifications.exam.ExamUserQuestions
+	32, // 26: resources.qualifications.exam.ExamLogEntry.created_at:type_name -> resources.timestamp.Timestamp
+	2,  // 27: resources.qualifications.exam.ExamLogEntry.type:type_name -> resources.qualifications.exam.ExamLogEventType
+	18, // 28: resources.qualifications.exam.ExamLogEntry.data:type_name -> resources.qualifications.exam.ExamLogEntryData
+	31, // 29: resources.qualifications.exam.ExamLogEntryData.duration:type_name -> google.protobuf.Duration
+	20, // 30: resources.qualifications.exam.ExamUserQuestions.questions:type_name -> resources.qualifications.exam.ExamUserQuestion
+	22, // 31: resources.qualifications.exam.ExamResponses.responses:type_name -> resources.qualifications.exam.ExamResponse
+	6,  // 32: resources.qualifications.exam.ExamResponse.question:type_name -> resources.qualifications.exam.ExamQuestion
+	23, // 33: resources.qualifications.exam.ExamResponse.response:type_name -> resources.qualifications.exam.ExamResponseData
+	24, // 34: resources.qualifications.exam.ExamResponseData.separator:type_name -> resources.qualifications.exam.ExamResponseSeparator
+	25, // 35: resources.qualifications.exam.ExamResponseData.yesno:type_name -> resources.qualifications.exam.ExamResponseYesNo
+	26, // 36: resources.qualifications.exam.ExamResponseData.free_text:type_name -> resources.qualifications.exam.ExamResponseText
+	27, // 37: resources.qualifications.exam.ExamResponseData.single_choice:type_name -> resources.qualifications.exam.ExamResponseSingleChoice
+	28, // 38: resources.qualifications.exam.ExamResponseData.multiple_choice:type_name -> resources.qualifications.exam.ExamResponseMultipleChoice
+	30, // 39: resources.qualifications.exam.ExamGrading.responses:type_name -> resources.qualifications.exam.ExamGradingResponse
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_resources_qualifications_exam_exam_proto_init() }
@@ -2889,8 +3639,9 @@ func file_resources_qualifications_exam_exam_proto_init() {
 	if File_resources_qualifications_exam_exam_proto != nil {
 		return
 	}
-	file_resources_qualifications_exam_exam_proto_msgTypes[2].OneofWrappers = []any{}
-	file_resources_qualifications_exam_exam_proto_msgTypes[3].OneofWrappers = []any{
+	file_resources_qualifications_exam_exam_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[3].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[5].OneofWrappers = []any{
 		(*ExamQuestionData_Separator)(nil),
 		(*ExamQuestionData_Image)(nil),
 		(*ExamQuestionData_Yesno)(nil),
@@ -2898,30 +3649,32 @@ func file_resources_qualifications_exam_exam_proto_init() {
 		(*ExamQuestionData_SingleChoice)(nil),
 		(*ExamQuestionData_MultipleChoice)(nil),
 	}
-	file_resources_qualifications_exam_exam_proto_msgTypes[5].OneofWrappers = []any{}
-	file_resources_qualifications_exam_exam_proto_msgTypes[9].OneofWrappers = []any{}
-	file_resources_qualifications_exam_exam_proto_msgTypes[10].OneofWrappers = []any{
+	file_resources_qualifications_exam_exam_proto_msgTypes[7].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[11].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[12].OneofWrappers = []any{
 		(*ExamQuestionAnswerData_Yesno)(nil),
 		(*ExamQuestionAnswerData_FreeText)(nil),
 		(*ExamQuestionAnswerData_SingleChoice)(nil),
 		(*ExamQuestionAnswerData_MultipleChoice)(nil),
 	}
-	file_resources_qualifications_exam_exam_proto_msgTypes[11].OneofWrappers = []any{}
-	file_resources_qualifications_exam_exam_proto_msgTypes[14].OneofWrappers = []any{
+	file_resources_qualifications_exam_exam_proto_msgTypes[13].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[14].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[15].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[20].OneofWrappers = []any{
 		(*ExamResponseData_Separator)(nil),
 		(*ExamResponseData_Yesno)(nil),
 		(*ExamResponseData_FreeText)(nil),
 		(*ExamResponseData_SingleChoice)(nil),
 		(*ExamResponseData_MultipleChoice)(nil),
 	}
-	file_resources_qualifications_exam_exam_proto_msgTypes[21].OneofWrappers = []any{}
+	file_resources_qualifications_exam_exam_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_qualifications_exam_exam_proto_rawDesc), len(file_resources_qualifications_exam_exam_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -29,6 +29,53 @@ func (m *ExamGrading) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExamLogEntry) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: CreatedAt
+	if m.CreatedAt != nil {
+		if v, ok := any(m.GetCreatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Data
+	if m.Data != nil {
+		if v, ok := any(m.GetData()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExamLogEntryData) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Duration
+	if m.Duration != nil {
+		if v, ok := any(m.GetDuration()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ExamQuestion) Sanitize() error {
@@ -68,6 +115,15 @@ func (m *ExamQuestion) Sanitize() error {
 		*m.Description = htmlsanitizer.StripHTMLTags(*m.Description)
 	}
 
+	// Field: Tags
+	if m.Tags != nil {
+		if v, ok := any(m.GetTags()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: Title
 	m.Title = htmlsanitizer.StripHTMLTags(m.Title)
 
@@ -266,6 +322,19 @@ func (m *ExamQuestionMultipleChoice) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExamQuestionPoolRule) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Tag
+	m.Tag = htmlsanitizer.StripHTMLTags(m.Tag)
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ExamQuestionSingleChoice) Sanitize() error {
@@ -284,6 +353,24 @@ func (m *ExamQuestionSingleChoice) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExamQuestionTags) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Tags
+	for idx, item := range m.Tags {
+		_, _ = idx, item
+
+		m.Tags[idx] = htmlsanitizer.StripHTMLTags(m.Tags[idx])
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ExamQuestions) Sanitize() error {
@@ -503,6 +590,15 @@ func (m *ExamUser) Sanitize() error {
 		}
 	}
 
+	// Field: Questions
+	if m.Questions != nil {
+		if v, ok := any(m.GetQuestions()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: StartedAt
 	if m.StartedAt != nil {
 		if v, ok := any(m.GetStartedAt()).(interface{ Sanitize() error }); ok {
@@ -515,6 +611,46 @@ func (m *ExamUser) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExamUserQuestion) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Choices
+	for idx, item := range m.Choices {
+		_, _ = idx, item
+
+		m.Choices[idx] = htmlsanitizer.SanitizeAndUnescape(m.Choices[idx])
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExamUserQuestions) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Questions
+	for idx, item := range m.Questions {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *QualificationExamSettings) Sanitize() error {
@@ -522,6 +658,27 @@ func (m *QualificationExamSettings) Sanitize() error {
 		return nil
 	}
 
+	// Field: PoolRules
+	for idx, item := range m.PoolRules {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: RetryCooldown
+	if m.RetryCooldown != nil {
+		if v, ok := any(m.GetRetryCooldown()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: Time
 	if m.Time != nil {
 		if v, ok := any(m.GetTime()).(interface{ Sanitize() error }); ok {
@@ -1,10 +1,15 @@
 package qualificationsexam
 
 import (
+	"math/rand/v2"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestGrade(t *testing.T) {
@@ -109,3 +114,135 @@ func TestGrade(t *testing.T) {
 		"Expected grading responses to be equal to the number of questions",
 	)
 }
+
+func TestDrawQuestions(t *testing.T) {
+	t.Parallel()
+
+	tagged := func(id int64, tag string) *ExamQuestion {
+		return &ExamQuestion{
+			Id: id,
+			Data: &ExamQuestionData{
+				Data: &ExamQuestionData_SingleChoice{
+					SingleChoice: &ExamQuestionSingleChoice{
+						Choices: []string{"A", "B", "C", "D"},
+					},
+				},
+			},
+			Tags: &ExamQuestionTags{Tags: []string{tag}},
+		}
+	}
+
+	questions := &ExamQuestions{
+		Questions: []*ExamQuestion{
+			{
+				Id: 1,
+				Data: &ExamQuestionData{
+					Data: &ExamQuestionData_Separator{
+						Separator: &ExamQuestionSeparator{},
+					},
+				},
+			},
+			tagged(2, "traffic"),
+			tagged(3, "traffic"),
+			tagged(4, "traffic"),
+			tagged(5, "law"),
+			tagged(6, "law"),
+			tagged(7, "other"),
+		},
+	}
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	assert.Nil(t, questions.DrawQuestions(&QualificationExamSettings{}, rnd))
+
+	drawn := questions.DrawQuestions(&QualificationExamSettings{
+		PoolRules: []*ExamQuestionPoolRule{
+			{Tag: "traffic", Count: 2},
+			{Tag: "law", Count: 5},
+		},
+		ShuffleChoices: true,
+	}, rnd)
+	require.NotNil(t, drawn)
+	require.Len(t, drawn.GetQuestions(), 5)
+
+	ids := []int64{}
+	traffic := 0
+	for _, q := range drawn.GetQuestions() {
+		ids = append(ids, q.GetQuestionId())
+		if q.GetQuestionId() >= 2 && q.GetQuestionId() <= 4 {
+			traffic++
+		}
+	}
+	// Untagged questions are always part of the exam, pools are capped by their size
+	assert.Contains(t, ids, int64(1))
+	assert.Contains(t, ids, int64(5))
+	assert.Contains(t, ids, int64(6))
+	assert.NotContains(t, ids, int64(7))
+	assert.Equal(t, 2, traffic)
+	assert.True(t, slices.IsSorted(ids), "Expected configured question order to be kept")
+
+	assert.Empty(t, drawn.GetQuestions()[0].GetChoices())
+	assert.ElementsMatch(t, []string{"A", "B", "C", "D"}, drawn.GetQuestions()[1].GetChoices())
+
+	attempt := questions.ForAttempt(drawn)
+	require.Len(t, attempt.GetQuestions(), 5)
+	assert.Equal(
+		t,
+		drawn.GetQuestions()[1].GetChoices(),
+		attempt.GetQuestions()[1].GetData().GetSingleChoice().GetChoices(),
+	)
+	// The exam questions themselves are left untouched
+	assert.Equal(
+		t,
+		[]string{"A", "B", "C", "D"},
+		questions.GetQuestions()[1].GetData().GetSingleChoice().GetChoices(),
+	)
+}
+
+func TestForAttempt(t *testing.T) {
+	t.Parallel()
+
+	questions := &ExamQuestions{
+		Questions: []*ExamQuestion{
+			{Id: 1, Points: proto.Int32(10)},
+			{Id: 2, Points: proto.Int32(10)},
+			{Id: 3, Points: proto.Int32(10)},
+		},
+	}
+
+	assert.Same(t, questions, questions.ForAttempt(nil))
+
+	// Deleted questions are skipped
+	attempt := questions.ForAttempt(&ExamUserQuestions{
+		Questions: []*ExamUserQuestion{
+			{QuestionId: 3},
+			{QuestionId: 4},
+			{QuestionId: 1},
+		},
+	})
+	require.Len(t, attempt.GetQuestions(), 2)
+	assert.Equal(t, int64(3), attempt.GetQuestions()[0].GetId())
+	assert.Equal(t, int64(1), attempt.GetQuestions()[1].GetId())
+}
+
+func TestExamAttempts(t *testing.T) {
+	t.Parallel()
+
+	lastFailedAt := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	var unlimited *QualificationExamSettings
+	assert.False(t, unlimited.AttemptsExhausted(100))
+	assert.Nil(t, unlimited.NextAttemptAt(&lastFailedAt))
+
+	settings := &QualificationExamSettings{
+		MaxAttempts:   proto.Int32(3),
+		RetryCooldown: durationpb.New(24 * time.Hour),
+	}
+	assert.False(t, settings.AttemptsExhausted(2))
+	assert.True(t, settings.AttemptsExhausted(3))
+
+	// No failed attempt, no cooldown
+	assert.Nil(t, settings.NextAttemptAt(nil))
+	nextAttemptAt := settings.NextAttemptAt(&lastFailedAt)
+	require.NotNil(t, nextAttemptAt)
+	assert.Equal(t, lastFailedAt.Add(24*time.Hour), *nextAttemptAt)
+}
@@ -94,6 +94,7 @@ const (
 	ResultStatus_RESULT_STATUS_PENDING     ResultStatus = 1
 	ResultStatus_RESULT_STATUS_FAILED      ResultStatus = 2
 	ResultStatus_RESULT_STATUS_SUCCESSFUL  ResultStatus = 3
+	ResultStatus_RESULT_STATUS_EXPIRED     ResultStatus = 4
 )
 
 // Enum value maps for ResultStatus.
@@ -103,12 +104,14 @@ var (
 		1: "RESULT_STATUS_PENDING",
 		2: "RESULT_STATUS_FAILED",
 		3: "RESULT_STATUS_SUCCESSFUL",
+		4: "RESULT_STATUS_EXPIRED",
 	}
 	ResultStatus_value = map[string]int32{
 		"RESULT_STATUS_UNSPECIFIED": 0,
 		"RESULT_STATUS_PENDING":     1,
 		"RESULT_STATUS_FAILED":      2,
 		"RESULT_STATUS_SUCCESSFUL":  3,
+		"RESULT_STATUS_EXPIRED":     4,
 	}
 )
 
@@ -164,6 +167,8 @@ type Qualification struct {
 	LabelSyncEnabled   bool                            `protobuf:"varint,26,opt,name=label_sync_enabled,json=labelSyncEnabled,proto3" json:"label_sync_enabled,omitempty"`
 	LabelSyncFormat    *string                         `protobuf:"bytes,27,opt,name=label_sync_format,json=labelSyncFormat,proto3,oneof" json:"label_sync_format,omitempty"`
 	Files              []*file.File                    `protobuf:"bytes,28,rep,name=files,proto3" json:"files,omitempty" alias:"files"`
+	ValidityDays       *int32                          `protobuf:"varint,29,opt,name=validity_days,json=validityDays,proto3,oneof" json:"validity_days,omitempty"`
+	GracePeriodDays    *int32                          `protobuf:"varint,30,opt,name=grace_period_days,json=gracePeriodDays,proto3,oneof" json:"grace_period_days,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -389,6 +394,20 @@ func (x *Qualification) GetFiles() []*file.File {
 	return nil
 }
 
+func (x *Qualification) GetValidityDays() int32 {
+	if x != nil && x.ValidityDays != nil {
+		return *x.ValidityDays
+	}
+	return 0
+}
+
+func (x *Qualification) GetGracePeriodDays() int32 {
+	if x != nil && x.GracePeriodDays != nil {
+		return *x.GracePeriodDays
+	}
+	return 0
+}
+
 func (x *Qualification) SetId(v int64) {
 	x.Id = v
 }
@@ -501,6 +520,14 @@ func (x *Qualification) SetFiles(v []*file.File) {
 	x.Files = v
 }
 
+func (x *Qualification) SetValidityDays(v int32) {
+	x.ValidityDays = &v
+}
+
+func (x *Qualification) SetGracePeriodDays(v int32) {
+	x.GracePeriodDays = &v
+}
+
 func (x *Qualification) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -599,6 +626,20 @@ func (x *Qualification) HasLabelSyncFormat() bool {
 	return x.LabelSyncFormat != nil
 }
 
+func (x *Qualification) HasValidityDays() bool {
+	if x == nil {
+		return false
+	}
+	return x.ValidityDays != nil
+}
+
+func (x *Qualification) HasGracePeriodDays() bool {
+	if x == nil {
+		return false
+	}
+	return x.GracePeriodDays != nil
+}
+
 func (x *Qualification) ClearCreatedAt() {
 	x.CreatedAt = nil
 }
@@ -655,6 +696,14 @@ func (x *Qualification) ClearLabelSyncFormat() {
 	x.LabelSyncFormat = nil
 }
 
+func (x *Qualification) ClearValidityDays() {
+	x.ValidityDays = nil
+}
+
+func (x *Qualification) ClearGracePeriodDays() {
+	x.GracePeriodDays = nil
+}
+
 type Qualification_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -686,6 +735,8 @@ type Qualification_builder struct {
 	LabelSyncEnabled   bool
 	LabelSyncFormat    *string
 	Files              []*file.File
+	ValidityDays       *int32
+	GracePeriodDays    *int32
 }
 
 func (b0 Qualification_builder) Build() *Qualification {
@@ -720,6 +771,8 @@ func (b0 Qualification_builder) Build() *Qualification {
 	x.LabelSyncEnabled = b.LabelSyncEnabled
 	x.LabelSyncFormat = b.LabelSyncFormat
 	x.Files = b.Files
+	x.ValidityDays = b.ValidityDays
+	x.GracePeriodDays = b.GracePeriodDays
 	return m0
 }
 
@@ -1709,6 +1762,7 @@ type QualificationResult struct {
 	CreatorId       int32                  `protobuf:"varint,11,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
 	Creator         *short.UserShort       `protobuf:"bytes,12,opt,name=creator,proto3" json:"creator,omitempty" alias:"creator"`
 	CreatorJob      string                 `protobuf:"bytes,13,opt,name=creator_job,json=creatorJob,proto3" json:"creator_job,omitempty"`
+	ExpiresAt       *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1829,6 +1883,13 @@ func (x *QualificationResult) GetCreatorJob() string {
 	return ""
 }
 
+func (x *QualificationResult) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 func (x *QualificationResult) SetId(v int64) {
 	x.Id = v
 }
@@ -1881,6 +1942,10 @@ func (x *QualificationResult) SetCreatorJob(v string) {
 	x.CreatorJob = v
 }
 
+func (x *QualificationResult) SetExpiresAt(v *timestamp.Timestamp) {
+	x.ExpiresAt = v
+}
+
 func (x *QualificationResult) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -1923,6 +1988,13 @@ func (x *QualificationResult) HasCreator() bool {
 	return x.Creator != nil
 }
 
+func (x *QualificationResult) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
 func (x *QualificationResult) ClearCreatedAt() {
 	x.CreatedAt = nil
 }
@@ -1947,6 +2019,10 @@ func (x *QualificationResult) ClearCreator() {
 	x.Creator = nil
 }
 
+func (x *QualificationResult) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
 type QualificationResult_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1963,6 +2039,7 @@ type QualificationResult_builder struct {
 	CreatorId       int32
 	Creator         *short.UserShort
 	CreatorJob      string
+	ExpiresAt       *timestamp.Timestamp
 }
 
 func (b0 QualificationResult_builder) Build() *QualificationResult {
@@ -1982,6 +2059,7 @@ func (b0 QualificationResult_builder) Build() *QualificationResult {
 	x.CreatorId = b.CreatorId
 	x.Creator = b.Creator
 	x.CreatorJob = b.CreatorJob
+	x.ExpiresAt = b.ExpiresAt
 	return m0
 }
 
@@ -1989,7 +2067,7 @@ var File_resources_qualifications_qualifications_proto protoreflect.FileDescript
 
 const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\n" +
-	"-resources/qualifications/qualifications.proto\x12\x18resources.qualifications\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a&resources/common/content/content.proto\x1a\x19resources/file/file.proto\x1a(resources/qualifications/exam/exam.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xef\r\n" +
+	"-resources/qualifications/qualifications.proto\x12\x18resources.qualifications\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a&resources/common/content/content.proto\x1a\x19resources/file/file.proto\x1a(resources/qualifications/exam/exam.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xf2\x0e\n" +
 	"\rQualification\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -2025,7 +2103,9 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"R\arequest\x88\x01\x01\x12,\n" +
 	"\x12label_sync_enabled\x18\x1a \x01(\bR\x10labelSyncEnabled\x129\n" +
 	"\x11label_sync_format\x18\x1b \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\vR\x0flabelSyncFormat\x88\x01\x01\x12>\n" +
-	"\x05files\x18\x1c \x03(\v2\x14.resources.file.FileB\x12\x9a\x84\x9e\x03\ralias:\"files\"R\x05filesB\r\n" +
+	"\x05files\x18\x1c \x03(\v2\x14.resources.file.FileB\x12\x9a\x84\x9e\x03\ralias:\"files\"R\x05files\x12(\n" +
+	"\rvalidity_days\x18\x1d \x01(\x05H\fR\fvalidityDays\x88\x01\x01\x12/\n" +
+	"\x11grace_period_days\x18\x1e \x01(\x05H\rR\x0fgracePeriodDays\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_deleted_atB\x0e\n" +
@@ -2039,7 +2119,9 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\a_resultB\n" +
 	"\n" +
 	"\b_requestB\x14\n" +
-	"\x12_label_sync_format\"\xec\b\n" +
+	"\x12_label_sync_formatB\x10\n" +
+	"\x0e_validity_daysB\x14\n" +
+	"\x12_grace_period_days\"\xec\b\n" +
 	"\x12QualificationShort\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -2119,7 +2201,7 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\x11_approver_commentB\x0e\n" +
 	"\f_approver_idB\v\n" +
 	"\t_approverB\x0f\n" +
-	"\r_approver_job\"\x81\x06\n" +
+	"\r_approver_job\"\xd4\x06\n" +
 	"\x13QualificationResult\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -2138,11 +2220,14 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"creator_id\x18\v \x01(\x05R\tcreatorId\x12P\n" +
 	"\acreator\x18\f \x01(\v2 .resources.users.short.UserShortB\x14\x9a\x84\x9e\x03\x0falias:\"creator\"R\acreator\x12\x1f\n" +
 	"\vcreator_job\x18\r \x01(\tR\n" +
-	"creatorJobB\r\n" +
+	"creatorJob\x12B\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\texpiresAt\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_deleted_atB\x10\n" +
 	"\x0e_qualificationB\b\n" +
-	"\x06_score*\xe3\x01\n" +
+	"\x06_scoreB\r\n" +
+	"\v_expires_at*\xe3\x01\n" +
 	"\rRequestStatus\x12\x1e\n" +
 	"\x1aREQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16REQUEST_STATUS_PENDING\x10\x01\x12\x19\n" +
@@ -2150,12 +2235,13 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\x17REQUEST_STATUS_ACCEPTED\x10\x03\x12\x1f\n" +
 	"\x1bREQUEST_STATUS_EXAM_STARTED\x10\x04\x12\x1f\n" +
 	"\x1bREQUEST_STATUS_EXAM_GRADING\x10\x05\x12\x1c\n" +
-	"\x18REQUEST_STATUS_COMPLETED\x10\x06*\x80\x01\n" +
+	"\x18REQUEST_STATUS_COMPLETED\x10\x06*\x9b\x01\n" +
 	"\fResultStatus\x12\x1d\n" +
 	"\x19RESULT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15RESULT_STATUS_PENDING\x10\x01\x12\x18\n" +
 	"\x14RESULT_STATUS_FAILED\x10\x02\x12\x1c\n" +
-	"\x18RESULT_STATUS_SUCCESSFUL\x10\x03B[ZYgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications;qualificationsb\x06proto3"
+	"\x18RESULT_STATUS_SUCCESSFUL\x10\x03\x12\x19\n" +
+	"\x15RESULT_STATUS_EXPIRED\x10\x04B[ZYgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications;qualificationsb\x06proto3"
 
 var file_resources_qualifications_qualifications_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_resources_qualifications_qualifications_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
@@ -2215,11 +2301,12 @@ var file_resources_qualifications_qualifications_proto_depIdxs = []int32{
 	10, // 34: resources.qualifications.QualificationResult.user:type_name -> resources.users.short.UserShort
 	1,  // 35: resources.qualifications.QualificationResult.status:type_name -> resources.qualifications.ResultStatus
 	10, // 36: resources.qualifications.QualificationResult.creator:type_name -> resources.users.short.UserShort
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	8,  // 37: resources.qualifications.QualificationResult.expires_at:type_name -> resources.timestamp.Timestamp
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_resources_qualifications_qualifications_proto_init() }
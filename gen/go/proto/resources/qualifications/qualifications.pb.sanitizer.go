@@ -326,6 +326,15 @@ func (m *QualificationResult) Sanitize() error {
 		}
 	}
 
+	// Field: ExpiresAt
+	if m.ExpiresAt != nil {
+		if v, ok := any(m.GetExpiresAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: Qualification
 	if m.Qualification != nil {
 		if v, ok := any(m.GetQualification()).(interface{ Sanitize() error }); ok {
@@ -94,6 +94,7 @@ const (
 	ResultStatus_RESULT_STATUS_PENDING     ResultStatus = 1
 	ResultStatus_RESULT_STATUS_FAILED      ResultStatus = 2
 	ResultStatus_RESULT_STATUS_SUCCESSFUL  ResultStatus = 3
+	ResultStatus_RESULT_STATUS_EXPIRED     ResultStatus = 4
 )
 
 // Enum value maps for ResultStatus.
@@ -103,12 +104,14 @@ var (
 		1: "RESULT_STATUS_PENDING",
 		2: "RESULT_STATUS_FAILED",
 		3: "RESULT_STATUS_SUCCESSFUL",
+		4: "RESULT_STATUS_EXPIRED",
 	}
 	ResultStatus_value = map[string]int32{
 		"RESULT_STATUS_UNSPECIFIED": 0,
 		"RESULT_STATUS_PENDING":     1,
 		"RESULT_STATUS_FAILED":      2,
 		"RESULT_STATUS_SUCCESSFUL":  3,
+		"RESULT_STATUS_EXPIRED":     4,
 	}
 )
 
@@ -164,6 +167,8 @@ type Qualification struct {
 	xxx_hidden_LabelSyncEnabled   bool                            `protobuf:"varint,26,opt,name=label_sync_enabled,json=labelSyncEnabled,proto3"`
 	xxx_hidden_LabelSyncFormat    *string                         `protobuf:"bytes,27,opt,name=label_sync_format,json=labelSyncFormat,proto3,oneof"`
 	xxx_hidden_Files              *[]*file.File                   `protobuf:"bytes,28,rep,name=files,proto3"`
+	xxx_hidden_ValidityDays       int32                           `protobuf:"varint,29,opt,name=validity_days,json=validityDays,proto3,oneof"`
+	xxx_hidden_GracePeriodDays    int32                           `protobuf:"varint,30,opt,name=grace_period_days,json=gracePeriodDays,proto3,oneof"`
 	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
 	XXX_presence                  [1]uint32
 	unknownFields                 protoimpl.UnknownFields
@@ -401,6 +406,20 @@ func (x *Qualification) GetFiles() []*file.File {
 	return nil
 }
 
+func (x *Qualification) GetValidityDays() int32 {
+	if x != nil {
+		return x.xxx_hidden_ValidityDays
+	}
+	return 0
+}
+
+func (x *Qualification) GetGracePeriodDays() int32 {
+	if x != nil {
+		return x.xxx_hidden_GracePeriodDays
+	}
+	return 0
+}
+
 func (x *Qualification) SetId(v int64) {
 	x.xxx_hidden_Id = v
 }
@@ -447,7 +466,7 @@ func (x *Qualification) SetTitle(v string) {
 
 func (x *Qualification) SetDescription(v string) {
 	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 28)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 30)
 }
 
 func (x *Qualification) SetContent(v *content.Content) {
@@ -456,7 +475,7 @@ func (x *Qualification) SetContent(v *content.Content) {
 
 func (x *Qualification) SetCreatorId(v int32) {
 	x.xxx_hidden_CreatorId = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 28)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 30)
 }
 
 func (x *Qualification) SetCreator(v *short.UserShort) {
@@ -509,13 +528,23 @@ func (x *Qualification) SetLabelSyncEnabled(v bool) {
 
 func (x *Qualification) SetLabelSyncFormat(v string) {
 	x.xxx_hidden_LabelSyncFormat = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 26, 28)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 26, 30)
 }
 
 func (x *Qualification) SetFiles(v []*file.File) {
 	x.xxx_hidden_Files = &v
 }
 
+func (x *Qualification) SetValidityDays(v int32) {
+	x.xxx_hidden_ValidityDays = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 28, 30)
+}
+
+func (x *Qualification) SetGracePeriodDays(v int32) {
+	x.xxx_hidden_GracePeriodDays = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 29, 30)
+}
+
 func (x *Qualification) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -614,6 +643,20 @@ func (x *Qualification) HasLabelSyncFormat() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 26)
 }
 
+func (x *Qualification) HasValidityDays() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 28)
+}
+
+func (x *Qualification) HasGracePeriodDays() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 29)
+}
+
 func (x *Qualification) ClearCreatedAt() {
 	x.xxx_hidden_CreatedAt = nil
 }
@@ -673,6 +716,16 @@ func (x *Qualification) ClearLabelSyncFormat() {
 	x.xxx_hidden_LabelSyncFormat = nil
 }
 
+func (x *Qualification) ClearValidityDays() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 28)
+	x.xxx_hidden_ValidityDays = 0
+}
+
+func (x *Qualification) ClearGracePeriodDays() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 29)
+	x.xxx_hidden_GracePeriodDays = 0
+}
+
 type Qualification_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -704,6 +757,8 @@ type Qualification_builder struct {
 	LabelSyncEnabled   bool
 	LabelSyncFormat    *string
 	Files              []*file.File
+	ValidityDays       *int32
+	GracePeriodDays    *int32
 }
 
 func (b0 Qualification_builder) Build() *Qualification {
@@ -722,12 +777,12 @@ func (b0 Qualification_builder) Build() *Qualification {
 	x.xxx_hidden_Abbreviation = b.Abbreviation
 	x.xxx_hidden_Title = b.Title
 	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 28)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 30)
 		x.xxx_hidden_Description = b.Description
 	}
 	x.xxx_hidden_Content = b.Content
 	if b.CreatorId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 28)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 30)
 		x.xxx_hidden_CreatorId = *b.CreatorId
 	}
 	x.xxx_hidden_Creator = b.Creator
@@ -743,10 +798,18 @@ func (b0 Qualification_builder) Build() *Qualification {
 	x.xxx_hidden_Request = b.Request
 	x.xxx_hidden_LabelSyncEnabled = b.LabelSyncEnabled
 	if b.LabelSyncFormat != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 26, 28)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 26, 30)
 		x.xxx_hidden_LabelSyncFormat = b.LabelSyncFormat
 	}
 	x.xxx_hidden_Files = &b.Files
+	if b.ValidityDays != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 28, 30)
+		x.xxx_hidden_ValidityDays = *b.ValidityDays
+	}
+	if b.GracePeriodDays != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 29, 30)
+		x.xxx_hidden_GracePeriodDays = *b.GracePeriodDays
+	}
 	return m0
 }
 
@@ -1809,6 +1872,7 @@ type QualificationResult struct {
 	xxx_hidden_CreatorId       int32                  `protobuf:"varint,11,opt,name=creator_id,json=creatorId,proto3"`
 	xxx_hidden_Creator         *short.UserShort       `protobuf:"bytes,12,opt,name=creator,proto3"`
 	xxx_hidden_CreatorJob      string                 `protobuf:"bytes,13,opt,name=creator_job,json=creatorJob,proto3"`
+	xxx_hidden_ExpiresAt       *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3,oneof"`
 	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
 	XXX_presence               [1]uint32
 	unknownFields              protoimpl.UnknownFields
@@ -1931,6 +1995,13 @@ func (x *QualificationResult) GetCreatorJob() string {
 	return ""
 }
 
+func (x *QualificationResult) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
 func (x *QualificationResult) SetId(v int64) {
 	x.xxx_hidden_Id = v
 }
@@ -1965,7 +2036,7 @@ func (x *QualificationResult) SetStatus(v ResultStatus) {
 
 func (x *QualificationResult) SetScore(v float32) {
 	x.xxx_hidden_Score = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 13)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 14)
 }
 
 func (x *QualificationResult) SetSummary(v string) {
@@ -1984,6 +2055,10 @@ func (x *QualificationResult) SetCreatorJob(v string) {
 	x.xxx_hidden_CreatorJob = v
 }
 
+func (x *QualificationResult) SetExpiresAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
 func (x *QualificationResult) HasCreatedAt() bool {
 	if x == nil {
 		return false
@@ -2026,6 +2101,13 @@ func (x *QualificationResult) HasCreator() bool {
 	return x.xxx_hidden_Creator != nil
 }
 
+func (x *QualificationResult) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
 func (x *QualificationResult) ClearCreatedAt() {
 	x.xxx_hidden_CreatedAt = nil
 }
@@ -2051,6 +2133,10 @@ func (x *QualificationResult) ClearCreator() {
 	x.xxx_hidden_Creator = nil
 }
 
+func (x *QualificationResult) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
 type QualificationResult_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -2067,6 +2153,7 @@ type QualificationResult_builder struct {
 	CreatorId       int32
 	Creator         *short.UserShort
 	CreatorJob      string
+	ExpiresAt       *timestamp.Timestamp
 }
 
 func (b0 QualificationResult_builder) Build() *QualificationResult {
@@ -2082,13 +2169,14 @@ func (b0 QualificationResult_builder) Build() *QualificationResult {
 	x.xxx_hidden_User = b.User
 	x.xxx_hidden_Status = b.Status
 	if b.Score != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 13)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 14)
 		x.xxx_hidden_Score = *b.Score
 	}
 	x.xxx_hidden_Summary = b.Summary
 	x.xxx_hidden_CreatorId = b.CreatorId
 	x.xxx_hidden_Creator = b.Creator
 	x.xxx_hidden_CreatorJob = b.CreatorJob
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
 	return m0
 }
 
@@ -2096,7 +2184,7 @@ var File_resources_qualifications_qualifications_proto protoreflect.FileDescript
 
 const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\n" +
-	"-resources/qualifications/qualifications.proto\x12\x18resources.qualifications\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a&resources/common/content/content.proto\x1a\x19resources/file/file.proto\x1a(resources/qualifications/exam/exam.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xef\r\n" +
+	"-resources/qualifications/qualifications.proto\x12\x18resources.qualifications\x1a!codegen/dbscanner/dbscanner.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a&resources/common/content/content.proto\x1a\x19resources/file/file.proto\x1a(resources/qualifications/exam/exam.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xf2\x0e\n" +
 	"\rQualification\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -2132,7 +2220,9 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"R\arequest\x88\x01\x01\x12,\n" +
 	"\x12label_sync_enabled\x18\x1a \x01(\bR\x10labelSyncEnabled\x129\n" +
 	"\x11label_sync_format\x18\x1b \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\vR\x0flabelSyncFormat\x88\x01\x01\x12>\n" +
-	"\x05files\x18\x1c \x03(\v2\x14.resources.file.FileB\x12\x9a\x84\x9e\x03\ralias:\"files\"R\x05filesB\r\n" +
+	"\x05files\x18\x1c \x03(\v2\x14.resources.file.FileB\x12\x9a\x84\x9e\x03\ralias:\"files\"R\x05files\x12(\n" +
+	"\rvalidity_days\x18\x1d \x01(\x05H\fR\fvalidityDays\x88\x01\x01\x12/\n" +
+	"\x11grace_period_days\x18\x1e \x01(\x05H\rR\x0fgracePeriodDays\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_deleted_atB\x0e\n" +
@@ -2146,7 +2236,9 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\a_resultB\n" +
 	"\n" +
 	"\b_requestB\x14\n" +
-	"\x12_label_sync_format\"\xec\b\n" +
+	"\x12_label_sync_formatB\x10\n" +
+	"\x0e_validity_daysB\x14\n" +
+	"\x12_grace_period_days\"\xec\b\n" +
 	"\x12QualificationShort\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -2226,7 +2318,7 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\x11_approver_commentB\x0e\n" +
 	"\f_approver_idB\v\n" +
 	"\t_approverB\x0f\n" +
-	"\r_approver_job\"\x81\x06\n" +
+	"\r_approver_job\"\xd4\x06\n" +
 	"\x13QualificationResult\x121\n" +
 	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
 	"\n" +
@@ -2245,11 +2337,14 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"creator_id\x18\v \x01(\x05R\tcreatorId\x12P\n" +
 	"\acreator\x18\f \x01(\v2 .resources.users.short.UserShortB\x14\x9a\x84\x9e\x03\x0falias:\"creator\"R\acreator\x12\x1f\n" +
 	"\vcreator_job\x18\r \x01(\tR\n" +
-	"creatorJobB\r\n" +
+	"creatorJob\x12B\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1e.resources.timestamp.TimestampH\x04R\texpiresAt\x88\x01\x01B\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_deleted_atB\x10\n" +
 	"\x0e_qualificationB\b\n" +
-	"\x06_score*\xe3\x01\n" +
+	"\x06_scoreB\r\n" +
+	"\v_expires_at*\xe3\x01\n" +
 	"\rRequestStatus\x12\x1e\n" +
 	"\x1aREQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16REQUEST_STATUS_PENDING\x10\x01\x12\x19\n" +
@@ -2257,12 +2352,13 @@ const file_resources_qualifications_qualifications_proto_rawDesc = "" +
 	"\x17REQUEST_STATUS_ACCEPTED\x10\x03\x12\x1f\n" +
 	"\x1bREQUEST_STATUS_EXAM_STARTED\x10\x04\x12\x1f\n" +
 	"\x1bREQUEST_STATUS_EXAM_GRADING\x10\x05\x12\x1c\n" +
-	"\x18REQUEST_STATUS_COMPLETED\x10\x06*\x80\x01\n" +
+	"\x18REQUEST_STATUS_COMPLETED\x10\x06*\x9b\x01\n" +
 	"\fResultStatus\x12\x1d\n" +
 	"\x19RESULT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15RESULT_STATUS_PENDING\x10\x01\x12\x18\n" +
 	"\x14RESULT_STATUS_FAILED\x10\x02\x12\x1c\n" +
-	"\x18RESULT_STATUS_SUCCESSFUL\x10\x03B[ZYgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications;qualificationsb\x06proto3"
+	"\x18RESULT_STATUS_SUCCESSFUL\x10\x03\x12\x19\n" +
+	"\x15RESULT_STATUS_EXPIRED\x10\x04B[ZYgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications;qualificationsb\x06proto3"
 
 var file_resources_qualifications_qualifications_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_resources_qualifications_qualifications_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
@@ -2322,11 +2418,12 @@ var file_resources_qualifications_qualifications_proto_depIdxs = []int32{
 	10, // 34: resources.qualifications.QualificationResult.user:type_name -> resources.users.short.UserShort
 	1,  // 35: resources.qualifications.QualificationResult.status:type_name -> resources.qualifications.ResultStatus
 	10, // 36: resources.qualifications.QualificationResult.creator:type_name -> resources.users.short.UserShort
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	8,  // 37: resources.qualifications.QualificationResult.expires_at:type_name -> resources.timestamp.Timestamp
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_resources_qualifications_qualifications_proto_init() }
@@ -0,0 +1,49 @@
+package qualifications
+
+// QualificationIDs returns the IDs of the path's qualifications in step order.
+func (x *TrainingPath) QualificationIDs() []int64 {
+	ids := make([]int64, 0, len(x.GetSteps()))
+	for _, step := range x.GetSteps() {
+		ids = append(ids, step.GetQualificationId())
+	}
+
+	return ids
+}
+
+// Progress derives a colleague's progress on the training path from the qualifications they have
+// completed and, if known, the status of their latest result per qualification.
+func (x *TrainingPath) Progress(
+	userId int32,
+	grade int32,
+	completed map[int64]bool,
+	statuses map[int64]ResultStatus,
+) *TrainingProgress {
+	progress := &TrainingProgress{
+		TrainingPathId: x.GetId(),
+		UserId:         userId,
+		Steps:          make([]*TrainingStepProgress, 0, len(x.GetSteps())),
+		TotalSteps:     int32(len(x.GetSteps())),
+	}
+
+	for _, step := range x.GetSteps() {
+		sp := &TrainingStepProgress{
+			QualificationId: step.GetQualificationId(),
+			Completed:       completed[step.GetQualificationId()],
+		}
+		if status, ok := statuses[step.GetQualificationId()]; ok {
+			sp.Status = &status
+		}
+		if sp.GetCompleted() {
+			progress.CompletedSteps++
+		}
+
+		progress.Steps = append(progress.Steps, sp)
+	}
+
+	progress.Completed = progress.GetTotalSteps() > 0 &&
+		progress.GetCompletedSteps() == progress.GetTotalSteps()
+	// Colleagues that already hold the path's grade (or above) aren't up for promotion
+	progress.PromotionReady = progress.GetCompleted() && (x.Grade == nil || grade < x.GetGrade())
+
+	return progress
+}
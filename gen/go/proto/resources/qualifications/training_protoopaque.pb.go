@@ -0,0 +1,915 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/qualifications/training.proto
+
+//go:build protoopaque
+
+package qualifications
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	short "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Ordered set of qualifications a job's colleagues complete to reach a grade, e.g. "Cadet -> Officer".
+type TrainingPath struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          int64                  `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_CreatedAt   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,oneof"`
+	xxx_hidden_UpdatedAt   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof"`
+	xxx_hidden_DeletedAt   *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3,oneof"`
+	xxx_hidden_Job         string                 `protobuf:"bytes,5,opt,name=job,proto3"`
+	xxx_hidden_Grade       int32                  `protobuf:"varint,6,opt,name=grade,proto3,oneof"`
+	xxx_hidden_GradeLabel  *string                `protobuf:"bytes,7,opt,name=grade_label,json=gradeLabel,proto3,oneof"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,8,opt,name=name,proto3"`
+	xxx_hidden_Description *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof"`
+	xxx_hidden_CreatorId   int32                  `protobuf:"varint,10,opt,name=creator_id,json=creatorId,proto3,oneof"`
+	xxx_hidden_Steps       *[]*TrainingPathStep   `protobuf:"bytes,11,rep,name=steps,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TrainingPath) Reset() {
+	*x = TrainingPath{}
+	mi := &file_resources_qualifications_training_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainingPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingPath) ProtoMessage() {}
+
+func (x *TrainingPath) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_training_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrainingPath) GetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *TrainingPath) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *TrainingPath) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *TrainingPath) GetDeletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DeletedAt
+	}
+	return nil
+}
+
+func (x *TrainingPath) GetJob() string {
+	if x != nil {
+		return x.xxx_hidden_Job
+	}
+	return ""
+}
+
+func (x *TrainingPath) GetGrade() int32 {
+	if x != nil {
+		return x.xxx_hidden_Grade
+	}
+	return 0
+}
+
+func (x *TrainingPath) GetGradeLabel() string {
+	if x != nil {
+		if x.xxx_hidden_GradeLabel != nil {
+			return *x.xxx_hidden_GradeLabel
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrainingPath) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *TrainingPath) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TrainingPath) GetCreatorId() int32 {
+	if x != nil {
+		return x.xxx_hidden_CreatorId
+	}
+	return 0
+}
+
+func (x *TrainingPath) GetSteps() []*TrainingPathStep {
+	if x != nil {
+		if x.xxx_hidden_Steps != nil {
+			return *x.xxx_hidden_Steps
+		}
+	}
+	return nil
+}
+
+func (x *TrainingPath) SetId(v int64) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *TrainingPath) SetCreatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *TrainingPath) SetUpdatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *TrainingPath) SetDeletedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_DeletedAt = v
+}
+
+func (x *TrainingPath) SetJob(v string) {
+	x.xxx_hidden_Job = v
+}
+
+func (x *TrainingPath) SetGrade(v int32) {
+	x.xxx_hidden_Grade = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 11)
+}
+
+func (x *TrainingPath) SetGradeLabel(v string) {
+	x.xxx_hidden_GradeLabel = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 11)
+}
+
+func (x *TrainingPath) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *TrainingPath) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 11)
+}
+
+func (x *TrainingPath) SetCreatorId(v int32) {
+	x.xxx_hidden_CreatorId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 11)
+}
+
+func (x *TrainingPath) SetSteps(v []*TrainingPathStep) {
+	x.xxx_hidden_Steps = &v
+}
+
+func (x *TrainingPath) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *TrainingPath) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *TrainingPath) HasDeletedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DeletedAt != nil
+}
+
+func (x *TrainingPath) HasGrade() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *TrainingPath) HasGradeLabel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *TrainingPath) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *TrainingPath) HasCreatorId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *TrainingPath) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *TrainingPath) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *TrainingPath) ClearDeletedAt() {
+	x.xxx_hidden_DeletedAt = nil
+}
+
+func (x *TrainingPath) ClearGrade() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Grade = 0
+}
+
+func (x *TrainingPath) ClearGradeLabel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_GradeLabel = nil
+}
+
+func (x *TrainingPath) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *TrainingPath) ClearCreatorId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_CreatorId = 0
+}
+
+type TrainingPath_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        int64
+	CreatedAt *timestamp.Timestamp
+	UpdatedAt *timestamp.Timestamp
+	DeletedAt *timestamp.Timestamp
+	Job       string
+	// Grade colleagues can be promoted to after completing the path
+	Grade       *int32
+	GradeLabel  *string
+	Name        string
+	Description *string
+	CreatorId   *int32
+	Steps       []*TrainingPathStep
+}
+
+func (b0 TrainingPath_builder) Build() *TrainingPath {
+	m0 := &TrainingPath{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_DeletedAt = b.DeletedAt
+	x.xxx_hidden_Job = b.Job
+	if b.Grade != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 11)
+		x.xxx_hidden_Grade = *b.Grade
+	}
+	if b.GradeLabel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 11)
+		x.xxx_hidden_GradeLabel = b.GradeLabel
+	}
+	x.xxx_hidden_Name = b.Name
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 11)
+		x.xxx_hidden_Description = b.Description
+	}
+	if b.CreatorId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 11)
+		x.xxx_hidden_CreatorId = *b.CreatorId
+	}
+	x.xxx_hidden_Steps = &b.Steps
+	return m0
+}
+
+type TrainingPathStep struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TrainingPathId  int64                  `protobuf:"varint,1,opt,name=training_path_id,json=trainingPathId,proto3"`
+	xxx_hidden_QualificationId int64                  `protobuf:"varint,2,opt,name=qualification_id,json=qualificationId,proto3"`
+	xxx_hidden_Order           int32                  `protobuf:"varint,3,opt,name=order,proto3"`
+	xxx_hidden_Qualification   *QualificationShort    `protobuf:"bytes,4,opt,name=qualification,proto3,oneof"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *TrainingPathStep) Reset() {
+	*x = TrainingPathStep{}
+	mi := &file_resources_qualifications_training_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainingPathStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingPathStep) ProtoMessage() {}
+
+func (x *TrainingPathStep) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_training_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrainingPathStep) GetTrainingPathId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrainingPathId
+	}
+	return 0
+}
+
+func (x *TrainingPathStep) GetQualificationId() int64 {
+	if x != nil {
+		return x.xxx_hidden_QualificationId
+	}
+	return 0
+}
+
+func (x *TrainingPathStep) GetOrder() int32 {
+	if x != nil {
+		return x.xxx_hidden_Order
+	}
+	return 0
+}
+
+func (x *TrainingPathStep) GetQualification() *QualificationShort {
+	if x != nil {
+		return x.xxx_hidden_Qualification
+	}
+	return nil
+}
+
+func (x *TrainingPathStep) SetTrainingPathId(v int64) {
+	x.xxx_hidden_TrainingPathId = v
+}
+
+func (x *TrainingPathStep) SetQualificationId(v int64) {
+	x.xxx_hidden_QualificationId = v
+}
+
+func (x *TrainingPathStep) SetOrder(v int32) {
+	x.xxx_hidden_Order = v
+}
+
+func (x *TrainingPathStep) SetQualification(v *QualificationShort) {
+	x.xxx_hidden_Qualification = v
+}
+
+func (x *TrainingPathStep) HasQualification() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Qualification != nil
+}
+
+func (x *TrainingPathStep) ClearQualification() {
+	x.xxx_hidden_Qualification = nil
+}
+
+type TrainingPathStep_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TrainingPathId  int64
+	QualificationId int64
+	Order           int32
+	Qualification   *QualificationShort
+}
+
+func (b0 TrainingPathStep_builder) Build() *TrainingPathStep {
+	m0 := &TrainingPathStep{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TrainingPathId = b.TrainingPathId
+	x.xxx_hidden_QualificationId = b.QualificationId
+	x.xxx_hidden_Order = b.Order
+	x.xxx_hidden_Qualification = b.Qualification
+	return m0
+}
+
+type TrainingProgress struct {
+	state                     protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_TrainingPathId int64                    `protobuf:"varint,1,opt,name=training_path_id,json=trainingPathId,proto3"`
+	xxx_hidden_UserId         int32                    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_Steps          *[]*TrainingStepProgress `protobuf:"bytes,3,rep,name=steps,proto3"`
+	xxx_hidden_CompletedSteps int32                    `protobuf:"varint,4,opt,name=completed_steps,json=completedSteps,proto3"`
+	xxx_hidden_TotalSteps     int32                    `protobuf:"varint,5,opt,name=total_steps,json=totalSteps,proto3"`
+	xxx_hidden_Completed      bool                     `protobuf:"varint,6,opt,name=completed,proto3"`
+	xxx_hidden_PromotionReady bool                     `protobuf:"varint,7,opt,name=promotion_ready,json=promotionReady,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *TrainingProgress) Reset() {
+	*x = TrainingProgress{}
+	mi := &file_resources_qualifications_training_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainingProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingProgress) ProtoMessage() {}
+
+func (x *TrainingProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_training_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrainingProgress) GetTrainingPathId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrainingPathId
+	}
+	return 0
+}
+
+func (x *TrainingProgress) GetUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *TrainingProgress) GetSteps() []*TrainingStepProgress {
+	if x != nil {
+		if x.xxx_hidden_Steps != nil {
+			return *x.xxx_hidden_Steps
+		}
+	}
+	return nil
+}
+
+func (x *TrainingProgress) GetCompletedSteps() int32 {
+	if x != nil {
+		return x.xxx_hidden_CompletedSteps
+	}
+	return 0
+}
+
+func (x *TrainingProgress) GetTotalSteps() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalSteps
+	}
+	return 0
+}
+
+func (x *TrainingProgress) GetCompleted() bool {
+	if x != nil {
+		return x.xxx_hidden_Completed
+	}
+	return false
+}
+
+func (x *TrainingProgress) GetPromotionReady() bool {
+	if x != nil {
+		return x.xxx_hidden_PromotionReady
+	}
+	return false
+}
+
+func (x *TrainingProgress) SetTrainingPathId(v int64) {
+	x.xxx_hidden_TrainingPathId = v
+}
+
+func (x *TrainingProgress) SetUserId(v int32) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *TrainingProgress) SetSteps(v []*TrainingStepProgress) {
+	x.xxx_hidden_Steps = &v
+}
+
+func (x *TrainingProgress) SetCompletedSteps(v int32) {
+	x.xxx_hidden_CompletedSteps = v
+}
+
+func (x *TrainingProgress) SetTotalSteps(v int32) {
+	x.xxx_hidden_TotalSteps = v
+}
+
+func (x *TrainingProgress) SetCompleted(v bool) {
+	x.xxx_hidden_Completed = v
+}
+
+func (x *TrainingProgress) SetPromotionReady(v bool) {
+	x.xxx_hidden_PromotionReady = v
+}
+
+type TrainingProgress_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TrainingPathId int64
+	UserId         int32
+	Steps          []*TrainingStepProgress
+	CompletedSteps int32
+	TotalSteps     int32
+	// All steps of the path have been completed
+	Completed bool
+	// Path has been completed and the colleague's grade is below the path's grade
+	PromotionReady bool
+}
+
+func (b0 TrainingProgress_builder) Build() *TrainingProgress {
+	m0 := &TrainingProgress{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TrainingPathId = b.TrainingPathId
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_Steps = &b.Steps
+	x.xxx_hidden_CompletedSteps = b.CompletedSteps
+	x.xxx_hidden_TotalSteps = b.TotalSteps
+	x.xxx_hidden_Completed = b.Completed
+	x.xxx_hidden_PromotionReady = b.PromotionReady
+	return m0
+}
+
+type TrainingStepProgress struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_QualificationId int64                  `protobuf:"varint,1,opt,name=qualification_id,json=qualificationId,proto3"`
+	xxx_hidden_Completed       bool                   `protobuf:"varint,2,opt,name=completed,proto3"`
+	xxx_hidden_Status          ResultStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=resources.qualifications.ResultStatus,oneof"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *TrainingStepProgress) Reset() {
+	*x = TrainingStepProgress{}
+	mi := &file_resources_qualifications_training_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainingStepProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingStepProgress) ProtoMessage() {}
+
+func (x *TrainingStepProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_training_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrainingStepProgress) GetQualificationId() int64 {
+	if x != nil {
+		return x.xxx_hidden_QualificationId
+	}
+	return 0
+}
+
+func (x *TrainingStepProgress) GetCompleted() bool {
+	if x != nil {
+		return x.xxx_hidden_Completed
+	}
+	return false
+}
+
+func (x *TrainingStepProgress) GetStatus() ResultStatus {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return ResultStatus_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *TrainingStepProgress) SetQualificationId(v int64) {
+	x.xxx_hidden_QualificationId = v
+}
+
+func (x *TrainingStepProgress) SetCompleted(v bool) {
+	x.xxx_hidden_Completed = v
+}
+
+func (x *TrainingStepProgress) SetStatus(v ResultStatus) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *TrainingStepProgress) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TrainingStepProgress) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Status = ResultStatus_RESULT_STATUS_UNSPECIFIED
+}
+
+type TrainingStepProgress_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	QualificationId int64
+	Completed       bool
+	// Status of the colleague's latest result of the qualification
+	Status *ResultStatus
+}
+
+func (b0 TrainingStepProgress_builder) Build() *TrainingStepProgress {
+	m0 := &TrainingStepProgress{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_QualificationId = b.QualificationId
+	x.xxx_hidden_Completed = b.Completed
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Status = *b.Status
+	}
+	return m0
+}
+
+type TrainingMatrixRow struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId   int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_User     *short.UserShort       `protobuf:"bytes,2,opt,name=user,proto3,oneof"`
+	xxx_hidden_Progress *TrainingProgress      `protobuf:"bytes,3,opt,name=progress,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TrainingMatrixRow) Reset() {
+	*x = TrainingMatrixRow{}
+	mi := &file_resources_qualifications_training_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainingMatrixRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingMatrixRow) ProtoMessage() {}
+
+func (x *TrainingMatrixRow) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_qualifications_training_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrainingMatrixRow) GetUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *TrainingMatrixRow) GetUser() *short.UserShort {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *TrainingMatrixRow) GetProgress() *TrainingProgress {
+	if x != nil {
+		return x.xxx_hidden_Progress
+	}
+	return nil
+}
+
+func (x *TrainingMatrixRow) SetUserId(v int32) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *TrainingMatrixRow) SetUser(v *short.UserShort) {
+	x.xxx_hidden_User = v
+}
+
+func (x *TrainingMatrixRow) SetProgress(v *TrainingProgress) {
+	x.xxx_hidden_Progress = v
+}
+
+func (x *TrainingMatrixRow) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *TrainingMatrixRow) HasProgress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Progress != nil
+}
+
+func (x *TrainingMatrixRow) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *TrainingMatrixRow) ClearProgress() {
+	x.xxx_hidden_Progress = nil
+}
+
+type TrainingMatrixRow_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId   int32
+	User     *short.UserShort
+	Progress *TrainingProgress
+}
+
+func (b0 TrainingMatrixRow_builder) Build() *TrainingMatrixRow {
+	m0 := &TrainingMatrixRow{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Progress = b.Progress
+	return m0
+}
+
+var File_resources_qualifications_training_proto protoreflect.FileDescriptor
+
+const file_resources_qualifications_training_proto_rawDesc = "" +
+	"\n" +
+	"'resources/qualifications/training.proto\x12\x18resources.qualifications\x1a!codegen/sanitizer/sanitizer.proto\x1a-resources/qualifications/qualifications.proto\x1a#resources/timestamp/timestamp.proto\x1a resources/users/short/user.proto\x1a\x13tagger/tagger.proto\"\xfb\x04\n" +
+	"\fTrainingPath\x121\n" +
+	"\x02id\x18\x01 \x01(\x03B!\x9a\x84\x9e\x03\x1csql:\"primary_key\" alias:\"id\"R\x02id\x12B\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tupdatedAt\x88\x01\x01\x12B\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\tdeletedAt\x88\x01\x01\x12\x10\n" +
+	"\x03job\x18\x05 \x01(\tR\x03job\x12\x19\n" +
+	"\x05grade\x18\x06 \x01(\x05H\x03R\x05grade\x88\x01\x01\x12$\n" +
+	"\vgrade_label\x18\a \x01(\tH\x04R\n" +
+	"gradeLabel\x88\x01\x01\x12\x1c\n" +
+	"\x04name\x18\b \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x04name\x12/\n" +
+	"\vdescription\x18\t \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01H\x05R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"creator_id\x18\n" +
+	" \x01(\x05H\x06R\tcreatorId\x88\x01\x01\x12@\n" +
+	"\x05steps\x18\v \x03(\v2*.resources.qualifications.TrainingPathStepR\x05stepsB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_deleted_atB\b\n" +
+	"\x06_gradeB\x0e\n" +
+	"\f_grade_labelB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_creator_id\"\xe8\x01\n" +
+	"\x10TrainingPathStep\x12(\n" +
+	"\x10training_path_id\x18\x01 \x01(\x03R\x0etrainingPathId\x12)\n" +
+	"\x10qualification_id\x18\x02 \x01(\x03R\x0fqualificationId\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\x05R\x05order\x12W\n" +
+	"\rqualification\x18\x04 \x01(\v2,.resources.qualifications.QualificationShortH\x00R\rqualification\x88\x01\x01B\x10\n" +
+	"\x0e_qualification\"\xac\x02\n" +
+	"\x10TrainingProgress\x12(\n" +
+	"\x10training_path_id\x18\x01 \x01(\x03R\x0etrainingPathId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12D\n" +
+	"\x05steps\x18\x03 \x03(\v2..resources.qualifications.TrainingStepProgressR\x05steps\x12'\n" +
+	"\x0fcompleted_steps\x18\x04 \x01(\x05R\x0ecompletedSteps\x12\x1f\n" +
+	"\vtotal_steps\x18\x05 \x01(\x05R\n" +
+	"totalSteps\x12\x1c\n" +
+	"\tcompleted\x18\x06 \x01(\bR\tcompleted\x12'\n" +
+	"\x0fpromotion_ready\x18\a \x01(\bR\x0epromotionReady\"\xaf\x01\n" +
+	"\x14TrainingStepProgress\x12)\n" +
+	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12C\n" +
+	"\x06status\x18\x03 \x01(\x0e2&.resources.qualifications.ResultStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xcb\x01\n" +
+	"\x11TrainingMatrixRow\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12L\n" +
+	"\x04user\x18\x02 \x01(\v2 .resources.users.short.UserShortB\x11\x9a\x84\x9e\x03\falias:\"user\"H\x00R\x04user\x88\x01\x01\x12F\n" +
+	"\bprogress\x18\x03 \x01(\v2*.resources.qualifications.TrainingProgressR\bprogressB\a\n" +
+	"\x05_userB[ZYgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications;qualificationsb\x06proto3"
+
+var file_resources_qualifications_training_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_resources_qualifications_training_proto_goTypes = []any{
+	(*TrainingPath)(nil),         // 0: resources.qualifications.TrainingPath
+	(*TrainingPathStep)(nil),     // 1: resources.qualifications.TrainingPathStep
+	(*TrainingProgress)(nil),     // 2: resources.qualifications.TrainingProgress
+	(*TrainingStepProgress)(nil), // 3: resources.qualifications.TrainingStepProgress
+	(*TrainingMatrixRow)(nil),    // 4: resources.qualifications.TrainingMatrixRow
+	(*timestamp.Timestamp)(nil),  // 5: resources.timestamp.Timestamp
+	(*QualificationShort)(nil),   // 6: resources.qualifications.QualificationShort
+	(ResultStatus)(0),            // 7: resources.qualifications.ResultStatus
+	(*short.UserShort)(nil),      // 8: resources.users.short.UserShort
+}
+var file_resources_qualifications_training_proto_depIdxs = []int32{
+	5, // 0: resources.qualifications.TrainingPath.created_at:type_name -> resources.timestamp.Timestamp
+	5, // 1: resources.qualifications.TrainingPath.updated_at:type_name -> resources.timestamp.Timestamp
+	5, // 2: resources.qualifications.TrainingPath.deleted_at:type_name -> resources.timestamp.Timestamp
+	1, // 3: resources.qualifications.TrainingPath.steps:type_name -> resources.qualifications.TrainingPathStep
+	6, // 4: resources.qualifications.TrainingPathStep.qualification:type_name -> resources.qualifications.QualificationShort
+	3, // 5: resources.qualifications.TrainingProgress.steps:type_name -> resources.qualifications.TrainingStepProgress
+	7, // 6: resources.qualifications.TrainingStepProgress.status:type_name -> resources.qualifications.ResultStatus
+	8, // 7: resources.qualifications.TrainingMatrixRow.user:type_name -> resources.users.short.UserShort
+	2, // 8: resources.qualifications.TrainingMatrixRow.progress:type_name -> resources.qualifications.TrainingProgress
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_resources_qualifications_training_proto_init() }
+func file_resources_qualifications_training_proto_init() {
+	if File_resources_qualifications_training_proto != nil {
+		return
+	}
+	file_resources_qualifications_qualifications_proto_init()
+	file_resources_qualifications_training_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_qualifications_training_proto_msgTypes[1].OneofWrappers = []any{}
+	file_resources_qualifications_training_proto_msgTypes[3].OneofWrappers = []any{}
+	file_resources_qualifications_training_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_qualifications_training_proto_rawDesc), len(file_resources_qualifications_training_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_qualifications_training_proto_goTypes,
+		DependencyIndexes: file_resources_qualifications_training_proto_depIdxs,
+		MessageInfos:      file_resources_qualifications_training_proto_msgTypes,
+	}.Build()
+	File_resources_qualifications_training_proto = out.File
+	file_resources_qualifications_training_proto_goTypes = nil
+	file_resources_qualifications_training_proto_depIdxs = nil
+}
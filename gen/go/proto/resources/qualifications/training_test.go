@@ -0,0 +1,54 @@
+package qualifications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTrainingPathProgress(t *testing.T) {
+	t.Parallel()
+
+	path := &TrainingPath{
+		Id:    1,
+		Grade: proto.Int32(3),
+		Steps: []*TrainingPathStep{
+			{QualificationId: 10},
+			{QualificationId: 11},
+		},
+	}
+	assert.Equal(t, []int64{10, 11}, path.QualificationIDs())
+
+	progress := path.Progress(5, 2, map[int64]bool{10: true}, map[int64]ResultStatus{
+		11: ResultStatus_RESULT_STATUS_PENDING,
+	})
+	require.Len(t, progress.GetSteps(), 2)
+	assert.Equal(t, int32(1), progress.GetCompletedSteps())
+	assert.Equal(t, int32(2), progress.GetTotalSteps())
+	assert.False(t, progress.GetCompleted())
+	assert.False(t, progress.GetPromotionReady())
+	assert.Nil(t, progress.GetSteps()[0].Status)
+	assert.Equal(t, ResultStatus_RESULT_STATUS_PENDING, progress.GetSteps()[1].GetStatus())
+
+	completed := map[int64]bool{10: true, 11: true}
+	progress = path.Progress(5, 2, completed, nil)
+	assert.True(t, progress.GetCompleted())
+	assert.True(t, progress.GetPromotionReady())
+
+	// Already at the path's grade
+	progress = path.Progress(5, 3, completed, nil)
+	assert.True(t, progress.GetCompleted())
+	assert.False(t, progress.GetPromotionReady())
+
+	// Paths without a grade don't care about the colleague's grade
+	path.Grade = nil
+	progress = path.Progress(5, 20, completed, nil)
+	assert.True(t, progress.GetPromotionReady())
+
+	// Empty paths are never completed
+	progress = (&TrainingPath{Id: 2}).Progress(5, 0, completed, nil)
+	assert.False(t, progress.GetCompleted())
+	assert.False(t, progress.GetPromotionReady())
+}
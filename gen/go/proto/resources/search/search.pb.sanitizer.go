@@ -0,0 +1,96 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: resources/search/search.proto
+
+package search
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *SearchIndexEntry) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Content
+	m.Content = htmlsanitizer.SanitizeAndUnescape(m.Content)
+
+	// Field: Job
+	if m.Job != nil {
+		*m.Job = htmlsanitizer.SanitizeAndUnescape(*m.Job)
+	}
+
+	// Field: TargetKey
+	m.TargetKey = htmlsanitizer.SanitizeAndUnescape(m.TargetKey)
+
+	// Field: Title
+	m.Title = htmlsanitizer.SanitizeAndUnescape(m.Title)
+
+	// Field: UpdatedAt
+	if m.UpdatedAt != nil {
+		if v, ok := any(m.GetUpdatedAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *SearchResult) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Job
+	if m.Job != nil {
+		*m.Job = htmlsanitizer.SanitizeAndUnescape(*m.Job)
+	}
+
+	// Field: JobLabel
+	if m.JobLabel != nil {
+		*m.JobLabel = htmlsanitizer.SanitizeAndUnescape(*m.JobLabel)
+	}
+
+	// Field: Snippet
+	m.Snippet = htmlsanitizer.SanitizeAndUnescape(m.Snippet)
+
+	// Field: SnippetHighlights
+	for idx, item := range m.SnippetHighlights {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: TargetKey
+	if m.TargetKey != nil {
+		*m.TargetKey = htmlsanitizer.SanitizeAndUnescape(*m.TargetKey)
+	}
+
+	// Field: Title
+	m.Title = htmlsanitizer.SanitizeAndUnescape(m.Title)
+
+	// Field: TitleHighlights
+	for idx, item := range m.TitleHighlights {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
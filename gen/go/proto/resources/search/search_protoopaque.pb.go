@@ -0,0 +1,683 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: resources/search/search.proto
+
+//go:build protoopaque
+
+package search
+
+import (
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchResultType int32
+
+const (
+	SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED   SearchResultType = 0
+	SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT      SearchResultType = 1
+	SearchResultType_SEARCH_RESULT_TYPE_WIKI_PAGE     SearchResultType = 2
+	SearchResultType_SEARCH_RESULT_TYPE_CITIZEN       SearchResultType = 3
+	SearchResultType_SEARCH_RESULT_TYPE_VEHICLE       SearchResultType = 4
+	SearchResultType_SEARCH_RESULT_TYPE_QUALIFICATION SearchResultType = 5
+)
+
+// Enum value maps for SearchResultType.
+var (
+	SearchResultType_name = map[int32]string{
+		0: "SEARCH_RESULT_TYPE_UNSPECIFIED",
+		1: "SEARCH_RESULT_TYPE_DOCUMENT",
+		2: "SEARCH_RESULT_TYPE_WIKI_PAGE",
+		3: "SEARCH_RESULT_TYPE_CITIZEN",
+		4: "SEARCH_RESULT_TYPE_VEHICLE",
+		5: "SEARCH_RESULT_TYPE_QUALIFICATION",
+	}
+	SearchResultType_value = map[string]int32{
+		"SEARCH_RESULT_TYPE_UNSPECIFIED":   0,
+		"SEARCH_RESULT_TYPE_DOCUMENT":      1,
+		"SEARCH_RESULT_TYPE_WIKI_PAGE":     2,
+		"SEARCH_RESULT_TYPE_CITIZEN":       3,
+		"SEARCH_RESULT_TYPE_VEHICLE":       4,
+		"SEARCH_RESULT_TYPE_QUALIFICATION": 5,
+	}
+)
+
+func (x SearchResultType) Enum() *SearchResultType {
+	p := new(SearchResultType)
+	*p = x
+	return p
+}
+
+func (x SearchResultType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResultType) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_search_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchResultType) Type() protoreflect.EnumType {
+	return &file_resources_search_search_proto_enumTypes[0]
+}
+
+func (x SearchResultType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Entry of the search index, the content is stored as plain text.
+type SearchIndexEntry struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type        SearchResultType       `protobuf:"varint,1,opt,name=type,proto3,enum=resources.search.SearchResultType"`
+	xxx_hidden_TargetId    int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3"`
+	xxx_hidden_TargetKey   string                 `protobuf:"bytes,3,opt,name=target_key,json=targetKey,proto3"`
+	xxx_hidden_Job         *string                `protobuf:"bytes,4,opt,name=job,proto3,oneof"`
+	xxx_hidden_Title       string                 `protobuf:"bytes,5,opt,name=title,proto3"`
+	xxx_hidden_Content     string                 `protobuf:"bytes,6,opt,name=content,proto3"`
+	xxx_hidden_UpdatedAt   *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,oneof"`
+	xxx_hidden_Score       float64                `protobuf:"fixed64,8,opt,name=score,proto3"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SearchIndexEntry) Reset() {
+	*x = SearchIndexEntry{}
+	mi := &file_resources_search_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIndexEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIndexEntry) ProtoMessage() {}
+
+func (x *SearchIndexEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_search_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchIndexEntry) GetType() SearchResultType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED
+}
+
+func (x *SearchIndexEntry) GetTargetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TargetId
+	}
+	return 0
+}
+
+func (x *SearchIndexEntry) GetTargetKey() string {
+	if x != nil {
+		return x.xxx_hidden_TargetKey
+	}
+	return ""
+}
+
+func (x *SearchIndexEntry) GetJob() string {
+	if x != nil {
+		if x.xxx_hidden_Job != nil {
+			return *x.xxx_hidden_Job
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchIndexEntry) GetTitle() string {
+	if x != nil {
+		return x.xxx_hidden_Title
+	}
+	return ""
+}
+
+func (x *SearchIndexEntry) GetContent() string {
+	if x != nil {
+		return x.xxx_hidden_Content
+	}
+	return ""
+}
+
+func (x *SearchIndexEntry) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *SearchIndexEntry) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *SearchIndexEntry) SetType(v SearchResultType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *SearchIndexEntry) SetTargetId(v int64) {
+	x.xxx_hidden_TargetId = v
+}
+
+func (x *SearchIndexEntry) SetTargetKey(v string) {
+	x.xxx_hidden_TargetKey = v
+}
+
+func (x *SearchIndexEntry) SetJob(v string) {
+	x.xxx_hidden_Job = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *SearchIndexEntry) SetTitle(v string) {
+	x.xxx_hidden_Title = v
+}
+
+func (x *SearchIndexEntry) SetContent(v string) {
+	x.xxx_hidden_Content = v
+}
+
+func (x *SearchIndexEntry) SetUpdatedAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *SearchIndexEntry) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+}
+
+func (x *SearchIndexEntry) HasJob() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SearchIndexEntry) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *SearchIndexEntry) ClearJob() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Job = nil
+}
+
+func (x *SearchIndexEntry) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type SearchIndexEntry_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Type SearchResultType
+	// ID of the entity (0 for vehicles)
+	TargetId int64
+	// Unique key of the entity per type (ID as string or the vehicle's plate)
+	TargetKey string
+	Job       *string
+	Title     string
+	Content   string
+	UpdatedAt *timestamp.Timestamp
+	// Relevance of the entry for the search query
+	Score float64
+}
+
+func (b0 SearchIndexEntry_builder) Build() *SearchIndexEntry {
+	m0 := &SearchIndexEntry{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_TargetId = b.TargetId
+	x.xxx_hidden_TargetKey = b.TargetKey
+	if b.Job != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_Job = b.Job
+	}
+	x.xxx_hidden_Title = b.Title
+	x.xxx_hidden_Content = b.Content
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_Score = b.Score
+	return m0
+}
+
+// Highlighted range, offsets are in runes.
+type SearchHighlight struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Start  int32                  `protobuf:"varint,1,opt,name=start,proto3"`
+	xxx_hidden_Length int32                  `protobuf:"varint,2,opt,name=length,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_resources_search_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_search_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchHighlight) GetStart() int32 {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return 0
+}
+
+func (x *SearchHighlight) GetLength() int32 {
+	if x != nil {
+		return x.xxx_hidden_Length
+	}
+	return 0
+}
+
+func (x *SearchHighlight) SetStart(v int32) {
+	x.xxx_hidden_Start = v
+}
+
+func (x *SearchHighlight) SetLength(v int32) {
+	x.xxx_hidden_Length = v
+}
+
+type SearchHighlight_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Start  int32
+	Length int32
+}
+
+func (b0 SearchHighlight_builder) Build() *SearchHighlight {
+	m0 := &SearchHighlight{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Start = b.Start
+	x.xxx_hidden_Length = b.Length
+	return m0
+}
+
+type SearchResult struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type              SearchResultType       `protobuf:"varint,1,opt,name=type,proto3,enum=resources.search.SearchResultType"`
+	xxx_hidden_TargetId          int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3"`
+	xxx_hidden_TargetKey         *string                `protobuf:"bytes,3,opt,name=target_key,json=targetKey,proto3,oneof"`
+	xxx_hidden_Job               *string                `protobuf:"bytes,4,opt,name=job,proto3,oneof"`
+	xxx_hidden_JobLabel          *string                `protobuf:"bytes,5,opt,name=job_label,json=jobLabel,proto3,oneof"`
+	xxx_hidden_Title             string                 `protobuf:"bytes,6,opt,name=title,proto3"`
+	xxx_hidden_Snippet           string                 `protobuf:"bytes,7,opt,name=snippet,proto3"`
+	xxx_hidden_TitleHighlights   *[]*SearchHighlight    `protobuf:"bytes,8,rep,name=title_highlights,json=titleHighlights,proto3"`
+	xxx_hidden_SnippetHighlights *[]*SearchHighlight    `protobuf:"bytes,9,rep,name=snippet_highlights,json=snippetHighlights,proto3"`
+	xxx_hidden_Score             float64                `protobuf:"fixed64,10,opt,name=score,proto3"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_resources_search_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_search_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchResult) GetType() SearchResultType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetTargetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TargetId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetTargetKey() string {
+	if x != nil {
+		if x.xxx_hidden_TargetKey != nil {
+			return *x.xxx_hidden_TargetKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchResult) GetJob() string {
+	if x != nil {
+		if x.xxx_hidden_Job != nil {
+			return *x.xxx_hidden_Job
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchResult) GetJobLabel() string {
+	if x != nil {
+		if x.xxx_hidden_JobLabel != nil {
+			return *x.xxx_hidden_JobLabel
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.xxx_hidden_Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.xxx_hidden_Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitleHighlights() []*SearchHighlight {
+	if x != nil {
+		if x.xxx_hidden_TitleHighlights != nil {
+			return *x.xxx_hidden_TitleHighlights
+		}
+	}
+	return nil
+}
+
+func (x *SearchResult) GetSnippetHighlights() []*SearchHighlight {
+	if x != nil {
+		if x.xxx_hidden_SnippetHighlights != nil {
+			return *x.xxx_hidden_SnippetHighlights
+		}
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *SearchResult) SetType(v SearchResultType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *SearchResult) SetTargetId(v int64) {
+	x.xxx_hidden_TargetId = v
+}
+
+func (x *SearchResult) SetTargetKey(v string) {
+	x.xxx_hidden_TargetKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 10)
+}
+
+func (x *SearchResult) SetJob(v string) {
+	x.xxx_hidden_Job = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 10)
+}
+
+func (x *SearchResult) SetJobLabel(v string) {
+	x.xxx_hidden_JobLabel = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 10)
+}
+
+func (x *SearchResult) SetTitle(v string) {
+	x.xxx_hidden_Title = v
+}
+
+func (x *SearchResult) SetSnippet(v string) {
+	x.xxx_hidden_Snippet = v
+}
+
+func (x *SearchResult) SetTitleHighlights(v []*SearchHighlight) {
+	x.xxx_hidden_TitleHighlights = &v
+}
+
+func (x *SearchResult) SetSnippetHighlights(v []*SearchHighlight) {
+	x.xxx_hidden_SnippetHighlights = &v
+}
+
+func (x *SearchResult) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+}
+
+func (x *SearchResult) HasTargetKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SearchResult) HasJob() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SearchResult) HasJobLabel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *SearchResult) ClearTargetKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_TargetKey = nil
+}
+
+func (x *SearchResult) ClearJob() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Job = nil
+}
+
+func (x *SearchResult) ClearJobLabel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_JobLabel = nil
+}
+
+type SearchResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Type     SearchResultType
+	TargetId int64
+	// Vehicle plate for vehicles
+	TargetKey *string
+	Job       *string
+	JobLabel  *string
+	Title     string
+	// Excerpt of the content around the first match
+	Snippet           string
+	TitleHighlights   []*SearchHighlight
+	SnippetHighlights []*SearchHighlight
+	Score             float64
+}
+
+func (b0 SearchResult_builder) Build() *SearchResult {
+	m0 := &SearchResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_TargetId = b.TargetId
+	if b.TargetKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 10)
+		x.xxx_hidden_TargetKey = b.TargetKey
+	}
+	if b.Job != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 10)
+		x.xxx_hidden_Job = b.Job
+	}
+	if b.JobLabel != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 10)
+		x.xxx_hidden_JobLabel = b.JobLabel
+	}
+	x.xxx_hidden_Title = b.Title
+	x.xxx_hidden_Snippet = b.Snippet
+	x.xxx_hidden_TitleHighlights = &b.TitleHighlights
+	x.xxx_hidden_SnippetHighlights = &b.SnippetHighlights
+	x.xxx_hidden_Score = b.Score
+	return m0
+}
+
+var File_resources_search_search_proto protoreflect.FileDescriptor
+
+const file_resources_search_search_proto_rawDesc = "" +
+	"\n" +
+	"\x1dresources/search/search.proto\x12\x10resources.search\x1a#resources/timestamp/timestamp.proto\"\xbe\x02\n" +
+	"\x10SearchIndexEntry\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\".resources.search.SearchResultTypeR\x04type\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\x1d\n" +
+	"\n" +
+	"target_key\x18\x03 \x01(\tR\ttargetKey\x12\x15\n" +
+	"\x03job\x18\x04 \x01(\tH\x00R\x03job\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12B\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\tupdatedAt\x88\x01\x01\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05scoreB\x06\n" +
+	"\x04_jobB\r\n" +
+	"\v_updated_at\"?\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\"\xcb\x03\n" +
+	"\fSearchResult\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\".resources.search.SearchResultTypeR\x04type\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\"\n" +
+	"\n" +
+	"target_key\x18\x03 \x01(\tH\x00R\ttargetKey\x88\x01\x01\x12\x15\n" +
+	"\x03job\x18\x04 \x01(\tH\x01R\x03job\x88\x01\x01\x12 \n" +
+	"\tjob_label\x18\x05 \x01(\tH\x02R\bjobLabel\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\a \x01(\tR\asnippet\x12L\n" +
+	"\x10title_highlights\x18\b \x03(\v2!.resources.search.SearchHighlightR\x0ftitleHighlights\x12P\n" +
+	"\x12snippet_highlights\x18\t \x03(\v2!.resources.search.SearchHighlightR\x11snippetHighlights\x12\x14\n" +
+	"\x05score\x18\n" +
+	" \x01(\x01R\x05scoreB\r\n" +
+	"\v_target_keyB\x06\n" +
+	"\x04_jobB\f\n" +
+	"\n" +
+	"_job_label*\xdf\x01\n" +
+	"\x10SearchResultType\x12\"\n" +
+	"\x1eSEARCH_RESULT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSEARCH_RESULT_TYPE_DOCUMENT\x10\x01\x12 \n" +
+	"\x1cSEARCH_RESULT_TYPE_WIKI_PAGE\x10\x02\x12\x1e\n" +
+	"\x1aSEARCH_RESULT_TYPE_CITIZEN\x10\x03\x12\x1e\n" +
+	"\x1aSEARCH_RESULT_TYPE_VEHICLE\x10\x04\x12$\n" +
+	" SEARCH_RESULT_TYPE_QUALIFICATION\x10\x05BKZIgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search;searchb\x06proto3"
+
+var file_resources_search_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_resources_search_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_resources_search_search_proto_goTypes = []any{
+	(SearchResultType)(0),       // 0: resources.search.SearchResultType
+	(*SearchIndexEntry)(nil),    // 1: resources.search.SearchIndexEntry
+	(*SearchHighlight)(nil),     // 2: resources.search.SearchHighlight
+	(*SearchResult)(nil),        // 3: resources.search.SearchResult
+	(*timestamp.Timestamp)(nil), // 4: resources.timestamp.Timestamp
+}
+var file_resources_search_search_proto_depIdxs = []int32{
+	0, // 0: resources.search.SearchIndexEntry.type:type_name -> resources.search.SearchResultType
+	4, // 1: resources.search.SearchIndexEntry.updated_at:type_name -> resources.timestamp.Timestamp
+	0, // 2: resources.search.SearchResult.type:type_name -> resources.search.SearchResultType
+	2, // 3: resources.search.SearchResult.title_highlights:type_name -> resources.search.SearchHighlight
+	2, // 4: resources.search.SearchResult.snippet_highlights:type_name -> resources.search.SearchHighlight
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_resources_search_search_proto_init() }
+func file_resources_search_search_proto_init() {
+	if File_resources_search_search_proto != nil {
+		return
+	}
+	file_resources_search_search_proto_msgTypes[0].OneofWrappers = []any{}
+	file_resources_search_search_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_search_search_proto_rawDesc), len(file_resources_search_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resources_search_search_proto_goTypes,
+		DependencyIndexes: file_resources_search_search_proto_depIdxs,
+		EnumInfos:         file_resources_search_search_proto_enumTypes,
+		MessageInfos:      file_resources_search_search_proto_msgTypes,
+	}.Build()
+	File_resources_search_search_proto = out.File
+	file_resources_search_search_proto_goTypes = nil
+	file_resources_search_search_proto_depIdxs = nil
+}
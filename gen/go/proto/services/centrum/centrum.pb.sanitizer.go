@@ -25,6 +25,56 @@ func (m *GetDispatchHeatmapResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetDispatchStatsRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: End
+	if m.End != nil {
+		if v, ok := any(m.GetEnd()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Start
+	if m.Start != nil {
+		if v, ok := any(m.GetStart()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetDispatchStatsResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Stats
+	for idx, item := range m.Stats {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *GetSettingsResponse) Sanitize() error {
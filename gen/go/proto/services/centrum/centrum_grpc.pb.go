@@ -22,6 +22,7 @@ const (
 	CentrumService_GetSettings_FullMethodName        = "/services.centrum.CentrumService/GetSettings"
 	CentrumService_UpdateSettings_FullMethodName     = "/services.centrum.CentrumService/UpdateSettings"
 	CentrumService_GetDispatchHeatmap_FullMethodName = "/services.centrum.CentrumService/GetDispatchHeatmap"
+	CentrumService_GetDispatchStats_FullMethodName   = "/services.centrum.CentrumService/GetDispatchStats"
 	CentrumService_TakeControl_FullMethodName        = "/services.centrum.CentrumService/TakeControl"
 	CentrumService_UpdateDispatchers_FullMethodName  = "/services.centrum.CentrumService/UpdateDispatchers"
 	CentrumService_Stream_FullMethodName             = "/services.centrum.CentrumService/Stream"
@@ -34,6 +35,7 @@ type CentrumServiceClient interface {
 	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error)
 	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UpdateSettingsResponse, error)
 	GetDispatchHeatmap(ctx context.Context, in *GetDispatchHeatmapRequest, opts ...grpc.CallOption) (*GetDispatchHeatmapResponse, error)
+	GetDispatchStats(ctx context.Context, in *GetDispatchStatsRequest, opts ...grpc.CallOption) (*GetDispatchStatsResponse, error)
 	TakeControl(ctx context.Context, in *TakeControlRequest, opts ...grpc.CallOption) (*TakeControlResponse, error)
 	UpdateDispatchers(ctx context.Context, in *UpdateDispatchersRequest, opts ...grpc.CallOption) (*UpdateDispatchersResponse, error)
 	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamResponse], error)
@@ -77,6 +79,16 @@ func (c *centrumServiceClient) GetDispatchHeatmap(ctx context.Context, in *GetDi
 	return out, nil
 }
 
+func (c *centrumServiceClient) GetDispatchStats(ctx context.Context, in *GetDispatchStatsRequest, opts ...grpc.CallOption) (*GetDispatchStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDispatchStatsResponse)
+	err := c.cc.Invoke(ctx, CentrumService_GetDispatchStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *centrumServiceClient) TakeControl(ctx context.Context, in *TakeControlRequest, opts ...grpc.CallOption) (*TakeControlResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TakeControlResponse)
@@ -123,6 +135,7 @@ type CentrumServiceServer interface {
 	GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error)
 	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
 	GetDispatchHeatmap(context.Context, *GetDispatchHeatmapRequest) (*GetDispatchHeatmapResponse, error)
+	GetDispatchStats(context.Context, *GetDispatchStatsRequest) (*GetDispatchStatsResponse, error)
 	TakeControl(context.Context, *TakeControlRequest) (*TakeControlResponse, error)
 	UpdateDispatchers(context.Context, *UpdateDispatchersRequest) (*UpdateDispatchersResponse, error)
 	Stream(*StreamRequest, grpc.ServerStreamingServer[StreamResponse]) error
@@ -145,6 +158,9 @@ func (UnimplementedCentrumServiceServer) UpdateSettings(context.Context, *Update
 func (UnimplementedCentrumServiceServer) GetDispatchHeatmap(context.Context, *GetDispatchHeatmapRequest) (*GetDispatchHeatmapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDispatchHeatmap not implemented")
 }
+func (UnimplementedCentrumServiceServer) GetDispatchStats(context.Context, *GetDispatchStatsRequest) (*GetDispatchStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispatchStats not implemented")
+}
 func (UnimplementedCentrumServiceServer) TakeControl(context.Context, *TakeControlRequest) (*TakeControlResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TakeControl not implemented")
 }
@@ -229,6 +245,24 @@ func _CentrumService_GetDispatchHeatmap_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CentrumService_GetDispatchStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDispatchStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentrumServiceServer).GetDispatchStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CentrumService_GetDispatchStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentrumServiceServer).GetDispatchStats(ctx, req.(*GetDispatchStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CentrumService_TakeControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TakeControlRequest)
 	if err := dec(in); err != nil {
@@ -295,6 +329,10 @@ var CentrumService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDispatchHeatmap",
 			Handler:    _CentrumService_GetDispatchHeatmap_Handler,
 		},
+		{
+			MethodName: "GetDispatchStats",
+			Handler:    _CentrumService_GetDispatchStats_Handler,
+		},
 		{
 			MethodName: "TakeControl",
 			Handler:    _CentrumService_TakeControl_Handler,
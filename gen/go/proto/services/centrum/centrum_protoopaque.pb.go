@@ -417,6 +417,192 @@ func (b0 GetDispatchHeatmapResponse_builder) Build() *GetDispatchHeatmapResponse
 	return m0
 }
 
+type GetDispatchStatsRequest struct {
+	state                  protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Start       *timestamp.Timestamp              `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+	xxx_hidden_End         *timestamp.Timestamp              `protobuf:"bytes,2,opt,name=end,proto3,oneof"`
+	xxx_hidden_Dimension   dispatches.DispatchStatsDimension `protobuf:"varint,3,opt,name=dimension,proto3,enum=resources.centrum.dispatches.DispatchStatsDimension,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetDispatchStatsRequest) Reset() {
+	*x = GetDispatchStatsRequest{}
+	mi := &file_services_centrum_centrum_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDispatchStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDispatchStatsRequest) ProtoMessage() {}
+
+func (x *GetDispatchStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_centrum_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDispatchStatsRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return nil
+}
+
+func (x *GetDispatchStatsRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_End
+	}
+	return nil
+}
+
+func (x *GetDispatchStatsRequest) GetDimension() dispatches.DispatchStatsDimension {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Dimension
+		}
+	}
+	return dispatches.DispatchStatsDimension(0)
+}
+
+func (x *GetDispatchStatsRequest) SetStart(v *timestamp.Timestamp) {
+	x.xxx_hidden_Start = v
+}
+
+func (x *GetDispatchStatsRequest) SetEnd(v *timestamp.Timestamp) {
+	x.xxx_hidden_End = v
+}
+
+func (x *GetDispatchStatsRequest) SetDimension(v dispatches.DispatchStatsDimension) {
+	x.xxx_hidden_Dimension = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *GetDispatchStatsRequest) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Start != nil
+}
+
+func (x *GetDispatchStatsRequest) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_End != nil
+}
+
+func (x *GetDispatchStatsRequest) HasDimension() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetDispatchStatsRequest) ClearStart() {
+	x.xxx_hidden_Start = nil
+}
+
+func (x *GetDispatchStatsRequest) ClearEnd() {
+	x.xxx_hidden_End = nil
+}
+
+func (x *GetDispatchStatsRequest) ClearDimension() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Dimension = dispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_UNSPECIFIED
+}
+
+type GetDispatchStatsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Start     *timestamp.Timestamp
+	End       *timestamp.Timestamp
+	Dimension *dispatches.DispatchStatsDimension
+}
+
+func (b0 GetDispatchStatsRequest_builder) Build() *GetDispatchStatsRequest {
+	m0 := &GetDispatchStatsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Start = b.Start
+	x.xxx_hidden_End = b.End
+	if b.Dimension != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Dimension = *b.Dimension
+	}
+	return m0
+}
+
+type GetDispatchStatsResponse struct {
+	state            protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Stats *[]*dispatches.DispatchStats `protobuf:"bytes,1,rep,name=stats,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDispatchStatsResponse) Reset() {
+	*x = GetDispatchStatsResponse{}
+	mi := &file_services_centrum_centrum_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDispatchStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDispatchStatsResponse) ProtoMessage() {}
+
+func (x *GetDispatchStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_centrum_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDispatchStatsResponse) GetStats() []*dispatches.DispatchStats {
+	if x != nil {
+		if x.xxx_hidden_Stats != nil {
+			return *x.xxx_hidden_Stats
+		}
+	}
+	return nil
+}
+
+func (x *GetDispatchStatsResponse) SetStats(v []*dispatches.DispatchStats) {
+	x.xxx_hidden_Stats = &v
+}
+
+type GetDispatchStatsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Stats []*dispatches.DispatchStats
+}
+
+func (b0 GetDispatchStatsResponse_builder) Build() *GetDispatchStatsResponse {
+	m0 := &GetDispatchStatsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Stats = &b.Stats
+	return m0
+}
+
 type TakeControlRequest struct {
 	state             protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Signon bool                   `protobuf:"varint,1,opt,name=signon,proto3"`
@@ -426,7 +612,7 @@ type TakeControlRequest struct {
 
 func (x *TakeControlRequest) Reset() {
 	*x = TakeControlRequest{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[6]
+	mi := &file_services_centrum_centrum_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -438,7 +624,7 @@ func (x *TakeControlRequest) String() string {
 func (*TakeControlRequest) ProtoMessage() {}
 
 func (x *TakeControlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[6]
+	mi := &file_services_centrum_centrum_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +668,7 @@ type TakeControlResponse struct {
 
 func (x *TakeControlResponse) Reset() {
 	*x = TakeControlResponse{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[7]
+	mi := &file_services_centrum_centrum_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +680,7 @@ func (x *TakeControlResponse) String() string {
 func (*TakeControlResponse) ProtoMessage() {}
 
 func (x *TakeControlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[7]
+	mi := &file_services_centrum_centrum_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,7 +712,7 @@ type UpdateDispatchersRequest struct {
 
 func (x *UpdateDispatchersRequest) Reset() {
 	*x = UpdateDispatchersRequest{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[8]
+	mi := &file_services_centrum_centrum_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +724,7 @@ func (x *UpdateDispatchersRequest) String() string {
 func (*UpdateDispatchersRequest) ProtoMessage() {}
 
 func (x *UpdateDispatchersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[8]
+	mi := &file_services_centrum_centrum_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +769,7 @@ type UpdateDispatchersResponse struct {
 
 func (x *UpdateDispatchersResponse) Reset() {
 	*x = UpdateDispatchersResponse{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[9]
+	mi := &file_services_centrum_centrum_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +781,7 @@ func (x *UpdateDispatchersResponse) String() string {
 func (*UpdateDispatchersResponse) ProtoMessage() {}
 
 func (x *UpdateDispatchersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[9]
+	mi := &file_services_centrum_centrum_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +839,7 @@ type StreamHandshake struct {
 
 func (x *StreamHandshake) Reset() {
 	*x = StreamHandshake{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[10]
+	mi := &file_services_centrum_centrum_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +851,7 @@ func (x *StreamHandshake) String() string {
 func (*StreamHandshake) ProtoMessage() {}
 
 func (x *StreamHandshake) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[10]
+	mi := &file_services_centrum_centrum_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,7 +960,7 @@ type LatestState struct {
 
 func (x *LatestState) Reset() {
 	*x = LatestState{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[11]
+	mi := &file_services_centrum_centrum_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -786,7 +972,7 @@ func (x *LatestState) String() string {
 func (*LatestState) ProtoMessage() {}
 
 func (x *LatestState) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[11]
+	mi := &file_services_centrum_centrum_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1087,7 @@ type StreamRequest struct {
 
 func (x *StreamRequest) Reset() {
 	*x = StreamRequest{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[12]
+	mi := &file_services_centrum_centrum_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -913,7 +1099,7 @@ func (x *StreamRequest) String() string {
 func (*StreamRequest) ProtoMessage() {}
 
 func (x *StreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[12]
+	mi := &file_services_centrum_centrum_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,7 +1131,7 @@ type StreamResponse struct {
 
 func (x *StreamResponse) Reset() {
 	*x = StreamResponse{}
-	mi := &file_services_centrum_centrum_proto_msgTypes[13]
+	mi := &file_services_centrum_centrum_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -957,7 +1143,7 @@ func (x *StreamResponse) String() string {
 func (*StreamResponse) ProtoMessage() {}
 
 func (x *StreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_centrum_centrum_proto_msgTypes[13]
+	mi := &file_services_centrum_centrum_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1604,7 @@ func (b0 StreamResponse_builder) Build() *StreamResponse {
 type case_StreamResponse_Change protoreflect.FieldNumber
 
 func (x case_StreamResponse_Change) String() string {
-	md := file_services_centrum_centrum_proto_msgTypes[13].Descriptor()
+	md := file_services_centrum_centrum_proto_msgTypes[15].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
@@ -1499,7 +1685,7 @@ var File_services_centrum_centrum_proto protoreflect.FileDescriptor
 
 const file_services_centrum_centrum_proto_rawDesc = "" +
 	"\n" +
-	"\x1eservices/centrum/centrum.proto\x12\x10services.centrum\x1a\x19codegen/perms/perms.proto\x1a/resources/centrum/dispatchers/dispatchers.proto\x1a-resources/centrum/dispatches/dispatches.proto\x1a)resources/centrum/settings/settings.proto\x1a#resources/centrum/units/units.proto\x1a'resources/livemap/heatmap/heatmap.proto\x1a#resources/timestamp/timestamp.proto\"\x14\n" +
+	"\x1eservices/centrum/centrum.proto\x12\x10services.centrum\x1a\x19codegen/perms/perms.proto\x1a/resources/centrum/dispatchers/dispatchers.proto\x1a-resources/centrum/dispatches/dispatches.proto\x1a(resources/centrum/dispatches/stats.proto\x1a)resources/centrum/settings/settings.proto\x1a#resources/centrum/units/units.proto\x1a'resources/livemap/heatmap/heatmap.proto\x1a#resources/timestamp/timestamp.proto\"\x14\n" +
 	"\x12GetSettingsRequest\"\xaf\x01\n" +
 	"\x13GetSettingsResponse\x12@\n" +
 	"\bsettings\x18\x01 \x01(\v2$.resources.centrum.settings.SettingsR\bsettings\x12V\n" +
@@ -1512,7 +1698,17 @@ const file_services_centrum_centrum_proto_rawDesc = "" +
 	"\x1aGetDispatchHeatmapResponse\x12\x1f\n" +
 	"\vmax_entries\x18\x01 \x01(\x05R\n" +
 	"maxEntries\x12A\n" +
-	"\aentries\x18\x02 \x03(\v2'.resources.livemap.heatmap.HeatmapEntryR\aentries\",\n" +
+	"\aentries\x18\x02 \x03(\v2'.resources.livemap.heatmap.HeatmapEntryR\aentries\"\x84\x02\n" +
+	"\x17GetDispatchStatsRequest\x129\n" +
+	"\x05start\x18\x01 \x01(\v2\x1e.resources.timestamp.TimestampH\x00R\x05start\x88\x01\x01\x125\n" +
+	"\x03end\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\x03end\x88\x01\x01\x12W\n" +
+	"\tdimension\x18\x03 \x01(\x0e24.resources.centrum.dispatches.DispatchStatsDimensionH\x02R\tdimension\x88\x01\x01B\b\n" +
+	"\x06_startB\x06\n" +
+	"\x04_endB\f\n" +
+	"\n" +
+	"_dimension\"]\n" +
+	"\x18GetDispatchStatsResponse\x12A\n" +
+	"\x05stats\x18\x01 \x03(\v2+.resources.centrum.dispatches.DispatchStatsR\x05stats\",\n" +
 	"\x12TakeControlRequest\x12\x16\n" +
 	"\x06signon\x18\x01 \x01(\bR\x06signon\"\x15\n" +
 	"\x13TakeControlResponse\"7\n" +
@@ -1548,83 +1744,94 @@ const file_services_centrum_centrum_proto_rawDesc = "" +
 	"\x10dispatch_updated\x18\n" +
 	" \x01(\v2&.resources.centrum.dispatches.DispatchH\x00R\x0fdispatchUpdated\x12W\n" +
 	"\x0fdispatch_status\x18\v \x01(\v2,.resources.centrum.dispatches.DispatchStatusH\x00R\x0edispatchStatusB\b\n" +
-	"\x06change2\xdb\x05\n" +
+	"\x06change2\xdb\x06\n" +
 	"\x0eCentrumService\x12j\n" +
 	"\vGetSettings\x12$.services.centrum.GetSettingsRequest\x1a%.services.centrum.GetSettingsResponse\"\x0e\xd2\xf3\x18\n" +
 	"\b\x01\"\x06Stream\x12\x87\x01\n" +
 	"\x0eUpdateSettings\x12'.services.centrum.UpdateSettingsRequest\x1a(.services.centrum.UpdateSettingsResponse\"\"\xd2\xf3\x18\x1e\b\x01:\x1a\n" +
 	"\x06Access\x18\x01\"\x06Shared\"\x06Public\x12\x84\x01\n" +
-	"\x12GetDispatchHeatmap\x12+.services.centrum.GetDispatchHeatmapRequest\x1a,.services.centrum.GetDispatchHeatmapResponse\"\x13\xd2\xf3\x18\x0f\b\x01\"\vTakeControl\x12b\n" +
+	"\x12GetDispatchHeatmap\x12+.services.centrum.GetDispatchHeatmapRequest\x1a,.services.centrum.GetDispatchHeatmapResponse\"\x13\xd2\xf3\x18\x0f\b\x01\"\vTakeControl\x12~\n" +
+	"\x10GetDispatchStats\x12).services.centrum.GetDispatchStatsRequest\x1a*.services.centrum.GetDispatchStatsResponse\"\x13\xd2\xf3\x18\x0f\b\x01\"\vTakeControl\x12b\n" +
 	"\vTakeControl\x12$.services.centrum.TakeControlRequest\x1a%.services.centrum.TakeControlResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12t\n" +
 	"\x11UpdateDispatchers\x12*.services.centrum.UpdateDispatchersRequest\x1a+.services.centrum.UpdateDispatchersResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12U\n" +
 	"\x06Stream\x12\x1f.services.centrum.StreamRequest\x1a .services.centrum.StreamResponse\"\x06\xd2\xf3\x18\x02\b\x010\x01\x1a\x1b\xea\xf3\x18\x17\bd\x12\x13i-mdi-car-emergencyBLZJgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum;centrumb\x06proto3"
 
-var file_services_centrum_centrum_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_services_centrum_centrum_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_services_centrum_centrum_proto_goTypes = []any{
-	(*GetSettingsRequest)(nil),         // 0: services.centrum.GetSettingsRequest
-	(*GetSettingsResponse)(nil),        // 1: services.centrum.GetSettingsResponse
-	(*UpdateSettingsRequest)(nil),      // 2: services.centrum.UpdateSettingsRequest
-	(*UpdateSettingsResponse)(nil),     // 3: services.centrum.UpdateSettingsResponse
-	(*GetDispatchHeatmapRequest)(nil),  // 4: services.centrum.GetDispatchHeatmapRequest
-	(*GetDispatchHeatmapResponse)(nil), // 5: services.centrum.GetDispatchHeatmapResponse
-	(*TakeControlRequest)(nil),         // 6: services.centrum.TakeControlRequest
-	(*TakeControlResponse)(nil),        // 7: services.centrum.TakeControlResponse
-	(*UpdateDispatchersRequest)(nil),   // 8: services.centrum.UpdateDispatchersRequest
-	(*UpdateDispatchersResponse)(nil),  // 9: services.centrum.UpdateDispatchersResponse
-	(*StreamHandshake)(nil),            // 10: services.centrum.StreamHandshake
-	(*LatestState)(nil),                // 11: services.centrum.LatestState
-	(*StreamRequest)(nil),              // 12: services.centrum.StreamRequest
-	(*StreamResponse)(nil),             // 13: services.centrum.StreamResponse
-	(*settings.Settings)(nil),          // 14: resources.centrum.settings.Settings
-	(*settings.EffectiveAccess)(nil),   // 15: resources.centrum.settings.EffectiveAccess
-	(*heatmap.HeatmapEntry)(nil),       // 16: resources.livemap.heatmap.HeatmapEntry
-	(*dispatchers.Dispatchers)(nil),    // 17: resources.centrum.dispatchers.Dispatchers
-	(*timestamp.Timestamp)(nil),        // 18: resources.timestamp.Timestamp
-	(*dispatchers.JobDispatchers)(nil), // 19: resources.centrum.dispatchers.JobDispatchers
-	(*units.Unit)(nil),                 // 20: resources.centrum.units.Unit
-	(*dispatches.Dispatch)(nil),        // 21: resources.centrum.dispatches.Dispatch
-	(*units.UnitStatus)(nil),           // 22: resources.centrum.units.UnitStatus
-	(*dispatches.DispatchStatus)(nil),  // 23: resources.centrum.dispatches.DispatchStatus
+	(*GetSettingsRequest)(nil),             // 0: services.centrum.GetSettingsRequest
+	(*GetSettingsResponse)(nil),            // 1: services.centrum.GetSettingsResponse
+	(*UpdateSettingsRequest)(nil),          // 2: services.centrum.UpdateSettingsRequest
+	(*UpdateSettingsResponse)(nil),         // 3: services.centrum.UpdateSettingsResponse
+	(*GetDispatchHeatmapRequest)(nil),      // 4: services.centrum.GetDispatchHeatmapRequest
+	(*GetDispatchHeatmapResponse)(nil),     // 5: services.centrum.GetDispatchHeatmapResponse
+	(*GetDispatchStatsRequest)(nil),        // 6: services.centrum.GetDispatchStatsRequest
+	(*GetDispatchStatsResponse)(nil),       // 7: services.centrum.GetDispatchStatsResponse
+	(*TakeControlRequest)(nil),             // 8: services.centrum.TakeControlRequest
+	(*TakeControlResponse)(nil),            // 9: services.centrum.TakeControlResponse
+	(*UpdateDispatchersRequest)(nil),       // 10: services.centrum.UpdateDispatchersRequest
+	(*UpdateDispatchersResponse)(nil),      // 11: services.centrum.UpdateDispatchersResponse
+	(*StreamHandshake)(nil),                // 12: services.centrum.StreamHandshake
+	(*LatestState)(nil),                    // 13: services.centrum.LatestState
+	(*StreamRequest)(nil),                  // 14: services.centrum.StreamRequest
+	(*StreamResponse)(nil),                 // 15: services.centrum.StreamResponse
+	(*settings.Settings)(nil),              // 16: resources.centrum.settings.Settings
+	(*settings.EffectiveAccess)(nil),       // 17: resources.centrum.settings.EffectiveAccess
+	(*heatmap.HeatmapEntry)(nil),           // 18: resources.livemap.heatmap.HeatmapEntry
+	(*timestamp.Timestamp)(nil),            // 19: resources.timestamp.Timestamp
+	(dispatches.DispatchStatsDimension)(0), // 20: resources.centrum.dispatches.DispatchStatsDimension
+	(*dispatches.DispatchStats)(nil),       // 21: resources.centrum.dispatches.DispatchStats
+	(*dispatchers.Dispatchers)(nil),        // 22: resources.centrum.dispatchers.Dispatchers
+	(*dispatchers.JobDispatchers)(nil),     // 23: resources.centrum.dispatchers.JobDispatchers
+	(*units.Unit)(nil),                     // 24: resources.centrum.units.Unit
+	(*dispatches.Dispatch)(nil),            // 25: resources.centrum.dispatches.Dispatch
+	(*units.UnitStatus)(nil),               // 26: resources.centrum.units.UnitStatus
+	(*dispatches.DispatchStatus)(nil),      // 27: resources.centrum.dispatches.DispatchStatus
 }
 var file_services_centrum_centrum_proto_depIdxs = []int32{
-	14, // 0: services.centrum.GetSettingsResponse.settings:type_name -> resources.centrum.settings.Settings
-	15, // 1: services.centrum.GetSettingsResponse.effective_access:type_name -> resources.centrum.settings.EffectiveAccess
-	14, // 2: services.centrum.UpdateSettingsRequest.settings:type_name -> resources.centrum.settings.Settings
-	14, // 3: services.centrum.UpdateSettingsResponse.settings:type_name -> resources.centrum.settings.Settings
-	16, // 4: services.centrum.GetDispatchHeatmapResponse.entries:type_name -> resources.livemap.heatmap.HeatmapEntry
-	17, // 5: services.centrum.UpdateDispatchersResponse.dispatchers:type_name -> resources.centrum.dispatchers.Dispatchers
-	18, // 6: services.centrum.StreamHandshake.server_time:type_name -> resources.timestamp.Timestamp
-	14, // 7: services.centrum.StreamHandshake.settings:type_name -> resources.centrum.settings.Settings
-	15, // 8: services.centrum.StreamHandshake.access:type_name -> resources.centrum.settings.EffectiveAccess
-	19, // 9: services.centrum.LatestState.dispatchers:type_name -> resources.centrum.dispatchers.JobDispatchers
-	20, // 10: services.centrum.LatestState.units:type_name -> resources.centrum.units.Unit
-	21, // 11: services.centrum.LatestState.dispatches:type_name -> resources.centrum.dispatches.Dispatch
-	10, // 12: services.centrum.StreamResponse.handshake:type_name -> services.centrum.StreamHandshake
-	11, // 13: services.centrum.StreamResponse.latest_state:type_name -> services.centrum.LatestState
-	14, // 14: services.centrum.StreamResponse.settings:type_name -> resources.centrum.settings.Settings
-	15, // 15: services.centrum.StreamResponse.access:type_name -> resources.centrum.settings.EffectiveAccess
-	17, // 16: services.centrum.StreamResponse.dispatchers:type_name -> resources.centrum.dispatchers.Dispatchers
-	20, // 17: services.centrum.StreamResponse.unit_updated:type_name -> resources.centrum.units.Unit
-	22, // 18: services.centrum.StreamResponse.unit_status:type_name -> resources.centrum.units.UnitStatus
-	21, // 19: services.centrum.StreamResponse.dispatch_updated:type_name -> resources.centrum.dispatches.Dispatch
-	23, // 20: services.centrum.StreamResponse.dispatch_status:type_name -> resources.centrum.dispatches.DispatchStatus
-	0,  // 21: services.centrum.CentrumService.GetSettings:input_type -> services.centrum.GetSettingsRequest
-	2,  // 22: services.centrum.CentrumService.UpdateSettings:input_type -> services.centrum.UpdateSettingsRequest
-	4,  // 23: services.centrum.CentrumService.GetDispatchHeatmap:input_type -> services.centrum.GetDispatchHeatmapRequest
-	6,  // 24: services.centrum.CentrumService.TakeControl:input_type -> services.centrum.TakeControlRequest
-	8,  // 25: services.centrum.CentrumService.UpdateDispatchers:input_type -> services.centrum.UpdateDispatchersRequest
-	12, // 26: services.centrum.CentrumService.Stream:input_type -> services.centrum.StreamRequest
-	1,  // 27: services.centrum.CentrumService.GetSettings:output_type -> services.centrum.GetSettingsResponse
-	3,  // 28: services.centrum.CentrumService.UpdateSettings:output_type -> services.centrum.UpdateSettingsResponse
-	5,  // 29: services.centrum.CentrumService.GetDispatchHeatmap:output_type -> services.centrum.GetDispatchHeatmapResponse
-	7,  // 30: services.centrum.CentrumService.TakeControl:output_type -> services.centrum.TakeControlResponse
-	9,  // 31: services.centrum.CentrumService.UpdateDispatchers:output_type -> services.centrum.UpdateDispatchersResponse
-	13, // 32: services.centrum.CentrumService.Stream:output_type -> services.centrum.StreamResponse
-	27, // [27:33] is the sub-list for method output_type
-	21, // [21:27] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	16, // 0: services.centrum.GetSettingsResponse.settings:type_name -> resources.centrum.settings.Settings
+	17, // 1: services.centrum.GetSettingsResponse.effective_access:type_name -> resources.centrum.settings.EffectiveAccess
+	16, // 2: services.centrum.UpdateSettingsRequest.settings:type_name -> resources.centrum.settings.Settings
+	16, // 3: services.centrum.UpdateSettingsResponse.settings:type_name -> resources.centrum.settings.Settings
+	18, // 4: services.centrum.GetDispatchHeatmapResponse.entries:type_name -> resources.livemap.heatmap.HeatmapEntry
+	19, // 5: services.centrum.GetDispatchStatsRequest.start:type_name -> resources.timestamp.Timestamp
+	19, // 6: services.centrum.GetDispatchStatsRequest.end:type_name -> resources.timestamp.Timestamp
+	20, // 7: services.centrum.GetDispatchStatsRequest.dimension:type_name -> resources.centrum.dispatches.DispatchStatsDimension
+	21, // 8: services.centrum.GetDispatchStatsResponse.stats:type_name -> resources.centrum.dispatches.DispatchStats
+	22, // 9: services.centrum.UpdateDispatchersResponse.dispatchers:type_name -> resources.centrum.dispatchers.Dispatchers
+	19, // 10: services.centrum.StreamHandshake.server_time:type_name -> resources.timestamp.Timestamp
+	16, // 11: services.centrum.StreamHandshake.settings:type_name -> resources.centrum.settings.Settings
+	17, // 12: services.centrum.StreamHandshake.access:type_name -> resources.centrum.settings.EffectiveAccess
+	23, // 13: services.centrum.LatestState.dispatchers:type_name -> resources.centrum.dispatchers.JobDispatchers
+	24, // 14: services.centrum.LatestState.units:type_name -> resources.centrum.units.Unit
+	25, // 15: services.centrum.LatestState.dispatches:type_name -> resources.centrum.dispatches.Dispatch
+	12, // 16: services.centrum.StreamResponse.handshake:type_name -> services.centrum.StreamHandshake
+	13, // 17: services.centrum.StreamResponse.latest_state:type_name -> services.centrum.LatestState
+	16, // 18: services.centrum.StreamResponse.settings:type_name -> resources.centrum.settings.Settings
+	17, // 19: services.centrum.StreamResponse.access:type_name -> resources.centrum.settings.EffectiveAccess
+	22, // 20: services.centrum.StreamResponse.dispatchers:type_name -> resources.centrum.dispatchers.Dispatchers
+	24, // 21: services.centrum.StreamResponse.unit_updated:type_name -> resources.centrum.units.Unit
+	26, // 22: services.centrum.StreamResponse.unit_status:type_name -> resources.centrum.units.UnitStatus
+	25, // 23: services.centrum.StreamResponse.dispatch_updated:type_name -> resources.centrum.dispatches.Dispatch
+	27, // 24: services.centrum.StreamResponse.dispatch_status:type_name -> resources.centrum.dispatches.DispatchStatus
+	0,  // 25: services.centrum.CentrumService.GetSettings:input_type -> services.centrum.GetSettingsRequest
+	2,  // 26: services.centrum.CentrumService.UpdateSettings:input_type -> services.centrum.UpdateSettingsRequest
+	4,  // 27: services.centrum.CentrumService.GetDispatchHeatmap:input_type -> services.centrum.GetDispatchHeatmapRequest
+	6,  // 28: services.centrum.CentrumService.GetDispatchStats:input_type -> services.centrum.GetDispatchStatsRequest
+	8,  // 29: services.centrum.CentrumService.TakeControl:input_type -> services.centrum.TakeControlRequest
+	10, // 30: services.centrum.CentrumService.UpdateDispatchers:input_type -> services.centrum.UpdateDispatchersRequest
+	14, // 31: services.centrum.CentrumService.Stream:input_type -> services.centrum.StreamRequest
+	1,  // 32: services.centrum.CentrumService.GetSettings:output_type -> services.centrum.GetSettingsResponse
+	3,  // 33: services.centrum.CentrumService.UpdateSettings:output_type -> services.centrum.UpdateSettingsResponse
+	5,  // 34: services.centrum.CentrumService.GetDispatchHeatmap:output_type -> services.centrum.GetDispatchHeatmapResponse
+	7,  // 35: services.centrum.CentrumService.GetDispatchStats:output_type -> services.centrum.GetDispatchStatsResponse
+	9,  // 36: services.centrum.CentrumService.TakeControl:output_type -> services.centrum.TakeControlResponse
+	11, // 37: services.centrum.CentrumService.UpdateDispatchers:output_type -> services.centrum.UpdateDispatchersResponse
+	15, // 38: services.centrum.CentrumService.Stream:output_type -> services.centrum.StreamResponse
+	32, // [32:39] is the sub-list for method output_type
+	25, // [25:32] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_services_centrum_centrum_proto_init() }
@@ -1632,8 +1839,9 @@ func file_services_centrum_centrum_proto_init() {
 	if File_services_centrum_centrum_proto != nil {
 		return
 	}
-	file_services_centrum_centrum_proto_msgTypes[11].OneofWrappers = []any{}
-	file_services_centrum_centrum_proto_msgTypes[13].OneofWrappers = []any{
+	file_services_centrum_centrum_proto_msgTypes[6].OneofWrappers = []any{}
+	file_services_centrum_centrum_proto_msgTypes[13].OneofWrappers = []any{}
+	file_services_centrum_centrum_proto_msgTypes[15].OneofWrappers = []any{
 		(*streamResponse_Handshake)(nil),
 		(*streamResponse_LatestState)(nil),
 		(*streamResponse_Settings)(nil),
@@ -1652,7 +1860,7 @@ func file_services_centrum_centrum_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_centrum_centrum_proto_rawDesc), len(file_services_centrum_centrum_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
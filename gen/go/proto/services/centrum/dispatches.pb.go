@@ -29,15 +29,17 @@ const (
 )
 
 type ListDispatchesRequest struct {
-	state         protoimpl.MessageState      `protogen:"hybrid.v1"`
-	Pagination    *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Status        []dispatches.StatusDispatch `protobuf:"varint,2,rep,packed,name=status,proto3,enum=resources.centrum.dispatches.StatusDispatch" json:"status,omitempty"`
-	NotStatus     []dispatches.StatusDispatch `protobuf:"varint,3,rep,packed,name=not_status,json=notStatus,proto3,enum=resources.centrum.dispatches.StatusDispatch" json:"not_status,omitempty"`
-	Ids           []int64                     `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Postal        *string                     `protobuf:"bytes,5,opt,name=postal,proto3,oneof" json:"postal,omitempty"`
-	CreatorIds    []int32                     `protobuf:"varint,6,rep,packed,name=creator_ids,json=creatorIds,proto3" json:"creator_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState      `protogen:"hybrid.v1"`
+	Pagination *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Status     []dispatches.StatusDispatch `protobuf:"varint,2,rep,packed,name=status,proto3,enum=resources.centrum.dispatches.StatusDispatch" json:"status,omitempty"`
+	NotStatus  []dispatches.StatusDispatch `protobuf:"varint,3,rep,packed,name=not_status,json=notStatus,proto3,enum=resources.centrum.dispatches.StatusDispatch" json:"not_status,omitempty"`
+	Ids        []int64                     `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Postal     *string                     `protobuf:"bytes,5,opt,name=postal,proto3,oneof" json:"postal,omitempty"`
+	CreatorIds []int32                     `protobuf:"varint,6,rep,packed,name=creator_ids,json=creatorIds,proto3" json:"creator_ids,omitempty"`
+	// Only list active dispatches whose unit requirements aren't met (yet)
+	RequirementsUnmet *bool `protobuf:"varint,7,opt,name=requirements_unmet,json=requirementsUnmet,proto3,oneof" json:"requirements_unmet,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListDispatchesRequest) Reset() {
@@ -107,6 +109,13 @@ func (x *ListDispatchesRequest) GetCreatorIds() []int32 {
 	return nil
 }
 
+func (x *ListDispatchesRequest) GetRequirementsUnmet() bool {
+	if x != nil && x.RequirementsUnmet != nil {
+		return *x.RequirementsUnmet
+	}
+	return false
+}
+
 func (x *ListDispatchesRequest) SetPagination(v *database.PaginationRequest) {
 	x.Pagination = v
 }
@@ -131,6 +140,10 @@ func (x *ListDispatchesRequest) SetCreatorIds(v []int32) {
 	x.CreatorIds = v
 }
 
+func (x *ListDispatchesRequest) SetRequirementsUnmet(v bool) {
+	x.RequirementsUnmet = &v
+}
+
 func (x *ListDispatchesRequest) HasPagination() bool {
 	if x == nil {
 		return false
@@ -145,6 +158,13 @@ func (x *ListDispatchesRequest) HasPostal() bool {
 	return x.Postal != nil
 }
 
+func (x *ListDispatchesRequest) HasRequirementsUnmet() bool {
+	if x == nil {
+		return false
+	}
+	return x.RequirementsUnmet != nil
+}
+
 func (x *ListDispatchesRequest) ClearPagination() {
 	x.Pagination = nil
 }
@@ -153,6 +173,10 @@ func (x *ListDispatchesRequest) ClearPostal() {
 	x.Postal = nil
 }
 
+func (x *ListDispatchesRequest) ClearRequirementsUnmet() {
+	x.RequirementsUnmet = nil
+}
+
 type ListDispatchesRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -162,6 +186,8 @@ type ListDispatchesRequest_builder struct {
 	Ids        []int64
 	Postal     *string
 	CreatorIds []int32
+	// Only list active dispatches whose unit requirements aren't met (yet)
+	RequirementsUnmet *bool
 }
 
 func (b0 ListDispatchesRequest_builder) Build() *ListDispatchesRequest {
@@ -174,6 +200,7 @@ func (b0 ListDispatchesRequest_builder) Build() *ListDispatchesRequest {
 	x.Ids = b.Ids
 	x.Postal = b.Postal
 	x.CreatorIds = b.CreatorIds
+	x.RequirementsUnmet = b.RequirementsUnmet
 	return m0
 }
 
@@ -549,8 +576,10 @@ func (b0 ListDispatchActivityResponse_builder) Build() *ListDispatchActivityResp
 }
 
 type CreateDispatchRequest struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Dispatch      *dispatches.Dispatch   `protobuf:"bytes,1,opt,name=dispatch,proto3" json:"dispatch,omitempty"`
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	Dispatch *dispatches.Dispatch   `protobuf:"bytes,1,opt,name=dispatch,proto3" json:"dispatch,omitempty"`
+	// Code of a dispatch template of the user's job, the template's defaults are used for unset fields
+	TemplateCode  *string `protobuf:"bytes,2,opt,name=template_code,json=templateCode,proto3,oneof" json:"template_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -587,10 +616,21 @@ func (x *CreateDispatchRequest) GetDispatch() *dispatches.Dispatch {
 	return nil
 }
 
+func (x *CreateDispatchRequest) GetTemplateCode() string {
+	if x != nil && x.TemplateCode != nil {
+		return *x.TemplateCode
+	}
+	return ""
+}
+
 func (x *CreateDispatchRequest) SetDispatch(v *dispatches.Dispatch) {
 	x.Dispatch = v
 }
 
+func (x *CreateDispatchRequest) SetTemplateCode(v string) {
+	x.TemplateCode = &v
+}
+
 func (x *CreateDispatchRequest) HasDispatch() bool {
 	if x == nil {
 		return false
@@ -598,14 +638,27 @@ func (x *CreateDispatchRequest) HasDispatch() bool {
 	return x.Dispatch != nil
 }
 
+func (x *CreateDispatchRequest) HasTemplateCode() bool {
+	if x == nil {
+		return false
+	}
+	return x.TemplateCode != nil
+}
+
 func (x *CreateDispatchRequest) ClearDispatch() {
 	x.Dispatch = nil
 }
 
+func (x *CreateDispatchRequest) ClearTemplateCode() {
+	x.TemplateCode = nil
+}
+
 type CreateDispatchRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Dispatch *dispatches.Dispatch
+	// Code of a dispatch template of the user's job, the template's defaults are used for unset fields
+	TemplateCode *string
 }
 
 func (b0 CreateDispatchRequest_builder) Build() *CreateDispatchRequest {
@@ -613,6 +666,7 @@ func (b0 CreateDispatchRequest_builder) Build() *CreateDispatchRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Dispatch = b.Dispatch
+	x.TemplateCode = b.TemplateCode
 	return m0
 }
 
@@ -1476,11 +1530,605 @@ func (b0 TakeDispatchResponse_builder) Build() *TakeDispatchResponse {
 	return m0
 }
 
+type GetDispatchTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDispatchTimelineRequest) Reset() {
+	*x = GetDispatchTimelineRequest{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDispatchTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDispatchTimelineRequest) ProtoMessage() {}
+
+func (x *GetDispatchTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDispatchTimelineRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetDispatchTimelineRequest) SetId(v int64) {
+	x.Id = v
+}
+
+type GetDispatchTimelineRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id int64
+}
+
+func (b0 GetDispatchTimelineRequest_builder) Build() *GetDispatchTimelineRequest {
+	m0 := &GetDispatchTimelineRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type GetDispatchTimelineResponse struct {
+	state         protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Timeline      *dispatches.DispatchTimeline `protobuf:"bytes,1,opt,name=timeline,proto3" json:"timeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDispatchTimelineResponse) Reset() {
+	*x = GetDispatchTimelineResponse{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDispatchTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDispatchTimelineResponse) ProtoMessage() {}
+
+func (x *GetDispatchTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDispatchTimelineResponse) GetTimeline() *dispatches.DispatchTimeline {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *GetDispatchTimelineResponse) SetTimeline(v *dispatches.DispatchTimeline) {
+	x.Timeline = v
+}
+
+func (x *GetDispatchTimelineResponse) HasTimeline() bool {
+	if x == nil {
+		return false
+	}
+	return x.Timeline != nil
+}
+
+func (x *GetDispatchTimelineResponse) ClearTimeline() {
+	x.Timeline = nil
+}
+
+type GetDispatchTimelineResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Timeline *dispatches.DispatchTimeline
+}
+
+func (b0 GetDispatchTimelineResponse_builder) Build() *GetDispatchTimelineResponse {
+	m0 := &GetDispatchTimelineResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Timeline = b.Timeline
+	return m0
+}
+
+type ExportDispatchTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDispatchTimelineRequest) Reset() {
+	*x = ExportDispatchTimelineRequest{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDispatchTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDispatchTimelineRequest) ProtoMessage() {}
+
+func (x *ExportDispatchTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportDispatchTimelineRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExportDispatchTimelineRequest) SetId(v int64) {
+	x.Id = v
+}
+
+type ExportDispatchTimelineRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id int64
+}
+
+func (b0 ExportDispatchTimelineRequest_builder) Build() *ExportDispatchTimelineRequest {
+	m0 := &ExportDispatchTimelineRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type ExportDispatchTimelineResponse struct {
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	FileName string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// JSON encoded dispatch timeline
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDispatchTimelineResponse) Reset() {
+	*x = ExportDispatchTimelineResponse{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDispatchTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDispatchTimelineResponse) ProtoMessage() {}
+
+func (x *ExportDispatchTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportDispatchTimelineResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportDispatchTimelineResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportDispatchTimelineResponse) SetFileName(v string) {
+	x.FileName = v
+}
+
+func (x *ExportDispatchTimelineResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Data = v
+}
+
+type ExportDispatchTimelineResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	FileName string
+	// JSON encoded dispatch timeline
+	Data []byte
+}
+
+func (b0 ExportDispatchTimelineResponse_builder) Build() *ExportDispatchTimelineResponse {
+	m0 := &ExportDispatchTimelineResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.FileName = b.FileName
+	x.Data = b.Data
+	return m0
+}
+
+type ListDispatchTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDispatchTemplatesRequest) Reset() {
+	*x = ListDispatchTemplatesRequest{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDispatchTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDispatchTemplatesRequest) ProtoMessage() {}
+
+func (x *ListDispatchTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListDispatchTemplatesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListDispatchTemplatesRequest_builder) Build() *ListDispatchTemplatesRequest {
+	m0 := &ListDispatchTemplatesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListDispatchTemplatesResponse struct {
+	state         protoimpl.MessageState         `protogen:"hybrid.v1"`
+	Templates     []*dispatches.DispatchTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDispatchTemplatesResponse) Reset() {
+	*x = ListDispatchTemplatesResponse{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDispatchTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDispatchTemplatesResponse) ProtoMessage() {}
+
+func (x *ListDispatchTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDispatchTemplatesResponse) GetTemplates() []*dispatches.DispatchTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListDispatchTemplatesResponse) SetTemplates(v []*dispatches.DispatchTemplate) {
+	x.Templates = v
+}
+
+type ListDispatchTemplatesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Templates []*dispatches.DispatchTemplate
+}
+
+func (b0 ListDispatchTemplatesResponse_builder) Build() *ListDispatchTemplatesResponse {
+	m0 := &ListDispatchTemplatesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Templates = b.Templates
+	return m0
+}
+
+type CreateOrUpdateDispatchTemplateRequest struct {
+	state         protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Template      *dispatches.DispatchTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateDispatchTemplateRequest) Reset() {
+	*x = CreateOrUpdateDispatchTemplateRequest{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateDispatchTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateDispatchTemplateRequest) ProtoMessage() {}
+
+func (x *CreateOrUpdateDispatchTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrUpdateDispatchTemplateRequest) GetTemplate() *dispatches.DispatchTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateDispatchTemplateRequest) SetTemplate(v *dispatches.DispatchTemplate) {
+	x.Template = v
+}
+
+func (x *CreateOrUpdateDispatchTemplateRequest) HasTemplate() bool {
+	if x == nil {
+		return false
+	}
+	return x.Template != nil
+}
+
+func (x *CreateOrUpdateDispatchTemplateRequest) ClearTemplate() {
+	x.Template = nil
+}
+
+type CreateOrUpdateDispatchTemplateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Template *dispatches.DispatchTemplate
+}
+
+func (b0 CreateOrUpdateDispatchTemplateRequest_builder) Build() *CreateOrUpdateDispatchTemplateRequest {
+	m0 := &CreateOrUpdateDispatchTemplateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Template = b.Template
+	return m0
+}
+
+type CreateOrUpdateDispatchTemplateResponse struct {
+	state         protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Template      *dispatches.DispatchTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateDispatchTemplateResponse) Reset() {
+	*x = CreateOrUpdateDispatchTemplateResponse{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateDispatchTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateDispatchTemplateResponse) ProtoMessage() {}
+
+func (x *CreateOrUpdateDispatchTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrUpdateDispatchTemplateResponse) GetTemplate() *dispatches.DispatchTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateDispatchTemplateResponse) SetTemplate(v *dispatches.DispatchTemplate) {
+	x.Template = v
+}
+
+func (x *CreateOrUpdateDispatchTemplateResponse) HasTemplate() bool {
+	if x == nil {
+		return false
+	}
+	return x.Template != nil
+}
+
+func (x *CreateOrUpdateDispatchTemplateResponse) ClearTemplate() {
+	x.Template = nil
+}
+
+type CreateOrUpdateDispatchTemplateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Template *dispatches.DispatchTemplate
+}
+
+func (b0 CreateOrUpdateDispatchTemplateResponse_builder) Build() *CreateOrUpdateDispatchTemplateResponse {
+	m0 := &CreateOrUpdateDispatchTemplateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Template = b.Template
+	return m0
+}
+
+type DeleteDispatchTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDispatchTemplateRequest) Reset() {
+	*x = DeleteDispatchTemplateRequest{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDispatchTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDispatchTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteDispatchTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteDispatchTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteDispatchTemplateRequest) SetId(v int64) {
+	x.Id = v
+}
+
+type DeleteDispatchTemplateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id int64
+}
+
+func (b0 DeleteDispatchTemplateRequest_builder) Build() *DeleteDispatchTemplateRequest {
+	m0 := &DeleteDispatchTemplateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type DeleteDispatchTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDispatchTemplateResponse) Reset() {
+	*x = DeleteDispatchTemplateResponse{}
+	mi := &file_services_centrum_dispatches_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDispatchTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDispatchTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteDispatchTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_dispatches_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteDispatchTemplateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteDispatchTemplateResponse_builder) Build() *DeleteDispatchTemplateResponse {
+	m0 := &DeleteDispatchTemplateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
 var File_services_centrum_dispatches_proto protoreflect.FileDescriptor
 
 const file_services_centrum_dispatches_proto_rawDesc = "" +
 	"\n" +
-	"!services/centrum/dispatches.proto\x12\x10services.centrum\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a-resources/centrum/dispatches/dispatches.proto\x1a(resources/common/database/database.proto\x1a\x19resources/jobs/jobs.proto\"\xd3\x02\n" +
+	"!services/centrum/dispatches.proto\x12\x10services.centrum\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a-resources/centrum/dispatches/dispatches.proto\x1a,resources/centrum/dispatches/templates.proto\x1a+resources/centrum/dispatches/timeline.proto\x1a(resources/common/database/database.proto\x1a\x19resources/jobs/jobs.proto\"\x9e\x03\n" +
 	"\x15ListDispatchesRequest\x12L\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
@@ -1491,8 +2139,10 @@ const file_services_centrum_dispatches_proto_rawDesc = "" +
 	"\x03ids\x18\x04 \x03(\x03R\x03ids\x12\x1b\n" +
 	"\x06postal\x18\x05 \x01(\tH\x00R\x06postal\x88\x01\x01\x12\x1f\n" +
 	"\vcreator_ids\x18\x06 \x03(\x05R\n" +
-	"creatorIdsB\t\n" +
-	"\a_postal\"\xb5\x01\n" +
+	"creatorIds\x122\n" +
+	"\x12requirements_unmet\x18\a \x01(\bH\x01R\x11requirementsUnmet\x88\x01\x01B\t\n" +
+	"\a_postalB\x15\n" +
+	"\x13_requirements_unmet\"\xb5\x01\n" +
 	"\x16ListDispatchesResponse\x12M\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2-.resources.common.database.PaginationResponseR\n" +
@@ -1513,9 +2163,11 @@ const file_services_centrum_dispatches_proto_rawDesc = "" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2-.resources.common.database.PaginationResponseR\n" +
 	"pagination\x12N\n" +
-	"\bactivity\x18\x02 \x03(\v2,.resources.centrum.dispatches.DispatchStatusB\x04\xc8\xf3\x18\x01R\bactivity\"[\n" +
+	"\bactivity\x18\x02 \x03(\v2,.resources.centrum.dispatches.DispatchStatusB\x04\xc8\xf3\x18\x01R\bactivity\"\x97\x01\n" +
 	"\x15CreateDispatchRequest\x12B\n" +
-	"\bdispatch\x18\x01 \x01(\v2&.resources.centrum.dispatches.DispatchR\bdispatch\"\\\n" +
+	"\bdispatch\x18\x01 \x01(\v2&.resources.centrum.dispatches.DispatchR\bdispatch\x12(\n" +
+	"\rtemplate_code\x18\x02 \x01(\tH\x00R\ftemplateCode\x88\x01\x01B\x10\n" +
+	"\x0e_template_code\"\\\n" +
 	"\x16CreateDispatchResponse\x12B\n" +
 	"\bdispatch\x18\x01 \x01(\v2&.resources.centrum.dispatches.DispatchR\bdispatch\"[\n" +
 	"\x15UpdateDispatchRequest\x12B\n" +
@@ -1550,8 +2202,26 @@ const file_services_centrum_dispatches_proto_rawDesc = "" +
 	"\x04resp\x18\x02 \x01(\x0e2..resources.centrum.dispatches.TakeDispatchRespR\x04resp\x12#\n" +
 	"\x06reason\x18\x03 \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x00R\x06reason\x88\x01\x01B\t\n" +
 	"\a_reason\"\x16\n" +
-	"\x14TakeDispatchResponse2\xcb\n" +
-	"\n" +
+	"\x14TakeDispatchResponse\",\n" +
+	"\x1aGetDispatchTimelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"i\n" +
+	"\x1bGetDispatchTimelineResponse\x12J\n" +
+	"\btimeline\x18\x01 \x01(\v2..resources.centrum.dispatches.DispatchTimelineR\btimeline\"/\n" +
+	"\x1dExportDispatchTimelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"Q\n" +
+	"\x1eExportDispatchTimelineResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\x1e\n" +
+	"\x1cListDispatchTemplatesRequest\"s\n" +
+	"\x1dListDispatchTemplatesResponse\x12R\n" +
+	"\ttemplates\x18\x01 \x03(\v2..resources.centrum.dispatches.DispatchTemplateB\x04\xc8\xf3\x18\x01R\ttemplates\"s\n" +
+	"%CreateOrUpdateDispatchTemplateRequest\x12J\n" +
+	"\btemplate\x18\x01 \x01(\v2..resources.centrum.dispatches.DispatchTemplateR\btemplate\"t\n" +
+	"&CreateOrUpdateDispatchTemplateResponse\x12J\n" +
+	"\btemplate\x18\x01 \x01(\v2..resources.centrum.dispatches.DispatchTemplateR\btemplate\"/\n" +
+	"\x1dDeleteDispatchTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\" \n" +
+	"\x1eDeleteDispatchTemplateResponse2\x99\x11\n" +
 	"\x11DispatchesService\x12k\n" +
 	"\x0eCreateDispatch\x12'.services.centrum.CreateDispatchRequest\x1a(.services.centrum.CreateDispatchResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12k\n" +
 	"\x0eUpdateDispatch\x12'.services.centrum.UpdateDispatchRequest\x1a(.services.centrum.UpdateDispatchResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12k\n" +
@@ -1562,80 +2232,111 @@ const file_services_centrum_dispatches_proto_rawDesc = "" +
 	"\x0eListDispatches\x12'.services.centrum.ListDispatchesRequest\x1a(.services.centrum.ListDispatchesResponse\"'\xd2\xf3\x18#\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x06Stream\x12\x9e\x01\n" +
 	"\x14ListDispatchActivity\x12-.services.centrum.ListDispatchActivityRequest\x1a..services.centrum.ListDispatchActivityResponse\"'\xd2\xf3\x18#\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x06Stream\x12e\n" +
 	"\fTakeDispatch\x12%.services.centrum.TakeDispatchRequest\x1a&.services.centrum.TakeDispatchResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12\x8b\x01\n" +
-	"\x14UpdateDispatchStatus\x12-.services.centrum.UpdateDispatchStatusRequest\x1a..services.centrum.UpdateDispatchStatusResponse\"\x14\xd2\xf3\x18\x10\b\x01\"\fTakeDispatch\x1a\x1a\xea\xf3\x18\x16\bi\x12\x12i-mdi-target-arrowBLZJgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum;centrumb\x06proto3"
-
-var file_services_centrum_dispatches_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+	"\x14UpdateDispatchStatus\x12-.services.centrum.UpdateDispatchStatusRequest\x1a..services.centrum.UpdateDispatchStatusResponse\"\x14\xd2\xf3\x18\x10\b\x01\"\fTakeDispatch\x12\x9b\x01\n" +
+	"\x13GetDispatchTimeline\x12,.services.centrum.GetDispatchTimelineRequest\x1a-.services.centrum.GetDispatchTimelineResponse\"'\xd2\xf3\x18#\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x06Stream\x12\xa4\x01\n" +
+	"\x16ExportDispatchTimeline\x12/.services.centrum.ExportDispatchTimelineRequest\x1a0.services.centrum.ExportDispatchTimelineResponse\"'\xd2\xf3\x18#\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x06Stream\x12\x90\x01\n" +
+	"\x15ListDispatchTemplates\x12..services.centrum.ListDispatchTemplatesRequest\x1a/.services.centrum.ListDispatchTemplatesResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eCreateDispatch\x12\xc4\x01\n" +
+	"\x1eCreateOrUpdateDispatchTemplate\x127.services.centrum.CreateOrUpdateDispatchTemplateRequest\x1a8.services.centrum.CreateOrUpdateDispatchTemplateResponse\"/\xd2\xf3\x18+\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x0eUpdateSettings\x12\xac\x01\n" +
+	"\x16DeleteDispatchTemplate\x12/.services.centrum.DeleteDispatchTemplateRequest\x1a0.services.centrum.DeleteDispatchTemplateResponse\"/\xd2\xf3\x18+\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x0eUpdateSettings\x1a\x1a\xea\xf3\x18\x16\bi\x12\x12i-mdi-target-arrowBLZJgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum;centrumb\x06proto3"
+
+var file_services_centrum_dispatches_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_services_centrum_dispatches_proto_goTypes = []any{
-	(*ListDispatchesRequest)(nil),          // 0: services.centrum.ListDispatchesRequest
-	(*ListDispatchesResponse)(nil),         // 1: services.centrum.ListDispatchesResponse
-	(*GetDispatchRequest)(nil),             // 2: services.centrum.GetDispatchRequest
-	(*GetDispatchResponse)(nil),            // 3: services.centrum.GetDispatchResponse
-	(*ListDispatchActivityRequest)(nil),    // 4: services.centrum.ListDispatchActivityRequest
-	(*ListDispatchActivityResponse)(nil),   // 5: services.centrum.ListDispatchActivityResponse
-	(*CreateDispatchRequest)(nil),          // 6: services.centrum.CreateDispatchRequest
-	(*CreateDispatchResponse)(nil),         // 7: services.centrum.CreateDispatchResponse
-	(*UpdateDispatchRequest)(nil),          // 8: services.centrum.UpdateDispatchRequest
-	(*UpdateDispatchResponse)(nil),         // 9: services.centrum.UpdateDispatchResponse
-	(*DeleteDispatchRequest)(nil),          // 10: services.centrum.DeleteDispatchRequest
-	(*DeleteDispatchResponse)(nil),         // 11: services.centrum.DeleteDispatchResponse
-	(*ListDispatchTargetJobsRequest)(nil),  // 12: services.centrum.ListDispatchTargetJobsRequest
-	(*ListDispatchTargetJobsResponse)(nil), // 13: services.centrum.ListDispatchTargetJobsResponse
-	(*UpdateDispatchStatusRequest)(nil),    // 14: services.centrum.UpdateDispatchStatusRequest
-	(*UpdateDispatchStatusResponse)(nil),   // 15: services.centrum.UpdateDispatchStatusResponse
-	(*AssignDispatchRequest)(nil),          // 16: services.centrum.AssignDispatchRequest
-	(*AssignDispatchResponse)(nil),         // 17: services.centrum.AssignDispatchResponse
-	(*TakeDispatchRequest)(nil),            // 18: services.centrum.TakeDispatchRequest
-	(*TakeDispatchResponse)(nil),           // 19: services.centrum.TakeDispatchResponse
-	(*database.PaginationRequest)(nil),     // 20: resources.common.database.PaginationRequest
-	(dispatches.StatusDispatch)(0),         // 21: resources.centrum.dispatches.StatusDispatch
-	(*database.PaginationResponse)(nil),    // 22: resources.common.database.PaginationResponse
-	(*dispatches.Dispatch)(nil),            // 23: resources.centrum.dispatches.Dispatch
-	(*dispatches.DispatchStatus)(nil),      // 24: resources.centrum.dispatches.DispatchStatus
-	(*jobs.Job)(nil),                       // 25: resources.jobs.Job
-	(dispatches.TakeDispatchResp)(0),       // 26: resources.centrum.dispatches.TakeDispatchResp
+	(*ListDispatchesRequest)(nil),                  // 0: services.centrum.ListDispatchesRequest
+	(*ListDispatchesResponse)(nil),                 // 1: services.centrum.ListDispatchesResponse
+	(*GetDispatchRequest)(nil),                     // 2: services.centrum.GetDispatchRequest
+	(*GetDispatchResponse)(nil),                    // 3: services.centrum.GetDispatchResponse
+	(*ListDispatchActivityRequest)(nil),            // 4: services.centrum.ListDispatchActivityRequest
+	(*ListDispatchActivityResponse)(nil),           // 5: services.centrum.ListDispatchActivityResponse
+	(*CreateDispatchRequest)(nil),                  // 6: services.centrum.CreateDispatchRequest
+	(*CreateDispatchResponse)(nil),                 // 7: services.centrum.CreateDispatchResponse
+	(*UpdateDispatchRequest)(nil),                  // 8: services.centrum.UpdateDispatchRequest
+	(*UpdateDispatchResponse)(nil),                 // 9: services.centrum.UpdateDispatchResponse
+	(*DeleteDispatchRequest)(nil),                  // 10: services.centrum.DeleteDispatchRequest
+	(*DeleteDispatchResponse)(nil),                 // 11: services.centrum.DeleteDispatchResponse
+	(*ListDispatchTargetJobsRequest)(nil),          // 12: services.centrum.ListDispatchTargetJobsRequest
+	(*ListDispatchTargetJobsResponse)(nil),         // 13: services.centrum.ListDispatchTargetJobsResponse
+	(*UpdateDispatchStatusRequest)(nil),            // 14: services.centrum.UpdateDispatchStatusRequest
+	(*UpdateDispatchStatusResponse)(nil),           // 15: services.centrum.UpdateDispatchStatusResponse
+	(*AssignDispatchRequest)(nil),                  // 16: services.centrum.AssignDispatchRequest
+	(*AssignDispatchResponse)(nil),                 // 17: services.centrum.AssignDispatchResponse
+	(*TakeDispatchRequest)(nil),                    // 18: services.centrum.TakeDispatchRequest
+	(*TakeDispatchResponse)(nil),                   // 19: services.centrum.TakeDispatchResponse
+	(*GetDispatchTimelineRequest)(nil),             // 20: services.centrum.GetDispatchTimelineRequest
+	(*GetDispatchTimelineResponse)(nil),            // 21: services.centrum.GetDispatchTimelineResponse
+	(*ExportDispatchTimelineRequest)(nil),          // 22: services.centrum.ExportDispatchTimelineRequest
+	(*ExportDispatchTimelineResponse)(nil),         // 23: services.centrum.ExportDispatchTimelineResponse
+	(*ListDispatchTemplatesRequest)(nil),           // 24: services.centrum.ListDispatchTemplatesRequest
+	(*ListDispatchTemplatesResponse)(nil),          // 25: services.centrum.ListDispatchTemplatesResponse
+	(*CreateOrUpdateDispatchTemplateRequest)(nil),  // 26: services.centrum.CreateOrUpdateDispatchTemplateRequest
+	(*CreateOrUpdateDispatchTemplateResponse)(nil), // 27: services.centrum.CreateOrUpdateDispatchTemplateResponse
+	(*DeleteDispatchTemplateRequest)(nil),          // 28: services.centrum.DeleteDispatchTemplateRequest
+	(*DeleteDispatchTemplateResponse)(nil),         // 29: services.centrum.DeleteDispatchTemplateResponse
+	(*database.PaginationRequest)(nil),             // 30: resources.common.database.PaginationRequest
+	(dispatches.StatusDispatch)(0),                 // 31: resources.centrum.dispatches.StatusDispatch
+	(*database.PaginationResponse)(nil),            // 32: resources.common.database.PaginationResponse
+	(*dispatches.Dispatch)(nil),                    // 33: resources.centrum.dispatches.Dispatch
+	(*dispatches.DispatchStatus)(nil),              // 34: resources.centrum.dispatches.DispatchStatus
+	(*jobs.Job)(nil),                               // 35: resources.jobs.Job
+	(dispatches.TakeDispatchResp)(0),               // 36: resources.centrum.dispatches.TakeDispatchResp
+	(*dispatches.DispatchTimeline)(nil),            // 37: resources.centrum.dispatches.DispatchTimeline
+	(*dispatches.DispatchTemplate)(nil),            // 38: resources.centrum.dispatches.DispatchTemplate
 }
 var file_services_centrum_dispatches_proto_depIdxs = []int32{
-	20, // 0: services.centrum.ListDispatchesRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	21, // 1: services.centrum.ListDispatchesRequest.status:type_name -> resources.centrum.dispatches.StatusDispatch
-	21, // 2: services.centrum.ListDispatchesRequest.not_status:type_name -> resources.centrum.dispatches.StatusDispatch
-	22, // 3: services.centrum.ListDispatchesResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	23, // 4: services.centrum.ListDispatchesResponse.dispatches:type_name -> resources.centrum.dispatches.Dispatch
-	23, // 5: services.centrum.GetDispatchResponse.dispatch:type_name -> resources.centrum.dispatches.Dispatch
-	20, // 6: services.centrum.ListDispatchActivityRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	22, // 7: services.centrum.ListDispatchActivityResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	24, // 8: services.centrum.ListDispatchActivityResponse.activity:type_name -> resources.centrum.dispatches.DispatchStatus
-	23, // 9: services.centrum.CreateDispatchRequest.dispatch:type_name -> resources.centrum.dispatches.Dispatch
-	23, // 10: services.centrum.CreateDispatchResponse.dispatch:type_name -> resources.centrum.dispatches.Dispatch
-	23, // 11: services.centrum.UpdateDispatchRequest.dispatch:type_name -> resources.centrum.dispatches.Dispatch
-	23, // 12: services.centrum.UpdateDispatchResponse.dispatch:type_name -> resources.centrum.dispatches.Dispatch
-	25, // 13: services.centrum.ListDispatchTargetJobsResponse.jobs:type_name -> resources.jobs.Job
-	21, // 14: services.centrum.UpdateDispatchStatusRequest.status:type_name -> resources.centrum.dispatches.StatusDispatch
-	26, // 15: services.centrum.TakeDispatchRequest.resp:type_name -> resources.centrum.dispatches.TakeDispatchResp
-	6,  // 16: services.centrum.DispatchesService.CreateDispatch:input_type -> services.centrum.CreateDispatchRequest
-	8,  // 17: services.centrum.DispatchesService.UpdateDispatch:input_type -> services.centrum.UpdateDispatchRequest
-	10, // 18: services.centrum.DispatchesService.DeleteDispatch:input_type -> services.centrum.DeleteDispatchRequest
-	12, // 19: services.centrum.DispatchesService.ListDispatchTargetJobs:input_type -> services.centrum.ListDispatchTargetJobsRequest
-	16, // 20: services.centrum.DispatchesService.AssignDispatch:input_type -> services.centrum.AssignDispatchRequest
-	2,  // 21: services.centrum.DispatchesService.GetDispatch:input_type -> services.centrum.GetDispatchRequest
-	0,  // 22: services.centrum.DispatchesService.ListDispatches:input_type -> services.centrum.ListDispatchesRequest
-	4,  // 23: services.centrum.DispatchesService.ListDispatchActivity:input_type -> services.centrum.ListDispatchActivityRequest
-	18, // 24: services.centrum.DispatchesService.TakeDispatch:input_type -> services.centrum.TakeDispatchRequest
-	14, // 25: services.centrum.DispatchesService.UpdateDispatchStatus:input_type -> services.centrum.UpdateDispatchStatusRequest
-	7,  // 26: services.centrum.DispatchesService.CreateDispatch:output_type -> services.centrum.CreateDispatchResponse
-	9,  // 27: services.centrum.DispatchesService.UpdateDispatch:output_type -> services.centrum.UpdateDispatchResponse
-	11, // 28: services.centrum.DispatchesService.DeleteDispatch:output_type -> services.centrum.DeleteDispatchResponse
-	13, // 29: services.centrum.DispatchesService.ListDispatchTargetJobs:output_type -> services.centrum.ListDispatchTargetJobsResponse
-	17, // 30: services.centrum.DispatchesService.AssignDispatch:output_type -> services.centrum.AssignDispatchResponse
-	3,  // 31: services.centrum.DispatchesService.GetDispatch:output_type -> services.centrum.GetDispatchResponse
-	1,  // 32: services.centrum.DispatchesService.ListDispatches:output_type -> services.centrum.ListDispatchesResponse
-	5,  // 33: services.centrum.DispatchesService.ListDispatchActivity:output_type -> services.centrum.ListDispatchActivityResponse
-	19, // 34: services.centrum.DispatchesService.TakeDispatch:output_type -> services.centrum.TakeDispatchResponse
-	15, // 35: services.centrum.DispatchesService.UpdateDispatchStatus:output_type -> services.centrum.UpdateDispatchStatusResponse
-	26, // [26:36] is the sub-list for method output_type
-	16, // [16:26] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	30, // 0: services.centrum.ListDispatchesRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	31, // 1: services.centrum.ListDispatchesRequest.status:type_name -> resources.centrum.dispatches.StatusDispatch
+	31, // 2: services.centrum.ListDispatchesRequest.not_status:type_name -> resources.centrum.dispatches.StatusDispatch
+	32, // 3: services.centrum.ListDispatchesResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	33, // 4: services.centrum.ListDispatchesResponse.dispatches:type_name -> resources.centrum.dispatches.Dispatch
+	33, // 5: services.centrum.GetDispatchResponse.dispatch:type_name -> resources.centrum.dispatches.Dispatch
+	30, // 6: services.centrum.ListDispatchActivityRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	32, // 7: services.centrum.ListDispatchActivityResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	34, // 8: services.centrum.ListDispatchActivityResponse.activity:type_name -> resources.centrum.dispatches.DispatchStatus
+	33, // 9: services.centrum.CreateDispatchRequest.dispatch:type_name -> resources.centrum.dispatches.Dispatch
+	33, // 10: services.centrum.CreateDispatchResponse.dispatch:type_name -> resources.centrum.dispatches.Dispatch
+	33, // 11: services.centrum.UpdateDispatchRequest.dispatch:type_name -> resources.centrum.dispatches.Dispatch
+	33, // 12: services.centrum.UpdateDispatchResponse.dispatch:type_name -> resources.centrum.dispatches.Dispatch
+	35, // 13: services.centrum.ListDispatchTargetJobsResponse.jobs:type_name -> resources.jobs.Job
+	31, // 14: services.centrum.UpdateDispatchStatusRequest.status:type_name -> resources.centrum.dispatches.StatusDispatch
+	36, // 15: services.centrum.TakeDispatchRequest.resp:type_name -> resources.centrum.dispatches.TakeDispatchResp
+	37, // 16: services.centrum.GetDispatchTimelineResponse.timeline:type_name -> resources.centrum.dispatches.DispatchTimeline
+	38, // 17: services.centrum.ListDispatchTemplatesResponse.templates:type_name -> resources.centrum.dispatches.DispatchTemplate
+	38, // 18: services.centrum.CreateOrUpdateDispatchTemplateRequest.template:type_name -> resources.centrum.dispatches.DispatchTemplate
+	38, // 19: services.centrum.CreateOrUpdateDispatchTemplateResponse.template:type_name -> resources.centrum.dispatches.DispatchTemplate
+	6,  // 20: services.centrum.DispatchesService.CreateDispatch:input_type -> services.centrum.CreateDispatchRequest
+	8,  // 21: services.centrum.DispatchesService.UpdateDispatch:input_type -> services.centrum.UpdateDispatchRequest
+	10, // 22: services.centrum.DispatchesService.DeleteDispatch:input_type -> services.centrum.DeleteDispatchRequest
+	12, // 23: services.centrum.DispatchesService.ListDispatchTargetJobs:input_type -> services.centrum.ListDispatchTargetJobsRequest
+	16, // 24: services.centrum.DispatchesService.AssignDispatch:input_type -> services.centrum.AssignDispatchRequest
+	2,  // 25: services.centrum.DispatchesService.GetDispatch:input_type -> services.centrum.GetDispatchRequest
+	0,  // 26: services.centrum.DispatchesService.ListDispatches:input_type -> services.centrum.ListDispatchesRequest
+	4,  // 27: services.centrum.DispatchesService.ListDispatchActivity:input_type -> services.centrum.ListDispatchActivityRequest
+	18, // 28: services.centrum.DispatchesService.TakeDispatch:input_type -> services.centrum.TakeDispatchRequest
+	14, // 29: services.centrum.DispatchesService.UpdateDispatchStatus:input_type -> services.centrum.UpdateDispatchStatusRequest
+	20, // 30: services.centrum.DispatchesService.GetDispatchTimeline:input_type -> services.centrum.GetDispatchTimelineRequest
+	22, // 31: services.centrum.DispatchesService.ExportDispatchTimeline:input_type -> services.centrum.ExportDispatchTimelineRequest
+	24, // 32: services.centrum.DispatchesService.ListDispatchTemplates:input_type -> services.centrum.ListDispatchTemplatesRequest
+	26, // 33: services.centrum.DispatchesService.CreateOrUpdateDispatchTemplate:input_type -> services.centrum.CreateOrUpdateDispatchTemplateRequest
+	28, // 34: services.centrum.DispatchesService.DeleteDispatchTemplate:input_type -> services.centrum.DeleteDispatchTemplateRequest
+	7,  // 35: services.centrum.DispatchesService.CreateDispatch:output_type -> services.centrum.CreateDispatchResponse
+	9,  // 36: services.centrum.DispatchesService.UpdateDispatch:output_type -> services.centrum.UpdateDispatchResponse
+	11, // 37: services.centrum.DispatchesService.DeleteDispatch:output_type -> services.centrum.DeleteDispatchResponse
+	13, // 38: services.centrum.DispatchesService.ListDispatchTargetJobs:output_type -> services.centrum.ListDispatchTargetJobsResponse
+	17, // 39: services.centrum.DispatchesService.AssignDispatch:output_type -> services.centrum.AssignDispatchResponse
+	3,  // 40: services.centrum.DispatchesService.GetDispatch:output_type -> services.centrum.GetDispatchResponse
+	1,  // 41: services.centrum.DispatchesService.ListDispatches:output_type -> services.centrum.ListDispatchesResponse
+	5,  // 42: services.centrum.DispatchesService.ListDispatchActivity:output_type -> services.centrum.ListDispatchActivityResponse
+	19, // 43: services.centrum.DispatchesService.TakeDispatch:output_type -> services.centrum.TakeDispatchResponse
+	15, // 44: services.centrum.DispatchesService.UpdateDispatchStatus:output_type -> services.centrum.UpdateDispatchStatusResponse
+	21, // 45: services.centrum.DispatchesService.GetDispatchTimeline:output_type -> services.centrum.GetDispatchTimelineResponse
+	23, // 46: services.centrum.DispatchesService.ExportDispatchTimeline:output_type -> services.centrum.ExportDispatchTimelineResponse
+	25, // 47: services.centrum.DispatchesService.ListDispatchTemplates:output_type -> services.centrum.ListDispatchTemplatesResponse
+	27, // 48: services.centrum.DispatchesService.CreateOrUpdateDispatchTemplate:output_type -> services.centrum.CreateOrUpdateDispatchTemplateResponse
+	29, // 49: services.centrum.DispatchesService.DeleteDispatchTemplate:output_type -> services.centrum.DeleteDispatchTemplateResponse
+	35, // [35:50] is the sub-list for method output_type
+	20, // [20:35] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_services_centrum_dispatches_proto_init() }
@@ -1644,6 +2345,7 @@ func file_services_centrum_dispatches_proto_init() {
 		return
 	}
 	file_services_centrum_dispatches_proto_msgTypes[0].OneofWrappers = []any{}
+	file_services_centrum_dispatches_proto_msgTypes[6].OneofWrappers = []any{}
 	file_services_centrum_dispatches_proto_msgTypes[14].OneofWrappers = []any{}
 	file_services_centrum_dispatches_proto_msgTypes[16].OneofWrappers = []any{}
 	file_services_centrum_dispatches_proto_msgTypes[18].OneofWrappers = []any{}
@@ -1653,7 +2355,7 @@ func file_services_centrum_dispatches_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_centrum_dispatches_proto_rawDesc), len(file_services_centrum_dispatches_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
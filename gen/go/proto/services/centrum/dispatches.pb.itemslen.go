@@ -19,6 +19,14 @@ func (m *ListDispatchTargetJobsResponse) ItemsLen() int {
 	return len(m.GetJobs())
 }
 
+// ItemsLen returns the length of Templates.
+func (m *ListDispatchTemplatesResponse) ItemsLen() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.GetTemplates())
+}
+
 // ItemsLen returns the length of Dispatches.
 func (m *ListDispatchesResponse) ItemsLen() int {
 	if m == nil {
@@ -23,6 +23,11 @@ func (m *CreateDispatchRequest) Sanitize() error {
 		}
 	}
 
+	// Field: TemplateCode
+	if m.TemplateCode != nil {
+		*m.TemplateCode = htmlsanitizer.SanitizeAndUnescape(*m.TemplateCode)
+	}
+
 	return nil
 }
 
@@ -45,6 +50,59 @@ func (m *CreateDispatchResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateOrUpdateDispatchTemplateRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Template
+	if m.Template != nil {
+		if v, ok := any(m.GetTemplate()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateOrUpdateDispatchTemplateResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Template
+	if m.Template != nil {
+		if v, ok := any(m.GetTemplate()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExportDispatchTimelineResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Data
+
+	// Field: FileName
+	m.FileName = htmlsanitizer.SanitizeAndUnescape(m.FileName)
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *GetDispatchResponse) Sanitize() error {
@@ -64,6 +122,25 @@ func (m *GetDispatchResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetDispatchTimelineResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Timeline
+	if m.Timeline != nil {
+		if v, ok := any(m.GetTimeline()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ListDispatchActivityRequest) Sanitize() error {
@@ -136,6 +213,28 @@ func (m *ListDispatchTargetJobsResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListDispatchTemplatesResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Templates
+	for idx, item := range m.Templates {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ListDispatchesRequest) Sanitize() error {
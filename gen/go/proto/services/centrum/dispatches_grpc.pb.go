@@ -19,16 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DispatchesService_CreateDispatch_FullMethodName         = "/services.centrum.DispatchesService/CreateDispatch"
-	DispatchesService_UpdateDispatch_FullMethodName         = "/services.centrum.DispatchesService/UpdateDispatch"
-	DispatchesService_DeleteDispatch_FullMethodName         = "/services.centrum.DispatchesService/DeleteDispatch"
-	DispatchesService_ListDispatchTargetJobs_FullMethodName = "/services.centrum.DispatchesService/ListDispatchTargetJobs"
-	DispatchesService_AssignDispatch_FullMethodName         = "/services.centrum.DispatchesService/AssignDispatch"
-	DispatchesService_GetDispatch_FullMethodName            = "/services.centrum.DispatchesService/GetDispatch"
-	DispatchesService_ListDispatches_FullMethodName         = "/services.centrum.DispatchesService/ListDispatches"
-	DispatchesService_ListDispatchActivity_FullMethodName   = "/services.centrum.DispatchesService/ListDispatchActivity"
-	DispatchesService_TakeDispatch_FullMethodName           = "/services.centrum.DispatchesService/TakeDispatch"
-	DispatchesService_UpdateDispatchStatus_FullMethodName   = "/services.centrum.DispatchesService/UpdateDispatchStatus"
+	DispatchesService_CreateDispatch_FullMethodName                 = "/services.centrum.DispatchesService/CreateDispatch"
+	DispatchesService_UpdateDispatch_FullMethodName                 = "/services.centrum.DispatchesService/UpdateDispatch"
+	DispatchesService_DeleteDispatch_FullMethodName                 = "/services.centrum.DispatchesService/DeleteDispatch"
+	DispatchesService_ListDispatchTargetJobs_FullMethodName         = "/services.centrum.DispatchesService/ListDispatchTargetJobs"
+	DispatchesService_AssignDispatch_FullMethodName                 = "/services.centrum.DispatchesService/AssignDispatch"
+	DispatchesService_GetDispatch_FullMethodName                    = "/services.centrum.DispatchesService/GetDispatch"
+	DispatchesService_ListDispatches_FullMethodName                 = "/services.centrum.DispatchesService/ListDispatches"
+	DispatchesService_ListDispatchActivity_FullMethodName           = "/services.centrum.DispatchesService/ListDispatchActivity"
+	DispatchesService_TakeDispatch_FullMethodName                   = "/services.centrum.DispatchesService/TakeDispatch"
+	DispatchesService_UpdateDispatchStatus_FullMethodName           = "/services.centrum.DispatchesService/UpdateDispatchStatus"
+	DispatchesService_GetDispatchTimeline_FullMethodName            = "/services.centrum.DispatchesService/GetDispatchTimeline"
+	DispatchesService_ExportDispatchTimeline_FullMethodName         = "/services.centrum.DispatchesService/ExportDispatchTimeline"
+	DispatchesService_ListDispatchTemplates_FullMethodName          = "/services.centrum.DispatchesService/ListDispatchTemplates"
+	DispatchesService_CreateOrUpdateDispatchTemplate_FullMethodName = "/services.centrum.DispatchesService/CreateOrUpdateDispatchTemplate"
+	DispatchesService_DeleteDispatchTemplate_FullMethodName         = "/services.centrum.DispatchesService/DeleteDispatchTemplate"
 )
 
 // DispatchesServiceClient is the client API for DispatchesService service.
@@ -45,6 +50,11 @@ type DispatchesServiceClient interface {
 	ListDispatchActivity(ctx context.Context, in *ListDispatchActivityRequest, opts ...grpc.CallOption) (*ListDispatchActivityResponse, error)
 	TakeDispatch(ctx context.Context, in *TakeDispatchRequest, opts ...grpc.CallOption) (*TakeDispatchResponse, error)
 	UpdateDispatchStatus(ctx context.Context, in *UpdateDispatchStatusRequest, opts ...grpc.CallOption) (*UpdateDispatchStatusResponse, error)
+	GetDispatchTimeline(ctx context.Context, in *GetDispatchTimelineRequest, opts ...grpc.CallOption) (*GetDispatchTimelineResponse, error)
+	ExportDispatchTimeline(ctx context.Context, in *ExportDispatchTimelineRequest, opts ...grpc.CallOption) (*ExportDispatchTimelineResponse, error)
+	ListDispatchTemplates(ctx context.Context, in *ListDispatchTemplatesRequest, opts ...grpc.CallOption) (*ListDispatchTemplatesResponse, error)
+	CreateOrUpdateDispatchTemplate(ctx context.Context, in *CreateOrUpdateDispatchTemplateRequest, opts ...grpc.CallOption) (*CreateOrUpdateDispatchTemplateResponse, error)
+	DeleteDispatchTemplate(ctx context.Context, in *DeleteDispatchTemplateRequest, opts ...grpc.CallOption) (*DeleteDispatchTemplateResponse, error)
 }
 
 type dispatchesServiceClient struct {
@@ -155,6 +165,56 @@ func (c *dispatchesServiceClient) UpdateDispatchStatus(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *dispatchesServiceClient) GetDispatchTimeline(ctx context.Context, in *GetDispatchTimelineRequest, opts ...grpc.CallOption) (*GetDispatchTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDispatchTimelineResponse)
+	err := c.cc.Invoke(ctx, DispatchesService_GetDispatchTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatchesServiceClient) ExportDispatchTimeline(ctx context.Context, in *ExportDispatchTimelineRequest, opts ...grpc.CallOption) (*ExportDispatchTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportDispatchTimelineResponse)
+	err := c.cc.Invoke(ctx, DispatchesService_ExportDispatchTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatchesServiceClient) ListDispatchTemplates(ctx context.Context, in *ListDispatchTemplatesRequest, opts ...grpc.CallOption) (*ListDispatchTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDispatchTemplatesResponse)
+	err := c.cc.Invoke(ctx, DispatchesService_ListDispatchTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatchesServiceClient) CreateOrUpdateDispatchTemplate(ctx context.Context, in *CreateOrUpdateDispatchTemplateRequest, opts ...grpc.CallOption) (*CreateOrUpdateDispatchTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrUpdateDispatchTemplateResponse)
+	err := c.cc.Invoke(ctx, DispatchesService_CreateOrUpdateDispatchTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatchesServiceClient) DeleteDispatchTemplate(ctx context.Context, in *DeleteDispatchTemplateRequest, opts ...grpc.CallOption) (*DeleteDispatchTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDispatchTemplateResponse)
+	err := c.cc.Invoke(ctx, DispatchesService_DeleteDispatchTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DispatchesServiceServer is the server API for DispatchesService service.
 // All implementations must embed UnimplementedDispatchesServiceServer
 // for forward compatibility.
@@ -169,6 +229,11 @@ type DispatchesServiceServer interface {
 	ListDispatchActivity(context.Context, *ListDispatchActivityRequest) (*ListDispatchActivityResponse, error)
 	TakeDispatch(context.Context, *TakeDispatchRequest) (*TakeDispatchResponse, error)
 	UpdateDispatchStatus(context.Context, *UpdateDispatchStatusRequest) (*UpdateDispatchStatusResponse, error)
+	GetDispatchTimeline(context.Context, *GetDispatchTimelineRequest) (*GetDispatchTimelineResponse, error)
+	ExportDispatchTimeline(context.Context, *ExportDispatchTimelineRequest) (*ExportDispatchTimelineResponse, error)
+	ListDispatchTemplates(context.Context, *ListDispatchTemplatesRequest) (*ListDispatchTemplatesResponse, error)
+	CreateOrUpdateDispatchTemplate(context.Context, *CreateOrUpdateDispatchTemplateRequest) (*CreateOrUpdateDispatchTemplateResponse, error)
+	DeleteDispatchTemplate(context.Context, *DeleteDispatchTemplateRequest) (*DeleteDispatchTemplateResponse, error)
 	mustEmbedUnimplementedDispatchesServiceServer()
 }
 
@@ -209,6 +274,21 @@ func (UnimplementedDispatchesServiceServer) TakeDispatch(context.Context, *TakeD
 func (UnimplementedDispatchesServiceServer) UpdateDispatchStatus(context.Context, *UpdateDispatchStatusRequest) (*UpdateDispatchStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDispatchStatus not implemented")
 }
+func (UnimplementedDispatchesServiceServer) GetDispatchTimeline(context.Context, *GetDispatchTimelineRequest) (*GetDispatchTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispatchTimeline not implemented")
+}
+func (UnimplementedDispatchesServiceServer) ExportDispatchTimeline(context.Context, *ExportDispatchTimelineRequest) (*ExportDispatchTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDispatchTimeline not implemented")
+}
+func (UnimplementedDispatchesServiceServer) ListDispatchTemplates(context.Context, *ListDispatchTemplatesRequest) (*ListDispatchTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDispatchTemplates not implemented")
+}
+func (UnimplementedDispatchesServiceServer) CreateOrUpdateDispatchTemplate(context.Context, *CreateOrUpdateDispatchTemplateRequest) (*CreateOrUpdateDispatchTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateDispatchTemplate not implemented")
+}
+func (UnimplementedDispatchesServiceServer) DeleteDispatchTemplate(context.Context, *DeleteDispatchTemplateRequest) (*DeleteDispatchTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDispatchTemplate not implemented")
+}
 func (UnimplementedDispatchesServiceServer) mustEmbedUnimplementedDispatchesServiceServer() {}
 func (UnimplementedDispatchesServiceServer) testEmbeddedByValue()                           {}
 
@@ -410,6 +490,96 @@ func _DispatchesService_UpdateDispatchStatus_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DispatchesService_GetDispatchTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDispatchTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatchesServiceServer).GetDispatchTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatchesService_GetDispatchTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatchesServiceServer).GetDispatchTimeline(ctx, req.(*GetDispatchTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatchesService_ExportDispatchTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDispatchTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatchesServiceServer).ExportDispatchTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatchesService_ExportDispatchTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatchesServiceServer).ExportDispatchTimeline(ctx, req.(*ExportDispatchTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatchesService_ListDispatchTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDispatchTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatchesServiceServer).ListDispatchTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatchesService_ListDispatchTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatchesServiceServer).ListDispatchTemplates(ctx, req.(*ListDispatchTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatchesService_CreateOrUpdateDispatchTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrUpdateDispatchTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatchesServiceServer).CreateOrUpdateDispatchTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatchesService_CreateOrUpdateDispatchTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatchesServiceServer).CreateOrUpdateDispatchTemplate(ctx, req.(*CreateOrUpdateDispatchTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatchesService_DeleteDispatchTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDispatchTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatchesServiceServer).DeleteDispatchTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatchesService_DeleteDispatchTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatchesServiceServer).DeleteDispatchTemplate(ctx, req.(*DeleteDispatchTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DispatchesService_ServiceDesc is the grpc.ServiceDesc for DispatchesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +627,26 @@ var DispatchesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDispatchStatus",
 			Handler:    _DispatchesService_UpdateDispatchStatus_Handler,
 		},
+		{
+			MethodName: "GetDispatchTimeline",
+			Handler:    _DispatchesService_GetDispatchTimeline_Handler,
+		},
+		{
+			MethodName: "ExportDispatchTimeline",
+			Handler:    _DispatchesService_ExportDispatchTimeline_Handler,
+		},
+		{
+			MethodName: "ListDispatchTemplates",
+			Handler:    _DispatchesService_ListDispatchTemplates_Handler,
+		},
+		{
+			MethodName: "CreateOrUpdateDispatchTemplate",
+			Handler:    _DispatchesService_CreateOrUpdateDispatchTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteDispatchTemplate",
+			Handler:    _DispatchesService_DeleteDispatchTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/centrum/dispatches.proto",
@@ -14,6 +14,7 @@ import (
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
 	units "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
 	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -1069,11 +1070,600 @@ func (b0 UpdateUnitStatusResponse_builder) Build() *UpdateUnitStatusResponse {
 	return m0
 }
 
+type ListUnitRostersRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	UnitId        *int64                 `protobuf:"varint,1,opt,name=unit_id,json=unitId,proto3,oneof" json:"unit_id,omitempty"`
+	Start         *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start,proto3,oneof" json:"start,omitempty"`
+	End           *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end,proto3,oneof" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnitRostersRequest) Reset() {
+	*x = ListUnitRostersRequest{}
+	mi := &file_services_centrum_units_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnitRostersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnitRostersRequest) ProtoMessage() {}
+
+func (x *ListUnitRostersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListUnitRostersRequest) GetUnitId() int64 {
+	if x != nil && x.UnitId != nil {
+		return *x.UnitId
+	}
+	return 0
+}
+
+func (x *ListUnitRostersRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListUnitRostersRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ListUnitRostersRequest) SetUnitId(v int64) {
+	x.UnitId = &v
+}
+
+func (x *ListUnitRostersRequest) SetStart(v *timestamp.Timestamp) {
+	x.Start = v
+}
+
+func (x *ListUnitRostersRequest) SetEnd(v *timestamp.Timestamp) {
+	x.End = v
+}
+
+func (x *ListUnitRostersRequest) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	return x.UnitId != nil
+}
+
+func (x *ListUnitRostersRequest) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.Start != nil
+}
+
+func (x *ListUnitRostersRequest) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.End != nil
+}
+
+func (x *ListUnitRostersRequest) ClearUnitId() {
+	x.UnitId = nil
+}
+
+func (x *ListUnitRostersRequest) ClearStart() {
+	x.Start = nil
+}
+
+func (x *ListUnitRostersRequest) ClearEnd() {
+	x.End = nil
+}
+
+type ListUnitRostersRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UnitId *int64
+	Start  *timestamp.Timestamp
+	End    *timestamp.Timestamp
+}
+
+func (b0 ListUnitRostersRequest_builder) Build() *ListUnitRostersRequest {
+	m0 := &ListUnitRostersRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UnitId = b.UnitId
+	x.Start = b.Start
+	x.End = b.End
+	return m0
+}
+
+type ListUnitRostersResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Rosters       []*units.UnitRoster    `protobuf:"bytes,1,rep,name=rosters,proto3" json:"rosters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnitRostersResponse) Reset() {
+	*x = ListUnitRostersResponse{}
+	mi := &file_services_centrum_units_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnitRostersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnitRostersResponse) ProtoMessage() {}
+
+func (x *ListUnitRostersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListUnitRostersResponse) GetRosters() []*units.UnitRoster {
+	if x != nil {
+		return x.Rosters
+	}
+	return nil
+}
+
+func (x *ListUnitRostersResponse) SetRosters(v []*units.UnitRoster) {
+	x.Rosters = v
+}
+
+type ListUnitRostersResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Rosters []*units.UnitRoster
+}
+
+func (b0 ListUnitRostersResponse_builder) Build() *ListUnitRostersResponse {
+	m0 := &ListUnitRostersResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Rosters = b.Rosters
+	return m0
+}
+
+type CreateOrUpdateUnitRosterRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Roster        *units.UnitRoster      `protobuf:"bytes,1,opt,name=roster,proto3" json:"roster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateUnitRosterRequest) Reset() {
+	*x = CreateOrUpdateUnitRosterRequest{}
+	mi := &file_services_centrum_units_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateUnitRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateUnitRosterRequest) ProtoMessage() {}
+
+func (x *CreateOrUpdateUnitRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrUpdateUnitRosterRequest) GetRoster() *units.UnitRoster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateUnitRosterRequest) SetRoster(v *units.UnitRoster) {
+	x.Roster = v
+}
+
+func (x *CreateOrUpdateUnitRosterRequest) HasRoster() bool {
+	if x == nil {
+		return false
+	}
+	return x.Roster != nil
+}
+
+func (x *CreateOrUpdateUnitRosterRequest) ClearRoster() {
+	x.Roster = nil
+}
+
+type CreateOrUpdateUnitRosterRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Roster *units.UnitRoster
+}
+
+func (b0 CreateOrUpdateUnitRosterRequest_builder) Build() *CreateOrUpdateUnitRosterRequest {
+	m0 := &CreateOrUpdateUnitRosterRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Roster = b.Roster
+	return m0
+}
+
+type CreateOrUpdateUnitRosterResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Roster        *units.UnitRoster      `protobuf:"bytes,1,opt,name=roster,proto3" json:"roster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateUnitRosterResponse) Reset() {
+	*x = CreateOrUpdateUnitRosterResponse{}
+	mi := &file_services_centrum_units_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateUnitRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateUnitRosterResponse) ProtoMessage() {}
+
+func (x *CreateOrUpdateUnitRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrUpdateUnitRosterResponse) GetRoster() *units.UnitRoster {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateUnitRosterResponse) SetRoster(v *units.UnitRoster) {
+	x.Roster = v
+}
+
+func (x *CreateOrUpdateUnitRosterResponse) HasRoster() bool {
+	if x == nil {
+		return false
+	}
+	return x.Roster != nil
+}
+
+func (x *CreateOrUpdateUnitRosterResponse) ClearRoster() {
+	x.Roster = nil
+}
+
+type CreateOrUpdateUnitRosterResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Roster *units.UnitRoster
+}
+
+func (b0 CreateOrUpdateUnitRosterResponse_builder) Build() *CreateOrUpdateUnitRosterResponse {
+	m0 := &CreateOrUpdateUnitRosterResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Roster = b.Roster
+	return m0
+}
+
+type DeleteUnitRosterRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	RosterId      int64                  `protobuf:"varint,1,opt,name=roster_id,json=rosterId,proto3" json:"roster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitRosterRequest) Reset() {
+	*x = DeleteUnitRosterRequest{}
+	mi := &file_services_centrum_units_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitRosterRequest) ProtoMessage() {}
+
+func (x *DeleteUnitRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteUnitRosterRequest) GetRosterId() int64 {
+	if x != nil {
+		return x.RosterId
+	}
+	return 0
+}
+
+func (x *DeleteUnitRosterRequest) SetRosterId(v int64) {
+	x.RosterId = v
+}
+
+type DeleteUnitRosterRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RosterId int64
+}
+
+func (b0 DeleteUnitRosterRequest_builder) Build() *DeleteUnitRosterRequest {
+	m0 := &DeleteUnitRosterRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.RosterId = b.RosterId
+	return m0
+}
+
+type DeleteUnitRosterResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitRosterResponse) Reset() {
+	*x = DeleteUnitRosterResponse{}
+	mi := &file_services_centrum_units_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitRosterResponse) ProtoMessage() {}
+
+func (x *DeleteUnitRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteUnitRosterResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteUnitRosterResponse_builder) Build() *DeleteUnitRosterResponse {
+	m0 := &DeleteUnitRosterResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GenerateUnitRostersRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	UnitId        int64                  `protobuf:"varint,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	CalendarId    int64                  `protobuf:"varint,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Start         *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateUnitRostersRequest) Reset() {
+	*x = GenerateUnitRostersRequest{}
+	mi := &file_services_centrum_units_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateUnitRostersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUnitRostersRequest) ProtoMessage() {}
+
+func (x *GenerateUnitRostersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GenerateUnitRostersRequest) GetUnitId() int64 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *GenerateUnitRostersRequest) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *GenerateUnitRostersRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GenerateUnitRostersRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GenerateUnitRostersRequest) SetUnitId(v int64) {
+	x.UnitId = v
+}
+
+func (x *GenerateUnitRostersRequest) SetCalendarId(v int64) {
+	x.CalendarId = v
+}
+
+func (x *GenerateUnitRostersRequest) SetStart(v *timestamp.Timestamp) {
+	x.Start = v
+}
+
+func (x *GenerateUnitRostersRequest) SetEnd(v *timestamp.Timestamp) {
+	x.End = v
+}
+
+func (x *GenerateUnitRostersRequest) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.Start != nil
+}
+
+func (x *GenerateUnitRostersRequest) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.End != nil
+}
+
+func (x *GenerateUnitRostersRequest) ClearStart() {
+	x.Start = nil
+}
+
+func (x *GenerateUnitRostersRequest) ClearEnd() {
+	x.End = nil
+}
+
+type GenerateUnitRostersRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UnitId     int64
+	CalendarId int64
+	Start      *timestamp.Timestamp
+	End        *timestamp.Timestamp
+}
+
+func (b0 GenerateUnitRostersRequest_builder) Build() *GenerateUnitRostersRequest {
+	m0 := &GenerateUnitRostersRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UnitId = b.UnitId
+	x.CalendarId = b.CalendarId
+	x.Start = b.Start
+	x.End = b.End
+	return m0
+}
+
+type GenerateUnitRostersResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Rosters       []*units.UnitRoster    `protobuf:"bytes,1,rep,name=rosters,proto3" json:"rosters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateUnitRostersResponse) Reset() {
+	*x = GenerateUnitRostersResponse{}
+	mi := &file_services_centrum_units_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateUnitRostersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUnitRostersResponse) ProtoMessage() {}
+
+func (x *GenerateUnitRostersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_centrum_units_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GenerateUnitRostersResponse) GetRosters() []*units.UnitRoster {
+	if x != nil {
+		return x.Rosters
+	}
+	return nil
+}
+
+func (x *GenerateUnitRostersResponse) SetRosters(v []*units.UnitRoster) {
+	x.Rosters = v
+}
+
+type GenerateUnitRostersResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Rosters []*units.UnitRoster
+}
+
+func (b0 GenerateUnitRostersResponse_builder) Build() *GenerateUnitRostersResponse {
+	m0 := &GenerateUnitRostersResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Rosters = b.Rosters
+	return m0
+}
+
 var File_services_centrum_units_proto protoreflect.FileDescriptor
 
 const file_services_centrum_units_proto_rawDesc = "" +
 	"\n" +
-	"\x1cservices/centrum/units.proto\x12\x10services.centrum\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a#resources/centrum/units/units.proto\x1a(resources/common/database/database.proto\";\n" +
+	"\x1cservices/centrum/units.proto\x12\x10services.centrum\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a%resources/centrum/units/rosters.proto\x1a#resources/centrum/units/units.proto\x1a(resources/common/database/database.proto\x1a#resources/timestamp/timestamp.proto\";\n" +
 	"\x0fJoinUnitRequest\x12\x1c\n" +
 	"\aunit_id\x18\x01 \x01(\x03H\x00R\x06unitId\x88\x01\x01B\n" +
 	"\n" +
@@ -1116,7 +1706,32 @@ const file_services_centrum_units_proto_rawDesc = "" +
 	"\x04code\x18\x04 \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x01R\x04code\x88\x01\x01B\t\n" +
 	"\a_reasonB\a\n" +
 	"\x05_code\"\x1a\n" +
-	"\x18UpdateUnitStatusResponse2\xb6\b\n" +
+	"\x18UpdateUnitStatusResponse\"\xc6\x01\n" +
+	"\x16ListUnitRostersRequest\x12\x1c\n" +
+	"\aunit_id\x18\x01 \x01(\x03H\x00R\x06unitId\x88\x01\x01\x129\n" +
+	"\x05start\x18\x02 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\x05start\x88\x01\x01\x125\n" +
+	"\x03end\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\x03end\x88\x01\x01B\n" +
+	"\n" +
+	"\b_unit_idB\b\n" +
+	"\x06_startB\x06\n" +
+	"\x04_end\"^\n" +
+	"\x17ListUnitRostersResponse\x12C\n" +
+	"\arosters\x18\x01 \x03(\v2#.resources.centrum.units.UnitRosterB\x04\xc8\xf3\x18\x01R\arosters\"^\n" +
+	"\x1fCreateOrUpdateUnitRosterRequest\x12;\n" +
+	"\x06roster\x18\x01 \x01(\v2#.resources.centrum.units.UnitRosterR\x06roster\"_\n" +
+	" CreateOrUpdateUnitRosterResponse\x12;\n" +
+	"\x06roster\x18\x01 \x01(\v2#.resources.centrum.units.UnitRosterR\x06roster\"6\n" +
+	"\x17DeleteUnitRosterRequest\x12\x1b\n" +
+	"\troster_id\x18\x01 \x01(\x03R\brosterId\"\x1a\n" +
+	"\x18DeleteUnitRosterResponse\"\xbe\x01\n" +
+	"\x1aGenerateUnitRostersRequest\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\x03R\x06unitId\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\x03R\n" +
+	"calendarId\x124\n" +
+	"\x05start\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampR\x05start\x120\n" +
+	"\x03end\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampR\x03end\"\\\n" +
+	"\x1bGenerateUnitRostersResponse\x12=\n" +
+	"\arosters\x18\x01 \x03(\v2#.resources.centrum.units.UnitRosterR\arosters2\x81\r\n" +
 	"\fUnitsService\x12z\n" +
 	"\bJoinUnit\x12!.services.centrum.JoinUnitRequest\x1a\".services.centrum.JoinUnitResponse\"'\xd2\xf3\x18#\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x06Stream\x12}\n" +
 	"\tListUnits\x12\".services.centrum.ListUnitsRequest\x1a#.services.centrum.ListUnitsResponse\"'\xd2\xf3\x18#\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x06Stream\x12\x92\x01\n" +
@@ -1127,63 +1742,93 @@ const file_services_centrum_units_proto_rawDesc = "" +
 	"\fReorderUnits\x12%.services.centrum.ReorderUnitsRequest\x1a&.services.centrum.ReorderUnitsResponse\"\x1a\xd2\xf3\x18\x16\b\x01\"\x12CreateOrUpdateUnit\x12\x85\x01\n" +
 	"\n" +
 	"AssignUnit\x12#.services.centrum.AssignUnitRequest\x1a$.services.centrum.AssignUnitResponse\",\xd2\xf3\x18(\b\x01\x12\acentrum\x1a\x0eCentrumService\"\vTakeControl\x12\x9b\x01\n" +
-	"\x10UpdateUnitStatus\x12).services.centrum.UpdateUnitStatusRequest\x1a*.services.centrum.UpdateUnitStatusResponse\"0\xd2\xf3\x18,\b\x01\x12\acentrum\x1a\x11DispatchesService\"\fTakeDispatch\x1a\x1b\xea\xf3\x18\x17\bn\x12\x13i-mdi-account-groupBLZJgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum;centrumb\x06proto3"
+	"\x10UpdateUnitStatus\x12).services.centrum.UpdateUnitStatusRequest\x1a*.services.centrum.UpdateUnitStatusResponse\"0\xd2\xf3\x18,\b\x01\x12\acentrum\x1a\x11DispatchesService\"\fTakeDispatch\x12\x8f\x01\n" +
+	"\x0fListUnitRosters\x12(.services.centrum.ListUnitRostersRequest\x1a).services.centrum.ListUnitRostersResponse\"'\xd2\xf3\x18#\b\x01\x12\acentrum\x1a\x0eCentrumService\"\x06Stream\x12\x9d\x01\n" +
+	"\x18CreateOrUpdateUnitRoster\x121.services.centrum.CreateOrUpdateUnitRosterRequest\x1a2.services.centrum.CreateOrUpdateUnitRosterResponse\"\x1a\xd2\xf3\x18\x16\b\x01\"\x12CreateOrUpdateUnit\x12\x85\x01\n" +
+	"\x10DeleteUnitRoster\x12).services.centrum.DeleteUnitRosterRequest\x1a*.services.centrum.DeleteUnitRosterResponse\"\x1a\xd2\xf3\x18\x16\b\x01\"\x12CreateOrUpdateUnit\x12\x8e\x01\n" +
+	"\x13GenerateUnitRosters\x12,.services.centrum.GenerateUnitRostersRequest\x1a-.services.centrum.GenerateUnitRostersResponse\"\x1a\xd2\xf3\x18\x16\b\x01\"\x12CreateOrUpdateUnit\x1a\x1b\xea\xf3\x18\x17\bn\x12\x13i-mdi-account-groupBLZJgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum;centrumb\x06proto3"
 
-var file_services_centrum_units_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_services_centrum_units_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_services_centrum_units_proto_goTypes = []any{
-	(*JoinUnitRequest)(nil),             // 0: services.centrum.JoinUnitRequest
-	(*JoinUnitResponse)(nil),            // 1: services.centrum.JoinUnitResponse
-	(*ListUnitsRequest)(nil),            // 2: services.centrum.ListUnitsRequest
-	(*ListUnitsResponse)(nil),           // 3: services.centrum.ListUnitsResponse
-	(*ListUnitActivityRequest)(nil),     // 4: services.centrum.ListUnitActivityRequest
-	(*ListUnitActivityResponse)(nil),    // 5: services.centrum.ListUnitActivityResponse
-	(*CreateOrUpdateUnitRequest)(nil),   // 6: services.centrum.CreateOrUpdateUnitRequest
-	(*CreateOrUpdateUnitResponse)(nil),  // 7: services.centrum.CreateOrUpdateUnitResponse
-	(*DeleteUnitRequest)(nil),           // 8: services.centrum.DeleteUnitRequest
-	(*DeleteUnitResponse)(nil),          // 9: services.centrum.DeleteUnitResponse
-	(*ReorderUnitsRequest)(nil),         // 10: services.centrum.ReorderUnitsRequest
-	(*ReorderUnitsResponse)(nil),        // 11: services.centrum.ReorderUnitsResponse
-	(*AssignUnitRequest)(nil),           // 12: services.centrum.AssignUnitRequest
-	(*AssignUnitResponse)(nil),          // 13: services.centrum.AssignUnitResponse
-	(*UpdateUnitStatusRequest)(nil),     // 14: services.centrum.UpdateUnitStatusRequest
-	(*UpdateUnitStatusResponse)(nil),    // 15: services.centrum.UpdateUnitStatusResponse
-	(*units.Unit)(nil),                  // 16: resources.centrum.units.Unit
-	(units.StatusUnit)(0),               // 17: resources.centrum.units.StatusUnit
-	(*database.PaginationRequest)(nil),  // 18: resources.common.database.PaginationRequest
-	(*database.PaginationResponse)(nil), // 19: resources.common.database.PaginationResponse
-	(*units.UnitStatus)(nil),            // 20: resources.centrum.units.UnitStatus
+	(*JoinUnitRequest)(nil),                  // 0: services.centrum.JoinUnitRequest
+	(*JoinUnitResponse)(nil),                 // 1: services.centrum.JoinUnitResponse
+	(*ListUnitsRequest)(nil),                 // 2: services.centrum.ListUnitsRequest
+	(*ListUnitsResponse)(nil),                // 3: services.centrum.ListUnitsResponse
+	(*ListUnitActivityRequest)(nil),          // 4: services.centrum.ListUnitActivityRequest
+	(*ListUnitActivityResponse)(nil),         // 5: services.centrum.ListUnitActivityResponse
+	(*CreateOrUpdateUnitRequest)(nil),        // 6: services.centrum.CreateOrUpdateUnitRequest
+	(*CreateOrUpdateUnitResponse)(nil),       // 7: services.centrum.CreateOrUpdateUnitResponse
+	(*DeleteUnitRequest)(nil),                // 8: services.centrum.DeleteUnitRequest
+	(*DeleteUnitResponse)(nil),               // 9: services.centrum.DeleteUnitResponse
+	(*ReorderUnitsRequest)(nil),              // 10: services.centrum.ReorderUnitsRequest
+	(*ReorderUnitsResponse)(nil),             // 11: services.centrum.ReorderUnitsResponse
+	(*AssignUnitRequest)(nil),                // 12: services.centrum.AssignUnitRequest
+	(*AssignUnitResponse)(nil),               // 13: services.centrum.AssignUnitResponse
+	(*UpdateUnitStatusRequest)(nil),          // 14: services.centrum.UpdateUnitStatusRequest
+	(*UpdateUnitStatusResponse)(nil),         // 15: services.centrum.UpdateUnitStatusResponse
+	(*ListUnitRostersRequest)(nil),           // 16: services.centrum.ListUnitRostersRequest
+	(*ListUnitRostersResponse)(nil),          // 17: services.centrum.ListUnitRostersResponse
+	(*CreateOrUpdateUnitRosterRequest)(nil),  // 18: services.centrum.CreateOrUpdateUnitRosterRequest
+	(*CreateOrUpdateUnitRosterResponse)(nil), // 19: services.centrum.CreateOrUpdateUnitRosterResponse
+	(*DeleteUnitRosterRequest)(nil),          // 20: services.centrum.DeleteUnitRosterRequest
+	(*DeleteUnitRosterResponse)(nil),         // 21: services.centrum.DeleteUnitRosterResponse
+	(*GenerateUnitRostersRequest)(nil),       // 22: services.centrum.GenerateUnitRostersRequest
+	(*GenerateUnitRostersResponse)(nil),      // 23: services.centrum.GenerateUnitRostersResponse
+	(*units.Unit)(nil),                       // 24: resources.centrum.units.Unit
+	(units.StatusUnit)(0),                    // 25: resources.centrum.units.StatusUnit
+	(*database.PaginationRequest)(nil),       // 26: resources.common.database.PaginationRequest
+	(*database.PaginationResponse)(nil),      // 27: resources.common.database.PaginationResponse
+	(*units.UnitStatus)(nil),                 // 28: resources.centrum.units.UnitStatus
+	(*timestamp.Timestamp)(nil),              // 29: resources.timestamp.Timestamp
+	(*units.UnitRoster)(nil),                 // 30: resources.centrum.units.UnitRoster
 }
 var file_services_centrum_units_proto_depIdxs = []int32{
-	16, // 0: services.centrum.JoinUnitResponse.unit:type_name -> resources.centrum.units.Unit
-	17, // 1: services.centrum.ListUnitsRequest.status:type_name -> resources.centrum.units.StatusUnit
-	16, // 2: services.centrum.ListUnitsResponse.units:type_name -> resources.centrum.units.Unit
-	18, // 3: services.centrum.ListUnitActivityRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	19, // 4: services.centrum.ListUnitActivityResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	20, // 5: services.centrum.ListUnitActivityResponse.activity:type_name -> resources.centrum.units.UnitStatus
-	16, // 6: services.centrum.CreateOrUpdateUnitRequest.unit:type_name -> resources.centrum.units.Unit
-	16, // 7: services.centrum.CreateOrUpdateUnitResponse.unit:type_name -> resources.centrum.units.Unit
-	17, // 8: services.centrum.UpdateUnitStatusRequest.status:type_name -> resources.centrum.units.StatusUnit
-	0,  // 9: services.centrum.UnitsService.JoinUnit:input_type -> services.centrum.JoinUnitRequest
-	2,  // 10: services.centrum.UnitsService.ListUnits:input_type -> services.centrum.ListUnitsRequest
-	4,  // 11: services.centrum.UnitsService.ListUnitActivity:input_type -> services.centrum.ListUnitActivityRequest
-	6,  // 12: services.centrum.UnitsService.CreateOrUpdateUnit:input_type -> services.centrum.CreateOrUpdateUnitRequest
-	8,  // 13: services.centrum.UnitsService.DeleteUnit:input_type -> services.centrum.DeleteUnitRequest
-	10, // 14: services.centrum.UnitsService.ReorderUnits:input_type -> services.centrum.ReorderUnitsRequest
-	12, // 15: services.centrum.UnitsService.AssignUnit:input_type -> services.centrum.AssignUnitRequest
-	14, // 16: services.centrum.UnitsService.UpdateUnitStatus:input_type -> services.centrum.UpdateUnitStatusRequest
-	1,  // 17: services.centrum.UnitsService.JoinUnit:output_type -> services.centrum.JoinUnitResponse
-	3,  // 18: services.centrum.UnitsService.ListUnits:output_type -> services.centrum.ListUnitsResponse
-	5,  // 19: services.centrum.UnitsService.ListUnitActivity:output_type -> services.centrum.ListUnitActivityResponse
-	7,  // 20: services.centrum.UnitsService.CreateOrUpdateUnit:output_type -> services.centrum.CreateOrUpdateUnitResponse
-	9,  // 21: services.centrum.UnitsService.DeleteUnit:output_type -> services.centrum.DeleteUnitResponse
-	11, // 22: services.centrum.UnitsService.ReorderUnits:output_type -> services.centrum.ReorderUnitsResponse
-	13, // 23: services.centrum.UnitsService.AssignUnit:output_type -> services.centrum.AssignUnitResponse
-	15, // 24: services.centrum.UnitsService.UpdateUnitStatus:output_type -> services.centrum.UpdateUnitStatusResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	24, // 0: services.centrum.JoinUnitResponse.unit:type_name -> resources.centrum.units.Unit
+	25, // 1: services.centrum.ListUnitsRequest.status:type_name -> resources.centrum.units.StatusUnit
+	24, // 2: services.centrum.ListUnitsResponse.units:type_name -> resources.centrum.units.Unit
+	26, // 3: services.centrum.ListUnitActivityRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	27, // 4: services.centrum.ListUnitActivityResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	28, // 5: services.centrum.ListUnitActivityResponse.activity:type_name -> resources.centrum.units.UnitStatus
+	24, // 6: services.centrum.CreateOrUpdateUnitRequest.unit:type_name -> resources.centrum.units.Unit
+	24, // 7: services.centrum.CreateOrUpdateUnitResponse.unit:type_name -> resources.centrum.units.Unit
+	25, // 8: services.centrum.UpdateUnitStatusRequest.status:type_name -> resources.centrum.units.StatusUnit
+	29, // 9: services.centrum.ListUnitRostersRequest.start:type_name -> resources.timestamp.Timestamp
+	29, // 10: services.centrum.ListUnitRostersRequest.end:type_name -> resources.timestamp.Timestamp
+	30, // 11: services.centrum.ListUnitRostersResponse.rosters:type_name -> resources.centrum.units.UnitRoster
+	30, // 12: services.centrum.CreateOrUpdateUnitRosterRequest.roster:type_name -> resources.centrum.units.UnitRoster
+	30, // 13: services.centrum.CreateOrUpdateUnitRosterResponse.roster:type_name -> resources.centrum.units.UnitRoster
+	29, // 14: services.centrum.GenerateUnitRostersRequest.start:type_name -> resources.timestamp.Timestamp
+	29, // 15: services.centrum.GenerateUnitRostersRequest.end:type_name -> resources.timestamp.Timestamp
+	30, // 16: services.centrum.GenerateUnitRostersResponse.rosters:type_name -> resources.centrum.units.UnitRoster
+	0,  // 17: services.centrum.UnitsService.JoinUnit:input_type -> services.centrum.JoinUnitRequest
+	2,  // 18: services.centrum.UnitsService.ListUnits:input_type -> services.centrum.ListUnitsRequest
+	4,  // 19: services.centrum.UnitsService.ListUnitActivity:input_type -> services.centrum.ListUnitActivityRequest
+	6,  // 20: services.centrum.UnitsService.CreateOrUpdateUnit:input_type -> services.centrum.CreateOrUpdateUnitRequest
+	8,  // 21: services.centrum.UnitsService.DeleteUnit:input_type -> services.centrum.DeleteUnitRequest
+	10, // 22: services.centrum.UnitsService.ReorderUnits:input_type -> services.centrum.ReorderUnitsRequest
+	12, // 23: services.centrum.UnitsService.AssignUnit:input_type -> services.centrum.AssignUnitRequest
+	14, // 24: services.centrum.UnitsService.UpdateUnitStatus:input_type -> services.centrum.UpdateUnitStatusRequest
+	16, // 25: services.centrum.UnitsService.ListUnitRosters:input_type -> services.centrum.ListUnitRostersRequest
+	18, // 26: services.centrum.UnitsService.CreateOrUpdateUnitRoster:input_type -> services.centrum.CreateOrUpdateUnitRosterRequest
+	20, // 27: services.centrum.UnitsService.DeleteUnitRoster:input_type -> services.centrum.DeleteUnitRosterRequest
+	22, // 28: services.centrum.UnitsService.GenerateUnitRosters:input_type -> services.centrum.GenerateUnitRostersRequest
+	1,  // 29: services.centrum.UnitsService.JoinUnit:output_type -> services.centrum.JoinUnitResponse
+	3,  // 30: services.centrum.UnitsService.ListUnits:output_type -> services.centrum.ListUnitsResponse
+	5,  // 31: services.centrum.UnitsService.ListUnitActivity:output_type -> services.centrum.ListUnitActivityResponse
+	7,  // 32: services.centrum.UnitsService.CreateOrUpdateUnit:output_type -> services.centrum.CreateOrUpdateUnitResponse
+	9,  // 33: services.centrum.UnitsService.DeleteUnit:output_type -> services.centrum.DeleteUnitResponse
+	11, // 34: services.centrum.UnitsService.ReorderUnits:output_type -> services.centrum.ReorderUnitsResponse
+	13, // 35: services.centrum.UnitsService.AssignUnit:output_type -> services.centrum.AssignUnitResponse
+	15, // 36: services.centrum.UnitsService.UpdateUnitStatus:output_type -> services.centrum.UpdateUnitStatusResponse
+	17, // 37: services.centrum.UnitsService.ListUnitRosters:output_type -> services.centrum.ListUnitRostersResponse
+	19, // 38: services.centrum.UnitsService.CreateOrUpdateUnitRoster:output_type -> services.centrum.CreateOrUpdateUnitRosterResponse
+	21, // 39: services.centrum.UnitsService.DeleteUnitRoster:output_type -> services.centrum.DeleteUnitRosterResponse
+	23, // 40: services.centrum.UnitsService.GenerateUnitRosters:output_type -> services.centrum.GenerateUnitRostersResponse
+	29, // [29:41] is the sub-list for method output_type
+	17, // [17:29] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_services_centrum_units_proto_init() }
@@ -1193,13 +1838,14 @@ func file_services_centrum_units_proto_init() {
 	}
 	file_services_centrum_units_proto_msgTypes[0].OneofWrappers = []any{}
 	file_services_centrum_units_proto_msgTypes[14].OneofWrappers = []any{}
+	file_services_centrum_units_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_centrum_units_proto_rawDesc), len(file_services_centrum_units_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,14 @@ func (m *ListUnitActivityResponse) ItemsLen() int {
 	return len(m.GetActivity())
 }
 
+// ItemsLen returns the length of Rosters.
+func (m *ListUnitRostersResponse) ItemsLen() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.GetRosters())
+}
+
 // ItemsLen returns the length of Units.
 func (m *ListUnitsResponse) ItemsLen() int {
 	if m == nil {
@@ -45,6 +45,94 @@ func (m *CreateOrUpdateUnitResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateOrUpdateUnitRosterRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Roster
+	if m.Roster != nil {
+		if v, ok := any(m.GetRoster()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateOrUpdateUnitRosterResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Roster
+	if m.Roster != nil {
+		if v, ok := any(m.GetRoster()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GenerateUnitRostersRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: End
+	if m.End != nil {
+		if v, ok := any(m.GetEnd()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Start
+	if m.Start != nil {
+		if v, ok := any(m.GetStart()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GenerateUnitRostersResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Rosters
+	for idx, item := range m.Rosters {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *JoinUnitResponse) Sanitize() error {
@@ -114,6 +202,56 @@ func (m *ListUnitActivityResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListUnitRostersRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: End
+	if m.End != nil {
+		if v, ok := any(m.GetEnd()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Start
+	if m.Start != nil {
+		if v, ok := any(m.GetStart()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListUnitRostersResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Rosters
+	for idx, item := range m.Rosters {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ListUnitsRequest) Sanitize() error {
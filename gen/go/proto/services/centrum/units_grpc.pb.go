@@ -19,14 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UnitsService_JoinUnit_FullMethodName           = "/services.centrum.UnitsService/JoinUnit"
-	UnitsService_ListUnits_FullMethodName          = "/services.centrum.UnitsService/ListUnits"
-	UnitsService_ListUnitActivity_FullMethodName   = "/services.centrum.UnitsService/ListUnitActivity"
-	UnitsService_CreateOrUpdateUnit_FullMethodName = "/services.centrum.UnitsService/CreateOrUpdateUnit"
-	UnitsService_DeleteUnit_FullMethodName         = "/services.centrum.UnitsService/DeleteUnit"
-	UnitsService_ReorderUnits_FullMethodName       = "/services.centrum.UnitsService/ReorderUnits"
-	UnitsService_AssignUnit_FullMethodName         = "/services.centrum.UnitsService/AssignUnit"
-	UnitsService_UpdateUnitStatus_FullMethodName   = "/services.centrum.UnitsService/UpdateUnitStatus"
+	UnitsService_JoinUnit_FullMethodName                 = "/services.centrum.UnitsService/JoinUnit"
+	UnitsService_ListUnits_FullMethodName                = "/services.centrum.UnitsService/ListUnits"
+	UnitsService_ListUnitActivity_FullMethodName         = "/services.centrum.UnitsService/ListUnitActivity"
+	UnitsService_CreateOrUpdateUnit_FullMethodName       = "/services.centrum.UnitsService/CreateOrUpdateUnit"
+	UnitsService_DeleteUnit_FullMethodName               = "/services.centrum.UnitsService/DeleteUnit"
+	UnitsService_ReorderUnits_FullMethodName             = "/services.centrum.UnitsService/ReorderUnits"
+	UnitsService_AssignUnit_FullMethodName               = "/services.centrum.UnitsService/AssignUnit"
+	UnitsService_UpdateUnitStatus_FullMethodName         = "/services.centrum.UnitsService/UpdateUnitStatus"
+	UnitsService_ListUnitRosters_FullMethodName          = "/services.centrum.UnitsService/ListUnitRosters"
+	UnitsService_CreateOrUpdateUnitRoster_FullMethodName = "/services.centrum.UnitsService/CreateOrUpdateUnitRoster"
+	UnitsService_DeleteUnitRoster_FullMethodName         = "/services.centrum.UnitsService/DeleteUnitRoster"
+	UnitsService_GenerateUnitRosters_FullMethodName      = "/services.centrum.UnitsService/GenerateUnitRosters"
 )
 
 // UnitsServiceClient is the client API for UnitsService service.
@@ -41,6 +45,10 @@ type UnitsServiceClient interface {
 	ReorderUnits(ctx context.Context, in *ReorderUnitsRequest, opts ...grpc.CallOption) (*ReorderUnitsResponse, error)
 	AssignUnit(ctx context.Context, in *AssignUnitRequest, opts ...grpc.CallOption) (*AssignUnitResponse, error)
 	UpdateUnitStatus(ctx context.Context, in *UpdateUnitStatusRequest, opts ...grpc.CallOption) (*UpdateUnitStatusResponse, error)
+	ListUnitRosters(ctx context.Context, in *ListUnitRostersRequest, opts ...grpc.CallOption) (*ListUnitRostersResponse, error)
+	CreateOrUpdateUnitRoster(ctx context.Context, in *CreateOrUpdateUnitRosterRequest, opts ...grpc.CallOption) (*CreateOrUpdateUnitRosterResponse, error)
+	DeleteUnitRoster(ctx context.Context, in *DeleteUnitRosterRequest, opts ...grpc.CallOption) (*DeleteUnitRosterResponse, error)
+	GenerateUnitRosters(ctx context.Context, in *GenerateUnitRostersRequest, opts ...grpc.CallOption) (*GenerateUnitRostersResponse, error)
 }
 
 type unitsServiceClient struct {
@@ -131,6 +139,46 @@ func (c *unitsServiceClient) UpdateUnitStatus(ctx context.Context, in *UpdateUni
 	return out, nil
 }
 
+func (c *unitsServiceClient) ListUnitRosters(ctx context.Context, in *ListUnitRostersRequest, opts ...grpc.CallOption) (*ListUnitRostersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnitRostersResponse)
+	err := c.cc.Invoke(ctx, UnitsService_ListUnitRosters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsServiceClient) CreateOrUpdateUnitRoster(ctx context.Context, in *CreateOrUpdateUnitRosterRequest, opts ...grpc.CallOption) (*CreateOrUpdateUnitRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrUpdateUnitRosterResponse)
+	err := c.cc.Invoke(ctx, UnitsService_CreateOrUpdateUnitRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsServiceClient) DeleteUnitRoster(ctx context.Context, in *DeleteUnitRosterRequest, opts ...grpc.CallOption) (*DeleteUnitRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUnitRosterResponse)
+	err := c.cc.Invoke(ctx, UnitsService_DeleteUnitRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsServiceClient) GenerateUnitRosters(ctx context.Context, in *GenerateUnitRostersRequest, opts ...grpc.CallOption) (*GenerateUnitRostersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateUnitRostersResponse)
+	err := c.cc.Invoke(ctx, UnitsService_GenerateUnitRosters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UnitsServiceServer is the server API for UnitsService service.
 // All implementations must embed UnimplementedUnitsServiceServer
 // for forward compatibility.
@@ -143,6 +191,10 @@ type UnitsServiceServer interface {
 	ReorderUnits(context.Context, *ReorderUnitsRequest) (*ReorderUnitsResponse, error)
 	AssignUnit(context.Context, *AssignUnitRequest) (*AssignUnitResponse, error)
 	UpdateUnitStatus(context.Context, *UpdateUnitStatusRequest) (*UpdateUnitStatusResponse, error)
+	ListUnitRosters(context.Context, *ListUnitRostersRequest) (*ListUnitRostersResponse, error)
+	CreateOrUpdateUnitRoster(context.Context, *CreateOrUpdateUnitRosterRequest) (*CreateOrUpdateUnitRosterResponse, error)
+	DeleteUnitRoster(context.Context, *DeleteUnitRosterRequest) (*DeleteUnitRosterResponse, error)
+	GenerateUnitRosters(context.Context, *GenerateUnitRostersRequest) (*GenerateUnitRostersResponse, error)
 	mustEmbedUnimplementedUnitsServiceServer()
 }
 
@@ -177,6 +229,18 @@ func (UnimplementedUnitsServiceServer) AssignUnit(context.Context, *AssignUnitRe
 func (UnimplementedUnitsServiceServer) UpdateUnitStatus(context.Context, *UpdateUnitStatusRequest) (*UpdateUnitStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUnitStatus not implemented")
 }
+func (UnimplementedUnitsServiceServer) ListUnitRosters(context.Context, *ListUnitRostersRequest) (*ListUnitRostersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnitRosters not implemented")
+}
+func (UnimplementedUnitsServiceServer) CreateOrUpdateUnitRoster(context.Context, *CreateOrUpdateUnitRosterRequest) (*CreateOrUpdateUnitRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateUnitRoster not implemented")
+}
+func (UnimplementedUnitsServiceServer) DeleteUnitRoster(context.Context, *DeleteUnitRosterRequest) (*DeleteUnitRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnitRoster not implemented")
+}
+func (UnimplementedUnitsServiceServer) GenerateUnitRosters(context.Context, *GenerateUnitRostersRequest) (*GenerateUnitRostersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateUnitRosters not implemented")
+}
 func (UnimplementedUnitsServiceServer) mustEmbedUnimplementedUnitsServiceServer() {}
 func (UnimplementedUnitsServiceServer) testEmbeddedByValue()                      {}
 
@@ -342,6 +406,78 @@ func _UnitsService_UpdateUnitStatus_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UnitsService_ListUnitRosters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnitRostersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServiceServer).ListUnitRosters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnitsService_ListUnitRosters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServiceServer).ListUnitRosters(ctx, req.(*ListUnitRostersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitsService_CreateOrUpdateUnitRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrUpdateUnitRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServiceServer).CreateOrUpdateUnitRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnitsService_CreateOrUpdateUnitRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServiceServer).CreateOrUpdateUnitRoster(ctx, req.(*CreateOrUpdateUnitRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitsService_DeleteUnitRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnitRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServiceServer).DeleteUnitRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnitsService_DeleteUnitRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServiceServer).DeleteUnitRoster(ctx, req.(*DeleteUnitRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitsService_GenerateUnitRosters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUnitRostersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServiceServer).GenerateUnitRosters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnitsService_GenerateUnitRosters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServiceServer).GenerateUnitRosters(ctx, req.(*GenerateUnitRostersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UnitsService_ServiceDesc is the grpc.ServiceDesc for UnitsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +517,22 @@ var UnitsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUnitStatus",
 			Handler:    _UnitsService_UpdateUnitStatus_Handler,
 		},
+		{
+			MethodName: "ListUnitRosters",
+			Handler:    _UnitsService_ListUnitRosters_Handler,
+		},
+		{
+			MethodName: "CreateOrUpdateUnitRoster",
+			Handler:    _UnitsService_CreateOrUpdateUnitRoster_Handler,
+		},
+		{
+			MethodName: "DeleteUnitRoster",
+			Handler:    _UnitsService_DeleteUnitRoster_Handler,
+		},
+		{
+			MethodName: "GenerateUnitRosters",
+			Handler:    _UnitsService_GenerateUnitRosters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/centrum/units.proto",
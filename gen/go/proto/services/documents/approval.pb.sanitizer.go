@@ -39,6 +39,44 @@ func (m *ApprovalTaskSeed) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateOrUpdateApprovalPolicyTemplateRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: PolicyTemplate
+	if m.PolicyTemplate != nil {
+		if v, ok := any(m.GetPolicyTemplate()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateOrUpdateApprovalPolicyTemplateResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: PolicyTemplate
+	if m.PolicyTemplate != nil {
+		if v, ok := any(m.GetPolicyTemplate()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *DecideApprovalRequest) Sanitize() error {
@@ -131,6 +169,28 @@ func (m *ListApprovalPoliciesResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListApprovalPolicyTemplatesResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: PolicyTemplates
+	for idx, item := range m.PolicyTemplates {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ListApprovalTasksInboxRequest) Sanitize() error {
@@ -19,17 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ApprovalService_ListApprovalTasksInbox_FullMethodName          = "/services.documents.ApprovalService/ListApprovalTasksInbox"
-	ApprovalService_ListApprovalPolicies_FullMethodName            = "/services.documents.ApprovalService/ListApprovalPolicies"
-	ApprovalService_UpsertApprovalPolicy_FullMethodName            = "/services.documents.ApprovalService/UpsertApprovalPolicy"
-	ApprovalService_ListApprovalTasks_FullMethodName               = "/services.documents.ApprovalService/ListApprovalTasks"
-	ApprovalService_UpsertApprovalTasks_FullMethodName             = "/services.documents.ApprovalService/UpsertApprovalTasks"
-	ApprovalService_DeleteApprovalTasks_FullMethodName             = "/services.documents.ApprovalService/DeleteApprovalTasks"
-	ApprovalService_ListApprovals_FullMethodName                   = "/services.documents.ApprovalService/ListApprovals"
-	ApprovalService_RevokeApproval_FullMethodName                  = "/services.documents.ApprovalService/RevokeApproval"
-	ApprovalService_DecideApproval_FullMethodName                  = "/services.documents.ApprovalService/DecideApproval"
-	ApprovalService_ReopenApprovalTask_FullMethodName              = "/services.documents.ApprovalService/ReopenApprovalTask"
-	ApprovalService_RecomputeApprovalPolicyCounters_FullMethodName = "/services.documents.ApprovalService/RecomputeApprovalPolicyCounters"
+	ApprovalService_ListApprovalTasksInbox_FullMethodName               = "/services.documents.ApprovalService/ListApprovalTasksInbox"
+	ApprovalService_ListApprovalPolicies_FullMethodName                 = "/services.documents.ApprovalService/ListApprovalPolicies"
+	ApprovalService_UpsertApprovalPolicy_FullMethodName                 = "/services.documents.ApprovalService/UpsertApprovalPolicy"
+	ApprovalService_ListApprovalTasks_FullMethodName                    = "/services.documents.ApprovalService/ListApprovalTasks"
+	ApprovalService_UpsertApprovalTasks_FullMethodName                  = "/services.documents.ApprovalService/UpsertApprovalTasks"
+	ApprovalService_DeleteApprovalTasks_FullMethodName                  = "/services.documents.ApprovalService/DeleteApprovalTasks"
+	ApprovalService_ListApprovals_FullMethodName                        = "/services.documents.ApprovalService/ListApprovals"
+	ApprovalService_RevokeApproval_FullMethodName                       = "/services.documents.ApprovalService/RevokeApproval"
+	ApprovalService_DecideApproval_FullMethodName                       = "/services.documents.ApprovalService/DecideApproval"
+	ApprovalService_ReopenApprovalTask_FullMethodName                   = "/services.documents.ApprovalService/ReopenApprovalTask"
+	ApprovalService_ListApprovalPolicyTemplates_FullMethodName          = "/services.documents.ApprovalService/ListApprovalPolicyTemplates"
+	ApprovalService_CreateOrUpdateApprovalPolicyTemplate_FullMethodName = "/services.documents.ApprovalService/CreateOrUpdateApprovalPolicyTemplate"
+	ApprovalService_DeleteApprovalPolicyTemplate_FullMethodName         = "/services.documents.ApprovalService/DeleteApprovalPolicyTemplate"
+	ApprovalService_RecomputeApprovalPolicyCounters_FullMethodName      = "/services.documents.ApprovalService/RecomputeApprovalPolicyCounters"
 )
 
 // ApprovalServiceClient is the client API for ApprovalService service.
@@ -50,6 +53,10 @@ type ApprovalServiceClient interface {
 	RevokeApproval(ctx context.Context, in *RevokeApprovalRequest, opts ...grpc.CallOption) (*RevokeApprovalResponse, error)
 	DecideApproval(ctx context.Context, in *DecideApprovalRequest, opts ...grpc.CallOption) (*DecideApprovalResponse, error)
 	ReopenApprovalTask(ctx context.Context, in *ReopenApprovalTaskRequest, opts ...grpc.CallOption) (*ReopenApprovalTaskResponse, error)
+	// Policy Templates
+	ListApprovalPolicyTemplates(ctx context.Context, in *ListApprovalPolicyTemplatesRequest, opts ...grpc.CallOption) (*ListApprovalPolicyTemplatesResponse, error)
+	CreateOrUpdateApprovalPolicyTemplate(ctx context.Context, in *CreateOrUpdateApprovalPolicyTemplateRequest, opts ...grpc.CallOption) (*CreateOrUpdateApprovalPolicyTemplateResponse, error)
+	DeleteApprovalPolicyTemplate(ctx context.Context, in *DeleteApprovalPolicyTemplateRequest, opts ...grpc.CallOption) (*DeleteApprovalPolicyTemplateResponse, error)
 	// Helpers
 	RecomputeApprovalPolicyCounters(ctx context.Context, in *RecomputeApprovalPolicyCountersRequest, opts ...grpc.CallOption) (*RecomputeApprovalPolicyCountersResponse, error)
 }
@@ -162,6 +169,36 @@ func (c *approvalServiceClient) ReopenApprovalTask(ctx context.Context, in *Reop
 	return out, nil
 }
 
+func (c *approvalServiceClient) ListApprovalPolicyTemplates(ctx context.Context, in *ListApprovalPolicyTemplatesRequest, opts ...grpc.CallOption) (*ListApprovalPolicyTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalPolicyTemplatesResponse)
+	err := c.cc.Invoke(ctx, ApprovalService_ListApprovalPolicyTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *approvalServiceClient) CreateOrUpdateApprovalPolicyTemplate(ctx context.Context, in *CreateOrUpdateApprovalPolicyTemplateRequest, opts ...grpc.CallOption) (*CreateOrUpdateApprovalPolicyTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrUpdateApprovalPolicyTemplateResponse)
+	err := c.cc.Invoke(ctx, ApprovalService_CreateOrUpdateApprovalPolicyTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *approvalServiceClient) DeleteApprovalPolicyTemplate(ctx context.Context, in *DeleteApprovalPolicyTemplateRequest, opts ...grpc.CallOption) (*DeleteApprovalPolicyTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteApprovalPolicyTemplateResponse)
+	err := c.cc.Invoke(ctx, ApprovalService_DeleteApprovalPolicyTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *approvalServiceClient) RecomputeApprovalPolicyCounters(ctx context.Context, in *RecomputeApprovalPolicyCountersRequest, opts ...grpc.CallOption) (*RecomputeApprovalPolicyCountersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecomputeApprovalPolicyCountersResponse)
@@ -190,6 +227,10 @@ type ApprovalServiceServer interface {
 	RevokeApproval(context.Context, *RevokeApprovalRequest) (*RevokeApprovalResponse, error)
 	DecideApproval(context.Context, *DecideApprovalRequest) (*DecideApprovalResponse, error)
 	ReopenApprovalTask(context.Context, *ReopenApprovalTaskRequest) (*ReopenApprovalTaskResponse, error)
+	// Policy Templates
+	ListApprovalPolicyTemplates(context.Context, *ListApprovalPolicyTemplatesRequest) (*ListApprovalPolicyTemplatesResponse, error)
+	CreateOrUpdateApprovalPolicyTemplate(context.Context, *CreateOrUpdateApprovalPolicyTemplateRequest) (*CreateOrUpdateApprovalPolicyTemplateResponse, error)
+	DeleteApprovalPolicyTemplate(context.Context, *DeleteApprovalPolicyTemplateRequest) (*DeleteApprovalPolicyTemplateResponse, error)
 	// Helpers
 	RecomputeApprovalPolicyCounters(context.Context, *RecomputeApprovalPolicyCountersRequest) (*RecomputeApprovalPolicyCountersResponse, error)
 	mustEmbedUnimplementedApprovalServiceServer()
@@ -232,6 +273,15 @@ func (UnimplementedApprovalServiceServer) DecideApproval(context.Context, *Decid
 func (UnimplementedApprovalServiceServer) ReopenApprovalTask(context.Context, *ReopenApprovalTaskRequest) (*ReopenApprovalTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReopenApprovalTask not implemented")
 }
+func (UnimplementedApprovalServiceServer) ListApprovalPolicyTemplates(context.Context, *ListApprovalPolicyTemplatesRequest) (*ListApprovalPolicyTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovalPolicyTemplates not implemented")
+}
+func (UnimplementedApprovalServiceServer) CreateOrUpdateApprovalPolicyTemplate(context.Context, *CreateOrUpdateApprovalPolicyTemplateRequest) (*CreateOrUpdateApprovalPolicyTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateApprovalPolicyTemplate not implemented")
+}
+func (UnimplementedApprovalServiceServer) DeleteApprovalPolicyTemplate(context.Context, *DeleteApprovalPolicyTemplateRequest) (*DeleteApprovalPolicyTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApprovalPolicyTemplate not implemented")
+}
 func (UnimplementedApprovalServiceServer) RecomputeApprovalPolicyCounters(context.Context, *RecomputeApprovalPolicyCountersRequest) (*RecomputeApprovalPolicyCountersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecomputeApprovalPolicyCounters not implemented")
 }
@@ -436,6 +486,60 @@ func _ApprovalService_ReopenApprovalTask_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApprovalService_ListApprovalPolicyTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalPolicyTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApprovalServiceServer).ListApprovalPolicyTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApprovalService_ListApprovalPolicyTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApprovalServiceServer).ListApprovalPolicyTemplates(ctx, req.(*ListApprovalPolicyTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApprovalService_CreateOrUpdateApprovalPolicyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrUpdateApprovalPolicyTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApprovalServiceServer).CreateOrUpdateApprovalPolicyTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApprovalService_CreateOrUpdateApprovalPolicyTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApprovalServiceServer).CreateOrUpdateApprovalPolicyTemplate(ctx, req.(*CreateOrUpdateApprovalPolicyTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApprovalService_DeleteApprovalPolicyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApprovalPolicyTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApprovalServiceServer).DeleteApprovalPolicyTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApprovalService_DeleteApprovalPolicyTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApprovalServiceServer).DeleteApprovalPolicyTemplate(ctx, req.(*DeleteApprovalPolicyTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApprovalService_RecomputeApprovalPolicyCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecomputeApprovalPolicyCountersRequest)
 	if err := dec(in); err != nil {
@@ -501,6 +605,18 @@ var ApprovalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReopenApprovalTask",
 			Handler:    _ApprovalService_ReopenApprovalTask_Handler,
 		},
+		{
+			MethodName: "ListApprovalPolicyTemplates",
+			Handler:    _ApprovalService_ListApprovalPolicyTemplates_Handler,
+		},
+		{
+			MethodName: "CreateOrUpdateApprovalPolicyTemplate",
+			Handler:    _ApprovalService_CreateOrUpdateApprovalPolicyTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteApprovalPolicyTemplate",
+			Handler:    _ApprovalService_DeleteApprovalPolicyTemplate_Handler,
+		},
 		{
 			MethodName: "RecomputeApprovalPolicyCounters",
 			Handler:    _ApprovalService_RecomputeApprovalPolicyCounters_Handler,
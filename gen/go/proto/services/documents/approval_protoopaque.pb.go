@@ -2266,11 +2266,411 @@ func (b0 RecomputeApprovalPolicyCountersResponse_builder) Build() *RecomputeAppr
 	return m0
 }
 
+type ListApprovalPolicyTemplatesRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CategoryId  int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3,oneof"`
+	xxx_hidden_TemplateId  int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) Reset() {
+	*x = ListApprovalPolicyTemplatesRequest{}
+	mi := &file_services_documents_approval_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalPolicyTemplatesRequest) ProtoMessage() {}
+
+func (x *ListApprovalPolicyTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_approval_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.xxx_hidden_CategoryId
+	}
+	return 0
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TemplateId
+	}
+	return 0
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) SetCategoryId(v int64) {
+	x.xxx_hidden_CategoryId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) SetTemplateId(v int64) {
+	x.xxx_hidden_TemplateId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) HasTemplateId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) ClearCategoryId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CategoryId = 0
+}
+
+func (x *ListApprovalPolicyTemplatesRequest) ClearTemplateId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TemplateId = 0
+}
+
+type ListApprovalPolicyTemplatesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CategoryId *int64
+	TemplateId *int64
+}
+
+func (b0 ListApprovalPolicyTemplatesRequest_builder) Build() *ListApprovalPolicyTemplatesRequest {
+	m0 := &ListApprovalPolicyTemplatesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CategoryId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_CategoryId = *b.CategoryId
+	}
+	if b.TemplateId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_TemplateId = *b.TemplateId
+	}
+	return m0
+}
+
+type ListApprovalPolicyTemplatesResponse struct {
+	state                      protoimpl.MessageState              `protogen:"opaque.v1"`
+	xxx_hidden_PolicyTemplates *[]*approval.ApprovalPolicyTemplate `protobuf:"bytes,1,rep,name=policy_templates,json=policyTemplates,proto3"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ListApprovalPolicyTemplatesResponse) Reset() {
+	*x = ListApprovalPolicyTemplatesResponse{}
+	mi := &file_services_documents_approval_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalPolicyTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalPolicyTemplatesResponse) ProtoMessage() {}
+
+func (x *ListApprovalPolicyTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_approval_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListApprovalPolicyTemplatesResponse) GetPolicyTemplates() []*approval.ApprovalPolicyTemplate {
+	if x != nil {
+		if x.xxx_hidden_PolicyTemplates != nil {
+			return *x.xxx_hidden_PolicyTemplates
+		}
+	}
+	return nil
+}
+
+func (x *ListApprovalPolicyTemplatesResponse) SetPolicyTemplates(v []*approval.ApprovalPolicyTemplate) {
+	x.xxx_hidden_PolicyTemplates = &v
+}
+
+type ListApprovalPolicyTemplatesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PolicyTemplates []*approval.ApprovalPolicyTemplate
+}
+
+func (b0 ListApprovalPolicyTemplatesResponse_builder) Build() *ListApprovalPolicyTemplatesResponse {
+	m0 := &ListApprovalPolicyTemplatesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PolicyTemplates = &b.PolicyTemplates
+	return m0
+}
+
+type CreateOrUpdateApprovalPolicyTemplateRequest struct {
+	state                     protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_PolicyTemplate *approval.ApprovalPolicyTemplate `protobuf:"bytes,1,opt,name=policy_template,json=policyTemplate,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateRequest) Reset() {
+	*x = CreateOrUpdateApprovalPolicyTemplateRequest{}
+	mi := &file_services_documents_approval_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateApprovalPolicyTemplateRequest) ProtoMessage() {}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_approval_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateRequest) GetPolicyTemplate() *approval.ApprovalPolicyTemplate {
+	if x != nil {
+		return x.xxx_hidden_PolicyTemplate
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateRequest) SetPolicyTemplate(v *approval.ApprovalPolicyTemplate) {
+	x.xxx_hidden_PolicyTemplate = v
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateRequest) HasPolicyTemplate() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PolicyTemplate != nil
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateRequest) ClearPolicyTemplate() {
+	x.xxx_hidden_PolicyTemplate = nil
+}
+
+type CreateOrUpdateApprovalPolicyTemplateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PolicyTemplate *approval.ApprovalPolicyTemplate
+}
+
+func (b0 CreateOrUpdateApprovalPolicyTemplateRequest_builder) Build() *CreateOrUpdateApprovalPolicyTemplateRequest {
+	m0 := &CreateOrUpdateApprovalPolicyTemplateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PolicyTemplate = b.PolicyTemplate
+	return m0
+}
+
+type CreateOrUpdateApprovalPolicyTemplateResponse struct {
+	state                     protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_PolicyTemplate *approval.ApprovalPolicyTemplate `protobuf:"bytes,1,opt,name=policy_template,json=policyTemplate,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateResponse) Reset() {
+	*x = CreateOrUpdateApprovalPolicyTemplateResponse{}
+	mi := &file_services_documents_approval_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateApprovalPolicyTemplateResponse) ProtoMessage() {}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_approval_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateResponse) GetPolicyTemplate() *approval.ApprovalPolicyTemplate {
+	if x != nil {
+		return x.xxx_hidden_PolicyTemplate
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateResponse) SetPolicyTemplate(v *approval.ApprovalPolicyTemplate) {
+	x.xxx_hidden_PolicyTemplate = v
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateResponse) HasPolicyTemplate() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PolicyTemplate != nil
+}
+
+func (x *CreateOrUpdateApprovalPolicyTemplateResponse) ClearPolicyTemplate() {
+	x.xxx_hidden_PolicyTemplate = nil
+}
+
+type CreateOrUpdateApprovalPolicyTemplateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PolicyTemplate *approval.ApprovalPolicyTemplate
+}
+
+func (b0 CreateOrUpdateApprovalPolicyTemplateResponse_builder) Build() *CreateOrUpdateApprovalPolicyTemplateResponse {
+	m0 := &CreateOrUpdateApprovalPolicyTemplateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PolicyTemplate = b.PolicyTemplate
+	return m0
+}
+
+type DeleteApprovalPolicyTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id int64                  `protobuf:"varint,1,opt,name=id,proto3"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApprovalPolicyTemplateRequest) Reset() {
+	*x = DeleteApprovalPolicyTemplateRequest{}
+	mi := &file_services_documents_approval_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApprovalPolicyTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApprovalPolicyTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteApprovalPolicyTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_approval_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteApprovalPolicyTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *DeleteApprovalPolicyTemplateRequest) SetId(v int64) {
+	x.xxx_hidden_Id = v
+}
+
+type DeleteApprovalPolicyTemplateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id int64
+}
+
+func (b0 DeleteApprovalPolicyTemplateRequest_builder) Build() *DeleteApprovalPolicyTemplateRequest {
+	m0 := &DeleteApprovalPolicyTemplateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type DeleteApprovalPolicyTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApprovalPolicyTemplateResponse) Reset() {
+	*x = DeleteApprovalPolicyTemplateResponse{}
+	mi := &file_services_documents_approval_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApprovalPolicyTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApprovalPolicyTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteApprovalPolicyTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_approval_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteApprovalPolicyTemplateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteApprovalPolicyTemplateResponse_builder) Build() *DeleteApprovalPolicyTemplateResponse {
+	m0 := &DeleteApprovalPolicyTemplateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
 var File_services_documents_approval_proto protoreflect.FileDescriptor
 
 const file_services_documents_approval_proto_rawDesc = "" +
 	"\n" +
-	"!services/documents/approval.proto\x12\x12services.documents\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a(resources/common/database/database.proto\x1a+resources/documents/approval/approval.proto\x1a#resources/documents/documents.proto\x1a#resources/timestamp/timestamp.proto\"\xb8\x02\n" +
+	"!services/documents/approval.proto\x12\x12services.documents\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a(resources/common/database/database.proto\x1a+resources/documents/approval/approval.proto\x1a2resources/documents/approval/policy_template.proto\x1a#resources/documents/documents.proto\x1a#resources/timestamp/timestamp.proto\"\xb8\x02\n" +
 	"\x1dListApprovalTasksInboxRequest\x12L\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
@@ -2383,7 +2783,23 @@ const file_services_documents_approval_proto_rawDesc = "" +
 	"\vdocument_id\x18\x01 \x01(\x03R\n" +
 	"documentId\"o\n" +
 	"'RecomputeApprovalPolicyCountersResponse\x12D\n" +
-	"\x06policy\x18\x01 \x01(\v2,.resources.documents.approval.ApprovalPolicyR\x06policy2\xf0\f\n" +
+	"\x06policy\x18\x01 \x01(\v2,.resources.documents.approval.ApprovalPolicyR\x06policy\"\x90\x01\n" +
+	"\"ListApprovalPolicyTemplatesRequest\x12$\n" +
+	"\vcategory_id\x18\x01 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x02 \x01(\x03H\x01R\n" +
+	"templateId\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\x0e\n" +
+	"\f_template_id\"\x86\x01\n" +
+	"#ListApprovalPolicyTemplatesResponse\x12_\n" +
+	"\x10policy_templates\x18\x01 \x03(\v24.resources.documents.approval.ApprovalPolicyTemplateR\x0fpolicyTemplates\"\x8c\x01\n" +
+	"+CreateOrUpdateApprovalPolicyTemplateRequest\x12]\n" +
+	"\x0fpolicy_template\x18\x01 \x01(\v24.resources.documents.approval.ApprovalPolicyTemplateR\x0epolicyTemplate\"\x8d\x01\n" +
+	",CreateOrUpdateApprovalPolicyTemplateResponse\x12]\n" +
+	"\x0fpolicy_template\x18\x01 \x01(\v24.resources.documents.approval.ApprovalPolicyTemplateR\x0epolicyTemplate\"5\n" +
+	"#DeleteApprovalPolicyTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"&\n" +
+	"$DeleteApprovalPolicyTemplateResponse2\xa5\x11\n" +
 	"\x0fApprovalService\x12\xa8\x01\n" +
 	"\x16ListApprovalTasksInbox\x121.services.documents.ListApprovalTasksInboxRequest\x1a2.services.documents.ListApprovalTasksInboxResponse\"'\xd2\xf3\x18#\b\x01\x1a\x10DocumentsService\"\rListDocuments\x12\xa2\x01\n" +
 	"\x14ListApprovalPolicies\x12/.services.documents.ListApprovalPoliciesRequest\x1a0.services.documents.ListApprovalPoliciesResponse\"'\xd2\xf3\x18#\b\x01\x1a\x10DocumentsService\"\rListDocuments\x12\x81\x01\n" +
@@ -2394,100 +2810,119 @@ const file_services_documents_approval_proto_rawDesc = "" +
 	"\rListApprovals\x12(.services.documents.ListApprovalsRequest\x1a).services.documents.ListApprovalsResponse\"'\xd2\xf3\x18#\b\x01\x1a\x10DocumentsService\"\rListDocuments\x12o\n" +
 	"\x0eRevokeApproval\x12).services.documents.RevokeApprovalRequest\x1a*.services.documents.RevokeApprovalResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12\x90\x01\n" +
 	"\x0eDecideApproval\x12).services.documents.DecideApprovalRequest\x1a*.services.documents.DecideApprovalResponse\"'\xd2\xf3\x18#\b\x01\x1a\x10DocumentsService\"\rListDocuments\x12\x8b\x01\n" +
-	"\x12ReopenApprovalTask\x12-.services.documents.ReopenApprovalTaskRequest\x1a..services.documents.ReopenApprovalTaskResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eRevokeApproval\x12\xb2\x01\n" +
+	"\x12ReopenApprovalTask\x12-.services.documents.ReopenApprovalTaskRequest\x1a..services.documents.ReopenApprovalTaskResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eRevokeApproval\x12\xbc\x01\n" +
+	"\x1bListApprovalPolicyTemplates\x126.services.documents.ListApprovalPolicyTemplatesRequest\x1a7.services.documents.ListApprovalPolicyTemplatesResponse\",\xd2\xf3\x18(\b\x01\"$CreateOrUpdateApprovalPolicyTemplate\x12\xb1\x01\n" +
+	"$CreateOrUpdateApprovalPolicyTemplate\x12?.services.documents.CreateOrUpdateApprovalPolicyTemplateRequest\x1a@.services.documents.CreateOrUpdateApprovalPolicyTemplateResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12\xbf\x01\n" +
+	"\x1cDeleteApprovalPolicyTemplate\x127.services.documents.DeleteApprovalPolicyTemplateRequest\x1a8.services.documents.DeleteApprovalPolicyTemplateResponse\",\xd2\xf3\x18(\b\x01\"$CreateOrUpdateApprovalPolicyTemplate\x12\xb2\x01\n" +
 	"\x1fRecomputeApprovalPolicyCounters\x12:.services.documents.RecomputeApprovalPolicyCountersRequest\x1a;.services.documents.RecomputeApprovalPolicyCountersResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eRevokeApproval\x1a\x16\xea\xf3\x18\x12\b4\x12\x0ei-mdi-approvalBPZNgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents;documentsb\x06proto3"
 
-var file_services_documents_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_services_documents_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_services_documents_approval_proto_goTypes = []any{
-	(*ListApprovalTasksInboxRequest)(nil),           // 0: services.documents.ListApprovalTasksInboxRequest
-	(*ListApprovalTasksInboxResponse)(nil),          // 1: services.documents.ListApprovalTasksInboxResponse
-	(*ListApprovalPoliciesRequest)(nil),             // 2: services.documents.ListApprovalPoliciesRequest
-	(*ListApprovalPoliciesResponse)(nil),            // 3: services.documents.ListApprovalPoliciesResponse
-	(*UpsertApprovalPolicyRequest)(nil),             // 4: services.documents.UpsertApprovalPolicyRequest
-	(*UpsertApprovalPolicyResponse)(nil),            // 5: services.documents.UpsertApprovalPolicyResponse
-	(*ListApprovalTasksRequest)(nil),                // 6: services.documents.ListApprovalTasksRequest
-	(*ListApprovalTasksResponse)(nil),               // 7: services.documents.ListApprovalTasksResponse
-	(*ApprovalTaskSeed)(nil),                        // 8: services.documents.ApprovalTaskSeed
-	(*UpsertApprovalTasksRequest)(nil),              // 9: services.documents.UpsertApprovalTasksRequest
-	(*UpsertApprovalTasksResponse)(nil),             // 10: services.documents.UpsertApprovalTasksResponse
-	(*DeleteApprovalTasksRequest)(nil),              // 11: services.documents.DeleteApprovalTasksRequest
-	(*DeleteApprovalTasksResponse)(nil),             // 12: services.documents.DeleteApprovalTasksResponse
-	(*ListApprovalsRequest)(nil),                    // 13: services.documents.ListApprovalsRequest
-	(*ListApprovalsResponse)(nil),                   // 14: services.documents.ListApprovalsResponse
-	(*RevokeApprovalRequest)(nil),                   // 15: services.documents.RevokeApprovalRequest
-	(*RevokeApprovalResponse)(nil),                  // 16: services.documents.RevokeApprovalResponse
-	(*DecideApprovalRequest)(nil),                   // 17: services.documents.DecideApprovalRequest
-	(*DecideApprovalResponse)(nil),                  // 18: services.documents.DecideApprovalResponse
-	(*ReopenApprovalTaskRequest)(nil),               // 19: services.documents.ReopenApprovalTaskRequest
-	(*ReopenApprovalTaskResponse)(nil),              // 20: services.documents.ReopenApprovalTaskResponse
-	(*RecomputeApprovalPolicyCountersRequest)(nil),  // 21: services.documents.RecomputeApprovalPolicyCountersRequest
-	(*RecomputeApprovalPolicyCountersResponse)(nil), // 22: services.documents.RecomputeApprovalPolicyCountersResponse
-	(*database.PaginationRequest)(nil),              // 23: resources.common.database.PaginationRequest
-	(approval.ApprovalTaskStatus)(0),                // 24: resources.documents.approval.ApprovalTaskStatus
-	(*database.PaginationResponse)(nil),             // 25: resources.common.database.PaginationResponse
-	(*approval.ApprovalTask)(nil),                   // 26: resources.documents.approval.ApprovalTask
-	(*approval.ApprovalPolicy)(nil),                 // 27: resources.documents.approval.ApprovalPolicy
-	(*documents.DocumentMeta)(nil),                  // 28: resources.documents.DocumentMeta
-	(*timestamp.Timestamp)(nil),                     // 29: resources.timestamp.Timestamp
-	(approval.ApprovalStatus)(0),                    // 30: resources.documents.approval.ApprovalStatus
-	(*approval.Approval)(nil),                       // 31: resources.documents.approval.Approval
+	(*ListApprovalTasksInboxRequest)(nil),                // 0: services.documents.ListApprovalTasksInboxRequest
+	(*ListApprovalTasksInboxResponse)(nil),               // 1: services.documents.ListApprovalTasksInboxResponse
+	(*ListApprovalPoliciesRequest)(nil),                  // 2: services.documents.ListApprovalPoliciesRequest
+	(*ListApprovalPoliciesResponse)(nil),                 // 3: services.documents.ListApprovalPoliciesResponse
+	(*UpsertApprovalPolicyRequest)(nil),                  // 4: services.documents.UpsertApprovalPolicyRequest
+	(*UpsertApprovalPolicyResponse)(nil),                 // 5: services.documents.UpsertApprovalPolicyResponse
+	(*ListApprovalTasksRequest)(nil),                     // 6: services.documents.ListApprovalTasksRequest
+	(*ListApprovalTasksResponse)(nil),                    // 7: services.documents.ListApprovalTasksResponse
+	(*ApprovalTaskSeed)(nil),                             // 8: services.documents.ApprovalTaskSeed
+	(*UpsertApprovalTasksRequest)(nil),                   // 9: services.documents.UpsertApprovalTasksRequest
+	(*UpsertApprovalTasksResponse)(nil),                  // 10: services.documents.UpsertApprovalTasksResponse
+	(*DeleteApprovalTasksRequest)(nil),                   // 11: services.documents.DeleteApprovalTasksRequest
+	(*DeleteApprovalTasksResponse)(nil),                  // 12: services.documents.DeleteApprovalTasksResponse
+	(*ListApprovalsRequest)(nil),                         // 13: services.documents.ListApprovalsRequest
+	(*ListApprovalsResponse)(nil),                        // 14: services.documents.ListApprovalsResponse
+	(*RevokeApprovalRequest)(nil),                        // 15: services.documents.RevokeApprovalRequest
+	(*RevokeApprovalResponse)(nil),                       // 16: services.documents.RevokeApprovalResponse
+	(*DecideApprovalRequest)(nil),                        // 17: services.documents.DecideApprovalRequest
+	(*DecideApprovalResponse)(nil),                       // 18: services.documents.DecideApprovalResponse
+	(*ReopenApprovalTaskRequest)(nil),                    // 19: services.documents.ReopenApprovalTaskRequest
+	(*ReopenApprovalTaskResponse)(nil),                   // 20: services.documents.ReopenApprovalTaskResponse
+	(*RecomputeApprovalPolicyCountersRequest)(nil),       // 21: services.documents.RecomputeApprovalPolicyCountersRequest
+	(*RecomputeApprovalPolicyCountersResponse)(nil),      // 22: services.documents.RecomputeApprovalPolicyCountersResponse
+	(*ListApprovalPolicyTemplatesRequest)(nil),           // 23: services.documents.ListApprovalPolicyTemplatesRequest
+	(*ListApprovalPolicyTemplatesResponse)(nil),          // 24: services.documents.ListApprovalPolicyTemplatesResponse
+	(*CreateOrUpdateApprovalPolicyTemplateRequest)(nil),  // 25: services.documents.CreateOrUpdateApprovalPolicyTemplateRequest
+	(*CreateOrUpdateApprovalPolicyTemplateResponse)(nil), // 26: services.documents.CreateOrUpdateApprovalPolicyTemplateResponse
+	(*DeleteApprovalPolicyTemplateRequest)(nil),          // 27: services.documents.DeleteApprovalPolicyTemplateRequest
+	(*DeleteApprovalPolicyTemplateResponse)(nil),         // 28: services.documents.DeleteApprovalPolicyTemplateResponse
+	(*database.PaginationRequest)(nil),                   // 29: resources.common.database.PaginationRequest
+	(approval.ApprovalTaskStatus)(0),                     // 30: resources.documents.approval.ApprovalTaskStatus
+	(*database.PaginationResponse)(nil),                  // 31: resources.common.database.PaginationResponse
+	(*approval.ApprovalTask)(nil),                        // 32: resources.documents.approval.ApprovalTask
+	(*approval.ApprovalPolicy)(nil),                      // 33: resources.documents.approval.ApprovalPolicy
+	(*documents.DocumentMeta)(nil),                       // 34: resources.documents.DocumentMeta
+	(*timestamp.Timestamp)(nil),                          // 35: resources.timestamp.Timestamp
+	(approval.ApprovalStatus)(0),                         // 36: resources.documents.approval.ApprovalStatus
+	(*approval.Approval)(nil),                            // 37: resources.documents.approval.Approval
+	(*approval.ApprovalPolicyTemplate)(nil),              // 38: resources.documents.approval.ApprovalPolicyTemplate
 }
 var file_services_documents_approval_proto_depIdxs = []int32{
-	23, // 0: services.documents.ListApprovalTasksInboxRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	24, // 1: services.documents.ListApprovalTasksInboxRequest.statuses:type_name -> resources.documents.approval.ApprovalTaskStatus
-	25, // 2: services.documents.ListApprovalTasksInboxResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	26, // 3: services.documents.ListApprovalTasksInboxResponse.tasks:type_name -> resources.documents.approval.ApprovalTask
-	27, // 4: services.documents.ListApprovalPoliciesResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
-	28, // 5: services.documents.ListApprovalPoliciesResponse.doc_meta:type_name -> resources.documents.DocumentMeta
-	27, // 6: services.documents.UpsertApprovalPolicyRequest.policy:type_name -> resources.documents.approval.ApprovalPolicy
-	27, // 7: services.documents.UpsertApprovalPolicyResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
-	28, // 8: services.documents.UpsertApprovalPolicyResponse.doc_meta:type_name -> resources.documents.DocumentMeta
-	24, // 9: services.documents.ListApprovalTasksRequest.statuses:type_name -> resources.documents.approval.ApprovalTaskStatus
-	26, // 10: services.documents.ListApprovalTasksResponse.tasks:type_name -> resources.documents.approval.ApprovalTask
-	29, // 11: services.documents.ApprovalTaskSeed.due_at:type_name -> resources.timestamp.Timestamp
-	29, // 12: services.documents.UpsertApprovalTasksRequest.snapshot_date:type_name -> resources.timestamp.Timestamp
+	29, // 0: services.documents.ListApprovalTasksInboxRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	30, // 1: services.documents.ListApprovalTasksInboxRequest.statuses:type_name -> resources.documents.approval.ApprovalTaskStatus
+	31, // 2: services.documents.ListApprovalTasksInboxResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	32, // 3: services.documents.ListApprovalTasksInboxResponse.tasks:type_name -> resources.documents.approval.ApprovalTask
+	33, // 4: services.documents.ListApprovalPoliciesResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
+	34, // 5: services.documents.ListApprovalPoliciesResponse.doc_meta:type_name -> resources.documents.DocumentMeta
+	33, // 6: services.documents.UpsertApprovalPolicyRequest.policy:type_name -> resources.documents.approval.ApprovalPolicy
+	33, // 7: services.documents.UpsertApprovalPolicyResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
+	34, // 8: services.documents.UpsertApprovalPolicyResponse.doc_meta:type_name -> resources.documents.DocumentMeta
+	30, // 9: services.documents.ListApprovalTasksRequest.statuses:type_name -> resources.documents.approval.ApprovalTaskStatus
+	32, // 10: services.documents.ListApprovalTasksResponse.tasks:type_name -> resources.documents.approval.ApprovalTask
+	35, // 11: services.documents.ApprovalTaskSeed.due_at:type_name -> resources.timestamp.Timestamp
+	35, // 12: services.documents.UpsertApprovalTasksRequest.snapshot_date:type_name -> resources.timestamp.Timestamp
 	8,  // 13: services.documents.UpsertApprovalTasksRequest.seeds:type_name -> services.documents.ApprovalTaskSeed
-	27, // 14: services.documents.UpsertApprovalTasksResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
-	29, // 15: services.documents.ListApprovalsRequest.snapshot_date:type_name -> resources.timestamp.Timestamp
-	30, // 16: services.documents.ListApprovalsRequest.status:type_name -> resources.documents.approval.ApprovalStatus
-	31, // 17: services.documents.ListApprovalsResponse.approvals:type_name -> resources.documents.approval.Approval
-	31, // 18: services.documents.RevokeApprovalResponse.approval:type_name -> resources.documents.approval.Approval
-	28, // 19: services.documents.RevokeApprovalResponse.doc_meta:type_name -> resources.documents.DocumentMeta
-	24, // 20: services.documents.DecideApprovalRequest.new_status:type_name -> resources.documents.approval.ApprovalTaskStatus
-	31, // 21: services.documents.DecideApprovalResponse.approval:type_name -> resources.documents.approval.Approval
-	26, // 22: services.documents.DecideApprovalResponse.task:type_name -> resources.documents.approval.ApprovalTask
-	27, // 23: services.documents.DecideApprovalResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
-	28, // 24: services.documents.DecideApprovalResponse.doc_meta:type_name -> resources.documents.DocumentMeta
-	26, // 25: services.documents.ReopenApprovalTaskResponse.task:type_name -> resources.documents.approval.ApprovalTask
-	27, // 26: services.documents.ReopenApprovalTaskResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
-	27, // 27: services.documents.RecomputeApprovalPolicyCountersResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
-	0,  // 28: services.documents.ApprovalService.ListApprovalTasksInbox:input_type -> services.documents.ListApprovalTasksInboxRequest
-	2,  // 29: services.documents.ApprovalService.ListApprovalPolicies:input_type -> services.documents.ListApprovalPoliciesRequest
-	4,  // 30: services.documents.ApprovalService.UpsertApprovalPolicy:input_type -> services.documents.UpsertApprovalPolicyRequest
-	6,  // 31: services.documents.ApprovalService.ListApprovalTasks:input_type -> services.documents.ListApprovalTasksRequest
-	9,  // 32: services.documents.ApprovalService.UpsertApprovalTasks:input_type -> services.documents.UpsertApprovalTasksRequest
-	11, // 33: services.documents.ApprovalService.DeleteApprovalTasks:input_type -> services.documents.DeleteApprovalTasksRequest
-	13, // 34: services.documents.ApprovalService.ListApprovals:input_type -> services.documents.ListApprovalsRequest
-	15, // 35: services.documents.ApprovalService.RevokeApproval:input_type -> services.documents.RevokeApprovalRequest
-	17, // 36: services.documents.ApprovalService.DecideApproval:input_type -> services.documents.DecideApprovalRequest
-	19, // 37: services.documents.ApprovalService.ReopenApprovalTask:input_type -> services.documents.ReopenApprovalTaskRequest
-	21, // 38: services.documents.ApprovalService.RecomputeApprovalPolicyCounters:input_type -> services.documents.RecomputeApprovalPolicyCountersRequest
-	1,  // 39: services.documents.ApprovalService.ListApprovalTasksInbox:output_type -> services.documents.ListApprovalTasksInboxResponse
-	3,  // 40: services.documents.ApprovalService.ListApprovalPolicies:output_type -> services.documents.ListApprovalPoliciesResponse
-	5,  // 41: services.documents.ApprovalService.UpsertApprovalPolicy:output_type -> services.documents.UpsertApprovalPolicyResponse
-	7,  // 42: services.documents.ApprovalService.ListApprovalTasks:output_type -> services.documents.ListApprovalTasksResponse
-	10, // 43: services.documents.ApprovalService.UpsertApprovalTasks:output_type -> services.documents.UpsertApprovalTasksResponse
-	12, // 44: services.documents.ApprovalService.DeleteApprovalTasks:output_type -> services.documents.DeleteApprovalTasksResponse
-	14, // 45: services.documents.ApprovalService.ListApprovals:output_type -> services.documents.ListApprovalsResponse
-	16, // 46: services.documents.ApprovalService.RevokeApproval:output_type -> services.documents.RevokeApprovalResponse
-	18, // 47: services.documents.ApprovalService.DecideApproval:output_type -> services.documents.DecideApprovalResponse
-	20, // 48: services.documents.ApprovalService.ReopenApprovalTask:output_type -> services.documents.ReopenApprovalTaskResponse
-	22, // 49: services.documents.ApprovalService.RecomputeApprovalPolicyCounters:output_type -> services.documents.RecomputeApprovalPolicyCountersResponse
-	39, // [39:50] is the sub-list for method output_type
-	28, // [28:39] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	33, // 14: services.documents.UpsertApprovalTasksResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
+	35, // 15: services.documents.ListApprovalsRequest.snapshot_date:type_name -> resources.timestamp.Timestamp
+	36, // 16: services.documents.ListApprovalsRequest.status:type_name -> resources.documents.approval.ApprovalStatus
+	37, // 17: services.documents.ListApprovalsResponse.approvals:type_name -> resources.documents.approval.Approval
+	37, // 18: services.documents.RevokeApprovalResponse.approval:type_name -> resources.documents.approval.Approval
+	34, // 19: services.documents.RevokeApprovalResponse.doc_meta:type_name -> resources.documents.DocumentMeta
+	30, // 20: services.documents.DecideApprovalRequest.new_status:type_name -> resources.documents.approval.ApprovalTaskStatus
+	37, // 21: services.documents.DecideApprovalResponse.approval:type_name -> resources.documents.approval.Approval
+	32, // 22: services.documents.DecideApprovalResponse.task:type_name -> resources.documents.approval.ApprovalTask
+	33, // 23: services.documents.DecideApprovalResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
+	34, // 24: services.documents.DecideApprovalResponse.doc_meta:type_name -> resources.documents.DocumentMeta
+	32, // 25: services.documents.ReopenApprovalTaskResponse.task:type_name -> resources.documents.approval.ApprovalTask
+	33, // 26: services.documents.ReopenApprovalTaskResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
+	33, // 27: services.documents.RecomputeApprovalPolicyCountersResponse.policy:type_name -> resources.documents.approval.ApprovalPolicy
+	38, // 28: services.documents.ListApprovalPolicyTemplatesResponse.policy_templates:type_name -> resources.documents.approval.ApprovalPolicyTemplate
+	38, // 29: services.documents.CreateOrUpdateApprovalPolicyTemplateRequest.policy_template:type_name -> resources.documents.approval.ApprovalPolicyTemplate
+	38, // 30: services.documents.CreateOrUpdateApprovalPolicyTemplateResponse.policy_template:type_name -> resources.documents.approval.ApprovalPolicyTemplate
+	0,  // 31: services.documents.ApprovalService.ListApprovalTasksInbox:input_type -> services.documents.ListApprovalTasksInboxRequest
+	2,  // 32: services.documents.ApprovalService.ListApprovalPolicies:input_type -> services.documents.ListApprovalPoliciesRequest
+	4,  // 33: services.documents.ApprovalService.UpsertApprovalPolicy:input_type -> services.documents.UpsertApprovalPolicyRequest
+	6,  // 34: services.documents.ApprovalService.ListApprovalTasks:input_type -> services.documents.ListApprovalTasksRequest
+	9,  // 35: services.documents.ApprovalService.UpsertApprovalTasks:input_type -> services.documents.UpsertApprovalTasksRequest
+	11, // 36: services.documents.ApprovalService.DeleteApprovalTasks:input_type -> services.documents.DeleteApprovalTasksRequest
+	13, // 37: services.documents.ApprovalService.ListApprovals:input_type -> services.documents.ListApprovalsRequest
+	15, // 38: services.documents.ApprovalService.RevokeApproval:input_type -> services.documents.RevokeApprovalRequest
+	17, // 39: services.documents.ApprovalService.DecideApproval:input_type -> services.documents.DecideApprovalRequest
+	19, // 40: services.documents.ApprovalService.ReopenApprovalTask:input_type -> services.documents.ReopenApprovalTaskRequest
+	23, // 41: services.documents.ApprovalService.ListApprovalPolicyTemplates:input_type -> services.documents.ListApprovalPolicyTemplatesRequest
+	25, // 42: services.documents.ApprovalService.CreateOrUpdateApprovalPolicyTemplate:input_type -> services.documents.CreateOrUpdateApprovalPolicyTemplateRequest
+	27, // 43: services.documents.ApprovalService.DeleteApprovalPolicyTemplate:input_type -> services.documents.DeleteApprovalPolicyTemplateRequest
+	21, // 44: services.documents.ApprovalService.RecomputeApprovalPolicyCounters:input_type -> services.documents.RecomputeApprovalPolicyCountersRequest
+	1,  // 45: services.documents.ApprovalService.ListApprovalTasksInbox:output_type -> services.documents.ListApprovalTasksInboxResponse
+	3,  // 46: services.documents.ApprovalService.ListApprovalPolicies:output_type -> services.documents.ListApprovalPoliciesResponse
+	5,  // 47: services.documents.ApprovalService.UpsertApprovalPolicy:output_type -> services.documents.UpsertApprovalPolicyResponse
+	7,  // 48: services.documents.ApprovalService.ListApprovalTasks:output_type -> services.documents.ListApprovalTasksResponse
+	10, // 49: services.documents.ApprovalService.UpsertApprovalTasks:output_type -> services.documents.UpsertApprovalTasksResponse
+	12, // 50: services.documents.ApprovalService.DeleteApprovalTasks:output_type -> services.documents.DeleteApprovalTasksResponse
+	14, // 51: services.documents.ApprovalService.ListApprovals:output_type -> services.documents.ListApprovalsResponse
+	16, // 52: services.documents.ApprovalService.RevokeApproval:output_type -> services.documents.RevokeApprovalResponse
+	18, // 53: services.documents.ApprovalService.DecideApproval:output_type -> services.documents.DecideApprovalResponse
+	20, // 54: services.documents.ApprovalService.ReopenApprovalTask:output_type -> services.documents.ReopenApprovalTaskResponse
+	24, // 55: services.documents.ApprovalService.ListApprovalPolicyTemplates:output_type -> services.documents.ListApprovalPolicyTemplatesResponse
+	26, // 56: services.documents.ApprovalService.CreateOrUpdateApprovalPolicyTemplate:output_type -> services.documents.CreateOrUpdateApprovalPolicyTemplateResponse
+	28, // 57: services.documents.ApprovalService.DeleteApprovalPolicyTemplate:output_type -> services.documents.DeleteApprovalPolicyTemplateResponse
+	22, // 58: services.documents.ApprovalService.RecomputeApprovalPolicyCounters:output_type -> services.documents.RecomputeApprovalPolicyCountersResponse
+	45, // [45:59] is the sub-list for method output_type
+	31, // [31:45] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_services_documents_approval_proto_init() }
@@ -2500,13 +2935,14 @@ func file_services_documents_approval_proto_init() {
 	file_services_documents_approval_proto_msgTypes[9].OneofWrappers = []any{}
 	file_services_documents_approval_proto_msgTypes[13].OneofWrappers = []any{}
 	file_services_documents_approval_proto_msgTypes[17].OneofWrappers = []any{}
+	file_services_documents_approval_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_documents_approval_proto_rawDesc), len(file_services_documents_approval_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -9,6 +9,7 @@
 package documents
 
 import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/audit"
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/itemslen"
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/perms"
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
@@ -17,12 +18,15 @@ import (
 	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
 	documents "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
 	activity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	bulk "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/bulk"
 	data "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	forms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
 	pins "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/pins"
 	references "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/references"
 	relations "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/relations"
 	requests "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/requests"
 	templates "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/templates"
+	versions "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/versions"
 	file "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/file"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
@@ -55,7 +59,9 @@ type ListDocumentsRequest struct {
 	// - unset/null: include all documents (drafts and non-drafts)
 	// - false: only non-draft documents
 	// - true: only draft documents
-	OnlyDrafts    *bool `protobuf:"varint,10,opt,name=only_drafts,json=onlyDrafts,proto3,oneof" json:"only_drafts,omitempty"`
+	OnlyDrafts *bool `protobuf:"varint,10,opt,name=only_drafts,json=onlyDrafts,proto3,oneof" json:"only_drafts,omitempty"`
+	// Filters on form field values of documents
+	FormFields    []*forms.FormFieldFilter `protobuf:"bytes,11,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -155,6 +161,13 @@ func (x *ListDocumentsRequest) GetOnlyDrafts() bool {
 	return false
 }
 
+func (x *ListDocumentsRequest) GetFormFields() []*forms.FormFieldFilter {
+	if x != nil {
+		return x.FormFields
+	}
+	return nil
+}
+
 func (x *ListDocumentsRequest) SetPagination(v *database.PaginationRequest) {
 	x.Pagination = v
 }
@@ -195,6 +208,10 @@ func (x *ListDocumentsRequest) SetOnlyDrafts(v bool) {
 	x.OnlyDrafts = &v
 }
 
+func (x *ListDocumentsRequest) SetFormFields(v []*forms.FormFieldFilter) {
+	x.FormFields = v
+}
+
 func (x *ListDocumentsRequest) HasPagination() bool {
 	if x == nil {
 		return false
@@ -290,6 +307,8 @@ type ListDocumentsRequest_builder struct {
 	// - false: only non-draft documents
 	// - true: only draft documents
 	OnlyDrafts *bool
+	// Filters on form field values of documents
+	FormFields []*forms.FormFieldFilter
 }
 
 func (b0 ListDocumentsRequest_builder) Build() *ListDocumentsRequest {
@@ -306,6 +325,7 @@ func (b0 ListDocumentsRequest_builder) Build() *ListDocumentsRequest {
 	x.Closed = b.Closed
 	x.DocumentIds = b.DocumentIds
 	x.OnlyDrafts = b.OnlyDrafts
+	x.FormFields = b.FormFields
 	return m0
 }
 
@@ -624,10 +644,11 @@ func (b0 GetDocumentReferencesRequest_builder) Build() *GetDocumentReferencesReq
 }
 
 type GetDocumentReferencesResponse struct {
-	state         protoimpl.MessageState          `protogen:"hybrid.v1"`
-	References    []*references.DocumentReference `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty" alias:"reference"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState                 `protogen:"hybrid.v1"`
+	References        []*references.DocumentReference        `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty" alias:"reference"`
+	DispatchTimelines []*references.DocumentDispatchTimeline `protobuf:"bytes,2,rep,name=dispatch_timelines,json=dispatchTimelines,proto3" json:"dispatch_timelines,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetDocumentReferencesResponse) Reset() {
@@ -662,14 +683,26 @@ func (x *GetDocumentReferencesResponse) GetReferences() []*references.DocumentRe
 	return nil
 }
 
+func (x *GetDocumentReferencesResponse) GetDispatchTimelines() []*references.DocumentDispatchTimeline {
+	if x != nil {
+		return x.DispatchTimelines
+	}
+	return nil
+}
+
 func (x *GetDocumentReferencesResponse) SetReferences(v []*references.DocumentReference) {
 	x.References = v
 }
 
+func (x *GetDocumentReferencesResponse) SetDispatchTimelines(v []*references.DocumentDispatchTimeline) {
+	x.DispatchTimelines = v
+}
+
 type GetDocumentReferencesResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	References []*references.DocumentReference
+	References        []*references.DocumentReference
+	DispatchTimelines []*references.DocumentDispatchTimeline
 }
 
 func (b0 GetDocumentReferencesResponse_builder) Build() *GetDocumentReferencesResponse {
@@ -677,6 +710,7 @@ func (b0 GetDocumentReferencesResponse_builder) Build() *GetDocumentReferencesRe
 	b, x := &b0, m0
 	_, _ = b, x
 	x.References = b.References
+	x.DispatchTimelines = b.DispatchTimelines
 	return m0
 }
 
@@ -795,8 +829,10 @@ func (b0 GetDocumentRelationsResponse_builder) Build() *GetDocumentRelationsResp
 }
 
 type AddDocumentReferenceRequest struct {
-	state         protoimpl.MessageState        `protogen:"hybrid.v1"`
-	Reference     *references.DocumentReference `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	state     protoimpl.MessageState        `protogen:"hybrid.v1"`
+	Reference *references.DocumentReference `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Attach the dispatch's timeline to the reference's source document instead of referencing another document
+	DispatchId    *int64 `protobuf:"varint,2,opt,name=dispatch_id,json=dispatchId,proto3,oneof" json:"dispatch_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -833,10 +869,21 @@ func (x *AddDocumentReferenceRequest) GetReference() *references.DocumentReferen
 	return nil
 }
 
+func (x *AddDocumentReferenceRequest) GetDispatchId() int64 {
+	if x != nil && x.DispatchId != nil {
+		return *x.DispatchId
+	}
+	return 0
+}
+
 func (x *AddDocumentReferenceRequest) SetReference(v *references.DocumentReference) {
 	x.Reference = v
 }
 
+func (x *AddDocumentReferenceRequest) SetDispatchId(v int64) {
+	x.DispatchId = &v
+}
+
 func (x *AddDocumentReferenceRequest) HasReference() bool {
 	if x == nil {
 		return false
@@ -844,14 +891,27 @@ func (x *AddDocumentReferenceRequest) HasReference() bool {
 	return x.Reference != nil
 }
 
+func (x *AddDocumentReferenceRequest) HasDispatchId() bool {
+	if x == nil {
+		return false
+	}
+	return x.DispatchId != nil
+}
+
 func (x *AddDocumentReferenceRequest) ClearReference() {
 	x.Reference = nil
 }
 
+func (x *AddDocumentReferenceRequest) ClearDispatchId() {
+	x.DispatchId = nil
+}
+
 type AddDocumentReferenceRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Reference *references.DocumentReference
+	// Attach the dispatch's timeline to the reference's source document instead of referencing another document
+	DispatchId *int64
 }
 
 func (b0 AddDocumentReferenceRequest_builder) Build() *AddDocumentReferenceRequest {
@@ -859,6 +919,7 @@ func (b0 AddDocumentReferenceRequest_builder) Build() *AddDocumentReferenceReque
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Reference = b.Reference
+	x.DispatchId = b.DispatchId
 	return m0
 }
 
@@ -920,10 +981,12 @@ func (b0 AddDocumentReferenceResponse_builder) Build() *AddDocumentReferenceResp
 }
 
 type RemoveDocumentReferenceRequest struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Id is the id of an attached dispatch timeline
+	DispatchTimeline bool `protobuf:"varint,2,opt,name=dispatch_timeline,json=dispatchTimeline,proto3" json:"dispatch_timeline,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *RemoveDocumentReferenceRequest) Reset() {
@@ -958,14 +1021,27 @@ func (x *RemoveDocumentReferenceRequest) GetId() int64 {
 	return 0
 }
 
+func (x *RemoveDocumentReferenceRequest) GetDispatchTimeline() bool {
+	if x != nil {
+		return x.DispatchTimeline
+	}
+	return false
+}
+
 func (x *RemoveDocumentReferenceRequest) SetId(v int64) {
 	x.Id = v
 }
 
+func (x *RemoveDocumentReferenceRequest) SetDispatchTimeline(v bool) {
+	x.DispatchTimeline = v
+}
+
 type RemoveDocumentReferenceRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Id int64
+	// Id is the id of an attached dispatch timeline
+	DispatchTimeline bool
 }
 
 func (b0 RemoveDocumentReferenceRequest_builder) Build() *RemoveDocumentReferenceRequest {
@@ -973,6 +1049,7 @@ func (b0 RemoveDocumentReferenceRequest_builder) Build() *RemoveDocumentReferenc
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Id = b.Id
+	x.DispatchTimeline = b.DispatchTimeline
 	return m0
 }
 
@@ -1551,28 +1628,29 @@ func (b0 ToggleDocumentResponse_builder) Build() *ToggleDocumentResponse {
 	return m0
 }
 
-type ChangeDocumentOwnerRequest struct {
+type TransitionDocumentStateRequest struct {
 	state         protoimpl.MessageState `protogen:"hybrid.v1"`
 	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	NewUserId     *int32                 `protobuf:"varint,2,opt,name=new_user_id,json=newUserId,proto3,oneof" json:"new_user_id,omitempty"`
+	ToState       string                 `protobuf:"bytes,2,opt,name=to_state,json=toState,proto3" json:"to_state,omitempty"`
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeDocumentOwnerRequest) Reset() {
-	*x = ChangeDocumentOwnerRequest{}
+func (x *TransitionDocumentStateRequest) Reset() {
+	*x = TransitionDocumentStateRequest{}
 	mi := &file_services_documents_documents_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeDocumentOwnerRequest) String() string {
+func (x *TransitionDocumentStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeDocumentOwnerRequest) ProtoMessage() {}
+func (*TransitionDocumentStateRequest) ProtoMessage() {}
 
-func (x *ChangeDocumentOwnerRequest) ProtoReflect() protoreflect.Message {
+func (x *TransitionDocumentStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_documents_documents_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1584,75 +1662,89 @@ func (x *ChangeDocumentOwnerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ChangeDocumentOwnerRequest) GetDocumentId() int64 {
+func (x *TransitionDocumentStateRequest) GetDocumentId() int64 {
 	if x != nil {
 		return x.DocumentId
 	}
 	return 0
 }
 
-func (x *ChangeDocumentOwnerRequest) GetNewUserId() int32 {
-	if x != nil && x.NewUserId != nil {
-		return *x.NewUserId
+func (x *TransitionDocumentStateRequest) GetToState() string {
+	if x != nil {
+		return x.ToState
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeDocumentOwnerRequest) SetDocumentId(v int64) {
+func (x *TransitionDocumentStateRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *TransitionDocumentStateRequest) SetDocumentId(v int64) {
 	x.DocumentId = v
 }
 
-func (x *ChangeDocumentOwnerRequest) SetNewUserId(v int32) {
-	x.NewUserId = &v
+func (x *TransitionDocumentStateRequest) SetToState(v string) {
+	x.ToState = v
 }
 
-func (x *ChangeDocumentOwnerRequest) HasNewUserId() bool {
+func (x *TransitionDocumentStateRequest) SetReason(v string) {
+	x.Reason = &v
+}
+
+func (x *TransitionDocumentStateRequest) HasReason() bool {
 	if x == nil {
 		return false
 	}
-	return x.NewUserId != nil
+	return x.Reason != nil
 }
 
-func (x *ChangeDocumentOwnerRequest) ClearNewUserId() {
-	x.NewUserId = nil
+func (x *TransitionDocumentStateRequest) ClearReason() {
+	x.Reason = nil
 }
 
-type ChangeDocumentOwnerRequest_builder struct {
+type TransitionDocumentStateRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	DocumentId int64
-	NewUserId  *int32
+	ToState    string
+	Reason     *string
 }
 
-func (b0 ChangeDocumentOwnerRequest_builder) Build() *ChangeDocumentOwnerRequest {
-	m0 := &ChangeDocumentOwnerRequest{}
+func (b0 TransitionDocumentStateRequest_builder) Build() *TransitionDocumentStateRequest {
+	m0 := &TransitionDocumentStateRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	x.DocumentId = b.DocumentId
-	x.NewUserId = b.NewUserId
+	x.ToState = b.ToState
+	x.Reason = b.Reason
 	return m0
 }
 
-type ChangeDocumentOwnerResponse struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+type TransitionDocumentStateResponse struct {
+	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
+	Meta          *documents.DocumentMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeDocumentOwnerResponse) Reset() {
-	*x = ChangeDocumentOwnerResponse{}
+func (x *TransitionDocumentStateResponse) Reset() {
+	*x = TransitionDocumentStateResponse{}
 	mi := &file_services_documents_documents_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeDocumentOwnerResponse) String() string {
+func (x *TransitionDocumentStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeDocumentOwnerResponse) ProtoMessage() {}
+func (*TransitionDocumentStateResponse) ProtoMessage() {}
 
-func (x *ChangeDocumentOwnerResponse) ProtoReflect() protoreflect.Message {
+func (x *TransitionDocumentStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_documents_documents_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1664,41 +1756,65 @@ func (x *ChangeDocumentOwnerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type ChangeDocumentOwnerResponse_builder struct {
+func (x *TransitionDocumentStateResponse) GetMeta() *documents.DocumentMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TransitionDocumentStateResponse) SetMeta(v *documents.DocumentMeta) {
+	x.Meta = v
+}
+
+func (x *TransitionDocumentStateResponse) HasMeta() bool {
+	if x == nil {
+		return false
+	}
+	return x.Meta != nil
+}
+
+func (x *TransitionDocumentStateResponse) ClearMeta() {
+	x.Meta = nil
+}
+
+type TransitionDocumentStateResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	Meta *documents.DocumentMeta
 }
 
-func (b0 ChangeDocumentOwnerResponse_builder) Build() *ChangeDocumentOwnerResponse {
-	m0 := &ChangeDocumentOwnerResponse{}
+func (b0 TransitionDocumentStateResponse_builder) Build() *TransitionDocumentStateResponse {
+	m0 := &TransitionDocumentStateResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.Meta = b.Meta
 	return m0
 }
 
-type CreateDocumentRequest struct {
-	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
-	ContentType   content.ContentType     `protobuf:"varint,1,opt,name=content_type,json=contentType,proto3,enum=resources.common.content.ContentType" json:"content_type,omitempty"`
-	TemplateId    *int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
-	TemplateData  *templates.TemplateData `protobuf:"bytes,3,opt,name=template_data,json=templateData,proto3,oneof" json:"template_data,omitempty"`
+type SetDocumentLegalHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	LegalHold     bool                   `protobuf:"varint,2,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDocumentRequest) Reset() {
-	*x = CreateDocumentRequest{}
+func (x *SetDocumentLegalHoldRequest) Reset() {
+	*x = SetDocumentLegalHoldRequest{}
 	mi := &file_services_documents_documents_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDocumentRequest) String() string {
+func (x *SetDocumentLegalHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDocumentRequest) ProtoMessage() {}
+func (*SetDocumentLegalHoldRequest) ProtoMessage() {}
 
-func (x *CreateDocumentRequest) ProtoReflect() protoreflect.Message {
+func (x *SetDocumentLegalHoldRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_documents_documents_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1710,100 +1826,89 @@ func (x *CreateDocumentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateDocumentRequest) GetContentType() content.ContentType {
+func (x *SetDocumentLegalHoldRequest) GetDocumentId() int64 {
 	if x != nil {
-		return x.ContentType
-	}
-	return content.ContentType(0)
-}
-
-func (x *CreateDocumentRequest) GetTemplateId() int64 {
-	if x != nil && x.TemplateId != nil {
-		return *x.TemplateId
+		return x.DocumentId
 	}
 	return 0
 }
 
-func (x *CreateDocumentRequest) GetTemplateData() *templates.TemplateData {
+func (x *SetDocumentLegalHoldRequest) GetLegalHold() bool {
 	if x != nil {
-		return x.TemplateData
+		return x.LegalHold
 	}
-	return nil
+	return false
 }
 
-func (x *CreateDocumentRequest) SetContentType(v content.ContentType) {
-	x.ContentType = v
+func (x *SetDocumentLegalHoldRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
 }
 
-func (x *CreateDocumentRequest) SetTemplateId(v int64) {
-	x.TemplateId = &v
+func (x *SetDocumentLegalHoldRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
 }
 
-func (x *CreateDocumentRequest) SetTemplateData(v *templates.TemplateData) {
-	x.TemplateData = v
+func (x *SetDocumentLegalHoldRequest) SetLegalHold(v bool) {
+	x.LegalHold = v
 }
 
-func (x *CreateDocumentRequest) HasTemplateId() bool {
-	if x == nil {
-		return false
-	}
-	return x.TemplateId != nil
+func (x *SetDocumentLegalHoldRequest) SetReason(v string) {
+	x.Reason = &v
 }
 
-func (x *CreateDocumentRequest) HasTemplateData() bool {
+func (x *SetDocumentLegalHoldRequest) HasReason() bool {
 	if x == nil {
 		return false
 	}
-	return x.TemplateData != nil
-}
-
-func (x *CreateDocumentRequest) ClearTemplateId() {
-	x.TemplateId = nil
+	return x.Reason != nil
 }
 
-func (x *CreateDocumentRequest) ClearTemplateData() {
-	x.TemplateData = nil
+func (x *SetDocumentLegalHoldRequest) ClearReason() {
+	x.Reason = nil
 }
 
-type CreateDocumentRequest_builder struct {
+type SetDocumentLegalHoldRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	ContentType  content.ContentType
-	TemplateId   *int64
-	TemplateData *templates.TemplateData
+	DocumentId int64
+	LegalHold  bool
+	Reason     *string
 }
 
-func (b0 CreateDocumentRequest_builder) Build() *CreateDocumentRequest {
-	m0 := &CreateDocumentRequest{}
+func (b0 SetDocumentLegalHoldRequest_builder) Build() *SetDocumentLegalHoldRequest {
+	m0 := &SetDocumentLegalHoldRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.ContentType = b.ContentType
-	x.TemplateId = b.TemplateId
-	x.TemplateData = b.TemplateData
+	x.DocumentId = b.DocumentId
+	x.LegalHold = b.LegalHold
+	x.Reason = b.Reason
 	return m0
 }
 
-type CreateDocumentResponse struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type SetDocumentLegalHoldResponse struct {
+	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
+	Meta          *documents.DocumentMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDocumentResponse) Reset() {
-	*x = CreateDocumentResponse{}
+func (x *SetDocumentLegalHoldResponse) Reset() {
+	*x = SetDocumentLegalHoldResponse{}
 	mi := &file_services_documents_documents_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDocumentResponse) String() string {
+func (x *SetDocumentLegalHoldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDocumentResponse) ProtoMessage() {}
+func (*SetDocumentLegalHoldResponse) ProtoMessage() {}
 
-func (x *CreateDocumentResponse) ProtoReflect() protoreflect.Message {
+func (x *SetDocumentLegalHoldResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_documents_documents_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1815,60 +1920,64 @@ func (x *CreateDocumentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateDocumentResponse) GetId() int64 {
+func (x *SetDocumentLegalHoldResponse) GetMeta() *documents.DocumentMeta {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateDocumentResponse) SetId(v int64) {
-	x.Id = v
+func (x *SetDocumentLegalHoldResponse) SetMeta(v *documents.DocumentMeta) {
+	x.Meta = v
 }
 
-type CreateDocumentResponse_builder struct {
+func (x *SetDocumentLegalHoldResponse) HasMeta() bool {
+	if x == nil {
+		return false
+	}
+	return x.Meta != nil
+}
+
+func (x *SetDocumentLegalHoldResponse) ClearMeta() {
+	x.Meta = nil
+}
+
+type SetDocumentLegalHoldResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id int64
+	Meta *documents.DocumentMeta
 }
 
-func (b0 CreateDocumentResponse_builder) Build() *CreateDocumentResponse {
-	m0 := &CreateDocumentResponse{}
+func (b0 SetDocumentLegalHoldResponse_builder) Build() *SetDocumentLegalHoldResponse {
+	m0 := &SetDocumentLegalHoldResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.Id = b.Id
+	x.Meta = b.Meta
 	return m0
 }
 
-type UpdateDocumentRequest struct {
-	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
-	DocumentId    int64                   `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty" alias:"id"`
-	CategoryId    *int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	Title         string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty" alias:"title"`
-	Content       *content.Content        `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	ContentType   content.ContentType     `protobuf:"varint,5,opt,name=content_type,json=contentType,proto3,enum=resources.common.content.ContentType" json:"content_type,omitempty"`
-	Data          *data.DocumentData      `protobuf:"bytes,6,opt,name=data,proto3,oneof" json:"data,omitempty"`
-	Meta          *documents.DocumentMeta `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
-	Access        *access.Access          `protobuf:"bytes,11,opt,name=access,proto3,oneof" json:"access,omitempty"`
-	Files         []*file.File            `protobuf:"bytes,12,rep,name=files,proto3" json:"files,omitempty" alias:"files"`
+type ChangeDocumentOwnerRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	NewUserId     *int32                 `protobuf:"varint,2,opt,name=new_user_id,json=newUserId,proto3,oneof" json:"new_user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDocumentRequest) Reset() {
-	*x = UpdateDocumentRequest{}
+func (x *ChangeDocumentOwnerRequest) Reset() {
+	*x = ChangeDocumentOwnerRequest{}
 	mi := &file_services_documents_documents_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDocumentRequest) String() string {
+func (x *ChangeDocumentOwnerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDocumentRequest) ProtoMessage() {}
+func (*ChangeDocumentOwnerRequest) ProtoMessage() {}
 
-func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeDocumentOwnerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_documents_documents_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1880,215 +1989,1577 @@ func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateDocumentRequest) GetDocumentId() int64 {
+func (x *ChangeDocumentOwnerRequest) GetDocumentId() int64 {
 	if x != nil {
 		return x.DocumentId
 	}
 	return 0
 }
 
-func (x *UpdateDocumentRequest) GetCategoryId() int64 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+func (x *ChangeDocumentOwnerRequest) GetNewUserId() int32 {
+	if x != nil && x.NewUserId != nil {
+		return *x.NewUserId
 	}
 	return 0
 }
 
-func (x *UpdateDocumentRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *ChangeDocumentOwnerRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
 }
 
-func (x *UpdateDocumentRequest) GetContent() *content.Content {
-	if x != nil {
-		return x.Content
-	}
-	return nil
+func (x *ChangeDocumentOwnerRequest) SetNewUserId(v int32) {
+	x.NewUserId = &v
 }
 
-func (x *UpdateDocumentRequest) GetContentType() content.ContentType {
-	if x != nil {
+func (x *ChangeDocumentOwnerRequest) HasNewUserId() bool {
+	if x == nil {
+		return false
+	}
+	return x.NewUserId != nil
+}
+
+func (x *ChangeDocumentOwnerRequest) ClearNewUserId() {
+	x.NewUserId = nil
+}
+
+type ChangeDocumentOwnerRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DocumentId int64
+	NewUserId  *int32
+}
+
+func (b0 ChangeDocumentOwnerRequest_builder) Build() *ChangeDocumentOwnerRequest {
+	m0 := &ChangeDocumentOwnerRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DocumentId = b.DocumentId
+	x.NewUserId = b.NewUserId
+	return m0
+}
+
+type ChangeDocumentOwnerResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeDocumentOwnerResponse) Reset() {
+	*x = ChangeDocumentOwnerResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeDocumentOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDocumentOwnerResponse) ProtoMessage() {}
+
+func (x *ChangeDocumentOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ChangeDocumentOwnerResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ChangeDocumentOwnerResponse_builder) Build() *ChangeDocumentOwnerResponse {
+	m0 := &ChangeDocumentOwnerResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type CreateDocumentRequest struct {
+	state        protoimpl.MessageState  `protogen:"hybrid.v1"`
+	ContentType  content.ContentType     `protobuf:"varint,1,opt,name=content_type,json=contentType,proto3,enum=resources.common.content.ContentType" json:"content_type,omitempty"`
+	TemplateId   *int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	TemplateData *templates.TemplateData `protobuf:"bytes,3,opt,name=template_data,json=templateData,proto3,oneof" json:"template_data,omitempty"`
+	// Initial form field values of the template
+	Data          *data.DocumentData `protobuf:"bytes,4,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDocumentRequest) Reset() {
+	*x = CreateDocumentRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentRequest) ProtoMessage() {}
+
+func (x *CreateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDocumentRequest) GetContentType() content.ContentType {
+	if x != nil {
 		return x.ContentType
 	}
-	return content.ContentType(0)
+	return content.ContentType(0)
+}
+
+func (x *CreateDocumentRequest) GetTemplateId() int64 {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return 0
+}
+
+func (x *CreateDocumentRequest) GetTemplateData() *templates.TemplateData {
+	if x != nil {
+		return x.TemplateData
+	}
+	return nil
+}
+
+func (x *CreateDocumentRequest) GetData() *data.DocumentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateDocumentRequest) SetContentType(v content.ContentType) {
+	x.ContentType = v
+}
+
+func (x *CreateDocumentRequest) SetTemplateId(v int64) {
+	x.TemplateId = &v
+}
+
+func (x *CreateDocumentRequest) SetTemplateData(v *templates.TemplateData) {
+	x.TemplateData = v
+}
+
+func (x *CreateDocumentRequest) SetData(v *data.DocumentData) {
+	x.Data = v
+}
+
+func (x *CreateDocumentRequest) HasTemplateId() bool {
+	if x == nil {
+		return false
+	}
+	return x.TemplateId != nil
+}
+
+func (x *CreateDocumentRequest) HasTemplateData() bool {
+	if x == nil {
+		return false
+	}
+	return x.TemplateData != nil
+}
+
+func (x *CreateDocumentRequest) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.Data != nil
+}
+
+func (x *CreateDocumentRequest) ClearTemplateId() {
+	x.TemplateId = nil
+}
+
+func (x *CreateDocumentRequest) ClearTemplateData() {
+	x.TemplateData = nil
+}
+
+func (x *CreateDocumentRequest) ClearData() {
+	x.Data = nil
+}
+
+type CreateDocumentRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ContentType  content.ContentType
+	TemplateId   *int64
+	TemplateData *templates.TemplateData
+	// Initial form field values of the template
+	Data *data.DocumentData
+}
+
+func (b0 CreateDocumentRequest_builder) Build() *CreateDocumentRequest {
+	m0 := &CreateDocumentRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.ContentType = b.ContentType
+	x.TemplateId = b.TemplateId
+	x.TemplateData = b.TemplateData
+	x.Data = b.Data
+	return m0
+}
+
+type CreateDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDocumentResponse) Reset() {
+	*x = CreateDocumentResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentResponse) ProtoMessage() {}
+
+func (x *CreateDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDocumentResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateDocumentResponse) SetId(v int64) {
+	x.Id = v
+}
+
+type CreateDocumentResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id int64
+}
+
+func (b0 CreateDocumentResponse_builder) Build() *CreateDocumentResponse {
+	m0 := &CreateDocumentResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type UpdateDocumentRequest struct {
+	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
+	DocumentId    int64                   `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty" alias:"id"`
+	CategoryId    *int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Title         string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty" alias:"title"`
+	Content       *content.Content        `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   content.ContentType     `protobuf:"varint,5,opt,name=content_type,json=contentType,proto3,enum=resources.common.content.ContentType" json:"content_type,omitempty"`
+	Data          *data.DocumentData      `protobuf:"bytes,6,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	Meta          *documents.DocumentMeta `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	Access        *access.Access          `protobuf:"bytes,11,opt,name=access,proto3,oneof" json:"access,omitempty"`
+	Files         []*file.File            `protobuf:"bytes,12,rep,name=files,proto3" json:"files,omitempty" alias:"files"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDocumentRequest) Reset() {
+	*x = UpdateDocumentRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocumentRequest) ProtoMessage() {}
+
+func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateDocumentRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *UpdateDocumentRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateDocumentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetContent() *content.Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) GetContentType() content.ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return content.ContentType(0)
+}
+
+func (x *UpdateDocumentRequest) GetData() *data.DocumentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) GetMeta() *documents.DocumentMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) GetAccess() *access.Access {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) GetFiles() []*file.File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
+}
+
+func (x *UpdateDocumentRequest) SetCategoryId(v int64) {
+	x.CategoryId = &v
+}
+
+func (x *UpdateDocumentRequest) SetTitle(v string) {
+	x.Title = v
+}
+
+func (x *UpdateDocumentRequest) SetContent(v *content.Content) {
+	x.Content = v
+}
+
+func (x *UpdateDocumentRequest) SetContentType(v content.ContentType) {
+	x.ContentType = v
+}
+
+func (x *UpdateDocumentRequest) SetData(v *data.DocumentData) {
+	x.Data = v
+}
+
+func (x *UpdateDocumentRequest) SetMeta(v *documents.DocumentMeta) {
+	x.Meta = v
+}
+
+func (x *UpdateDocumentRequest) SetAccess(v *access.Access) {
+	x.Access = v
+}
+
+func (x *UpdateDocumentRequest) SetFiles(v []*file.File) {
+	x.Files = v
+}
+
+func (x *UpdateDocumentRequest) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return x.CategoryId != nil
+}
+
+func (x *UpdateDocumentRequest) HasContent() bool {
+	if x == nil {
+		return false
+	}
+	return x.Content != nil
+}
+
+func (x *UpdateDocumentRequest) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.Data != nil
+}
+
+func (x *UpdateDocumentRequest) HasMeta() bool {
+	if x == nil {
+		return false
+	}
+	return x.Meta != nil
+}
+
+func (x *UpdateDocumentRequest) HasAccess() bool {
+	if x == nil {
+		return false
+	}
+	return x.Access != nil
+}
+
+func (x *UpdateDocumentRequest) ClearCategoryId() {
+	x.CategoryId = nil
+}
+
+func (x *UpdateDocumentRequest) ClearContent() {
+	x.Content = nil
+}
+
+func (x *UpdateDocumentRequest) ClearData() {
+	x.Data = nil
+}
+
+func (x *UpdateDocumentRequest) ClearMeta() {
+	x.Meta = nil
+}
+
+func (x *UpdateDocumentRequest) ClearAccess() {
+	x.Access = nil
+}
+
+type UpdateDocumentRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DocumentId  int64
+	CategoryId  *int64
+	Title       string
+	Content     *content.Content
+	ContentType content.ContentType
+	Data        *data.DocumentData
+	Meta        *documents.DocumentMeta
+	Access      *access.Access
+	Files       []*file.File
+}
+
+func (b0 UpdateDocumentRequest_builder) Build() *UpdateDocumentRequest {
+	m0 := &UpdateDocumentRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DocumentId = b.DocumentId
+	x.CategoryId = b.CategoryId
+	x.Title = b.Title
+	x.Content = b.Content
+	x.ContentType = b.ContentType
+	x.Data = b.Data
+	x.Meta = b.Meta
+	x.Access = b.Access
+	x.Files = b.Files
+	return m0
+}
+
+type ListDocumentActivityRequest struct {
+	state      protoimpl.MessageState      `protogen:"hybrid.v1"`
+	Pagination *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DocumentId int64                       `protobuf:"varint,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// Search params
+	ActivityTypes []activity.DocActivityType `protobuf:"varint,3,rep,packed,name=activity_types,json=activityTypes,proto3,enum=resources.documents.activity.DocActivityType" json:"activity_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentActivityRequest) Reset() {
+	*x = ListDocumentActivityRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentActivityRequest) ProtoMessage() {}
+
+func (x *ListDocumentActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDocumentActivityRequest) GetPagination() *database.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListDocumentActivityRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *ListDocumentActivityRequest) GetActivityTypes() []activity.DocActivityType {
+	if x != nil {
+		return x.ActivityTypes
+	}
+	return nil
+}
+
+func (x *ListDocumentActivityRequest) SetPagination(v *database.PaginationRequest) {
+	x.Pagination = v
+}
+
+func (x *ListDocumentActivityRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
+}
+
+func (x *ListDocumentActivityRequest) SetActivityTypes(v []activity.DocActivityType) {
+	x.ActivityTypes = v
+}
+
+func (x *ListDocumentActivityRequest) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
+}
+
+func (x *ListDocumentActivityRequest) ClearPagination() {
+	x.Pagination = nil
+}
+
+type ListDocumentActivityRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationRequest
+	DocumentId int64
+	// Search params
+	ActivityTypes []activity.DocActivityType
+}
+
+func (b0 ListDocumentActivityRequest_builder) Build() *ListDocumentActivityRequest {
+	m0 := &ListDocumentActivityRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	x.DocumentId = b.DocumentId
+	x.ActivityTypes = b.ActivityTypes
+	return m0
+}
+
+type ListDocumentActivityResponse struct {
+	state         protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Pagination    *database.PaginationResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Activity      []*activity.DocActivity      `protobuf:"bytes,2,rep,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentActivityResponse) Reset() {
+	*x = ListDocumentActivityResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentActivityResponse) ProtoMessage() {}
+
+func (x *ListDocumentActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDocumentActivityResponse) GetPagination() *database.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListDocumentActivityResponse) GetActivity() []*activity.DocActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+func (x *ListDocumentActivityResponse) SetPagination(v *database.PaginationResponse) {
+	x.Pagination = v
+}
+
+func (x *ListDocumentActivityResponse) SetActivity(v []*activity.DocActivity) {
+	x.Activity = v
+}
+
+func (x *ListDocumentActivityResponse) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
+}
+
+func (x *ListDocumentActivityResponse) ClearPagination() {
+	x.Pagination = nil
+}
+
+type ListDocumentActivityResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationResponse
+	Activity   []*activity.DocActivity
+}
+
+func (b0 ListDocumentActivityResponse_builder) Build() *ListDocumentActivityResponse {
+	m0 := &ListDocumentActivityResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	x.Activity = b.Activity
+	return m0
+}
+
+type BulkUpdateDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Selector      *bulk.BulkSelector     `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Operation     *bulk.BulkOperation    `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateDocumentsRequest) Reset() {
+	*x = BulkUpdateDocumentsRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateDocumentsRequest) ProtoMessage() {}
+
+func (x *BulkUpdateDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkUpdateDocumentsRequest) GetSelector() *bulk.BulkSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *BulkUpdateDocumentsRequest) GetOperation() *bulk.BulkOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *BulkUpdateDocumentsRequest) SetSelector(v *bulk.BulkSelector) {
+	x.Selector = v
+}
+
+func (x *BulkUpdateDocumentsRequest) SetOperation(v *bulk.BulkOperation) {
+	x.Operation = v
+}
+
+func (x *BulkUpdateDocumentsRequest) HasSelector() bool {
+	if x == nil {
+		return false
+	}
+	return x.Selector != nil
+}
+
+func (x *BulkUpdateDocumentsRequest) HasOperation() bool {
+	if x == nil {
+		return false
+	}
+	return x.Operation != nil
+}
+
+func (x *BulkUpdateDocumentsRequest) ClearSelector() {
+	x.Selector = nil
+}
+
+func (x *BulkUpdateDocumentsRequest) ClearOperation() {
+	x.Operation = nil
+}
+
+type BulkUpdateDocumentsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Selector  *bulk.BulkSelector
+	Operation *bulk.BulkOperation
+}
+
+func (b0 BulkUpdateDocumentsRequest_builder) Build() *BulkUpdateDocumentsRequest {
+	m0 := &BulkUpdateDocumentsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Selector = b.Selector
+	x.Operation = b.Operation
+	return m0
+}
+
+type BulkUpdateDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Job           *bulk.BulkJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateDocumentsResponse) Reset() {
+	*x = BulkUpdateDocumentsResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateDocumentsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BulkUpdateDocumentsResponse) GetJob() *bulk.BulkJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *BulkUpdateDocumentsResponse) SetJob(v *bulk.BulkJob) {
+	x.Job = v
+}
+
+func (x *BulkUpdateDocumentsResponse) HasJob() bool {
+	if x == nil {
+		return false
+	}
+	return x.Job != nil
+}
+
+func (x *BulkUpdateDocumentsResponse) ClearJob() {
+	x.Job = nil
+}
+
+type BulkUpdateDocumentsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Job *bulk.BulkJob
+}
+
+func (b0 BulkUpdateDocumentsResponse_builder) Build() *BulkUpdateDocumentsResponse {
+	m0 := &BulkUpdateDocumentsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Job = b.Job
+	return m0
+}
+
+type GetBulkJobRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	JobId         int64                  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBulkJobRequest) Reset() {
+	*x = GetBulkJobRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBulkJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBulkJobRequest) ProtoMessage() {}
+
+func (x *GetBulkJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetBulkJobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *GetBulkJobRequest) SetJobId(v int64) {
+	x.JobId = v
+}
+
+type GetBulkJobRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	JobId int64
+}
+
+func (b0 GetBulkJobRequest_builder) Build() *GetBulkJobRequest {
+	m0 := &GetBulkJobRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.JobId = b.JobId
+	return m0
+}
+
+type GetBulkJobResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Job           *bulk.BulkJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBulkJobResponse) Reset() {
+	*x = GetBulkJobResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBulkJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBulkJobResponse) ProtoMessage() {}
+
+func (x *GetBulkJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetBulkJobResponse) GetJob() *bulk.BulkJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GetBulkJobResponse) SetJob(v *bulk.BulkJob) {
+	x.Job = v
+}
+
+func (x *GetBulkJobResponse) HasJob() bool {
+	if x == nil {
+		return false
+	}
+	return x.Job != nil
+}
+
+func (x *GetBulkJobResponse) ClearJob() {
+	x.Job = nil
+}
+
+type GetBulkJobResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Job *bulk.BulkJob
+}
+
+func (b0 GetBulkJobResponse_builder) Build() *GetBulkJobResponse {
+	m0 := &GetBulkJobResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Job = b.Job
+	return m0
+}
+
+type ListBulkJobsRequest struct {
+	state         protoimpl.MessageState      `protogen:"hybrid.v1"`
+	Pagination    *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBulkJobsRequest) Reset() {
+	*x = ListBulkJobsRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBulkJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBulkJobsRequest) ProtoMessage() {}
+
+func (x *ListBulkJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListBulkJobsRequest) GetPagination() *database.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBulkJobsRequest) SetPagination(v *database.PaginationRequest) {
+	x.Pagination = v
+}
+
+func (x *ListBulkJobsRequest) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
+}
+
+func (x *ListBulkJobsRequest) ClearPagination() {
+	x.Pagination = nil
+}
+
+type ListBulkJobsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationRequest
+}
+
+func (b0 ListBulkJobsRequest_builder) Build() *ListBulkJobsRequest {
+	m0 := &ListBulkJobsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	return m0
+}
+
+type ListBulkJobsResponse struct {
+	state         protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Pagination    *database.PaginationResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Jobs          []*bulk.BulkJob              `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBulkJobsResponse) Reset() {
+	*x = ListBulkJobsResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBulkJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBulkJobsResponse) ProtoMessage() {}
+
+func (x *ListBulkJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListBulkJobsResponse) GetPagination() *database.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBulkJobsResponse) GetJobs() []*bulk.BulkJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListBulkJobsResponse) SetPagination(v *database.PaginationResponse) {
+	x.Pagination = v
+}
+
+func (x *ListBulkJobsResponse) SetJobs(v []*bulk.BulkJob) {
+	x.Jobs = v
+}
+
+func (x *ListBulkJobsResponse) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
+}
+
+func (x *ListBulkJobsResponse) ClearPagination() {
+	x.Pagination = nil
+}
+
+type ListBulkJobsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationResponse
+	Jobs       []*bulk.BulkJob
+}
+
+func (b0 ListBulkJobsResponse_builder) Build() *ListBulkJobsResponse {
+	m0 := &ListBulkJobsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	x.Jobs = b.Jobs
+	return m0
+}
+
+type ListDocumentVersionsRequest struct {
+	state         protoimpl.MessageState      `protogen:"hybrid.v1"`
+	Pagination    *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DocumentId    int64                       `protobuf:"varint,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentVersionsRequest) Reset() {
+	*x = ListDocumentVersionsRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentVersionsRequest) ProtoMessage() {}
+
+func (x *ListDocumentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateDocumentRequest) GetData() *data.DocumentData {
+func (x *ListDocumentVersionsRequest) GetPagination() *database.PaginationRequest {
 	if x != nil {
-		return x.Data
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *UpdateDocumentRequest) GetMeta() *documents.DocumentMeta {
+func (x *ListDocumentVersionsRequest) GetDocumentId() int64 {
 	if x != nil {
-		return x.Meta
+		return x.DocumentId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateDocumentRequest) GetAccess() *access.Access {
+func (x *ListDocumentVersionsRequest) SetPagination(v *database.PaginationRequest) {
+	x.Pagination = v
+}
+
+func (x *ListDocumentVersionsRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
+}
+
+func (x *ListDocumentVersionsRequest) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
+}
+
+func (x *ListDocumentVersionsRequest) ClearPagination() {
+	x.Pagination = nil
+}
+
+type ListDocumentVersionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationRequest
+	DocumentId int64
+}
+
+func (b0 ListDocumentVersionsRequest_builder) Build() *ListDocumentVersionsRequest {
+	m0 := &ListDocumentVersionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	x.DocumentId = b.DocumentId
+	return m0
+}
+
+type ListDocumentVersionsResponse struct {
+	state         protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Pagination    *database.PaginationResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Versions      []*versions.DocumentVersion  `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentVersionsResponse) Reset() {
+	*x = ListDocumentVersionsResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentVersionsResponse) ProtoMessage() {}
+
+func (x *ListDocumentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[39]
 	if x != nil {
-		return x.Access
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDocumentVersionsResponse) GetPagination() *database.PaginationResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *UpdateDocumentRequest) GetFiles() []*file.File {
+func (x *ListDocumentVersionsResponse) GetVersions() []*versions.DocumentVersion {
 	if x != nil {
-		return x.Files
+		return x.Versions
 	}
 	return nil
 }
 
-func (x *UpdateDocumentRequest) SetDocumentId(v int64) {
-	x.DocumentId = v
+func (x *ListDocumentVersionsResponse) SetPagination(v *database.PaginationResponse) {
+	x.Pagination = v
 }
 
-func (x *UpdateDocumentRequest) SetCategoryId(v int64) {
-	x.CategoryId = &v
+func (x *ListDocumentVersionsResponse) SetVersions(v []*versions.DocumentVersion) {
+	x.Versions = v
 }
 
-func (x *UpdateDocumentRequest) SetTitle(v string) {
-	x.Title = v
+func (x *ListDocumentVersionsResponse) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
 }
 
-func (x *UpdateDocumentRequest) SetContent(v *content.Content) {
-	x.Content = v
+func (x *ListDocumentVersionsResponse) ClearPagination() {
+	x.Pagination = nil
 }
 
-func (x *UpdateDocumentRequest) SetContentType(v content.ContentType) {
-	x.ContentType = v
+type ListDocumentVersionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationResponse
+	Versions   []*versions.DocumentVersion
 }
 
-func (x *UpdateDocumentRequest) SetData(v *data.DocumentData) {
-	x.Data = v
+func (b0 ListDocumentVersionsResponse_builder) Build() *ListDocumentVersionsResponse {
+	m0 := &ListDocumentVersionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	x.Versions = b.Versions
+	return m0
 }
 
-func (x *UpdateDocumentRequest) SetMeta(v *documents.DocumentMeta) {
-	x.Meta = v
+type GetDocumentVersionRequest struct {
+	state      protoimpl.MessageState `protogen:"hybrid.v1"`
+	DocumentId int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	VersionId  int64                  `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Version to compute the diff against, defaults to the previous version
+	CompareVersionId *int64 `protobuf:"varint,3,opt,name=compare_version_id,json=compareVersionId,proto3,oneof" json:"compare_version_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateDocumentRequest) SetAccess(v *access.Access) {
-	x.Access = v
+func (x *GetDocumentVersionRequest) Reset() {
+	*x = GetDocumentVersionRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDocumentRequest) SetFiles(v []*file.File) {
-	x.Files = v
+func (x *GetDocumentVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateDocumentRequest) HasCategoryId() bool {
-	if x == nil {
-		return false
+func (*GetDocumentVersionRequest) ProtoMessage() {}
+
+func (x *GetDocumentVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return x.CategoryId != nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateDocumentRequest) HasContent() bool {
+func (x *GetDocumentVersionRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *GetDocumentVersionRequest) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *GetDocumentVersionRequest) GetCompareVersionId() int64 {
+	if x != nil && x.CompareVersionId != nil {
+		return *x.CompareVersionId
+	}
+	return 0
+}
+
+func (x *GetDocumentVersionRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
+}
+
+func (x *GetDocumentVersionRequest) SetVersionId(v int64) {
+	x.VersionId = v
+}
+
+func (x *GetDocumentVersionRequest) SetCompareVersionId(v int64) {
+	x.CompareVersionId = &v
+}
+
+func (x *GetDocumentVersionRequest) HasCompareVersionId() bool {
 	if x == nil {
 		return false
 	}
-	return x.Content != nil
+	return x.CompareVersionId != nil
 }
 
-func (x *UpdateDocumentRequest) HasData() bool {
+func (x *GetDocumentVersionRequest) ClearCompareVersionId() {
+	x.CompareVersionId = nil
+}
+
+type GetDocumentVersionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DocumentId int64
+	VersionId  int64
+	// Version to compute the diff against, defaults to the previous version
+	CompareVersionId *int64
+}
+
+func (b0 GetDocumentVersionRequest_builder) Build() *GetDocumentVersionRequest {
+	m0 := &GetDocumentVersionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DocumentId = b.DocumentId
+	x.VersionId = b.VersionId
+	x.CompareVersionId = b.CompareVersionId
+	return m0
+}
+
+type GetDocumentVersionResponse struct {
+	state         protoimpl.MessageState        `protogen:"hybrid.v1"`
+	Version       *versions.DocumentVersion     `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Diff          *versions.DocumentVersionDiff `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentVersionResponse) Reset() {
+	*x = GetDocumentVersionResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentVersionResponse) ProtoMessage() {}
+
+func (x *GetDocumentVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDocumentVersionResponse) GetVersion() *versions.DocumentVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *GetDocumentVersionResponse) GetDiff() *versions.DocumentVersionDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetDocumentVersionResponse) SetVersion(v *versions.DocumentVersion) {
+	x.Version = v
+}
+
+func (x *GetDocumentVersionResponse) SetDiff(v *versions.DocumentVersionDiff) {
+	x.Diff = v
+}
+
+func (x *GetDocumentVersionResponse) HasVersion() bool {
 	if x == nil {
 		return false
 	}
-	return x.Data != nil
+	return x.Version != nil
 }
 
-func (x *UpdateDocumentRequest) HasMeta() bool {
+func (x *GetDocumentVersionResponse) HasDiff() bool {
 	if x == nil {
 		return false
 	}
-	return x.Meta != nil
+	return x.Diff != nil
+}
+
+func (x *GetDocumentVersionResponse) ClearVersion() {
+	x.Version = nil
+}
+
+func (x *GetDocumentVersionResponse) ClearDiff() {
+	x.Diff = nil
+}
+
+type GetDocumentVersionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Version *versions.DocumentVersion
+	Diff    *versions.DocumentVersionDiff
+}
+
+func (b0 GetDocumentVersionResponse_builder) Build() *GetDocumentVersionResponse {
+	m0 := &GetDocumentVersionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Version = b.Version
+	x.Diff = b.Diff
+	return m0
+}
+
+type RestoreDocumentVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	VersionId     int64                  `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreDocumentVersionRequest) Reset() {
+	*x = RestoreDocumentVersionRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDocumentRequest) HasAccess() bool {
-	if x == nil {
-		return false
-	}
-	return x.Access != nil
+func (x *RestoreDocumentVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateDocumentRequest) ClearCategoryId() {
-	x.CategoryId = nil
+func (*RestoreDocumentVersionRequest) ProtoMessage() {}
+
+func (x *RestoreDocumentVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateDocumentRequest) ClearContent() {
-	x.Content = nil
+func (x *RestoreDocumentVersionRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
 }
 
-func (x *UpdateDocumentRequest) ClearData() {
-	x.Data = nil
+func (x *RestoreDocumentVersionRequest) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
 }
 
-func (x *UpdateDocumentRequest) ClearMeta() {
-	x.Meta = nil
+func (x *RestoreDocumentVersionRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
 }
 
-func (x *UpdateDocumentRequest) ClearAccess() {
-	x.Access = nil
+func (x *RestoreDocumentVersionRequest) SetVersionId(v int64) {
+	x.VersionId = v
 }
 
-type UpdateDocumentRequest_builder struct {
+type RestoreDocumentVersionRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	DocumentId  int64
-	CategoryId  *int64
-	Title       string
-	Content     *content.Content
-	ContentType content.ContentType
-	Data        *data.DocumentData
-	Meta        *documents.DocumentMeta
-	Access      *access.Access
-	Files       []*file.File
+	DocumentId int64
+	VersionId  int64
 }
 
-func (b0 UpdateDocumentRequest_builder) Build() *UpdateDocumentRequest {
-	m0 := &UpdateDocumentRequest{}
+func (b0 RestoreDocumentVersionRequest_builder) Build() *RestoreDocumentVersionRequest {
+	m0 := &RestoreDocumentVersionRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	x.DocumentId = b.DocumentId
-	x.CategoryId = b.CategoryId
-	x.Title = b.Title
-	x.Content = b.Content
-	x.ContentType = b.ContentType
-	x.Data = b.Data
-	x.Meta = b.Meta
-	x.Access = b.Access
-	x.Files = b.Files
+	x.VersionId = b.VersionId
 	return m0
 }
 
-type ListDocumentActivityRequest struct {
-	state      protoimpl.MessageState      `protogen:"hybrid.v1"`
-	Pagination *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	DocumentId int64                       `protobuf:"varint,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	// Search params
-	ActivityTypes []activity.DocActivityType `protobuf:"varint,3,rep,packed,name=activity_types,json=activityTypes,proto3,enum=resources.documents.activity.DocActivityType" json:"activity_types,omitempty"`
+type RestoreDocumentVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Document      *documents.Document    `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDocumentActivityRequest) Reset() {
-	*x = ListDocumentActivityRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[26]
+func (x *RestoreDocumentVersionResponse) Reset() {
+	*x = RestoreDocumentVersionResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDocumentActivityRequest) String() string {
+func (x *RestoreDocumentVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDocumentActivityRequest) ProtoMessage() {}
+func (*RestoreDocumentVersionResponse) ProtoMessage() {}
 
-func (x *ListDocumentActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[26]
+func (x *RestoreDocumentVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2099,92 +3570,122 @@ func (x *ListDocumentActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListDocumentActivityRequest) GetPagination() *database.PaginationRequest {
+func (x *RestoreDocumentVersionResponse) GetDocument() *documents.Document {
 	if x != nil {
-		return x.Pagination
+		return x.Document
 	}
 	return nil
 }
 
-func (x *ListDocumentActivityRequest) GetDocumentId() int64 {
-	if x != nil {
-		return x.DocumentId
-	}
-	return 0
+func (x *RestoreDocumentVersionResponse) SetDocument(v *documents.Document) {
+	x.Document = v
 }
 
-func (x *ListDocumentActivityRequest) GetActivityTypes() []activity.DocActivityType {
-	if x != nil {
-		return x.ActivityTypes
+func (x *RestoreDocumentVersionResponse) HasDocument() bool {
+	if x == nil {
+		return false
 	}
-	return nil
+	return x.Document != nil
 }
 
-func (x *ListDocumentActivityRequest) SetPagination(v *database.PaginationRequest) {
-	x.Pagination = v
+func (x *RestoreDocumentVersionResponse) ClearDocument() {
+	x.Document = nil
 }
 
-func (x *ListDocumentActivityRequest) SetDocumentId(v int64) {
-	x.DocumentId = v
+type RestoreDocumentVersionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Document *documents.Document
 }
 
-func (x *ListDocumentActivityRequest) SetActivityTypes(v []activity.DocActivityType) {
-	x.ActivityTypes = v
+func (b0 RestoreDocumentVersionResponse_builder) Build() *RestoreDocumentVersionResponse {
+	m0 := &RestoreDocumentVersionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Document = b.Document
+	return m0
 }
 
-func (x *ListDocumentActivityRequest) HasPagination() bool {
-	if x == nil {
-		return false
+type GetDocumentPDFRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentPDFRequest) Reset() {
+	*x = GetDocumentPDFRequest{}
+	mi := &file_services_documents_documents_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentPDFRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentPDFRequest) ProtoMessage() {}
+
+func (x *GetDocumentPDFRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return x.Pagination != nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListDocumentActivityRequest) ClearPagination() {
-	x.Pagination = nil
+func (x *GetDocumentPDFRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
 }
 
-type ListDocumentActivityRequest_builder struct {
+func (x *GetDocumentPDFRequest) SetDocumentId(v int64) {
+	x.DocumentId = v
+}
+
+type GetDocumentPDFRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Pagination *database.PaginationRequest
 	DocumentId int64
-	// Search params
-	ActivityTypes []activity.DocActivityType
 }
 
-func (b0 ListDocumentActivityRequest_builder) Build() *ListDocumentActivityRequest {
-	m0 := &ListDocumentActivityRequest{}
+func (b0 GetDocumentPDFRequest_builder) Build() *GetDocumentPDFRequest {
+	m0 := &GetDocumentPDFRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.Pagination = b.Pagination
 	x.DocumentId = b.DocumentId
-	x.ActivityTypes = b.ActivityTypes
 	return m0
 }
 
-type ListDocumentActivityResponse struct {
-	state         protoimpl.MessageState       `protogen:"hybrid.v1"`
-	Pagination    *database.PaginationResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Activity      []*activity.DocActivity      `protobuf:"bytes,2,rep,name=activity,proto3" json:"activity,omitempty"`
+type GetDocumentPDFResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDocumentActivityResponse) Reset() {
-	*x = ListDocumentActivityResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[27]
+func (x *GetDocumentPDFResponse) Reset() {
+	*x = GetDocumentPDFResponse{}
+	mi := &file_services_documents_documents_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDocumentActivityResponse) String() string {
+func (x *GetDocumentPDFResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDocumentActivityResponse) ProtoMessage() {}
+func (*GetDocumentPDFResponse) ProtoMessage() {}
 
-func (x *ListDocumentActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[27]
+func (x *GetDocumentPDFResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_documents_documents_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,52 +3696,44 @@ func (x *ListDocumentActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListDocumentActivityResponse) GetPagination() *database.PaginationResponse {
+func (x *GetDocumentPDFResponse) GetFileName() string {
 	if x != nil {
-		return x.Pagination
+		return x.FileName
 	}
-	return nil
+	return ""
 }
 
-func (x *ListDocumentActivityResponse) GetActivity() []*activity.DocActivity {
+func (x *GetDocumentPDFResponse) GetData() []byte {
 	if x != nil {
-		return x.Activity
+		return x.Data
 	}
 	return nil
 }
 
-func (x *ListDocumentActivityResponse) SetPagination(v *database.PaginationResponse) {
-	x.Pagination = v
-}
-
-func (x *ListDocumentActivityResponse) SetActivity(v []*activity.DocActivity) {
-	x.Activity = v
+func (x *GetDocumentPDFResponse) SetFileName(v string) {
+	x.FileName = v
 }
 
-func (x *ListDocumentActivityResponse) HasPagination() bool {
-	if x == nil {
-		return false
+func (x *GetDocumentPDFResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
 	}
-	return x.Pagination != nil
-}
-
-func (x *ListDocumentActivityResponse) ClearPagination() {
-	x.Pagination = nil
+	x.Data = v
 }
 
-type ListDocumentActivityResponse_builder struct {
+type GetDocumentPDFResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Pagination *database.PaginationResponse
-	Activity   []*activity.DocActivity
+	FileName string
+	Data     []byte
 }
 
-func (b0 ListDocumentActivityResponse_builder) Build() *ListDocumentActivityResponse {
-	m0 := &ListDocumentActivityResponse{}
+func (b0 GetDocumentPDFResponse_builder) Build() *GetDocumentPDFResponse {
+	m0 := &GetDocumentPDFResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.Pagination = b.Pagination
-	x.Activity = b.Activity
+	x.FileName = b.FileName
+	x.Data = b.Data
 	return m0
 }
 
@@ -2254,7 +3747,7 @@ type ListDocumentReqsRequest struct {
 
 func (x *ListDocumentReqsRequest) Reset() {
 	*x = ListDocumentReqsRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[28]
+	mi := &file_services_documents_documents_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2266,7 +3759,7 @@ func (x *ListDocumentReqsRequest) String() string {
 func (*ListDocumentReqsRequest) ProtoMessage() {}
 
 func (x *ListDocumentReqsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[28]
+	mi := &file_services_documents_documents_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +3829,7 @@ type ListDocumentReqsResponse struct {
 
 func (x *ListDocumentReqsResponse) Reset() {
 	*x = ListDocumentReqsResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[29]
+	mi := &file_services_documents_documents_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2348,7 +3841,7 @@ func (x *ListDocumentReqsResponse) String() string {
 func (*ListDocumentReqsResponse) ProtoMessage() {}
 
 func (x *ListDocumentReqsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[29]
+	mi := &file_services_documents_documents_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,7 +3913,7 @@ type CreateDocumentReqRequest struct {
 
 func (x *CreateDocumentReqRequest) Reset() {
 	*x = CreateDocumentReqRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[30]
+	mi := &file_services_documents_documents_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2432,7 +3925,7 @@ func (x *CreateDocumentReqRequest) String() string {
 func (*CreateDocumentReqRequest) ProtoMessage() {}
 
 func (x *CreateDocumentReqRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[30]
+	mi := &file_services_documents_documents_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,7 +4031,7 @@ type CreateDocumentReqResponse struct {
 
 func (x *CreateDocumentReqResponse) Reset() {
 	*x = CreateDocumentReqResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[31]
+	mi := &file_services_documents_documents_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2550,7 +4043,7 @@ func (x *CreateDocumentReqResponse) String() string {
 func (*CreateDocumentReqResponse) ProtoMessage() {}
 
 func (x *CreateDocumentReqResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[31]
+	mi := &file_services_documents_documents_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2610,7 +4103,7 @@ type UpdateDocumentReqRequest struct {
 
 func (x *UpdateDocumentReqRequest) Reset() {
 	*x = UpdateDocumentReqRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[32]
+	mi := &file_services_documents_documents_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2622,7 +4115,7 @@ func (x *UpdateDocumentReqRequest) String() string {
 func (*UpdateDocumentReqRequest) ProtoMessage() {}
 
 func (x *UpdateDocumentReqRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[32]
+	mi := &file_services_documents_documents_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,7 +4234,7 @@ type UpdateDocumentReqResponse struct {
 
 func (x *UpdateDocumentReqResponse) Reset() {
 	*x = UpdateDocumentReqResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[33]
+	mi := &file_services_documents_documents_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2753,7 +4246,7 @@ func (x *UpdateDocumentReqResponse) String() string {
 func (*UpdateDocumentReqResponse) ProtoMessage() {}
 
 func (x *UpdateDocumentReqResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[33]
+	mi := &file_services_documents_documents_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2809,7 +4302,7 @@ type DeleteDocumentReqRequest struct {
 
 func (x *DeleteDocumentReqRequest) Reset() {
 	*x = DeleteDocumentReqRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[34]
+	mi := &file_services_documents_documents_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2821,7 +4314,7 @@ func (x *DeleteDocumentReqRequest) String() string {
 func (*DeleteDocumentReqRequest) ProtoMessage() {}
 
 func (x *DeleteDocumentReqRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[34]
+	mi := &file_services_documents_documents_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2865,7 +4358,7 @@ type DeleteDocumentReqResponse struct {
 
 func (x *DeleteDocumentReqResponse) Reset() {
 	*x = DeleteDocumentReqResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[35]
+	mi := &file_services_documents_documents_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2877,7 +4370,7 @@ func (x *DeleteDocumentReqResponse) String() string {
 func (*DeleteDocumentReqResponse) ProtoMessage() {}
 
 func (x *DeleteDocumentReqResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[35]
+	mi := &file_services_documents_documents_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2909,7 +4402,7 @@ type GetDocumentAccessRequest struct {
 
 func (x *GetDocumentAccessRequest) Reset() {
 	*x = GetDocumentAccessRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[36]
+	mi := &file_services_documents_documents_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2921,7 +4414,7 @@ func (x *GetDocumentAccessRequest) String() string {
 func (*GetDocumentAccessRequest) ProtoMessage() {}
 
 func (x *GetDocumentAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[36]
+	mi := &file_services_documents_documents_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,7 +4459,7 @@ type GetDocumentAccessResponse struct {
 
 func (x *GetDocumentAccessResponse) Reset() {
 	*x = GetDocumentAccessResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[37]
+	mi := &file_services_documents_documents_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2978,7 +4471,7 @@ func (x *GetDocumentAccessResponse) String() string {
 func (*GetDocumentAccessResponse) ProtoMessage() {}
 
 func (x *GetDocumentAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[37]
+	mi := &file_services_documents_documents_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3035,7 +4528,7 @@ type SetDocumentAccessRequest struct {
 
 func (x *SetDocumentAccessRequest) Reset() {
 	*x = SetDocumentAccessRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[38]
+	mi := &file_services_documents_documents_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3047,7 +4540,7 @@ func (x *SetDocumentAccessRequest) String() string {
 func (*SetDocumentAccessRequest) ProtoMessage() {}
 
 func (x *SetDocumentAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[38]
+	mi := &file_services_documents_documents_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3115,7 +4608,7 @@ type SetDocumentAccessResponse struct {
 
 func (x *SetDocumentAccessResponse) Reset() {
 	*x = SetDocumentAccessResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[39]
+	mi := &file_services_documents_documents_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3127,7 +4620,7 @@ func (x *SetDocumentAccessResponse) String() string {
 func (*SetDocumentAccessResponse) ProtoMessage() {}
 
 func (x *SetDocumentAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[39]
+	mi := &file_services_documents_documents_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3165,7 +4658,7 @@ type ListUserDocumentsRequest struct {
 
 func (x *ListUserDocumentsRequest) Reset() {
 	*x = ListUserDocumentsRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[40]
+	mi := &file_services_documents_documents_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3177,7 +4670,7 @@ func (x *ListUserDocumentsRequest) String() string {
 func (*ListUserDocumentsRequest) ProtoMessage() {}
 
 func (x *ListUserDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[40]
+	mi := &file_services_documents_documents_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3333,7 +4826,7 @@ type ListUserDocumentsResponse struct {
 
 func (x *ListUserDocumentsResponse) Reset() {
 	*x = ListUserDocumentsResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[41]
+	mi := &file_services_documents_documents_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3345,7 +4838,7 @@ func (x *ListUserDocumentsResponse) String() string {
 func (*ListUserDocumentsResponse) ProtoMessage() {}
 
 func (x *ListUserDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[41]
+	mi := &file_services_documents_documents_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3417,7 +4910,7 @@ type ListDocumentPinsRequest struct {
 
 func (x *ListDocumentPinsRequest) Reset() {
 	*x = ListDocumentPinsRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[42]
+	mi := &file_services_documents_documents_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3429,7 +4922,7 @@ func (x *ListDocumentPinsRequest) String() string {
 func (*ListDocumentPinsRequest) ProtoMessage() {}
 
 func (x *ListDocumentPinsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[42]
+	mi := &file_services_documents_documents_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3512,7 +5005,7 @@ type ListDocumentPinsResponse struct {
 
 func (x *ListDocumentPinsResponse) Reset() {
 	*x = ListDocumentPinsResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[43]
+	mi := &file_services_documents_documents_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3524,7 +5017,7 @@ func (x *ListDocumentPinsResponse) String() string {
 func (*ListDocumentPinsResponse) ProtoMessage() {}
 
 func (x *ListDocumentPinsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[43]
+	mi := &file_services_documents_documents_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3596,7 +5089,7 @@ type ToggleDocumentPinRequest struct {
 
 func (x *ToggleDocumentPinRequest) Reset() {
 	*x = ToggleDocumentPinRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[44]
+	mi := &file_services_documents_documents_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3608,7 +5101,7 @@ func (x *ToggleDocumentPinRequest) String() string {
 func (*ToggleDocumentPinRequest) ProtoMessage() {}
 
 func (x *ToggleDocumentPinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[44]
+	mi := &file_services_documents_documents_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,7 +5184,7 @@ type ToggleDocumentPinResponse struct {
 
 func (x *ToggleDocumentPinResponse) Reset() {
 	*x = ToggleDocumentPinResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[45]
+	mi := &file_services_documents_documents_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3703,7 +5196,7 @@ func (x *ToggleDocumentPinResponse) String() string {
 func (*ToggleDocumentPinResponse) ProtoMessage() {}
 
 func (x *ToggleDocumentPinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[45]
+	mi := &file_services_documents_documents_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3762,7 +5255,7 @@ type SetDocumentReminderRequest struct {
 
 func (x *SetDocumentReminderRequest) Reset() {
 	*x = SetDocumentReminderRequest{}
-	mi := &file_services_documents_documents_proto_msgTypes[46]
+	mi := &file_services_documents_documents_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3774,7 +5267,7 @@ func (x *SetDocumentReminderRequest) String() string {
 func (*SetDocumentReminderRequest) ProtoMessage() {}
 
 func (x *SetDocumentReminderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[46]
+	mi := &file_services_documents_documents_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3879,7 +5372,7 @@ type SetDocumentReminderResponse struct {
 
 func (x *SetDocumentReminderResponse) Reset() {
 	*x = SetDocumentReminderResponse{}
-	mi := &file_services_documents_documents_proto_msgTypes[47]
+	mi := &file_services_documents_documents_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3891,7 +5384,7 @@ func (x *SetDocumentReminderResponse) String() string {
 func (*SetDocumentReminderResponse) ProtoMessage() {}
 
 func (x *SetDocumentReminderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_documents_documents_proto_msgTypes[47]
+	mi := &file_services_documents_documents_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3918,7 +5411,7 @@ var File_services_documents_documents_proto protoreflect.FileDescriptor
 
 const file_services_documents_documents_proto_rawDesc = "" +
 	"\n" +
-	"\"services/documents/documents.proto\x12\x12services.documents\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a&resources/common/content/content.proto\x1a(resources/common/database/database.proto\x1a+resources/documents/activity/activity.proto\x1a#resources/documents/data/data.proto\x1a#resources/documents/documents.proto\x1a#resources/documents/pins/pins.proto\x1a/resources/documents/references/references.proto\x1a-resources/documents/relations/relations.proto\x1a+resources/documents/requests/requests.proto\x1a-resources/documents/templates/templates.proto\x1a\x19resources/file/file.proto\x1a\x1eresources/file/filestore.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\x92\x04\n" +
+	"\"services/documents/documents.proto\x12\x12services.documents\x1a\x1ccodegen/audit/redacted.proto\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/access/access.proto\x1a&resources/common/content/content.proto\x1a(resources/common/database/database.proto\x1a+resources/documents/activity/activity.proto\x1a#resources/documents/bulk/bulk.proto\x1a#resources/documents/data/data.proto\x1a#resources/documents/documents.proto\x1a%resources/documents/forms/forms.proto\x1a#resources/documents/pins/pins.proto\x1a/resources/documents/references/references.proto\x1a-resources/documents/relations/relations.proto\x1a+resources/documents/requests/requests.proto\x1a-resources/documents/templates/templates.proto\x1a+resources/documents/versions/versions.proto\x1a\x19resources/file/file.proto\x1a\x1eresources/file/filestore.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xdf\x04\n" +
 	"\x14ListDocumentsRequest\x12L\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
@@ -3934,7 +5427,9 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"\fdocument_ids\x18\t \x03(\x03R\vdocumentIds\x12$\n" +
 	"\vonly_drafts\x18\n" +
 	" \x01(\bH\x05R\n" +
-	"onlyDrafts\x88\x01\x01B\a\n" +
+	"onlyDrafts\x88\x01\x01\x12K\n" +
+	"\vform_fields\x18\v \x03(\v2*.resources.documents.forms.FormFieldFilterR\n" +
+	"formFieldsB\a\n" +
 	"\x05_sortB\t\n" +
 	"\a_searchB\a\n" +
 	"\x05_fromB\x05\n" +
@@ -3957,22 +5452,27 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"\x06access\x18\x02 \x01(\v2\x18.resources.access.AccessR\x06access\"?\n" +
 	"\x1cGetDocumentReferencesRequest\x12\x1f\n" +
 	"\vdocument_id\x18\x01 \x01(\x03R\n" +
-	"documentId\"\x8a\x01\n" +
+	"documentId\"\xf3\x01\n" +
 	"\x1dGetDocumentReferencesResponse\x12i\n" +
 	"\n" +
 	"references\x18\x01 \x03(\v21.resources.documents.references.DocumentReferenceB\x16\x9a\x84\x9e\x03\x11alias:\"reference\"R\n" +
-	"references\">\n" +
+	"references\x12g\n" +
+	"\x12dispatch_timelines\x18\x02 \x03(\v28.resources.documents.references.DocumentDispatchTimelineR\x11dispatchTimelines\">\n" +
 	"\x1bGetDocumentRelationsRequest\x12\x1f\n" +
 	"\vdocument_id\x18\x01 \x01(\x03R\n" +
 	"documentId\"\x84\x01\n" +
 	"\x1cGetDocumentRelationsResponse\x12d\n" +
-	"\trelations\x18\x01 \x03(\v2/.resources.documents.relations.DocumentRelationB\x15\x9a\x84\x9e\x03\x10alias:\"relation\"R\trelations\"n\n" +
+	"\trelations\x18\x01 \x03(\v2/.resources.documents.relations.DocumentRelationB\x15\x9a\x84\x9e\x03\x10alias:\"relation\"R\trelations\"\xa4\x01\n" +
 	"\x1bAddDocumentReferenceRequest\x12O\n" +
-	"\treference\x18\x01 \x01(\v21.resources.documents.references.DocumentReferenceR\treference\".\n" +
+	"\treference\x18\x01 \x01(\v21.resources.documents.references.DocumentReferenceR\treference\x12$\n" +
+	"\vdispatch_id\x18\x02 \x01(\x03H\x00R\n" +
+	"dispatchId\x88\x01\x01B\x0e\n" +
+	"\f_dispatch_id\".\n" +
 	"\x1cAddDocumentReferenceResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"0\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"]\n" +
 	"\x1eRemoveDocumentReferenceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"!\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x11dispatch_timeline\x18\x02 \x01(\bR\x10dispatchTimeline\"!\n" +
 	"\x1fRemoveDocumentReferenceResponse\"i\n" +
 	"\x1aAddDocumentRelationRequest\x12K\n" +
 	"\brelation\x18\x01 \x01(\v2/.resources.documents.relations.DocumentRelationR\brelation\"-\n" +
@@ -3994,20 +5494,39 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"\vdocument_id\x18\x01 \x01(\x03R\n" +
 	"documentId\x12\x16\n" +
 	"\x06closed\x18\x02 \x01(\bR\x06closed\"\x18\n" +
-	"\x16ToggleDocumentResponse\"r\n" +
+	"\x16ToggleDocumentResponse\"\x8c\x01\n" +
+	"\x1eTransitionDocumentStateRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12\x19\n" +
+	"\bto_state\x18\x02 \x01(\tR\atoState\x12#\n" +
+	"\x06reason\x18\x03 \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"X\n" +
+	"\x1fTransitionDocumentStateResponse\x125\n" +
+	"\x04meta\x18\x01 \x01(\v2!.resources.documents.DocumentMetaR\x04meta\"\x8d\x01\n" +
+	"\x1bSetDocumentLegalHoldRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\x02 \x01(\bR\tlegalHold\x12#\n" +
+	"\x06reason\x18\x03 \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"U\n" +
+	"\x1cSetDocumentLegalHoldResponse\x125\n" +
+	"\x04meta\x18\x01 \x01(\v2!.resources.documents.DocumentMetaR\x04meta\"r\n" +
 	"\x1aChangeDocumentOwnerRequest\x12\x1f\n" +
 	"\vdocument_id\x18\x01 \x01(\x03R\n" +
 	"documentId\x12#\n" +
 	"\vnew_user_id\x18\x02 \x01(\x05H\x00R\tnewUserId\x88\x01\x01B\x0e\n" +
 	"\f_new_user_id\"\x1d\n" +
-	"\x1bChangeDocumentOwnerResponse\"\x80\x02\n" +
+	"\x1bChangeDocumentOwnerResponse\"\xca\x02\n" +
 	"\x15CreateDocumentRequest\x12H\n" +
 	"\fcontent_type\x18\x01 \x01(\x0e2%.resources.common.content.ContentTypeR\vcontentType\x12$\n" +
 	"\vtemplate_id\x18\x02 \x01(\x03H\x00R\n" +
 	"templateId\x88\x01\x01\x12U\n" +
-	"\rtemplate_data\x18\x03 \x01(\v2+.resources.documents.templates.TemplateDataH\x01R\ftemplateData\x88\x01\x01B\x0e\n" +
+	"\rtemplate_data\x18\x03 \x01(\v2+.resources.documents.templates.TemplateDataH\x01R\ftemplateData\x88\x01\x01\x12?\n" +
+	"\x04data\x18\x04 \x01(\v2&.resources.documents.data.DocumentDataH\x02R\x04data\x88\x01\x01B\x0e\n" +
 	"\f_template_idB\x10\n" +
-	"\x0e_template_data\"(\n" +
+	"\x0e_template_dataB\a\n" +
+	"\x05_data\"(\n" +
 	"\x16CreateDocumentResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\xbb\x04\n" +
 	"\x15UpdateDocumentRequest\x120\n" +
@@ -4037,7 +5556,59 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2-.resources.common.database.PaginationResponseR\n" +
 	"pagination\x12K\n" +
-	"\bactivity\x18\x02 \x03(\v2).resources.documents.activity.DocActivityB\x04\xc8\xf3\x18\x01R\bactivity\"\x88\x01\n" +
+	"\bactivity\x18\x02 \x03(\v2).resources.documents.activity.DocActivityB\x04\xc8\xf3\x18\x01R\bactivity\"\xa7\x01\n" +
+	"\x1aBulkUpdateDocumentsRequest\x12B\n" +
+	"\bselector\x18\x01 \x01(\v2&.resources.documents.bulk.BulkSelectorR\bselector\x12E\n" +
+	"\toperation\x18\x02 \x01(\v2'.resources.documents.bulk.BulkOperationR\toperation\"R\n" +
+	"\x1bBulkUpdateDocumentsResponse\x123\n" +
+	"\x03job\x18\x01 \x01(\v2!.resources.documents.bulk.BulkJobR\x03job\"*\n" +
+	"\x11GetBulkJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x03R\x05jobId\"I\n" +
+	"\x12GetBulkJobResponse\x123\n" +
+	"\x03job\x18\x01 \x01(\v2!.resources.documents.bulk.BulkJobR\x03job\"c\n" +
+	"\x13ListBulkJobsRequest\x12L\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
+	"pagination\"\xa2\x01\n" +
+	"\x14ListBulkJobsResponse\x12M\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2-.resources.common.database.PaginationResponseR\n" +
+	"pagination\x12;\n" +
+	"\x04jobs\x18\x02 \x03(\v2!.resources.documents.bulk.BulkJobB\x04\xc8\xf3\x18\x01R\x04jobs\"\x8c\x01\n" +
+	"\x1bListDocumentVersionsRequest\x12L\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
+	"pagination\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\x03R\n" +
+	"documentId\"\xbe\x01\n" +
+	"\x1cListDocumentVersionsResponse\x12M\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2-.resources.common.database.PaginationResponseR\n" +
+	"pagination\x12O\n" +
+	"\bversions\x18\x02 \x03(\v2-.resources.documents.versions.DocumentVersionB\x04\xc8\xf3\x18\x01R\bversions\"\xa5\x01\n" +
+	"\x19GetDocumentVersionRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x03R\tversionId\x121\n" +
+	"\x12compare_version_id\x18\x03 \x01(\x03H\x00R\x10compareVersionId\x88\x01\x01B\x15\n" +
+	"\x13_compare_version_id\"\xac\x01\n" +
+	"\x1aGetDocumentVersionResponse\x12G\n" +
+	"\aversion\x18\x01 \x01(\v2-.resources.documents.versions.DocumentVersionR\aversion\x12E\n" +
+	"\x04diff\x18\x02 \x01(\v21.resources.documents.versions.DocumentVersionDiffR\x04diff\"_\n" +
+	"\x1dRestoreDocumentVersionRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x03R\tversionId\"[\n" +
+	"\x1eRestoreDocumentVersionResponse\x129\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1d.resources.documents.DocumentR\bdocument\"8\n" +
+	"\x15GetDocumentPDFRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\"O\n" +
+	"\x16GetDocumentPDFResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x18\n" +
+	"\x04data\x18\x02 \x01(\fB\x04\xf0\xf3\x18\x01R\x04data\"\x88\x01\n" +
 	"\x17ListDocumentReqsRequest\x12L\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
@@ -4131,7 +5702,7 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"\x0e_reminder_timeB\n" +
 	"\n" +
 	"\b_message\"\x1d\n" +
-	"\x1bSetDocumentReminderResponse2\xcd\x1c\n" +
+	"\x1bSetDocumentReminderResponse2\xb0&\n" +
 	"\x10DocumentsService\x12l\n" +
 	"\rListDocuments\x12(.services.documents.ListDocumentsRequest\x1a).services.documents.ListDocumentsResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12u\n" +
 	"\vGetDocument\x12&.services.documents.GetDocumentRequest\x1a'.services.documents.GetDocumentResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListDocuments\x12\x7f\n" +
@@ -4144,10 +5715,16 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"Lower_Rank\"\tSame_Rank\"\x03Any\x12\x9c\x01\n" +
 	"\x0eToggleDocument\x12).services.documents.ToggleDocumentRequest\x1a*.services.documents.ToggleDocumentResponse\"3\xd2\xf3\x18/\b\x01:+\n" +
 	"\x06Access\x18\x01\"\x03Own\"\n" +
-	"Lower_Rank\"\tSame_Rank\"\x03Any\x12\xab\x01\n" +
+	"Lower_Rank\"\tSame_Rank\"\x03Any\x12\x9a\x01\n" +
+	"\x17TransitionDocumentState\x122.services.documents.TransitionDocumentStateRequest\x1a3.services.documents.TransitionDocumentStateResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eToggleDocument\x12\x81\x01\n" +
+	"\x14SetDocumentLegalHold\x12/.services.documents.SetDocumentLegalHoldRequest\x1a0.services.documents.SetDocumentLegalHoldResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12\xab\x01\n" +
 	"\x13ChangeDocumentOwner\x12..services.documents.ChangeDocumentOwnerRequest\x1a/.services.documents.ChangeDocumentOwnerResponse\"3\xd2\xf3\x18/\b\x01:+\n" +
 	"\x06Access\x18\x01\"\x03Own\"\n" +
-	"Lower_Rank\"\tSame_Rank\"\x03Any\x12\x93\x01\n" +
+	"Lower_Rank\"\tSame_Rank\"\x03Any\x12~\n" +
+	"\x13BulkUpdateDocuments\x12..services.documents.BulkUpdateDocumentsRequest\x1a/.services.documents.BulkUpdateDocumentsResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12x\n" +
+	"\n" +
+	"GetBulkJob\x12%.services.documents.GetBulkJobRequest\x1a&.services.documents.GetBulkJobResponse\"\x1b\xd2\xf3\x18\x17\b\x01\"\x13BulkUpdateDocuments\x12~\n" +
+	"\fListBulkJobs\x12'.services.documents.ListBulkJobsRequest\x1a(.services.documents.ListBulkJobsResponse\"\x1b\xd2\xf3\x18\x17\b\x01\"\x13BulkUpdateDocuments\x12\x93\x01\n" +
 	"\x15GetDocumentReferences\x120.services.documents.GetDocumentReferencesRequest\x1a1.services.documents.GetDocumentReferencesResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListDocuments\x12\x90\x01\n" +
 	"\x14GetDocumentRelations\x12/.services.documents.GetDocumentRelationsRequest\x1a0.services.documents.GetDocumentRelationsResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListDocuments\x12\xa6\x01\n" +
 	"\x14AddDocumentReference\x12/.services.documents.AddDocumentReferenceRequest\x1a0.services.documents.AddDocumentReferenceResponse\"+\xd2\xf3\x18'\b\x01*\x14AddDocumentReference*\rListDocuments\x12\x99\x01\n" +
@@ -4156,7 +5733,11 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"\x16RemoveDocumentRelation\x121.services.documents.RemoveDocumentRelationRequest\x1a2.services.documents.RemoveDocumentRelationResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListDocuments\x12\x87\x01\n" +
 	"\x11GetDocumentAccess\x12,.services.documents.GetDocumentAccessRequest\x1a-.services.documents.GetDocumentAccessResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListDocuments\x12\x88\x01\n" +
 	"\x11SetDocumentAccess\x12,.services.documents.SetDocumentAccessRequest\x1a-.services.documents.SetDocumentAccessResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eUpdateDocument\x12\x81\x01\n" +
-	"\x14ListDocumentActivity\x12/.services.documents.ListDocumentActivityRequest\x1a0.services.documents.ListDocumentActivityResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12u\n" +
+	"\x14ListDocumentActivity\x12/.services.documents.ListDocumentActivityRequest\x1a0.services.documents.ListDocumentActivityResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12\x97\x01\n" +
+	"\x14ListDocumentVersions\x12/.services.documents.ListDocumentVersionsRequest\x1a0.services.documents.ListDocumentVersionsResponse\"\x1c\xd2\xf3\x18\x18\b\x01\"\x14ListDocumentActivity\x12\x91\x01\n" +
+	"\x12GetDocumentVersion\x12-.services.documents.GetDocumentVersionRequest\x1a..services.documents.GetDocumentVersionResponse\"\x1c\xd2\xf3\x18\x18\b\x01\"\x14ListDocumentActivity\x12\x97\x01\n" +
+	"\x16RestoreDocumentVersion\x121.services.documents.RestoreDocumentVersionRequest\x1a2.services.documents.RestoreDocumentVersionResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eUpdateDocument\x12~\n" +
+	"\x0eGetDocumentPDF\x12).services.documents.GetDocumentPDFRequest\x1a*.services.documents.GetDocumentPDFResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListDocuments\x12u\n" +
 	"\x10ListDocumentReqs\x12+.services.documents.ListDocumentReqsRequest\x1a,.services.documents.ListDocumentReqsResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12\xb3\x01\n" +
 	"\x11CreateDocumentReq\x12,.services.documents.CreateDocumentReqRequest\x1a-.services.documents.CreateDocumentReqResponse\"A\xd2\xf3\x18=\b\x01:9\n" +
 	"\x05Types\x18\x01\"\x06Access\"\aClosure\"\x06Update\"\bDeletion\"\vOwnerChange\x12\x8b\x01\n" +
@@ -4170,181 +5751,242 @@ const file_services_documents_documents_proto_rawDesc = "" +
 	"\n" +
 	"UploadFile\x12!.resources.file.UploadFileRequest\x1a\".resources.file.UploadFileResponse\"\x16\xd2\xf3\x18\x12\b\x01\"\x0eUpdateDocument(\x01\x1a0\xea\xf3\x18,\b2\x12(i-mdi-file-document-box-multiple-outlineBPZNgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents;documentsb\x06proto3"
 
-var file_services_documents_documents_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_services_documents_documents_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_services_documents_documents_proto_goTypes = []any{
-	(*ListDocumentsRequest)(nil),            // 0: services.documents.ListDocumentsRequest
-	(*ListDocumentsResponse)(nil),           // 1: services.documents.ListDocumentsResponse
-	(*GetDocumentRequest)(nil),              // 2: services.documents.GetDocumentRequest
-	(*GetDocumentResponse)(nil),             // 3: services.documents.GetDocumentResponse
-	(*GetDocumentReferencesRequest)(nil),    // 4: services.documents.GetDocumentReferencesRequest
-	(*GetDocumentReferencesResponse)(nil),   // 5: services.documents.GetDocumentReferencesResponse
-	(*GetDocumentRelationsRequest)(nil),     // 6: services.documents.GetDocumentRelationsRequest
-	(*GetDocumentRelationsResponse)(nil),    // 7: services.documents.GetDocumentRelationsResponse
-	(*AddDocumentReferenceRequest)(nil),     // 8: services.documents.AddDocumentReferenceRequest
-	(*AddDocumentReferenceResponse)(nil),    // 9: services.documents.AddDocumentReferenceResponse
-	(*RemoveDocumentReferenceRequest)(nil),  // 10: services.documents.RemoveDocumentReferenceRequest
-	(*RemoveDocumentReferenceResponse)(nil), // 11: services.documents.RemoveDocumentReferenceResponse
-	(*AddDocumentRelationRequest)(nil),      // 12: services.documents.AddDocumentRelationRequest
-	(*AddDocumentRelationResponse)(nil),     // 13: services.documents.AddDocumentRelationResponse
-	(*RemoveDocumentRelationRequest)(nil),   // 14: services.documents.RemoveDocumentRelationRequest
-	(*RemoveDocumentRelationResponse)(nil),  // 15: services.documents.RemoveDocumentRelationResponse
-	(*UpdateDocumentResponse)(nil),          // 16: services.documents.UpdateDocumentResponse
-	(*DeleteDocumentRequest)(nil),           // 17: services.documents.DeleteDocumentRequest
-	(*DeleteDocumentResponse)(nil),          // 18: services.documents.DeleteDocumentResponse
-	(*ToggleDocumentRequest)(nil),           // 19: services.documents.ToggleDocumentRequest
-	(*ToggleDocumentResponse)(nil),          // 20: services.documents.ToggleDocumentResponse
-	(*ChangeDocumentOwnerRequest)(nil),      // 21: services.documents.ChangeDocumentOwnerRequest
-	(*ChangeDocumentOwnerResponse)(nil),     // 22: services.documents.ChangeDocumentOwnerResponse
-	(*CreateDocumentRequest)(nil),           // 23: services.documents.CreateDocumentRequest
-	(*CreateDocumentResponse)(nil),          // 24: services.documents.CreateDocumentResponse
-	(*UpdateDocumentRequest)(nil),           // 25: services.documents.UpdateDocumentRequest
-	(*ListDocumentActivityRequest)(nil),     // 26: services.documents.ListDocumentActivityRequest
-	(*ListDocumentActivityResponse)(nil),    // 27: services.documents.ListDocumentActivityResponse
-	(*ListDocumentReqsRequest)(nil),         // 28: services.documents.ListDocumentReqsRequest
-	(*ListDocumentReqsResponse)(nil),        // 29: services.documents.ListDocumentReqsResponse
-	(*CreateDocumentReqRequest)(nil),        // 30: services.documents.CreateDocumentReqRequest
-	(*CreateDocumentReqResponse)(nil),       // 31: services.documents.CreateDocumentReqResponse
-	(*UpdateDocumentReqRequest)(nil),        // 32: services.documents.UpdateDocumentReqRequest
-	(*UpdateDocumentReqResponse)(nil),       // 33: services.documents.UpdateDocumentReqResponse
-	(*DeleteDocumentReqRequest)(nil),        // 34: services.documents.DeleteDocumentReqRequest
-	(*DeleteDocumentReqResponse)(nil),       // 35: services.documents.DeleteDocumentReqResponse
-	(*GetDocumentAccessRequest)(nil),        // 36: services.documents.GetDocumentAccessRequest
-	(*GetDocumentAccessResponse)(nil),       // 37: services.documents.GetDocumentAccessResponse
-	(*SetDocumentAccessRequest)(nil),        // 38: services.documents.SetDocumentAccessRequest
-	(*SetDocumentAccessResponse)(nil),       // 39: services.documents.SetDocumentAccessResponse
-	(*ListUserDocumentsRequest)(nil),        // 40: services.documents.ListUserDocumentsRequest
-	(*ListUserDocumentsResponse)(nil),       // 41: services.documents.ListUserDocumentsResponse
-	(*ListDocumentPinsRequest)(nil),         // 42: services.documents.ListDocumentPinsRequest
-	(*ListDocumentPinsResponse)(nil),        // 43: services.documents.ListDocumentPinsResponse
-	(*ToggleDocumentPinRequest)(nil),        // 44: services.documents.ToggleDocumentPinRequest
-	(*ToggleDocumentPinResponse)(nil),       // 45: services.documents.ToggleDocumentPinResponse
-	(*SetDocumentReminderRequest)(nil),      // 46: services.documents.SetDocumentReminderRequest
-	(*SetDocumentReminderResponse)(nil),     // 47: services.documents.SetDocumentReminderResponse
-	(*database.PaginationRequest)(nil),      // 48: resources.common.database.PaginationRequest
-	(*database.Sort)(nil),                   // 49: resources.common.database.Sort
-	(*timestamp.Timestamp)(nil),             // 50: resources.timestamp.Timestamp
-	(*database.PaginationResponse)(nil),     // 51: resources.common.database.PaginationResponse
-	(*documents.DocumentShort)(nil),         // 52: resources.documents.DocumentShort
-	(*documents.Document)(nil),              // 53: resources.documents.Document
-	(*access.Access)(nil),                   // 54: resources.access.Access
-	(*references.DocumentReference)(nil),    // 55: resources.documents.references.DocumentReference
-	(*relations.DocumentRelation)(nil),      // 56: resources.documents.relations.DocumentRelation
-	(content.ContentType)(0),                // 57: resources.common.content.ContentType
-	(*templates.TemplateData)(nil),          // 58: resources.documents.templates.TemplateData
-	(*content.Content)(nil),                 // 59: resources.common.content.Content
-	(*data.DocumentData)(nil),               // 60: resources.documents.data.DocumentData
-	(*documents.DocumentMeta)(nil),          // 61: resources.documents.DocumentMeta
-	(*file.File)(nil),                       // 62: resources.file.File
-	(activity.DocActivityType)(0),           // 63: resources.documents.activity.DocActivityType
-	(*activity.DocActivity)(nil),            // 64: resources.documents.activity.DocActivity
-	(*requests.DocRequest)(nil),             // 65: resources.documents.requests.DocRequest
-	(*activity.DocActivityData)(nil),        // 66: resources.documents.activity.DocActivityData
-	(relations.DocRelation)(0),              // 67: resources.documents.relations.DocRelation
-	(*pins.DocumentPin)(nil),                // 68: resources.documents.pins.DocumentPin
-	(*file.UploadFileRequest)(nil),          // 69: resources.file.UploadFileRequest
-	(*file.UploadFileResponse)(nil),         // 70: resources.file.UploadFileResponse
+	(*ListDocumentsRequest)(nil),                // 0: services.documents.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),               // 1: services.documents.ListDocumentsResponse
+	(*GetDocumentRequest)(nil),                  // 2: services.documents.GetDocumentRequest
+	(*GetDocumentResponse)(nil),                 // 3: services.documents.GetDocumentResponse
+	(*GetDocumentReferencesRequest)(nil),        // 4: services.documents.GetDocumentReferencesRequest
+	(*GetDocumentReferencesResponse)(nil),       // 5: services.documents.GetDocumentReferencesResponse
+	(*GetDocumentRelationsRequest)(nil),         // 6: services.documents.GetDocumentRelationsRequest
+	(*GetDocumentRelationsResponse)(nil),        // 7: services.documents.GetDocumentRelationsResponse
+	(*AddDocumentReferenceRequest)(nil),         // 8: services.documents.AddDocumentReferenceRequest
+	(*AddDocumentReferenceResponse)(nil),        // 9: services.documents.AddDocumentReferenceResponse
+	(*RemoveDocumentReferenceRequest)(nil),      // 10: services.documents.RemoveDocumentReferenceRequest
+	(*RemoveDocumentReferenceResponse)(nil),     // 11: services.documents.RemoveDocumentReferenceResponse
+	(*AddDocumentRelationRequest)(nil),          // 12: services.documents.AddDocumentRelationRequest
+	(*AddDocumentRelationResponse)(nil),         // 13: services.documents.AddDocumentRelationResponse
+	(*RemoveDocumentRelationRequest)(nil),       // 14: services.documents.RemoveDocumentRelationRequest
+	(*RemoveDocumentRelationResponse)(nil),      // 15: services.documents.RemoveDocumentRelationResponse
+	(*UpdateDocumentResponse)(nil),              // 16: services.documents.UpdateDocumentResponse
+	(*DeleteDocumentRequest)(nil),               // 17: services.documents.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),              // 18: services.documents.DeleteDocumentResponse
+	(*ToggleDocumentRequest)(nil),               // 19: services.documents.ToggleDocumentRequest
+	(*ToggleDocumentResponse)(nil),              // 20: services.documents.ToggleDocumentResponse
+	(*TransitionDocumentStateRequest)(nil),      // 21: services.documents.TransitionDocumentStateRequest
+	(*TransitionDocumentStateResponse)(nil),     // 22: services.documents.TransitionDocumentStateResponse
+	(*SetDocumentLegalHoldRequest)(nil),         // 23: services.documents.SetDocumentLegalHoldRequest
+	(*SetDocumentLegalHoldResponse)(nil),        // 24: services.documents.SetDocumentLegalHoldResponse
+	(*ChangeDocumentOwnerRequest)(nil),          // 25: services.documents.ChangeDocumentOwnerRequest
+	(*ChangeDocumentOwnerResponse)(nil),         // 26: services.documents.ChangeDocumentOwnerResponse
+	(*CreateDocumentRequest)(nil),               // 27: services.documents.CreateDocumentRequest
+	(*CreateDocumentResponse)(nil),              // 28: services.documents.CreateDocumentResponse
+	(*UpdateDocumentRequest)(nil),               // 29: services.documents.UpdateDocumentRequest
+	(*ListDocumentActivityRequest)(nil),         // 30: services.documents.ListDocumentActivityRequest
+	(*ListDocumentActivityResponse)(nil),        // 31: services.documents.ListDocumentActivityResponse
+	(*BulkUpdateDocumentsRequest)(nil),          // 32: services.documents.BulkUpdateDocumentsRequest
+	(*BulkUpdateDocumentsResponse)(nil),         // 33: services.documents.BulkUpdateDocumentsResponse
+	(*GetBulkJobRequest)(nil),                   // 34: services.documents.GetBulkJobRequest
+	(*GetBulkJobResponse)(nil),                  // 35: services.documents.GetBulkJobResponse
+	(*ListBulkJobsRequest)(nil),                 // 36: services.documents.ListBulkJobsRequest
+	(*ListBulkJobsResponse)(nil),                // 37: services.documents.ListBulkJobsResponse
+	(*ListDocumentVersionsRequest)(nil),         // 38: services.documents.ListDocumentVersionsRequest
+	(*ListDocumentVersionsResponse)(nil),        // 39: services.documents.ListDocumentVersionsResponse
+	(*GetDocumentVersionRequest)(nil),           // 40: services.documents.GetDocumentVersionRequest
+	(*GetDocumentVersionResponse)(nil),          // 41: services.documents.GetDocumentVersionResponse
+	(*RestoreDocumentVersionRequest)(nil),       // 42: services.documents.RestoreDocumentVersionRequest
+	(*RestoreDocumentVersionResponse)(nil),      // 43: services.documents.RestoreDocumentVersionResponse
+	(*GetDocumentPDFRequest)(nil),               // 44: services.documents.GetDocumentPDFRequest
+	(*GetDocumentPDFResponse)(nil),              // 45: services.documents.GetDocumentPDFResponse
+	(*ListDocumentReqsRequest)(nil),             // 46: services.documents.ListDocumentReqsRequest
+	(*ListDocumentReqsResponse)(nil),            // 47: services.documents.ListDocumentReqsResponse
+	(*CreateDocumentReqRequest)(nil),            // 48: services.documents.CreateDocumentReqRequest
+	(*CreateDocumentReqResponse)(nil),           // 49: services.documents.CreateDocumentReqResponse
+	(*UpdateDocumentReqRequest)(nil),            // 50: services.documents.UpdateDocumentReqRequest
+	(*UpdateDocumentReqResponse)(nil),           // 51: services.documents.UpdateDocumentReqResponse
+	(*DeleteDocumentReqRequest)(nil),            // 52: services.documents.DeleteDocumentReqRequest
+	(*DeleteDocumentReqResponse)(nil),           // 53: services.documents.DeleteDocumentReqResponse
+	(*GetDocumentAccessRequest)(nil),            // 54: services.documents.GetDocumentAccessRequest
+	(*GetDocumentAccessResponse)(nil),           // 55: services.documents.GetDocumentAccessResponse
+	(*SetDocumentAccessRequest)(nil),            // 56: services.documents.SetDocumentAccessRequest
+	(*SetDocumentAccessResponse)(nil),           // 57: services.documents.SetDocumentAccessResponse
+	(*ListUserDocumentsRequest)(nil),            // 58: services.documents.ListUserDocumentsRequest
+	(*ListUserDocumentsResponse)(nil),           // 59: services.documents.ListUserDocumentsResponse
+	(*ListDocumentPinsRequest)(nil),             // 60: services.documents.ListDocumentPinsRequest
+	(*ListDocumentPinsResponse)(nil),            // 61: services.documents.ListDocumentPinsResponse
+	(*ToggleDocumentPinRequest)(nil),            // 62: services.documents.ToggleDocumentPinRequest
+	(*ToggleDocumentPinResponse)(nil),           // 63: services.documents.ToggleDocumentPinResponse
+	(*SetDocumentReminderRequest)(nil),          // 64: services.documents.SetDocumentReminderRequest
+	(*SetDocumentReminderResponse)(nil),         // 65: services.documents.SetDocumentReminderResponse
+	(*database.PaginationRequest)(nil),          // 66: resources.common.database.PaginationRequest
+	(*database.Sort)(nil),                       // 67: resources.common.database.Sort
+	(*timestamp.Timestamp)(nil),                 // 68: resources.timestamp.Timestamp
+	(*forms.FormFieldFilter)(nil),               // 69: resources.documents.forms.FormFieldFilter
+	(*database.PaginationResponse)(nil),         // 70: resources.common.database.PaginationResponse
+	(*documents.DocumentShort)(nil),             // 71: resources.documents.DocumentShort
+	(*documents.Document)(nil),                  // 72: resources.documents.Document
+	(*access.Access)(nil),                       // 73: resources.access.Access
+	(*references.DocumentReference)(nil),        // 74: resources.documents.references.DocumentReference
+	(*references.DocumentDispatchTimeline)(nil), // 75: resources.documents.references.DocumentDispatchTimeline
+	(*relations.DocumentRelation)(nil),          // 76: resources.documents.relations.DocumentRelation
+	(*documents.DocumentMeta)(nil),              // 77: resources.documents.DocumentMeta
+	(content.ContentType)(0),                    // 78: resources.common.content.ContentType
+	(*templates.TemplateData)(nil),              // 79: resources.documents.templates.TemplateData
+	(*data.DocumentData)(nil),                   // 80: resources.documents.data.DocumentData
+	(*content.Content)(nil),                     // 81: resources.common.content.Content
+	(*file.File)(nil),                           // 82: resources.file.File
+	(activity.DocActivityType)(0),               // 83: resources.documents.activity.DocActivityType
+	(*activity.DocActivity)(nil),                // 84: resources.documents.activity.DocActivity
+	(*bulk.BulkSelector)(nil),                   // 85: resources.documents.bulk.BulkSelector
+	(*bulk.BulkOperation)(nil),                  // 86: resources.documents.bulk.BulkOperation
+	(*bulk.BulkJob)(nil),                        // 87: resources.documents.bulk.BulkJob
+	(*versions.DocumentVersion)(nil),            // 88: resources.documents.versions.DocumentVersion
+	(*versions.DocumentVersionDiff)(nil),        // 89: resources.documents.versions.DocumentVersionDiff
+	(*requests.DocRequest)(nil),                 // 90: resources.documents.requests.DocRequest
+	(*activity.DocActivityData)(nil),            // 91: resources.documents.activity.DocActivityData
+	(relations.DocRelation)(0),                  // 92: resources.documents.relations.DocRelation
+	(*pins.DocumentPin)(nil),                    // 93: resources.documents.pins.DocumentPin
+	(*file.UploadFileRequest)(nil),              // 94: resources.file.UploadFileRequest
+	(*file.UploadFileResponse)(nil),             // 95: resources.file.UploadFileResponse
 }
 var file_services_documents_documents_proto_depIdxs = []int32{
-	48, // 0: services.documents.ListDocumentsRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	49, // 1: services.documents.ListDocumentsRequest.sort:type_name -> resources.common.database.Sort
-	50, // 2: services.documents.ListDocumentsRequest.from:type_name -> resources.timestamp.Timestamp
-	50, // 3: services.documents.ListDocumentsRequest.to:type_name -> resources.timestamp.Timestamp
-	51, // 4: services.documents.ListDocumentsResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	52, // 5: services.documents.ListDocumentsResponse.documents:type_name -> resources.documents.DocumentShort
-	53, // 6: services.documents.GetDocumentResponse.document:type_name -> resources.documents.Document
-	54, // 7: services.documents.GetDocumentResponse.access:type_name -> resources.access.Access
-	55, // 8: services.documents.GetDocumentReferencesResponse.references:type_name -> resources.documents.references.DocumentReference
-	56, // 9: services.documents.GetDocumentRelationsResponse.relations:type_name -> resources.documents.relations.DocumentRelation
-	55, // 10: services.documents.AddDocumentReferenceRequest.reference:type_name -> resources.documents.references.DocumentReference
-	56, // 11: services.documents.AddDocumentRelationRequest.relation:type_name -> resources.documents.relations.DocumentRelation
-	53, // 12: services.documents.UpdateDocumentResponse.document:type_name -> resources.documents.Document
-	57, // 13: services.documents.CreateDocumentRequest.content_type:type_name -> resources.common.content.ContentType
-	58, // 14: services.documents.CreateDocumentRequest.template_data:type_name -> resources.documents.templates.TemplateData
-	59, // 15: services.documents.UpdateDocumentRequest.content:type_name -> resources.common.content.Content
-	57, // 16: services.documents.UpdateDocumentRequest.content_type:type_name -> resources.common.content.ContentType
-	60, // 17: services.documents.UpdateDocumentRequest.data:type_name -> resources.documents.data.DocumentData
-	61, // 18: services.documents.UpdateDocumentRequest.meta:type_name -> resources.documents.DocumentMeta
-	54, // 19: services.documents.UpdateDocumentRequest.access:type_name -> resources.access.Access
-	62, // 20: services.documents.UpdateDocumentRequest.files:type_name -> resources.file.File
-	48, // 21: services.documents.ListDocumentActivityRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	63, // 22: services.documents.ListDocumentActivityRequest.activity_types:type_name -> resources.documents.activity.DocActivityType
-	51, // 23: services.documents.ListDocumentActivityResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	64, // 24: services.documents.ListDocumentActivityResponse.activity:type_name -> resources.documents.activity.DocActivity
-	48, // 25: services.documents.ListDocumentReqsRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	51, // 26: services.documents.ListDocumentReqsResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	65, // 27: services.documents.ListDocumentReqsResponse.requests:type_name -> resources.documents.requests.DocRequest
-	63, // 28: services.documents.CreateDocumentReqRequest.request_type:type_name -> resources.documents.activity.DocActivityType
-	66, // 29: services.documents.CreateDocumentReqRequest.data:type_name -> resources.documents.activity.DocActivityData
-	65, // 30: services.documents.CreateDocumentReqResponse.request:type_name -> resources.documents.requests.DocRequest
-	66, // 31: services.documents.UpdateDocumentReqRequest.data:type_name -> resources.documents.activity.DocActivityData
-	65, // 32: services.documents.UpdateDocumentReqResponse.request:type_name -> resources.documents.requests.DocRequest
-	54, // 33: services.documents.GetDocumentAccessResponse.access:type_name -> resources.access.Access
-	54, // 34: services.documents.SetDocumentAccessRequest.access:type_name -> resources.access.Access
-	48, // 35: services.documents.ListUserDocumentsRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	49, // 36: services.documents.ListUserDocumentsRequest.sort:type_name -> resources.common.database.Sort
-	67, // 37: services.documents.ListUserDocumentsRequest.relations:type_name -> resources.documents.relations.DocRelation
-	51, // 38: services.documents.ListUserDocumentsResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	56, // 39: services.documents.ListUserDocumentsResponse.relations:type_name -> resources.documents.relations.DocumentRelation
-	48, // 40: services.documents.ListDocumentPinsRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	51, // 41: services.documents.ListDocumentPinsResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	52, // 42: services.documents.ListDocumentPinsResponse.documents:type_name -> resources.documents.DocumentShort
-	68, // 43: services.documents.ToggleDocumentPinResponse.pin:type_name -> resources.documents.pins.DocumentPin
-	50, // 44: services.documents.SetDocumentReminderRequest.reminder_time:type_name -> resources.timestamp.Timestamp
-	0,  // 45: services.documents.DocumentsService.ListDocuments:input_type -> services.documents.ListDocumentsRequest
-	2,  // 46: services.documents.DocumentsService.GetDocument:input_type -> services.documents.GetDocumentRequest
-	23, // 47: services.documents.DocumentsService.CreateDocument:input_type -> services.documents.CreateDocumentRequest
-	25, // 48: services.documents.DocumentsService.UpdateDocument:input_type -> services.documents.UpdateDocumentRequest
-	17, // 49: services.documents.DocumentsService.DeleteDocument:input_type -> services.documents.DeleteDocumentRequest
-	19, // 50: services.documents.DocumentsService.ToggleDocument:input_type -> services.documents.ToggleDocumentRequest
-	21, // 51: services.documents.DocumentsService.ChangeDocumentOwner:input_type -> services.documents.ChangeDocumentOwnerRequest
-	4,  // 52: services.documents.DocumentsService.GetDocumentReferences:input_type -> services.documents.GetDocumentReferencesRequest
-	6,  // 53: services.documents.DocumentsService.GetDocumentRelations:input_type -> services.documents.GetDocumentRelationsRequest
-	8,  // 54: services.documents.DocumentsService.AddDocumentReference:input_type -> services.documents.AddDocumentReferenceRequest
-	10, // 55: services.documents.DocumentsService.RemoveDocumentReference:input_type -> services.documents.RemoveDocumentReferenceRequest
-	12, // 56: services.documents.DocumentsService.AddDocumentRelation:input_type -> services.documents.AddDocumentRelationRequest
-	14, // 57: services.documents.DocumentsService.RemoveDocumentRelation:input_type -> services.documents.RemoveDocumentRelationRequest
-	36, // 58: services.documents.DocumentsService.GetDocumentAccess:input_type -> services.documents.GetDocumentAccessRequest
-	38, // 59: services.documents.DocumentsService.SetDocumentAccess:input_type -> services.documents.SetDocumentAccessRequest
-	26, // 60: services.documents.DocumentsService.ListDocumentActivity:input_type -> services.documents.ListDocumentActivityRequest
-	28, // 61: services.documents.DocumentsService.ListDocumentReqs:input_type -> services.documents.ListDocumentReqsRequest
-	30, // 62: services.documents.DocumentsService.CreateDocumentReq:input_type -> services.documents.CreateDocumentReqRequest
-	32, // 63: services.documents.DocumentsService.UpdateDocumentReq:input_type -> services.documents.UpdateDocumentReqRequest
-	34, // 64: services.documents.DocumentsService.DeleteDocumentReq:input_type -> services.documents.DeleteDocumentReqRequest
-	40, // 65: services.documents.DocumentsService.ListUserDocuments:input_type -> services.documents.ListUserDocumentsRequest
-	42, // 66: services.documents.DocumentsService.ListDocumentPins:input_type -> services.documents.ListDocumentPinsRequest
-	44, // 67: services.documents.DocumentsService.ToggleDocumentPin:input_type -> services.documents.ToggleDocumentPinRequest
-	46, // 68: services.documents.DocumentsService.SetDocumentReminder:input_type -> services.documents.SetDocumentReminderRequest
-	69, // 69: services.documents.DocumentsService.UploadFile:input_type -> resources.file.UploadFileRequest
-	1,  // 70: services.documents.DocumentsService.ListDocuments:output_type -> services.documents.ListDocumentsResponse
-	3,  // 71: services.documents.DocumentsService.GetDocument:output_type -> services.documents.GetDocumentResponse
-	24, // 72: services.documents.DocumentsService.CreateDocument:output_type -> services.documents.CreateDocumentResponse
-	16, // 73: services.documents.DocumentsService.UpdateDocument:output_type -> services.documents.UpdateDocumentResponse
-	18, // 74: services.documents.DocumentsService.DeleteDocument:output_type -> services.documents.DeleteDocumentResponse
-	20, // 75: services.documents.DocumentsService.ToggleDocument:output_type -> services.documents.ToggleDocumentResponse
-	22, // 76: services.documents.DocumentsService.ChangeDocumentOwner:output_type -> services.documents.ChangeDocumentOwnerResponse
-	5,  // 77: services.documents.DocumentsService.GetDocumentReferences:output_type -> services.documents.GetDocumentReferencesResponse
-	7,  // 78: services.documents.DocumentsService.GetDocumentRelations:output_type -> services.documents.GetDocumentRelationsResponse
-	9,  // 79: services.documents.DocumentsService.AddDocumentReference:output_type -> services.documents.AddDocumentReferenceResponse
-	11, // 80: services.documents.DocumentsService.RemoveDocumentReference:output_type -> services.documents.RemoveDocumentReferenceResponse
-	13, // 81: services.documents.DocumentsService.AddDocumentRelation:output_type -> services.documents.AddDocumentRelationResponse
-	15, // 82: services.documents.DocumentsService.RemoveDocumentRelation:output_type -> services.documents.RemoveDocumentRelationResponse
-	37, // 83: services.documents.DocumentsService.GetDocumentAccess:output_type -> services.documents.GetDocumentAccessResponse
-	39, // 84: services.documents.DocumentsService.SetDocumentAccess:output_type -> services.documents.SetDocumentAccessResponse
-	27, // 85: services.documents.DocumentsService.ListDocumentActivity:output_type -> services.documents.ListDocumentActivityResponse
-	29, // 86: services.documents.DocumentsService.ListDocumentReqs:output_type -> services.documents.ListDocumentReqsResponse
-	31, // 87: services.documents.DocumentsService.CreateDocumentReq:output_type -> services.documents.CreateDocumentReqResponse
-	33, // 88: services.documents.DocumentsService.UpdateDocumentReq:output_type -> services.documents.UpdateDocumentReqResponse
-	35, // 89: services.documents.DocumentsService.DeleteDocumentReq:output_type -> services.documents.DeleteDocumentReqResponse
-	41, // 90: services.documents.DocumentsService.ListUserDocuments:output_type -> services.documents.ListUserDocumentsResponse
-	43, // 91: services.documents.DocumentsService.ListDocumentPins:output_type -> services.documents.ListDocumentPinsResponse
-	45, // 92: services.documents.DocumentsService.ToggleDocumentPin:output_type -> services.documents.ToggleDocumentPinResponse
-	47, // 93: services.documents.DocumentsService.SetDocumentReminder:output_type -> services.documents.SetDocumentReminderResponse
-	70, // 94: services.documents.DocumentsService.UploadFile:output_type -> resources.file.UploadFileResponse
-	70, // [70:95] is the sub-list for method output_type
-	45, // [45:70] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	66, // 0: services.documents.ListDocumentsRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	67, // 1: services.documents.ListDocumentsRequest.sort:type_name -> resources.common.database.Sort
+	68, // 2: services.documents.ListDocumentsRequest.from:type_name -> resources.timestamp.Timestamp
+	68, // 3: services.documents.ListDocumentsRequest.to:type_name -> resources.timestamp.Timestamp
+	69, // 4: services.documents.ListDocumentsRequest.form_fields:type_name -> resources.documents.forms.FormFieldFilter
+	70, // 5: services.documents.ListDocumentsResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	71, // 6: services.documents.ListDocumentsResponse.documents:type_name -> resources.documents.DocumentShort
+	72, // 7: services.documents.GetDocumentResponse.document:type_name -> resources.documents.Document
+	73, // 8: services.documents.GetDocumentResponse.access:type_name -> resources.access.Access
+	74, // 9: services.documents.GetDocumentReferencesResponse.references:type_name -> resources.documents.references.DocumentReference
+	75, // 10: services.documents.GetDocumentReferencesResponse.dispatch_timelines:type_name -> resources.documents.references.DocumentDispatchTimeline
+	76, // 11: services.documents.GetDocumentRelationsResponse.relations:type_name -> resources.documents.relations.DocumentRelation
+	74, // 12: services.documents.AddDocumentReferenceRequest.reference:type_name -> resources.documents.references.DocumentReference
+	76, // 13: services.documents.AddDocumentRelationRequest.relation:type_name -> resources.documents.relations.DocumentRelation
+	72, // 14: services.documents.UpdateDocumentResponse.document:type_name -> resources.documents.Document
+	77, // 15: services.documents.TransitionDocumentStateResponse.meta:type_name -> resources.documents.DocumentMeta
+	77, // 16: services.documents.SetDocumentLegalHoldResponse.meta:type_name -> resources.documents.DocumentMeta
+	78, // 17: services.documents.CreateDocumentRequest.content_type:type_name -> resources.common.content.ContentType
+	79, // 18: services.documents.CreateDocumentRequest.template_data:type_name -> resources.documents.templates.TemplateData
+	80, // 19: services.documents.CreateDocumentRequest.data:type_name -> resources.documents.data.DocumentData
+	81, // 20: services.documents.UpdateDocumentRequest.content:type_name -> resources.common.content.Content
+	78, // 21: services.documents.UpdateDocumentRequest.content_type:type_name -> resources.common.content.ContentType
+	80, // 22: services.documents.UpdateDocumentRequest.data:type_name -> resources.documents.data.DocumentData
+	77, // 23: services.documents.UpdateDocumentRequest.meta:type_name -> resources.documents.DocumentMeta
+	73, // 24: services.documents.UpdateDocumentRequest.access:type_name -> resources.access.Access
+	82, // 25: services.documents.UpdateDocumentRequest.files:type_name -> resources.file.File
+	66, // 26: services.documents.ListDocumentActivityRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	83, // 27: services.documents.ListDocumentActivityRequest.activity_types:type_name -> resources.documents.activity.DocActivityType
+	70, // 28: services.documents.ListDocumentActivityResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	84, // 29: services.documents.ListDocumentActivityResponse.activity:type_name -> resources.documents.activity.DocActivity
+	85, // 30: services.documents.BulkUpdateDocumentsRequest.selector:type_name -> resources.documents.bulk.BulkSelector
+	86, // 31: services.documents.BulkUpdateDocumentsRequest.operation:type_name -> resources.documents.bulk.BulkOperation
+	87, // 32: services.documents.BulkUpdateDocumentsResponse.job:type_name -> resources.documents.bulk.BulkJob
+	87, // 33: services.documents.GetBulkJobResponse.job:type_name -> resources.documents.bulk.BulkJob
+	66, // 34: services.documents.ListBulkJobsRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	70, // 35: services.documents.ListBulkJobsResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	87, // 36: services.documents.ListBulkJobsResponse.jobs:type_name -> resources.documents.bulk.BulkJob
+	66, // 37: services.documents.ListDocumentVersionsRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	70, // 38: services.documents.ListDocumentVersionsResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	88, // 39: services.documents.ListDocumentVersionsResponse.versions:type_name -> resources.documents.versions.DocumentVersion
+	88, // 40: services.documents.GetDocumentVersionResponse.version:type_name -> resources.documents.versions.DocumentVersion
+	89, // 41: services.documents.GetDocumentVersionResponse.diff:type_name -> resources.documents.versions.DocumentVersionDiff
+	72, // 42: services.documents.RestoreDocumentVersionResponse.document:type_name -> resources.documents.Document
+	66, // 43: services.documents.ListDocumentReqsRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	70, // 44: services.documents.ListDocumentReqsResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	90, // 45: services.documents.ListDocumentReqsResponse.requests:type_name -> resources.documents.requests.DocRequest
+	83, // 46: services.documents.CreateDocumentReqRequest.request_type:type_name -> resources.documents.activity.DocActivityType
+	91, // 47: services.documents.CreateDocumentReqRequest.data:type_name -> resources.documents.activity.DocActivityData
+	90, // 48: services.documents.CreateDocumentReqResponse.request:type_name -> resources.documents.requests.DocRequest
+	91, // 49: services.documents.UpdateDocumentReqRequest.data:type_name -> resources.documents.activity.DocActivityData
+	90, // 50: services.documents.UpdateDocumentReqResponse.request:type_name -> resources.documents.requests.DocRequest
+	73, // 51: services.documents.GetDocumentAccessResponse.access:type_name -> resources.access.Access
+	73, // 52: services.documents.SetDocumentAccessRequest.access:type_name -> resources.access.Access
+	66, // 53: services.documents.ListUserDocumentsRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	67, // 54: services.documents.ListUserDocumentsRequest.sort:type_name -> resources.common.database.Sort
+	92, // 55: services.documents.ListUserDocumentsRequest.relations:type_name -> resources.documents.relations.DocRelation
+	70, // 56: services.documents.ListUserDocumentsResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	76, // 57: services.documents.ListUserDocumentsResponse.relations:type_name -> resources.documents.relations.DocumentRelation
+	66, // 58: services.documents.ListDocumentPinsRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	70, // 59: services.documents.ListDocumentPinsResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	71, // 60: services.documents.ListDocumentPinsResponse.documents:type_name -> resources.documents.DocumentShort
+	93, // 61: services.documents.ToggleDocumentPinResponse.pin:type_name -> resources.documents.pins.DocumentPin
+	68, // 62: services.documents.SetDocumentReminderRequest.reminder_time:type_name -> resources.timestamp.Timestamp
+	0,  // 63: services.documents.DocumentsService.ListDocuments:input_type -> services.documents.ListDocumentsRequest
+	2,  // 64: services.documents.DocumentsService.GetDocument:input_type -> services.documents.GetDocumentRequest
+	27, // 65: services.documents.DocumentsService.CreateDocument:input_type -> services.documents.CreateDocumentRequest
+	29, // 66: services.documents.DocumentsService.UpdateDocument:input_type -> services.documents.UpdateDocumentRequest
+	17, // 67: services.documents.DocumentsService.DeleteDocument:input_type -> services.documents.DeleteDocumentRequest
+	19, // 68: services.documents.DocumentsService.ToggleDocument:input_type -> services.documents.ToggleDocumentRequest
+	21, // 69: services.documents.DocumentsService.TransitionDocumentState:input_type -> services.documents.TransitionDocumentStateRequest
+	23, // 70: services.documents.DocumentsService.SetDocumentLegalHold:input_type -> services.documents.SetDocumentLegalHoldRequest
+	25, // 71: services.documents.DocumentsService.ChangeDocumentOwner:input_type -> services.documents.ChangeDocumentOwnerRequest
+	32, // 72: services.documents.DocumentsService.BulkUpdateDocuments:input_type -> services.documents.BulkUpdateDocumentsRequest
+	34, // 73: services.documents.DocumentsService.GetBulkJob:input_type -> services.documents.GetBulkJobRequest
+	36, // 74: services.documents.DocumentsService.ListBulkJobs:input_type -> services.documents.ListBulkJobsRequest
+	4,  // 75: services.documents.DocumentsService.GetDocumentReferences:input_type -> services.documents.GetDocumentReferencesRequest
+	6,  // 76: services.documents.DocumentsService.GetDocumentRelations:input_type -> services.documents.GetDocumentRelationsRequest
+	8,  // 77: services.documents.DocumentsService.AddDocumentReference:input_type -> services.documents.AddDocumentReferenceRequest
+	10, // 78: services.documents.DocumentsService.RemoveDocumentReference:input_type -> services.documents.RemoveDocumentReferenceRequest
+	12, // 79: services.documents.DocumentsService.AddDocumentRelation:input_type -> services.documents.AddDocumentRelationRequest
+	14, // 80: services.documents.DocumentsService.RemoveDocumentRelation:input_type -> services.documents.RemoveDocumentRelationRequest
+	54, // 81: services.documents.DocumentsService.GetDocumentAccess:input_type -> services.documents.GetDocumentAccessRequest
+	56, // 82: services.documents.DocumentsService.SetDocumentAccess:input_type -> services.documents.SetDocumentAccessRequest
+	30, // 83: services.documents.DocumentsService.ListDocumentActivity:input_type -> services.documents.ListDocumentActivityRequest
+	38, // 84: services.documents.DocumentsService.ListDocumentVersions:input_type -> services.documents.ListDocumentVersionsRequest
+	40, // 85: services.documents.DocumentsService.GetDocumentVersion:input_type -> services.documents.GetDocumentVersionRequest
+	42, // 86: services.documents.DocumentsService.RestoreDocumentVersion:input_type -> services.documents.RestoreDocumentVersionRequest
+	44, // 87: services.documents.DocumentsService.GetDocumentPDF:input_type -> services.documents.GetDocumentPDFRequest
+	46, // 88: services.documents.DocumentsService.ListDocumentReqs:input_type -> services.documents.ListDocumentReqsRequest
+	48, // 89: services.documents.DocumentsService.CreateDocumentReq:input_type -> services.documents.CreateDocumentReqRequest
+	50, // 90: services.documents.DocumentsService.UpdateDocumentReq:input_type -> services.documents.UpdateDocumentReqRequest
+	52, // 91: services.documents.DocumentsService.DeleteDocumentReq:input_type -> services.documents.DeleteDocumentReqRequest
+	58, // 92: services.documents.DocumentsService.ListUserDocuments:input_type -> services.documents.ListUserDocumentsRequest
+	60, // 93: services.documents.DocumentsService.ListDocumentPins:input_type -> services.documents.ListDocumentPinsRequest
+	62, // 94: services.documents.DocumentsService.ToggleDocumentPin:input_type -> services.documents.ToggleDocumentPinRequest
+	64, // 95: services.documents.DocumentsService.SetDocumentReminder:input_type -> services.documents.SetDocumentReminderRequest
+	94, // 96: services.documents.DocumentsService.UploadFile:input_type -> resources.file.UploadFileRequest
+	1,  // 97: services.documents.DocumentsService.ListDocuments:output_type -> services.documents.ListDocumentsResponse
+	3,  // 98: services.documents.DocumentsService.GetDocument:output_type -> services.documents.GetDocumentResponse
+	28, // 99: services.documents.DocumentsService.CreateDocument:output_type -> services.documents.CreateDocumentResponse
+	16, // 100: services.documents.DocumentsService.UpdateDocument:output_type -> services.documents.UpdateDocumentResponse
+	18, // 101: services.documents.DocumentsService.DeleteDocument:output_type -> services.documents.DeleteDocumentResponse
+	20, // 102: services.documents.DocumentsService.ToggleDocument:output_type -> services.documents.ToggleDocumentResponse
+	22, // 103: services.documents.DocumentsService.TransitionDocumentState:output_type -> services.documents.TransitionDocumentStateResponse
+	24, // 104: services.documents.DocumentsService.SetDocumentLegalHold:output_type -> services.documents.SetDocumentLegalHoldResponse
+	26, // 105: services.documents.DocumentsService.ChangeDocumentOwner:output_type -> services.documents.ChangeDocumentOwnerResponse
+	33, // 106: services.documents.DocumentsService.BulkUpdateDocuments:output_type -> services.documents.BulkUpdateDocumentsResponse
+	35, // 107: services.documents.DocumentsService.GetBulkJob:output_type -> services.documents.GetBulkJobResponse
+	37, // 108: services.documents.DocumentsService.ListBulkJobs:output_type -> services.documents.ListBulkJobsResponse
+	5,  // 109: services.documents.DocumentsService.GetDocumentReferences:output_type -> services.documents.GetDocumentReferencesResponse
+	7,  // 110: services.documents.DocumentsService.GetDocumentRelations:output_type -> services.documents.GetDocumentRelationsResponse
+	9,  // 111: services.documents.DocumentsService.AddDocumentReference:output_type -> services.documents.AddDocumentReferenceResponse
+	11, // 112: services.documents.DocumentsService.RemoveDocumentReference:output_type -> services.documents.RemoveDocumentReferenceResponse
+	13, // 113: services.documents.DocumentsService.AddDocumentRelation:output_type -> services.documents.AddDocumentRelationResponse
+	15, // 114: services.documents.DocumentsService.RemoveDocumentRelation:output_type -> services.documents.RemoveDocumentRelationResponse
+	55, // 115: services.documents.DocumentsService.GetDocumentAccess:output_type -> services.documents.GetDocumentAccessResponse
+	57, // 116: services.documents.DocumentsService.SetDocumentAccess:output_type -> services.documents.SetDocumentAccessResponse
+	31, // 117: services.documents.DocumentsService.ListDocumentActivity:output_type -> services.documents.ListDocumentActivityResponse
+	39, // 118: services.documents.DocumentsService.ListDocumentVersions:output_type -> services.documents.ListDocumentVersionsResponse
+	41, // 119: services.documents.DocumentsService.GetDocumentVersion:output_type -> services.documents.GetDocumentVersionResponse
+	43, // 120: services.documents.DocumentsService.RestoreDocumentVersion:output_type -> services.documents.RestoreDocumentVersionResponse
+	45, // 121: services.documents.DocumentsService.GetDocumentPDF:output_type -> services.documents.GetDocumentPDFResponse
+	47, // 122: services.documents.DocumentsService.ListDocumentReqs:output_type -> services.documents.ListDocumentReqsResponse
+	49, // 123: services.documents.DocumentsService.CreateDocumentReq:output_type -> services.documents.CreateDocumentReqResponse
+	51, // 124: services.documents.DocumentsService.UpdateDocumentReq:output_type -> services.documents.UpdateDocumentReqResponse
+	53, // 125: services.documents.DocumentsService.DeleteDocumentReq:output_type -> services.documents.DeleteDocumentReqResponse
+	59, // 126: services.documents.DocumentsService.ListUserDocuments:output_type -> services.documents.ListUserDocumentsResponse
+	61, // 127: services.documents.DocumentsService.ListDocumentPins:output_type -> services.documents.ListDocumentPinsResponse
+	63, // 128: services.documents.DocumentsService.ToggleDocumentPin:output_type -> services.documents.ToggleDocumentPinResponse
+	65, // 129: services.documents.DocumentsService.SetDocumentReminder:output_type -> services.documents.SetDocumentReminderResponse
+	95, // 130: services.documents.DocumentsService.UploadFile:output_type -> resources.file.UploadFileResponse
+	97, // [97:131] is the sub-list for method output_type
+	63, // [63:97] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_services_documents_documents_proto_init() }
@@ -4354,24 +5996,28 @@ func file_services_documents_documents_proto_init() {
 	}
 	file_services_documents_documents_proto_msgTypes[0].OneofWrappers = []any{}
 	file_services_documents_documents_proto_msgTypes[2].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[8].OneofWrappers = []any{}
 	file_services_documents_documents_proto_msgTypes[17].OneofWrappers = []any{}
 	file_services_documents_documents_proto_msgTypes[21].OneofWrappers = []any{}
 	file_services_documents_documents_proto_msgTypes[23].OneofWrappers = []any{}
 	file_services_documents_documents_proto_msgTypes[25].OneofWrappers = []any{}
-	file_services_documents_documents_proto_msgTypes[30].OneofWrappers = []any{}
-	file_services_documents_documents_proto_msgTypes[32].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[27].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[29].OneofWrappers = []any{}
 	file_services_documents_documents_proto_msgTypes[40].OneofWrappers = []any{}
-	file_services_documents_documents_proto_msgTypes[42].OneofWrappers = []any{}
-	file_services_documents_documents_proto_msgTypes[44].OneofWrappers = []any{}
-	file_services_documents_documents_proto_msgTypes[45].OneofWrappers = []any{}
-	file_services_documents_documents_proto_msgTypes[46].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[48].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[50].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[58].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[60].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[62].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[63].OneofWrappers = []any{}
+	file_services_documents_documents_proto_msgTypes[64].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_documents_documents_proto_rawDesc), len(file_services_documents_documents_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   48,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
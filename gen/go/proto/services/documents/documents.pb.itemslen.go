@@ -3,6 +3,14 @@
 
 package documents
 
+// ItemsLen returns the length of Jobs.
+func (m *ListBulkJobsResponse) ItemsLen() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.GetJobs())
+}
+
 // ItemsLen returns the length of Activity.
 func (m *ListDocumentActivityResponse) ItemsLen() int {
 	if m == nil {
@@ -27,6 +35,14 @@ func (m *ListDocumentReqsResponse) ItemsLen() int {
 	return len(m.GetRequests())
 }
 
+// ItemsLen returns the length of Versions.
+func (m *ListDocumentVersionsResponse) ItemsLen() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.GetVersions())
+}
+
 // ItemsLen returns the length of Documents.
 func (m *ListDocumentsResponse) ItemsLen() int {
 	if m == nil {
@@ -45,6 +45,53 @@ func (m *AddDocumentRelationRequest) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkUpdateDocumentsRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Operation
+	if m.Operation != nil {
+		if v, ok := any(m.GetOperation()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Selector
+	if m.Selector != nil {
+		if v, ok := any(m.GetSelector()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *BulkUpdateDocumentsResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Job
+	if m.Job != nil {
+		if v, ok := any(m.GetJob()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *CreateDocumentReqRequest) Sanitize() error {
@@ -95,6 +142,15 @@ func (m *CreateDocumentRequest) Sanitize() error {
 		return nil
 	}
 
+	// Field: Data
+	if m.Data != nil {
+		if v, ok := any(m.GetData()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: TemplateData
 	if m.TemplateData != nil {
 		if v, ok := any(m.GetTemplateData()).(interface{ Sanitize() error }); ok {
@@ -122,6 +178,25 @@ func (m *DeleteDocumentRequest) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetBulkJobResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Job
+	if m.Job != nil {
+		if v, ok := any(m.GetJob()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *GetDocumentAccessResponse) Sanitize() error {
@@ -141,6 +216,21 @@ func (m *GetDocumentAccessResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetDocumentPDFResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Data
+
+	// Field: FileName
+	m.FileName = htmlsanitizer.SanitizeAndUnescape(m.FileName)
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *GetDocumentReferencesResponse) Sanitize() error {
@@ -148,6 +238,18 @@ func (m *GetDocumentReferencesResponse) Sanitize() error {
 		return nil
 	}
 
+	// Field: DispatchTimelines
+	for idx, item := range m.DispatchTimelines {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// Field: References
 	for idx, item := range m.References {
 		_, _ = idx, item
@@ -213,6 +315,84 @@ func (m *GetDocumentResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetDocumentVersionResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Diff
+	if m.Diff != nil {
+		if v, ok := any(m.GetDiff()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Version
+	if m.Version != nil {
+		if v, ok := any(m.GetVersion()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListBulkJobsRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListBulkJobsResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Jobs
+	for idx, item := range m.Jobs {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ListDocumentActivityRequest) Sanitize() error {
@@ -369,6 +549,56 @@ func (m *ListDocumentReqsResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListDocumentVersionsRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListDocumentVersionsResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Versions
+	for idx, item := range m.Versions {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ListDocumentsRequest) Sanitize() error {
@@ -376,6 +606,18 @@ func (m *ListDocumentsRequest) Sanitize() error {
 		return nil
 	}
 
+	// Field: FormFields
+	for idx, item := range m.FormFields {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// Field: From
 	if m.From != nil {
 		if v, ok := any(m.GetFrom()).(interface{ Sanitize() error }); ok {
@@ -516,6 +758,25 @@ func (m *ListUserDocumentsResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *RestoreDocumentVersionResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Document
+	if m.Document != nil {
+		if v, ok := any(m.GetDocument()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *SetDocumentAccessRequest) Sanitize() error {
@@ -535,6 +796,40 @@ func (m *SetDocumentAccessRequest) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *SetDocumentLegalHoldRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Reason
+	if m.Reason != nil {
+		*m.Reason = htmlsanitizer.SanitizeAndUnescape(*m.Reason)
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *SetDocumentLegalHoldResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Meta
+	if m.Meta != nil {
+		if v, ok := any(m.GetMeta()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *SetDocumentReminderRequest) Sanitize() error {
@@ -578,6 +873,43 @@ func (m *ToggleDocumentPinResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *TransitionDocumentStateRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Reason
+	if m.Reason != nil {
+		*m.Reason = htmlsanitizer.SanitizeAndUnescape(*m.Reason)
+	}
+
+	// Field: ToState
+	m.ToState = htmlsanitizer.SanitizeAndUnescape(m.ToState)
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *TransitionDocumentStateResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Meta
+	if m.Meta != nil {
+		if v, ok := any(m.GetMeta()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *UpdateDocumentReqRequest) Sanitize() error {
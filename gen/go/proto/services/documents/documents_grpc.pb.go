@@ -26,7 +26,12 @@ const (
 	DocumentsService_UpdateDocument_FullMethodName          = "/services.documents.DocumentsService/UpdateDocument"
 	DocumentsService_DeleteDocument_FullMethodName          = "/services.documents.DocumentsService/DeleteDocument"
 	DocumentsService_ToggleDocument_FullMethodName          = "/services.documents.DocumentsService/ToggleDocument"
+	DocumentsService_TransitionDocumentState_FullMethodName = "/services.documents.DocumentsService/TransitionDocumentState"
+	DocumentsService_SetDocumentLegalHold_FullMethodName    = "/services.documents.DocumentsService/SetDocumentLegalHold"
 	DocumentsService_ChangeDocumentOwner_FullMethodName     = "/services.documents.DocumentsService/ChangeDocumentOwner"
+	DocumentsService_BulkUpdateDocuments_FullMethodName     = "/services.documents.DocumentsService/BulkUpdateDocuments"
+	DocumentsService_GetBulkJob_FullMethodName              = "/services.documents.DocumentsService/GetBulkJob"
+	DocumentsService_ListBulkJobs_FullMethodName            = "/services.documents.DocumentsService/ListBulkJobs"
 	DocumentsService_GetDocumentReferences_FullMethodName   = "/services.documents.DocumentsService/GetDocumentReferences"
 	DocumentsService_GetDocumentRelations_FullMethodName    = "/services.documents.DocumentsService/GetDocumentRelations"
 	DocumentsService_AddDocumentReference_FullMethodName    = "/services.documents.DocumentsService/AddDocumentReference"
@@ -36,6 +41,10 @@ const (
 	DocumentsService_GetDocumentAccess_FullMethodName       = "/services.documents.DocumentsService/GetDocumentAccess"
 	DocumentsService_SetDocumentAccess_FullMethodName       = "/services.documents.DocumentsService/SetDocumentAccess"
 	DocumentsService_ListDocumentActivity_FullMethodName    = "/services.documents.DocumentsService/ListDocumentActivity"
+	DocumentsService_ListDocumentVersions_FullMethodName    = "/services.documents.DocumentsService/ListDocumentVersions"
+	DocumentsService_GetDocumentVersion_FullMethodName      = "/services.documents.DocumentsService/GetDocumentVersion"
+	DocumentsService_RestoreDocumentVersion_FullMethodName  = "/services.documents.DocumentsService/RestoreDocumentVersion"
+	DocumentsService_GetDocumentPDF_FullMethodName          = "/services.documents.DocumentsService/GetDocumentPDF"
 	DocumentsService_ListDocumentReqs_FullMethodName        = "/services.documents.DocumentsService/ListDocumentReqs"
 	DocumentsService_CreateDocumentReq_FullMethodName       = "/services.documents.DocumentsService/CreateDocumentReq"
 	DocumentsService_UpdateDocumentReq_FullMethodName       = "/services.documents.DocumentsService/UpdateDocumentReq"
@@ -57,7 +66,12 @@ type DocumentsServiceClient interface {
 	UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*UpdateDocumentResponse, error)
 	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
 	ToggleDocument(ctx context.Context, in *ToggleDocumentRequest, opts ...grpc.CallOption) (*ToggleDocumentResponse, error)
+	TransitionDocumentState(ctx context.Context, in *TransitionDocumentStateRequest, opts ...grpc.CallOption) (*TransitionDocumentStateResponse, error)
+	SetDocumentLegalHold(ctx context.Context, in *SetDocumentLegalHoldRequest, opts ...grpc.CallOption) (*SetDocumentLegalHoldResponse, error)
 	ChangeDocumentOwner(ctx context.Context, in *ChangeDocumentOwnerRequest, opts ...grpc.CallOption) (*ChangeDocumentOwnerResponse, error)
+	BulkUpdateDocuments(ctx context.Context, in *BulkUpdateDocumentsRequest, opts ...grpc.CallOption) (*BulkUpdateDocumentsResponse, error)
+	GetBulkJob(ctx context.Context, in *GetBulkJobRequest, opts ...grpc.CallOption) (*GetBulkJobResponse, error)
+	ListBulkJobs(ctx context.Context, in *ListBulkJobsRequest, opts ...grpc.CallOption) (*ListBulkJobsResponse, error)
 	GetDocumentReferences(ctx context.Context, in *GetDocumentReferencesRequest, opts ...grpc.CallOption) (*GetDocumentReferencesResponse, error)
 	GetDocumentRelations(ctx context.Context, in *GetDocumentRelationsRequest, opts ...grpc.CallOption) (*GetDocumentRelationsResponse, error)
 	AddDocumentReference(ctx context.Context, in *AddDocumentReferenceRequest, opts ...grpc.CallOption) (*AddDocumentReferenceResponse, error)
@@ -67,6 +81,10 @@ type DocumentsServiceClient interface {
 	GetDocumentAccess(ctx context.Context, in *GetDocumentAccessRequest, opts ...grpc.CallOption) (*GetDocumentAccessResponse, error)
 	SetDocumentAccess(ctx context.Context, in *SetDocumentAccessRequest, opts ...grpc.CallOption) (*SetDocumentAccessResponse, error)
 	ListDocumentActivity(ctx context.Context, in *ListDocumentActivityRequest, opts ...grpc.CallOption) (*ListDocumentActivityResponse, error)
+	ListDocumentVersions(ctx context.Context, in *ListDocumentVersionsRequest, opts ...grpc.CallOption) (*ListDocumentVersionsResponse, error)
+	GetDocumentVersion(ctx context.Context, in *GetDocumentVersionRequest, opts ...grpc.CallOption) (*GetDocumentVersionResponse, error)
+	RestoreDocumentVersion(ctx context.Context, in *RestoreDocumentVersionRequest, opts ...grpc.CallOption) (*RestoreDocumentVersionResponse, error)
+	GetDocumentPDF(ctx context.Context, in *GetDocumentPDFRequest, opts ...grpc.CallOption) (*GetDocumentPDFResponse, error)
 	ListDocumentReqs(ctx context.Context, in *ListDocumentReqsRequest, opts ...grpc.CallOption) (*ListDocumentReqsResponse, error)
 	CreateDocumentReq(ctx context.Context, in *CreateDocumentReqRequest, opts ...grpc.CallOption) (*CreateDocumentReqResponse, error)
 	UpdateDocumentReq(ctx context.Context, in *UpdateDocumentReqRequest, opts ...grpc.CallOption) (*UpdateDocumentReqResponse, error)
@@ -146,6 +164,26 @@ func (c *documentsServiceClient) ToggleDocument(ctx context.Context, in *ToggleD
 	return out, nil
 }
 
+func (c *documentsServiceClient) TransitionDocumentState(ctx context.Context, in *TransitionDocumentStateRequest, opts ...grpc.CallOption) (*TransitionDocumentStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionDocumentStateResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_TransitionDocumentState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentsServiceClient) SetDocumentLegalHold(ctx context.Context, in *SetDocumentLegalHoldRequest, opts ...grpc.CallOption) (*SetDocumentLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDocumentLegalHoldResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_SetDocumentLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentsServiceClient) ChangeDocumentOwner(ctx context.Context, in *ChangeDocumentOwnerRequest, opts ...grpc.CallOption) (*ChangeDocumentOwnerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ChangeDocumentOwnerResponse)
@@ -156,6 +194,36 @@ func (c *documentsServiceClient) ChangeDocumentOwner(ctx context.Context, in *Ch
 	return out, nil
 }
 
+func (c *documentsServiceClient) BulkUpdateDocuments(ctx context.Context, in *BulkUpdateDocumentsRequest, opts ...grpc.CallOption) (*BulkUpdateDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateDocumentsResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_BulkUpdateDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentsServiceClient) GetBulkJob(ctx context.Context, in *GetBulkJobRequest, opts ...grpc.CallOption) (*GetBulkJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBulkJobResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_GetBulkJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentsServiceClient) ListBulkJobs(ctx context.Context, in *ListBulkJobsRequest, opts ...grpc.CallOption) (*ListBulkJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBulkJobsResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_ListBulkJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentsServiceClient) GetDocumentReferences(ctx context.Context, in *GetDocumentReferencesRequest, opts ...grpc.CallOption) (*GetDocumentReferencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDocumentReferencesResponse)
@@ -246,6 +314,46 @@ func (c *documentsServiceClient) ListDocumentActivity(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *documentsServiceClient) ListDocumentVersions(ctx context.Context, in *ListDocumentVersionsRequest, opts ...grpc.CallOption) (*ListDocumentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_ListDocumentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentsServiceClient) GetDocumentVersion(ctx context.Context, in *GetDocumentVersionRequest, opts ...grpc.CallOption) (*GetDocumentVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentVersionResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_GetDocumentVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentsServiceClient) RestoreDocumentVersion(ctx context.Context, in *RestoreDocumentVersionRequest, opts ...grpc.CallOption) (*RestoreDocumentVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreDocumentVersionResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_RestoreDocumentVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentsServiceClient) GetDocumentPDF(ctx context.Context, in *GetDocumentPDFRequest, opts ...grpc.CallOption) (*GetDocumentPDFResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentPDFResponse)
+	err := c.cc.Invoke(ctx, DocumentsService_GetDocumentPDF_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentsServiceClient) ListDocumentReqs(ctx context.Context, in *ListDocumentReqsRequest, opts ...grpc.CallOption) (*ListDocumentReqsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListDocumentReqsResponse)
@@ -349,7 +457,12 @@ type DocumentsServiceServer interface {
 	UpdateDocument(context.Context, *UpdateDocumentRequest) (*UpdateDocumentResponse, error)
 	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
 	ToggleDocument(context.Context, *ToggleDocumentRequest) (*ToggleDocumentResponse, error)
+	TransitionDocumentState(context.Context, *TransitionDocumentStateRequest) (*TransitionDocumentStateResponse, error)
+	SetDocumentLegalHold(context.Context, *SetDocumentLegalHoldRequest) (*SetDocumentLegalHoldResponse, error)
 	ChangeDocumentOwner(context.Context, *ChangeDocumentOwnerRequest) (*ChangeDocumentOwnerResponse, error)
+	BulkUpdateDocuments(context.Context, *BulkUpdateDocumentsRequest) (*BulkUpdateDocumentsResponse, error)
+	GetBulkJob(context.Context, *GetBulkJobRequest) (*GetBulkJobResponse, error)
+	ListBulkJobs(context.Context, *ListBulkJobsRequest) (*ListBulkJobsResponse, error)
 	GetDocumentReferences(context.Context, *GetDocumentReferencesRequest) (*GetDocumentReferencesResponse, error)
 	GetDocumentRelations(context.Context, *GetDocumentRelationsRequest) (*GetDocumentRelationsResponse, error)
 	AddDocumentReference(context.Context, *AddDocumentReferenceRequest) (*AddDocumentReferenceResponse, error)
@@ -359,6 +472,10 @@ type DocumentsServiceServer interface {
 	GetDocumentAccess(context.Context, *GetDocumentAccessRequest) (*GetDocumentAccessResponse, error)
 	SetDocumentAccess(context.Context, *SetDocumentAccessRequest) (*SetDocumentAccessResponse, error)
 	ListDocumentActivity(context.Context, *ListDocumentActivityRequest) (*ListDocumentActivityResponse, error)
+	ListDocumentVersions(context.Context, *ListDocumentVersionsRequest) (*ListDocumentVersionsResponse, error)
+	GetDocumentVersion(context.Context, *GetDocumentVersionRequest) (*GetDocumentVersionResponse, error)
+	RestoreDocumentVersion(context.Context, *RestoreDocumentVersionRequest) (*RestoreDocumentVersionResponse, error)
+	GetDocumentPDF(context.Context, *GetDocumentPDFRequest) (*GetDocumentPDFResponse, error)
 	ListDocumentReqs(context.Context, *ListDocumentReqsRequest) (*ListDocumentReqsResponse, error)
 	CreateDocumentReq(context.Context, *CreateDocumentReqRequest) (*CreateDocumentReqResponse, error)
 	UpdateDocumentReq(context.Context, *UpdateDocumentReqRequest) (*UpdateDocumentReqResponse, error)
@@ -396,9 +513,24 @@ func (UnimplementedDocumentsServiceServer) DeleteDocument(context.Context, *Dele
 func (UnimplementedDocumentsServiceServer) ToggleDocument(context.Context, *ToggleDocumentRequest) (*ToggleDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleDocument not implemented")
 }
+func (UnimplementedDocumentsServiceServer) TransitionDocumentState(context.Context, *TransitionDocumentStateRequest) (*TransitionDocumentStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionDocumentState not implemented")
+}
+func (UnimplementedDocumentsServiceServer) SetDocumentLegalHold(context.Context, *SetDocumentLegalHoldRequest) (*SetDocumentLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDocumentLegalHold not implemented")
+}
 func (UnimplementedDocumentsServiceServer) ChangeDocumentOwner(context.Context, *ChangeDocumentOwnerRequest) (*ChangeDocumentOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeDocumentOwner not implemented")
 }
+func (UnimplementedDocumentsServiceServer) BulkUpdateDocuments(context.Context, *BulkUpdateDocumentsRequest) (*BulkUpdateDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateDocuments not implemented")
+}
+func (UnimplementedDocumentsServiceServer) GetBulkJob(context.Context, *GetBulkJobRequest) (*GetBulkJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkJob not implemented")
+}
+func (UnimplementedDocumentsServiceServer) ListBulkJobs(context.Context, *ListBulkJobsRequest) (*ListBulkJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBulkJobs not implemented")
+}
 func (UnimplementedDocumentsServiceServer) GetDocumentReferences(context.Context, *GetDocumentReferencesRequest) (*GetDocumentReferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentReferences not implemented")
 }
@@ -426,6 +558,18 @@ func (UnimplementedDocumentsServiceServer) SetDocumentAccess(context.Context, *S
 func (UnimplementedDocumentsServiceServer) ListDocumentActivity(context.Context, *ListDocumentActivityRequest) (*ListDocumentActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentActivity not implemented")
 }
+func (UnimplementedDocumentsServiceServer) ListDocumentVersions(context.Context, *ListDocumentVersionsRequest) (*ListDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentVersions not implemented")
+}
+func (UnimplementedDocumentsServiceServer) GetDocumentVersion(context.Context, *GetDocumentVersionRequest) (*GetDocumentVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentVersion not implemented")
+}
+func (UnimplementedDocumentsServiceServer) RestoreDocumentVersion(context.Context, *RestoreDocumentVersionRequest) (*RestoreDocumentVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDocumentVersion not implemented")
+}
+func (UnimplementedDocumentsServiceServer) GetDocumentPDF(context.Context, *GetDocumentPDFRequest) (*GetDocumentPDFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentPDF not implemented")
+}
 func (UnimplementedDocumentsServiceServer) ListDocumentReqs(context.Context, *ListDocumentReqsRequest) (*ListDocumentReqsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentReqs not implemented")
 }
@@ -582,6 +726,42 @@ func _DocumentsService_ToggleDocument_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentsService_TransitionDocumentState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionDocumentStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).TransitionDocumentState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_TransitionDocumentState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).TransitionDocumentState(ctx, req.(*TransitionDocumentStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentsService_SetDocumentLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDocumentLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).SetDocumentLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_SetDocumentLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).SetDocumentLegalHold(ctx, req.(*SetDocumentLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentsService_ChangeDocumentOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangeDocumentOwnerRequest)
 	if err := dec(in); err != nil {
@@ -600,6 +780,60 @@ func _DocumentsService_ChangeDocumentOwner_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentsService_BulkUpdateDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).BulkUpdateDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_BulkUpdateDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).BulkUpdateDocuments(ctx, req.(*BulkUpdateDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentsService_GetBulkJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).GetBulkJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_GetBulkJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).GetBulkJob(ctx, req.(*GetBulkJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentsService_ListBulkJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBulkJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).ListBulkJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_ListBulkJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).ListBulkJobs(ctx, req.(*ListBulkJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentsService_GetDocumentReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDocumentReferencesRequest)
 	if err := dec(in); err != nil {
@@ -762,6 +996,78 @@ func _DocumentsService_ListDocumentActivity_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentsService_ListDocumentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).ListDocumentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_ListDocumentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).ListDocumentVersions(ctx, req.(*ListDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentsService_GetDocumentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).GetDocumentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_GetDocumentVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).GetDocumentVersion(ctx, req.(*GetDocumentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentsService_RestoreDocumentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDocumentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).RestoreDocumentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_RestoreDocumentVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).RestoreDocumentVersion(ctx, req.(*RestoreDocumentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentsService_GetDocumentPDF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentPDFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentsServiceServer).GetDocumentPDF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentsService_GetDocumentPDF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentsServiceServer).GetDocumentPDF(ctx, req.(*GetDocumentPDFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentsService_ListDocumentReqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListDocumentReqsRequest)
 	if err := dec(in); err != nil {
@@ -944,10 +1250,30 @@ var DocumentsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleDocument",
 			Handler:    _DocumentsService_ToggleDocument_Handler,
 		},
+		{
+			MethodName: "TransitionDocumentState",
+			Handler:    _DocumentsService_TransitionDocumentState_Handler,
+		},
+		{
+			MethodName: "SetDocumentLegalHold",
+			Handler:    _DocumentsService_SetDocumentLegalHold_Handler,
+		},
 		{
 			MethodName: "ChangeDocumentOwner",
 			Handler:    _DocumentsService_ChangeDocumentOwner_Handler,
 		},
+		{
+			MethodName: "BulkUpdateDocuments",
+			Handler:    _DocumentsService_BulkUpdateDocuments_Handler,
+		},
+		{
+			MethodName: "GetBulkJob",
+			Handler:    _DocumentsService_GetBulkJob_Handler,
+		},
+		{
+			MethodName: "ListBulkJobs",
+			Handler:    _DocumentsService_ListBulkJobs_Handler,
+		},
 		{
 			MethodName: "GetDocumentReferences",
 			Handler:    _DocumentsService_GetDocumentReferences_Handler,
@@ -984,6 +1310,22 @@ var DocumentsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDocumentActivity",
 			Handler:    _DocumentsService_ListDocumentActivity_Handler,
 		},
+		{
+			MethodName: "ListDocumentVersions",
+			Handler:    _DocumentsService_ListDocumentVersions_Handler,
+		},
+		{
+			MethodName: "GetDocumentVersion",
+			Handler:    _DocumentsService_GetDocumentVersion_Handler,
+		},
+		{
+			MethodName: "RestoreDocumentVersion",
+			Handler:    _DocumentsService_RestoreDocumentVersion_Handler,
+		},
+		{
+			MethodName: "GetDocumentPDF",
+			Handler:    _DocumentsService_GetDocumentPDF_Handler,
+		},
 		{
 			MethodName: "ListDocumentReqs",
 			Handler:    _DocumentsService_ListDocumentReqs_Handler,
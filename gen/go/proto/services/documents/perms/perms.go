@@ -29,10 +29,11 @@ const (
 	TemplatesServicePerm  perms.Service = "TemplatesService"
 
 	// Service: documents.ApprovalService
-	ApprovalServiceDeleteApprovalTasksPerm  perms.Name = "DeleteApprovalTasks"
-	ApprovalServiceRevokeApprovalPerm       perms.Name = "RevokeApproval"
-	ApprovalServiceUpsertApprovalPolicyPerm perms.Name = "UpsertApprovalPolicy"
-	ApprovalServiceUpsertApprovalTasksPerm  perms.Name = "UpsertApprovalTasks"
+	ApprovalServiceCreateOrUpdateApprovalPolicyTemplatePerm perms.Name = "CreateOrUpdateApprovalPolicyTemplate"
+	ApprovalServiceDeleteApprovalTasksPerm                  perms.Name = "DeleteApprovalTasks"
+	ApprovalServiceRevokeApprovalPerm                       perms.Name = "RevokeApproval"
+	ApprovalServiceUpsertApprovalPolicyPerm                 perms.Name = "UpsertApprovalPolicy"
+	ApprovalServiceUpsertApprovalTasksPerm                  perms.Name = "UpsertApprovalTasks"
 
 	// Service: documents.CategoriesService
 	CategoriesServiceCreateOrUpdateCategoryPerm  perms.Name = "CreateOrUpdateCategory"
@@ -47,6 +48,7 @@ const (
 	// Service: documents.DocumentsService
 	DocumentsServiceAddDocumentReferencePerm           perms.Name = "AddDocumentReference"
 	DocumentsServiceAddDocumentRelationPerm            perms.Name = "AddDocumentRelation"
+	DocumentsServiceBulkUpdateDocumentsPerm            perms.Name = "BulkUpdateDocuments"
 	DocumentsServiceChangeDocumentOwnerPerm            perms.Name = "ChangeDocumentOwner"
 	DocumentsServiceChangeDocumentOwnerAccessPermField perms.Key  = "Access"
 	DocumentsServiceCreateDocumentReqPerm              perms.Name = "CreateDocumentReq"
@@ -58,6 +60,7 @@ const (
 	DocumentsServiceListDocumentReqsPerm               perms.Name = "ListDocumentReqs"
 	DocumentsServiceListDocumentsPerm                  perms.Name = "ListDocuments"
 	DocumentsServiceListUserDocumentsPerm              perms.Name = "ListUserDocuments"
+	DocumentsServiceSetDocumentLegalHoldPerm           perms.Name = "SetDocumentLegalHold"
 	DocumentsServiceSetDocumentReminderPerm            perms.Name = "SetDocumentReminder"
 	DocumentsServiceToggleDocumentPerm                 perms.Name = "ToggleDocument"
 	DocumentsServiceToggleDocumentAccessPermField      perms.Key  = "Access"
@@ -151,10 +154,14 @@ const (
 )
 
 type ApprovalServicePerms struct {
-	DeleteApprovalTasks  ApprovalServiceDeleteApprovalTasksPermRef
-	RevokeApproval       ApprovalServiceRevokeApprovalPermRef
-	UpsertApprovalPolicy ApprovalServiceUpsertApprovalPolicyPermRef
-	UpsertApprovalTasks  ApprovalServiceUpsertApprovalTasksPermRef
+	CreateOrUpdateApprovalPolicyTemplate ApprovalServiceCreateOrUpdateApprovalPolicyTemplatePermRef
+	DeleteApprovalTasks                  ApprovalServiceDeleteApprovalTasksPermRef
+	RevokeApproval                       ApprovalServiceRevokeApprovalPermRef
+	UpsertApprovalPolicy                 ApprovalServiceUpsertApprovalPolicyPermRef
+	UpsertApprovalTasks                  ApprovalServiceUpsertApprovalTasksPermRef
+}
+type ApprovalServiceCreateOrUpdateApprovalPolicyTemplatePermRef struct {
+	Perm perms.PermissionRef
 }
 type ApprovalServiceDeleteApprovalTasksPermRef struct {
 	Perm perms.PermissionRef
@@ -170,6 +177,9 @@ type ApprovalServiceUpsertApprovalTasksPermRef struct {
 }
 
 var ApprovalService = ApprovalServicePerms{
+	CreateOrUpdateApprovalPolicyTemplate: ApprovalServiceCreateOrUpdateApprovalPolicyTemplatePermRef{
+		Perm: perms.NewPermissionRef(Namespace, ApprovalServicePerm, ApprovalServiceCreateOrUpdateApprovalPolicyTemplatePerm),
+	},
 	DeleteApprovalTasks: ApprovalServiceDeleteApprovalTasksPermRef{
 		Perm: perms.NewPermissionRef(Namespace, ApprovalServicePerm, ApprovalServiceDeleteApprovalTasksPerm),
 	},
@@ -242,6 +252,7 @@ var CommentsService = CommentsServicePerms{
 type DocumentsServicePerms struct {
 	AddDocumentReference DocumentsServiceAddDocumentReferencePermRef
 	AddDocumentRelation  DocumentsServiceAddDocumentRelationPermRef
+	BulkUpdateDocuments  DocumentsServiceBulkUpdateDocumentsPermRef
 	ChangeDocumentOwner  DocumentsServiceChangeDocumentOwnerPermRef
 	CreateDocumentReq    DocumentsServiceCreateDocumentReqPermRef
 	DeleteDocument       DocumentsServiceDeleteDocumentPermRef
@@ -250,6 +261,7 @@ type DocumentsServicePerms struct {
 	ListDocumentReqs     DocumentsServiceListDocumentReqsPermRef
 	ListDocuments        DocumentsServiceListDocumentsPermRef
 	ListUserDocuments    DocumentsServiceListUserDocumentsPermRef
+	SetDocumentLegalHold DocumentsServiceSetDocumentLegalHoldPermRef
 	SetDocumentReminder  DocumentsServiceSetDocumentReminderPermRef
 	ToggleDocument       DocumentsServiceToggleDocumentPermRef
 	ToggleDocumentPin    DocumentsServiceToggleDocumentPinPermRef
@@ -261,6 +273,9 @@ type DocumentsServiceAddDocumentReferencePermRef struct {
 type DocumentsServiceAddDocumentRelationPermRef struct {
 	Perm perms.PermissionRef
 }
+type DocumentsServiceBulkUpdateDocumentsPermRef struct {
+	Perm perms.PermissionRef
+}
 type DocumentsServiceChangeDocumentOwnerPermRef struct {
 	Perm        perms.PermissionRef
 	Access      perms.AttrRef[perms.StringListAttr]
@@ -291,6 +306,9 @@ type DocumentsServiceListDocumentsPermRef struct {
 type DocumentsServiceListUserDocumentsPermRef struct {
 	Perm perms.PermissionRef
 }
+type DocumentsServiceSetDocumentLegalHoldPermRef struct {
+	Perm perms.PermissionRef
+}
 type DocumentsServiceSetDocumentReminderPermRef struct {
 	Perm perms.PermissionRef
 }
@@ -317,6 +335,9 @@ var DocumentsService = DocumentsServicePerms{
 	AddDocumentRelation: DocumentsServiceAddDocumentRelationPermRef{
 		Perm: perms.NewPermissionRef(Namespace, DocumentsServicePerm, DocumentsServiceAddDocumentRelationPerm),
 	},
+	BulkUpdateDocuments: DocumentsServiceBulkUpdateDocumentsPermRef{
+		Perm: perms.NewPermissionRef(Namespace, DocumentsServicePerm, DocumentsServiceBulkUpdateDocumentsPerm),
+	},
 	ChangeDocumentOwner: DocumentsServiceChangeDocumentOwnerPermRef{
 		Perm: perms.NewPermissionRef(Namespace, DocumentsServicePerm, DocumentsServiceChangeDocumentOwnerPerm),
 		Access: perms.NewStringListAttrRef(
@@ -365,6 +386,9 @@ var DocumentsService = DocumentsServicePerms{
 	ListUserDocuments: DocumentsServiceListUserDocumentsPermRef{
 		Perm: perms.NewPermissionRef(Namespace, DocumentsServicePerm, DocumentsServiceListUserDocumentsPerm),
 	},
+	SetDocumentLegalHold: DocumentsServiceSetDocumentLegalHoldPermRef{
+		Perm: perms.NewPermissionRef(Namespace, DocumentsServicePerm, DocumentsServiceSetDocumentLegalHoldPerm),
+	},
 	SetDocumentReminder: DocumentsServiceSetDocumentReminderPermRef{
 		Perm: perms.NewPermissionRef(Namespace, DocumentsServicePerm, DocumentsServiceSetDocumentReminderPerm),
 	},
@@ -22,6 +22,14 @@ func init() {
 		// Namespace: documents
 
 		// Service: documents.ApprovalService
+		{
+			Namespace: permkeys.Namespace,
+			Service:   permkeys.ApprovalServicePerm,
+			Name:      permkeys.ApprovalServiceCreateOrUpdateApprovalPolicyTemplatePerm,
+			Attrs:     []perms.Attr{},
+			Order:     5200,
+			Icon:      "i-mdi-approval",
+		},
 		{
 			Namespace: permkeys.Namespace,
 			Service:   permkeys.ApprovalServicePerm,
@@ -119,6 +127,14 @@ func init() {
 			Order:     5000,
 			Icon:      "i-mdi-file-document-box-multiple-outline",
 		},
+		{
+			Namespace: permkeys.Namespace,
+			Service:   permkeys.DocumentsServicePerm,
+			Name:      permkeys.DocumentsServiceBulkUpdateDocumentsPerm,
+			Attrs:     []perms.Attr{},
+			Order:     5000,
+			Icon:      "i-mdi-file-document-box-multiple-outline",
+		},
 		{
 			Namespace: permkeys.Namespace,
 			Service:   permkeys.DocumentsServicePerm,
@@ -201,6 +217,14 @@ func init() {
 			Order:     5000,
 			Icon:      "i-mdi-file-document-box-multiple-outline",
 		},
+		{
+			Namespace: permkeys.Namespace,
+			Service:   permkeys.DocumentsServicePerm,
+			Name:      permkeys.DocumentsServiceSetDocumentLegalHoldPerm,
+			Attrs:     []perms.Attr{},
+			Order:     5000,
+			Icon:      "i-mdi-file-document-box-multiple-outline",
+		},
 		{
 			Namespace: permkeys.Namespace,
 			Service:   permkeys.DocumentsServicePerm,
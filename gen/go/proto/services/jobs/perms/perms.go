@@ -54,9 +54,10 @@ const (
 	StatsServiceGetStatsPerm perms.Name = "GetStats"
 
 	// Service: jobs.TimeclockService
-	TimeclockServiceListInactiveEmployeesPerm    perms.Name = "ListInactiveEmployees"
-	TimeclockServiceListTimeclockPerm            perms.Name = "ListTimeclock"
-	TimeclockServiceListTimeclockAccessPermField perms.Key  = "Access"
+	TimeclockServiceListInactiveEmployeesPerm     perms.Name = "ListInactiveEmployees"
+	TimeclockServiceListTimeclockPerm             perms.Name = "ListTimeclock"
+	TimeclockServiceListTimeclockAccessPermField  perms.Key  = "Access"
+	TimeclockServiceReviewTimeclockCorrectionPerm perms.Name = "ReviewTimeclockCorrection"
 )
 
 type ColleaguesServiceGetColleagueAccessPermValue string
@@ -78,14 +79,15 @@ const (
 type ColleaguesServiceListColleagueActivityTypesPermValue string
 
 const (
-	ColleaguesServiceListColleagueActivityTypesPermValueHIRED       ColleaguesServiceListColleagueActivityTypesPermValue = "HIRED"
-	ColleaguesServiceListColleagueActivityTypesPermValueFIRED       ColleaguesServiceListColleagueActivityTypesPermValue = "FIRED"
-	ColleaguesServiceListColleagueActivityTypesPermValuePROMOTED    ColleaguesServiceListColleagueActivityTypesPermValue = "PROMOTED"
-	ColleaguesServiceListColleagueActivityTypesPermValueDEMOTED     ColleaguesServiceListColleagueActivityTypesPermValue = "DEMOTED"
-	ColleaguesServiceListColleagueActivityTypesPermValueABSENCEDATE ColleaguesServiceListColleagueActivityTypesPermValue = "ABSENCE_DATE"
-	ColleaguesServiceListColleagueActivityTypesPermValueNOTE        ColleaguesServiceListColleagueActivityTypesPermValue = "NOTE"
-	ColleaguesServiceListColleagueActivityTypesPermValueLABELS      ColleaguesServiceListColleagueActivityTypesPermValue = "LABELS"
-	ColleaguesServiceListColleagueActivityTypesPermValueNAME        ColleaguesServiceListColleagueActivityTypesPermValue = "NAME"
+	ColleaguesServiceListColleagueActivityTypesPermValueHIRED               ColleaguesServiceListColleagueActivityTypesPermValue = "HIRED"
+	ColleaguesServiceListColleagueActivityTypesPermValueFIRED               ColleaguesServiceListColleagueActivityTypesPermValue = "FIRED"
+	ColleaguesServiceListColleagueActivityTypesPermValuePROMOTED            ColleaguesServiceListColleagueActivityTypesPermValue = "PROMOTED"
+	ColleaguesServiceListColleagueActivityTypesPermValueDEMOTED             ColleaguesServiceListColleagueActivityTypesPermValue = "DEMOTED"
+	ColleaguesServiceListColleagueActivityTypesPermValueABSENCEDATE         ColleaguesServiceListColleagueActivityTypesPermValue = "ABSENCE_DATE"
+	ColleaguesServiceListColleagueActivityTypesPermValueNOTE                ColleaguesServiceListColleagueActivityTypesPermValue = "NOTE"
+	ColleaguesServiceListColleagueActivityTypesPermValueLABELS              ColleaguesServiceListColleagueActivityTypesPermValue = "LABELS"
+	ColleaguesServiceListColleagueActivityTypesPermValueNAME                ColleaguesServiceListColleagueActivityTypesPermValue = "NAME"
+	ColleaguesServiceListColleagueActivityTypesPermValueTIMECLOCKCORRECTION ColleaguesServiceListColleagueActivityTypesPermValue = "TIMECLOCK_CORRECTION"
 )
 
 type ColleaguesServiceSetColleaguePropsAccessPermValue string
@@ -315,8 +317,9 @@ var StatsService = StatsServicePerms{
 }
 
 type TimeclockServicePerms struct {
-	ListInactiveEmployees TimeclockServiceListInactiveEmployeesPermRef
-	ListTimeclock         TimeclockServiceListTimeclockPermRef
+	ListInactiveEmployees     TimeclockServiceListInactiveEmployeesPermRef
+	ListTimeclock             TimeclockServiceListTimeclockPermRef
+	ReviewTimeclockCorrection TimeclockServiceReviewTimeclockCorrectionPermRef
 }
 type TimeclockServiceListInactiveEmployeesPermRef struct {
 	Perm perms.PermissionRef
@@ -326,6 +329,9 @@ type TimeclockServiceListTimeclockPermRef struct {
 	Access      perms.AttrRef[perms.StringListAttr]
 	AccessTyped perms.StringListAttrRef[TimeclockServiceListTimeclockAccessPermValue]
 }
+type TimeclockServiceReviewTimeclockCorrectionPermRef struct {
+	Perm perms.PermissionRef
+}
 
 var TimeclockService = TimeclockServicePerms{
 	ListInactiveEmployees: TimeclockServiceListInactiveEmployeesPermRef{
@@ -342,4 +348,7 @@ var TimeclockService = TimeclockServicePerms{
 			TimeclockServiceListTimeclockAccessPermField,
 		),
 	},
+	ReviewTimeclockCorrection: TimeclockServiceReviewTimeclockCorrectionPermRef{
+		Perm: perms.NewPermissionRef(Namespace, TimeclockServicePerm, TimeclockServiceReviewTimeclockCorrectionPerm),
+	},
 }
@@ -54,7 +54,7 @@ func init() {
 				{
 					Key:         permkeys.ColleaguesServiceListColleagueActivityTypesPermField,
 					Type:        permissionsattributes.StringListAttributeType,
-					ValidValues: []string{"HIRED", "FIRED", "PROMOTED", "DEMOTED", "ABSENCE_DATE", "NOTE", "LABELS", "NAME"},
+					ValidValues: []string{"HIRED", "FIRED", "PROMOTED", "DEMOTED", "ABSENCE_DATE", "NOTE", "LABELS", "NAME", "TIMECLOCK_CORRECTION"},
 				},
 			},
 			Order: 6100,
@@ -205,5 +205,13 @@ func init() {
 			Order: 6200,
 			Icon:  "i-mdi-timeline-clock-outline",
 		},
+		{
+			Namespace: permkeys.Namespace,
+			Service:   permkeys.TimeclockServicePerm,
+			Name:      permkeys.TimeclockServiceReviewTimeclockCorrectionPerm,
+			Attrs:     []perms.Attr{},
+			Order:     6200,
+			Icon:      "i-mdi-timeline-clock-outline",
+		},
 	})
 }
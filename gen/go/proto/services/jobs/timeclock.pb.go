@@ -11,6 +11,7 @@ package jobs
 import (
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/itemslen"
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/perms"
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
 	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
 	jobs "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs"
 	colleagues "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues"
@@ -1162,11 +1163,491 @@ func (b0 ListInactiveEmployeesResponse_builder) Build() *ListInactiveEmployeesRe
 	return m0
 }
 
+type ListTimeclockCorrectionsRequest struct {
+	state      protoimpl.MessageState      `protogen:"hybrid.v1"`
+	Pagination *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Search params
+	Statuses      []timeclock.TimeclockCorrectionStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=resources.jobs.timeclock.TimeclockCorrectionStatus" json:"statuses,omitempty"`
+	UserIds       []int32                               `protobuf:"varint,3,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeclockCorrectionsRequest) Reset() {
+	*x = ListTimeclockCorrectionsRequest{}
+	mi := &file_services_jobs_timeclock_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeclockCorrectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeclockCorrectionsRequest) ProtoMessage() {}
+
+func (x *ListTimeclockCorrectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_jobs_timeclock_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListTimeclockCorrectionsRequest) GetPagination() *database.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListTimeclockCorrectionsRequest) GetStatuses() []timeclock.TimeclockCorrectionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListTimeclockCorrectionsRequest) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ListTimeclockCorrectionsRequest) SetPagination(v *database.PaginationRequest) {
+	x.Pagination = v
+}
+
+func (x *ListTimeclockCorrectionsRequest) SetStatuses(v []timeclock.TimeclockCorrectionStatus) {
+	x.Statuses = v
+}
+
+func (x *ListTimeclockCorrectionsRequest) SetUserIds(v []int32) {
+	x.UserIds = v
+}
+
+func (x *ListTimeclockCorrectionsRequest) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
+}
+
+func (x *ListTimeclockCorrectionsRequest) ClearPagination() {
+	x.Pagination = nil
+}
+
+type ListTimeclockCorrectionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationRequest
+	// Search params
+	Statuses []timeclock.TimeclockCorrectionStatus
+	UserIds  []int32
+}
+
+func (b0 ListTimeclockCorrectionsRequest_builder) Build() *ListTimeclockCorrectionsRequest {
+	m0 := &ListTimeclockCorrectionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	x.Statuses = b.Statuses
+	x.UserIds = b.UserIds
+	return m0
+}
+
+type ListTimeclockCorrectionsResponse struct {
+	state         protoimpl.MessageState           `protogen:"hybrid.v1"`
+	Pagination    *database.PaginationResponse     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Corrections   []*timeclock.TimeclockCorrection `protobuf:"bytes,2,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeclockCorrectionsResponse) Reset() {
+	*x = ListTimeclockCorrectionsResponse{}
+	mi := &file_services_jobs_timeclock_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeclockCorrectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeclockCorrectionsResponse) ProtoMessage() {}
+
+func (x *ListTimeclockCorrectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_jobs_timeclock_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListTimeclockCorrectionsResponse) GetPagination() *database.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListTimeclockCorrectionsResponse) GetCorrections() []*timeclock.TimeclockCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *ListTimeclockCorrectionsResponse) SetPagination(v *database.PaginationResponse) {
+	x.Pagination = v
+}
+
+func (x *ListTimeclockCorrectionsResponse) SetCorrections(v []*timeclock.TimeclockCorrection) {
+	x.Corrections = v
+}
+
+func (x *ListTimeclockCorrectionsResponse) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.Pagination != nil
+}
+
+func (x *ListTimeclockCorrectionsResponse) ClearPagination() {
+	x.Pagination = nil
+}
+
+type ListTimeclockCorrectionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination  *database.PaginationResponse
+	Corrections []*timeclock.TimeclockCorrection
+}
+
+func (b0 ListTimeclockCorrectionsResponse_builder) Build() *ListTimeclockCorrectionsResponse {
+	m0 := &ListTimeclockCorrectionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Pagination = b.Pagination
+	x.Corrections = b.Corrections
+	return m0
+}
+
+type CreateTimeclockCorrectionRequest struct {
+	state         protoimpl.MessageState         `protogen:"hybrid.v1"`
+	Correction    *timeclock.TimeclockCorrection `protobuf:"bytes,1,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeclockCorrectionRequest) Reset() {
+	*x = CreateTimeclockCorrectionRequest{}
+	mi := &file_services_jobs_timeclock_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeclockCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeclockCorrectionRequest) ProtoMessage() {}
+
+func (x *CreateTimeclockCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_jobs_timeclock_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateTimeclockCorrectionRequest) GetCorrection() *timeclock.TimeclockCorrection {
+	if x != nil {
+		return x.Correction
+	}
+	return nil
+}
+
+func (x *CreateTimeclockCorrectionRequest) SetCorrection(v *timeclock.TimeclockCorrection) {
+	x.Correction = v
+}
+
+func (x *CreateTimeclockCorrectionRequest) HasCorrection() bool {
+	if x == nil {
+		return false
+	}
+	return x.Correction != nil
+}
+
+func (x *CreateTimeclockCorrectionRequest) ClearCorrection() {
+	x.Correction = nil
+}
+
+type CreateTimeclockCorrectionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Correction *timeclock.TimeclockCorrection
+}
+
+func (b0 CreateTimeclockCorrectionRequest_builder) Build() *CreateTimeclockCorrectionRequest {
+	m0 := &CreateTimeclockCorrectionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Correction = b.Correction
+	return m0
+}
+
+type CreateTimeclockCorrectionResponse struct {
+	state         protoimpl.MessageState         `protogen:"hybrid.v1"`
+	Correction    *timeclock.TimeclockCorrection `protobuf:"bytes,1,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeclockCorrectionResponse) Reset() {
+	*x = CreateTimeclockCorrectionResponse{}
+	mi := &file_services_jobs_timeclock_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeclockCorrectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeclockCorrectionResponse) ProtoMessage() {}
+
+func (x *CreateTimeclockCorrectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_jobs_timeclock_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateTimeclockCorrectionResponse) GetCorrection() *timeclock.TimeclockCorrection {
+	if x != nil {
+		return x.Correction
+	}
+	return nil
+}
+
+func (x *CreateTimeclockCorrectionResponse) SetCorrection(v *timeclock.TimeclockCorrection) {
+	x.Correction = v
+}
+
+func (x *CreateTimeclockCorrectionResponse) HasCorrection() bool {
+	if x == nil {
+		return false
+	}
+	return x.Correction != nil
+}
+
+func (x *CreateTimeclockCorrectionResponse) ClearCorrection() {
+	x.Correction = nil
+}
+
+type CreateTimeclockCorrectionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Correction *timeclock.TimeclockCorrection
+}
+
+func (b0 CreateTimeclockCorrectionResponse_builder) Build() *CreateTimeclockCorrectionResponse {
+	m0 := &CreateTimeclockCorrectionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Correction = b.Correction
+	return m0
+}
+
+type ReviewTimeclockCorrectionRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	CorrectionId  int64                  `protobuf:"varint,1,opt,name=correction_id,json=correctionId,proto3" json:"correction_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewTimeclockCorrectionRequest) Reset() {
+	*x = ReviewTimeclockCorrectionRequest{}
+	mi := &file_services_jobs_timeclock_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewTimeclockCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTimeclockCorrectionRequest) ProtoMessage() {}
+
+func (x *ReviewTimeclockCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_jobs_timeclock_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReviewTimeclockCorrectionRequest) GetCorrectionId() int64 {
+	if x != nil {
+		return x.CorrectionId
+	}
+	return 0
+}
+
+func (x *ReviewTimeclockCorrectionRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewTimeclockCorrectionRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *ReviewTimeclockCorrectionRequest) SetCorrectionId(v int64) {
+	x.CorrectionId = v
+}
+
+func (x *ReviewTimeclockCorrectionRequest) SetApprove(v bool) {
+	x.Approve = v
+}
+
+func (x *ReviewTimeclockCorrectionRequest) SetComment(v string) {
+	x.Comment = &v
+}
+
+func (x *ReviewTimeclockCorrectionRequest) HasComment() bool {
+	if x == nil {
+		return false
+	}
+	return x.Comment != nil
+}
+
+func (x *ReviewTimeclockCorrectionRequest) ClearComment() {
+	x.Comment = nil
+}
+
+type ReviewTimeclockCorrectionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CorrectionId int64
+	Approve      bool
+	Comment      *string
+}
+
+func (b0 ReviewTimeclockCorrectionRequest_builder) Build() *ReviewTimeclockCorrectionRequest {
+	m0 := &ReviewTimeclockCorrectionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.CorrectionId = b.CorrectionId
+	x.Approve = b.Approve
+	x.Comment = b.Comment
+	return m0
+}
+
+type ReviewTimeclockCorrectionResponse struct {
+	state         protoimpl.MessageState         `protogen:"hybrid.v1"`
+	Correction    *timeclock.TimeclockCorrection `protobuf:"bytes,1,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewTimeclockCorrectionResponse) Reset() {
+	*x = ReviewTimeclockCorrectionResponse{}
+	mi := &file_services_jobs_timeclock_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewTimeclockCorrectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTimeclockCorrectionResponse) ProtoMessage() {}
+
+func (x *ReviewTimeclockCorrectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_jobs_timeclock_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReviewTimeclockCorrectionResponse) GetCorrection() *timeclock.TimeclockCorrection {
+	if x != nil {
+		return x.Correction
+	}
+	return nil
+}
+
+func (x *ReviewTimeclockCorrectionResponse) SetCorrection(v *timeclock.TimeclockCorrection) {
+	x.Correction = v
+}
+
+func (x *ReviewTimeclockCorrectionResponse) HasCorrection() bool {
+	if x == nil {
+		return false
+	}
+	return x.Correction != nil
+}
+
+func (x *ReviewTimeclockCorrectionResponse) ClearCorrection() {
+	x.Correction = nil
+}
+
+type ReviewTimeclockCorrectionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Correction *timeclock.TimeclockCorrection
+}
+
+func (b0 ReviewTimeclockCorrectionResponse_builder) Build() *ReviewTimeclockCorrectionResponse {
+	m0 := &ReviewTimeclockCorrectionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Correction = b.Correction
+	return m0
+}
+
 var File_services_jobs_timeclock_proto protoreflect.FileDescriptor
 
 const file_services_jobs_timeclock_proto_rawDesc = "" +
 	"\n" +
-	"\x1dservices/jobs/timeclock.proto\x12\rservices.jobs\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a(resources/common/database/database.proto\x1a*resources/jobs/colleagues/colleagues.proto\x1a(resources/jobs/timeclock/timeclock.proto\x1a\"resources/jobs/user_selector.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xd2\x03\n" +
+	"\x1dservices/jobs/timeclock.proto\x12\rservices.jobs\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a(resources/common/database/database.proto\x1a*resources/jobs/colleagues/colleagues.proto\x1a(resources/jobs/timeclock/timeclock.proto\x1a\"resources/jobs/user_selector.proto\x1a#resources/timestamp/timestamp.proto\x1a\x13tagger/tagger.proto\"\xd2\x03\n" +
 	"\x14ListTimeclockRequest\x12L\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
@@ -1223,75 +1704,128 @@ const file_services_jobs_timeclock_proto_rawDesc = "" +
 	"pagination\x12J\n" +
 	"\n" +
 	"colleagues\x18\x02 \x03(\v2$.resources.jobs.colleagues.ColleagueB\x04\xc8\xf3\x18\x01R\n" +
-	"colleagues2\xa8\x03\n" +
+	"colleagues\"\xdb\x01\n" +
+	"\x1fListTimeclockCorrectionsRequest\x12L\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
+	"pagination\x12O\n" +
+	"\bstatuses\x18\x02 \x03(\x0e23.resources.jobs.timeclock.TimeclockCorrectionStatusR\bstatuses\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\x05R\auserIds\"\xc8\x01\n" +
+	" ListTimeclockCorrectionsResponse\x12M\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2-.resources.common.database.PaginationResponseR\n" +
+	"pagination\x12U\n" +
+	"\vcorrections\x18\x02 \x03(\v2-.resources.jobs.timeclock.TimeclockCorrectionB\x04\xc8\xf3\x18\x01R\vcorrections\"q\n" +
+	" CreateTimeclockCorrectionRequest\x12M\n" +
+	"\n" +
+	"correction\x18\x01 \x01(\v2-.resources.jobs.timeclock.TimeclockCorrectionR\n" +
+	"correction\"r\n" +
+	"!CreateTimeclockCorrectionResponse\x12M\n" +
+	"\n" +
+	"correction\x18\x01 \x01(\v2-.resources.jobs.timeclock.TimeclockCorrectionR\n" +
+	"correction\"\x94\x01\n" +
+	" ReviewTimeclockCorrectionRequest\x12#\n" +
+	"\rcorrection_id\x18\x01 \x01(\x03R\fcorrectionId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12%\n" +
+	"\acomment\x18\x03 \x01(\tB\x06\xda\xf3\x18\x02\b\x01H\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"r\n" +
+	"!ReviewTimeclockCorrectionResponse\x12M\n" +
+	"\n" +
+	"correction\x18\x01 \x01(\v2-.resources.jobs.timeclock.TimeclockCorrectionR\n" +
+	"correction2\xde\x06\n" +
 	"\x10TimeclockService\x12s\n" +
 	"\rListTimeclock\x12#.services.jobs.ListTimeclockRequest\x1a$.services.jobs.ListTimeclockResponse\"\x17\xd2\xf3\x18\x13\b\x01:\x0f\n" +
 	"\x06Access\x18\x01\"\x03All\x12}\n" +
 	"\x11GetTimeclockStats\x12'.services.jobs.GetTimeclockStatsRequest\x1a(.services.jobs.GetTimeclockStatsResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListTimeclock\x12z\n" +
-	"\x15ListInactiveEmployees\x12+.services.jobs.ListInactiveEmployeesRequest\x1a,.services.jobs.ListInactiveEmployeesResponse\"\x06\xd2\xf3\x18\x02\b\x01\x1a$\xea\xf3\x18 \b>\x12\x1ci-mdi-timeline-clock-outlineBFZDgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/jobs;jobsb\x06proto3"
+	"\x15ListInactiveEmployees\x12+.services.jobs.ListInactiveEmployeesRequest\x1a,.services.jobs.ListInactiveEmployeesResponse\"\x06\xd2\xf3\x18\x02\b\x01\x12\x92\x01\n" +
+	"\x18ListTimeclockCorrections\x12..services.jobs.ListTimeclockCorrectionsRequest\x1a/.services.jobs.ListTimeclockCorrectionsResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListTimeclock\x12\x95\x01\n" +
+	"\x19CreateTimeclockCorrection\x12/.services.jobs.CreateTimeclockCorrectionRequest\x1a0.services.jobs.CreateTimeclockCorrectionResponse\"\x15\xd2\xf3\x18\x11\b\x01\"\rListTimeclock\x12\x86\x01\n" +
+	"\x19ReviewTimeclockCorrection\x12/.services.jobs.ReviewTimeclockCorrectionRequest\x1a0.services.jobs.ReviewTimeclockCorrectionResponse\"\x06\xd2\xf3\x18\x02\b\x01\x1a$\xea\xf3\x18 \b>\x12\x1ci-mdi-timeline-clock-outlineBFZDgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/jobs;jobsb\x06proto3"
 
-var file_services_jobs_timeclock_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_services_jobs_timeclock_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_services_jobs_timeclock_proto_goTypes = []any{
-	(*ListTimeclockRequest)(nil),           // 0: services.jobs.ListTimeclockRequest
-	(*ListTimeclockResponse)(nil),          // 1: services.jobs.ListTimeclockResponse
-	(*TimeclockDay)(nil),                   // 2: services.jobs.TimeclockDay
-	(*TimeclockWeekly)(nil),                // 3: services.jobs.TimeclockWeekly
-	(*TimeclockRange)(nil),                 // 4: services.jobs.TimeclockRange
-	(*GetTimeclockStatsRequest)(nil),       // 5: services.jobs.GetTimeclockStatsRequest
-	(*GetTimeclockStatsResponse)(nil),      // 6: services.jobs.GetTimeclockStatsResponse
-	(*ListInactiveEmployeesRequest)(nil),   // 7: services.jobs.ListInactiveEmployeesRequest
-	(*ListInactiveEmployeesResponse)(nil),  // 8: services.jobs.ListInactiveEmployeesResponse
-	(*database.PaginationRequest)(nil),     // 9: resources.common.database.PaginationRequest
-	(*database.Sort)(nil),                  // 10: resources.common.database.Sort
-	(timeclock.TimeclockViewMode)(0),       // 11: resources.jobs.timeclock.TimeclockViewMode
-	(timeclock.TimeclockMode)(0),           // 12: resources.jobs.timeclock.TimeclockMode
-	(*database.DateRange)(nil),             // 13: resources.common.database.DateRange
-	(*jobs.UserSelector)(nil),              // 14: resources.jobs.UserSelector
-	(*database.PaginationResponse)(nil),    // 15: resources.common.database.PaginationResponse
-	(*timeclock.TimeclockStats)(nil),       // 16: resources.jobs.timeclock.TimeclockStats
-	(*timeclock.TimeclockWeeklyStats)(nil), // 17: resources.jobs.timeclock.TimeclockWeeklyStats
-	(*timestamp.Timestamp)(nil),            // 18: resources.timestamp.Timestamp
-	(*timeclock.TimeclockEntry)(nil),       // 19: resources.jobs.timeclock.TimeclockEntry
-	(*colleagues.Colleague)(nil),           // 20: resources.jobs.colleagues.Colleague
+	(*ListTimeclockRequest)(nil),              // 0: services.jobs.ListTimeclockRequest
+	(*ListTimeclockResponse)(nil),             // 1: services.jobs.ListTimeclockResponse
+	(*TimeclockDay)(nil),                      // 2: services.jobs.TimeclockDay
+	(*TimeclockWeekly)(nil),                   // 3: services.jobs.TimeclockWeekly
+	(*TimeclockRange)(nil),                    // 4: services.jobs.TimeclockRange
+	(*GetTimeclockStatsRequest)(nil),          // 5: services.jobs.GetTimeclockStatsRequest
+	(*GetTimeclockStatsResponse)(nil),         // 6: services.jobs.GetTimeclockStatsResponse
+	(*ListInactiveEmployeesRequest)(nil),      // 7: services.jobs.ListInactiveEmployeesRequest
+	(*ListInactiveEmployeesResponse)(nil),     // 8: services.jobs.ListInactiveEmployeesResponse
+	(*ListTimeclockCorrectionsRequest)(nil),   // 9: services.jobs.ListTimeclockCorrectionsRequest
+	(*ListTimeclockCorrectionsResponse)(nil),  // 10: services.jobs.ListTimeclockCorrectionsResponse
+	(*CreateTimeclockCorrectionRequest)(nil),  // 11: services.jobs.CreateTimeclockCorrectionRequest
+	(*CreateTimeclockCorrectionResponse)(nil), // 12: services.jobs.CreateTimeclockCorrectionResponse
+	(*ReviewTimeclockCorrectionRequest)(nil),  // 13: services.jobs.ReviewTimeclockCorrectionRequest
+	(*ReviewTimeclockCorrectionResponse)(nil), // 14: services.jobs.ReviewTimeclockCorrectionResponse
+	(*database.PaginationRequest)(nil),        // 15: resources.common.database.PaginationRequest
+	(*database.Sort)(nil),                     // 16: resources.common.database.Sort
+	(timeclock.TimeclockViewMode)(0),          // 17: resources.jobs.timeclock.TimeclockViewMode
+	(timeclock.TimeclockMode)(0),              // 18: resources.jobs.timeclock.TimeclockMode
+	(*database.DateRange)(nil),                // 19: resources.common.database.DateRange
+	(*jobs.UserSelector)(nil),                 // 20: resources.jobs.UserSelector
+	(*database.PaginationResponse)(nil),       // 21: resources.common.database.PaginationResponse
+	(*timeclock.TimeclockStats)(nil),          // 22: resources.jobs.timeclock.TimeclockStats
+	(*timeclock.TimeclockWeeklyStats)(nil),    // 23: resources.jobs.timeclock.TimeclockWeeklyStats
+	(*timestamp.Timestamp)(nil),               // 24: resources.timestamp.Timestamp
+	(*timeclock.TimeclockEntry)(nil),          // 25: resources.jobs.timeclock.TimeclockEntry
+	(*colleagues.Colleague)(nil),              // 26: resources.jobs.colleagues.Colleague
+	(timeclock.TimeclockCorrectionStatus)(0),  // 27: resources.jobs.timeclock.TimeclockCorrectionStatus
+	(*timeclock.TimeclockCorrection)(nil),     // 28: resources.jobs.timeclock.TimeclockCorrection
 }
 var file_services_jobs_timeclock_proto_depIdxs = []int32{
-	9,  // 0: services.jobs.ListTimeclockRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	10, // 1: services.jobs.ListTimeclockRequest.sort:type_name -> resources.common.database.Sort
-	11, // 2: services.jobs.ListTimeclockRequest.user_mode:type_name -> resources.jobs.timeclock.TimeclockViewMode
-	12, // 3: services.jobs.ListTimeclockRequest.mode:type_name -> resources.jobs.timeclock.TimeclockMode
-	13, // 4: services.jobs.ListTimeclockRequest.date:type_name -> resources.common.database.DateRange
-	14, // 5: services.jobs.ListTimeclockRequest.users:type_name -> resources.jobs.UserSelector
-	15, // 6: services.jobs.ListTimeclockResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	16, // 7: services.jobs.ListTimeclockResponse.stats:type_name -> resources.jobs.timeclock.TimeclockStats
-	17, // 8: services.jobs.ListTimeclockResponse.stats_weekly:type_name -> resources.jobs.timeclock.TimeclockWeeklyStats
+	15, // 0: services.jobs.ListTimeclockRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	16, // 1: services.jobs.ListTimeclockRequest.sort:type_name -> resources.common.database.Sort
+	17, // 2: services.jobs.ListTimeclockRequest.user_mode:type_name -> resources.jobs.timeclock.TimeclockViewMode
+	18, // 3: services.jobs.ListTimeclockRequest.mode:type_name -> resources.jobs.timeclock.TimeclockMode
+	19, // 4: services.jobs.ListTimeclockRequest.date:type_name -> resources.common.database.DateRange
+	20, // 5: services.jobs.ListTimeclockRequest.users:type_name -> resources.jobs.UserSelector
+	21, // 6: services.jobs.ListTimeclockResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	22, // 7: services.jobs.ListTimeclockResponse.stats:type_name -> resources.jobs.timeclock.TimeclockStats
+	23, // 8: services.jobs.ListTimeclockResponse.stats_weekly:type_name -> resources.jobs.timeclock.TimeclockWeeklyStats
 	2,  // 9: services.jobs.ListTimeclockResponse.daily:type_name -> services.jobs.TimeclockDay
 	3,  // 10: services.jobs.ListTimeclockResponse.weekly:type_name -> services.jobs.TimeclockWeekly
 	4,  // 11: services.jobs.ListTimeclockResponse.range:type_name -> services.jobs.TimeclockRange
-	18, // 12: services.jobs.TimeclockDay.date:type_name -> resources.timestamp.Timestamp
-	19, // 13: services.jobs.TimeclockDay.entries:type_name -> resources.jobs.timeclock.TimeclockEntry
-	18, // 14: services.jobs.TimeclockWeekly.date:type_name -> resources.timestamp.Timestamp
-	19, // 15: services.jobs.TimeclockWeekly.entries:type_name -> resources.jobs.timeclock.TimeclockEntry
-	18, // 16: services.jobs.TimeclockRange.date:type_name -> resources.timestamp.Timestamp
-	19, // 17: services.jobs.TimeclockRange.entries:type_name -> resources.jobs.timeclock.TimeclockEntry
-	14, // 18: services.jobs.GetTimeclockStatsRequest.users:type_name -> resources.jobs.UserSelector
-	16, // 19: services.jobs.GetTimeclockStatsResponse.stats:type_name -> resources.jobs.timeclock.TimeclockStats
-	17, // 20: services.jobs.GetTimeclockStatsResponse.weekly:type_name -> resources.jobs.timeclock.TimeclockWeeklyStats
-	9,  // 21: services.jobs.ListInactiveEmployeesRequest.pagination:type_name -> resources.common.database.PaginationRequest
-	10, // 22: services.jobs.ListInactiveEmployeesRequest.sort:type_name -> resources.common.database.Sort
-	14, // 23: services.jobs.ListInactiveEmployeesRequest.users:type_name -> resources.jobs.UserSelector
-	15, // 24: services.jobs.ListInactiveEmployeesResponse.pagination:type_name -> resources.common.database.PaginationResponse
-	20, // 25: services.jobs.ListInactiveEmployeesResponse.colleagues:type_name -> resources.jobs.colleagues.Colleague
-	0,  // 26: services.jobs.TimeclockService.ListTimeclock:input_type -> services.jobs.ListTimeclockRequest
-	5,  // 27: services.jobs.TimeclockService.GetTimeclockStats:input_type -> services.jobs.GetTimeclockStatsRequest
-	7,  // 28: services.jobs.TimeclockService.ListInactiveEmployees:input_type -> services.jobs.ListInactiveEmployeesRequest
-	1,  // 29: services.jobs.TimeclockService.ListTimeclock:output_type -> services.jobs.ListTimeclockResponse
-	6,  // 30: services.jobs.TimeclockService.GetTimeclockStats:output_type -> services.jobs.GetTimeclockStatsResponse
-	8,  // 31: services.jobs.TimeclockService.ListInactiveEmployees:output_type -> services.jobs.ListInactiveEmployeesResponse
-	29, // [29:32] is the sub-list for method output_type
-	26, // [26:29] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	24, // 12: services.jobs.TimeclockDay.date:type_name -> resources.timestamp.Timestamp
+	25, // 13: services.jobs.TimeclockDay.entries:type_name -> resources.jobs.timeclock.TimeclockEntry
+	24, // 14: services.jobs.TimeclockWeekly.date:type_name -> resources.timestamp.Timestamp
+	25, // 15: services.jobs.TimeclockWeekly.entries:type_name -> resources.jobs.timeclock.TimeclockEntry
+	24, // 16: services.jobs.TimeclockRange.date:type_name -> resources.timestamp.Timestamp
+	25, // 17: services.jobs.TimeclockRange.entries:type_name -> resources.jobs.timeclock.TimeclockEntry
+	20, // 18: services.jobs.GetTimeclockStatsRequest.users:type_name -> resources.jobs.UserSelector
+	22, // 19: services.jobs.GetTimeclockStatsResponse.stats:type_name -> resources.jobs.timeclock.TimeclockStats
+	23, // 20: services.jobs.GetTimeclockStatsResponse.weekly:type_name -> resources.jobs.timeclock.TimeclockWeeklyStats
+	15, // 21: services.jobs.ListInactiveEmployeesRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	16, // 22: services.jobs.ListInactiveEmployeesRequest.sort:type_name -> resources.common.database.Sort
+	20, // 23: services.jobs.ListInactiveEmployeesRequest.users:type_name -> resources.jobs.UserSelector
+	21, // 24: services.jobs.ListInactiveEmployeesResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	26, // 25: services.jobs.ListInactiveEmployeesResponse.colleagues:type_name -> resources.jobs.colleagues.Colleague
+	15, // 26: services.jobs.ListTimeclockCorrectionsRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	27, // 27: services.jobs.ListTimeclockCorrectionsRequest.statuses:type_name -> resources.jobs.timeclock.TimeclockCorrectionStatus
+	21, // 28: services.jobs.ListTimeclockCorrectionsResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	28, // 29: services.jobs.ListTimeclockCorrectionsResponse.corrections:type_name -> resources.jobs.timeclock.TimeclockCorrection
+	28, // 30: services.jobs.CreateTimeclockCorrectionRequest.correction:type_name -> resources.jobs.timeclock.TimeclockCorrection
+	28, // 31: services.jobs.CreateTimeclockCorrectionResponse.correction:type_name -> resources.jobs.timeclock.TimeclockCorrection
+	28, // 32: services.jobs.ReviewTimeclockCorrectionResponse.correction:type_name -> resources.jobs.timeclock.TimeclockCorrection
+	0,  // 33: services.jobs.TimeclockService.ListTimeclock:input_type -> services.jobs.ListTimeclockRequest
+	5,  // 34: services.jobs.TimeclockService.GetTimeclockStats:input_type -> services.jobs.GetTimeclockStatsRequest
+	7,  // 35: services.jobs.TimeclockService.ListInactiveEmployees:input_type -> services.jobs.ListInactiveEmployeesRequest
+	9,  // 36: services.jobs.TimeclockService.ListTimeclockCorrections:input_type -> services.jobs.ListTimeclockCorrectionsRequest
+	11, // 37: services.jobs.TimeclockService.CreateTimeclockCorrection:input_type -> services.jobs.CreateTimeclockCorrectionRequest
+	13, // 38: services.jobs.TimeclockService.ReviewTimeclockCorrection:input_type -> services.jobs.ReviewTimeclockCorrectionRequest
+	1,  // 39: services.jobs.TimeclockService.ListTimeclock:output_type -> services.jobs.ListTimeclockResponse
+	6,  // 40: services.jobs.TimeclockService.GetTimeclockStats:output_type -> services.jobs.GetTimeclockStatsResponse
+	8,  // 41: services.jobs.TimeclockService.ListInactiveEmployees:output_type -> services.jobs.ListInactiveEmployeesResponse
+	10, // 42: services.jobs.TimeclockService.ListTimeclockCorrections:output_type -> services.jobs.ListTimeclockCorrectionsResponse
+	12, // 43: services.jobs.TimeclockService.CreateTimeclockCorrection:output_type -> services.jobs.CreateTimeclockCorrectionResponse
+	14, // 44: services.jobs.TimeclockService.ReviewTimeclockCorrection:output_type -> services.jobs.ReviewTimeclockCorrectionResponse
+	39, // [39:45] is the sub-list for method output_type
+	33, // [33:39] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_services_jobs_timeclock_proto_init() }
@@ -1307,13 +1841,14 @@ func file_services_jobs_timeclock_proto_init() {
 	}
 	file_services_jobs_timeclock_proto_msgTypes[5].OneofWrappers = []any{}
 	file_services_jobs_timeclock_proto_msgTypes[7].OneofWrappers = []any{}
+	file_services_jobs_timeclock_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_jobs_timeclock_proto_rawDesc), len(file_services_jobs_timeclock_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
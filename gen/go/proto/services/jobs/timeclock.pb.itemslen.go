@@ -10,3 +10,11 @@ func (m *ListInactiveEmployeesResponse) ItemsLen() int {
 	}
 	return len(m.GetColleagues())
 }
+
+// ItemsLen returns the length of Corrections.
+func (m *ListTimeclockCorrectionsResponse) ItemsLen() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.GetCorrections())
+}
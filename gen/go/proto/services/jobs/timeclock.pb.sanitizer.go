@@ -3,6 +3,48 @@
 
 package jobs
 
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateTimeclockCorrectionRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Correction
+	if m.Correction != nil {
+		if v, ok := any(m.GetCorrection()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateTimeclockCorrectionResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Correction
+	if m.Correction != nil {
+		if v, ok := any(m.GetCorrection()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *GetTimeclockStatsRequest) Sanitize() error {
@@ -121,6 +163,62 @@ func (m *ListInactiveEmployeesResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListTimeclockCorrectionsRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Statuses
+	for idx, item := range m.Statuses {
+		_, _ = idx, item
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListTimeclockCorrectionsResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Corrections
+	for idx, item := range m.Corrections {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *ListTimeclockRequest) Sanitize() error {
@@ -252,6 +350,40 @@ func (m *ListTimeclockResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ReviewTimeclockCorrectionRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Comment
+	if m.Comment != nil {
+		*m.Comment = htmlsanitizer.SanitizeAndUnescape(*m.Comment)
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ReviewTimeclockCorrectionResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Correction
+	if m.Correction != nil {
+		if v, ok := any(m.GetCorrection()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *TimeclockDay) Sanitize() error {
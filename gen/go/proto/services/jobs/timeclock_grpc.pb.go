@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TimeclockService_ListTimeclock_FullMethodName         = "/services.jobs.TimeclockService/ListTimeclock"
-	TimeclockService_GetTimeclockStats_FullMethodName     = "/services.jobs.TimeclockService/GetTimeclockStats"
-	TimeclockService_ListInactiveEmployees_FullMethodName = "/services.jobs.TimeclockService/ListInactiveEmployees"
+	TimeclockService_ListTimeclock_FullMethodName             = "/services.jobs.TimeclockService/ListTimeclock"
+	TimeclockService_GetTimeclockStats_FullMethodName         = "/services.jobs.TimeclockService/GetTimeclockStats"
+	TimeclockService_ListInactiveEmployees_FullMethodName     = "/services.jobs.TimeclockService/ListInactiveEmployees"
+	TimeclockService_ListTimeclockCorrections_FullMethodName  = "/services.jobs.TimeclockService/ListTimeclockCorrections"
+	TimeclockService_CreateTimeclockCorrection_FullMethodName = "/services.jobs.TimeclockService/CreateTimeclockCorrection"
+	TimeclockService_ReviewTimeclockCorrection_FullMethodName = "/services.jobs.TimeclockService/ReviewTimeclockCorrection"
 )
 
 // TimeclockServiceClient is the client API for TimeclockService service.
@@ -31,6 +34,9 @@ type TimeclockServiceClient interface {
 	ListTimeclock(ctx context.Context, in *ListTimeclockRequest, opts ...grpc.CallOption) (*ListTimeclockResponse, error)
 	GetTimeclockStats(ctx context.Context, in *GetTimeclockStatsRequest, opts ...grpc.CallOption) (*GetTimeclockStatsResponse, error)
 	ListInactiveEmployees(ctx context.Context, in *ListInactiveEmployeesRequest, opts ...grpc.CallOption) (*ListInactiveEmployeesResponse, error)
+	ListTimeclockCorrections(ctx context.Context, in *ListTimeclockCorrectionsRequest, opts ...grpc.CallOption) (*ListTimeclockCorrectionsResponse, error)
+	CreateTimeclockCorrection(ctx context.Context, in *CreateTimeclockCorrectionRequest, opts ...grpc.CallOption) (*CreateTimeclockCorrectionResponse, error)
+	ReviewTimeclockCorrection(ctx context.Context, in *ReviewTimeclockCorrectionRequest, opts ...grpc.CallOption) (*ReviewTimeclockCorrectionResponse, error)
 }
 
 type timeclockServiceClient struct {
@@ -71,6 +77,36 @@ func (c *timeclockServiceClient) ListInactiveEmployees(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *timeclockServiceClient) ListTimeclockCorrections(ctx context.Context, in *ListTimeclockCorrectionsRequest, opts ...grpc.CallOption) (*ListTimeclockCorrectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTimeclockCorrectionsResponse)
+	err := c.cc.Invoke(ctx, TimeclockService_ListTimeclockCorrections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeclockServiceClient) CreateTimeclockCorrection(ctx context.Context, in *CreateTimeclockCorrectionRequest, opts ...grpc.CallOption) (*CreateTimeclockCorrectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTimeclockCorrectionResponse)
+	err := c.cc.Invoke(ctx, TimeclockService_CreateTimeclockCorrection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeclockServiceClient) ReviewTimeclockCorrection(ctx context.Context, in *ReviewTimeclockCorrectionRequest, opts ...grpc.CallOption) (*ReviewTimeclockCorrectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewTimeclockCorrectionResponse)
+	err := c.cc.Invoke(ctx, TimeclockService_ReviewTimeclockCorrection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TimeclockServiceServer is the server API for TimeclockService service.
 // All implementations must embed UnimplementedTimeclockServiceServer
 // for forward compatibility.
@@ -78,6 +114,9 @@ type TimeclockServiceServer interface {
 	ListTimeclock(context.Context, *ListTimeclockRequest) (*ListTimeclockResponse, error)
 	GetTimeclockStats(context.Context, *GetTimeclockStatsRequest) (*GetTimeclockStatsResponse, error)
 	ListInactiveEmployees(context.Context, *ListInactiveEmployeesRequest) (*ListInactiveEmployeesResponse, error)
+	ListTimeclockCorrections(context.Context, *ListTimeclockCorrectionsRequest) (*ListTimeclockCorrectionsResponse, error)
+	CreateTimeclockCorrection(context.Context, *CreateTimeclockCorrectionRequest) (*CreateTimeclockCorrectionResponse, error)
+	ReviewTimeclockCorrection(context.Context, *ReviewTimeclockCorrectionRequest) (*ReviewTimeclockCorrectionResponse, error)
 	mustEmbedUnimplementedTimeclockServiceServer()
 }
 
@@ -97,6 +136,15 @@ func (UnimplementedTimeclockServiceServer) GetTimeclockStats(context.Context, *G
 func (UnimplementedTimeclockServiceServer) ListInactiveEmployees(context.Context, *ListInactiveEmployeesRequest) (*ListInactiveEmployeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListInactiveEmployees not implemented")
 }
+func (UnimplementedTimeclockServiceServer) ListTimeclockCorrections(context.Context, *ListTimeclockCorrectionsRequest) (*ListTimeclockCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTimeclockCorrections not implemented")
+}
+func (UnimplementedTimeclockServiceServer) CreateTimeclockCorrection(context.Context, *CreateTimeclockCorrectionRequest) (*CreateTimeclockCorrectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTimeclockCorrection not implemented")
+}
+func (UnimplementedTimeclockServiceServer) ReviewTimeclockCorrection(context.Context, *ReviewTimeclockCorrectionRequest) (*ReviewTimeclockCorrectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewTimeclockCorrection not implemented")
+}
 func (UnimplementedTimeclockServiceServer) mustEmbedUnimplementedTimeclockServiceServer() {}
 func (UnimplementedTimeclockServiceServer) testEmbeddedByValue()                          {}
 
@@ -172,6 +220,60 @@ func _TimeclockService_ListInactiveEmployees_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TimeclockService_ListTimeclockCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimeclockCorrectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeclockServiceServer).ListTimeclockCorrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeclockService_ListTimeclockCorrections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeclockServiceServer).ListTimeclockCorrections(ctx, req.(*ListTimeclockCorrectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeclockService_CreateTimeclockCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTimeclockCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeclockServiceServer).CreateTimeclockCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeclockService_CreateTimeclockCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeclockServiceServer).CreateTimeclockCorrection(ctx, req.(*CreateTimeclockCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeclockService_ReviewTimeclockCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewTimeclockCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeclockServiceServer).ReviewTimeclockCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeclockService_ReviewTimeclockCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeclockServiceServer).ReviewTimeclockCorrection(ctx, req.(*ReviewTimeclockCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TimeclockService_ServiceDesc is the grpc.ServiceDesc for TimeclockService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +293,18 @@ var TimeclockService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListInactiveEmployees",
 			Handler:    _TimeclockService_ListInactiveEmployees_Handler,
 		},
+		{
+			MethodName: "ListTimeclockCorrections",
+			Handler:    _TimeclockService_ListTimeclockCorrections_Handler,
+		},
+		{
+			MethodName: "CreateTimeclockCorrection",
+			Handler:    _TimeclockService_CreateTimeclockCorrection_Handler,
+		},
+		{
+			MethodName: "ReviewTimeclockCorrection",
+			Handler:    _TimeclockService_ReviewTimeclockCorrection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/jobs/timeclock.proto",
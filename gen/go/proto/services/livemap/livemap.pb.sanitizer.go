@@ -45,6 +45,56 @@ func (m *CreateOrUpdateMarkerResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetPositionTrailsRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: End
+	if m.End != nil {
+		if v, ok := any(m.GetEnd()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Start
+	if m.Start != nil {
+		if v, ok := any(m.GetStart()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetPositionTrailsResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Trails
+	for idx, item := range m.Trails {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *JobsList) Sanitize() error {
@@ -101,6 +151,28 @@ func (m *MarkerMarkersUpdates) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *SetStreamViewportRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: StreamId
+	m.StreamId = htmlsanitizer.SanitizeAndUnescape(m.StreamId)
+
+	// Field: Viewport
+	if m.Viewport != nil {
+		if v, ok := any(m.GetViewport()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *Snapshot) Sanitize() error {
@@ -123,6 +195,25 @@ func (m *Snapshot) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *StreamRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Viewport
+	if m.Viewport != nil {
+		if v, ok := any(m.GetViewport()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *StreamResponse) Sanitize() error {
@@ -165,6 +256,26 @@ func (m *StreamResponse) Sanitize() error {
 			}
 		}
 
+	}
+
+	// Field: StreamId
+	if m.StreamId != nil {
+		*m.StreamId = htmlsanitizer.SanitizeAndUnescape(*m.StreamId)
+	}
+
+	// Field: UserClusters
+	switch v := m.Data.(type) {
+
+	case *StreamResponse_UserClusters:
+
+		if v.UserClusters != nil {
+			if s, ok := any(v.UserClusters).(interface{ Sanitize() error }); ok {
+				if err := s.Sanitize(); err != nil {
+					return err
+				}
+			}
+		}
+
 		// Field: UserDeletes
 	case *StreamResponse_UserDeletes:
 
@@ -192,6 +303,28 @@ func (m *StreamResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *UserClusters) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Clusters
+	for idx, item := range m.Clusters {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *UserDelete) Sanitize() error {
@@ -20,7 +20,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	LivemapService_Stream_FullMethodName               = "/services.livemap.LivemapService/Stream"
+	LivemapService_SetStreamViewport_FullMethodName    = "/services.livemap.LivemapService/SetStreamViewport"
 	LivemapService_CreateOrUpdateMarker_FullMethodName = "/services.livemap.LivemapService/CreateOrUpdateMarker"
+	LivemapService_GetPositionTrails_FullMethodName    = "/services.livemap.LivemapService/GetPositionTrails"
 	LivemapService_DeleteMarker_FullMethodName         = "/services.livemap.LivemapService/DeleteMarker"
 )
 
@@ -29,7 +31,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LivemapServiceClient interface {
 	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamResponse], error)
+	// Updates the viewport of one of the caller's active streams.
+	SetStreamViewport(ctx context.Context, in *SetStreamViewportRequest, opts ...grpc.CallOption) (*SetStreamViewportResponse, error)
 	CreateOrUpdateMarker(ctx context.Context, in *CreateOrUpdateMarkerRequest, opts ...grpc.CallOption) (*CreateOrUpdateMarkerResponse, error)
+	// Returns the position history of a user or the members of a unit as one polyline per user.
+	// Only positions of jobs and grades visible via the Stream "Players" attribute are returned.
+	GetPositionTrails(ctx context.Context, in *GetPositionTrailsRequest, opts ...grpc.CallOption) (*GetPositionTrailsResponse, error)
 	// Deletes or restores a marker.
 	//
 	// Private markers use the DeleteMarker Access attribute in the usual creator job/rank scope:
@@ -69,6 +76,16 @@ func (c *livemapServiceClient) Stream(ctx context.Context, in *StreamRequest, op
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type LivemapService_StreamClient = grpc.ServerStreamingClient[StreamResponse]
 
+func (c *livemapServiceClient) SetStreamViewport(ctx context.Context, in *SetStreamViewportRequest, opts ...grpc.CallOption) (*SetStreamViewportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStreamViewportResponse)
+	err := c.cc.Invoke(ctx, LivemapService_SetStreamViewport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *livemapServiceClient) CreateOrUpdateMarker(ctx context.Context, in *CreateOrUpdateMarkerRequest, opts ...grpc.CallOption) (*CreateOrUpdateMarkerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateOrUpdateMarkerResponse)
@@ -79,6 +96,16 @@ func (c *livemapServiceClient) CreateOrUpdateMarker(ctx context.Context, in *Cre
 	return out, nil
 }
 
+func (c *livemapServiceClient) GetPositionTrails(ctx context.Context, in *GetPositionTrailsRequest, opts ...grpc.CallOption) (*GetPositionTrailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPositionTrailsResponse)
+	err := c.cc.Invoke(ctx, LivemapService_GetPositionTrails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *livemapServiceClient) DeleteMarker(ctx context.Context, in *DeleteMarkerRequest, opts ...grpc.CallOption) (*DeleteMarkerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteMarkerResponse)
@@ -94,7 +121,12 @@ func (c *livemapServiceClient) DeleteMarker(ctx context.Context, in *DeleteMarke
 // for forward compatibility.
 type LivemapServiceServer interface {
 	Stream(*StreamRequest, grpc.ServerStreamingServer[StreamResponse]) error
+	// Updates the viewport of one of the caller's active streams.
+	SetStreamViewport(context.Context, *SetStreamViewportRequest) (*SetStreamViewportResponse, error)
 	CreateOrUpdateMarker(context.Context, *CreateOrUpdateMarkerRequest) (*CreateOrUpdateMarkerResponse, error)
+	// Returns the position history of a user or the members of a unit as one polyline per user.
+	// Only positions of jobs and grades visible via the Stream "Players" attribute are returned.
+	GetPositionTrails(context.Context, *GetPositionTrailsRequest) (*GetPositionTrailsResponse, error)
 	// Deletes or restores a marker.
 	//
 	// Private markers use the DeleteMarker Access attribute in the usual creator job/rank scope:
@@ -118,9 +150,15 @@ type UnimplementedLivemapServiceServer struct{}
 func (UnimplementedLivemapServiceServer) Stream(*StreamRequest, grpc.ServerStreamingServer[StreamResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
 }
+func (UnimplementedLivemapServiceServer) SetStreamViewport(context.Context, *SetStreamViewportRequest) (*SetStreamViewportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStreamViewport not implemented")
+}
 func (UnimplementedLivemapServiceServer) CreateOrUpdateMarker(context.Context, *CreateOrUpdateMarkerRequest) (*CreateOrUpdateMarkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateMarker not implemented")
 }
+func (UnimplementedLivemapServiceServer) GetPositionTrails(context.Context, *GetPositionTrailsRequest) (*GetPositionTrailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositionTrails not implemented")
+}
 func (UnimplementedLivemapServiceServer) DeleteMarker(context.Context, *DeleteMarkerRequest) (*DeleteMarkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMarker not implemented")
 }
@@ -156,6 +194,24 @@ func _LivemapService_Stream_Handler(srv interface{}, stream grpc.ServerStream) e
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type LivemapService_StreamServer = grpc.ServerStreamingServer[StreamResponse]
 
+func _LivemapService_SetStreamViewport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStreamViewportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LivemapServiceServer).SetStreamViewport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LivemapService_SetStreamViewport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LivemapServiceServer).SetStreamViewport(ctx, req.(*SetStreamViewportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LivemapService_CreateOrUpdateMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateOrUpdateMarkerRequest)
 	if err := dec(in); err != nil {
@@ -174,6 +230,24 @@ func _LivemapService_CreateOrUpdateMarker_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LivemapService_GetPositionTrails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionTrailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LivemapServiceServer).GetPositionTrails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LivemapService_GetPositionTrails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LivemapServiceServer).GetPositionTrails(ctx, req.(*GetPositionTrailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LivemapService_DeleteMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteMarkerRequest)
 	if err := dec(in); err != nil {
@@ -199,10 +273,18 @@ var LivemapService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "services.livemap.LivemapService",
 	HandlerType: (*LivemapServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetStreamViewport",
+			Handler:    _LivemapService_SetStreamViewport_Handler,
+		},
 		{
 			MethodName: "CreateOrUpdateMarker",
 			Handler:    _LivemapService_CreateOrUpdateMarker_Handler,
 		},
+		{
+			MethodName: "GetPositionTrails",
+			Handler:    _LivemapService_GetPositionTrails_Handler,
+		},
 		{
 			MethodName: "DeleteMarker",
 			Handler:    _LivemapService_DeleteMarker_Handler,
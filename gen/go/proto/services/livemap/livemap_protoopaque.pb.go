@@ -11,7 +11,9 @@ package livemap
 import (
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/perms"
 	jobs "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs"
+	history "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
 	markers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,9 +28,10 @@ const (
 )
 
 type StreamRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Viewport *Viewport              `protobuf:"bytes,1,opt,name=viewport,proto3,oneof"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *StreamRequest) Reset() {
@@ -56,21 +59,161 @@ func (x *StreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+func (x *StreamRequest) GetViewport() *Viewport {
+	if x != nil {
+		return x.xxx_hidden_Viewport
+	}
+	return nil
+}
+
+func (x *StreamRequest) SetViewport(v *Viewport) {
+	x.xxx_hidden_Viewport = v
+}
+
+func (x *StreamRequest) HasViewport() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Viewport != nil
+}
+
+func (x *StreamRequest) ClearViewport() {
+	x.xxx_hidden_Viewport = nil
+}
+
 type StreamRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Only markers inside the viewport are sent, user markers are clustered at low zoom levels.
+	// Without a viewport all visible markers are sent.
+	Viewport *Viewport
 }
 
 func (b0 StreamRequest_builder) Build() *StreamRequest {
 	m0 := &StreamRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.xxx_hidden_Viewport = b.Viewport
+	return m0
+}
+
+type Viewport struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MinX float64                `protobuf:"fixed64,1,opt,name=min_x,json=minX,proto3"`
+	xxx_hidden_MinY float64                `protobuf:"fixed64,2,opt,name=min_y,json=minY,proto3"`
+	xxx_hidden_MaxX float64                `protobuf:"fixed64,3,opt,name=max_x,json=maxX,proto3"`
+	xxx_hidden_MaxY float64                `protobuf:"fixed64,4,opt,name=max_y,json=maxY,proto3"`
+	xxx_hidden_Zoom int32                  `protobuf:"varint,5,opt,name=zoom,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Viewport) Reset() {
+	*x = Viewport{}
+	mi := &file_services_livemap_livemap_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Viewport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Viewport) ProtoMessage() {}
+
+func (x *Viewport) ProtoReflect() protoreflect.Message {
+	mi := &file_services_livemap_livemap_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Viewport) GetMinX() float64 {
+	if x != nil {
+		return x.xxx_hidden_MinX
+	}
+	return 0
+}
+
+func (x *Viewport) GetMinY() float64 {
+	if x != nil {
+		return x.xxx_hidden_MinY
+	}
+	return 0
+}
+
+func (x *Viewport) GetMaxX() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxX
+	}
+	return 0
+}
+
+func (x *Viewport) GetMaxY() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxY
+	}
+	return 0
+}
+
+func (x *Viewport) GetZoom() int32 {
+	if x != nil {
+		return x.xxx_hidden_Zoom
+	}
+	return 0
+}
+
+func (x *Viewport) SetMinX(v float64) {
+	x.xxx_hidden_MinX = v
+}
+
+func (x *Viewport) SetMinY(v float64) {
+	x.xxx_hidden_MinY = v
+}
+
+func (x *Viewport) SetMaxX(v float64) {
+	x.xxx_hidden_MaxX = v
+}
+
+func (x *Viewport) SetMaxY(v float64) {
+	x.xxx_hidden_MaxY = v
+}
+
+func (x *Viewport) SetZoom(v int32) {
+	x.xxx_hidden_Zoom = v
+}
+
+type Viewport_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+	Zoom int32
+}
+
+func (b0 Viewport_builder) Build() *Viewport {
+	m0 := &Viewport{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MinX = b.MinX
+	x.xxx_hidden_MinY = b.MinY
+	x.xxx_hidden_MaxX = b.MaxX
+	x.xxx_hidden_MaxY = b.MaxY
+	x.xxx_hidden_Zoom = b.Zoom
 	return m0
 }
 
 type StreamResponse struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_UserOnDuty  bool                   `protobuf:"varint,1,opt,name=user_on_duty,json=userOnDuty,proto3,oneof"`
+	xxx_hidden_StreamId    *string                `protobuf:"bytes,8,opt,name=stream_id,json=streamId,proto3,oneof"`
 	xxx_hidden_Data        isStreamResponse_Data  `protobuf_oneof:"data"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
@@ -80,7 +223,7 @@ type StreamResponse struct {
 
 func (x *StreamResponse) Reset() {
 	*x = StreamResponse{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[1]
+	mi := &file_services_livemap_livemap_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -92,7 +235,7 @@ func (x *StreamResponse) String() string {
 func (*StreamResponse) ProtoMessage() {}
 
 func (x *StreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[1]
+	mi := &file_services_livemap_livemap_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,6 +253,16 @@ func (x *StreamResponse) GetUserOnDuty() bool {
 	return false
 }
 
+func (x *StreamResponse) GetStreamId() string {
+	if x != nil {
+		if x.xxx_hidden_StreamId != nil {
+			return *x.xxx_hidden_StreamId
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *StreamResponse) GetJobs() *JobsList {
 	if x != nil {
 		if x, ok := x.xxx_hidden_Data.(*streamResponse_Jobs); ok {
@@ -155,9 +308,23 @@ func (x *StreamResponse) GetUserDeletes() *UserDeletes {
 	return nil
 }
 
+func (x *StreamResponse) GetUserClusters() *UserClusters {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Data.(*streamResponse_UserClusters); ok {
+			return x.UserClusters
+		}
+	}
+	return nil
+}
+
 func (x *StreamResponse) SetUserOnDuty(v bool) {
 	x.xxx_hidden_UserOnDuty = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *StreamResponse) SetStreamId(v string) {
+	x.xxx_hidden_StreamId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
 func (x *StreamResponse) SetJobs(v *JobsList) {
@@ -200,6 +367,14 @@ func (x *StreamResponse) SetUserDeletes(v *UserDeletes) {
 	x.xxx_hidden_Data = &streamResponse_UserDeletes{v}
 }
 
+func (x *StreamResponse) SetUserClusters(v *UserClusters) {
+	if v == nil {
+		x.xxx_hidden_Data = nil
+		return
+	}
+	x.xxx_hidden_Data = &streamResponse_UserClusters{v}
+}
+
 func (x *StreamResponse) HasUserOnDuty() bool {
 	if x == nil {
 		return false
@@ -207,6 +382,13 @@ func (x *StreamResponse) HasUserOnDuty() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
+func (x *StreamResponse) HasStreamId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
 func (x *StreamResponse) HasData() bool {
 	if x == nil {
 		return false
@@ -254,11 +436,24 @@ func (x *StreamResponse) HasUserDeletes() bool {
 	return ok
 }
 
+func (x *StreamResponse) HasUserClusters() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*streamResponse_UserClusters)
+	return ok
+}
+
 func (x *StreamResponse) ClearUserOnDuty() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_UserOnDuty = false
 }
 
+func (x *StreamResponse) ClearStreamId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_StreamId = nil
+}
+
 func (x *StreamResponse) ClearData() {
 	x.xxx_hidden_Data = nil
 }
@@ -293,12 +488,19 @@ func (x *StreamResponse) ClearUserDeletes() {
 	}
 }
 
+func (x *StreamResponse) ClearUserClusters() {
+	if _, ok := x.xxx_hidden_Data.(*streamResponse_UserClusters); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
 const StreamResponse_Data_not_set_case case_StreamResponse_Data = 0
 const StreamResponse_Jobs_case case_StreamResponse_Data = 2
 const StreamResponse_Markers_case case_StreamResponse_Data = 3
 const StreamResponse_Snapshot_case case_StreamResponse_Data = 4
 const StreamResponse_UserUpdates_case case_StreamResponse_Data = 5
 const StreamResponse_UserDeletes_case case_StreamResponse_Data = 6
+const StreamResponse_UserClusters_case case_StreamResponse_Data = 7
 
 func (x *StreamResponse) WhichData() case_StreamResponse_Data {
 	if x == nil {
@@ -315,6 +517,8 @@ func (x *StreamResponse) WhichData() case_StreamResponse_Data {
 		return StreamResponse_UserUpdates_case
 	case *streamResponse_UserDeletes:
 		return StreamResponse_UserDeletes_case
+	case *streamResponse_UserClusters:
+		return StreamResponse_UserClusters_case
 	default:
 		return StreamResponse_Data_not_set_case
 	}
@@ -324,12 +528,15 @@ type StreamResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	UserOnDuty *bool
+	// ID of the stream, used to update the stream's viewport via `SetStreamViewport`
+	StreamId *string
 	// Fields of oneof xxx_hidden_Data:
-	Jobs        *JobsList
-	Markers     *MarkerMarkersUpdates
-	Snapshot    *Snapshot
-	UserUpdates *UserUpdates
-	UserDeletes *UserDeletes
+	Jobs         *JobsList
+	Markers      *MarkerMarkersUpdates
+	Snapshot     *Snapshot
+	UserUpdates  *UserUpdates
+	UserDeletes  *UserDeletes
+	UserClusters *UserClusters
 	// -- end of xxx_hidden_Data
 }
 
@@ -338,9 +545,13 @@ func (b0 StreamResponse_builder) Build() *StreamResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.UserOnDuty != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
 		x.xxx_hidden_UserOnDuty = *b.UserOnDuty
 	}
+	if b.StreamId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_StreamId = b.StreamId
+	}
 	if b.Jobs != nil {
 		x.xxx_hidden_Data = &streamResponse_Jobs{b.Jobs}
 	}
@@ -356,13 +567,16 @@ func (b0 StreamResponse_builder) Build() *StreamResponse {
 	if b.UserDeletes != nil {
 		x.xxx_hidden_Data = &streamResponse_UserDeletes{b.UserDeletes}
 	}
+	if b.UserClusters != nil {
+		x.xxx_hidden_Data = &streamResponse_UserClusters{b.UserClusters}
+	}
 	return m0
 }
 
 type case_StreamResponse_Data protoreflect.FieldNumber
 
 func (x case_StreamResponse_Data) String() string {
-	md := file_services_livemap_livemap_proto_msgTypes[1].Descriptor()
+	md := file_services_livemap_livemap_proto_msgTypes[2].Descriptor()
 	if x == 0 {
 		return "not set"
 	}
@@ -393,6 +607,10 @@ type streamResponse_UserDeletes struct {
 	UserDeletes *UserDeletes `protobuf:"bytes,6,opt,name=user_deletes,json=userDeletes,proto3,oneof"`
 }
 
+type streamResponse_UserClusters struct {
+	UserClusters *UserClusters `protobuf:"bytes,7,opt,name=user_clusters,json=userClusters,proto3,oneof"`
+}
+
 func (*streamResponse_Jobs) isStreamResponse_Data() {}
 
 func (*streamResponse_Markers) isStreamResponse_Data() {}
@@ -403,6 +621,8 @@ func (*streamResponse_UserUpdates) isStreamResponse_Data() {}
 
 func (*streamResponse_UserDeletes) isStreamResponse_Data() {}
 
+func (*streamResponse_UserClusters) isStreamResponse_Data() {}
+
 type JobsList struct {
 	state              protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Users   *[]*jobs.Job           `protobuf:"bytes,1,rep,name=users,proto3"`
@@ -413,7 +633,7 @@ type JobsList struct {
 
 func (x *JobsList) Reset() {
 	*x = JobsList{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[2]
+	mi := &file_services_livemap_livemap_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +645,7 @@ func (x *JobsList) String() string {
 func (*JobsList) ProtoMessage() {}
 
 func (x *JobsList) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[2]
+	mi := &file_services_livemap_livemap_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +710,7 @@ type MarkerMarkersUpdates struct {
 
 func (x *MarkerMarkersUpdates) Reset() {
 	*x = MarkerMarkersUpdates{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[3]
+	mi := &file_services_livemap_livemap_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +722,7 @@ func (x *MarkerMarkersUpdates) String() string {
 func (*MarkerMarkersUpdates) ProtoMessage() {}
 
 func (x *MarkerMarkersUpdates) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[3]
+	mi := &file_services_livemap_livemap_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +814,7 @@ type Snapshot struct {
 
 func (x *Snapshot) Reset() {
 	*x = Snapshot{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[4]
+	mi := &file_services_livemap_livemap_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +826,7 @@ func (x *Snapshot) String() string {
 func (*Snapshot) ProtoMessage() {}
 
 func (x *Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[4]
+	mi := &file_services_livemap_livemap_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +875,7 @@ type UserUpdates struct {
 
 func (x *UserUpdates) Reset() {
 	*x = UserUpdates{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[5]
+	mi := &file_services_livemap_livemap_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +887,7 @@ func (x *UserUpdates) String() string {
 func (*UserUpdates) ProtoMessage() {}
 
 func (x *UserUpdates) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[5]
+	mi := &file_services_livemap_livemap_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +934,7 @@ type UserDeletes struct {
 
 func (x *UserDeletes) Reset() {
 	*x = UserDeletes{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[6]
+	mi := &file_services_livemap_livemap_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +946,7 @@ func (x *UserDeletes) String() string {
 func (*UserDeletes) ProtoMessage() {}
 
 func (x *UserDeletes) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[6]
+	mi := &file_services_livemap_livemap_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,6 +984,80 @@ func (b0 UserDeletes_builder) Build() *UserDeletes {
 	return m0
 }
 
+// Replaces all user markers and clusters currently shown to the client.
+type UserClusters struct {
+	state               protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Clusters *[]*markers.MarkerCluster `protobuf:"bytes,1,rep,name=clusters,proto3"`
+	xxx_hidden_Zoom     int32                     `protobuf:"varint,2,opt,name=zoom,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UserClusters) Reset() {
+	*x = UserClusters{}
+	mi := &file_services_livemap_livemap_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserClusters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserClusters) ProtoMessage() {}
+
+func (x *UserClusters) ProtoReflect() protoreflect.Message {
+	mi := &file_services_livemap_livemap_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UserClusters) GetClusters() []*markers.MarkerCluster {
+	if x != nil {
+		if x.xxx_hidden_Clusters != nil {
+			return *x.xxx_hidden_Clusters
+		}
+	}
+	return nil
+}
+
+func (x *UserClusters) GetZoom() int32 {
+	if x != nil {
+		return x.xxx_hidden_Zoom
+	}
+	return 0
+}
+
+func (x *UserClusters) SetClusters(v []*markers.MarkerCluster) {
+	x.xxx_hidden_Clusters = &v
+}
+
+func (x *UserClusters) SetZoom(v int32) {
+	x.xxx_hidden_Zoom = v
+}
+
+type UserClusters_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Clusters []*markers.MarkerCluster
+	Zoom     int32
+}
+
+func (b0 UserClusters_builder) Build() *UserClusters {
+	m0 := &UserClusters{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Clusters = &b.Clusters
+	x.xxx_hidden_Zoom = b.Zoom
+	return m0
+}
+
 type UserDelete struct {
 	state          protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id  int32                  `protobuf:"varint,1,opt,name=id,proto3"`
@@ -774,7 +1068,7 @@ type UserDelete struct {
 
 func (x *UserDelete) Reset() {
 	*x = UserDelete{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[7]
+	mi := &file_services_livemap_livemap_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -786,7 +1080,7 @@ func (x *UserDelete) String() string {
 func (*UserDelete) ProtoMessage() {}
 
 func (x *UserDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[7]
+	mi := &file_services_livemap_livemap_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,6 +1131,131 @@ func (b0 UserDelete_builder) Build() *UserDelete {
 	return m0
 }
 
+type SetStreamViewportRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_StreamId string                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3"`
+	xxx_hidden_Viewport *Viewport              `protobuf:"bytes,2,opt,name=viewport,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetStreamViewportRequest) Reset() {
+	*x = SetStreamViewportRequest{}
+	mi := &file_services_livemap_livemap_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStreamViewportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStreamViewportRequest) ProtoMessage() {}
+
+func (x *SetStreamViewportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_livemap_livemap_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SetStreamViewportRequest) GetStreamId() string {
+	if x != nil {
+		return x.xxx_hidden_StreamId
+	}
+	return ""
+}
+
+func (x *SetStreamViewportRequest) GetViewport() *Viewport {
+	if x != nil {
+		return x.xxx_hidden_Viewport
+	}
+	return nil
+}
+
+func (x *SetStreamViewportRequest) SetStreamId(v string) {
+	x.xxx_hidden_StreamId = v
+}
+
+func (x *SetStreamViewportRequest) SetViewport(v *Viewport) {
+	x.xxx_hidden_Viewport = v
+}
+
+func (x *SetStreamViewportRequest) HasViewport() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Viewport != nil
+}
+
+func (x *SetStreamViewportRequest) ClearViewport() {
+	x.xxx_hidden_Viewport = nil
+}
+
+type SetStreamViewportRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	StreamId string
+	Viewport *Viewport
+}
+
+func (b0 SetStreamViewportRequest_builder) Build() *SetStreamViewportRequest {
+	m0 := &SetStreamViewportRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_StreamId = b.StreamId
+	x.xxx_hidden_Viewport = b.Viewport
+	return m0
+}
+
+type SetStreamViewportResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStreamViewportResponse) Reset() {
+	*x = SetStreamViewportResponse{}
+	mi := &file_services_livemap_livemap_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStreamViewportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStreamViewportResponse) ProtoMessage() {}
+
+func (x *SetStreamViewportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_livemap_livemap_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type SetStreamViewportResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 SetStreamViewportResponse_builder) Build() *SetStreamViewportResponse {
+	m0 := &SetStreamViewportResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
 type CreateOrUpdateMarkerRequest struct {
 	state             protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Marker *markers.MarkerMarker  `protobuf:"bytes,1,opt,name=marker,proto3"`
@@ -846,7 +1265,7 @@ type CreateOrUpdateMarkerRequest struct {
 
 func (x *CreateOrUpdateMarkerRequest) Reset() {
 	*x = CreateOrUpdateMarkerRequest{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[8]
+	mi := &file_services_livemap_livemap_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +1277,7 @@ func (x *CreateOrUpdateMarkerRequest) String() string {
 func (*CreateOrUpdateMarkerRequest) ProtoMessage() {}
 
 func (x *CreateOrUpdateMarkerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[8]
+	mi := &file_services_livemap_livemap_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1333,7 @@ type CreateOrUpdateMarkerResponse struct {
 
 func (x *CreateOrUpdateMarkerResponse) Reset() {
 	*x = CreateOrUpdateMarkerResponse{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[9]
+	mi := &file_services_livemap_livemap_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -926,7 +1345,7 @@ func (x *CreateOrUpdateMarkerResponse) String() string {
 func (*CreateOrUpdateMarkerResponse) ProtoMessage() {}
 
 func (x *CreateOrUpdateMarkerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[9]
+	mi := &file_services_livemap_livemap_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1401,7 @@ type DeleteMarkerRequest struct {
 
 func (x *DeleteMarkerRequest) Reset() {
 	*x = DeleteMarkerRequest{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[10]
+	mi := &file_services_livemap_livemap_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -994,7 +1413,7 @@ func (x *DeleteMarkerRequest) String() string {
 func (*DeleteMarkerRequest) ProtoMessage() {}
 
 func (x *DeleteMarkerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[10]
+	mi := &file_services_livemap_livemap_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,7 +1457,7 @@ type DeleteMarkerResponse struct {
 
 func (x *DeleteMarkerResponse) Reset() {
 	*x = DeleteMarkerResponse{}
-	mi := &file_services_livemap_livemap_proto_msgTypes[11]
+	mi := &file_services_livemap_livemap_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1050,7 +1469,7 @@ func (x *DeleteMarkerResponse) String() string {
 func (*DeleteMarkerResponse) ProtoMessage() {}
 
 func (x *DeleteMarkerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_livemap_livemap_proto_msgTypes[11]
+	mi := &file_services_livemap_livemap_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,22 +1492,323 @@ func (b0 DeleteMarkerResponse_builder) Build() *DeleteMarkerResponse {
 	return m0
 }
 
+type GetPositionTrailsRequest struct {
+	state             protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Target isGetPositionTrailsRequest_Target `protobuf_oneof:"target"`
+	xxx_hidden_Start  *timestamp.Timestamp              `protobuf:"bytes,3,opt,name=start,proto3,oneof"`
+	xxx_hidden_End    *timestamp.Timestamp              `protobuf:"bytes,4,opt,name=end,proto3,oneof"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetPositionTrailsRequest) Reset() {
+	*x = GetPositionTrailsRequest{}
+	mi := &file_services_livemap_livemap_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPositionTrailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionTrailsRequest) ProtoMessage() {}
+
+func (x *GetPositionTrailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_livemap_livemap_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetPositionTrailsRequest) GetUserId() int32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Target.(*getPositionTrailsRequest_UserId); ok {
+			return x.UserId
+		}
+	}
+	return 0
+}
+
+func (x *GetPositionTrailsRequest) GetUnitId() int64 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Target.(*getPositionTrailsRequest_UnitId); ok {
+			return x.UnitId
+		}
+	}
+	return 0
+}
+
+func (x *GetPositionTrailsRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return nil
+}
+
+func (x *GetPositionTrailsRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_End
+	}
+	return nil
+}
+
+func (x *GetPositionTrailsRequest) SetUserId(v int32) {
+	x.xxx_hidden_Target = &getPositionTrailsRequest_UserId{v}
+}
+
+func (x *GetPositionTrailsRequest) SetUnitId(v int64) {
+	x.xxx_hidden_Target = &getPositionTrailsRequest_UnitId{v}
+}
+
+func (x *GetPositionTrailsRequest) SetStart(v *timestamp.Timestamp) {
+	x.xxx_hidden_Start = v
+}
+
+func (x *GetPositionTrailsRequest) SetEnd(v *timestamp.Timestamp) {
+	x.xxx_hidden_End = v
+}
+
+func (x *GetPositionTrailsRequest) HasTarget() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Target != nil
+}
+
+func (x *GetPositionTrailsRequest) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Target.(*getPositionTrailsRequest_UserId)
+	return ok
+}
+
+func (x *GetPositionTrailsRequest) HasUnitId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Target.(*getPositionTrailsRequest_UnitId)
+	return ok
+}
+
+func (x *GetPositionTrailsRequest) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Start != nil
+}
+
+func (x *GetPositionTrailsRequest) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_End != nil
+}
+
+func (x *GetPositionTrailsRequest) ClearTarget() {
+	x.xxx_hidden_Target = nil
+}
+
+func (x *GetPositionTrailsRequest) ClearUserId() {
+	if _, ok := x.xxx_hidden_Target.(*getPositionTrailsRequest_UserId); ok {
+		x.xxx_hidden_Target = nil
+	}
+}
+
+func (x *GetPositionTrailsRequest) ClearUnitId() {
+	if _, ok := x.xxx_hidden_Target.(*getPositionTrailsRequest_UnitId); ok {
+		x.xxx_hidden_Target = nil
+	}
+}
+
+func (x *GetPositionTrailsRequest) ClearStart() {
+	x.xxx_hidden_Start = nil
+}
+
+func (x *GetPositionTrailsRequest) ClearEnd() {
+	x.xxx_hidden_End = nil
+}
+
+const GetPositionTrailsRequest_Target_not_set_case case_GetPositionTrailsRequest_Target = 0
+const GetPositionTrailsRequest_UserId_case case_GetPositionTrailsRequest_Target = 1
+const GetPositionTrailsRequest_UnitId_case case_GetPositionTrailsRequest_Target = 2
+
+func (x *GetPositionTrailsRequest) WhichTarget() case_GetPositionTrailsRequest_Target {
+	if x == nil {
+		return GetPositionTrailsRequest_Target_not_set_case
+	}
+	switch x.xxx_hidden_Target.(type) {
+	case *getPositionTrailsRequest_UserId:
+		return GetPositionTrailsRequest_UserId_case
+	case *getPositionTrailsRequest_UnitId:
+		return GetPositionTrailsRequest_UnitId_case
+	default:
+		return GetPositionTrailsRequest_Target_not_set_case
+	}
+}
+
+type GetPositionTrailsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Target:
+	UserId *int32
+	UnitId *int64
+	// -- end of xxx_hidden_Target
+	// Defaults to one hour before the end
+	Start *timestamp.Timestamp
+	// Defaults to now
+	End *timestamp.Timestamp
+}
+
+func (b0 GetPositionTrailsRequest_builder) Build() *GetPositionTrailsRequest {
+	m0 := &GetPositionTrailsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UserId != nil {
+		x.xxx_hidden_Target = &getPositionTrailsRequest_UserId{*b.UserId}
+	}
+	if b.UnitId != nil {
+		x.xxx_hidden_Target = &getPositionTrailsRequest_UnitId{*b.UnitId}
+	}
+	x.xxx_hidden_Start = b.Start
+	x.xxx_hidden_End = b.End
+	return m0
+}
+
+type case_GetPositionTrailsRequest_Target protoreflect.FieldNumber
+
+func (x case_GetPositionTrailsRequest_Target) String() string {
+	md := file_services_livemap_livemap_proto_msgTypes[16].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isGetPositionTrailsRequest_Target interface {
+	isGetPositionTrailsRequest_Target()
+}
+
+type getPositionTrailsRequest_UserId struct {
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type getPositionTrailsRequest_UnitId struct {
+	UnitId int64 `protobuf:"varint,2,opt,name=unit_id,json=unitId,proto3,oneof"`
+}
+
+func (*getPositionTrailsRequest_UserId) isGetPositionTrailsRequest_Target() {}
+
+func (*getPositionTrailsRequest_UnitId) isGetPositionTrailsRequest_Target() {}
+
+type GetPositionTrailsResponse struct {
+	state                protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Trails    *[]*history.PositionTrail `protobuf:"bytes,1,rep,name=trails,proto3"`
+	xxx_hidden_Truncated bool                      `protobuf:"varint,2,opt,name=truncated,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetPositionTrailsResponse) Reset() {
+	*x = GetPositionTrailsResponse{}
+	mi := &file_services_livemap_livemap_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPositionTrailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionTrailsResponse) ProtoMessage() {}
+
+func (x *GetPositionTrailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_livemap_livemap_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetPositionTrailsResponse) GetTrails() []*history.PositionTrail {
+	if x != nil {
+		if x.xxx_hidden_Trails != nil {
+			return *x.xxx_hidden_Trails
+		}
+	}
+	return nil
+}
+
+func (x *GetPositionTrailsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.xxx_hidden_Truncated
+	}
+	return false
+}
+
+func (x *GetPositionTrailsResponse) SetTrails(v []*history.PositionTrail) {
+	x.xxx_hidden_Trails = &v
+}
+
+func (x *GetPositionTrailsResponse) SetTruncated(v bool) {
+	x.xxx_hidden_Truncated = v
+}
+
+type GetPositionTrailsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Trails []*history.PositionTrail
+	// Set when the window contained more positions than are returned
+	Truncated bool
+}
+
+func (b0 GetPositionTrailsResponse_builder) Build() *GetPositionTrailsResponse {
+	m0 := &GetPositionTrailsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Trails = &b.Trails
+	x.xxx_hidden_Truncated = b.Truncated
+	return m0
+}
+
 var File_services_livemap_livemap_proto protoreflect.FileDescriptor
 
 const file_services_livemap_livemap_proto_rawDesc = "" +
 	"\n" +
-	"\x1eservices/livemap/livemap.proto\x12\x10services.livemap\x1a\x19codegen/perms/perms.proto\x1a\x19resources/jobs/jobs.proto\x1a-resources/livemap/markers/marker_marker.proto\x1a+resources/livemap/markers/user_marker.proto\"\x0f\n" +
-	"\rStreamRequest\"\x88\x03\n" +
+	"\x1eservices/livemap/livemap.proto\x12\x10services.livemap\x1a\x19codegen/perms/perms.proto\x1a\x19resources/jobs/jobs.proto\x1a'resources/livemap/history/history.proto\x1a'resources/livemap/markers/cluster.proto\x1a-resources/livemap/markers/marker_marker.proto\x1a+resources/livemap/markers/user_marker.proto\x1a#resources/timestamp/timestamp.proto\"Y\n" +
+	"\rStreamRequest\x12;\n" +
+	"\bviewport\x18\x01 \x01(\v2\x1a.services.livemap.ViewportH\x00R\bviewport\x88\x01\x01B\v\n" +
+	"\t_viewport\"r\n" +
+	"\bViewport\x12\x13\n" +
+	"\x05min_x\x18\x01 \x01(\x01R\x04minX\x12\x13\n" +
+	"\x05min_y\x18\x02 \x01(\x01R\x04minY\x12\x13\n" +
+	"\x05max_x\x18\x03 \x01(\x01R\x04maxX\x12\x13\n" +
+	"\x05max_y\x18\x04 \x01(\x01R\x04maxY\x12\x12\n" +
+	"\x04zoom\x18\x05 \x01(\x05R\x04zoom\"\xff\x03\n" +
 	"\x0eStreamResponse\x12%\n" +
 	"\fuser_on_duty\x18\x01 \x01(\bH\x01R\n" +
-	"userOnDuty\x88\x01\x01\x120\n" +
+	"userOnDuty\x88\x01\x01\x12 \n" +
+	"\tstream_id\x18\b \x01(\tH\x02R\bstreamId\x88\x01\x01\x120\n" +
 	"\x04jobs\x18\x02 \x01(\v2\x1a.services.livemap.JobsListH\x00R\x04jobs\x12B\n" +
 	"\amarkers\x18\x03 \x01(\v2&.services.livemap.MarkerMarkersUpdatesH\x00R\amarkers\x128\n" +
 	"\bsnapshot\x18\x04 \x01(\v2\x1a.services.livemap.SnapshotH\x00R\bsnapshot\x12B\n" +
 	"\fuser_updates\x18\x05 \x01(\v2\x1d.services.livemap.UserUpdatesH\x00R\vuserUpdates\x12B\n" +
-	"\fuser_deletes\x18\x06 \x01(\v2\x1d.services.livemap.UserDeletesH\x00R\vuserDeletesB\x06\n" +
+	"\fuser_deletes\x18\x06 \x01(\v2\x1d.services.livemap.UserDeletesH\x00R\vuserDeletes\x12E\n" +
+	"\ruser_clusters\x18\a \x01(\v2\x1e.services.livemap.UserClustersH\x00R\fuserClustersB\x06\n" +
 	"\x04dataB\x0f\n" +
-	"\r_user_on_duty\"d\n" +
+	"\r_user_on_dutyB\f\n" +
+	"\n" +
+	"_stream_id\"d\n" +
 	"\bJobsList\x12)\n" +
 	"\x05users\x18\x01 \x03(\v2\x13.resources.jobs.JobR\x05users\x12-\n" +
 	"\amarkers\x18\x02 \x03(\v2\x13.resources.jobs.JobR\amarkers\"\xa1\x01\n" +
@@ -1102,72 +1822,114 @@ const file_services_livemap_livemap_proto_rawDesc = "" +
 	"\vUserUpdates\x12?\n" +
 	"\aupdates\x18\x01 \x03(\v2%.resources.livemap.markers.UserMarkerR\aupdates\"E\n" +
 	"\vUserDeletes\x126\n" +
-	"\adeletes\x18\x01 \x03(\v2\x1c.services.livemap.UserDeleteR\adeletes\".\n" +
+	"\adeletes\x18\x01 \x03(\v2\x1c.services.livemap.UserDeleteR\adeletes\"h\n" +
+	"\fUserClusters\x12D\n" +
+	"\bclusters\x18\x01 \x03(\v2(.resources.livemap.markers.MarkerClusterR\bclusters\x12\x12\n" +
+	"\x04zoom\x18\x02 \x01(\x05R\x04zoom\".\n" +
 	"\n" +
 	"UserDelete\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
-	"\x03job\x18\x02 \x01(\tR\x03job\"^\n" +
+	"\x03job\x18\x02 \x01(\tR\x03job\"o\n" +
+	"\x18SetStreamViewportRequest\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\x126\n" +
+	"\bviewport\x18\x02 \x01(\v2\x1a.services.livemap.ViewportR\bviewport\"\x1b\n" +
+	"\x19SetStreamViewportResponse\"^\n" +
 	"\x1bCreateOrUpdateMarkerRequest\x12?\n" +
 	"\x06marker\x18\x01 \x01(\v2'.resources.livemap.markers.MarkerMarkerR\x06marker\"_\n" +
 	"\x1cCreateOrUpdateMarkerResponse\x12?\n" +
 	"\x06marker\x18\x01 \x01(\v2'.resources.livemap.markers.MarkerMarkerR\x06marker\"%\n" +
 	"\x13DeleteMarkerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x16\n" +
-	"\x14DeleteMarkerResponse2\xde\x03\n" +
+	"\x14DeleteMarkerResponse\"\xde\x01\n" +
+	"\x18GetPositionTrailsRequest\x12\x19\n" +
+	"\auser_id\x18\x01 \x01(\x05H\x00R\x06userId\x12\x19\n" +
+	"\aunit_id\x18\x02 \x01(\x03H\x00R\x06unitId\x129\n" +
+	"\x05start\x18\x03 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\x05start\x88\x01\x01\x125\n" +
+	"\x03end\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\x03end\x88\x01\x01B\b\n" +
+	"\x06targetB\b\n" +
+	"\x06_startB\x06\n" +
+	"\x04_end\"{\n" +
+	"\x19GetPositionTrailsResponse\x12@\n" +
+	"\x06trails\x18\x01 \x03(\v2(.resources.livemap.history.PositionTrailR\x06trails\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated2\xda\x05\n" +
 	"\x0eLivemapService\x12o\n" +
 	"\x06Stream\x12\x1f.services.livemap.StreamRequest\x1a .services.livemap.StreamResponse\" \xd2\xf3\x18\x1c\b\x01:\v\n" +
 	"\aMarkers\x18\x02:\v\n" +
-	"\aPlayers\x18\x030\x01\x12\xaa\x01\n" +
+	"\aPlayers\x18\x030\x01\x12|\n" +
+	"\x11SetStreamViewport\x12*.services.livemap.SetStreamViewportRequest\x1a+.services.livemap.SetStreamViewportResponse\"\x0e\xd2\xf3\x18\n" +
+	"\b\x01\"\x06Stream\x12\xaa\x01\n" +
 	"\x14CreateOrUpdateMarker\x12-.services.livemap.CreateOrUpdateMarkerRequest\x1a..services.livemap.CreateOrUpdateMarkerResponse\"3\xd2\xf3\x18/\b\x01:+\n" +
 	"\x06Access\x18\x01\"\x03Own\"\n" +
-	"Lower_Rank\"\tSame_Rank\"\x03Any\x12\x92\x01\n" +
+	"Lower_Rank\"\tSame_Rank\"\x03Any\x12|\n" +
+	"\x11GetPositionTrails\x12*.services.livemap.GetPositionTrailsRequest\x1a+.services.livemap.GetPositionTrailsResponse\"\x0e\xd2\xf3\x18\n" +
+	"\b\x01\"\x06Stream\x12\x92\x01\n" +
 	"\fDeleteMarker\x12%.services.livemap.DeleteMarkerRequest\x1a&.services.livemap.DeleteMarkerResponse\"3\xd2\xf3\x18/\b\x01:+\n" +
 	"\x06Access\x18\x01\"\x03Own\"\n" +
 	"Lower_Rank\"\tSame_Rank\"\x03Any\x1a\x19\xea\xf3\x18\x15\bZ\x12\x11i-mdi-map-outlineBLZJgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap;livemapb\x06proto3"
 
-var file_services_livemap_livemap_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_services_livemap_livemap_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_services_livemap_livemap_proto_goTypes = []any{
 	(*StreamRequest)(nil),                // 0: services.livemap.StreamRequest
-	(*StreamResponse)(nil),               // 1: services.livemap.StreamResponse
-	(*JobsList)(nil),                     // 2: services.livemap.JobsList
-	(*MarkerMarkersUpdates)(nil),         // 3: services.livemap.MarkerMarkersUpdates
-	(*Snapshot)(nil),                     // 4: services.livemap.Snapshot
-	(*UserUpdates)(nil),                  // 5: services.livemap.UserUpdates
-	(*UserDeletes)(nil),                  // 6: services.livemap.UserDeletes
-	(*UserDelete)(nil),                   // 7: services.livemap.UserDelete
-	(*CreateOrUpdateMarkerRequest)(nil),  // 8: services.livemap.CreateOrUpdateMarkerRequest
-	(*CreateOrUpdateMarkerResponse)(nil), // 9: services.livemap.CreateOrUpdateMarkerResponse
-	(*DeleteMarkerRequest)(nil),          // 10: services.livemap.DeleteMarkerRequest
-	(*DeleteMarkerResponse)(nil),         // 11: services.livemap.DeleteMarkerResponse
-	(*jobs.Job)(nil),                     // 12: resources.jobs.Job
-	(*markers.MarkerMarker)(nil),         // 13: resources.livemap.markers.MarkerMarker
-	(*markers.UserMarker)(nil),           // 14: resources.livemap.markers.UserMarker
+	(*Viewport)(nil),                     // 1: services.livemap.Viewport
+	(*StreamResponse)(nil),               // 2: services.livemap.StreamResponse
+	(*JobsList)(nil),                     // 3: services.livemap.JobsList
+	(*MarkerMarkersUpdates)(nil),         // 4: services.livemap.MarkerMarkersUpdates
+	(*Snapshot)(nil),                     // 5: services.livemap.Snapshot
+	(*UserUpdates)(nil),                  // 6: services.livemap.UserUpdates
+	(*UserDeletes)(nil),                  // 7: services.livemap.UserDeletes
+	(*UserClusters)(nil),                 // 8: services.livemap.UserClusters
+	(*UserDelete)(nil),                   // 9: services.livemap.UserDelete
+	(*SetStreamViewportRequest)(nil),     // 10: services.livemap.SetStreamViewportRequest
+	(*SetStreamViewportResponse)(nil),    // 11: services.livemap.SetStreamViewportResponse
+	(*CreateOrUpdateMarkerRequest)(nil),  // 12: services.livemap.CreateOrUpdateMarkerRequest
+	(*CreateOrUpdateMarkerResponse)(nil), // 13: services.livemap.CreateOrUpdateMarkerResponse
+	(*DeleteMarkerRequest)(nil),          // 14: services.livemap.DeleteMarkerRequest
+	(*DeleteMarkerResponse)(nil),         // 15: services.livemap.DeleteMarkerResponse
+	(*GetPositionTrailsRequest)(nil),     // 16: services.livemap.GetPositionTrailsRequest
+	(*GetPositionTrailsResponse)(nil),    // 17: services.livemap.GetPositionTrailsResponse
+	(*jobs.Job)(nil),                     // 18: resources.jobs.Job
+	(*markers.MarkerMarker)(nil),         // 19: resources.livemap.markers.MarkerMarker
+	(*markers.UserMarker)(nil),           // 20: resources.livemap.markers.UserMarker
+	(*markers.MarkerCluster)(nil),        // 21: resources.livemap.markers.MarkerCluster
+	(*timestamp.Timestamp)(nil),          // 22: resources.timestamp.Timestamp
+	(*history.PositionTrail)(nil),        // 23: resources.livemap.history.PositionTrail
 }
 var file_services_livemap_livemap_proto_depIdxs = []int32{
-	2,  // 0: services.livemap.StreamResponse.jobs:type_name -> services.livemap.JobsList
-	3,  // 1: services.livemap.StreamResponse.markers:type_name -> services.livemap.MarkerMarkersUpdates
-	4,  // 2: services.livemap.StreamResponse.snapshot:type_name -> services.livemap.Snapshot
-	5,  // 3: services.livemap.StreamResponse.user_updates:type_name -> services.livemap.UserUpdates
-	6,  // 4: services.livemap.StreamResponse.user_deletes:type_name -> services.livemap.UserDeletes
-	12, // 5: services.livemap.JobsList.users:type_name -> resources.jobs.Job
-	12, // 6: services.livemap.JobsList.markers:type_name -> resources.jobs.Job
-	13, // 7: services.livemap.MarkerMarkersUpdates.updated:type_name -> resources.livemap.markers.MarkerMarker
-	14, // 8: services.livemap.Snapshot.markers:type_name -> resources.livemap.markers.UserMarker
-	14, // 9: services.livemap.UserUpdates.updates:type_name -> resources.livemap.markers.UserMarker
-	7,  // 10: services.livemap.UserDeletes.deletes:type_name -> services.livemap.UserDelete
-	13, // 11: services.livemap.CreateOrUpdateMarkerRequest.marker:type_name -> resources.livemap.markers.MarkerMarker
-	13, // 12: services.livemap.CreateOrUpdateMarkerResponse.marker:type_name -> resources.livemap.markers.MarkerMarker
-	0,  // 13: services.livemap.LivemapService.Stream:input_type -> services.livemap.StreamRequest
-	8,  // 14: services.livemap.LivemapService.CreateOrUpdateMarker:input_type -> services.livemap.CreateOrUpdateMarkerRequest
-	10, // 15: services.livemap.LivemapService.DeleteMarker:input_type -> services.livemap.DeleteMarkerRequest
-	1,  // 16: services.livemap.LivemapService.Stream:output_type -> services.livemap.StreamResponse
-	9,  // 17: services.livemap.LivemapService.CreateOrUpdateMarker:output_type -> services.livemap.CreateOrUpdateMarkerResponse
-	11, // 18: services.livemap.LivemapService.DeleteMarker:output_type -> services.livemap.DeleteMarkerResponse
-	16, // [16:19] is the sub-list for method output_type
-	13, // [13:16] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	1,  // 0: services.livemap.StreamRequest.viewport:type_name -> services.livemap.Viewport
+	3,  // 1: services.livemap.StreamResponse.jobs:type_name -> services.livemap.JobsList
+	4,  // 2: services.livemap.StreamResponse.markers:type_name -> services.livemap.MarkerMarkersUpdates
+	5,  // 3: services.livemap.StreamResponse.snapshot:type_name -> services.livemap.Snapshot
+	6,  // 4: services.livemap.StreamResponse.user_updates:type_name -> services.livemap.UserUpdates
+	7,  // 5: services.livemap.StreamResponse.user_deletes:type_name -> services.livemap.UserDeletes
+	8,  // 6: services.livemap.StreamResponse.user_clusters:type_name -> services.livemap.UserClusters
+	18, // 7: services.livemap.JobsList.users:type_name -> resources.jobs.Job
+	18, // 8: services.livemap.JobsList.markers:type_name -> resources.jobs.Job
+	19, // 9: services.livemap.MarkerMarkersUpdates.updated:type_name -> resources.livemap.markers.MarkerMarker
+	20, // 10: services.livemap.Snapshot.markers:type_name -> resources.livemap.markers.UserMarker
+	20, // 11: services.livemap.UserUpdates.updates:type_name -> resources.livemap.markers.UserMarker
+	9,  // 12: services.livemap.UserDeletes.deletes:type_name -> services.livemap.UserDelete
+	21, // 13: services.livemap.UserClusters.clusters:type_name -> resources.livemap.markers.MarkerCluster
+	1,  // 14: services.livemap.SetStreamViewportRequest.viewport:type_name -> services.livemap.Viewport
+	19, // 15: services.livemap.CreateOrUpdateMarkerRequest.marker:type_name -> resources.livemap.markers.MarkerMarker
+	19, // 16: services.livemap.CreateOrUpdateMarkerResponse.marker:type_name -> resources.livemap.markers.MarkerMarker
+	22, // 17: services.livemap.GetPositionTrailsRequest.start:type_name -> resources.timestamp.Timestamp
+	22, // 18: services.livemap.GetPositionTrailsRequest.end:type_name -> resources.timestamp.Timestamp
+	23, // 19: services.livemap.GetPositionTrailsResponse.trails:type_name -> resources.livemap.history.PositionTrail
+	0,  // 20: services.livemap.LivemapService.Stream:input_type -> services.livemap.StreamRequest
+	10, // 21: services.livemap.LivemapService.SetStreamViewport:input_type -> services.livemap.SetStreamViewportRequest
+	12, // 22: services.livemap.LivemapService.CreateOrUpdateMarker:input_type -> services.livemap.CreateOrUpdateMarkerRequest
+	16, // 23: services.livemap.LivemapService.GetPositionTrails:input_type -> services.livemap.GetPositionTrailsRequest
+	14, // 24: services.livemap.LivemapService.DeleteMarker:input_type -> services.livemap.DeleteMarkerRequest
+	2,  // 25: services.livemap.LivemapService.Stream:output_type -> services.livemap.StreamResponse
+	11, // 26: services.livemap.LivemapService.SetStreamViewport:output_type -> services.livemap.SetStreamViewportResponse
+	13, // 27: services.livemap.LivemapService.CreateOrUpdateMarker:output_type -> services.livemap.CreateOrUpdateMarkerResponse
+	17, // 28: services.livemap.LivemapService.GetPositionTrails:output_type -> services.livemap.GetPositionTrailsResponse
+	15, // 29: services.livemap.LivemapService.DeleteMarker:output_type -> services.livemap.DeleteMarkerResponse
+	25, // [25:30] is the sub-list for method output_type
+	20, // [20:25] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_services_livemap_livemap_proto_init() }
@@ -1175,12 +1937,18 @@ func file_services_livemap_livemap_proto_init() {
 	if File_services_livemap_livemap_proto != nil {
 		return
 	}
-	file_services_livemap_livemap_proto_msgTypes[1].OneofWrappers = []any{
+	file_services_livemap_livemap_proto_msgTypes[0].OneofWrappers = []any{}
+	file_services_livemap_livemap_proto_msgTypes[2].OneofWrappers = []any{
 		(*streamResponse_Jobs)(nil),
 		(*streamResponse_Markers)(nil),
 		(*streamResponse_Snapshot)(nil),
 		(*streamResponse_UserUpdates)(nil),
 		(*streamResponse_UserDeletes)(nil),
+		(*streamResponse_UserClusters)(nil),
+	}
+	file_services_livemap_livemap_proto_msgTypes[16].OneofWrappers = []any{
+		(*getPositionTrailsRequest_UserId)(nil),
+		(*getPositionTrailsRequest_UnitId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1188,7 +1956,7 @@ func file_services_livemap_livemap_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_livemap_livemap_proto_rawDesc), len(file_services_livemap_livemap_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,6 +19,15 @@ func (m *GetExamInfoResponse) Sanitize() error {
 		}
 	}
 
+	// Field: NextAttemptAt
+	if m.NextAttemptAt != nil {
+		if v, ok := any(m.GetNextAttemptAt()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Field: Qualification
 	if m.Qualification != nil {
 		if v, ok := any(m.GetQualification()).(interface{ Sanitize() error }); ok {
@@ -65,6 +74,18 @@ func (m *GetUserExamResponse) Sanitize() error {
 		}
 	}
 
+	// Field: Log
+	for idx, item := range m.Log {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// Field: Responses
 	if m.Responses != nil {
 		if v, ok := any(m.GetResponses()).(interface{ Sanitize() error }); ok {
@@ -77,6 +98,25 @@ func (m *GetUserExamResponse) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ReportExamFocusLostRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Duration
+	if m.Duration != nil {
+		if v, ok := any(m.GetDuration()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *SubmitExamRequest) Sanitize() error {
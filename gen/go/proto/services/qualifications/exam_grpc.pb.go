@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExamService_GetExamInfo_FullMethodName = "/services.qualifications.ExamService/GetExamInfo"
-	ExamService_TakeExam_FullMethodName    = "/services.qualifications.ExamService/TakeExam"
-	ExamService_SubmitExam_FullMethodName  = "/services.qualifications.ExamService/SubmitExam"
-	ExamService_GetUserExam_FullMethodName = "/services.qualifications.ExamService/GetUserExam"
+	ExamService_GetExamInfo_FullMethodName         = "/services.qualifications.ExamService/GetExamInfo"
+	ExamService_TakeExam_FullMethodName            = "/services.qualifications.ExamService/TakeExam"
+	ExamService_SubmitExam_FullMethodName          = "/services.qualifications.ExamService/SubmitExam"
+	ExamService_GetUserExam_FullMethodName         = "/services.qualifications.ExamService/GetUserExam"
+	ExamService_ReportExamFocusLost_FullMethodName = "/services.qualifications.ExamService/ReportExamFocusLost"
 )
 
 // ExamServiceClient is the client API for ExamService service.
@@ -33,6 +34,7 @@ type ExamServiceClient interface {
 	TakeExam(ctx context.Context, in *TakeExamRequest, opts ...grpc.CallOption) (*TakeExamResponse, error)
 	SubmitExam(ctx context.Context, in *SubmitExamRequest, opts ...grpc.CallOption) (*SubmitExamResponse, error)
 	GetUserExam(ctx context.Context, in *GetUserExamRequest, opts ...grpc.CallOption) (*GetUserExamResponse, error)
+	ReportExamFocusLost(ctx context.Context, in *ReportExamFocusLostRequest, opts ...grpc.CallOption) (*ReportExamFocusLostResponse, error)
 }
 
 type examServiceClient struct {
@@ -83,6 +85,16 @@ func (c *examServiceClient) GetUserExam(ctx context.Context, in *GetUserExamRequ
 	return out, nil
 }
 
+func (c *examServiceClient) ReportExamFocusLost(ctx context.Context, in *ReportExamFocusLostRequest, opts ...grpc.CallOption) (*ReportExamFocusLostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportExamFocusLostResponse)
+	err := c.cc.Invoke(ctx, ExamService_ReportExamFocusLost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExamServiceServer is the server API for ExamService service.
 // All implementations must embed UnimplementedExamServiceServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type ExamServiceServer interface {
 	TakeExam(context.Context, *TakeExamRequest) (*TakeExamResponse, error)
 	SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error)
 	GetUserExam(context.Context, *GetUserExamRequest) (*GetUserExamResponse, error)
+	ReportExamFocusLost(context.Context, *ReportExamFocusLostRequest) (*ReportExamFocusLostResponse, error)
 	mustEmbedUnimplementedExamServiceServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedExamServiceServer) SubmitExam(context.Context, *SubmitExamReq
 func (UnimplementedExamServiceServer) GetUserExam(context.Context, *GetUserExamRequest) (*GetUserExamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserExam not implemented")
 }
+func (UnimplementedExamServiceServer) ReportExamFocusLost(context.Context, *ReportExamFocusLostRequest) (*ReportExamFocusLostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExamFocusLost not implemented")
+}
 func (UnimplementedExamServiceServer) mustEmbedUnimplementedExamServiceServer() {}
 func (UnimplementedExamServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,6 +222,24 @@ func _ExamService_GetUserExam_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExamService_ReportExamFocusLost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportExamFocusLostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ReportExamFocusLost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_ReportExamFocusLost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ReportExamFocusLost(ctx, req.(*ReportExamFocusLostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExamService_ServiceDesc is the grpc.ServiceDesc for ExamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserExam",
 			Handler:    _ExamService_GetUserExam_Handler,
 		},
+		{
+			MethodName: "ReportExamFocusLost",
+			Handler:    _ExamService_ReportExamFocusLost_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/qualifications/exam.proto",
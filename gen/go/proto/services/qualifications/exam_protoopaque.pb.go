@@ -12,6 +12,7 @@ import (
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/perms"
 	qualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
 	exam "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/exam"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -84,12 +85,14 @@ func (b0 GetExamInfoRequest_builder) Build() *GetExamInfoRequest {
 }
 
 type GetExamInfoResponse struct {
-	state                    protoimpl.MessageState             `protogen:"opaque.v1"`
-	xxx_hidden_Qualification *qualifications.QualificationShort `protobuf:"bytes,1,opt,name=qualification,proto3"`
-	xxx_hidden_QuestionCount int64                              `protobuf:"varint,2,opt,name=question_count,json=questionCount,proto3"`
-	xxx_hidden_ExamUser      *exam.ExamUser                     `protobuf:"bytes,3,opt,name=exam_user,json=examUser,proto3,oneof"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	state                     protoimpl.MessageState             `protogen:"opaque.v1"`
+	xxx_hidden_Qualification  *qualifications.QualificationShort `protobuf:"bytes,1,opt,name=qualification,proto3"`
+	xxx_hidden_QuestionCount  int64                              `protobuf:"varint,2,opt,name=question_count,json=questionCount,proto3"`
+	xxx_hidden_ExamUser       *exam.ExamUser                     `protobuf:"bytes,3,opt,name=exam_user,json=examUser,proto3,oneof"`
+	xxx_hidden_FailedAttempts int32                              `protobuf:"varint,4,opt,name=failed_attempts,json=failedAttempts,proto3"`
+	xxx_hidden_NextAttemptAt  *timestamp.Timestamp               `protobuf:"bytes,5,opt,name=next_attempt_at,json=nextAttemptAt,proto3,oneof"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *GetExamInfoResponse) Reset() {
@@ -138,6 +141,20 @@ func (x *GetExamInfoResponse) GetExamUser() *exam.ExamUser {
 	return nil
 }
 
+func (x *GetExamInfoResponse) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.xxx_hidden_FailedAttempts
+	}
+	return 0
+}
+
+func (x *GetExamInfoResponse) GetNextAttemptAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_NextAttemptAt
+	}
+	return nil
+}
+
 func (x *GetExamInfoResponse) SetQualification(v *qualifications.QualificationShort) {
 	x.xxx_hidden_Qualification = v
 }
@@ -150,6 +167,14 @@ func (x *GetExamInfoResponse) SetExamUser(v *exam.ExamUser) {
 	x.xxx_hidden_ExamUser = v
 }
 
+func (x *GetExamInfoResponse) SetFailedAttempts(v int32) {
+	x.xxx_hidden_FailedAttempts = v
+}
+
+func (x *GetExamInfoResponse) SetNextAttemptAt(v *timestamp.Timestamp) {
+	x.xxx_hidden_NextAttemptAt = v
+}
+
 func (x *GetExamInfoResponse) HasQualification() bool {
 	if x == nil {
 		return false
@@ -164,6 +189,13 @@ func (x *GetExamInfoResponse) HasExamUser() bool {
 	return x.xxx_hidden_ExamUser != nil
 }
 
+func (x *GetExamInfoResponse) HasNextAttemptAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NextAttemptAt != nil
+}
+
 func (x *GetExamInfoResponse) ClearQualification() {
 	x.xxx_hidden_Qualification = nil
 }
@@ -172,12 +204,19 @@ func (x *GetExamInfoResponse) ClearExamUser() {
 	x.xxx_hidden_ExamUser = nil
 }
 
+func (x *GetExamInfoResponse) ClearNextAttemptAt() {
+	x.xxx_hidden_NextAttemptAt = nil
+}
+
 type GetExamInfoResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Qualification *qualifications.QualificationShort
-	QuestionCount int64
-	ExamUser      *exam.ExamUser
+	Qualification  *qualifications.QualificationShort
+	QuestionCount  int64
+	ExamUser       *exam.ExamUser
+	FailedAttempts int32
+	// Set when the user has to wait for the retry cooldown to pass
+	NextAttemptAt *timestamp.Timestamp
 }
 
 func (b0 GetExamInfoResponse_builder) Build() *GetExamInfoResponse {
@@ -187,6 +226,8 @@ func (b0 GetExamInfoResponse_builder) Build() *GetExamInfoResponse {
 	x.xxx_hidden_Qualification = b.Qualification
 	x.xxx_hidden_QuestionCount = b.QuestionCount
 	x.xxx_hidden_ExamUser = b.ExamUser
+	x.xxx_hidden_FailedAttempts = b.FailedAttempts
+	x.xxx_hidden_NextAttemptAt = b.NextAttemptAt
 	return m0
 }
 
@@ -652,6 +693,7 @@ type GetUserExamResponse struct {
 	xxx_hidden_ExamUser  *exam.ExamUser         `protobuf:"bytes,2,opt,name=exam_user,json=examUser,proto3"`
 	xxx_hidden_Responses *exam.ExamResponses    `protobuf:"bytes,3,opt,name=responses,proto3"`
 	xxx_hidden_Grading   *exam.ExamGrading      `protobuf:"bytes,4,opt,name=grading,proto3"`
+	xxx_hidden_Log       *[]*exam.ExamLogEntry  `protobuf:"bytes,5,rep,name=log,proto3"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -709,6 +751,15 @@ func (x *GetUserExamResponse) GetGrading() *exam.ExamGrading {
 	return nil
 }
 
+func (x *GetUserExamResponse) GetLog() []*exam.ExamLogEntry {
+	if x != nil {
+		if x.xxx_hidden_Log != nil {
+			return *x.xxx_hidden_Log
+		}
+	}
+	return nil
+}
+
 func (x *GetUserExamResponse) SetExam(v *exam.ExamQuestions) {
 	x.xxx_hidden_Exam = v
 }
@@ -725,6 +776,10 @@ func (x *GetUserExamResponse) SetGrading(v *exam.ExamGrading) {
 	x.xxx_hidden_Grading = v
 }
 
+func (x *GetUserExamResponse) SetLog(v []*exam.ExamLogEntry) {
+	x.xxx_hidden_Log = &v
+}
+
 func (x *GetUserExamResponse) HasExam() bool {
 	if x == nil {
 		return false
@@ -776,6 +831,8 @@ type GetUserExamResponse_builder struct {
 	ExamUser  *exam.ExamUser
 	Responses *exam.ExamResponses
 	Grading   *exam.ExamGrading
+	// Log of the user's current attempt
+	Log []*exam.ExamLogEntry
 }
 
 func (b0 GetUserExamResponse_builder) Build() *GetUserExamResponse {
@@ -786,6 +843,132 @@ func (b0 GetUserExamResponse_builder) Build() *GetUserExamResponse {
 	x.xxx_hidden_ExamUser = b.ExamUser
 	x.xxx_hidden_Responses = b.Responses
 	x.xxx_hidden_Grading = b.Grading
+	x.xxx_hidden_Log = &b.Log
+	return m0
+}
+
+type ReportExamFocusLostRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_QualificationId int64                  `protobuf:"varint,1,opt,name=qualification_id,json=qualificationId,proto3"`
+	xxx_hidden_Duration        *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3,oneof"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ReportExamFocusLostRequest) Reset() {
+	*x = ReportExamFocusLostRequest{}
+	mi := &file_services_qualifications_exam_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportExamFocusLostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportExamFocusLostRequest) ProtoMessage() {}
+
+func (x *ReportExamFocusLostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_exam_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReportExamFocusLostRequest) GetQualificationId() int64 {
+	if x != nil {
+		return x.xxx_hidden_QualificationId
+	}
+	return 0
+}
+
+func (x *ReportExamFocusLostRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Duration
+	}
+	return nil
+}
+
+func (x *ReportExamFocusLostRequest) SetQualificationId(v int64) {
+	x.xxx_hidden_QualificationId = v
+}
+
+func (x *ReportExamFocusLostRequest) SetDuration(v *durationpb.Duration) {
+	x.xxx_hidden_Duration = v
+}
+
+func (x *ReportExamFocusLostRequest) HasDuration() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Duration != nil
+}
+
+func (x *ReportExamFocusLostRequest) ClearDuration() {
+	x.xxx_hidden_Duration = nil
+}
+
+type ReportExamFocusLostRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	QualificationId int64
+	Duration        *durationpb.Duration
+}
+
+func (b0 ReportExamFocusLostRequest_builder) Build() *ReportExamFocusLostRequest {
+	m0 := &ReportExamFocusLostRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_QualificationId = b.QualificationId
+	x.xxx_hidden_Duration = b.Duration
+	return m0
+}
+
+type ReportExamFocusLostResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportExamFocusLostResponse) Reset() {
+	*x = ReportExamFocusLostResponse{}
+	mi := &file_services_qualifications_exam_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportExamFocusLostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportExamFocusLostResponse) ProtoMessage() {}
+
+func (x *ReportExamFocusLostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_exam_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ReportExamFocusLostResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ReportExamFocusLostResponse_builder) Build() *ReportExamFocusLostResponse {
+	m0 := &ReportExamFocusLostResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
 	return m0
 }
 
@@ -793,15 +976,18 @@ var File_services_qualifications_exam_proto protoreflect.FileDescriptor
 
 const file_services_qualifications_exam_proto_rawDesc = "" +
 	"\n" +
-	"\"services/qualifications/exam.proto\x12\x17services.qualifications\x1a\x19codegen/perms/perms.proto\x1a\x1egoogle/protobuf/duration.proto\x1a(resources/qualifications/exam/exam.proto\x1a-resources/qualifications/qualifications.proto\"?\n" +
+	"\"services/qualifications/exam.proto\x12\x17services.qualifications\x1a\x19codegen/perms/perms.proto\x1a\x1egoogle/protobuf/duration.proto\x1a(resources/qualifications/exam/exam.proto\x1a-resources/qualifications/qualifications.proto\x1a#resources/timestamp/timestamp.proto\"?\n" +
 	"\x12GetExamInfoRequest\x12)\n" +
-	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\"\xe9\x01\n" +
+	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\"\xf3\x02\n" +
 	"\x13GetExamInfoResponse\x12R\n" +
 	"\rqualification\x18\x01 \x01(\v2,.resources.qualifications.QualificationShortR\rqualification\x12%\n" +
 	"\x0equestion_count\x18\x02 \x01(\x03R\rquestionCount\x12I\n" +
-	"\texam_user\x18\x03 \x01(\v2'.resources.qualifications.exam.ExamUserH\x00R\bexamUser\x88\x01\x01B\f\n" +
+	"\texam_user\x18\x03 \x01(\v2'.resources.qualifications.exam.ExamUserH\x00R\bexamUser\x88\x01\x01\x12'\n" +
+	"\x0ffailed_attempts\x18\x04 \x01(\x05R\x0efailedAttempts\x12K\n" +
+	"\x0fnext_attempt_at\x18\x05 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\rnextAttemptAt\x88\x01\x01B\f\n" +
 	"\n" +
-	"_exam_user\"d\n" +
+	"_exam_userB\x12\n" +
+	"\x10_next_attempt_at\"d\n" +
 	"\x0fTakeExamRequest\x12)\n" +
 	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\x12\x1b\n" +
 	"\x06cancel\x18\x02 \x01(\bH\x00R\x06cancel\x88\x01\x01B\t\n" +
@@ -819,20 +1005,27 @@ const file_services_qualifications_exam_proto_rawDesc = "" +
 	"\bduration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bduration\"X\n" +
 	"\x12GetUserExamRequest\x12)\n" +
 	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xaf\x02\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xee\x02\n" +
 	"\x13GetUserExamResponse\x12@\n" +
 	"\x04exam\x18\x01 \x01(\v2,.resources.qualifications.exam.ExamQuestionsR\x04exam\x12D\n" +
 	"\texam_user\x18\x02 \x01(\v2'.resources.qualifications.exam.ExamUserR\bexamUser\x12J\n" +
 	"\tresponses\x18\x03 \x01(\v2,.resources.qualifications.exam.ExamResponsesR\tresponses\x12D\n" +
-	"\agrading\x18\x04 \x01(\v2*.resources.qualifications.exam.ExamGradingR\agrading2\xb9\x05\n" +
+	"\agrading\x18\x04 \x01(\v2*.resources.qualifications.exam.ExamGradingR\agrading\x12=\n" +
+	"\x03log\x18\x05 \x03(\v2+.resources.qualifications.exam.ExamLogEntryR\x03log\"\x90\x01\n" +
+	"\x1aReportExamFocusLostRequest\x12)\n" +
+	"\x10qualification_id\x18\x01 \x01(\x03R\x0fqualificationId\x12:\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\bduration\x88\x01\x01B\v\n" +
+	"\t_duration\"\x1d\n" +
+	"\x1bReportExamFocusLostResponse2\xff\x06\n" +
 	"\vExamService\x12\xab\x01\n" +
 	"\vGetExamInfo\x12+.services.qualifications.GetExamInfoRequest\x1a,.services.qualifications.GetExamInfoResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualifications\x12\xa2\x01\n" +
 	"\bTakeExam\x12(.services.qualifications.TakeExamRequest\x1a).services.qualifications.TakeExamResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualifications\x12\xa8\x01\n" +
 	"\n" +
 	"SubmitExam\x12*.services.qualifications.SubmitExamRequest\x1a+.services.qualifications.SubmitExamResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualifications\x12\xab\x01\n" +
-	"\vGetUserExam\x12+.services.qualifications.GetUserExamRequest\x1a,.services.qualifications.GetUserExamResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualificationsBZZXgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications;qualificationsb\x06proto3"
+	"\vGetUserExam\x12+.services.qualifications.GetUserExamRequest\x1a,.services.qualifications.GetUserExamResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualifications\x12\xc3\x01\n" +
+	"\x13ReportExamFocusLost\x123.services.qualifications.ReportExamFocusLostRequest\x1a4.services.qualifications.ReportExamFocusLostResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualificationsBZZXgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications;qualificationsb\x06proto3"
 
-var file_services_qualifications_exam_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_services_qualifications_exam_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_services_qualifications_exam_proto_goTypes = []any{
 	(*GetExamInfoRequest)(nil),                // 0: services.qualifications.GetExamInfoRequest
 	(*GetExamInfoResponse)(nil),               // 1: services.qualifications.GetExamInfoResponse
@@ -842,38 +1035,47 @@ var file_services_qualifications_exam_proto_goTypes = []any{
 	(*SubmitExamResponse)(nil),                // 5: services.qualifications.SubmitExamResponse
 	(*GetUserExamRequest)(nil),                // 6: services.qualifications.GetUserExamRequest
 	(*GetUserExamResponse)(nil),               // 7: services.qualifications.GetUserExamResponse
-	(*qualifications.QualificationShort)(nil), // 8: resources.qualifications.QualificationShort
-	(*exam.ExamUser)(nil),                     // 9: resources.qualifications.exam.ExamUser
-	(*exam.ExamQuestions)(nil),                // 10: resources.qualifications.exam.ExamQuestions
-	(*exam.ExamResponses)(nil),                // 11: resources.qualifications.exam.ExamResponses
-	(*durationpb.Duration)(nil),               // 12: google.protobuf.Duration
-	(*exam.ExamGrading)(nil),                  // 13: resources.qualifications.exam.ExamGrading
+	(*ReportExamFocusLostRequest)(nil),        // 8: services.qualifications.ReportExamFocusLostRequest
+	(*ReportExamFocusLostResponse)(nil),       // 9: services.qualifications.ReportExamFocusLostResponse
+	(*qualifications.QualificationShort)(nil), // 10: resources.qualifications.QualificationShort
+	(*exam.ExamUser)(nil),                     // 11: resources.qualifications.exam.ExamUser
+	(*timestamp.Timestamp)(nil),               // 12: resources.timestamp.Timestamp
+	(*exam.ExamQuestions)(nil),                // 13: resources.qualifications.exam.ExamQuestions
+	(*exam.ExamResponses)(nil),                // 14: resources.qualifications.exam.ExamResponses
+	(*durationpb.Duration)(nil),               // 15: google.protobuf.Duration
+	(*exam.ExamGrading)(nil),                  // 16: resources.qualifications.exam.ExamGrading
+	(*exam.ExamLogEntry)(nil),                 // 17: resources.qualifications.exam.ExamLogEntry
 }
 var file_services_qualifications_exam_proto_depIdxs = []int32{
-	8,  // 0: services.qualifications.GetExamInfoResponse.qualification:type_name -> resources.qualifications.QualificationShort
-	9,  // 1: services.qualifications.GetExamInfoResponse.exam_user:type_name -> resources.qualifications.exam.ExamUser
-	10, // 2: services.qualifications.TakeExamResponse.exam:type_name -> resources.qualifications.exam.ExamQuestions
-	9,  // 3: services.qualifications.TakeExamResponse.exam_user:type_name -> resources.qualifications.exam.ExamUser
-	11, // 4: services.qualifications.TakeExamResponse.responses:type_name -> resources.qualifications.exam.ExamResponses
-	11, // 5: services.qualifications.SubmitExamRequest.responses:type_name -> resources.qualifications.exam.ExamResponses
-	12, // 6: services.qualifications.SubmitExamResponse.duration:type_name -> google.protobuf.Duration
-	10, // 7: services.qualifications.GetUserExamResponse.exam:type_name -> resources.qualifications.exam.ExamQuestions
-	9,  // 8: services.qualifications.GetUserExamResponse.exam_user:type_name -> resources.qualifications.exam.ExamUser
-	11, // 9: services.qualifications.GetUserExamResponse.responses:type_name -> resources.qualifications.exam.ExamResponses
-	13, // 10: services.qualifications.GetUserExamResponse.grading:type_name -> resources.qualifications.exam.ExamGrading
-	0,  // 11: services.qualifications.ExamService.GetExamInfo:input_type -> services.qualifications.GetExamInfoRequest
-	2,  // 12: services.qualifications.ExamService.TakeExam:input_type -> services.qualifications.TakeExamRequest
-	4,  // 13: services.qualifications.ExamService.SubmitExam:input_type -> services.qualifications.SubmitExamRequest
-	6,  // 14: services.qualifications.ExamService.GetUserExam:input_type -> services.qualifications.GetUserExamRequest
-	1,  // 15: services.qualifications.ExamService.GetExamInfo:output_type -> services.qualifications.GetExamInfoResponse
-	3,  // 16: services.qualifications.ExamService.TakeExam:output_type -> services.qualifications.TakeExamResponse
-	5,  // 17: services.qualifications.ExamService.SubmitExam:output_type -> services.qualifications.SubmitExamResponse
-	7,  // 18: services.qualifications.ExamService.GetUserExam:output_type -> services.qualifications.GetUserExamResponse
-	15, // [15:19] is the sub-list for method output_type
-	11, // [11:15] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	10, // 0: services.qualifications.GetExamInfoResponse.qualification:type_name -> resources.qualifications.QualificationShort
+	11, // 1: services.qualifications.GetExamInfoResponse.exam_user:type_name -> resources.qualifications.exam.ExamUser
+	12, // 2: services.qualifications.GetExamInfoResponse.next_attempt_at:type_name -> resources.timestamp.Timestamp
+	13, // 3: services.qualifications.TakeExamResponse.exam:type_name -> resources.qualifications.exam.ExamQuestions
+	11, // 4: services.qualifications.TakeExamResponse.exam_user:type_name -> resources.qualifications.exam.ExamUser
+	14, // 5: services.qualifications.TakeExamResponse.responses:type_name -> resources.qualifications.exam.ExamResponses
+	14, // 6: services.qualifications.SubmitExamRequest.responses:type_name -> resources.qualifications.exam.ExamResponses
+	15, // 7: services.qualifications.SubmitExamResponse.duration:type_name -> google.protobuf.Duration
+	13, // 8: services.qualifications.GetUserExamResponse.exam:type_name -> resources.qualifications.exam.ExamQuestions
+	11, // 9: services.qualifications.GetUserExamResponse.exam_user:type_name -> resources.qualifications.exam.ExamUser
+	14, // 10: services.qualifications.GetUserExamResponse.responses:type_name -> resources.qualifications.exam.ExamResponses
+	16, // 11: services.qualifications.GetUserExamResponse.grading:type_name -> resources.qualifications.exam.ExamGrading
+	17, // 12: services.qualifications.GetUserExamResponse.log:type_name -> resources.qualifications.exam.ExamLogEntry
+	15, // 13: services.qualifications.ReportExamFocusLostRequest.duration:type_name -> google.protobuf.Duration
+	0,  // 14: services.qualifications.ExamService.GetExamInfo:input_type -> services.qualifications.GetExamInfoRequest
+	2,  // 15: services.qualifications.ExamService.TakeExam:input_type -> services.qualifications.TakeExamRequest
+	4,  // 16: services.qualifications.ExamService.SubmitExam:input_type -> services.qualifications.SubmitExamRequest
+	6,  // 17: services.qualifications.ExamService.GetUserExam:input_type -> services.qualifications.GetUserExamRequest
+	8,  // 18: services.qualifications.ExamService.ReportExamFocusLost:input_type -> services.qualifications.ReportExamFocusLostRequest
+	1,  // 19: services.qualifications.ExamService.GetExamInfo:output_type -> services.qualifications.GetExamInfoResponse
+	3,  // 20: services.qualifications.ExamService.TakeExam:output_type -> services.qualifications.TakeExamResponse
+	5,  // 21: services.qualifications.ExamService.SubmitExam:output_type -> services.qualifications.SubmitExamResponse
+	7,  // 22: services.qualifications.ExamService.GetUserExam:output_type -> services.qualifications.GetUserExamResponse
+	9,  // 23: services.qualifications.ExamService.ReportExamFocusLost:output_type -> services.qualifications.ReportExamFocusLostResponse
+	19, // [19:24] is the sub-list for method output_type
+	14, // [14:19] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_services_qualifications_exam_proto_init() }
@@ -883,13 +1085,14 @@ func file_services_qualifications_exam_proto_init() {
 	}
 	file_services_qualifications_exam_proto_msgTypes[1].OneofWrappers = []any{}
 	file_services_qualifications_exam_proto_msgTypes[2].OneofWrappers = []any{}
+	file_services_qualifications_exam_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_qualifications_exam_proto_rawDesc), len(file_services_qualifications_exam_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1,6 +1,7 @@
 // Code generated by protoc-gen-backend. DO NOT EDIT.
 // source: services/qualifications/exam.proto
 // source: services/qualifications/qualifications.proto
+// source: services/qualifications/training.proto
 
 package permsqualifications
 
@@ -13,6 +14,7 @@ const (
 
 	ExamServicePerm           perms.Service = "ExamService"
 	QualificationsServicePerm perms.Service = "QualificationsService"
+	TrainingServicePerm       perms.Service = "TrainingService"
 
 	// Service: qualifications.QualificationsService
 	QualificationsServiceDeleteQualificationPerm            perms.Name = "DeleteQualification"
@@ -1,6 +1,7 @@
 // Code generated by protoc-gen-backend. DO NOT EDIT.
 // source: services/qualifications/exam.proto
 // source: services/qualifications/qualifications.proto
+// source: services/qualifications/training.proto
 
 package qualifications
 
@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: services/qualifications/training.proto
+
+package qualifications
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateTrainingPathRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Path
+	if m.Path != nil {
+		if v, ok := any(m.GetPath()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *CreateTrainingPathResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Path
+	if m.Path != nil {
+		if v, ok := any(m.GetPath()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetTrainingMatrixRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Search
+	if m.Search != nil {
+		*m.Search = htmlsanitizer.SanitizeAndUnescape(*m.Search)
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetTrainingMatrixResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Pagination
+	if m.Pagination != nil {
+		if v, ok := any(m.GetPagination()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Path
+	if m.Path != nil {
+		if v, ok := any(m.GetPath()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Rows
+	for idx, item := range m.Rows {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetTrainingPathResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Path
+	if m.Path != nil {
+		if v, ok := any(m.GetPath()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *GetTrainingProgressResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Path
+	if m.Path != nil {
+		if v, ok := any(m.GetPath()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Progress
+	if m.Progress != nil {
+		if v, ok := any(m.GetProgress()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListTrainingPathsRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Search
+	if m.Search != nil {
+		*m.Search = htmlsanitizer.SanitizeAndUnescape(*m.Search)
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ListTrainingPathsResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Paths
+	for idx, item := range m.Paths {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *UpdateTrainingPathRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Path
+	if m.Path != nil {
+		if v, ok := any(m.GetPath()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *UpdateTrainingPathResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Path
+	if m.Path != nil {
+		if v, ok := any(m.GetPath()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: services/qualifications/training.proto
+
+package qualifications
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TrainingService_ListTrainingPaths_FullMethodName   = "/services.qualifications.TrainingService/ListTrainingPaths"
+	TrainingService_GetTrainingPath_FullMethodName     = "/services.qualifications.TrainingService/GetTrainingPath"
+	TrainingService_CreateTrainingPath_FullMethodName  = "/services.qualifications.TrainingService/CreateTrainingPath"
+	TrainingService_UpdateTrainingPath_FullMethodName  = "/services.qualifications.TrainingService/UpdateTrainingPath"
+	TrainingService_DeleteTrainingPath_FullMethodName  = "/services.qualifications.TrainingService/DeleteTrainingPath"
+	TrainingService_GetTrainingProgress_FullMethodName = "/services.qualifications.TrainingService/GetTrainingProgress"
+	TrainingService_GetTrainingMatrix_FullMethodName   = "/services.qualifications.TrainingService/GetTrainingMatrix"
+)
+
+// TrainingServiceClient is the client API for TrainingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TrainingServiceClient interface {
+	ListTrainingPaths(ctx context.Context, in *ListTrainingPathsRequest, opts ...grpc.CallOption) (*ListTrainingPathsResponse, error)
+	GetTrainingPath(ctx context.Context, in *GetTrainingPathRequest, opts ...grpc.CallOption) (*GetTrainingPathResponse, error)
+	CreateTrainingPath(ctx context.Context, in *CreateTrainingPathRequest, opts ...grpc.CallOption) (*CreateTrainingPathResponse, error)
+	UpdateTrainingPath(ctx context.Context, in *UpdateTrainingPathRequest, opts ...grpc.CallOption) (*UpdateTrainingPathResponse, error)
+	DeleteTrainingPath(ctx context.Context, in *DeleteTrainingPathRequest, opts ...grpc.CallOption) (*DeleteTrainingPathResponse, error)
+	GetTrainingProgress(ctx context.Context, in *GetTrainingProgressRequest, opts ...grpc.CallOption) (*GetTrainingProgressResponse, error)
+	GetTrainingMatrix(ctx context.Context, in *GetTrainingMatrixRequest, opts ...grpc.CallOption) (*GetTrainingMatrixResponse, error)
+}
+
+type trainingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrainingServiceClient(cc grpc.ClientConnInterface) TrainingServiceClient {
+	return &trainingServiceClient{cc}
+}
+
+func (c *trainingServiceClient) ListTrainingPaths(ctx context.Context, in *ListTrainingPathsRequest, opts ...grpc.CallOption) (*ListTrainingPathsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrainingPathsResponse)
+	err := c.cc.Invoke(ctx, TrainingService_ListTrainingPaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainingServiceClient) GetTrainingPath(ctx context.Context, in *GetTrainingPathRequest, opts ...grpc.CallOption) (*GetTrainingPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrainingPathResponse)
+	err := c.cc.Invoke(ctx, TrainingService_GetTrainingPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainingServiceClient) CreateTrainingPath(ctx context.Context, in *CreateTrainingPathRequest, opts ...grpc.CallOption) (*CreateTrainingPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTrainingPathResponse)
+	err := c.cc.Invoke(ctx, TrainingService_CreateTrainingPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainingServiceClient) UpdateTrainingPath(ctx context.Context, in *UpdateTrainingPathRequest, opts ...grpc.CallOption) (*UpdateTrainingPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTrainingPathResponse)
+	err := c.cc.Invoke(ctx, TrainingService_UpdateTrainingPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainingServiceClient) DeleteTrainingPath(ctx context.Context, in *DeleteTrainingPathRequest, opts ...grpc.CallOption) (*DeleteTrainingPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTrainingPathResponse)
+	err := c.cc.Invoke(ctx, TrainingService_DeleteTrainingPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainingServiceClient) GetTrainingProgress(ctx context.Context, in *GetTrainingProgressRequest, opts ...grpc.CallOption) (*GetTrainingProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrainingProgressResponse)
+	err := c.cc.Invoke(ctx, TrainingService_GetTrainingProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainingServiceClient) GetTrainingMatrix(ctx context.Context, in *GetTrainingMatrixRequest, opts ...grpc.CallOption) (*GetTrainingMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrainingMatrixResponse)
+	err := c.cc.Invoke(ctx, TrainingService_GetTrainingMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrainingServiceServer is the server API for TrainingService service.
+// All implementations must embed UnimplementedTrainingServiceServer
+// for forward compatibility.
+type TrainingServiceServer interface {
+	ListTrainingPaths(context.Context, *ListTrainingPathsRequest) (*ListTrainingPathsResponse, error)
+	GetTrainingPath(context.Context, *GetTrainingPathRequest) (*GetTrainingPathResponse, error)
+	CreateTrainingPath(context.Context, *CreateTrainingPathRequest) (*CreateTrainingPathResponse, error)
+	UpdateTrainingPath(context.Context, *UpdateTrainingPathRequest) (*UpdateTrainingPathResponse, error)
+	DeleteTrainingPath(context.Context, *DeleteTrainingPathRequest) (*DeleteTrainingPathResponse, error)
+	GetTrainingProgress(context.Context, *GetTrainingProgressRequest) (*GetTrainingProgressResponse, error)
+	GetTrainingMatrix(context.Context, *GetTrainingMatrixRequest) (*GetTrainingMatrixResponse, error)
+	mustEmbedUnimplementedTrainingServiceServer()
+}
+
+// UnimplementedTrainingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrainingServiceServer struct{}
+
+func (UnimplementedTrainingServiceServer) ListTrainingPaths(context.Context, *ListTrainingPathsRequest) (*ListTrainingPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrainingPaths not implemented")
+}
+func (UnimplementedTrainingServiceServer) GetTrainingPath(context.Context, *GetTrainingPathRequest) (*GetTrainingPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrainingPath not implemented")
+}
+func (UnimplementedTrainingServiceServer) CreateTrainingPath(context.Context, *CreateTrainingPathRequest) (*CreateTrainingPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrainingPath not implemented")
+}
+func (UnimplementedTrainingServiceServer) UpdateTrainingPath(context.Context, *UpdateTrainingPathRequest) (*UpdateTrainingPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrainingPath not implemented")
+}
+func (UnimplementedTrainingServiceServer) DeleteTrainingPath(context.Context, *DeleteTrainingPathRequest) (*DeleteTrainingPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrainingPath not implemented")
+}
+func (UnimplementedTrainingServiceServer) GetTrainingProgress(context.Context, *GetTrainingProgressRequest) (*GetTrainingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrainingProgress not implemented")
+}
+func (UnimplementedTrainingServiceServer) GetTrainingMatrix(context.Context, *GetTrainingMatrixRequest) (*GetTrainingMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrainingMatrix not implemented")
+}
+func (UnimplementedTrainingServiceServer) mustEmbedUnimplementedTrainingServiceServer() {}
+func (UnimplementedTrainingServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTrainingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrainingServiceServer will
+// result in compilation errors.
+type UnsafeTrainingServiceServer interface {
+	mustEmbedUnimplementedTrainingServiceServer()
+}
+
+func RegisterTrainingServiceServer(s grpc.ServiceRegistrar, srv TrainingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTrainingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TrainingService_ServiceDesc, srv)
+}
+
+func _TrainingService_ListTrainingPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrainingPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainingServiceServer).ListTrainingPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainingService_ListTrainingPaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainingServiceServer).ListTrainingPaths(ctx, req.(*ListTrainingPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainingService_GetTrainingPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrainingPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainingServiceServer).GetTrainingPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainingService_GetTrainingPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainingServiceServer).GetTrainingPath(ctx, req.(*GetTrainingPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainingService_CreateTrainingPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTrainingPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainingServiceServer).CreateTrainingPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainingService_CreateTrainingPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainingServiceServer).CreateTrainingPath(ctx, req.(*CreateTrainingPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainingService_UpdateTrainingPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTrainingPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainingServiceServer).UpdateTrainingPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainingService_UpdateTrainingPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainingServiceServer).UpdateTrainingPath(ctx, req.(*UpdateTrainingPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainingService_DeleteTrainingPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTrainingPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainingServiceServer).DeleteTrainingPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainingService_DeleteTrainingPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainingServiceServer).DeleteTrainingPath(ctx, req.(*DeleteTrainingPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainingService_GetTrainingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrainingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainingServiceServer).GetTrainingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainingService_GetTrainingProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainingServiceServer).GetTrainingProgress(ctx, req.(*GetTrainingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainingService_GetTrainingMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrainingMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainingServiceServer).GetTrainingMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainingService_GetTrainingMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainingServiceServer).GetTrainingMatrix(ctx, req.(*GetTrainingMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrainingService_ServiceDesc is the grpc.ServiceDesc for TrainingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrainingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.qualifications.TrainingService",
+	HandlerType: (*TrainingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrainingPaths",
+			Handler:    _TrainingService_ListTrainingPaths_Handler,
+		},
+		{
+			MethodName: "GetTrainingPath",
+			Handler:    _TrainingService_GetTrainingPath_Handler,
+		},
+		{
+			MethodName: "CreateTrainingPath",
+			Handler:    _TrainingService_CreateTrainingPath_Handler,
+		},
+		{
+			MethodName: "UpdateTrainingPath",
+			Handler:    _TrainingService_UpdateTrainingPath_Handler,
+		},
+		{
+			MethodName: "DeleteTrainingPath",
+			Handler:    _TrainingService_DeleteTrainingPath_Handler,
+		},
+		{
+			MethodName: "GetTrainingProgress",
+			Handler:    _TrainingService_GetTrainingProgress_Handler,
+		},
+		{
+			MethodName: "GetTrainingMatrix",
+			Handler:    _TrainingService_GetTrainingMatrix_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/qualifications/training.proto",
+}
@@ -0,0 +1,1242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: services/qualifications/training.proto
+
+//go:build protoopaque
+
+package qualifications
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/itemslen"
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/perms"
+	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	qualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListTrainingPathsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Search      *string                `protobuf:"bytes,1,opt,name=search,proto3,oneof"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListTrainingPathsRequest) Reset() {
+	*x = ListTrainingPathsRequest{}
+	mi := &file_services_qualifications_training_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrainingPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrainingPathsRequest) ProtoMessage() {}
+
+func (x *ListTrainingPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListTrainingPathsRequest) GetSearch() string {
+	if x != nil {
+		if x.xxx_hidden_Search != nil {
+			return *x.xxx_hidden_Search
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListTrainingPathsRequest) SetSearch(v string) {
+	x.xxx_hidden_Search = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *ListTrainingPathsRequest) HasSearch() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListTrainingPathsRequest) ClearSearch() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Search = nil
+}
+
+type ListTrainingPathsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Search *string
+}
+
+func (b0 ListTrainingPathsRequest_builder) Build() *ListTrainingPathsRequest {
+	m0 := &ListTrainingPathsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Search != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Search = b.Search
+	}
+	return m0
+}
+
+type ListTrainingPathsResponse struct {
+	state            protoimpl.MessageState          `protogen:"opaque.v1"`
+	xxx_hidden_Paths *[]*qualifications.TrainingPath `protobuf:"bytes,1,rep,name=paths,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListTrainingPathsResponse) Reset() {
+	*x = ListTrainingPathsResponse{}
+	mi := &file_services_qualifications_training_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrainingPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrainingPathsResponse) ProtoMessage() {}
+
+func (x *ListTrainingPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListTrainingPathsResponse) GetPaths() []*qualifications.TrainingPath {
+	if x != nil {
+		if x.xxx_hidden_Paths != nil {
+			return *x.xxx_hidden_Paths
+		}
+	}
+	return nil
+}
+
+func (x *ListTrainingPathsResponse) SetPaths(v []*qualifications.TrainingPath) {
+	x.xxx_hidden_Paths = &v
+}
+
+type ListTrainingPathsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Paths []*qualifications.TrainingPath
+}
+
+func (b0 ListTrainingPathsResponse_builder) Build() *ListTrainingPathsResponse {
+	m0 := &ListTrainingPathsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Paths = &b.Paths
+	return m0
+}
+
+type GetTrainingPathRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TrainingPathId int64                  `protobuf:"varint,1,opt,name=training_path_id,json=trainingPathId,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetTrainingPathRequest) Reset() {
+	*x = GetTrainingPathRequest{}
+	mi := &file_services_qualifications_training_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainingPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingPathRequest) ProtoMessage() {}
+
+func (x *GetTrainingPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTrainingPathRequest) GetTrainingPathId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrainingPathId
+	}
+	return 0
+}
+
+func (x *GetTrainingPathRequest) SetTrainingPathId(v int64) {
+	x.xxx_hidden_TrainingPathId = v
+}
+
+type GetTrainingPathRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TrainingPathId int64
+}
+
+func (b0 GetTrainingPathRequest_builder) Build() *GetTrainingPathRequest {
+	m0 := &GetTrainingPathRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TrainingPathId = b.TrainingPathId
+	return m0
+}
+
+type GetTrainingPathResponse struct {
+	state           protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Path *qualifications.TrainingPath `protobuf:"bytes,1,opt,name=path,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTrainingPathResponse) Reset() {
+	*x = GetTrainingPathResponse{}
+	mi := &file_services_qualifications_training_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainingPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingPathResponse) ProtoMessage() {}
+
+func (x *GetTrainingPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTrainingPathResponse) GetPath() *qualifications.TrainingPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *GetTrainingPathResponse) SetPath(v *qualifications.TrainingPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *GetTrainingPathResponse) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *GetTrainingPathResponse) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+type GetTrainingPathResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path *qualifications.TrainingPath
+}
+
+func (b0 GetTrainingPathResponse_builder) Build() *GetTrainingPathResponse {
+	m0 := &GetTrainingPathResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	return m0
+}
+
+type CreateTrainingPathRequest struct {
+	state           protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Path *qualifications.TrainingPath `protobuf:"bytes,1,opt,name=path,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTrainingPathRequest) Reset() {
+	*x = CreateTrainingPathRequest{}
+	mi := &file_services_qualifications_training_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTrainingPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrainingPathRequest) ProtoMessage() {}
+
+func (x *CreateTrainingPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateTrainingPathRequest) GetPath() *qualifications.TrainingPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *CreateTrainingPathRequest) SetPath(v *qualifications.TrainingPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *CreateTrainingPathRequest) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *CreateTrainingPathRequest) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+type CreateTrainingPathRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path *qualifications.TrainingPath
+}
+
+func (b0 CreateTrainingPathRequest_builder) Build() *CreateTrainingPathRequest {
+	m0 := &CreateTrainingPathRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	return m0
+}
+
+type CreateTrainingPathResponse struct {
+	state           protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Path *qualifications.TrainingPath `protobuf:"bytes,1,opt,name=path,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTrainingPathResponse) Reset() {
+	*x = CreateTrainingPathResponse{}
+	mi := &file_services_qualifications_training_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTrainingPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrainingPathResponse) ProtoMessage() {}
+
+func (x *CreateTrainingPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateTrainingPathResponse) GetPath() *qualifications.TrainingPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *CreateTrainingPathResponse) SetPath(v *qualifications.TrainingPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *CreateTrainingPathResponse) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *CreateTrainingPathResponse) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+type CreateTrainingPathResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path *qualifications.TrainingPath
+}
+
+func (b0 CreateTrainingPathResponse_builder) Build() *CreateTrainingPathResponse {
+	m0 := &CreateTrainingPathResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	return m0
+}
+
+type UpdateTrainingPathRequest struct {
+	state           protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Path *qualifications.TrainingPath `protobuf:"bytes,1,opt,name=path,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTrainingPathRequest) Reset() {
+	*x = UpdateTrainingPathRequest{}
+	mi := &file_services_qualifications_training_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTrainingPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrainingPathRequest) ProtoMessage() {}
+
+func (x *UpdateTrainingPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateTrainingPathRequest) GetPath() *qualifications.TrainingPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *UpdateTrainingPathRequest) SetPath(v *qualifications.TrainingPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *UpdateTrainingPathRequest) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *UpdateTrainingPathRequest) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+type UpdateTrainingPathRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path *qualifications.TrainingPath
+}
+
+func (b0 UpdateTrainingPathRequest_builder) Build() *UpdateTrainingPathRequest {
+	m0 := &UpdateTrainingPathRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	return m0
+}
+
+type UpdateTrainingPathResponse struct {
+	state           protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Path *qualifications.TrainingPath `protobuf:"bytes,1,opt,name=path,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTrainingPathResponse) Reset() {
+	*x = UpdateTrainingPathResponse{}
+	mi := &file_services_qualifications_training_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTrainingPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrainingPathResponse) ProtoMessage() {}
+
+func (x *UpdateTrainingPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateTrainingPathResponse) GetPath() *qualifications.TrainingPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *UpdateTrainingPathResponse) SetPath(v *qualifications.TrainingPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *UpdateTrainingPathResponse) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *UpdateTrainingPathResponse) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+type UpdateTrainingPathResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path *qualifications.TrainingPath
+}
+
+func (b0 UpdateTrainingPathResponse_builder) Build() *UpdateTrainingPathResponse {
+	m0 := &UpdateTrainingPathResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	return m0
+}
+
+type DeleteTrainingPathRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TrainingPathId int64                  `protobuf:"varint,1,opt,name=training_path_id,json=trainingPathId,proto3"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *DeleteTrainingPathRequest) Reset() {
+	*x = DeleteTrainingPathRequest{}
+	mi := &file_services_qualifications_training_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTrainingPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrainingPathRequest) ProtoMessage() {}
+
+func (x *DeleteTrainingPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteTrainingPathRequest) GetTrainingPathId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrainingPathId
+	}
+	return 0
+}
+
+func (x *DeleteTrainingPathRequest) SetTrainingPathId(v int64) {
+	x.xxx_hidden_TrainingPathId = v
+}
+
+type DeleteTrainingPathRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TrainingPathId int64
+}
+
+func (b0 DeleteTrainingPathRequest_builder) Build() *DeleteTrainingPathRequest {
+	m0 := &DeleteTrainingPathRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TrainingPathId = b.TrainingPathId
+	return m0
+}
+
+type DeleteTrainingPathResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTrainingPathResponse) Reset() {
+	*x = DeleteTrainingPathResponse{}
+	mi := &file_services_qualifications_training_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTrainingPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrainingPathResponse) ProtoMessage() {}
+
+func (x *DeleteTrainingPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteTrainingPathResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteTrainingPathResponse_builder) Build() *DeleteTrainingPathResponse {
+	m0 := &DeleteTrainingPathResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetTrainingProgressRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TrainingPathId int64                  `protobuf:"varint,1,opt,name=training_path_id,json=trainingPathId,proto3"`
+	xxx_hidden_UserId         int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetTrainingProgressRequest) Reset() {
+	*x = GetTrainingProgressRequest{}
+	mi := &file_services_qualifications_training_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainingProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingProgressRequest) ProtoMessage() {}
+
+func (x *GetTrainingProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTrainingProgressRequest) GetTrainingPathId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrainingPathId
+	}
+	return 0
+}
+
+func (x *GetTrainingProgressRequest) GetUserId() int32 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *GetTrainingProgressRequest) SetTrainingPathId(v int64) {
+	x.xxx_hidden_TrainingPathId = v
+}
+
+func (x *GetTrainingProgressRequest) SetUserId(v int32) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetTrainingProgressRequest) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetTrainingProgressRequest) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UserId = 0
+}
+
+type GetTrainingProgressRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TrainingPathId int64
+	// Defaults to the current user, other colleagues require the colleagues list permission
+	UserId *int32
+}
+
+func (b0 GetTrainingProgressRequest_builder) Build() *GetTrainingProgressRequest {
+	m0 := &GetTrainingProgressRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TrainingPathId = b.TrainingPathId
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	return m0
+}
+
+type GetTrainingProgressResponse struct {
+	state               protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_Path     *qualifications.TrainingPath     `protobuf:"bytes,1,opt,name=path,proto3"`
+	xxx_hidden_Progress *qualifications.TrainingProgress `protobuf:"bytes,2,opt,name=progress,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetTrainingProgressResponse) Reset() {
+	*x = GetTrainingProgressResponse{}
+	mi := &file_services_qualifications_training_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainingProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingProgressResponse) ProtoMessage() {}
+
+func (x *GetTrainingProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTrainingProgressResponse) GetPath() *qualifications.TrainingPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *GetTrainingProgressResponse) GetProgress() *qualifications.TrainingProgress {
+	if x != nil {
+		return x.xxx_hidden_Progress
+	}
+	return nil
+}
+
+func (x *GetTrainingProgressResponse) SetPath(v *qualifications.TrainingPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *GetTrainingProgressResponse) SetProgress(v *qualifications.TrainingProgress) {
+	x.xxx_hidden_Progress = v
+}
+
+func (x *GetTrainingProgressResponse) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *GetTrainingProgressResponse) HasProgress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Progress != nil
+}
+
+func (x *GetTrainingProgressResponse) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+func (x *GetTrainingProgressResponse) ClearProgress() {
+	x.xxx_hidden_Progress = nil
+}
+
+type GetTrainingProgressResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path     *qualifications.TrainingPath
+	Progress *qualifications.TrainingProgress
+}
+
+func (b0 GetTrainingProgressResponse_builder) Build() *GetTrainingProgressResponse {
+	m0 := &GetTrainingProgressResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	x.xxx_hidden_Progress = b.Progress
+	return m0
+}
+
+type GetTrainingMatrixRequest struct {
+	state                     protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_Pagination     *database.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3"`
+	xxx_hidden_TrainingPathId int64                       `protobuf:"varint,2,opt,name=training_path_id,json=trainingPathId,proto3"`
+	xxx_hidden_Search         *string                     `protobuf:"bytes,3,opt,name=search,proto3,oneof"`
+	xxx_hidden_PromotionReady bool                        `protobuf:"varint,4,opt,name=promotion_ready,json=promotionReady,proto3,oneof"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetTrainingMatrixRequest) Reset() {
+	*x = GetTrainingMatrixRequest{}
+	mi := &file_services_qualifications_training_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainingMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingMatrixRequest) ProtoMessage() {}
+
+func (x *GetTrainingMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTrainingMatrixRequest) GetPagination() *database.PaginationRequest {
+	if x != nil {
+		return x.xxx_hidden_Pagination
+	}
+	return nil
+}
+
+func (x *GetTrainingMatrixRequest) GetTrainingPathId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TrainingPathId
+	}
+	return 0
+}
+
+func (x *GetTrainingMatrixRequest) GetSearch() string {
+	if x != nil {
+		if x.xxx_hidden_Search != nil {
+			return *x.xxx_hidden_Search
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetTrainingMatrixRequest) GetPromotionReady() bool {
+	if x != nil {
+		return x.xxx_hidden_PromotionReady
+	}
+	return false
+}
+
+func (x *GetTrainingMatrixRequest) SetPagination(v *database.PaginationRequest) {
+	x.xxx_hidden_Pagination = v
+}
+
+func (x *GetTrainingMatrixRequest) SetTrainingPathId(v int64) {
+	x.xxx_hidden_TrainingPathId = v
+}
+
+func (x *GetTrainingMatrixRequest) SetSearch(v string) {
+	x.xxx_hidden_Search = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *GetTrainingMatrixRequest) SetPromotionReady(v bool) {
+	x.xxx_hidden_PromotionReady = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *GetTrainingMatrixRequest) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Pagination != nil
+}
+
+func (x *GetTrainingMatrixRequest) HasSearch() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetTrainingMatrixRequest) HasPromotionReady() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetTrainingMatrixRequest) ClearPagination() {
+	x.xxx_hidden_Pagination = nil
+}
+
+func (x *GetTrainingMatrixRequest) ClearSearch() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Search = nil
+}
+
+func (x *GetTrainingMatrixRequest) ClearPromotionReady() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_PromotionReady = false
+}
+
+type GetTrainingMatrixRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination     *database.PaginationRequest
+	TrainingPathId int64
+	// Search params
+	Search         *string
+	PromotionReady *bool
+}
+
+func (b0 GetTrainingMatrixRequest_builder) Build() *GetTrainingMatrixRequest {
+	m0 := &GetTrainingMatrixRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Pagination = b.Pagination
+	x.xxx_hidden_TrainingPathId = b.TrainingPathId
+	if b.Search != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Search = b.Search
+	}
+	if b.PromotionReady != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_PromotionReady = *b.PromotionReady
+	}
+	return m0
+}
+
+type GetTrainingMatrixResponse struct {
+	state                 protoimpl.MessageState               `protogen:"opaque.v1"`
+	xxx_hidden_Pagination *database.PaginationResponse         `protobuf:"bytes,1,opt,name=pagination,proto3"`
+	xxx_hidden_Path       *qualifications.TrainingPath         `protobuf:"bytes,2,opt,name=path,proto3"`
+	xxx_hidden_Rows       *[]*qualifications.TrainingMatrixRow `protobuf:"bytes,3,rep,name=rows,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetTrainingMatrixResponse) Reset() {
+	*x = GetTrainingMatrixResponse{}
+	mi := &file_services_qualifications_training_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrainingMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingMatrixResponse) ProtoMessage() {}
+
+func (x *GetTrainingMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_qualifications_training_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTrainingMatrixResponse) GetPagination() *database.PaginationResponse {
+	if x != nil {
+		return x.xxx_hidden_Pagination
+	}
+	return nil
+}
+
+func (x *GetTrainingMatrixResponse) GetPath() *qualifications.TrainingPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *GetTrainingMatrixResponse) GetRows() []*qualifications.TrainingMatrixRow {
+	if x != nil {
+		if x.xxx_hidden_Rows != nil {
+			return *x.xxx_hidden_Rows
+		}
+	}
+	return nil
+}
+
+func (x *GetTrainingMatrixResponse) SetPagination(v *database.PaginationResponse) {
+	x.xxx_hidden_Pagination = v
+}
+
+func (x *GetTrainingMatrixResponse) SetPath(v *qualifications.TrainingPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *GetTrainingMatrixResponse) SetRows(v []*qualifications.TrainingMatrixRow) {
+	x.xxx_hidden_Rows = &v
+}
+
+func (x *GetTrainingMatrixResponse) HasPagination() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Pagination != nil
+}
+
+func (x *GetTrainingMatrixResponse) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *GetTrainingMatrixResponse) ClearPagination() {
+	x.xxx_hidden_Pagination = nil
+}
+
+func (x *GetTrainingMatrixResponse) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+type GetTrainingMatrixResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Pagination *database.PaginationResponse
+	Path       *qualifications.TrainingPath
+	Rows       []*qualifications.TrainingMatrixRow
+}
+
+func (b0 GetTrainingMatrixResponse_builder) Build() *GetTrainingMatrixResponse {
+	m0 := &GetTrainingMatrixResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Pagination = b.Pagination
+	x.xxx_hidden_Path = b.Path
+	x.xxx_hidden_Rows = &b.Rows
+	return m0
+}
+
+var File_services_qualifications_training_proto protoreflect.FileDescriptor
+
+const file_services_qualifications_training_proto_rawDesc = "" +
+	"\n" +
+	"&services/qualifications/training.proto\x12\x17services.qualifications\x1a\x1fcodegen/itemslen/itemslen.proto\x1a\x19codegen/perms/perms.proto\x1a(resources/common/database/database.proto\x1a'resources/qualifications/training.proto\"B\n" +
+	"\x18ListTrainingPathsRequest\x12\x1b\n" +
+	"\x06search\x18\x01 \x01(\tH\x00R\x06search\x88\x01\x01B\t\n" +
+	"\a_search\"Y\n" +
+	"\x19ListTrainingPathsResponse\x12<\n" +
+	"\x05paths\x18\x01 \x03(\v2&.resources.qualifications.TrainingPathR\x05paths\"B\n" +
+	"\x16GetTrainingPathRequest\x12(\n" +
+	"\x10training_path_id\x18\x01 \x01(\x03R\x0etrainingPathId\"U\n" +
+	"\x17GetTrainingPathResponse\x12:\n" +
+	"\x04path\x18\x01 \x01(\v2&.resources.qualifications.TrainingPathR\x04path\"W\n" +
+	"\x19CreateTrainingPathRequest\x12:\n" +
+	"\x04path\x18\x01 \x01(\v2&.resources.qualifications.TrainingPathR\x04path\"X\n" +
+	"\x1aCreateTrainingPathResponse\x12:\n" +
+	"\x04path\x18\x01 \x01(\v2&.resources.qualifications.TrainingPathR\x04path\"W\n" +
+	"\x19UpdateTrainingPathRequest\x12:\n" +
+	"\x04path\x18\x01 \x01(\v2&.resources.qualifications.TrainingPathR\x04path\"X\n" +
+	"\x1aUpdateTrainingPathResponse\x12:\n" +
+	"\x04path\x18\x01 \x01(\v2&.resources.qualifications.TrainingPathR\x04path\"E\n" +
+	"\x19DeleteTrainingPathRequest\x12(\n" +
+	"\x10training_path_id\x18\x01 \x01(\x03R\x0etrainingPathId\"\x1c\n" +
+	"\x1aDeleteTrainingPathResponse\"p\n" +
+	"\x1aGetTrainingProgressRequest\x12(\n" +
+	"\x10training_path_id\x18\x01 \x01(\x03R\x0etrainingPathId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\x05H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\xa1\x01\n" +
+	"\x1bGetTrainingProgressResponse\x12:\n" +
+	"\x04path\x18\x01 \x01(\v2&.resources.qualifications.TrainingPathR\x04path\x12F\n" +
+	"\bprogress\x18\x02 \x01(\v2*.resources.qualifications.TrainingProgressR\bprogress\"\xfc\x01\n" +
+	"\x18GetTrainingMatrixRequest\x12L\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2,.resources.common.database.PaginationRequestR\n" +
+	"pagination\x12(\n" +
+	"\x10training_path_id\x18\x02 \x01(\x03R\x0etrainingPathId\x12\x1b\n" +
+	"\x06search\x18\x03 \x01(\tH\x00R\x06search\x88\x01\x01\x12,\n" +
+	"\x0fpromotion_ready\x18\x04 \x01(\bH\x01R\x0epromotionReady\x88\x01\x01B\t\n" +
+	"\a_searchB\x12\n" +
+	"\x10_promotion_ready\"\xed\x01\n" +
+	"\x19GetTrainingMatrixResponse\x12M\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2-.resources.common.database.PaginationResponseR\n" +
+	"pagination\x12:\n" +
+	"\x04path\x18\x02 \x01(\v2&.resources.qualifications.TrainingPathR\x04path\x12E\n" +
+	"\x04rows\x18\x03 \x03(\v2+.resources.qualifications.TrainingMatrixRowB\x04\xc8\xf3\x18\x01R\x04rows2\xdd\n" +
+	"\n" +
+	"\x0fTrainingService\x12\xbd\x01\n" +
+	"\x11ListTrainingPaths\x121.services.qualifications.ListTrainingPathsRequest\x1a2.services.qualifications.ListTrainingPathsResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualifications\x12\xb7\x01\n" +
+	"\x0fGetTrainingPath\x12/.services.qualifications.GetTrainingPathRequest\x1a0.services.qualifications.GetTrainingPathResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualifications\x12\xc1\x01\n" +
+	"\x12CreateTrainingPath\x122.services.qualifications.CreateTrainingPathRequest\x1a3.services.qualifications.CreateTrainingPathResponse\"B\xd2\xf3\x18>\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x13UpdateQualification\x12\xc1\x01\n" +
+	"\x12UpdateTrainingPath\x122.services.qualifications.UpdateTrainingPathRequest\x1a3.services.qualifications.UpdateTrainingPathResponse\"B\xd2\xf3\x18>\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x13UpdateQualification\x12\xc1\x01\n" +
+	"\x12DeleteTrainingPath\x122.services.qualifications.DeleteTrainingPathRequest\x1a3.services.qualifications.DeleteTrainingPathResponse\"B\xd2\xf3\x18>\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x13UpdateQualification\x12\xc3\x01\n" +
+	"\x13GetTrainingProgress\x123.services.qualifications.GetTrainingProgressRequest\x1a4.services.qualifications.GetTrainingProgressResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualifications\x12\xbd\x01\n" +
+	"\x11GetTrainingMatrix\x121.services.qualifications.GetTrainingMatrixRequest\x1a2.services.qualifications.GetTrainingMatrixResponse\"A\xd2\xf3\x18=\b\x01\x12\x0equalifications\x1a\x15QualificationsService\"\x12ListQualificationsBZZXgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications;qualificationsb\x06proto3"
+
+var file_services_qualifications_training_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_services_qualifications_training_proto_goTypes = []any{
+	(*ListTrainingPathsRequest)(nil),         // 0: services.qualifications.ListTrainingPathsRequest
+	(*ListTrainingPathsResponse)(nil),        // 1: services.qualifications.ListTrainingPathsResponse
+	(*GetTrainingPathRequest)(nil),           // 2: services.qualifications.GetTrainingPathRequest
+	(*GetTrainingPathResponse)(nil),          // 3: services.qualifications.GetTrainingPathResponse
+	(*CreateTrainingPathRequest)(nil),        // 4: services.qualifications.CreateTrainingPathRequest
+	(*CreateTrainingPathResponse)(nil),       // 5: services.qualifications.CreateTrainingPathResponse
+	(*UpdateTrainingPathRequest)(nil),        // 6: services.qualifications.UpdateTrainingPathRequest
+	(*UpdateTrainingPathResponse)(nil),       // 7: services.qualifications.UpdateTrainingPathResponse
+	(*DeleteTrainingPathRequest)(nil),        // 8: services.qualifications.DeleteTrainingPathRequest
+	(*DeleteTrainingPathResponse)(nil),       // 9: services.qualifications.DeleteTrainingPathResponse
+	(*GetTrainingProgressRequest)(nil),       // 10: services.qualifications.GetTrainingProgressRequest
+	(*GetTrainingProgressResponse)(nil),      // 11: services.qualifications.GetTrainingProgressResponse
+	(*GetTrainingMatrixRequest)(nil),         // 12: services.qualifications.GetTrainingMatrixRequest
+	(*GetTrainingMatrixResponse)(nil),        // 13: services.qualifications.GetTrainingMatrixResponse
+	(*qualifications.TrainingPath)(nil),      // 14: resources.qualifications.TrainingPath
+	(*qualifications.TrainingProgress)(nil),  // 15: resources.qualifications.TrainingProgress
+	(*database.PaginationRequest)(nil),       // 16: resources.common.database.PaginationRequest
+	(*database.PaginationResponse)(nil),      // 17: resources.common.database.PaginationResponse
+	(*qualifications.TrainingMatrixRow)(nil), // 18: resources.qualifications.TrainingMatrixRow
+}
+var file_services_qualifications_training_proto_depIdxs = []int32{
+	14, // 0: services.qualifications.ListTrainingPathsResponse.paths:type_name -> resources.qualifications.TrainingPath
+	14, // 1: services.qualifications.GetTrainingPathResponse.path:type_name -> resources.qualifications.TrainingPath
+	14, // 2: services.qualifications.CreateTrainingPathRequest.path:type_name -> resources.qualifications.TrainingPath
+	14, // 3: services.qualifications.CreateTrainingPathResponse.path:type_name -> resources.qualifications.TrainingPath
+	14, // 4: services.qualifications.UpdateTrainingPathRequest.path:type_name -> resources.qualifications.TrainingPath
+	14, // 5: services.qualifications.UpdateTrainingPathResponse.path:type_name -> resources.qualifications.TrainingPath
+	14, // 6: services.qualifications.GetTrainingProgressResponse.path:type_name -> resources.qualifications.TrainingPath
+	15, // 7: services.qualifications.GetTrainingProgressResponse.progress:type_name -> resources.qualifications.TrainingProgress
+	16, // 8: services.qualifications.GetTrainingMatrixRequest.pagination:type_name -> resources.common.database.PaginationRequest
+	17, // 9: services.qualifications.GetTrainingMatrixResponse.pagination:type_name -> resources.common.database.PaginationResponse
+	14, // 10: services.qualifications.GetTrainingMatrixResponse.path:type_name -> resources.qualifications.TrainingPath
+	18, // 11: services.qualifications.GetTrainingMatrixResponse.rows:type_name -> resources.qualifications.TrainingMatrixRow
+	0,  // 12: services.qualifications.TrainingService.ListTrainingPaths:input_type -> services.qualifications.ListTrainingPathsRequest
+	2,  // 13: services.qualifications.TrainingService.GetTrainingPath:input_type -> services.qualifications.GetTrainingPathRequest
+	4,  // 14: services.qualifications.TrainingService.CreateTrainingPath:input_type -> services.qualifications.CreateTrainingPathRequest
+	6,  // 15: services.qualifications.TrainingService.UpdateTrainingPath:input_type -> services.qualifications.UpdateTrainingPathRequest
+	8,  // 16: services.qualifications.TrainingService.DeleteTrainingPath:input_type -> services.qualifications.DeleteTrainingPathRequest
+	10, // 17: services.qualifications.TrainingService.GetTrainingProgress:input_type -> services.qualifications.GetTrainingProgressRequest
+	12, // 18: services.qualifications.TrainingService.GetTrainingMatrix:input_type -> services.qualifications.GetTrainingMatrixRequest
+	1,  // 19: services.qualifications.TrainingService.ListTrainingPaths:output_type -> services.qualifications.ListTrainingPathsResponse
+	3,  // 20: services.qualifications.TrainingService.GetTrainingPath:output_type -> services.qualifications.GetTrainingPathResponse
+	5,  // 21: services.qualifications.TrainingService.CreateTrainingPath:output_type -> services.qualifications.CreateTrainingPathResponse
+	7,  // 22: services.qualifications.TrainingService.UpdateTrainingPath:output_type -> services.qualifications.UpdateTrainingPathResponse
+	9,  // 23: services.qualifications.TrainingService.DeleteTrainingPath:output_type -> services.qualifications.DeleteTrainingPathResponse
+	11, // 24: services.qualifications.TrainingService.GetTrainingProgress:output_type -> services.qualifications.GetTrainingProgressResponse
+	13, // 25: services.qualifications.TrainingService.GetTrainingMatrix:output_type -> services.qualifications.GetTrainingMatrixResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_services_qualifications_training_proto_init() }
+func file_services_qualifications_training_proto_init() {
+	if File_services_qualifications_training_proto != nil {
+		return
+	}
+	file_services_qualifications_training_proto_msgTypes[0].OneofWrappers = []any{}
+	file_services_qualifications_training_proto_msgTypes[10].OneofWrappers = []any{}
+	file_services_qualifications_training_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_qualifications_training_proto_rawDesc), len(file_services_qualifications_training_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_services_qualifications_training_proto_goTypes,
+		DependencyIndexes: file_services_qualifications_training_proto_depIdxs,
+		MessageInfos:      file_services_qualifications_training_proto_msgTypes,
+	}.Build()
+	File_services_qualifications_training_proto = out.File
+	file_services_qualifications_training_proto_goTypes = nil
+	file_services_qualifications_training_proto_depIdxs = nil
+}
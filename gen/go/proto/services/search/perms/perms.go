@@ -0,0 +1,30 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: services/search/search.proto
+
+package permssearch
+
+import (
+	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+)
+
+const (
+	Namespace perms.Namespace = "search"
+
+	SearchServicePerm perms.Service = "SearchService"
+
+	// Service: search.SearchService
+	SearchServiceSearchPerm perms.Name = "Search"
+)
+
+type SearchServicePerms struct {
+	Search SearchServiceSearchPermRef
+}
+type SearchServiceSearchPermRef struct {
+	Perm perms.PermissionRef
+}
+
+var SearchService = SearchServicePerms{
+	Search: SearchServiceSearchPermRef{
+		Perm: perms.NewPermissionRef(Namespace, SearchServicePerm, SearchServiceSearchPerm),
+	},
+}
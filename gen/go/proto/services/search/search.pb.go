@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: services/search/search.proto
+
+//go:build !protoopaque
+
+package search
+
+import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/perms"
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/sanitizer"
+	search "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchRequest struct {
+	state  protoimpl.MessageState `protogen:"hybrid.v1"`
+	Search string                 `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	// Limit the results to the given types, all types are searched when empty
+	Types         []search.SearchResultType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=resources.search.SearchResultType" json:"types,omitempty"`
+	Limit         *int32                    `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_services_search_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_search_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []search.SearchResultType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) SetSearch(v string) {
+	x.Search = v
+}
+
+func (x *SearchRequest) SetTypes(v []search.SearchResultType) {
+	x.Types = v
+}
+
+func (x *SearchRequest) SetLimit(v int32) {
+	x.Limit = &v
+}
+
+func (x *SearchRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return x.Limit != nil
+}
+
+func (x *SearchRequest) ClearLimit() {
+	x.Limit = nil
+}
+
+type SearchRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Search string
+	// Limit the results to the given types, all types are searched when empty
+	Types []search.SearchResultType
+	Limit *int32
+}
+
+func (b0 SearchRequest_builder) Build() *SearchRequest {
+	m0 := &SearchRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Search = b.Search
+	x.Types = b.Types
+	x.Limit = b.Limit
+	return m0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Results       []*search.SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_services_search_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_search_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchResponse) GetResults() []*search.SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) SetResults(v []*search.SearchResult) {
+	x.Results = v
+}
+
+type SearchResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results []*search.SearchResult
+}
+
+func (b0 SearchResponse_builder) Build() *SearchResponse {
+	m0 := &SearchResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Results = b.Results
+	return m0
+}
+
+var File_services_search_search_proto protoreflect.FileDescriptor
+
+const file_services_search_search_proto_rawDesc = "" +
+	"\n" +
+	"\x1cservices/search/search.proto\x12\x0fservices.search\x1a\x19codegen/perms/perms.proto\x1a!codegen/sanitizer/sanitizer.proto\x1a\x1dresources/search/search.proto\"\x90\x01\n" +
+	"\rSearchRequest\x12 \n" +
+	"\x06search\x18\x01 \x01(\tB\b\xda\xf3\x18\x04\b\x01\x18\x01R\x06search\x128\n" +
+	"\x05types\x18\x02 \x03(\x0e2\".resources.search.SearchResultTypeR\x05types\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"J\n" +
+	"\x0eSearchResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.resources.search.SearchResultR\aresults2y\n" +
+	"\rSearchService\x12Q\n" +
+	"\x06Search\x12\x1e.services.search.SearchRequest\x1a\x1f.services.search.SearchResponse\"\x06\xd2\xf3\x18\x02\b\x01\x1a\x15\xea\xf3\x18\x11\b\x0f\x12\ri-mdi-magnifyBJZHgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/search;searchb\x06proto3"
+
+var file_services_search_search_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_services_search_search_proto_goTypes = []any{
+	(*SearchRequest)(nil),        // 0: services.search.SearchRequest
+	(*SearchResponse)(nil),       // 1: services.search.SearchResponse
+	(search.SearchResultType)(0), // 2: resources.search.SearchResultType
+	(*search.SearchResult)(nil),  // 3: resources.search.SearchResult
+}
+var file_services_search_search_proto_depIdxs = []int32{
+	2, // 0: services.search.SearchRequest.types:type_name -> resources.search.SearchResultType
+	3, // 1: services.search.SearchResponse.results:type_name -> resources.search.SearchResult
+	0, // 2: services.search.SearchService.Search:input_type -> services.search.SearchRequest
+	1, // 3: services.search.SearchService.Search:output_type -> services.search.SearchResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_services_search_search_proto_init() }
+func file_services_search_search_proto_init() {
+	if File_services_search_search_proto != nil {
+		return
+	}
+	file_services_search_search_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_search_search_proto_rawDesc), len(file_services_search_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_services_search_search_proto_goTypes,
+		DependencyIndexes: file_services_search_search_proto_depIdxs,
+		MessageInfos:      file_services_search_search_proto_msgTypes,
+	}.Build()
+	File_services_search_search_proto = out.File
+	file_services_search_search_proto_goTypes = nil
+	file_services_search_search_proto_depIdxs = nil
+}
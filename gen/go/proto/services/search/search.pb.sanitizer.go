@@ -0,0 +1,49 @@
+// Code generated by protoc-gen-backend. DO NOT EDIT.
+// source: services/search/search.proto
+
+package search
+
+import (
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+)
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *SearchRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Search
+	m.Search = htmlsanitizer.StripHTMLTags(m.Search)
+
+	// Field: Types
+	for idx, item := range m.Types {
+		_, _ = idx, item
+
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *SearchResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Results
+	for idx, item := range m.Results {
+		_, _ = idx, item
+
+		if v, ok := any(item).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
@@ -9,10 +9,12 @@
 package settings
 
 import (
+	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/audit"
 	_ "github.com/fivenet-app/fivenet/v2026/gen/go/proto/codegen/perms"
 	attributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
 	permissions "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/permissions"
 	settings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/settings"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -782,11 +784,273 @@ func (b0 TriggerUserSyncResponse_builder) Build() *TriggerUserSyncResponse {
 	return m0
 }
 
+type ExportDocumentsArchiveRequest struct {
+	state          protoimpl.MessageState `protogen:"hybrid.v1"`
+	Job            *string                `protobuf:"bytes,1,opt,name=job,proto3,oneof" json:"job,omitempty"`
+	CategoryIds    []int64                `protobuf:"varint,2,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	DocumentIds    []int64                `protobuf:"varint,3,rep,packed,name=document_ids,json=documentIds,proto3" json:"document_ids,omitempty"`
+	From           *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To             *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportDocumentsArchiveRequest) Reset() {
+	*x = ExportDocumentsArchiveRequest{}
+	mi := &file_services_settings_system_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDocumentsArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDocumentsArchiveRequest) ProtoMessage() {}
+
+func (x *ExportDocumentsArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_settings_system_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportDocumentsArchiveRequest) GetJob() string {
+	if x != nil && x.Job != nil {
+		return *x.Job
+	}
+	return ""
+}
+
+func (x *ExportDocumentsArchiveRequest) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *ExportDocumentsArchiveRequest) GetDocumentIds() []int64 {
+	if x != nil {
+		return x.DocumentIds
+	}
+	return nil
+}
+
+func (x *ExportDocumentsArchiveRequest) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportDocumentsArchiveRequest) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportDocumentsArchiveRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ExportDocumentsArchiveRequest) SetJob(v string) {
+	x.Job = &v
+}
+
+func (x *ExportDocumentsArchiveRequest) SetCategoryIds(v []int64) {
+	x.CategoryIds = v
+}
+
+func (x *ExportDocumentsArchiveRequest) SetDocumentIds(v []int64) {
+	x.DocumentIds = v
+}
+
+func (x *ExportDocumentsArchiveRequest) SetFrom(v *timestamp.Timestamp) {
+	x.From = v
+}
+
+func (x *ExportDocumentsArchiveRequest) SetTo(v *timestamp.Timestamp) {
+	x.To = v
+}
+
+func (x *ExportDocumentsArchiveRequest) SetIncludeDeleted(v bool) {
+	x.IncludeDeleted = v
+}
+
+func (x *ExportDocumentsArchiveRequest) HasJob() bool {
+	if x == nil {
+		return false
+	}
+	return x.Job != nil
+}
+
+func (x *ExportDocumentsArchiveRequest) HasFrom() bool {
+	if x == nil {
+		return false
+	}
+	return x.From != nil
+}
+
+func (x *ExportDocumentsArchiveRequest) HasTo() bool {
+	if x == nil {
+		return false
+	}
+	return x.To != nil
+}
+
+func (x *ExportDocumentsArchiveRequest) ClearJob() {
+	x.Job = nil
+}
+
+func (x *ExportDocumentsArchiveRequest) ClearFrom() {
+	x.From = nil
+}
+
+func (x *ExportDocumentsArchiveRequest) ClearTo() {
+	x.To = nil
+}
+
+type ExportDocumentsArchiveRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Job            *string
+	CategoryIds    []int64
+	DocumentIds    []int64
+	From           *timestamp.Timestamp
+	To             *timestamp.Timestamp
+	IncludeDeleted bool
+}
+
+func (b0 ExportDocumentsArchiveRequest_builder) Build() *ExportDocumentsArchiveRequest {
+	m0 := &ExportDocumentsArchiveRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Job = b.Job
+	x.CategoryIds = b.CategoryIds
+	x.DocumentIds = b.DocumentIds
+	x.From = b.From
+	x.To = b.To
+	x.IncludeDeleted = b.IncludeDeleted
+	return m0
+}
+
+type ExportDocumentsArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Documents     int32                  `protobuf:"varint,3,opt,name=documents,proto3" json:"documents,omitempty"`
+	Files         int32                  `protobuf:"varint,4,opt,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDocumentsArchiveResponse) Reset() {
+	*x = ExportDocumentsArchiveResponse{}
+	mi := &file_services_settings_system_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDocumentsArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDocumentsArchiveResponse) ProtoMessage() {}
+
+func (x *ExportDocumentsArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_settings_system_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportDocumentsArchiveResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportDocumentsArchiveResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportDocumentsArchiveResponse) GetDocuments() int32 {
+	if x != nil {
+		return x.Documents
+	}
+	return 0
+}
+
+func (x *ExportDocumentsArchiveResponse) GetFiles() int32 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *ExportDocumentsArchiveResponse) SetFileName(v string) {
+	x.FileName = v
+}
+
+func (x *ExportDocumentsArchiveResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Data = v
+}
+
+func (x *ExportDocumentsArchiveResponse) SetDocuments(v int32) {
+	x.Documents = v
+}
+
+func (x *ExportDocumentsArchiveResponse) SetFiles(v int32) {
+	x.Files = v
+}
+
+type ExportDocumentsArchiveResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	FileName  string
+	Data      []byte
+	Documents int32
+	Files     int32
+}
+
+func (b0 ExportDocumentsArchiveResponse_builder) Build() *ExportDocumentsArchiveResponse {
+	m0 := &ExportDocumentsArchiveResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.FileName = b.FileName
+	x.Data = b.Data
+	x.Documents = b.Documents
+	x.Files = b.Files
+	return m0
+}
+
 var File_services_settings_system_proto protoreflect.FileDescriptor
 
 const file_services_settings_system_proto_rawDesc = "" +
 	"\n" +
-	"\x1eservices/settings/system.proto\x12\x11services.settings\x1a\x19codegen/perms/perms.proto\x1a1resources/permissions/attributes/attributes.proto\x1a3resources/permissions/permissions/permissions.proto\x1a\x1eresources/settings/perms.proto\x1a\x1fresources/settings/status.proto\",\n" +
+	"\x1eservices/settings/system.proto\x12\x11services.settings\x1a\x1ccodegen/audit/redacted.proto\x1a\x19codegen/perms/perms.proto\x1a1resources/permissions/attributes/attributes.proto\x1a3resources/permissions/permissions/permissions.proto\x1a\x1eresources/settings/perms.proto\x1a\x1fresources/settings/status.proto\x1a#resources/timestamp/timestamp.proto\",\n" +
 	"\x18GetAllPermissionsRequest\x12\x10\n" +
 	"\x03job\x18\x01 \x01(\tR\x03job\"\xbd\x01\n" +
 	"\x19GetAllPermissionsResponse\x12O\n" +
@@ -820,60 +1084,83 @@ const file_services_settings_system_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\v2 .resources.settings.SystemStatusR\x06status\"1\n" +
 	"\x16TriggerUserSyncRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\x19\n" +
-	"\x17TriggerUserSyncResponse2\xe3\x05\n" +
+	"\x17TriggerUserSyncResponse\"\xab\x02\n" +
+	"\x1dExportDocumentsArchiveRequest\x12\x15\n" +
+	"\x03job\x18\x01 \x01(\tH\x00R\x03job\x88\x01\x01\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\x03R\vcategoryIds\x12!\n" +
+	"\fdocument_ids\x18\x03 \x03(\x03R\vdocumentIds\x127\n" +
+	"\x04from\x18\x04 \x01(\v2\x1e.resources.timestamp.TimestampH\x01R\x04from\x88\x01\x01\x123\n" +
+	"\x02to\x18\x05 \x01(\v2\x1e.resources.timestamp.TimestampH\x02R\x02to\x88\x01\x01\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeletedB\x06\n" +
+	"\x04_jobB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\x8b\x01\n" +
+	"\x1eExportDocumentsArchiveResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x18\n" +
+	"\x04data\x18\x02 \x01(\fB\x04\xf0\xf3\x18\x01R\x04data\x12\x1c\n" +
+	"\tdocuments\x18\x03 \x01(\x05R\tdocuments\x12\x14\n" +
+	"\x05files\x18\x04 \x01(\x05R\x05files2\xf5\x06\n" +
 	"\rSystemService\x12k\n" +
 	"\tGetStatus\x12#.services.settings.GetStatusRequest\x1a$.services.settings.GetStatusResponse\"\x13\xd2\xf3\x18\x0f\b\x01\"\vConfigAdmin\x12\x80\x01\n" +
 	"\x11GetAllPermissions\x12+.services.settings.GetAllPermissionsRequest\x1a,.services.settings.GetAllPermissionsResponse\"\x10\xd2\xf3\x18\f\b\x01\"\bJobAdmin\x12q\n" +
 	"\fGetJobLimits\x12&.services.settings.GetJobLimitsRequest\x1a'.services.settings.GetJobLimitsResponse\"\x10\xd2\xf3\x18\f\b\x01\"\bJobAdmin\x12z\n" +
 	"\x0fUpdateJobLimits\x12).services.settings.UpdateJobLimitsRequest\x1a*.services.settings.UpdateJobLimitsResponse\"\x10\xd2\xf3\x18\f\b\x01\"\bJobAdmin\x12t\n" +
 	"\rDeleteFaction\x12'.services.settings.DeleteFactionRequest\x1a(.services.settings.DeleteFactionResponse\"\x10\xd2\xf3\x18\f\b\x01\"\bJobAdmin\x12}\n" +
-	"\x0fTriggerUserSync\x12).services.settings.TriggerUserSyncRequest\x1a*.services.settings.TriggerUserSyncResponse\"\x13\xd2\xf3\x18\x0f\b\x01\"\vConfigAdminBNZLgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/settings;settingsb\x06proto3"
+	"\x0fTriggerUserSync\x12).services.settings.TriggerUserSyncRequest\x1a*.services.settings.TriggerUserSyncResponse\"\x13\xd2\xf3\x18\x0f\b\x01\"\vConfigAdmin\x12\x8f\x01\n" +
+	"\x16ExportDocumentsArchive\x120.services.settings.ExportDocumentsArchiveRequest\x1a1.services.settings.ExportDocumentsArchiveResponse\"\x10\xd2\xf3\x18\f\b\x01\"\bJobAdminBNZLgithub.com/fivenet-app/fivenet/v2026/gen/go/proto/services/settings;settingsb\x06proto3"
 
-var file_services_settings_system_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_services_settings_system_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_services_settings_system_proto_goTypes = []any{
-	(*GetAllPermissionsRequest)(nil),  // 0: services.settings.GetAllPermissionsRequest
-	(*GetAllPermissionsResponse)(nil), // 1: services.settings.GetAllPermissionsResponse
-	(*GetJobLimitsRequest)(nil),       // 2: services.settings.GetJobLimitsRequest
-	(*GetJobLimitsResponse)(nil),      // 3: services.settings.GetJobLimitsResponse
-	(*UpdateJobLimitsRequest)(nil),    // 4: services.settings.UpdateJobLimitsRequest
-	(*UpdateJobLimitsResponse)(nil),   // 5: services.settings.UpdateJobLimitsResponse
-	(*DeleteFactionRequest)(nil),      // 6: services.settings.DeleteFactionRequest
-	(*DeleteFactionResponse)(nil),     // 7: services.settings.DeleteFactionResponse
-	(*GetStatusRequest)(nil),          // 8: services.settings.GetStatusRequest
-	(*GetStatusResponse)(nil),         // 9: services.settings.GetStatusResponse
-	(*TriggerUserSyncRequest)(nil),    // 10: services.settings.TriggerUserSyncRequest
-	(*TriggerUserSyncResponse)(nil),   // 11: services.settings.TriggerUserSyncResponse
-	(*permissions.Permission)(nil),    // 12: resources.permissions.permissions.Permission
-	(*attributes.RoleAttribute)(nil),  // 13: resources.permissions.attributes.RoleAttribute
-	(*settings.PermsUpdate)(nil),      // 14: resources.settings.PermsUpdate
-	(*settings.AttrsUpdate)(nil),      // 15: resources.settings.AttrsUpdate
-	(*settings.SystemStatus)(nil),     // 16: resources.settings.SystemStatus
+	(*GetAllPermissionsRequest)(nil),       // 0: services.settings.GetAllPermissionsRequest
+	(*GetAllPermissionsResponse)(nil),      // 1: services.settings.GetAllPermissionsResponse
+	(*GetJobLimitsRequest)(nil),            // 2: services.settings.GetJobLimitsRequest
+	(*GetJobLimitsResponse)(nil),           // 3: services.settings.GetJobLimitsResponse
+	(*UpdateJobLimitsRequest)(nil),         // 4: services.settings.UpdateJobLimitsRequest
+	(*UpdateJobLimitsResponse)(nil),        // 5: services.settings.UpdateJobLimitsResponse
+	(*DeleteFactionRequest)(nil),           // 6: services.settings.DeleteFactionRequest
+	(*DeleteFactionResponse)(nil),          // 7: services.settings.DeleteFactionResponse
+	(*GetStatusRequest)(nil),               // 8: services.settings.GetStatusRequest
+	(*GetStatusResponse)(nil),              // 9: services.settings.GetStatusResponse
+	(*TriggerUserSyncRequest)(nil),         // 10: services.settings.TriggerUserSyncRequest
+	(*TriggerUserSyncResponse)(nil),        // 11: services.settings.TriggerUserSyncResponse
+	(*ExportDocumentsArchiveRequest)(nil),  // 12: services.settings.ExportDocumentsArchiveRequest
+	(*ExportDocumentsArchiveResponse)(nil), // 13: services.settings.ExportDocumentsArchiveResponse
+	(*permissions.Permission)(nil),         // 14: resources.permissions.permissions.Permission
+	(*attributes.RoleAttribute)(nil),       // 15: resources.permissions.attributes.RoleAttribute
+	(*settings.PermsUpdate)(nil),           // 16: resources.settings.PermsUpdate
+	(*settings.AttrsUpdate)(nil),           // 17: resources.settings.AttrsUpdate
+	(*settings.SystemStatus)(nil),          // 18: resources.settings.SystemStatus
+	(*timestamp.Timestamp)(nil),            // 19: resources.timestamp.Timestamp
 }
 var file_services_settings_system_proto_depIdxs = []int32{
-	12, // 0: services.settings.GetAllPermissionsResponse.permissions:type_name -> resources.permissions.permissions.Permission
-	13, // 1: services.settings.GetAllPermissionsResponse.attributes:type_name -> resources.permissions.attributes.RoleAttribute
-	12, // 2: services.settings.GetJobLimitsResponse.permissions:type_name -> resources.permissions.permissions.Permission
-	13, // 3: services.settings.GetJobLimitsResponse.attributes:type_name -> resources.permissions.attributes.RoleAttribute
-	14, // 4: services.settings.UpdateJobLimitsRequest.perms:type_name -> resources.settings.PermsUpdate
-	15, // 5: services.settings.UpdateJobLimitsRequest.attrs:type_name -> resources.settings.AttrsUpdate
-	16, // 6: services.settings.GetStatusResponse.status:type_name -> resources.settings.SystemStatus
-	8,  // 7: services.settings.SystemService.GetStatus:input_type -> services.settings.GetStatusRequest
-	0,  // 8: services.settings.SystemService.GetAllPermissions:input_type -> services.settings.GetAllPermissionsRequest
-	2,  // 9: services.settings.SystemService.GetJobLimits:input_type -> services.settings.GetJobLimitsRequest
-	4,  // 10: services.settings.SystemService.UpdateJobLimits:input_type -> services.settings.UpdateJobLimitsRequest
-	6,  // 11: services.settings.SystemService.DeleteFaction:input_type -> services.settings.DeleteFactionRequest
-	10, // 12: services.settings.SystemService.TriggerUserSync:input_type -> services.settings.TriggerUserSyncRequest
-	9,  // 13: services.settings.SystemService.GetStatus:output_type -> services.settings.GetStatusResponse
-	1,  // 14: services.settings.SystemService.GetAllPermissions:output_type -> services.settings.GetAllPermissionsResponse
-	3,  // 15: services.settings.SystemService.GetJobLimits:output_type -> services.settings.GetJobLimitsResponse
-	5,  // 16: services.settings.SystemService.UpdateJobLimits:output_type -> services.settings.UpdateJobLimitsResponse
-	7,  // 17: services.settings.SystemService.DeleteFaction:output_type -> services.settings.DeleteFactionResponse
-	11, // 18: services.settings.SystemService.TriggerUserSync:output_type -> services.settings.TriggerUserSyncResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	14, // 0: services.settings.GetAllPermissionsResponse.permissions:type_name -> resources.permissions.permissions.Permission
+	15, // 1: services.settings.GetAllPermissionsResponse.attributes:type_name -> resources.permissions.attributes.RoleAttribute
+	14, // 2: services.settings.GetJobLimitsResponse.permissions:type_name -> resources.permissions.permissions.Permission
+	15, // 3: services.settings.GetJobLimitsResponse.attributes:type_name -> resources.permissions.attributes.RoleAttribute
+	16, // 4: services.settings.UpdateJobLimitsRequest.perms:type_name -> resources.settings.PermsUpdate
+	17, // 5: services.settings.UpdateJobLimitsRequest.attrs:type_name -> resources.settings.AttrsUpdate
+	18, // 6: services.settings.GetStatusResponse.status:type_name -> resources.settings.SystemStatus
+	19, // 7: services.settings.ExportDocumentsArchiveRequest.from:type_name -> resources.timestamp.Timestamp
+	19, // 8: services.settings.ExportDocumentsArchiveRequest.to:type_name -> resources.timestamp.Timestamp
+	8,  // 9: services.settings.SystemService.GetStatus:input_type -> services.settings.GetStatusRequest
+	0,  // 10: services.settings.SystemService.GetAllPermissions:input_type -> services.settings.GetAllPermissionsRequest
+	2,  // 11: services.settings.SystemService.GetJobLimits:input_type -> services.settings.GetJobLimitsRequest
+	4,  // 12: services.settings.SystemService.UpdateJobLimits:input_type -> services.settings.UpdateJobLimitsRequest
+	6,  // 13: services.settings.SystemService.DeleteFaction:input_type -> services.settings.DeleteFactionRequest
+	10, // 14: services.settings.SystemService.TriggerUserSync:input_type -> services.settings.TriggerUserSyncRequest
+	12, // 15: services.settings.SystemService.ExportDocumentsArchive:input_type -> services.settings.ExportDocumentsArchiveRequest
+	9,  // 16: services.settings.SystemService.GetStatus:output_type -> services.settings.GetStatusResponse
+	1,  // 17: services.settings.SystemService.GetAllPermissions:output_type -> services.settings.GetAllPermissionsResponse
+	3,  // 18: services.settings.SystemService.GetJobLimits:output_type -> services.settings.GetJobLimitsResponse
+	5,  // 19: services.settings.SystemService.UpdateJobLimits:output_type -> services.settings.UpdateJobLimitsResponse
+	7,  // 20: services.settings.SystemService.DeleteFaction:output_type -> services.settings.DeleteFactionResponse
+	11, // 21: services.settings.SystemService.TriggerUserSync:output_type -> services.settings.TriggerUserSyncResponse
+	13, // 22: services.settings.SystemService.ExportDocumentsArchive:output_type -> services.settings.ExportDocumentsArchiveResponse
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_services_settings_system_proto_init() }
@@ -883,13 +1170,14 @@ func file_services_settings_system_proto_init() {
 	}
 	file_services_settings_system_proto_msgTypes[3].OneofWrappers = []any{}
 	file_services_settings_system_proto_msgTypes[4].OneofWrappers = []any{}
+	file_services_settings_system_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_settings_system_proto_rawDesc), len(file_services_settings_system_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,54 @@ func (m *DeleteFactionRequest) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExportDocumentsArchiveRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: From
+	if m.From != nil {
+		if v, ok := any(m.GetFrom()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field: Job
+	if m.Job != nil {
+		*m.Job = htmlsanitizer.SanitizeAndUnescape(*m.Job)
+	}
+
+	// Field: To
+	if m.To != nil {
+		if v, ok := any(m.GetTo()).(interface{ Sanitize() error }); ok {
+			if err := v.Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *ExportDocumentsArchiveResponse) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Data
+
+	// Field: FileName
+	m.FileName = htmlsanitizer.SanitizeAndUnescape(m.FileName)
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *GetAllPermissionsRequest) Sanitize() error {
@@ -19,12 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SystemService_GetStatus_FullMethodName         = "/services.settings.SystemService/GetStatus"
-	SystemService_GetAllPermissions_FullMethodName = "/services.settings.SystemService/GetAllPermissions"
-	SystemService_GetJobLimits_FullMethodName      = "/services.settings.SystemService/GetJobLimits"
-	SystemService_UpdateJobLimits_FullMethodName   = "/services.settings.SystemService/UpdateJobLimits"
-	SystemService_DeleteFaction_FullMethodName     = "/services.settings.SystemService/DeleteFaction"
-	SystemService_TriggerUserSync_FullMethodName   = "/services.settings.SystemService/TriggerUserSync"
+	SystemService_GetStatus_FullMethodName              = "/services.settings.SystemService/GetStatus"
+	SystemService_GetAllPermissions_FullMethodName      = "/services.settings.SystemService/GetAllPermissions"
+	SystemService_GetJobLimits_FullMethodName           = "/services.settings.SystemService/GetJobLimits"
+	SystemService_UpdateJobLimits_FullMethodName        = "/services.settings.SystemService/UpdateJobLimits"
+	SystemService_DeleteFaction_FullMethodName          = "/services.settings.SystemService/DeleteFaction"
+	SystemService_TriggerUserSync_FullMethodName        = "/services.settings.SystemService/TriggerUserSync"
+	SystemService_ExportDocumentsArchive_FullMethodName = "/services.settings.SystemService/ExportDocumentsArchive"
 )
 
 // SystemServiceClient is the client API for SystemService service.
@@ -37,6 +38,7 @@ type SystemServiceClient interface {
 	UpdateJobLimits(ctx context.Context, in *UpdateJobLimitsRequest, opts ...grpc.CallOption) (*UpdateJobLimitsResponse, error)
 	DeleteFaction(ctx context.Context, in *DeleteFactionRequest, opts ...grpc.CallOption) (*DeleteFactionResponse, error)
 	TriggerUserSync(ctx context.Context, in *TriggerUserSyncRequest, opts ...grpc.CallOption) (*TriggerUserSyncResponse, error)
+	ExportDocumentsArchive(ctx context.Context, in *ExportDocumentsArchiveRequest, opts ...grpc.CallOption) (*ExportDocumentsArchiveResponse, error)
 }
 
 type systemServiceClient struct {
@@ -107,6 +109,16 @@ func (c *systemServiceClient) TriggerUserSync(ctx context.Context, in *TriggerUs
 	return out, nil
 }
 
+func (c *systemServiceClient) ExportDocumentsArchive(ctx context.Context, in *ExportDocumentsArchiveRequest, opts ...grpc.CallOption) (*ExportDocumentsArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportDocumentsArchiveResponse)
+	err := c.cc.Invoke(ctx, SystemService_ExportDocumentsArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemServiceServer is the server API for SystemService service.
 // All implementations must embed UnimplementedSystemServiceServer
 // for forward compatibility.
@@ -117,6 +129,7 @@ type SystemServiceServer interface {
 	UpdateJobLimits(context.Context, *UpdateJobLimitsRequest) (*UpdateJobLimitsResponse, error)
 	DeleteFaction(context.Context, *DeleteFactionRequest) (*DeleteFactionResponse, error)
 	TriggerUserSync(context.Context, *TriggerUserSyncRequest) (*TriggerUserSyncResponse, error)
+	ExportDocumentsArchive(context.Context, *ExportDocumentsArchiveRequest) (*ExportDocumentsArchiveResponse, error)
 	mustEmbedUnimplementedSystemServiceServer()
 }
 
@@ -145,6 +158,9 @@ func (UnimplementedSystemServiceServer) DeleteFaction(context.Context, *DeleteFa
 func (UnimplementedSystemServiceServer) TriggerUserSync(context.Context, *TriggerUserSyncRequest) (*TriggerUserSyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerUserSync not implemented")
 }
+func (UnimplementedSystemServiceServer) ExportDocumentsArchive(context.Context, *ExportDocumentsArchiveRequest) (*ExportDocumentsArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDocumentsArchive not implemented")
+}
 func (UnimplementedSystemServiceServer) mustEmbedUnimplementedSystemServiceServer() {}
 func (UnimplementedSystemServiceServer) testEmbeddedByValue()                       {}
 
@@ -274,6 +290,24 @@ func _SystemService_TriggerUserSync_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemService_ExportDocumentsArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDocumentsArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).ExportDocumentsArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemService_ExportDocumentsArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).ExportDocumentsArchive(ctx, req.(*ExportDocumentsArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SystemService_ServiceDesc is the grpc.ServiceDesc for SystemService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +339,10 @@ var SystemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerUserSync",
 			Handler:    _SystemService_TriggerUserSync_Handler,
 		},
+		{
+			MethodName: "ExportDocumentsArchive",
+			Handler:    _SystemService_ExportDocumentsArchive_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/settings/system.proto",
@@ -693,8 +693,10 @@ func (b0 AddUserOAuth2ConnRequest_builder) Build() *AddUserOAuth2ConnRequest {
 }
 
 type AddDispatchRequest struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Dispatch      *dispatches.Dispatch   `protobuf:"bytes,1,opt,name=dispatch,proto3" json:"dispatch,omitempty"`
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	Dispatch *dispatches.Dispatch   `protobuf:"bytes,1,opt,name=dispatch,proto3" json:"dispatch,omitempty"`
+	// Code of a dispatch template of the dispatch's (first) job, the template's defaults are used for unset fields
+	TemplateCode  *string `protobuf:"bytes,2,opt,name=template_code,json=templateCode,proto3,oneof" json:"template_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -731,10 +733,21 @@ func (x *AddDispatchRequest) GetDispatch() *dispatches.Dispatch {
 	return nil
 }
 
+func (x *AddDispatchRequest) GetTemplateCode() string {
+	if x != nil && x.TemplateCode != nil {
+		return *x.TemplateCode
+	}
+	return ""
+}
+
 func (x *AddDispatchRequest) SetDispatch(v *dispatches.Dispatch) {
 	x.Dispatch = v
 }
 
+func (x *AddDispatchRequest) SetTemplateCode(v string) {
+	x.TemplateCode = &v
+}
+
 func (x *AddDispatchRequest) HasDispatch() bool {
 	if x == nil {
 		return false
@@ -742,14 +755,27 @@ func (x *AddDispatchRequest) HasDispatch() bool {
 	return x.Dispatch != nil
 }
 
+func (x *AddDispatchRequest) HasTemplateCode() bool {
+	if x == nil {
+		return false
+	}
+	return x.TemplateCode != nil
+}
+
 func (x *AddDispatchRequest) ClearDispatch() {
 	x.Dispatch = nil
 }
 
+func (x *AddDispatchRequest) ClearTemplateCode() {
+	x.TemplateCode = nil
+}
+
 type AddDispatchRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Dispatch *dispatches.Dispatch
+	// Code of a dispatch template of the dispatch's (first) job, the template's defaults are used for unset fields
+	TemplateCode *string
 }
 
 func (b0 AddDispatchRequest_builder) Build() *AddDispatchRequest {
@@ -757,6 +783,7 @@ func (b0 AddDispatchRequest_builder) Build() *AddDispatchRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Dispatch = b.Dispatch
+	x.TemplateCode = b.TemplateCode
 	return m0
 }
 
@@ -886,7 +913,11 @@ func (b0 DeleteMarkerRequest_builder) Build() *DeleteMarkerRequest {
 }
 
 type EndActiveJobTimeclocksRequest struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Only end the active timeclock entries of the job (all jobs if unset)
+	Job *string `protobuf:"bytes,1,opt,name=job,proto3,oneof" json:"job,omitempty"`
+	// Only end the active timeclock entries of the users (all users if empty)
+	UserIds       []int32 `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -916,15 +947,54 @@ func (x *EndActiveJobTimeclocksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+func (x *EndActiveJobTimeclocksRequest) GetJob() string {
+	if x != nil && x.Job != nil {
+		return *x.Job
+	}
+	return ""
+}
+
+func (x *EndActiveJobTimeclocksRequest) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *EndActiveJobTimeclocksRequest) SetJob(v string) {
+	x.Job = &v
+}
+
+func (x *EndActiveJobTimeclocksRequest) SetUserIds(v []int32) {
+	x.UserIds = v
+}
+
+func (x *EndActiveJobTimeclocksRequest) HasJob() bool {
+	if x == nil {
+		return false
+	}
+	return x.Job != nil
+}
+
+func (x *EndActiveJobTimeclocksRequest) ClearJob() {
+	x.Job = nil
+}
+
 type EndActiveJobTimeclocksRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Only end the active timeclock entries of the job (all jobs if unset)
+	Job *string
+	// Only end the active timeclock entries of the users (all users if empty)
+	UserIds []int32
 }
 
 func (b0 EndActiveJobTimeclocksRequest_builder) Build() *EndActiveJobTimeclocksRequest {
 	m0 := &EndActiveJobTimeclocksRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.Job = b.Job
+	x.UserIds = b.UserIds
 	return m0
 }
 
@@ -3889,14 +3959,19 @@ const file_services_sync_sync_proto_rawDesc = "" +
 	"\x17TransferAccountResponse\"d\n" +
 	"\x18AddUserOAuth2ConnRequest\x12H\n" +
 	"\vuser_oauth2\x18\x01 \x01(\v2'.resources.sync.activity.UserOAuth2ConnR\n" +
-	"userOauth2\"X\n" +
+	"userOauth2\"\x94\x01\n" +
 	"\x12AddDispatchRequest\x12B\n" +
-	"\bdispatch\x18\x01 \x01(\v2&.resources.centrum.dispatches.DispatchR\bdispatch\"S\n" +
+	"\bdispatch\x18\x01 \x01(\v2&.resources.centrum.dispatches.DispatchR\bdispatch\x12(\n" +
+	"\rtemplate_code\x18\x02 \x01(\tH\x00R\ftemplateCode\x88\x01\x01B\x10\n" +
+	"\x0e_template_code\"S\n" +
 	"\x10AddMarkerRequest\x12?\n" +
 	"\x06marker\x18\x01 \x01(\v2'.resources.livemap.markers.MarkerMarkerR\x06marker\"%\n" +
 	"\x13DeleteMarkerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1f\n" +
-	"\x1dEndActiveJobTimeclocksRequest\"E\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"Y\n" +
+	"\x1dEndActiveJobTimeclocksRequest\x12\x15\n" +
+	"\x03job\x18\x01 \x01(\tH\x00R\x03job\x88\x01\x01\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x05R\auserIdsB\x06\n" +
+	"\x04_job\"E\n" +
 	"\x1eEndActiveJobTimeclocksResponse\x12#\n" +
 	"\rrows_affected\x18\x01 \x01(\x03R\frowsAffected\"\xd4\x01\n" +
 	"\x1aCloseUserDispatchesRequest\x12$\n" +
@@ -4224,6 +4299,8 @@ func file_services_sync_sync_proto_init() {
 	file_services_sync_sync_proto_msgTypes[1].OneofWrappers = []any{}
 	file_services_sync_sync_proto_msgTypes[2].OneofWrappers = []any{}
 	file_services_sync_sync_proto_msgTypes[3].OneofWrappers = []any{}
+	file_services_sync_sync_proto_msgTypes[7].OneofWrappers = []any{}
+	file_services_sync_sync_proto_msgTypes[10].OneofWrappers = []any{}
 	file_services_sync_sync_proto_msgTypes[12].OneofWrappers = []any{}
 	file_services_sync_sync_proto_msgTypes[23].OneofWrappers = []any{}
 	file_services_sync_sync_proto_msgTypes[29].OneofWrappers = []any{}
@@ -212,6 +212,11 @@ func (m *AddDispatchRequest) Sanitize() error {
 		}
 	}
 
+	// Field: TemplateCode
+	if m.TemplateCode != nil {
+		*m.TemplateCode = htmlsanitizer.SanitizeAndUnescape(*m.TemplateCode)
+	}
+
 	return nil
 }
 
@@ -415,6 +420,21 @@ func (m *DeleteVehiclesRequest) Sanitize() error {
 	return nil
 }
 
+// Sanitize sanitizes the message's fields, in case of complex types it calls
+// their Sanitize() method recursively.
+func (m *EndActiveJobTimeclocksRequest) Sanitize() error {
+	if m == nil {
+		return nil
+	}
+
+	// Field: Job
+	if m.Job != nil {
+		*m.Job = htmlsanitizer.SanitizeAndUnescape(*m.Job)
+	}
+
+	return nil
+}
+
 // Sanitize sanitizes the message's fields, in case of complex types it calls
 // their Sanitize() method recursively.
 func (m *GetStatusResponse) Sanitize() error {
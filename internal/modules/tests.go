@@ -25,6 +25,7 @@ import (
 	mailerstore "github.com/fivenet-app/fivenet/v2026/stores/mailer"
 	notificationsstore "github.com/fivenet-app/fivenet/v2026/stores/notifications"
 	qualificationsstore "github.com/fivenet-app/fivenet/v2026/stores/qualifications"
+	searchstore "github.com/fivenet-app/fivenet/v2026/stores/search"
 	settingsstore "github.com/fivenet-app/fivenet/v2026/stores/settings"
 	statsstore "github.com/fivenet-app/fivenet/v2026/stores/stats"
 	usersstore "github.com/fivenet-app/fivenet/v2026/stores/users"
@@ -71,6 +72,7 @@ func GetFxTestOpts(opts ...fx.Option) []fx.Option {
 			mailerstore.New,
 			notificationsstore.New,
 			qualificationsstore.New,
+			searchstore.New,
 			settingsstore.New,
 			statsstore.New,
 			documentsstore.New,
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	"github.com/go-jet/jet/v2/mysql"
 )
 
 type GroupedAccess interface {
@@ -16,6 +17,20 @@ type GroupedAccess interface {
 	) (bool, error)
 }
 
+// VisibleTargetsAccess is implemented by grouped accesses that can limit queries to the targets
+// visible to a user in SQL.
+type VisibleTargetsAccess interface {
+	GroupedAccess
+
+	TargetIDColumn() mysql.ColumnInteger
+	VisibleIDsByConditionQuery(
+		userInfo *userinfo.UserInfo,
+		access int32,
+		includeDeleted bool,
+		condition mysql.BoolExpression,
+	) VisibilityQuery
+}
+
 type GroupedAccessAdapter struct {
 	CanUserAccessTargetFn func(ctx context.Context, targetId int64, userInfo *userinfo.UserInfo, access int32) (bool, error)
 }
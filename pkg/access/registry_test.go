@@ -7,6 +7,8 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
 )
 
+var _ VisibleTargetsAccess = (*DocumentsObjectAccess)(nil)
+
 func resetGroupedAccesses(t *testing.T) {
 	t.Helper()
 
@@ -368,6 +368,11 @@ func (a *SubjectObjectAccess) CanUserAccessTargetIDs(
 	return dest.IDs, nil
 }
 
+// TargetIDColumn returns the ID column of the target table, the visible IDs are selected from it.
+func (a *SubjectObjectAccess) TargetIDColumn() mysql.ColumnInteger {
+	return a.targetTableColumns.ID
+}
+
 func (a *SubjectObjectAccess) VisibleIDsStatement(
 	userInfo *userinfo.UserInfo,
 	access int32,
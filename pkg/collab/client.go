@@ -40,13 +40,14 @@ func NewClient(
 	logger *zap.Logger,
 	clientId uint64,
 	room *CollabRoom,
-	_ int32,
+	userId int32,
 	role collab.ClientRole,
 	stream grpc.BidiStreamingServer[collab.ClientPacket, collab.ServerPacket],
 ) *Client {
 	return &Client{
 		logger: logger,
 		Id:     clientId,
+		UserId: userId,
 		Role:   role,
 		room:   room,
 		Stream: stream,
@@ -64,7 +65,7 @@ func (c *Client) StartPresence(ctx context.Context) error {
 	c.firstKey = "first." + c.room.category + "." + roomId
 
 	// Announce this client
-	_, err := stateKV.Put(ctx, c.presenceKey, nil)
+	_, err := stateKV.Put(ctx, c.presenceKey, c.presenceValue())
 	if err != nil {
 		c.logger.Error("failed to announce presence", zap.Error(err))
 		return fmt.Errorf("failed to announce presence for client %d. %w", c.Id, err)
@@ -83,12 +84,22 @@ func (c *Client) StartPresence(ctx context.Context) error {
 	// Launch heartbeat + watcher
 	hbCtx, cancel := context.WithCancel(ctx)
 	c.hbCancel = cancel
-	go hbLoop(hbCtx, c.room, c.presenceKey, c.firstKey, cid)
+	go hbLoop(hbCtx, c.room, c.presenceKey, c.presenceValue(), c.firstKey, cid)
 	go firstWatch(hbCtx, c.room, c.firstKey, cid, c.Id)
 
 	return nil
 }
 
+// presenceValue returns the user ID for writer clients, so the users editing the target can be
+// listed. Reader clients announce themselves without a value.
+func (c *Client) presenceValue() []byte {
+	if c.Role < collab.ClientRole_CLIENT_ROLE_WRITER {
+		return nil
+	}
+
+	return []byte(strconv.FormatInt(int64(c.UserId), 10))
+}
+
 func (c *Client) StopPresence(ctx context.Context) {
 	if c.hbCancel != nil {
 		c.hbCancel()
@@ -127,7 +138,7 @@ func (c *Client) SendLoop() error {
 }
 
 // hbLoop - resets TTL every 2s.
-func hbLoop(ctx context.Context, room *CollabRoom, pKey, fKey, cid string) {
+func hbLoop(ctx context.Context, room *CollabRoom, pKey string, pVal []byte, fKey, cid string) {
 	t := time.NewTicker(hbEvery)
 	defer t.Stop()
 
@@ -139,7 +150,7 @@ func hbLoop(ctx context.Context, room *CollabRoom, pKey, fKey, cid string) {
 
 		case <-t.C:
 			// Refresh our presence key
-			if _, err := room.stateKV.Put(ctx, pKey, nil); err != nil {
+			if _, err := room.stateKV.Put(ctx, pKey, pVal); err != nil {
 				room.logger.Warn(
 					"hbLoop: failed to refresh presence key",
 					zap.String("key", pKey),
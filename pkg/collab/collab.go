@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"strconv"
 	"sync"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type collabMetrics struct {
@@ -319,15 +322,64 @@ func (s *CollabServer) HandleClient(
 	}
 }
 
-// SendTargetSaved notifies the room for the given targetId that the target has been saved.
-func (s *CollabServer) SendTargetSaved(_ context.Context, targetId int64) {
+// SendTargetSaved notifies the clients of the target's room that the target has been saved.
+// The event is published even if this instance has no local room for the target, as the
+// clients might be connected to another instance.
+func (s *CollabServer) SendTargetSaved(_ context.Context, targetId int64, versionId *int64) {
 	s.mu.Lock()
 	room, exists := s.rooms[targetId]
 	s.mu.Unlock()
 
-	if !exists {
-		return // No room exists for this target, nothing to do
+	if exists {
+		room.SendTargetSaved(versionId)
+		return
+	}
+
+	data, err := proto.Marshal(newTargetSavedPacket(targetId, versionId))
+	if err != nil {
+		s.logger.Error("failed to marshal target saved message", zap.Error(err))
+		return
+	}
+
+	if _, err := s.js.PublishAsync(fmt.Sprintf("collab.%s.%d", s.category, targetId), data); err != nil {
+		s.logger.Error("publish error", zap.Error(err))
+	}
+}
+
+// ListWriterUserIDs returns the IDs of the users that are currently connected as writers to the
+// target's room (across all instances).
+func (s *CollabServer) ListWriterUserIDs(ctx context.Context, targetId int64) ([]int32, error) {
+	if s.stateKV == nil {
+		return nil, nil
+	}
+
+	keyIter, err := s.stateKV.ListKeysFiltered(
+		ctx,
+		"presence."+s.category+"."+strconv.FormatInt(targetId, 10)+".*",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list presence keys. %w", err)
+	}
+	defer keyIter.Stop()
+
+	userIds := []int32{}
+	for key := range keyIter.Keys() {
+		entry, err := s.stateKV.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				// Client has left in the meantime
+				continue
+			}
+			return nil, fmt.Errorf("failed to get presence key %q. %w", key, err)
+		}
+
+		userId, ok := parsePresenceValue(entry.Value())
+		if !ok || slices.Contains(userIds, userId) {
+			continue
+		}
+		userIds = append(userIds, userId)
 	}
+	slices.Sort(userIds)
 
-	room.SendTargetSaved()
+	return userIds, nil
 }
@@ -297,14 +297,19 @@ func (r *CollabRoom) BroadcastAwareness(fromId uint64, data []byte) {
 }
 
 // SendTargetSaved notifies all clients in the room that the target has been saved.
-func (r *CollabRoom) SendTargetSaved() {
-	r.Broadcast(0, &collab.ServerPacket{
+func (r *CollabRoom) SendTargetSaved(versionId *int64) {
+	r.Broadcast(0, newTargetSavedPacket(r.Id, versionId))
+}
+
+func newTargetSavedPacket(targetId int64, versionId *int64) *collab.ServerPacket {
+	return &collab.ServerPacket{
 		Msg: &collab.ServerPacket_TargetSaved{
 			TargetSaved: &collab.TargetSaved{
-				TargetId: r.Id,
+				TargetId:  targetId,
+				VersionId: versionId,
 			},
 		},
-	})
+	}
 }
 
 func (r *CollabRoom) notifyFirst(id uint64) {
@@ -24,3 +24,17 @@ func MakeClientID(userID int32, sessionID string) uint64 {
 	// Clamp to max safe JS integer to be Yjs-safe
 	return id & maxJSInt
 }
+
+// parsePresenceValue returns the user ID of a writer client's presence value.
+func parsePresenceValue(val []byte) (int32, bool) {
+	if len(val) == 0 {
+		return 0, false
+	}
+
+	userId, err := strconv.ParseInt(string(val), 10, 32)
+	if err != nil || userId <= 0 {
+		return 0, false
+	}
+
+	return int32(userId), true
+}
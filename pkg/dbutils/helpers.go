@@ -2,6 +2,7 @@ package dbutils
 
 import (
 	"strings"
+	"unicode/utf8"
 )
 
 func PrepareForLikeSearch(input string) string {
@@ -26,3 +27,25 @@ func PrepareForLikeSearch(input string) string {
 
 	return input
 }
+
+// PrepareForFulltextSearch converts user input into a boolean mode fulltext search query,
+// requiring every word (as a prefix). Words shorter than the default InnoDB min token size are dropped.
+func PrepareForFulltextSearch(input string) string {
+	// Remove boolean mode operators
+	input = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`+-<>()~*"@`, r) {
+			return ' '
+		}
+		return r
+	}, input)
+
+	terms := []string{}
+	for _, term := range strings.Fields(input) {
+		if utf8.RuneCountInString(term) < 3 {
+			continue
+		}
+		terms = append(terms, "+"+term+"*")
+	}
+
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,39 @@
+package dbutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareForFulltextSearch(t *testing.T) {
+	t.Parallel()
+	for _, run := range []struct {
+		input  string
+		output string
+		msg    string
+	}{
+		{
+			input:  "",
+			output: "",
+			msg:    "empty input",
+		},
+		{
+			input:  "  John   Doe ",
+			output: "+John* +Doe*",
+			msg:    "words are required prefixes",
+		},
+		{
+			input:  `+traffic -"stop" (report)*`,
+			output: "+traffic* +stop* +report*",
+			msg:    "boolean mode operators are removed",
+		},
+		{
+			input:  "a of Müller",
+			output: "+Müller*",
+			msg:    "short words are dropped",
+		},
+	} {
+		assert.Equal(t, run.output, PrepareForFulltextSearch(run.input), run.msg)
+	}
+}
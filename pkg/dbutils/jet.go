@@ -35,6 +35,23 @@ func JSON_CONTAINS(column mysql.Column, value mysql.Expression) mysql.BoolExpres
 	))
 }
 
+// JSON_UNQUOTE_EXTRACT is a helper function to create a JSON_UNQUOTE(JSON_EXTRACT()) expression
+// in go-jet, returning the scalar value at the JSON path as a string.
+//
+//nolint:revive // Function name is all uppercase to be consistent with go-jet package.
+func JSON_UNQUOTE_EXTRACT(
+	column mysql.Column,
+	path mysql.StringExpression,
+) mysql.StringExpression {
+	return mysql.StringExp(mysql.CustomExpression(
+		mysql.Token("JSON_UNQUOTE(JSON_EXTRACT("),
+		column,
+		mysql.Token(", "),
+		path,
+		mysql.Token("))"),
+	))
+}
+
 // MATCH is a helper function to create a boolean mode MATCH expression in go-jet.
 func MATCH(column mysql.Column, search mysql.Expression) mysql.BoolExpression {
 	return mysql.BoolExp(mysql.CustomExpression(
@@ -46,6 +63,19 @@ func MATCH(column mysql.Column, search mysql.Expression) mysql.BoolExpression {
 	))
 }
 
+// MATCH_SCORE is a helper function to select the relevance of a boolean mode MATCH expression in go-jet.
+//
+//nolint:revive // Function name is all uppercase to be consistent with go-jet package.
+func MATCH_SCORE(column mysql.Column, search mysql.Expression) mysql.FloatExpression {
+	return mysql.FloatExp(mysql.CustomExpression(
+		mysql.Token("MATCH("),
+		column,
+		mysql.Token(") AGAINST ("),
+		search,
+		mysql.Token(" IN BOOLEAN MODE)"),
+	))
+}
+
 // LAST_INSERT_ID is a helper function to create a LAST_INSERT_ID expression in go-jet.
 //
 //nolint:revive // Function name is all uppercase to be consistent with go-jet package.
@@ -0,0 +1,158 @@
+// Package docarchive exports documents to and imports documents from self-contained zip
+// archives, e.g., to move a job's paperwork between FiveNet instances.
+//
+// An archive contains a `manifest.json`, one `documents/<id>.json` per document and the
+// filestore attachments of the documents under `files/<file id>/<name>`.
+package docarchive
+
+import (
+	"archive/zip"
+	"cmp"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
+	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	"github.com/fivenet-app/fivenet/v2026/pkg/access"
+	"github.com/fivenet-app/fivenet/v2026/pkg/storage"
+)
+
+var subjectAccessOptions = access.SubjectAccessOptions{
+	BlockedAccess: int32(documentsaccess.AccessLevel_ACCESS_LEVEL_BLOCKED),
+	DeniedAccessLevels: []int32{
+		int32(documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW),
+		int32(documentsaccess.AccessLevel_ACCESS_LEVEL_COMMENT),
+		int32(documentsaccess.AccessLevel_ACCESS_LEVEL_STATUS),
+		int32(documentsaccess.AccessLevel_ACCESS_LEVEL_ACCESS),
+		int32(documentsaccess.AccessLevel_ACCESS_LEVEL_EDIT),
+	},
+}
+
+type Archiver struct {
+	db       *sql.DB
+	st       storage.IStorage
+	access   *access.DocumentsObjectAccess
+	resolver *access.SubjectResolver
+}
+
+func New(db *sql.DB, st storage.IStorage) *Archiver {
+	return &Archiver{
+		db:       db,
+		st:       st,
+		access:   access.NewDocumentsSubjectObjectAccess(db),
+		resolver: access.NewSubjectResolver(db),
+	}
+}
+
+func writeJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func readJSON(zr *zip.Reader, name string, v any) error {
+	f, err := zr.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewDecoder(f).Decode(v)
+}
+
+func readManifest(zr *zip.Reader) (*Manifest, error) {
+	manifest := &Manifest{}
+	if err := readJSON(zr, manifestFileName, manifest); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNoManifest
+		}
+		return nil, err
+	}
+
+	if manifest.Version <= 0 || manifest.Version > FormatVersion {
+		return nil, ErrUnsupportedVersion
+	}
+
+	return manifest, nil
+}
+
+func readDocuments(zr *zip.Reader) ([]*Document, error) {
+	docs := []*Document{}
+	for _, f := range zr.File {
+		if path.Dir(f.Name) != documentsDir || !strings.HasSuffix(f.Name, ".json") {
+			continue
+		}
+
+		doc := &Document{}
+		if err := readJSON(zr, f.Name, doc); err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	slices.SortFunc(docs, func(a, b *Document) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return docs, nil
+}
+
+func accessFromProto(in *resourcesaccess.Access) *Access {
+	out := &Access{}
+	for _, ja := range in.GetJobs() {
+		out.Jobs = append(out.Jobs, &JobAccess{
+			Job:          ja.GetJob(),
+			MinimumGrade: ja.GetMinimumGrade(),
+			Access:       ja.GetAccess(),
+		})
+	}
+	for _, ua := range in.GetUsers() {
+		out.Users = append(out.Users, &UserAccess{
+			UserID: ua.GetUserId(),
+			Access: ua.GetAccess(),
+		})
+	}
+
+	if len(out.Jobs) == 0 && len(out.Users) == 0 {
+		return nil
+	}
+	return out
+}
+
+func (r *remapper) accessToProto(in *Access) *resourcesaccess.Access {
+	out := &resourcesaccess.Access{}
+	if in == nil {
+		return out
+	}
+
+	for _, ja := range in.Jobs {
+		out.Jobs = append(out.Jobs, &resourcesaccess.JobAccess{
+			Job:          r.job(ja.Job),
+			MinimumGrade: ja.MinimumGrade,
+			Access:       ja.Access,
+		})
+	}
+	for _, ua := range in.Users {
+		userId := r.user(ua.UserID)
+		if !r.existingUsers[userId] {
+			continue
+		}
+
+		out.Users = append(out.Users, &resourcesaccess.UserAccess{
+			UserId: userId,
+			Access: ua.Access,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,71 @@
+package docarchive
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveReadWrite(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	require.NoError(t, writeJSON(zw, manifestFileName, &Manifest{
+		Version:   FormatVersion,
+		Documents: 2,
+	}))
+	require.NoError(t, writeJSON(zw, "documents/12.json", &Document{
+		ID:    12,
+		Title: "Second",
+		Comments: []*Comment{
+			{ID: 1, Content: "Comment"},
+		},
+	}))
+	require.NoError(t, writeJSON(zw, "documents/3.json", &Document{ID: 3, Title: "First"}))
+	// Files aren't read as documents
+	require.NoError(t, writeJSON(zw, "files/1/doc.json", &Document{ID: 1}))
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	manifest, err := readManifest(zr)
+	require.NoError(t, err)
+	assert.Equal(t, 2, manifest.Documents)
+
+	docs, err := readDocuments(zr)
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+	assert.Equal(t, int64(3), docs[0].ID)
+	assert.Equal(t, "First", docs[0].Title)
+	assert.Equal(t, int64(12), docs[1].ID)
+	require.Len(t, docs[1].Comments, 1)
+	assert.Equal(t, "Comment", docs[1].Comments[0].Content)
+}
+
+func TestArchiveManifestErrors(t *testing.T) {
+	t.Parallel()
+
+	newReader := func(manifest *Manifest) *zip.Reader {
+		buf := &bytes.Buffer{}
+		zw := zip.NewWriter(buf)
+		if manifest != nil {
+			require.NoError(t, writeJSON(zw, manifestFileName, manifest))
+		}
+		require.NoError(t, zw.Close())
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		return zr
+	}
+
+	_, err := readManifest(newReader(nil))
+	require.ErrorIs(t, err, ErrNoManifest)
+
+	_, err = readManifest(newReader(&Manifest{Version: FormatVersion + 1}))
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+}
@@ -0,0 +1,429 @@
+package docarchive
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/pkg/storage"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+const exportBatchSize = 100
+
+// Export writes the documents matching the filter, with their comments, references, relations,
+// access, activity and filestore attachments, as a zip archive to w.
+func (a *Archiver) Export(ctx context.Context, w io.Writer, filter *Filter) (*Manifest, error) {
+	if filter == nil {
+		filter = &Filter{}
+	}
+
+	docIds, err := a.listDocumentIDs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents. %w", err)
+	}
+
+	manifest := &Manifest{
+		Version:   FormatVersion,
+		CreatedAt: time.Now().UTC(),
+		Filter:    filter,
+	}
+
+	zw := zip.NewWriter(w)
+	for batch := range slices.Chunk(docIds, exportBatchSize) {
+		docs, err := a.getDocuments(ctx, batch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get documents. %w", err)
+		}
+
+		for _, doc := range docs {
+			files := doc.Files[:0]
+			for _, f := range doc.Files {
+				f.Path = path.Join(
+					filesDir,
+					strconv.FormatInt(f.ID, 10),
+					path.Base(f.FilePath),
+				)
+
+				if err := a.writeFile(ctx, zw, f); err != nil {
+					// Attachments missing from the storage are left out of the archive
+					if errors.Is(err, storage.ErrNotFound) {
+						continue
+					}
+					return nil, fmt.Errorf("failed to archive file %s. %w", f.FilePath, err)
+				}
+				files = append(files, f)
+			}
+			doc.Files = files
+			manifest.Files += len(files)
+
+			name := path.Join(documentsDir, strconv.FormatInt(doc.ID, 10)+".json")
+			if err := writeJSON(zw, name, doc); err != nil {
+				return nil, fmt.Errorf("failed to archive document %d. %w", doc.ID, err)
+			}
+			manifest.Documents++
+		}
+	}
+
+	if err := writeJSON(zw, manifestFileName, manifest); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (a *Archiver) writeFile(ctx context.Context, zw *zip.Writer, f *File) error {
+	obj, _, err := a.st.Get(ctx, f.FilePath)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+
+	w, err := zw.Create(f.Path)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, obj)
+	return err
+}
+
+func (a *Archiver) listDocumentIDs(ctx context.Context, filter *Filter) ([]int64, error) {
+	tDocument := table.FivenetDocuments.AS("document")
+
+	condition := []mysql.BoolExpression{}
+	if !filter.IncludeDeleted {
+		condition = append(condition, tDocument.DeletedAt.IS_NULL())
+	}
+	if filter.Job != "" {
+		condition = append(condition, tDocument.CreatorJob.EQ(mysql.String(filter.Job)))
+	}
+	if len(filter.CategoryIDs) > 0 {
+		ids := make([]mysql.Expression, len(filter.CategoryIDs))
+		for i, id := range filter.CategoryIDs {
+			ids[i] = mysql.Int64(id)
+		}
+		condition = append(condition, tDocument.CategoryID.IN(ids...))
+	}
+	if len(filter.DocumentIDs) > 0 {
+		ids := make([]mysql.Expression, len(filter.DocumentIDs))
+		for i, id := range filter.DocumentIDs {
+			ids[i] = mysql.Int64(id)
+		}
+		condition = append(condition, tDocument.ID.IN(ids...))
+	}
+	if filter.From != nil {
+		condition = append(condition, tDocument.CreatedAt.GT_EQ(mysql.TimestampT(*filter.From)))
+	}
+	if filter.To != nil {
+		condition = append(condition, tDocument.CreatedAt.LT_EQ(mysql.TimestampT(*filter.To)))
+	}
+
+	stmt := tDocument.
+		SELECT(tDocument.ID.AS("id")).
+		FROM(tDocument).
+		ORDER_BY(tDocument.ID.ASC())
+	if len(condition) > 0 {
+		stmt = stmt.WHERE(mysql.AND(condition...))
+	}
+	if filter.Limit > 0 {
+		stmt = stmt.LIMIT(filter.Limit + 1)
+	}
+
+	docIds := []int64{}
+	if err := stmt.QueryContext(ctx, a.db, &docIds); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if filter.Limit > 0 && int64(len(docIds)) > filter.Limit {
+		return nil, ErrLimitExceeded
+	}
+
+	return docIds, nil
+}
+
+func (a *Archiver) getDocuments(ctx context.Context, docIds []int64) ([]*Document, error) {
+	if len(docIds) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]mysql.Expression, len(docIds))
+	for i, id := range docIds {
+		ids[i] = mysql.Int64(id)
+	}
+
+	tDocument := table.FivenetDocuments.AS("document")
+	tCategory := table.FivenetDocumentsCategories.AS("category")
+
+	stmt := tDocument.
+		SELECT(
+			tDocument.ID.AS("document.id"),
+			tDocument.CreatedAt.AS("document.created_at"),
+			tDocument.UpdatedAt.AS("document.updated_at"),
+			tDocument.DeletedAt.AS("document.deleted_at"),
+			tCategory.Name.AS("document.category_name"),
+			tCategory.Job.AS("document.category_job"),
+			tDocument.Title.AS("document.title"),
+			tDocument.Summary.AS("document.summary"),
+			tDocument.WordCount.AS("document.word_count"),
+			tDocument.FirstHeading.AS("document.first_heading"),
+			tDocument.ContentType.AS("document.content_type"),
+			tDocument.ContentJSON.AS("document.content_json"),
+			tDocument.ContentText.AS("document.content_text"),
+			tDocument.Data.AS("document.data"),
+			tDocument.CreatorID.AS("document.creator_id"),
+			tDocument.CreatorJob.AS("document.creator_job"),
+			tDocument.State.AS("document.state"),
+			tDocument.Closed.AS("document.closed"),
+			tDocument.Draft.AS("document.draft"),
+			tDocument.Public.AS("document.public"),
+			tDocument.Locked.AS("document.locked"),
+		).
+		FROM(
+			tDocument.
+				LEFT_JOIN(tCategory,
+					tCategory.ID.EQ(tDocument.CategoryID),
+				),
+		).
+		WHERE(tDocument.ID.IN(ids...)).
+		ORDER_BY(tDocument.ID.ASC())
+
+	docs := []*Document{}
+	if err := stmt.QueryContext(ctx, a.db, &docs); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	byId := make(map[int64]*Document, len(docs))
+	for _, doc := range docs {
+		byId[doc.ID] = doc
+	}
+
+	comments, err := a.getComments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range comments {
+		if doc, ok := byId[c.DocumentID]; ok {
+			doc.Comments = append(doc.Comments, c)
+		}
+	}
+
+	references, err := a.getReferences(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range references {
+		if doc, ok := byId[ref.SourceDocumentID]; ok {
+			doc.References = append(doc.References, ref)
+		}
+	}
+
+	relations, err := a.getRelations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range relations {
+		if doc, ok := byId[rel.DocumentID]; ok {
+			doc.Relations = append(doc.Relations, rel)
+		}
+	}
+
+	activity, err := a.getActivity(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, act := range activity {
+		if doc, ok := byId[act.DocumentID]; ok {
+			doc.Activity = append(doc.Activity, act)
+		}
+	}
+
+	files, err := a.getFiles(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if doc, ok := byId[f.DocumentID]; ok {
+			doc.Files = append(doc.Files, f)
+		}
+	}
+
+	for _, doc := range docs {
+		docAccess, err := a.access.ListTargetAccess(ctx, a.db, doc.ID, subjectAccessOptions)
+		if err != nil {
+			return nil, err
+		}
+		doc.Access = accessFromProto(docAccess)
+	}
+
+	return docs, nil
+}
+
+func (a *Archiver) getComments(ctx context.Context, ids []mysql.Expression) ([]*Comment, error) {
+	tComments := table.FivenetDocumentsComments.AS("comment")
+
+	stmt := tComments.
+		SELECT(
+			tComments.ID.AS("comment.id"),
+			tComments.DocumentID.AS("comment.document_id"),
+			tComments.CreatedAt.AS("comment.created_at"),
+			tComments.UpdatedAt.AS("comment.updated_at"),
+			tComments.DeletedAt.AS("comment.deleted_at"),
+			tComments.Content.AS("comment.content"),
+			tComments.CreatorID.AS("comment.creator_id"),
+			tComments.CreatorJob.AS("comment.creator_job"),
+		).
+		FROM(tComments).
+		WHERE(tComments.DocumentID.IN(ids...)).
+		ORDER_BY(tComments.ID.ASC())
+
+	dest := []*Comment{}
+	if err := stmt.QueryContext(ctx, a.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (a *Archiver) getReferences(
+	ctx context.Context,
+	ids []mysql.Expression,
+) ([]*Reference, error) {
+	tReferences := table.FivenetDocumentsReferences.AS("reference")
+
+	stmt := tReferences.
+		SELECT(
+			tReferences.ID.AS("reference.id"),
+			tReferences.CreatedAt.AS("reference.created_at"),
+			tReferences.SourceDocumentID.AS("reference.source_document_id"),
+			tReferences.Reference.AS("reference.reference"),
+			tReferences.TargetDocumentID.AS("reference.target_document_id"),
+			tReferences.CreatorID.AS("reference.creator_id"),
+		).
+		FROM(tReferences).
+		WHERE(mysql.AND(
+			tReferences.SourceDocumentID.IN(ids...),
+			tReferences.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tReferences.ID.ASC())
+
+	dest := []*Reference{}
+	if err := stmt.QueryContext(ctx, a.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (a *Archiver) getRelations(ctx context.Context, ids []mysql.Expression) ([]*Relation, error) {
+	tRelations := table.FivenetDocumentsRelations.AS("relation")
+
+	stmt := tRelations.
+		SELECT(
+			tRelations.ID.AS("relation.id"),
+			tRelations.DocumentID.AS("relation.document_id"),
+			tRelations.CreatedAt.AS("relation.created_at"),
+			tRelations.SourceUserID.AS("relation.source_user_id"),
+			tRelations.Relation.AS("relation.relation"),
+			tRelations.TargetUserID.AS("relation.target_user_id"),
+		).
+		FROM(tRelations).
+		WHERE(mysql.AND(
+			tRelations.DocumentID.IN(ids...),
+			tRelations.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tRelations.ID.ASC())
+
+	dest := []*Relation{}
+	if err := stmt.QueryContext(ctx, a.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (a *Archiver) getActivity(ctx context.Context, ids []mysql.Expression) ([]*Activity, error) {
+	tActivity := table.FivenetDocumentsActivity.AS("activity")
+
+	stmt := tActivity.
+		SELECT(
+			tActivity.ID.AS("activity.id"),
+			tActivity.DocumentID.AS("activity.document_id"),
+			tActivity.CreatedAt.AS("activity.created_at"),
+			tActivity.ActivityType.AS("activity.activity_type"),
+			tActivity.CreatorID.AS("activity.creator_id"),
+			tActivity.CreatorJob.AS("activity.creator_job"),
+			tActivity.Reason.AS("activity.reason"),
+			tActivity.Data.AS("activity.data"),
+		).
+		FROM(tActivity).
+		WHERE(tActivity.DocumentID.IN(ids...)).
+		ORDER_BY(tActivity.ID.ASC())
+
+	dest := []*Activity{}
+	if err := stmt.QueryContext(ctx, a.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (a *Archiver) getFiles(ctx context.Context, ids []mysql.Expression) ([]*File, error) {
+	tDocFiles := table.FivenetDocumentsFiles.AS("document_file")
+	tFiles := table.FivenetFiles.AS("file")
+
+	stmt := tDocFiles.
+		SELECT(
+			tFiles.ID.AS("file.id"),
+			tDocFiles.DocumentID.AS("file.document_id"),
+			tFiles.FilePath.AS("file.file_path"),
+			tFiles.ByteSize.AS("file.byte_size"),
+			tFiles.ContentType.AS("file.content_type"),
+			tFiles.Meta.AS("file.meta"),
+			tFiles.CreatedAt.AS("file.created_at"),
+		).
+		FROM(
+			tDocFiles.
+				INNER_JOIN(tFiles,
+					tFiles.ID.EQ(tDocFiles.FileID),
+				),
+		).
+		WHERE(mysql.AND(
+			tDocFiles.DocumentID.IN(ids...),
+			tFiles.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tDocFiles.DocumentID.ASC(), tFiles.ID.ASC())
+
+	dest := []*File{}
+	if err := stmt.QueryContext(ctx, a.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
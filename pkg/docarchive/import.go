@@ -0,0 +1,518 @@
+package docarchive
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/pkg/storage"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Import creates the documents of the archive as new documents. User IDs and jobs are remapped
+// according to the options, creators that don't exist on the target instance are removed and
+// relations and access entries of such users are skipped.
+func (a *Archiver) Import(
+	ctx context.Context,
+	r io.ReaderAt,
+	size int64,
+	opts *ImportOptions,
+) (*ImportResult, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := readManifest(zr); err != nil {
+		return nil, err
+	}
+
+	docs, err := readDocuments(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read documents from archive. %w", err)
+	}
+
+	remap := newRemapper(opts)
+	userIds := remap.collectUsers(docs)
+	existing, err := a.getExistingUsers(ctx, userIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check users. %w", err)
+	}
+
+	result := &ImportResult{}
+	for _, userId := range userIds {
+		if slices.Contains(existing, userId) {
+			remap.existingUsers[userId] = true
+		} else {
+			result.SkippedUsers = append(result.SkippedUsers, userId)
+		}
+	}
+	slices.Sort(result.SkippedUsers)
+
+	docIds := make(map[int64]int64, len(docs))
+	for _, doc := range docs {
+		newId, err := a.importDocument(ctx, zr, doc, remap)
+		if err != nil {
+			return result, fmt.Errorf("failed to import document %d. %w", doc.ID, err)
+		}
+		docIds[doc.ID] = newId
+
+		result.Documents++
+		result.Files += len(doc.Files)
+	}
+
+	// References can only be created once all documents have been imported
+	count, err := a.importReferences(ctx, docs, docIds, remap)
+	if err != nil {
+		return result, fmt.Errorf("failed to import document references. %w", err)
+	}
+	result.References = count
+
+	return result, nil
+}
+
+func createdAtOrNow(t *time.Time) time.Time {
+	if t == nil {
+		return time.Now()
+	}
+	return *t
+}
+
+func (a *Archiver) getExistingUsers(ctx context.Context, userIds []int32) ([]int32, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]mysql.Expression, len(userIds))
+	for i, id := range userIds {
+		ids[i] = mysql.Int32(id)
+	}
+
+	tUsers := table.FivenetUser.AS("user")
+
+	stmt := tUsers.
+		SELECT(tUsers.ID.AS("id")).
+		FROM(tUsers).
+		WHERE(tUsers.ID.IN(ids...))
+
+	dest := []int32{}
+	if err := stmt.QueryContext(ctx, a.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (a *Archiver) getCategoryID(ctx context.Context, tx qrm.DB, name, job string) (*int64, error) {
+	tCategory := table.FivenetDocumentsCategories.AS("category")
+
+	stmt := tCategory.
+		SELECT(tCategory.ID.AS("id")).
+		FROM(tCategory).
+		WHERE(mysql.AND(
+			tCategory.Job.EQ(mysql.String(job)),
+			tCategory.Name.EQ(mysql.String(name)),
+			tCategory.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tCategory.ID.ASC()).
+		LIMIT(1)
+
+	dest := []int64{}
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+	if len(dest) == 0 {
+		return nil, nil
+	}
+
+	return &dest[0], nil
+}
+
+func (a *Archiver) importDocument(
+	ctx context.Context,
+	zr *zip.Reader,
+	doc *Document,
+	remap *remapper,
+) (int64, error) {
+	// Upload the attachments first, the storage isn't part of the transaction
+	for _, f := range doc.Files {
+		if err := a.uploadFile(ctx, zr, f); err != nil {
+			return 0, fmt.Errorf("failed to upload file %s. %w", f.FilePath, err)
+		}
+	}
+
+	// The form data references users by ID, these need to be remapped as well
+	data, err := parseDocumentData(doc.Data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse document data. %w", err)
+	}
+	data = remap.documentData(data)
+
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	creatorJob := remap.job(doc.CreatorJob)
+
+	var categoryId *int64
+	if doc.CategoryName != nil {
+		categoryJob := creatorJob
+		if doc.CategoryJob != nil {
+			categoryJob = remap.job(*doc.CategoryJob)
+		}
+
+		categoryId, err = a.getCategoryID(ctx, tx, *doc.CategoryName, categoryJob)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	tDocument := table.FivenetDocuments
+	res, err := tDocument.
+		INSERT(
+			tDocument.CreatedAt,
+			tDocument.UpdatedAt,
+			tDocument.DeletedAt,
+			tDocument.CategoryID,
+			tDocument.Title,
+			tDocument.Summary,
+			tDocument.WordCount,
+			tDocument.FirstHeading,
+			tDocument.ContentType,
+			tDocument.ContentJSON,
+			tDocument.ContentText,
+			tDocument.Data,
+			tDocument.CreatorID,
+			tDocument.CreatorJob,
+			tDocument.State,
+			tDocument.Closed,
+			tDocument.Draft,
+			tDocument.Public,
+			tDocument.Locked,
+		).
+		VALUES(
+			createdAtOrNow(doc.CreatedAt),
+			doc.UpdatedAt,
+			doc.DeletedAt,
+			categoryId,
+			doc.Title,
+			doc.Summary,
+			doc.WordCount,
+			doc.FirstHeading,
+			doc.ContentType,
+			doc.ContentJSON,
+			doc.ContentText,
+			data,
+			remap.optUser(doc.CreatorID),
+			creatorJob,
+			doc.State,
+			doc.Closed,
+			doc.Draft,
+			doc.Public,
+			doc.Locked,
+		).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	docId, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := a.importComments(ctx, tx, docId, doc.Comments, remap); err != nil {
+		return 0, err
+	}
+	if err := a.importRelations(ctx, tx, docId, doc.Relations, remap); err != nil {
+		return 0, err
+	}
+	if err := a.importActivity(ctx, tx, docId, doc.Activity, remap); err != nil {
+		return 0, err
+	}
+	if err := a.importFiles(ctx, tx, docId, doc.Files); err != nil {
+		return 0, err
+	}
+
+	if _, err := a.access.ReplaceTargetAccess(
+		ctx,
+		tx,
+		a.resolver,
+		docId,
+		remap.accessToProto(doc.Access),
+		subjectAccessOptions,
+	); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return docId, nil
+}
+
+func (a *Archiver) importComments(
+	ctx context.Context,
+	tx qrm.DB,
+	docId int64,
+	comments []*Comment,
+	remap *remapper,
+) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	tComments := table.FivenetDocumentsComments
+	stmt := tComments.
+		INSERT(
+			tComments.DocumentID,
+			tComments.CreatedAt,
+			tComments.UpdatedAt,
+			tComments.DeletedAt,
+			tComments.Content,
+			tComments.CreatorID,
+			tComments.CreatorJob,
+		)
+	for _, c := range comments {
+		stmt = stmt.VALUES(
+			docId,
+			createdAtOrNow(c.CreatedAt),
+			c.UpdatedAt,
+			c.DeletedAt,
+			c.Content,
+			remap.optUser(c.CreatorID),
+			remap.job(c.CreatorJob),
+		)
+	}
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+func (a *Archiver) importRelations(
+	ctx context.Context,
+	tx qrm.DB,
+	docId int64,
+	relations []*Relation,
+	remap *remapper,
+) error {
+	tRelations := table.FivenetDocumentsRelations
+	stmt := tRelations.
+		INSERT(
+			tRelations.DocumentID,
+			tRelations.CreatedAt,
+			tRelations.SourceUserID,
+			tRelations.Relation,
+			tRelations.TargetUserID,
+		)
+
+	count := 0
+	for _, rel := range relations {
+		sourceUserId := remap.optUser(&rel.SourceUserID)
+		targetUserId := remap.optUser(&rel.TargetUserID)
+		if sourceUserId == nil || targetUserId == nil {
+			continue
+		}
+
+		stmt = stmt.VALUES(
+			docId,
+			createdAtOrNow(rel.CreatedAt),
+			*sourceUserId,
+			rel.Relation,
+			*targetUserId,
+		)
+		count++
+	}
+	if count == 0 {
+		return nil
+	}
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+func (a *Archiver) importActivity(
+	ctx context.Context,
+	tx qrm.DB,
+	docId int64,
+	activity []*Activity,
+	remap *remapper,
+) error {
+	if len(activity) == 0 {
+		return nil
+	}
+
+	tActivity := table.FivenetDocumentsActivity
+	stmt := tActivity.
+		INSERT(
+			tActivity.DocumentID,
+			tActivity.CreatedAt,
+			tActivity.ActivityType,
+			tActivity.CreatorID,
+			tActivity.CreatorJob,
+			tActivity.Reason,
+			tActivity.Data,
+		)
+	for _, act := range activity {
+		data, err := parseActivityData(act.Data)
+		if err != nil {
+			return fmt.Errorf("failed to parse activity data. %w", err)
+		}
+
+		stmt = stmt.VALUES(
+			docId,
+			createdAtOrNow(act.CreatedAt),
+			act.ActivityType,
+			remap.optUser(act.CreatorID),
+			remap.job(act.CreatorJob),
+			act.Reason,
+			remap.activityData(data),
+		)
+	}
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+func (a *Archiver) uploadFile(ctx context.Context, zr *zip.Reader, f *File) error {
+	// Keep the file path so links in the document content stay intact, files that already exist
+	// (e.g., the archive is imported a second time) are reused
+	if _, err := a.st.Stat(ctx, f.FilePath); err == nil {
+		return nil
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+
+	r, err := zr.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = a.st.Put(ctx, f.FilePath, r, f.ByteSize, f.ContentType)
+	return err
+}
+
+func (a *Archiver) importFiles(ctx context.Context, tx qrm.DB, docId int64, files []*File) error {
+	tFiles := table.FivenetFiles
+	tDocFiles := table.FivenetDocumentsFiles
+
+	for _, f := range files {
+		dest := []int64{}
+		if err := tFiles.
+			SELECT(tFiles.ID.AS("id")).
+			FROM(tFiles).
+			WHERE(tFiles.FilePath.EQ(mysql.String(f.FilePath))).
+			LIMIT(1).
+			QueryContext(ctx, tx, &dest); err != nil {
+			if !errors.Is(err, qrm.ErrNoRows) {
+				return err
+			}
+		}
+
+		var fileId int64
+		if len(dest) > 0 {
+			fileId = dest[0]
+		} else {
+			res, err := tFiles.
+				INSERT(
+					tFiles.FilePath,
+					tFiles.ByteSize,
+					tFiles.ContentType,
+					tFiles.Meta,
+				).
+				VALUES(
+					f.FilePath,
+					f.ByteSize,
+					f.ContentType,
+					f.Meta,
+				).
+				ExecContext(ctx, tx)
+			if err != nil {
+				return err
+			}
+
+			fileId, err = res.LastInsertId()
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := tDocFiles.
+			INSERT(
+				tDocFiles.DocumentID,
+				tDocFiles.FileID,
+			).
+			VALUES(
+				docId,
+				fileId,
+			).
+			ExecContext(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *Archiver) importReferences(
+	ctx context.Context,
+	docs []*Document,
+	docIds map[int64]int64,
+	remap *remapper,
+) (int, error) {
+	tReferences := table.FivenetDocumentsReferences
+	stmt := tReferences.
+		INSERT(
+			tReferences.CreatedAt,
+			tReferences.SourceDocumentID,
+			tReferences.Reference,
+			tReferences.TargetDocumentID,
+			tReferences.CreatorID,
+		)
+
+	count := 0
+	for _, doc := range docs {
+		for _, ref := range doc.References {
+			sourceId, ok := docIds[ref.SourceDocumentID]
+			if !ok {
+				continue
+			}
+			targetId, ok := docIds[ref.TargetDocumentID]
+			if !ok {
+				continue
+			}
+
+			stmt = stmt.VALUES(
+				createdAtOrNow(ref.CreatedAt),
+				sourceId,
+				ref.Reference,
+				targetId,
+				remap.optUser(ref.CreatorID),
+			)
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	if _, err := stmt.ExecContext(ctx, a.db); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
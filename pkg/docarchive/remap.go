@@ -0,0 +1,314 @@
+package docarchive
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
+	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	documentsdata "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
+	usershort "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+)
+
+// ParseUserMappings parses `old=new` user ID pairs.
+func ParseUserMappings(in []string) (map[int32]int32, error) {
+	out := make(map[int32]int32, len(in))
+	for _, m := range in {
+		from, to, err := splitMapping(m)
+		if err != nil {
+			return nil, err
+		}
+
+		fromID, err := strconv.ParseInt(from, 10, 32)
+		if err != nil || fromID <= 0 {
+			return nil, fmt.Errorf("invalid user id %q in mapping %q", from, m)
+		}
+		toID, err := strconv.ParseInt(to, 10, 32)
+		if err != nil || toID <= 0 {
+			return nil, fmt.Errorf("invalid user id %q in mapping %q", to, m)
+		}
+
+		out[int32(fromID)] = int32(toID)
+	}
+
+	return out, nil
+}
+
+// ParseJobMappings parses `old=new` job name pairs.
+func ParseJobMappings(in []string) (map[string]string, error) {
+	out := make(map[string]string, len(in))
+	for _, m := range in {
+		from, to, err := splitMapping(m)
+		if err != nil {
+			return nil, err
+		}
+
+		out[from] = to
+	}
+
+	return out, nil
+}
+
+func splitMapping(m string) (string, string, error) {
+	from, to, ok := strings.Cut(m, "=")
+	from = strings.TrimSpace(from)
+	to = strings.TrimSpace(to)
+	if !ok || from == "" || to == "" {
+		return "", "", fmt.Errorf("invalid mapping %q, expected format old=new", m)
+	}
+
+	return from, to, nil
+}
+
+type remapper struct {
+	users map[int32]int32
+	jobs  map[string]string
+
+	// existingUsers contains the remapped user IDs that exist on the target instance
+	existingUsers map[int32]bool
+}
+
+func newRemapper(opts *ImportOptions) *remapper {
+	r := &remapper{
+		users: map[int32]int32{},
+		jobs:  map[string]string{},
+
+		existingUsers: map[int32]bool{},
+	}
+	if opts != nil {
+		if opts.Users != nil {
+			r.users = opts.Users
+		}
+		if opts.Jobs != nil {
+			r.jobs = opts.Jobs
+		}
+	}
+
+	return r
+}
+
+func (r *remapper) user(userId int32) int32 {
+	if to, ok := r.users[userId]; ok {
+		return to
+	}
+	return userId
+}
+
+// optUser remaps the user ID and returns nil when the user doesn't exist on the target instance.
+func (r *remapper) optUser(userId *int32) *int32 {
+	if userId == nil {
+		return nil
+	}
+
+	to := r.user(*userId)
+	if !r.existingUsers[to] {
+		return nil
+	}
+	return &to
+}
+
+func (r *remapper) job(job string) string {
+	if to, ok := r.jobs[job]; ok {
+		return to
+	}
+	return job
+}
+
+// collectUsers returns the remapped IDs of all users referenced by the documents.
+func (r *remapper) collectUsers(docs []*Document) []int32 {
+	seen := map[int32]bool{}
+	userIds := []int32{}
+	add := func(userId *int32) {
+		if userId == nil {
+			return
+		}
+		to := r.user(*userId)
+		if seen[to] {
+			return
+		}
+		seen[to] = true
+		userIds = append(userIds, to)
+	}
+
+	for _, doc := range docs {
+		add(doc.CreatorID)
+		for _, c := range doc.Comments {
+			add(c.CreatorID)
+		}
+		for _, ref := range doc.References {
+			add(ref.CreatorID)
+		}
+		for _, rel := range doc.Relations {
+			add(&rel.SourceUserID)
+			add(&rel.TargetUserID)
+		}
+		for _, a := range doc.Activity {
+			add(a.CreatorID)
+			// Data that can't be parsed fails the document's import later on
+			if data, err := parseActivityData(a.Data); err == nil {
+				for _, userId := range activityDataUsers(data) {
+					add(&userId)
+				}
+			}
+		}
+		if data, err := parseDocumentData(doc.Data); err == nil {
+			for _, userId := range documentDataUsers(data) {
+				add(&userId)
+			}
+		}
+		if doc.Access != nil {
+			for _, ua := range doc.Access.Users {
+				add(&ua.UserID)
+			}
+		}
+	}
+
+	return userIds
+}
+
+// parseDocumentData parses the document's form data JSON, nil when the document has no data.
+func parseDocumentData(raw *string) (*documentsdata.DocumentData, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	data := &documentsdata.DocumentData{}
+	if err := protoutils.UnmarshalPartialJSON([]byte(*raw), data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// parseActivityData parses the activity's data JSON, nil when the activity has no data.
+func parseActivityData(raw *string) (*documentsactivity.DocActivityData, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	data := &documentsactivity.DocActivityData{}
+	if err := protoutils.UnmarshalPartialJSON([]byte(*raw), data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// documentDataUsers returns the user IDs of the form data's user field values.
+func documentDataUsers(data *documentsdata.DocumentData) []int32 {
+	userIds := []int32{}
+	for _, value := range data.GetFields() {
+		if _, ok := value.GetValue().(*documentsforms.FormFieldValue_UserId); ok {
+			userIds = append(userIds, value.GetUserId())
+		}
+	}
+	return userIds
+}
+
+// activityDataUsers returns the user IDs referenced by the activity's data.
+func activityDataUsers(data *documentsactivity.DocActivityData) []int32 {
+	userIds := []int32{}
+	if ownerChanged := data.GetOwnerChanged(); ownerChanged != nil && ownerChanged.GetNewOwnerId() > 0 {
+		userIds = append(userIds, ownerChanged.GetNewOwnerId())
+	}
+	if users := data.GetAccessUpdated().GetUsers(); users != nil {
+		for _, ua := range slices.Concat(users.GetToCreate(), users.GetToUpdate(), users.GetToDelete()) {
+			userIds = append(userIds, ua.GetUserId())
+		}
+	}
+	for _, approver := range data.GetSigningRequested().GetApprovers() {
+		userIds = append(userIds, approver.GetUserId())
+	}
+	return userIds
+}
+
+// documentData remaps the user IDs of the form data's user field values, values of users that
+// don't exist on the target instance are removed.
+func (r *remapper) documentData(data *documentsdata.DocumentData) *documentsdata.DocumentData {
+	for key, value := range data.GetFields() {
+		v, ok := value.GetValue().(*documentsforms.FormFieldValue_UserId)
+		if !ok {
+			continue
+		}
+
+		userId := r.optUser(&v.UserId)
+		if userId == nil {
+			delete(data.Fields, key)
+			continue
+		}
+		v.UserId = *userId
+	}
+
+	return data
+}
+
+// activityData remaps the user IDs and jobs referenced by the activity's data, users that don't
+// exist on the target instance are removed.
+func (r *remapper) activityData(
+	data *documentsactivity.DocActivityData,
+) *documentsactivity.DocActivityData {
+	if ownerChanged := data.GetOwnerChanged(); ownerChanged != nil {
+		ownerChanged.NewOwner = r.userShort(ownerChanged.GetNewOwner())
+		if userId := r.optUser(&ownerChanged.NewOwnerId); userId != nil {
+			ownerChanged.NewOwnerId = *userId
+		} else {
+			ownerChanged.NewOwnerId = 0
+		}
+	}
+
+	if accessUpdated := data.GetAccessUpdated(); accessUpdated != nil {
+		if jobs := accessUpdated.GetJobs(); jobs != nil {
+			for _, ja := range slices.Concat(jobs.GetToCreate(), jobs.GetToUpdate(), jobs.GetToDelete()) {
+				ja.Job = r.job(ja.GetJob())
+			}
+		}
+		if users := accessUpdated.GetUsers(); users != nil {
+			users.ToCreate = r.userAccess(users.GetToCreate())
+			users.ToUpdate = r.userAccess(users.GetToUpdate())
+			users.ToDelete = r.userAccess(users.GetToDelete())
+		}
+	}
+
+	if signingRequested := data.GetSigningRequested(); signingRequested != nil {
+		approvers := []*usershort.UserShort{}
+		for _, approver := range signingRequested.GetApprovers() {
+			if approver = r.userShort(approver); approver != nil {
+				approvers = append(approvers, approver)
+			}
+		}
+		signingRequested.Approvers = approvers
+	}
+
+	return data
+}
+
+func (r *remapper) userShort(user *usershort.UserShort) *usershort.UserShort {
+	if user == nil {
+		return nil
+	}
+
+	userId := r.optUser(&user.UserId)
+	if userId == nil {
+		return nil
+	}
+	user.UserId = *userId
+	user.Job = r.job(user.GetJob())
+	return user
+}
+
+func (r *remapper) userAccess(in []*resourcesaccess.UserAccess) []*resourcesaccess.UserAccess {
+	out := []*resourcesaccess.UserAccess{}
+	for _, ua := range in {
+		userId := r.optUser(&ua.UserId)
+		if userId == nil {
+			continue
+		}
+
+		ua.UserId = *userId
+		ua.User = r.userShort(ua.GetUser())
+		out = append(out, ua)
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+package docarchive
+
+import (
+	"testing"
+
+	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
+	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	documentsdata "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
+	usershort "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMappings(t *testing.T) {
+	t.Parallel()
+
+	users, err := ParseUserMappings([]string{"1=10", " 2 = 20 "})
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]int32{1: 10, 2: 20}, users)
+
+	for _, in := range []string{"1", "=10", "a=10", "1=b", "0=10", "1=-1"} {
+		_, err := ParseUserMappings([]string{in})
+		require.Error(t, err, in)
+	}
+
+	jobs, err := ParseJobMappings([]string{"police=lspd", "ambulance=ems"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"police": "lspd", "ambulance": "ems"}, jobs)
+
+	_, err = ParseJobMappings([]string{"police="})
+	require.Error(t, err)
+}
+
+func TestRemapper(t *testing.T) {
+	t.Parallel()
+
+	remap := newRemapper(&ImportOptions{
+		Users: map[int32]int32{1: 10},
+		Jobs:  map[string]string{"police": "lspd"},
+	})
+
+	creatorId := int32(1)
+	docs := []*Document{
+		{
+			CreatorID:  &creatorId,
+			CreatorJob: "police",
+			Relations: []*Relation{
+				{SourceUserID: 1, TargetUserID: 2},
+			},
+			Access: &Access{
+				Jobs: []*JobAccess{
+					{Job: "police", MinimumGrade: 2, Access: 4},
+					{Job: "ambulance", Access: 2},
+				},
+				Users: []*UserAccess{
+					{UserID: 1, Access: 6},
+					{UserID: 3, Access: 2},
+				},
+			},
+		},
+	}
+
+	assert.ElementsMatch(t, []int32{10, 2, 3}, remap.collectUsers(docs))
+
+	// Only user 10 exists on the target instance
+	remap.existingUsers[10] = true
+
+	assert.Equal(t, int32(10), *remap.optUser(&creatorId))
+	assert.Nil(t, remap.optUser(new(int32(2))))
+	assert.Nil(t, remap.optUser(nil))
+	assert.Equal(t, "lspd", remap.job("police"))
+	assert.Equal(t, "ambulance", remap.job("ambulance"))
+
+	access := remap.accessToProto(docs[0].Access)
+	require.Len(t, access.GetJobs(), 2)
+	assert.Equal(t, "lspd", access.GetJobs()[0].GetJob())
+	assert.Equal(t, int32(2), access.GetJobs()[0].GetMinimumGrade())
+	assert.Equal(t, "ambulance", access.GetJobs()[1].GetJob())
+	require.Len(t, access.GetUsers(), 1)
+	assert.Equal(t, int32(10), access.GetUsers()[0].GetUserId())
+	assert.Equal(t, int32(6), access.GetUsers()[0].GetAccess())
+
+	assert.Empty(t, remap.accessToProto(nil).GetJobs())
+}
+
+func TestRemapperData(t *testing.T) {
+	t.Parallel()
+
+	remap := newRemapper(&ImportOptions{
+		Users: map[int32]int32{1: 10},
+		Jobs:  map[string]string{"police": "lspd"},
+	})
+
+	data, err := protoutils.MarshalToJSON(&documentsdata.DocumentData{
+		Fields: map[string]*documentsforms.FormFieldValue{
+			"suspect": {Value: &documentsforms.FormFieldValue_UserId{UserId: 1}},
+			"witness": {Value: &documentsforms.FormFieldValue_UserId{UserId: 2}},
+			"notes":   {Value: &documentsforms.FormFieldValue_Text{Text: "test"}},
+		},
+	})
+	require.NoError(t, err)
+	actData, err := protoutils.MarshalToJSON(&documentsactivity.DocActivityData{
+		Data: &documentsactivity.DocActivityData_AccessUpdated{
+			AccessUpdated: &documentsactivity.DocAccessUpdated{
+				Jobs: &documentsactivity.DocAccessJobsDiff{
+					ToCreate: []*resourcesaccess.JobAccess{{Job: "police"}},
+				},
+				Users: &documentsactivity.DocAccessUsersDiff{
+					ToCreate: []*resourcesaccess.UserAccess{
+						{UserId: 1, User: &usershort.UserShort{UserId: 1, Job: "police"}},
+						{UserId: 3},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	docs := []*Document{
+		{
+			CreatorJob: "police",
+			Data:       new(string(data)),
+			Activity: []*Activity{
+				{Data: new(string(actData))},
+			},
+		},
+	}
+
+	assert.ElementsMatch(t, []int32{10, 2, 3}, remap.collectUsers(docs))
+
+	// Only user 10 exists on the target instance
+	remap.existingUsers[10] = true
+
+	docData, err := parseDocumentData(docs[0].Data)
+	require.NoError(t, err)
+	docData = remap.documentData(docData)
+	require.Len(t, docData.GetFields(), 2)
+	assert.Equal(t, int32(10), docData.GetFields()["suspect"].GetUserId())
+	assert.Equal(t, "test", docData.GetFields()["notes"].GetText())
+
+	activityData, err := parseActivityData(docs[0].Activity[0].Data)
+	require.NoError(t, err)
+	accessUpdated := remap.activityData(activityData).GetAccessUpdated()
+	assert.Equal(t, "lspd", accessUpdated.GetJobs().GetToCreate()[0].GetJob())
+	require.Len(t, accessUpdated.GetUsers().GetToCreate(), 1)
+	assert.Equal(t, int32(10), accessUpdated.GetUsers().GetToCreate()[0].GetUserId())
+	assert.Equal(t, int32(10), accessUpdated.GetUsers().GetToCreate()[0].GetUser().GetUserId())
+	assert.Equal(t, "lspd", accessUpdated.GetUsers().GetToCreate()[0].GetUser().GetJob())
+
+	emptyData, err := parseDocumentData(nil)
+	require.NoError(t, err)
+	assert.Nil(t, remap.documentData(emptyData))
+}
@@ -0,0 +1,170 @@
+package docarchive
+
+import (
+	"errors"
+	"time"
+)
+
+// FormatVersion is the version of the archive format written by the exporter.
+const FormatVersion = 1
+
+const (
+	manifestFileName = "manifest.json"
+	documentsDir     = "documents"
+	filesDir         = "files"
+)
+
+var (
+	// ErrLimitExceeded is returned when more documents match the export filter than allowed.
+	ErrLimitExceeded = errors.New("more documents match the filter than the export limit allows")
+	// ErrNoManifest is returned when an archive doesn't contain a manifest.
+	ErrNoManifest = errors.New("archive has no manifest")
+	// ErrUnsupportedVersion is returned when an archive has been written in an unknown format.
+	ErrUnsupportedVersion = errors.New("unsupported archive format version")
+)
+
+// Manifest describes the contents of an archive.
+type Manifest struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	Filter    *Filter   `json:"filter,omitempty"`
+	Documents int       `json:"documents"`
+	Files     int       `json:"files"`
+}
+
+// Filter selects the documents to export.
+type Filter struct {
+	Job            string     `json:"job,omitempty"`
+	CategoryIDs    []int64    `json:"category_ids,omitempty"`
+	DocumentIDs    []int64    `json:"document_ids,omitempty"`
+	From           *time.Time `json:"from,omitempty"`
+	To             *time.Time `json:"to,omitempty"`
+	IncludeDeleted bool       `json:"include_deleted,omitempty"`
+
+	// Limit is the max. amount of documents to export, zero means no limit.
+	Limit int64 `json:"-"`
+}
+
+// Document is an archived document with everything attached to it. User and job references are
+// kept as-is and are remapped on import, qualification access entries are not archived as
+// qualifications are specific to an instance.
+type Document struct {
+	ID           int64      `alias:"id"            json:"id"`
+	CreatedAt    *time.Time `alias:"created_at"    json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `alias:"updated_at"    json:"updated_at,omitempty"`
+	DeletedAt    *time.Time `alias:"deleted_at"    json:"deleted_at,omitempty"`
+	CategoryName *string    `alias:"category_name" json:"category_name,omitempty"`
+	CategoryJob  *string    `alias:"category_job"  json:"category_job,omitempty"`
+	Title        string     `alias:"title"         json:"title"`
+	Summary      string     `alias:"summary"       json:"summary"`
+	WordCount    int32      `alias:"word_count"    json:"word_count"`
+	FirstHeading string     `alias:"first_heading" json:"first_heading"`
+	ContentType  int32      `alias:"content_type"  json:"content_type"`
+	ContentJSON  string     `alias:"content_json"  json:"content_json"`
+	ContentText  string     `alias:"content_text"  json:"content_text"`
+	Data         *string    `alias:"data"          json:"data,omitempty"`
+	CreatorID    *int32     `alias:"creator_id"    json:"creator_id,omitempty"`
+	CreatorJob   string     `alias:"creator_job"   json:"creator_job"`
+	State        string     `alias:"state"         json:"state"`
+	Closed       bool       `alias:"closed"        json:"closed"`
+	Draft        bool       `alias:"draft"         json:"draft"`
+	Public       bool       `alias:"public"        json:"public"`
+	Locked       bool       `alias:"locked"        json:"locked"`
+
+	Comments   []*Comment   `json:"comments,omitempty"`
+	References []*Reference `json:"references,omitempty"`
+	Relations  []*Relation  `json:"relations,omitempty"`
+	Access     *Access      `json:"access,omitempty"`
+	Activity   []*Activity  `json:"activity,omitempty"`
+	Files      []*File      `json:"files,omitempty"`
+}
+
+type Comment struct {
+	ID         int64      `alias:"id"          json:"id"`
+	DocumentID int64      `alias:"document_id" json:"-"`
+	CreatedAt  *time.Time `alias:"created_at"  json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `alias:"updated_at"  json:"updated_at,omitempty"`
+	DeletedAt  *time.Time `alias:"deleted_at"  json:"deleted_at,omitempty"`
+	Content    string     `alias:"content"     json:"content"`
+	CreatorID  *int32     `alias:"creator_id"  json:"creator_id,omitempty"`
+	CreatorJob string     `alias:"creator_job" json:"creator_job"`
+}
+
+// Reference is a reference from the archived document to another document. References to
+// documents that aren't part of the archive are dropped on import.
+type Reference struct {
+	ID               int64      `alias:"id"                 json:"id"`
+	CreatedAt        *time.Time `alias:"created_at"         json:"created_at,omitempty"`
+	SourceDocumentID int64      `alias:"source_document_id" json:"source_document_id"`
+	Reference        int32      `alias:"reference"          json:"reference"`
+	TargetDocumentID int64      `alias:"target_document_id" json:"target_document_id"`
+	CreatorID        *int32     `alias:"creator_id"         json:"creator_id,omitempty"`
+}
+
+type Relation struct {
+	ID           int64      `alias:"id"             json:"id"`
+	DocumentID   int64      `alias:"document_id"    json:"-"`
+	CreatedAt    *time.Time `alias:"created_at"     json:"created_at,omitempty"`
+	SourceUserID int32      `alias:"source_user_id" json:"source_user_id"`
+	Relation     int32      `alias:"relation"       json:"relation"`
+	TargetUserID int32      `alias:"target_user_id" json:"target_user_id"`
+}
+
+type Access struct {
+	Jobs  []*JobAccess  `json:"jobs,omitempty"`
+	Users []*UserAccess `json:"users,omitempty"`
+}
+
+type JobAccess struct {
+	Job          string `json:"job"`
+	MinimumGrade int32  `json:"minimum_grade"`
+	Access       int32  `json:"access"`
+}
+
+type UserAccess struct {
+	UserID int32 `json:"user_id"`
+	Access int32 `json:"access"`
+}
+
+// Activity is an activity entry of the archived document. The activity data is archived as-is.
+type Activity struct {
+	ID           int64      `alias:"id"            json:"id"`
+	DocumentID   int64      `alias:"document_id"   json:"-"`
+	CreatedAt    *time.Time `alias:"created_at"    json:"created_at,omitempty"`
+	ActivityType int32      `alias:"activity_type" json:"activity_type"`
+	CreatorID    *int32     `alias:"creator_id"    json:"creator_id,omitempty"`
+	CreatorJob   string     `alias:"creator_job"   json:"creator_job"`
+	Reason       *string    `alias:"reason"        json:"reason,omitempty"`
+	Data         *string    `alias:"data"          json:"data,omitempty"`
+}
+
+// File is a filestore attachment of the archived document, its contents are stored at Path in
+// the archive.
+type File struct {
+	ID          int64      `alias:"id"           json:"id"`
+	DocumentID  int64      `alias:"document_id"  json:"-"`
+	FilePath    string     `alias:"file_path"    json:"file_path"`
+	ByteSize    int64      `alias:"byte_size"    json:"byte_size"`
+	ContentType string     `alias:"content_type" json:"content_type"`
+	Meta        *string    `alias:"meta"         json:"meta,omitempty"`
+	CreatedAt   *time.Time `alias:"created_at"   json:"created_at,omitempty"`
+
+	Path string `json:"path"`
+}
+
+// ImportOptions control how an archive is imported.
+type ImportOptions struct {
+	// Users maps user IDs of the source instance to user IDs of the target instance.
+	Users map[int32]int32
+	// Jobs maps job names of the source instance to job names of the target instance.
+	Jobs map[string]string
+}
+
+// ImportResult summarizes an import.
+type ImportResult struct {
+	Documents  int
+	Files      int
+	References int
+	// SkippedUsers are the (remapped) user IDs that don't exist on the target instance.
+	SkippedUsers []int32
+}
@@ -0,0 +1,324 @@
+// Package pdf renders documents (tiptap JSON content, stamps and signatures) to paginated PDFs.
+//
+// Only the PDF core fonts are used, so rendering doesn't depend on any font files.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// Page margin in mm
+	pageMargin = 20.0
+	// Line height of the base font size in mm
+	lineHeight = 5.0
+
+	fontFamily     = "Helvetica"
+	codeFontFamily = "Courier"
+	baseFontSize   = 10.0
+	smallFontSize  = 8.0
+	titleFontSize  = 18.0
+
+	// Indentation of lists and blockquotes in mm
+	listIndent  = 6.0
+	quoteIndent = 6.0
+
+	// Size of the signature and stamp drawings in mm
+	drawingWidth  = 55.0
+	drawingHeight = 25.0
+
+	dateFormat = "2006-01-02 15:04"
+)
+
+// Document contains the data of a document that is rendered.
+type Document struct {
+	Title      string
+	Category   string
+	Creator    string
+	CreatorJob string
+	State      string
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+
+	// Tiptap JSON content, Text is rendered instead if unset
+	Content *structpb.Struct
+	Text    string
+
+	Signatures []*Signature
+}
+
+// Signature of a (completed) approval, with the drawn signature and/or the used stamp.
+type Signature struct {
+	Name     string
+	Job      string
+	SignedAt time.Time
+	Comment  string
+
+	// Drawn signature (SVG paths)
+	SVG string
+
+	StampName string
+	StampSVG  string
+}
+
+// Labels used for the document information, signatures section and the page footer.
+type Labels struct {
+	Category   string
+	Creator    string
+	State      string
+	CreatedAt  string
+	UpdatedAt  string
+	Signatures string
+	// Format with the current page and the total page count
+	Page string
+}
+
+var DefaultLabels = Labels{
+	Category:   "Category",
+	Creator:    "Creator",
+	State:      "State",
+	CreatedAt:  "Created at",
+	UpdatedAt:  "Updated at",
+	Signatures: "Signatures",
+	Page:       "Page %d of %s",
+}
+
+// Render renders the document as PDF.
+func Render(doc *Document, labels Labels) ([]byte, error) {
+	pdf := render(doc, labels)
+
+	buf := &bytes.Buffer{}
+	if err := pdf.Output(buf); err != nil {
+		return nil, fmt.Errorf("failed to render document pdf. %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+type renderer struct {
+	pdf *fpdf.Fpdf
+	// Translates UTF-8 to the core fonts' encoding (cp1252)
+	tr func(string) string
+
+	labels Labels
+
+	indent    float64
+	listDepth int
+	images    int
+}
+
+func render(doc *Document, labels Labels) *fpdf.Fpdf {
+	pdf := fpdf.New(fpdf.OrientationPortrait, fpdf.UnitMillimeter, fpdf.PageSizeA4, "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetTitle(doc.Title, true)
+	pdf.SetCreator("FiveNet", true)
+	pdf.AliasNbPages("")
+
+	r := &renderer{
+		pdf:    pdf,
+		tr:     pdf.UnicodeTranslatorFromDescriptor(""),
+		labels: labels,
+	}
+
+	pdf.SetFooterFunc(r.footer)
+	pdf.AddPage()
+
+	r.header(doc)
+
+	if doc.Content != nil {
+		r.renderBlocks(nodeChildren(doc.Content.AsMap()))
+	} else {
+		r.renderText(doc.Text)
+	}
+
+	r.signatures(doc.Signatures)
+
+	return pdf
+}
+
+func (r *renderer) footer() {
+	r.pdf.SetY(-pageMargin + 5)
+	r.pdf.SetFont(fontFamily, "I", smallFontSize)
+	r.pdf.SetTextColor(128, 128, 128)
+	r.pdf.CellFormat(0, lineHeight,
+		r.tr(fmt.Sprintf(r.labels.Page, r.pdf.PageNo(), "{nb}")),
+		"", 0, "C", false, 0, "")
+	r.pdf.SetTextColor(0, 0, 0)
+}
+
+// header renders the title and information of the document.
+func (r *renderer) header(doc *Document) {
+	r.pdf.SetFont(fontFamily, "B", titleFontSize)
+	r.pdf.MultiCell(0, 8, r.tr(doc.Title), "", "L", false)
+	r.pdf.Ln(2)
+
+	info := [][2]string{
+		{r.labels.Category, doc.Category},
+		{r.labels.Creator, joinNonEmpty(", ", doc.Creator, doc.CreatorJob)},
+		{r.labels.State, doc.State},
+		{r.labels.CreatedAt, doc.CreatedAt.Format(dateFormat)},
+	}
+	if doc.UpdatedAt != nil {
+		info = append(info, [2]string{r.labels.UpdatedAt, doc.UpdatedAt.Format(dateFormat)})
+	}
+
+	for _, field := range info {
+		if field[1] == "" {
+			continue
+		}
+
+		r.pdf.SetFont(fontFamily, "B", baseFontSize)
+		r.pdf.CellFormat(30, lineHeight, r.tr(field[0]), "", 0, "L", false, 0, "")
+		r.pdf.SetFont(fontFamily, "", baseFontSize)
+		r.pdf.MultiCell(0, lineHeight, r.tr(field[1]), "", "L", false)
+	}
+
+	r.pdf.Ln(2)
+	r.horizontalLine()
+	r.pdf.Ln(4)
+}
+
+// renderText renders plain text, one paragraph per line.
+func (r *renderer) renderText(text string) {
+	r.pdf.SetFont(fontFamily, "", baseFontSize)
+	for line := range strings.SplitSeq(text, "\n") {
+		r.pdf.MultiCell(0, lineHeight, r.tr(line), "", "L", false)
+	}
+}
+
+// signatures renders the signatures (and stamps) section.
+func (r *renderer) signatures(signatures []*Signature) {
+	if len(signatures) == 0 {
+		return
+	}
+
+	r.pdf.Ln(lineHeight)
+	r.ensureSpace(2*lineHeight + drawingHeight)
+	r.pdf.SetFont(fontFamily, "B", 13)
+	r.pdf.CellFormat(0, 7, r.tr(r.labels.Signatures), "", 1, "L", false, 0, "")
+	r.horizontalLine()
+	r.pdf.Ln(3)
+
+	for _, sig := range signatures {
+		r.signature(sig)
+	}
+}
+
+func (r *renderer) signature(sig *Signature) {
+	r.ensureSpace(drawingHeight + 6)
+
+	left, _, right, _ := r.pdf.GetMargins()
+	pageWidth, _ := r.pdf.GetPageSize()
+	x := left
+	y := r.pdf.GetY()
+
+	// Drawn signature, the stamp is used if there is no (drawable) signature
+	if !r.drawSVG(sig.SVG, x, y) && !r.drawSVG(sig.StampSVG, x, y) {
+		r.pdf.SetDrawColor(160, 160, 160)
+		r.pdf.Rect(x, y, drawingWidth, drawingHeight, "D")
+		r.pdf.SetDrawColor(0, 0, 0)
+
+		label := sig.StampName
+		if label == "" {
+			label = sig.Name
+		}
+		r.pdf.SetFont(fontFamily, "I", baseFontSize)
+		r.pdf.SetXY(x, y)
+		r.pdf.CellFormat(drawingWidth, drawingHeight, r.tr(label), "", 0, "C", false, 0, "")
+	}
+
+	textX := x + drawingWidth + 5
+	textWidth := pageWidth - right - textX
+	r.pdf.SetLeftMargin(textX)
+	r.pdf.SetXY(textX, y)
+
+	r.pdf.SetFont(fontFamily, "B", baseFontSize)
+	r.pdf.MultiCell(textWidth, lineHeight, r.tr(sig.Name), "", "L", false)
+	r.pdf.SetFont(fontFamily, "", baseFontSize)
+	if sig.Job != "" {
+		r.pdf.MultiCell(textWidth, lineHeight, r.tr(sig.Job), "", "L", false)
+	}
+	if !sig.SignedAt.IsZero() {
+		r.pdf.MultiCell(textWidth, lineHeight, sig.SignedAt.Format(dateFormat), "", "L", false)
+	}
+	if sig.StampName != "" && sig.SVG != "" {
+		r.pdf.MultiCell(textWidth, lineHeight, r.tr(sig.StampName), "", "L", false)
+	}
+	if sig.Comment != "" {
+		r.pdf.SetFont(fontFamily, "I", baseFontSize)
+		r.pdf.MultiCell(textWidth, lineHeight, r.tr(sig.Comment), "", "L", false)
+	}
+
+	r.pdf.SetLeftMargin(left)
+	r.pdf.SetXY(left, max(r.pdf.GetY(), y+drawingHeight)+4)
+}
+
+// drawSVG draws the SVG paths scaled into the drawing area, returns false if the SVG has no
+// drawable paths.
+func (r *renderer) drawSVG(svg string, x float64, y float64) bool {
+	if svg == "" {
+		return false
+	}
+
+	sb, err := fpdf.SVGBasicParse([]byte(svg))
+	// The SVG can't be scaled without its dimensions
+	if err != nil || len(sb.Segments) == 0 || sb.Wd <= 0 || sb.Ht <= 0 {
+		return false
+	}
+	// Don't clear errors that occurred before the drawing
+	if !r.pdf.Ok() {
+		return false
+	}
+
+	scale := min(drawingWidth/sb.Wd, drawingHeight/sb.Ht)
+	r.pdf.SetLineWidth(0.3)
+	r.pdf.SetXY(x, y)
+	r.pdf.SVGBasicWrite(&sb, scale)
+	r.pdf.SetLineWidth(0.2)
+	if !r.pdf.Ok() {
+		// Don't let a broken signature fail the whole document
+		r.pdf.ClearError()
+		return false
+	}
+
+	return true
+}
+
+// ensureSpace adds a page break if there isn't enough space left on the current page.
+func (r *renderer) ensureSpace(height float64) {
+	_, pageHeight := r.pdf.GetPageSize()
+	_, _, _, bottom := r.pdf.GetMargins()
+	if r.pdf.GetY()+height > pageHeight-bottom {
+		r.pdf.AddPage()
+	}
+}
+
+func (r *renderer) horizontalLine() {
+	left, _, right, _ := r.pdf.GetMargins()
+	pageWidth, _ := r.pdf.GetPageSize()
+	y := r.pdf.GetY()
+	r.pdf.Line(left, y, pageWidth-right, y)
+}
+
+func (r *renderer) setIndent(indent float64) {
+	r.indent = indent
+	r.pdf.SetLeftMargin(pageMargin + indent)
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+
+	return strings.Join(out, sep)
+}
@@ -0,0 +1,184 @@
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testSignatureSVG = `<svg xmlns="http://www.w3.org/2000/svg" width="300" height="100">` +
+	`<path d="M 10 50 C 40 10, 60 90, 90 50 L 150 60 L 280 20"/></svg>`
+
+func testContent(t *testing.T, paragraphs int) *structpb.Struct {
+	t.Helper()
+
+	content := []any{
+		map[string]any{
+			"type":    "heading",
+			"attrs":   map[string]any{"level": 1},
+			"content": []any{map[string]any{"type": "text", "text": "Überschrift"}},
+		},
+		map[string]any{
+			"type": "bulletList",
+			"content": []any{
+				map[string]any{
+					"type": "listItem",
+					"content": []any{map[string]any{
+						"type": "paragraph",
+						"content": []any{
+							map[string]any{
+								"type":  "text",
+								"text":  "Bold",
+								"marks": []any{map[string]any{"type": "bold"}},
+							},
+							map[string]any{
+								"type":  "mention",
+								"attrs": map[string]any{"id": "1", "label": "John Doe"},
+							},
+						},
+					}},
+				},
+			},
+		},
+		map[string]any{
+			"type": "table",
+			"content": []any{
+				map[string]any{"type": "tableRow", "content": []any{
+					map[string]any{"type": "tableHeader", "content": []any{
+						map[string]any{"type": "paragraph", "content": []any{
+							map[string]any{"type": "text", "text": "Name"},
+						}},
+					}},
+					map[string]any{"type": "tableHeader", "content": []any{
+						map[string]any{"type": "paragraph", "content": []any{
+							map[string]any{"type": "text", "text": "Value"},
+						}},
+					}},
+				}},
+			},
+		},
+		map[string]any{
+			"type":  "image",
+			"attrs": map[string]any{"src": "https://example.com/image.png", "alt": "Example"},
+		},
+		map[string]any{"type": "horizontalRule"},
+	}
+
+	for range paragraphs {
+		content = append(content, map[string]any{
+			"type": "paragraph",
+			"content": []any{map[string]any{
+				"type": "text",
+				"text": strings.Repeat("Lorem ipsum dolor sit amet. ", 20),
+			}},
+		})
+	}
+
+	s, err := structpb.NewStruct(map[string]any{
+		"type":    "doc",
+		"content": content,
+	})
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	doc := &Document{
+		Title:      "Test Document",
+		Category:   "Reports",
+		Creator:    "Jane Doe",
+		CreatorJob: "Police",
+		State:      "Open",
+		CreatedAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Content:    testContent(t, 1),
+		Signatures: []*Signature{
+			{
+				Name:     "John Doe",
+				Job:      "Police",
+				SignedAt: time.Date(2026, 1, 3, 3, 4, 5, 0, time.UTC),
+				SVG:      testSignatureSVG,
+			},
+			{
+				Name:      "Max Mustermann",
+				StampName: "Approved",
+				StampSVG:  "<svg><text>Approved</text></svg>",
+			},
+		},
+	}
+
+	out, err := Render(doc, DefaultLabels)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+}
+
+func TestRenderPagination(t *testing.T) {
+	t.Parallel()
+
+	doc := &Document{
+		Title:   "Long Document",
+		Content: testContent(t, 50),
+	}
+
+	pdf := render(doc, DefaultLabels)
+	require.NoError(t, pdf.Error())
+	assert.Greater(t, pdf.PageCount(), 1)
+}
+
+func TestRenderPlainText(t *testing.T) {
+	t.Parallel()
+
+	pdf := render(&Document{
+		Title: "Plain",
+		Text:  "First line\nSecond line",
+	}, DefaultLabels)
+	require.NoError(t, pdf.Error())
+	assert.Equal(t, 1, pdf.PageCount())
+}
+
+func TestDrawSVG(t *testing.T) {
+	t.Parallel()
+
+	pdf := fpdf.New(fpdf.OrientationPortrait, fpdf.UnitMillimeter, fpdf.PageSizeA4, "")
+	pdf.AddPage()
+	r := &renderer{pdf: pdf}
+
+	assert.True(t, r.drawSVG(testSignatureSVG, pageMargin, pageMargin))
+	// Without dimensions the SVG can't be scaled
+	assert.False(t, r.drawSVG(
+		`<svg xmlns="http://www.w3.org/2000/svg"><path d="M 10 50 L 150 60"/></svg>`,
+		pageMargin,
+		pageMargin,
+	))
+	require.NoError(t, pdf.Error())
+
+	// Errors from before the drawing are kept
+	errBefore := errors.New("broken")
+	pdf.SetError(errBefore)
+	assert.False(t, r.drawSVG(testSignatureSVG, pageMargin, pageMargin))
+	require.ErrorIs(t, pdf.Error(), errBefore)
+}
+
+func TestParseDataURL(t *testing.T) {
+	t.Parallel()
+
+	mediaType, data, ok := parseDataURL("data:image/PNG;base64,aGVsbG8=")
+	require.True(t, ok)
+	assert.Equal(t, "image/png", mediaType)
+	assert.Equal(t, []byte("hello"), data)
+
+	_, _, ok = parseDataURL("https://example.com/image.png")
+	assert.False(t, ok)
+
+	_, _, ok = parseDataURL("data:image/png,plain")
+	assert.False(t, ok)
+}
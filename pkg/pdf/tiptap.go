@@ -0,0 +1,470 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tiptapsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/tiptap"
+	"github.com/go-pdf/fpdf"
+)
+
+// Image types that the PDF can embed
+var imageTypes = map[string]string{
+	"image/png":  "PNG",
+	"image/jpeg": "JPG",
+	"image/jpg":  "JPG",
+	"image/gif":  "GIF",
+}
+
+var headingFontSizes = map[int]float64{
+	1: 16,
+	2: 14,
+	3: 12,
+}
+
+type textStyle struct {
+	family string
+	style  string
+	size   float64
+	link   string
+}
+
+func (r *renderer) renderBlocks(nodes []map[string]any) {
+	for _, node := range nodes {
+		r.renderBlock(node)
+	}
+}
+
+func (r *renderer) renderBlock(node map[string]any) {
+	switch nodeType(node) {
+	case tiptapsanitizer.NodeTypeParagraph:
+		r.renderInline(nodeChildren(node), baseFontSize, "")
+		r.blockEnd()
+
+	case tiptapsanitizer.NodeTypeHeading:
+		size, ok := headingFontSizes[attrInt(node, "level")]
+		if !ok {
+			size = 11
+		}
+
+		r.pdf.Ln(2)
+		r.ensureSpace(3 * lineHeight)
+		r.renderInline(nodeChildren(node), size, "B")
+		r.pdf.Ln(lineHeight * size / baseFontSize)
+		r.pdf.Ln(1)
+
+	case tiptapsanitizer.NodeTypeBlockquote:
+		prev := r.indent
+		r.setIndent(prev + quoteIndent)
+		r.pdf.SetX(pageMargin + r.indent)
+		r.pdf.SetTextColor(96, 96, 96)
+		r.renderBlocks(nodeChildren(node))
+		r.pdf.SetTextColor(0, 0, 0)
+		r.setIndent(prev)
+
+	case tiptapsanitizer.NodeTypeBulletList, tiptapsanitizer.NodeTypeOrderedList,
+		tiptapsanitizer.NodeTypeTaskList:
+		r.renderList(node)
+
+	case tiptapsanitizer.NodeTypeCodeBlock:
+		r.pdf.SetFont(codeFontFamily, "", 9)
+		r.pdf.SetFillColor(240, 240, 240)
+		r.pdf.MultiCell(0, 4.5, r.tr(plainText(node)), "", "L", true)
+		r.blockEnd()
+
+	case tiptapsanitizer.NodeTypeHorizontalRule:
+		r.pdf.Ln(2)
+		r.horizontalLine()
+		r.pdf.Ln(3)
+
+	case tiptapsanitizer.NodeTypeTable:
+		r.renderTable(node)
+
+	case tiptapsanitizer.NodeTypeImage:
+		r.renderImage(node)
+
+	case tiptapsanitizer.NodeTypeCheckboxStandalone:
+		r.renderInline([]map[string]any{{
+			"type": tiptapsanitizer.NodeTypeText,
+			"text": checkbox(attrBool(node, "checked")) + " " + attrString(node, "label"),
+		}}, baseFontSize, "")
+		r.blockEnd()
+
+	case tiptapsanitizer.NodeTypeDetailsSummary:
+		r.renderInline(nodeChildren(node), baseFontSize, "B")
+		r.blockEnd()
+
+	case tiptapsanitizer.NodeTypeMapBlock:
+		r.renderPlaceholder(joinNonEmpty(" ",
+			"[Map", attrString(node, "postal")) + "]")
+
+	case tiptapsanitizer.NodeTypePenaltyCalculator:
+		r.renderPlaceholder("[Penalty Calculator]")
+
+	default:
+		// Containers (e.g., doc, details, detailsContent, template blocks) and unknown nodes
+		children := nodeChildren(node)
+		if len(children) > 0 && isInline(children[0]) {
+			r.renderInline(children, baseFontSize, "")
+			r.blockEnd()
+		} else {
+			r.renderBlocks(children)
+		}
+	}
+}
+
+// blockEnd ends the current line and adds the paragraph spacing (except inside lists).
+func (r *renderer) blockEnd() {
+	r.pdf.Ln(lineHeight)
+	if r.listDepth == 0 {
+		r.pdf.Ln(1.5)
+	}
+}
+
+func (r *renderer) renderList(node map[string]any) {
+	r.listDepth++
+	defer func() { r.listDepth-- }()
+
+	start := 1
+	if n := attrInt(node, "start"); n > 0 {
+		start = n
+	}
+
+	for idx, item := range nodeChildren(node) {
+		var marker string
+		switch nodeType(node) {
+		case tiptapsanitizer.NodeTypeOrderedList:
+			marker = strconv.Itoa(start+idx) + "."
+		case tiptapsanitizer.NodeTypeTaskList:
+			marker = checkbox(attrBool(item, "checked"))
+		default:
+			marker = "•"
+		}
+
+		prev := r.indent
+		r.pdf.SetX(pageMargin + prev)
+		r.pdf.SetFont(fontFamily, "", baseFontSize)
+		r.pdf.CellFormat(listIndent, lineHeight, r.tr(marker), "", 0, "L", false, 0, "")
+
+		// The first line of the item continues right after the marker
+		r.setIndent(prev + listIndent)
+		r.renderBlocks(nodeChildren(item))
+		r.setIndent(prev)
+	}
+
+	if r.listDepth == 1 {
+		r.pdf.Ln(1.5)
+	}
+}
+
+func (r *renderer) renderTable(node map[string]any) {
+	rows := nodeChildren(node)
+
+	cols := 0
+	for _, row := range rows {
+		cols = max(cols, len(nodeChildren(row)))
+	}
+	if cols == 0 {
+		return
+	}
+
+	left, _, right, _ := r.pdf.GetMargins()
+	pageWidth, _ := r.pdf.GetPageSize()
+	colWidth := (pageWidth - left - right) / float64(cols)
+
+	for _, row := range rows {
+		cells := nodeChildren(row)
+
+		lines := make([][]string, len(cells))
+		rowLines := 1
+		for idx, cell := range cells {
+			r.setCellFont(cell)
+			lines[idx] = r.pdf.SplitText(r.tr(plainText(cell)), colWidth-2)
+			rowLines = max(rowLines, len(lines[idx]))
+		}
+
+		height := float64(rowLines)*lineHeight + 1
+		r.ensureSpace(height)
+		y := r.pdf.GetY()
+
+		for idx, cell := range cells {
+			x := left + float64(idx)*colWidth
+			r.pdf.Rect(x, y, colWidth, height, "D")
+
+			r.setCellFont(cell)
+			for li, line := range lines[idx] {
+				r.pdf.SetXY(x+1, y+0.5+float64(li)*lineHeight)
+				r.pdf.CellFormat(colWidth-2, lineHeight, line, "", 0, "L", false, 0, "")
+			}
+		}
+
+		r.pdf.SetXY(left, y+height)
+	}
+
+	r.pdf.Ln(3)
+}
+
+func (r *renderer) setCellFont(cell map[string]any) {
+	if nodeType(cell) == tiptapsanitizer.NodeTypeTableHeader {
+		r.pdf.SetFont(fontFamily, "B", baseFontSize)
+	} else {
+		r.pdf.SetFont(fontFamily, "", baseFontSize)
+	}
+}
+
+// renderImage embeds data URL images, other images are rendered as a placeholder as they
+// would need to be fetched.
+func (r *renderer) renderImage(node map[string]any) {
+	src := attrString(node, "src")
+	placeholder := "[" + joinNonEmpty(": ", "Image", attrString(node, "alt")) + "]"
+
+	mediaType, data, ok := parseDataURL(src)
+	imageType, supported := imageTypes[mediaType]
+	if !ok || !supported {
+		r.renderPlaceholder(placeholder)
+		return
+	}
+
+	r.images++
+	name := fmt.Sprintf("image-%d", r.images)
+	opts := fpdf.ImageOptions{ImageType: imageType}
+	info := r.pdf.RegisterImageOptionsReader(name, opts, bytes.NewReader(data))
+	if !r.pdf.Ok() || info == nil {
+		// Don't let a broken image fail the whole document
+		r.pdf.ClearError()
+		r.renderPlaceholder(placeholder)
+		return
+	}
+
+	left, _, right, _ := r.pdf.GetMargins()
+	pageWidth, pageHeight := r.pdf.GetPageSize()
+	width, height := info.Width(), info.Height()
+	if maxWidth := pageWidth - left - right; width > maxWidth {
+		height = height * maxWidth / width
+		width = maxWidth
+	}
+	if maxHeight := pageHeight - 2*pageMargin; height > maxHeight {
+		width = width * maxHeight / height
+		height = maxHeight
+	}
+
+	r.ensureSpace(height)
+	r.pdf.ImageOptions(name, left, r.pdf.GetY(), width, height, true, opts, 0, "")
+	r.pdf.Ln(2)
+}
+
+func (r *renderer) renderPlaceholder(text string) {
+	r.pdf.SetFont(fontFamily, "I", baseFontSize)
+	r.pdf.SetTextColor(128, 128, 128)
+	r.pdf.Write(lineHeight, r.tr(text))
+	r.pdf.SetTextColor(0, 0, 0)
+	r.blockEnd()
+}
+
+// renderInline writes the inline nodes (text with marks, mentions, hard breaks), the text is
+// wrapped at the (indented) left margin.
+func (r *renderer) renderInline(nodes []map[string]any, size float64, baseStyle string) {
+	lh := lineHeight * size / baseFontSize
+
+	for _, node := range nodes {
+		switch nodeType(node) {
+		case tiptapsanitizer.NodeTypeHardBreak:
+			r.pdf.Ln(lh)
+			continue
+
+		case tiptapsanitizer.NodeTypeImage:
+			r.pdf.Ln(lh)
+			r.renderImage(node)
+			continue
+		}
+
+		text := inlineText(node)
+		if text == "" {
+			continue
+		}
+
+		st := markStyle(node, size, baseStyle)
+		r.pdf.SetFont(st.family, st.style, st.size)
+		if st.link != "" {
+			r.pdf.SetTextColor(0, 0, 200)
+			r.pdf.WriteLinkString(lh, r.tr(text), st.link)
+			r.pdf.SetTextColor(0, 0, 0)
+		} else {
+			r.pdf.Write(lh, r.tr(text))
+		}
+	}
+}
+
+func markStyle(node map[string]any, size float64, baseStyle string) textStyle {
+	st := textStyle{
+		family: fontFamily,
+		style:  baseStyle,
+		size:   size,
+	}
+
+	marks, _ := node["marks"].([]any)
+	for _, m := range marks {
+		mark, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		switch nodeType(mark) {
+		case tiptapsanitizer.MarkTypeBold:
+			st.style += "B"
+		case tiptapsanitizer.MarkTypeItalic:
+			st.style += "I"
+		case tiptapsanitizer.MarkTypeUnderline:
+			st.style += "U"
+		case tiptapsanitizer.MarkTypeStrike:
+			st.style += "S"
+		case tiptapsanitizer.MarkTypeCode:
+			st.family = codeFontFamily
+		case tiptapsanitizer.MarkTypeSubscript, tiptapsanitizer.MarkTypeSuperscript:
+			st.size = size * 0.75
+		case tiptapsanitizer.MarkTypeLink:
+			st.link = attrString(mark, "href")
+		}
+	}
+
+	if st.link != "" && !strings.Contains(st.style, "U") {
+		st.style += "U"
+	}
+
+	return st
+}
+
+// inlineText returns the text of an inline node.
+func inlineText(node map[string]any) string {
+	switch nodeType(node) {
+	case tiptapsanitizer.NodeTypeText:
+		text, _ := node["text"].(string)
+		return text
+
+	case tiptapsanitizer.NodeTypeMention:
+		label := attrString(node, "label")
+		if label == "" {
+			label = attrString(node, "id")
+		}
+		return "@" + label
+
+	case tiptapsanitizer.NodeTypeCheckboxStandalone:
+		return checkbox(attrBool(node, "checked")) + " " + attrString(node, "label")
+
+	default:
+		return plainText(node)
+	}
+}
+
+// plainText returns the text of the node and its children, blocks are separated by new lines.
+func plainText(node map[string]any) string {
+	if nodeType(node) == tiptapsanitizer.NodeTypeHardBreak {
+		return "\n"
+	}
+
+	children := nodeChildren(node)
+	if len(children) == 0 {
+		return inlineText(node)
+	}
+
+	parts := make([]string, 0, len(children))
+	sep := ""
+	for _, child := range children {
+		if !isInline(child) {
+			sep = "\n"
+		}
+		parts = append(parts, plainText(child))
+	}
+
+	return strings.Join(parts, sep)
+}
+
+func isInline(node map[string]any) bool {
+	switch nodeType(node) {
+	case tiptapsanitizer.NodeTypeText, tiptapsanitizer.NodeTypeHardBreak,
+		tiptapsanitizer.NodeTypeMention, tiptapsanitizer.NodeTypeTemplateVar:
+		return true
+	}
+
+	return false
+}
+
+func checkbox(checked bool) string {
+	if checked {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+func nodeType(node map[string]any) string {
+	t, _ := node["type"].(string)
+	return t
+}
+
+func nodeChildren(node map[string]any) []map[string]any {
+	content, _ := node["content"].([]any)
+
+	children := make([]map[string]any, 0, len(content))
+	for _, c := range content {
+		if child, ok := c.(map[string]any); ok {
+			children = append(children, child)
+		}
+	}
+
+	return children
+}
+
+func attrString(node map[string]any, key string) string {
+	attrs, _ := node["attrs"].(map[string]any)
+	v, _ := attrs[key].(string)
+	return v
+}
+
+func attrBool(node map[string]any, key string) bool {
+	attrs, _ := node["attrs"].(map[string]any)
+	v, _ := attrs[key].(bool)
+	return v
+}
+
+func attrInt(node map[string]any, key string) int {
+	attrs, _ := node["attrs"].(map[string]any)
+	switch v := attrs[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case string:
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+
+	return 0
+}
+
+// parseDataURL parses base64 data URLs, e.g., `data:image/png;base64,...`.
+func parseDataURL(src string) (string, []byte, bool) {
+	rest, ok := strings.CutPrefix(src, "data:")
+	if !ok {
+		return "", nil, false
+	}
+
+	meta, payload, ok := strings.Cut(rest, ",")
+	if !ok {
+		return "", nil, false
+	}
+
+	mediaType, ok := strings.CutSuffix(meta, ";base64")
+	if !ok {
+		return "", nil, false
+	}
+
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", nil, false
+	}
+
+	return strings.ToLower(mediaType), data, true
+}
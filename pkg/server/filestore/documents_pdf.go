@@ -0,0 +1,102 @@
+package filestore
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DocumentPDFPath is the base path for the document PDF download endpoint.
+const DocumentPDFPath = "/api/documents/pdf"
+
+// documentPDFTimeout is the timeout for rendering a document PDF.
+const documentPDFTimeout = 30 * time.Second
+
+// DocumentPDFRenderer renders a document as PDF for the user (info) in the context.
+type DocumentPDFRenderer interface {
+	RenderDocumentPDF(ctx context.Context, documentId int64) (string, []byte, error)
+}
+
+// DocumentPDFHTTP provides the HTTP download handler for document PDFs via Gin.
+type DocumentPDFHTTP struct {
+	// auth authenticates the requests the same way as gRPC requests.
+	auth *auth.GRPCAuth
+	// renderer renders the documents with the user's access checks.
+	renderer DocumentPDFRenderer
+}
+
+// NewDocumentPDF creates a new DocumentPDFHTTP handler with the given auth and renderer.
+func NewDocumentPDF(a *auth.GRPCAuth, renderer DocumentPDFRenderer) *DocumentPDFHTTP {
+	return &DocumentPDFHTTP{
+		auth:     a,
+		renderer: renderer,
+	}
+}
+
+// RegisterHTTP registers the document PDF download endpoint on the provided Gin engine.
+func (s *DocumentPDFHTTP) RegisterHTTP(e *gin.Engine) {
+	e.GET(DocumentPDFPath+"/:documentId", s.GET)
+}
+
+// documentPDFAuthMD returns the request's tokens as gRPC metadata for the gRPC auth. The account token
+// is read from the session cookie, the user token from the authorization header. Tokens aren't accepted
+// as query parameters, as they would end up in access logs and the browser history.
+func documentPDFAuthMD(c *gin.Context) metadata.MD {
+	md := metadata.MD{}
+	if cookie := c.GetHeader("Cookie"); cookie != "" {
+		md.Set("cookie", cookie)
+	}
+	if authorization := c.GetHeader("Authorization"); authorization != "" {
+		md.Set("authorization", authorization)
+	}
+
+	return md
+}
+
+// GET handles HTTP GET requests for document PDFs, see `documentPDFAuthMD` for the accepted tokens.
+// It returns 401 for unauthenticated requests, 403/404 if the user can't access the document.
+func (s *DocumentPDFHTTP) GET(c *gin.Context) {
+	documentId, err := strconv.ParseInt(c.Param("documentId"), 10, 64)
+	if err != nil || documentId <= 0 {
+		c.AbortWithError(http.StatusBadRequest, errors.New("invalid document id"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c, documentPDFTimeout)
+	defer cancel()
+
+	ctx, err = s.auth.GRPCAuthFunc(metadata.NewIncomingContext(ctx, documentPDFAuthMD(c)), "")
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	fileName, data, err := s.renderer.RenderDocumentPDF(ctx, documentId)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			c.AbortWithStatus(http.StatusForbidden)
+		case codes.NotFound:
+			c.AbortWithStatus(http.StatusNotFound)
+		default:
+			c.Error(err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": fileName,
+	}))
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/pdf", data)
+}
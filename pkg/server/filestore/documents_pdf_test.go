@@ -0,0 +1,40 @@
+package filestore
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentPDFAuthMD(t *testing.T) {
+	t.Parallel()
+
+	newContext := func(target string, headers map[string]string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		for key, value := range headers {
+			c.Request.Header.Set(key, value)
+		}
+		return c
+	}
+
+	md := documentPDFAuthMD(newContext(DocumentPDFPath+"/1", map[string]string{
+		"Cookie":        "fivenet_acc=acc-token",
+		"Authorization": "Bearer user-token",
+	}))
+	assert.Equal(t, []string{"fivenet_acc=acc-token"}, md.Get("cookie"))
+	assert.Equal(t, []string{"Bearer user-token"}, md.Get("authorization"))
+
+	// Tokens in the query are ignored
+	md = documentPDFAuthMD(newContext(DocumentPDFPath+"/1?token=user-token", map[string]string{
+		"Cookie": "fivenet_acc=acc-token",
+	}))
+	assert.Equal(t, []string{"fivenet_acc=acc-token"}, md.Get("cookie"))
+	assert.Empty(t, md.Get("authorization"))
+
+	md = documentPDFAuthMD(newContext(DocumentPDFPath+"/1", nil))
+	assert.Empty(t, md.Get("authorization"))
+}
@@ -21,9 +21,10 @@ import (
 )
 
 const (
-	SourceKindDocumentColumn = "document_column"
-	SourceKindDocumentMetric = "document_metric"
-	SourceKindEmployeeCount  = "employee_count"
+	SourceKindDocumentColumn  = "document_column"
+	SourceKindDocumentMetric  = "document_metric"
+	SourceKindEmployeeCount   = "employee_count"
+	SourceKindCentrumDispatch = "centrum_dispatch"
 )
 
 func init() {
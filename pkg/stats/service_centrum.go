@@ -0,0 +1,477 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumsettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/timeutils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+const CentrumDispatchSourceKey = "fivenet_centrum_dispatches"
+
+// Dispatch rollups are split by dimension (stored in `dimension1`), the unit ID or postal code is stored in `dimension2`.
+const (
+	DispatchDimensionJob    = "job"
+	DispatchDimensionUnit   = "unit"
+	DispatchDimensionPostal = "postal"
+)
+
+// Duration metrics are stored as a sum of seconds (`<metric>_sum`) and a count (`<metric>_count`),
+// so averages can be calculated over any date range.
+const (
+	DispatchMetricCount          = "dispatch_count"
+	DispatchMetricSLABreachCount = "sla_breach_count"
+
+	DispatchMetricTimeToAssign  = "time_to_assign"
+	DispatchMetricTimeToEnRoute = "time_to_en_route"
+	DispatchMetricTimeOnScene   = "time_on_scene"
+	DispatchMetricHandlingTime  = "handling_time"
+
+	DispatchMetricSumSuffix   = "_sum"
+	DispatchMetricCountSuffix = "_count"
+)
+
+const dispatchRollupInsertBatchSize = 500
+
+type dispatchStatsDispatch struct {
+	ID        int64            `alias:"id"`
+	CreatedAt time.Time        `alias:"created_at"`
+	Jobs      *centrum.JobList `alias:"jobs"`
+	Postal    *string          `alias:"postal"`
+}
+
+type dispatchStatsStatus struct {
+	DispatchID int64                            `alias:"dispatch_id"`
+	CreatedAt  time.Time                        `alias:"created_at"`
+	Status     centrumdispatches.StatusDispatch `alias:"status"`
+	UnitID     *int64                           `alias:"unit_id"`
+	UnitJob    *string                          `alias:"unit_job"`
+}
+
+type dispatchStatsBreach struct {
+	DispatchID int64  `alias:"dispatch_id"`
+	Job        string `alias:"job"`
+}
+
+type dispatchRollupKey struct {
+	day       time.Time
+	job       string
+	dimension string
+	key       string
+	metric    string
+}
+
+type dispatchRollups map[dispatchRollupKey]int64
+
+func (r dispatchRollups) add(day time.Time, job, dimension, key, metric string, value int64) {
+	r[dispatchRollupKey{
+		day:       day,
+		job:       job,
+		dimension: dimension,
+		key:       key,
+		metric:    metric,
+	}] += value
+}
+
+func (r dispatchRollups) addDuration(
+	day time.Time,
+	job, dimension, key, metric string,
+	from, to time.Time,
+) {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return
+	}
+
+	r.add(day, job, dimension, key, metric+DispatchMetricSumSuffix, int64(to.Sub(from).Seconds()))
+	r.add(day, job, dimension, key, metric+DispatchMetricCountSuffix, 1)
+}
+
+func (r dispatchRollups) addDispatch(
+	day time.Time,
+	job, dimension, key string,
+	createdAt time.Time,
+	timings dispatchTimings,
+) {
+	r.add(day, job, dimension, key, DispatchMetricCount, 1)
+	r.addDuration(day, job, dimension, key, DispatchMetricTimeToAssign, createdAt, timings.assigned)
+	r.addDuration(day, job, dimension, key, DispatchMetricTimeToEnRoute, timings.assigned, timings.enRoute)
+	r.addDuration(day, job, dimension, key, DispatchMetricTimeOnScene, timings.onScene, timings.completed)
+	r.addDuration(day, job, dimension, key, DispatchMetricHandlingTime, createdAt, timings.completed)
+}
+
+// dispatchTimings holds the first occurrence of the relevant statuses of a dispatch (or of a unit on a dispatch).
+type dispatchTimings struct {
+	assigned  time.Time
+	enRoute   time.Time
+	onScene   time.Time
+	completed time.Time
+}
+
+func newDispatchTimings(statuses []*dispatchStatsStatus) dispatchTimings {
+	t := dispatchTimings{}
+	for _, st := range statuses {
+		var target *time.Time
+		switch st.Status {
+		case centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNIT_ASSIGNED,
+			centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNIT_ACCEPTED:
+			target = &t.assigned
+
+		case centrumdispatches.StatusDispatch_STATUS_DISPATCH_EN_ROUTE:
+			target = &t.enRoute
+
+		case centrumdispatches.StatusDispatch_STATUS_DISPATCH_ON_SCENE:
+			target = &t.onScene
+
+		case centrumdispatches.StatusDispatch_STATUS_DISPATCH_COMPLETED:
+			target = &t.completed
+
+		default:
+			continue
+		}
+
+		if target.IsZero() || st.CreatedAt.Before(*target) {
+			*target = st.CreatedAt
+		}
+	}
+
+	return t
+}
+
+// computeDispatchRollups derives the per job, unit and postal rollups from the dispatches and their status history.
+// Dispatches are counted on the day they have been created on, unit rollups are counted for the unit's job.
+func computeDispatchRollups(
+	dsps []*dispatchStatsDispatch,
+	statuses []*dispatchStatsStatus,
+	breaches []*dispatchStatsBreach,
+) dispatchRollups {
+	rollups := dispatchRollups{}
+
+	statusesByDispatch := map[int64][]*dispatchStatsStatus{}
+	for _, st := range statuses {
+		statusesByDispatch[st.DispatchID] = append(statusesByDispatch[st.DispatchID], st)
+	}
+
+	dispatchesByID := make(map[int64]*dispatchStatsDispatch, len(dsps))
+	for _, dsp := range dsps {
+		dispatchesByID[dsp.ID] = dsp
+
+		day := timeutils.StartOfDay(dsp.CreatedAt.UTC())
+		postal := strings.TrimSpace(utils.Deref(dsp.Postal))
+		timings := newDispatchTimings(statusesByDispatch[dsp.ID])
+
+		for _, job := range dsp.Jobs.GetJobStrings() {
+			rollups.addDispatch(day, job, DispatchDimensionJob, "", dsp.CreatedAt, timings)
+			if postal != "" {
+				rollups.addDispatch(day, job, DispatchDimensionPostal, postal, dsp.CreatedAt, timings)
+			}
+		}
+
+		unitStatuses := map[int64][]*dispatchStatsStatus{}
+		unitJobs := map[int64]string{}
+		unitIDs := []int64{}
+		for _, st := range statusesByDispatch[dsp.ID] {
+			if st.UnitID == nil {
+				continue
+			}
+
+			unitID := *st.UnitID
+			if _, ok := unitStatuses[unitID]; !ok {
+				unitIDs = append(unitIDs, unitID)
+			}
+			unitStatuses[unitID] = append(unitStatuses[unitID], st)
+			if st.UnitJob != nil && *st.UnitJob != "" {
+				unitJobs[unitID] = *st.UnitJob
+			}
+		}
+
+		for _, unitID := range unitIDs {
+			job, ok := unitJobs[unitID]
+			if !ok {
+				// Unit has been deleted, can't attribute it to a job anymore
+				continue
+			}
+
+			unitTimings := newDispatchTimings(unitStatuses[unitID])
+			// Units don't necessarily complete the dispatch themselves
+			if unitTimings.completed.IsZero() {
+				unitTimings.completed = timings.completed
+			}
+
+			rollups.addDispatch(
+				day,
+				job,
+				DispatchDimensionUnit,
+				strconv.FormatInt(unitID, 10),
+				dsp.CreatedAt,
+				unitTimings,
+			)
+		}
+	}
+
+	for _, breach := range breaches {
+		dsp, ok := dispatchesByID[breach.DispatchID]
+		if !ok {
+			continue
+		}
+
+		day := timeutils.StartOfDay(dsp.CreatedAt.UTC())
+		rollups.add(day, breach.Job, DispatchDimensionJob, "", DispatchMetricSLABreachCount, 1)
+		if postal := strings.TrimSpace(utils.Deref(dsp.Postal)); postal != "" {
+			rollups.add(day, breach.Job, DispatchDimensionPostal, postal, DispatchMetricSLABreachCount, 1)
+		}
+	}
+
+	return rollups
+}
+
+// BuildDispatchMetrics rebuilds the centrum dispatch rollups for dispatches created between the start and end day (inclusive).
+func (s *Service) BuildDispatchMetrics(ctx context.Context, startDay, endDay time.Time) (int64, error) {
+	startDay = timeutils.StartOfDay(startDay)
+	endDay = timeutils.StartOfDay(endDay)
+	if endDay.Before(startDay) {
+		return 0, errors.New("end day before start day")
+	}
+
+	dsps, statuses, breaches, err := s.loadDispatchStatsData(ctx, startDay, endDay.AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	rollups := computeDispatchRollups(dsps, statuses, breaches)
+
+	keys := make([]dispatchRollupKey, 0, len(rollups))
+	for key := range rollups {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b dispatchRollupKey) int {
+		if c := a.day.Compare(b.day); c != 0 {
+			return c
+		}
+		return strings.Compare(
+			a.job+"\x00"+a.dimension+"\x00"+a.key+"\x00"+a.metric,
+			b.job+"\x00"+b.dimension+"\x00"+b.key+"\x00"+b.metric,
+		)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	tRollup := table.FivenetStatsDailyRollup
+	if _, err := tRollup.
+		DELETE().
+		WHERE(mysql.AND(
+			tRollup.Day.GT_EQ(mysql.DateT(startDay)),
+			tRollup.Day.LT_EQ(mysql.DateT(endDay)),
+			tRollup.SourceKind.EQ(mysql.String(SourceKindCentrumDispatch)),
+			tRollup.SourceKey.EQ(mysql.String(CentrumDispatchSourceKey)),
+		)).
+		ExecContext(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	var inserted int64
+	for batch := range slices.Chunk(keys, dispatchRollupInsertBatchSize) {
+		stmt := tRollup.
+			INSERT(
+				tRollup.Day,
+				tRollup.Job,
+				tRollup.SourceKind,
+				tRollup.SourceKey,
+				tRollup.MetricKey,
+				tRollup.Dimension1,
+				tRollup.Dimension2,
+				tRollup.Dimension3,
+				tRollup.Value,
+			)
+
+		for _, key := range batch {
+			stmt = stmt.VALUES(
+				mysql.DateT(key.day),
+				key.job,
+				SourceKindCentrumDispatch,
+				CentrumDispatchSourceKey,
+				key.metric,
+				key.dimension,
+				key.key,
+				"",
+				rollups[key],
+			)
+		}
+
+		res, err := stmt.ExecContext(ctx, tx)
+		if err != nil {
+			return 0, err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		inserted += affected
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return inserted, nil
+}
+
+// GetOldestDispatchDay returns the day the oldest centrum dispatch has been created on, zero if there are no dispatches.
+func (s *Service) GetOldestDispatchDay(ctx context.Context) (time.Time, error) {
+	tDispatch := table.FivenetCentrumDispatches
+
+	stmt := tDispatch.
+		SELECT(
+			mysql.MIN(tDispatch.CreatedAt).AS("oldest"),
+		).
+		FROM(tDispatch)
+
+	var dest struct {
+		Oldest *time.Time `alias:"oldest"`
+	}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		return time.Time{}, err
+	}
+
+	if dest.Oldest == nil {
+		return time.Time{}, nil
+	}
+
+	return timeutils.StartOfDay(dest.Oldest.UTC()), nil
+}
+
+func (s *Service) loadDispatchStatsData(
+	ctx context.Context,
+	start, end time.Time,
+) ([]*dispatchStatsDispatch, []*dispatchStatsStatus, []*dispatchStatsBreach, error) {
+	tDispatch := table.FivenetCentrumDispatches
+
+	dispatchStmt := tDispatch.
+		SELECT(
+			tDispatch.ID.AS("dispatch_stats_dispatch.id"),
+			tDispatch.CreatedAt.AS("dispatch_stats_dispatch.created_at"),
+			tDispatch.Jobs.AS("dispatch_stats_dispatch.jobs"),
+			tDispatch.Postal.AS("dispatch_stats_dispatch.postal"),
+		).
+		FROM(tDispatch).
+		WHERE(mysql.AND(
+			tDispatch.CreatedAt.GT_EQ(mysql.TimestampT(start)),
+			tDispatch.CreatedAt.LT(mysql.TimestampT(end)),
+		))
+
+	dsps := []*dispatchStatsDispatch{}
+	if err := dispatchStmt.QueryContext(ctx, s.db, &dsps); err != nil {
+		return nil, nil, nil, err
+	}
+	if len(dsps) == 0 {
+		return dsps, []*dispatchStatsStatus{}, []*dispatchStatsBreach{}, nil
+	}
+
+	ids := make([]mysql.Expression, len(dsps))
+	for i, dsp := range dsps {
+		ids[i] = mysql.Int64(dsp.ID)
+	}
+
+	tDispatchStatus := table.FivenetCentrumDispatchesStatus
+	tUnits := table.FivenetCentrumUnits
+
+	statusStmt := tDispatchStatus.
+		SELECT(
+			tDispatchStatus.DispatchID.AS("dispatch_stats_status.dispatch_id"),
+			tDispatchStatus.CreatedAt.AS("dispatch_stats_status.created_at"),
+			tDispatchStatus.Status.AS("dispatch_stats_status.status"),
+			tDispatchStatus.UnitID.AS("dispatch_stats_status.unit_id"),
+			tUnits.Job.AS("dispatch_stats_status.unit_job"),
+		).
+		FROM(
+			tDispatchStatus.
+				LEFT_JOIN(tUnits,
+					tUnits.ID.EQ(tDispatchStatus.UnitID),
+				),
+		).
+		WHERE(tDispatchStatus.DispatchID.IN(ids...)).
+		ORDER_BY(tDispatchStatus.ID.ASC())
+
+	statuses := []*dispatchStatsStatus{}
+	if err := statusStmt.QueryContext(ctx, s.db, &statuses); err != nil {
+		return nil, nil, nil, err
+	}
+
+	tDispatchEscalations := table.FivenetCentrumDispatchesEscalations
+
+	breachStmt := tDispatchEscalations.
+		SELECT(
+			tDispatchEscalations.DispatchID.AS("dispatch_stats_breach.dispatch_id"),
+			tDispatchEscalations.Job.AS("dispatch_stats_breach.job"),
+		).
+		FROM(tDispatchEscalations).
+		WHERE(mysql.AND(
+			tDispatchEscalations.DispatchID.IN(ids...),
+			tDispatchEscalations.Action.EQ(
+				mysql.Int32(int32(centrumsettings.EscalationAction_ESCALATION_ACTION_MARK_BREACHED)),
+			),
+		))
+
+	breaches := []*dispatchStatsBreach{}
+	if err := breachStmt.QueryContext(ctx, s.db, &breaches); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return dsps, statuses, breaches, nil
+}
+
+// QueryDispatchStats returns the summed up dispatch rollup values of the job per dimension, key and metric.
+func (s *Service) QueryDispatchStats(
+	ctx context.Context,
+	startDay, endDay time.Time,
+	job string,
+	dimension string,
+) ([]*DispatchStatsValue, error) {
+	tRollup := table.FivenetStatsDailyRollup
+
+	condition := mysql.AND(
+		tRollup.Day.GT_EQ(mysql.DateT(timeutils.StartOfDay(startDay))),
+		tRollup.Day.LT_EQ(mysql.DateT(timeutils.StartOfDay(endDay))),
+		tRollup.Job.EQ(mysql.String(job)),
+		tRollup.SourceKind.EQ(mysql.String(SourceKindCentrumDispatch)),
+		tRollup.SourceKey.EQ(mysql.String(CentrumDispatchSourceKey)),
+	)
+	if dimension != "" {
+		condition = condition.AND(tRollup.Dimension1.EQ(mysql.String(dimension)))
+	}
+
+	stmt := tRollup.
+		SELECT(
+			tRollup.Dimension1.AS("dispatch_stats_value.dimension"),
+			tRollup.Dimension2.AS("dispatch_stats_value.key"),
+			tRollup.MetricKey.AS("dispatch_stats_value.metric_key"),
+			mysql.SUM(tRollup.Value).AS("dispatch_stats_value.value"),
+		).
+		FROM(tRollup).
+		WHERE(condition).
+		GROUP_BY(tRollup.Dimension1, tRollup.Dimension2, tRollup.MetricKey).
+		ORDER_BY(tRollup.Dimension1.ASC(), tRollup.Dimension2.ASC(), tRollup.MetricKey.ASC())
+
+	items := []*DispatchStatsValue{}
+	if err := stmt.QueryContext(ctx, s.db, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,78 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeDispatchRollups(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, time.March, 4, 23, 50, 0, 0, time.UTC)
+	day := time.Date(2026, time.March, 4, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return createdAt.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	dsps := []*dispatchStatsDispatch{
+		{
+			ID:        1,
+			CreatedAt: createdAt,
+			Jobs: &centrum.JobList{
+				Jobs: []*centrum.JobListEntry{{Name: "police"}},
+			},
+			Postal: new("1001"),
+		},
+		{
+			// Unassigned dispatch without postal
+			ID:        2,
+			CreatedAt: createdAt,
+			Jobs: &centrum.JobList{
+				Jobs: []*centrum.JobListEntry{{Name: "police"}},
+			},
+		},
+	}
+	statuses := []*dispatchStatsStatus{
+		{DispatchID: 1, CreatedAt: at(0), Status: centrumdispatches.StatusDispatch_STATUS_DISPATCH_NEW},
+		{DispatchID: 1, CreatedAt: at(2), Status: centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNIT_ASSIGNED, UnitID: new(int64(7)), UnitJob: new("police")},
+		{DispatchID: 1, CreatedAt: at(3), Status: centrumdispatches.StatusDispatch_STATUS_DISPATCH_EN_ROUTE, UnitID: new(int64(7)), UnitJob: new("police")},
+		{DispatchID: 1, CreatedAt: at(10), Status: centrumdispatches.StatusDispatch_STATUS_DISPATCH_ON_SCENE, UnitID: new(int64(7)), UnitJob: new("police")},
+		{DispatchID: 1, CreatedAt: at(30), Status: centrumdispatches.StatusDispatch_STATUS_DISPATCH_COMPLETED},
+		{DispatchID: 2, CreatedAt: at(0), Status: centrumdispatches.StatusDispatch_STATUS_DISPATCH_NEW},
+	}
+	breaches := []*dispatchStatsBreach{
+		{DispatchID: 2, Job: "police"},
+	}
+
+	rollups := computeDispatchRollups(dsps, statuses, breaches)
+	get := func(dimension, key, metric string) int64 {
+		return rollups[dispatchRollupKey{
+			day:       day,
+			job:       "police",
+			dimension: dimension,
+			key:       key,
+			metric:    metric,
+		}]
+	}
+
+	// Dispatches are counted on the day they have been created, even if completed on the next day
+	assert.Equal(t, int64(2), get(DispatchDimensionJob, "", DispatchMetricCount))
+	assert.Equal(t, int64(1), get(DispatchDimensionJob, "", DispatchMetricSLABreachCount))
+	assert.Equal(t, int64(120), get(DispatchDimensionJob, "", DispatchMetricTimeToAssign+DispatchMetricSumSuffix))
+	assert.Equal(t, int64(1), get(DispatchDimensionJob, "", DispatchMetricTimeToAssign+DispatchMetricCountSuffix))
+	assert.Equal(t, int64(60), get(DispatchDimensionJob, "", DispatchMetricTimeToEnRoute+DispatchMetricSumSuffix))
+	assert.Equal(t, int64(1200), get(DispatchDimensionJob, "", DispatchMetricTimeOnScene+DispatchMetricSumSuffix))
+	assert.Equal(t, int64(1800), get(DispatchDimensionJob, "", DispatchMetricHandlingTime+DispatchMetricSumSuffix))
+
+	assert.Equal(t, int64(1), get(DispatchDimensionPostal, "1001", DispatchMetricCount))
+	assert.Equal(t, int64(0), get(DispatchDimensionPostal, "1001", DispatchMetricSLABreachCount))
+
+	// Unit didn't complete the dispatch itself, the dispatch completion is used
+	assert.Equal(t, int64(1), get(DispatchDimensionUnit, "7", DispatchMetricCount))
+	assert.Equal(t, int64(1200), get(DispatchDimensionUnit, "7", DispatchMetricTimeOnScene+DispatchMetricSumSuffix))
+	assert.Equal(t, int64(1800), get(DispatchDimensionUnit, "7", DispatchMetricHandlingTime+DispatchMetricSumSuffix))
+}
@@ -48,6 +48,13 @@ type PeriodSeriesValue struct {
 	Value int64     `alias:"value"`
 }
 
+type DispatchStatsValue struct {
+	Dimension string `alias:"dimension"`
+	Key       string `alias:"key"`
+	MetricKey string `alias:"metric_key"`
+	Value     int64  `alias:"value"`
+}
+
 type DocumentMetricExtractor interface {
 	SourceKey() string
 	Supports(doc *documents.Document) bool
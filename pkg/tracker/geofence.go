@@ -0,0 +1,88 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	"github.com/fivenet-app/fivenet/v2026/pkg/events"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	GeofenceStreamName = "TRACKER"
+
+	BaseSubject events.Subject = "tracker"
+
+	TopicGeofence     events.Topic = "geofence"
+	TypeGeofenceEnter events.Type  = "enter"
+	TypeGeofenceExit  events.Type  = "exit"
+)
+
+// BuildGeofenceSubject creates a subject of structure: "BASE_SUBJECT.JOB.TOPIC.TYPE".
+func BuildGeofenceSubject(tType events.Type, job string) string {
+	return fmt.Sprintf("%s.%s.%s.%s", BaseSubject, job, TopicGeofence, tType)
+}
+
+func SplitGeofenceSubject(subject string) (string, events.Type) {
+	split := strings.Split(subject, ".")
+	if len(split) < 4 {
+		return "", ""
+	}
+
+	return split[1], events.Type(split[3])
+}
+
+func RegisterGeofenceStream(ctx context.Context, js *events.JSWrapper) (jetstream.StreamConfig, error) {
+	cfg := jetstream.StreamConfig{
+		Name:        GeofenceStreamName,
+		Description: "Tracker Events",
+		Retention:   jetstream.InterestPolicy,
+		Subjects:    []string{fmt.Sprintf("%s.>", BaseSubject)},
+		Discard:     jetstream.DiscardOld,
+		MaxAge:      60 * time.Second,
+		Storage:     jetstream.MemoryStorage,
+	}
+	if _, err := js.CreateOrUpdateStream(ctx, cfg); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// GeofenceContains reports whether the given position is inside the circle or polygon shape of the marker.
+// Markers of any other type never contain a position.
+func GeofenceContains(marker *livemapmarkers.MarkerMarker, x float64, y float64) bool {
+	switch marker.GetType() {
+	case livemapmarkers.MarkerType_MARKER_TYPE_CIRCLE:
+		circle := marker.GetData().GetCircle()
+		if circle == nil || circle.GetRadius() <= 0 {
+			return false
+		}
+
+		return math.Hypot(x-marker.GetX(), y-marker.GetY()) <= float64(circle.GetRadius())
+
+	case livemapmarkers.MarkerType_MARKER_TYPE_POLYGON:
+		points := marker.GetData().GetPolygon().GetPoints()
+		if len(points) < 3 {
+			return false
+		}
+
+		// Ray casting, count how many polygon edges a ray from the position crosses
+		inside := false
+		for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+			pi, pj := points[i], points[j]
+			if (pi.GetY() > y) != (pj.GetY() > y) &&
+				x < (pj.GetX()-pi.GetX())*(y-pi.GetY())/(pj.GetY()-pi.GetY())+pi.GetX() {
+				inside = !inside
+			}
+		}
+
+		return inside
+	}
+
+	return false
+}
@@ -0,0 +1,75 @@
+package tracker
+
+import (
+	"testing"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap"
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	"github.com/fivenet-app/fivenet/v2026/pkg/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeofenceContains(t *testing.T) {
+	t.Parallel()
+
+	circle := &livemapmarkers.MarkerMarker{
+		X:    100,
+		Y:    100,
+		Type: livemapmarkers.MarkerType_MARKER_TYPE_CIRCLE,
+		Data: &livemapmarkers.MarkerData{
+			Data: &livemapmarkers.MarkerData_Circle{
+				Circle: &livemapmarkers.CircleMarker{Radius: 50},
+			},
+		},
+	}
+	assert.True(t, GeofenceContains(circle, 100, 100))
+	assert.True(t, GeofenceContains(circle, 130, 140))
+	assert.False(t, GeofenceContains(circle, 140, 140))
+
+	polygon := &livemapmarkers.MarkerMarker{
+		Type: livemapmarkers.MarkerType_MARKER_TYPE_POLYGON,
+		Data: &livemapmarkers.MarkerData{
+			Data: &livemapmarkers.MarkerData_Polygon{
+				Polygon: &livemapmarkers.PolygonMarker{
+					// L-shaped zone
+					Points: []*livemap.Coords{
+						{X: 0, Y: 0},
+						{X: 100, Y: 0},
+						{X: 100, Y: 50},
+						{X: 50, Y: 50},
+						{X: 50, Y: 100},
+						{X: 0, Y: 100},
+					},
+				},
+			},
+		},
+	}
+	assert.True(t, GeofenceContains(polygon, 25, 25))
+	assert.True(t, GeofenceContains(polygon, 75, 25))
+	assert.True(t, GeofenceContains(polygon, 25, 75))
+	assert.False(t, GeofenceContains(polygon, 75, 75))
+	assert.False(t, GeofenceContains(polygon, -1, 50))
+
+	// Shapes without an area never contain a position
+	dot := &livemapmarkers.MarkerMarker{
+		X:    100,
+		Y:    100,
+		Type: livemapmarkers.MarkerType_MARKER_TYPE_DOT,
+	}
+	assert.False(t, GeofenceContains(dot, 100, 100))
+}
+
+func TestGeofenceSubject(t *testing.T) {
+	t.Parallel()
+
+	subject := BuildGeofenceSubject(TypeGeofenceEnter, "police")
+	assert.Equal(t, "tracker.police.geofence.enter", subject)
+
+	job, tType := SplitGeofenceSubject(subject)
+	assert.Equal(t, "police", job)
+	assert.Equal(t, TypeGeofenceEnter, tType)
+
+	job, tType = SplitGeofenceSubject("tracker.police")
+	assert.Empty(t, job)
+	assert.Equal(t, events.Type(""), tType)
+}
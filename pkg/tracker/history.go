@@ -0,0 +1,12 @@
+package tracker
+
+import "time"
+
+const (
+	// PositionHistoryRetention is how long position samples are kept before they are pruned.
+	PositionHistoryRetention = 12 * time.Hour
+	// PositionHistoryInterval is the minimum time between two position samples of a user.
+	PositionHistoryInterval = 30 * time.Second
+	// PositionHistoryMinDistance is the distance a user has to move for a new position sample.
+	PositionHistoryMinDistance = 10.0
+)
@@ -0,0 +1,158 @@
+package manager
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const geofenceRefreshInterval = 1 * time.Minute
+
+// loadGeofences returns the cached geofence markers, they are reloaded from the database once the refresh interval has passed.
+func (m *Manager) loadGeofences(ctx context.Context) []*livemapmarkers.MarkerMarker {
+	if m.geofences != nil && time.Since(m.geofencesLoadedAt) < geofenceRefreshInterval {
+		return m.geofences
+	}
+
+	geofences, err := m.livemapStore.ListGeofenceMarkers(ctx)
+	if err != nil {
+		// Keep using the previously loaded geofences
+		m.logger.Error("failed to load geofence markers", zap.Error(err))
+		return m.geofences
+	}
+
+	m.geofences = geofences
+	m.geofencesLoadedAt = time.Now()
+
+	return m.geofences
+}
+
+// checkGeofences determines which geofences the users are in and publishes enter/exit events for the changes since the last check.
+// Users that weren't tracked during the last check only have their state recorded, so going on duty inside a zone isn't treated as an enter.
+func (m *Manager) checkGeofences(ctx context.Context, userMarkers []*livemapmarkers.UserMarker) error {
+	geofences := m.loadGeofences(ctx)
+
+	geofencesByJob := map[string][]*livemapmarkers.MarkerMarker{}
+	geofencesByID := make(map[int64]*livemapmarkers.MarkerMarker, len(geofences))
+	for _, geofence := range geofences {
+		geofencesByJob[geofence.GetJob()] = append(geofencesByJob[geofence.GetJob()], geofence)
+		geofencesByID[geofence.GetId()] = geofence
+	}
+
+	state := make(map[int32]map[int64]struct{}, len(userMarkers))
+	errs := multierr.Combine()
+	for _, um := range userMarkers {
+		if um.GetUser() == nil {
+			continue
+		}
+
+		var inside map[int64]struct{}
+		for _, geofence := range geofencesByJob[um.GetJob()] {
+			if !tracker.GeofenceContains(geofence, um.GetX(), um.GetY()) {
+				continue
+			}
+
+			if inside == nil {
+				inside = map[int64]struct{}{}
+			}
+			inside[geofence.GetId()] = struct{}{}
+		}
+		state[um.GetUserId()] = inside
+
+		previous, ok := m.geofenceState[um.GetUserId()]
+		if !ok {
+			continue
+		}
+
+		entered, exited := diffGeofences(previous, inside)
+		for _, id := range entered {
+			if err := m.publishGeofenceEvent(
+				ctx,
+				livemapmarkers.GeofenceEventType_GEOFENCE_EVENT_TYPE_ENTER,
+				geofencesByID[id],
+				um,
+			); err != nil {
+				errs = multierr.Append(errs, err)
+			}
+		}
+		for _, id := range exited {
+			geofence, ok := geofencesByID[id]
+			// Geofence has been removed in the meantime
+			if !ok {
+				continue
+			}
+
+			if err := m.publishGeofenceEvent(
+				ctx,
+				livemapmarkers.GeofenceEventType_GEOFENCE_EVENT_TYPE_EXIT,
+				geofence,
+				um,
+			); err != nil {
+				errs = multierr.Append(errs, err)
+			}
+		}
+	}
+
+	// Users that are no longer tracked are dropped from the state
+	m.geofenceState = state
+
+	return errs
+}
+
+func (m *Manager) publishGeofenceEvent(
+	ctx context.Context,
+	eventType livemapmarkers.GeofenceEventType,
+	geofence *livemapmarkers.MarkerMarker,
+	um *livemapmarkers.UserMarker,
+) error {
+	tType := tracker.TypeGeofenceEnter
+	if eventType == livemapmarkers.GeofenceEventType_GEOFENCE_EVENT_TYPE_EXIT {
+		tType = tracker.TypeGeofenceExit
+	}
+
+	_, err := m.js.PublishProto(
+		ctx,
+		tracker.BuildGeofenceSubject(tType, geofence.GetJob()),
+		&livemapmarkers.GeofenceEvent{
+			Type:       eventType,
+			Time:       timestamp.Now(),
+			MarkerId:   geofence.GetId(),
+			MarkerName: geofence.GetName(),
+			Job:        geofence.GetJob(),
+			Geofence:   geofence.GetGeofence(),
+			UserId:     um.GetUserId(),
+			UnitId:     um.UnitId,
+			X:          um.GetX(),
+			Y:          um.GetY(),
+		},
+	)
+	return err
+}
+
+// diffGeofences returns the sorted IDs of the geofences that have been entered and exited.
+func diffGeofences(previous map[int64]struct{}, current map[int64]struct{}) ([]int64, []int64) {
+	entered := []int64{}
+	for id := range current {
+		if _, ok := previous[id]; !ok {
+			entered = append(entered, id)
+		}
+	}
+
+	exited := []int64{}
+	for id := range previous {
+		if _, ok := current[id]; !ok {
+			exited = append(exited, id)
+		}
+	}
+
+	slices.Sort(entered)
+	slices.Sort(exited)
+
+	return entered, exited
+}
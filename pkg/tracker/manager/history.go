@@ -0,0 +1,77 @@
+package manager
+
+import (
+	"context"
+	"math"
+	"time"
+
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
+)
+
+// recordPositionHistory writes a position sample for each visible user that has moved far enough since their last sample.
+func (m *Manager) recordPositionHistory(
+	ctx context.Context,
+	userMarkers []*livemapmarkers.UserMarker,
+) error {
+	now := time.Now()
+
+	samples := []*livemaphistory.PositionSample{}
+	last := make(map[int32]*livemaphistory.PositionSample, len(userMarkers))
+	for _, um := range userMarkers {
+		// Hidden users aren't visible on the livemap, so don't record their positions either
+		if um.GetUser() == nil || um.GetHidden() {
+			continue
+		}
+
+		previous := m.positionSamples[um.GetUserId()]
+		if !shouldSamplePosition(previous, um, now) {
+			last[um.GetUserId()] = previous
+			continue
+		}
+
+		sample := &livemaphistory.PositionSample{
+			CreatedAt: timestamp.New(now),
+			UserId:    um.GetUserId(),
+			Job:       um.GetJob(),
+			JobGrade:  um.GetJobGrade(),
+			UnitId:    um.UnitId,
+			X:         um.GetX(),
+			Y:         um.GetY(),
+		}
+		samples = append(samples, sample)
+		last[um.GetUserId()] = sample
+	}
+
+	if err := m.livemapStore.AddPositionSamples(ctx, samples); err != nil {
+		return err
+	}
+
+	// Users that are no longer tracked are dropped
+	m.positionSamples = last
+
+	return nil
+}
+
+// shouldSamplePosition reports whether a new position sample should be taken for the user.
+// Unit changes are always sampled, otherwise the user must have moved far enough after the sample interval.
+func shouldSamplePosition(
+	previous *livemaphistory.PositionSample,
+	um *livemapmarkers.UserMarker,
+	now time.Time,
+) bool {
+	if previous == nil || previous.GetUnitId() != um.GetUnitId() {
+		return true
+	}
+
+	if now.Sub(previous.GetCreatedAt().AsTime()) < tracker.PositionHistoryInterval {
+		return false
+	}
+
+	return math.Hypot(
+		um.GetX()-previous.GetX(),
+		um.GetY()-previous.GetY(),
+	) >= tracker.PositionHistoryMinDistance
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	jobsprops "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/props"
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
 	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	pbtracker "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/tracker"
@@ -24,6 +25,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatchers"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/helpers"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/units"
+	livemapstore "github.com/fivenet-app/fivenet/v2026/stores/livemap"
 	"github.com/go-jet/jet/v2/mysql"
 	"github.com/go-jet/jet/v2/qrm"
 	"github.com/nats-io/nats.go/jetstream"
@@ -50,12 +52,20 @@ type Manager struct {
 	postals  postals.Postals
 	appCfg   appconfig.IConfig
 
-	units       *units.UnitDB
-	helpers     *helpers.Helpers
-	dispatchers *dispatchers.DispatchersDB
+	units        *units.UnitDB
+	helpers      *helpers.Helpers
+	dispatchers  *dispatchers.DispatchersDB
+	livemapStore livemapstore.IStore
 
 	refreshTicker *time.Ticker
 
+	geofences         []*livemapmarkers.MarkerMarker
+	geofencesLoadedAt time.Time
+	// User ID -> IDs of the geofence markers the user is in
+	geofenceState map[int32]map[int64]struct{}
+	// User ID -> last recorded position sample
+	positionSamples map[int32]*livemaphistory.PositionSample
+
 	userByIDStore     *store.Store[livemapmarkers.UserMarker, *livemapmarkers.UserMarker]
 	userLocStore      *store.Store[livemapmarkers.UserMarker, *livemapmarkers.UserMarker]
 	userMappingsStore *store.Store[pbtracker.UserMapping, *pbtracker.UserMapping]
@@ -75,9 +85,10 @@ type Params struct {
 	Cfg       *config.Config
 	AppConfig appconfig.IConfig
 
-	Units       *units.UnitDB
-	Helpers     *helpers.Helpers
-	Dispatchers *dispatchers.DispatchersDB
+	Units        *units.UnitDB
+	Helpers      *helpers.Helpers
+	Dispatchers  *dispatchers.DispatchersDB
+	LivemapStore livemapstore.IStore
 }
 
 func New(p Params) (*Manager, error) {
@@ -94,9 +105,13 @@ func New(p Params) (*Manager, error) {
 		postals:  p.Postals,
 		appCfg:   p.AppConfig,
 
-		units:       p.Units,
-		helpers:     p.Helpers,
-		dispatchers: p.Dispatchers,
+		units:        p.Units,
+		helpers:      p.Helpers,
+		dispatchers:  p.Dispatchers,
+		livemapStore: p.LivemapStore,
+
+		geofenceState:   map[int32]map[int64]struct{}{},
+		positionSamples: map[int32]*livemaphistory.PositionSample{},
 	}
 
 	p.LC.Append(fx.StartHook(func(ctxStartup context.Context) error {
@@ -286,6 +301,10 @@ func New(p Params) (*Manager, error) {
 		}
 		m.userByIDStore = byID
 
+		if _, err := tracker.RegisterGeofenceStream(ctxStartup, p.JS); err != nil {
+			return fmt.Errorf("failed to register tracker geofence stream. %w", err)
+		}
+
 		go m.start(ctxCancel)
 
 		return nil
@@ -534,6 +553,14 @@ func (m *Manager) refreshUserLocations(ctx context.Context, initial bool) error
 		}
 	}
 
+	if err := m.checkGeofences(ctx, dest); err != nil {
+		m.logger.Error("failed to publish geofence events", zap.Error(err))
+	}
+
+	if err := m.recordPositionHistory(ctx, dest); err != nil {
+		m.logger.Error("failed to record position history", zap.Error(err))
+	}
+
 	removed, err := m.cleanupUserIDs(ctx, foundUserIds)
 	if err != nil {
 		return err
@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"os"
 	"testing"
+	"time"
 
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
 	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	"github.com/fivenet-app/fivenet/v2026/internal/modules"
 	"github.com/fivenet-app/fivenet/v2026/internal/tests/servers"
 	"github.com/fivenet-app/fivenet/v2026/pkg/access"
@@ -290,6 +293,53 @@ func TestCleanupUserIDsDeletesStaleLocationKeyWhenMarkerMissing(t *testing.T) {
 	assert.Empty(t, dispatchersForPolice.GetDispatchers())
 }
 
+func TestDiffGeofences(t *testing.T) {
+	t.Parallel()
+
+	entered, exited := diffGeofences(nil, map[int64]struct{}{3: {}, 1: {}})
+	assert.Equal(t, []int64{1, 3}, entered)
+	assert.Empty(t, exited)
+
+	entered, exited = diffGeofences(
+		map[int64]struct{}{1: {}, 2: {}},
+		map[int64]struct{}{2: {}, 4: {}},
+	)
+	assert.Equal(t, []int64{4}, entered)
+	assert.Equal(t, []int64{1}, exited)
+
+	entered, exited = diffGeofences(map[int64]struct{}{5: {}}, nil)
+	assert.Empty(t, entered)
+	assert.Equal(t, []int64{5}, exited)
+}
+
+func TestShouldSamplePosition(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	previous := &livemaphistory.PositionSample{
+		CreatedAt: timestamp.New(now.Add(-time.Minute)),
+		UserId:    1,
+		X:         100,
+		Y:         100,
+	}
+
+	um := &livemapmarkers.UserMarker{UserId: 1, X: 100, Y: 100}
+	// First position of a user is always sampled
+	assert.True(t, shouldSamplePosition(nil, um, now))
+	// Not moved
+	assert.False(t, shouldSamplePosition(previous, um, now))
+
+	um.X = 150
+	assert.True(t, shouldSamplePosition(previous, um, now))
+	// Moved, but sample interval hasn't passed yet
+	assert.False(t, shouldSamplePosition(previous, um, now.Add(-50*time.Second)))
+
+	// Unit change is always sampled
+	um.X = 100
+	um.UnitId = new(int64(3))
+	assert.True(t, shouldSamplePosition(previous, um, now.Add(-50*time.Second)))
+}
+
 func insertCitizenLocations(
 	ctx context.Context,
 	db *sql.DB,
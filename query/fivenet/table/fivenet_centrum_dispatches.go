@@ -17,19 +17,20 @@ type fivenetCentrumDispatchesTable struct {
 	mysql.Table
 
 	// Columns
-	ID          mysql.ColumnInteger
-	CreatedAt   mysql.ColumnTimestamp
-	UpdatedAt   mysql.ColumnTimestamp
-	Jobs        mysql.ColumnString
-	Message     mysql.ColumnString
-	Description mysql.ColumnString
-	Attributes  mysql.ColumnString
-	References  mysql.ColumnString
-	X           mysql.ColumnFloat
-	Y           mysql.ColumnFloat
-	Postal      mysql.ColumnString
-	Anon        mysql.ColumnBool
-	CreatorID   mysql.ColumnInteger
+	ID           mysql.ColumnInteger
+	CreatedAt    mysql.ColumnTimestamp
+	UpdatedAt    mysql.ColumnTimestamp
+	Jobs         mysql.ColumnString
+	Message      mysql.ColumnString
+	Description  mysql.ColumnString
+	Attributes   mysql.ColumnString
+	References   mysql.ColumnString
+	Requirements mysql.ColumnString
+	X            mysql.ColumnFloat
+	Y            mysql.ColumnFloat
+	Postal       mysql.ColumnString
+	Anon         mysql.ColumnBool
+	CreatorID    mysql.ColumnInteger
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -71,41 +72,43 @@ func newFivenetCentrumDispatchesTable(schemaName, tableName, alias string) *Five
 
 func newFivenetCentrumDispatchesTableImpl(schemaName, tableName, alias string) fivenetCentrumDispatchesTable {
 	var (
-		IDColumn          = mysql.IntegerColumn("id")
-		CreatedAtColumn   = mysql.TimestampColumn("created_at")
-		UpdatedAtColumn   = mysql.TimestampColumn("updated_at")
-		JobsColumn        = mysql.StringColumn("jobs")
-		MessageColumn     = mysql.StringColumn("message")
-		DescriptionColumn = mysql.StringColumn("description")
-		AttributesColumn  = mysql.StringColumn("attributes")
-		ReferencesColumn  = mysql.StringColumn("references")
-		XColumn           = mysql.FloatColumn("x")
-		YColumn           = mysql.FloatColumn("y")
-		PostalColumn      = mysql.StringColumn("postal")
-		AnonColumn        = mysql.BoolColumn("anon")
-		CreatorIDColumn   = mysql.IntegerColumn("creator_id")
-		allColumns        = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, JobsColumn, MessageColumn, DescriptionColumn, AttributesColumn, ReferencesColumn, XColumn, YColumn, PostalColumn, AnonColumn, CreatorIDColumn}
-		mutableColumns    = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, JobsColumn, MessageColumn, DescriptionColumn, AttributesColumn, ReferencesColumn, XColumn, YColumn, PostalColumn, AnonColumn, CreatorIDColumn}
-		defaultColumns    = mysql.ColumnList{CreatedAtColumn, AnonColumn}
+		IDColumn           = mysql.IntegerColumn("id")
+		CreatedAtColumn    = mysql.TimestampColumn("created_at")
+		UpdatedAtColumn    = mysql.TimestampColumn("updated_at")
+		JobsColumn         = mysql.StringColumn("jobs")
+		MessageColumn      = mysql.StringColumn("message")
+		DescriptionColumn  = mysql.StringColumn("description")
+		AttributesColumn   = mysql.StringColumn("attributes")
+		ReferencesColumn   = mysql.StringColumn("references")
+		RequirementsColumn = mysql.StringColumn("requirements")
+		XColumn            = mysql.FloatColumn("x")
+		YColumn            = mysql.FloatColumn("y")
+		PostalColumn       = mysql.StringColumn("postal")
+		AnonColumn         = mysql.BoolColumn("anon")
+		CreatorIDColumn    = mysql.IntegerColumn("creator_id")
+		allColumns         = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, JobsColumn, MessageColumn, DescriptionColumn, AttributesColumn, ReferencesColumn, RequirementsColumn, XColumn, YColumn, PostalColumn, AnonColumn, CreatorIDColumn}
+		mutableColumns     = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, JobsColumn, MessageColumn, DescriptionColumn, AttributesColumn, ReferencesColumn, RequirementsColumn, XColumn, YColumn, PostalColumn, AnonColumn, CreatorIDColumn}
+		defaultColumns     = mysql.ColumnList{CreatedAtColumn, AnonColumn}
 	)
 
 	return fivenetCentrumDispatchesTable{
 		Table: mysql.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:          IDColumn,
-		CreatedAt:   CreatedAtColumn,
-		UpdatedAt:   UpdatedAtColumn,
-		Jobs:        JobsColumn,
-		Message:     MessageColumn,
-		Description: DescriptionColumn,
-		Attributes:  AttributesColumn,
-		References:  ReferencesColumn,
-		X:           XColumn,
-		Y:           YColumn,
-		Postal:      PostalColumn,
-		Anon:        AnonColumn,
-		CreatorID:   CreatorIDColumn,
+		ID:           IDColumn,
+		CreatedAt:    CreatedAtColumn,
+		UpdatedAt:    UpdatedAtColumn,
+		Jobs:         JobsColumn,
+		Message:      MessageColumn,
+		Description:  DescriptionColumn,
+		Attributes:   AttributesColumn,
+		References:   ReferencesColumn,
+		Requirements: RequirementsColumn,
+		X:            XColumn,
+		Y:            YColumn,
+		Postal:       PostalColumn,
+		Anon:         AnonColumn,
+		CreatorID:    CreatorIDColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -33,6 +33,7 @@ type fivenetCentrumMarkersTable struct {
 	Icon        mysql.ColumnString
 	MarkerType  mysql.ColumnInteger
 	MarkerData  mysql.ColumnBlob
+	Geofence    mysql.ColumnString
 	CreatorID   mysql.ColumnInteger
 
 	AllColumns     mysql.ColumnList
@@ -91,10 +92,11 @@ func newFivenetCentrumMarkersTableImpl(schemaName, tableName, alias string) five
 		IconColumn        = mysql.StringColumn("icon")
 		MarkerTypeColumn  = mysql.IntegerColumn("marker_type")
 		MarkerDataColumn  = mysql.BlobColumn("marker_data")
+		GeofenceColumn    = mysql.StringColumn("geofence")
 		CreatorIDColumn   = mysql.IntegerColumn("creator_id")
-		allColumns        = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, ExpiresAtColumn, JobColumn, PublicColumn, NameColumn, DescriptionColumn, XColumn, YColumn, PostalColumn, ColorColumn, IconColumn, MarkerTypeColumn, MarkerDataColumn, CreatorIDColumn}
-		mutableColumns    = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, ExpiresAtColumn, JobColumn, PublicColumn, NameColumn, DescriptionColumn, XColumn, YColumn, PostalColumn, ColorColumn, IconColumn, MarkerTypeColumn, MarkerDataColumn, CreatorIDColumn}
-		defaultColumns    = mysql.ColumnList{CreatedAtColumn, PublicColumn, ColorColumn}
+		allColumns        = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, ExpiresAtColumn, JobColumn, PublicColumn, NameColumn, DescriptionColumn, XColumn, YColumn, PostalColumn, ColorColumn, IconColumn, MarkerTypeColumn, MarkerDataColumn, GeofenceColumn, CreatorIDColumn}
+		mutableColumns    = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, ExpiresAtColumn, JobColumn, PublicColumn, NameColumn, DescriptionColumn, XColumn, YColumn, PostalColumn, ColorColumn, IconColumn, MarkerTypeColumn, MarkerDataColumn, GeofenceColumn, CreatorIDColumn}
+		defaultColumns    = mysql.ColumnList{CreatedAtColumn, PublicColumn, ColorColumn, GeofenceColumn}
 	)
 
 	return fivenetCentrumMarkersTable{
@@ -117,6 +119,7 @@ func newFivenetCentrumMarkersTableImpl(schemaName, tableName, alias string) five
 		Icon:        IconColumn,
 		MarkerType:  MarkerTypeColumn,
 		MarkerData:  MarkerDataColumn,
+		Geofence:    GeofenceColumn,
 		CreatorID:   CreatorIDColumn,
 
 		AllColumns:     allColumns,
@@ -36,6 +36,9 @@ type fivenetDocumentsTable struct {
 	Closed       mysql.ColumnBool
 	Draft        mysql.ColumnBool
 	Public       mysql.ColumnBool
+	Locked       mysql.ColumnBool
+	LegalHold    mysql.ColumnBool
+	ArchivedAt   mysql.ColumnTimestamp
 	TemplateID   mysql.ColumnInteger
 
 	AllColumns     mysql.ColumnList
@@ -97,10 +100,13 @@ func newFivenetDocumentsTableImpl(schemaName, tableName, alias string) fivenetDo
 		ClosedColumn       = mysql.BoolColumn("closed")
 		DraftColumn        = mysql.BoolColumn("draft")
 		PublicColumn       = mysql.BoolColumn("public")
+		LockedColumn       = mysql.BoolColumn("locked")
+		LegalHoldColumn    = mysql.BoolColumn("legal_hold")
+		ArchivedAtColumn   = mysql.TimestampColumn("archived_at")
 		TemplateIDColumn   = mysql.IntegerColumn("template_id")
-		allColumns         = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, CategoryIDColumn, TitleColumn, SummaryColumn, WordCountColumn, FirstHeadingColumn, ContentTypeColumn, ContentJSONColumn, ContentTextColumn, DataColumn, CreatorIDColumn, CreatorJobColumn, StateColumn, ClosedColumn, DraftColumn, PublicColumn, TemplateIDColumn}
-		mutableColumns     = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, CategoryIDColumn, TitleColumn, SummaryColumn, WordCountColumn, FirstHeadingColumn, ContentTypeColumn, ContentJSONColumn, ContentTextColumn, DataColumn, CreatorIDColumn, CreatorJobColumn, StateColumn, ClosedColumn, DraftColumn, PublicColumn, TemplateIDColumn}
-		defaultColumns     = mysql.ColumnList{CreatedAtColumn, WordCountColumn, FirstHeadingColumn, ClosedColumn, DraftColumn, PublicColumn}
+		allColumns         = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, CategoryIDColumn, TitleColumn, SummaryColumn, WordCountColumn, FirstHeadingColumn, ContentTypeColumn, ContentJSONColumn, ContentTextColumn, DataColumn, CreatorIDColumn, CreatorJobColumn, StateColumn, ClosedColumn, DraftColumn, PublicColumn, LockedColumn, LegalHoldColumn, ArchivedAtColumn, TemplateIDColumn}
+		mutableColumns     = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, CategoryIDColumn, TitleColumn, SummaryColumn, WordCountColumn, FirstHeadingColumn, ContentTypeColumn, ContentJSONColumn, ContentTextColumn, DataColumn, CreatorIDColumn, CreatorJobColumn, StateColumn, ClosedColumn, DraftColumn, PublicColumn, LockedColumn, LegalHoldColumn, ArchivedAtColumn, TemplateIDColumn}
+		defaultColumns     = mysql.ColumnList{CreatedAtColumn, WordCountColumn, FirstHeadingColumn, ClosedColumn, DraftColumn, PublicColumn, LockedColumn, LegalHoldColumn}
 	)
 
 	return fivenetDocumentsTable{
@@ -126,6 +132,9 @@ func newFivenetDocumentsTableImpl(schemaName, tableName, alias string) fivenetDo
 		Closed:       ClosedColumn,
 		Draft:        DraftColumn,
 		Public:       PublicColumn,
+		Locked:       LockedColumn,
+		LegalHold:    LegalHoldColumn,
+		ArchivedAt:   ArchivedAtColumn,
 		TemplateID:   TemplateIDColumn,
 
 		AllColumns:     allColumns,
@@ -24,6 +24,8 @@ type fivenetDocumentsApprovalPoliciesTable struct {
 	RequiredCount      mysql.ColumnInteger
 	SignatureRequired  mysql.ColumnBool
 	SelfApproveAllowed mysql.ColumnBool
+	PolicyTemplateID   mysql.ColumnInteger
+	CurrentStage       mysql.ColumnInteger
 	AssignedCount      mysql.ColumnInteger
 	ApprovedCount      mysql.ColumnInteger
 	DeclinedCount      mysql.ColumnInteger
@@ -82,6 +84,8 @@ func newFivenetDocumentsApprovalPoliciesTableImpl(schemaName, tableName, alias s
 		RequiredCountColumn      = mysql.IntegerColumn("required_count")
 		SignatureRequiredColumn  = mysql.BoolColumn("signature_required")
 		SelfApproveAllowedColumn = mysql.BoolColumn("self_approve_allowed")
+		PolicyTemplateIDColumn   = mysql.IntegerColumn("policy_template_id")
+		CurrentStageColumn       = mysql.IntegerColumn("current_stage")
 		AssignedCountColumn      = mysql.IntegerColumn("assigned_count")
 		ApprovedCountColumn      = mysql.IntegerColumn("approved_count")
 		DeclinedCountColumn      = mysql.IntegerColumn("declined_count")
@@ -92,9 +96,9 @@ func newFivenetDocumentsApprovalPoliciesTableImpl(schemaName, tableName, alias s
 		CreatedAtColumn          = mysql.TimestampColumn("created_at")
 		UpdatedAtColumn          = mysql.TimestampColumn("updated_at")
 		DeletedAtColumn          = mysql.TimestampColumn("deleted_at")
-		allColumns               = mysql.ColumnList{DocumentIDColumn, SnapshotDateColumn, OnEditBehaviorColumn, RuleKindColumn, RequiredCountColumn, SignatureRequiredColumn, SelfApproveAllowedColumn, AssignedCountColumn, ApprovedCountColumn, DeclinedCountColumn, PendingCountColumn, AnyDeclinedColumn, StartedAtColumn, CompletedAtColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn}
-		mutableColumns           = mysql.ColumnList{SnapshotDateColumn, OnEditBehaviorColumn, RuleKindColumn, RequiredCountColumn, SignatureRequiredColumn, SelfApproveAllowedColumn, AssignedCountColumn, ApprovedCountColumn, DeclinedCountColumn, PendingCountColumn, AnyDeclinedColumn, StartedAtColumn, CompletedAtColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn}
-		defaultColumns           = mysql.ColumnList{RuleKindColumn, RequiredCountColumn, SignatureRequiredColumn, SelfApproveAllowedColumn, AssignedCountColumn, ApprovedCountColumn, DeclinedCountColumn, PendingCountColumn, AnyDeclinedColumn, CreatedAtColumn}
+		allColumns               = mysql.ColumnList{DocumentIDColumn, SnapshotDateColumn, OnEditBehaviorColumn, RuleKindColumn, RequiredCountColumn, SignatureRequiredColumn, SelfApproveAllowedColumn, PolicyTemplateIDColumn, CurrentStageColumn, AssignedCountColumn, ApprovedCountColumn, DeclinedCountColumn, PendingCountColumn, AnyDeclinedColumn, StartedAtColumn, CompletedAtColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn}
+		mutableColumns           = mysql.ColumnList{SnapshotDateColumn, OnEditBehaviorColumn, RuleKindColumn, RequiredCountColumn, SignatureRequiredColumn, SelfApproveAllowedColumn, PolicyTemplateIDColumn, CurrentStageColumn, AssignedCountColumn, ApprovedCountColumn, DeclinedCountColumn, PendingCountColumn, AnyDeclinedColumn, StartedAtColumn, CompletedAtColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn}
+		defaultColumns           = mysql.ColumnList{RuleKindColumn, RequiredCountColumn, SignatureRequiredColumn, SelfApproveAllowedColumn, CurrentStageColumn, AssignedCountColumn, ApprovedCountColumn, DeclinedCountColumn, PendingCountColumn, AnyDeclinedColumn, CreatedAtColumn}
 	)
 
 	return fivenetDocumentsApprovalPoliciesTable{
@@ -108,6 +112,8 @@ func newFivenetDocumentsApprovalPoliciesTableImpl(schemaName, tableName, alias s
 		RequiredCount:      RequiredCountColumn,
 		SignatureRequired:  SignatureRequiredColumn,
 		SelfApproveAllowed: SelfApproveAllowedColumn,
+		PolicyTemplateID:   PolicyTemplateIDColumn,
+		CurrentStage:       CurrentStageColumn,
 		AssignedCount:      AssignedCountColumn,
 		ApprovedCount:      ApprovedCountColumn,
 		DeclinedCount:      DeclinedCountColumn,
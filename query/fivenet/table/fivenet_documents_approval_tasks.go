@@ -22,6 +22,8 @@ type fivenetDocumentsApprovalTasksTable struct {
 	SnapshotDate      mysql.ColumnTimestamp
 	AssigneeKind      mysql.ColumnInteger
 	UserID            mysql.ColumnInteger
+	OriginalUserID    mysql.ColumnInteger
+	DelegatedAt       mysql.ColumnTimestamp
 	Job               mysql.ColumnString
 	MinimumGrade      mysql.ColumnInteger
 	Label             mysql.ColumnString
@@ -82,6 +84,8 @@ func newFivenetDocumentsApprovalTasksTableImpl(schemaName, tableName, alias stri
 		SnapshotDateColumn      = mysql.TimestampColumn("snapshot_date")
 		AssigneeKindColumn      = mysql.IntegerColumn("assignee_kind")
 		UserIDColumn            = mysql.IntegerColumn("user_id")
+		OriginalUserIDColumn    = mysql.IntegerColumn("original_user_id")
+		DelegatedAtColumn       = mysql.TimestampColumn("delegated_at")
 		JobColumn               = mysql.StringColumn("job")
 		MinimumGradeColumn      = mysql.IntegerColumn("minimum_grade")
 		LabelColumn             = mysql.StringColumn("label")
@@ -96,8 +100,8 @@ func newFivenetDocumentsApprovalTasksTableImpl(schemaName, tableName, alias stri
 		ApprovalIDColumn        = mysql.IntegerColumn("approval_id")
 		CreatorIDColumn         = mysql.IntegerColumn("creator_id")
 		CreatorJobColumn        = mysql.StringColumn("creator_job")
-		allColumns              = mysql.ColumnList{IDColumn, DocumentIDColumn, SnapshotDateColumn, AssigneeKindColumn, UserIDColumn, JobColumn, MinimumGradeColumn, LabelColumn, SignatureRequiredColumn, SlotNoColumn, StatusColumn, CommentColumn, DueAtColumn, DecisionCountColumn, CreatedAtColumn, CompletedAtColumn, ApprovalIDColumn, CreatorIDColumn, CreatorJobColumn}
-		mutableColumns          = mysql.ColumnList{DocumentIDColumn, SnapshotDateColumn, AssigneeKindColumn, UserIDColumn, JobColumn, MinimumGradeColumn, LabelColumn, SignatureRequiredColumn, SlotNoColumn, StatusColumn, CommentColumn, DueAtColumn, DecisionCountColumn, CreatedAtColumn, CompletedAtColumn, ApprovalIDColumn, CreatorIDColumn, CreatorJobColumn}
+		allColumns              = mysql.ColumnList{IDColumn, DocumentIDColumn, SnapshotDateColumn, AssigneeKindColumn, UserIDColumn, OriginalUserIDColumn, DelegatedAtColumn, JobColumn, MinimumGradeColumn, LabelColumn, SignatureRequiredColumn, SlotNoColumn, StatusColumn, CommentColumn, DueAtColumn, DecisionCountColumn, CreatedAtColumn, CompletedAtColumn, ApprovalIDColumn, CreatorIDColumn, CreatorJobColumn}
+		mutableColumns          = mysql.ColumnList{DocumentIDColumn, SnapshotDateColumn, AssigneeKindColumn, UserIDColumn, OriginalUserIDColumn, DelegatedAtColumn, JobColumn, MinimumGradeColumn, LabelColumn, SignatureRequiredColumn, SlotNoColumn, StatusColumn, CommentColumn, DueAtColumn, DecisionCountColumn, CreatedAtColumn, CompletedAtColumn, ApprovalIDColumn, CreatorIDColumn, CreatorJobColumn}
 		defaultColumns          = mysql.ColumnList{SignatureRequiredColumn, SlotNoColumn, DecisionCountColumn, CreatedAtColumn}
 	)
 
@@ -110,6 +114,8 @@ func newFivenetDocumentsApprovalTasksTableImpl(schemaName, tableName, alias stri
 		SnapshotDate:      SnapshotDateColumn,
 		AssigneeKind:      AssigneeKindColumn,
 		UserID:            UserIDColumn,
+		OriginalUserID:    OriginalUserIDColumn,
+		DelegatedAt:       DelegatedAtColumn,
 		Job:               JobColumn,
 		MinimumGrade:      MinimumGradeColumn,
 		Label:             LabelColumn,
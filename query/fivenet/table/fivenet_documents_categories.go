@@ -17,15 +17,17 @@ type fivenetDocumentsCategoriesTable struct {
 	mysql.Table
 
 	// Columns
-	ID          mysql.ColumnInteger
-	CreatedAt   mysql.ColumnTimestamp
-	DeletedAt   mysql.ColumnTimestamp
-	Name        mysql.ColumnString
-	SortKey     mysql.ColumnString
-	Description mysql.ColumnString
-	Job         mysql.ColumnString
-	Color       mysql.ColumnString
-	Icon        mysql.ColumnString
+	ID              mysql.ColumnInteger
+	CreatedAt       mysql.ColumnTimestamp
+	DeletedAt       mysql.ColumnTimestamp
+	Name            mysql.ColumnString
+	SortKey         mysql.ColumnString
+	Description     mysql.ColumnString
+	Job             mysql.ColumnString
+	Color           mysql.ColumnString
+	Icon            mysql.ColumnString
+	StateMachine    mysql.ColumnString
+	RetentionPolicy mysql.ColumnString
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -67,33 +69,37 @@ func newFivenetDocumentsCategoriesTable(schemaName, tableName, alias string) *Fi
 
 func newFivenetDocumentsCategoriesTableImpl(schemaName, tableName, alias string) fivenetDocumentsCategoriesTable {
 	var (
-		IDColumn          = mysql.IntegerColumn("id")
-		CreatedAtColumn   = mysql.TimestampColumn("created_at")
-		DeletedAtColumn   = mysql.TimestampColumn("deleted_at")
-		NameColumn        = mysql.StringColumn("name")
-		SortKeyColumn     = mysql.StringColumn("sort_key")
-		DescriptionColumn = mysql.StringColumn("description")
-		JobColumn         = mysql.StringColumn("job")
-		ColorColumn       = mysql.StringColumn("color")
-		IconColumn        = mysql.StringColumn("icon")
-		allColumns        = mysql.ColumnList{IDColumn, CreatedAtColumn, DeletedAtColumn, NameColumn, SortKeyColumn, DescriptionColumn, JobColumn, ColorColumn, IconColumn}
-		mutableColumns    = mysql.ColumnList{CreatedAtColumn, DeletedAtColumn, NameColumn, SortKeyColumn, DescriptionColumn, JobColumn, ColorColumn, IconColumn}
-		defaultColumns    = mysql.ColumnList{CreatedAtColumn}
+		IDColumn              = mysql.IntegerColumn("id")
+		CreatedAtColumn       = mysql.TimestampColumn("created_at")
+		DeletedAtColumn       = mysql.TimestampColumn("deleted_at")
+		NameColumn            = mysql.StringColumn("name")
+		SortKeyColumn         = mysql.StringColumn("sort_key")
+		DescriptionColumn     = mysql.StringColumn("description")
+		JobColumn             = mysql.StringColumn("job")
+		ColorColumn           = mysql.StringColumn("color")
+		IconColumn            = mysql.StringColumn("icon")
+		StateMachineColumn    = mysql.StringColumn("state_machine")
+		RetentionPolicyColumn = mysql.StringColumn("retention_policy")
+		allColumns            = mysql.ColumnList{IDColumn, CreatedAtColumn, DeletedAtColumn, NameColumn, SortKeyColumn, DescriptionColumn, JobColumn, ColorColumn, IconColumn, StateMachineColumn, RetentionPolicyColumn}
+		mutableColumns        = mysql.ColumnList{CreatedAtColumn, DeletedAtColumn, NameColumn, SortKeyColumn, DescriptionColumn, JobColumn, ColorColumn, IconColumn, StateMachineColumn, RetentionPolicyColumn}
+		defaultColumns        = mysql.ColumnList{CreatedAtColumn}
 	)
 
 	return fivenetDocumentsCategoriesTable{
 		Table: mysql.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:          IDColumn,
-		CreatedAt:   CreatedAtColumn,
-		DeletedAt:   DeletedAtColumn,
-		Name:        NameColumn,
-		SortKey:     SortKeyColumn,
-		Description: DescriptionColumn,
-		Job:         JobColumn,
-		Color:       ColorColumn,
-		Icon:        IconColumn,
+		ID:              IDColumn,
+		CreatedAt:       CreatedAtColumn,
+		DeletedAt:       DeletedAtColumn,
+		Name:            NameColumn,
+		SortKey:         SortKeyColumn,
+		Description:     DescriptionColumn,
+		Job:             JobColumn,
+		Color:           ColorColumn,
+		Icon:            IconColumn,
+		StateMachine:    StateMachineColumn,
+		RetentionPolicy: RetentionPolicyColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -17,14 +17,15 @@ type fivenetJobColleaguePropsTable struct {
 	mysql.Table
 
 	// Columns
-	UserID       mysql.ColumnInteger
-	Job          mysql.ColumnString
-	DeletedAt    mysql.ColumnTimestamp
-	AbsenceBegin mysql.ColumnDate
-	AbsenceEnd   mysql.ColumnDate
-	Note         mysql.ColumnString
-	NamePrefix   mysql.ColumnString
-	NameSuffix   mysql.ColumnString
+	UserID            mysql.ColumnInteger
+	Job               mysql.ColumnString
+	DeletedAt         mysql.ColumnTimestamp
+	AbsenceBegin      mysql.ColumnDate
+	AbsenceEnd        mysql.ColumnDate
+	AbsenceDelegateID mysql.ColumnInteger
+	Note              mysql.ColumnString
+	NamePrefix        mysql.ColumnString
+	NameSuffix        mysql.ColumnString
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -66,31 +67,33 @@ func newFivenetJobColleaguePropsTable(schemaName, tableName, alias string) *Five
 
 func newFivenetJobColleaguePropsTableImpl(schemaName, tableName, alias string) fivenetJobColleaguePropsTable {
 	var (
-		UserIDColumn       = mysql.IntegerColumn("user_id")
-		JobColumn          = mysql.StringColumn("job")
-		DeletedAtColumn    = mysql.TimestampColumn("deleted_at")
-		AbsenceBeginColumn = mysql.DateColumn("absence_begin")
-		AbsenceEndColumn   = mysql.DateColumn("absence_end")
-		NoteColumn         = mysql.StringColumn("note")
-		NamePrefixColumn   = mysql.StringColumn("name_prefix")
-		NameSuffixColumn   = mysql.StringColumn("name_suffix")
-		allColumns         = mysql.ColumnList{UserIDColumn, JobColumn, DeletedAtColumn, AbsenceBeginColumn, AbsenceEndColumn, NoteColumn, NamePrefixColumn, NameSuffixColumn}
-		mutableColumns     = mysql.ColumnList{UserIDColumn, JobColumn, DeletedAtColumn, AbsenceBeginColumn, AbsenceEndColumn, NoteColumn, NamePrefixColumn, NameSuffixColumn}
-		defaultColumns     = mysql.ColumnList{}
+		UserIDColumn            = mysql.IntegerColumn("user_id")
+		JobColumn               = mysql.StringColumn("job")
+		DeletedAtColumn         = mysql.TimestampColumn("deleted_at")
+		AbsenceBeginColumn      = mysql.DateColumn("absence_begin")
+		AbsenceEndColumn        = mysql.DateColumn("absence_end")
+		AbsenceDelegateIDColumn = mysql.IntegerColumn("absence_delegate_id")
+		NoteColumn              = mysql.StringColumn("note")
+		NamePrefixColumn        = mysql.StringColumn("name_prefix")
+		NameSuffixColumn        = mysql.StringColumn("name_suffix")
+		allColumns              = mysql.ColumnList{UserIDColumn, JobColumn, DeletedAtColumn, AbsenceBeginColumn, AbsenceEndColumn, AbsenceDelegateIDColumn, NoteColumn, NamePrefixColumn, NameSuffixColumn}
+		mutableColumns          = mysql.ColumnList{UserIDColumn, JobColumn, DeletedAtColumn, AbsenceBeginColumn, AbsenceEndColumn, AbsenceDelegateIDColumn, NoteColumn, NamePrefixColumn, NameSuffixColumn}
+		defaultColumns          = mysql.ColumnList{}
 	)
 
 	return fivenetJobColleaguePropsTable{
 		Table: mysql.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		UserID:       UserIDColumn,
-		Job:          JobColumn,
-		DeletedAt:    DeletedAtColumn,
-		AbsenceBegin: AbsenceBeginColumn,
-		AbsenceEnd:   AbsenceEndColumn,
-		Note:         NoteColumn,
-		NamePrefix:   NamePrefixColumn,
-		NameSuffix:   NameSuffixColumn,
+		UserID:            UserIDColumn,
+		Job:               JobColumn,
+		DeletedAt:         DeletedAtColumn,
+		AbsenceBegin:      AbsenceBeginColumn,
+		AbsenceEnd:        AbsenceEndColumn,
+		AbsenceDelegateID: AbsenceDelegateIDColumn,
+		Note:              NoteColumn,
+		NamePrefix:        NamePrefixColumn,
+		NameSuffix:        NameSuffixColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -249,6 +249,8 @@ type FivenetJobGroupRuleQualificationsTable struct {
 	RuleID                mysql.ColumnInteger
 	QualificationRuleType mysql.ColumnInteger
 	RequireCompleted      mysql.ColumnBool
+	TrainingPathID        mysql.ColumnInteger
+	PromotionReady        mysql.ColumnBool
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -267,16 +269,26 @@ func newFivenetJobGroupRuleQualificationsTable(schemaName, tableName, alias stri
 	ruleID := mysql.IntegerColumn("rule_id")
 	qualificationRuleType := mysql.IntegerColumn("qualification_rule_type")
 	requireCompleted := mysql.BoolColumn("require_completed")
-	allColumns := mysql.ColumnList{ruleID, qualificationRuleType, requireCompleted}
+	trainingPathID := mysql.IntegerColumn("training_path_id")
+	promotionReady := mysql.BoolColumn("promotion_ready")
+	allColumns := mysql.ColumnList{
+		ruleID,
+		qualificationRuleType,
+		requireCompleted,
+		trainingPathID,
+		promotionReady,
+	}
 
 	return &FivenetJobGroupRuleQualificationsTable{
 		Table:                 mysql.NewTable(schemaName, tableName, alias, allColumns...),
 		RuleID:                ruleID,
 		QualificationRuleType: qualificationRuleType,
 		RequireCompleted:      requireCompleted,
+		TrainingPathID:        trainingPathID,
+		PromotionReady:        promotionReady,
 		AllColumns:            allColumns,
 		MutableColumns:        allColumns,
-		DefaultColumns:        mysql.ColumnList{requireCompleted},
+		DefaultColumns:        mysql.ColumnList{requireCompleted, promotionReady},
 	}
 }
 
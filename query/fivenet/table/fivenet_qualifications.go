@@ -39,6 +39,8 @@ type fivenetQualificationsTable struct {
 	ExamSettings       mysql.ColumnString
 	LabelSyncEnabled   mysql.ColumnBool
 	LabelSyncFormat    mysql.ColumnString
+	ValidityDays       mysql.ColumnInteger
+	GracePeriodDays    mysql.ColumnInteger
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -102,8 +104,10 @@ func newFivenetQualificationsTableImpl(schemaName, tableName, alias string) five
 		ExamSettingsColumn       = mysql.StringColumn("exam_settings")
 		LabelSyncEnabledColumn   = mysql.BoolColumn("label_sync_enabled")
 		LabelSyncFormatColumn    = mysql.StringColumn("label_sync_format")
-		allColumns               = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, JobColumn, WeightColumn, ClosedColumn, DraftColumn, PublicColumn, AbbreviationColumn, TitleColumn, DescriptionColumn, ContentTypeColumn, ContentColumn, CreatorIDColumn, CreatorJobColumn, DiscordSyncEnabledColumn, DiscordSettingsColumn, ExamModeColumn, ExamSettingsColumn, LabelSyncEnabledColumn, LabelSyncFormatColumn}
-		mutableColumns           = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, JobColumn, WeightColumn, ClosedColumn, DraftColumn, PublicColumn, AbbreviationColumn, TitleColumn, DescriptionColumn, ContentTypeColumn, ContentColumn, CreatorIDColumn, CreatorJobColumn, DiscordSyncEnabledColumn, DiscordSettingsColumn, ExamModeColumn, ExamSettingsColumn, LabelSyncEnabledColumn, LabelSyncFormatColumn}
+		ValidityDaysColumn       = mysql.IntegerColumn("validity_days")
+		GracePeriodDaysColumn    = mysql.IntegerColumn("grace_period_days")
+		allColumns               = mysql.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, JobColumn, WeightColumn, ClosedColumn, DraftColumn, PublicColumn, AbbreviationColumn, TitleColumn, DescriptionColumn, ContentTypeColumn, ContentColumn, CreatorIDColumn, CreatorJobColumn, DiscordSyncEnabledColumn, DiscordSettingsColumn, ExamModeColumn, ExamSettingsColumn, LabelSyncEnabledColumn, LabelSyncFormatColumn, ValidityDaysColumn, GracePeriodDaysColumn}
+		mutableColumns           = mysql.ColumnList{CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, JobColumn, WeightColumn, ClosedColumn, DraftColumn, PublicColumn, AbbreviationColumn, TitleColumn, DescriptionColumn, ContentTypeColumn, ContentColumn, CreatorIDColumn, CreatorJobColumn, DiscordSyncEnabledColumn, DiscordSettingsColumn, ExamModeColumn, ExamSettingsColumn, LabelSyncEnabledColumn, LabelSyncFormatColumn, ValidityDaysColumn, GracePeriodDaysColumn}
 		defaultColumns           = mysql.ColumnList{CreatedAtColumn, WeightColumn, ClosedColumn, DraftColumn, PublicColumn, DiscordSyncEnabledColumn, ExamModeColumn, LabelSyncEnabledColumn}
 	)
 
@@ -133,6 +137,8 @@ func newFivenetQualificationsTableImpl(schemaName, tableName, alias string) five
 		ExamSettings:       ExamSettingsColumn,
 		LabelSyncEnabled:   LabelSyncEnabledColumn,
 		LabelSyncFormat:    LabelSyncFormatColumn,
+		ValidityDays:       ValidityDaysColumn,
+		GracePeriodDays:    GracePeriodDaysColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
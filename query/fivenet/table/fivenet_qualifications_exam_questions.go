@@ -25,6 +25,7 @@ type fivenetQualificationsExamQuestionsTable struct {
 	Description     mysql.ColumnString
 	Data            mysql.ColumnString
 	Answer          mysql.ColumnString
+	Tags            mysql.ColumnString
 	Points          mysql.ColumnInteger
 	Order           mysql.ColumnInteger
 
@@ -76,10 +77,11 @@ func newFivenetQualificationsExamQuestionsTableImpl(schemaName, tableName, alias
 		DescriptionColumn     = mysql.StringColumn("description")
 		DataColumn            = mysql.StringColumn("data")
 		AnswerColumn          = mysql.StringColumn("answer")
+		TagsColumn            = mysql.StringColumn("tags")
 		PointsColumn          = mysql.IntegerColumn("points")
 		OrderColumn           = mysql.IntegerColumn("order")
-		allColumns            = mysql.ColumnList{IDColumn, QualificationIDColumn, CreatedAtColumn, UpdatedAtColumn, TitleColumn, DescriptionColumn, DataColumn, AnswerColumn, PointsColumn, OrderColumn}
-		mutableColumns        = mysql.ColumnList{QualificationIDColumn, CreatedAtColumn, UpdatedAtColumn, TitleColumn, DescriptionColumn, DataColumn, AnswerColumn, PointsColumn, OrderColumn}
+		allColumns            = mysql.ColumnList{IDColumn, QualificationIDColumn, CreatedAtColumn, UpdatedAtColumn, TitleColumn, DescriptionColumn, DataColumn, AnswerColumn, TagsColumn, PointsColumn, OrderColumn}
+		mutableColumns        = mysql.ColumnList{QualificationIDColumn, CreatedAtColumn, UpdatedAtColumn, TitleColumn, DescriptionColumn, DataColumn, AnswerColumn, TagsColumn, PointsColumn, OrderColumn}
 		defaultColumns        = mysql.ColumnList{CreatedAtColumn, PointsColumn, OrderColumn}
 	)
 
@@ -95,6 +97,7 @@ func newFivenetQualificationsExamQuestionsTableImpl(schemaName, tableName, alias
 		Description:     DescriptionColumn,
 		Data:            DataColumn,
 		Answer:          AnswerColumn,
+		Tags:            TagsColumn,
 		Points:          PointsColumn,
 		Order:           OrderColumn,
 
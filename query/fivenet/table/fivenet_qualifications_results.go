@@ -17,16 +17,19 @@ type fivenetQualificationsResultsTable struct {
 	mysql.Table
 
 	// Columns
-	ID              mysql.ColumnInteger
-	CreatedAt       mysql.ColumnTimestamp
-	DeletedAt       mysql.ColumnTimestamp
-	QualificationID mysql.ColumnInteger
-	UserID          mysql.ColumnInteger
-	Status          mysql.ColumnInteger
-	Score           mysql.ColumnFloat
-	Summary         mysql.ColumnString
-	CreatorID       mysql.ColumnInteger
-	CreatorJob      mysql.ColumnString
+	ID                   mysql.ColumnInteger
+	CreatedAt            mysql.ColumnTimestamp
+	DeletedAt            mysql.ColumnTimestamp
+	QualificationID      mysql.ColumnInteger
+	UserID               mysql.ColumnInteger
+	Status               mysql.ColumnInteger
+	Score                mysql.ColumnFloat
+	Summary              mysql.ColumnString
+	SuccessfulAt         mysql.ColumnTimestamp
+	ExpiresAt            mysql.ColumnTimestamp
+	ExpiryReminderSentAt mysql.ColumnTimestamp
+	CreatorID            mysql.ColumnInteger
+	CreatorJob           mysql.ColumnString
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -68,35 +71,41 @@ func newFivenetQualificationsResultsTable(schemaName, tableName, alias string) *
 
 func newFivenetQualificationsResultsTableImpl(schemaName, tableName, alias string) fivenetQualificationsResultsTable {
 	var (
-		IDColumn              = mysql.IntegerColumn("id")
-		CreatedAtColumn       = mysql.TimestampColumn("created_at")
-		DeletedAtColumn       = mysql.TimestampColumn("deleted_at")
-		QualificationIDColumn = mysql.IntegerColumn("qualification_id")
-		UserIDColumn          = mysql.IntegerColumn("user_id")
-		StatusColumn          = mysql.IntegerColumn("status")
-		ScoreColumn           = mysql.FloatColumn("score")
-		SummaryColumn         = mysql.StringColumn("summary")
-		CreatorIDColumn       = mysql.IntegerColumn("creator_id")
-		CreatorJobColumn      = mysql.StringColumn("creator_job")
-		allColumns            = mysql.ColumnList{IDColumn, CreatedAtColumn, DeletedAtColumn, QualificationIDColumn, UserIDColumn, StatusColumn, ScoreColumn, SummaryColumn, CreatorIDColumn, CreatorJobColumn}
-		mutableColumns        = mysql.ColumnList{CreatedAtColumn, DeletedAtColumn, QualificationIDColumn, UserIDColumn, StatusColumn, ScoreColumn, SummaryColumn, CreatorIDColumn, CreatorJobColumn}
-		defaultColumns        = mysql.ColumnList{CreatedAtColumn, StatusColumn}
+		IDColumn                   = mysql.IntegerColumn("id")
+		CreatedAtColumn            = mysql.TimestampColumn("created_at")
+		DeletedAtColumn            = mysql.TimestampColumn("deleted_at")
+		QualificationIDColumn      = mysql.IntegerColumn("qualification_id")
+		UserIDColumn               = mysql.IntegerColumn("user_id")
+		StatusColumn               = mysql.IntegerColumn("status")
+		ScoreColumn                = mysql.FloatColumn("score")
+		SummaryColumn              = mysql.StringColumn("summary")
+		SuccessfulAtColumn         = mysql.TimestampColumn("successful_at")
+		ExpiresAtColumn            = mysql.TimestampColumn("expires_at")
+		ExpiryReminderSentAtColumn = mysql.TimestampColumn("expiry_reminder_sent_at")
+		CreatorIDColumn            = mysql.IntegerColumn("creator_id")
+		CreatorJobColumn           = mysql.StringColumn("creator_job")
+		allColumns                 = mysql.ColumnList{IDColumn, CreatedAtColumn, DeletedAtColumn, QualificationIDColumn, UserIDColumn, StatusColumn, ScoreColumn, SummaryColumn, SuccessfulAtColumn, ExpiresAtColumn, ExpiryReminderSentAtColumn, CreatorIDColumn, CreatorJobColumn}
+		mutableColumns             = mysql.ColumnList{CreatedAtColumn, DeletedAtColumn, QualificationIDColumn, UserIDColumn, StatusColumn, ScoreColumn, SummaryColumn, SuccessfulAtColumn, ExpiresAtColumn, ExpiryReminderSentAtColumn, CreatorIDColumn, CreatorJobColumn}
+		defaultColumns             = mysql.ColumnList{CreatedAtColumn, StatusColumn}
 	)
 
 	return fivenetQualificationsResultsTable{
 		Table: mysql.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:              IDColumn,
-		CreatedAt:       CreatedAtColumn,
-		DeletedAt:       DeletedAtColumn,
-		QualificationID: QualificationIDColumn,
-		UserID:          UserIDColumn,
-		Status:          StatusColumn,
-		Score:           ScoreColumn,
-		Summary:         SummaryColumn,
-		CreatorID:       CreatorIDColumn,
-		CreatorJob:      CreatorJobColumn,
+		ID:                   IDColumn,
+		CreatedAt:            CreatedAtColumn,
+		DeletedAt:            DeletedAtColumn,
+		QualificationID:      QualificationIDColumn,
+		UserID:               UserIDColumn,
+		Status:               StatusColumn,
+		Score:                ScoreColumn,
+		Summary:              SummaryColumn,
+		SuccessfulAt:         SuccessfulAtColumn,
+		ExpiresAt:            ExpiresAtColumn,
+		ExpiryReminderSentAt: ExpiryReminderSentAtColumn,
+		CreatorID:            CreatorIDColumn,
+		CreatorJob:           CreatorJobColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -30,25 +30,33 @@ func UseSchema(schema string) {
 	FivenetCentrumDispatchers = FivenetCentrumDispatchers.FromSchema(schema)
 	FivenetCentrumDispatches = FivenetCentrumDispatches.FromSchema(schema)
 	FivenetCentrumDispatchesAsgmts = FivenetCentrumDispatchesAsgmts.FromSchema(schema)
+	FivenetCentrumDispatchesEscalations = FivenetCentrumDispatchesEscalations.FromSchema(schema)
 	FivenetCentrumDispatchesHeatmaps = FivenetCentrumDispatchesHeatmaps.FromSchema(schema)
 	FivenetCentrumDispatchesStatus = FivenetCentrumDispatchesStatus.FromSchema(schema)
+	FivenetCentrumDispatchesTemplates = FivenetCentrumDispatchesTemplates.FromSchema(schema)
 	FivenetCentrumJobAccess = FivenetCentrumJobAccess.FromSchema(schema)
 	FivenetCentrumMarkers = FivenetCentrumMarkers.FromSchema(schema)
 	FivenetCentrumSettings = FivenetCentrumSettings.FromSchema(schema)
 	FivenetCentrumUnits = FivenetCentrumUnits.FromSchema(schema)
 	FivenetCentrumUnitsAccess = FivenetCentrumUnitsAccess.FromSchema(schema)
+	FivenetCentrumUnitsRosters = FivenetCentrumUnitsRosters.FromSchema(schema)
+	FivenetCentrumUnitsRostersUsers = FivenetCentrumUnitsRostersUsers.FromSchema(schema)
 	FivenetCentrumUnitsStatus = FivenetCentrumUnitsStatus.FromSchema(schema)
 	FivenetCentrumUnitsUsers = FivenetCentrumUnitsUsers.FromSchema(schema)
 	FivenetCentrumUserLocations = FivenetCentrumUserLocations.FromSchema(schema)
+	FivenetCentrumUserLocationsHistory = FivenetCentrumUserLocationsHistory.FromSchema(schema)
 	FivenetConfig = FivenetConfig.FromSchema(schema)
 	FivenetDocuments = FivenetDocuments.FromSchema(schema)
 	FivenetDocumentsAccess = FivenetDocumentsAccess.FromSchema(schema)
 	FivenetDocumentsActivity = FivenetDocumentsActivity.FromSchema(schema)
 	FivenetDocumentsApprovalPolicies = FivenetDocumentsApprovalPolicies.FromSchema(schema)
+	FivenetDocumentsApprovalPolicyTemplates = FivenetDocumentsApprovalPolicyTemplates.FromSchema(schema)
 	FivenetDocumentsApprovalTasks = FivenetDocumentsApprovalTasks.FromSchema(schema)
 	FivenetDocumentsApprovals = FivenetDocumentsApprovals.FromSchema(schema)
+	FivenetDocumentsBulkJobs = FivenetDocumentsBulkJobs.FromSchema(schema)
 	FivenetDocumentsCategories = FivenetDocumentsCategories.FromSchema(schema)
 	FivenetDocumentsComments = FivenetDocumentsComments.FromSchema(schema)
+	FivenetDocumentsDispatchTimelines = FivenetDocumentsDispatchTimelines.FromSchema(schema)
 	FivenetDocumentsFiles = FivenetDocumentsFiles.FromSchema(schema)
 	FivenetDocumentsMeta = FivenetDocumentsMeta.FromSchema(schema)
 	FivenetDocumentsPins = FivenetDocumentsPins.FromSchema(schema)
@@ -63,6 +71,7 @@ func UseSchema(schema string) {
 	FivenetDocumentsTemplates = FivenetDocumentsTemplates.FromSchema(schema)
 	FivenetDocumentsTemplatesAccess = FivenetDocumentsTemplatesAccess.FromSchema(schema)
 	FivenetDocumentsTemplatesVisibilitySubject = FivenetDocumentsTemplatesVisibilitySubject.FromSchema(schema)
+	FivenetDocumentsVersions = FivenetDocumentsVersions.FromSchema(schema)
 	FivenetDocumentsVisibilityCreator = FivenetDocumentsVisibilityCreator.FromSchema(schema)
 	FivenetDocumentsVisibilityPublic = FivenetDocumentsVisibilityPublic.FromSchema(schema)
 	FivenetDocumentsVisibilitySubject = FivenetDocumentsVisibilitySubject.FromSchema(schema)
@@ -76,6 +85,10 @@ func UseSchema(schema string) {
 	FivenetJobConductFiles = FivenetJobConductFiles.FromSchema(schema)
 	FivenetJobGroupActivity = FivenetJobGroupActivity.FromSchema(schema)
 	FivenetJobGroupsAccess = FivenetJobGroupsAccess.FromSchema(schema)
+	FivenetJobTimeclockCorrections = FivenetJobTimeclockCorrections.FromSchema(schema)
+	FivenetQualificationsExamLog = FivenetQualificationsExamLog.FromSchema(schema)
+	FivenetQualificationsTrainingPathSteps = FivenetQualificationsTrainingPathSteps.FromSchema(schema)
+	FivenetQualificationsTrainingPaths = FivenetQualificationsTrainingPaths.FromSchema(schema)
 	FivenetJobGroupLeaders = FivenetJobGroupLeaders.FromSchema(schema)
 	FivenetJobGroupManualMembers = FivenetJobGroupManualMembers.FromSchema(schema)
 	FivenetJobGroupMemberExclusions = FivenetJobGroupMemberExclusions.FromSchema(schema)
@@ -126,6 +139,7 @@ func UseSchema(schema string) {
 	FivenetRbacRoles = FivenetRbacRoles.FromSchema(schema)
 	FivenetRbacRolesAttrs = FivenetRbacRolesAttrs.FromSchema(schema)
 	FivenetRbacRolesPermissions = FivenetRbacRolesPermissions.FromSchema(schema)
+	FivenetSearchIndex = FivenetSearchIndex.FromSchema(schema)
 	FivenetStatsDailyRollup = FivenetStatsDailyRollup.FromSchema(schema)
 	FivenetSyncUser = FivenetSyncUser.FromSchema(schema)
 	FivenetUser = FivenetUser.FromSchema(schema)
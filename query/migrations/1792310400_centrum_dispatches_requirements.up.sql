@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE `fivenet_centrum_dispatches`
+  ADD COLUMN `requirements` varchar(1024) NULL DEFAULT NULL AFTER `references`;
+
+COMMIT;
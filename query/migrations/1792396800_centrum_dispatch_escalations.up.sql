@@ -0,0 +1,19 @@
+BEGIN;
+
+-- Table: fivenet_centrum_dispatches_escalations
+CREATE TABLE IF NOT EXISTS `fivenet_centrum_dispatches_escalations` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `dispatch_id` bigint(20) unsigned NOT NULL,
+  `job` varchar(20) NOT NULL,
+  `step` smallint(2) NOT NULL,
+  `action` smallint(2) NOT NULL,
+  `target_job` varchar(20) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_fivenet_centrum_dispatches_escalations_unique` (`dispatch_id`, `job`, `step`),
+  KEY `idx_fivenet_centrum_dispatches_escalations_job_action` (`job`, `action`, `created_at`),
+  CONSTRAINT `fk_fivenet_centrum_dispatches_escalations_dispatch_id` FOREIGN KEY (`dispatch_id`) REFERENCES `fivenet_centrum_dispatches` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS `fivenet_centrum_units_rosters_users`;
+DROP TABLE IF EXISTS `fivenet_centrum_units_rosters`;
+
+COMMIT;
@@ -0,0 +1,41 @@
+BEGIN;
+
+-- Table: fivenet_centrum_units_rosters
+CREATE TABLE IF NOT EXISTS `fivenet_centrum_units_rosters` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` datetime(3) DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP(3),
+  `job` varchar(20) NOT NULL,
+  `unit_id` bigint(20) unsigned NOT NULL,
+  `start_time` datetime(3) NOT NULL,
+  `end_time` datetime(3) NOT NULL,
+  `calendar_entry_id` bigint(20) unsigned DEFAULT NULL,
+  `creator_id` int(11) DEFAULT NULL,
+  `started_at` datetime(3) DEFAULT NULL,
+  `ended_at` datetime(3) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_fivenet_centrum_units_rosters_calendar_entry` (`unit_id`, `calendar_entry_id`),
+  KEY `idx_fivenet_centrum_units_rosters_job_time` (`job`, `start_time`, `end_time`),
+  KEY `idx_fivenet_centrum_units_rosters_ended_at` (`ended_at`, `start_time`),
+  CONSTRAINT `fk_fivenet_centrum_units_rosters_unit_id` FOREIGN KEY (`unit_id`) REFERENCES `fivenet_centrum_units` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_centrum_units_rosters_calendar_entry_id` FOREIGN KEY (`calendar_entry_id`) REFERENCES `fivenet_calendar_entries` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL,
+  CONSTRAINT `fk_fivenet_centrum_units_rosters_creator_id` FOREIGN KEY (`creator_id`) REFERENCES `fivenet_user` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL
+) ENGINE=InnoDB;
+
+-- Table: fivenet_centrum_units_rosters_users
+CREATE TABLE IF NOT EXISTS `fivenet_centrum_units_rosters_users` (
+  `roster_id` bigint(20) unsigned NOT NULL,
+  `user_id` int(11) NOT NULL,
+  `assigned_at` datetime(3) DEFAULT NULL,
+  PRIMARY KEY (`roster_id`, `user_id`),
+  KEY `idx_fivenet_centrum_units_rosters_users_user_id` (`user_id`),
+  CONSTRAINT `fk_fivenet_centrum_units_rosters_users_roster_id` FOREIGN KEY (`roster_id`) REFERENCES `fivenet_centrum_units_rosters` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_centrum_units_rosters_users_user_id` FOREIGN KEY (`user_id`) REFERENCES `fivenet_user` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- Table: fivenet_centrum_dispatches_templates
+CREATE TABLE IF NOT EXISTS `fivenet_centrum_dispatches_templates` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` datetime(3) DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP(3),
+  `job` varchar(20) NOT NULL,
+  `code` varchar(20) NOT NULL,
+  `message` varchar(255) NOT NULL,
+  `description` varchar(1024) DEFAULT NULL,
+  `attributes` varchar(2048) DEFAULT NULL,
+  `requirements` varchar(1024) DEFAULT NULL,
+  `jobs` json DEFAULT NULL,
+  `creator_id` int(11) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_fivenet_centrum_dispatches_templates_job_code` (`job`, `code`),
+  CONSTRAINT `chk_fivenet_centrum_dispatches_templates_jobs` CHECK (`jobs` IS NULL OR json_valid(`jobs`)),
+  CONSTRAINT `fk_fivenet_centrum_dispatches_templates_creator_id` FOREIGN KEY (`creator_id`) REFERENCES `fivenet_user` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL
+) ENGINE=InnoDB;
+
+COMMIT;
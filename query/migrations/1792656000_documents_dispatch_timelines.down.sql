@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS `fivenet_documents_dispatch_timelines`;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- Table: fivenet_documents_dispatch_timelines
+CREATE TABLE IF NOT EXISTS `fivenet_documents_dispatch_timelines` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `document_id` bigint(20) unsigned NOT NULL,
+  `dispatch_id` bigint(20) unsigned NOT NULL,
+  `creator_id` int(11) DEFAULT NULL,
+  `timeline` longtext NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_documents_dispatch_timelines_deleted_at` (`deleted_at`),
+  KEY `idx_fivenet_documents_dispatch_timelines_document_id` (`document_id`),
+  KEY `idx_fivenet_documents_dispatch_timelines_dispatch_id` (`dispatch_id`),
+  CONSTRAINT `chk_fivenet_documents_dispatch_timelines_timeline` CHECK (json_valid(`timeline`)),
+  CONSTRAINT `fk_fivenet_documents_dispatch_timelines_document_id` FOREIGN KEY (`document_id`) REFERENCES `fivenet_documents` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_documents_dispatch_timelines_creator_id` FOREIGN KEY (`creator_id`) REFERENCES `fivenet_user` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL
+) ENGINE=InnoDB;
+
+COMMIT;
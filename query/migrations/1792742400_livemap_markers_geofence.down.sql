@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE `fivenet_centrum_markers`
+  DROP COLUMN `geofence`;
+
+COMMIT;
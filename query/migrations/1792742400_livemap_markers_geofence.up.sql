@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE `fivenet_centrum_markers`
+  ADD COLUMN `geofence` varchar(255) NULL DEFAULT NULL AFTER `marker_data`;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS `fivenet_centrum_user_locations_history`;
+
+COMMIT;
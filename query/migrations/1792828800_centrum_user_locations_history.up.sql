@@ -0,0 +1,21 @@
+BEGIN;
+
+-- Table: fivenet_centrum_user_locations_history
+CREATE TABLE IF NOT EXISTS `fivenet_centrum_user_locations_history` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `user_id` int(11) NOT NULL,
+  `job` varchar(20) NOT NULL,
+  `job_grade` int(11) NOT NULL DEFAULT 0,
+  `unit_id` bigint(20) unsigned DEFAULT NULL,
+  `x` decimal(24,14) NOT NULL,
+  `y` decimal(24,14) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_centrum_user_locations_history_created_at` (`created_at`),
+  KEY `idx_fivenet_centrum_user_locations_history_user_id_created_at` (`user_id`, `created_at`),
+  KEY `idx_fivenet_centrum_user_locations_history_unit_id_created_at` (`unit_id`, `created_at`),
+  CONSTRAINT `fk_fivenet_centrum_user_locations_history_user_id` FOREIGN KEY (`user_id`) REFERENCES `fivenet_user` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB;
+
+COMMIT;
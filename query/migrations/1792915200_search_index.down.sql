@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS `fivenet_search_index`;
+
+COMMIT;
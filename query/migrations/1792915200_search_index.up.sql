@@ -0,0 +1,20 @@
+BEGIN;
+
+-- Table: fivenet_search_index
+CREATE TABLE IF NOT EXISTS `fivenet_search_index` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  `type` smallint(2) NOT NULL,
+  `target_id` bigint(20) unsigned NOT NULL DEFAULT 0,
+  `target_key` varchar(64) NOT NULL,
+  `job` varchar(50) DEFAULT NULL,
+  `title` varchar(255) NOT NULL,
+  `content` mediumtext NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_fivenet_search_index_type_target_key` (`type`, `target_key`),
+  KEY `idx_fivenet_search_index_updated_at` (`updated_at`),
+  FULLTEXT KEY `idx_fivenet_search_index_title` (`title`),
+  FULLTEXT KEY `idx_fivenet_search_index_content` (`content`)
+) ENGINE=InnoDB;
+
+COMMIT;
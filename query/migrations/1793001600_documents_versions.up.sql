@@ -0,0 +1,24 @@
+BEGIN;
+
+-- Table: fivenet_documents_versions
+CREATE TABLE IF NOT EXISTS `fivenet_documents_versions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),
+  `document_id` bigint(20) unsigned NOT NULL,
+  `creator_id` int(11) DEFAULT NULL,
+  `creator_job` varchar(20) NOT NULL,
+  `title` varchar(255) NOT NULL,
+  `content_json` longtext NOT NULL,
+  `data` longtext DEFAULT NULL,
+  `contributors` longtext DEFAULT NULL,
+  `restored_from_id` bigint(20) unsigned DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_documents_versions_document_id` (`document_id`),
+  KEY `idx_fivenet_documents_versions_creator_id` (`creator_id`),
+  CONSTRAINT `fk_fivenet_documents_versions_document_id` FOREIGN KEY (`document_id`) REFERENCES `fivenet_documents` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_documents_versions_creator_id` FOREIGN KEY (`creator_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL
+) ENGINE=InnoDB;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Table: fivenet_documents
+ALTER TABLE `fivenet_documents`
+  DROP COLUMN `locked`;
+
+-- Table: fivenet_documents_categories
+ALTER TABLE `fivenet_documents_categories`
+  DROP COLUMN `state_machine`;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Table: fivenet_documents_categories
+ALTER TABLE `fivenet_documents_categories`
+  ADD COLUMN `state_machine` longtext DEFAULT NULL AFTER `icon`;
+
+-- Table: fivenet_documents
+ALTER TABLE `fivenet_documents`
+  ADD COLUMN `locked` tinyint(1) DEFAULT '0' AFTER `public`;
+
+COMMIT;
@@ -0,0 +1,29 @@
+BEGIN;
+
+-- Table: fivenet_documents_bulk_jobs
+CREATE TABLE IF NOT EXISTS `fivenet_documents_bulk_jobs` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` datetime(3) DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP(3),
+  `started_at` datetime(3) DEFAULT NULL,
+  `completed_at` datetime(3) DEFAULT NULL,
+  `job` varchar(20) NOT NULL,
+  `creator_id` int(11) NOT NULL,
+  `status` smallint(2) NOT NULL DEFAULT 1,
+  `selector` longtext NOT NULL,
+  `operation` longtext NOT NULL,
+  `total_count` bigint(20) NOT NULL DEFAULT 0,
+  `processed_count` bigint(20) NOT NULL DEFAULT 0,
+  `succeeded_count` bigint(20) NOT NULL DEFAULT 0,
+  `failed_count` bigint(20) NOT NULL DEFAULT 0,
+  `last_document_id` bigint(20) unsigned NOT NULL DEFAULT 0,
+  `error` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_documents_bulk_jobs_job` (`job`),
+  KEY `idx_fivenet_documents_bulk_jobs_status` (`status`),
+  KEY `idx_fivenet_documents_bulk_jobs_creator_id` (`creator_id`),
+  CONSTRAINT `fk_fivenet_documents_bulk_jobs_creator_id` FOREIGN KEY (`creator_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB;
+
+COMMIT;
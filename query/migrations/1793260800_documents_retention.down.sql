@@ -0,0 +1,12 @@
+BEGIN;
+
+-- Table: fivenet_documents
+ALTER TABLE `fivenet_documents`
+  DROP COLUMN `archived_at`,
+  DROP COLUMN `legal_hold`;
+
+-- Table: fivenet_documents_categories
+ALTER TABLE `fivenet_documents_categories`
+  DROP COLUMN `retention_policy`;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- Table: fivenet_documents_categories
+ALTER TABLE `fivenet_documents_categories`
+  ADD COLUMN `retention_policy` longtext DEFAULT NULL AFTER `state_machine`;
+
+-- Table: fivenet_documents
+ALTER TABLE `fivenet_documents`
+  ADD COLUMN `legal_hold` tinyint(1) DEFAULT '0' AFTER `locked`,
+  ADD COLUMN `archived_at` datetime(3) DEFAULT NULL AFTER `legal_hold`;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+-- Table: fivenet_documents_approval_policies
+ALTER TABLE `fivenet_documents_approval_policies`
+  DROP COLUMN `current_stage`,
+  DROP COLUMN `policy_template_id`;
+
+DROP TABLE IF EXISTS `fivenet_documents_approval_policy_templates`;
+
+COMMIT;
@@ -0,0 +1,39 @@
+BEGIN;
+
+-- Table: fivenet_documents_approval_policy_templates
+CREATE TABLE IF NOT EXISTS `fivenet_documents_approval_policy_templates` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` datetime(3) DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP(3),
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `job` varchar(20) NOT NULL,
+  `creator_id` int(11) DEFAULT NULL,
+  `name` varchar(128) NOT NULL,
+  `description` varchar(255) DEFAULT NULL,
+  `category_id` bigint(20) unsigned DEFAULT NULL,
+  `template_id` bigint(20) unsigned DEFAULT NULL,
+  `trigger_state` varchar(32) DEFAULT NULL,
+  `rule_kind` smallint(2) NOT NULL DEFAULT 1,
+  `on_edit_behavior` smallint(2) NOT NULL DEFAULT 1,
+  `required_count` int DEFAULT NULL,
+  `signature_required` tinyint(1) NOT NULL DEFAULT 0,
+  `self_approve_allowed` tinyint(1) NOT NULL DEFAULT 0,
+  `stages` longtext NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_documents_approval_policy_templates_job` (`job`, `deleted_at`),
+  KEY `idx_fivenet_documents_approval_policy_templates_category_id` (`category_id`),
+  KEY `idx_fivenet_documents_approval_policy_templates_template_id` (`template_id`),
+  CONSTRAINT `fk_fivenet_documents_approval_policy_templates_category_id` FOREIGN KEY (`category_id`) REFERENCES `fivenet_documents_categories` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_documents_approval_policy_templates_template_id` FOREIGN KEY (`template_id`) REFERENCES `fivenet_documents_templates` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_documents_approval_policy_templates_creator_id` FOREIGN KEY (`creator_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL
+) ENGINE=InnoDB;
+
+-- Table: fivenet_documents_approval_policies
+ALTER TABLE `fivenet_documents_approval_policies`
+  ADD COLUMN `policy_template_id` bigint(20) unsigned DEFAULT NULL AFTER `self_approve_allowed`,
+  ADD COLUMN `current_stage` int NOT NULL DEFAULT 0 AFTER `policy_template_id`;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Table: fivenet_documents_approval_tasks
+ALTER TABLE `fivenet_documents_approval_tasks`
+  DROP FOREIGN KEY `fk_fivenet_doc_apptsk_original_user_id`,
+  DROP KEY `idx_fivenet_doc_apptsk_original_user_id`,
+  DROP COLUMN `delegated_at`,
+  DROP COLUMN `original_user_id`;
+
+-- Table: fivenet_job_colleague_props
+ALTER TABLE `fivenet_job_colleague_props`
+  DROP FOREIGN KEY `fk_fivenet_job_colleague_props_absence_delegate_id`,
+  DROP KEY `idx_absence_delegate_id`,
+  DROP COLUMN `absence_delegate_id`;
+
+COMMIT;
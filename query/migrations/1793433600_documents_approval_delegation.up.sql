@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Table: fivenet_job_colleague_props
+ALTER TABLE `fivenet_job_colleague_props`
+  ADD COLUMN `absence_delegate_id` int(11) DEFAULT NULL AFTER `absence_end`,
+  ADD KEY `idx_absence_delegate_id` (`absence_delegate_id`),
+  ADD CONSTRAINT `fk_fivenet_job_colleague_props_absence_delegate_id` FOREIGN KEY (`absence_delegate_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL;
+
+-- Table: fivenet_documents_approval_tasks
+ALTER TABLE `fivenet_documents_approval_tasks`
+  ADD COLUMN `original_user_id` int(11) DEFAULT NULL AFTER `user_id`,
+  ADD COLUMN `delegated_at` datetime(3) DEFAULT NULL AFTER `original_user_id`,
+  ADD KEY `idx_fivenet_doc_apptsk_original_user_id` (`original_user_id`),
+  ADD CONSTRAINT `fk_fivenet_doc_apptsk_original_user_id` FOREIGN KEY (`original_user_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL;
+
+COMMIT;
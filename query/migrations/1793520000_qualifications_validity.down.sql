@@ -0,0 +1,14 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_results
+ALTER TABLE `fivenet_qualifications_results`
+  DROP KEY `idx_fivenet_qualifications_results_status_expires_at`,
+  DROP COLUMN `expiry_reminder_sent_at`,
+  DROP COLUMN `expires_at`;
+
+-- Table: fivenet_qualifications
+ALTER TABLE `fivenet_qualifications`
+  DROP COLUMN `grace_period_days`,
+  DROP COLUMN `validity_days`;
+
+COMMIT;
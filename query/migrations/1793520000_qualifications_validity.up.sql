@@ -0,0 +1,14 @@
+BEGIN;
+
+-- Table: fivenet_qualifications
+ALTER TABLE `fivenet_qualifications`
+  ADD COLUMN `validity_days` int(11) NULL DEFAULT NULL AFTER `label_sync_format`,
+  ADD COLUMN `grace_period_days` int(11) NULL DEFAULT NULL AFTER `validity_days`;
+
+-- Table: fivenet_qualifications_results
+ALTER TABLE `fivenet_qualifications_results`
+  ADD COLUMN `expires_at` datetime(3) NULL DEFAULT NULL AFTER `summary`,
+  ADD COLUMN `expiry_reminder_sent_at` datetime(3) NULL DEFAULT NULL AFTER `expires_at`,
+  ADD KEY `idx_fivenet_qualifications_results_status_expires_at` (`status`, `expires_at`);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_exam_users
+ALTER TABLE `fivenet_qualifications_exam_users`
+  DROP COLUMN `questions`;
+
+-- Table: fivenet_qualifications_exam_questions
+ALTER TABLE `fivenet_qualifications_exam_questions`
+  DROP COLUMN `tags`;
+
+COMMIT;
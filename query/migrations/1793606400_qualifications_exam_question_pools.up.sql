@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_exam_questions
+ALTER TABLE `fivenet_qualifications_exam_questions`
+  ADD COLUMN `tags` varchar(512) NULL DEFAULT NULL AFTER `answer`;
+
+-- Table: fivenet_qualifications_exam_users
+ALTER TABLE `fivenet_qualifications_exam_users`
+  ADD COLUMN `questions` longtext NULL DEFAULT NULL AFTER `ended_at`;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_exam_log
+DROP TABLE IF EXISTS `fivenet_qualifications_exam_log`;
+
+COMMIT;
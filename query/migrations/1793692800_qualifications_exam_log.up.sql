@@ -0,0 +1,20 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_exam_log
+CREATE TABLE IF NOT EXISTS `fivenet_qualifications_exam_log` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),
+  `qualification_id` bigint(20) unsigned NOT NULL,
+  `user_id` int(11) NOT NULL,
+  `type` smallint(2) NOT NULL,
+  `data` longtext DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_qualifications_exam_log_quali_user_created` (`qualification_id`, `user_id`, `created_at`),
+  KEY `idx_fivenet_qualifications_exam_log_user_id` (`user_id`),
+  CONSTRAINT `fk_fivenet_qualifications_exam_log_quali_id` FOREIGN KEY (`qualification_id`) REFERENCES `fivenet_qualifications` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_qualifications_exam_log_user_id` FOREIGN KEY (`user_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Table: fivenet_job_group_rule_qualifications
+ALTER TABLE `fivenet_job_group_rule_qualifications`
+  DROP FOREIGN KEY `fk_fivenet_job_group_rule_qualifications_training_path_id`,
+  DROP KEY `idx_fivenet_job_group_rule_qualifications_training_path_id`,
+  DROP COLUMN `promotion_ready`,
+  DROP COLUMN `training_path_id`;
+
+-- Table: fivenet_qualifications_training_path_steps
+DROP TABLE IF EXISTS `fivenet_qualifications_training_path_steps`;
+
+-- Table: fivenet_qualifications_training_paths
+DROP TABLE IF EXISTS `fivenet_qualifications_training_paths`;
+
+COMMIT;
@@ -0,0 +1,42 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_training_paths
+CREATE TABLE IF NOT EXISTS `fivenet_qualifications_training_paths` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` datetime(3) DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP(3),
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `job` varchar(20) NOT NULL,
+  `grade` int(11) DEFAULT NULL,
+  `name` varchar(128) NOT NULL,
+  `description` varchar(1024) DEFAULT NULL,
+  `creator_id` int(11) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_qualifications_training_paths_job_deleted_at` (`job`, `deleted_at`),
+  KEY `idx_fivenet_qualifications_training_paths_creator_id` (`creator_id`),
+  CONSTRAINT `fk_fivenet_qualifications_training_paths_creator_id` FOREIGN KEY (`creator_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL
+) ENGINE=InnoDB;
+
+-- Table: fivenet_qualifications_training_path_steps
+CREATE TABLE IF NOT EXISTS `fivenet_qualifications_training_path_steps` (
+  `training_path_id` bigint(20) unsigned NOT NULL,
+  `qualification_id` bigint(20) unsigned NOT NULL,
+  `sort_order` int(11) NOT NULL DEFAULT 0,
+  PRIMARY KEY (`training_path_id`, `qualification_id`),
+  KEY `idx_fivenet_qualifications_training_path_steps_quali_id` (`qualification_id`),
+  CONSTRAINT `fk_fivenet_qualifications_training_path_steps_path_id` FOREIGN KEY (`training_path_id`) REFERENCES `fivenet_qualifications_training_paths` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_qualifications_training_path_steps_quali_id` FOREIGN KEY (`qualification_id`) REFERENCES `fivenet_qualifications` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB;
+
+-- Table: fivenet_job_group_rule_qualifications
+ALTER TABLE `fivenet_job_group_rule_qualifications`
+  ADD COLUMN `training_path_id` bigint(20) unsigned DEFAULT NULL AFTER `require_completed`,
+  ADD COLUMN `promotion_ready` tinyint(1) NOT NULL DEFAULT 0 AFTER `training_path_id`,
+  ADD KEY `idx_fivenet_job_group_rule_qualifications_training_path_id` (`training_path_id`),
+  ADD CONSTRAINT `fk_fivenet_job_group_rule_qualifications_training_path_id` FOREIGN KEY (`training_path_id`) REFERENCES `fivenet_qualifications_training_paths` (`id`)
+    ON DELETE SET NULL ON UPDATE CASCADE;
+
+COMMIT;
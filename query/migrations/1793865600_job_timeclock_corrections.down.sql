@@ -0,0 +1,6 @@
+BEGIN;
+
+-- Table: fivenet_job_timeclock_corrections
+DROP TABLE IF EXISTS `fivenet_job_timeclock_corrections`;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Table: fivenet_job_timeclock_corrections
+CREATE TABLE IF NOT EXISTS `fivenet_job_timeclock_corrections` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` datetime(3) DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP(3),
+  `job` varchar(20) NOT NULL,
+  `user_id` int(11) NOT NULL,
+  `type` smallint(2) NOT NULL,
+  `status` smallint(2) NOT NULL DEFAULT 1,
+  `date` date DEFAULT NULL,
+  `original_start_time` datetime(3) DEFAULT NULL,
+  `original_end_time` datetime(3) DEFAULT NULL,
+  `original_spent_time` decimal(10,2) DEFAULT NULL,
+  `start_time` datetime(3) DEFAULT NULL,
+  `end_time` datetime(3) DEFAULT NULL,
+  `reason` varchar(255) NOT NULL,
+  `reviewer_id` int(11) DEFAULT NULL,
+  `reviewed_at` datetime(3) DEFAULT NULL,
+  `review_comment` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_fivenet_job_timeclock_corrections_job_status` (`job`, `status`),
+  KEY `idx_fivenet_job_timeclock_corrections_user_id` (`user_id`),
+  KEY `idx_fivenet_job_timeclock_corrections_reviewer_id` (`reviewer_id`),
+  CONSTRAINT `fk_fivenet_job_timeclock_corrections_user_id` FOREIGN KEY (`user_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_fivenet_job_timeclock_corrections_reviewer_id` FOREIGN KEY (`reviewer_id`) REFERENCES `{{.UsersTableName}}` (`id`)
+    ON DELETE SET NULL ON UPDATE SET NULL
+) ENGINE=InnoDB;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_results
+ALTER TABLE `fivenet_qualifications_results`
+  DROP COLUMN `successful_at`;
+
+COMMIT;
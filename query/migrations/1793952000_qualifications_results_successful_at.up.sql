@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Table: fivenet_qualifications_results
+ALTER TABLE `fivenet_qualifications_results`
+  ADD COLUMN `successful_at` datetime(3) NULL DEFAULT NULL AFTER `summary`;
+
+-- Successful results with an expiry became successful a validity period before it, fall back to
+-- the creation date for the others (RESULT_STATUS_SUCCESSFUL = 3).
+UPDATE `fivenet_qualifications_results` AS `result`
+LEFT JOIN `fivenet_qualifications` AS `quali` ON `quali`.`id` = `result`.`qualification_id`
+SET `result`.`successful_at` = IF(
+  `result`.`expires_at` IS NOT NULL AND `quali`.`validity_days` IS NOT NULL,
+  DATE_SUB(`result`.`expires_at`, INTERVAL `quali`.`validity_days` DAY),
+  `result`.`created_at`
+)
+WHERE `result`.`status` = 3;
+
+COMMIT;
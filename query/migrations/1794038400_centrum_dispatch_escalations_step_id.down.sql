@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Table: fivenet_centrum_dispatches_escalations
+DELETE FROM `fivenet_centrum_dispatches_escalations`
+WHERE `step_id` NOT REGEXP '^[0-9]+$';
+
+ALTER TABLE `fivenet_centrum_dispatches_escalations`
+  ADD COLUMN `step` smallint(2) NOT NULL DEFAULT 0 AFTER `job`;
+
+UPDATE `fivenet_centrum_dispatches_escalations`
+SET `step` = CAST(`step_id` AS UNSIGNED);
+
+ALTER TABLE `fivenet_centrum_dispatches_escalations`
+  DROP KEY `idx_fivenet_centrum_dispatches_escalations_unique`,
+  DROP COLUMN `step_id`,
+  ADD UNIQUE KEY `idx_fivenet_centrum_dispatches_escalations_unique` (`dispatch_id`, `job`, `step`);
+
+COMMIT;
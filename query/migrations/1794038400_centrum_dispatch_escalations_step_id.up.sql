@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Table: fivenet_centrum_dispatches_escalations
+ALTER TABLE `fivenet_centrum_dispatches_escalations`
+  ADD COLUMN `step_id` varchar(64) NOT NULL DEFAULT '' AFTER `job`;
+
+-- Steps without an ID use their index as ID, so already run steps aren't run again
+UPDATE `fivenet_centrum_dispatches_escalations`
+SET `step_id` = CAST(`step` AS CHAR);
+
+ALTER TABLE `fivenet_centrum_dispatches_escalations`
+  DROP KEY `idx_fivenet_centrum_dispatches_escalations_unique`,
+  DROP COLUMN `step`,
+  ADD UNIQUE KEY `idx_fivenet_centrum_dispatches_escalations_unique` (`dispatch_id`, `job`, `step_id`);
+
+COMMIT;
@@ -2,12 +2,11 @@ package centrumbot
 
 import (
 	"context"
-	"math/rand/v2"
 	"sort"
 	"time"
 
-	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
 	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumsettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings"
 	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
 	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatches"
@@ -15,6 +14,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/settings"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/units"
 	centrumutils "github.com/fivenet-app/fivenet/v2026/services/centrum/utils"
+	"github.com/paulmach/orb"
 	"go.uber.org/zap"
 )
 
@@ -84,12 +84,6 @@ func (b *Bot) Run() {
 			[]string{b.job},
 			nil,
 			[]centrumdispatches.StatusDispatch{
-				// Dispatch status that mean it is being worked on
-				centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNIT_ASSIGNED,
-				centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNIT_ACCEPTED,
-				centrumdispatches.StatusDispatch_STATUS_DISPATCH_EN_ROUTE,
-				centrumdispatches.StatusDispatch_STATUS_DISPATCH_ON_SCENE,
-				centrumdispatches.StatusDispatch_STATUS_DISPATCH_NEED_ASSISTANCE,
 				// "Completed" states
 				centrumdispatches.StatusDispatch_STATUS_DISPATCH_CANCELLED,
 				centrumdispatches.StatusDispatch_STATUS_DISPATCH_COMPLETED,
@@ -109,20 +103,33 @@ func (b *Bot) Run() {
 
 		for _, dsp := range dispatches {
 			// Dispatch should be at least 7 seconds old to ensure deduplication has happened
-			if (dsp.GetCreatedAt() != nil && time.Since(dsp.GetCreatedAt().AsTime()) <= 7*time.Second) ||
-				!centrumutils.IsDispatchUnassigned(dsp) {
+			if dsp.GetCreatedAt() != nil && time.Since(dsp.GetCreatedAt().AsTime()) <= 7*time.Second {
 				continue
 			}
 
-			b.logger.Debug("trying to auto assign dispatch", zap.Int64("dispatch_id", dsp.GetId()))
+			// Dispatches that are being worked on, only get more units assigned till their requirements are met
+			unassigned := centrumutils.IsDispatchUnassigned(dsp)
+			if !unassigned && !needsMoreUnits(dsp) {
+				continue
+			}
 
-			unit, ok := b.getAvailableUnit(b.ctx, dsp.GetJobs())
+			b.logger.Debug(
+				"trying to auto assign dispatch",
+				zap.Int64("dispatch_id", dsp.GetId()),
+				zap.Bool("unassigned", unassigned),
+			)
+
+			unit, ok := b.getAvailableUnit(b.ctx, dsp)
 			if !ok {
 				// No unit available
 				b.logger.Warn(
 					"no available units for dispatch",
 					zap.Int64("dispatch_id", dsp.GetId()),
 				)
+				if !unassigned {
+					// A unit with the missing skills might still be available for other dispatches
+					continue
+				}
 				break
 			}
 
@@ -155,11 +162,12 @@ func (b *Bot) Stop() {
 
 func (b *Bot) getAvailableUnit(
 	ctx context.Context,
-	jobs *centrum.JobList,
+	dsp *centrumdispatches.Dispatch,
 ) (*centrumunits.Unit, bool) {
+	jobs := dsp.GetJobs().GetJobStrings()
 	units := b.units.Filter(
 		ctx,
-		jobs.GetJobStrings(),
+		jobs,
 		[]centrumunits.StatusUnit{centrumunits.StatusUnit_STATUS_UNIT_AVAILABLE},
 		nil,
 		func(unit *centrumunits.Unit) bool {
@@ -174,31 +182,44 @@ func (b *Bot) getAvailableUnit(
 		return nil, false
 	}
 
-	// Randomize unit ids
-	for i := range units {
-		//nolint:gosec // G404: rand.Intn is not cryptographically secure, but we don't need it to be here.
-		j := rand.IntN(i + 1)
-		units[i], units[j] = units[j], units[i]
+	cfg := &centrumsettings.AutoAssign{}
+	cfg.Default()
+	if s, err := b.settings.Get(ctx, b.job); err != nil {
+		b.logger.Warn("failed to get settings for auto assign, using defaults", zap.Error(err))
+	} else if s.GetConfiguration().GetAutoAssign() != nil {
+		cfg = s.GetConfiguration().GetAutoAssign()
 	}
 
-	var selectedUnit *centrumunits.Unit
+	load := b.getUnitsLoad(ctx, jobs)
+
+	candidates := []*unitCandidate{}
 	for _, unit := range units {
 		t, ok := b.lastAssignedUnits[unit.GetId()]
-		if !ok || time.Now().After(t) {
-			// Double check if unit is still available
-			if unit.GetStatus() == nil ||
-				unit.GetStatus().GetStatus() != centrumunits.StatusUnit_STATUS_UNIT_AVAILABLE {
-				continue
-			}
+		if ok && !time.Now().After(t) {
+			continue
+		}
 
-			selectedUnit = unit
-			break
+		// Double check if unit is still available
+		if unit.GetStatus() == nil ||
+			unit.GetStatus().GetStatus() != centrumunits.StatusUnit_STATUS_UNIT_AVAILABLE {
+			continue
 		}
+
+		point, hasPosition := b.getUnitPosition(unit)
+		candidates = append(candidates, &unitCandidate{
+			unit:        unit,
+			point:       point,
+			hasPosition: hasPosition,
+			load:        load[unit.GetId()],
+		})
 	}
 
-	if selectedUnit == nil {
+	candidates = filterRequirementCandidates(dsp, candidates)
+	candidates = rankCandidates(cfg, dsp, candidates)
+	if len(candidates) == 0 {
 		return nil, false
 	}
+	selectedUnit := candidates[0].unit
 
 	delay := 0 * time.Second
 	unitCount := len(units)
@@ -210,3 +231,53 @@ func (b *Bot) getAvailableUnit(
 
 	return selectedUnit, true
 }
+
+// getUnitPosition returns the center of the unit members' positions, falling back to the unit's last status position.
+func (b *Bot) getUnitPosition(unit *centrumunits.Unit) (orb.Point, bool) {
+	var x, y float64
+	count := 0
+	for _, user := range unit.GetUsers() {
+		um, ok := b.tracker.GetUserMarkerById(user.GetUserId())
+		if !ok || um == nil {
+			continue
+		}
+
+		x += um.GetX()
+		y += um.GetY()
+		count++
+	}
+
+	if count > 0 {
+		return orb.Point{x / float64(count), y / float64(count)}, true
+	}
+
+	if unit.GetStatus() != nil && unit.GetStatus().X != nil && unit.GetStatus().Y != nil {
+		return unit.GetStatus().Point(), true
+	}
+
+	return orb.Point{}, false
+}
+
+// getUnitsLoad returns the number of active dispatches each unit is assigned to.
+func (b *Bot) getUnitsLoad(ctx context.Context, jobs []string) map[int64]int {
+	dsps := b.dispatches.Filter(
+		ctx,
+		jobs,
+		nil,
+		[]centrumdispatches.StatusDispatch{
+			centrumdispatches.StatusDispatch_STATUS_DISPATCH_CANCELLED,
+			centrumdispatches.StatusDispatch_STATUS_DISPATCH_COMPLETED,
+			centrumdispatches.StatusDispatch_STATUS_DISPATCH_ARCHIVED,
+			centrumdispatches.StatusDispatch_STATUS_DISPATCH_DELETED,
+		},
+	)
+
+	load := map[int64]int{}
+	for _, dsp := range dsps {
+		for _, ua := range dsp.GetUnits() {
+			load[ua.GetUnitId()]++
+		}
+	}
+
+	return load
+}
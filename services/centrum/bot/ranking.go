@@ -0,0 +1,191 @@
+package centrumbot
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumsettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings"
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/fivenet-app/fivenet/v2026/pkg/coords"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+// unitCandidate is a unit that can be assigned to a dispatch, with its (estimated) position and current load.
+type unitCandidate struct {
+	unit *centrumunits.Unit
+
+	point       orb.Point
+	hasPosition bool
+	// load is the number of active dispatches the unit is assigned to
+	load int
+}
+
+func (c *unitCandidate) Point() orb.Point {
+	return c.point
+}
+
+// rankCandidates orders the candidates according to the given auto assign strategy, the first candidate is the best fit.
+// Candidates farther away than the configured max distance are dropped.
+func rankCandidates(
+	cfg *centrumsettings.AutoAssign,
+	dsp *centrumdispatches.Dispatch,
+	candidates []*unitCandidate,
+) []*unitCandidate {
+	if len(candidates) == 0 {
+		return candidates
+	}
+
+	// Randomize candidates so ties (and the random strategy) don't always favor the same unit
+	for i := range candidates {
+		//nolint:gosec // G404: rand.Intn is not cryptographically secure, but we don't need it to be here.
+		j := rand.IntN(i + 1)
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	}
+
+	switch cfg.GetStrategy() {
+	case centrumsettings.AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_NEAREST:
+		return rankByDistance(cfg, dsp.Point(), candidates)
+
+	case centrumsettings.AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_WEIGHTED:
+		return rankByScore(cfg, dsp, candidates)
+
+	default:
+		// Random strategy
+		return candidates
+	}
+}
+
+func maxDistance(cfg *centrumsettings.AutoAssign) float64 {
+	if cfg.GetMaxDistance() <= 0 {
+		return math.Inf(1)
+	}
+
+	return float64(cfg.GetMaxDistance())
+}
+
+// rankByDistance uses a spatial index to order the candidates by distance to the dispatch.
+// Candidates without a known position are appended at the end, unless a max distance is set.
+func rankByDistance(
+	cfg *centrumsettings.AutoAssign,
+	point orb.Point,
+	candidates []*unitCandidate,
+) []*unitCandidate {
+	locs := coords.New[*unitCandidate]()
+	unknown := []*unitCandidate{}
+	positioned := 0
+	for _, c := range candidates {
+		if !c.hasPosition {
+			unknown = append(unknown, c)
+			continue
+		}
+
+		if err := locs.Add(c); err != nil {
+			// Position is outside of the map bounds
+			unknown = append(unknown, c)
+			continue
+		}
+		positioned++
+	}
+
+	ranked := make([]*unitCandidate, 0, len(candidates))
+	if positioned > 0 {
+		for _, p := range locs.KNearest(point, positioned, nil, maxDistance(cfg)) {
+			//nolint:forcetypeassert // We know that p is a *unitCandidate because locs is a generics spatial index
+			ranked = append(ranked, p.(*unitCandidate))
+		}
+	}
+
+	if cfg.GetMaxDistance() <= 0 {
+		ranked = append(ranked, unknown...)
+	}
+
+	return ranked
+}
+
+// rankByScore orders the candidates by a weighted score of distance, current load and matching skills.
+func rankByScore(
+	cfg *centrumsettings.AutoAssign,
+	dsp *centrumdispatches.Dispatch,
+	candidates []*unitCandidate,
+) []*unitCandidate {
+	maxDist := maxDistance(cfg)
+
+	// Candidates without a position are treated as being at the max distance (or farthest known distance)
+	distances := make(map[*unitCandidate]float64, len(candidates))
+	farthest := 0.0
+	for _, c := range candidates {
+		if !c.hasPosition {
+			continue
+		}
+
+		d := planar.Distance(c.point, dsp.Point())
+		distances[c] = d
+		farthest = max(farthest, d)
+	}
+	if !math.IsInf(maxDist, 1) {
+		farthest = maxDist
+	}
+
+	maxLoad := 0
+	for _, c := range candidates {
+		maxLoad = max(maxLoad, c.load)
+	}
+
+	skills := dsp.GetRequirements().GetSkills()
+
+	scores := make(map[*unitCandidate]float64, len(candidates))
+	ranked := make([]*unitCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		d, ok := distances[c]
+		if ok && d > maxDist {
+			continue
+		} else if !ok && !math.IsInf(maxDist, 1) {
+			continue
+		}
+
+		// Each factor is normalized to a value between 0 (worst) and 1 (best)
+		distanceScore := 0.0
+		if ok && farthest > 0 {
+			distanceScore = 1 - d/farthest
+		} else if ok {
+			distanceScore = 1
+		}
+
+		loadScore := 1.0
+		if maxLoad > 0 {
+			loadScore = 1 - float64(c.load)/float64(maxLoad)
+		}
+
+		skillsScore := 1.0
+		if len(skills) > 0 {
+			matched := 0
+			for _, skill := range skills {
+				if c.unit.GetAttributes().HasSkill(skill) {
+					matched++
+				}
+			}
+			skillsScore = float64(matched) / float64(len(skills))
+		}
+
+		scores[c] = float64(cfg.GetDistanceWeight())*distanceScore +
+			float64(cfg.GetLoadWeight())*loadScore +
+			float64(cfg.GetSkillsWeight())*skillsScore
+		ranked = append(ranked, c)
+	}
+
+	slices.SortStableFunc(ranked, func(a, b *unitCandidate) int {
+		switch {
+		case scores[a] > scores[b]:
+			return -1
+		case scores[a] < scores[b]:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return ranked
+}
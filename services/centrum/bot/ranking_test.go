@@ -0,0 +1,100 @@
+package centrumbot
+
+import (
+	"testing"
+
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumsettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings"
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/paulmach/orb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCandidate(id int64, point *orb.Point, load int, skills ...string) *unitCandidate {
+	c := &unitCandidate{
+		unit: &centrumunits.Unit{
+			Id: id,
+			Attributes: &centrumunits.UnitAttributes{
+				Skills: skills,
+			},
+		},
+		load: load,
+	}
+	if point != nil {
+		c.point = *point
+		c.hasPosition = true
+	}
+
+	return c
+}
+
+func candidateIds(candidates []*unitCandidate) []int64 {
+	ids := make([]int64, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.unit.GetId())
+	}
+
+	return ids
+}
+
+func TestRankCandidatesNearest(t *testing.T) {
+	t.Parallel()
+
+	dsp := &centrumdispatches.Dispatch{X: 0, Y: 0}
+	candidates := func() []*unitCandidate {
+		return []*unitCandidate{
+			newTestCandidate(1, &orb.Point{500, 500}, 0),
+			newTestCandidate(2, &orb.Point{10, 10}, 0),
+			newTestCandidate(3, nil, 0),
+			newTestCandidate(4, &orb.Point{100, -100}, 0),
+		}
+	}
+
+	cfg := &centrumsettings.AutoAssign{
+		Strategy: centrumsettings.AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_NEAREST,
+	}
+	ranked := rankCandidates(cfg, dsp, candidates())
+	assert.Equal(t, []int64{2, 4, 1, 3}, candidateIds(ranked))
+
+	// Units without position and too far away are dropped when a max distance is set
+	cfg.MaxDistance = 200
+	ranked = rankCandidates(cfg, dsp, candidates())
+	assert.Equal(t, []int64{2, 4}, candidateIds(ranked))
+}
+
+func TestRankCandidatesWeighted(t *testing.T) {
+	t.Parallel()
+
+	dsp := &centrumdispatches.Dispatch{
+		X: 0,
+		Y: 0,
+		Requirements: &centrumdispatches.DispatchRequirements{
+			Skills: []string{"medic"},
+		},
+	}
+
+	cfg := &centrumsettings.AutoAssign{
+		Strategy: centrumsettings.AutoAssignStrategy_AUTO_ASSIGN_STRATEGY_WEIGHTED,
+	}
+	cfg.Default()
+
+	// Closest unit is busy with other dispatches and lacks the required skill
+	ranked := rankCandidates(cfg, dsp, []*unitCandidate{
+		newTestCandidate(1, &orb.Point{10, 10}, 2),
+		newTestCandidate(2, &orb.Point{150, 150}, 0, "Medic"),
+		newTestCandidate(3, &orb.Point{300, 300}, 0),
+	})
+	require.Len(t, ranked, 3)
+	assert.Equal(t, int64(2), ranked[0].unit.GetId())
+
+	// Only distance counts
+	cfg.LoadWeight = 0
+	cfg.SkillsWeight = 0
+	ranked = rankCandidates(cfg, dsp, []*unitCandidate{
+		newTestCandidate(1, &orb.Point{10, 10}, 2),
+		newTestCandidate(2, &orb.Point{150, 150}, 0, "Medic"),
+		newTestCandidate(3, &orb.Point{300, 300}, 0),
+	})
+	assert.Equal(t, []int64{1, 2, 3}, candidateIds(ranked))
+}
@@ -0,0 +1,54 @@
+package centrumbot
+
+import (
+	"slices"
+
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumutils "github.com/fivenet-app/fivenet/v2026/services/centrum/utils"
+)
+
+// needsMoreUnits returns true if the (active) dispatch has unit requirements that the assigned units don't meet yet.
+func needsMoreUnits(dsp *centrumdispatches.Dispatch) bool {
+	if dsp.GetStatus() == nil || centrumutils.IsStatusDispatchComplete(dsp.GetStatus().GetStatus()) {
+		return false
+	}
+
+	state := dsp.ComputeRequirementsState()
+	return state != nil && !state.GetMet()
+}
+
+// filterRequirementCandidates removes candidates that are already assigned to the dispatch. If the dispatch requires
+// units with skills that are still missing, only candidates having at least one of the missing skills are kept, unless
+// the dispatch still needs more units in general.
+func filterRequirementCandidates(
+	dsp *centrumdispatches.Dispatch,
+	candidates []*unitCandidate,
+) []*unitCandidate {
+	candidates = slices.DeleteFunc(candidates, func(c *unitCandidate) bool {
+		return slices.ContainsFunc(dsp.GetUnits(), func(ua *centrumdispatches.DispatchAssignment) bool {
+			return ua.GetUnitId() == c.unit.GetId()
+		})
+	})
+
+	state := dsp.ComputeRequirementsState()
+	missing := state.MissingSkills()
+	if len(missing) == 0 {
+		return candidates
+	}
+
+	matching := []*unitCandidate{}
+	for _, c := range candidates {
+		if slices.ContainsFunc(missing, c.unit.GetAttributes().HasSkill) {
+			matching = append(matching, c)
+		}
+	}
+	if len(matching) > 0 {
+		return matching
+	}
+
+	if state.GetAssignedUnits() < state.GetRequiredUnits() {
+		return candidates
+	}
+
+	return nil
+}
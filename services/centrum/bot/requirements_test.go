@@ -0,0 +1,61 @@
+package centrumbot
+
+import (
+	"testing"
+
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterRequirementCandidates(t *testing.T) {
+	t.Parallel()
+
+	// 2 units, 1 of them with the "medic" skill
+	dsp := &centrumdispatches.Dispatch{
+		Status: &centrumdispatches.DispatchStatus{
+			Status: centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNIT_ASSIGNED,
+		},
+		Requirements: &centrumdispatches.DispatchRequirements{
+			Units: new(int32(2)),
+			UnitSkills: []*centrumdispatches.DispatchUnitRequirement{
+				{Skill: "medic", Count: 1},
+			},
+		},
+	}
+	assert.True(t, needsMoreUnits(dsp))
+
+	candidates := func() []*unitCandidate {
+		return []*unitCandidate{
+			newTestCandidate(1, nil, 0),
+			newTestCandidate(2, nil, 0, "Medic"),
+			newTestCandidate(3, nil, 0),
+		}
+	}
+
+	// Missing skill is preferred
+	assert.Equal(t, []int64{2}, candidateIds(filterRequirementCandidates(dsp, candidates())))
+
+	// Medic unit assigned, one more unit needed
+	dsp.Units = []*centrumdispatches.DispatchAssignment{
+		{UnitId: 2, Unit: &centrumunits.Unit{Id: 2, Attributes: &centrumunits.UnitAttributes{Skills: []string{"medic"}}}},
+	}
+	assert.True(t, needsMoreUnits(dsp))
+	assert.Equal(t, []int64{1, 3}, candidateIds(filterRequirementCandidates(dsp, candidates())))
+
+	// Requirements met
+	dsp.Units = append(dsp.Units, &centrumdispatches.DispatchAssignment{UnitId: 1, Unit: &centrumunits.Unit{Id: 1}})
+	assert.False(t, needsMoreUnits(dsp))
+
+	// Enough units, but the medic is missing and no medic is available
+	dsp.Units = []*centrumdispatches.DispatchAssignment{
+		{UnitId: 1, Unit: &centrumunits.Unit{Id: 1}},
+		{UnitId: 3, Unit: &centrumunits.Unit{Id: 3}},
+	}
+	assert.True(t, needsMoreUnits(dsp))
+	assert.Empty(t, filterRequirementCandidates(dsp, []*unitCandidate{newTestCandidate(4, nil, 0)}))
+
+	// Completed dispatches don't need any units
+	dsp.Status.Status = centrumdispatches.StatusDispatch_STATUS_DISPATCH_COMPLETED
+	assert.False(t, needsMoreUnits(dsp))
+}
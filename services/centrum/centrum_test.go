@@ -27,6 +27,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/mstlystcdata"
 	"github.com/fivenet-app/fivenet/v2026/pkg/nats/store"
 	"github.com/fivenet-app/fivenet/v2026/pkg/notifi"
+	"github.com/fivenet-app/fivenet/v2026/pkg/stats"
 	trackerpkg "github.com/fivenet-app/fivenet/v2026/pkg/tracker"
 	"github.com/fivenet-app/fivenet/v2026/pkg/userinfo"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatchers"
@@ -218,6 +219,7 @@ func newCentrumJoinUnitTestServer(
 			fx.Provide(func() trackerpkg.ITracker {
 				return trackerStub
 			}),
+			fx.Provide(stats.NewService),
 			fx.Provide(helpers.New),
 			fx.Provide(settings.New),
 			fx.Provide(dispatchers.New),
@@ -19,7 +19,9 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
 	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
 	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatches"
 	errorscentrum "github.com/fivenet-app/fivenet/v2026/services/centrum/errors"
+	centrumutils "github.com/fivenet-app/fivenet/v2026/services/centrum/utils"
 	usersstore "github.com/fivenet-app/fivenet/v2026/stores/users"
 	"github.com/go-jet/jet/v2/mysql"
 	"github.com/go-jet/jet/v2/qrm"
@@ -96,6 +98,26 @@ func (s *Server) ListDispatches(
 		condition = condition.AND(tDispatch.Postal.EQ(mysql.String(req.GetPostal())))
 	}
 
+	if req.GetRequirementsUnmet() {
+		// Requirement state is only computed for active dispatches (kept in the kv store)
+		unmetIds := []mysql.Expression{}
+		for _, dsp := range s.dispatches.List(ctx, jobs) {
+			if state := dsp.GetRequirementsState(); state != nil && !state.GetMet() &&
+				!centrumutils.IsStatusDispatchComplete(dsp.GetStatus().GetStatus()) {
+				unmetIds = append(unmetIds, mysql.Int64(dsp.GetId()))
+			}
+		}
+
+		if len(unmetIds) == 0 {
+			pag, _ := req.GetPagination().GetResponseWithPageSize(0, 20)
+			return &pbcentrum.ListDispatchesResponse{
+				Pagination: pag,
+			}, nil
+		}
+
+		condition = condition.AND(tDispatch.ID.IN(unmetIds...))
+	}
+
 	countStmt := tDispatch.
 		SELECT(
 			mysql.COUNT(tDispatch.ID).AS("data_count.total"),
@@ -135,6 +157,7 @@ func (s *Server) ListDispatches(
 			tDispatch.Description,
 			tDispatch.Attributes,
 			tDispatch.References,
+			tDispatch.Requirements,
 			tDispatch.X,
 			tDispatch.Y,
 			tDispatch.Postal,
@@ -193,6 +216,7 @@ func (s *Server) ListDispatches(
 		if err != nil {
 			return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
 		}
+		resp.Dispatches[i].RequirementsState = dsps[i].ComputeRequirementsState()
 
 		if resp.Dispatches[i].CreatorId != nil {
 			resp.Dispatches[i].Creator, err = usersstore.RetrieveUserById(
@@ -279,6 +303,7 @@ func (s *Server) GetDispatch(
 			tDispatch.Description,
 			tDispatch.Attributes,
 			tDispatch.References,
+			tDispatch.Requirements,
 			tDispatch.X,
 			tDispatch.Y,
 			tDispatch.Postal,
@@ -332,6 +357,7 @@ func (s *Server) GetDispatch(
 	if err != nil {
 		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
 	}
+	resp.Dispatch.RequirementsState = resp.GetDispatch().ComputeRequirementsState()
 
 	if resp.Dispatch.CreatorId != nil && resp.GetDispatch().GetCreatorId() > 0 {
 		creator, err := usersstore.RetrieveUserById(ctx, s.db, resp.GetDispatch().GetCreatorId())
@@ -360,6 +386,17 @@ func (s *Server) CreateDispatch(
 ) (*pbcentrum.CreateDispatchResponse, error) {
 	userInfo := auth.MustGetUserInfoFromContext(ctx)
 
+	if req.GetTemplateCode() != "" {
+		tpl, err := s.dispatches.GetTemplateByCode(ctx, userInfo.GetJob(), req.GetTemplateCode())
+		if err != nil {
+			if errors.Is(err, errorscentrum.ErrDispatchTemplateNotFound) {
+				return nil, err
+			}
+			return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+		}
+		dispatches.ApplyTemplate(req.GetDispatch(), tpl)
+	}
+
 	// Make sure jobs and creator id are set
 	if len(req.GetDispatch().GetJobs().GetJobs()) > 0 {
 		jobs, _, err := s.settings.GetAccessList(ctx, userInfo.GetJob(), userInfo.GetJobGrade())
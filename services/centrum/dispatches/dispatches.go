@@ -514,6 +514,7 @@ func (s *DispatchDB) LoadFromDB(ctx context.Context, cond mysql.BoolExpression)
 			tDispatch.Description,
 			tDispatch.Attributes,
 			tDispatch.References,
+			tDispatch.Requirements,
 			tDispatch.X,
 			tDispatch.Y,
 			tDispatch.Postal,
@@ -1118,6 +1119,7 @@ func (s *DispatchDB) UpdateAssignments(
 				}
 			}
 			dsp.Units = finalAssignments
+			dsp.RequirementsState = dsp.ComputeRequirementsState()
 			if currentStatusID > 0 {
 				for _, pending := range persistedStatuses {
 					if pending.status.GetId() != currentStatusID {
@@ -1215,6 +1217,7 @@ func (s *DispatchDB) Create(
 			tDispatch.Description,
 			tDispatch.Attributes,
 			tDispatch.References,
+			tDispatch.Requirements,
 			tDispatch.X,
 			tDispatch.Y,
 			tDispatch.Postal,
@@ -1228,6 +1231,7 @@ func (s *DispatchDB) Create(
 			dsp.Description,
 			dsp.GetAttributes(),
 			dsp.GetReferences(),
+			dsp.GetRequirements(),
 			dsp.GetX(),
 			dsp.GetY(),
 			dsp.Postal,
@@ -1306,6 +1310,7 @@ func (s *DispatchDB) Update(
 			tDispatch.Description,
 			tDispatch.Attributes,
 			tDispatch.References,
+			tDispatch.Requirements,
 			tDispatch.X,
 			tDispatch.Y,
 			tDispatch.Postal,
@@ -1319,6 +1324,7 @@ func (s *DispatchDB) Update(
 			dsp.Description,
 			dsp.GetAttributes(),
 			dsp.GetReferences(),
+			dsp.GetRequirements(),
 			dsp.GetX(),
 			dsp.GetY(),
 			dsp.Postal,
@@ -1658,6 +1664,7 @@ func (s *DispatchDB) TakeDispatch(
 					return nil, false, err
 				}
 				dsp.SetStatus(persistedStatus)
+				dsp.RequirementsState = dsp.ComputeRequirementsState()
 				statusToPublish = persistedStatus
 				publishJobs = slices.Clone(dsp.GetJobs().GetJobStrings())
 
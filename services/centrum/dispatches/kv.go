@@ -32,11 +32,15 @@ func (s *DispatchDB) updateInKV(
 		centrumutils.IdKey(id),
 		func(key string, existing *centrumdispatches.Dispatch) (*centrumdispatches.Dispatch, bool, error) {
 			if existing == nil {
+				if dsp != nil {
+					dsp.RequirementsState = dsp.ComputeRequirementsState()
+				}
 				return dsp, dsp != nil, nil
 			}
 
 			if !proto.Equal(existing, dsp) {
 				existing.Merge(dsp)
+				existing.RequirementsState = existing.ComputeRequirementsState()
 				return existing, true, nil
 			}
 
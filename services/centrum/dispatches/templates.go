@@ -0,0 +1,254 @@
+package dispatches
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	errorscentrum "github.com/fivenet-app/fivenet/v2026/services/centrum/errors"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+	"google.golang.org/protobuf/proto"
+)
+
+func templateColumns(tTemplates *table.FivenetCentrumDispatchesTemplatesTable) mysql.ProjectionList {
+	return mysql.ProjectionList{
+		tTemplates.ID,
+		tTemplates.CreatedAt,
+		tTemplates.UpdatedAt,
+		tTemplates.Job,
+		tTemplates.Code,
+		tTemplates.Message,
+		tTemplates.Description,
+		tTemplates.Attributes,
+		tTemplates.Requirements,
+		tTemplates.Jobs,
+		tTemplates.CreatorID,
+	}
+}
+
+// ListTemplates returns the dispatch templates of a job ordered by their code.
+func (s *DispatchDB) ListTemplates(
+	ctx context.Context,
+	job string,
+) ([]*centrumdispatches.DispatchTemplate, error) {
+	tTemplates := table.FivenetCentrumDispatchesTemplates.AS("dispatch_template")
+
+	stmt := tTemplates.
+		SELECT(templateColumns(tTemplates)).
+		FROM(tTemplates).
+		WHERE(tTemplates.Job.EQ(mysql.String(job))).
+		ORDER_BY(tTemplates.Code.ASC()).
+		LIMIT(250)
+
+	templates := []*centrumdispatches.DispatchTemplate{}
+	if err := stmt.QueryContext(ctx, s.db, &templates); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+func (s *DispatchDB) getTemplate(
+	ctx context.Context,
+	condition mysql.BoolExpression,
+) (*centrumdispatches.DispatchTemplate, error) {
+	tTemplates := table.FivenetCentrumDispatchesTemplates.AS("dispatch_template")
+
+	stmt := tTemplates.
+		SELECT(templateColumns(tTemplates)).
+		FROM(tTemplates).
+		WHERE(condition).
+		LIMIT(1)
+
+	dest := &centrumdispatches.DispatchTemplate{}
+	if err := stmt.QueryContext(ctx, s.db, dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if dest.GetId() <= 0 {
+		return nil, errorscentrum.ErrDispatchTemplateNotFound
+	}
+
+	return dest, nil
+}
+
+// GetTemplate returns the dispatch template by id, the template must belong to the given job.
+func (s *DispatchDB) GetTemplate(
+	ctx context.Context,
+	job string,
+	id int64,
+) (*centrumdispatches.DispatchTemplate, error) {
+	tTemplates := table.FivenetCentrumDispatchesTemplates.AS("dispatch_template")
+
+	return s.getTemplate(ctx, mysql.AND(
+		tTemplates.ID.EQ(mysql.Int64(id)),
+		tTemplates.Job.EQ(mysql.String(job)),
+	))
+}
+
+// GetTemplateByCode returns the job's dispatch template with the given code.
+func (s *DispatchDB) GetTemplateByCode(
+	ctx context.Context,
+	job string,
+	code string,
+) (*centrumdispatches.DispatchTemplate, error) {
+	tTemplates := table.FivenetCentrumDispatchesTemplates.AS("dispatch_template")
+
+	return s.getTemplate(ctx, mysql.AND(
+		tTemplates.Job.EQ(mysql.String(job)),
+		tTemplates.Code.EQ(mysql.String(strings.TrimSpace(code))),
+	))
+}
+
+// CreateOrUpdateTemplate creates the dispatch template when it has no id yet, otherwise the job's template is updated.
+func (s *DispatchDB) CreateOrUpdateTemplate(
+	ctx context.Context,
+	tpl *centrumdispatches.DispatchTemplate,
+) (*centrumdispatches.DispatchTemplate, error) {
+	tpl.Code = strings.TrimSpace(tpl.GetCode())
+
+	tTemplates := table.FivenetCentrumDispatchesTemplates
+
+	if tpl.GetId() <= 0 {
+		stmt := tTemplates.
+			INSERT(
+				tTemplates.Job,
+				tTemplates.Code,
+				tTemplates.Message,
+				tTemplates.Description,
+				tTemplates.Attributes,
+				tTemplates.Requirements,
+				tTemplates.Jobs,
+				tTemplates.CreatorID,
+			).
+			VALUES(
+				tpl.GetJob(),
+				tpl.GetCode(),
+				tpl.GetMessage(),
+				tpl.Description,
+				tpl.GetAttributes(),
+				tpl.GetRequirements(),
+				tpl.GetJobs(),
+				tpl.CreatorId,
+			)
+
+		res, err := stmt.ExecContext(ctx, s.db)
+		if err != nil {
+			if dbutils.IsDuplicateError(err) {
+				return nil, errorscentrum.ErrDispatchTemplateCodeExists
+			}
+			return nil, err
+		}
+
+		lastId, err := res.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		tpl.Id = lastId
+	} else {
+		stmt := tTemplates.
+			UPDATE(
+				tTemplates.Code,
+				tTemplates.Message,
+				tTemplates.Description,
+				tTemplates.Attributes,
+				tTemplates.Requirements,
+				tTemplates.Jobs,
+			).
+			SET(
+				tpl.GetCode(),
+				tpl.GetMessage(),
+				tpl.Description,
+				tpl.GetAttributes(),
+				tpl.GetRequirements(),
+				tpl.GetJobs(),
+			).
+			WHERE(mysql.AND(
+				tTemplates.ID.EQ(mysql.Int64(tpl.GetId())),
+				tTemplates.Job.EQ(mysql.String(tpl.GetJob())),
+			))
+
+		if _, err := stmt.ExecContext(ctx, s.db); err != nil {
+			if dbutils.IsDuplicateError(err) {
+				return nil, errorscentrum.ErrDispatchTemplateCodeExists
+			}
+			return nil, err
+		}
+	}
+
+	return s.GetTemplate(ctx, tpl.GetJob(), tpl.GetId())
+}
+
+// DeleteTemplate deletes the job's dispatch template.
+func (s *DispatchDB) DeleteTemplate(ctx context.Context, job string, id int64) error {
+	tTemplates := table.FivenetCentrumDispatchesTemplates
+
+	stmt := tTemplates.
+		DELETE().
+		WHERE(mysql.AND(
+			tTemplates.ID.EQ(mysql.Int64(id)),
+			tTemplates.Job.EQ(mysql.String(job)),
+		)).
+		LIMIT(1)
+
+	if _, err := stmt.ExecContext(ctx, s.db); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ApplyTemplate fills the dispatch's unset fields with the template's defaults.
+// Attributes are merged, message, description, requirements and jobs are only used when the dispatch has none set.
+func ApplyTemplate(dsp *centrumdispatches.Dispatch, tpl *centrumdispatches.DispatchTemplate) {
+	if tpl == nil {
+		return
+	}
+
+	if strings.TrimSpace(dsp.GetMessage()) == "" {
+		dsp.Message = tpl.GetMessage()
+	}
+	if dsp.Description == nil && tpl.Description != nil {
+		dsp.Description = new(tpl.GetDescription())
+	}
+
+	if len(tpl.GetAttributes().GetList()) > 0 {
+		if dsp.Attributes == nil {
+			dsp.Attributes = &centrumdispatches.DispatchAttributes{}
+		}
+		for _, attr := range tpl.GetAttributes().GetList() {
+			if !slices.Contains(dsp.GetAttributes().GetList(), attr) {
+				dsp.Attributes.List = append(dsp.Attributes.List, attr)
+			}
+		}
+	}
+
+	if dsp.Requirements == nil && tpl.Requirements != nil {
+		dsp.Requirements = proto.Clone(tpl.GetRequirements()).(*centrumdispatches.DispatchRequirements)
+	}
+
+	//nolint:staticcheck // Check old job info. This is a fallback for old dispatches.
+	if len(dsp.GetJobs().GetJobs()) == 0 && dsp.GetJob() == "" {
+		if len(tpl.GetJobs().GetJobs()) > 0 {
+			dsp.Jobs = proto.Clone(tpl.GetJobs()).(*centrum.JobList)
+		} else {
+			dsp.Jobs = &centrum.JobList{
+				Jobs: []*centrum.JobListEntry{
+					{
+						Name: tpl.GetJob(),
+					},
+				},
+			}
+		}
+	}
+}
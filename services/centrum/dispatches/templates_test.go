@@ -0,0 +1,68 @@
+package dispatches
+
+import (
+	"testing"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTemplate(t *testing.T) {
+	t.Parallel()
+
+	tpl := &centrumdispatches.DispatchTemplate{
+		Job:         "police",
+		Code:        "10-50",
+		Message:     "Traffic accident",
+		Description: new("Check for injured persons"),
+		Attributes: &centrumdispatches.DispatchAttributes{
+			List: []centrumdispatches.DispatchAttribute{
+				centrumdispatches.DispatchAttribute_DISPATCH_ATTRIBUTE_MULTIPLE,
+			},
+		},
+		Requirements: &centrumdispatches.DispatchRequirements{
+			Units: new(int32(2)),
+		},
+		Jobs: &centrum.JobList{
+			Jobs: []*centrum.JobListEntry{{Name: "police"}, {Name: "ambulance"}},
+		},
+	}
+
+	// Empty dispatch gets all of the template's defaults
+	dsp := &centrumdispatches.Dispatch{}
+	ApplyTemplate(dsp, tpl)
+	assert.Equal(t, "Traffic accident", dsp.GetMessage())
+	assert.Equal(t, "Check for injured persons", dsp.GetDescription())
+	assert.Equal(t, []string{"police", "ambulance"}, dsp.GetJobs().GetJobStrings())
+	assert.Equal(t, int32(2), dsp.GetRequirements().GetUnits())
+	assert.Len(t, dsp.GetAttributes().GetList(), 1)
+
+	// Template values must not be shared with the dispatch
+	dsp.Requirements.Units = new(int32(3))
+	assert.Equal(t, int32(2), tpl.GetRequirements().GetUnits())
+
+	// Values set on the dispatch are kept, attributes are merged
+	dsp = &centrumdispatches.Dispatch{
+		Message: "Accident with fire",
+		Attributes: &centrumdispatches.DispatchAttributes{
+			List: []centrumdispatches.DispatchAttribute{
+				centrumdispatches.DispatchAttribute_DISPATCH_ATTRIBUTE_MULTIPLE,
+				centrumdispatches.DispatchAttribute_DISPATCH_ATTRIBUTE_DUPLICATE,
+			},
+		},
+		Jobs: &centrum.JobList{
+			Jobs: []*centrum.JobListEntry{{Name: "fire"}},
+		},
+	}
+	ApplyTemplate(dsp, tpl)
+	assert.Equal(t, "Accident with fire", dsp.GetMessage())
+	assert.Equal(t, []string{"fire"}, dsp.GetJobs().GetJobStrings())
+	assert.Len(t, dsp.GetAttributes().GetList(), 2)
+
+	// Template without target jobs falls back to the template's job
+	dsp = &centrumdispatches.Dispatch{}
+	ApplyTemplate(dsp, &centrumdispatches.DispatchTemplate{Job: "police", Message: "Robbery"})
+	assert.Equal(t, []string{"police"}, dsp.GetJobs().GetJobStrings())
+	assert.Nil(t, dsp.GetAttributes())
+}
@@ -0,0 +1,567 @@
+package dispatches
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	centrumutils "github.com/fivenet-app/fivenet/v2026/services/centrum/utils"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+const (
+	// TimelineMaxWindow caps the incident window of a dispatch timeline.
+	TimelineMaxWindow = 24 * time.Hour
+	// TimelineMaxEntries is the max. number of entries a dispatch timeline contains.
+	TimelineMaxEntries = 2500
+
+	// Minimum time between two position samples of the same unit member
+	timelinePositionInterval = 30 * time.Second
+	// Markers within this distance of the dispatch are considered related
+	timelineMarkerDistance = 150.0
+)
+
+// BuildTimeline merges the dispatch's status changes, the status changes and position history of the units assigned to it
+// and markers near the dispatch into one ordered timeline covering the dispatch's incident window.
+// Returns nil when the dispatch doesn't exist or isn't visible to the given jobs.
+func (s *DispatchDB) BuildTimeline(
+	ctx context.Context,
+	id int64,
+	jobs []string,
+) (*centrumdispatches.DispatchTimeline, error) {
+	dsp, err := s.getTimelineDispatch(ctx, id, jobs)
+	if err != nil {
+		return nil, err
+	}
+	if dsp == nil {
+		return nil, nil
+	}
+
+	dspStatuses, err := s.listTimelineDispatchStatuses(ctx, dsp.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	start := dsp.GetCreatedAt().AsTime()
+	end := time.Now()
+	active := true
+	if centrumutils.IsStatusDispatchComplete(dsp.GetStatus().GetStatus()) &&
+		dsp.GetStatus().GetCreatedAt() != nil {
+		end = dsp.GetStatus().GetCreatedAt().AsTime()
+		active = false
+	}
+	if end.Sub(start) > TimelineMaxWindow {
+		end = start.Add(TimelineMaxWindow)
+		active = false
+	}
+
+	timeline := &centrumdispatches.DispatchTimeline{
+		Dispatch: dsp,
+		Start:    timestamp.New(start),
+		End:      timestamp.New(end),
+		Units:    []*centrumunits.Unit{},
+		Entries:  []*centrumdispatches.DispatchTimelineEntry{},
+	}
+
+	positions := []*centrumdispatches.DispatchTimelineEntry{}
+	unitIds := []int64{}
+	for _, status := range dspStatuses {
+		timeline.Entries = append(timeline.Entries, &centrumdispatches.DispatchTimelineEntry{
+			Time: status.GetCreatedAt(),
+			Data: &centrumdispatches.DispatchTimelineEntry_DispatchStatus{
+				DispatchStatus: status,
+			},
+		})
+
+		if status.GetUnitId() <= 0 {
+			continue
+		}
+		if !slices.Contains(unitIds, status.GetUnitId()) {
+			unitIds = append(unitIds, status.GetUnitId())
+		}
+		if status.HasX() && status.HasY() {
+			positions = append(positions, newTimelinePosition(
+				status.GetCreatedAt(), status.GetUnitId(), status.UserId, status.GetX(), status.GetY(),
+			))
+		}
+	}
+	for _, unit := range dsp.GetUnits() {
+		if !slices.Contains(unitIds, unit.GetUnitId()) {
+			unitIds = append(unitIds, unit.GetUnitId())
+		}
+	}
+
+	for _, unitId := range unitIds {
+		unit, err := s.units.Get(ctx, unitId)
+		if err != nil || unit == nil {
+			// Unit might have been deleted since
+			continue
+		}
+		timeline.Units = append(timeline.Units, unit)
+
+		// Add the current positions of the unit's members while the dispatch is still active
+		if !active || !slices.ContainsFunc(dsp.GetUnits(), func(ua *centrumdispatches.DispatchAssignment) bool {
+			return ua.GetUnitId() == unitId
+		}) {
+			continue
+		}
+		for _, member := range unit.GetUsers() {
+			um, ok := s.tracker.GetUserMarkerById(member.GetUserId())
+			if !ok || um == nil {
+				continue
+			}
+
+			positions = append(positions, newTimelinePosition(
+				timestamp.Now(), unitId, &member.UserId, um.GetX(), um.GetY(),
+			))
+		}
+	}
+
+	unitStatuses, err := s.listTimelineUnitStatuses(ctx, unitIds, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range unitStatuses {
+		timeline.Entries = append(timeline.Entries, &centrumdispatches.DispatchTimelineEntry{
+			Time: status.GetCreatedAt(),
+			Data: &centrumdispatches.DispatchTimelineEntry_UnitStatus{
+				UnitStatus: status,
+			},
+		})
+
+		if status.HasX() && status.HasY() {
+			positions = append(positions, newTimelinePosition(
+				status.GetCreatedAt(), status.GetUnitId(), status.UserId, status.GetX(), status.GetY(),
+			))
+		}
+	}
+
+	samples, err := s.listTimelinePositionHistory(ctx, unitIds, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, sample := range samples {
+		positions = append(positions, newTimelinePosition(
+			sample.GetCreatedAt(), sample.GetUnitId(), &sample.UserId, sample.GetX(), sample.GetY(),
+		))
+	}
+
+	timeline.Entries = append(timeline.Entries, downsamplePositions(positions, timelinePositionInterval)...)
+
+	markers, err := s.listTimelineMarkers(ctx, dsp, jobs, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, marker := range markers {
+		timeline.Entries = append(timeline.Entries, &centrumdispatches.DispatchTimelineEntry{
+			Time: marker.GetCreatedAt(),
+			Data: &centrumdispatches.DispatchTimelineEntry_Marker{
+				Marker: marker,
+			},
+		})
+	}
+
+	timeline.Entries, timeline.Truncated = sortTimelineEntries(timeline.GetEntries(), TimelineMaxEntries)
+
+	return timeline, nil
+}
+
+func (s *DispatchDB) getTimelineDispatch(
+	ctx context.Context,
+	id int64,
+	jobs []string,
+) (*centrumdispatches.Dispatch, error) {
+	tDispatch := table.FivenetCentrumDispatches.AS("dispatch")
+	tDispatchStatus := table.FivenetCentrumDispatchesStatus.AS("dispatch_status")
+
+	jobsOut, _ := json.Marshal(jobs)
+
+	stmt := tDispatch.
+		SELECT(
+			tDispatch.ID,
+			tDispatch.CreatedAt,
+			tDispatch.UpdatedAt,
+			tDispatch.Jobs,
+			tDispatch.Message,
+			tDispatch.Description,
+			tDispatch.Attributes,
+			tDispatch.References,
+			tDispatch.Requirements,
+			tDispatch.X,
+			tDispatch.Y,
+			tDispatch.Postal,
+			tDispatch.Anon,
+			tDispatch.CreatorID,
+			tDispatchStatus.ID,
+			tDispatchStatus.CreatedAt,
+			tDispatchStatus.DispatchID,
+			tDispatchStatus.UnitID,
+			tDispatchStatus.Status,
+			tDispatchStatus.Reason,
+			tDispatchStatus.Code,
+			tDispatchStatus.UserID,
+			tDispatchStatus.X,
+			tDispatchStatus.Y,
+			tDispatchStatus.Postal,
+			tDispatchStatus.CreatorJob,
+		).
+		FROM(tDispatch.
+			LEFT_JOIN(tDispatchStatus,
+				tDispatchStatus.DispatchID.EQ(tDispatch.ID),
+			)).
+		WHERE(mysql.AND(
+			tDispatchStatus.ID.IS_NULL().OR(
+				tDispatchStatus.ID.EQ(
+					mysql.RawInt(
+						"SELECT MAX(`dispatchstatus`.`id`) FROM `fivenet_centrum_dispatches_status` AS `dispatchstatus` WHERE `dispatchstatus`.`dispatch_id` = `dispatch`.`id`",
+					),
+				),
+			),
+			tDispatch.ID.EQ(mysql.Int64(id)),
+			dbutils.JSON_CONTAINS(tDispatch.Jobs, mysql.String(string(jobsOut))),
+		)).
+		LIMIT(1)
+
+	dsp := &centrumdispatches.Dispatch{}
+	if err := stmt.QueryContext(ctx, s.db, dsp); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if dsp.GetId() <= 0 {
+		return nil, nil
+	}
+
+	var err error
+	dsp.Units, err = s.LoadDispatchAssignments(ctx, dsp.GetId())
+	if err != nil {
+		return nil, err
+	}
+	dsp.RequirementsState = dsp.ComputeRequirementsState()
+
+	return dsp, nil
+}
+
+func (s *DispatchDB) listTimelineDispatchStatuses(
+	ctx context.Context,
+	dspId int64,
+) ([]*centrumdispatches.DispatchStatus, error) {
+	tDispatchStatus := table.FivenetCentrumDispatchesStatus.AS("dispatch_status")
+	tUsers := table.FivenetUser.AS("colleague")
+
+	stmt := tDispatchStatus.
+		SELECT(
+			tDispatchStatus.ID,
+			tDispatchStatus.CreatedAt,
+			tDispatchStatus.DispatchID,
+			tDispatchStatus.UnitID,
+			tDispatchStatus.Status,
+			tDispatchStatus.Reason,
+			tDispatchStatus.Code,
+			tDispatchStatus.UserID,
+			tDispatchStatus.X,
+			tDispatchStatus.Y,
+			tDispatchStatus.Postal,
+			tDispatchStatus.CreatorJob,
+			tUsers.ID,
+			tUsers.Firstname,
+			tUsers.Lastname,
+			tUsers.Job,
+			tUsers.JobGrade,
+		).
+		FROM(
+			tDispatchStatus.
+				LEFT_JOIN(tUsers,
+					tUsers.ID.EQ(tDispatchStatus.UserID),
+				),
+		).
+		WHERE(
+			tDispatchStatus.DispatchID.EQ(mysql.Int64(dspId)),
+		).
+		ORDER_BY(tDispatchStatus.ID.ASC()).
+		LIMIT(TimelineMaxEntries)
+
+	dest := []*centrumdispatches.DispatchStatus{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (s *DispatchDB) listTimelineUnitStatuses(
+	ctx context.Context,
+	unitIds []int64,
+	start time.Time,
+	end time.Time,
+) ([]*centrumunits.UnitStatus, error) {
+	if len(unitIds) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]mysql.Expression, len(unitIds))
+	for i := range unitIds {
+		ids[i] = mysql.Int64(unitIds[i])
+	}
+
+	tUnitStatus := table.FivenetCentrumUnitsStatus.AS("unit_status")
+	tUsers := table.FivenetUser.AS("colleague")
+
+	stmt := tUnitStatus.
+		SELECT(
+			tUnitStatus.ID,
+			tUnitStatus.CreatedAt,
+			tUnitStatus.UnitID,
+			tUnitStatus.Status,
+			tUnitStatus.Reason,
+			tUnitStatus.Code,
+			tUnitStatus.UserID,
+			tUnitStatus.CreatorID,
+			tUnitStatus.X,
+			tUnitStatus.Y,
+			tUnitStatus.Postal,
+			tUnitStatus.CreatorJob,
+			tUsers.ID,
+			tUsers.Firstname,
+			tUsers.Lastname,
+			tUsers.Job,
+			tUsers.JobGrade,
+		).
+		FROM(
+			tUnitStatus.
+				LEFT_JOIN(tUsers,
+					tUsers.ID.EQ(tUnitStatus.UserID),
+				),
+		).
+		WHERE(mysql.AND(
+			tUnitStatus.UnitID.IN(ids...),
+			tUnitStatus.CreatedAt.BETWEEN(
+				mysql.TimestampT(start),
+				mysql.TimestampT(end),
+			),
+		)).
+		ORDER_BY(tUnitStatus.ID.ASC()).
+		LIMIT(TimelineMaxEntries)
+
+	dest := []*centrumunits.UnitStatus{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// listTimelinePositionHistory returns the tracker's position samples of the users while they were in one of the units.
+func (s *DispatchDB) listTimelinePositionHistory(
+	ctx context.Context,
+	unitIds []int64,
+	start time.Time,
+	end time.Time,
+) ([]*livemaphistory.PositionSample, error) {
+	if len(unitIds) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]mysql.Expression, len(unitIds))
+	for i := range unitIds {
+		ids[i] = mysql.Int64(unitIds[i])
+	}
+
+	tHistory := table.FivenetCentrumUserLocationsHistory.AS("position_sample")
+
+	stmt := tHistory.
+		SELECT(
+			tHistory.CreatedAt,
+			tHistory.UserID,
+			tHistory.UnitID,
+			tHistory.X,
+			tHistory.Y,
+		).
+		FROM(tHistory).
+		WHERE(mysql.AND(
+			tHistory.UnitID.IN(ids...),
+			tHistory.CreatedAt.BETWEEN(
+				mysql.TimestampT(start),
+				mysql.TimestampT(end),
+			),
+		)).
+		ORDER_BY(tHistory.CreatedAt.ASC()).
+		LIMIT(TimelineMaxEntries)
+
+	dest := []*livemaphistory.PositionSample{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (s *DispatchDB) listTimelineMarkers(
+	ctx context.Context,
+	dsp *centrumdispatches.Dispatch,
+	jobs []string,
+	start time.Time,
+	end time.Time,
+) ([]*livemapmarkers.MarkerMarker, error) {
+	jobsExp := make([]mysql.Expression, len(jobs))
+	for i := range jobs {
+		jobsExp[i] = mysql.String(jobs[i])
+	}
+
+	tMarkers := table.FivenetCentrumMarkers.AS("marker_marker")
+
+	jobCondition := tMarkers.Public.IS_TRUE()
+	if len(jobsExp) > 0 {
+		jobCondition = jobCondition.OR(tMarkers.Job.IN(jobsExp...))
+	}
+
+	stmt := tMarkers.
+		SELECT(
+			tMarkers.ID,
+			tMarkers.CreatedAt,
+			tMarkers.UpdatedAt,
+			tMarkers.DeletedAt,
+			tMarkers.ExpiresAt,
+			tMarkers.Job,
+			tMarkers.Public,
+			tMarkers.Name,
+			tMarkers.Description,
+			tMarkers.X,
+			tMarkers.Y,
+			tMarkers.Postal,
+			tMarkers.Color,
+			tMarkers.MarkerType,
+			tMarkers.MarkerData,
+			tMarkers.CreatorID,
+		).
+		FROM(tMarkers).
+		WHERE(mysql.AND(
+			jobCondition,
+			tMarkers.CreatedAt.LT_EQ(mysql.TimestampT(end)),
+			mysql.OR(
+				tMarkers.DeletedAt.IS_NULL(),
+				tMarkers.DeletedAt.GT_EQ(mysql.TimestampT(start)),
+			),
+			mysql.OR(
+				tMarkers.ExpiresAt.IS_NULL(),
+				tMarkers.ExpiresAt.GT_EQ(mysql.TimestampT(start)),
+			),
+			tMarkers.X.BETWEEN(
+				mysql.Float(dsp.GetX()-timelineMarkerDistance),
+				mysql.Float(dsp.GetX()+timelineMarkerDistance),
+			),
+			tMarkers.Y.BETWEEN(
+				mysql.Float(dsp.GetY()-timelineMarkerDistance),
+				mysql.Float(dsp.GetY()+timelineMarkerDistance),
+			),
+		)).
+		ORDER_BY(tMarkers.CreatedAt.ASC()).
+		LIMIT(100)
+
+	dest := []*livemapmarkers.MarkerMarker{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	// Bounding box is only a pre-filter, only keep markers that are within the actual distance
+	dest = slices.DeleteFunc(dest, func(marker *livemapmarkers.MarkerMarker) bool {
+		return math.Hypot(marker.GetX()-dsp.GetX(), marker.GetY()-dsp.GetY()) > timelineMarkerDistance
+	})
+
+	for _, marker := range dest {
+		s.enricher.EnrichJobName(marker)
+	}
+
+	return dest, nil
+}
+
+func newTimelinePosition(
+	ts *timestamp.Timestamp,
+	unitId int64,
+	userId *int32,
+	x float64,
+	y float64,
+) *centrumdispatches.DispatchTimelineEntry {
+	return &centrumdispatches.DispatchTimelineEntry{
+		Time: ts,
+		Data: &centrumdispatches.DispatchTimelineEntry_UnitPosition{
+			UnitPosition: &centrumdispatches.DispatchTimelinePosition{
+				UnitId: unitId,
+				UserId: userId,
+				X:      x,
+				Y:      y,
+			},
+		},
+	}
+}
+
+// downsamplePositions keeps at most one position sample per unit member (or unit when no user is set) per interval.
+func downsamplePositions(
+	positions []*centrumdispatches.DispatchTimelineEntry,
+	interval time.Duration,
+) []*centrumdispatches.DispatchTimelineEntry {
+	type sampleKey struct {
+		unitId int64
+		userId int32
+	}
+
+	slices.SortStableFunc(positions, compareTimelineEntries)
+
+	last := map[sampleKey]time.Time{}
+	out := make([]*centrumdispatches.DispatchTimelineEntry, 0, len(positions))
+	for _, entry := range positions {
+		pos := entry.GetUnitPosition()
+		key := sampleKey{
+			unitId: pos.GetUnitId(),
+			userId: pos.GetUserId(),
+		}
+
+		t := entry.GetTime().AsTime()
+		if lt, ok := last[key]; ok && t.Sub(lt) < interval {
+			continue
+		}
+		last[key] = t
+
+		out = append(out, entry)
+	}
+
+	return out
+}
+
+// sortTimelineEntries orders the entries by time and cuts them off at the given max. entry count.
+func sortTimelineEntries(
+	entries []*centrumdispatches.DispatchTimelineEntry,
+	limit int,
+) ([]*centrumdispatches.DispatchTimelineEntry, bool) {
+	slices.SortStableFunc(entries, compareTimelineEntries)
+
+	if len(entries) > limit {
+		return entries[:limit], true
+	}
+
+	return entries, false
+}
+
+func compareTimelineEntries(a, b *centrumdispatches.DispatchTimelineEntry) int {
+	return a.GetTime().AsTime().Compare(b.GetTime().AsTime())
+}
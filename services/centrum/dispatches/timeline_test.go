@@ -0,0 +1,59 @@
+package dispatches
+
+import (
+	"testing"
+	"time"
+
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownsamplePositions(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, time.May, 10, 22, 0, 0, 0, time.UTC)
+	at := func(sec int) *timestamp.Timestamp {
+		return timestamp.New(base.Add(time.Duration(sec) * time.Second))
+	}
+
+	positions := []*centrumdispatches.DispatchTimelineEntry{
+		newTimelinePosition(at(40), 1, new(int32(5)), 3, 3),
+		newTimelinePosition(at(0), 1, new(int32(5)), 1, 1),
+		newTimelinePosition(at(10), 1, new(int32(5)), 2, 2),
+		// Different member of the same unit is sampled separately
+		newTimelinePosition(at(10), 1, new(int32(6)), 5, 5),
+		newTimelinePosition(at(20), 2, nil, 7, 7),
+	}
+
+	out := downsamplePositions(positions, 30*time.Second)
+	assert.Len(t, out, 4)
+
+	xs := []float64{}
+	for _, entry := range out {
+		xs = append(xs, entry.GetUnitPosition().GetX())
+	}
+	assert.Equal(t, []float64{1, 5, 7, 3}, xs)
+}
+
+func TestSortTimelineEntries(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, time.May, 10, 22, 0, 0, 0, time.UTC)
+	entries := []*centrumdispatches.DispatchTimelineEntry{
+		newTimelinePosition(timestamp.New(base.Add(2*time.Minute)), 1, nil, 3, 3),
+		newTimelinePosition(timestamp.New(base), 1, nil, 1, 1),
+		newTimelinePosition(timestamp.New(base.Add(time.Minute)), 1, nil, 2, 2),
+	}
+
+	sorted, truncated := sortTimelineEntries(entries, 10)
+	assert.False(t, truncated)
+	assert.Len(t, sorted, 3)
+	assert.InDelta(t, 1, sorted[0].GetUnitPosition().GetX(), 0)
+	assert.InDelta(t, 3, sorted[2].GetUnitPosition().GetX(), 0)
+
+	sorted, truncated = sortTimelineEntries(entries, 2)
+	assert.True(t, truncated)
+	assert.Len(t, sorted, 2)
+	assert.InDelta(t, 2, sorted[1].GetUnitPosition().GetX(), 0)
+}
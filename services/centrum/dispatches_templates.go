@@ -0,0 +1,95 @@
+package centrum
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	pbcentrum "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	errorscentrum "github.com/fivenet-app/fivenet/v2026/services/centrum/errors"
+)
+
+func (s *Server) ListDispatchTemplates(
+	ctx context.Context,
+	req *pbcentrum.ListDispatchTemplatesRequest,
+) (*pbcentrum.ListDispatchTemplatesResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	templates, err := s.dispatches.ListTemplates(ctx, userInfo.GetJob())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	for _, tpl := range templates {
+		for _, job := range tpl.GetJobs().GetJobs() {
+			s.enricher.EnrichJobName(job)
+		}
+	}
+
+	return &pbcentrum.ListDispatchTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+func (s *Server) CreateOrUpdateDispatchTemplate(
+	ctx context.Context,
+	req *pbcentrum.CreateOrUpdateDispatchTemplateRequest,
+) (*pbcentrum.CreateOrUpdateDispatchTemplateResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	tpl := req.GetTemplate()
+	tpl.Job = userInfo.GetJob()
+
+	// Target jobs must be part of the job's dispatch access list
+	if len(tpl.GetJobs().GetJobs()) > 0 {
+		jobs, _, err := s.settings.GetAccessList(ctx, userInfo.GetJob(), userInfo.GetJobGrade())
+		if err != nil {
+			return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+		}
+		for _, job := range tpl.GetJobs().GetJobStrings() {
+			if !s.jobs.Has(job) || !slices.Contains(jobs, job) {
+				return nil, errorscentrum.ErrDispatchJobPermDenied
+			}
+		}
+	}
+
+	auditAction := audit.EventAction_EVENT_ACTION_UPDATED
+	if tpl.GetId() <= 0 {
+		tpl.CreatorId = &userInfo.UserId
+		auditAction = audit.EventAction_EVENT_ACTION_CREATED
+	}
+
+	tpl, err := s.dispatches.CreateOrUpdateTemplate(ctx, tpl)
+	if err != nil {
+		if errors.Is(err, errorscentrum.ErrDispatchTemplateCodeExists) ||
+			errors.Is(err, errorscentrum.ErrDispatchTemplateNotFound) {
+			return nil, err
+		}
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, auditAction)
+
+	return &pbcentrum.CreateOrUpdateDispatchTemplateResponse{
+		Template: tpl,
+	}, nil
+}
+
+func (s *Server) DeleteDispatchTemplate(
+	ctx context.Context,
+	req *pbcentrum.DeleteDispatchTemplateRequest,
+) (*pbcentrum.DeleteDispatchTemplateResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if err := s.dispatches.DeleteTemplate(ctx, userInfo.GetJob(), req.GetId()); err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_DELETED)
+
+	return &pbcentrum.DeleteDispatchTemplateResponse{}, nil
+}
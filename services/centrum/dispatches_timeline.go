@@ -0,0 +1,76 @@
+package centrum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	pbcentrum "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+	errorscentrum "github.com/fivenet-app/fivenet/v2026/services/centrum/errors"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+)
+
+func (s *Server) getDispatchTimeline(
+	ctx context.Context,
+	id int64,
+) (*centrumdispatches.DispatchTimeline, error) {
+	logging.InjectFields(ctx, logging.Fields{dispatchIDLogFieldKey, id})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	jobs, _, err := s.settings.GetAccessList(ctx, userInfo.GetJob(), userInfo.GetJobGrade())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	timeline, err := s.dispatches.BuildTimeline(ctx, id, jobs)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+	if timeline == nil {
+		return nil, errorscentrum.ErrDispatchNotFound
+	}
+
+	return timeline, nil
+}
+
+func (s *Server) GetDispatchTimeline(
+	ctx context.Context,
+	req *pbcentrum.GetDispatchTimelineRequest,
+) (*pbcentrum.GetDispatchTimelineResponse, error) {
+	timeline, err := s.getDispatchTimeline(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcentrum.GetDispatchTimelineResponse{
+		Timeline: timeline,
+	}, nil
+}
+
+func (s *Server) ExportDispatchTimeline(
+	ctx context.Context,
+	req *pbcentrum.ExportDispatchTimelineRequest,
+) (*pbcentrum.ExportDispatchTimelineResponse, error) {
+	timeline, err := s.getDispatchTimeline(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := protoutils.MarshalToPrettyJSON(timeline)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_VIEWED)
+
+	return &pbcentrum.ExportDispatchTimelineResponse{
+		FileName: fmt.Sprintf("dispatch-%d-timeline.json", req.GetId()),
+		Data:     data,
+	}, nil
+}
@@ -57,9 +57,34 @@ var (
 		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchAlreadyCompleted.content"},
 		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchAlreadyCompleted.title"},
 	)
+	ErrUnitRosterInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrUnitRosterInvalid.content"},
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrUnitRosterInvalid.title"},
+	)
+	ErrUnitRosterRunning = common.NewI18nErr(
+		codes.FailedPrecondition,
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrUnitRosterRunning.content"},
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrUnitRosterRunning.title"},
+	)
+	ErrDispatchTemplateNotFound = common.NewI18nErr(
+		codes.NotFound,
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchTemplateNotFound.content"},
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchTemplateNotFound.title"},
+	)
+	ErrDispatchTemplateCodeExists = common.NewI18nErr(
+		codes.AlreadyExists,
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchTemplateCodeExists.content"},
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchTemplateCodeExists.title"},
+	)
 	ErrDispatchNoJobs = common.NewI18nErr(
 		codes.InvalidArgument,
 		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchNoJobs.content"},
 		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchNoJobs.title"},
 	)
+	ErrDispatchNotFound = common.NewI18nErr(
+		codes.NotFound,
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchNotFound.content"},
+		&common.I18NItem{Key: "errors.centrum.CentrumService.ErrDispatchNotFound.title"},
+	)
 )
@@ -0,0 +1,360 @@
+package housekeeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumsettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/pkg/server/admin"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	centrumutils "github.com/fivenet-app/fivenet/v2026/services/centrum/utils"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	dispatchEscalationStepsAttr    = "escalation_steps"
+	dispatchEscalationBreachesAttr = "sla_breaches"
+)
+
+var (
+	escalationMetricsOnce sync.Once
+	escalationMetric      *prometheus.CounterVec
+)
+
+func getEscalationMetric() *prometheus.CounterVec {
+	escalationMetricsOnce.Do(func() {
+		escalationMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: admin.MetricsNamespace,
+			Subsystem: "centrum",
+			Name:      "dispatch_escalations_total",
+			Help:      "Count of dispatch escalation steps run, by action.",
+		}, []string{"job_name", "action"})
+
+		prometheus.MustRegister(escalationMetric)
+	})
+
+	return escalationMetric
+}
+
+func (s *Housekeeper) runDispatchEscalation(ctx context.Context, data *cron.CronjobData) error {
+	ctx, span := s.tracer.Start(ctx, "centrum.dispatch-escalation")
+	defer span.End()
+
+	dest := &cron.GenericCronData{
+		Attributes: map[string]string{},
+	}
+	if err := data.Unmarshal(dest); err != nil {
+		s.logger.Warn("failed to unmarshal dispatch escalation cron data", zap.Error(err))
+	}
+
+	steps, breaches, err := s.handleDispatchEscalations(ctx)
+	if err != nil {
+		s.logger.Error("failed to handle dispatch escalations", zap.Error(err))
+	}
+
+	dest.SetAttribute(dispatchEscalationStepsAttr, strconv.Itoa(steps))
+	dest.SetAttribute(dispatchEscalationBreachesAttr, strconv.Itoa(breaches))
+
+	if err := data.MarshalFrom(dest); err != nil {
+		return fmt.Errorf("failed to marshal updated dispatch escalation cron data. %w", err)
+	}
+
+	return err
+}
+
+// handleDispatchEscalations runs the configured escalation steps of each job for dispatches that are still unassigned.
+func (s *Housekeeper) handleDispatchEscalations(ctx context.Context) (int, int, error) {
+	errs := multierr.Combine()
+	stepsRun := 0
+	breaches := 0
+
+	for _, settings := range s.settings.List(ctx) {
+		escalation := settings.GetTimings().GetEscalation()
+		if !settings.GetEnabled() || !escalation.GetEnabled() || len(escalation.GetSteps()) == 0 {
+			continue
+		}
+
+		// Dispatches offered to another job are only escalated by the job they originate from
+		dsps := s.dispatches.Filter(ctx, []string{settings.GetJob()}, nil, nil)
+		dsps = slices.DeleteFunc(dsps, func(dsp *centrumdispatches.Dispatch) bool {
+			return !isDispatchOriginJob(dsp, settings.GetJob()) ||
+				!centrumutils.IsDispatchUnassigned(dsp)
+		})
+		if len(dsps) == 0 {
+			continue
+		}
+
+		done, err := s.getDispatchEscalationSteps(ctx, settings.GetJob(), dsps)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+
+		for _, dsp := range dsps {
+			if dsp.GetCreatedAt() == nil {
+				continue
+			}
+			unassignedFor := time.Since(dsp.GetCreatedAt().AsTime())
+
+			due := getDueEscalationSteps(escalation.GetSteps(), unassignedFor, done[dsp.GetId()])
+			for _, step := range due {
+				if err := s.runEscalationStep(ctx, settings.GetJob(), dsp, step); err != nil {
+					errs = multierr.Append(errs, fmt.Errorf(
+						"failed to run escalation step %q for dispatch %d. %w",
+						step.GetId(),
+						dsp.GetId(),
+						err,
+					))
+					continue
+				}
+
+				stepsRun++
+				if step.GetAction() == centrumsettings.EscalationAction_ESCALATION_ACTION_MARK_BREACHED {
+					breaches++
+				}
+			}
+		}
+	}
+
+	return stepsRun, breaches, errs
+}
+
+// isDispatchOriginJob returns true if the dispatch has been created for the job, jobs it has been offered to are
+// appended to the dispatch's jobs.
+func isDispatchOriginJob(dsp *centrumdispatches.Dispatch, job string) bool {
+	dspJobs := dsp.GetJobs().GetJobs()
+	return len(dspJobs) > 0 && dspJobs[0].GetName() == job
+}
+
+// getDueEscalationSteps returns the steps that are due for a dispatch unassigned for the given duration and haven't
+// been run yet.
+func getDueEscalationSteps(
+	steps []*centrumsettings.EscalationStep,
+	unassignedFor time.Duration,
+	done map[string]struct{},
+) []*centrumsettings.EscalationStep {
+	due := []*centrumsettings.EscalationStep{}
+	for _, step := range steps {
+		if unassignedFor < time.Duration(step.GetAfterSeconds())*time.Second {
+			continue
+		}
+		if _, ok := done[step.GetId()]; ok {
+			continue
+		}
+
+		due = append(due, step)
+	}
+
+	return due
+}
+
+// getDispatchEscalationSteps returns the escalation steps that have already been run for the given dispatches.
+func (s *Housekeeper) getDispatchEscalationSteps(
+	ctx context.Context,
+	job string,
+	dsps []*centrumdispatches.Dispatch,
+) (map[int64]map[string]struct{}, error) {
+	tDispatchEscalations := table.FivenetCentrumDispatchesEscalations
+
+	ids := make([]mysql.Expression, len(dsps))
+	for i, dsp := range dsps {
+		ids[i] = mysql.Int64(dsp.GetId())
+	}
+
+	stmt := tDispatchEscalations.
+		SELECT(
+			tDispatchEscalations.DispatchID.AS("dispatch_id"),
+			tDispatchEscalations.StepID.AS("step_id"),
+		).
+		FROM(tDispatchEscalations).
+		WHERE(mysql.AND(
+			tDispatchEscalations.Job.EQ(mysql.String(job)),
+			tDispatchEscalations.DispatchID.IN(ids...),
+		))
+
+	var dest []*struct {
+		DispatchID int64
+		StepID     string
+	}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		return nil, fmt.Errorf("failed to query dispatch escalations. %w", err)
+	}
+
+	done := map[int64]map[string]struct{}{}
+	for _, d := range dest {
+		if _, ok := done[d.DispatchID]; !ok {
+			done[d.DispatchID] = map[string]struct{}{}
+		}
+		done[d.DispatchID][d.StepID] = struct{}{}
+	}
+
+	return done, nil
+}
+
+func (s *Housekeeper) runEscalationStep(
+	ctx context.Context,
+	job string,
+	dsp *centrumdispatches.Dispatch,
+	step *centrumsettings.EscalationStep,
+) error {
+	var code string
+	var reason *string
+
+	switch step.GetAction() {
+	case centrumsettings.EscalationAction_ESCALATION_ACTION_NOTIFY_DISPATCHERS:
+		code = "notify_dispatchers"
+		if err := s.notifyDispatchersOfEscalation(ctx, job, dsp); err != nil {
+			return err
+		}
+
+	case centrumsettings.EscalationAction_ESCALATION_ACTION_OFFER_TO_JOB:
+		code = "offer_to_job"
+		targetJob := step.GetTargetJob()
+		reason = &targetJob
+		if err := s.offerDispatchToJob(ctx, dsp, targetJob); err != nil {
+			return err
+		}
+
+	case centrumsettings.EscalationAction_ESCALATION_ACTION_MARK_BREACHED:
+		code = "sla_breached"
+		if err := s.dispatches.AddAttributeToDispatch(
+			ctx,
+			dsp,
+			centrumdispatches.DispatchAttribute_DISPATCH_ATTRIBUTE_SLA_BREACHED,
+		); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unknown escalation action %s", step.GetAction().String())
+	}
+
+	// Record the step before adding the activity entry, so it isn't run again if the status update fails
+	tDispatchEscalations := table.FivenetCentrumDispatchesEscalations
+	stmt := tDispatchEscalations.
+		INSERT(
+			tDispatchEscalations.DispatchID,
+			tDispatchEscalations.Job,
+			tDispatchEscalations.StepID,
+			tDispatchEscalations.Action,
+			tDispatchEscalations.TargetJob,
+		).
+		VALUES(
+			dsp.GetId(),
+			job,
+			step.GetId(),
+			int32(step.GetAction()),
+			step.TargetJob,
+		)
+
+	if _, err := stmt.ExecContext(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to insert dispatch escalation. %w", err)
+	}
+
+	getEscalationMetric().WithLabelValues(job, step.GetAction().String()).Inc()
+
+	// Add activity entry to the dispatch
+	if _, err := s.dispatches.UpdateStatus(ctx, dsp.GetId(), &centrumdispatches.DispatchStatus{
+		CreatedAt:  timestamp.Now(),
+		DispatchId: dsp.GetId(),
+		Status:     centrumdispatches.StatusDispatch_STATUS_DISPATCH_ESCALATED,
+		Code:       &code,
+		Reason:     reason,
+		CreatorJob: &job,
+		X:          &dsp.X,
+		Y:          &dsp.Y,
+		Postal:     dsp.Postal,
+	}); err != nil {
+		return fmt.Errorf("failed to add escalation status to dispatch. %w", err)
+	}
+
+	return nil
+}
+
+func (s *Housekeeper) notifyDispatchersOfEscalation(
+	ctx context.Context,
+	job string,
+	dsp *centrumdispatches.Dispatch,
+) error {
+	dispatchers, err := s.dispatchers.Get(ctx, job)
+	if err != nil {
+		return fmt.Errorf("failed to get dispatchers for job %s. %w", job, err)
+	}
+
+	errs := multierr.Combine()
+	for _, dispatcher := range dispatchers.GetDispatchers() {
+		if err := s.notifi.NotifyUser(ctx, &notifications.Notification{
+			UserId: dispatcher.GetUserId(),
+			Title: &common.I18NItem{
+				Key: "notifications.centrum.escalation.title",
+			},
+			Content: &common.I18NItem{
+				Key: "notifications.centrum.escalation.content",
+				Parameters: map[string]string{
+					"id":      strconv.FormatInt(dsp.GetId(), 10),
+					"message": dsp.GetMessage(),
+				},
+			},
+			Category: notifications.NotificationCategory_NOTIFICATION_CATEGORY_GENERAL,
+			Type:     notifications.NotificationType_NOTIFICATION_TYPE_WARNING,
+			Data: &notifications.Data{
+				Link: &notifications.Link{
+					To: "/dispatch/dispatches",
+				},
+			},
+		}); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// offerDispatchToJob adds the target job to the dispatch, the job must be one of the dispatch target jobs (see `ListDispatchTargetJobs`).
+func (s *Housekeeper) offerDispatchToJob(
+	ctx context.Context,
+	dsp *centrumdispatches.Dispatch,
+	targetJob string,
+) error {
+	if targetJob == "" {
+		return errors.New("no target job set for escalation step")
+	}
+
+	if dsp.GetJobs().ContainsJob(targetJob) {
+		return nil
+	}
+
+	if !slices.ContainsFunc(s.settings.GetPublicJobs(), func(j *jobs.Job) bool {
+		return j.GetName() == targetJob
+	}) {
+		return fmt.Errorf("target job %s is not a dispatch target job", targetJob)
+	}
+
+	if dsp.GetJobs() == nil {
+		dsp.Jobs = &centrum.JobList{}
+	}
+	dsp.Jobs.Jobs = append(dsp.Jobs.Jobs, &centrum.JobListEntry{
+		Name: targetJob,
+	})
+
+	if _, err := s.dispatches.Update(ctx, nil, dsp); err != nil {
+		return fmt.Errorf("failed to add job %s to dispatch. %w", targetJob, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package housekeeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumsettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDueEscalationSteps(t *testing.T) {
+	t.Parallel()
+
+	steps := []*centrumsettings.EscalationStep{
+		{
+			Id:           "notify",
+			AfterSeconds: 60,
+			Action:       centrumsettings.EscalationAction_ESCALATION_ACTION_NOTIFY_DISPATCHERS,
+		},
+		{
+			Id:           "offer",
+			AfterSeconds: 120,
+			Action:       centrumsettings.EscalationAction_ESCALATION_ACTION_OFFER_TO_JOB,
+			TargetJob:    new("ambulance"),
+		},
+		{
+			Id:           "breach",
+			AfterSeconds: 300,
+			Action:       centrumsettings.EscalationAction_ESCALATION_ACTION_MARK_BREACHED,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		steps         []*centrumsettings.EscalationStep
+		unassignedFor time.Duration
+		done          map[string]struct{}
+		expected      []string
+	}{
+		{
+			name:          "No step due yet",
+			steps:         steps,
+			unassignedFor: 30 * time.Second,
+			expected:      []string{},
+		},
+		{
+			name:          "First step due",
+			steps:         steps,
+			unassignedFor: 90 * time.Second,
+			expected:      []string{"notify"},
+		},
+		{
+			name:          "Already run steps are skipped",
+			steps:         steps,
+			unassignedFor: 150 * time.Second,
+			done:          map[string]struct{}{"notify": {}},
+			expected:      []string{"offer"},
+		},
+		{
+			name:          "All steps due at once",
+			steps:         steps,
+			unassignedFor: 10 * time.Minute,
+			expected:      []string{"notify", "offer", "breach"},
+		},
+		{
+			name: "Reordered steps keep their runs",
+			steps: []*centrumsettings.EscalationStep{
+				steps[2],
+				steps[0],
+				steps[1],
+			},
+			unassignedFor: 10 * time.Minute,
+			done:          map[string]struct{}{"notify": {}, "offer": {}},
+			expected:      []string{"breach"},
+		},
+		{
+			name: "Inserted step is run without rerunning others",
+			steps: []*centrumsettings.EscalationStep{
+				steps[0],
+				{
+					Id:           "notify-again",
+					AfterSeconds: 90,
+					Action:       centrumsettings.EscalationAction_ESCALATION_ACTION_NOTIFY_DISPATCHERS,
+				},
+				steps[1],
+			},
+			unassignedFor: 150 * time.Second,
+			done:          map[string]struct{}{"notify": {}, "offer": {}},
+			expected:      []string{"notify-again"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			due := getDueEscalationSteps(tt.steps, tt.unassignedFor, tt.done)
+			ids := make([]string, len(due))
+			for i, step := range due {
+				ids[i] = step.GetId()
+			}
+			assert.Equal(t, tt.expected, ids, tt.name)
+		})
+	}
+}
+
+func TestIsDispatchOriginJob(t *testing.T) {
+	t.Parallel()
+
+	jobList := func(jobs ...string) *centrum.JobList {
+		out := &centrum.JobList{}
+		for _, job := range jobs {
+			out.Jobs = append(out.Jobs, &centrum.JobListEntry{Name: job})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		jobs     *centrum.JobList
+		job      string
+		expected bool
+	}{
+		{
+			name:     "Dispatch of the job",
+			jobs:     jobList("police"),
+			job:      "police",
+			expected: true,
+		},
+		{
+			name:     "Dispatch offered to the job",
+			jobs:     jobList("police", "ambulance"),
+			job:      "ambulance",
+			expected: false,
+		},
+		{
+			name:     "Offered dispatch is still escalated by its job",
+			jobs:     jobList("police", "ambulance"),
+			job:      "police",
+			expected: true,
+		},
+		{
+			name:     "Dispatch without jobs",
+			jobs:     nil,
+			job:      "police",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dsp := &centrumdispatches.Dispatch{Jobs: tt.jobs}
+			assert.Equal(t, tt.expected, isDispatchOriginJob(dsp, tt.job), tt.name)
+		})
+	}
+}
+
+func TestEscalationDefaultStepIDs(t *testing.T) {
+	t.Parallel()
+
+	// Steps without an ID fall back to their index, which is what their runs were tracked by
+	escalation := &centrumsettings.Escalation{
+		Steps: []*centrumsettings.EscalationStep{
+			{AfterSeconds: 60},
+			{Id: "offer", AfterSeconds: 120},
+			{AfterSeconds: 300},
+		},
+	}
+	escalation.Default()
+
+	assert.Equal(t, "0", escalation.GetSteps()[0].GetId())
+	assert.Equal(t, "offer", escalation.GetSteps()[1].GetId())
+	assert.Equal(t, "2", escalation.GetSteps()[2].GetId())
+}
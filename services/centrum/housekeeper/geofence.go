@@ -0,0 +1,231 @@
+package housekeeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common"
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+	centrumutils "github.com/fivenet-app/fivenet/v2026/services/centrum/utils"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+func (s *Housekeeper) runGeofenceWatch(ctx context.Context) {
+	for {
+		if err := s.geofenceWatch(ctx); err != nil {
+			if !errors.Is(err, context.Canceled) {
+				s.logger.Error("geofence watcher stopped", zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+func (s *Housekeeper) geofenceWatch(ctx context.Context) error {
+	cfg, err := tracker.RegisterGeofenceStream(ctx, s.js)
+	if err != nil {
+		return fmt.Errorf("failed to register tracker geofence stream. %w", err)
+	}
+
+	consumer, err := s.js.CreateOrUpdateConsumer(ctx, cfg.Name, jetstream.ConsumerConfig{
+		Durable: "centrum_housekeeper_geofence",
+		FilterSubject: fmt.Sprintf(
+			"%s.*.%s.*",
+			tracker.BaseSubject,
+			tracker.TopicGeofence,
+		),
+		DeliverPolicy:     jetstream.DeliverNewPolicy,
+		AckPolicy:         jetstream.AckExplicitPolicy,
+		InactiveThreshold: 1 * time.Minute, // Close consumer if inactive for 1 minute
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create/update geofence consumer. %w", err)
+	}
+
+	msgs, err := consumer.Messages()
+	if err != nil {
+		return fmt.Errorf("failed to start geofence consumer. %w", err)
+	}
+	defer msgs.Stop()
+
+	stop := context.AfterFunc(ctx, msgs.Stop)
+	defer stop()
+
+	for {
+		msg, err := msgs.Next()
+		if err != nil {
+			if errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		if err := msg.Ack(); err != nil {
+			s.logger.Error("failed to ack geofence message", zap.Error(err))
+		}
+
+		event := &livemapmarkers.GeofenceEvent{}
+		if err := protoutils.UnmarshalPartialJSON(msg.Data(), event); err != nil {
+			s.logger.Error("failed to unmarshal geofence event", zap.Error(err))
+			continue
+		}
+
+		if err := s.handleGeofenceEvent(ctx, event); err != nil {
+			s.logger.Error(
+				"failed to handle geofence event",
+				zap.Int64("marker_id", event.GetMarkerId()),
+				zap.Int32("user_id", event.GetUserId()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (s *Housekeeper) handleGeofenceEvent(
+	ctx context.Context,
+	event *livemapmarkers.GeofenceEvent,
+) error {
+	errs := multierr.Combine()
+
+	if event.GetGeofence().GetNotify() {
+		if err := s.notifyGeofenceEvent(ctx, event); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	if event.GetType() == livemapmarkers.GeofenceEventType_GEOFENCE_EVENT_TYPE_ENTER &&
+		event.GetGeofence().GetAutoOnScene() && event.GetUnitId() > 0 {
+		if err := s.setUnitOnSceneInGeofence(ctx, event); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func (s *Housekeeper) notifyGeofenceEvent(
+	ctx context.Context,
+	event *livemapmarkers.GeofenceEvent,
+) error {
+	key := "notifications.livemap.geofence.enter"
+	if event.GetType() == livemapmarkers.GeofenceEventType_GEOFENCE_EVENT_TYPE_EXIT {
+		key = "notifications.livemap.geofence.exit"
+	}
+
+	return s.notifi.NotifyUser(ctx, &notifications.Notification{
+		UserId: event.GetUserId(),
+		Title: &common.I18NItem{
+			Key: key + ".title",
+		},
+		Content: &common.I18NItem{
+			Key: key + ".content",
+			Parameters: map[string]string{
+				"name": event.GetMarkerName(),
+			},
+		},
+		Category: notifications.NotificationCategory_NOTIFICATION_CATEGORY_GENERAL,
+		Type:     notifications.NotificationType_NOTIFICATION_TYPE_INFO,
+		Data: &notifications.Data{
+			Link: &notifications.Link{
+				To: "/livemap",
+			},
+		},
+	})
+}
+
+// setUnitOnSceneInGeofence sets the user's unit "on scene" for each active dispatch assigned to the unit that is located inside the geofence.
+func (s *Housekeeper) setUnitOnSceneInGeofence(
+	ctx context.Context,
+	event *livemapmarkers.GeofenceEvent,
+) error {
+	settings, err := s.settings.Get(ctx, event.GetJob())
+	if err != nil {
+		return fmt.Errorf("failed to get centrum settings for job %s. %w", event.GetJob(), err)
+	}
+	if !settings.GetEnabled() {
+		return nil
+	}
+
+	marker, err := s.livemapStore.GetMarker(ctx, event.GetMarkerId())
+	if err != nil {
+		return fmt.Errorf("failed to get geofence marker %d. %w", event.GetMarkerId(), err)
+	}
+
+	unitId := event.GetUnitId()
+	dsps := s.dispatches.Filter(ctx, []string{event.GetJob()}, nil, nil)
+	dsps = slices.DeleteFunc(dsps, func(dsp *centrumdispatches.Dispatch) bool {
+		if centrumutils.IsStatusDispatchComplete(dsp.GetStatus().GetStatus()) {
+			return true
+		}
+
+		// Unit must have accepted the dispatch assignment
+		if !slices.ContainsFunc(dsp.GetUnits(), func(a *centrumdispatches.DispatchAssignment) bool {
+			return a.GetUnitId() == unitId && a.GetExpiresAt() == nil
+		}) {
+			return true
+		}
+
+		// Unit is already on scene
+		if dsp.GetStatus().GetStatus() == centrumdispatches.StatusDispatch_STATUS_DISPATCH_ON_SCENE &&
+			dsp.GetStatus().GetUnitId() == unitId {
+			return true
+		}
+
+		return !tracker.GeofenceContains(marker, dsp.GetX(), dsp.GetY())
+	})
+	if len(dsps) == 0 {
+		return nil
+	}
+
+	errs := multierr.Combine()
+	for _, dsp := range dsps {
+		if _, err := s.dispatches.UpdateStatus(ctx, dsp.GetId(), &centrumdispatches.DispatchStatus{
+			CreatedAt:  timestamp.Now(),
+			DispatchId: dsp.GetId(),
+			UnitId:     &unitId,
+			Status:     centrumdispatches.StatusDispatch_STATUS_DISPATCH_ON_SCENE,
+			UserId:     &event.UserId,
+			X:          &event.X,
+			Y:          &event.Y,
+		}); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+	}
+
+	// Set unit to busy, same as when a unit sets itself on scene
+	unit, err := s.units.Get(ctx, unitId)
+	if err != nil {
+		return multierr.Append(errs, err)
+	}
+	if unit.GetStatus() == nil ||
+		unit.GetStatus().GetStatus() != centrumunits.StatusUnit_STATUS_UNIT_BUSY {
+		if _, err := s.units.UpdateStatus(ctx, unitId, &centrumunits.UnitStatus{
+			CreatedAt: timestamp.Now(),
+			UnitId:    unitId,
+			Status:    centrumunits.StatusUnit_STATUS_UNIT_BUSY,
+			UserId:    &event.UserId,
+		}); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	return errs
+}
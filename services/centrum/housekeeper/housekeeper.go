@@ -12,6 +12,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/croner"
 	"github.com/fivenet-app/fivenet/v2026/pkg/events"
 	"github.com/fivenet-app/fivenet/v2026/pkg/nats/leaderelection"
+	"github.com/fivenet-app/fivenet/v2026/pkg/notifi"
 	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
 	"github.com/fivenet-app/fivenet/v2026/pkg/utils/instance"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatchers"
@@ -19,6 +20,8 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/helpers"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/settings"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/units"
+	livemapstore "github.com/fivenet-app/fivenet/v2026/stores/livemap"
+	syncstore "github.com/fivenet-app/fivenet/v2026/stores/sync"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
@@ -45,7 +48,9 @@ type Housekeeper struct {
 
 	tracer  trace.Tracer
 	db      *sql.DB
+	js      *events.JSWrapper
 	tracker tracker.ITracker
+	notifi  notifi.INotifi
 	le      *leaderelection.LeaderElector
 
 	helpers     *helpers.Helpers
@@ -53,6 +58,9 @@ type Housekeeper struct {
 	dispatchers *dispatchers.DispatchersDB
 	units       *units.UnitDB
 	dispatches  *dispatches.DispatchDB
+
+	livemapStore livemapstore.IStore
+	syncStore    syncstore.IStore
 }
 
 type Params struct {
@@ -66,12 +74,16 @@ type Params struct {
 	JS      *events.JSWrapper
 	Config  *config.Config
 	Tracker tracker.ITracker
+	Notifi  notifi.INotifi
 
 	Helpers     *helpers.Helpers
 	Settings    *settings.SettingsDB
 	Dispatchers *dispatchers.DispatchersDB
 	Units       *units.UnitDB
 	Dispatches  *dispatches.DispatchDB
+
+	LivemapStore livemapstore.IStore
+	SyncStore    syncstore.IStore
 }
 
 type Result struct {
@@ -91,13 +103,18 @@ func New(p Params) Result {
 
 		tracer:  p.TP.Tracer("centrum.manager.housekeeper"),
 		db:      p.DB,
+		js:      p.JS,
 		tracker: p.Tracker,
+		notifi:  p.Notifi,
 
 		helpers:     p.Helpers,
 		settings:    p.Settings,
 		dispatchers: p.Dispatchers,
 		units:       p.Units,
 		dispatches:  p.Dispatches,
+
+		livemapStore: p.LivemapStore,
+		syncStore:    p.SyncStore,
 	}
 
 	p.LC.Append(fx.StartHook(func(ctxStartup context.Context) error {
@@ -163,6 +180,10 @@ func (s *Housekeeper) start(ctx context.Context) {
 	s.wg.Go(func() {
 		s.runTTLWatcher(ctx)
 	})
+
+	s.wg.Go(func() {
+		s.runGeofenceWatch(ctx)
+	})
 }
 
 func (s *Housekeeper) RegisterCronjobs(ctx context.Context, registry croner.IRegistry) error {
@@ -196,6 +217,14 @@ func (s *Housekeeper) RegisterCronjobs(ctx context.Context, registry croner.IReg
 		return err
 	}
 
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "centrum.housekeeper.dispatch_escalation",
+		Schedule: "*/5 * * * * * *", // Every 5 seconds
+		Timeout:  durationpb.New(4 * time.Second),
+	}); err != nil {
+		return err
+	}
+
 	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
 		Name:     "centrum.housekeeper.cleanup_units",
 		Schedule: "15 * * * *", // Every hour at 15 minutes past the hour
@@ -204,6 +233,14 @@ func (s *Housekeeper) RegisterCronjobs(ctx context.Context, registry croner.IReg
 		return err
 	}
 
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "centrum.housekeeper.unit_rosters",
+		Schedule: "@always", // Every minute
+		Timeout:  durationpb.New(30 * time.Second),
+	}); err != nil {
+		return err
+	}
+
 	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
 		Name:     "centrum.housekeeper.cancel_old_dispatches",
 		Schedule: "*/12 * * * * * *", // Every 12 hours
@@ -236,6 +273,14 @@ func (s *Housekeeper) RegisterCronjobs(ctx context.Context, registry croner.IReg
 		return err
 	}
 
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "centrum.housekeeper.prune_position_history",
+		Schedule: "*/5 * * * *", // Every 5 minutes
+		Timeout:  durationpb.New(30 * time.Second),
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -245,11 +290,14 @@ func (s *Housekeeper) RegisterCronjobHandlers(h *croner.Handlers) error {
 		"centrum.housekeeper.dispatch_assignment_expiration",
 		s.runHandleDispatchAssignmentExpiration,
 	)
+	h.Add("centrum.housekeeper.dispatch_escalation", s.runDispatchEscalation)
 	h.Add("centrum.housekeeper.cleanup_units", s.runCleanupUnits)
+	h.Add("centrum.housekeeper.unit_rosters", s.runUnitRosters)
 	h.Add("centrum.housekeeper.cancel_old_dispatches", s.runCancelOldDispatches)
 	h.Add("centrum.housekeeper.delete_old_dispatches", s.runDeleteOldDispatches)
 	h.Add("centrum.housekeeper.delete_old_dispatches_from_kv", s.runDeleteOldDispatchesFromKV)
 	h.Add("centrum.housekeeper.cleanup_dispatchers", s.runCleanupDispatchers)
+	h.Add("centrum.housekeeper.prune_position_history", s.runPrunePositionHistory)
 
 	return nil
 }
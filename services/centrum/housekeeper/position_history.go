@@ -0,0 +1,69 @@
+package housekeeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
+	"go.uber.org/zap"
+)
+
+const (
+	prunePositionHistoryDeletedAttr = "samples_deleted"
+
+	// Max. amount of position samples deleted per batch and batches per run
+	prunePositionHistoryBatchSize  = 2500
+	prunePositionHistoryMaxBatches = 8
+)
+
+func (s *Housekeeper) runPrunePositionHistory(ctx context.Context, data *cron.CronjobData) error {
+	ctx, span := s.tracer.Start(ctx, "centrum.position-history-prune")
+	defer span.End()
+
+	dest := &cron.GenericCronData{
+		Attributes: map[string]string{},
+	}
+	if err := data.Unmarshal(dest); err != nil {
+		s.logger.Warn("failed to unmarshal prune position history cron data", zap.Error(err))
+	}
+
+	deleted, err := s.prunePositionHistory(ctx)
+	if err != nil {
+		s.logger.Error("failed to prune position history", zap.Error(err))
+		return err
+	}
+
+	dest.SetAttribute(prunePositionHistoryDeletedAttr, strconv.FormatInt(deleted, 10))
+	if err := data.MarshalFrom(dest); err != nil {
+		return fmt.Errorf("failed to marshal updated prune position history cron data. %w", err)
+	}
+
+	return nil
+}
+
+// prunePositionHistory deletes position samples older than the retention period in batches.
+func (s *Housekeeper) prunePositionHistory(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-tracker.PositionHistoryRetention)
+
+	total := int64(0)
+	for range prunePositionHistoryMaxBatches {
+		deleted, err := s.livemapStore.DeletePositionSamples(
+			ctx,
+			before,
+			prunePositionHistoryBatchSize,
+		)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+
+		if deleted < prunePositionHistoryBatchSize {
+			break
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,238 @@
+package housekeeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	pbsync "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/sync"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	unitRostersMembersAssignedAttr = "members_assigned"
+	unitRostersMembersRemovedAttr  = "members_removed"
+	unitRostersEndedAttr           = "rosters_ended"
+)
+
+func (s *Housekeeper) runUnitRosters(ctx context.Context, data *cron.CronjobData) error {
+	ctx, span := s.tracer.Start(ctx, "centrum.unit-rosters")
+	defer span.End()
+
+	dest := &cron.GenericCronData{
+		Attributes: map[string]string{},
+	}
+	if err := data.Unmarshal(dest); err != nil {
+		s.logger.Warn("failed to unmarshal unit rosters cron data", zap.Error(err))
+	}
+
+	assigned, removed, ended, err := s.handleUnitRosters(ctx, time.Now())
+	if err != nil {
+		s.logger.Error("failed to handle unit rosters", zap.Error(err))
+	}
+
+	dest.SetAttribute(unitRostersMembersAssignedAttr, strconv.Itoa(assigned))
+	dest.SetAttribute(unitRostersMembersRemovedAttr, strconv.Itoa(removed))
+	dest.SetAttribute(unitRostersEndedAttr, strconv.Itoa(ended))
+
+	if err := data.MarshalFrom(dest); err != nil {
+		return fmt.Errorf("failed to marshal updated unit rosters cron data. %w", err)
+	}
+
+	return err
+}
+
+// handleUnitRosters assigns roster members to their unit while the roster's shift is running and removes them
+// (and closes their timeclock) once the shift has ended.
+func (s *Housekeeper) handleUnitRosters(ctx context.Context, now time.Time) (int, int, int, error) {
+	rosters, err := s.units.ListDueRosters(ctx, now)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to list due unit rosters. %w", err)
+	}
+
+	errs := multierr.Combine()
+	assigned := 0
+	removed := 0
+	ended := 0
+	for _, roster := range rosters {
+		if !roster.GetEndTime().AsTime().After(now) {
+			n, err := s.endUnitRoster(ctx, roster)
+			if err != nil {
+				errs = multierr.Append(errs, err)
+				continue
+			}
+			removed += n
+			ended++
+			continue
+		}
+
+		n, err := s.startUnitRoster(ctx, roster)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+		}
+		assigned += n
+	}
+
+	return assigned, removed, ended, errs
+}
+
+// startUnitRoster joins the roster's members to the unit. Members that aren't on duty yet, are absent or are part of
+// another unit are skipped (and retried on the next run).
+func (s *Housekeeper) startUnitRoster(
+	ctx context.Context,
+	roster *centrumunits.UnitRoster,
+) (int, error) {
+	if roster.GetStartedAt() == nil {
+		if err := s.units.SetRosterStarted(ctx, roster.GetId()); err != nil {
+			return 0, err
+		}
+	}
+
+	toAdd := []int32{}
+	for _, member := range roster.GetMembers() {
+		if member.GetAssignedAt() != nil || member.GetAbsenceConflict() {
+			continue
+		}
+
+		userId := member.GetUserId()
+		if !s.tracker.IsUserOnDuty(userId) {
+			continue
+		}
+
+		mapping, ok, err := s.tracker.GetUserMapping(userId)
+		if err != nil {
+			continue
+		}
+		if ok && mapping.UnitId != nil && mapping.GetUnitId() > 0 {
+			// Don't pull the member out of another unit
+			if mapping.GetUnitId() != roster.GetUnitId() {
+				continue
+			}
+
+			if err := s.units.SetRosterMemberAssigned(ctx, roster.GetId(), userId); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		toAdd = append(toAdd, userId)
+	}
+
+	if len(toAdd) == 0 {
+		return 0, nil
+	}
+
+	s.logger.Debug(
+		"assigning roster members to unit",
+		zap.String("job", roster.GetJob()),
+		zap.Int64("unit_id", roster.GetUnitId()),
+		zap.Int64("roster_id", roster.GetId()),
+		zap.Int32s("user_ids", toAdd),
+	)
+
+	if err := s.units.UpdateUnitAssignments(
+		ctx,
+		roster.GetJob(),
+		nil,
+		roster.GetUnitId(),
+		toAdd,
+		nil,
+	); err != nil {
+		return 0, fmt.Errorf(
+			"failed to assign roster %d members to unit %d. %w",
+			roster.GetId(),
+			roster.GetUnitId(),
+			err,
+		)
+	}
+
+	errs := multierr.Combine()
+	for _, userId := range toAdd {
+		if err := s.units.SetRosterMemberAssigned(ctx, roster.GetId(), userId); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	return len(toAdd), errs
+}
+
+// endUnitRoster removes the members that have been assigned by the roster from the unit and closes their timeclock.
+func (s *Housekeeper) endUnitRoster(
+	ctx context.Context,
+	roster *centrumunits.UnitRoster,
+) (int, error) {
+	assigned := []int32{}
+	for _, member := range roster.GetMembers() {
+		if member.GetAssignedAt() != nil {
+			assigned = append(assigned, member.GetUserId())
+		}
+	}
+
+	toRemove := []int32{}
+	if len(assigned) > 0 {
+		unit, err := s.units.Get(ctx, roster.GetUnitId())
+		if err == nil {
+			for _, user := range unit.GetUsers() {
+				if slices.Contains(assigned, user.GetUserId()) {
+					toRemove = append(toRemove, user.GetUserId())
+				}
+			}
+		}
+	}
+
+	if len(toRemove) > 0 {
+		s.logger.Debug(
+			"removing roster members from unit after shift end",
+			zap.String("job", roster.GetJob()),
+			zap.Int64("unit_id", roster.GetUnitId()),
+			zap.Int64("roster_id", roster.GetId()),
+			zap.Int32s("user_ids", toRemove),
+		)
+
+		if err := s.units.UpdateUnitAssignments(
+			ctx,
+			roster.GetJob(),
+			nil,
+			roster.GetUnitId(),
+			nil,
+			toRemove,
+		); err != nil {
+			return 0, fmt.Errorf(
+				"failed to remove roster %d members from unit %d. %w",
+				roster.GetId(),
+				roster.GetUnitId(),
+				err,
+			)
+		}
+	}
+
+	if err := s.closeTimeclocks(ctx, roster.GetJob(), assigned); err != nil {
+		return len(toRemove), err
+	}
+
+	if err := s.units.SetRosterEnded(ctx, roster.GetId()); err != nil {
+		return len(toRemove), err
+	}
+
+	return len(toRemove), nil
+}
+
+func (s *Housekeeper) closeTimeclocks(ctx context.Context, job string, userIds []int32) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	if _, err := s.syncStore.EndActiveJobTimeclocks(ctx, &pbsync.EndActiveJobTimeclocksRequest{
+		Job:     &job,
+		UserIds: userIds,
+	}); err != nil {
+		return fmt.Errorf("failed to close timeclock entries. %w", err)
+	}
+
+	return nil
+}
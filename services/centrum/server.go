@@ -19,6 +19,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/housekeeper"
 	"github.com/fivenet-app/fivenet/v2026/pkg/mstlystcdata"
 	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+	"github.com/fivenet-app/fivenet/v2026/pkg/stats"
 	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
 	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
 	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatchers"
@@ -107,6 +108,7 @@ type Server struct {
 	appCfg   appconfig.IConfig
 	enricher mstlystcdata.IUserAwareEnricher
 	jobs     mstlystcdata.IJobs
+	stats    *stats.Service
 
 	helpers     *helpers.Helpers
 	settings    *settings.SettingsDB
@@ -131,6 +133,7 @@ type Params struct {
 	Postals   postals.Postals
 	Enricher  mstlystcdata.IUserAwareEnricher
 	Jobs      mstlystcdata.IJobs
+	Stats     *stats.Service
 
 	Helpers     *helpers.Helpers
 	Settings    *settings.SettingsDB
@@ -163,6 +166,7 @@ func NewServer(p Params) Result {
 		appCfg:   p.AppConfig,
 		enricher: p.Enricher,
 		jobs:     p.Jobs,
+		stats:    p.Stats,
 
 		helpers:     p.Helpers,
 		settings:    p.Settings,
@@ -214,6 +218,14 @@ func (s *Server) RegisterCronjobs(ctx context.Context, registry croner.IRegistry
 		return err
 	}
 
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "centrum.dispatch.stats",
+		Schedule: "*/15 * * * *", // Every 15 minutes
+		Timeout:  durationpb.New(5 * time.Minute),
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -246,6 +258,8 @@ func (s *Server) RegisterCronjobHandlers(hand *croner.Handlers) error {
 		return nil
 	})
 
+	hand.Add("centrum.dispatch.stats", s.runDispatchStats)
+
 	return nil
 }
 
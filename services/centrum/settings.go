@@ -10,6 +10,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
 	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
 	errorscentrum "github.com/fivenet-app/fivenet/v2026/services/centrum/errors"
+	"github.com/google/uuid"
 )
 
 func (s *Server) GetSettings(
@@ -86,6 +87,13 @@ func (s *Server) UpdateSettings(
 		req.Settings.Public = current.GetPublic()
 	}
 
+	// New escalation steps get a stable ID, runs of a step are tracked by it
+	for _, step := range req.GetSettings().GetTimings().GetEscalation().GetSteps() {
+		if step.GetId() == "" {
+			step.Id = uuid.NewString()
+		}
+	}
+
 	settings, err := s.settings.Update(ctx, userInfo.GetJob(), req.GetSettings())
 	if err != nil {
 		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
@@ -0,0 +1,289 @@
+package centrum
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	pbcentrum "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	pkgstats "github.com/fivenet-app/fivenet/v2026/pkg/stats"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/timeutils"
+	errorscentrum "github.com/fivenet-app/fivenet/v2026/services/centrum/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dispatchStatsRowsAttr = "rows"
+	// Next day of the existing dispatches to build the rollups for, see backfillDispatchStats
+	dispatchStatsBackfillDayAttr = "backfill_day"
+	dispatchStatsBackfilledAttr  = "backfilled"
+)
+
+// Dispatches are usually completed within a day, rebuild the last days to catch late status changes
+const dispatchStatsRebuildDays = 2
+
+// Days of existing dispatches backfilled per run, keeps each run well within the cronjob's timeout
+const dispatchStatsBackfillDays = 14
+
+func (s *Server) GetDispatchStats(
+	ctx context.Context,
+	req *pbcentrum.GetDispatchStatsRequest,
+) (*pbcentrum.GetDispatchStatsResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	start := time.Now().UTC().AddDate(0, 0, -14)
+	end := time.Now().UTC()
+	if req.GetStart() != nil {
+		start = req.GetStart().AsTime()
+	}
+	if req.GetEnd() != nil {
+		end = req.GetEnd().AsTime()
+	}
+
+	if end.Before(start) {
+		return nil, status.Error(codes.InvalidArgument, "end must not be before start")
+	}
+
+	if end.Sub(start) > 365*24*time.Hour {
+		return nil, status.Error(codes.InvalidArgument, "range must not exceed 365 days")
+	}
+
+	dimension := ""
+	switch req.GetDimension() {
+	case centrumdispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_JOB:
+		dimension = pkgstats.DispatchDimensionJob
+	case centrumdispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_UNIT:
+		dimension = pkgstats.DispatchDimensionUnit
+	case centrumdispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_POSTAL:
+		dimension = pkgstats.DispatchDimensionPostal
+	case centrumdispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_UNSPECIFIED:
+	}
+
+	values, err := s.stats.QueryDispatchStats(ctx, start, end, userInfo.GetJob(), dimension)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	resp := &pbcentrum.GetDispatchStatsResponse{
+		Stats: s.toDispatchStats(ctx, userInfo.GetJob(), values),
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_VIEWED)
+
+	return resp, nil
+}
+
+func (s *Server) toDispatchStats(
+	ctx context.Context,
+	job string,
+	values []*pkgstats.DispatchStatsValue,
+) []*centrumdispatches.DispatchStats {
+	unitNames := map[string]string{}
+	for _, unit := range s.units.List(ctx, []string{job}) {
+		unitNames[strconv.FormatInt(unit.GetId(), 10)] = unit.GetName()
+	}
+
+	type durationSum struct {
+		sum   int64
+		count int64
+	}
+
+	stats := []*centrumdispatches.DispatchStats{}
+	byKey := map[string]*centrumdispatches.DispatchStats{}
+	sums := map[string]map[string]*durationSum{}
+	for _, value := range values {
+		mapKey := value.Dimension + "/" + value.Key
+
+		stat, ok := byKey[mapKey]
+		if !ok {
+			stat = &centrumdispatches.DispatchStats{
+				Key: value.Key,
+			}
+
+			switch value.Dimension {
+			case pkgstats.DispatchDimensionJob:
+				stat.Dimension = centrumdispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_JOB
+			case pkgstats.DispatchDimensionUnit:
+				stat.Dimension = centrumdispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_UNIT
+				if name, ok := unitNames[value.Key]; ok {
+					stat.Label = &name
+				}
+			case pkgstats.DispatchDimensionPostal:
+				stat.Dimension = centrumdispatches.DispatchStatsDimension_DISPATCH_STATS_DIMENSION_POSTAL
+			default:
+				continue
+			}
+
+			byKey[mapKey] = stat
+			sums[mapKey] = map[string]*durationSum{}
+			stats = append(stats, stat)
+		}
+
+		switch value.MetricKey {
+		case pkgstats.DispatchMetricCount:
+			stat.Dispatches = value.Value
+
+		case pkgstats.DispatchMetricSLABreachCount:
+			stat.SlaBreaches = value.Value
+
+		default:
+			metric, isSum := strings.CutSuffix(value.MetricKey, pkgstats.DispatchMetricSumSuffix)
+			if !isSum {
+				var isCount bool
+				metric, isCount = strings.CutSuffix(value.MetricKey, pkgstats.DispatchMetricCountSuffix)
+				if !isCount {
+					continue
+				}
+			}
+
+			sum, ok := sums[mapKey][metric]
+			if !ok {
+				sum = &durationSum{}
+				sums[mapKey][metric] = sum
+			}
+			if isSum {
+				sum.sum = value.Value
+			} else {
+				sum.count = value.Value
+			}
+		}
+	}
+
+	toDuration := func(sum *durationSum) *centrumdispatches.DispatchDurationStat {
+		stat := &centrumdispatches.DispatchDurationStat{}
+		if sum == nil || sum.count == 0 {
+			return stat
+		}
+
+		stat.Count = sum.count
+		stat.AvgSeconds = float64(sum.sum) / float64(sum.count)
+		return stat
+	}
+
+	for mapKey, stat := range byKey {
+		stat.TimeToAssign = toDuration(sums[mapKey][pkgstats.DispatchMetricTimeToAssign])
+		stat.TimeToEnRoute = toDuration(sums[mapKey][pkgstats.DispatchMetricTimeToEnRoute])
+		stat.TimeOnScene = toDuration(sums[mapKey][pkgstats.DispatchMetricTimeOnScene])
+		stat.HandlingTime = toDuration(sums[mapKey][pkgstats.DispatchMetricHandlingTime])
+	}
+
+	return stats
+}
+
+func (s *Server) runDispatchStats(ctx context.Context, data *cron.CronjobData) error {
+	ctx, span := s.tracer.Start(ctx, "centrum.dispatch.stats")
+	defer span.End()
+
+	dest := &cron.GenericCronData{
+		Attributes: map[string]string{},
+	}
+	if err := data.Unmarshal(dest); err != nil {
+		s.logger.Warn("failed to unmarshal centrum dispatch stats cron data", zap.Error(err))
+	}
+
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -dispatchStatsRebuildDays)
+
+	if err := s.backfillDispatchStats(ctx, dest, start); err != nil {
+		s.logger.Error("failed to backfill centrum dispatch stats", zap.Error(err))
+		return err
+	}
+
+	rows, err := s.stats.BuildDispatchMetrics(ctx, start, end)
+	if err != nil {
+		s.logger.Error("failed to build centrum dispatch stats", zap.Error(err))
+		return err
+	}
+	dest.SetAttribute(dispatchStatsRowsAttr, strconv.FormatInt(rows, 10))
+
+	if err := data.MarshalFrom(dest); err != nil {
+		return fmt.Errorf("failed to marshal updated centrum dispatch stats cron data. %w", err)
+	}
+
+	return nil
+}
+
+// backfillDispatchStats builds the rollups of the dispatches that have been created before the stats job existed.
+// Each run handles a few days starting at the oldest dispatch, until the days rebuilt by the regular runs are reached.
+func (s *Server) backfillDispatchStats(
+	ctx context.Context,
+	dest *cron.GenericCronData,
+	recentStart time.Time,
+) error {
+	if dest.HasAttribute(dispatchStatsBackfilledAttr) {
+		return nil
+	}
+
+	var day time.Time
+	if raw := dest.GetAttribute(dispatchStatsBackfillDayAttr); raw != "" {
+		if parsed, err := time.Parse(time.DateOnly, raw); err == nil {
+			day = parsed
+		}
+	}
+	if day.IsZero() {
+		oldest, err := s.stats.GetOldestDispatchDay(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get oldest dispatch day. %w", err)
+		}
+		// No dispatches yet, nothing to backfill
+		if oldest.IsZero() {
+			oldest = recentStart
+		}
+		day = oldest
+	}
+
+	start, end, ok := getDispatchStatsBackfillRange(day, recentStart)
+	if !ok {
+		dest.DeleteAttribute(dispatchStatsBackfillDayAttr)
+		dest.SetAttribute(dispatchStatsBackfilledAttr, "true")
+		return nil
+	}
+
+	s.logger.Info(
+		"backfilling centrum dispatch stats",
+		zap.Time("start", start),
+		zap.Time("end", end),
+	)
+
+	if _, err := s.stats.BuildDispatchMetrics(ctx, start, end); err != nil {
+		return err
+	}
+	dest.SetAttribute(dispatchStatsBackfillDayAttr, end.AddDate(0, 0, 1).Format(time.DateOnly))
+
+	return nil
+}
+
+// getDispatchStatsBackfillRange returns the days (inclusive) to backfill next, starting at the given day.
+// Returns false once the backfill has reached the days that are rebuilt by the regular runs.
+func getDispatchStatsBackfillRange(
+	day time.Time,
+	recentStart time.Time,
+) (time.Time, time.Time, bool) {
+	day = timeutils.StartOfDay(day.UTC())
+	recentStart = timeutils.StartOfDay(recentStart.UTC())
+
+	// Stats can't be queried for more than a year
+	if minDay := recentStart.AddDate(-1, 0, 0); day.Before(minDay) {
+		day = minDay
+	}
+	if !day.Before(recentStart) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	end := day.AddDate(0, 0, dispatchStatsBackfillDays-1)
+	if !end.Before(recentStart) {
+		end = recentStart.AddDate(0, 0, -1)
+	}
+
+	return day, end, true
+}
@@ -0,0 +1,63 @@
+package centrum
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDispatchStatsBackfillRange(t *testing.T) {
+	t.Parallel()
+
+	recentStart := time.Date(2026, time.March, 20, 14, 30, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name          string
+		day           time.Time
+		expectedStart time.Time
+		expectedEnd   time.Time
+		expectedOk    bool
+	}{
+		{
+			name:          "Full backfill window",
+			day:           date(time.January, 10),
+			expectedStart: date(time.January, 10),
+			expectedEnd:   date(time.January, 23),
+			expectedOk:    true,
+		},
+		{
+			name:          "Window ends before the recent days",
+			day:           date(time.March, 15),
+			expectedStart: date(time.March, 15),
+			expectedEnd:   date(time.March, 19),
+			expectedOk:    true,
+		},
+		{
+			name:          "Days older than a year are skipped",
+			day:           time.Date(2024, time.June, 1, 8, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC),
+			expectedOk:    true,
+		},
+		{
+			name:       "Reached the recent days",
+			day:        date(time.March, 20),
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start, end, ok := getDispatchStatsBackfillRange(tt.day, recentStart)
+			assert.Equal(t, tt.expectedOk, ok, tt.name)
+			assert.Equal(t, tt.expectedStart, start, tt.name)
+			assert.Equal(t, tt.expectedEnd, end, tt.name)
+		})
+	}
+}
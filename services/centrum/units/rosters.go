@@ -0,0 +1,524 @@
+package units
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/timeutils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	usersstore "github.com/fivenet-app/fivenet/v2026/stores/users"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+var ErrRosterNotFound = errors.New("unit roster not found")
+
+type RosterQuery struct {
+	Job    string
+	UnitID *int64
+	Start  time.Time
+	End    time.Time
+}
+
+type rosterMemberRow struct {
+	RosterID     int64      `alias:"roster_id"`
+	UserID       int32      `alias:"user_id"`
+	AssignedAt   *time.Time `alias:"assigned_at"`
+	AbsenceBegin *time.Time `alias:"absence_begin"`
+	AbsenceEnd   *time.Time `alias:"absence_end"`
+}
+
+func rosterColumns(tRosters *table.FivenetCentrumUnitsRostersTable) mysql.ProjectionList {
+	return mysql.ProjectionList{
+		tRosters.ID,
+		tRosters.CreatedAt,
+		tRosters.UpdatedAt,
+		tRosters.Job,
+		tRosters.UnitID,
+		tRosters.StartTime,
+		tRosters.EndTime,
+		tRosters.CalendarEntryID,
+		tRosters.CreatorID,
+		tRosters.StartedAt,
+		tRosters.EndedAt,
+	}
+}
+
+// ListRosters returns the rosters of a job that overlap with the given time window.
+func (s *UnitDB) ListRosters(
+	ctx context.Context,
+	q RosterQuery,
+) ([]*centrumunits.UnitRoster, error) {
+	tRosters := table.FivenetCentrumUnitsRosters.AS("unit_roster")
+
+	condition := mysql.AND(
+		tRosters.Job.EQ(mysql.String(q.Job)),
+		tRosters.StartTime.LT(mysql.TimestampT(q.End)),
+		tRosters.EndTime.GT(mysql.TimestampT(q.Start)),
+	)
+	if q.UnitID != nil {
+		condition = condition.AND(tRosters.UnitID.EQ(mysql.Int64(*q.UnitID)))
+	}
+
+	stmt := tRosters.
+		SELECT(rosterColumns(tRosters)).
+		FROM(tRosters).
+		WHERE(condition).
+		ORDER_BY(tRosters.StartTime.ASC(), tRosters.ID.ASC()).
+		LIMIT(500)
+
+	rosters := []*centrumunits.UnitRoster{}
+	if err := stmt.QueryContext(ctx, s.db, &rosters); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if err := s.loadRosterMembers(ctx, rosters, true); err != nil {
+		return nil, err
+	}
+
+	return rosters, nil
+}
+
+func (s *UnitDB) GetRoster(ctx context.Context, id int64) (*centrumunits.UnitRoster, error) {
+	tRosters := table.FivenetCentrumUnitsRosters.AS("unit_roster")
+
+	stmt := tRosters.
+		SELECT(rosterColumns(tRosters)).
+		FROM(tRosters).
+		WHERE(tRosters.ID.EQ(mysql.Int64(id))).
+		LIMIT(1)
+
+	roster := &centrumunits.UnitRoster{}
+	if err := stmt.QueryContext(ctx, s.db, roster); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrRosterNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.loadRosterMembers(ctx, []*centrumunits.UnitRoster{roster}, true); err != nil {
+		return nil, err
+	}
+
+	return roster, nil
+}
+
+// ListDueRosters returns rosters that have started but haven't been ended yet.
+func (s *UnitDB) ListDueRosters(
+	ctx context.Context,
+	now time.Time,
+) ([]*centrumunits.UnitRoster, error) {
+	tRosters := table.FivenetCentrumUnitsRosters.AS("unit_roster")
+
+	stmt := tRosters.
+		SELECT(rosterColumns(tRosters)).
+		FROM(tRosters).
+		WHERE(mysql.AND(
+			tRosters.EndedAt.IS_NULL(),
+			tRosters.StartTime.LT_EQ(mysql.TimestampT(now)),
+		)).
+		ORDER_BY(tRosters.StartTime.ASC()).
+		LIMIT(250)
+
+	rosters := []*centrumunits.UnitRoster{}
+	if err := stmt.QueryContext(ctx, s.db, &rosters); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if err := s.loadRosterMembers(ctx, rosters, false); err != nil {
+		return nil, err
+	}
+
+	return rosters, nil
+}
+
+// loadRosterMembers loads the members of the rosters and flags members with an absence overlapping the roster.
+func (s *UnitDB) loadRosterMembers(
+	ctx context.Context,
+	rosters []*centrumunits.UnitRoster,
+	withUsers bool,
+) error {
+	if len(rosters) == 0 {
+		return nil
+	}
+
+	ids := make([]mysql.Expression, len(rosters))
+	byId := make(map[int64]*centrumunits.UnitRoster, len(rosters))
+	for i, roster := range rosters {
+		ids[i] = mysql.Int64(roster.GetId())
+		byId[roster.GetId()] = roster
+		roster.Members = []*centrumunits.UnitRosterMember{}
+	}
+
+	tRosters := table.FivenetCentrumUnitsRosters
+	tRosterUsers := table.FivenetCentrumUnitsRostersUsers
+	tColleagueProps := table.FivenetJobColleagueProps
+
+	stmt := tRosterUsers.
+		SELECT(
+			tRosterUsers.RosterID.AS("roster_member_row.roster_id"),
+			tRosterUsers.UserID.AS("roster_member_row.user_id"),
+			tRosterUsers.AssignedAt.AS("roster_member_row.assigned_at"),
+			tColleagueProps.AbsenceBegin.AS("roster_member_row.absence_begin"),
+			tColleagueProps.AbsenceEnd.AS("roster_member_row.absence_end"),
+		).
+		FROM(
+			tRosterUsers.
+				INNER_JOIN(tRosters,
+					tRosters.ID.EQ(tRosterUsers.RosterID),
+				).
+				LEFT_JOIN(tColleagueProps,
+					mysql.AND(
+						tColleagueProps.UserID.EQ(tRosterUsers.UserID),
+						tColleagueProps.Job.EQ(tRosters.Job),
+						tColleagueProps.DeletedAt.IS_NULL(),
+					),
+				),
+		).
+		WHERE(tRosterUsers.RosterID.IN(ids...))
+
+	dest := []*rosterMemberRow{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return err
+		}
+	}
+
+	userIds := []int32{}
+	for _, row := range dest {
+		roster, ok := byId[row.RosterID]
+		if !ok {
+			continue
+		}
+
+		member := &centrumunits.UnitRosterMember{
+			RosterId:        row.RosterID,
+			UserId:          row.UserID,
+			AbsenceConflict: absenceOverlaps(roster, row.AbsenceBegin, row.AbsenceEnd),
+		}
+		if row.AssignedAt != nil {
+			member.AssignedAt = timestamp.New(*row.AssignedAt)
+		}
+		roster.Members = append(roster.Members, member)
+		userIds = append(userIds, row.UserID)
+	}
+
+	if !withUsers || len(userIds) == 0 {
+		return nil
+	}
+
+	colleagues, err := usersstore.RetrieveColleagueById(ctx, s.db, s.enricher, userIds...)
+	if err != nil {
+		return err
+	}
+
+	for _, roster := range rosters {
+		for _, member := range roster.GetMembers() {
+			for _, colleague := range colleagues {
+				if colleague.GetUserId() == member.GetUserId() {
+					member.User = colleague
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// absenceOverlaps checks if the absence (dates, inclusive) overlaps with the roster's time window.
+func absenceOverlaps(roster *centrumunits.UnitRoster, begin *time.Time, end *time.Time) bool {
+	if begin == nil || end == nil || roster.GetStartTime() == nil || roster.GetEndTime() == nil {
+		return false
+	}
+
+	absenceStart := timeutils.StartOfDay(*begin)
+	absenceEnd := timeutils.StartOfDay(*end).AddDate(0, 0, 1)
+
+	return absenceStart.Before(roster.GetEndTime().AsTime()) &&
+		absenceEnd.After(roster.GetStartTime().AsTime())
+}
+
+// CreateOrUpdateRoster creates or updates the roster and its members.
+// Already assigned members keep their assignment state, assigned members that are no longer listed in a running
+// roster are removed from the unit.
+func (s *UnitDB) CreateOrUpdateRoster(
+	ctx context.Context,
+	roster *centrumunits.UnitRoster,
+) (*centrumunits.UnitRoster, error) {
+	tRosters := table.FivenetCentrumUnitsRosters
+	tRosterUsers := table.FivenetCentrumUnitsRostersUsers
+
+	var current *centrumunits.UnitRoster
+	if roster.GetId() > 0 {
+		var err error
+		current, err = s.GetRoster(ctx, roster.GetId())
+		if err != nil && !errors.Is(err, ErrRosterNotFound) {
+			return nil, err
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rosterId := roster.GetId()
+	if rosterId <= 0 {
+		stmt := tRosters.
+			INSERT(
+				tRosters.Job,
+				tRosters.UnitID,
+				tRosters.StartTime,
+				tRosters.EndTime,
+				tRosters.CalendarEntryID,
+				tRosters.CreatorID,
+			).
+			VALUES(
+				roster.GetJob(),
+				roster.GetUnitId(),
+				roster.GetStartTime(),
+				roster.GetEndTime(),
+				roster.CalendarEntryId,
+				roster.CreatorId,
+			)
+
+		res, err := stmt.ExecContext(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		lastId, err := res.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		rosterId = lastId
+	} else {
+		stmt := tRosters.
+			UPDATE(
+				tRosters.UnitID,
+				tRosters.StartTime,
+				tRosters.EndTime,
+			).
+			SET(
+				roster.GetUnitId(),
+				roster.GetStartTime(),
+				roster.GetEndTime(),
+			).
+			WHERE(mysql.AND(
+				tRosters.ID.EQ(mysql.Int64(rosterId)),
+				tRosters.Job.EQ(mysql.String(roster.GetJob())),
+			)).
+			LIMIT(1)
+
+		if _, err := stmt.ExecContext(ctx, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	userIds := make([]mysql.Expression, 0, len(roster.GetMembers()))
+	for _, member := range roster.GetMembers() {
+		userIds = append(userIds, mysql.Int32(member.GetUserId()))
+	}
+
+	deleteCondition := tRosterUsers.RosterID.EQ(mysql.Int64(rosterId))
+	if len(userIds) > 0 {
+		deleteCondition = deleteCondition.AND(tRosterUsers.UserID.NOT_IN(userIds...))
+	}
+	if _, err := tRosterUsers.
+		DELETE().
+		WHERE(deleteCondition).
+		ExecContext(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if len(roster.GetMembers()) > 0 {
+		stmt := tRosterUsers.
+			INSERT(
+				tRosterUsers.RosterID,
+				tRosterUsers.UserID,
+			)
+		for _, member := range roster.GetMembers() {
+			stmt = stmt.VALUES(rosterId, member.GetUserId())
+		}
+		stmt = stmt.ON_DUPLICATE_KEY_UPDATE(
+			tRosterUsers.RosterID.SET(tRosterUsers.RosterID),
+		)
+
+		if _, err := stmt.ExecContext(ctx, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if err := s.removeDroppedRosterMembers(ctx, current, roster); err != nil {
+		return nil, err
+	}
+
+	return s.GetRoster(ctx, rosterId)
+}
+
+// removeDroppedRosterMembers removes the members the running roster has assigned to its unit, but that are no longer
+// listed in the updated roster (or the roster has been moved to another unit), from the unit.
+func (s *UnitDB) removeDroppedRosterMembers(
+	ctx context.Context,
+	current *centrumunits.UnitRoster,
+	updated *centrumunits.UnitRoster,
+) error {
+	dropped := droppedRosterMembers(current, updated)
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	unit, err := s.Get(ctx, current.GetUnitId())
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get unit %d of roster %d. %w",
+			current.GetUnitId(),
+			current.GetId(),
+			err,
+		)
+	}
+
+	toRemove := []int32{}
+	for _, user := range unit.GetUsers() {
+		if slices.Contains(dropped, user.GetUserId()) {
+			toRemove = append(toRemove, user.GetUserId())
+		}
+	}
+	if len(toRemove) == 0 {
+		return nil
+	}
+
+	if err := s.UpdateUnitAssignments(
+		ctx,
+		current.GetJob(),
+		nil,
+		current.GetUnitId(),
+		nil,
+		toRemove,
+	); err != nil {
+		return fmt.Errorf(
+			"failed to remove dropped roster %d members from unit %d. %w",
+			current.GetId(),
+			current.GetUnitId(),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// droppedRosterMembers returns the members that have been assigned to the unit by the running roster, but are no
+// longer listed in the updated roster. All assigned members are returned when the roster's unit has been changed.
+func droppedRosterMembers(current *centrumunits.UnitRoster, updated *centrumunits.UnitRoster) []int32 {
+	if !current.IsRunning() {
+		return nil
+	}
+
+	listed := map[int32]bool{}
+	if current.GetUnitId() == updated.GetUnitId() {
+		for _, member := range updated.GetMembers() {
+			listed[member.GetUserId()] = true
+		}
+	}
+
+	dropped := []int32{}
+	for _, member := range current.GetMembers() {
+		if member.GetAssignedAt() != nil && !listed[member.GetUserId()] {
+			dropped = append(dropped, member.GetUserId())
+		}
+	}
+
+	return dropped
+}
+
+func (s *UnitDB) DeleteRoster(ctx context.Context, job string, id int64) error {
+	tRosters := table.FivenetCentrumUnitsRosters
+
+	stmt := tRosters.
+		DELETE().
+		WHERE(mysql.AND(
+			tRosters.ID.EQ(mysql.Int64(id)),
+			tRosters.Job.EQ(mysql.String(job)),
+		)).
+		LIMIT(1)
+
+	if _, err := stmt.ExecContext(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to delete unit roster. %w", err)
+	}
+
+	return nil
+}
+
+func (s *UnitDB) SetRosterStarted(ctx context.Context, id int64) error {
+	tRosters := table.FivenetCentrumUnitsRosters
+
+	stmt := tRosters.
+		UPDATE().
+		SET(tRosters.StartedAt.SET(mysql.CURRENT_TIMESTAMP())).
+		WHERE(mysql.AND(
+			tRosters.ID.EQ(mysql.Int64(id)),
+			tRosters.StartedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	if _, err := stmt.ExecContext(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to set unit roster %d started. %w", id, err)
+	}
+
+	return nil
+}
+
+func (s *UnitDB) SetRosterEnded(ctx context.Context, id int64) error {
+	tRosters := table.FivenetCentrumUnitsRosters
+
+	stmt := tRosters.
+		UPDATE().
+		SET(tRosters.EndedAt.SET(mysql.CURRENT_TIMESTAMP())).
+		WHERE(tRosters.ID.EQ(mysql.Int64(id))).
+		LIMIT(1)
+
+	if _, err := stmt.ExecContext(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to set unit roster %d ended. %w", id, err)
+	}
+
+	return nil
+}
+
+func (s *UnitDB) SetRosterMemberAssigned(ctx context.Context, rosterId int64, userId int32) error {
+	tRosterUsers := table.FivenetCentrumUnitsRostersUsers
+
+	stmt := tRosterUsers.
+		UPDATE().
+		SET(tRosterUsers.AssignedAt.SET(mysql.CURRENT_TIMESTAMP())).
+		WHERE(mysql.AND(
+			tRosterUsers.RosterID.EQ(mysql.Int64(rosterId)),
+			tRosterUsers.UserID.EQ(mysql.Int32(userId)),
+		)).
+		LIMIT(1)
+
+	if _, err := stmt.ExecContext(ctx, s.db); err != nil {
+		return fmt.Errorf(
+			"failed to set unit roster %d member %d assigned. %w",
+			rosterId,
+			userId,
+			err,
+		)
+	}
+
+	return nil
+}
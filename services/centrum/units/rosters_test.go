@@ -0,0 +1,70 @@
+package units
+
+import (
+	"testing"
+	"time"
+
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbsenceOverlaps(t *testing.T) {
+	t.Parallel()
+
+	// Night shift from 22:00 to 06:00 the next day
+	roster := &centrumunits.UnitRoster{
+		StartTime: timestamp.New(time.Date(2026, time.May, 10, 22, 0, 0, 0, time.UTC)),
+		EndTime:   timestamp.New(time.Date(2026, time.May, 11, 6, 0, 0, 0, time.UTC)),
+	}
+	date := func(day int) *time.Time {
+		d := time.Date(2026, time.May, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	assert.False(t, absenceOverlaps(roster, nil, nil))
+	assert.False(t, absenceOverlaps(roster, date(1), date(9)))
+	assert.False(t, absenceOverlaps(roster, date(12), date(14)))
+	// Absence dates are inclusive
+	assert.True(t, absenceOverlaps(roster, date(5), date(10)))
+	assert.True(t, absenceOverlaps(roster, date(11), date(11)))
+	assert.True(t, absenceOverlaps(roster, date(1), date(31)))
+}
+
+func TestDroppedRosterMembers(t *testing.T) {
+	t.Parallel()
+
+	now := timestamp.Now()
+	current := &centrumunits.UnitRoster{
+		Id:        1,
+		UnitId:    5,
+		StartedAt: now,
+		Members: []*centrumunits.UnitRosterMember{
+			{UserId: 1, AssignedAt: now},
+			{UserId: 2, AssignedAt: now},
+			// Not assigned yet (e.g., not on duty)
+			{UserId: 3},
+		},
+	}
+	updated := &centrumunits.UnitRoster{
+		Id:     1,
+		UnitId: 5,
+		Members: []*centrumunits.UnitRosterMember{
+			{UserId: 1},
+			{UserId: 4},
+		},
+	}
+
+	assert.Equal(t, []int32{2}, droppedRosterMembers(current, updated))
+
+	// Moving the roster to another unit drops all assigned members from the old unit
+	updated.UnitId = 6
+	assert.Equal(t, []int32{1, 2}, droppedRosterMembers(current, updated))
+
+	// Rosters that haven't started or have ended don't have members in the unit
+	assert.Empty(t, droppedRosterMembers(nil, updated))
+	current.EndedAt = now
+	assert.Empty(t, droppedRosterMembers(current, updated))
+	current.StartedAt, current.EndedAt = nil, nil
+	assert.Empty(t, droppedRosterMembers(current, updated))
+}
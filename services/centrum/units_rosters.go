@@ -0,0 +1,353 @@
+package centrum
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	calendarentries "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/calendar/entries"
+	centrumunits "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/units"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	pbcentrum "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	errorscentrum "github.com/fivenet-app/fivenet/v2026/services/centrum/errors"
+	"github.com/fivenet-app/fivenet/v2026/services/centrum/units"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+const (
+	maxRosterDuration       = 24 * time.Hour
+	maxRosterGenerateWindow = 31 * 24 * time.Hour
+)
+
+func (s *Server) ListUnitRosters(
+	ctx context.Context,
+	req *pbcentrum.ListUnitRostersRequest,
+) (*pbcentrum.ListUnitRostersResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	start := time.Now().Add(-12 * time.Hour)
+	end := time.Now().AddDate(0, 0, 7)
+	if req.GetStart() != nil {
+		start = req.GetStart().AsTime()
+	}
+	if req.GetEnd() != nil {
+		end = req.GetEnd().AsTime()
+	}
+	if end.Before(start) {
+		return nil, errorscentrum.ErrUnitRosterInvalid
+	}
+
+	rosters, err := s.units.ListRosters(ctx, units.RosterQuery{
+		Job:    userInfo.GetJob(),
+		UnitID: req.UnitId,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	return &pbcentrum.ListUnitRostersResponse{
+		Rosters: rosters,
+	}, nil
+}
+
+func (s *Server) CreateOrUpdateUnitRoster(
+	ctx context.Context,
+	req *pbcentrum.CreateOrUpdateUnitRosterRequest,
+) (*pbcentrum.CreateOrUpdateUnitRosterResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	roster := req.GetRoster()
+	roster.Job = userInfo.GetJob()
+
+	if err := s.validateUnitRoster(ctx, roster); err != nil {
+		return nil, err
+	}
+
+	if roster.GetId() > 0 {
+		existing, err := s.units.GetRoster(ctx, roster.GetId())
+		if err != nil {
+			if errors.Is(err, units.ErrRosterNotFound) {
+				return nil, errorscentrum.ErrUnitPermDenied
+			}
+			return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+		}
+		if existing.GetJob() != userInfo.GetJob() {
+			return nil, errorscentrum.ErrUnitPermDenied
+		}
+		// Ended rosters can't be changed anymore
+		if existing.GetEndedAt() != nil {
+			return nil, errorscentrum.ErrUnitRosterInvalid
+		}
+
+		grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
+	} else {
+		roster.CreatorId = &userInfo.UserId
+
+		grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_CREATED)
+	}
+
+	roster, err := s.units.CreateOrUpdateRoster(ctx, roster)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	return &pbcentrum.CreateOrUpdateUnitRosterResponse{
+		Roster: roster,
+	}, nil
+}
+
+// validateUnitRoster checks the roster's time window, that the unit belongs to the roster's job and that all
+// members are employed by the job.
+func (s *Server) validateUnitRoster(ctx context.Context, roster *centrumunits.UnitRoster) error {
+	start := roster.GetStartTime().AsTime()
+	end := roster.GetEndTime().AsTime()
+	if !end.After(start) || end.Sub(start) > maxRosterDuration {
+		return errorscentrum.ErrUnitRosterInvalid
+	}
+
+	unit, err := s.units.Get(ctx, roster.GetUnitId())
+	if err != nil {
+		return errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+	if unit.GetJob() != roster.GetJob() {
+		return errorscentrum.ErrUnitPermDenied
+	}
+
+	userIds := []int32{}
+	for _, member := range roster.GetMembers() {
+		userIds = append(userIds, member.GetUserId())
+	}
+	userIds = utils.SliceDedup(userIds)
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	ids := make([]mysql.Expression, len(userIds))
+	for i, userId := range userIds {
+		ids[i] = mysql.Int32(userId)
+	}
+
+	tUserJobs := table.FivenetUserJobs
+	stmt := tUserJobs.
+		SELECT(tUserJobs.UserID).
+		FROM(tUserJobs).
+		WHERE(mysql.AND(
+			tUserJobs.Job.EQ(mysql.String(roster.GetJob())),
+			tUserJobs.UserID.IN(ids...),
+		))
+
+	var dest []int32
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+		}
+	}
+
+	if len(utils.SliceDedup(dest)) != len(userIds) {
+		return errorscentrum.ErrUnitRosterInvalid
+	}
+
+	return nil
+}
+
+func (s *Server) DeleteUnitRoster(
+	ctx context.Context,
+	req *pbcentrum.DeleteUnitRosterRequest,
+) (*pbcentrum.DeleteUnitRosterResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	roster, err := s.units.GetRoster(ctx, req.GetRosterId())
+	if err != nil {
+		if errors.Is(err, units.ErrRosterNotFound) {
+			return &pbcentrum.DeleteUnitRosterResponse{}, nil
+		}
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+	if roster.GetJob() != userInfo.GetJob() {
+		return nil, errorscentrum.ErrUnitPermDenied
+	}
+	// Running rosters need to be ended first, so their members are removed from the unit and their timeclocks closed
+	if roster.IsRunning() {
+		return nil, errorscentrum.ErrUnitRosterRunning
+	}
+
+	if err := s.units.DeleteRoster(ctx, userInfo.GetJob(), roster.GetId()); err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_DELETED)
+
+	return &pbcentrum.DeleteUnitRosterResponse{}, nil
+}
+
+// GenerateUnitRosters creates (or updates) a roster for each entry of a job calendar in the given time window.
+// Colleagues that have accepted the entry's invitation (RSVP "yes") become the roster's members.
+// Recurring and all day entries are skipped.
+func (s *Server) GenerateUnitRosters(
+	ctx context.Context,
+	req *pbcentrum.GenerateUnitRostersRequest,
+) (*pbcentrum.GenerateUnitRostersResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	start := req.GetStart().AsTime()
+	end := req.GetEnd().AsTime()
+	if !end.After(start) || end.Sub(start) > maxRosterGenerateWindow {
+		return nil, errorscentrum.ErrUnitRosterInvalid
+	}
+
+	unit, err := s.units.Get(ctx, req.GetUnitId())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+	if unit.GetJob() != userInfo.GetJob() {
+		return nil, errorscentrum.ErrUnitPermDenied
+	}
+
+	tCalendar := table.FivenetCalendar
+	tCalendarEntries := table.FivenetCalendarEntries.AS("calendar_entry")
+
+	stmt := tCalendarEntries.
+		SELECT(
+			tCalendarEntries.ID,
+			tCalendarEntries.StartTime,
+			tCalendarEntries.EndTime,
+		).
+		FROM(
+			tCalendarEntries.
+				INNER_JOIN(tCalendar,
+					tCalendar.ID.EQ(tCalendarEntries.CalendarID),
+				),
+		).
+		WHERE(mysql.AND(
+			tCalendar.ID.EQ(mysql.Int64(req.GetCalendarId())),
+			tCalendar.Job.EQ(mysql.String(userInfo.GetJob())),
+			tCalendar.DeletedAt.IS_NULL(),
+			tCalendarEntries.DeletedAt.IS_NULL(),
+			tCalendarEntries.AllDay.IS_FALSE(),
+			tCalendarEntries.Recurring.IS_NULL(),
+			tCalendarEntries.EndTime.IS_NOT_NULL(),
+			tCalendarEntries.StartTime.GT_EQ(mysql.TimestampT(start)),
+			tCalendarEntries.StartTime.LT(mysql.TimestampT(end)),
+		)).
+		ORDER_BY(tCalendarEntries.StartTime.ASC()).
+		LIMIT(100)
+
+	entries := []*calendarentries.CalendarEntry{}
+	if err := stmt.QueryContext(ctx, s.db, &entries); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+		}
+	}
+
+	existing, err := s.units.ListRosters(ctx, units.RosterQuery{
+		Job:    userInfo.GetJob(),
+		UnitID: &req.UnitId,
+		Start:  start,
+		End:    end.Add(maxRosterDuration),
+	})
+	if err != nil {
+		return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+	}
+
+	resp := &pbcentrum.GenerateUnitRostersResponse{
+		Rosters: []*centrumunits.UnitRoster{},
+	}
+	for _, entry := range entries {
+		entryStart := entry.GetStartTime().AsTime()
+		entryEnd := entry.GetEndTime().AsTime()
+		if !entryEnd.After(entryStart) || entryEnd.Sub(entryStart) > maxRosterDuration {
+			continue
+		}
+
+		userIds, err := s.getCalendarEntryAttendees(ctx, userInfo.GetJob(), entry.GetId())
+		if err != nil {
+			return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+		}
+
+		roster := &centrumunits.UnitRoster{
+			Job:             userInfo.GetJob(),
+			UnitId:          unit.GetId(),
+			StartTime:       timestamp.New(entryStart),
+			EndTime:         timestamp.New(entryEnd),
+			CalendarEntryId: &entry.Id,
+			CreatorId:       &userInfo.UserId,
+			Members:         []*centrumunits.UnitRosterMember{},
+		}
+		for _, userId := range userIds {
+			roster.Members = append(roster.Members, &centrumunits.UnitRosterMember{
+				UserId: userId,
+			})
+		}
+
+		// Update the roster previously generated from the entry (as long as it hasn't ended yet)
+		skip := false
+		for _, r := range existing {
+			if r.GetCalendarEntryId() != entry.GetId() {
+				continue
+			}
+			if r.GetEndedAt() != nil {
+				skip = true
+				break
+			}
+			roster.Id = r.GetId()
+		}
+		if skip {
+			continue
+		}
+
+		roster, err = s.units.CreateOrUpdateRoster(ctx, roster)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorscentrum.ErrFailedQuery)
+		}
+		resp.Rosters = append(resp.Rosters, roster)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_CREATED)
+
+	return resp, nil
+}
+
+// getCalendarEntryAttendees returns the colleagues that have accepted the calendar entry's invitation.
+func (s *Server) getCalendarEntryAttendees(
+	ctx context.Context,
+	job string,
+	entryId int64,
+) ([]int32, error) {
+	tCalendarRsvp := table.FivenetCalendarRsvp
+	tUserJobs := table.FivenetUserJobs
+
+	stmt := tCalendarRsvp.
+		SELECT(tCalendarRsvp.UserID).
+		FROM(
+			tCalendarRsvp.
+				INNER_JOIN(tUserJobs,
+					tUserJobs.UserID.EQ(tCalendarRsvp.UserID),
+				),
+		).
+		WHERE(mysql.AND(
+			tCalendarRsvp.EntryID.EQ(mysql.Int64(entryId)),
+			tCalendarRsvp.Response.EQ(
+				mysql.Int32(int32(calendarentries.RsvpResponses_RSVP_RESPONSES_YES)),
+			),
+			tUserJobs.Job.EQ(mysql.String(job)),
+		)).
+		LIMIT(25)
+
+	var dest []int32
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return utils.SliceDedup(dest), nil
+}
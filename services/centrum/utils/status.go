@@ -14,7 +14,9 @@ func IsStatusDispatchComplete(in centrumdispatches.StatusDispatch) bool {
 func IsStatusDispatchUnassigned(in centrumdispatches.StatusDispatch) bool {
 	return in == centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNSPECIFIED ||
 		in == centrumdispatches.StatusDispatch_STATUS_DISPATCH_NEW ||
-		in == centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNASSIGNED
+		in == centrumdispatches.StatusDispatch_STATUS_DISPATCH_UNASSIGNED ||
+		// Escalated dispatches are still waiting for an unit
+		in == centrumdispatches.StatusDispatch_STATUS_DISPATCH_ESCALATED
 }
 
 func IsDispatchUnassigned(in *centrumdispatches.Dispatch) bool {
@@ -303,6 +303,7 @@ func (s *Server) ListApprovalTasks(
 	}
 
 	tUser := table.FivenetUser.AS("usershort")
+	tOriginalUser := table.FivenetUser.AS("original_user")
 
 	stmt := mysql.
 		SELECT(
@@ -311,6 +312,8 @@ func (s *Server) ListApprovalTasks(
 			tApprovalTasks.SnapshotDate,
 			tApprovalTasks.AssigneeKind,
 			tApprovalTasks.UserID,
+			tApprovalTasks.OriginalUserID,
+			tApprovalTasks.DelegatedAt,
 			tApprovalTasks.Job,
 			tApprovalTasks.MinimumGrade,
 			tApprovalTasks.Label,
@@ -330,11 +333,20 @@ func (s *Server) ListApprovalTasks(
 			tUser.Dateofbirth,
 			tUser.Job,
 			tUser.JobGrade,
+			tOriginalUser.ID,
+			tOriginalUser.Firstname,
+			tOriginalUser.Lastname,
+			tOriginalUser.Dateofbirth,
+			tOriginalUser.Job,
+			tOriginalUser.JobGrade,
 		).
 		FROM(
 			tApprovalTasks.
 				LEFT_JOIN(tUser,
 					tUser.ID.EQ(tApprovalTasks.UserID),
+				).
+				LEFT_JOIN(tOriginalUser,
+					tOriginalUser.ID.EQ(tApprovalTasks.OriginalUserID),
 				),
 		).
 		WHERE(condition).
@@ -764,11 +776,12 @@ func (s *Server) DecideApproval(
 		return nil, errswrap.NewError(err, errorsdocuments.ErrDocAccessViewDenied)
 	}
 
+	// The document's data is needed to match the conditions of approval policy template stages
 	doc, err := s.getDocument(
 		ctx,
 		tDocument.ID.EQ(mysql.Int64(req.GetDocumentId())),
 		userInfo,
-		false,
+		true,
 	)
 	if err != nil {
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
@@ -834,8 +847,10 @@ func (s *Server) DecideApproval(
 			return nil, errorsdocuments.ErrApprovalTaskAlreadyHandled
 		}
 
+		// The original assignee can still decide tasks that were delegated during their absence
 		if decidedTask.GetAssigneeKind() == documentsapproval.ApprovalAssigneeKind_APPROVAL_ASSIGNEE_KIND_USER &&
-			decidedTask.GetUserId() != userInfo.GetUserId() {
+			decidedTask.GetUserId() != userInfo.GetUserId() &&
+			decidedTask.GetOriginalUserId() != userInfo.GetUserId() {
 			return nil, errorsdocuments.ErrDocAccessViewDenied
 		} else if decidedTask.GetAssigneeKind() == documentsapproval.ApprovalAssigneeKind_APPROVAL_ASSIGNEE_KIND_JOB_GRADE {
 			if decidedTask.GetJob() != userInfo.GetJob() ||
@@ -881,6 +896,8 @@ func (s *Server) DecideApproval(
 				tApprovalTasks.SnapshotDate,
 				tApprovalTasks.AssigneeKind,
 				tApprovalTasks.UserID,
+				tApprovalTasks.OriginalUserID,
+				tApprovalTasks.DelegatedAt,
 				tApprovalTasks.Job,
 				tApprovalTasks.MinimumGrade,
 				tApprovalTasks.Label,
@@ -905,7 +922,10 @@ func (s *Server) DecideApproval(
 						int32(documentsapproval.ApprovalAssigneeKind_APPROVAL_ASSIGNEE_KIND_USER),
 					),
 				),
-				tApprovalTasks.UserID.EQ(mysql.Int32(userInfo.GetUserId())),
+				mysql.OR(
+					tApprovalTasks.UserID.EQ(mysql.Int32(userInfo.GetUserId())),
+					tApprovalTasks.OriginalUserID.EQ(mysql.Int32(userInfo.GetUserId())),
+				),
 				tApprovalTasks.Status.IN(
 					mysql.Int32(
 						int32(documentsapproval.ApprovalTaskStatus_APPROVAL_TASK_STATUS_PENDING),
@@ -937,6 +957,8 @@ func (s *Server) DecideApproval(
 					tApprovalTasks.SnapshotDate,
 					tApprovalTasks.AssigneeKind,
 					tApprovalTasks.UserID,
+					tApprovalTasks.OriginalUserID,
+					tApprovalTasks.DelegatedAt,
 					tApprovalTasks.Job,
 					tApprovalTasks.MinimumGrade,
 					tApprovalTasks.Label,
@@ -1182,6 +1204,11 @@ func (s *Server) DecideApproval(
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
 	}
 
+	// Policies created from a policy template continue with their next stage
+	if err := s.advanceApprovalPolicyStage(ctx, tx, userInfo, doc); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
 	// Create document activity entry
 	comment := req.GetComment()
 	userId := userInfo.GetUserId()
@@ -1411,3 +1438,27 @@ func (s *Server) expireApprovalTasks(ctx context.Context) (int64, error) {
 
 	return affected, nil
 }
+
+// delegateApprovalTasks reassigns pending approval tasks of absent colleagues to their delegates.
+func (s *Server) delegateApprovalTasks(ctx context.Context) (int64, error) {
+	delegations, err := s.store.ListActiveApprovalDelegations(ctx, s.db)
+	if err != nil {
+		return 0, err
+	}
+
+	total := int64(0)
+	for _, delegation := range delegations {
+		affected, err := s.store.DelegateApprovalTasks(
+			ctx,
+			s.db,
+			delegation.UserID,
+			delegation.DelegateID,
+		)
+		if err != nil {
+			return total, err
+		}
+		total += affected
+	}
+
+	return total, nil
+}
@@ -0,0 +1,374 @@
+package documents
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
+	documentsapproval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+)
+
+func (s *Server) ListApprovalPolicyTemplates(
+	ctx context.Context,
+	req *pbdocuments.ListApprovalPolicyTemplatesRequest,
+) (*pbdocuments.ListApprovalPolicyTemplatesResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	policyTemplates, err := s.store.ListApprovalPolicyTemplates(
+		ctx,
+		s.db,
+		userInfo.GetJob(),
+		req.CategoryId,
+		req.TemplateId,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	return &pbdocuments.ListApprovalPolicyTemplatesResponse{
+		PolicyTemplates: policyTemplates,
+	}, nil
+}
+
+// getOwnApprovalPolicyTemplate returns the (not deleted) approval policy template if it belongs to
+// the user's job.
+func (s *Server) getOwnApprovalPolicyTemplate(
+	ctx context.Context,
+	userInfo *userinfo.UserInfo,
+	id int64,
+) (*documentsapproval.ApprovalPolicyTemplate, error) {
+	policyTemplate, err := s.store.GetApprovalPolicyTemplate(ctx, s.db, id)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if policyTemplate == nil || policyTemplate.GetDeletedAt() != nil ||
+		policyTemplate.GetJob() != userInfo.GetJob() {
+		return nil, errorsdocuments.ErrNotFoundOrNoPerms
+	}
+
+	return policyTemplate, nil
+}
+
+// validateApprovalPolicyTemplate checks the policy template and that the category and template it
+// is bound to belong to the user's job.
+func (s *Server) validateApprovalPolicyTemplate(
+	ctx context.Context,
+	userInfo *userinfo.UserInfo,
+	policyTemplate *documentsapproval.ApprovalPolicyTemplate,
+) error {
+	if err := policyTemplate.Validate(); err != nil {
+		return errswrap.NewError(err, errorsdocuments.ErrApprovalPolicyTemplateInvalid)
+	}
+
+	if policyTemplate.GetCategoryId() > 0 {
+		category, err := s.getCategory(ctx, policyTemplate.GetCategoryId())
+		if err != nil {
+			return errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+		if category == nil || category.GetDeletedAt() != nil {
+			return errorsdocuments.ErrNotFoundOrNoPerms
+		}
+
+		// Trigger states must exist in the category's state machine (if it uses one)
+		if policyTemplate.TriggerState != nil && category.GetStateMachine().IsActive() &&
+			!category.GetStateMachine().HasState(policyTemplate.GetTriggerState()) {
+			return errorsdocuments.ErrApprovalPolicyTemplateInvalid
+		}
+	}
+
+	if policyTemplate.GetTemplateId() > 0 {
+		tmpl, err := s.store.GetTemplate(ctx, policyTemplate.GetTemplateId(), false)
+		if err != nil {
+			if !errors.Is(err, qrm.ErrNoRows) {
+				return errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+			}
+		}
+		if tmpl == nil || tmpl.GetCreatorJob() != userInfo.GetJob() {
+			return errorsdocuments.ErrTemplateNoPerms
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) CreateOrUpdateApprovalPolicyTemplate(
+	ctx context.Context,
+	req *pbdocuments.CreateOrUpdateApprovalPolicyTemplateRequest,
+) (*pbdocuments.CreateOrUpdateApprovalPolicyTemplateResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if err := s.validateApprovalPolicyTemplate(ctx, userInfo, req.GetPolicyTemplate()); err != nil {
+		return nil, err
+	}
+
+	if req.GetPolicyTemplate().GetId() == 0 {
+		lastId, err := s.store.CreateApprovalPolicyTemplate(
+			ctx,
+			s.db,
+			req.GetPolicyTemplate(),
+			userInfo,
+		)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+		req.PolicyTemplate.Id = lastId
+
+		grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_CREATED)
+	} else {
+		if _, err := s.getOwnApprovalPolicyTemplate(
+			ctx,
+			userInfo,
+			req.GetPolicyTemplate().GetId(),
+		); err != nil {
+			return nil, err
+		}
+
+		if err := s.store.UpdateApprovalPolicyTemplate(
+			ctx,
+			s.db,
+			req.GetPolicyTemplate(),
+			userInfo,
+		); err != nil {
+			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+
+		grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
+	}
+
+	policyTemplate, err := s.store.GetApprovalPolicyTemplate(
+		ctx,
+		s.db,
+		req.GetPolicyTemplate().GetId(),
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	return &pbdocuments.CreateOrUpdateApprovalPolicyTemplateResponse{
+		PolicyTemplate: policyTemplate,
+	}, nil
+}
+
+func (s *Server) DeleteApprovalPolicyTemplate(
+	ctx context.Context,
+	req *pbdocuments.DeleteApprovalPolicyTemplateRequest,
+) (*pbdocuments.DeleteApprovalPolicyTemplateResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{
+		"fivenet.documents.approval.policy_template_id", req.GetId(),
+	})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if _, err := s.getOwnApprovalPolicyTemplate(ctx, userInfo, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteApprovalPolicyTemplate(ctx, s.db, req.GetId(), userInfo); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_DELETED)
+
+	return &pbdocuments.DeleteApprovalPolicyTemplateResponse{}, nil
+}
+
+// applyApprovalPolicyTemplate creates the document's approval policy and the tasks of the first
+// matching stage from the first policy template found for the trigger states (empty for
+// publishing). Documents that already have an approval policy are left untouched.
+func (s *Server) applyApprovalPolicyTemplate(
+	ctx context.Context,
+	tx qrm.DB,
+	userInfo *userinfo.UserInfo,
+	doc *documents.Document,
+	triggerStates ...string,
+) error {
+	var policyTemplate *documentsapproval.ApprovalPolicyTemplate
+	for _, triggerState := range triggerStates {
+		var err error
+		policyTemplate, err = s.store.FindApprovalPolicyTemplate(
+			ctx,
+			tx,
+			doc.GetCreatorJob(),
+			doc.GetCategoryId(),
+			doc.GetTemplateId(),
+			triggerState,
+		)
+		if err != nil {
+			return err
+		}
+		if policyTemplate != nil {
+			break
+		}
+	}
+	if policyTemplate == nil {
+		return nil
+	}
+
+	stageNo, stage := policyTemplate.NextStage(0, doc.GetData())
+	if stage == nil {
+		// None of the stages apply to the document
+		return nil
+	}
+
+	pol, err := s.store.GetApprovalPolicy(
+		ctx,
+		tx,
+		table.FivenetDocumentsApprovalPolicies.AS(
+			"approval_policy",
+		).DocumentID.EQ(
+			mysql.Int64(doc.GetId()),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	if pol != nil {
+		// A policy already exists, don't update the existing one
+		return nil
+	}
+
+	newPol := &documentsapproval.ApprovalPolicy{
+		SnapshotDate:       timestamp.New(time.Now().Truncate(time.Second)),
+		RuleKind:           policyTemplate.GetRuleKind(),
+		OnEditBehavior:     policyTemplate.GetOnEditBehavior(),
+		SignatureRequired:  policyTemplate.GetSignatureRequired(),
+		SelfApproveAllowed: policyTemplate.GetSelfApproveAllowed(),
+		PolicyTemplateId:   &policyTemplate.Id,
+		CurrentStage:       stageNo,
+	}
+	if newPol.GetRuleKind() == documentsapproval.ApprovalRuleKind_APPROVAL_RULE_KIND_QUORUM_ANY {
+		requiredCount := max(policyTemplate.GetRequiredCount(), 1)
+		newPol.RequiredCount = &requiredCount
+	}
+	newPol.Default()
+
+	if err := s.store.CreateApprovalPolicy(ctx, tx, doc.GetId(), newPol); err != nil {
+		return err
+	}
+
+	if err := s.createApprovalStageTasks(
+		ctx,
+		tx,
+		userInfo,
+		doc,
+		newPol.GetSnapshotDate(),
+		stage,
+	); err != nil {
+		return err
+	}
+
+	return s.recomputeApprovalPolicyTx(ctx, tx, doc.GetId(), newPol.GetSnapshotDate())
+}
+
+// advanceApprovalPolicyStage creates the tasks of the next matching stage once all tasks of the
+// current stage of a policy template based approval policy have been approved.
+func (s *Server) advanceApprovalPolicyStage(
+	ctx context.Context,
+	tx qrm.DB,
+	userInfo *userinfo.UserInfo,
+	doc *documents.Document,
+) error {
+	pol, err := s.store.GetApprovalPolicy(
+		ctx,
+		tx,
+		table.FivenetDocumentsApprovalPolicies.AS(
+			"approval_policy",
+		).DocumentID.EQ(
+			mysql.Int64(doc.GetId()),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	if pol == nil || pol.GetPolicyTemplateId() <= 0 {
+		return nil
+	}
+	// Stages are complete once all of their tasks have been approved
+	if pol.GetPendingCount() > 0 || pol.GetAnyDeclined() ||
+		pol.GetApprovedCount() < pol.GetAssignedCount() {
+		return nil
+	}
+
+	policyTemplate, err := s.store.GetApprovalPolicyTemplate(ctx, tx, pol.GetPolicyTemplateId())
+	if err != nil {
+		return err
+	}
+	if policyTemplate == nil {
+		return nil
+	}
+
+	stageNo, stage := policyTemplate.NextStage(pol.GetCurrentStage(), doc.GetData())
+	if stage == nil {
+		return nil
+	}
+
+	if err := s.store.UpdateApprovalPolicyStage(ctx, tx, doc.GetId(), stageNo); err != nil {
+		return err
+	}
+
+	if err := s.createApprovalStageTasks(
+		ctx,
+		tx,
+		userInfo,
+		doc,
+		pol.GetSnapshotDate(),
+		stage,
+	); err != nil {
+		return err
+	}
+
+	return s.recomputeApprovalPolicyTx(ctx, tx, doc.GetId(), pol.GetSnapshotDate())
+}
+
+func (s *Server) createApprovalStageTasks(
+	ctx context.Context,
+	tx qrm.DB,
+	userInfo *userinfo.UserInfo,
+	doc *documents.Document,
+	snapDate *timestamp.Timestamp,
+	stage *documentsapproval.ApprovalStage,
+) error {
+	seeds := []*pbdocuments.ApprovalTaskSeed{}
+	for _, assignee := range stage.GetAssignees() {
+		var dueAt *timestamp.Timestamp
+		if assignee.GetDueInDays() > 0 {
+			dueAt = timestamp.New(time.Now().AddDate(0, 0, int(assignee.GetDueInDays())))
+		}
+
+		job := assignee.GetJob()
+		if assignee.GetUserId() <= 0 && job == "" {
+			job = doc.GetCreatorJob()
+		}
+
+		label := assignee.Label
+		if label == nil {
+			label = stage.Label
+		}
+
+		seeds = append(seeds, &pbdocuments.ApprovalTaskSeed{
+			UserId:            assignee.GetUserId(),
+			Job:               job,
+			MinimumGrade:      assignee.GetMinimumGrade(),
+			Label:             label,
+			SignatureRequired: assignee.GetSignatureRequired(),
+			Slots:             max(assignee.GetSlots(), 1),
+			DueAt:             dueAt,
+		})
+	}
+
+	_, _, err := s.store.CreateApprovalTasks(ctx, tx, userInfo, doc.GetId(), snapDate, seeds)
+	return err
+}
@@ -0,0 +1,163 @@
+package documents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
+	documentsapproval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
+	documentsdata "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	documentsstore "github.com/fivenet-app/fivenet/v2026/stores/documents"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type approvalStageTestStore struct {
+	documentsstore.IStore
+
+	policy   *documentsapproval.ApprovalPolicy
+	template *documentsapproval.ApprovalPolicyTemplate
+
+	stage int32
+	seeds []*pbdocuments.ApprovalTaskSeed
+}
+
+func (s *approvalStageTestStore) GetApprovalPolicy(
+	context.Context,
+	qrm.DB,
+	mysql.BoolExpression,
+) (*documentsapproval.ApprovalPolicy, error) {
+	return s.policy, nil
+}
+
+func (s *approvalStageTestStore) GetApprovalPolicyTemplate(
+	context.Context,
+	qrm.DB,
+	int64,
+) (*documentsapproval.ApprovalPolicyTemplate, error) {
+	return s.template, nil
+}
+
+func (s *approvalStageTestStore) UpdateApprovalPolicyStage(
+	_ context.Context,
+	_ qrm.DB,
+	_ int64,
+	stage int32,
+) error {
+	s.stage = stage
+	return nil
+}
+
+func (s *approvalStageTestStore) CreateApprovalTasks(
+	_ context.Context,
+	_ qrm.DB,
+	_ *userinfo.UserInfo,
+	_ int64,
+	_ *timestamp.Timestamp,
+	seeds []*pbdocuments.ApprovalTaskSeed,
+) (int32, int32, error) {
+	s.seeds = append(s.seeds, seeds...)
+	return int32(len(seeds)), 0, nil
+}
+
+func (s *approvalStageTestStore) RecomputeApprovalPolicyTx(
+	context.Context,
+	qrm.DB,
+	int64,
+	*timestamp.Timestamp,
+) error {
+	return nil
+}
+
+func TestAdvanceApprovalPolicyStageConditional(t *testing.T) {
+	t.Parallel()
+
+	severity := func(option string) *documentsdata.DocumentData {
+		return &documentsdata.DocumentData{
+			Fields: map[string]*documentsforms.FormFieldValue{
+				"severity": {Value: &documentsforms.FormFieldValue_Option{Option: option}},
+			},
+		}
+	}
+
+	newStore := func() *approvalStageTestStore {
+		return &approvalStageTestStore{
+			// First stage is complete
+			policy: &documentsapproval.ApprovalPolicy{
+				PolicyTemplateId: proto.Int64(1),
+				CurrentStage:     1,
+				AssignedCount:    1,
+				ApprovedCount:    1,
+			},
+			template: &documentsapproval.ApprovalPolicyTemplate{
+				Id: 1,
+				Stages: &documentsapproval.ApprovalStages{
+					Stages: []*documentsapproval.ApprovalStage{
+						{
+							Assignees: []*documentsapproval.ApprovalStageAssignee{
+								{MinimumGrade: 5, Slots: 1},
+							},
+						},
+						{
+							Conditions: []*documentsapproval.ApprovalCondition{
+								{
+									Condition: &documentsapproval.ApprovalCondition_Field{
+										Field: &documentsforms.FormFieldFilter{
+											Key: "severity",
+											Filter: &documentsforms.FormFieldFilter_Option{
+												Option: "felony",
+											},
+										},
+									},
+								},
+							},
+							Assignees: []*documentsapproval.ApprovalStageAssignee{
+								{Job: "doj", Slots: 1},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("matching document data advances to the conditional stage", func(t *testing.T) {
+		t.Parallel()
+
+		store := newStore()
+		s := &Server{store: store}
+
+		require.NoError(t, s.advanceApprovalPolicyStage(
+			t.Context(),
+			nil,
+			&userinfo.UserInfo{},
+			&documents.Document{Id: 1, CreatorJob: "police", Data: severity("felony")},
+		))
+		assert.Equal(t, int32(2), store.stage)
+		require.Len(t, store.seeds, 1)
+		assert.Equal(t, "doj", store.seeds[0].GetJob())
+	})
+
+	t.Run("other document data skips the conditional stage", func(t *testing.T) {
+		t.Parallel()
+
+		store := newStore()
+		s := &Server{store: store}
+
+		require.NoError(t, s.advanceApprovalPolicyStage(
+			t.Context(),
+			nil,
+			&userinfo.UserInfo{},
+			&documents.Document{Id: 1, CreatorJob: "police", Data: severity("misdemeanor")},
+		))
+		assert.Zero(t, store.stage)
+		assert.Empty(t, store.seeds)
+	})
+}
@@ -0,0 +1,413 @@
+package documents
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	documentsbulk "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/bulk"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	permsdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents/perms"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	documentsstore "github.com/fivenet-app/fivenet/v2026/stores/documents"
+	"github.com/go-jet/jet/v2/mysql"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	BulkJobsDefaultPageSize = 10
+
+	// Number of documents processed per bulk job run
+	bulkJobBatchSize = 100
+)
+
+var tBulkJob = table.FivenetDocumentsBulkJobs.AS("bulk_job")
+
+func (s *Server) BulkUpdateDocuments(
+	ctx context.Context,
+	req *pbdocuments.BulkUpdateDocumentsRequest,
+) (*pbdocuments.BulkUpdateDocumentsResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if req.GetSelector().IsEmpty() {
+		return nil, errorsdocuments.ErrBulkSelectorEmpty
+	}
+	if !s.canUserRunBulkOperation(userInfo, req.GetOperation()) {
+		return nil, errorsdocuments.ErrBulkOperationDenied
+	}
+
+	if op := req.GetOperation().GetChangeCategory(); op != nil && op.CategoryId != nil {
+		category, err := s.store.GetCategory(ctx, op.GetCategoryId(), userInfo)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+		if category == nil || category.GetDeletedAt() != nil {
+			return nil, errorsdocuments.ErrBulkCategoryInvalid
+		}
+	}
+
+	id, err := s.store.CreateBulkJob(ctx, s.db, &documentsbulk.BulkJob{
+		Job:       userInfo.GetJob(),
+		CreatorId: userInfo.GetUserId(),
+		Selector:  req.GetSelector(),
+		Operation: req.GetOperation(),
+	})
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_CREATED)
+
+	job, err := s.store.GetBulkJob(ctx, s.db, tBulkJob.ID.EQ(mysql.Int64(id)))
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	return &pbdocuments.BulkUpdateDocumentsResponse{
+		Job: job,
+	}, nil
+}
+
+func (s *Server) GetBulkJob(
+	ctx context.Context,
+	req *pbdocuments.GetBulkJobRequest,
+) (*pbdocuments.GetBulkJobResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	condition := mysql.AND(
+		tBulkJob.ID.EQ(mysql.Int64(req.GetJobId())),
+		tBulkJob.Job.EQ(mysql.String(userInfo.GetJob())),
+	)
+	// Job admins can see the bulk jobs of all colleagues
+	if !userInfo.GetJobAdmin() {
+		condition = condition.AND(tBulkJob.CreatorID.EQ(mysql.Int32(userInfo.GetUserId())))
+	}
+
+	job, err := s.store.GetBulkJob(ctx, s.db, condition)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if job == nil {
+		return nil, errorsdocuments.ErrNotFoundOrNoPerms
+	}
+
+	if job.GetCreator() != nil {
+		s.enricher.EnrichJobInfoSafeFunc(userInfo)(job.GetCreator())
+	}
+
+	return &pbdocuments.GetBulkJobResponse{
+		Job: job,
+	}, nil
+}
+
+func (s *Server) ListBulkJobs(
+	ctx context.Context,
+	req *pbdocuments.ListBulkJobsRequest,
+) (*pbdocuments.ListBulkJobsResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	q := documentsstore.ListBulkJobsQuery{
+		Job:        userInfo.GetJob(),
+		Pagination: req.GetPagination(),
+	}
+	if !userInfo.GetJobAdmin() {
+		q.CreatorID = &userInfo.UserId
+	}
+
+	count, jobs, err := s.store.ListBulkJobs(ctx, q)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	pag, _ := req.GetPagination().GetResponseWithPageSize(count.Total, BulkJobsDefaultPageSize)
+	resp := &pbdocuments.ListBulkJobsResponse{
+		Pagination: pag,
+		Jobs:       []*documentsbulk.BulkJob{},
+	}
+	if count.Total <= 0 {
+		return resp, nil
+	}
+	resp.Jobs = jobs
+
+	jobInfoFn := s.enricher.EnrichJobInfoSafeFunc(userInfo)
+	for i := range resp.GetJobs() {
+		if resp.GetJobs()[i].GetCreator() != nil {
+			jobInfoFn(resp.GetJobs()[i].GetCreator())
+		}
+	}
+
+	return resp, nil
+}
+
+// canUserRunBulkOperation checks that the user has the permission of the single document RPC
+// used by the bulk operation. Field permissions are checked for each document.
+func (s *Server) canUserRunBulkOperation(
+	userInfo *userinfo.UserInfo,
+	op *documentsbulk.BulkOperation,
+) bool {
+	var perm perms.PermissionRef
+	switch op.GetOperation().(type) {
+	case *documentsbulk.BulkOperation_Toggle:
+		perm = permsdocuments.DocumentsService.ToggleDocument.Perm
+
+	case *documentsbulk.BulkOperation_ChangeCategory, *documentsbulk.BulkOperation_Access:
+		perm = permsdocuments.DocumentsService.UpdateDocument.Perm
+
+	case *documentsbulk.BulkOperation_ChangeOwner:
+		perm = permsdocuments.DocumentsService.ChangeDocumentOwner.Perm
+
+	case *documentsbulk.BulkOperation_Delete:
+		perm = permsdocuments.DocumentsService.DeleteDocument.Perm
+
+	default:
+		return false
+	}
+
+	return s.ps.Can(userInfo, perm)
+}
+
+// runBulkJobs processes the next batch of documents of the oldest pending or running bulk job.
+func (s *Server) runBulkJobs(ctx context.Context) (int64, int64, error) {
+	job, err := s.store.GetBulkJob(ctx, s.db, tBulkJob.Status.IN(
+		mysql.Int32(int32(documentsbulk.BulkJobStatus_BULK_JOB_STATUS_PENDING)),
+		mysql.Int32(int32(documentsbulk.BulkJobStatus_BULK_JOB_STATUS_RUNNING)),
+	))
+	if err != nil {
+		return 0, 0, err
+	}
+	if job == nil {
+		return 0, 0, nil
+	}
+
+	processed, err := s.processBulkJob(ctx, job)
+
+	return job.GetId(), processed, err
+}
+
+func (s *Server) processBulkJob(ctx context.Context, job *documentsbulk.BulkJob) (int64, error) {
+	// The job runs with the creator's current permissions
+	userInfo, err := s.ui.GetUserInfo(ctx, job.GetCreatorId())
+	if err != nil {
+		s.logger.Warn("failed to get user info of bulk job creator",
+			zap.Int64("bulk_job_id", job.GetId()), zap.Error(err))
+		return 0, s.failBulkJob(ctx, job, "creator not found")
+	}
+	if userInfo.GetJob() != job.GetJob() ||
+		!s.canUserRunBulkOperation(userInfo, job.GetOperation()) {
+		return 0, s.failBulkJob(ctx, job, "permission denied")
+	}
+	ctx = auth.ContextWithUserInfo(ctx, userInfo)
+
+	filter := job.GetSelector().GetFilter()
+	if filter == nil {
+		filter = &documentsbulk.BulkFilter{}
+	}
+	q := documentsstore.ListQuery{
+		Search:      filter.GetSearch(),
+		CategoryIDs: filter.GetCategoryIds(),
+		CreatorIDs:  filter.GetCreatorIds(),
+		From:        filter.GetFrom(),
+		To:          filter.GetTo(),
+		Closed:      filter.Closed,
+		DocumentIDs: job.GetSelector().GetDocumentIds(),
+		OnlyDrafts:  filter.OnlyDrafts,
+		FormFields:  filter.GetFormFields(),
+		Limit:       bulkJobBatchSize,
+		UserInfo:    userInfo,
+	}
+
+	if job.GetStatus() == documentsbulk.BulkJobStatus_BULK_JOB_STATUS_PENDING {
+		total, err := s.store.CountDocuments(ctx, q)
+		if err != nil {
+			return 0, err
+		}
+
+		job.Status = documentsbulk.BulkJobStatus_BULK_JOB_STATUS_RUNNING
+		job.StartedAt = timestamp.Now()
+		job.TotalCount = total
+		if err := s.store.UpdateBulkJobProgress(ctx, s.db, job); err != nil {
+			return 0, err
+		}
+	}
+
+	ids, err := s.store.ListDocumentIDs(ctx, q, job.GetLastDocumentId())
+	if err != nil {
+		return 0, err
+	}
+
+	processed := int64(0)
+	for _, documentId := range ids {
+		if ctx.Err() != nil {
+			return processed, ctx.Err()
+		}
+
+		err := s.applyBulkOperation(ctx, documentId, job.GetOperation())
+		s.logBulkOperation(userInfo, job, documentId, err)
+
+		processed++
+		job.ProcessedCount++
+		if err != nil {
+			job.FailedCount++
+		} else {
+			job.SucceededCount++
+		}
+		job.LastDocumentId = documentId
+
+		// Store the progress after each document, so it is resumed at the right document
+		if err := s.store.UpdateBulkJobProgress(ctx, s.db, job); err != nil {
+			return processed, err
+		}
+	}
+
+	if len(ids) < bulkJobBatchSize {
+		job.Status = documentsbulk.BulkJobStatus_BULK_JOB_STATUS_COMPLETED
+		job.CompletedAt = timestamp.Now()
+		if err := s.store.UpdateBulkJobProgress(ctx, s.db, job); err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+func (s *Server) failBulkJob(ctx context.Context, job *documentsbulk.BulkJob, reason string) error {
+	job.Status = documentsbulk.BulkJobStatus_BULK_JOB_STATUS_FAILED
+	job.CompletedAt = timestamp.Now()
+	job.Error = &reason
+
+	return s.store.UpdateBulkJobProgress(ctx, s.db, job)
+}
+
+// applyBulkOperation applies the operation to the document using the single document RPCs, so
+// the same access and field permission checks apply.
+func (s *Server) applyBulkOperation(
+	ctx context.Context,
+	documentId int64,
+	op *documentsbulk.BulkOperation,
+) error {
+	var err error
+	switch o := op.GetOperation().(type) {
+	case *documentsbulk.BulkOperation_Toggle:
+		_, err = s.ToggleDocument(ctx, &pbdocuments.ToggleDocumentRequest{
+			DocumentId: documentId,
+			Closed:     o.Toggle.GetClosed(),
+		})
+
+	case *documentsbulk.BulkOperation_ChangeCategory:
+		err = s.changeDocumentCategory(ctx, documentId, o.ChangeCategory.CategoryId)
+
+	case *documentsbulk.BulkOperation_ChangeOwner:
+		_, err = s.ChangeDocumentOwner(ctx, &pbdocuments.ChangeDocumentOwnerRequest{
+			DocumentId: documentId,
+			NewUserId:  o.ChangeOwner.NewUserId,
+		})
+
+	case *documentsbulk.BulkOperation_Access:
+		current, aerr := s.store.GetDocumentAccess(ctx, documentId)
+		if aerr != nil {
+			return errswrap.NewError(aerr, errorsdocuments.ErrFailedQuery)
+		}
+
+		_, err = s.SetDocumentAccess(ctx, &pbdocuments.SetDocumentAccessRequest{
+			DocumentId: documentId,
+			Access:     o.Access.Apply(current),
+		})
+
+	case *documentsbulk.BulkOperation_Delete:
+		reason := o.Delete.GetReason()
+		_, err = s.DeleteDocument(ctx, &pbdocuments.DeleteDocumentRequest{
+			DocumentId: documentId,
+			Reason:     &reason,
+		})
+
+	default:
+		err = errorsdocuments.ErrBulkOperationDenied
+	}
+
+	return err
+}
+
+// changeDocumentCategory updates the document's category through the regular document update.
+func (s *Server) changeDocumentCategory(
+	ctx context.Context,
+	documentId int64,
+	categoryId *int64,
+) error {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	doc, err := s.getDocument(
+		ctx,
+		tDocument.ID.EQ(mysql.Int64(documentId)),
+		userInfo,
+		true,
+	)
+	if err != nil {
+		return errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if doc == nil {
+		return errorsdocuments.ErrNotFoundOrNoPerms
+	}
+	if (categoryId == nil && doc.CategoryId == nil) ||
+		(categoryId != nil && doc.GetCategoryId() == *categoryId) {
+		return nil
+	}
+
+	files, err := s.fHandler.ListFilesForParentID(ctx, documentId)
+	if err != nil {
+		return errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	_, err = s.UpdateDocument(ctx, &pbdocuments.UpdateDocumentRequest{
+		DocumentId:  documentId,
+		CategoryId:  categoryId,
+		Title:       doc.GetTitle(),
+		Content:     doc.GetContent(),
+		ContentType: doc.GetContentType(),
+		Data:        doc.GetData(),
+		Meta:        doc.GetMeta(),
+		Files:       files,
+	})
+	return err
+}
+
+// logBulkOperation adds an audit log entry for each document processed by a bulk job.
+func (s *Server) logBulkOperation(
+	userInfo *userinfo.UserInfo,
+	job *documentsbulk.BulkJob,
+	documentId int64,
+	err error,
+) {
+	action := audit.EventAction_EVENT_ACTION_UPDATED
+	if job.GetOperation().GetDelete() != nil {
+		action = audit.EventAction_EVENT_ACTION_DELETED
+	}
+
+	result := audit.EventResult_EVENT_RESULT_SUCCEEDED
+	if err != nil {
+		result = audit.EventResult_EVENT_RESULT_ERRORED
+	}
+
+	s.aud.Log(&audit.AuditEntry{
+		UserId:  &userInfo.UserId,
+		UserJob: userInfo.GetJob(),
+		Service: pbdocuments.DocumentsService_ServiceDesc.ServiceName,
+		Method:  "BulkUpdateDocuments",
+		Action:  action,
+		Result:  result,
+		Meta: &audit.AuditEntryMeta{
+			Meta: map[string]string{
+				"bulk_job_id": strconv.FormatInt(job.GetId(), 10),
+				"document_id": strconv.FormatInt(documentId, 10),
+				"grpc_code":   status.Code(err).String(),
+			},
+		},
+	}, job.GetOperation())
+}
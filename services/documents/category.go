@@ -40,6 +40,13 @@ func (s *Server) CreateOrUpdateCategory(
 ) (*pbdocuments.CreateOrUpdateCategoryResponse, error) {
 	userInfo := auth.MustGetUserInfoFromContext(ctx)
 
+	if err := validateCategoryStateMachine(req.GetCategory()); err != nil {
+		return nil, err
+	}
+	if err := validateCategoryRetentionPolicy(req.GetCategory()); err != nil {
+		return nil, err
+	}
+
 	if req.GetCategory().GetId() == 0 {
 		lastId, err := s.store.CreateCategory(ctx, s.db, req.GetCategory(), userInfo)
 		if err != nil {
@@ -150,6 +150,7 @@ func (s *Server) ListDocuments(
 		Closed:      req.Closed,
 		DocumentIDs: req.GetDocumentIds(),
 		OnlyDrafts:  req.OnlyDrafts,
+		FormFields:  req.GetFormFields(),
 		Sort:        req.GetSort(),
 		Offset:      req.GetPagination().GetOffset(),
 		Limit:       limit,
@@ -291,6 +292,29 @@ func (s *Server) getDocument(
 	return &doc, nil
 }
 
+// checkDocumentLegalHold returns ErrDocLegalHold if the document is on legal hold, its access,
+// references and relations can't be changed then.
+func (s *Server) checkDocumentLegalHold(
+	ctx context.Context,
+	documentId int64,
+	userInfo *userinfo.UserInfo,
+) error {
+	doc, err := s.getDocument(ctx, tDocument.ID.EQ(mysql.Int64(documentId)), userInfo, false)
+	if err != nil {
+		return err
+	}
+
+	return documentLegalHoldError(doc)
+}
+
+func documentLegalHoldError(doc *documents.Document) error {
+	if doc.GetMeta().GetLegalHold() {
+		return errorsdocuments.ErrDocLegalHold
+	}
+
+	return nil
+}
+
 func (s *Server) CreateDocument(
 	ctx context.Context,
 	req *pbdocuments.CreateDocumentRequest,
@@ -412,6 +436,22 @@ func (s *Server) CreateDocument(
 		})
 	}
 
+	// New documents are drafts, required form fields are checked once the document is published
+	if err := s.validateFormValues(ctx, tmpl, req.GetData(), false); err != nil {
+		return nil, err
+	}
+
+	// Documents in a category with a state machine start in its initial state
+	if categoryId != nil {
+		category, err := s.getCategoryStateMachine(ctx, *categoryId)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+		if category != nil {
+			docState = category.GetStateMachine().GetInitialState()
+		}
+	}
+
 	// Begin transaction
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -448,7 +488,7 @@ func (s *Server) CreateDocument(
 			int32(docContent.GetContentType()),
 			"",
 			docState,
-			mysql.NULL,
+			req.GetData(),
 			false,
 			true,
 			false,
@@ -554,6 +594,15 @@ func (s *Server) CreateDocument(
 func (s *Server) UpdateDocument(
 	ctx context.Context,
 	req *pbdocuments.UpdateDocumentRequest,
+) (*pbdocuments.UpdateDocumentResponse, error) {
+	return s.updateDocument(ctx, req, nil)
+}
+
+// updateDocument updates the document, restoredFromId is set when a document version is restored.
+func (s *Server) updateDocument(
+	ctx context.Context,
+	req *pbdocuments.UpdateDocumentRequest,
+	restoredFromId *int64,
 ) (*pbdocuments.UpdateDocumentResponse, error) {
 	logging.InjectFields(ctx, logging.Fields{documentIDLogFieldKey, req.GetDocumentId()})
 
@@ -623,6 +672,19 @@ func (s *Server) UpdateDocument(
 		}
 	}
 
+	// The state machine of the category the document ends up in decides its state
+	category, err := s.getCategoryStateMachine(ctx, req.GetCategoryId())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	docState, err := getDocumentUpdateState(oldDoc, req, category)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetMeta() != nil {
+		req.GetMeta().State = docState
+	}
+
 	accessChanged := documentUpdateAccessChanged(oldAccess, req)
 	statusChanged := documentUpdateStatusChanged(oldDoc, req)
 	contentChanged := documentUpdateContentChanged(oldDoc, req)
@@ -636,6 +698,18 @@ func (s *Server) UpdateDocument(
 	if contentChanged && !canEditUpdate {
 		return nil, errorsdocuments.ErrDocUpdateDenied
 	}
+	if contentChanged && oldDoc.GetMeta().GetLocked() && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrDocLocked
+	}
+	if contentChanged && oldDoc.GetMeta().GetArchivedAt() != nil && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrDocArchived
+	}
+	// Documents on legal hold can't be edited, not even by job admins
+	if contentChanged || statusChanged || accessChanged {
+		if err := documentLegalHoldError(oldDoc); err != nil {
+			return nil, err
+		}
+	}
 
 	var tmpl *documentstemplates.Template
 	if oldDoc.GetTemplateId() > 0 {
@@ -648,6 +722,18 @@ func (s *Server) UpdateDocument(
 		}
 	}
 
+	// Form values of documents whose template has been removed can't be validated anymore
+	if tmpl != nil || oldDoc.GetTemplateId() <= 0 {
+		if err := s.validateFormValues(
+			ctx,
+			tmpl,
+			req.GetData(),
+			!req.GetMeta().GetDraft(),
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	// Begin transaction
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -656,6 +742,7 @@ func (s *Server) UpdateDocument(
 	// Defer a rollback in case anything fails
 	defer tx.Rollback()
 
+	var versionId *int64
 	if contentChanged {
 		extracted := req.GetContent().Extract()
 
@@ -692,6 +779,14 @@ func (s *Server) UpdateDocument(
 			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
 		}
 
+		if documentVersionChanged(oldDoc, req) {
+			id, err := s.createDocumentVersion(ctx, tx, userInfo, oldDoc, req, restoredFromId)
+			if err != nil {
+				return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+			}
+			versionId = &id
+		}
+
 		if tmpl != nil && tmpl.GetWorkflow() != nil {
 			if err := s.store.UpsertWorkflowState(
 				ctx,
@@ -808,6 +903,29 @@ func (s *Server) UpdateDocument(
 		if err := s.handleApprovalOnEditBehaviors(ctx, tx, oldDoc); err != nil {
 			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
 		}
+
+		// Published documents "enter" their current state as well
+		if !req.GetMeta().GetDraft() {
+			published := oldDoc.GetMeta().GetDraft()
+			triggerStates := []string{}
+			if published {
+				triggerStates = append(triggerStates, "")
+			}
+			if state := req.GetMeta().GetState(); state != "" &&
+				(published || oldDoc.GetMeta().GetState() != state) {
+				triggerStates = append(triggerStates, state)
+			}
+
+			if err := s.applyApprovalPolicyTemplate(ctx, tx, userInfo, &documents.Document{
+				Id:         oldDoc.GetId(),
+				CategoryId: req.CategoryId,
+				CreatorJob: oldDoc.GetCreatorJob(),
+				TemplateId: oldDoc.TemplateId,
+				Data:       req.GetData(),
+			}, triggerStates...); err != nil {
+				return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+			}
+		}
 	}
 
 	// Commit the transaction
@@ -832,7 +950,7 @@ func (s *Server) UpdateDocument(
 		)
 	}
 
-	s.collabServer.SendTargetSaved(ctx, doc.GetId())
+	s.collabServer.SendTargetSaved(ctx, doc.GetId(), versionId)
 
 	s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
 		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
@@ -993,6 +1111,9 @@ func (s *Server) DeleteDocument(
 	if doc.GetDeletedAt() == nil && req.Reason == nil {
 		return nil, errorsdocuments.ErrDocDeleteDenied
 	}
+	if doc.GetDeletedAt() == nil && doc.GetMeta().GetLegalHold() {
+		return nil, errorsdocuments.ErrDocLegalHold
+	}
 
 	// Field Permission Check
 	fields, err := permsdocuments.DocumentsService.DeleteDocument.AccessTyped.Get(s.ps, userInfo)
@@ -1056,6 +1177,19 @@ func (s *Server) DeleteDocument(
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
 	}
 
+	eventType := notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED
+	if deletedAtTime != nil {
+		eventType = notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_DELETED
+	}
+	s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
+		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
+		Id:        &req.DocumentId,
+		EventType: eventType,
+
+		UserId: &userInfo.UserId,
+		Job:    &userInfo.Job,
+	})
+
 	return &pbdocuments.DeleteDocumentResponse{}, nil
 }
 
@@ -1106,6 +1240,13 @@ func (s *Server) ToggleDocument(
 		return nil, errorsdocuments.ErrDocToggleDenied
 	}
 
+	if doc.GetMeta().GetLegalHold() {
+		return nil, errorsdocuments.ErrDocLegalHold
+	}
+	if !req.GetClosed() && doc.GetMeta().GetArchivedAt() != nil && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrDocArchived
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
@@ -1180,6 +1321,9 @@ func (s *Server) ChangeDocumentOwner(
 	if doc.GetCreatorJob() != userInfo.GetJob() {
 		return nil, errorsdocuments.ErrDocOwnerWrongJob
 	}
+	if doc.GetMeta().GetLegalHold() {
+		return nil, errorsdocuments.ErrDocLegalHold
+	}
 
 	// If user is not a super user make sure they can only change owner to themselves
 	if req.NewUserId == nil || !userInfo.GetJobAdmin() {
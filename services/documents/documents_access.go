@@ -189,6 +189,10 @@ func (s *Server) SetDocumentAccess(
 		return nil, errorsdocuments.ErrDocAccessEditDenied
 	}
 
+	if err := s.checkDocumentLegalHold(ctx, req.GetDocumentId(), userInfo); err != nil {
+		return nil, err
+	}
+
 	// Begin transaction
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
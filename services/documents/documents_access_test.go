@@ -4,8 +4,12 @@ import (
 	"testing"
 
 	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
 	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDocumentAccessHasDuplicates(t *testing.T) {
@@ -42,3 +46,41 @@ func TestDocumentAccessHasDuplicates(t *testing.T) {
 		},
 	}))
 }
+
+func TestDocumentLegalHoldBlocksAccessChange(t *testing.T) {
+	t.Parallel()
+
+	oldAccess := &documentsaccess.DocumentAccess{
+		Jobs: []*resourcesaccess.JobAccess{
+			{
+				Job:          "police",
+				MinimumGrade: 3,
+				Access:       2,
+			},
+		},
+	}
+	req := &pbdocuments.UpdateDocumentRequest{
+		Access: &documentsaccess.DocumentAccess{
+			Jobs: []*resourcesaccess.JobAccess{
+				{
+					Job:          "police",
+					MinimumGrade: 3,
+					Access:       4,
+				},
+			},
+		},
+	}
+	require.True(t, documentUpdateAccessChanged(oldAccess, req))
+
+	doc := &documents.Document{
+		Meta: &documents.DocumentMeta{
+			LegalHold: true,
+		},
+	}
+	require.ErrorIs(t, documentLegalHoldError(doc), errorsdocuments.ErrDocLegalHold)
+
+	doc.Meta.LegalHold = false
+	require.NoError(t, documentLegalHoldError(doc))
+	// Documents without meta aren't on legal hold
+	require.NoError(t, documentLegalHoldError(&documents.Document{}))
+}
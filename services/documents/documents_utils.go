@@ -40,6 +40,7 @@ func (s *Server) getDocumentQuery(
 			tDCategory.Job,
 			tDCategory.Color,
 			tDCategory.Icon,
+			tDCategory.StateMachine,
 			tDocument.Title,
 			tDocument.ContentType,
 			tDocument.CreatorID,
@@ -54,6 +55,9 @@ func (s *Server) getDocumentQuery(
 			tDocument.Closed.AS("meta.closed"),
 			tDocument.Draft.AS("meta.draft"),
 			tDocument.Public.AS("meta.public"),
+			tDocument.Locked.AS("meta.locked"),
+			tDocument.LegalHold.AS("meta.legal_hold"),
+			tDocument.ArchivedAt.AS("meta.archived_at"),
 			tDocument.TemplateID,
 			tDPins.State,
 			tDPins.Job,
@@ -31,6 +31,110 @@ var (
 		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrClosedDoc.content"},
 		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrClosedDoc.title"},
 	)
+	ErrVersionNotFound = common.NewI18nErr(
+		codes.NotFound,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrVersionNotFound.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrVersionNotFound.title"},
+	)
+	ErrFormFieldInvalid = common.NewI18nErrFunc(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrFormFieldInvalid.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrFormFieldInvalid.title"},
+	)
+	ErrTemplateFormFieldsInvalid = common.NewI18nErrFunc(
+		codes.InvalidArgument,
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrTemplateFormFieldsInvalid.content",
+		},
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrTemplateFormFieldsInvalid.title",
+		},
+	)
+	ErrPDFRenderFailed = common.NewI18nErr(
+		codes.Internal,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrPDFRenderFailed.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrPDFRenderFailed.title"},
+	)
+	ErrDocLocked = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocLocked.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocLocked.title"},
+	)
+	ErrCategoryStateMachineInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrCategoryStateMachineInvalid.content",
+		},
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrCategoryStateMachineInvalid.title",
+		},
+	)
+	ErrDocStateTransitionInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrDocStateTransitionInvalid.content",
+		},
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrDocStateTransitionInvalid.title",
+		},
+	)
+	ErrDocStateTransitionDenied = common.NewI18nErr(
+		codes.PermissionDenied,
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrDocStateTransitionDenied.content",
+		},
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrDocStateTransitionDenied.title",
+		},
+	)
+	ErrDocStateNotApproved = common.NewI18nErr(
+		codes.FailedPrecondition,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocStateNotApproved.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocStateNotApproved.title"},
+	)
+	ErrDocStateRequiresTransition = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrDocStateRequiresTransition.content",
+		},
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrDocStateRequiresTransition.title",
+		},
+	)
+	ErrBulkSelectorEmpty = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrBulkSelectorEmpty.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrBulkSelectorEmpty.title"},
+	)
+	ErrBulkOperationDenied = common.NewI18nErr(
+		codes.PermissionDenied,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrBulkOperationDenied.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrBulkOperationDenied.title"},
+	)
+	ErrBulkCategoryInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrBulkCategoryInvalid.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrBulkCategoryInvalid.title"},
+	)
+	ErrDocLegalHold = common.NewI18nErr(
+		codes.FailedPrecondition,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocLegalHold.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocLegalHold.title"},
+	)
+	ErrDocArchived = common.NewI18nErr(
+		codes.FailedPrecondition,
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocArchived.content"},
+		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocArchived.title"},
+	)
+	ErrCategoryRetentionPolicyInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrCategoryRetentionPolicyInvalid.content",
+		},
+		&common.I18NItem{
+			Key: "errors.documents.DocumentsService.ErrCategoryRetentionPolicyInvalid.title",
+		},
+	)
 	ErrDocViewDenied = common.NewI18nErr(
 		codes.PermissionDenied,
 		&common.I18NItem{Key: "errors.documents.DocumentsService.ErrDocViewDenied"},
@@ -207,6 +311,15 @@ var (
 		&common.I18NItem{Key: "errors.documents.ApprovalService.ErrApprovalDocIsDraft.content"},
 		&common.I18NItem{Key: "errors.documents.ApprovalService.ErrApprovalDocIsDraft.title"},
 	)
+	ErrApprovalPolicyTemplateInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{
+			Key: "errors.documents.ApprovalService.ErrApprovalPolicyTemplateInvalid.content",
+		},
+		&common.I18NItem{
+			Key: "errors.documents.ApprovalService.ErrApprovalPolicyTemplateInvalid.title",
+		},
+	)
 
 	ErrStampLimitReached = common.NewI18nErr(
 		codes.InvalidArgument,
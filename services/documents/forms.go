@@ -0,0 +1,129 @@
+package documents
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	documentsdata "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
+	documentstemplates "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/templates"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// validateTemplateFormFields checks the typed field definitions of a template.
+func validateTemplateFormFields(tmpl *documentstemplates.Template) error {
+	if err := documentsforms.ValidateFields(tmpl.GetSchema().GetFields()); err != nil {
+		return errswrap.NewError(err, errorsdocuments.ErrTemplateFormFieldsInvalid(
+			formFieldErrorParams(err),
+		))
+	}
+
+	return nil
+}
+
+// validateFormValues validates the form field values against the template's fields and checks
+// that the referenced citizens, vehicles and laws exist.
+func (s *Server) validateFormValues(
+	ctx context.Context,
+	tmpl *documentstemplates.Template,
+	data *documentsdata.DocumentData,
+	checkRequired bool,
+) error {
+	fields := tmpl.GetSchema().GetFields()
+	if len(fields) == 0 && len(data.GetFields()) == 0 {
+		return nil
+	}
+
+	if err := documentsforms.ValidateValues(fields, data.GetFields(), checkRequired); err != nil {
+		return errswrap.NewError(err, errorsdocuments.ErrFormFieldInvalid(
+			formFieldErrorParams(err),
+		))
+	}
+
+	userIds, plates, lawIds := documentsforms.References(data.GetFields())
+
+	var existingUserIds []int32
+	if len(userIds) > 0 {
+		tUser := table.FivenetUser.AS("user")
+
+		ids := make([]mysql.Expression, len(userIds))
+		for i := range userIds {
+			ids[i] = mysql.Int32(userIds[i])
+		}
+
+		if err := tUser.
+			SELECT(tUser.ID).
+			FROM(tUser).
+			WHERE(tUser.ID.IN(ids...)).
+			QueryContext(ctx, s.db, &existingUserIds); err != nil {
+			return errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+	}
+
+	var existingPlates []string
+	if len(plates) > 0 {
+		tVehicles := table.FivenetOwnedVehicles.AS("vehicle")
+
+		ids := make([]mysql.Expression, len(plates))
+		for i := range plates {
+			ids[i] = mysql.String(plates[i])
+		}
+
+		if err := tVehicles.
+			SELECT(tVehicles.Plate).
+			FROM(tVehicles).
+			WHERE(tVehicles.Plate.IN(ids...)).
+			QueryContext(ctx, s.db, &existingPlates); err != nil {
+			return errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+	}
+
+	var existingLawIds []int64
+	if len(lawIds) > 0 {
+		tLaws := table.FivenetLawbooksLaws.AS("law")
+
+		ids := make([]mysql.Expression, len(lawIds))
+		for i := range lawIds {
+			ids[i] = mysql.Int64(lawIds[i])
+		}
+
+		if err := tLaws.
+			SELECT(tLaws.ID).
+			FROM(tLaws).
+			WHERE(tLaws.ID.IN(ids...)).
+			QueryContext(ctx, s.db, &existingLawIds); err != nil {
+			return errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+	}
+
+	for key, value := range data.GetFields() {
+		found := true
+		switch v := value.GetValue().(type) {
+		case *documentsforms.FormFieldValue_UserId:
+			found = slices.Contains(existingUserIds, v.UserId)
+		case *documentsforms.FormFieldValue_Plate:
+			found = slices.Contains(existingPlates, v.Plate)
+		case *documentsforms.FormFieldValue_LawId:
+			found = slices.Contains(existingLawIds, v.LawId)
+		}
+
+		if !found {
+			return errorsdocuments.ErrFormFieldInvalid(map[string]any{"field": key})
+		}
+	}
+
+	return nil
+}
+
+func formFieldErrorParams(err error) map[string]any {
+	var fieldErr *documentsforms.FieldError
+	if errors.As(err, &fieldErr) {
+		return map[string]any{"field": fieldErr.Key}
+	}
+
+	return map[string]any{"field": ""}
+}
@@ -0,0 +1,203 @@
+package documents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
+	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	documentsapproval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	permsdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents/perms"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	"github.com/fivenet-app/fivenet/v2026/pkg/pdf"
+	htmlsanitizer "github.com/fivenet-app/fivenet/v2026/pkg/sanitizer/html"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	documentsstore "github.com/fivenet-app/fivenet/v2026/stores/documents"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+)
+
+func (s *Server) GetDocumentPDF(
+	ctx context.Context,
+	req *pbdocuments.GetDocumentPDFRequest,
+) (*pbdocuments.GetDocumentPDFResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{documentIDLogFieldKey, req.GetDocumentId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	fileName, data, err := s.renderDocumentPDF(ctx, req.GetDocumentId(), userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_VIEWED)
+
+	return &pbdocuments.GetDocumentPDFResponse{
+		FileName: fileName,
+		Data:     data,
+	}, nil
+}
+
+// RenderDocumentPDF renders the document as PDF for the user in the context, used by the
+// PDF download HTTP endpoint.
+func (s *Server) RenderDocumentPDF(
+	ctx context.Context,
+	documentId int64,
+) (string, []byte, error) {
+	userInfo, ok := auth.GetUserInfoFromContext(ctx)
+	if !ok {
+		return "", nil, errorsdocuments.ErrDocViewDenied
+	}
+
+	// Same permission as the GetDocumentPDF RPC
+	if !s.ps.Can(userInfo, permsdocuments.DocumentsService.ListDocuments.Perm) {
+		return "", nil, errorsdocuments.ErrDocViewDenied
+	}
+
+	return s.renderDocumentPDF(ctx, documentId, userInfo)
+}
+
+func (s *Server) renderDocumentPDF(
+	ctx context.Context,
+	documentId int64,
+	userInfo *userinfo.UserInfo,
+) (string, []byte, error) {
+	check, err := s.canUserAccessDocument(
+		ctx,
+		documentId,
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW,
+	)
+	if err != nil {
+		return "", nil, errswrap.NewError(err, errorsdocuments.ErrNotFoundOrNoPerms)
+	}
+	// The access helper already grants job admins access
+	if !check {
+		return "", nil, errorsdocuments.ErrDocViewDenied
+	}
+
+	doc, err := s.getDocument(ctx, tDocument.ID.EQ(mysql.Int64(documentId)), userInfo, true)
+	if err != nil {
+		return "", nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if doc == nil {
+		return "", nil, errorsdocuments.ErrNotFoundOrNoPerms
+	}
+
+	approvals, err := s.listCompletedApprovals(ctx, doc.GetId(), userInfo)
+	if err != nil {
+		return "", nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	data, err := pdf.Render(documentToPDF(doc, approvals), pdf.DefaultLabels)
+	if err != nil {
+		return "", nil, errswrap.NewError(err, errorsdocuments.ErrPDFRenderFailed)
+	}
+
+	return fmt.Sprintf("document-%d.pdf", doc.GetId()), data, nil
+}
+
+// listCompletedApprovals returns the approved (signed/stamped) approvals of the document's
+// current approval policy snapshot.
+func (s *Server) listCompletedApprovals(
+	ctx context.Context,
+	documentId int64,
+	userInfo *userinfo.UserInfo,
+) ([]*documentsapproval.Approval, error) {
+	tApprovalPolicy := table.FivenetDocumentsApprovalPolicies.AS("approval_policy")
+
+	var pol documentsapproval.ApprovalPolicy
+	if err := tApprovalPolicy.
+		SELECT(
+			tApprovalPolicy.DocumentID,
+			tApprovalPolicy.SnapshotDate,
+		).
+		FROM(tApprovalPolicy).
+		WHERE(tApprovalPolicy.DocumentID.EQ(mysql.Int64(documentId))).
+		LIMIT(1).
+		QueryContext(ctx, s.db, &pol); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+	// No approval policy, no approvals
+	if pol.GetDocumentId() == 0 {
+		return nil, nil
+	}
+
+	_, approvals, err := s.store.ListApprovals(ctx, documentsstore.ListApprovalsQuery{
+		DocumentID:   documentId,
+		SnapshotDate: pol.GetSnapshotDate(),
+		Status:       documentsapproval.ApprovalStatus_APPROVAL_STATUS_APPROVED,
+		UserInfo:     userInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jobInfoFn := s.enricher.EnrichJobInfoSafeFunc(userInfo)
+	for _, apr := range approvals {
+		if apr.GetUserJob() != "" {
+			jobInfoFn(apr)
+		}
+	}
+
+	return approvals, nil
+}
+
+func documentToPDF(doc *documents.Document, approvals []*documentsapproval.Approval) *pdf.Document {
+	out := &pdf.Document{
+		Title:      doc.GetTitle(),
+		Category:   doc.GetCategory().GetName(),
+		CreatorJob: doc.GetCreatorJobLabel(),
+		State:      doc.GetMeta().GetState(),
+		CreatedAt:  doc.GetCreatedAt().AsTime(),
+		Content:    doc.GetContent().GetTiptapJson(),
+		Signatures: make([]*pdf.Signature, 0, len(approvals)),
+	}
+	if out.CreatorJob == "" {
+		out.CreatorJob = doc.GetCreatorJob()
+	}
+	if creator := doc.GetCreator(); creator != nil {
+		out.Creator = creator.GetFirstname() + " " + creator.GetLastname()
+	}
+	if doc.GetUpdatedAt() != nil {
+		updatedAt := doc.GetUpdatedAt().AsTime()
+		out.UpdatedAt = &updatedAt
+	}
+	if out.Content == nil {
+		// Legacy documents only have HTML content
+		out.Text = htmlsanitizer.StripHTMLTags(doc.GetContent().GetRawHtml())
+	}
+
+	for _, apr := range approvals {
+		sig := &pdf.Signature{
+			Job:       apr.GetUserJobLabel(),
+			Comment:   apr.GetComment(),
+			SVG:       apr.GetPayloadSvg(),
+			StampName: apr.GetStamp().GetName(),
+			StampSVG:  apr.GetStamp().GetSvgTemplate(),
+		}
+		if sig.Job == "" {
+			sig.Job = apr.GetUserJob()
+		}
+		if user := apr.GetUser(); user != nil {
+			sig.Name = user.GetFirstname() + " " + user.GetLastname()
+		}
+		if apr.GetCreatedAt() != nil {
+			sig.SignedAt = apr.GetCreatedAt().AsTime()
+		}
+
+		out.Signatures = append(out.Signatures, sig)
+	}
+
+	return out
+}
@@ -10,8 +10,10 @@ import (
 	documentsreferences "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/references"
 	documentsrelations "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/relations"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
 	usersactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/activity"
 	usershort "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	permscentrum "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/centrum/perms"
 	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
 	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
 	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
@@ -47,7 +49,28 @@ func (s *Server) GetDocumentReferences(
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
 	}
 
-	resp := &pbdocuments.GetDocumentReferencesResponse{References: dest}
+	jobInfoFn := s.enricher.EnrichJobInfoSafeFunc(userInfo)
+
+	// Dispatch timelines contain unit members and their positions, so they are only returned
+	// to users that can access the dispatch center
+	var timelines []*documentsreferences.DocumentDispatchTimeline
+	if s.ps.Can(userInfo, permscentrum.CentrumService.Stream.Perm) {
+		timelines, err = s.store.ListDocumentDispatchTimelines(ctx, req.GetDocumentId())
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+
+		for i := range timelines {
+			if timelines[i].GetCreator() != nil {
+				jobInfoFn(timelines[i].GetCreator())
+			}
+		}
+	}
+
+	resp := &pbdocuments.GetDocumentReferencesResponse{
+		References:        dest,
+		DispatchTimelines: timelines,
+	}
 	if len(dest) == 0 {
 		return resp, nil
 	}
@@ -66,10 +89,11 @@ func (s *Server) GetDocumentReferences(
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
 	}
 	if len(ids) == 0 {
-		return &pbdocuments.GetDocumentReferencesResponse{}, nil
+		return &pbdocuments.GetDocumentReferencesResponse{
+			DispatchTimelines: timelines,
+		}, nil
 	}
 
-	jobInfoFn := s.enricher.EnrichJobInfoSafeFunc(userInfo)
 	for i := range dest {
 		if dest[i].GetCreator() != nil {
 			jobInfoFn(dest[i].GetCreator())
@@ -142,6 +166,10 @@ func (s *Server) AddDocumentReference(
 
 	userInfo := auth.MustGetUserInfoFromContext(ctx)
 
+	if req.DispatchId != nil {
+		return s.addDocumentDispatchTimeline(ctx, userInfo, req)
+	}
+
 	if req.GetReference().GetSourceDocumentId() == req.GetReference().GetTargetDocumentId() {
 		return nil, errorsdocuments.ErrFeedRefSelf
 	}
@@ -161,6 +189,14 @@ func (s *Server) AddDocumentReference(
 		return nil, errorsdocuments.ErrFeedRefAddDenied
 	}
 
+	if err := s.checkDocumentLegalHold(
+		ctx,
+		req.GetReference().GetSourceDocumentId(),
+		userInfo,
+	); err != nil {
+		return nil, err
+	}
+
 	req.Reference.CreatorId = &userInfo.UserId
 
 	lastId, err := s.store.CreateDocumentReference(
@@ -182,6 +218,72 @@ func (s *Server) AddDocumentReference(
 	return &pbdocuments.AddDocumentReferenceResponse{Id: lastId}, nil
 }
 
+// addDocumentDispatchTimeline attaches a snapshot of the dispatch's timeline to the reference's source document.
+func (s *Server) addDocumentDispatchTimeline(
+	ctx context.Context,
+	userInfo *userinfo.UserInfo,
+	req *pbdocuments.AddDocumentReferenceRequest,
+) (*pbdocuments.AddDocumentReferenceResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{"fivenet.centrum.dispatch_id", req.GetDispatchId()})
+
+	check, err := s.canUserAccessDocument(
+		ctx,
+		req.GetReference().GetSourceDocumentId(),
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_EDIT,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if !check && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrFeedRefAddDenied
+	}
+
+	if err := s.checkDocumentLegalHold(
+		ctx,
+		req.GetReference().GetSourceDocumentId(),
+		userInfo,
+	); err != nil {
+		return nil, err
+	}
+
+	// Dispatch timelines are only available to users that can access the dispatch center
+	if !s.ps.Can(userInfo, permscentrum.CentrumService.Stream.Perm) {
+		return nil, errorsdocuments.ErrFeedRefAddDenied
+	}
+
+	jobs, _, err := s.centrumSettings.GetAccessList(ctx, userInfo.GetJob(), userInfo.GetJobGrade())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	timeline, err := s.dispatches.BuildTimeline(ctx, req.GetDispatchId(), jobs)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if timeline == nil {
+		return nil, errorsdocuments.ErrFeedRefAddDenied
+	}
+
+	lastId, err := s.store.CreateDocumentDispatchTimeline(
+		ctx,
+		s.db,
+		&documentsreferences.DocumentDispatchTimeline{
+			DocumentId: req.GetReference().GetSourceDocumentId(),
+			DispatchId: req.GetDispatchId(),
+			CreatorId:  &userInfo.UserId,
+			Timeline:   timeline,
+		},
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_CREATED)
+
+	return &pbdocuments.AddDocumentReferenceResponse{Id: lastId}, nil
+}
+
 func (s *Server) RemoveDocumentReference(
 	ctx context.Context,
 	req *pbdocuments.RemoveDocumentReferenceRequest,
@@ -190,6 +292,10 @@ func (s *Server) RemoveDocumentReference(
 
 	userInfo := auth.MustGetUserInfoFromContext(ctx)
 
+	if req.GetDispatchTimeline() {
+		return s.removeDocumentDispatchTimeline(ctx, userInfo, req.GetId())
+	}
+
 	ref, err := s.store.GetDocumentReference(ctx, req.GetId(), userInfo.GetJobAdmin())
 	if err != nil {
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
@@ -209,6 +315,10 @@ func (s *Server) RemoveDocumentReference(
 		return nil, errorsdocuments.ErrFeedRefRemoveDenied
 	}
 
+	if err := s.checkDocumentLegalHold(ctx, ref.GetSourceDocumentId(), userInfo); err != nil {
+		return nil, err
+	}
+
 	if err := s.store.DeleteDocumentReference(ctx, s.db, req.GetId()); err != nil {
 		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
 	}
@@ -218,6 +328,45 @@ func (s *Server) RemoveDocumentReference(
 	return &pbdocuments.RemoveDocumentReferenceResponse{}, nil
 }
 
+func (s *Server) removeDocumentDispatchTimeline(
+	ctx context.Context,
+	userInfo *userinfo.UserInfo,
+	id int64,
+) (*pbdocuments.RemoveDocumentReferenceResponse, error) {
+	tl, err := s.store.GetDocumentDispatchTimeline(ctx, id)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if tl.GetId() <= 0 {
+		return &pbdocuments.RemoveDocumentReferenceResponse{}, nil
+	}
+
+	check, err := s.canUserAccessDocument(
+		ctx,
+		tl.GetDocumentId(),
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_EDIT,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if !check && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrFeedRefRemoveDenied
+	}
+
+	if err := s.checkDocumentLegalHold(ctx, tl.GetDocumentId(), userInfo); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteDocumentDispatchTimeline(ctx, s.db, tl.GetId()); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_DELETED)
+
+	return &pbdocuments.RemoveDocumentReferenceResponse{}, nil
+}
+
 func (s *Server) AddDocumentRelation(
 	ctx context.Context,
 	req *pbdocuments.AddDocumentRelationRequest,
@@ -243,6 +392,14 @@ func (s *Server) AddDocumentRelation(
 		return nil, errorsdocuments.ErrFeedRelAddDenied
 	}
 
+	if err := s.checkDocumentLegalHold(
+		ctx,
+		req.GetRelation().GetDocumentId(),
+		userInfo,
+	); err != nil {
+		return nil, err
+	}
+
 	req.Relation.SourceUserId = userInfo.GetUserId()
 
 	lastId, created, err := s.store.CreateDocumentRelation(
@@ -325,6 +482,10 @@ func (s *Server) RemoveDocumentRelation(
 		return nil, errorsdocuments.ErrFeedRelRemoveDenied
 	}
 
+	if err := s.checkDocumentLegalHold(ctx, rel.GetDocumentId(), userInfo); err != nil {
+		return nil, err
+	}
+
 	// Begin transaction
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -0,0 +1,345 @@
+package documents
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	documentscategory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
+	notificationsclientview "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications/clientview"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// retentionBatchSize is the max. number of documents a retention action is applied to per
+	// category and run.
+	retentionBatchSize = 250
+
+	// Prefix of the cron data attributes holding the fingerprint of the last logged dry-run report
+	retentionDryRunAttrPrefix = "dry_run."
+)
+
+// validateCategoryRetentionPolicy checks the order of the category's retention policy actions.
+func validateCategoryRetentionPolicy(category *documentscategory.Category) error {
+	if err := category.GetRetentionPolicy().Validate(); err != nil {
+		return errswrap.NewError(err, errorsdocuments.ErrCategoryRetentionPolicyInvalid)
+	}
+
+	return nil
+}
+
+func (s *Server) SetDocumentLegalHold(
+	ctx context.Context,
+	req *pbdocuments.SetDocumentLegalHoldRequest,
+) (*pbdocuments.SetDocumentLegalHoldResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{documentIDLogFieldKey, req.GetDocumentId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	check, err := s.canUserAccessDocument(
+		ctx,
+		req.GetDocumentId(),
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrNotFoundOrNoPerms)
+	}
+	if !check && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrNotFoundOrNoPerms
+	}
+
+	doc, err := s.getDocument(
+		ctx,
+		tDocument.ID.EQ(mysql.Int64(req.GetDocumentId())),
+		userInfo,
+		false,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if doc == nil || doc.GetDeletedAt() != nil {
+		return nil, errorsdocuments.ErrNotFoundOrNoPerms
+	}
+
+	// Legal holds can only be managed for documents of the own job
+	if doc.GetCreatorJob() != userInfo.GetJob() {
+		return nil, errorsdocuments.ErrPermissionDenied
+	}
+
+	if doc.GetMeta().GetLegalHold() == req.GetLegalHold() {
+		return &pbdocuments.SetDocumentLegalHoldResponse{
+			Meta: doc.GetMeta(),
+		}, nil
+	}
+
+	activityType := documentsactivity.DocActivityType_DOC_ACTIVITY_TYPE_LEGAL_HOLD_SET
+	if !req.GetLegalHold() {
+		activityType = documentsactivity.DocActivityType_DOC_ACTIVITY_TYPE_LEGAL_HOLD_REMOVED
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	defer tx.Rollback()
+
+	if err := s.store.SetDocumentLegalHold(
+		ctx,
+		tx,
+		doc.GetId(),
+		req.GetLegalHold(),
+	); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	if _, err := addDocumentActivity(ctx, tx, &documentsactivity.DocActivity{
+		DocumentId:   doc.GetId(),
+		ActivityType: activityType,
+		CreatorId:    &userInfo.UserId,
+		CreatorJob:   userInfo.GetJob(),
+		Reason:       req.Reason,
+	}); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
+		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
+		Id:        &doc.Id,
+		EventType: notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED,
+
+		UserId: &userInfo.UserId,
+		Job:    &userInfo.Job,
+	})
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
+
+	doc.GetMeta().LegalHold = req.GetLegalHold()
+
+	return &pbdocuments.SetDocumentLegalHoldResponse{
+		Meta: doc.GetMeta(),
+	}, nil
+}
+
+// runRetentionPolicies applies the retention policies of all categories. Policies in dry-run mode
+// only report the documents they would act on, their reports are only logged when the matched
+// documents change. Returns the number of documents that have been acted on and the number of
+// documents matched by dry-run policies.
+func (s *Server) runRetentionPolicies(
+	ctx context.Context,
+	dest *cron.GenericCronData,
+) (int64, int64, error) {
+	categories, err := s.store.ListCategoriesWithRetentionPolicy(ctx, s.db)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	now := time.Now()
+	affected := int64(0)
+	dryRunMatched := int64(0)
+	dryRuns := map[string]struct{}{}
+	for _, category := range categories {
+		policy := category.GetRetentionPolicy()
+
+		for _, action := range documentscategory.RetentionActions {
+			days, ok := policy.AfterDays(action)
+			if !ok {
+				continue
+			}
+			before := now.AddDate(0, 0, -int(days))
+
+			report, err := s.applyRetentionPolicyAction(ctx, category, action, before)
+			if err != nil {
+				return affected, dryRunMatched, err
+			}
+			if report == nil {
+				continue
+			}
+
+			if report.GetDryRun() {
+				dryRunMatched += report.GetCount()
+				dryRuns[s.logRetentionDryRunReport(dest, report)] = struct{}{}
+			} else {
+				affected += int64(len(report.GetDocumentIds()))
+			}
+		}
+	}
+
+	// Forget dry-run reports that don't match any documents anymore
+	for key := range dest.GetAttributes() {
+		if _, ok := dryRuns[key]; !ok && strings.HasPrefix(key, retentionDryRunAttrPrefix) {
+			dest.DeleteAttribute(key)
+		}
+	}
+
+	return affected, dryRunMatched, nil
+}
+
+// applyRetentionPolicyAction applies the retention action to the next batch of the category's
+// documents and logs a report to the audit log, policies in dry-run mode only return the report.
+// No report is returned if there aren't any documents to act on.
+func (s *Server) applyRetentionPolicyAction(
+	ctx context.Context,
+	category *documentscategory.Category,
+	action documentscategory.RetentionAction,
+	before time.Time,
+) (*documentscategory.RetentionReport, error) {
+	count, err := s.store.CountRetentionDocuments(ctx, s.db, category.GetId(), action, before)
+	if err != nil {
+		return nil, err
+	}
+	if count <= 0 {
+		return nil, nil
+	}
+
+	documentIds, err := s.store.ListRetentionDocumentIDs(
+		ctx,
+		s.db,
+		category.GetId(),
+		action,
+		before,
+		retentionBatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &documentscategory.RetentionReport{
+		CategoryId:  category.GetId(),
+		Job:         category.GetJob(),
+		Action:      action,
+		DryRun:      category.GetRetentionPolicy().GetDryRun(),
+		Count:       count,
+		DocumentIds: documentIds,
+	}
+
+	if !report.GetDryRun() {
+		err = s.applyRetentionAction(ctx, category.GetJob(), action, documentIds)
+		s.logRetentionReport(report, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.logger.Info("applied document retention policy",
+		zap.Int64("category_id", report.GetCategoryId()),
+		zap.String("job", report.GetJob()),
+		zap.String("action", action.String()),
+		zap.Bool("dry_run", report.GetDryRun()),
+		zap.Int64("count", count),
+		zap.Int("documents", len(documentIds)))
+
+	return report, nil
+}
+
+func (s *Server) applyRetentionAction(
+	ctx context.Context,
+	job string,
+	action documentscategory.RetentionAction,
+	documentIds []int64,
+) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := s.store.ApplyRetentionAction(ctx, tx, action, documentIds); err != nil {
+		return err
+	}
+
+	if action == documentscategory.RetentionAction_RETENTION_ACTION_DELETE {
+		for _, documentId := range documentIds {
+			if err := s.subjectAccess.RefreshTargetVisibility(ctx, tx, documentId); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	eventType := notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED
+	if action == documentscategory.RetentionAction_RETENTION_ACTION_DELETE {
+		eventType = notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_DELETED
+	}
+	for _, documentId := range documentIds {
+		s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
+			Type:      notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
+			Id:        &documentId,
+			EventType: eventType,
+
+			Job: &job,
+		})
+	}
+
+	return nil
+}
+
+// logRetentionDryRunReport logs the dry-run report unless the same documents have been reported
+// by the previous run. Returns the report's cron data attribute key.
+func (s *Server) logRetentionDryRunReport(
+	dest *cron.GenericCronData,
+	report *documentscategory.RetentionReport,
+) string {
+	key := retentionDryRunAttrPrefix + strconv.FormatInt(report.GetCategoryId(), 10) + "." +
+		strconv.FormatInt(int64(report.GetAction()), 10)
+
+	matched := []string{strconv.FormatInt(report.GetCount(), 10)}
+	for _, documentId := range report.GetDocumentIds() {
+		matched = append(matched, strconv.FormatInt(documentId, 10))
+	}
+	fingerprint := strconv.FormatUint(xxhash.Sum64String(strings.Join(matched, ",")), 16)
+
+	if dest.GetAttribute(key) != fingerprint {
+		s.logRetentionReport(report, nil)
+		dest.SetAttribute(key, fingerprint)
+	}
+
+	return key
+}
+
+func (s *Server) logRetentionReport(report *documentscategory.RetentionReport, err error) {
+	action := audit.EventAction_EVENT_ACTION_UPDATED
+	if report.GetAction() == documentscategory.RetentionAction_RETENTION_ACTION_DELETE {
+		action = audit.EventAction_EVENT_ACTION_DELETED
+	}
+
+	result := audit.EventResult_EVENT_RESULT_SUCCEEDED
+	if err != nil {
+		result = audit.EventResult_EVENT_RESULT_ERRORED
+	}
+
+	s.aud.Log(&audit.AuditEntry{
+		UserJob: report.GetJob(),
+		Service: pbdocuments.DocumentsService_ServiceDesc.ServiceName,
+		Method:  "ApplyRetentionPolicy",
+		Action:  action,
+		Result:  result,
+		Meta: &audit.AuditEntryMeta{
+			Meta: map[string]string{
+				"category_id": strconv.FormatInt(report.GetCategoryId(), 10),
+				"dry_run":     strconv.FormatBool(report.GetDryRun()),
+			},
+		},
+	}, report)
+}
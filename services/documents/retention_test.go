@@ -0,0 +1,49 @@
+package documents
+
+import (
+	"testing"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	documentscategory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type retentionTestAuditer struct {
+	entries []*audit.AuditEntry
+}
+
+func (a *retentionTestAuditer) Log(in *audit.AuditEntry, _ any) {
+	a.entries = append(a.entries, in)
+}
+
+func TestLogRetentionDryRunReportOnlyOnChange(t *testing.T) {
+	t.Parallel()
+
+	aud := &retentionTestAuditer{}
+	s := &Server{aud: aud}
+	dest := &cron.GenericCronData{}
+
+	report := &documentscategory.RetentionReport{
+		CategoryId:  3,
+		Job:         "police",
+		Action:      documentscategory.RetentionAction_RETENTION_ACTION_ARCHIVE,
+		DryRun:      true,
+		Count:       2,
+		DocumentIds: []int64{5, 8},
+	}
+
+	key := s.logRetentionDryRunReport(dest, report)
+	assert.Equal(t, "dry_run.3.2", key)
+	assert.Len(t, aud.entries, 1)
+
+	// Same documents matched again
+	assert.Equal(t, key, s.logRetentionDryRunReport(dest, report))
+	assert.Len(t, aud.entries, 1)
+
+	report.Count = 3
+	report.DocumentIds = append(report.DocumentIds, 13)
+	s.logRetentionDryRunReport(dest, report)
+	assert.Len(t, aud.entries, 2)
+	assert.Equal(t, "ApplyRetentionPolicy", aud.entries[1].GetMethod())
+}
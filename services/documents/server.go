@@ -10,6 +10,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
 	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
 	"github.com/fivenet-app/fivenet/v2026/pkg/access"
+	"github.com/fivenet-app/fivenet/v2026/pkg/audit"
 	"github.com/fivenet-app/fivenet/v2026/pkg/collab"
 	"github.com/fivenet-app/fivenet/v2026/pkg/croner"
 	"github.com/fivenet-app/fivenet/v2026/pkg/events"
@@ -19,10 +20,13 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/mstlystcdata"
 	"github.com/fivenet-app/fivenet/v2026/pkg/notifi"
 	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+	serverfilestore "github.com/fivenet-app/fivenet/v2026/pkg/server/filestore"
 	docstats "github.com/fivenet-app/fivenet/v2026/pkg/stats"
 	"github.com/fivenet-app/fivenet/v2026/pkg/storage"
 	"github.com/fivenet-app/fivenet/v2026/pkg/userinfo"
 	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatches"
+	centrumsettings "github.com/fivenet-app/fivenet/v2026/services/centrum/settings"
 	documentsstore "github.com/fivenet-app/fivenet/v2026/stores/documents"
 	"github.com/go-jet/jet/v2/mysql"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
@@ -83,6 +87,14 @@ func init() {
 
 				MinDays: housekeeperMinDays,
 			},
+			{
+				Table:           table.FivenetDocumentsDispatchTimelines,
+				IDColumn:        table.FivenetDocumentsDispatchTimelines.ID,
+				ForeignKey:      table.FivenetDocumentsDispatchTimelines.DocumentID,
+				DeletedAtColumn: table.FivenetDocumentsDispatchTimelines.DeletedAt,
+
+				MinDays: housekeeperMinDays,
+			},
 
 			// Pins
 			{
@@ -133,6 +145,7 @@ type Server struct {
 	enricher      mstlystcdata.IUserAwareEnricher
 	ui            userinfo.UserInfoRetriever
 	notifi        notifi.INotifi
+	aud           audit.IAuditer
 	store         documentsstore.IStore
 
 	subjectAccess   *access.DocumentsObjectAccess
@@ -141,6 +154,9 @@ type Server struct {
 
 	signingStampAccess *access.DocumentStampsObjectAccess
 
+	centrumSettings *centrumsettings.SettingsDB
+	dispatches      *dispatches.DispatchDB
+
 	collabServer *collab.CollabServer
 	fHandler     *filestore.Handler[int64]
 	stats        *docstats.Service
@@ -161,12 +177,15 @@ type Params struct {
 	Enricher           mstlystcdata.IUserAwareEnricher
 	Ui                 userinfo.UserInfoRetriever
 	Notif              notifi.INotifi
+	Audit              audit.IAuditer
 	JS                 *events.JSWrapper
 	Stats              *docstats.Service
 	Store              documentsstore.IStore
 	SubjectAccess      *access.DocumentsObjectAccess
 	TemplateAccess     *access.DocumentTemplatesObjectAccess
 	SigningStampAccess *access.DocumentStampsObjectAccess
+	CentrumSettings    *centrumsettings.SettingsDB
+	Dispatches         *dispatches.DispatchDB
 }
 
 type Result struct {
@@ -175,6 +194,7 @@ type Result struct {
 	Server       *Server
 	Service      pkggrpc.Service     `group:"grpcservices"`
 	CronRegister croner.CronRegister `group:"cronjobregister"`
+	PDFRenderer  serverfilestore.DocumentPDFRenderer
 }
 
 func NewServer(p Params) Result {
@@ -215,6 +235,7 @@ func NewServer(p Params) Result {
 		enricher:      p.Enricher,
 		ui:            p.Ui,
 		notifi:        p.Notif,
+		aud:           p.Audit,
 		store:         p.Store,
 
 		subjectAccess:   p.SubjectAccess,
@@ -223,6 +244,9 @@ func NewServer(p Params) Result {
 
 		signingStampAccess: p.SigningStampAccess,
 
+		centrumSettings: p.CentrumSettings,
+		dispatches:      p.Dispatches,
+
 		collabServer: collabServer,
 		fHandler:     fHandler,
 		stats:        p.Stats,
@@ -242,6 +266,7 @@ func NewServer(p Params) Result {
 		Server:       s,
 		Service:      s,
 		CronRegister: s,
+		PDFRenderer:  s,
 	}
 }
 
@@ -264,10 +289,31 @@ func (s *Server) RegisterCronjobs(ctx context.Context, registry croner.IRegistry
 		return err
 	}
 
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "documents.approval.tasks.delegate",
+		Schedule: "*/5 * * * *", // Every 5 minutes
+	}); err != nil {
+		return err
+	}
+
 	if err := registry.UnregisterCronjob(ctx, "documents.signature.tasks.expire"); err != nil {
 		return err
 	}
 
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "documents.bulk_jobs.run",
+		Schedule: "* * * * *", // Every minute
+	}); err != nil {
+		return err
+	}
+
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "documents.retention.run",
+		Schedule: "0 * * * *", // Every hour
+	}); err != nil {
+		return err
+	}
+
 	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
 		Name:     "documents.stats.rollup.columns.recent",
 		Schedule: "*/5 * * * *",
@@ -320,6 +366,79 @@ func (s *Server) RegisterCronjobHandlers(h *croner.Handlers) error {
 		},
 	)
 
+	h.Add(
+		"documents.approval.tasks.delegate",
+		func(ctx context.Context, data *cron.CronjobData) error {
+			ctx, span := s.tracer.Start(ctx, "documents.approval.tasks.delegate")
+			defer span.End()
+
+			dest := &cron.GenericCronData{}
+
+			rowsAffected, err := s.delegateApprovalTasks(ctx)
+			if err != nil {
+				return err
+			}
+			dest.SetAttribute("rows_affected", strconv.FormatInt(rowsAffected, 10))
+
+			if err := data.MarshalFrom(dest); err != nil {
+				return fmt.Errorf("failed to marshal updated approval delegation cron data. %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	h.Add(
+		"documents.bulk_jobs.run",
+		func(ctx context.Context, data *cron.CronjobData) error {
+			ctx, span := s.tracer.Start(ctx, "documents.bulk_jobs.run")
+			defer span.End()
+
+			dest := &cron.GenericCronData{}
+
+			jobId, processed, err := s.runBulkJobs(ctx)
+			if err != nil {
+				return err
+			}
+			dest.SetAttribute("bulk_job_id", strconv.FormatInt(jobId, 10))
+			dest.SetAttribute("processed", strconv.FormatInt(processed, 10))
+
+			if err := data.MarshalFrom(dest); err != nil {
+				return fmt.Errorf("failed to marshal updated document bulk jobs cron data. %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	h.Add(
+		"documents.retention.run",
+		func(ctx context.Context, data *cron.CronjobData) error {
+			ctx, span := s.tracer.Start(ctx, "documents.retention.run")
+			defer span.End()
+
+			dest := &cron.GenericCronData{
+				Attributes: map[string]string{},
+			}
+			if err := data.Unmarshal(dest); err != nil {
+				s.logger.Warn("failed to unmarshal document retention cron data", zap.Error(err))
+			}
+
+			affected, dryRunMatched, err := s.runRetentionPolicies(ctx, dest)
+			if err != nil {
+				return err
+			}
+			dest.SetAttribute("affected", strconv.FormatInt(affected, 10))
+			dest.SetAttribute("dry_run_matched", strconv.FormatInt(dryRunMatched, 10))
+
+			if err := data.MarshalFrom(dest); err != nil {
+				return fmt.Errorf("failed to marshal updated document retention cron data. %w", err)
+			}
+
+			return nil
+		},
+	)
+
 	h.Add(
 		"documents.stats.rollup.columns.recent",
 		func(ctx context.Context, data *cron.CronjobData) error {
@@ -0,0 +1,322 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
+	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	documentscategory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications"
+	notificationsclientview "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications/clientview"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	usershort "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	permsdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents/perms"
+	"github.com/fivenet-app/fivenet/v2026/pkg/access"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"go.uber.org/zap"
+)
+
+// validateCategoryStateMachine checks the states and transitions of the category's state machine.
+func validateCategoryStateMachine(category *documentscategory.Category) error {
+	if err := category.GetStateMachine().Validate(); err != nil {
+		return errswrap.NewError(err, errorsdocuments.ErrCategoryStateMachineInvalid)
+	}
+
+	return nil
+}
+
+// getCategoryStateMachine returns the active state machine of the category, nil if the category
+// doesn't use one.
+func (s *Server) getCategoryStateMachine(
+	ctx context.Context,
+	categoryId int64,
+) (*documentscategory.Category, error) {
+	if categoryId <= 0 {
+		return nil, nil
+	}
+
+	return s.store.GetCategoryWithStateMachine(ctx, s.db, categoryId)
+}
+
+// getDocumentUpdateState returns the state of the document after the update, category is the state machine category
+// the document is in after the update (nil if it doesn't use one). Documents moved into a category with a state machine
+// start in its initial state, otherwise the state can only be changed by transitions.
+func getDocumentUpdateState(
+	oldDoc *documents.Document,
+	req *pbdocuments.UpdateDocumentRequest,
+	category *documentscategory.Category,
+) (string, error) {
+	state := req.GetMeta().GetState()
+	if category == nil {
+		return state, nil
+	}
+
+	if oldDoc.GetCategoryId() != req.GetCategoryId() {
+		return category.GetStateMachine().GetInitialState(), nil
+	}
+
+	if state != oldDoc.GetMeta().GetState() {
+		return "", errorsdocuments.ErrDocStateRequiresTransition
+	}
+
+	return state, nil
+}
+
+func (s *Server) TransitionDocumentState(
+	ctx context.Context,
+	req *pbdocuments.TransitionDocumentStateRequest,
+) (*pbdocuments.TransitionDocumentStateResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{documentIDLogFieldKey, req.GetDocumentId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	check, err := s.canUserAccessDocument(
+		ctx,
+		req.GetDocumentId(),
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_STATUS,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrNotFoundOrNoPerms)
+	}
+	if !check && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrDocStateTransitionDenied
+	}
+
+	// The document's data is needed to match the conditions of approval policy template stages
+	doc, err := s.getDocument(
+		ctx,
+		tDocument.ID.EQ(mysql.Int64(req.GetDocumentId())),
+		userInfo,
+		true,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if doc == nil {
+		return nil, errorsdocuments.ErrNotFoundOrNoPerms
+	}
+
+	// Field Permission Check
+	fields, err := permsdocuments.DocumentsService.ToggleDocument.AccessTyped.Get(s.ps, userInfo)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if !access.CheckIfHasOwnJobAccess(
+		fields.StringList(),
+		userInfo,
+		doc.GetCreatorJob(),
+		doc.GetCreator(),
+	) {
+		return nil, errorsdocuments.ErrDocStateTransitionDenied
+	}
+
+	if doc.GetMeta().GetClosed() && !userInfo.GetJobAdmin() {
+		return nil, errorsdocuments.ErrClosedDoc
+	}
+	if doc.GetMeta().GetLegalHold() {
+		return nil, errorsdocuments.ErrDocLegalHold
+	}
+
+	category, err := s.getCategoryStateMachine(ctx, doc.GetCategoryId())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if category == nil {
+		return nil, errorsdocuments.ErrDocStateTransitionInvalid
+	}
+
+	transition := category.GetStateMachine().FindTransition(
+		doc.GetMeta().GetState(),
+		req.GetToState(),
+	)
+	if transition == nil {
+		return nil, errorsdocuments.ErrDocStateTransitionInvalid
+	}
+	if !canUserPerformTransition(userInfo, category, transition) {
+		return nil, errorsdocuments.ErrDocStateTransitionDenied
+	}
+
+	if transition.HasEffect(
+		documentscategory.StateTransitionEffect_STATE_TRANSITION_EFFECT_REQUIRE_APPROVAL,
+	) && !doc.GetMeta().GetApproved() {
+		return nil, errorsdocuments.ErrDocStateNotApproved
+	}
+
+	locked := doc.GetMeta().GetLocked()
+	if transition.HasEffect(
+		documentscategory.StateTransitionEffect_STATE_TRANSITION_EFFECT_LOCK_CONTENT,
+	) {
+		locked = true
+	} else if transition.HasEffect(
+		documentscategory.StateTransitionEffect_STATE_TRANSITION_EFFECT_UNLOCK_CONTENT,
+	) {
+		locked = false
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	defer tx.Rollback()
+
+	if err := s.store.UpdateDocumentState(
+		ctx,
+		tx,
+		doc.GetId(),
+		req.GetToState(),
+		locked,
+	); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	if _, err := addDocumentActivity(ctx, tx, &documentsactivity.DocActivity{
+		DocumentId:   doc.GetId(),
+		ActivityType: documentsactivity.DocActivityType_DOC_ACTIVITY_TYPE_STATE_CHANGED,
+		CreatorId:    &userInfo.UserId,
+		CreatorJob:   userInfo.GetJob(),
+		Reason:       req.Reason,
+		Data: &documentsactivity.DocActivityData{
+			Data: &documentsactivity.DocActivityData_StateChanged{
+				StateChanged: &documentsactivity.DocStateChanged{
+					FromState: doc.GetMeta().GetState(),
+					ToState:   req.GetToState(),
+					Effects:   transition.GetEffects(),
+				},
+			},
+		},
+	}); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	if !doc.GetMeta().GetDraft() {
+		if err := s.applyApprovalPolicyTemplate(
+			ctx,
+			tx,
+			userInfo,
+			doc,
+			req.GetToState(),
+		); err != nil {
+			return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+		}
+	}
+
+	if transition.HasEffect(
+		documentscategory.StateTransitionEffect_STATE_TRANSITION_EFFECT_CLOSE,
+	) && !doc.GetMeta().GetClosed() {
+		if err := s.store.ToggleDocument(
+			ctx,
+			tx,
+			doc.GetId(),
+			doc.GetTemplateId(),
+			true,
+			userInfo,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	if transition.HasEffect(
+		documentscategory.StateTransitionEffect_STATE_TRANSITION_EFFECT_NOTIFY_OWNER,
+	) && doc.GetCreatorId() > 0 && doc.GetCreatorId() != userInfo.GetUserId() {
+		if err := s.notifyOwnerAboutStateChange(
+			ctx,
+			doc,
+			userInfo.GetUserId(),
+			req.GetToState(),
+		); err != nil {
+			s.logger.Warn("failed to notify document owner about state change",
+				zap.Int64("document_id", doc.GetId()), zap.Error(err))
+		}
+	}
+
+	s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
+		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
+		Id:        &doc.Id,
+		EventType: notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED,
+
+		UserId: &userInfo.UserId,
+		Job:    &userInfo.Job,
+	})
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
+
+	doc, err = s.getDocument(
+		ctx,
+		tDocument.ID.EQ(mysql.Int64(req.GetDocumentId())),
+		userInfo,
+		false,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	return &pbdocuments.TransitionDocumentStateResponse{
+		Meta: doc.GetMeta(),
+	}, nil
+}
+
+// canUserPerformTransition checks the transition's minimum grade, which applies to members of the
+// category's job. Job admins can perform any transition.
+func canUserPerformTransition(
+	userInfo *userinfo.UserInfo,
+	category *documentscategory.Category,
+	transition *documentscategory.StateTransition,
+) bool {
+	if userInfo.GetJobAdmin() || transition.MinimumGrade == nil {
+		return true
+	}
+
+	return userInfo.GetJob() == category.GetJob() &&
+		userInfo.GetJobGrade() >= transition.GetMinimumGrade()
+}
+
+func (s *Server) notifyOwnerAboutStateChange(
+	ctx context.Context,
+	doc *documents.Document,
+	sourceUserId int32,
+	state string,
+) error {
+	not := &notifications.Notification{
+		UserId: doc.GetCreatorId(),
+		Title: &common.I18NItem{
+			Key:        "notifications.documents.document_state_changed.title",
+			Parameters: map[string]string{"id": strconv.FormatInt(doc.GetId(), 10)},
+		},
+		Content: &common.I18NItem{
+			Key: "notifications.documents.document_state_changed.content",
+			Parameters: map[string]string{
+				"title": doc.GetTitle(),
+				"state": state,
+			},
+		},
+		Type:     notifications.NotificationType_NOTIFICATION_TYPE_INFO,
+		Category: notifications.NotificationCategory_NOTIFICATION_CATEGORY_DOCUMENT,
+		Data: &notifications.Data{
+			Link: &notifications.Link{
+				To: fmt.Sprintf("/documents/%d", doc.GetId()),
+			},
+			CausedBy: &usershort.UserShort{
+				UserId: sourceUserId,
+			},
+		},
+	}
+
+	return s.notifi.NotifyUser(ctx, not)
+}
@@ -0,0 +1,98 @@
+package documents
+
+import (
+	"testing"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
+	documentscategory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDocumentUpdateState(t *testing.T) {
+	t.Parallel()
+
+	category := &documentscategory.Category{
+		Id: 2,
+		StateMachine: &documentscategory.StateMachine{
+			Enabled:      true,
+			InitialState: "open",
+			States: []*documentscategory.DocumentState{
+				{Name: "open"},
+				{Name: "closed"},
+			},
+		},
+	}
+
+	newDoc := func(categoryId int64, state string) *documents.Document {
+		return &documents.Document{
+			CategoryId: &categoryId,
+			Meta:       &documents.DocumentMeta{State: state},
+		}
+	}
+	newReq := func(categoryId int64, state string) *pbdocuments.UpdateDocumentRequest {
+		return &pbdocuments.UpdateDocumentRequest{
+			CategoryId: &categoryId,
+			Meta:       &documents.DocumentMeta{State: state},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		oldDoc   *documents.Document
+		req      *pbdocuments.UpdateDocumentRequest
+		category *documentscategory.Category
+		expected string
+		err      error
+	}{
+		{
+			name:     "Free text state without a state machine",
+			oldDoc:   newDoc(1, "Open"),
+			req:      newReq(1, "In Progress"),
+			expected: "In Progress",
+		},
+		{
+			name:     "Unchanged state in a state machine category",
+			oldDoc:   newDoc(2, "closed"),
+			req:      newReq(2, "closed"),
+			category: category,
+			expected: "closed",
+		},
+		{
+			name:     "State change in a state machine category requires a transition",
+			oldDoc:   newDoc(2, "open"),
+			req:      newReq(2, "closed"),
+			category: category,
+			err:      errorsdocuments.ErrDocStateRequiresTransition,
+		},
+		{
+			name:     "Moved into a state machine category starts in the initial state",
+			oldDoc:   newDoc(1, "Open"),
+			req:      newReq(2, "anything"),
+			category: category,
+			expected: "open",
+		},
+		{
+			name:     "Moved out of a state machine category keeps the requested state",
+			oldDoc:   newDoc(2, "closed"),
+			req:      newReq(1, "Archived"),
+			expected: "Archived",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, err := getDocumentUpdateState(tt.oldDoc, tt.req, tt.category)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, state)
+		})
+	}
+}
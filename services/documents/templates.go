@@ -244,6 +244,10 @@ func (s *Server) CreateTemplate(
 		return nil, errorsdocuments.ErrTemplateAccessDuplicate
 	}
 
+	if err := validateTemplateFormFields(req.GetTemplate()); err != nil {
+		return nil, err
+	}
+
 	var categoryId *int64
 	if req.GetTemplate().GetCategory() != nil {
 		cat, err := s.getCategory(ctx, req.GetTemplate().GetCategory().GetId())
@@ -345,6 +349,10 @@ func (s *Server) UpdateTemplate(
 		return nil, errorsdocuments.ErrTemplateAccessDuplicate
 	}
 
+	if err := validateTemplateFormFields(req.GetTemplate()); err != nil {
+		return nil, err
+	}
+
 	var categoryId *int64
 	if req.GetTemplate().GetCategory() != nil {
 		cat, err := s.getCategory(ctx, req.GetTemplate().GetCategory().GetId())
@@ -0,0 +1,349 @@
+package documents
+
+import (
+	"context"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
+	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	documentsdata "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/data"
+	documentsversions "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/versions"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	pbdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/textdiff"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	errorsdocuments "github.com/fivenet-app/fivenet/v2026/services/documents/errors"
+	documentsstore "github.com/fivenet-app/fivenet/v2026/stores/documents"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	VersionsDefaultPageSize = 10
+)
+
+var tDocVersion = table.FivenetDocumentsVersions.AS("document_version")
+
+func (s *Server) ListDocumentVersions(
+	ctx context.Context,
+	req *pbdocuments.ListDocumentVersionsRequest,
+) (*pbdocuments.ListDocumentVersionsResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{documentIDLogFieldKey, req.GetDocumentId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	check, err := s.canUserAccessDocument(
+		ctx,
+		req.GetDocumentId(),
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if !check {
+		return nil, errorsdocuments.ErrDocViewDenied
+	}
+
+	count, versions, err := s.store.ListDocumentVersions(
+		ctx,
+		documentsstore.ListDocumentVersionsQuery{
+			DocumentID: req.GetDocumentId(),
+			Pagination: req.GetPagination(),
+		},
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	pag, _ := req.GetPagination().GetResponseWithPageSize(count.Total, VersionsDefaultPageSize)
+	resp := &pbdocuments.ListDocumentVersionsResponse{
+		Pagination: pag,
+		Versions:   []*documentsversions.DocumentVersion{},
+	}
+	if count.Total <= 0 {
+		return resp, nil
+	}
+	resp.Versions = versions
+
+	jobInfoFn := s.enricher.EnrichJobInfoSafeFunc(userInfo)
+	for i := range resp.GetVersions() {
+		if resp.GetVersions()[i].GetCreator() != nil {
+			jobInfoFn(resp.GetVersions()[i].GetCreator())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetDocumentVersion(
+	ctx context.Context,
+	req *pbdocuments.GetDocumentVersionRequest,
+) (*pbdocuments.GetDocumentVersionResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{documentIDLogFieldKey, req.GetDocumentId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	check, err := s.canUserAccessDocument(
+		ctx,
+		req.GetDocumentId(),
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if !check {
+		return nil, errorsdocuments.ErrDocViewDenied
+	}
+
+	version, err := s.getDocumentVersion(ctx, s.db, req.GetDocumentId(), req.GetVersionId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Compare to the previous version by default
+	baseCondition := tDocVersion.DocumentID.EQ(mysql.Int64(req.GetDocumentId())).
+		AND(tDocVersion.ID.LT(mysql.Int64(version.GetId())))
+	if req.CompareVersionId != nil {
+		baseCondition = tDocVersion.DocumentID.EQ(mysql.Int64(req.GetDocumentId())).
+			AND(tDocVersion.ID.EQ(mysql.Int64(req.GetCompareVersionId())))
+	}
+
+	base, err := s.store.GetDocumentVersion(ctx, s.db, baseCondition)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if base == nil && req.CompareVersionId != nil {
+		return nil, errorsdocuments.ErrVersionNotFound
+	}
+
+	diff, err := diffDocumentVersions(base, version)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	if version.GetCreator() != nil {
+		s.enricher.EnrichJobInfoSafe(userInfo, version.GetCreator())
+	}
+
+	return &pbdocuments.GetDocumentVersionResponse{
+		Version: version,
+		Diff:    diff,
+	}, nil
+}
+
+func (s *Server) RestoreDocumentVersion(
+	ctx context.Context,
+	req *pbdocuments.RestoreDocumentVersionRequest,
+) (*pbdocuments.RestoreDocumentVersionResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{documentIDLogFieldKey, req.GetDocumentId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	check, err := s.canUserAccessDocument(
+		ctx,
+		req.GetDocumentId(),
+		userInfo,
+		documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if !check {
+		return nil, errorsdocuments.ErrDocViewDenied
+	}
+
+	version, err := s.getDocumentVersion(ctx, s.db, req.GetDocumentId(), req.GetVersionId())
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := s.getDocument(ctx,
+		tDocument.ID.EQ(mysql.Int64(req.GetDocumentId())),
+		userInfo, true)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if doc == nil {
+		return nil, errorsdocuments.ErrNotFoundOrNoPerms
+	}
+
+	// Keep the document's files as they are, they aren't part of a version
+	files, err := s.fHandler.ListFilesForParentID(ctx, doc.GetId())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+
+	// The restore is a regular update with the version's title, content and data, so the same
+	// access and approval checks apply
+	resp, err := s.updateDocument(ctx, &pbdocuments.UpdateDocumentRequest{
+		DocumentId:  doc.GetId(),
+		CategoryId:  doc.CategoryId,
+		Title:       version.GetTitle(),
+		Content:     version.GetContent(),
+		ContentType: doc.GetContentType(),
+		Data:        version.GetData(),
+		Meta:        doc.GetMeta(),
+		Files:       files,
+	}, &version.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbdocuments.RestoreDocumentVersionResponse{
+		Document: resp.GetDocument(),
+	}, nil
+}
+
+func (s *Server) getDocumentVersion(
+	ctx context.Context,
+	db qrm.DB,
+	documentId int64,
+	versionId int64,
+) (*documentsversions.DocumentVersion, error) {
+	version, err := s.store.GetDocumentVersion(
+		ctx,
+		db,
+		tDocVersion.DocumentID.EQ(mysql.Int64(documentId)).
+			AND(tDocVersion.ID.EQ(mysql.Int64(versionId))),
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsdocuments.ErrFailedQuery)
+	}
+	if version == nil {
+		return nil, errorsdocuments.ErrVersionNotFound
+	}
+
+	return version, nil
+}
+
+// createDocumentVersion stores the updated title, content and data as a new version of the
+// document. Documents without any versions yet (e.g., created before versions existed) get their
+// previous state stored as the initial version first.
+func (s *Server) createDocumentVersion(
+	ctx context.Context,
+	tx qrm.DB,
+	userInfo *userinfo.UserInfo,
+	oldDoc *documents.Document,
+	req *pbdocuments.UpdateDocumentRequest,
+	restoredFromId *int64,
+) (int64, error) {
+	latest, err := s.store.GetDocumentVersion(
+		ctx,
+		tx,
+		tDocVersion.DocumentID.EQ(mysql.Int64(oldDoc.GetId())),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if latest == nil {
+		createdAt := oldDoc.GetUpdatedAt()
+		if createdAt == nil {
+			createdAt = oldDoc.GetCreatedAt()
+		}
+
+		if _, err := s.store.CreateDocumentVersion(ctx, tx, &documentsversions.DocumentVersion{
+			CreatedAt:  createdAt,
+			DocumentId: oldDoc.GetId(),
+			CreatorId:  oldDoc.CreatorId,
+			CreatorJob: oldDoc.GetCreatorJob(),
+			Title:      oldDoc.GetTitle(),
+			Content:    oldDoc.GetContent(),
+			Data:       oldDoc.GetData(),
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	version := &documentsversions.DocumentVersion{
+		DocumentId:     oldDoc.GetId(),
+		CreatorId:      &userInfo.UserId,
+		CreatorJob:     userInfo.GetJob(),
+		Title:          req.GetTitle(),
+		Content:        req.GetContent(),
+		Data:           req.GetData(),
+		RestoredFromId: restoredFromId,
+	}
+
+	// Users co-editing the document have contributed to the saved version
+	userIds, err := s.collabServer.ListWriterUserIDs(ctx, oldDoc.GetId())
+	if err != nil {
+		s.logger.Warn(
+			"failed to list collab writers for document version",
+			zap.Int64("document_id", oldDoc.GetId()),
+			zap.Error(err),
+		)
+	} else if len(userIds) > 0 {
+		version.Contributors = &documentsversions.DocumentVersionContributors{
+			UserIds: userIds,
+		}
+	}
+
+	return s.store.CreateDocumentVersion(ctx, tx, version)
+}
+
+// documentVersionChanged returns true if the update changes any of the versioned fields.
+func documentVersionChanged(
+	oldDoc *documents.Document,
+	req *pbdocuments.UpdateDocumentRequest,
+) bool {
+	return oldDoc.GetTitle() != req.GetTitle() ||
+		!proto.Equal(oldDoc.GetContent(), req.GetContent()) ||
+		!proto.Equal(oldDoc.GetData(), req.GetData())
+}
+
+// diffDocumentVersions computes the changes from the base version (nil for an empty document) to
+// the version.
+func diffDocumentVersions(
+	base *documentsversions.DocumentVersion,
+	version *documentsversions.DocumentVersion,
+) (*documentsversions.DocumentVersionDiff, error) {
+	diff := &documentsversions.DocumentVersionDiff{}
+	if base != nil {
+		diff.BaseVersionId = &base.Id
+	}
+
+	if d := textdiff.DiffText(base.GetTitle(), version.GetTitle()); d.HasChanges() {
+		diff.TitleCdiff = d
+	}
+
+	if d := textdiff.DiffText(
+		base.GetContent().Extract().GetText(),
+		version.GetContent().Extract().GetText(),
+	); d.HasChanges() {
+		diff.ContentCdiff = d
+	}
+
+	baseData, err := documentDataText(base.GetData())
+	if err != nil {
+		return nil, err
+	}
+	data, err := documentDataText(version.GetData())
+	if err != nil {
+		return nil, err
+	}
+	if d := textdiff.DiffText(baseData, data); d.HasChanges() {
+		diff.DataCdiff = d
+	}
+
+	return diff, nil
+}
+
+func documentDataText(data *documentsdata.DocumentData) (string, error) {
+	if data == nil {
+		return "", nil
+	}
+
+	out, err := protoutils.MarshalToPrettyJSON(data)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
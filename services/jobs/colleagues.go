@@ -403,6 +403,27 @@ func (s *Server) SetColleagueProps(
 			if !timeutils.InTimeSpan(minStart, maxEnd, reqProps.GetAbsenceEnd().AsTime()) {
 				return nil, errorsjobs.ErrAbsenceBeginOutOfRange
 			}
+
+			// Approval tasks can only be delegated to another colleague of the same job
+			if reqProps.GetAbsenceDelegateId() > 0 {
+				if reqProps.GetAbsenceDelegateId() == targetUser.GetUserId() {
+					return nil, errorsjobs.ErrAbsenceDelegateInvalid
+				}
+
+				delegate, err := s.getColleague(
+					ctx,
+					userInfo,
+					targetUser.GetJob(),
+					reqProps.GetAbsenceDelegateId(),
+					nil,
+				)
+				if err != nil {
+					return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+				}
+				if delegate == nil {
+					return nil, errorsjobs.ErrAbsenceDelegateInvalid
+				}
+			}
 		}
 	}
 
@@ -647,6 +668,7 @@ func (s *Server) ListColleagueActivity(
 				permsjobs.ColleaguesServiceListColleagueActivityTypesPermValueNOTE,
 				permsjobs.ColleaguesServiceListColleagueActivityTypesPermValueLABELS,
 				permsjobs.ColleaguesServiceListColleagueActivityTypesPermValueNAME,
+				permsjobs.ColleaguesServiceListColleagueActivityTypesPermValueTIMECLOCKCORRECTION,
 			)
 		}
 	}
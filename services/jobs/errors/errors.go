@@ -62,12 +62,32 @@ var (
 		&common.I18NItem{Key: "errors.jobs.JobsService.ErrAbsenceEndOutOfRange.content"},
 		&common.I18NItem{Key: "errors.jobs.JobsService.ErrAbsenceEndOutOfRange.title"},
 	)
+	ErrAbsenceDelegateInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrAbsenceDelegateInvalid.content"},
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrAbsenceDelegateInvalid.title"},
+	)
 
 	ErrTimeclockOutOfRange = common.NewI18nErr(
 		codes.InvalidArgument,
 		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockOutOfRange.content"},
 		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockOutOfRange.title"},
 	)
+	ErrTimeclockCorrectionInvalid = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockCorrectionInvalid.content"},
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockCorrectionInvalid.title"},
+	)
+	ErrTimeclockCorrectionNotPending = common.NewI18nErr(
+		codes.FailedPrecondition,
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockCorrectionNotPending.content"},
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockCorrectionNotPending.title"},
+	)
+	ErrTimeclockCorrectionSelfReview = common.NewI18nErr(
+		codes.PermissionDenied,
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockCorrectionSelfReview.content"},
+		&common.I18NItem{Key: "errors.jobs.JobsService.ErrTimeclockCorrectionSelfReview.title"},
+	)
 
 	ErrLabelsNoPerms = common.NewI18nErr(
 		codes.PermissionDenied,
@@ -114,6 +114,35 @@ func validateQualificationRule(rule *jobsgroups.GroupQualificationRule) error {
 		return status.Error(codes.InvalidArgument, "unsupported qualification rule type")
 	}
 
+	if rule.TrainingPathId != nil {
+		// Training path rules always check the path's current steps
+		if rule.GetTrainingPathId() <= 0 {
+			return status.Error(codes.InvalidArgument, "training path ID must be positive")
+		}
+		if len(rule.GetQualificationIds()) > 0 {
+			return status.Error(
+				codes.InvalidArgument,
+				"qualification IDs can't be combined with a training path",
+			)
+		}
+		if rule.GetPromotionReady() &&
+			rule.GetType() != jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ALL {
+			return status.Error(codes.InvalidArgument, "promotion readiness requires the all rule type")
+		}
+		// Colleagues are only ready for promotion once they've passed the path's qualifications
+		if rule.GetPromotionReady() && !rule.GetRequireCompleted() {
+			return status.Error(
+				codes.InvalidArgument,
+				"promotion readiness requires completed qualifications",
+			)
+		}
+
+		return nil
+	}
+	if rule.GetPromotionReady() {
+		return status.Error(codes.InvalidArgument, "promotion readiness requires a training path")
+	}
+
 	seen := map[int64]struct{}{}
 	for _, qualificationID := range rule.GetQualificationIds() {
 		if qualificationID <= 0 {
@@ -140,6 +169,22 @@ func (s *Server) ensureGroupRuleQualificationAccess(
 	if qualification == nil {
 		return nil
 	}
+	if qualification.TrainingPathId != nil {
+		ok, err := s.store.TrainingPathExists(
+			ctx,
+			s.db,
+			userInfo.GetJob(),
+			qualification.GetTrainingPathId(),
+		)
+		if err != nil {
+			return errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+		}
+		if !ok {
+			return errorsjobs.ErrNotFoundOrNoPerms
+		}
+
+		return nil
+	}
 	if s.qualificationAccess == nil {
 		return errorsjobs.ErrFailedQuery
 	}
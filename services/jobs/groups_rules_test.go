@@ -150,3 +150,28 @@ func TestEnsureGroupRuleQualificationAccessSkipsGradeRule(t *testing.T) {
 	require.NoError(t, err)
 	assert.Zero(t, qualificationAccess.calls)
 }
+
+func TestValidateQualificationRuleTrainingPath(t *testing.T) {
+	t.Parallel()
+
+	all := jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ALL
+	anyType := jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ANY
+
+	require.NoError(t, validateQualificationRule(&jobsgroups.GroupQualificationRule{
+		Type:             all,
+		TrainingPathId:   new(int64(3)),
+		RequireCompleted: true,
+		PromotionReady:   true,
+	}))
+
+	for _, rule := range []*jobsgroups.GroupQualificationRule{
+		{Type: all, TrainingPathId: new(int64(3)), QualificationIds: []int64{10}},
+		{Type: anyType, TrainingPathId: new(int64(3)), RequireCompleted: true, PromotionReady: true},
+		{Type: all, TrainingPathId: new(int64(3)), PromotionReady: true},
+		{Type: all, QualificationIds: []int64{10}, PromotionReady: true},
+		{Type: all, TrainingPathId: new(int64(0))},
+	} {
+		err := validateQualificationRule(rule)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
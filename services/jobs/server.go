@@ -69,6 +69,15 @@ func init() {
 		MinDays: 365, // One year retention
 	})
 
+	housekeeper.AddTable(&housekeeper.Table{
+		Table:           table.FivenetJobTimeclockCorrections,
+		IDColumn:        table.FivenetJobTimeclockCorrections.ID,
+		JobColumn:       table.FivenetJobTimeclockCorrections.Job,
+		TimestampColumn: table.FivenetJobTimeclockCorrections.CreatedAt,
+
+		MinDays: 365, // One year retention
+	})
+
 	housekeeper.AddTable(&housekeeper.Table{
 		Table:           table.FivenetJobGroups,
 		IDColumn:        table.FivenetJobGroups.ID,
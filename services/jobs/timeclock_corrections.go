@@ -0,0 +1,370 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	colleaguesactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues/activity"
+	jobstimeclock "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/timeclock"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	pbjobs "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/jobs"
+	permsjobs "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/jobs/perms"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	errorsjobs "github.com/fivenet-app/fivenet/v2026/services/jobs/errors"
+	jobsstore "github.com/fivenet-app/fivenet/v2026/stores/jobs"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+func (s *Server) ListTimeclockCorrections(
+	ctx context.Context,
+	req *pbjobs.ListTimeclockCorrectionsRequest,
+) (*pbjobs.ListTimeclockCorrectionsResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	// Field Permission Check
+	fields, err := permsjobs.TimeclockService.ListTimeclock.AccessTyped.Get(s.ps, userInfo)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+
+	query := jobsstore.TimeclockCorrectionsQuery{
+		Job:      userInfo.GetJob(),
+		UserIDs:  req.GetUserIds(),
+		Statuses: req.GetStatuses(),
+	}
+	if !fields.Contains(permsjobs.TimeclockServiceListTimeclockAccessPermValueAll) {
+		query.UserIDs = []int32{userInfo.GetUserId()}
+	}
+
+	count, err := s.store.CountTimeclockCorrections(ctx, s.db, query)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+
+	pag, limit := req.GetPagination().GetResponseWithPageSize(count, 20)
+	resp := &pbjobs.ListTimeclockCorrectionsResponse{
+		Pagination: pag,
+	}
+	if count <= 0 {
+		return resp, nil
+	}
+
+	query.Offset = req.GetPagination().GetOffset()
+	query.Limit = limit
+
+	resp.Corrections, err = s.store.ListTimeclockCorrections(ctx, s.db, query)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+
+	for _, correction := range resp.GetCorrections() {
+		s.enrichTimeclockCorrection(correction)
+	}
+
+	resp.GetPagination().Update(len(resp.GetCorrections()))
+
+	return resp, nil
+}
+
+func (s *Server) CreateTimeclockCorrection(
+	ctx context.Context,
+	req *pbjobs.CreateTimeclockCorrectionRequest,
+) (*pbjobs.CreateTimeclockCorrectionResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	correction := req.GetCorrection()
+	correction.Job = userInfo.GetJob()
+	if correction.GetUserId() <= 0 {
+		correction.UserId = userInfo.GetUserId()
+	}
+
+	// Correction requests for colleagues require access to all timeclock entries
+	if correction.GetUserId() != userInfo.GetUserId() {
+		fields, err := permsjobs.TimeclockService.ListTimeclock.AccessTyped.Get(s.ps, userInfo)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+		}
+		if !fields.Contains(permsjobs.TimeclockServiceListTimeclockAccessPermValueAll) {
+			return nil, errorsjobs.ErrNotFoundOrNoPerms
+		}
+
+		colleague, err := s.store.GetColleague(
+			ctx,
+			s.db,
+			correction.GetJob(),
+			correction.GetUserId(),
+			nil,
+			false,
+		)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+		}
+		if colleague == nil {
+			return nil, errorsjobs.ErrNotFoundOrNoPerms
+		}
+	}
+
+	if err := validateTimeclockCorrection(correction, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if correction.GetType() == jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD {
+		start := correction.GetStartTime().AsTime()
+		correction.Date = timestamp.New(
+			time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location()),
+		)
+		correction.OriginalStartTime = nil
+	} else {
+		// Make sure the entry exists and is in the state the correction expects
+		if _, err := s.getTimeclockCorrectionEntry(ctx, s.db, correction); err != nil {
+			return nil, err
+		}
+	}
+
+	lastId, err := s.store.CreateTimeclockCorrection(ctx, s.db, correction)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+
+	grpc_audit.AddMeta(ctx, "timeclock_correction.id", strconv.FormatInt(lastId, 10))
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_CREATED)
+
+	correction, err = s.store.GetTimeclockCorrection(ctx, s.db, userInfo.GetJob(), lastId)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+	s.enrichTimeclockCorrection(correction)
+
+	return &pbjobs.CreateTimeclockCorrectionResponse{Correction: correction}, nil
+}
+
+func (s *Server) ReviewTimeclockCorrection(
+	ctx context.Context,
+	req *pbjobs.ReviewTimeclockCorrectionRequest,
+) (*pbjobs.ReviewTimeclockCorrectionResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	grpc_audit.AddMeta(ctx, "timeclock_correction.id", strconv.FormatInt(req.GetCorrectionId(), 10))
+
+	correction, err := s.store.GetTimeclockCorrection(
+		ctx,
+		s.db,
+		userInfo.GetJob(),
+		req.GetCorrectionId(),
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+	if correction == nil {
+		return nil, errorsjobs.ErrNotFoundOrNoPerms
+	}
+	if correction.GetStatus() !=
+		jobstimeclock.TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_PENDING {
+		return nil, errorsjobs.ErrTimeclockCorrectionNotPending
+	}
+	if correction.GetUserId() == userInfo.GetUserId() && !userInfo.GetJobAdmin() {
+		return nil, errorsjobs.ErrTimeclockCorrectionSelfReview
+	}
+
+	correction.ReviewerId = &userInfo.UserId
+	correction.ReviewComment = req.Comment
+	correction.Status = jobstimeclock.TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_REJECTED
+	if req.GetApprove() {
+		correction.Status = jobstimeclock.TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_APPROVED
+	}
+
+	// Begin transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+	// Defer a rollback in case anything fails
+	defer tx.Rollback()
+
+	if req.GetApprove() {
+		if correction.GetType() != jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD {
+			// Keep the entry's values as they were before the correction
+			entry, err := s.getTimeclockCorrectionEntry(ctx, tx, correction)
+			if err != nil {
+				return nil, err
+			}
+			correction.OriginalEndTime = entry.GetEndTime()
+			correction.OriginalSpentTime = &entry.SpentTime
+		}
+
+		if err := s.store.ApplyTimeclockCorrection(ctx, tx, correction); err != nil {
+			if errors.Is(err, sql.ErrNoRows) || dbutils.IsDuplicateError(err) {
+				return nil, errswrap.NewError(err, errorsjobs.ErrTimeclockCorrectionInvalid)
+			}
+			return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+		}
+	}
+
+	if err := s.store.ReviewTimeclockCorrection(ctx, tx, correction); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errswrap.NewError(err, errorsjobs.ErrTimeclockCorrectionNotPending)
+		}
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+
+	if req.GetApprove() {
+		if err := colleaguesactivity.CreateColleagueActivity(
+			ctx,
+			tx,
+			timeclockCorrectionActivity(correction),
+		); err != nil {
+			return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
+
+	correction, err = s.store.GetTimeclockCorrection(
+		ctx,
+		s.db,
+		userInfo.GetJob(),
+		req.GetCorrectionId(),
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+	s.enrichTimeclockCorrection(correction)
+
+	return &pbjobs.ReviewTimeclockCorrectionResponse{Correction: correction}, nil
+}
+
+// getTimeclockCorrectionEntry returns the timeclock entry a trim or close correction refers to,
+// trimming requires a finished shift while closing requires a shift that is still open.
+func (s *Server) getTimeclockCorrectionEntry(
+	ctx context.Context,
+	db qrm.DB,
+	correction *jobstimeclock.TimeclockCorrection,
+) (*jobstimeclock.TimeclockEntry, error) {
+	if correction.GetDate() == nil {
+		return nil, errorsjobs.ErrTimeclockCorrectionInvalid
+	}
+
+	var startTime *time.Time
+	if correction.GetOriginalStartTime() != nil {
+		t := correction.GetOriginalStartTime().AsTime()
+		startTime = &t
+	}
+
+	entry, err := s.store.GetTimeclockEntry(
+		ctx,
+		db,
+		correction.GetJob(),
+		correction.GetUserId(),
+		correction.GetDate().AsTime(),
+		startTime,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsjobs.ErrFailedQuery)
+	}
+	if entry == nil {
+		return nil, errorsjobs.ErrTimeclockCorrectionInvalid
+	}
+
+	trim := correction.GetType() == jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM
+	if closed := entry.GetEndTime() != nil; closed != trim {
+		return nil, errorsjobs.ErrTimeclockCorrectionInvalid
+	}
+
+	return entry, nil
+}
+
+func (s *Server) enrichTimeclockCorrection(correction *jobstimeclock.TimeclockCorrection) {
+	if correction == nil {
+		return
+	}
+
+	if correction.GetUser() != nil {
+		s.enricher.EnrichJobInfo(correction.GetUser())
+	}
+	if correction.GetReviewer() != nil {
+		s.enricher.EnrichJobInfo(correction.GetReviewer())
+	}
+}
+
+func validateTimeclockCorrection(
+	correction *jobstimeclock.TimeclockCorrection,
+	now time.Time,
+) error {
+	switch correction.GetType() {
+	case jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD:
+
+	case jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM:
+		if correction.GetDate() == nil || correction.GetOriginalStartTime() == nil {
+			return errorsjobs.ErrTimeclockCorrectionInvalid
+		}
+
+	case jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE:
+		if correction.GetDate() == nil {
+			return errorsjobs.ErrTimeclockCorrectionInvalid
+		}
+
+	default:
+		return errorsjobs.ErrTimeclockCorrectionInvalid
+	}
+
+	start := correction.CorrectedStartTime()
+	if start == nil || correction.GetEndTime() == nil {
+		return errorsjobs.ErrTimeclockCorrectionInvalid
+	}
+
+	end := correction.GetEndTime().AsTime()
+	if !end.After(*start) || end.After(now) ||
+		correction.Duration() > jobstimeclock.MaxCorrectionDuration {
+		return errorsjobs.ErrTimeclockCorrectionInvalid
+	}
+	if now.Sub(*start) >= TimeclockMaxDays {
+		return errorsjobs.ErrTimeclockOutOfRange
+	}
+
+	return nil
+}
+
+func timeclockCorrectionActivity(
+	correction *jobstimeclock.TimeclockCorrection,
+) *colleaguesactivity.ColleagueActivity {
+	var startTime *timestamp.Timestamp
+	if start := correction.CorrectedStartTime(); start != nil {
+		startTime = timestamp.New(*start)
+	}
+
+	activityType := colleaguesactivity.ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_TIMECLOCK_CORRECTION
+	return &colleaguesactivity.ColleagueActivity{
+		Job:          correction.GetJob(),
+		SourceUserId: correction.ReviewerId,
+		TargetUserId: correction.GetUserId(),
+		ActivityType: activityType,
+		Reason:       correction.GetReason(),
+		Data: &colleaguesactivity.ColleagueActivityData{
+			Data: &colleaguesactivity.ColleagueActivityData_TimeclockCorrection{
+				TimeclockCorrection: &colleaguesactivity.TimeclockCorrectionChange{
+					CorrectionId:      correction.GetId(),
+					Type:              correction.GetType(),
+					Date:              correction.GetDate(),
+					OriginalStartTime: correction.GetOriginalStartTime(),
+					OriginalEndTime:   correction.GetOriginalEndTime(),
+					OriginalSpentTime: correction.OriginalSpentTime,
+					StartTime:         startTime,
+					EndTime:           correction.GetEndTime(),
+					SpentTime:         correction.SpentTime(),
+				},
+			},
+		},
+	}
+}
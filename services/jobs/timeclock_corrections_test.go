@@ -0,0 +1,135 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	colleaguesactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues/activity"
+	jobstimeclock "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/timeclock"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	errorsjobs "github.com/fivenet-app/fivenet/v2026/services/jobs/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateTimeclockCorrection(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC)
+	start := now.Add(-18 * time.Hour)
+
+	valid := []*jobstimeclock.TimeclockCorrection{
+		{
+			Type:      jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD,
+			StartTime: timestamp.New(start),
+			EndTime:   timestamp.New(start.Add(2 * time.Hour)),
+		},
+		{
+			Type:              jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM,
+			Date:              timestamp.New(start),
+			OriginalStartTime: timestamp.New(start),
+			StartTime:         timestamp.New(start.Add(30 * time.Minute)),
+			EndTime:           timestamp.New(start.Add(time.Hour)),
+		},
+		{
+			Type:              jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE,
+			Date:              timestamp.New(start),
+			OriginalStartTime: timestamp.New(start),
+			EndTime:           timestamp.New(start.Add(time.Hour)),
+		},
+	}
+	for _, correction := range valid {
+		require.NoError(t, validateTimeclockCorrection(correction, now), correction.GetType())
+	}
+
+	invalid := []*jobstimeclock.TimeclockCorrection{
+		// End before start
+		{
+			Type:      jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD,
+			StartTime: timestamp.New(start),
+			EndTime:   timestamp.New(start.Add(-time.Minute)),
+		},
+		// End in the future
+		{
+			Type:      jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD,
+			StartTime: timestamp.New(start),
+			EndTime:   timestamp.New(now.Add(time.Hour)),
+		},
+		// Longer than a day
+		{
+			Type:      jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD,
+			StartTime: timestamp.New(start.Add(-48 * time.Hour)),
+			EndTime:   timestamp.New(start),
+		},
+		// Trimming requires the original start time to find the entry
+		{
+			Type:      jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM,
+			Date:      timestamp.New(start),
+			StartTime: timestamp.New(start),
+			EndTime:   timestamp.New(start.Add(time.Hour)),
+		},
+		// Closing an entry without a start time requires a new one
+		{
+			Type:    jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE,
+			Date:    timestamp.New(start),
+			EndTime: timestamp.New(start.Add(time.Hour)),
+		},
+		{
+			Type: jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_UNSPECIFIED,
+		},
+	}
+	for _, correction := range invalid {
+		require.ErrorIs(
+			t,
+			validateTimeclockCorrection(correction, now),
+			errorsjobs.ErrTimeclockCorrectionInvalid,
+		)
+	}
+
+	outOfRange := &jobstimeclock.TimeclockCorrection{
+		Type:      jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD,
+		StartTime: timestamp.New(now.Add(-TimeclockMaxDays)),
+		EndTime:   timestamp.New(now.Add(-TimeclockMaxDays).Add(time.Hour)),
+	}
+	require.ErrorIs(
+		t,
+		validateTimeclockCorrection(outOfRange, now),
+		errorsjobs.ErrTimeclockOutOfRange,
+	)
+}
+
+func TestTimeclockCorrectionActivity(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 10, 1, 18, 0, 0, 0, time.UTC)
+	activity := timeclockCorrectionActivity(&jobstimeclock.TimeclockCorrection{
+		Id:                3,
+		Job:               "police",
+		UserId:            5,
+		Type:              jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE,
+		Date:              timestamp.New(start),
+		OriginalStartTime: timestamp.New(start),
+		OriginalSpentTime: proto.Float32(1),
+		EndTime:           timestamp.New(start.Add(30 * time.Minute)),
+		Reason:            "Forgot to clock out",
+		ReviewerId:        proto.Int32(2),
+	})
+
+	assert.Equal(t, "police", activity.GetJob())
+	assert.Equal(t, int32(2), activity.GetSourceUserId())
+	assert.Equal(t, int32(5), activity.GetTargetUserId())
+	assert.Equal(
+		t,
+		colleaguesactivity.ColleagueActivityType_COLLEAGUE_ACTIVITY_TYPE_TIMECLOCK_CORRECTION,
+		activity.GetActivityType(),
+	)
+	assert.Equal(t, "Forgot to clock out", activity.GetReason())
+
+	change := activity.GetData().GetTimeclockCorrection()
+	require.NotNil(t, change)
+	assert.Equal(t, int64(3), change.GetCorrectionId())
+	assert.Equal(t, start, change.GetStartTime().AsTime())
+	assert.InDelta(t, 1, change.GetOriginalSpentTime(), 0.001)
+	assert.InDelta(t, 1.5, change.GetSpentTime(), 0.001)
+}
@@ -21,4 +21,14 @@ var (
 		&common.I18NItem{Key: "errors.livemap.LivemapService.ErrMarkerDenied.content"},
 		&common.I18NItem{Key: "errors.livemap.LivemapService.ErrMarkerDenied.title"},
 	)
+	ErrMarkerGeofenceUnsupported = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.livemap.LivemapService.ErrMarkerGeofenceUnsupported.content"},
+		&common.I18NItem{Key: "errors.livemap.LivemapService.ErrMarkerGeofenceUnsupported.title"},
+	)
+	ErrPositionTrailsFailed = common.NewI18nErr(
+		codes.Internal,
+		&common.I18NItem{Key: "errors.livemap.LivemapService.ErrPositionTrailsFailed.content"},
+		&common.I18NItem{Key: "errors.livemap.LivemapService.ErrPositionTrailsFailed.title"},
+	)
 )
@@ -3,10 +3,12 @@ package livemap
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	pblivemap "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap"
 	"github.com/fivenet-app/fivenet/v2026/pkg/events"
 	"github.com/fivenet-app/fivenet/v2026/pkg/utils/instance"
 	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
@@ -21,6 +23,9 @@ const (
 
 	MarkerUpdate events.Type = "update"
 	MarkerDelete events.Type = "delete"
+
+	ViewportTopic  events.Topic = "viewport"
+	ViewportUpdate events.Type  = "update"
 )
 
 func (s *Server) registerStreamAndConsumer(
@@ -116,5 +121,30 @@ func (s *Server) watchForEventsFunc(msg jetstream.Msg) {
 				MarkerDelete: &marker.Id,
 			})
 		}
+
+	case ViewportTopic:
+		if tType != ViewportUpdate || s.broker.SubCount() <= 0 {
+			return
+		}
+
+		userId, err := strconv.ParseInt(split[3], 10, 32)
+		if err != nil {
+			s.logger.Error("invalid user id in livemap viewport subject", zap.Error(err))
+			return
+		}
+
+		req := &pblivemap.SetStreamViewportRequest{}
+		if err := protoutils.UnmarshalPartialJSON(msg.Data(), req); err != nil {
+			s.logger.Error("failed to unmarshal livemap viewport update data", zap.Error(err))
+			return
+		}
+
+		s.broker.Publish(&brokerEvent{
+			ViewportUpdate: &viewportUpdate{
+				UserID:   int32(userId),
+				StreamID: req.GetStreamId(),
+				Viewport: req.GetViewport(),
+			},
+		})
 	}
 }
@@ -1,8 +1,10 @@
 package livemap
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
 	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
 	errorslivemap "github.com/fivenet-app/fivenet/v2026/services/livemap/errors"
+	"github.com/google/uuid"
 	"github.com/nats-io/nats.go/jetstream"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -27,11 +30,15 @@ const (
 	markerMarkerChunkSize = 75
 
 	feedFetch = 16
+
+	// Interval in which changed user marker clusters are sent
+	clusterRefreshInterval = 2 * time.Second
 )
 
 func (s *Server) getAndSendACL(
 	srv pblivemap.LivemapService_StreamServer,
 	userInfo *userinfo.UserInfo,
+	streamId string,
 ) (*permissionsattributes.StringList, *permissionsattributes.JobGradeList, bool, error) {
 	markerJobs, err := s.ps.AttrJobList(
 		userInfo,
@@ -87,16 +94,11 @@ func (s *Server) getAndSendACL(
 		js.Jobs.Users = append(js.Jobs.Users, j)
 	}
 
-	// Check if the user is on duty (superuser is always on duty)
-	userOnDuty := false
-	if userInfo.GetJobAdmin() {
-		userOnDuty = true
-	} else if um, ok := s.tracker.GetUserMarkerById(userInfo.GetUserId()); ok && !um.GetHidden() {
-		userOnDuty = true
-	}
+	userOnDuty := s.isUserOnDuty(userInfo)
 
 	if err := srv.Send(&pblivemap.StreamResponse{
 		UserOnDuty: &userOnDuty,
+		StreamId:   &streamId,
 		Data:       js,
 	}); err != nil {
 		return nil, nil, false, err
@@ -105,6 +107,16 @@ func (s *Server) getAndSendACL(
 	return markerJobs, usersJobs, userOnDuty, nil
 }
 
+// isUserOnDuty checks if the user is on duty (superuser is always on duty).
+func (s *Server) isUserOnDuty(userInfo *userinfo.UserInfo) bool {
+	if userInfo.GetJobAdmin() {
+		return true
+	}
+
+	um, ok := s.tracker.GetUserMarkerById(userInfo.GetUserId())
+	return ok && !um.GetHidden()
+}
+
 // buildFilters returns the FilterSubjects slice that encodes the caller’s ACL.
 func buildFilters(jobs *permissionsattributes.JobGradeList) []string {
 	var f []string
@@ -126,9 +138,10 @@ func (s *Server) sendUserMarkers(
 	usersJobs *permissionsattributes.JobGradeList,
 	userInfo *userinfo.UserInfo,
 	userOnDuty bool,
+	view *streamView,
 ) error {
 	// Send initial payload
-	if err := srv.Send(s.userMarkersResponse(usersJobs, userInfo, userOnDuty)); err != nil {
+	if err := srv.Send(s.userMarkersResponse(usersJobs, userInfo, userOnDuty, view)); err != nil {
 		return err
 	}
 
@@ -139,14 +152,51 @@ func (s *Server) userMarkersResponse(
 	usersJobs *permissionsattributes.JobGradeList,
 	userInfo *userinfo.UserInfo,
 	userOnDuty bool,
+	view *streamView,
 ) *pblivemap.StreamResponse {
 	markers := s.tracker.GetFilteredUserMarkers(usersJobs, userInfo)
 
+	if view.clustered() {
+		clusters, zoom, _ := view.clusters(markers)
+		return &pblivemap.StreamResponse{
+			UserOnDuty: &userOnDuty,
+			Data: &pblivemap.StreamResponse_UserClusters{
+				UserClusters: &pblivemap.UserClusters{
+					Clusters: clusters,
+					Zoom:     zoom,
+				},
+			},
+		}
+	}
+
 	return &pblivemap.StreamResponse{
 		UserOnDuty: &userOnDuty,
 		Data: &pblivemap.StreamResponse_Snapshot{
 			Snapshot: &pblivemap.Snapshot{
-				Markers: markers,
+				Markers: view.filterUserMarkers(markers),
+			},
+		},
+	}
+}
+
+// userClustersUpdate returns the user marker clusters if they have changed since they have last been sent.
+func (s *Server) userClustersUpdate(
+	usersJobs *permissionsattributes.JobGradeList,
+	userInfo *userinfo.UserInfo,
+	view *streamView,
+) *pblivemap.StreamResponse {
+	markers := s.tracker.GetFilteredUserMarkers(usersJobs, userInfo)
+
+	clusters, zoom, changed := view.clusters(markers)
+	if !changed {
+		return nil
+	}
+
+	return &pblivemap.StreamResponse{
+		Data: &pblivemap.StreamResponse_UserClusters{
+			UserClusters: &pblivemap.UserClusters{
+				Clusters: clusters,
+				Zoom:     zoom,
 			},
 		},
 	}
@@ -162,7 +212,13 @@ func (s *Server) Stream(
 
 	s.logger.Debug("starting livemap stream", zap.Int32("user_id", userInfo.GetUserId()))
 
-	markerJobs, usersJobs, userOnDuty, err := s.getAndSendACL(srv, userInfo)
+	streamId, err := uuid.NewV7()
+	if err != nil {
+		return errswrap.NewError(err, errorslivemap.ErrStreamFailed)
+	}
+	view := newStreamView(streamId.String(), req.GetViewport())
+
+	markerJobs, usersJobs, userOnDuty, err := s.getAndSendACL(srv, userInfo, view.id)
 	if err != nil {
 		if protoutils.IsContextCanceled(err) {
 			return nil
@@ -170,7 +226,7 @@ func (s *Server) Stream(
 		return err
 	}
 
-	if end, err := s.sendMarkerMarkers(srv, markerJobs); end || err != nil {
+	if end, err := s.sendMarkerMarkers(ctx, srv.Send, markerJobs, view); end || err != nil {
 		if protoutils.IsContextCanceled(err) {
 			return nil
 		}
@@ -179,7 +235,7 @@ func (s *Server) Stream(
 
 	// Send user markers if the user is on duty and can see at least one job (superuser can see all jobs, so this is always true for them)
 	if userOnDuty && usersJobs.Len() > 0 {
-		if err := s.sendUserMarkers(srv, usersJobs, userInfo, userOnDuty); err != nil {
+		if err := s.sendUserMarkers(srv, usersJobs, userInfo, userOnDuty, view); err != nil {
 			if protoutils.IsContextCanceled(err) {
 				return nil
 			}
@@ -256,8 +312,17 @@ func (s *Server) Stream(
 				}
 
 				switch {
+				case e.ViewportUpdate != nil:
+					if e.ViewportUpdate.StreamID != view.id ||
+						e.ViewportUpdate.UserID != userInfo.GetUserId() {
+						continue
+					}
+
+					view.set(e.ViewportUpdate.Viewport)
+
 				case e.MarkerUpdate != nil:
-					if markerUpdateShouldDeleteForUser(e.MarkerUpdate, markerJobs, userInfo) {
+					if markerUpdateShouldDeleteForUser(e.MarkerUpdate, markerJobs, userInfo) ||
+						!view.contains(e.MarkerUpdate.GetX(), e.MarkerUpdate.GetY()) {
 						if err := sendOut(&pblivemap.StreamResponse{
 							Data: &pblivemap.StreamResponse_Markers{
 								Markers: &pblivemap.MarkerMarkersUpdates{
@@ -305,6 +370,43 @@ func (s *Server) Stream(
 		}
 	})
 
+	// Viewport goroutine - resends the markers when the viewport changes and sends changed user marker clusters
+	g.Go(func() error {
+		ticker := time.NewTicker(clusterRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-gctx.Done():
+				return nil
+
+			case <-view.changed:
+				if end, err := s.sendMarkerMarkers(gctx, sendOut, markerJobs, view); end || err != nil {
+					return err
+				}
+
+				// The user's on duty state is tracked by the user markers goroutine, so it is checked again here
+				onDuty := s.isUserOnDuty(userInfo)
+				if !onDuty || usersJobs.Len() == 0 {
+					continue
+				}
+				view.takeDirty()
+				if err := sendOut(s.userMarkersResponse(usersJobs, userInfo, onDuty, view)); err != nil {
+					return err
+				}
+
+			case <-ticker.C:
+				if !clusterUpdateDue(usersJobs, userInfo, userOnDuty, view) {
+					continue
+				}
+
+				if err := sendOut(s.userClustersUpdate(usersJobs, userInfo, view)); err != nil {
+					return err
+				}
+			}
+		}
+	})
+
 	if usersJobs.Len() > 0 {
 		// User markers goroutine - listens for user marker updates and sends them to outCh
 		g.Go(func() error {
@@ -344,6 +446,7 @@ func (s *Server) Stream(
 					userInfo,
 					&userOnDuty,
 					usersJobs,
+					view,
 					sendOut,
 				); err != nil {
 					return err
@@ -355,6 +458,20 @@ func (s *Server) Stream(
 	return g.Wait()
 }
 
+// clusterUpdateDue returns true when the user marker clusters have changed and should be sent to the user.
+func clusterUpdateDue(
+	usersJobs *permissionsattributes.JobGradeList,
+	userInfo *userinfo.UserInfo,
+	userOnDuty bool,
+	view *streamView,
+) bool {
+	if usersJobs.Len() == 0 || (!userOnDuty && !userInfo.GetJobAdmin()) || !view.clustered() {
+		return false
+	}
+
+	return view.takeDirty()
+}
+
 func markerUpdateShouldDeleteForUser(
 	marker *livemapmarkers.MarkerMarker,
 	markerJobs *permissionsattributes.StringList,
@@ -385,6 +502,7 @@ func (s *Server) processMessage(
 	userInfo *userinfo.UserInfo,
 	userOnDuty *bool,
 	usersJobs *permissionsattributes.JobGradeList,
+	view *streamView,
 	sendOut func(*pblivemap.StreamResponse) error,
 ) error {
 	op := m.Headers().Get("KV-Operation")
@@ -400,11 +518,20 @@ func (s *Server) processMessage(
 			return errswrap.NewError(err, errorslivemap.ErrStreamFailed)
 		}
 
-		if userId == userInfo.GetUserId() && job == userInfo.GetJob() &&
-			jobGrade == userInfo.GetJobGrade() && !userInfo.GetJobAdmin() {
+		ownMarker := userId == userInfo.GetUserId() && job == userInfo.GetJob() &&
+			jobGrade == userInfo.GetJobGrade() && !userInfo.GetJobAdmin()
+		if ownMarker {
 			*userOnDuty = false
 		}
 
+		if *userOnDuty || userInfo.GetJobAdmin() {
+			view.markDirty()
+		}
+		// Only send deletes for user markers the client has received
+		if !view.hide(userId) && view.hasViewport() && !ownMarker {
+			return nil
+		}
+
 		if err := sendOut(&pblivemap.StreamResponse{
 			UserOnDuty: userOnDuty,
 			Data: &pblivemap.StreamResponse_UserDeletes{
@@ -427,11 +554,20 @@ func (s *Server) processMessage(
 	}
 
 	if um.GetHidden() && !userInfo.GetJobAdmin() {
-		if um.GetUserId() == userInfo.GetUserId() && um.GetJob() == userInfo.GetJob() &&
-			(um.JobGrade == nil || um.GetJobGrade() == userInfo.GetJobGrade()) {
+		ownMarker := um.GetUserId() == userInfo.GetUserId() && um.GetJob() == userInfo.GetJob() &&
+			(um.JobGrade == nil || um.GetJobGrade() == userInfo.GetJobGrade())
+		if ownMarker {
 			*userOnDuty = false
 		}
 
+		// Off duty users don't receive clusters, same as the individual markers
+		if *userOnDuty {
+			view.markDirty()
+		}
+		if !view.hide(um.GetUserId()) && view.hasViewport() && !ownMarker {
+			return nil
+		}
+
 		if err := sendOut(&pblivemap.StreamResponse{
 			UserOnDuty: userOnDuty,
 			Data: &pblivemap.StreamResponse_UserDeletes{
@@ -450,7 +586,7 @@ func (s *Server) processMessage(
 	if !*userOnDuty {
 		if um.GetUserId() == userInfo.GetUserId() {
 			*userOnDuty = true
-			if err := sendOut(s.userMarkersResponse(usersJobs, userInfo, true)); err != nil {
+			if err := sendOut(s.userMarkersResponse(usersJobs, userInfo, true, view)); err != nil {
 				return err
 			}
 		} else if !userInfo.GetJobAdmin() {
@@ -471,6 +607,31 @@ func (s *Server) processMessage(
 		return nil
 	}
 
+	// Clusters are sent periodically by the stream
+	if view.clustered() {
+		view.markDirty()
+		return nil
+	}
+
+	if !view.contains(um.GetX(), um.GetY()) {
+		// User marker has left the viewport
+		if !view.hide(um.GetUserId()) {
+			return nil
+		}
+
+		return sendOut(&pblivemap.StreamResponse{
+			UserOnDuty: userOnDuty,
+			Data: &pblivemap.StreamResponse_UserDeletes{
+				UserDeletes: &pblivemap.UserDeletes{
+					Deletes: []*pblivemap.UserDelete{
+						{Id: um.GetUserId(), Job: job},
+					},
+				},
+			},
+		})
+	}
+	view.show(um.GetUserId())
+
 	if err := sendOut(&pblivemap.StreamResponse{
 		UserOnDuty: userOnDuty,
 		Data: &pblivemap.StreamResponse_UserUpdates{
@@ -485,12 +646,19 @@ func (s *Server) processMessage(
 	return nil
 }
 
-// Send out chunked current marker markers.
+// Send out chunked current marker markers (inside the stream's viewport).
 func (s *Server) sendMarkerMarkers(
-	srv pblivemap.LivemapService_StreamServer,
+	ctx context.Context,
+	send func(*pblivemap.StreamResponse) error,
 	jobs *permissionsattributes.StringList,
+	view *streamView,
 ) (bool, error) {
 	updatedMarkers, deletedMarkers := s.getMarkerMarkers(jobs)
+	if view.hasViewport() {
+		updatedMarkers = slices.DeleteFunc(updatedMarkers, func(m *livemapmarkers.MarkerMarker) bool {
+			return !view.contains(m.GetX(), m.GetY())
+		})
+	}
 
 	// Less than chunk size or no markers, no need to chunk the response early return
 	if len(updatedMarkers) <= markerMarkerChunkSize {
@@ -505,7 +673,7 @@ func (s *Server) sendMarkerMarkers(
 			},
 		}
 
-		if err := srv.Send(resp); err != nil {
+		if err := send(resp); err != nil {
 			return true, err
 		}
 
@@ -534,14 +702,14 @@ func (s *Server) sendMarkerMarkers(
 		}
 		currentPart--
 
-		if err := srv.Send(resp); err != nil {
+		if err := send(resp); err != nil {
 			return true, err
 		}
 
 		updatedMarkers = updatedMarkers[markerMarkerChunkSize:]
 
 		select {
-		case <-srv.Context().Done():
+		case <-ctx.Done():
 			return true, nil
 
 		case <-time.After(25 * time.Millisecond):
@@ -558,7 +726,7 @@ func (s *Server) sendMarkerMarkers(
 				},
 			},
 		}
-		if err := srv.Send(resp); err != nil {
+		if err := send(resp); err != nil {
 			return true, err
 		}
 	}
@@ -36,6 +36,13 @@ func (s *Server) CreateOrUpdateMarker(
 
 	userInfo := auth.MustGetUserInfoFromContext(ctx)
 
+	// Geofences are only supported for markers with an area
+	if reqMarker.GetGeofence() != nil &&
+		reqMarker.GetType() != livemapmarkers.MarkerType_MARKER_TYPE_CIRCLE &&
+		reqMarker.GetType() != livemapmarkers.MarkerType_MARKER_TYPE_POLYGON {
+		return nil, errorslivemap.ErrMarkerGeofenceUnsupported
+	}
+
 	if reqMarker.Postal == nil || reqMarker.GetPostal() == "" {
 		if postal, ok := s.postals.Closest(
 			reqMarker.GetX(),
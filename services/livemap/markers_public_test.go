@@ -2,9 +2,11 @@ package livemap
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
 	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
 	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
 	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
@@ -32,6 +34,7 @@ type markerTestStore struct {
 	deleteCalls  int
 	lastUpdate   *livemapmarkers.MarkerMarker
 	lastDeleteAt *timestamp.Timestamp
+	samples      []*livemaphistory.PositionSample
 }
 
 func newMarkerTestStore(markers ...*livemapmarkers.MarkerMarker) *markerTestStore {
@@ -144,6 +147,79 @@ func (s *markerTestStore) ListDeletedMarkers(
 	return out, nil
 }
 
+func (s *markerTestStore) ListGeofenceMarkers(
+	_ context.Context,
+) ([]*livemapmarkers.MarkerMarker, error) {
+	out := make([]*livemapmarkers.MarkerMarker, 0, len(s.markers))
+	for _, marker := range s.markers {
+		if marker.GetDeletedAt() != nil || marker.GetGeofence() == nil {
+			continue
+		}
+		out = append(out, cloneMarker(marker))
+	}
+
+	return out, nil
+}
+
+func (s *markerTestStore) AddPositionSamples(
+	_ context.Context,
+	samples []*livemaphistory.PositionSample,
+) error {
+	s.samples = append(s.samples, samples...)
+	return nil
+}
+
+func (s *markerTestStore) ListPositionSamples(
+	_ context.Context,
+	userID *int32,
+	unitID *int64,
+	jobs *permissionsattributes.JobGradeList,
+	start time.Time,
+	end time.Time,
+	limit int64,
+) ([]*livemaphistory.PositionSample, error) {
+	out := []*livemaphistory.PositionSample{}
+	for _, sample := range s.samples {
+		createdAt := sample.GetCreatedAt().AsTime()
+		if createdAt.Before(start) || createdAt.After(end) {
+			continue
+		}
+		if userID != nil && sample.GetUserId() != *userID {
+			continue
+		}
+		if unitID != nil && sample.GetUnitId() != *unitID {
+			continue
+		}
+		if jobs != nil && !jobs.HasJobGrade(sample.GetJob(), sample.GetJobGrade()) {
+			continue
+		}
+		out = append(out, sample)
+	}
+	// Keep the most recent samples
+	if int64(len(out)) > limit {
+		out = out[int64(len(out))-limit:]
+	}
+
+	return out, nil
+}
+
+func (s *markerTestStore) DeletePositionSamples(
+	_ context.Context,
+	before time.Time,
+	limit int64,
+) (int64, error) {
+	deleted := int64(0)
+	s.samples = slices.DeleteFunc(s.samples, func(sample *livemaphistory.PositionSample) bool {
+		if deleted >= limit || !sample.GetCreatedAt().AsTime().Before(before) {
+			return false
+		}
+		deleted++
+		return true
+	})
+
+	return deleted, nil
+}
+
 var _ livemapstore.IStore = (*markerTestStore)(nil)
 
 type testLivemapPerms struct {
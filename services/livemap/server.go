@@ -93,6 +93,8 @@ type Params struct {
 type brokerEvent struct {
 	MarkerUpdate *livemapmarkers.MarkerMarker
 	MarkerDelete *int64
+
+	ViewportUpdate *viewportUpdate
 }
 
 func NewServer(p Params) *Server {
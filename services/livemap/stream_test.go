@@ -173,6 +173,7 @@ func TestProcessMessageOnDutyTransitionEnqueuesSnapshot(t *testing.T) {
 		userInfo,
 		&userOnDuty,
 		usersJobs,
+		newStreamView("", nil),
 		func(resp *pblivemap.StreamResponse) error {
 			sent = append(sent, resp)
 			return nil
@@ -0,0 +1,129 @@
+package livemap
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
+	pblivemap "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap"
+	permslivemap "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap/perms"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	"github.com/fivenet-app/fivenet/v2026/pkg/tracker"
+	errorslivemap "github.com/fivenet-app/fivenet/v2026/services/livemap/errors"
+)
+
+const (
+	positionTrailsDefaultWindow = 1 * time.Hour
+	positionTrailsMaxSamples    = 5000
+)
+
+func (s *Server) GetPositionTrails(
+	ctx context.Context,
+	req *pblivemap.GetPositionTrailsRequest,
+) (*pblivemap.GetPositionTrailsResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	usersJobs, err := s.ps.AttrJobGradeList(
+		userInfo,
+		permslivemap.LivemapService.Stream.Players,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorslivemap.ErrPositionTrailsFailed)
+	}
+
+	resp := &pblivemap.GetPositionTrailsResponse{
+		Trails: []*livemaphistory.PositionTrail{},
+	}
+	if !userInfo.GetJobAdmin() && usersJobs.Len() == 0 {
+		return resp, nil
+	}
+
+	now := time.Now()
+	end := now
+	if req.GetEnd() != nil && req.GetEnd().AsTime().Before(now) {
+		end = req.GetEnd().AsTime()
+	}
+	start := end.Add(-positionTrailsDefaultWindow)
+	if req.GetStart() != nil {
+		start = req.GetStart().AsTime()
+	}
+	// Older positions have already been pruned
+	if oldest := now.Add(-tracker.PositionHistoryRetention); start.Before(oldest) {
+		start = oldest
+	}
+	if !start.Before(end) {
+		return resp, nil
+	}
+
+	var userID *int32
+	var unitID *int64
+	if req.HasUserId() {
+		userID = new(req.GetUserId())
+	} else if req.HasUnitId() {
+		unitID = new(req.GetUnitId())
+	}
+
+	// Job admins see the samples of all jobs
+	jobs := usersJobs
+	if userInfo.GetJobAdmin() {
+		jobs = nil
+	}
+
+	samples, err := s.store.ListPositionSamples(
+		ctx,
+		userID,
+		unitID,
+		jobs,
+		start,
+		end,
+		positionTrailsMaxSamples+1,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorslivemap.ErrPositionTrailsFailed)
+	}
+	if len(samples) > positionTrailsMaxSamples {
+		// Drop the oldest sample, the trails should end at the user's latest position
+		samples = samples[len(samples)-positionTrailsMaxSamples:]
+		resp.Truncated = true
+	}
+
+	resp.Trails = buildPositionTrails(samples)
+
+	return resp, nil
+}
+
+// buildPositionTrails groups the time ordered samples into one trail per user (ordered by user ID).
+func buildPositionTrails(samples []*livemaphistory.PositionSample) []*livemaphistory.PositionTrail {
+	trails := []*livemaphistory.PositionTrail{}
+	byUser := map[int32]*livemaphistory.PositionTrail{}
+	for _, sample := range samples {
+		trail, ok := byUser[sample.GetUserId()]
+		if !ok {
+			trail = &livemaphistory.PositionTrail{
+				UserId: sample.GetUserId(),
+				Job:    sample.GetJob(),
+				Points: []*livemaphistory.PositionTrailPoint{},
+			}
+			byUser[sample.GetUserId()] = trail
+			trails = append(trails, trail)
+		}
+		// Use the user's most recent job
+		trail.Job = sample.GetJob()
+
+		trail.Points = append(trail.Points, &livemaphistory.PositionTrailPoint{
+			Time:   sample.GetCreatedAt(),
+			X:      sample.GetX(),
+			Y:      sample.GetY(),
+			UnitId: sample.UnitId,
+		})
+	}
+
+	slices.SortFunc(trails, func(a, b *livemaphistory.PositionTrail) int {
+		return cmp.Compare(a.GetUserId(), b.GetUserId())
+	})
+
+	return trails
+}
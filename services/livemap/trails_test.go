@@ -0,0 +1,115 @@
+package livemap
+
+import (
+	"testing"
+	"time"
+
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
+	timestamp "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	pbuserinfo "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	pblivemap "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap"
+	"github.com/fivenet-app/fivenet/v2026/internal/tests/permsstub"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+	"github.com/stretchr/testify/require"
+)
+
+type trailsTestPerms struct {
+	permsstub.Permissions
+
+	players *permissionsattributes.JobGradeList
+}
+
+func (p *trailsTestPerms) AttrJobGradeList(
+	_ *pbuserinfo.UserInfo,
+	_ perms.AttrRef[perms.JobGradeListAttr],
+) (*permissionsattributes.JobGradeList, error) {
+	return p.players, nil
+}
+
+func newPositionSample(
+	at time.Time,
+	userID int32,
+	job string,
+	grade int32,
+	unitID int64,
+	x float64,
+) *livemaphistory.PositionSample {
+	return &livemaphistory.PositionSample{
+		CreatedAt: timestamp.New(at),
+		UserId:    userID,
+		Job:       job,
+		JobGrade:  grade,
+		UnitId:    new(unitID),
+		X:         x,
+		Y:         x,
+	}
+}
+
+func TestGetPositionTrails(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	store := newMarkerTestStore()
+	store.samples = []*livemaphistory.PositionSample{
+		newPositionSample(now.Add(-10*time.Minute), 1, "police", 2, 5, 1),
+		newPositionSample(now.Add(-8*time.Minute), 2, "ambulance", 1, 5, 2),
+		newPositionSample(now.Add(-5*time.Minute), 1, "police", 2, 5, 3),
+		newPositionSample(now.Add(-3*time.Minute), 3, "police", 4, 5, 4),
+		newPositionSample(now.Add(-2*time.Minute), 4, "police", 1, 6, 5),
+	}
+
+	srv := newMarkerServer(store, &trailsTestPerms{
+		players: &permissionsattributes.JobGradeList{
+			Jobs: map[string]int32{"police": 3},
+		},
+	})
+
+	t.Run("only visible jobs and grades are returned", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := auth.ContextWithUserInfo(t.Context(), newUserInfo(10, "police", 3, false))
+		req := &pblivemap.GetPositionTrailsRequest{}
+		req.SetUnitId(5)
+
+		resp, err := srv.GetPositionTrails(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.GetTrails(), 1)
+		require.Equal(t, int32(1), resp.GetTrails()[0].GetUserId())
+		require.Len(t, resp.GetTrails()[0].GetPoints(), 2)
+		require.InDelta(t, 1, resp.GetTrails()[0].GetPoints()[0].GetX(), 0)
+		require.InDelta(t, 3, resp.GetTrails()[0].GetPoints()[1].GetX(), 0)
+		require.False(t, resp.GetTruncated())
+	})
+
+	t.Run("job admin sees all users of the unit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := auth.ContextWithUserInfo(t.Context(), newUserInfo(10, "police", 3, true))
+		req := &pblivemap.GetPositionTrailsRequest{}
+		req.SetUnitId(5)
+
+		resp, err := srv.GetPositionTrails(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.GetTrails(), 3)
+		require.Equal(t, int32(1), resp.GetTrails()[0].GetUserId())
+		require.Equal(t, int32(2), resp.GetTrails()[1].GetUserId())
+		require.Equal(t, int32(3), resp.GetTrails()[2].GetUserId())
+	})
+
+	t.Run("time window is respected", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := auth.ContextWithUserInfo(t.Context(), newUserInfo(10, "police", 3, false))
+		req := &pblivemap.GetPositionTrailsRequest{}
+		req.SetUserId(1)
+		req.SetStart(timestamp.New(now.Add(-6 * time.Minute)))
+
+		resp, err := srv.GetPositionTrails(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.GetTrails(), 1)
+		require.Len(t, resp.GetTrails()[0].GetPoints(), 1)
+		require.InDelta(t, 3, resp.GetTrails()[0].GetPoints()[0].GetX(), 0)
+	})
+}
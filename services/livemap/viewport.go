@@ -0,0 +1,296 @@
+package livemap
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	pblivemap "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap"
+	"github.com/fivenet-app/fivenet/v2026/pkg/coords"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	errorslivemap "github.com/fivenet-app/fivenet/v2026/services/livemap/errors"
+	"github.com/paulmach/orb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// User markers are clustered at and below this zoom level
+	clusterMaxZoom = 3
+	// Cluster radius (in map units) at zoom level 0, halved with each zoom level
+	clusterBaseRadius = 2000.0
+
+	// Fraction of the viewport's width/height added on each side to avoid markers popping in at the edges
+	viewportPadding = 0.1
+)
+
+type viewportUpdate struct {
+	UserID   int32
+	StreamID string
+	Viewport *pblivemap.Viewport
+}
+
+// streamView holds the viewport state of a single livemap stream.
+// Without a viewport, nothing is filtered or clustered.
+type streamView struct {
+	mu sync.Mutex
+
+	id       string
+	viewport *pblivemap.Viewport
+	// User markers sent individually to the client (only tracked when a viewport is set)
+	visible map[int32]struct{}
+	// User markers changed since the last clusters have been sent
+	dirty        bool
+	lastClusters []*livemapmarkers.MarkerCluster
+
+	changed chan struct{}
+}
+
+func newStreamView(id string, viewport *pblivemap.Viewport) *streamView {
+	return &streamView{
+		id:       id,
+		viewport: viewport,
+		visible:  map[int32]struct{}{},
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+// set replaces the viewport and signals the stream to resend the markers.
+func (v *streamView) set(viewport *pblivemap.Viewport) {
+	v.mu.Lock()
+	v.viewport = viewport
+	v.lastClusters = nil
+	v.mu.Unlock()
+
+	select {
+	case v.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (v *streamView) hasViewport() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.viewport != nil
+}
+
+func (v *streamView) clustered() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return isClusterZoom(v.viewport)
+}
+
+func (v *streamView) contains(x, y float64) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return viewportContains(v.viewport, x, y)
+}
+
+// show records that the user marker has been sent individually to the client.
+func (v *streamView) show(userID int32) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.viewport != nil {
+		v.visible[userID] = struct{}{}
+	}
+}
+
+// hide forgets the user marker and returns true if it has been sent individually to the client before.
+func (v *streamView) hide(userID int32) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	_, ok := v.visible[userID]
+	delete(v.visible, userID)
+	return ok
+}
+
+func (v *streamView) markDirty() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.dirty = true
+}
+
+// takeDirty returns true (and resets the flag) when user markers have changed since the last clusters were sent.
+func (v *streamView) takeDirty() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	dirty := v.dirty
+	v.dirty = false
+	return dirty
+}
+
+// filterUserMarkers returns the user markers inside the viewport and resets the tracked visible user markers to them.
+func (v *streamView) filterUserMarkers(
+	markers []*livemapmarkers.UserMarker,
+) []*livemapmarkers.UserMarker {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.viewport == nil {
+		return markers
+	}
+
+	clear(v.visible)
+	filtered := make([]*livemapmarkers.UserMarker, 0, len(markers))
+	for _, um := range markers {
+		if !viewportContains(v.viewport, um.GetX(), um.GetY()) {
+			continue
+		}
+
+		filtered = append(filtered, um)
+		v.visible[um.GetUserId()] = struct{}{}
+	}
+
+	return filtered
+}
+
+// clusters returns the clusters for the user markers inside the viewport and if they differ from the last sent clusters.
+func (v *streamView) clusters(
+	markers []*livemapmarkers.UserMarker,
+) ([]*livemapmarkers.MarkerCluster, int32, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	inView := make([]*livemapmarkers.UserMarker, 0, len(markers))
+	for _, um := range markers {
+		if viewportContains(v.viewport, um.GetX(), um.GetY()) {
+			inView = append(inView, um)
+		}
+	}
+
+	clusters := clusterUserMarkers(inView, v.viewport.GetZoom())
+	changed := v.lastClusters == nil ||
+		!slices.EqualFunc(clusters, v.lastClusters, func(a, b *livemapmarkers.MarkerCluster) bool {
+			return proto.Equal(a, b)
+		})
+	v.lastClusters = clusters
+	// The client replaces all user markers with the clusters
+	clear(v.visible)
+
+	return clusters, v.viewport.GetZoom(), changed
+}
+
+func isClusterZoom(viewport *pblivemap.Viewport) bool {
+	return viewport != nil && viewport.GetZoom() <= clusterMaxZoom
+}
+
+func viewportContains(viewport *pblivemap.Viewport, x, y float64) bool {
+	if viewport == nil {
+		return true
+	}
+
+	padX := (viewport.GetMaxX() - viewport.GetMinX()) * viewportPadding
+	padY := (viewport.GetMaxY() - viewport.GetMinY()) * viewportPadding
+
+	return x >= viewport.GetMinX()-padX && x <= viewport.GetMaxX()+padX &&
+		y >= viewport.GetMinY()-padY && y <= viewport.GetMaxY()+padY
+}
+
+func clusterRadius(zoom int32) float64 {
+	return clusterBaseRadius / float64(int64(1)<<max(zoom, 0))
+}
+
+// clusterUserMarkers groups nearby user markers of the same job into clusters (ordered by job).
+// Each cluster is formed greedily around the marker with the lowest user ID not yet clustered.
+func clusterUserMarkers(
+	markers []*livemapmarkers.UserMarker,
+	zoom int32,
+) []*livemapmarkers.MarkerCluster {
+	radius := clusterRadius(zoom)
+
+	byJob := map[string][]*livemapmarkers.UserMarker{}
+	for _, um := range markers {
+		byJob[um.GetJob()] = append(byJob[um.GetJob()], um)
+	}
+
+	clusters := []*livemapmarkers.MarkerCluster{}
+	for _, job := range slices.Sorted(maps.Keys(byJob)) {
+		jobMarkers := byJob[job]
+		slices.SortFunc(jobMarkers, func(a, b *livemapmarkers.UserMarker) int {
+			return cmp.Compare(a.GetUserId(), b.GetUserId())
+		})
+
+		locs := coords.New[*livemapmarkers.UserMarker]()
+		for _, um := range jobMarkers {
+			// Markers outside of the map bounds end up in their own cluster
+			_ = locs.Add(um)
+		}
+
+		clustered := make(map[int32]struct{}, len(jobMarkers))
+		for _, um := range jobMarkers {
+			if _, ok := clustered[um.GetUserId()]; ok {
+				continue
+			}
+
+			members := []*livemapmarkers.UserMarker{um}
+			for _, p := range locs.KNearest(um.Point(), len(jobMarkers), func(p orb.Pointer) bool {
+				//nolint:forcetypeassert // We know that p is a *UserMarker because locs is a generics spatial index
+				_, ok := clustered[p.(*livemapmarkers.UserMarker).GetUserId()]
+				return !ok
+			}, radius) {
+				//nolint:forcetypeassert // We know that p is a *UserMarker because locs is a generics spatial index
+				member := p.(*livemapmarkers.UserMarker)
+				if member.GetUserId() != um.GetUserId() {
+					members = append(members, member)
+				}
+			}
+
+			cluster := &livemapmarkers.MarkerCluster{
+				Job:      job,
+				JobLabel: um.GetJobLabel(),
+			}
+			for _, member := range members {
+				clustered[member.GetUserId()] = struct{}{}
+				cluster.X += member.GetX()
+				cluster.Y += member.GetY()
+			}
+			//nolint:gosec // Cluster count is bound by the number of tracked users.
+			cluster.Count = int32(len(members))
+			cluster.X /= float64(len(members))
+			cluster.Y /= float64(len(members))
+
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	return clusters
+}
+
+func (s *Server) SetStreamViewport(
+	ctx context.Context,
+	req *pblivemap.SetStreamViewportRequest,
+) (*pblivemap.SetStreamViewportResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	// Streams of the user might be running on any instance
+	if _, err := s.js.PublishProto(
+		ctx,
+		buildViewportSubject(userInfo.GetUserId()),
+		req,
+	); err != nil {
+		return nil, errswrap.NewError(err, errorslivemap.ErrStreamFailed)
+	}
+
+	return &pblivemap.SetStreamViewportResponse{}, nil
+}
+
+func buildViewportSubject(userID int32) string {
+	return strings.Join([]string{
+		string(BaseSubject),
+		string(ViewportTopic),
+		string(ViewportUpdate),
+		fmt.Sprintf("%d", userID),
+	}, ".")
+}
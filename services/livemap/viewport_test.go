@@ -0,0 +1,232 @@
+package livemap
+
+import (
+	"testing"
+
+	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
+	pblivemap "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/livemap"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestUserMarker(userID int32, job string, x, y float64) *livemapmarkers.UserMarker {
+	grade := int32(1)
+	return &livemapmarkers.UserMarker{
+		UserId:   userID,
+		Job:      job,
+		JobGrade: &grade,
+		X:        x,
+		Y:        y,
+	}
+}
+
+func newTestViewport(zoom int32) *pblivemap.Viewport {
+	return &pblivemap.Viewport{MinX: 0, MinY: 0, MaxX: 1000, MaxY: 1000, Zoom: zoom}
+}
+
+func TestClusterUserMarkers(t *testing.T) {
+	t.Parallel()
+
+	markers := []*livemapmarkers.UserMarker{
+		newTestUserMarker(1, "police", 0, 0),
+		newTestUserMarker(2, "police", 100, 100),
+		newTestUserMarker(3, "police", 5000, 5000),
+		newTestUserMarker(4, "ambulance", 50, 50),
+	}
+
+	clusters := clusterUserMarkers(markers, 1)
+	require.Len(t, clusters, 3)
+
+	// Clusters are ordered by job
+	require.Equal(t, "ambulance", clusters[0].GetJob())
+	require.Equal(t, int32(1), clusters[0].GetCount())
+
+	require.Equal(t, "police", clusters[1].GetJob())
+	require.Equal(t, int32(2), clusters[1].GetCount())
+	require.InDelta(t, 50, clusters[1].GetX(), 0.001)
+	require.InDelta(t, 50, clusters[1].GetY(), 0.001)
+
+	require.Equal(t, int32(1), clusters[2].GetCount())
+	require.InDelta(t, 5000, clusters[2].GetX(), 0.001)
+
+	// Higher zoom levels use a smaller radius
+	require.Len(t, clusterUserMarkers(markers, 7), 4)
+}
+
+func TestViewportContains(t *testing.T) {
+	t.Parallel()
+
+	vp := newTestViewport(5)
+
+	require.True(t, viewportContains(nil, 99999, 99999))
+	require.True(t, viewportContains(vp, 500, 500))
+	// Within padding
+	require.True(t, viewportContains(vp, -50, 1050))
+	require.False(t, viewportContains(vp, -200, 500))
+	require.False(t, viewportContains(vp, 500, 1200))
+}
+
+func TestProcessMessageViewport(t *testing.T) {
+	t.Parallel()
+
+	srv := &Server{
+		tracker: &streamTestTracker{},
+	}
+	userInfo := newUserInfo(10, "police", 3, false)
+	usersJobs := &permissionsattributes.JobGradeList{
+		Jobs: map[string]int32{"police": 10},
+	}
+
+	process := func(
+		t *testing.T,
+		view *streamView,
+		um *livemapmarkers.UserMarker,
+	) []*pblivemap.StreamResponse {
+		t.Helper()
+
+		data, err := proto.Marshal(um)
+		require.NoError(t, err)
+
+		userOnDuty := true
+		var sent []*pblivemap.StreamResponse
+		require.NoError(t, srv.processMessage(
+			&streamTestMsg{
+				subject: "$KV.userloc.police.1.20",
+				headers: nats.Header{},
+				data:    data,
+			},
+			userInfo,
+			&userOnDuty,
+			usersJobs,
+			view,
+			func(resp *pblivemap.StreamResponse) error {
+				sent = append(sent, resp)
+				return nil
+			},
+		))
+
+		return sent
+	}
+
+	t.Run("updates outside of the viewport are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		view := newStreamView("test", newTestViewport(5))
+
+		require.Empty(t, process(t, view, newTestUserMarker(20, "police", 5000, 5000)))
+
+		sent := process(t, view, newTestUserMarker(20, "police", 500, 500))
+		require.Len(t, sent, 1)
+		require.Len(t, sent[0].GetUserUpdates().GetUpdates(), 1)
+
+		sent = process(t, view, newTestUserMarker(20, "police", 5000, 5000))
+		require.Len(t, sent, 1)
+		require.Len(t, sent[0].GetUserDeletes().GetDeletes(), 1)
+		require.Equal(t, int32(20), sent[0].GetUserDeletes().GetDeletes()[0].GetId())
+
+		require.Empty(t, process(t, view, newTestUserMarker(20, "police", 5000, 5000)))
+	})
+
+	t.Run("clustered views only mark the view dirty", func(t *testing.T) {
+		t.Parallel()
+
+		view := newStreamView("test", newTestViewport(1))
+
+		require.Empty(t, process(t, view, newTestUserMarker(20, "police", 500, 500)))
+		require.True(t, view.takeDirty())
+		require.False(t, view.takeDirty())
+	})
+}
+
+func TestClusterUpdateOffDuty(t *testing.T) {
+	t.Parallel()
+
+	srv := &Server{
+		tracker: &streamTestTracker{},
+	}
+	userInfo := newUserInfo(10, "police", 3, false)
+	usersJobs := &permissionsattributes.JobGradeList{
+		Jobs: map[string]int32{"police": 10},
+	}
+	view := newStreamView("test", newTestViewport(1))
+	userOnDuty := true
+
+	process := func(um *livemapmarkers.UserMarker) []*pblivemap.StreamResponse {
+		data, err := proto.Marshal(um)
+		require.NoError(t, err)
+
+		var sent []*pblivemap.StreamResponse
+		require.NoError(t, srv.processMessage(
+			&streamTestMsg{
+				subject: "$KV.userloc.police.3.10",
+				headers: nats.Header{},
+				data:    data,
+			},
+			userInfo,
+			&userOnDuty,
+			usersJobs,
+			view,
+			func(resp *pblivemap.StreamResponse) error {
+				sent = append(sent, resp)
+				return nil
+			},
+		))
+
+		return sent
+	}
+
+	// Own marker is hidden, the user went off duty
+	own := newTestUserMarker(10, "police", 500, 500)
+	own.JobGrade = new(int32(3))
+	own.Hidden = true
+	sent := process(own)
+	require.Len(t, sent, 1)
+	require.False(t, sent[0].GetUserOnDuty())
+	require.False(t, userOnDuty)
+	require.False(t, clusterUpdateDue(usersJobs, userInfo, userOnDuty, view))
+
+	// Other user markers neither reach the user nor cause a cluster update
+	require.Empty(t, process(newTestUserMarker(20, "police", 100, 100)))
+	view.markDirty()
+	require.False(t, clusterUpdateDue(usersJobs, userInfo, userOnDuty, view))
+
+	// Job admins still receive clusters when not on duty
+	adminInfo := newUserInfo(10, "police", 3, true)
+	require.True(t, clusterUpdateDue(usersJobs, adminInfo, userOnDuty, view))
+
+	userOnDuty = true
+	view.markDirty()
+	require.True(t, clusterUpdateDue(usersJobs, userInfo, userOnDuty, view))
+}
+
+func TestStreamViewClustersOnlyChanged(t *testing.T) {
+	t.Parallel()
+
+	view := newStreamView("test", newTestViewport(1))
+	markers := []*livemapmarkers.UserMarker{
+		newTestUserMarker(1, "police", 0, 0),
+		newTestUserMarker(2, "police", 100, 100),
+		// Outside of the viewport
+		newTestUserMarker(3, "police", 5000, 5000),
+	}
+
+	clusters, zoom, changed := view.clusters(markers)
+	require.True(t, changed)
+	require.Equal(t, int32(1), zoom)
+	require.Len(t, clusters, 1)
+	require.Equal(t, int32(2), clusters[0].GetCount())
+
+	_, _, changed = view.clusters(markers)
+	require.False(t, changed)
+
+	markers[1].X = 200
+	_, _, changed = view.clusters(markers)
+	require.True(t, changed)
+
+	// A new viewport always resends the clusters
+	view.set(newTestViewport(2))
+	_, _, changed = view.clusters(markers)
+	require.True(t, changed)
+}
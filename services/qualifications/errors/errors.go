@@ -36,6 +36,24 @@ var (
 		},
 		&common.I18NItem{Key: "errors.qualifications.QualificationsService.ErrExamDisabled.title"},
 	)
+	ErrExamMaxAttempts = common.NewI18nErr(
+		codes.FailedPrecondition,
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrExamMaxAttempts.content",
+		},
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrExamMaxAttempts.title",
+		},
+	)
+	ErrExamRetryCooldown = common.NewI18nErr(
+		codes.FailedPrecondition,
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrExamRetryCooldown.content",
+		},
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrExamRetryCooldown.title",
+		},
+	)
 	ErrRequirementSelfRef = common.NewI18nErr(
 		codes.InvalidArgument,
 		&common.I18NItem{
@@ -54,6 +72,24 @@ var (
 			Key: "errors.qualifications.QualificationsService.ErrQualiAccessDenied.title",
 		},
 	)
+	ErrTrainingPathNotFound = common.NewI18nErr(
+		codes.NotFound,
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrTrainingPathNotFound.content",
+		},
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrTrainingPathNotFound.title",
+		},
+	)
+	ErrTrainingPathInvalidSteps = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrTrainingPathInvalidSteps.content",
+		},
+		&common.I18NItem{
+			Key: "errors.qualifications.QualificationsService.ErrTrainingPathInvalidSteps.title",
+		},
+	)
 	ErrQualiUpdateDenied = common.NewI18nErr(
 		codes.PermissionDenied,
 		&common.I18NItem{Key: "errors.qualifications.QualificationsService.ErrQualiUpdateDenied"},
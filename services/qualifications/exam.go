@@ -2,12 +2,14 @@ package qualifications
 
 import (
 	"context"
+	"math/rand/v2"
 	"time"
 
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
 	qualificationsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/access"
 	qualificationsexam "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/exam"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
 	pbqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications"
 	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
@@ -59,21 +61,58 @@ func (s *Server) GetExamInfo(
 		return nil, errorsqualifications.ErrExamDisabled
 	}
 
-	questionCount, err := s.store.CountExamQuestions(ctx, req.GetQualificationId())
+	examUser, err := s.store.GetExamUser(ctx, req.GetQualificationId(), userInfo.GetUserId())
 	if err != nil {
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
-	examUser, err := s.store.GetExamUser(ctx, req.GetQualificationId(), userInfo.GetUserId())
+	var questionCount int64
+	switch {
+	case examUser.GetQuestions() != nil:
+		questionCount = int64(len(examUser.GetQuestions().GetQuestions()))
+
+	case len(quali.GetExamSettings().GetPoolRules()) > 0:
+		// Amount of questions a new attempt would draw
+		exam, err := s.store.GetExamQuestions(ctx, s.db, req.GetQualificationId(), false)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+		}
+		questionCount = int64(len(
+			exam.DrawQuestions(quali.GetExamSettings(), newExamRand()).GetQuestions(),
+		))
+
+	default:
+		questionCount, err = s.store.CountExamQuestions(ctx, req.GetQualificationId())
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+		}
+	}
+
+	attempts, err := s.store.GetExamAttempts(ctx, req.GetQualificationId(), userInfo.GetUserId())
 	if err != nil {
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
-	return &pbqualifications.GetExamInfoResponse{
-		Qualification: quali,
-		QuestionCount: questionCount,
-		ExamUser:      examUser,
-	}, nil
+	resp := &pbqualifications.GetExamInfoResponse{
+		Qualification:  quali,
+		QuestionCount:  questionCount,
+		ExamUser:       examUser,
+		FailedAttempts: int32(attempts.Failed),
+	}
+	nextAttemptAt := quali.GetExamSettings().NextAttemptAt(attempts.LastFailedAt)
+	if nextAttemptAt != nil && time.Now().Before(*nextAttemptAt) {
+		resp.NextAttemptAt = timestamp.New(*nextAttemptAt)
+	}
+
+	return resp, nil
+}
+
+// Focus loss events reported by the client beyond this amount per attempt aren't logged anymore
+const examLogMaxFocusLostEntries = 250
+
+func newExamRand() *rand.Rand {
+	//nolint:gosec // G404: Drawing exam questions doesn't need to be cryptographically secure.
+	return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
 }
 
 func (s *Server) checkIfUserCanTakeExam(
@@ -103,6 +142,34 @@ func (s *Server) checkIfUserCanTakeExam(
 	return true, nil
 }
 
+// checkExamAttempts makes sure the user has attempts left and the retry cooldown after their last
+// failed attempt has passed.
+func (s *Server) checkExamAttempts(
+	ctx context.Context,
+	quali *qualifications.QualificationShort,
+	userId int32,
+) error {
+	settings := quali.GetExamSettings()
+	if settings.GetMaxAttempts() <= 0 && settings.GetRetryCooldown().AsDuration() <= 0 {
+		return nil
+	}
+
+	attempts, err := s.store.GetExamAttempts(ctx, quali.GetId(), userId)
+	if err != nil {
+		return errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	if settings.AttemptsExhausted(attempts.Failed) {
+		return errorsqualifications.ErrExamMaxAttempts
+	}
+	if nextAttemptAt := settings.NextAttemptAt(attempts.LastFailedAt); nextAttemptAt != nil &&
+		time.Now().Before(*nextAttemptAt) {
+		return errorsqualifications.ErrExamRetryCooldown
+	}
+
+	return nil
+}
+
 func (s *Server) TakeExam(
 	ctx context.Context,
 	req *pbqualifications.TakeExamRequest,
@@ -147,11 +214,19 @@ func (s *Server) TakeExam(
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
+	// No end time for the exam? A new attempt is started
+	newAttempt := examUser == nil || examUser.GetEndsAt() == nil
+	if newAttempt {
+		if err := s.checkExamAttempts(ctx, quali, userInfo.GetUserId()); err != nil {
+			return nil, err
+		}
+	}
+
 	timesUp := examUser != nil && examUser.GetEndsAt() != nil &&
 		time.Since(examUser.GetEndsAt().AsTime()) > 10*time.Second
 
 	var exam *qualificationsexam.ExamQuestions
-	if examUser == nil || !timesUp {
+	if !timesUp {
 		exam, err = s.store.GetExamQuestions(ctx, s.db, req.GetQualificationId(), false)
 		if err != nil {
 			return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
@@ -180,8 +255,7 @@ func (s *Server) TakeExam(
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
-	// No end time for the exam? Need to create an entry
-	if examUser == nil || examUser.GetEndsAt() == nil {
+	if newAttempt {
 		examTime := quali.GetExamSettings().GetTime().AsDuration()
 		if err := s.store.CreateExamUser(
 			ctx,
@@ -189,10 +263,17 @@ func (s *Server) TakeExam(
 			req.GetQualificationId(),
 			userInfo.GetUserId(),
 			time.Now().Add(examTime),
+			exam.DrawQuestions(quali.GetExamSettings(), newExamRand()),
 		); err != nil {
 			if !dbutils.IsDuplicateError(err) {
 				return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 			}
+		} else if err := s.store.CreateExamLogEntry(ctx, s.db, &qualificationsexam.ExamLogEntry{
+			QualificationId: req.GetQualificationId(),
+			UserId:          userInfo.GetUserId(),
+			Type:            qualificationsexam.ExamLogEventType_EXAM_LOG_EVENT_TYPE_STARTED,
+		}); err != nil {
+			return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 		}
 	}
 
@@ -201,6 +282,11 @@ func (s *Server) TakeExam(
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
+	// Only show the questions drawn for this attempt
+	if exam != nil {
+		exam = exam.ForAttempt(examUser.GetQuestions())
+	}
+
 	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
 
 	return &pbqualifications.TakeExamResponse{
@@ -272,6 +358,22 @@ func (s *Server) SubmitExam(
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
+	logType := qualificationsexam.ExamLogEventType_EXAM_LOG_EVENT_TYPE_ANSWER_SAVED
+	if !req.GetPartial() {
+		logType = qualificationsexam.ExamLogEventType_EXAM_LOG_EVENT_TYPE_SUBMITTED
+	}
+	answered := int32(len(req.GetResponses().GetResponses()))
+	if err := s.store.CreateExamLogEntry(ctx, tx, &qualificationsexam.ExamLogEntry{
+		QualificationId: req.GetQualificationId(),
+		UserId:          userInfo.GetUserId(),
+		Type:            logType,
+		Data: &qualificationsexam.ExamLogEntryData{
+			Answered: &answered,
+		},
+	}); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
 	// Only update the exam user if this is not a partial update, otherwise we might "end" the exam prematurely when the user is still working on it
 	if !req.GetPartial() {
 		if err := s.store.UpsertExamUserEndedAt(
@@ -294,6 +396,7 @@ func (s *Server) SubmitExam(
 			req.GetQualificationId(),
 			userInfo.GetUserId(),
 			quali,
+			examUser,
 			req.GetResponses(),
 		); err != nil {
 			return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
@@ -318,6 +421,7 @@ func (s *Server) gradeExam(
 	qualificationId int64,
 	userId int32,
 	quali *qualifications.Qualification,
+	examUser *qualificationsexam.ExamUser,
 	responses *qualificationsexam.ExamResponses,
 ) error {
 	if quali.GetExamSettings() != nil && quali.GetExamSettings().GetAutoGrade() {
@@ -325,6 +429,8 @@ func (s *Server) gradeExam(
 		if err != nil {
 			return err
 		}
+		// Grade against the questions the user has been given
+		exam = exam.ForAttempt(examUser.GetQuestions())
 		if exam != nil && len(exam.GetQuestions()) > 0 {
 			// Auto grading is enabled, we can grade the exam now
 			score, grading := exam.Grade(
@@ -408,11 +514,17 @@ func (s *Server) GetUserExam(
 
 	resp := &pbqualifications.GetUserExamResponse{}
 
+	examUser, err := s.store.GetExamUser(ctx, req.GetQualificationId(), req.GetUserId())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	resp.ExamUser = examUser
+
 	exam, err := s.store.GetExamQuestions(ctx, s.db, req.GetQualificationId(), true)
 	if err != nil {
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
-	resp.Exam = exam
+	resp.Exam = exam.ForAttempt(examUser.GetQuestions())
 
 	resp.Responses, resp.Grading, err = s.store.GetExamResponses(
 		ctx,
@@ -423,11 +535,80 @@ func (s *Server) GetUserExam(
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
-	examUser, err := s.store.GetExamUser(ctx, req.GetQualificationId(), req.GetUserId())
+	if examUser.GetStartedAt() != nil {
+		resp.Log, err = s.store.ListExamLog(
+			ctx,
+			req.GetQualificationId(),
+			req.GetUserId(),
+			examUser.GetStartedAt().AsTime(),
+		)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ReportExamFocusLost(
+	ctx context.Context,
+	req *pbqualifications.ReportExamFocusLostRequest,
+) (*pbqualifications.ReportExamFocusLostResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{qualificationIDLogFieldKey, req.GetQualificationId()})
+
+	// The exam log records the event already
+	grpc_audit.Skip(ctx)
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	check, err := s.access.CanUserAccessTarget(
+		ctx,
+		req.GetQualificationId(),
+		userInfo,
+		int32(qualificationsaccess.AccessLevel_ACCESS_LEVEL_TAKE),
+	)
 	if err != nil {
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
-	resp.ExamUser = examUser
+	if !check && !userInfo.GetJobAdmin() {
+		return nil, errorsqualifications.ErrFailedQuery
+	}
+
+	resp := &pbqualifications.ReportExamFocusLostResponse{}
+
+	examUser, err := s.store.GetExamUser(ctx, req.GetQualificationId(), userInfo.GetUserId())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	// Only events of a running attempt are logged
+	if examUser == nil || examUser.GetStartedAt() == nil || examUser.GetEndedAt() != nil {
+		return resp, nil
+	}
+
+	count, err := s.store.CountExamLogEntries(
+		ctx,
+		req.GetQualificationId(),
+		userInfo.GetUserId(),
+		examUser.GetStartedAt().AsTime(),
+		qualificationsexam.ExamLogEventType_EXAM_LOG_EVENT_TYPE_FOCUS_LOST,
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	if count >= examLogMaxFocusLostEntries {
+		return resp, nil
+	}
+
+	if err := s.store.CreateExamLogEntry(ctx, s.db, &qualificationsexam.ExamLogEntry{
+		QualificationId: req.GetQualificationId(),
+		UserId:          userInfo.GetUserId(),
+		Type:            qualificationsexam.ExamLogEventType_EXAM_LOG_EVENT_TYPE_FOCUS_LOST,
+		Data: &qualificationsexam.ExamLogEntryData{
+			Duration: req.GetDuration(),
+		},
+	}); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
 
 	return resp, nil
 }
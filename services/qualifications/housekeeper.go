@@ -0,0 +1,277 @@
+package qualifications
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	"github.com/fivenet-app/fivenet/v2026/pkg/croner"
+	"github.com/fivenet-app/fivenet/v2026/pkg/notifi"
+	qualificationsstore "github.com/fivenet-app/fivenet/v2026/stores/qualifications"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+var HousekeeperModule = fx.Module(
+	"qualifications.housekeeper",
+	fx.Provide(
+		NewHousekeeper,
+	),
+)
+
+const (
+	expiryCronName = "qualifications.results.expiry"
+
+	// How long before a qualification expires the holder is reminded to renew it
+	expiryReminderPeriod = 7 * 24 * time.Hour
+	expiryBatchSize      = 100
+
+	expiryRemindedAttr = "reminded_results"
+	expiryExpiredAttr  = "expired_results"
+)
+
+type Housekeeper struct {
+	logger *zap.Logger
+	tracer trace.Tracer
+
+	db    *sql.DB
+	notif notifi.INotifi
+	store qualificationsstore.IStore
+}
+
+type HousekeeperParams struct {
+	fx.In
+
+	Logger *zap.Logger
+	DB     *sql.DB
+	TP     *tracesdk.TracerProvider
+
+	Notif notifi.INotifi
+	Store qualificationsstore.IStore
+}
+
+type HousekeeperResult struct {
+	fx.Out
+
+	Housekeeper  *Housekeeper
+	CronRegister croner.CronRegister `group:"cronjobregister"`
+}
+
+func NewHousekeeper(p HousekeeperParams) HousekeeperResult {
+	s := &Housekeeper{
+		logger: p.Logger.Named("qualifications.housekeeper"),
+		tracer: p.TP.Tracer("qualifications.housekeeper"),
+		db:     p.DB,
+		notif:  p.Notif,
+		store:  p.Store,
+	}
+
+	return HousekeeperResult{
+		Housekeeper:  s,
+		CronRegister: s,
+	}
+}
+
+func (s *Housekeeper) RegisterCronjobs(ctx context.Context, registry croner.IRegistry) error {
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     expiryCronName,
+		Schedule: "*/15 * * * *", // Every 15 minutes
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Housekeeper) RegisterCronjobHandlers(h *croner.Handlers) error {
+	h.Add(expiryCronName, func(ctx context.Context, data *cron.CronjobData) error {
+		ctx, span := s.tracer.Start(ctx, expiryCronName)
+		defer span.End()
+
+		dest := &cron.GenericCronData{
+			Attributes: map[string]string{},
+		}
+		if err := data.Unmarshal(dest); err != nil {
+			s.logger.Warn("failed to unmarshal qualification expiry cron data", zap.Error(err))
+		}
+
+		reminded, err := s.remindExpiringResults(ctx)
+		if err != nil {
+			s.logger.Error("error during qualification expiry reminders", zap.Error(err))
+			return err
+		}
+		dest.SetAttribute(expiryRemindedAttr, strconv.Itoa(reminded))
+
+		expired, err := s.expireLapsedResults(ctx)
+		if err != nil {
+			s.logger.Error("error during qualification results expiry", zap.Error(err))
+			return err
+		}
+		dest.SetAttribute(expiryExpiredAttr, strconv.Itoa(expired))
+
+		// Marshal the updated cron data
+		if err := data.MarshalFrom(dest); err != nil {
+			return fmt.Errorf("failed to marshal updated qualification expiry cron data. %w", err)
+		}
+
+		return nil
+	})
+
+	return nil
+}
+
+func (s *Housekeeper) remindExpiringResults(ctx context.Context) (int, error) {
+	results, err := s.store.ListQualificationResultsDueForReminder(
+		ctx,
+		expiryReminderPeriod,
+		expiryBatchSize,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, result := range results {
+		if err := s.store.SetQualificationResultReminderSent(ctx, s.db, result.ResultID); err != nil {
+			s.logger.Error(
+				"error marking qualification expiry reminder as sent",
+				zap.Int64("result_id", result.ResultID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if err := s.notifyUser(
+			ctx,
+			result,
+			"notifications.qualifications.result_expiring",
+			notifications.NotificationType_NOTIFICATION_TYPE_WARNING,
+		); err != nil {
+			s.logger.Error(
+				"error sending qualification expiry reminder",
+				zap.Int64("result_id", result.ResultID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return len(results), nil
+}
+
+func (s *Housekeeper) expireLapsedResults(ctx context.Context) (int, error) {
+	results, err := s.store.ListLapsedQualificationResults(ctx, expiryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, result := range results {
+		expired, err := s.expireResult(ctx, result)
+		if err != nil {
+			s.logger.Error(
+				"error expiring qualification result",
+				zap.Int64("result_id", result.ResultID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if !expired {
+			continue
+		}
+		count++
+
+		if err := s.notifyUser(
+			ctx,
+			result,
+			"notifications.qualifications.result_expired",
+			notifications.NotificationType_NOTIFICATION_TYPE_ERROR,
+		); err != nil {
+			s.logger.Error(
+				"error sending qualification expired notification",
+				zap.Int64("result_id", result.ResultID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return count, nil
+}
+
+func (s *Housekeeper) expireResult(
+	ctx context.Context,
+	result *qualificationsstore.ExpiringQualificationResult,
+) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	// Defer a rollback in case anything fails
+	defer tx.Rollback()
+
+	expired, err := s.store.ExpireQualificationResult(ctx, tx, result.ResultID)
+	if err != nil {
+		return false, err
+	}
+	if !expired {
+		return false, nil
+	}
+
+	if result.LabelSyncEnabled {
+		if err := handleColleagueLabelSync(
+			ctx,
+			tx,
+			result.Job,
+			&qualifications.Qualification{
+				Abbreviation:    result.Abbreviation,
+				Title:           result.Title,
+				LabelSyncFormat: result.LabelSyncFormat,
+			},
+			result.UserID,
+			false,
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *Housekeeper) notifyUser(
+	ctx context.Context,
+	result *qualificationsstore.ExpiringQualificationResult,
+	key string,
+	nType notifications.NotificationType,
+) error {
+	return s.notif.NotifyUser(ctx, &notifications.Notification{
+		UserId: result.UserID,
+		Title: &common.I18NItem{
+			Key: key + ".title",
+		},
+		Content: &common.I18NItem{
+			Key: key + ".content",
+			Parameters: map[string]string{
+				"abbreviation": result.Abbreviation,
+				"title":        result.Title,
+				"date":         result.ExpiresAt.Format(time.DateOnly),
+			},
+		},
+		Category: notifications.NotificationCategory_NOTIFICATION_CATEGORY_GENERAL,
+		Type:     nType,
+		Data: &notifications.Data{
+			Link: &notifications.Link{
+				To: fmt.Sprintf("/qualifications/%d", result.QualificationID),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/file"
+	notificationsclientview "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications/clientview"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
 	qualificationsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/access"
 	qualificationsexam "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/exam"
@@ -419,6 +420,15 @@ func (s *Server) UpdateQualification(
 
 	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
 
+	s.notif.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
+		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_QUALIFICATION,
+		Id:        &req.Qualification.Id,
+		EventType: notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED,
+
+		UserId: &userInfo.UserId,
+		Job:    &userInfo.Job,
+	})
+
 	return &pbqualifications.UpdateQualificationResponse{
 		QualificationId: req.GetQualification().GetId(),
 	}, nil
@@ -497,5 +507,18 @@ func (s *Server) DeleteQualification(
 		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
 	}
 
+	eventType := notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED
+	if deletedAtTime != nil {
+		eventType = notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_DELETED
+	}
+	s.notif.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
+		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_QUALIFICATION,
+		Id:        &req.QualificationId,
+		EventType: eventType,
+
+		UserId: &userInfo.UserId,
+		Job:    &userInfo.Job,
+	})
+
 	return &pbqualifications.DeleteQualificationResponse{}, nil
 }
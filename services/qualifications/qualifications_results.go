@@ -244,10 +244,10 @@ func (s *Server) createOrUpdateQualificationResult(
 
 	if quali.GetLabelSyncEnabled() {
 		// Add/Remove label based on result status
-		if err := s.handleColleagueLabelSync(
+		if err := handleColleagueLabelSync(
 			ctx,
 			tx,
-			userInfo,
+			userInfo.GetJob(),
 			quali,
 			userId,
 			status == qualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL,
@@ -404,10 +404,10 @@ func (s *Server) DeleteQualificationResult(
 
 	if quali.GetLabelSyncEnabled() {
 		// Remove label as we are deleting the result
-		if err := s.handleColleagueLabelSync(
+		if err := handleColleagueLabelSync(
 			ctx,
 			tx,
-			userInfo,
+			userInfo.GetJob(),
 			quali,
 			result.GetUserId(),
 			false,
@@ -426,10 +426,10 @@ func (s *Server) DeleteQualificationResult(
 	return &pbqualifications.DeleteQualificationResultResponse{}, nil
 }
 
-func (s *Server) handleColleagueLabelSync(
+func handleColleagueLabelSync(
 	ctx context.Context,
 	tx qrm.DB,
-	userInfo *userinfo.UserInfo,
+	job string,
 	quali *qualifications.Qualification,
 	targetUserId int32,
 	addLabel bool,
@@ -456,7 +456,7 @@ func (s *Server) handleColleagueLabelSync(
 			tJobLabels.Color,
 		).
 		VALUES(
-			userInfo.GetJob(),
+			job,
 			labelName,
 			"#5c7aff", // Default color if not set
 		)
@@ -477,7 +477,7 @@ func (s *Server) handleColleagueLabelSync(
 			).
 			FROM(tJobLabels).
 			WHERE(mysql.AND(
-				tJobLabels.Job.EQ(mysql.String(userInfo.GetJob())),
+				tJobLabels.Job.EQ(mysql.String(job)),
 				tJobLabels.Name.EQ(mysql.String(labelName)),
 			)).
 			LIMIT(1)
@@ -511,7 +511,7 @@ func (s *Server) handleColleagueLabelSync(
 			).
 			VALUES(
 				targetUserId,
-				userInfo.GetJob(),
+				job,
 				labelId,
 			)
 
@@ -525,7 +525,7 @@ func (s *Server) handleColleagueLabelSync(
 			DELETE().
 			WHERE(mysql.AND(
 				tUserLabels.UserID.EQ(mysql.Int32(targetUserId)),
-				tUserLabels.Job.EQ(mysql.String(userInfo.GetJob())),
+				tUserLabels.Job.EQ(mysql.String(job)),
 				tUserLabels.LabelID.EQ(mysql.Int64(labelId)),
 			)).
 			LIMIT(1)
@@ -57,6 +57,15 @@ func init() {
 			},
 		},
 	})
+
+	housekeeper.AddTable(&housekeeper.Table{
+		Table:           table.FivenetQualificationsTrainingPaths,
+		IDColumn:        table.FivenetQualificationsTrainingPaths.ID,
+		JobColumn:       table.FivenetQualificationsTrainingPaths.Job,
+		DeletedAtColumn: table.FivenetQualificationsTrainingPaths.DeletedAt,
+
+		MinDays: 60,
+	})
 }
 
 var tQualiFiles = table.FivenetQualificationsFiles
@@ -64,6 +73,7 @@ var tQualiFiles = table.FivenetQualificationsFiles
 type Server struct {
 	pbqualifications.QualificationsServiceServer
 	pbqualifications.ExamServiceServer
+	pbqualifications.TrainingServiceServer
 
 	db       *sql.DB
 	perms    perms.Permissions
@@ -90,6 +100,8 @@ type Params struct {
 }
 
 func NewServer(p Params) *Server {
+	access.RegisterAccess("qualifications", p.Access)
+
 	// 3 MiB limit
 	qualiFileHandler := filestore.NewHandler(
 		p.Storage,
@@ -125,4 +137,5 @@ func NewServer(p Params) *Server {
 func (s *Server) RegisterServer(srv *grpc.Server) {
 	pbqualifications.RegisterQualificationsServiceServer(srv, s)
 	pbqualifications.RegisterExamServiceServer(srv, s)
+	pbqualifications.RegisterTrainingServiceServer(srv, s)
 }
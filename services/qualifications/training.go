@@ -0,0 +1,346 @@
+package qualifications
+
+import (
+	"context"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	qualificationsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/access"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	permsjobs "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/jobs/perms"
+	pbqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
+	errorsqualifications "github.com/fivenet-app/fivenet/v2026/services/qualifications/errors"
+	qualificationsstore "github.com/fivenet-app/fivenet/v2026/stores/qualifications"
+	logging "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+)
+
+const trainingPathIDLogFieldKey = "fivenet.qualifications.training_path_id"
+
+func (s *Server) ListTrainingPaths(
+	ctx context.Context,
+	req *pbqualifications.ListTrainingPathsRequest,
+) (*pbqualifications.ListTrainingPathsResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	paths, err := s.store.ListTrainingPaths(ctx, userInfo.GetJob(), req.GetSearch())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	for _, path := range paths {
+		s.enrichTrainingPath(path)
+	}
+
+	return &pbqualifications.ListTrainingPathsResponse{
+		Paths: paths,
+	}, nil
+}
+
+func (s *Server) GetTrainingPath(
+	ctx context.Context,
+	req *pbqualifications.GetTrainingPathRequest,
+) (*pbqualifications.GetTrainingPathResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{trainingPathIDLogFieldKey, req.GetTrainingPathId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	path, err := s.getTrainingPath(ctx, userInfo, req.GetTrainingPathId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbqualifications.GetTrainingPathResponse{
+		Path: path,
+	}, nil
+}
+
+func (s *Server) CreateTrainingPath(
+	ctx context.Context,
+	req *pbqualifications.CreateTrainingPathRequest,
+) (*pbqualifications.CreateTrainingPathResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if err := s.validateTrainingPathSteps(ctx, userInfo, req.GetPath().GetSteps()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	defer tx.Rollback()
+
+	lastId, err := s.store.CreateTrainingPath(ctx, tx, req.GetPath(), userInfo)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	if err := s.store.SetTrainingPathSteps(ctx, tx, lastId, req.GetPath().GetSteps()); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_CREATED)
+
+	path, err := s.getTrainingPath(ctx, userInfo, lastId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbqualifications.CreateTrainingPathResponse{
+		Path: path,
+	}, nil
+}
+
+func (s *Server) UpdateTrainingPath(
+	ctx context.Context,
+	req *pbqualifications.UpdateTrainingPathRequest,
+) (*pbqualifications.UpdateTrainingPathResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{trainingPathIDLogFieldKey, req.GetPath().GetId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if _, err := s.getTrainingPath(ctx, userInfo, req.GetPath().GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTrainingPathSteps(ctx, userInfo, req.GetPath().GetSteps()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	defer tx.Rollback()
+
+	if err := s.store.UpdateTrainingPath(ctx, tx, req.GetPath()); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	if err := s.store.SetTrainingPathSteps(
+		ctx,
+		tx,
+		req.GetPath().GetId(),
+		req.GetPath().GetSteps(),
+	); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
+
+	path, err := s.getTrainingPath(ctx, userInfo, req.GetPath().GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbqualifications.UpdateTrainingPathResponse{
+		Path: path,
+	}, nil
+}
+
+func (s *Server) DeleteTrainingPath(
+	ctx context.Context,
+	req *pbqualifications.DeleteTrainingPathRequest,
+) (*pbqualifications.DeleteTrainingPathResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{trainingPathIDLogFieldKey, req.GetTrainingPathId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if _, err := s.getTrainingPath(ctx, userInfo, req.GetTrainingPathId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteTrainingPath(ctx, s.db, req.GetTrainingPathId()); err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_DELETED)
+
+	return &pbqualifications.DeleteTrainingPathResponse{}, nil
+}
+
+func (s *Server) GetTrainingProgress(
+	ctx context.Context,
+	req *pbqualifications.GetTrainingProgressRequest,
+) (*pbqualifications.GetTrainingProgressResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{trainingPathIDLogFieldKey, req.GetTrainingPathId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	path, err := s.getTrainingPath(ctx, userInfo, req.GetTrainingPathId())
+	if err != nil {
+		return nil, err
+	}
+
+	userId := userInfo.GetUserId()
+	if req.UserId != nil && req.GetUserId() != userId {
+		// Other colleagues' progress is visible to those who can list colleagues
+		if !s.perms.Can(userInfo, permsjobs.ColleaguesService.ListColleagues.Perm) {
+			return nil, errorsqualifications.ErrQualiAccessDenied
+		}
+		userId = req.GetUserId()
+	}
+	logging.InjectFields(ctx, logging.Fields{userIDLogFieldKey, userId})
+
+	grade, ok, err := s.store.GetUserJobGrade(ctx, userId, path.GetJob())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	if !ok && userId != userInfo.GetUserId() {
+		return nil, errorsqualifications.ErrTrainingPathNotFound
+	}
+
+	completed, statuses, err := s.store.GetUserQualificationStates(
+		ctx,
+		userId,
+		path.QualificationIDs(),
+	)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	return &pbqualifications.GetTrainingProgressResponse{
+		Path:     path,
+		Progress: path.Progress(userId, grade, completed, statuses),
+	}, nil
+}
+
+func (s *Server) GetTrainingMatrix(
+	ctx context.Context,
+	req *pbqualifications.GetTrainingMatrixRequest,
+) (*pbqualifications.GetTrainingMatrixResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{trainingPathIDLogFieldKey, req.GetTrainingPathId()})
+
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	if !s.perms.Can(userInfo, permsjobs.ColleaguesService.ListColleagues.Perm) {
+		return nil, errorsqualifications.ErrQualiAccessDenied
+	}
+
+	path, err := s.getTrainingPath(ctx, userInfo, req.GetTrainingPathId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.store.ListTrainingMatrix(ctx, qualificationsstore.TrainingMatrixOptions{
+		Pagination:     req.GetPagination(),
+		Path:           path,
+		Search:         req.GetSearch(),
+		PromotionReady: req.GetPromotionReady(),
+	})
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	resp.Path = path
+
+	if len(resp.GetRows()) == 0 {
+		return resp, nil
+	}
+
+	userIds := make([]int32, 0, len(resp.GetRows()))
+	for _, row := range resp.GetRows() {
+		userIds = append(userIds, row.GetUserId())
+	}
+
+	completed, err := s.store.ListCompletedQualifications(ctx, userIds, path.QualificationIDs())
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+
+	jobInfoFn := s.enricher.EnrichJobInfoSafeFunc(userInfo)
+	for _, row := range resp.GetRows() {
+		row.Progress = path.Progress(
+			row.GetUserId(),
+			row.GetUser().GetJobGrade(),
+			completed[row.GetUserId()],
+			nil,
+		)
+
+		if row.GetUser() != nil {
+			jobInfoFn(row.GetUser())
+		}
+	}
+
+	return resp, nil
+}
+
+// getTrainingPath returns the enriched training path if it exists and belongs to the user's job.
+func (s *Server) getTrainingPath(
+	ctx context.Context,
+	userInfo *userinfo.UserInfo,
+	trainingPathId int64,
+) (*qualifications.TrainingPath, error) {
+	path, err := s.store.GetTrainingPath(ctx, s.db, trainingPathId)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	if path == nil || path.GetDeletedAt() != nil {
+		return nil, errorsqualifications.ErrTrainingPathNotFound
+	}
+	if path.GetJob() != userInfo.GetJob() && !userInfo.GetSuperuser() {
+		return nil, errorsqualifications.ErrTrainingPathNotFound
+	}
+
+	s.enrichTrainingPath(path)
+
+	return path, nil
+}
+
+func (s *Server) enrichTrainingPath(path *qualifications.TrainingPath) {
+	if path.Grade == nil {
+		return
+	}
+
+	if _, grade := s.enricher.GetJobGrade(path.GetJob(), path.GetGrade()); grade != nil {
+		label := grade.GetLabel()
+		path.GradeLabel = &label
+	}
+}
+
+// validateTrainingPathSteps makes sure each qualification is only part of the path once and that
+// the user can view every qualification of the path.
+func (s *Server) validateTrainingPathSteps(
+	ctx context.Context,
+	userInfo *userinfo.UserInfo,
+	steps []*qualifications.TrainingPathStep,
+) error {
+	if len(steps) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(steps))
+	seen := make(map[int64]struct{}, len(steps))
+	for _, step := range steps {
+		if _, ok := seen[step.GetQualificationId()]; ok {
+			return errorsqualifications.ErrTrainingPathInvalidSteps
+		}
+		seen[step.GetQualificationId()] = struct{}{}
+		ids = append(ids, step.GetQualificationId())
+	}
+
+	accessible, err := s.access.CanUserAccessTargetIDs(
+		ctx,
+		userInfo,
+		int32(qualificationsaccess.AccessLevel_ACCESS_LEVEL_VIEW),
+		ids...,
+	)
+	if err != nil {
+		return errswrap.NewError(err, errorsqualifications.ErrFailedQuery)
+	}
+	if len(accessible) != len(ids) {
+		return errorsqualifications.ErrTrainingPathInvalidSteps
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package errorssearch
+
+import (
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common"
+	"google.golang.org/grpc/codes"
+)
+
+var ErrFailedQuery = common.NewI18nErr(
+	codes.Internal,
+	&common.I18NItem{Key: "errors.search.SearchService.ErrFailedQuery.content"},
+	&common.I18NItem{Key: "errors.search.SearchService.ErrFailedQuery.title"},
+)
@@ -0,0 +1,135 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	notificationsclientview "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications/clientview"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/pkg/notifi"
+	"github.com/fivenet-app/fivenet/v2026/pkg/utils/protoutils"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+// Object event types that are kept up to date in the search index.
+var indexedObjectTypes = map[notificationsclientview.ObjectType]search.SearchResultType{
+	notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT:      search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT,
+	notificationsclientview.ObjectType_OBJECT_TYPE_WIKI_PAGE:     search.SearchResultType_SEARCH_RESULT_TYPE_WIKI_PAGE,
+	notificationsclientview.ObjectType_OBJECT_TYPE_CITIZEN:       search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN,
+	notificationsclientview.ObjectType_OBJECT_TYPE_QUALIFICATION: search.SearchResultType_SEARCH_RESULT_TYPE_QUALIFICATION,
+}
+
+func (s *Server) runIndexer(ctx context.Context) {
+	for {
+		if err := s.indexer(ctx); err != nil {
+			if !errors.Is(err, context.Canceled) {
+				s.logger.Error("search indexer stopped", zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+func (s *Server) indexer(ctx context.Context) error {
+	filterSubjects := make([]string, 0, len(indexedObjectTypes))
+	for objType := range indexedObjectTypes {
+		spec, ok := objType.Spec()
+		if !ok {
+			continue
+		}
+
+		filterSubjects = append(filterSubjects, fmt.Sprintf(
+			"%s.%s.%s.*",
+			notifi.BaseSubject,
+			notifi.ObjectTopic,
+			spec.NatsKey,
+		))
+	}
+
+	// The durable consumer is shared by all instances, so each event is only indexed once
+	consumer, err := s.js.CreateOrUpdateConsumer(ctx, notifi.StreamName, jetstream.ConsumerConfig{
+		Durable:           "search_indexer",
+		FilterSubjects:    filterSubjects,
+		DeliverPolicy:     jetstream.DeliverNewPolicy,
+		AckPolicy:         jetstream.AckExplicitPolicy,
+		InactiveThreshold: 1 * time.Minute, // Close consumer if inactive for 1 minute
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create/update search indexer consumer. %w", err)
+	}
+
+	msgs, err := consumer.Messages()
+	if err != nil {
+		return fmt.Errorf("failed to start search indexer consumer. %w", err)
+	}
+	defer msgs.Stop()
+
+	stop := context.AfterFunc(ctx, msgs.Stop)
+	defer stop()
+
+	for {
+		msg, err := msgs.Next()
+		if err != nil {
+			if errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		if err := msg.Ack(); err != nil {
+			s.logger.Error("failed to ack search indexer message", zap.Error(err))
+		}
+
+		event := &notificationsclientview.ObjectEvent{}
+		if err := protoutils.UnmarshalPartialJSON(msg.Data(), event); err != nil {
+			s.logger.Error("failed to unmarshal object event", zap.Error(err))
+			continue
+		}
+
+		if err := s.handleObjectEvent(ctx, event); err != nil {
+			s.logger.Error(
+				"failed to index object",
+				zap.String("object_type", event.GetType().String()),
+				zap.Int64("object_id", event.GetId()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// handleObjectEvent re-indexes the object of the event, objects that are gone (e.g., deleted) are
+// removed from the index.
+func (s *Server) handleObjectEvent(
+	ctx context.Context,
+	event *notificationsclientview.ObjectEvent,
+) error {
+	rType, ok := indexedObjectTypes[event.GetType()]
+	if !ok || event.Id == nil {
+		return nil
+	}
+
+	targetKey := strconv.FormatInt(event.GetId(), 10)
+	if event.GetEventType() == notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_DELETED {
+		return s.store.DeleteEntry(ctx, rType, targetKey)
+	}
+
+	entries, err := s.store.LoadSourceEntries(ctx, rType, []int64{event.GetId()})
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return s.store.DeleteEntry(ctx, rType, targetKey)
+	}
+
+	return s.store.UpsertEntries(ctx, entries)
+}
@@ -0,0 +1,112 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	notificationsclientview "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/notifications/clientview"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	searchstore "github.com/fivenet-app/fivenet/v2026/stores/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type indexerTestStore struct {
+	searchstore.IStore
+
+	sources  map[int64]*search.SearchIndexEntry
+	upserted []*search.SearchIndexEntry
+	deleted  []string
+}
+
+func (s *indexerTestStore) LoadSourceEntries(
+	_ context.Context,
+	_ search.SearchResultType,
+	ids []int64,
+) ([]*search.SearchIndexEntry, error) {
+	entries := []*search.SearchIndexEntry{}
+	for _, id := range ids {
+		if entry, ok := s.sources[id]; ok {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (s *indexerTestStore) UpsertEntries(
+	_ context.Context,
+	entries []*search.SearchIndexEntry,
+) error {
+	s.upserted = append(s.upserted, entries...)
+	return nil
+}
+
+func (s *indexerTestStore) DeleteEntry(
+	_ context.Context,
+	_ search.SearchResultType,
+	targetKey string,
+) error {
+	s.deleted = append(s.deleted, targetKey)
+	return nil
+}
+
+func TestHandleObjectEvent(t *testing.T) {
+	t.Parallel()
+
+	store := &indexerTestStore{
+		sources: map[int64]*search.SearchIndexEntry{
+			1: {
+				Type:      search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT,
+				TargetId:  1,
+				TargetKey: "1",
+				Title:     "Report",
+			},
+		},
+	}
+	s := &Server{store: store}
+
+	event := func(
+		objType notificationsclientview.ObjectType,
+		id int64,
+		eventType notificationsclientview.ObjectEventType,
+	) *notificationsclientview.ObjectEvent {
+		return &notificationsclientview.ObjectEvent{
+			Type:      objType,
+			Id:        &id,
+			EventType: eventType,
+		}
+	}
+
+	// Existing document is (re-)indexed
+	require.NoError(t, s.handleObjectEvent(t.Context(), event(
+		notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
+		1,
+		notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED,
+	)))
+	require.Len(t, store.upserted, 1)
+	assert.Equal(t, "Report", store.upserted[0].GetTitle())
+
+	// Missing document is removed from the index
+	require.NoError(t, s.handleObjectEvent(t.Context(), event(
+		notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
+		2,
+		notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED,
+	)))
+	// Deleted events don't load the source
+	require.NoError(t, s.handleObjectEvent(t.Context(), event(
+		notificationsclientview.ObjectType_OBJECT_TYPE_DOCUMENT,
+		1,
+		notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_DELETED,
+	)))
+	assert.Equal(t, []string{"2", "1"}, store.deleted)
+	assert.Len(t, store.upserted, 1)
+
+	// Object types that aren't indexed are ignored
+	require.NoError(t, s.handleObjectEvent(t.Context(), event(
+		notificationsclientview.ObjectType_OBJECT_TYPE_JOBS_CONDUCT,
+		1,
+		notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED,
+	)))
+	assert.Len(t, store.upserted, 1)
+	assert.Len(t, store.deleted, 2)
+}
@@ -0,0 +1,109 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"go.uber.org/zap"
+)
+
+const (
+	rebuildBatchSize = 250
+
+	rebuildIndexedAttr = "entries_indexed"
+	rebuildDeletedAttr = "entries_deleted"
+)
+
+// Types (re-)indexed by the index rebuild, vehicles are only indexed by it.
+var rebuildTypes = []search.SearchResultType{
+	search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT,
+	search.SearchResultType_SEARCH_RESULT_TYPE_WIKI_PAGE,
+	search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN,
+	search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE,
+	search.SearchResultType_SEARCH_RESULT_TYPE_QUALIFICATION,
+}
+
+func (s *Server) runRebuildIndex(ctx context.Context, data *cron.CronjobData) error {
+	ctx, span := s.tracer.Start(ctx, "search.index.rebuild")
+	defer span.End()
+
+	dest := &cron.GenericCronData{
+		Attributes: map[string]string{},
+	}
+
+	indexed, deleted, err := s.rebuildIndex(ctx)
+	if err != nil {
+		s.logger.Error("failed to rebuild search index", zap.Error(err))
+		return err
+	}
+
+	dest.SetAttribute(rebuildIndexedAttr, strconv.FormatInt(indexed, 10))
+	dest.SetAttribute(rebuildDeletedAttr, strconv.FormatInt(deleted, 10))
+	if err := data.MarshalFrom(dest); err != nil {
+		return fmt.Errorf("failed to marshal updated search index rebuild cron data. %w", err)
+	}
+
+	return nil
+}
+
+// rebuildIndex re-indexes all entities and removes the entries of entities that haven't been
+// re-indexed (e.g., deleted entities and vehicles without an owner anymore).
+func (s *Server) rebuildIndex(ctx context.Context) (int64, int64, error) {
+	indexed := int64(0)
+	deleted := int64(0)
+
+	for _, rType := range rebuildTypes {
+		// Entries updated by the indexer while rebuilding are kept as well
+		start := time.Now()
+
+		after := ""
+		for {
+			entries, err := s.store.ListSourceEntries(ctx, rType, after, rebuildBatchSize)
+			if err != nil {
+				return indexed, deleted, fmt.Errorf(
+					"failed to list %s entries. %w",
+					rTypeName(rType),
+					err,
+				)
+			}
+			if len(entries) == 0 {
+				break
+			}
+
+			if err := s.store.UpsertEntries(ctx, entries); err != nil {
+				return indexed, deleted, fmt.Errorf(
+					"failed to index %s entries. %w",
+					rTypeName(rType),
+					err,
+				)
+			}
+			indexed += int64(len(entries))
+
+			if len(entries) < rebuildBatchSize {
+				break
+			}
+			after = entries[len(entries)-1].GetTargetKey()
+		}
+
+		count, err := s.store.DeleteStaleEntries(ctx, rType, start)
+		if err != nil {
+			return indexed, deleted, fmt.Errorf(
+				"failed to delete stale %s entries. %w",
+				rTypeName(rType),
+				err,
+			)
+		}
+		deleted += count
+	}
+
+	return indexed, deleted, nil
+}
+
+func rTypeName(rType search.SearchResultType) string {
+	return strings.ToLower(strings.TrimPrefix(rType.String(), "SEARCH_RESULT_TYPE_"))
+}
@@ -0,0 +1,413 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	permscitizens "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/citizens/perms"
+	permsdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/documents/perms"
+	permsqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications/perms"
+	pbsearch "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/search"
+	permsvehicles "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/vehicles/perms"
+	permswiki "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/wiki/perms"
+	"github.com/fivenet-app/fivenet/v2026/pkg/access"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
+	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
+	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+	errorssearch "github.com/fivenet-app/fivenet/v2026/services/search/errors"
+	searchstore "github.com/fivenet-app/fivenet/v2026/stores/search"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+const (
+	defaultLimit = 20
+
+	// Length of the content snippet and the context before the first match (in runes)
+	snippetLength  = 160
+	snippetContext = 40
+	ellipsis       = "…"
+)
+
+type resultTypeSpec struct {
+	// Permissions required to search for the type, all of them must be granted
+	perms []perms.PermissionRef
+	// Key of the grouped access in the access registry, empty if the permissions are enough
+	accessKey string
+}
+
+var resultTypes = map[search.SearchResultType]resultTypeSpec{
+	search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT: {
+		perms:     []perms.PermissionRef{permsdocuments.DocumentsService.ListDocuments.Perm},
+		accessKey: "documents",
+	},
+	search.SearchResultType_SEARCH_RESULT_TYPE_WIKI_PAGE: {
+		perms:     []perms.PermissionRef{permswiki.WikiService.ListPages.Perm},
+		accessKey: "wiki_page",
+	},
+	search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN: {
+		perms:     []perms.PermissionRef{permscitizens.CitizensService.GetUser.Perm},
+		accessKey: "citizen",
+	},
+	search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE: {
+		// Vehicle entries contain the owner's name
+		perms: []perms.PermissionRef{
+			permsvehicles.VehiclesService.ListVehicles.Perm,
+			permscitizens.CitizensService.ListCitizens.Perm,
+		},
+	},
+	search.SearchResultType_SEARCH_RESULT_TYPE_QUALIFICATION: {
+		perms: []perms.PermissionRef{
+			permsqualifications.QualificationsService.ListQualifications.Perm,
+		},
+		accessKey: "qualifications",
+	},
+}
+
+func (s *Server) Search(
+	ctx context.Context,
+	req *pbsearch.SearchRequest,
+) (*pbsearch.SearchResponse, error) {
+	userInfo := auth.MustGetUserInfoFromContext(ctx)
+
+	resp := &pbsearch.SearchResponse{
+		Results: []*search.SearchResult{},
+	}
+
+	query := dbutils.PrepareForFulltextSearch(req.GetSearch())
+	if query == "" {
+		return resp, nil
+	}
+
+	types := s.allowedTypes(userInfo, req.GetTypes())
+	if len(types) == 0 {
+		return resp, nil
+	}
+
+	limit := int64(defaultLimit)
+	if req.Limit != nil {
+		limit = int64(req.GetLimit())
+	}
+
+	filters, err := s.typeFilters(userInfo, types)
+	if err != nil {
+		return nil, errswrap.NewError(err, errorssearch.ErrFailedQuery)
+	}
+
+	entries, err := s.store.Search(ctx, searchstore.SearchQuery{
+		Search:  query,
+		Types:   types,
+		Filters: filters,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, errswrap.NewError(err, errorssearch.ErrFailedQuery)
+	}
+
+	terms := searchTerms(query)
+	for _, entry := range entries {
+		// The entries have been limited to the visible ones by the store already, the access
+		// check only guards against the grade restrictions that can't be applied there
+		check, err := s.canUserAccessEntry(ctx, userInfo, entry)
+		if err != nil {
+			return nil, errswrap.NewError(err, errorssearch.ErrFailedQuery)
+		}
+		if !check {
+			continue
+		}
+
+		resp.Results = append(resp.Results, s.buildResult(entry, terms))
+	}
+
+	return resp, nil
+}
+
+// allowedTypes returns the requested (or all) types the user is allowed to search for.
+func (s *Server) allowedTypes(
+	userInfo *userinfo.UserInfo,
+	requested []search.SearchResultType,
+) []search.SearchResultType {
+	if len(requested) == 0 {
+		requested = rebuildTypes
+	}
+
+	types := []search.SearchResultType{}
+	for _, rType := range requested {
+		spec, ok := resultTypes[rType]
+		if !ok || !s.canSearchType(userInfo, spec) {
+			continue
+		}
+
+		types = append(types, rType)
+	}
+
+	return types
+}
+
+func (s *Server) canSearchType(userInfo *userinfo.UserInfo, spec resultTypeSpec) bool {
+	for _, perm := range spec.perms {
+		if !s.ps.Can(userInfo, perm) {
+			return false
+		}
+	}
+
+	return len(spec.perms) > 0
+}
+
+// typeFilters limits the entries of the types to the visible targets and omits the citizens of
+// the jobs the user can't see.
+func (s *Server) typeFilters(
+	userInfo *userinfo.UserInfo,
+	types []search.SearchResultType,
+) (map[search.SearchResultType]*searchstore.TypeFilter, error) {
+	filters := map[search.SearchResultType]*searchstore.TypeFilter{}
+	for _, rType := range types {
+		if rType == search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN {
+			excluded, err := s.excludedCitizenJobs(userInfo)
+			if err != nil {
+				return nil, err
+			}
+
+			filters[rType] = &searchstore.TypeFilter{ExcludedJobs: excluded}
+			continue
+		}
+
+		spec := resultTypes[rType]
+		if spec.accessKey == "" {
+			continue
+		}
+
+		gAccess, ok := access.GetAccess(spec.accessKey)
+		if !ok {
+			continue
+		}
+		vAccess, ok := gAccess.(access.VisibleTargetsAccess)
+		if !ok {
+			continue
+		}
+
+		filters[rType] = &searchstore.TypeFilter{
+			TargetID: vAccess.TargetIDColumn(),
+			VisibleIDs: func(condition mysql.BoolExpression) access.VisibilityQuery {
+				return vAccess.VisibleIDsByConditionQuery(
+					userInfo,
+					2, // View access level
+					false,
+					condition,
+				)
+			},
+		}
+	}
+
+	return filters, nil
+}
+
+// excludedCitizenJobs returns the public and hidden jobs the user can't see any citizen of.
+func (s *Server) excludedCitizenJobs(userInfo *userinfo.UserInfo) ([]string, error) {
+	if userInfo.GetJobAdmin() {
+		return nil, nil
+	}
+
+	jobGrades, err := s.ps.AttrJobGradeList(userInfo, permscitizens.CitizensService.GetUser.Jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	jobInfo := s.appCfg.Get().GetJobInfo()
+	return restrictedJobsWithoutAccess(
+		jobInfo.GetUnemployedJob().GetName(),
+		slices.Concat(jobInfo.GetPublicJobs(), jobInfo.GetHiddenJobs()),
+		jobGrades,
+	), nil
+}
+
+// restrictedJobsWithoutAccess returns the restricted jobs (except the unemployed job) that aren't
+// part of the job grade list.
+func restrictedJobsWithoutAccess(
+	unemployedJob string,
+	restricted []string,
+	jobGrades *permissionsattributes.JobGradeList,
+) []string {
+	allowed := map[string]struct{}{}
+	for job, grades := range jobGrades.Iter() {
+		if len(grades) > 0 {
+			allowed[job] = struct{}{}
+		}
+	}
+
+	excluded := []string{}
+	for _, job := range restricted {
+		if _, ok := allowed[job]; ok || job == unemployedJob || slices.Contains(excluded, job) {
+			continue
+		}
+
+		excluded = append(excluded, job)
+	}
+
+	return excluded
+}
+
+func (s *Server) canUserAccessEntry(
+	ctx context.Context,
+	userInfo *userinfo.UserInfo,
+	entry *search.SearchIndexEntry,
+) (bool, error) {
+	spec, ok := resultTypes[entry.GetType()]
+	if !ok {
+		return false, nil
+	}
+	if spec.accessKey == "" {
+		return true, nil
+	}
+
+	gAccess, ok := access.GetAccess(spec.accessKey)
+	if !ok {
+		return false, nil
+	}
+
+	check, err := gAccess.CanUserAccessTarget(
+		ctx,
+		entry.GetTargetId(),
+		userInfo,
+		2, // View access level
+	)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return false, err
+	}
+
+	return check, nil
+}
+
+func (s *Server) buildResult(entry *search.SearchIndexEntry, terms []string) *search.SearchResult {
+	snippet, snippetHighlights := buildSnippet(entry.GetContent(), terms)
+
+	result := &search.SearchResult{
+		Type:              entry.GetType(),
+		TargetId:          entry.GetTargetId(),
+		Job:               entry.Job,
+		Title:             entry.GetTitle(),
+		Snippet:           snippet,
+		TitleHighlights:   findHighlights(entry.GetTitle(), terms),
+		SnippetHighlights: snippetHighlights,
+		Score:             entry.GetScore(),
+	}
+
+	if entry.GetType() == search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE {
+		result.TargetKey = &entry.TargetKey
+	}
+
+	if entry.Job != nil {
+		if job := s.enricher.GetJobByName(entry.GetJob()); job != nil {
+			result.JobLabel = &job.Label
+		}
+	}
+
+	return result
+}
+
+// searchTerms returns the lower cased terms of a fulltext search query.
+func searchTerms(query string) []string {
+	terms := []string{}
+	for _, term := range strings.Fields(query) {
+		term = strings.Trim(term, "+*")
+		if term == "" {
+			continue
+		}
+
+		terms = append(terms, strings.Map(unicode.ToLower, term))
+	}
+
+	return terms
+}
+
+// findHighlights returns the ranges of words starting with one of the terms (as fulltext search
+// matches word prefixes).
+func findHighlights(text string, terms []string) []*search.SearchHighlight {
+	highlights := []*search.SearchHighlight{}
+	if len(terms) == 0 {
+		return highlights
+	}
+
+	// Lower case rune by rune, so the offsets stay the same
+	runes := []rune(strings.Map(unicode.ToLower, text))
+	for i := 0; i < len(runes); i++ {
+		if i > 0 && isWordRune(runes[i-1]) {
+			continue
+		}
+
+		length := 0
+		for _, term := range terms {
+			termRunes := []rune(term)
+			if len(termRunes) > length && hasRunePrefix(runes[i:], termRunes) {
+				length = len(termRunes)
+			}
+		}
+		if length == 0 {
+			continue
+		}
+
+		highlights = append(highlights, &search.SearchHighlight{
+			//nolint:gosec // Offsets are bound by the indexed content length.
+			Start: int32(i),
+			//nolint:gosec // Length is bound by the search term length.
+			Length: int32(length),
+		})
+		i += length - 1
+	}
+
+	return highlights
+}
+
+// buildSnippet returns an excerpt of the content around the first match with its highlights.
+// Without a match in the content, the excerpt is taken from the beginning of the content.
+func buildSnippet(content string, terms []string) (string, []*search.SearchHighlight) {
+	runes := []rune(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return ' '
+		}
+		return r
+	}, content))
+
+	start := 0
+	if highlights := findHighlights(string(runes), terms); len(highlights) > 0 {
+		start = max(int(highlights[0].GetStart())-snippetContext, 0)
+	}
+	end := min(start+snippetLength, len(runes))
+	// Use the full snippet length if the match is close to the end of the content
+	start = max(end-snippetLength, 0)
+
+	snippet := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		snippet = ellipsis + snippet
+	}
+	if end < len(runes) {
+		snippet += ellipsis
+	}
+
+	return snippet, findHighlights(snippet, terms)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func hasRunePrefix(runes []rune, prefix []rune) bool {
+	if len(runes) < len(prefix) {
+		return false
+	}
+
+	for i, r := range prefix {
+		if runes[i] != r {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,133 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	permscitizens "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/citizens/perms"
+	permsvehicles "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/vehicles/perms"
+	"github.com/fivenet-app/fivenet/v2026/internal/tests/permsstub"
+	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTerms(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"john", "müller"}, searchTerms("+John* +Müller*"))
+	assert.Empty(t, searchTerms(""))
+}
+
+func TestAllowedTypesVehiclesRequireCitizens(t *testing.T) {
+	t.Parallel()
+
+	granted := []perms.PermissionRef{permsvehicles.VehiclesService.ListVehicles.Perm}
+	s := &Server{
+		ps: &permsstub.Permissions{
+			CanFunc: func(_ *userinfo.UserInfo, perm perms.PermissionRef) bool {
+				return slices.Contains(granted, perm)
+			},
+		},
+	}
+	requested := []search.SearchResultType{search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE}
+
+	// Vehicle entries contain the owner's name
+	assert.Empty(t, s.allowedTypes(&userinfo.UserInfo{}, requested))
+
+	granted = append(granted, permscitizens.CitizensService.ListCitizens.Perm)
+	assert.Equal(t, requested, s.allowedTypes(&userinfo.UserInfo{}, requested))
+}
+
+func TestRestrictedJobsWithoutAccess(t *testing.T) {
+	t.Parallel()
+
+	jobGrades := &permissionsattributes.JobGradeList{
+		Jobs: map[string]int32{"police": 2},
+	}
+
+	// Citizens of the unemployed job are always visible
+	assert.Equal(t, []string{"ems", "doj"}, restrictedJobsWithoutAccess(
+		"unemployed",
+		[]string{"police", "ems", "unemployed", "doj", "ems"},
+		jobGrades,
+	))
+	assert.Equal(
+		t,
+		[]string{"police"},
+		restrictedJobsWithoutAccess("unemployed", []string{"police"}, nil),
+	)
+	assert.Empty(t, restrictedJobsWithoutAccess("unemployed", nil, jobGrades))
+}
+
+func TestFindHighlights(t *testing.T) {
+	t.Parallel()
+
+	highlights := findHighlights(
+		"Über Müller and Mülleimer, not Smüller",
+		[]string{"müll", "müller"},
+	)
+	require.Len(t, highlights, 2)
+	// Longest matching term wins
+	assert.Equal(t, int32(5), highlights[0].GetStart())
+	assert.Equal(t, int32(6), highlights[0].GetLength())
+	// Only word prefixes are highlighted
+	assert.Equal(t, int32(16), highlights[1].GetStart())
+	assert.Equal(t, int32(4), highlights[1].GetLength())
+
+	assert.Empty(t, findHighlights("Lorem ipsum", nil))
+}
+
+func TestBuildSnippet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("short content is returned as is", func(t *testing.T) {
+		t.Parallel()
+
+		snippet, highlights := buildSnippet("Traffic\nreport", []string{"report"})
+		assert.Equal(t, "Traffic report", snippet)
+		require.Len(t, highlights, 1)
+		assert.Equal(t, int32(8), highlights[0].GetStart())
+	})
+
+	t.Run("snippet is centered around the first match", func(t *testing.T) {
+		t.Parallel()
+
+		content := strings.Repeat("lorem ", 100) + "suspect " + strings.Repeat("ipsum ", 100)
+		snippet, highlights := buildSnippet(content, []string{"suspect"})
+		assert.True(t, strings.HasPrefix(snippet, ellipsis))
+		assert.True(t, strings.HasSuffix(snippet, ellipsis))
+		require.Len(t, highlights, 1)
+		assert.Equal(t, "suspect", string([]rune(snippet)[highlights[0].GetStart():][:7]))
+	})
+
+	t.Run("without a match the beginning is used", func(t *testing.T) {
+		t.Parallel()
+
+		content := strings.Repeat("lorem ", 100)
+		snippet, highlights := buildSnippet(content, []string{"suspect"})
+		assert.False(t, strings.HasPrefix(snippet, ellipsis))
+		assert.True(t, strings.HasSuffix(snippet, ellipsis))
+		assert.Empty(t, highlights)
+	})
+}
+
+func TestBuildResultVehicleKey(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{}
+	result := s.buildResult(&search.SearchIndexEntry{
+		Type:      search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE,
+		TargetKey: "ABC 123",
+		Title:     "ABC 123",
+		Content:   "car adder\nJohn Doe",
+	}, []string{"abc"})
+
+	assert.Equal(t, "ABC 123", result.GetTargetKey())
+	require.Len(t, result.GetTitleHighlights(), 1)
+	assert.Equal(t, "car adder John Doe", result.GetSnippet())
+}
@@ -0,0 +1,118 @@
+package search
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/cron"
+	pbsearch "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/search"
+	"github.com/fivenet-app/fivenet/v2026/pkg/config/appconfig"
+	"github.com/fivenet-app/fivenet/v2026/pkg/croner"
+	"github.com/fivenet-app/fivenet/v2026/pkg/events"
+	pkggrpc "github.com/fivenet-app/fivenet/v2026/pkg/grpc"
+	"github.com/fivenet-app/fivenet/v2026/pkg/mstlystcdata"
+	"github.com/fivenet-app/fivenet/v2026/pkg/perms"
+	searchstore "github.com/fivenet-app/fivenet/v2026/stores/search"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type Server struct {
+	pbsearch.SearchServiceServer
+
+	logger *zap.Logger
+	tracer trace.Tracer
+	wg     sync.WaitGroup
+
+	js       *events.JSWrapper
+	ps       perms.Permissions
+	appCfg   appconfig.IConfig
+	enricher mstlystcdata.IUserAwareEnricher
+	store    searchstore.IStore
+}
+
+type Params struct {
+	fx.In
+
+	LC fx.Lifecycle
+
+	Logger    *zap.Logger
+	TP        *tracesdk.TracerProvider
+	JS        *events.JSWrapper
+	Perms     perms.Permissions
+	AppConfig appconfig.IConfig
+	Enricher  mstlystcdata.IUserAwareEnricher
+	Store     searchstore.IStore
+}
+
+type Result struct {
+	fx.Out
+
+	Server       *Server
+	Service      pkggrpc.Service     `group:"grpcservices"`
+	CronRegister croner.CronRegister `group:"cronjobregister"`
+}
+
+func NewServer(p Params) Result {
+	ctxCancel, cancel := context.WithCancel(context.Background())
+
+	s := &Server{
+		logger: p.Logger.Named("search"),
+		tracer: p.TP.Tracer("search"),
+
+		js:       p.JS,
+		ps:       p.Perms,
+		appCfg:   p.AppConfig,
+		enricher: p.Enricher,
+		store:    p.Store,
+	}
+
+	p.LC.Append(fx.StartHook(func(_ context.Context) error {
+		s.wg.Go(func() {
+			s.runIndexer(ctxCancel)
+		})
+
+		return nil
+	}))
+
+	p.LC.Append(fx.StopHook(func(_ context.Context) error {
+		cancel()
+
+		s.wg.Wait()
+
+		return nil
+	}))
+
+	return Result{
+		Server:       s,
+		Service:      s,
+		CronRegister: s,
+	}
+}
+
+func (s *Server) RegisterServer(srv *grpc.Server) {
+	pbsearch.RegisterSearchServiceServer(srv, s)
+}
+
+func (s *Server) RegisterCronjobs(ctx context.Context, registry croner.IRegistry) error {
+	if err := registry.RegisterCronjob(ctx, &cron.Cronjob{
+		Name:     "search.index.rebuild",
+		Schedule: "40 4 * * *", // Every day at 04:40
+		Timeout:  durationpb.New(15 * time.Minute),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) RegisterCronjobHandlers(h *croner.Handlers) error {
+	h.Add("search.index.rebuild", s.runRebuildIndex)
+
+	return nil
+}
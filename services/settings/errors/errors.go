@@ -62,4 +62,10 @@ var (
 		&common.I18NItem{Key: "errors.settings.SettingsService.ErrDiscordTokenExpired.content"},
 		&common.I18NItem{Key: "errors.settings.SettingsService.ErrDiscordTokenExpired.title"},
 	)
+
+	ErrDocArchiveTooLarge = common.NewI18nErr(
+		codes.InvalidArgument,
+		&common.I18NItem{Key: "errors.settings.SettingsService.ErrDocArchiveTooLarge.content"},
+		&common.I18NItem{Key: "errors.settings.SettingsService.ErrDocArchiveTooLarge.title"},
+	)
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/pkg/config/appconfig"
 	"github.com/fivenet-app/fivenet/v2026/pkg/croner"
 	"github.com/fivenet-app/fivenet/v2026/pkg/crypt"
+	"github.com/fivenet-app/fivenet/v2026/pkg/docarchive"
 	"github.com/fivenet-app/fivenet/v2026/pkg/events"
 	"github.com/fivenet-app/fivenet/v2026/pkg/filestore"
 	grpcauth "github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
@@ -70,6 +71,7 @@ type Server struct {
 	notifi       notifi.INotifi
 
 	jobPropsFileHandler *filestore.Handler[string]
+	docArchiver         *docarchive.Archiver
 
 	dc               *discordapi.Client
 	dcOAuth2Provider *config.OAuth2Provider
@@ -155,6 +157,7 @@ func NewServer(p Params) *Server {
 		notifi:       p.Notifi,
 
 		jobPropsFileHandler: fHandler,
+		docArchiver:         docarchive.New(p.DB, p.Storage),
 
 		dc:               dc,
 		dcOAuth2Provider: dcOAuth2Provider,
@@ -1,7 +1,9 @@
 package settings
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/audit"
@@ -9,6 +11,7 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	pbsettings "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/settings"
 	pbsync "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/sync"
+	"github.com/fivenet-app/fivenet/v2026/pkg/docarchive"
 	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/errswrap"
 	grpc_audit "github.com/fivenet-app/fivenet/v2026/pkg/grpc/interceptors/audit"
 	"github.com/fivenet-app/fivenet/v2026/pkg/version"
@@ -182,3 +185,48 @@ func (s *Server) TriggerUserSync(
 
 	return &pbsettings.TriggerUserSyncResponse{}, nil
 }
+
+// maxArchiveDocuments limits the documents per archive export, bigger exports should be done
+// using the `tools documents export` command.
+const maxArchiveDocuments = 250
+
+func (s *Server) ExportDocumentsArchive(
+	ctx context.Context,
+	req *pbsettings.ExportDocumentsArchiveRequest,
+) (*pbsettings.ExportDocumentsArchiveResponse, error) {
+	logging.InjectFields(ctx, logging.Fields{jobNameLogFieldKey, req.GetJob()})
+
+	filter := &docarchive.Filter{
+		Job:            req.GetJob(),
+		CategoryIDs:    req.GetCategoryIds(),
+		DocumentIDs:    req.GetDocumentIds(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+		Limit:          maxArchiveDocuments,
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		filter.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		filter.To = &to
+	}
+
+	buf := &bytes.Buffer{}
+	manifest, err := s.docArchiver.Export(ctx, buf, filter)
+	if err != nil {
+		if errors.Is(err, docarchive.ErrLimitExceeded) {
+			return nil, errorssettings.ErrDocArchiveTooLarge
+		}
+		return nil, errswrap.NewError(err, errorssettings.ErrFailedQuery)
+	}
+
+	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_VIEWED)
+
+	return &pbsettings.ExportDocumentsArchiveResponse{
+		FileName:  fmt.Sprintf("documents-%s.zip", manifest.CreatedAt.Format("20060102-150405")),
+		Data:      buf.Bytes(),
+		Documents: int32(manifest.Documents),
+		Files:     int32(manifest.Files),
+	}, nil
+}
@@ -2,7 +2,6 @@ package sync
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"slices"
 	"sync/atomic"
@@ -12,17 +11,10 @@ import (
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	pbsync "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/sync"
 	"github.com/fivenet-app/fivenet/v2026/pkg/config"
-	"github.com/fivenet-app/fivenet/v2026/pkg/config/appconfig"
 	"github.com/fivenet-app/fivenet/v2026/pkg/events"
 	pkggrpc "github.com/fivenet-app/fivenet/v2026/pkg/grpc"
 	"github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth"
 	errorsgrpcauth "github.com/fivenet-app/fivenet/v2026/pkg/grpc/auth/errors"
-	"github.com/fivenet-app/fivenet/v2026/pkg/mstlystcdata"
-	"github.com/fivenet-app/fivenet/v2026/pkg/notifi"
-	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatches"
-	citizensstore "github.com/fivenet-app/fivenet/v2026/stores/citizens"
-	jobsstore "github.com/fivenet-app/fivenet/v2026/stores/jobs"
-	livemapstore "github.com/fivenet-app/fivenet/v2026/stores/livemap"
 	syncstore "github.com/fivenet-app/fivenet/v2026/stores/sync"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -51,18 +43,11 @@ type Params struct {
 
 	LC fx.Lifecycle
 
-	Logger        *zap.Logger
-	DB            *sql.DB
-	JS            *events.JSWrapper
-	Auth          *auth.GRPCAuth
-	Config        *config.Config
-	AppConfig     appconfig.IConfig
-	DispatchDB    *dispatches.DispatchDB
-	CitizensStore citizensstore.IStore
-	JobsStore     jobsstore.IStore
-	LivemapStore  livemapstore.IStore
-	Enricher      mstlystcdata.IEnricher
-	Notifi        notifi.INotifi
+	Logger *zap.Logger
+	JS     *events.JSWrapper
+	Auth   *auth.GRPCAuth
+	Config *config.Config
+	Store  syncstore.IStore
 }
 
 type Result struct {
@@ -78,18 +63,7 @@ func NewServer(p Params) Result {
 		js:     p.JS,
 		auth:   p.Auth,
 		cfg:    p.Config,
-		store: syncstore.New(
-			p.DB,
-			p.Logger,
-			p.Config,
-			p.AppConfig,
-			p.DispatchDB,
-			p.CitizensStore,
-			p.JobsStore,
-			p.LivemapStore,
-			p.Enricher,
-			p.Notifi,
-		),
+		store:  p.Store,
 
 		tokens: p.Config.Sync.APITokens,
 
@@ -479,7 +479,7 @@ func (s *Server) UpdatePage(
 		return nil, errswrap.NewError(err, errorswiki.ErrFailedQuery)
 	}
 
-	s.collabServer.SendTargetSaved(ctx, page.GetId())
+	s.collabServer.SendTargetSaved(ctx, page.GetId(), nil)
 
 	s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
 		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_WIKI_PAGE,
@@ -572,7 +572,7 @@ func (s *Server) MovePage(
 
 	grpc_audit.SetAction(ctx, audit.EventAction_EVENT_ACTION_UPDATED)
 
-	s.collabServer.SendTargetSaved(ctx, req.GetPageId())
+	s.collabServer.SendTargetSaved(ctx, req.GetPageId(), nil)
 	s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
 		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_WIKI_PAGE,
 		Id:        &req.PageId,
@@ -642,5 +642,18 @@ func (s *Server) DeletePage(
 		return nil, errswrap.NewError(err, errorswiki.ErrFailedQuery)
 	}
 
+	eventType := notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_UPDATED
+	if deletedAtTime != nil {
+		eventType = notificationsclientview.ObjectEventType_OBJECT_EVENT_TYPE_DELETED
+	}
+	s.notifi.SendObjectEvent(ctx, &notificationsclientview.ObjectEvent{
+		Type:      notificationsclientview.ObjectType_OBJECT_TYPE_WIKI_PAGE,
+		Id:        &page.Id,
+		EventType: eventType,
+
+		UserId: &userInfo.UserId,
+		Job:    &userInfo.Job,
+	})
+
 	return &pbwiki.DeletePageResponse{}, nil
 }
@@ -141,6 +141,8 @@ func (s *Store) ListApprovalTasksInbox(
 				tApprovalTasks.SnapshotDate,
 				tApprovalTasks.AssigneeKind,
 				tApprovalTasks.UserID,
+				tApprovalTasks.OriginalUserID,
+				tApprovalTasks.DelegatedAt,
 				tApprovalTasks.Job,
 				tApprovalTasks.MinimumGrade,
 				tApprovalTasks.Label,
@@ -222,6 +224,8 @@ func (s *Store) ListApprovalTasksInbox(
 				tApprovalTasks.SnapshotDate,
 				tApprovalTasks.AssigneeKind,
 				tApprovalTasks.UserID,
+				tApprovalTasks.OriginalUserID,
+				tApprovalTasks.DelegatedAt,
 				tApprovalTasks.Job,
 				tApprovalTasks.MinimumGrade,
 				tApprovalTasks.Label,
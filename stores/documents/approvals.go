@@ -29,6 +29,8 @@ func (s *Store) GetApprovalPolicy(
 			tApprovalPolicy.CompletedAt,
 			tApprovalPolicy.OnEditBehavior,
 			tApprovalPolicy.SelfApproveAllowed,
+			tApprovalPolicy.PolicyTemplateID,
+			tApprovalPolicy.CurrentStage,
 			tApprovalPolicy.AssignedCount,
 			tApprovalPolicy.ApprovedCount,
 			tApprovalPolicy.DeclinedCount,
@@ -72,6 +74,8 @@ func (s *Store) CreateApprovalPolicy(
 			tApprovalPolicy.OnEditBehavior,
 			tApprovalPolicy.SignatureRequired,
 			tApprovalPolicy.SelfApproveAllowed,
+			tApprovalPolicy.PolicyTemplateID,
+			tApprovalPolicy.CurrentStage,
 		).
 		VALUES(
 			documentID,
@@ -81,6 +85,8 @@ func (s *Store) CreateApprovalPolicy(
 			pol.GetOnEditBehavior(),
 			pol.GetSignatureRequired(),
 			pol.GetSelfApproveAllowed(),
+			pol.PolicyTemplateId,
+			pol.GetCurrentStage(),
 		).
 		ExecContext(ctx, tx)
 	return err
@@ -100,6 +106,8 @@ func (s *Store) GetApprovalTask(
 			tApprovalTasks.SnapshotDate,
 			tApprovalTasks.AssigneeKind,
 			tApprovalTasks.UserID,
+			tApprovalTasks.OriginalUserID,
+			tApprovalTasks.DelegatedAt,
 			tApprovalTasks.Job,
 			tApprovalTasks.MinimumGrade,
 			tApprovalTasks.Label,
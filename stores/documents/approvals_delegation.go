@@ -0,0 +1,144 @@
+package documentsstore
+
+import (
+	"context"
+	"errors"
+
+	documentsapproval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// ApprovalDelegation is an absent user's delegation of their approval tasks to a colleague.
+type ApprovalDelegation struct {
+	UserID     int32 `alias:"user_id"`
+	DelegateID int32 `alias:"delegate_id"`
+}
+
+func colleagueAbsentCondition(
+	tColleagueProps *table.FivenetJobColleaguePropsTable,
+) mysql.BoolExpression {
+	return mysql.AND(
+		tColleagueProps.DeletedAt.IS_NULL(),
+		tColleagueProps.AbsenceBegin.IS_NOT_NULL(),
+		tColleagueProps.AbsenceEnd.IS_NOT_NULL(),
+		tColleagueProps.AbsenceBegin.LT_EQ(mysql.CURRENT_DATE()),
+		tColleagueProps.AbsenceEnd.GT_EQ(mysql.CURRENT_DATE()),
+	)
+}
+
+// ListActiveApprovalDelegations returns the delegations of colleagues that are absent today.
+// Delegations to colleagues that are absent themselves are skipped.
+func (s *Store) ListActiveApprovalDelegations(
+	ctx context.Context,
+	tx qrm.DB,
+) ([]*ApprovalDelegation, error) {
+	tColleagueProps := table.FivenetJobColleagueProps.AS("colleague_props")
+	tDelegateProps := table.FivenetJobColleagueProps.AS("delegate_props")
+
+	stmt := tColleagueProps.
+		SELECT(
+			tColleagueProps.UserID.AS("approval_delegation.user_id"),
+			tColleagueProps.AbsenceDelegateID.AS("approval_delegation.delegate_id"),
+		).
+		FROM(
+			tColleagueProps.
+				LEFT_JOIN(tDelegateProps, mysql.AND(
+					tDelegateProps.UserID.EQ(tColleagueProps.AbsenceDelegateID),
+					tDelegateProps.Job.EQ(tColleagueProps.Job),
+					colleagueAbsentCondition(tDelegateProps),
+				)),
+		).
+		WHERE(mysql.AND(
+			tColleagueProps.AbsenceDelegateID.IS_NOT_NULL(),
+			colleagueAbsentCondition(tColleagueProps),
+			tDelegateProps.UserID.IS_NULL(),
+		)).
+		ORDER_BY(tColleagueProps.UserID.ASC())
+
+	dest := []*ApprovalDelegation{}
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// DelegateApprovalTasks reassigns the user's pending approval tasks to the delegate. The original
+// assignee is kept on the task, tasks of documents the delegate already has a task for are skipped.
+func (s *Store) DelegateApprovalTasks(
+	ctx context.Context,
+	tx qrm.DB,
+	userId int32,
+	delegateId int32,
+) (int64, error) {
+	tApprovalTasks := table.FivenetDocumentsApprovalTasks.AS("approval_task")
+	tDelegateTasks := table.FivenetDocumentsApprovalTasks.AS("delegate_task")
+
+	userKind := mysql.Int32(
+		int32(documentsapproval.ApprovalAssigneeKind_APPROVAL_ASSIGNEE_KIND_USER),
+	)
+	pending := mysql.Int32(
+		int32(documentsapproval.ApprovalTaskStatus_APPROVAL_TASK_STATUS_PENDING),
+	)
+
+	stmt := tApprovalTasks.
+		SELECT(tApprovalTasks.ID.AS("id")).
+		FROM(
+			tApprovalTasks.
+				LEFT_JOIN(tDelegateTasks, mysql.AND(
+					tDelegateTasks.DocumentID.EQ(tApprovalTasks.DocumentID),
+					tDelegateTasks.SnapshotDate.EQ(tApprovalTasks.SnapshotDate),
+					tDelegateTasks.AssigneeKind.EQ(userKind),
+					tDelegateTasks.UserID.EQ(mysql.Int32(delegateId)),
+				)),
+		).
+		WHERE(mysql.AND(
+			tApprovalTasks.AssigneeKind.EQ(userKind),
+			tApprovalTasks.UserID.EQ(mysql.Int32(userId)),
+			tApprovalTasks.Status.EQ(pending),
+			tDelegateTasks.ID.IS_NULL(),
+		)).
+		ORDER_BY(tApprovalTasks.ID.ASC()).
+		LIMIT(250)
+
+	taskIds := []int64{}
+	if err := stmt.QueryContext(ctx, tx, &taskIds); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return 0, err
+		}
+	}
+	if len(taskIds) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]mysql.Expression, len(taskIds))
+	for i, id := range taskIds {
+		ids[i] = mysql.Int64(id)
+	}
+
+	tTasks := table.FivenetDocumentsApprovalTasks
+	res, err := tTasks.
+		UPDATE().
+		SET(
+			tTasks.OriginalUserID.SET(
+				mysql.IntExp(mysql.COALESCE(tTasks.OriginalUserID, tTasks.UserID)),
+			),
+			tTasks.UserID.SET(mysql.Int32(delegateId)),
+			tTasks.DelegatedAt.SET(mysql.CURRENT_TIMESTAMP()),
+		).
+		WHERE(mysql.AND(
+			tTasks.ID.IN(ids...),
+			tTasks.Status.EQ(pending),
+		)).
+		LIMIT(int64(len(ids))).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,53 @@
+package documentsstore
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreApprovalDelegations(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	// Delegates that are absent themselves are skipped
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_job_colleague_props AS colleague_props`) + `(?s).*` + regexp.QuoteMeta(`delegate_props.user_id IS NULL`)).
+		WillReturnRows(sqlmock.NewRows([]string{"approval_delegation.user_id", "approval_delegation.delegate_id"}).AddRow(int32(3), int32(5)))
+
+	delegations, err := store.ListActiveApprovalDelegations(t.Context(), db)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	assert.Equal(t, int32(3), delegations[0].UserID)
+	assert.Equal(t, int32(5), delegations[0].DelegateID)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT approval_task.id AS "id"`)+`(?s).*`+regexp.QuoteMeta(`delegate_task.id IS NULL`)).
+		WithArgs(int32(1), int32(5), int32(1), int32(3), int32(1), int64(250)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(7)).AddRow(int64(8)))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_documents_approval_tasks`)+`(?s).*`+regexp.QuoteMeta(`original_user_id = COALESCE(fivenet_documents_approval_tasks.original_user_id, fivenet_documents_approval_tasks.user_id)`)).
+		WithArgs(int32(5), int64(7), int64(8), int32(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	affected, err := store.DelegateApprovalTasks(t.Context(), db, 3, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), affected)
+
+	// Nothing to update without pending tasks
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT approval_task.id AS "id"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	affected, err = store.DelegateApprovalTasks(t.Context(), db, 3, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), affected)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -69,6 +69,7 @@ func (s *Store) ListApprovals(
 			tApprovals.RevokedAt,
 
 			tStamp.ID,
+			tStamp.Name,
 			tStamp.SvgTemplate,
 
 			tUser.ID,
@@ -81,7 +82,7 @@ func (s *Store) ListApprovals(
 		FROM(
 			tApprovals.
 				LEFT_JOIN(tUser, tUser.ID.EQ(tApprovals.UserID)).
-				LEFT_JOIN(tStamp, tApprovals.ID.EQ(tStamp.ID)),
+				LEFT_JOIN(tStamp, tStamp.ID.EQ(tApprovals.StampID)),
 		).
 		WHERE(condition).
 		ORDER_BY(tApprovals.Status.ASC(), tApprovals.CreatedAt.DESC()).
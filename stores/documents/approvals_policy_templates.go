@@ -0,0 +1,306 @@
+package documentsstore
+
+import (
+	"context"
+	"errors"
+
+	documentsapproval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+func approvalPolicyTemplateColumns(
+	tPolicyTemplate *table.FivenetDocumentsApprovalPolicyTemplatesTable,
+) mysql.ProjectionList {
+	return mysql.ProjectionList{
+		tPolicyTemplate.ID,
+		tPolicyTemplate.CreatedAt,
+		tPolicyTemplate.UpdatedAt,
+		tPolicyTemplate.DeletedAt,
+		tPolicyTemplate.Job,
+		tPolicyTemplate.CreatorID,
+		tPolicyTemplate.Name,
+		tPolicyTemplate.Description,
+		tPolicyTemplate.CategoryID,
+		tPolicyTemplate.TemplateID,
+		tPolicyTemplate.TriggerState,
+		tPolicyTemplate.RuleKind,
+		tPolicyTemplate.OnEditBehavior,
+		tPolicyTemplate.RequiredCount,
+		tPolicyTemplate.SignatureRequired,
+		tPolicyTemplate.SelfApproveAllowed,
+		tPolicyTemplate.Stages,
+	}
+}
+
+// ListApprovalPolicyTemplates returns the job's approval policy templates, optionally filtered by
+// the category or template they are bound to.
+func (s *Store) ListApprovalPolicyTemplates(
+	ctx context.Context,
+	tx qrm.DB,
+	job string,
+	categoryId *int64,
+	templateId *int64,
+) ([]*documentsapproval.ApprovalPolicyTemplate, error) {
+	tPolicyTemplate := table.FivenetDocumentsApprovalPolicyTemplates.AS("approval_policy_template")
+
+	condition := mysql.AND(
+		tPolicyTemplate.Job.EQ(mysql.String(job)),
+		tPolicyTemplate.DeletedAt.IS_NULL(),
+	)
+	if categoryId != nil {
+		condition = condition.AND(tPolicyTemplate.CategoryID.EQ(mysql.Int64(*categoryId)))
+	}
+	if templateId != nil {
+		condition = condition.AND(tPolicyTemplate.TemplateID.EQ(mysql.Int64(*templateId)))
+	}
+
+	stmt := tPolicyTemplate.
+		SELECT(
+			approvalPolicyTemplateColumns(tPolicyTemplate),
+		).
+		FROM(tPolicyTemplate).
+		WHERE(condition).
+		ORDER_BY(tPolicyTemplate.ID.ASC())
+
+	dest := []*documentsapproval.ApprovalPolicyTemplate{}
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// GetApprovalPolicyTemplate returns the approval policy template, including deleted ones so
+// already started approvals can be completed. Returns nil if the template doesn't exist.
+func (s *Store) GetApprovalPolicyTemplate(
+	ctx context.Context,
+	tx qrm.DB,
+	id int64,
+) (*documentsapproval.ApprovalPolicyTemplate, error) {
+	tPolicyTemplate := table.FivenetDocumentsApprovalPolicyTemplates.AS("approval_policy_template")
+
+	stmt := tPolicyTemplate.
+		SELECT(
+			approvalPolicyTemplateColumns(tPolicyTemplate),
+		).
+		FROM(tPolicyTemplate).
+		WHERE(tPolicyTemplate.ID.EQ(mysql.Int64(id))).
+		LIMIT(1)
+
+	var dest documentsapproval.ApprovalPolicyTemplate
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if dest.GetId() == 0 {
+		return nil, nil
+	}
+
+	return &dest, nil
+}
+
+// FindApprovalPolicyTemplate returns the job's approval policy template for documents of the
+// category and template entering the trigger state (empty for publishing). Policy templates bound
+// to the document template take precedence over category bound ones.
+func (s *Store) FindApprovalPolicyTemplate(
+	ctx context.Context,
+	tx qrm.DB,
+	job string,
+	categoryId int64,
+	templateId int64,
+	triggerState string,
+) (*documentsapproval.ApprovalPolicyTemplate, error) {
+	if categoryId <= 0 && templateId <= 0 {
+		return nil, nil
+	}
+
+	tPolicyTemplate := table.FivenetDocumentsApprovalPolicyTemplates.AS("approval_policy_template")
+
+	condition := mysql.AND(
+		tPolicyTemplate.Job.EQ(mysql.String(job)),
+		tPolicyTemplate.DeletedAt.IS_NULL(),
+		mysql.OR(
+			tPolicyTemplate.TemplateID.EQ(mysql.Int64(templateId)),
+			mysql.AND(
+				tPolicyTemplate.TemplateID.IS_NULL(),
+				tPolicyTemplate.CategoryID.EQ(mysql.Int64(categoryId)),
+			),
+		),
+	)
+	if triggerState == "" {
+		condition = condition.AND(tPolicyTemplate.TriggerState.IS_NULL())
+	} else {
+		condition = condition.AND(tPolicyTemplate.TriggerState.EQ(mysql.String(triggerState)))
+	}
+
+	stmt := tPolicyTemplate.
+		SELECT(
+			approvalPolicyTemplateColumns(tPolicyTemplate),
+		).
+		FROM(tPolicyTemplate).
+		WHERE(condition).
+		ORDER_BY(
+			tPolicyTemplate.TemplateID.IS_NULL().ASC(),
+			tPolicyTemplate.ID.ASC(),
+		).
+		LIMIT(1)
+
+	var dest documentsapproval.ApprovalPolicyTemplate
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if dest.GetId() == 0 {
+		return nil, nil
+	}
+
+	return &dest, nil
+}
+
+func (s *Store) CreateApprovalPolicyTemplate(
+	ctx context.Context,
+	tx qrm.DB,
+	policyTemplate *documentsapproval.ApprovalPolicyTemplate,
+	userInfo *userinfo.UserInfo,
+) (int64, error) {
+	tPolicyTemplate := table.FivenetDocumentsApprovalPolicyTemplates
+	res, err := tPolicyTemplate.
+		INSERT(
+			tPolicyTemplate.Job,
+			tPolicyTemplate.CreatorID,
+			tPolicyTemplate.Name,
+			tPolicyTemplate.Description,
+			tPolicyTemplate.CategoryID,
+			tPolicyTemplate.TemplateID,
+			tPolicyTemplate.TriggerState,
+			tPolicyTemplate.RuleKind,
+			tPolicyTemplate.OnEditBehavior,
+			tPolicyTemplate.RequiredCount,
+			tPolicyTemplate.SignatureRequired,
+			tPolicyTemplate.SelfApproveAllowed,
+			tPolicyTemplate.Stages,
+		).
+		VALUES(
+			userInfo.GetJob(),
+			userInfo.GetUserId(),
+			policyTemplate.GetName(),
+			policyTemplate.Description,
+			policyTemplate.CategoryId,
+			policyTemplate.TemplateId,
+			policyTemplate.TriggerState,
+			int32(policyTemplate.GetRuleKind()),
+			int32(policyTemplate.GetOnEditBehavior()),
+			policyTemplate.RequiredCount,
+			policyTemplate.GetSignatureRequired(),
+			policyTemplate.GetSelfApproveAllowed(),
+			policyTemplate.GetStages(),
+		).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+func (s *Store) UpdateApprovalPolicyTemplate(
+	ctx context.Context,
+	tx qrm.DB,
+	policyTemplate *documentsapproval.ApprovalPolicyTemplate,
+	userInfo *userinfo.UserInfo,
+) error {
+	tPolicyTemplate := table.FivenetDocumentsApprovalPolicyTemplates
+	stmt := tPolicyTemplate.
+		UPDATE(
+			tPolicyTemplate.Name,
+			tPolicyTemplate.Description,
+			tPolicyTemplate.CategoryID,
+			tPolicyTemplate.TemplateID,
+			tPolicyTemplate.TriggerState,
+			tPolicyTemplate.RuleKind,
+			tPolicyTemplate.OnEditBehavior,
+			tPolicyTemplate.RequiredCount,
+			tPolicyTemplate.SignatureRequired,
+			tPolicyTemplate.SelfApproveAllowed,
+			tPolicyTemplate.Stages,
+		).
+		SET(
+			policyTemplate.GetName(),
+			policyTemplate.Description,
+			policyTemplate.CategoryId,
+			policyTemplate.TemplateId,
+			policyTemplate.TriggerState,
+			int32(policyTemplate.GetRuleKind()),
+			int32(policyTemplate.GetOnEditBehavior()),
+			policyTemplate.RequiredCount,
+			policyTemplate.GetSignatureRequired(),
+			policyTemplate.GetSelfApproveAllowed(),
+			policyTemplate.GetStages(),
+		).
+		WHERE(mysql.AND(
+			tPolicyTemplate.ID.EQ(mysql.Int64(policyTemplate.GetId())),
+			tPolicyTemplate.Job.EQ(mysql.String(userInfo.GetJob())),
+			tPolicyTemplate.DeletedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+func (s *Store) DeleteApprovalPolicyTemplate(
+	ctx context.Context,
+	tx qrm.DB,
+	id int64,
+	userInfo *userinfo.UserInfo,
+) error {
+	tPolicyTemplate := table.FivenetDocumentsApprovalPolicyTemplates
+	stmt := tPolicyTemplate.
+		UPDATE(
+			tPolicyTemplate.DeletedAt,
+		).
+		SET(
+			tPolicyTemplate.DeletedAt.SET(mysql.CURRENT_TIMESTAMP()),
+		).
+		WHERE(mysql.AND(
+			tPolicyTemplate.ID.EQ(mysql.Int64(id)),
+			tPolicyTemplate.Job.EQ(mysql.String(userInfo.GetJob())),
+			tPolicyTemplate.DeletedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+// UpdateApprovalPolicyStage sets the current policy template stage of the document's approval
+// policy.
+func (s *Store) UpdateApprovalPolicyStage(
+	ctx context.Context,
+	tx qrm.DB,
+	documentID int64,
+	stage int32,
+) error {
+	tApprovalPolicy := table.FivenetDocumentsApprovalPolicies
+	stmt := tApprovalPolicy.
+		UPDATE(
+			tApprovalPolicy.CurrentStage,
+		).
+		SET(
+			stage,
+		).
+		WHERE(tApprovalPolicy.DocumentID.EQ(mysql.Int64(documentID))).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
@@ -0,0 +1,124 @@
+package documentsstore
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	documentsapproval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStoreApprovalPolicyTemplatesReadWrite(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+	userInfo := &userinfo.UserInfo{UserId: 3, Job: "police"}
+	columns := []string{
+		"approval_policy_template.id",
+		"approval_policy_template.job",
+		"approval_policy_template.name",
+		"approval_policy_template.category_id",
+		"approval_policy_template.stages",
+	}
+	stages := `{"stages":[{"assignees":[{"minimumGrade":5,"slots":1}]}]}`
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents_approval_policy_templates AS approval_policy_template`)+`(?s).*`+regexp.QuoteMeta(`approval_policy_template.category_id = ?`)).
+		WithArgs("police", int64(4)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(int64(1), "police", "Reports", int64(4), stages))
+
+	policyTemplates, err := store.ListApprovalPolicyTemplates(
+		t.Context(),
+		db,
+		"police",
+		proto.Int64(4),
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, policyTemplates, 1)
+	assert.Equal(t, int64(4), policyTemplates[0].GetCategoryId())
+	require.Len(t, policyTemplates[0].GetStages().GetStages(), 1)
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_documents_approval_policy_templates`)).
+		WithArgs("police", int32(3), "Reports", nil, int64(4), nil, nil, int32(1), int32(1), nil, false, false, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+
+	policyTemplate := &documentsapproval.ApprovalPolicyTemplate{
+		Name:           "Reports",
+		CategoryId:     proto.Int64(4),
+		RuleKind:       documentsapproval.ApprovalRuleKind_APPROVAL_RULE_KIND_REQUIRE_ALL,
+		OnEditBehavior: documentsapproval.OnEditBehavior_ON_EDIT_BEHAVIOR_KEEP_PROGRESS,
+		Stages:         policyTemplates[0].GetStages(),
+	}
+	lastID, err := store.CreateApprovalPolicyTemplate(t.Context(), db, policyTemplate, userInfo)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), lastID)
+
+	policyTemplate.Id = lastID
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_documents_approval_policy_templates`)+`(?s).*`+regexp.QuoteMeta(`deleted_at IS NULL`)).
+		WithArgs("Reports", nil, int64(4), nil, nil, int32(1), int32(1), nil, false, false, sqlmock.AnyArg(), int64(2), "police", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(
+		t,
+		store.UpdateApprovalPolicyTemplate(t.Context(), db, policyTemplate, userInfo),
+	)
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_documents_approval_policy_templates`)+`(?s).*`+regexp.QuoteMeta(`deleted_at = CURRENT_TIMESTAMP`)).
+		WithArgs(int64(2), "police", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, store.DeleteApprovalPolicyTemplate(t.Context(), db, 2, userInfo))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreFindApprovalPolicyTemplate(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+	columns := []string{
+		"approval_policy_template.id",
+		"approval_policy_template.job",
+		"approval_policy_template.template_id",
+		"approval_policy_template.trigger_state",
+	}
+
+	// Template bound policy templates are preferred
+	mock.ExpectQuery(regexp.QuoteMeta(`approval_policy_template.trigger_state = ?`)+`(?s).*`+regexp.QuoteMeta(`ORDER BY approval_policy_template.template_id IS NULL ASC`)).
+		WithArgs("police", int64(2), int64(4), "review", int64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(int64(5), "police", int64(2), "review"))
+
+	policyTemplate, err := store.FindApprovalPolicyTemplate(t.Context(), db, "police", 4, 2, "review")
+	require.NoError(t, err)
+	require.NotNil(t, policyTemplate)
+	assert.Equal(t, int64(5), policyTemplate.GetId())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`approval_policy_template.trigger_state IS NULL`)).
+		WithArgs("police", int64(0), int64(4), int64(1)).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	policyTemplate, err = store.FindApprovalPolicyTemplate(t.Context(), db, "police", 4, 0, "")
+	require.NoError(t, err)
+	assert.Nil(t, policyTemplate)
+
+	// Documents without category and template never match
+	policyTemplate, err = store.FindApprovalPolicyTemplate(t.Context(), db, "police", 0, 0, "")
+	require.NoError(t, err)
+	assert.Nil(t, policyTemplate)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -44,7 +44,11 @@ func (s *Store) CreateApprovalTasks(
 							),
 						),
 					),
-					tApprovalTasks.UserID.EQ(mysql.Int32(seed.GetUserId())),
+					// Tasks delegated to an absent user's colleague count as well
+					mysql.OR(
+						tApprovalTasks.UserID.EQ(mysql.Int32(seed.GetUserId())),
+						tApprovalTasks.OriginalUserID.EQ(mysql.Int32(seed.GetUserId())),
+					),
 				)).
 				LIMIT(1).
 				QueryContext(ctx, tx, &cnt); err != nil {
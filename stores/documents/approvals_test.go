@@ -39,7 +39,7 @@ func TestStoreApprovalPolicyHelpers(t *testing.T) {
 	require.Nil(t, pol)
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_documents_approval_policies`)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	require.NoError(
 		t,
@@ -100,7 +100,7 @@ func TestStoreApprovalTaskWrites(t *testing.T) {
 	}
 
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(fivenet_documents_approval_tasks.id) AS "C" FROM fivenet_documents_approval_tasks`)+`(?s).*`+regexp.QuoteMeta(`fivenet_documents_approval_tasks.document_id = ?`)+`(?s).*`+regexp.QuoteMeta(`fivenet_documents_approval_tasks.user_id = ?`)).
-		WithArgs(int64(42), sqlmock.AnyArg(), int32(1), int32(9), int64(1)).
+		WithArgs(int64(42), sqlmock.AnyArg(), int32(1), int32(9), int32(9), int64(1)).
 		WillReturnRows(sqlmock.NewRows([]string{"C"}).AddRow(int32(0)))
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_documents_approval_tasks`)).
@@ -171,3 +171,31 @@ func TestStoreRecomputeApprovalPolicyTx(t *testing.T) {
 	require.NoError(t, store.RecomputeApprovalPolicyTx(t.Context(), db, 42, snapDate))
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestStoreListApprovalsJoinsStamp(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents_approvals AS approval`)).
+		WithArgs(int64(42)).
+		WillReturnRows(sqlmock.NewRows([]string{"data_count.total"}).AddRow(int64(1)))
+	mock.ExpectQuery(regexp.QuoteMeta(`stamp.id = approval.stamp_id`)).
+		WithArgs(int64(42)).
+		WillReturnRows(sqlmock.NewRows([]string{"approval.id", "stamp.id", "stamp.name"}).
+			AddRow(int64(1), int64(5), "Approved"))
+
+	count, approvals, err := store.ListApprovals(
+		t.Context(),
+		ListApprovalsQuery{DocumentID: 42, Pagination: &resourcesdatabase.PaginationRequest{}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count.Total)
+	require.Len(t, approvals, 1)
+	assert.Equal(t, "Approved", approvals[0].GetStamp().GetName())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,279 @@
+package documentsstore
+
+import (
+	"context"
+	"errors"
+
+	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
+	documentsbulk "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/bulk"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+func bulkJobColumns(
+	tBulkJob *table.FivenetDocumentsBulkJobsTable,
+	tCreator *table.FivenetUserTable,
+) dbutils.Columns {
+	return dbutils.Columns{
+		tBulkJob.ID,
+		tBulkJob.CreatedAt,
+		tBulkJob.UpdatedAt,
+		tBulkJob.StartedAt,
+		tBulkJob.CompletedAt,
+		tBulkJob.Job,
+		tBulkJob.CreatorID,
+		tBulkJob.Status,
+		tBulkJob.Selector,
+		tBulkJob.Operation,
+		tBulkJob.TotalCount,
+		tBulkJob.ProcessedCount,
+		tBulkJob.SucceededCount,
+		tBulkJob.FailedCount,
+		tBulkJob.LastDocumentID,
+		tBulkJob.Error,
+		tCreator.ID,
+		tCreator.Job,
+		tCreator.JobGrade,
+		tCreator.Firstname,
+		tCreator.Lastname,
+	}
+}
+
+func (s *Store) ListBulkJobs(
+	ctx context.Context,
+	q ListBulkJobsQuery,
+) (resourcesdatabase.DataCount, []*documentsbulk.BulkJob, error) {
+	tBulkJob := table.FivenetDocumentsBulkJobs.AS("bulk_job")
+
+	condition := tBulkJob.Job.EQ(mysql.String(q.Job))
+	if q.CreatorID != nil {
+		condition = condition.AND(tBulkJob.CreatorID.EQ(mysql.Int32(*q.CreatorID)))
+	}
+
+	countStmt := tBulkJob.
+		SELECT(mysql.COUNT(tBulkJob.ID).AS("data_count.total")).
+		FROM(tBulkJob).
+		WHERE(condition)
+
+	var count resourcesdatabase.DataCount
+	if err := countStmt.QueryContext(ctx, s.db, &count); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return resourcesdatabase.DataCount{}, nil, err
+		}
+	}
+
+	_, limit := q.Pagination.GetResponseWithPageSize(count.Total, 10)
+	if count.Total <= 0 {
+		return count, []*documentsbulk.BulkJob{}, nil
+	}
+
+	tCreator := table.FivenetUser.AS("creator")
+	columns := bulkJobColumns(tBulkJob, tCreator)
+
+	stmt := tBulkJob.
+		SELECT(
+			columns[0],
+			columns[1:]...,
+		).
+		FROM(
+			tBulkJob.
+				LEFT_JOIN(tCreator,
+					tCreator.ID.EQ(tBulkJob.CreatorID),
+				),
+		).
+		WHERE(condition).
+		OFFSET(q.Pagination.GetOffset()).
+		ORDER_BY(tBulkJob.ID.DESC()).
+		LIMIT(limit)
+
+	var jobs []*documentsbulk.BulkJob
+	if err := stmt.QueryContext(ctx, s.db, &jobs); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return resourcesdatabase.DataCount{}, nil, err
+		}
+	}
+
+	return count, jobs, nil
+}
+
+// GetBulkJob returns the oldest bulk job matching the condition, nil if there is no matching job.
+func (s *Store) GetBulkJob(
+	ctx context.Context,
+	db qrm.DB,
+	condition mysql.BoolExpression,
+) (*documentsbulk.BulkJob, error) {
+	tBulkJob := table.FivenetDocumentsBulkJobs.AS("bulk_job")
+	tCreator := table.FivenetUser.AS("creator")
+	columns := bulkJobColumns(tBulkJob, tCreator)
+
+	stmt := tBulkJob.
+		SELECT(
+			columns[0],
+			columns[1:]...,
+		).
+		FROM(
+			tBulkJob.
+				LEFT_JOIN(tCreator,
+					tCreator.ID.EQ(tBulkJob.CreatorID),
+				),
+		).
+		WHERE(condition).
+		ORDER_BY(tBulkJob.ID.ASC()).
+		LIMIT(1)
+
+	job := &documentsbulk.BulkJob{}
+	if err := stmt.QueryContext(ctx, db, job); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if job.GetId() == 0 {
+		return nil, nil
+	}
+
+	return job, nil
+}
+
+func (s *Store) CreateBulkJob(
+	ctx context.Context,
+	tx qrm.DB,
+	job *documentsbulk.BulkJob,
+) (int64, error) {
+	tBulkJob := table.FivenetDocumentsBulkJobs
+	stmt := tBulkJob.
+		INSERT(
+			tBulkJob.Job,
+			tBulkJob.CreatorID,
+			tBulkJob.Status,
+			tBulkJob.Selector,
+			tBulkJob.Operation,
+		).
+		VALUES(
+			job.GetJob(),
+			job.GetCreatorId(),
+			int32(documentsbulk.BulkJobStatus_BULK_JOB_STATUS_PENDING),
+			job.GetSelector(),
+			job.GetOperation(),
+		)
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	lastId, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return lastId, nil
+}
+
+// UpdateBulkJobProgress stores the status, counters and timestamps of the bulk job.
+func (s *Store) UpdateBulkJobProgress(
+	ctx context.Context,
+	tx qrm.DB,
+	job *documentsbulk.BulkJob,
+) error {
+	tBulkJob := table.FivenetDocumentsBulkJobs
+	stmt := tBulkJob.
+		UPDATE(
+			tBulkJob.Status,
+			tBulkJob.StartedAt,
+			tBulkJob.CompletedAt,
+			tBulkJob.TotalCount,
+			tBulkJob.ProcessedCount,
+			tBulkJob.SucceededCount,
+			tBulkJob.FailedCount,
+			tBulkJob.LastDocumentID,
+			tBulkJob.Error,
+		).
+		SET(
+			int32(job.GetStatus()),
+			dbutils.TimestampToMySQL(job.GetStartedAt()),
+			dbutils.TimestampToMySQL(job.GetCompletedAt()),
+			job.GetTotalCount(),
+			job.GetProcessedCount(),
+			job.GetSucceededCount(),
+			job.GetFailedCount(),
+			job.GetLastDocumentId(),
+			job.Error,
+		).
+		WHERE(tBulkJob.ID.EQ(mysql.Int64(job.GetId()))).
+		LIMIT(1)
+
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListDocumentIDs returns the IDs of the (non-deleted) documents matching the list query that are
+// visible to the user, ordered by ID and starting after the given document ID.
+func (s *Store) ListDocumentIDs(
+	ctx context.Context,
+	q ListQuery,
+	afterId int64,
+) ([]int64, error) {
+	tDocument := table.FivenetDocuments
+
+	visibleIDs := s.subjectAccess.VisibleIDsByConditionQuery(
+		q.UserInfo,
+		int32(documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW),
+		false,
+		buildListDocumentsCondition(tDocument, q).
+			AND(tDocument.ID.GT(mysql.Int64(afterId))),
+	)
+	docID := mysql.IntegerColumn("id").From(visibleIDs.Table)
+
+	var stmt mysql.Statement = mysql.
+		SELECT(docID.AS("id")).
+		FROM(visibleIDs.Table).
+		ORDER_BY(docID.ASC()).
+		LIMIT(q.Limit)
+	if len(visibleIDs.CTEs) > 0 {
+		stmt = mysql.WITH(visibleIDs.CTEs...)(stmt)
+	}
+
+	ids := []int64{}
+	if err := stmt.QueryContext(ctx, s.db, &ids); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// CountDocuments counts the (non-deleted) documents matching the list query that are visible to
+// the user.
+func (s *Store) CountDocuments(ctx context.Context, q ListQuery) (int64, error) {
+	visibleIDs := s.subjectAccess.VisibleIDsByConditionQuery(
+		q.UserInfo,
+		int32(documentsaccess.AccessLevel_ACCESS_LEVEL_VIEW),
+		false,
+		buildListDocumentsCondition(table.FivenetDocuments, q),
+	)
+	docID := mysql.IntegerColumn("id").From(visibleIDs.Table)
+
+	var stmt mysql.Statement = mysql.
+		SELECT(mysql.COUNT(docID).AS("data_count.total")).
+		FROM(visibleIDs.Table)
+	if len(visibleIDs.CTEs) > 0 {
+		stmt = mysql.WITH(visibleIDs.CTEs...)(stmt)
+	}
+
+	var count resourcesdatabase.DataCount
+	if err := stmt.QueryContext(ctx, s.db, &count); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return 0, err
+		}
+	}
+
+	return count.Total, nil
+}
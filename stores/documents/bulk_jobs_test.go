@@ -0,0 +1,134 @@
+package documentsstore
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	documentsbulk "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/bulk"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreListBulkJobsEmpty(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+	creatorId := int32(3)
+
+	mock.ExpectQuery(`(?s).*FROM fivenet_documents_bulk_jobs AS bulk_job.*bulk_job.creator_id = \?.*`).
+		WillReturnRows(sqlmock.NewRows([]string{"data_count.total"}).AddRow(int64(0)))
+
+	count, items, err := store.ListBulkJobs(t.Context(), ListBulkJobsQuery{
+		Job:        "police",
+		CreatorID:  &creatorId,
+		Pagination: &resourcesdatabase.PaginationRequest{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count.Total)
+	assert.Empty(t, items)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreGetBulkJob(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+	tBulkJob := table.FivenetDocumentsBulkJobs.AS("bulk_job")
+	condition := tBulkJob.ID.EQ(mysql.Int64(5))
+
+	mock.ExpectQuery(
+		`(?s).*FROM fivenet_documents_bulk_jobs AS bulk_job.*ORDER BY bulk_job.id ASC.*`,
+	).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"bulk_job.id",
+			"bulk_job.job",
+			"bulk_job.creator_id",
+			"bulk_job.status",
+			"bulk_job.selector",
+			"bulk_job.operation",
+			"bulk_job.total_count",
+			"bulk_job.processed_count",
+		}).AddRow(
+			int64(5), "police", int32(3), int32(2),
+			`{"documentIds":["1","2"]}`, `{"toggle":{"closed":true}}`,
+			int64(2), int64(1),
+		))
+
+	job, err := store.GetBulkJob(t.Context(), db, condition)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, int64(5), job.GetId())
+	assert.Equal(t, documentsbulk.BulkJobStatus_BULK_JOB_STATUS_RUNNING, job.GetStatus())
+	assert.Equal(t, []int64{1, 2}, job.GetSelector().GetDocumentIds())
+	assert.True(t, job.GetOperation().GetToggle().GetClosed())
+	assert.Equal(t, int64(1), job.GetProcessedCount())
+
+	mock.ExpectQuery(`(?s).*FROM fivenet_documents_bulk_jobs AS bulk_job.*`).
+		WillReturnRows(sqlmock.NewRows([]string{"bulk_job.id"}))
+
+	job, err = store.GetBulkJob(t.Context(), db, condition)
+	require.NoError(t, err)
+	assert.Nil(t, job)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreCreateAndUpdateBulkJob(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	mock.ExpectExec(`(?s).*INSERT INTO fivenet_documents_bulk_jobs.*`).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	job := &documentsbulk.BulkJob{
+		Job:       "police",
+		CreatorId: 3,
+		Selector:  &documentsbulk.BulkSelector{DocumentIds: []int64{1, 2}},
+		Operation: &documentsbulk.BulkOperation{
+			Operation: &documentsbulk.BulkOperation_Toggle{
+				Toggle: &documentsbulk.BulkToggleOperation{Closed: true},
+			},
+		},
+	}
+	id, err := store.CreateBulkJob(t.Context(), db, job)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), id)
+
+	mock.ExpectExec(`(?s).*UPDATE fivenet_documents_bulk_jobs.*WHERE fivenet_documents_bulk_jobs.id = \?.*`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	job.Id = id
+	job.Status = documentsbulk.BulkJobStatus_BULK_JOB_STATUS_COMPLETED
+	job.StartedAt = timestamp.Now()
+	job.CompletedAt = timestamp.Now()
+	job.TotalCount = 2
+	job.ProcessedCount = 2
+	job.SucceededCount = 2
+	job.LastDocumentId = 2
+	require.NoError(t, store.UpdateBulkJobProgress(t.Context(), db, job))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -35,6 +35,8 @@ func (s *Store) ListCategories(
 			tCategory.Job,
 			tCategory.Color,
 			tCategory.Icon,
+			tCategory.StateMachine,
+			tCategory.RetentionPolicy,
 		).
 		FROM(tCategory).
 		WHERE(condition).
@@ -65,6 +67,8 @@ func (s *Store) GetCategory(
 			tCategory.Job,
 			tCategory.Color,
 			tCategory.Icon,
+			tCategory.StateMachine,
+			tCategory.RetentionPolicy,
 		).
 		FROM(tCategory).
 		WHERE(mysql.AND(
@@ -87,6 +91,41 @@ func (s *Store) GetCategory(
 	return &dest, nil
 }
 
+// GetCategoryWithStateMachine returns the category regardless of the user's job, nil is returned
+// if the category doesn't exist or has no active state machine.
+func (s *Store) GetCategoryWithStateMachine(
+	ctx context.Context,
+	tx qrm.DB,
+	categoryId int64,
+) (*documentscategory.Category, error) {
+	tCategory := table.FivenetDocumentsCategories.AS("category")
+	stmt := tCategory.
+		SELECT(
+			tCategory.ID,
+			tCategory.Job,
+			tCategory.StateMachine,
+		).
+		FROM(tCategory).
+		WHERE(mysql.AND(
+			tCategory.ID.EQ(mysql.Int64(categoryId)),
+			tCategory.DeletedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	var dest documentscategory.Category
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if dest.GetId() == 0 || !dest.GetStateMachine().IsActive() {
+		return nil, nil
+	}
+
+	return &dest, nil
+}
+
 func (s *Store) CreateCategory(
 	ctx context.Context,
 	tx qrm.DB,
@@ -101,6 +140,8 @@ func (s *Store) CreateCategory(
 			tCategory.Job,
 			tCategory.Color,
 			tCategory.Icon,
+			tCategory.StateMachine,
+			tCategory.RetentionPolicy,
 		).
 		VALUES(
 			category.GetName(),
@@ -108,6 +149,8 @@ func (s *Store) CreateCategory(
 			userInfo.GetJob(),
 			category.Color,
 			category.Icon,
+			category.GetStateMachine(),
+			category.GetRetentionPolicy(),
 		).
 		ExecContext(ctx, tx)
 	if err != nil {
@@ -131,6 +174,8 @@ func (s *Store) UpdateCategory(
 			tCategory.Job,
 			tCategory.Color,
 			tCategory.Icon,
+			tCategory.StateMachine,
+			tCategory.RetentionPolicy,
 		).
 		SET(
 			category.GetName(),
@@ -138,6 +183,8 @@ func (s *Store) UpdateCategory(
 			userInfo.GetJob(),
 			category.Color,
 			category.Icon,
+			category.GetStateMachine(),
+			category.GetRetentionPolicy(),
 		).
 		WHERE(mysql.AND(
 			tCategory.ID.EQ(mysql.Int64(category.GetId())),
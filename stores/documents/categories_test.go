@@ -43,7 +43,7 @@ func TestStoreCategoriesReadWrite(t *testing.T) {
 	assert.Equal(t, int64(1), category.GetId())
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_documents_categories`)).
-		WithArgs("Beta", nil, "doj", nil, nil).
+		WithArgs("Beta", nil, "doj", nil, nil, nil, nil).
 		WillReturnResult(sqlmock.NewResult(2, 1))
 	lastID, err := store.CreateCategory(
 		t.Context(),
@@ -55,7 +55,7 @@ func TestStoreCategoriesReadWrite(t *testing.T) {
 	assert.Equal(t, int64(2), lastID)
 
 	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_documents_categories SET`)).
-		WithArgs("Beta", nil, "doj", nil, nil, int64(2), "doj", int64(1)).
+		WithArgs("Beta", nil, "doj", nil, nil, nil, nil, int64(2), "doj", int64(1)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	require.NoError(
 		t,
@@ -75,3 +75,48 @@ func TestStoreCategoriesReadWrite(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestStoreGetCategoryWithStateMachine(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	query := regexp.QuoteMeta(`FROM fivenet_documents_categories AS category`) + `(?s).*` +
+		regexp.QuoteMeta(`category.deleted_at IS NULL`)
+	columns := []string{"category.id", "category.job", "category.state_machine"}
+
+	mock.ExpectQuery(query).
+		WithArgs(int64(1), int64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			int64(1),
+			"doj",
+			`{"enabled":true,"initialState":"open","states":[{"name":"open"}]}`,
+		))
+
+	category, err := store.GetCategoryWithStateMachine(t.Context(), db, 1)
+	require.NoError(t, err)
+	require.NotNil(t, category)
+	assert.Equal(t, "doj", category.GetJob())
+	assert.Equal(t, "open", category.GetStateMachine().GetInitialState())
+
+	// Disabled state machines are treated as if the category has none
+	mock.ExpectQuery(query).
+		WithArgs(int64(2), int64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			int64(2),
+			"doj",
+			`{"enabled":false,"initialState":"open","states":[{"name":"open"}]}`,
+		))
+
+	category, err = store.GetCategoryWithStateMachine(t.Context(), db, 2)
+	require.NoError(t, err)
+	assert.Nil(t, category)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
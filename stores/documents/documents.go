@@ -4,12 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
 
 	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
 	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
 	resourcesdocuments "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents"
 	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
 	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
 	documentspins "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/pins"
 	documentstemplates "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/templates"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
@@ -65,6 +68,9 @@ func (s *Store) List(
 		tDocumentShort.Closed.AS("meta.closed"),
 		tDocumentShort.Draft.AS("meta.draft"),
 		tDocumentShort.Public.AS("meta.public"),
+		tDocumentShort.Locked.AS("meta.locked"),
+		tDocumentShort.LegalHold.AS("meta.legal_hold"),
+		tDocumentShort.ArchivedAt.AS("meta.archived_at"),
 		tDMeta.DocumentID,
 		tDMeta.Approved,
 		tDMeta.ApRequiredTotal,
@@ -238,6 +244,7 @@ func (s *Store) Get(ctx context.Context, q GetQuery) (*resourcesdocuments.Docume
 		tDCategory.Job,
 		tDCategory.Color,
 		tDCategory.Icon,
+		tDCategory.StateMachine,
 		tDocument.Title,
 		tDocument.ContentType,
 		tDocument.CreatorID,
@@ -252,6 +259,9 @@ func (s *Store) Get(ctx context.Context, q GetQuery) (*resourcesdocuments.Docume
 		tDocument.Closed.AS("meta.closed"),
 		tDocument.Draft.AS("meta.draft"),
 		tDocument.Public.AS("meta.public"),
+		tDocument.Locked.AS("meta.locked"),
+		tDocument.LegalHold.AS("meta.legal_hold"),
+		tDocument.ArchivedAt.AS("meta.archived_at"),
 		tDocument.TemplateID,
 		tDPins.State,
 		tDPins.Job,
@@ -767,10 +777,68 @@ func buildListDocumentsCondition(
 	if q.OnlyDrafts != nil {
 		condition = condition.AND(document.Draft.EQ(mysql.Bool(*q.OnlyDrafts)))
 	}
+	for _, filter := range q.FormFields {
+		condition = condition.AND(buildFormFieldCondition(document, filter))
+	}
 
 	return condition
 }
 
+// buildFormFieldCondition filters on a form field value stored in the document data, the JSON
+// path uses the (protojson) field names of the value.
+func buildFormFieldCondition(
+	document *table.FivenetDocumentsTable,
+	filter *documentsforms.FormFieldFilter,
+) mysql.BoolExpression {
+	value := func(name string) mysql.StringExpression {
+		path := fmt.Sprintf("$.fields.%q.%s", filter.GetKey(), name)
+		return dbutils.JSON_UNQUOTE_EXTRACT(document.Data, mysql.String(path))
+	}
+
+	switch f := filter.GetFilter().(type) {
+	case *documentsforms.FormFieldFilter_Text:
+		return value("text").LIKE(mysql.String(dbutils.PrepareForLikeSearch(f.Text)))
+
+	case *documentsforms.FormFieldFilter_Number:
+		// A plain DECIMAL cast would drop the fractional part
+		number := mysql.FloatExp(mysql.CAST(value("number")).AS("DECIMAL(20, 4)"))
+		condition := number.IS_NOT_NULL()
+		if f.Number.Min != nil {
+			condition = condition.AND(number.GT_EQ(mysql.Float(f.Number.GetMin())))
+		}
+		if f.Number.Max != nil {
+			condition = condition.AND(number.LT_EQ(mysql.Float(f.Number.GetMax())))
+		}
+		return condition
+
+	case *documentsforms.FormFieldFilter_Date:
+		// Dates are stored as `YYYY-MM-DD` so they can be compared as strings
+		date := value("date")
+		condition := date.IS_NOT_NULL()
+		if f.Date.From != nil {
+			condition = condition.AND(date.GT_EQ(mysql.String(f.Date.GetFrom())))
+		}
+		if f.Date.To != nil {
+			condition = condition.AND(date.LT_EQ(mysql.String(f.Date.GetTo())))
+		}
+		return condition
+
+	case *documentsforms.FormFieldFilter_UserId:
+		return value("userId").EQ(mysql.String(strconv.FormatInt(int64(f.UserId), 10)))
+
+	case *documentsforms.FormFieldFilter_Plate:
+		return value("plate").EQ(mysql.String(f.Plate))
+
+	case *documentsforms.FormFieldFilter_LawId:
+		return value("lawId").EQ(mysql.String(strconv.FormatInt(f.LawId, 10)))
+
+	case *documentsforms.FormFieldFilter_Option:
+		return value("option").EQ(mysql.String(f.Option))
+	}
+
+	return mysql.Bool(true)
+}
+
 func buildListOrder(
 	sort *resourcesdatabase.Sort,
 	title mysql.ColumnString,
@@ -864,3 +932,30 @@ func (s *Store) ToggleDocument(
 	}
 	return nil
 }
+
+// UpdateDocumentState sets the (state machine) state and content lock of the document.
+func (s *Store) UpdateDocumentState(
+	ctx context.Context,
+	tx qrm.DB,
+	documentId int64,
+	state string,
+	locked bool,
+) error {
+	tDocument := table.FivenetDocuments
+	stmt := tDocument.
+		UPDATE(
+			tDocument.State,
+			tDocument.Locked,
+		).
+		SET(
+			state,
+			locked,
+		).
+		WHERE(
+			tDocument.ID.EQ(mysql.Int64(documentId)),
+		).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
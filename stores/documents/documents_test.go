@@ -8,11 +8,13 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
 	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
 	usershort "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/users/short"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestStoreListAppliesFiltersAndSortFallback(t *testing.T) {
@@ -71,6 +73,52 @@ func TestStoreListAppliesFiltersAndSortFallback(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestStoreListFiltersFormFields(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	query := ListQuery{
+		FormFields: []*documentsforms.FormFieldFilter{
+			{
+				Key:    "suspect",
+				Filter: &documentsforms.FormFieldFilter_UserId{UserId: 5},
+			},
+			{
+				Key: "fine",
+				Filter: &documentsforms.FormFieldFilter_Number{
+					Number: &documentsforms.NumberRange{Min: proto.Float64(100)},
+				},
+			},
+		},
+		Limit: 20,
+		UserInfo: &userinfo.UserInfo{
+			UserId:    3,
+			Job:       "doj",
+			JobGrade:  16,
+			Superuser: true,
+		},
+	}
+
+	expectedQuery := regexp.QuoteMeta(`FROM fivenet_documents AS document_short`) +
+		`(?s).*` + regexp.QuoteMeta(`JSON_UNQUOTE(JSON_EXTRACT(document_short.data, ?))) = ?`) +
+		`(?s).*` + regexp.QuoteMeta(`AS DECIMAL(20, 4)) >= ?`)
+
+	mock.ExpectQuery(expectedQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	docs, err := store.List(t.Context(), query)
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestStoreListUsesAclBranchesForNonSuperuser(t *testing.T) {
 	t.Parallel()
 
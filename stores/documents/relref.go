@@ -173,6 +173,121 @@ func (s *Store) DeleteDocumentReference(ctx context.Context, tx qrm.DB, id int64
 	return err
 }
 
+func (s *Store) GetDocumentDispatchTimeline(
+	ctx context.Context,
+	id int64,
+) (*documentsreferences.DocumentDispatchTimeline, error) {
+	tTimeline := table.FivenetDocumentsDispatchTimelines.AS("document_dispatch_timeline")
+	stmt := tTimeline.
+		SELECT(
+			tTimeline.ID,
+			tTimeline.CreatedAt,
+			tTimeline.DocumentID,
+			tTimeline.DispatchID,
+			tTimeline.CreatorID,
+		).
+		FROM(tTimeline).
+		WHERE(mysql.AND(
+			tTimeline.ID.EQ(mysql.Int64(id)),
+			tTimeline.DeletedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	var dest documentsreferences.DocumentDispatchTimeline
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return &dest, nil
+}
+
+func (s *Store) ListDocumentDispatchTimelines(
+	ctx context.Context,
+	documentID int64,
+) ([]*documentsreferences.DocumentDispatchTimeline, error) {
+	tTimeline := table.FivenetDocumentsDispatchTimelines.AS("document_dispatch_timeline")
+	tCreator := table.FivenetUser.AS("creator")
+
+	stmt := tTimeline.
+		SELECT(
+			tTimeline.ID,
+			tTimeline.CreatedAt,
+			tTimeline.DocumentID,
+			tTimeline.DispatchID,
+			tTimeline.CreatorID,
+			tTimeline.Timeline,
+			tCreator.ID,
+			tCreator.Job,
+			tCreator.JobGrade,
+			tCreator.Firstname,
+			tCreator.Lastname,
+			tCreator.Dateofbirth,
+		).
+		FROM(
+			tTimeline.
+				LEFT_JOIN(tCreator,
+					tTimeline.CreatorID.EQ(tCreator.ID),
+				),
+		).
+		WHERE(mysql.AND(
+			tTimeline.DocumentID.EQ(mysql.Int64(documentID)),
+			tTimeline.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tTimeline.CreatedAt.DESC()).
+		LIMIT(10)
+
+	var dest []*documentsreferences.DocumentDispatchTimeline
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (s *Store) CreateDocumentDispatchTimeline(
+	ctx context.Context,
+	tx qrm.DB,
+	tl *documentsreferences.DocumentDispatchTimeline,
+) (int64, error) {
+	tTimeline := table.FivenetDocumentsDispatchTimelines
+	stmt := tTimeline.
+		INSERT(
+			tTimeline.DocumentID,
+			tTimeline.DispatchID,
+			tTimeline.CreatorID,
+			tTimeline.Timeline,
+		).
+		VALUES(
+			tl.GetDocumentId(),
+			tl.GetDispatchId(),
+			tl.GetCreatorId(),
+			tl.GetTimeline(),
+		)
+
+	result, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.LastInsertId()
+}
+
+func (s *Store) DeleteDocumentDispatchTimeline(ctx context.Context, tx qrm.DB, id int64) error {
+	tTimeline := table.FivenetDocumentsDispatchTimelines
+	stmt := tTimeline.
+		UPDATE(tTimeline.DeletedAt).
+		SET(tTimeline.DeletedAt.SET(mysql.CURRENT_TIMESTAMP())).
+		WHERE(tTimeline.ID.EQ(mysql.Int64(id))).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
 func (s *Store) GetDocumentRelation(
 	ctx context.Context,
 	id int64,
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	centrumdispatches "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/centrum/dispatches"
 	documentsreferences "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/references"
 	documentsrelations "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/relations"
 	"github.com/go-sql-driver/mysql"
@@ -142,3 +143,58 @@ func TestStoreDocumentRelations(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestStoreDocumentDispatchTimelines(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents_dispatch_timelines AS document_dispatch_timeline`)+`(?s).*`+regexp.QuoteMeta(`document_dispatch_timeline.id = ?`)+`(?s).*`+regexp.QuoteMeta(`LIMIT ?`)).
+		WithArgs(int64(5), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"document_dispatch_timeline.id", "document_dispatch_timeline.document_id", "document_dispatch_timeline.dispatch_id", "document_dispatch_timeline.creator_id"}).AddRow(int64(5), int64(42), int64(1337), int32(3)))
+
+	tl, err := store.GetDocumentDispatchTimeline(t.Context(), 5)
+	require.NoError(t, err)
+	require.NotNil(t, tl)
+	assert.Equal(t, int64(5), tl.GetId())
+	assert.Equal(t, int64(42), tl.GetDocumentId())
+	assert.Equal(t, int64(1337), tl.GetDispatchId())
+	assert.Equal(t, int32(3), tl.GetCreatorId())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents_dispatch_timelines AS document_dispatch_timeline`)+`(?s).*`+regexp.QuoteMeta(`document_dispatch_timeline.deleted_at IS NULL`)+`(?s).*`+regexp.QuoteMeta(`LIMIT ?`)).
+		WithArgs(int64(42), int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{}))
+
+	tls, err := store.ListDocumentDispatchTimelines(t.Context(), 42)
+	require.NoError(t, err)
+	assert.Empty(t, tls)
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_documents_dispatch_timelines`)).
+		WithArgs(int64(42), int64(1337), int32(3), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(6, 1))
+	lastID, err := store.CreateDocumentDispatchTimeline(
+		t.Context(),
+		db,
+		&documentsreferences.DocumentDispatchTimeline{
+			DocumentId: 42,
+			DispatchId: 1337,
+			CreatorId:  new(int32(3)),
+			Timeline:   &centrumdispatches.DispatchTimeline{},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), lastID)
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_documents_dispatch_timelines`)).
+		WithArgs(int64(6), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, store.DeleteDocumentDispatchTimeline(t.Context(), db, 6))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,232 @@
+package documentsstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
+	documentscategory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// ListCategoriesWithRetentionPolicy returns the categories of all jobs with an active retention
+// policy.
+func (s *Store) ListCategoriesWithRetentionPolicy(
+	ctx context.Context,
+	tx qrm.DB,
+) ([]*documentscategory.Category, error) {
+	tCategory := table.FivenetDocumentsCategories.AS("category")
+	stmt := tCategory.
+		SELECT(
+			tCategory.ID,
+			tCategory.Name,
+			tCategory.Job,
+			tCategory.RetentionPolicy,
+		).
+		FROM(tCategory).
+		WHERE(mysql.AND(
+			tCategory.DeletedAt.IS_NULL(),
+			tCategory.RetentionPolicy.IS_NOT_NULL(),
+		)).
+		ORDER_BY(tCategory.ID.ASC())
+
+	var dest []*documentscategory.Category
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	categories := []*documentscategory.Category{}
+	for _, category := range dest {
+		if category.GetRetentionPolicy().IsActive() {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories, nil
+}
+
+// retentionCondition matches the category's documents created before the given time which the
+// retention action still has to be applied to. Documents on legal hold are never matched.
+func retentionCondition(
+	tDocument *table.FivenetDocumentsTable,
+	categoryId int64,
+	action documentscategory.RetentionAction,
+	before time.Time,
+) mysql.BoolExpression {
+	condition := mysql.AND(
+		tDocument.CategoryID.EQ(mysql.Int64(categoryId)),
+		tDocument.DeletedAt.IS_NULL(),
+		tDocument.LegalHold.IS_FALSE(),
+		tDocument.CreatedAt.LT(mysql.TimestampT(before)),
+	)
+
+	switch action {
+	case documentscategory.RetentionAction_RETENTION_ACTION_CLOSE:
+		condition = condition.AND(tDocument.Closed.IS_FALSE())
+	case documentscategory.RetentionAction_RETENTION_ACTION_ARCHIVE:
+		condition = condition.AND(tDocument.ArchivedAt.IS_NULL())
+	}
+
+	return condition
+}
+
+// CountRetentionDocuments counts the documents the retention action applies to.
+func (s *Store) CountRetentionDocuments(
+	ctx context.Context,
+	tx qrm.DB,
+	categoryId int64,
+	action documentscategory.RetentionAction,
+	before time.Time,
+) (int64, error) {
+	tDocument := table.FivenetDocuments
+	stmt := tDocument.
+		SELECT(mysql.COUNT(tDocument.ID).AS("data_count.total")).
+		FROM(tDocument).
+		WHERE(retentionCondition(tDocument, categoryId, action, before))
+
+	var count resourcesdatabase.DataCount
+	if err := stmt.QueryContext(ctx, tx, &count); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return 0, err
+		}
+	}
+
+	return count.Total, nil
+}
+
+// ListRetentionDocumentIDs returns the IDs of the oldest documents the retention action applies to.
+func (s *Store) ListRetentionDocumentIDs(
+	ctx context.Context,
+	tx qrm.DB,
+	categoryId int64,
+	action documentscategory.RetentionAction,
+	before time.Time,
+	limit int64,
+) ([]int64, error) {
+	tDocument := table.FivenetDocuments
+	stmt := tDocument.
+		SELECT(tDocument.ID.AS("id")).
+		FROM(tDocument).
+		WHERE(retentionCondition(tDocument, categoryId, action, before)).
+		ORDER_BY(tDocument.ID.ASC()).
+		LIMIT(limit)
+
+	ids := []int64{}
+	if err := stmt.QueryContext(ctx, tx, &ids); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// ApplyRetentionAction closes, archives or (soft) deletes the documents and adds an activity entry
+// for each of them. Documents on legal hold are skipped.
+func (s *Store) ApplyRetentionAction(
+	ctx context.Context,
+	tx qrm.DB,
+	action documentscategory.RetentionAction,
+	documentIds []int64,
+) error {
+	if len(documentIds) == 0 {
+		return nil
+	}
+
+	ids := make([]mysql.Expression, len(documentIds))
+	for i, id := range documentIds {
+		ids[i] = mysql.Int64(id)
+	}
+
+	var activityType documentsactivity.DocActivityType
+	var stmt mysql.UpdateStatement
+
+	tDocument := table.FivenetDocuments
+	switch action {
+	case documentscategory.RetentionAction_RETENTION_ACTION_CLOSE:
+		activityType = documentsactivity.DocActivityType_DOC_ACTIVITY_TYPE_STATUS_CLOSED
+		stmt = tDocument.
+			UPDATE(
+				tDocument.Closed,
+			).
+			SET(
+				true,
+			)
+
+	case documentscategory.RetentionAction_RETENTION_ACTION_ARCHIVE:
+		activityType = documentsactivity.DocActivityType_DOC_ACTIVITY_TYPE_ARCHIVED
+		stmt = tDocument.
+			UPDATE(
+				tDocument.ArchivedAt,
+				tDocument.Closed,
+			).
+			SET(
+				tDocument.ArchivedAt.SET(mysql.CURRENT_TIMESTAMP()),
+				tDocument.Closed.SET(mysql.Bool(true)),
+			)
+
+	case documentscategory.RetentionAction_RETENTION_ACTION_DELETE:
+		activityType = documentsactivity.DocActivityType_DOC_ACTIVITY_TYPE_DELETED
+		stmt = tDocument.
+			UPDATE(
+				tDocument.DeletedAt,
+			).
+			SET(
+				tDocument.DeletedAt.SET(mysql.CURRENT_TIMESTAMP()),
+			)
+
+	default:
+		return nil
+	}
+
+	if _, err := stmt.
+		WHERE(mysql.AND(
+			tDocument.ID.IN(ids...),
+			tDocument.LegalHold.IS_FALSE(),
+		)).
+		LIMIT(int64(len(documentIds))).
+		ExecContext(ctx, tx); err != nil {
+		return err
+	}
+
+	for _, documentId := range documentIds {
+		if _, err := addDocumentActivity(ctx, tx, &documentsactivity.DocActivity{
+			DocumentId:   documentId,
+			ActivityType: activityType,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetDocumentLegalHold places the document on legal hold or releases it.
+func (s *Store) SetDocumentLegalHold(
+	ctx context.Context,
+	tx qrm.DB,
+	documentId int64,
+	legalHold bool,
+) error {
+	tDocument := table.FivenetDocuments
+	stmt := tDocument.
+		UPDATE(
+			tDocument.LegalHold,
+		).
+		SET(
+			legalHold,
+		).
+		WHERE(
+			tDocument.ID.EQ(mysql.Int64(documentId)),
+		).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
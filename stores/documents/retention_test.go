@@ -0,0 +1,123 @@
+package documentsstore
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	documentscategory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreListCategoriesWithRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents_categories AS category`) + `(?s).*` + regexp.QuoteMeta(`category.retention_policy IS NOT NULL`)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"category.id",
+			"category.job",
+			"category.retention_policy",
+		}).
+			AddRow(int64(1), "doj", `{"enabled":true,"closeAfterDays":30}`).
+			AddRow(int64(2), "doj", `{"enabled":false,"closeAfterDays":30}`).
+			AddRow(int64(3), "police", `{"enabled":true}`))
+
+	categories, err := store.ListCategoriesWithRetentionPolicy(t.Context(), db)
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	assert.Equal(t, int64(1), categories[0].GetId())
+	assert.Equal(t, int32(30), categories[0].GetRetentionPolicy().GetCloseAfterDays())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreRetentionDocuments(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+	before := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents`)+`(?s).*`+regexp.QuoteMeta(`fivenet_documents.legal_hold IS FALSE`)+`(?s).*`+regexp.QuoteMeta(`fivenet_documents.archived_at IS NULL`)).
+		WithArgs(int64(4), before).
+		WillReturnRows(sqlmock.NewRows([]string{"data_count.total"}).AddRow(int64(2)))
+
+	count, err := store.CountRetentionDocuments(
+		t.Context(),
+		db,
+		4,
+		documentscategory.RetentionAction_RETENTION_ACTION_ARCHIVE,
+		before,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents`)+`(?s).*`+regexp.QuoteMeta(`fivenet_documents.closed IS FALSE`)+`(?s).*`+regexp.QuoteMeta(`ORDER BY fivenet_documents.id ASC`)).
+		WithArgs(int64(4), before, int64(100)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(7)).AddRow(int64(9)))
+
+	ids, err := store.ListRetentionDocumentIDs(
+		t.Context(),
+		db,
+		4,
+		documentscategory.RetentionAction_RETENTION_ACTION_CLOSE,
+		before,
+		100,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7, 9}, ids)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreApplyRetentionAction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_documents`)+`(?s).*`+regexp.QuoteMeta(`archived_at = CURRENT_TIMESTAMP`)+`(?s).*`+regexp.QuoteMeta(`fivenet_documents.legal_hold IS FALSE`)).
+		WithArgs(true, int64(7), int64(9), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_documents_activity`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_documents_activity`)).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+
+	require.NoError(t, store.ApplyRetentionAction(
+		t.Context(),
+		db,
+		documentscategory.RetentionAction_RETENTION_ACTION_ARCHIVE,
+		[]int64{7, 9},
+	))
+
+	// Nothing to do without documents
+	require.NoError(t, store.ApplyRetentionAction(
+		t.Context(),
+		db,
+		documentscategory.RetentionAction_RETENTION_ACTION_DELETE,
+		nil,
+	))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package documentsstore
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	resourcesaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/access"
 	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
@@ -10,14 +11,17 @@ import (
 	documentsaccess "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/access"
 	documentsactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/activity"
 	documentsapproval "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/approval"
+	documentsbulk "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/bulk"
 	documentscategory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/category"
 	documentscomment "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/comment"
+	documentsforms "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/forms"
 	documentspins "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/pins"
 	documentsreferences "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/references"
 	documentsrelations "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/relations"
 	documentsrequests "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/requests"
 	documentsstamps "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/stamps"
 	documentstemplates "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/templates"
+	documentsversions "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/versions"
 	documentsworkflow "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/workflow"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
@@ -114,6 +118,11 @@ type IStore interface {
 		id int64,
 		userInfo *userinfo.UserInfo,
 	) (*documentscategory.Category, error)
+	GetCategoryWithStateMachine(
+		ctx context.Context,
+		tx qrm.DB,
+		categoryId int64,
+	) (*documentscategory.Category, error)
 	CreateCategory(
 		ctx context.Context,
 		tx qrm.DB,
@@ -149,6 +158,33 @@ type IStore interface {
 		ctx context.Context,
 		q ListDocumentActivityQuery,
 	) (resourcesdatabase.DataCount, []*documentsactivity.DocActivity, error)
+	ListDocumentVersions(
+		ctx context.Context,
+		q ListDocumentVersionsQuery,
+	) (resourcesdatabase.DataCount, []*documentsversions.DocumentVersion, error)
+	GetDocumentVersion(
+		ctx context.Context,
+		db qrm.DB,
+		condition mysql.BoolExpression,
+	) (*documentsversions.DocumentVersion, error)
+	CreateDocumentVersion(
+		ctx context.Context,
+		tx qrm.DB,
+		version *documentsversions.DocumentVersion,
+	) (int64, error)
+	ListBulkJobs(
+		ctx context.Context,
+		q ListBulkJobsQuery,
+	) (resourcesdatabase.DataCount, []*documentsbulk.BulkJob, error)
+	GetBulkJob(
+		ctx context.Context,
+		db qrm.DB,
+		condition mysql.BoolExpression,
+	) (*documentsbulk.BulkJob, error)
+	CreateBulkJob(ctx context.Context, tx qrm.DB, job *documentsbulk.BulkJob) (int64, error)
+	UpdateBulkJobProgress(ctx context.Context, tx qrm.DB, job *documentsbulk.BulkJob) error
+	ListDocumentIDs(ctx context.Context, q ListQuery, afterId int64) ([]int64, error)
+	CountDocuments(ctx context.Context, q ListQuery) (int64, error)
 	ListDocumentReqs(
 		ctx context.Context,
 		q ListDocumentReqsQuery,
@@ -208,6 +244,13 @@ type IStore interface {
 		pendingCount int32,
 	) error
 	ExpireApprovalTasks(ctx context.Context, tx qrm.DB) (int64, error)
+	ListActiveApprovalDelegations(ctx context.Context, tx qrm.DB) ([]*ApprovalDelegation, error)
+	DelegateApprovalTasks(
+		ctx context.Context,
+		tx qrm.DB,
+		userId int32,
+		delegateId int32,
+	) (int64, error)
 	ResetApprovalProgress(ctx context.Context, tx qrm.DB, documentID int64) error
 	RecomputeApprovalPolicyTx(
 		ctx context.Context,
@@ -224,6 +267,45 @@ type IStore interface {
 		db qrm.DB,
 		approvalID int64,
 	) (*documentsapproval.Approval, error)
+	ListApprovalPolicyTemplates(
+		ctx context.Context,
+		tx qrm.DB,
+		job string,
+		categoryId *int64,
+		templateId *int64,
+	) ([]*documentsapproval.ApprovalPolicyTemplate, error)
+	GetApprovalPolicyTemplate(
+		ctx context.Context,
+		tx qrm.DB,
+		id int64,
+	) (*documentsapproval.ApprovalPolicyTemplate, error)
+	FindApprovalPolicyTemplate(
+		ctx context.Context,
+		tx qrm.DB,
+		job string,
+		categoryId int64,
+		templateId int64,
+		triggerState string,
+	) (*documentsapproval.ApprovalPolicyTemplate, error)
+	CreateApprovalPolicyTemplate(
+		ctx context.Context,
+		tx qrm.DB,
+		policyTemplate *documentsapproval.ApprovalPolicyTemplate,
+		userInfo *userinfo.UserInfo,
+	) (int64, error)
+	UpdateApprovalPolicyTemplate(
+		ctx context.Context,
+		tx qrm.DB,
+		policyTemplate *documentsapproval.ApprovalPolicyTemplate,
+		userInfo *userinfo.UserInfo,
+	) error
+	DeleteApprovalPolicyTemplate(
+		ctx context.Context,
+		tx qrm.DB,
+		id int64,
+		userInfo *userinfo.UserInfo,
+	) error
+	UpdateApprovalPolicyStage(ctx context.Context, tx qrm.DB, documentID int64, stage int32) error
 	CheckJobStampCount(ctx context.Context, job string) (int64, error)
 	ListUsableStamps(
 		ctx context.Context,
@@ -253,6 +335,20 @@ type IStore interface {
 		ref *documentsreferences.DocumentReference,
 	) (int64, error)
 	DeleteDocumentReference(ctx context.Context, tx qrm.DB, id int64) error
+	GetDocumentDispatchTimeline(
+		ctx context.Context,
+		id int64,
+	) (*documentsreferences.DocumentDispatchTimeline, error)
+	ListDocumentDispatchTimelines(
+		ctx context.Context,
+		documentID int64,
+	) ([]*documentsreferences.DocumentDispatchTimeline, error)
+	CreateDocumentDispatchTimeline(
+		ctx context.Context,
+		tx qrm.DB,
+		tl *documentsreferences.DocumentDispatchTimeline,
+	) (int64, error)
+	DeleteDocumentDispatchTimeline(ctx context.Context, tx qrm.DB, id int64) error
 	GetDocumentRelation(
 		ctx context.Context,
 		id int64,
@@ -352,6 +448,44 @@ type IStore interface {
 		closed bool,
 		userInfo *userinfo.UserInfo,
 	) error
+	UpdateDocumentState(
+		ctx context.Context,
+		tx qrm.DB,
+		documentId int64,
+		state string,
+		locked bool,
+	) error
+	SetDocumentLegalHold(
+		ctx context.Context,
+		tx qrm.DB,
+		documentId int64,
+		legalHold bool,
+	) error
+	ListCategoriesWithRetentionPolicy(
+		ctx context.Context,
+		tx qrm.DB,
+	) ([]*documentscategory.Category, error)
+	CountRetentionDocuments(
+		ctx context.Context,
+		tx qrm.DB,
+		categoryId int64,
+		action documentscategory.RetentionAction,
+		before time.Time,
+	) (int64, error)
+	ListRetentionDocumentIDs(
+		ctx context.Context,
+		tx qrm.DB,
+		categoryId int64,
+		action documentscategory.RetentionAction,
+		before time.Time,
+		limit int64,
+	) ([]int64, error)
+	ApplyRetentionAction(
+		ctx context.Context,
+		tx qrm.DB,
+		action documentscategory.RetentionAction,
+		documentIds []int64,
+	) error
 }
 
 type Store struct {
@@ -383,6 +517,7 @@ type ListQuery struct {
 	Closed      *bool
 	DocumentIDs []int64
 	OnlyDrafts  *bool
+	FormFields  []*documentsforms.FormFieldFilter
 	Sort        *resourcesdatabase.Sort
 	Offset      int64
 	Limit       int64
@@ -421,6 +556,17 @@ type ListDocumentActivityQuery struct {
 	UserInfo      *userinfo.UserInfo
 }
 
+type ListDocumentVersionsQuery struct {
+	DocumentID int64
+	Pagination *resourcesdatabase.PaginationRequest
+}
+
+type ListBulkJobsQuery struct {
+	Job        string
+	CreatorID  *int32
+	Pagination *resourcesdatabase.PaginationRequest
+}
+
 type ListDocumentReqsQuery struct {
 	DocumentID int64
 	Pagination *resourcesdatabase.PaginationRequest
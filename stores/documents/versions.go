@@ -0,0 +1,176 @@
+package documentsstore
+
+import (
+	"context"
+	"errors"
+
+	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	documentsversions "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/versions"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+func (s *Store) ListDocumentVersions(
+	ctx context.Context,
+	q ListDocumentVersionsQuery,
+) (resourcesdatabase.DataCount, []*documentsversions.DocumentVersion, error) {
+	tDocVersion := table.FivenetDocumentsVersions.AS("document_version")
+	condition := tDocVersion.DocumentID.EQ(mysql.Int64(q.DocumentID))
+
+	countStmt := tDocVersion.
+		SELECT(mysql.COUNT(tDocVersion.ID).AS("data_count.total")).
+		FROM(tDocVersion).
+		WHERE(condition)
+
+	var count resourcesdatabase.DataCount
+	if err := countStmt.QueryContext(ctx, s.db, &count); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return resourcesdatabase.DataCount{}, nil, err
+		}
+	}
+
+	_, limit := q.Pagination.GetResponseWithPageSize(count.Total, 10)
+	if count.Total <= 0 {
+		return count, []*documentsversions.DocumentVersion{}, nil
+	}
+
+	tCreator := table.FivenetUser.AS("creator")
+	// The content is only loaded for single versions
+	stmt := tDocVersion.
+		SELECT(
+			tDocVersion.ID,
+			tDocVersion.CreatedAt,
+			tDocVersion.DocumentID,
+			tDocVersion.CreatorID,
+			tDocVersion.CreatorJob,
+			tDocVersion.Title,
+			tDocVersion.Contributors,
+			tDocVersion.RestoredFromID,
+			tCreator.ID,
+			tCreator.Job,
+			tCreator.JobGrade,
+			tCreator.Firstname,
+			tCreator.Lastname,
+		).
+		FROM(
+			tDocVersion.
+				LEFT_JOIN(tCreator,
+					tCreator.ID.EQ(tDocVersion.CreatorID),
+				),
+		).
+		WHERE(condition).
+		OFFSET(q.Pagination.GetOffset()).
+		ORDER_BY(tDocVersion.ID.DESC()).
+		LIMIT(limit)
+
+	var versions []*documentsversions.DocumentVersion
+	if err := stmt.QueryContext(ctx, s.db, &versions); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return resourcesdatabase.DataCount{}, nil, err
+		}
+	}
+
+	return count, versions, nil
+}
+
+// GetDocumentVersion returns the latest version matching the condition (including the content and
+// data), nil if there is no matching version.
+func (s *Store) GetDocumentVersion(
+	ctx context.Context,
+	db qrm.DB,
+	condition mysql.BoolExpression,
+) (*documentsversions.DocumentVersion, error) {
+	tDocVersion := table.FivenetDocumentsVersions.AS("document_version")
+	tCreator := table.FivenetUser.AS("creator")
+
+	stmt := tDocVersion.
+		SELECT(
+			tDocVersion.ID,
+			tDocVersion.CreatedAt,
+			tDocVersion.DocumentID,
+			tDocVersion.CreatorID,
+			tDocVersion.CreatorJob,
+			tDocVersion.Title,
+			tDocVersion.ContentJSON,
+			tDocVersion.Data,
+			tDocVersion.Contributors,
+			tDocVersion.RestoredFromID,
+			tCreator.ID,
+			tCreator.Job,
+			tCreator.JobGrade,
+			tCreator.Firstname,
+			tCreator.Lastname,
+		).
+		FROM(
+			tDocVersion.
+				LEFT_JOIN(tCreator,
+					tCreator.ID.EQ(tDocVersion.CreatorID),
+				),
+		).
+		WHERE(condition).
+		ORDER_BY(tDocVersion.ID.DESC()).
+		LIMIT(1)
+
+	version := &documentsversions.DocumentVersion{}
+	if err := stmt.QueryContext(ctx, db, version); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if version.GetId() == 0 {
+		return nil, nil
+	}
+
+	return version, nil
+}
+
+func (s *Store) CreateDocumentVersion(
+	ctx context.Context,
+	tx qrm.DB,
+	version *documentsversions.DocumentVersion,
+) (int64, error) {
+	// Keep the original creation date, e.g., for the initial version of existing documents
+	var createdAt mysql.Expression = mysql.CURRENT_TIMESTAMP(3)
+	if version.GetCreatedAt() != nil {
+		createdAt = mysql.TimestampT(version.GetCreatedAt().AsTime())
+	}
+
+	tDocVersion := table.FivenetDocumentsVersions
+	stmt := tDocVersion.
+		INSERT(
+			tDocVersion.CreatedAt,
+			tDocVersion.DocumentID,
+			tDocVersion.CreatorID,
+			tDocVersion.CreatorJob,
+			tDocVersion.Title,
+			tDocVersion.ContentJSON,
+			tDocVersion.Data,
+			tDocVersion.Contributors,
+			tDocVersion.RestoredFromID,
+		).
+		VALUES(
+			createdAt,
+			version.GetDocumentId(),
+			version.CreatorId,
+			version.GetCreatorJob(),
+			version.GetTitle(),
+			version.GetContent(),
+			version.GetData(),
+			version.GetContributors(),
+			version.RestoredFromId,
+		)
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	lastId, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return lastId, nil
+}
@@ -0,0 +1,119 @@
+package documentsstore
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	resourcesdatabase "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	documentsversions "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/documents/versions"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreListDocumentVersionsEmpty(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+
+	mock.ExpectQuery(`(?s).*FROM fivenet_documents_versions AS document_version.*`).
+		WillReturnRows(sqlmock.NewRows([]string{"data_count.total"}).AddRow(int64(0)))
+
+	count, items, err := store.ListDocumentVersions(t.Context(), ListDocumentVersionsQuery{
+		DocumentID: 42,
+		Pagination: &resourcesdatabase.PaginationRequest{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count.Total)
+	assert.Empty(t, items)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreGetDocumentVersion(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+	tDocVersion := table.FivenetDocumentsVersions.AS("document_version")
+	condition := tDocVersion.DocumentID.EQ(mysql.Int64(42))
+
+	mock.ExpectQuery(
+		`(?s).*FROM fivenet_documents_versions AS document_version.*ORDER BY document_version.id DESC.*`,
+	).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"document_version.id",
+			"document_version.document_id",
+			"document_version.creator_job",
+			"document_version.title",
+			"document_version.contributors",
+		}).AddRow(int64(7), int64(42), "doj", "Title", `{"userIds":[3,5]}`))
+
+	version, err := store.GetDocumentVersion(t.Context(), db, condition)
+	require.NoError(t, err)
+	require.NotNil(t, version)
+	assert.Equal(t, int64(7), version.GetId())
+	assert.Equal(t, "Title", version.GetTitle())
+	assert.Equal(t, []int32{3, 5}, version.GetContributors().GetUserIds())
+
+	mock.ExpectQuery(`(?s).*FROM fivenet_documents_versions AS document_version.*`).
+		WillReturnRows(sqlmock.NewRows([]string{"document_version.id"}))
+
+	version, err = store.GetDocumentVersion(t.Context(), db, condition)
+	require.NoError(t, err)
+	assert.Nil(t, version)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreCreateDocumentVersion(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	store := New(testParams(db))
+	creatorId := int32(3)
+
+	mock.ExpectExec(`(?s).*INSERT INTO fivenet_documents_versions.*CURRENT_TIMESTAMP\(3\).*`).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+
+	id, err := store.CreateDocumentVersion(t.Context(), db, &documentsversions.DocumentVersion{
+		DocumentId: 42,
+		CreatorId:  &creatorId,
+		CreatorJob: "doj",
+		Title:      "Title",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), id)
+
+	// Initial versions of existing documents keep their original date
+	mock.ExpectExec(`(?s).*INSERT INTO fivenet_documents_versions.*`).
+		WillReturnResult(sqlmock.NewResult(8, 1))
+
+	id, err = store.CreateDocumentVersion(t.Context(), db, &documentsversions.DocumentVersion{
+		CreatedAt:  timestamp.Now(),
+		DocumentId: 42,
+		CreatorJob: "doj",
+		Title:      "Title",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), id)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
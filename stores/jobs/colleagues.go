@@ -265,6 +265,7 @@ func (s *Store) ListColleagues(
 			tColleagueProps.Job,
 			tColleagueProps.AbsenceBegin,
 			tColleagueProps.AbsenceEnd,
+			tColleagueProps.AbsenceDelegateID,
 			tColleagueProps.NamePrefix,
 			tColleagueProps.NameSuffix,
 		).
@@ -331,6 +332,7 @@ func (s *Store) ListColleaguesByUserIDs(
 		tColleagueProps.Job,
 		tColleagueProps.AbsenceBegin,
 		tColleagueProps.AbsenceEnd,
+		tColleagueProps.AbsenceDelegateID,
 		tColleagueProps.NamePrefix,
 		tColleagueProps.NameSuffix,
 	}
@@ -405,6 +407,7 @@ func (s *Store) GetColleague(
 		tColleagueProps.Job,
 		tColleagueProps.AbsenceBegin,
 		tColleagueProps.AbsenceEnd,
+		tColleagueProps.AbsenceDelegateID,
 		tColleagueProps.NamePrefix,
 		tColleagueProps.NameSuffix,
 	}
@@ -625,6 +628,7 @@ func (s *Store) GetColleagueProps(
 		tColleagueProps.Job,
 		tColleagueProps.AbsenceBegin,
 		tColleagueProps.AbsenceEnd,
+		tColleagueProps.AbsenceDelegateID,
 		tColleagueProps.NamePrefix,
 		tColleagueProps.NameSuffix,
 	}
@@ -683,6 +687,7 @@ func (s *Store) HandleColleaguePropsChanges(
 
 	absenceBegin := mysql.DateExp(mysql.NULL)
 	absenceEnd := mysql.DateExp(mysql.NULL)
+	absenceDelegateId := mysql.IntExp(mysql.NULL)
 	if in.GetAbsenceBegin() != nil && in.GetAbsenceEnd() != nil {
 		if in.GetAbsenceBegin().GetTimestamp() == nil {
 			in.AbsenceBegin = nil
@@ -695,14 +700,25 @@ func (s *Store) HandleColleaguePropsChanges(
 		} else {
 			absenceEnd = mysql.DateT(in.GetAbsenceEnd().AsTime())
 		}
+
+		// The delegate is only kept as long as there is an absence
+		if in.AbsenceBegin == nil || in.AbsenceEnd == nil || in.GetAbsenceDelegateId() <= 0 {
+			in.AbsenceDelegateId = nil
+		} else {
+			absenceDelegateId = mysql.Int32(in.GetAbsenceDelegateId())
+		}
 	} else {
 		in.AbsenceBegin = x.GetAbsenceBegin()
 		in.AbsenceEnd = x.GetAbsenceEnd()
+		in.AbsenceDelegateId = x.AbsenceDelegateId
 	}
 
 	updateSets = append(updateSets,
 		tColleagueProps.AbsenceBegin.SET(mysql.DateExp(mysql.Raw("VALUES(`absence_begin`)"))),
 		tColleagueProps.AbsenceEnd.SET(mysql.DateExp(mysql.Raw("VALUES(`absence_end`)"))),
+		tColleagueProps.AbsenceDelegateID.SET(
+			mysql.IntExp(mysql.Raw("VALUES(`absence_delegate_id`)")),
+		),
 	)
 
 	if in.Note != nil {
@@ -749,6 +765,7 @@ func (s *Store) HandleColleaguePropsChanges(
 			tColleagueProps.Job,
 			tColleagueProps.AbsenceBegin,
 			tColleagueProps.AbsenceEnd,
+			tColleagueProps.AbsenceDelegateID,
 			tColleagueProps.Note,
 			tColleagueProps.NamePrefix,
 			tColleagueProps.NameSuffix,
@@ -758,6 +775,7 @@ func (s *Store) HandleColleaguePropsChanges(
 			job,
 			absenceBegin,
 			absenceEnd,
+			absenceDelegateId,
 			in.Note,
 			in.NamePrefix,
 			in.NameSuffix,
@@ -797,7 +815,8 @@ func (s *Store) HandleColleaguePropsChanges(
 
 	if (in.GetAbsenceBegin() == nil && in.GetAbsenceEnd() == nil && x.GetAbsenceBegin() != nil && x.GetAbsenceEnd() != nil) ||
 		(in.GetAbsenceBegin() != nil && (x.GetAbsenceBegin() == nil || in.GetAbsenceBegin().AsTime().Compare(x.GetAbsenceBegin().AsTime()) != 0) ||
-			in.GetAbsenceEnd() != nil && (x.GetAbsenceEnd() == nil || in.GetAbsenceEnd().AsTime().Compare(x.GetAbsenceEnd().AsTime()) != 0)) {
+			in.GetAbsenceEnd() != nil && (x.GetAbsenceEnd() == nil || in.GetAbsenceEnd().AsTime().Compare(x.GetAbsenceEnd().AsTime()) != 0)) ||
+		in.GetAbsenceDelegateId() != x.GetAbsenceDelegateId() {
 		activities = append(activities, &colleaguesactivity.ColleagueActivity{
 			Job:          job,
 			SourceUserId: sourceUserId,
@@ -807,8 +826,9 @@ func (s *Store) HandleColleaguePropsChanges(
 			Data: &colleaguesactivity.ColleagueActivityData{
 				Data: &colleaguesactivity.ColleagueActivityData_AbsenceDate{
 					AbsenceDate: &colleaguesactivity.AbsenceDateChange{
-						AbsenceBegin: in.GetAbsenceBegin(),
-						AbsenceEnd:   in.GetAbsenceEnd(),
+						AbsenceBegin:      in.GetAbsenceBegin(),
+						AbsenceEnd:        in.GetAbsenceEnd(),
+						AbsenceDelegateId: in.AbsenceDelegateId,
 					},
 				},
 			},
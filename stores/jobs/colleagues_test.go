@@ -4,11 +4,17 @@ import (
 	"regexp"
 	"testing"
 
+	"time"
+
 	"github.com/DATA-DOG/go-sqlmock"
+	jobscolleagues "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues"
 	colleaguesactivity "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues/activity"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
 	"github.com/go-jet/jet/v2/mysql"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestStoreCreateColleagueActivity(t *testing.T) {
@@ -65,3 +71,69 @@ func TestStoreCountColleagueActivityJoinsTargetUserForAccessFilters(t *testing.T
 	require.Equal(t, int64(1), count)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestStoreHandleColleaguePropsChangesAbsenceDelegate(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	begin := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := begin.AddDate(0, 0, 7)
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_job_colleague_props`)+`(?s).*`+regexp.QuoteMeta("absence_delegate_id = (VALUES(`absence_delegate_id`))")).
+		WithArgs(int32(1), "police", sqlmock.AnyArg(), sqlmock.AnyArg(), int32(2), nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	in := &jobscolleagues.ColleagueProps{
+		AbsenceBegin:      timestamp.New(begin),
+		AbsenceEnd:        timestamp.New(end),
+		AbsenceDelegateId: proto.Int32(2),
+	}
+	activities, err := store.HandleColleaguePropsChanges(
+		t.Context(),
+		store.db,
+		&jobscolleagues.ColleagueProps{UserId: 1, Job: "police"},
+		in,
+		"police",
+		nil,
+		"",
+	)
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(
+		t,
+		int32(2),
+		activities[0].GetData().GetAbsenceDate().GetAbsenceDelegateId(),
+	)
+
+	// Clearing the absence clears the delegate as well
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_job_colleague_props`)).
+		WithArgs(int32(1), "police", nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	in = &jobscolleagues.ColleagueProps{
+		AbsenceBegin:      &timestamp.Timestamp{},
+		AbsenceEnd:        &timestamp.Timestamp{},
+		AbsenceDelegateId: proto.Int32(2),
+	}
+	activities, err = store.HandleColleaguePropsChanges(
+		t.Context(),
+		store.db,
+		&jobscolleagues.ColleagueProps{
+			UserId:            1,
+			Job:               "police",
+			AbsenceBegin:      timestamp.New(begin),
+			AbsenceEnd:        timestamp.New(end),
+			AbsenceDelegateId: proto.Int32(2),
+		},
+		in,
+		"police",
+		nil,
+		"",
+	)
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Nil(t, in.AbsenceDelegateId)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -79,6 +79,36 @@ func nullableInt32(v *int32) any {
 	return *v
 }
 
+func nullableInt64(v *int64) any {
+	if v == nil {
+		return nil
+	}
+
+	return *v
+}
+
+// qualificationRuleItems returns the table (aliased "qi") holding the qualifications a rule checks,
+// its qualification ID column and the join condition. Training path rules use the path's steps
+// instead of the rule's own items.
+func qualificationRuleItems(
+	ruleID int64,
+	rule *jobsgroups.GroupQualificationRule,
+) (mysql.ReadableTable, mysql.ColumnInteger, mysql.BoolExpression) {
+	if rule.TrainingPathId != nil {
+		tSteps := table.FivenetQualificationsTrainingPathSteps.AS("qi")
+		return tSteps, tSteps.QualificationID, mysql.AND(
+			tSteps.TrainingPathID.EQ(mysql.Int64(rule.GetTrainingPathId())),
+			tSteps.QualificationID.IN(int64Expressions(rule.GetQualificationIds())...),
+		)
+	}
+
+	tItems := table.FivenetJobGroupRuleQualificationItems.AS("qi")
+	return tItems, tItems.QualificationID, mysql.AND(
+		tItems.RuleID.EQ(mysql.Int64(ruleID)),
+		tItems.QualificationID.IN(int64Expressions(rule.GetQualificationIds())...),
+	)
+}
+
 func groupRuleType(rule *jobsgroups.GroupRule) (jobsgroups.GroupRuleType, error) {
 	if rule.GetGrade() != nil {
 		return groupRuleTypeGrade, nil
@@ -341,6 +371,8 @@ func (s *Store) loadGroupQualificationRule(
 		SELECT(
 			tQualifications.QualificationRuleType.AS("group_qualification_rule.type"),
 			tQualifications.RequireCompleted.AS("group_qualification_rule.require_completed"),
+			tQualifications.TrainingPathID.AS("group_qualification_rule.training_path_id"),
+			tQualifications.PromotionReady.AS("group_qualification_rule.promotion_ready"),
 		).
 		FROM(tQualifications).
 		WHERE(tQualifications.RuleID.EQ(mysql.Int64(rule.GetId()))).
@@ -354,6 +386,21 @@ func (s *Store) loadGroupQualificationRule(
 		return err
 	}
 
+	if qualificationRule.TrainingPathId != nil {
+		qualificationIDs, err := s.listTrainingPathQualificationIDs(
+			ctx,
+			db,
+			qualificationRule.GetTrainingPathId(),
+		)
+		if err != nil {
+			return err
+		}
+		qualificationRule.QualificationIds = qualificationIDs
+
+		rule.SetQualification(qualificationRule)
+		return nil
+	}
+
 	tItems := table.FivenetJobGroupRuleQualificationItems
 	itemStmt := tItems.
 		SELECT(tItems.QualificationID.AS("qualification_id")).
@@ -375,6 +422,67 @@ func (s *Store) loadGroupQualificationRule(
 	return nil
 }
 
+// listTrainingPathQualificationIDs returns the qualifications of the training path's steps,
+// empty if the path has been deleted.
+func (s *Store) listTrainingPathQualificationIDs(
+	ctx context.Context,
+	db qrm.DB,
+	trainingPathID int64,
+) ([]int64, error) {
+	tPaths := table.FivenetQualificationsTrainingPaths
+	tSteps := table.FivenetQualificationsTrainingPathSteps
+	tQualifications := table.FivenetQualifications
+	stmt := tSteps.
+		SELECT(tSteps.QualificationID.AS("qualification_id")).
+		FROM(tSteps.
+			INNER_JOIN(tPaths, tPaths.ID.EQ(tSteps.TrainingPathID)).
+			INNER_JOIN(tQualifications, tQualifications.ID.EQ(tSteps.QualificationID)),
+		).
+		WHERE(mysql.AND(
+			tSteps.TrainingPathID.EQ(mysql.Int64(trainingPathID)),
+			tPaths.DeletedAt.IS_NULL(),
+			tQualifications.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tSteps.SortOrder.ASC())
+
+	qualificationIDs := []int64{}
+	if err := stmt.QueryContext(ctx, db, &qualificationIDs); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return qualificationIDs, nil
+}
+
+// TrainingPathExists checks that the (non-deleted) training path belongs to the job.
+func (s *Store) TrainingPathExists(
+	ctx context.Context,
+	db qrm.DB,
+	job string,
+	trainingPathID int64,
+) (bool, error) {
+	tPaths := table.FivenetQualificationsTrainingPaths
+	stmt := tPaths.
+		SELECT(tPaths.ID.AS("id")).
+		FROM(tPaths).
+		WHERE(mysql.AND(
+			tPaths.ID.EQ(mysql.Int64(trainingPathID)),
+			tPaths.Job.EQ(mysql.String(job)),
+			tPaths.DeletedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	ids := []int64{}
+	if err := stmt.QueryContext(ctx, db, &ids); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return false, err
+		}
+	}
+
+	return len(ids) > 0, nil
+}
+
 func (s *Store) insertGroupRuleDetails(
 	ctx context.Context,
 	db qrm.DB,
@@ -413,11 +521,15 @@ func (s *Store) insertGroupRuleDetails(
 			tQualifications.RuleID,
 			tQualifications.QualificationRuleType,
 			tQualifications.RequireCompleted,
+			tQualifications.TrainingPathID,
+			tQualifications.PromotionReady,
 		).
 		VALUES(
 			rule.GetId(),
 			int32(qualification.GetType()),
 			qualification.GetRequireCompleted(),
+			nullableInt64(qualification.TrainingPathId),
+			qualification.GetPromotionReady(),
 		)
 
 	_, err := stmt.ExecContext(ctx, db)
@@ -425,6 +537,11 @@ func (s *Store) insertGroupRuleDetails(
 		return err
 	}
 
+	// Training path rules check the path's current steps
+	if qualification.TrainingPathId != nil {
+		return nil
+	}
+
 	tItems := table.FivenetJobGroupRuleQualificationItems
 	for _, qualificationID := range qualification.GetQualificationIds() {
 		stmt := tItems.
@@ -588,47 +705,57 @@ func (s *Store) listQualificationRuleMemberMatches(
 
 	tUserJobs := table.FivenetUserJobs.AS("uj")
 	tUser := table.FivenetUser.AS("u")
-	tItems := table.FivenetJobGroupRuleQualificationItems.AS("qi")
+	tItems, itemQualificationID, itemsCondition := qualificationRuleItems(ruleID, rule)
 	condition := mysql.AND(
 		tUserJobs.Job.EQ(mysql.String(group.GetJob())),
-		tItems.RuleID.EQ(mysql.Int64(ruleID)),
-		tItems.QualificationID.IN(int64Expressions(rule.GetQualificationIds())...),
+		itemsCondition,
 	)
 	if searchCondition != nil {
 		condition = condition.AND(searchCondition)
 	}
+	// Promotion readiness always requires passed qualifications, rules saved before this has
+	// been validated might not require them
+	requireCompleted := rule.GetRequireCompleted() ||
+		(rule.TrainingPathId != nil && rule.GetPromotionReady())
+
 	var from mysql.ReadableTable
-	if rule.GetRequireCompleted() {
+	if requireCompleted {
 		tSuccess := table.FivenetQualificationsResultSuccessMap.AS("qr")
 		from = tUserJobs.
 			INNER_JOIN(tUser, tUser.ID.EQ(tUserJobs.UserID)).
-			INNER_JOIN(tItems,
-				mysql.AND(
-					tItems.RuleID.EQ(mysql.Int64(ruleID)),
-					tItems.QualificationID.IN(int64Expressions(rule.GetQualificationIds())...),
-				),
-			).
+			INNER_JOIN(tItems, itemsCondition).
 			INNER_JOIN(tSuccess,
 				mysql.AND(
 					tSuccess.UserID.EQ(tUserJobs.UserID),
-					tSuccess.QualificationID.EQ(tItems.QualificationID),
+					tSuccess.QualificationID.EQ(itemQualificationID),
 				),
 			)
 	} else {
+		// Any (not deleted) result counts, no matter its status
 		tResults := table.FivenetQualificationsResults.AS("qr")
 		from = tUserJobs.
 			INNER_JOIN(tUser, tUser.ID.EQ(tUserJobs.UserID)).
-			INNER_JOIN(tItems, mysql.AND(
-				tItems.RuleID.EQ(mysql.Int64(ruleID)),
-				tItems.QualificationID.IN(int64Expressions(rule.GetQualificationIds())...),
-			)).
+			INNER_JOIN(tItems, itemsCondition).
 			INNER_JOIN(tResults, mysql.AND(
 				tResults.UserID.EQ(tUserJobs.UserID),
-				tResults.QualificationID.EQ(tItems.QualificationID),
+				tResults.QualificationID.EQ(itemQualificationID),
 				tResults.DeletedAt.IS_NULL(),
 			))
 	}
 
+	if rule.TrainingPathId != nil && rule.GetPromotionReady() {
+		// Only colleagues below the path's grade are up for promotion
+		tPaths := table.FivenetQualificationsTrainingPaths.AS("tp")
+		from = from.INNER_JOIN(tPaths, mysql.AND(
+			tPaths.ID.EQ(mysql.Int64(rule.GetTrainingPathId())),
+			tPaths.DeletedAt.IS_NULL(),
+		))
+		condition = condition.AND(mysql.OR(
+			tPaths.Grade.IS_NULL(),
+			tUserJobs.Grade.LT(tPaths.Grade),
+		))
+	}
+
 	stmt := tUserJobs.
 		SELECT(tUserJobs.UserID.AS("group_rule_member_match.user_id")).
 		FROM(from).
@@ -636,7 +763,7 @@ func (s *Store) listQualificationRuleMemberMatches(
 		GROUP_BY(tUserJobs.UserID).
 		ORDER_BY(tUserJobs.UserID.ASC())
 
-	matchCount := mysql.COUNT(mysql.DISTINCT(tItems.QualificationID))
+	matchCount := mysql.COUNT(mysql.DISTINCT(itemQualificationID))
 	label := "Matches any qualification"
 	switch rule.GetType() {
 	case jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ANY:
@@ -649,9 +776,12 @@ func (s *Store) listQualificationRuleMemberMatches(
 	default:
 		return nil, fmt.Errorf("unsupported qualification rule type %d", rule.GetType())
 	}
-	if rule.GetRequireCompleted() {
+	if requireCompleted {
 		label += " (completed)"
 	}
+	if rule.GetPromotionReady() {
+		label += " (promotion ready)"
+	}
 
 	matches := []*GroupRuleMemberMatch{}
 	if err := stmt.QueryContext(ctx, db, &matches); err != nil {
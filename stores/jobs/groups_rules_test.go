@@ -77,7 +77,7 @@ func TestStoreCreateGroupRuleQualification(t *testing.T) {
 		WillReturnResult(sqlmock.NewResult(9, 1))
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_job_group_rule_qualifications`)).
-		WithArgs(int64(9), int32(jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ANY), true).
+		WithArgs(int64(9), int32(jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ANY), true, nil, false).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_job_group_rule_qualification_items`)).
@@ -175,3 +175,138 @@ func TestStoreListGroupRuleMemberMatchesGrade(t *testing.T) {
 	assert.Equal(t, int32(11), matches[1].UserID)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestStoreListGroupRuleMemberMatchesTrainingPath(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	createdAt := time.Date(2026, time.July, 31, 12, 3, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_job_group_rules`)).
+		WithArgs(int64(42)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"group_rule_builder.id",
+			"group_rule_builder.group_id",
+			"group_rule_builder.type",
+			"group_rule_builder.enabled",
+			"group_rule_builder.created_by_user_id",
+			"group_rule_builder.created_at",
+			"group_rule_builder.updated_at",
+		}).AddRow(int64(8), int64(42), int32(groupRuleTypeQualification), true, int64(100), createdAt, nil))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_job_group_rule_qualifications`)).
+		WithArgs(int64(8), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"group_qualification_rule.type",
+			"group_qualification_rule.require_completed",
+			"group_qualification_rule.training_path_id",
+			"group_qualification_rule.promotion_ready",
+		}).AddRow(int32(jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ALL), true, int64(3), true))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_qualifications_training_path_steps`)).
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"qualification_id"}).AddRow(int64(10)).AddRow(int64(11)))
+
+	expectedQuery := regexp.QuoteMeta(
+		`INNER JOIN fivenet_qualifications_training_path_steps AS qi`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(`INNER JOIN fivenet_qualifications_training_paths AS tp`) +
+		`(?s).*` + regexp.QuoteMeta(`uj.grade < tp.grade`)
+	mock.ExpectQuery(expectedQuery).
+		WithArgs(
+			int64(3), int64(10), int64(11),
+			int64(3),
+			"police",
+			int64(3), int64(10), int64(11),
+			int64(2),
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"group_rule_member_match.user_id"}).AddRow(int64(10)))
+
+	matches, err := store.ListGroupRuleMemberMatches(t.Context(), store.db, &jobsgroups.Group{
+		Id:  42,
+		Job: "police",
+	}, "")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, int32(10), matches[0].UserID)
+	assert.Equal(t, int64(8), matches[0].RuleID)
+	assert.Equal(t, "Matches all qualifications (completed) (promotion ready)", matches[0].Label)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreListQualificationRuleMemberMatchesAnyResult(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	// Without requiring completed qualifications any (not deleted) result matches
+	expectedQuery := regexp.QuoteMeta(
+		`INNER JOIN fivenet_job_group_rule_qualification_items AS qi`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(`INNER JOIN fivenet_qualifications_results AS qr`) +
+		`(?s).*` + regexp.QuoteMeta(`qr.deleted_at IS NULL`)
+	mock.ExpectQuery(expectedQuery).
+		WithArgs(
+			int64(8), int64(10), int64(11),
+			"police",
+			int64(8), int64(10), int64(11),
+			int64(0),
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"group_rule_member_match.user_id"}).AddRow(int64(10)))
+
+	matches, err := store.listQualificationRuleMemberMatches(
+		t.Context(),
+		store.db,
+		&jobsgroups.Group{Id: 42, Job: "police"},
+		8,
+		&jobsgroups.GroupQualificationRule{
+			Type:             jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ANY,
+			QualificationIds: []int64{10, 11},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, int32(10), matches[0].UserID)
+	assert.Equal(t, "Matches any qualification", matches[0].Label)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreListQualificationRuleMemberMatchesPromotionReadyRequiresCompleted(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	// Rules saved without requiring completed qualifications still only match passed ones
+	expectedQuery := regexp.QuoteMeta(
+		`INNER JOIN fivenet_qualifications_result_success_map AS qr`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(`INNER JOIN fivenet_qualifications_training_paths AS tp`)
+	mock.ExpectQuery(expectedQuery).
+		WithArgs(
+			int64(3), int64(10), int64(11),
+			int64(3),
+			"police",
+			int64(3), int64(10), int64(11),
+			int64(2),
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"group_rule_member_match.user_id"}).AddRow(int64(10)))
+
+	matches, err := store.listQualificationRuleMemberMatches(
+		t.Context(),
+		store.db,
+		&jobsgroups.Group{Id: 42, Job: "police"},
+		8,
+		&jobsgroups.GroupQualificationRule{
+			Type:             jobsgroups.GroupQualificationRuleType_GROUP_QUALIFICATION_RULE_TYPE_ALL,
+			QualificationIds: []int64{10, 11},
+			TrainingPathId:   new(int64(3)),
+			PromotionReady:   true,
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Matches all qualifications (completed) (promotion ready)", matches[0].Label)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
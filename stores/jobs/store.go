@@ -3,6 +3,7 @@ package jobsstore
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
 	jobscolleagues "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/colleagues"
@@ -66,6 +67,14 @@ type TimeclockQuery struct {
 	UserID   int32
 }
 
+type TimeclockCorrectionsQuery struct {
+	Job      string
+	UserIDs  []int32
+	Statuses []jobstimeclock.TimeclockCorrectionStatus
+	Offset   int64
+	Limit    int64
+}
+
 type InactiveEmployeesQuery struct {
 	Days    int32
 	UserIDs []int32
@@ -227,6 +236,12 @@ type IStore interface {
 		updatedByUserID int32,
 	) (*jobsgroups.GroupRule, error)
 	DeleteGroupRule(ctx context.Context, db qrm.DB, groupID int64, ruleID int64) error
+	TrainingPathExists(
+		ctx context.Context,
+		db qrm.DB,
+		job string,
+		trainingPathID int64,
+	) (bool, error)
 	CreateGroupActivity(
 		ctx context.Context,
 		db qrm.DB,
@@ -375,6 +390,46 @@ type IStore interface {
 		q InactiveEmployeesQuery,
 	) ([]*jobscolleagues.Colleague, error)
 	CleanupTimeclock(ctx context.Context, db qrm.DB) error
+	GetTimeclockEntry(
+		ctx context.Context,
+		db qrm.DB,
+		job string,
+		userId int32,
+		date time.Time,
+		startTime *time.Time,
+	) (*jobstimeclock.TimeclockEntry, error)
+
+	CountTimeclockCorrections(
+		ctx context.Context,
+		db qrm.DB,
+		q TimeclockCorrectionsQuery,
+	) (int64, error)
+	ListTimeclockCorrections(
+		ctx context.Context,
+		db qrm.DB,
+		q TimeclockCorrectionsQuery,
+	) ([]*jobstimeclock.TimeclockCorrection, error)
+	GetTimeclockCorrection(
+		ctx context.Context,
+		db qrm.DB,
+		job string,
+		id int64,
+	) (*jobstimeclock.TimeclockCorrection, error)
+	CreateTimeclockCorrection(
+		ctx context.Context,
+		db qrm.DB,
+		correction *jobstimeclock.TimeclockCorrection,
+	) (int64, error)
+	ReviewTimeclockCorrection(
+		ctx context.Context,
+		db qrm.DB,
+		correction *jobstimeclock.TimeclockCorrection,
+	) error
+	ApplyTimeclockCorrection(
+		ctx context.Context,
+		db qrm.DB,
+		correction *jobstimeclock.TimeclockCorrection,
+	) error
 
 	CountConductEntries(ctx context.Context, db qrm.DB, q ConductQuery) (int64, error)
 	ListConductEntries(
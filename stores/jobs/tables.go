@@ -13,6 +13,8 @@ var (
 	tAvatar            = table.FivenetFiles.AS("profile_picture")
 	tConduct           = table.FivenetJobConduct.AS("conduct_entry")
 	tTimeClock         = table.FivenetJobTimeclock.AS("timeclock_entry")
+
+	tTimeclockCorrection = table.FivenetJobTimeclockCorrections.AS("timeclock_correction")
 )
 
 const (
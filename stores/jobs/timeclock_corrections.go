@@ -0,0 +1,395 @@
+package jobsstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	jobstimeclock "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/timeclock"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+func timeclockCorrectionsCondition(q TimeclockCorrectionsQuery) mysql.BoolExpression {
+	condition := mysql.AND(tTimeclockCorrection.Job.EQ(mysql.String(q.Job)))
+	if len(q.UserIDs) > 0 {
+		ids := make([]mysql.Expression, len(q.UserIDs))
+		for i := range q.UserIDs {
+			ids[i] = mysql.Int32(q.UserIDs[i])
+		}
+		condition = condition.AND(tTimeclockCorrection.UserID.IN(ids...))
+	}
+	if len(q.Statuses) > 0 {
+		statuses := make([]mysql.Expression, len(q.Statuses))
+		for i := range q.Statuses {
+			statuses[i] = mysql.Int32(int32(q.Statuses[i].Number()))
+		}
+		condition = condition.AND(tTimeclockCorrection.Status.IN(statuses...))
+	}
+
+	return condition
+}
+
+func (s *Store) CountTimeclockCorrections(
+	ctx context.Context,
+	db qrm.DB,
+	q TimeclockCorrectionsQuery,
+) (int64, error) {
+	countStmt := tTimeclockCorrection.
+		SELECT(mysql.COUNT(tTimeclockCorrection.ID).AS("data_count.total")).
+		FROM(tTimeclockCorrection).
+		WHERE(timeclockCorrectionsCondition(q))
+
+	var count database.DataCount
+	if err := countStmt.QueryContext(ctx, db, &count); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return 0, err
+		}
+	}
+	return count.Total, nil
+}
+
+func (s *Store) ListTimeclockCorrections(
+	ctx context.Context,
+	db qrm.DB,
+	q TimeclockCorrectionsQuery,
+) ([]*jobstimeclock.TimeclockCorrection, error) {
+	stmt := s.selectTimeclockCorrections(q.Job).
+		WHERE(timeclockCorrectionsCondition(q)).
+		OFFSET(q.Offset).
+		ORDER_BY(tTimeclockCorrection.ID.DESC()).
+		LIMIT(q.Limit)
+
+	corrections := []*jobstimeclock.TimeclockCorrection{}
+	if err := stmt.QueryContext(ctx, db, &corrections); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return corrections, nil
+}
+
+func (s *Store) GetTimeclockCorrection(
+	ctx context.Context,
+	db qrm.DB,
+	job string,
+	id int64,
+) (*jobstimeclock.TimeclockCorrection, error) {
+	stmt := s.selectTimeclockCorrections(job).
+		WHERE(mysql.AND(
+			tTimeclockCorrection.Job.EQ(mysql.String(job)),
+			tTimeclockCorrection.ID.EQ(mysql.Int64(id)),
+		)).
+		LIMIT(1)
+
+	dest := &jobstimeclock.TimeclockCorrection{}
+	if err := stmt.QueryContext(ctx, db, dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if dest.GetId() == 0 {
+		return nil, nil
+	}
+
+	return dest, nil
+}
+
+func (s *Store) selectTimeclockCorrections(job string) mysql.SelectStatement {
+	tUser := table.FivenetUser.AS("user")
+	tUserUserProps := table.FivenetUserProps.AS("user_props")
+	tUserAvatar := table.FivenetFiles.AS("user_profile_picture")
+	tReviewer := table.FivenetUser.AS("reviewer")
+
+	return tTimeclockCorrection.
+		SELECT(
+			tTimeclockCorrection.ID,
+			tTimeclockCorrection.CreatedAt,
+			tTimeclockCorrection.UpdatedAt,
+			tTimeclockCorrection.Job,
+			tTimeclockCorrection.UserID,
+			tTimeclockCorrection.Type,
+			tTimeclockCorrection.Status,
+			tTimeclockCorrection.Date,
+			tTimeclockCorrection.OriginalStartTime,
+			tTimeclockCorrection.OriginalEndTime,
+			tTimeclockCorrection.OriginalSpentTime,
+			tTimeclockCorrection.StartTime,
+			tTimeclockCorrection.EndTime,
+			tTimeclockCorrection.Reason,
+			tTimeclockCorrection.ReviewerID,
+			tTimeclockCorrection.ReviewedAt,
+			tTimeclockCorrection.ReviewComment,
+			tUser.ID,
+			tUser.Job,
+			tUser.JobGrade,
+			tUser.Firstname,
+			tUser.Lastname,
+			tUser.Dateofbirth,
+			tUser.PhoneNumber,
+			tUserUserProps.AvatarFileID.AS("user.profile_picture_file_id"),
+			tUserAvatar.FilePath.AS("user.profile_picture"),
+			tColleagueProps.UserID,
+			tColleagueProps.Job,
+			tColleagueProps.AbsenceBegin,
+			tColleagueProps.AbsenceEnd,
+			tColleagueProps.NamePrefix,
+			tColleagueProps.NameSuffix,
+			tReviewer.ID,
+			tReviewer.Job,
+			tReviewer.JobGrade,
+			tReviewer.Firstname,
+			tReviewer.Lastname,
+			tReviewer.Dateofbirth,
+			tReviewer.PhoneNumber,
+		).
+		FROM(tTimeclockCorrection.
+			LEFT_JOIN(tUser, tUser.ID.EQ(tTimeclockCorrection.UserID)).
+			LEFT_JOIN(tUserUserProps, tUserUserProps.UserID.EQ(tTimeclockCorrection.UserID)).
+			LEFT_JOIN(tUserAvatar, tUserAvatar.ID.EQ(tUserUserProps.AvatarFileID)).
+			LEFT_JOIN(tColleagueProps, mysql.AND(
+				tColleagueProps.UserID.EQ(tTimeclockCorrection.UserID),
+				tColleagueProps.Job.EQ(mysql.String(job)),
+			)).
+			LEFT_JOIN(tReviewer, tReviewer.ID.EQ(tTimeclockCorrection.ReviewerID)),
+		)
+}
+
+func (s *Store) CreateTimeclockCorrection(
+	ctx context.Context,
+	db qrm.DB,
+	correction *jobstimeclock.TimeclockCorrection,
+) (int64, error) {
+	tTimeclockCorrection := table.FivenetJobTimeclockCorrections
+	stmt := tTimeclockCorrection.
+		INSERT(
+			tTimeclockCorrection.Job,
+			tTimeclockCorrection.UserID,
+			tTimeclockCorrection.Type,
+			tTimeclockCorrection.Status,
+			tTimeclockCorrection.Date,
+			tTimeclockCorrection.OriginalStartTime,
+			tTimeclockCorrection.StartTime,
+			tTimeclockCorrection.EndTime,
+			tTimeclockCorrection.Reason,
+		).
+		VALUES(
+			correction.GetJob(),
+			correction.GetUserId(),
+			correction.GetType(),
+			jobstimeclock.TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_PENDING,
+			correction.GetDate(),
+			correction.GetOriginalStartTime(),
+			correction.GetStartTime(),
+			correction.GetEndTime(),
+			correction.GetReason(),
+		)
+
+	res, err := stmt.ExecContext(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// ReviewTimeclockCorrection stores the review of a pending correction together with the original
+// timeclock entry values. Returns sql.ErrNoRows when the correction isn't pending (anymore).
+func (s *Store) ReviewTimeclockCorrection(
+	ctx context.Context,
+	db qrm.DB,
+	correction *jobstimeclock.TimeclockCorrection,
+) error {
+	tTimeclockCorrection := table.FivenetJobTimeclockCorrections
+	stmt := tTimeclockCorrection.
+		UPDATE(
+			tTimeclockCorrection.Status,
+			tTimeclockCorrection.OriginalEndTime,
+			tTimeclockCorrection.OriginalSpentTime,
+			tTimeclockCorrection.ReviewerID,
+			tTimeclockCorrection.ReviewedAt,
+			tTimeclockCorrection.ReviewComment,
+		).
+		SET(
+			correction.GetStatus(),
+			correction.GetOriginalEndTime(),
+			correction.OriginalSpentTime,
+			correction.ReviewerId,
+			mysql.CURRENT_TIMESTAMP(),
+			correction.ReviewComment,
+		).
+		WHERE(mysql.AND(
+			tTimeclockCorrection.Job.EQ(mysql.String(correction.GetJob())),
+			tTimeclockCorrection.ID.EQ(mysql.Int64(correction.GetId())),
+			tTimeclockCorrection.Status.EQ(mysql.Int32(int32(
+				jobstimeclock.TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_PENDING,
+			))),
+		)).
+		LIMIT(1)
+
+	result, err := stmt.ExecContext(ctx, db)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetTimeclockEntry returns the colleague's timeclock entry of the day, a nil start time matches
+// entries whose start time has been cleared (see CleanupTimeclock).
+func (s *Store) GetTimeclockEntry(
+	ctx context.Context,
+	db qrm.DB,
+	job string,
+	userId int32,
+	date time.Time,
+	startTime *time.Time,
+) (*jobstimeclock.TimeclockEntry, error) {
+	stmt := tTimeClock.
+		SELECT(
+			tTimeClock.Job,
+			tTimeClock.UserID,
+			tTimeClock.Date,
+			tTimeClock.StartTime,
+			tTimeClock.EndTime,
+			tTimeClock.SpentTime,
+		).
+		FROM(tTimeClock).
+		WHERE(timeclockEntryCondition(tTimeClock, job, userId, date, startTime)).
+		LIMIT(1)
+
+	dest := &jobstimeclock.TimeclockEntry{}
+	if err := stmt.QueryContext(ctx, db, dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	if dest.GetUserId() == 0 {
+		return nil, nil
+	}
+
+	return dest, nil
+}
+
+func timeclockEntryCondition(
+	tTimeClock *table.FivenetJobTimeclockTable,
+	job string,
+	userId int32,
+	date time.Time,
+	startTime *time.Time,
+) mysql.BoolExpression {
+	condition := mysql.AND(
+		tTimeClock.Job.EQ(mysql.String(job)),
+		tTimeClock.UserID.EQ(mysql.Int32(userId)),
+		tTimeClock.Date.EQ(mysql.DateT(date)),
+	)
+	if startTime != nil {
+		condition = condition.AND(tTimeClock.StartTime.EQ(mysql.TimestampT(*startTime)))
+	} else {
+		condition = condition.AND(tTimeClock.StartTime.IS_NULL())
+	}
+
+	return condition
+}
+
+// ApplyTimeclockCorrection writes an approved correction to the colleague's timeclock, the
+// correction's original values must have been filled from the matched entry beforehand.
+// Returns sql.ErrNoRows when the entry to trim or close doesn't exist (anymore).
+func (s *Store) ApplyTimeclockCorrection(
+	ctx context.Context,
+	db qrm.DB,
+	correction *jobstimeclock.TimeclockCorrection,
+) error {
+	tTimeClock := table.FivenetJobTimeclock
+
+	startTime := correction.CorrectedStartTime()
+	if startTime == nil || correction.GetEndTime() == nil {
+		return errors.New("timeclock correction has no start or end time")
+	}
+
+	var stmt mysql.Statement
+	switch correction.GetType() {
+	case jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_ADD:
+		stmt = tTimeClock.
+			INSERT(
+				tTimeClock.Job,
+				tTimeClock.UserID,
+				tTimeClock.Date,
+				tTimeClock.StartTime,
+				tTimeClock.EndTime,
+				tTimeClock.SpentTime,
+			).
+			VALUES(
+				correction.GetJob(),
+				correction.GetUserId(),
+				mysql.DateT(*startTime),
+				mysql.TimestampT(*startTime),
+				correction.GetEndTime(),
+				correction.SpentTime(),
+			)
+
+	case jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM,
+		jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE:
+		var originalStartTime *time.Time
+		if correction.GetOriginalStartTime() != nil {
+			t := correction.GetOriginalStartTime().AsTime()
+			originalStartTime = &t
+		}
+
+		condition := timeclockEntryCondition(
+			tTimeClock,
+			correction.GetJob(),
+			correction.GetUserId(),
+			correction.GetDate().AsTime(),
+			originalStartTime,
+		)
+		if correction.GetType() == jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_TRIM {
+			condition = condition.AND(tTimeClock.EndTime.IS_NOT_NULL())
+		} else {
+			condition = condition.AND(tTimeClock.EndTime.IS_NULL())
+		}
+
+		stmt = tTimeClock.
+			UPDATE().
+			SET(
+				tTimeClock.StartTime.SET(mysql.TimestampT(*startTime)),
+				tTimeClock.EndTime.SET(mysql.TimestampT(correction.GetEndTime().AsTime())),
+				tTimeClock.SpentTime.SET(mysql.Float(float64(correction.SpentTime()))),
+			).
+			WHERE(condition).
+			LIMIT(1)
+
+	default:
+		return errors.New("unknown timeclock correction type")
+	}
+
+	result, err := stmt.ExecContext(ctx, db)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
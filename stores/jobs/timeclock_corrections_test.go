@@ -0,0 +1,107 @@
+package jobsstore
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	jobstimeclock "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/jobs/timeclock"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStoreListTimeclockCorrectionsFilters(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	mock.ExpectQuery(`(?s)SELECT .*FROM fivenet_job_timeclock_corrections AS timeclock_correction.*LEFT JOIN fivenet_user AS reviewer.*timeclock_correction\.user_id IN \(\?\).*timeclock_correction\.status IN \(\?\).*ORDER BY timeclock_correction\.id DESC.*`).
+		WithArgs("police", "police", int32(5), int32(1), int64(20), int64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"timeclock_correction.id",
+			"timeclock_correction.job",
+			"timeclock_correction.user_id",
+			"timeclock_correction.type",
+			"timeclock_correction.status",
+			"timeclock_correction.reason",
+		}).AddRow(int64(3), "police", int32(5), int32(3), int32(1), "Forgot to clock out"))
+
+	corrections, err := store.ListTimeclockCorrections(t.Context(), store.db, TimeclockCorrectionsQuery{
+		Job:     "police",
+		UserIDs: []int32{5},
+		Statuses: []jobstimeclock.TimeclockCorrectionStatus{
+			jobstimeclock.TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_PENDING,
+		},
+		Limit: 20,
+	})
+	require.NoError(t, err)
+	require.Len(t, corrections, 1)
+	assert.Equal(
+		t,
+		jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE,
+		corrections[0].GetType(),
+	)
+	assert.Equal(t, "Forgot to clock out", corrections[0].GetReason())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreReviewTimeclockCorrectionNotPending(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_job_timeclock_corrections`) +
+		`(?s).*` + regexp.QuoteMeta(`fivenet_job_timeclock_corrections.status = ?`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := store.ReviewTimeclockCorrection(t.Context(), store.db, &jobstimeclock.TimeclockCorrection{
+		Id:         3,
+		Job:        "police",
+		Status:     jobstimeclock.TimeclockCorrectionStatus_TIMECLOCK_CORRECTION_STATUS_APPROVED,
+		ReviewerId: proto.Int32(2),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreApplyTimeclockCorrectionClosesEntryWithoutStartTime(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	start := time.Date(2026, 10, 1, 18, 0, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE fivenet_job_timeclock SET start_time = TIMESTAMP(?), end_time = TIMESTAMP(?), spent_time = ?`) +
+		`(?s).*` + regexp.QuoteMeta(`fivenet_job_timeclock.start_time IS NULL`) +
+		`(?s).*` + regexp.QuoteMeta(`fivenet_job_timeclock.end_time IS NULL`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := store.ApplyTimeclockCorrection(t.Context(), store.db, &jobstimeclock.TimeclockCorrection{
+		Job:               "police",
+		UserId:            5,
+		Type:              jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE,
+		Date:              timestamp.New(start),
+		OriginalSpentTime: proto.Float32(1),
+		StartTime:         timestamp.New(start),
+		EndTime:           timestamp.New(start.Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreApplyTimeclockCorrectionRequiresTimes(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	err := store.ApplyTimeclockCorrection(t.Context(), store.db, &jobstimeclock.TimeclockCorrection{
+		Job:    "police",
+		UserId: 5,
+		Type:   jobstimeclock.TimeclockCorrectionType_TIMECLOCK_CORRECTION_TYPE_CLOSE,
+	})
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
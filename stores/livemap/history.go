@@ -0,0 +1,176 @@
+package livemapstore
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+func (s *Store) AddPositionSamples(
+	ctx context.Context,
+	samples []*livemaphistory.PositionSample,
+) error {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	tHistory := table.FivenetCentrumUserLocationsHistory
+	stmt := tHistory.
+		INSERT(
+			tHistory.CreatedAt,
+			tHistory.UserID,
+			tHistory.Job,
+			tHistory.JobGrade,
+			tHistory.UnitID,
+			tHistory.X,
+			tHistory.Y,
+		)
+
+	for _, sample := range samples {
+		stmt = stmt.VALUES(
+			sample.GetCreatedAt(),
+			sample.GetUserId(),
+			sample.GetJob(),
+			sample.GetJobGrade(),
+			sample.UnitId,
+			sample.GetX(),
+			sample.GetY(),
+		)
+	}
+
+	_, err := stmt.ExecContext(ctx, s.db)
+	return err
+}
+
+// ListPositionSamples returns the position samples of a user or a unit's members (by the unit they were in at the time)
+// between start and end ordered by time. When there are more than limit samples, the most recent ones are returned.
+// Samples are filtered by the jobs and grades the users had at the time, nil jobs returns the samples of all jobs.
+func (s *Store) ListPositionSamples(
+	ctx context.Context,
+	userID *int32,
+	unitID *int64,
+	jobs *permissionsattributes.JobGradeList,
+	start time.Time,
+	end time.Time,
+	limit int64,
+) ([]*livemaphistory.PositionSample, error) {
+	tHistory := table.FivenetCentrumUserLocationsHistory.AS("position_sample")
+
+	condition := tHistory.CreatedAt.BETWEEN(
+		mysql.TimestampT(start),
+		mysql.TimestampT(end),
+	)
+	if jobs != nil {
+		condition = condition.AND(positionSamplesJobsCondition(tHistory, jobs))
+	}
+	if userID != nil {
+		condition = condition.AND(tHistory.UserID.EQ(mysql.Int32(*userID)))
+	}
+	if unitID != nil {
+		condition = condition.AND(tHistory.UnitID.EQ(mysql.Int64(*unitID)))
+	}
+
+	stmt := tHistory.
+		SELECT(
+			tHistory.CreatedAt,
+			tHistory.UserID,
+			tHistory.Job,
+			tHistory.JobGrade,
+			tHistory.UnitID,
+			tHistory.X,
+			tHistory.Y,
+		).
+		FROM(
+			tHistory,
+		).
+		WHERE(condition).
+		ORDER_BY(
+			tHistory.CreatedAt.DESC(),
+			tHistory.ID.DESC(),
+		).
+		LIMIT(limit)
+
+	var dest []*livemaphistory.PositionSample
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*livemaphistory.PositionSample{}, nil
+		}
+		return nil, err
+	}
+
+	// Queried newest first so the limit keeps the most recent samples
+	slices.Reverse(dest)
+
+	return dest, nil
+}
+
+// positionSamplesJobsCondition matches the samples of the jobs and grades, same as the tracker's user marker filters.
+func positionSamplesJobsCondition(
+	tHistory *table.FivenetCentrumUserLocationsHistoryTable,
+	jobs *permissionsattributes.JobGradeList,
+) mysql.BoolExpression {
+	names := []string{}
+	grades := map[string][]int32{}
+	for job, jobGrades := range jobs.Iter() {
+		if len(jobGrades) == 0 {
+			continue
+		}
+		names = append(names, job)
+		grades[job] = jobGrades
+	}
+	if len(names) == 0 {
+		return mysql.Bool(false)
+	}
+	slices.Sort(names)
+
+	conditions := make([]mysql.BoolExpression, 0, len(names))
+	for _, job := range names {
+		var gradeCondition mysql.BoolExpression
+		if jobs.GetFineGrained() {
+			values := make([]mysql.Expression, len(grades[job]))
+			for i, grade := range grades[job] {
+				values[i] = mysql.Int32(grade)
+			}
+			gradeCondition = tHistory.JobGrade.IN(values...)
+		} else {
+			gradeCondition = tHistory.JobGrade.LT_EQ(mysql.Int32(grades[job][0]))
+		}
+
+		conditions = append(conditions, mysql.AND(
+			tHistory.Job.EQ(mysql.String(job)),
+			gradeCondition,
+		))
+	}
+
+	return mysql.OR(conditions...)
+}
+
+// DeletePositionSamples deletes up to limit position samples created before the given time.
+func (s *Store) DeletePositionSamples(
+	ctx context.Context,
+	before time.Time,
+	limit int64,
+) (int64, error) {
+	tHistory := table.FivenetCentrumUserLocationsHistory
+
+	stmt := tHistory.
+		DELETE().
+		WHERE(
+			tHistory.CreatedAt.LT(mysql.TimestampT(before)),
+		).
+		LIMIT(limit)
+
+	res, err := stmt.ExecContext(ctx, s.db)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
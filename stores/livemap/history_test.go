@@ -0,0 +1,95 @@
+package livemapstore
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
+	"github.com/fivenet-app/fivenet/v2026/pkg/mstlystcdata"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreAddPositionSamples(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, Enricher: mstlystcdata.NewDummyEnricher()})
+	now := timestamp.New(time.Unix(100, 0).UTC())
+	samples := []*livemaphistory.PositionSample{
+		{CreatedAt: now, UserId: 1, Job: "police", JobGrade: 2, UnitId: new(int64(5)), X: 1.5, Y: 2.5},
+		{CreatedAt: now, UserId: 2, Job: "ambulance", JobGrade: 1, X: 3.5, Y: 4.5},
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_centrum_user_locations_history`)+`(?s).*`+regexp.QuoteMeta(`VALUES (?, ?, ?, ?, ?, ?, ?),`)).
+		WithArgs(
+			samples[0].GetCreatedAt(), int32(1), "police", int32(2), samples[0].UnitId, 1.5, 2.5,
+			samples[1].GetCreatedAt(), int32(2), "ambulance", int32(1), nil, 3.5, 4.5,
+		).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+
+	require.NoError(t, store.AddPositionSamples(t.Context(), samples))
+	// No samples, no query
+	require.NoError(t, store.AddPositionSamples(t.Context(), nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreDeletePositionSamples(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, Enricher: mstlystcdata.NewDummyEnricher()})
+	before := time.Unix(100, 0).UTC()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM fivenet_centrum_user_locations_history`)+`(?s).*`+regexp.QuoteMeta(`fivenet_centrum_user_locations_history.created_at < TIMESTAMP(?)`)+`(?s).*`+regexp.QuoteMeta(`LIMIT ?;`)).
+		WithArgs(before, int64(250)).
+		WillReturnResult(sqlmock.NewResult(0, 42))
+
+	deleted, err := store.DeletePositionSamples(t.Context(), before, 250)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), deleted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPositionSamplesJobsCondition(t *testing.T) {
+	t.Parallel()
+
+	tHistory := table.FivenetCentrumUserLocationsHistory.AS("position_sample")
+	render := func(jobs *permissionsattributes.JobGradeList) string {
+		sql := tHistory.
+			SELECT(tHistory.ID).
+			FROM(tHistory).
+			WHERE(positionSamplesJobsCondition(tHistory, jobs)).
+			DebugSql()
+		return strings.Join(strings.Fields(sql), " ")
+	}
+
+	sql := render(&permissionsattributes.JobGradeList{
+		Jobs: map[string]int32{"police": 3, "ambulance": 1},
+	})
+	assert.Contains(t, sql, "( (position_sample.job = 'ambulance') AND (position_sample.job_grade <= 1) )"+
+		" OR ( (position_sample.job = 'police') AND (position_sample.job_grade <= 3) )")
+
+	sql = render(&permissionsattributes.JobGradeList{
+		FineGrained: true,
+		Grades: map[string]*permissionsattributes.JobGrades{
+			"police": {Grades: []int32{1, 4}},
+		},
+	})
+	assert.Contains(t, sql, "(position_sample.job = 'police') AND (position_sample.job_grade IN (1, 4))")
+
+	// No visible jobs, no samples
+	assert.Contains(t, render(&permissionsattributes.JobGradeList{}), "WHERE FALSE;")
+}
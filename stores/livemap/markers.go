@@ -32,6 +32,7 @@ func (s *Store) CreateMarker(
 			tMarkers.Color,
 			tMarkers.MarkerType,
 			tMarkers.MarkerData,
+			tMarkers.Geofence,
 			tMarkers.CreatorID,
 		).
 		VALUES(
@@ -46,6 +47,7 @@ func (s *Store) CreateMarker(
 			marker.Color,
 			marker.GetType(),
 			marker.GetData(),
+			marker.GetGeofence(),
 			creatorID,
 		)
 
@@ -91,6 +93,7 @@ func (s *Store) UpdateMarker(
 			tMarkers.Public,
 			tMarkers.MarkerType,
 			tMarkers.MarkerData,
+			tMarkers.Geofence,
 		).
 		SET(
 			marker.GetExpiresAt(),
@@ -103,6 +106,7 @@ func (s *Store) UpdateMarker(
 			marker.GetPublic(),
 			marker.GetType(),
 			marker.GetData(),
+			marker.GetGeofence(),
 		).
 		WHERE(mysql.AND(
 			tMarkers.Job.EQ(mysql.String(job)),
@@ -180,6 +184,7 @@ func (s *Store) GetMarker(ctx context.Context, id int64) (*livemapmarkers.Marker
 			tMarkers.Color,
 			tMarkers.MarkerType,
 			tMarkers.MarkerData,
+			tMarkers.Geofence,
 			tMarkers.CreatorID,
 			tUsers.ID,
 			tUsers.Job,
@@ -231,6 +236,7 @@ func (s *Store) ListActiveMarkers(ctx context.Context) ([]*livemapmarkers.Marker
 			tMarkers.Color,
 			tMarkers.MarkerType,
 			tMarkers.MarkerData,
+			tMarkers.Geofence,
 			tMarkers.CreatorID,
 			tUsers.ID,
 			tUsers.Job,
@@ -269,6 +275,50 @@ func (s *Store) ListActiveMarkers(ctx context.Context) ([]*livemapmarkers.Marker
 	return dest, nil
 }
 
+func (s *Store) ListGeofenceMarkers(ctx context.Context) ([]*livemapmarkers.MarkerMarker, error) {
+	tMarkers := table.FivenetCentrumMarkers.AS("marker_marker")
+
+	stmt := tMarkers.
+		SELECT(
+			tMarkers.ID,
+			tMarkers.Job,
+			tMarkers.Name,
+			tMarkers.X,
+			tMarkers.Y,
+			tMarkers.MarkerType,
+			tMarkers.MarkerData,
+			tMarkers.Geofence,
+		).
+		FROM(
+			tMarkers,
+		).
+		WHERE(mysql.AND(
+			tMarkers.DeletedAt.IS_NULL(),
+			mysql.OR(
+				tMarkers.ExpiresAt.IS_NULL(),
+				tMarkers.ExpiresAt.GT(mysql.CURRENT_TIMESTAMP()),
+			),
+			tMarkers.Geofence.IS_NOT_NULL(),
+			tMarkers.MarkerType.IN(
+				mysql.Int32(int32(livemapmarkers.MarkerType_MARKER_TYPE_CIRCLE)),
+				mysql.Int32(int32(livemapmarkers.MarkerType_MARKER_TYPE_POLYGON)),
+			),
+		)).
+		ORDER_BY(
+			tMarkers.ID.ASC(),
+		)
+
+	var dest []*livemapmarkers.MarkerMarker
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*livemapmarkers.MarkerMarker{}, nil
+		}
+		return nil, err
+	}
+
+	return dest, nil
+}
+
 func (s *Store) ListDeletedMarkers(ctx context.Context) ([]*livemapmarkers.MarkerMarker, error) {
 	tMarkers := table.FivenetCentrumMarkers.AS("marker_marker")
 
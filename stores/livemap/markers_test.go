@@ -42,7 +42,7 @@ func TestStoreCreateMarker(t *testing.T) {
 		`creator_id`,
 	)
 	mock.ExpectExec(expectedQuery).
-		WithArgs(marker.GetExpiresAt(), "police", marker.GetPublic(), marker.GetName(), marker.Description, marker.GetX(), marker.GetY(), marker.Postal, marker.Color, marker.GetType(), marker.GetData(), int32(3)).
+		WithArgs(marker.GetExpiresAt(), "police", marker.GetPublic(), marker.GetName(), marker.Description, marker.GetX(), marker.GetY(), marker.Postal, marker.Color, marker.GetType(), marker.GetData(), marker.GetGeofence(), int32(3)).
 		WillReturnResult(sqlmock.NewResult(55, 1))
 	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_centrum_markers AS marker_marker`)+`(?s).*`+regexp.QuoteMeta(`LEFT JOIN fivenet_user AS user_short ON`)+`(?s).*`+regexp.QuoteMeta(`marker_marker.id = ?`)+`(?s).*`+regexp.QuoteMeta(`LIMIT ?;`)).
 		WithArgs(int64(55), int64(1)).
@@ -126,7 +126,7 @@ func TestStoreCreateMarkerAllowsNilCreatorID(t *testing.T) {
 		`creator_id`,
 	)
 	mock.ExpectExec(expectedQuery).
-		WithArgs(marker.GetExpiresAt(), "police", marker.GetPublic(), marker.GetName(), marker.Description, marker.GetX(), marker.GetY(), marker.Postal, marker.Color, marker.GetType(), marker.GetData(), nil).
+		WithArgs(marker.GetExpiresAt(), "police", marker.GetPublic(), marker.GetName(), marker.Description, marker.GetX(), marker.GetY(), marker.Postal, marker.Color, marker.GetType(), marker.GetData(), marker.GetGeofence(), nil).
 		WillReturnResult(sqlmock.NewResult(55, 1))
 	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_centrum_markers AS marker_marker`)+`(?s).*`+regexp.QuoteMeta(`LEFT JOIN fivenet_user AS user_short ON`)+`(?s).*`+regexp.QuoteMeta(`marker_marker.id = ?`)+`(?s).*`+regexp.QuoteMeta(`LIMIT ?;`)).
 		WithArgs(int64(55), int64(1)).
@@ -212,7 +212,7 @@ func TestStoreUpdateMarker(t *testing.T) {
 		`LIMIT ?;`,
 	)
 	mock.ExpectExec(expectedQuery).
-		WithArgs(nil, marker.GetName(), marker.Description, marker.GetX(), marker.GetY(), marker.Postal, marker.Color, marker.GetPublic(), marker.GetType(), marker.GetData(), "police", int64(42), int64(1)).
+		WithArgs(nil, marker.GetName(), marker.Description, marker.GetX(), marker.GetY(), marker.Postal, marker.Color, marker.GetPublic(), marker.GetType(), marker.GetData(), marker.GetGeofence(), "police", int64(42), int64(1)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_centrum_markers AS marker_marker`)+`(?s).*`+regexp.QuoteMeta(`LEFT JOIN fivenet_user AS user_short ON`)+`(?s).*`+regexp.QuoteMeta(`marker_marker.id = ?`)+`(?s).*`+regexp.QuoteMeta(`LIMIT ?;`)).
 		WithArgs(int64(42), int64(1)).
@@ -3,8 +3,11 @@ package livemapstore
 import (
 	"context"
 	"database/sql"
+	"time"
 
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
 	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	"github.com/fivenet-app/fivenet/v2026/pkg/events"
 	"github.com/fivenet-app/fivenet/v2026/pkg/mstlystcdata"
@@ -23,6 +26,19 @@ type IStore interface {
 	GetMarker(ctx context.Context, id int64) (*livemapmarkers.MarkerMarker, error)
 	ListActiveMarkers(ctx context.Context) ([]*livemapmarkers.MarkerMarker, error)
 	ListDeletedMarkers(ctx context.Context) ([]*livemapmarkers.MarkerMarker, error)
+	ListGeofenceMarkers(ctx context.Context) ([]*livemapmarkers.MarkerMarker, error)
+
+	AddPositionSamples(ctx context.Context, samples []*livemaphistory.PositionSample) error
+	ListPositionSamples(
+		ctx context.Context,
+		userID *int32,
+		unitID *int64,
+		jobs *permissionsattributes.JobGradeList,
+		start time.Time,
+		end time.Time,
+		limit int64,
+	) ([]*livemaphistory.PositionSample, error)
+	DeletePositionSamples(ctx context.Context, before time.Time, limit int64) (int64, error)
 }
 
 const (
@@ -31,6 +31,7 @@ func (s *Store) GetExamUser(
 			tExamUser.StartedAt,
 			tExamUser.EndsAt,
 			tExamUser.EndedAt,
+			tExamUser.Questions,
 		).
 		FROM(tExamUser).
 		WHERE(mysql.AND(
@@ -66,6 +67,7 @@ func (s *Store) GetExamQuestions(
 		tExamQuestion.Description,
 		tExamQuestion.Data,
 		tExamQuestion.Points,
+		tExamQuestion.Tags,
 	}
 	if withAnswers {
 		columns = append(columns, tExamQuestion.Answer)
@@ -198,6 +200,7 @@ func (s *Store) CreateExamUser(
 	qualificationId int64,
 	userId int32,
 	endsAt time.Time,
+	questions *qualificationsexam.ExamUserQuestions,
 ) error {
 	tExamUser := table.FivenetQualificationsExamUsers
 	stmt := tExamUser.
@@ -207,6 +210,7 @@ func (s *Store) CreateExamUser(
 			tExamUser.StartedAt,
 			tExamUser.EndsAt,
 			tExamUser.EndedAt,
+			tExamUser.Questions,
 		).
 		VALUES(
 			qualificationId,
@@ -214,6 +218,7 @@ func (s *Store) CreateExamUser(
 			mysql.CURRENT_TIMESTAMP(),
 			mysql.TimestampT(endsAt),
 			mysql.NULL,
+			questions,
 		)
 
 	_, err := stmt.ExecContext(ctx, tx)
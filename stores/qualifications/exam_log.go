@@ -0,0 +1,140 @@
+package qualificationsstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	resqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	qualificationsexam "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/exam"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// ExamLogListLimit is the maximum amount of log entries returned for an exam attempt.
+const ExamLogListLimit = 1000
+
+// ExamAttempts are the failed exam attempts of a user.
+type ExamAttempts struct {
+	Failed       int64      `alias:"failed"`
+	LastFailedAt *time.Time `alias:"last_failed_at"`
+}
+
+// GetExamAttempts counts the user's failed results of the qualification. Deleted results don't
+// count, so deleting a failed result grants the user another attempt.
+func (s *Store) GetExamAttempts(
+	ctx context.Context,
+	qualificationId int64,
+	userId int32,
+) (*ExamAttempts, error) {
+	stmt := tQualiResult.
+		SELECT(
+			mysql.COUNT(tQualiResult.ID).AS("exam_attempts.failed"),
+			mysql.MAX(tQualiResult.CreatedAt).AS("exam_attempts.last_failed_at"),
+		).
+		FROM(tQualiResult).
+		WHERE(mysql.AND(
+			tQualiResult.QualificationID.EQ(mysql.Int64(qualificationId)),
+			tQualiResult.UserID.EQ(mysql.Int32(userId)),
+			tQualiResult.DeletedAt.IS_NULL(),
+			tQualiResult.Status.EQ(
+				mysql.Int32(int32(resqualifications.ResultStatus_RESULT_STATUS_FAILED)),
+			),
+		))
+
+	dest := &ExamAttempts{}
+	if err := stmt.QueryContext(ctx, s.db, dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (s *Store) CreateExamLogEntry(
+	ctx context.Context,
+	tx qrm.DB,
+	entry *qualificationsexam.ExamLogEntry,
+) error {
+	stmt := tExamLog.
+		INSERT(
+			tExamLog.QualificationID,
+			tExamLog.UserID,
+			tExamLog.Type,
+			tExamLog.Data,
+		).
+		VALUES(
+			entry.GetQualificationId(),
+			entry.GetUserId(),
+			int32(entry.GetType()),
+			entry.GetData(),
+		)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+func (s *Store) CountExamLogEntries(
+	ctx context.Context,
+	qualificationId int64,
+	userId int32,
+	since time.Time,
+	eventType qualificationsexam.ExamLogEventType,
+) (int64, error) {
+	stmt := tExamLog.
+		SELECT(
+			mysql.COUNT(tExamLog.ID).AS("data_count.total"),
+		).
+		FROM(tExamLog).
+		WHERE(mysql.AND(
+			tExamLog.QualificationID.EQ(mysql.Int64(qualificationId)),
+			tExamLog.UserID.EQ(mysql.Int32(userId)),
+			tExamLog.CreatedAt.GT_EQ(mysql.TimestampT(since)),
+			tExamLog.Type.EQ(mysql.Int32(int32(eventType))),
+		))
+
+	var count database.DataCount
+	if err := stmt.QueryContext(ctx, s.db, &count); err != nil {
+		return 0, err
+	}
+
+	return count.Total, nil
+}
+
+// ListExamLog returns the user's exam log entries created since the given time (usually the start
+// of the current attempt) in chronological order.
+func (s *Store) ListExamLog(
+	ctx context.Context,
+	qualificationId int64,
+	userId int32,
+	since time.Time,
+) ([]*qualificationsexam.ExamLogEntry, error) {
+	stmt := tExamLog.
+		SELECT(
+			tExamLog.ID,
+			tExamLog.CreatedAt,
+			tExamLog.QualificationID,
+			tExamLog.UserID,
+			tExamLog.Type,
+			tExamLog.Data,
+		).
+		FROM(tExamLog).
+		WHERE(mysql.AND(
+			tExamLog.QualificationID.EQ(mysql.Int64(qualificationId)),
+			tExamLog.UserID.EQ(mysql.Int32(userId)),
+			tExamLog.CreatedAt.GT_EQ(mysql.TimestampT(since)),
+		)).
+		ORDER_BY(tExamLog.ID.ASC()).
+		LIMIT(ExamLogListLimit)
+
+	dest := []*qualificationsexam.ExamLogEntry{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
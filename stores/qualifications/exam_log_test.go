@@ -0,0 +1,98 @@
+package qualificationsstore
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	resqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	qualificationsexam "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications/exam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreGetExamAttempts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	expectedQuery := regexp.QuoteMeta(
+		`FROM fivenet_qualifications_results AS qualification_result`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(`qualification_result.deleted_at IS NULL`) +
+		`(?s).*` + regexp.QuoteMeta(`qualification_result.status = ?`)
+
+	lastFailedAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(expectedQuery).
+		WithArgs(
+			int64(42),
+			int32(7),
+			int32(resqualifications.ResultStatus_RESULT_STATUS_FAILED),
+		).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"exam_attempts.failed",
+			"exam_attempts.last_failed_at",
+		}).AddRow(int64(2), lastFailedAt))
+
+	attempts, err := store.GetExamAttempts(t.Context(), 42, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), attempts.Failed)
+	require.NotNil(t, attempts.LastFailedAt)
+	assert.Equal(t, lastFailedAt, *attempts.LastFailedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreListExamLog(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	expectedQuery := regexp.QuoteMeta(
+		`FROM fivenet_qualifications_exam_log AS exam_log_entry`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(`exam_log_entry.created_at >= TIMESTAMP(?)`) +
+		`(?s).*` + regexp.QuoteMeta(`ORDER BY exam_log_entry.id ASC`)
+
+	startedAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(expectedQuery).
+		WithArgs(int64(42), int32(7), startedAt, int64(ExamLogListLimit)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"exam_log_entry.id",
+			"exam_log_entry.created_at",
+			"exam_log_entry.qualification_id",
+			"exam_log_entry.user_id",
+			"exam_log_entry.type",
+			"exam_log_entry.data",
+		}).
+			AddRow(int64(1), startedAt, int64(42), int32(7), int32(1), nil).
+			AddRow(
+				int64(2), startedAt.Add(time.Minute), int64(42), int32(7), int32(3),
+				`{"duration":"12s"}`,
+			))
+
+	entries, err := store.ListExamLog(t.Context(), 42, 7, startedAt)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(
+		t,
+		qualificationsexam.ExamLogEventType_EXAM_LOG_EVENT_TYPE_STARTED,
+		entries[0].GetType(),
+	)
+	assert.Nil(t, entries[0].GetData())
+	assert.Equal(
+		t,
+		qualificationsexam.ExamLogEventType_EXAM_LOG_EVENT_TYPE_FOCUS_LOST,
+		entries[1].GetType(),
+	)
+	assert.Equal(t, 12*time.Second, entries[1].GetData().GetDuration().AsDuration())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
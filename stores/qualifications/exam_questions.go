@@ -65,6 +65,7 @@ func (s *Store) HandleExamQuestionsChanges(
 				tExamQuestion.Answer,
 				tExamQuestion.Points,
 				tExamQuestion.Order,
+				tExamQuestion.Tags,
 			).
 			VALUES(
 				qualificationId,
@@ -74,6 +75,7 @@ func (s *Store) HandleExamQuestionsChanges(
 				question.GetAnswer(),
 				question.GetPoints(),
 				question.GetOrder(),
+				question.GetTags(),
 			)
 
 		if _, err := stmt.ExecContext(ctx, tx); err != nil {
@@ -100,6 +102,7 @@ func (s *Store) HandleExamQuestionsChanges(
 				tExamQuestion.Answer,
 				tExamQuestion.Points,
 				tExamQuestion.Order,
+				tExamQuestion.Tags,
 			).
 			SET(
 				question.GetTitle(),
@@ -108,6 +111,7 @@ func (s *Store) HandleExamQuestionsChanges(
 				question.GetAnswer(),
 				question.Points,
 				question.GetOrder(),
+				question.GetTags(),
 			).
 			WHERE(mysql.AND(
 				tExamQuestion.ID.EQ(mysql.Int64(question.GetId())),
@@ -34,13 +34,20 @@ func TestStoreGetExamUser(t *testing.T) {
 			"exam_user.started_at",
 			"exam_user.ends_at",
 			"exam_user.ended_at",
-		}).AddRow(int64(42), int32(7), now, now, now, nil))
+			"exam_user.questions",
+		}).AddRow(
+			int64(42), int32(7), now, now, now, nil,
+			`{"questions":[{"questionId":"3","choices":["B","A"]},{"questionId":"1"}]}`,
+		))
 
 	examUser, err := store.GetExamUser(t.Context(), 42, 7)
 	require.NoError(t, err)
 	require.NotNil(t, examUser)
 	assert.Equal(t, int64(42), examUser.GetQualificationId())
 	assert.Equal(t, int32(7), examUser.GetUserId())
+	require.Len(t, examUser.GetQuestions().GetQuestions(), 2)
+	assert.Equal(t, int64(3), examUser.GetQuestions().GetQuestions()[0].GetQuestionId())
+	assert.Equal(t, []string{"B", "A"}, examUser.GetQuestions().GetQuestions()[0].GetChoices())
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
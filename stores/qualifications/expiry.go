@@ -0,0 +1,283 @@
+package qualificationsstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	resqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// ExpiringQualificationResult is a successful qualification result with a validity period.
+type ExpiringQualificationResult struct {
+	ResultID         int64     `alias:"result_id"`
+	QualificationID  int64     `alias:"qualification_id"`
+	UserID           int32     `alias:"user_id"`
+	ExpiresAt        time.Time `alias:"expires_at"`
+	Job              string    `alias:"job"`
+	Abbreviation     string    `alias:"abbreviation"`
+	Title            string    `alias:"title"`
+	LabelSyncEnabled bool      `alias:"label_sync_enabled"`
+	LabelSyncFormat  *string   `alias:"label_sync_format"`
+}
+
+// qualificationExpiresAt returns the expiry time of a result that becomes successful now, NULL
+// when the qualification has no validity period.
+func qualificationExpiresAt(qualificationId int64) mysql.TimestampExpression {
+	tQuali := table.FivenetQualifications
+
+	return mysql.TimestampExp(
+		tQuali.
+			SELECT(
+				mysql.CURRENT_TIMESTAMP().ADD(mysql.INTERVALe(tQuali.ValidityDays, mysql.DAY)),
+			).
+			FROM(tQuali).
+			WHERE(tQuali.ID.EQ(mysql.Int64(qualificationId))),
+	)
+}
+
+// updateQualificationResultValidity must be called before a result's status is changed. A result
+// becoming successful starts its validity period, pending and failed results don't expire.
+func (s *Store) updateQualificationResultValidity(
+	ctx context.Context,
+	tx qrm.DB,
+	qualificationId int64,
+	resultId int64,
+	status resqualifications.ResultStatus,
+) error {
+	tQualiResult := table.FivenetQualificationsResults
+
+	condition := mysql.AND(
+		tQualiResult.ID.EQ(mysql.Int64(resultId)),
+		tQualiResult.DeletedAt.IS_NULL(),
+	)
+
+	successfulAt := mysql.TimestampExp(mysql.NULL)
+	expiresAt := mysql.TimestampExp(mysql.NULL)
+	switch status {
+	case resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL:
+		// Keep the validity of results that already are successful
+		successfulAt = mysql.CURRENT_TIMESTAMP()
+		expiresAt = qualificationExpiresAt(qualificationId)
+		condition = condition.AND(tQualiResult.Status.NOT_EQ(
+			mysql.Int32(int32(resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL)),
+		))
+
+	case resqualifications.ResultStatus_RESULT_STATUS_EXPIRED:
+		return nil
+	}
+
+	stmt := tQualiResult.
+		UPDATE().
+		SET(
+			tQualiResult.SuccessfulAt.SET(successfulAt),
+			tQualiResult.ExpiresAt.SET(expiresAt),
+			tQualiResult.ExpiryReminderSentAt.SET(mysql.TimestampExp(mysql.NULL)),
+		).
+		WHERE(condition).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+// getQualificationValidityDays returns the qualification's current validity period (in days).
+func (s *Store) getQualificationValidityDays(
+	ctx context.Context,
+	tx qrm.DB,
+	qualificationId int64,
+) (*int32, error) {
+	tQuali := table.FivenetQualifications
+
+	stmt := tQuali.
+		SELECT(
+			tQuali.ValidityDays.AS("validity_days"),
+		).
+		FROM(tQuali).
+		WHERE(tQuali.ID.EQ(mysql.Int64(qualificationId))).
+		LIMIT(1)
+
+	var dest struct {
+		ValidityDays *int32 `alias:"validity_days"`
+	}
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest.ValidityDays, nil
+}
+
+// updateQualificationResultsValidity recalculates the expiry of the qualification's successful
+// results from the date they became successful after the validity period has been changed.
+// Reminders are only sent again for results whose expiry has moved.
+func (s *Store) updateQualificationResultsValidity(
+	ctx context.Context,
+	tx qrm.DB,
+	qualificationId int64,
+	validityDays *int32,
+) error {
+	tQualiResult := table.FivenetQualificationsResults
+
+	expiresAt := mysql.TimestampExp(mysql.NULL)
+	if validityDays != nil {
+		expiresAt = mysql.TimestampExp(
+			mysql.COALESCE(tQualiResult.SuccessfulAt, tQualiResult.CreatedAt),
+		).ADD(mysql.INTERVAL(*validityDays, mysql.DAY))
+	}
+
+	stmt := tQualiResult.
+		UPDATE().
+		SET(
+			tQualiResult.ExpiresAt.SET(expiresAt),
+			tQualiResult.ExpiryReminderSentAt.SET(mysql.TimestampExp(mysql.NULL)),
+		).
+		WHERE(mysql.AND(
+			tQualiResult.QualificationID.EQ(mysql.Int64(qualificationId)),
+			tQualiResult.DeletedAt.IS_NULL(),
+			tQualiResult.Status.EQ(
+				mysql.Int32(int32(resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL)),
+			),
+			mysql.NOT(tQualiResult.ExpiresAt.IS_NOT_DISTINCT_FROM(expiresAt)),
+		))
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+// ListQualificationResultsDueForReminder returns successful results expiring within the given
+// period for which no reminder has been sent yet.
+func (s *Store) ListQualificationResultsDueForReminder(
+	ctx context.Context,
+	period time.Duration,
+	limit int64,
+) ([]*ExpiringQualificationResult, error) {
+	return s.listExpiringQualificationResults(ctx, mysql.AND(
+		tQualiResult.ExpiresAt.LT_EQ(
+			mysql.CURRENT_TIMESTAMP().ADD(mysql.INTERVALd(period)),
+		),
+		tQualiResult.ExpiryReminderSentAt.IS_NULL(),
+	), limit)
+}
+
+// ListLapsedQualificationResults returns successful results that have expired and whose grace
+// period has passed.
+func (s *Store) ListLapsedQualificationResults(
+	ctx context.Context,
+	limit int64,
+) ([]*ExpiringQualificationResult, error) {
+	return s.listExpiringQualificationResults(ctx, tQualiResult.ExpiresAt.
+		ADD(mysql.INTERVALe(mysql.COALESCE(tQuali.GracePeriodDays, mysql.Int32(0)), mysql.DAY)).
+		LT_EQ(mysql.CURRENT_TIMESTAMP()),
+		limit,
+	)
+}
+
+func (s *Store) listExpiringQualificationResults(
+	ctx context.Context,
+	condition mysql.BoolExpression,
+	limit int64,
+) ([]*ExpiringQualificationResult, error) {
+	stmt := tQualiResult.
+		SELECT(
+			tQualiResult.ID.AS("expiring_qualification_result.result_id"),
+			tQualiResult.QualificationID.AS("expiring_qualification_result.qualification_id"),
+			tQualiResult.UserID.AS("expiring_qualification_result.user_id"),
+			tQualiResult.ExpiresAt.AS("expiring_qualification_result.expires_at"),
+			tQuali.Job.AS("expiring_qualification_result.job"),
+			tQuali.Abbreviation.AS("expiring_qualification_result.abbreviation"),
+			tQuali.Title.AS("expiring_qualification_result.title"),
+			tQuali.LabelSyncEnabled.AS("expiring_qualification_result.label_sync_enabled"),
+			tQuali.LabelSyncFormat.AS("expiring_qualification_result.label_sync_format"),
+		).
+		FROM(
+			tQualiResult.
+				INNER_JOIN(tQuali, tQuali.ID.EQ(tQualiResult.QualificationID)),
+		).
+		WHERE(mysql.AND(
+			tQualiResult.DeletedAt.IS_NULL(),
+			tQualiResult.Status.EQ(
+				mysql.Int32(int32(resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL)),
+			),
+			tQualiResult.ExpiresAt.IS_NOT_NULL(),
+			tQuali.DeletedAt.IS_NULL(),
+			condition,
+		)).
+		ORDER_BY(tQualiResult.ExpiresAt.ASC()).
+		LIMIT(limit)
+
+	dest := []*ExpiringQualificationResult{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (s *Store) SetQualificationResultReminderSent(
+	ctx context.Context,
+	tx qrm.DB,
+	resultId int64,
+) error {
+	tQualiResult := table.FivenetQualificationsResults
+	stmt := tQualiResult.
+		UPDATE().
+		SET(
+			tQualiResult.ExpiryReminderSentAt.SET(mysql.CURRENT_TIMESTAMP()),
+		).
+		WHERE(tQualiResult.ID.EQ(mysql.Int64(resultId))).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+// ExpireQualificationResult sets a successful result to expired and removes it from the success
+// map, which revokes the qualification (Discord role sync, group rules, requirements).
+// Returns false if the result wasn't successful (anymore).
+func (s *Store) ExpireQualificationResult(
+	ctx context.Context,
+	tx qrm.DB,
+	resultId int64,
+) (bool, error) {
+	tQualiResult := table.FivenetQualificationsResults
+	stmt := tQualiResult.
+		UPDATE().
+		SET(
+			tQualiResult.Status.SET(
+				mysql.Int32(int32(resqualifications.ResultStatus_RESULT_STATUS_EXPIRED)),
+			),
+		).
+		WHERE(mysql.AND(
+			tQualiResult.ID.EQ(mysql.Int64(resultId)),
+			tQualiResult.Status.EQ(
+				mysql.Int32(int32(resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL)),
+			),
+		)).
+		LIMIT(1)
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := s.deleteQualificationResultSuccessMapByResultID(ctx, tx, resultId); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,154 @@
+package qualificationsstore
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	resqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStoreListLapsedQualificationResults(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	expectedQuery := regexp.QuoteMeta(
+		`FROM fivenet_qualifications_results AS qualification_result`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(
+		`INNER JOIN fivenet_qualifications AS qualification ON`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(
+		`qualification_result.expires_at IS NOT NULL`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(
+		`COALESCE(qualification.grace_period_days, ?)`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(
+		`ORDER BY qualification_result.expires_at ASC`,
+	)
+
+	expiresAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(expectedQuery).
+		WithArgs(
+			int32(resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL),
+			int32(0),
+			int64(100),
+		).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"expiring_qualification_result.result_id",
+			"expiring_qualification_result.qualification_id",
+			"expiring_qualification_result.user_id",
+			"expiring_qualification_result.expires_at",
+			"expiring_qualification_result.job",
+			"expiring_qualification_result.abbreviation",
+			"expiring_qualification_result.title",
+			"expiring_qualification_result.label_sync_enabled",
+			"expiring_qualification_result.label_sync_format",
+		}).AddRow(
+			int64(9), int64(42), int32(7), expiresAt, "police", "FTO", "Field Training", true, nil,
+		))
+
+	results, err := store.ListLapsedQualificationResults(t.Context(), 100)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int64(9), results[0].ResultID)
+	assert.Equal(t, int64(42), results[0].QualificationID)
+	assert.Equal(t, int32(7), results[0].UserID)
+	assert.Equal(t, expiresAt, results[0].ExpiresAt)
+	assert.Equal(t, "FTO", results[0].Abbreviation)
+	assert.True(t, results[0].LabelSyncEnabled)
+	assert.Nil(t, results[0].LabelSyncFormat)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreExpireQualificationResult(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	updateQuery := regexp.QuoteMeta(`UPDATE fivenet_qualifications_results`) +
+		`(?s).*` + regexp.QuoteMeta(`SET status = ?`)
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM fivenet_qualifications_result_success_map`) +
+		`(?s).*` + regexp.QuoteMeta(`fivenet_qualifications_result_success_map.result_id = ?`)
+
+	mock.ExpectExec(updateQuery).
+		WithArgs(
+			int32(resqualifications.ResultStatus_RESULT_STATUS_EXPIRED),
+			int64(9),
+			int32(resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL),
+			int64(1),
+		).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteQuery).
+		WithArgs(int64(9), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	expired, err := store.ExpireQualificationResult(t.Context(), db, 9)
+	require.NoError(t, err)
+	assert.True(t, expired)
+
+	// Results which aren't successful anymore are left untouched
+	mock.ExpectExec(updateQuery).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	expired, err = store.ExpireQualificationResult(t.Context(), db, 9)
+	require.NoError(t, err)
+	assert.False(t, expired)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreUpdateQualificationValidityChange(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	selectQuery := regexp.QuoteMeta(`SELECT fivenet_qualifications.validity_days AS "validity_days"`)
+	updateQuery := regexp.QuoteMeta(`UPDATE fivenet_qualifications SET`)
+	resultsQuery := regexp.QuoteMeta(`UPDATE fivenet_qualifications_results`) + `(?s).*` +
+		regexp.QuoteMeta(
+			`COALESCE(fivenet_qualifications_results.successful_at, fivenet_qualifications_results.created_at) + INTERVAL 30 DAY`,
+		)
+
+	quali := &resqualifications.Qualification{
+		Id:           42,
+		ValidityDays: proto.Int32(30),
+	}
+
+	// Unchanged validity period keeps the existing results' expiry (and reminders)
+	mock.ExpectQuery(selectQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"validity_days"}).AddRow(int32(30)))
+	mock.ExpectExec(updateQuery).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.UpdateQualification(t.Context(), db, quali))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Changed validity period moves the expiry based on when the results became successful
+	mock.ExpectQuery(selectQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"validity_days"}).AddRow(int32(14)))
+	mock.ExpectExec(updateQuery).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(resultsQuery).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	require.NoError(t, store.UpdateQualification(t.Context(), db, quali))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -240,6 +240,7 @@ func (s *Store) listQualificationsQuery(
 		tQualiResult.Status,
 		tQualiResult.Score,
 		tQualiResult.Summary,
+		tQualiResult.ExpiresAt,
 		tQualiResult.CreatorID,
 	}
 	if includePhoneNumber {
@@ -309,11 +310,14 @@ func (s *Store) getQualificationQuery(
 		tQuali.DiscordSettings,
 		tQuali.LabelSyncEnabled,
 		tQuali.LabelSyncFormat,
+		tQuali.ValidityDays,
+		tQuali.GracePeriodDays,
 		tQualiResult.ID,
 		tQualiResult.QualificationID,
 		tQualiResult.Status,
 		tQualiResult.Score,
 		tQualiResult.Summary,
+		tQualiResult.ExpiresAt,
 		tQualiResult.CreatorID,
 	}
 
@@ -66,6 +66,12 @@ func (s *Store) UpdateQualification(
 	}
 
 	tQuali := table.FivenetQualifications
+
+	validityDays, err := s.getQualificationValidityDays(ctx, tx, quali.GetId())
+	if err != nil {
+		return err
+	}
+
 	stmt := tQuali.
 		UPDATE(
 			tQuali.Weight,
@@ -83,6 +89,8 @@ func (s *Store) UpdateQualification(
 			tQuali.ExamSettings,
 			tQuali.LabelSyncEnabled,
 			tQuali.LabelSyncFormat,
+			tQuali.ValidityDays,
+			tQuali.GracePeriodDays,
 		).
 		SET(
 			quali.GetWeight(),
@@ -100,12 +108,23 @@ func (s *Store) UpdateQualification(
 			quali.GetExamSettings(),
 			quali.GetLabelSyncEnabled(),
 			quali.LabelSyncFormat,
+			quali.ValidityDays,
+			quali.GracePeriodDays,
 		).
 		WHERE(tQuali.ID.EQ(mysql.Int64(quali.GetId()))).
 		LIMIT(1)
 
-	_, err := stmt.ExecContext(ctx, tx)
-	return err
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		return err
+	}
+
+	// Only a changed validity period moves the expiry of existing results
+	if (validityDays == nil) == (quali.ValidityDays == nil) &&
+		(validityDays == nil || *validityDays == quali.GetValidityDays()) {
+		return nil
+	}
+
+	return s.updateQualificationResultsValidity(ctx, tx, quali.GetId(), quali.ValidityDays)
 }
 
 func (s *Store) DeleteQualification(
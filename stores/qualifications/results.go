@@ -84,6 +84,7 @@ func (s *Store) ListQualificationsResults(
 		tQualiResult.Status,
 		tQualiResult.Score,
 		tQualiResult.Summary,
+		tQualiResult.ExpiresAt,
 		tQualiResult.CreatorID,
 		tCreator.ID,
 		tCreator.Job,
@@ -264,6 +265,7 @@ func (s *Store) GetQualificationResult(
 		tQualiResult.Status,
 		tQualiResult.Score,
 		tQualiResult.Summary,
+		tQualiResult.ExpiresAt,
 		tQualiResult.CreatorID,
 		tQualiResult.CreatorJob,
 		tCreator.ID,
@@ -342,6 +344,13 @@ func (s *Store) CreateQualificationResult(
 		creatorId = mysql.Int32(creator.GetUserId())
 	}
 
+	var successfulAt mysql.Expression = mysql.NULL
+	var expiresAt mysql.Expression = mysql.NULL
+	if status == resqualifications.ResultStatus_RESULT_STATUS_SUCCESSFUL {
+		successfulAt = mysql.CURRENT_TIMESTAMP()
+		expiresAt = qualificationExpiresAt(qualificationId)
+	}
+
 	tQualiResult := table.FivenetQualificationsResults
 	stmt := tQualiResult.
 		INSERT(
@@ -350,6 +359,8 @@ func (s *Store) CreateQualificationResult(
 			tQualiResult.Status,
 			tQualiResult.Score,
 			tQualiResult.Summary,
+			tQualiResult.SuccessfulAt,
+			tQualiResult.ExpiresAt,
 			tQualiResult.CreatorID,
 			tQualiResult.CreatorJob,
 		).
@@ -359,6 +370,8 @@ func (s *Store) CreateQualificationResult(
 			status,
 			score,
 			summary,
+			successfulAt,
+			expiresAt,
 			creatorId,
 			creator.GetJob(),
 		)
@@ -399,6 +412,16 @@ func (s *Store) UpdateQualificationResult(
 	score *float32,
 	summary string,
 ) error {
+	if err := s.updateQualificationResultValidity(
+		ctx,
+		tx,
+		qualificationId,
+		resultId,
+		status,
+	); err != nil {
+		return err
+	}
+
 	tQualiResult := table.FivenetQualificationsResults
 	stmt := tQualiResult.
 		UPDATE(
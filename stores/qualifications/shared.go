@@ -19,4 +19,8 @@ var (
 	tExamQuestion  = table.FivenetQualificationsExamQuestions.AS("exam_question")
 	tExamResponses = table.FivenetQualificationsExamResponses.AS("exam_response")
 	tExamUser      = table.FivenetQualificationsExamUsers.AS("exam_user")
+	tExamLog       = table.FivenetQualificationsExamLog.AS("exam_log_entry")
+
+	tTrainingPath     = table.FivenetQualificationsTrainingPaths.AS("training_path")
+	tTrainingPathStep = table.FivenetQualificationsTrainingPathSteps.AS("training_path_step")
 )
@@ -176,6 +176,7 @@ type IStore interface {
 		qualificationId int64,
 		userId int32,
 		endsAt time.Time,
+		questions *qualificationsexam.ExamUserQuestions,
 	) error
 	UpsertExamResponses(
 		ctx context.Context,
@@ -212,6 +213,71 @@ type IStore interface {
 	) error
 	DeleteQualificationResult(ctx context.Context, tx qrm.DB, resultId int64) error
 	DeleteExamUser(ctx context.Context, tx qrm.DB, qualificationId int64, userId int32) error
+	ListQualificationResultsDueForReminder(
+		ctx context.Context,
+		period time.Duration,
+		limit int64,
+	) ([]*ExpiringQualificationResult, error)
+	ListLapsedQualificationResults(
+		ctx context.Context,
+		limit int64,
+	) ([]*ExpiringQualificationResult, error)
+	SetQualificationResultReminderSent(ctx context.Context, tx qrm.DB, resultId int64) error
+	ExpireQualificationResult(ctx context.Context, tx qrm.DB, resultId int64) (bool, error)
+	GetExamAttempts(ctx context.Context, qualificationId int64, userId int32) (*ExamAttempts, error)
+	CreateExamLogEntry(ctx context.Context, tx qrm.DB, entry *qualificationsexam.ExamLogEntry) error
+	CountExamLogEntries(
+		ctx context.Context,
+		qualificationId int64,
+		userId int32,
+		since time.Time,
+		eventType qualificationsexam.ExamLogEventType,
+	) (int64, error)
+	ListExamLog(
+		ctx context.Context,
+		qualificationId int64,
+		userId int32,
+		since time.Time,
+	) ([]*qualificationsexam.ExamLogEntry, error)
+	ListTrainingPaths(
+		ctx context.Context,
+		job string,
+		search string,
+	) ([]*resqualifications.TrainingPath, error)
+	GetTrainingPath(
+		ctx context.Context,
+		tx qrm.DB,
+		trainingPathId int64,
+	) (*resqualifications.TrainingPath, error)
+	CreateTrainingPath(
+		ctx context.Context,
+		tx qrm.DB,
+		path *resqualifications.TrainingPath,
+		userInfo *userinfo.UserInfo,
+	) (int64, error)
+	UpdateTrainingPath(ctx context.Context, tx qrm.DB, path *resqualifications.TrainingPath) error
+	DeleteTrainingPath(ctx context.Context, tx qrm.DB, trainingPathId int64) error
+	SetTrainingPathSteps(
+		ctx context.Context,
+		tx qrm.DB,
+		trainingPathId int64,
+		steps []*resqualifications.TrainingPathStep,
+	) error
+	GetUserQualificationStates(
+		ctx context.Context,
+		userId int32,
+		qualificationIds []int64,
+	) (map[int64]bool, map[int64]resqualifications.ResultStatus, error)
+	ListCompletedQualifications(
+		ctx context.Context,
+		userIds []int32,
+		qualificationIds []int64,
+	) (map[int32]map[int64]bool, error)
+	GetUserJobGrade(ctx context.Context, userId int32, job string) (int32, bool, error)
+	ListTrainingMatrix(
+		ctx context.Context,
+		opts TrainingMatrixOptions,
+	) (*pbqualifications.GetTrainingMatrixResponse, error)
 }
 
 type Store struct {
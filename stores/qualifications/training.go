@@ -0,0 +1,534 @@
+package qualificationsstore
+
+import (
+	"context"
+	"errors"
+
+	database "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/database"
+	resqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/userinfo"
+	pbqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/qualifications"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+const (
+	TrainingPathsListLimit = 100
+	TrainingMatrixPageSize = 20
+)
+
+type TrainingMatrixOptions struct {
+	Pagination     *database.PaginationRequest
+	Path           *resqualifications.TrainingPath
+	Search         string
+	PromotionReady bool
+}
+
+func trainingPathColumns() mysql.ProjectionList {
+	return mysql.ProjectionList{
+		tTrainingPath.CreatedAt,
+		tTrainingPath.UpdatedAt,
+		tTrainingPath.DeletedAt,
+		tTrainingPath.Job,
+		tTrainingPath.Grade,
+		tTrainingPath.Name,
+		tTrainingPath.Description,
+		tTrainingPath.CreatorID,
+	}
+}
+
+// ListTrainingPaths returns the job's training paths including their steps.
+func (s *Store) ListTrainingPaths(
+	ctx context.Context,
+	job string,
+	search string,
+) ([]*resqualifications.TrainingPath, error) {
+	condition := mysql.AND(
+		tTrainingPath.Job.EQ(mysql.String(job)),
+		tTrainingPath.DeletedAt.IS_NULL(),
+	)
+	if search = dbutils.PrepareForLikeSearch(search); search != "" {
+		condition = condition.AND(tTrainingPath.Name.LIKE(mysql.String(search)))
+	}
+
+	stmt := tTrainingPath.
+		SELECT(
+			tTrainingPath.ID,
+			trainingPathColumns()...,
+		).
+		FROM(tTrainingPath).
+		WHERE(condition).
+		ORDER_BY(tTrainingPath.Grade.ASC(), tTrainingPath.Name.ASC()).
+		LIMIT(TrainingPathsListLimit)
+
+	dest := []*resqualifications.TrainingPath{}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+	if len(dest) == 0 {
+		return dest, nil
+	}
+
+	pathIds := make([]int64, 0, len(dest))
+	for _, path := range dest {
+		pathIds = append(pathIds, path.GetId())
+	}
+
+	steps, err := s.getTrainingPathSteps(ctx, s.db, pathIds...)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		for _, path := range dest {
+			if path.GetId() == step.GetTrainingPathId() {
+				path.Steps = append(path.Steps, step)
+				break
+			}
+		}
+	}
+
+	return dest, nil
+}
+
+// GetTrainingPath returns the training path with its steps, nil if it doesn't exist.
+func (s *Store) GetTrainingPath(
+	ctx context.Context,
+	tx qrm.DB,
+	trainingPathId int64,
+) (*resqualifications.TrainingPath, error) {
+	stmt := tTrainingPath.
+		SELECT(
+			tTrainingPath.ID,
+			trainingPathColumns()...,
+		).
+		FROM(tTrainingPath).
+		WHERE(tTrainingPath.ID.EQ(mysql.Int64(trainingPathId))).
+		LIMIT(1)
+
+	var dest resqualifications.TrainingPath
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+	if dest.GetId() == 0 {
+		return nil, nil
+	}
+
+	steps, err := s.getTrainingPathSteps(ctx, tx, dest.GetId())
+	if err != nil {
+		return nil, err
+	}
+	dest.Steps = steps
+
+	return &dest, nil
+}
+
+// getTrainingPathSteps returns the steps of the given paths in order, steps of deleted
+// qualifications are left out.
+func (s *Store) getTrainingPathSteps(
+	ctx context.Context,
+	tx qrm.DB,
+	trainingPathIds ...int64,
+) ([]*resqualifications.TrainingPathStep, error) {
+	ids := make([]mysql.Expression, 0, len(trainingPathIds))
+	for _, id := range trainingPathIds {
+		ids = append(ids, mysql.Int64(id))
+	}
+
+	tQuali := table.FivenetQualifications.AS("qualification_short")
+	stmt := tTrainingPathStep.
+		SELECT(
+			tTrainingPathStep.TrainingPathID,
+			tTrainingPathStep.QualificationID,
+			tTrainingPathStep.SortOrder.AS("training_path_step.order"),
+			tQuali.ID,
+			tQuali.Job,
+			tQuali.Closed,
+			tQuali.Draft,
+			tQuali.Abbreviation,
+			tQuali.Title,
+		).
+		FROM(
+			tTrainingPathStep.
+				INNER_JOIN(tQuali, tQuali.ID.EQ(tTrainingPathStep.QualificationID)),
+		).
+		WHERE(mysql.AND(
+			tTrainingPathStep.TrainingPathID.IN(ids...),
+			tQuali.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tTrainingPathStep.TrainingPathID.ASC(), tTrainingPathStep.SortOrder.ASC())
+
+	dest := []*resqualifications.TrainingPathStep{}
+	if err := stmt.QueryContext(ctx, tx, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+func (s *Store) CreateTrainingPath(
+	ctx context.Context,
+	tx qrm.DB,
+	path *resqualifications.TrainingPath,
+	userInfo *userinfo.UserInfo,
+) (int64, error) {
+	tTrainingPath := table.FivenetQualificationsTrainingPaths
+	stmt := tTrainingPath.
+		INSERT(
+			tTrainingPath.Job,
+			tTrainingPath.Grade,
+			tTrainingPath.Name,
+			tTrainingPath.Description,
+			tTrainingPath.CreatorID,
+		).
+		VALUES(
+			userInfo.GetJob(),
+			path.Grade,
+			path.GetName(),
+			path.Description,
+			userInfo.GetUserId(),
+		)
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+func (s *Store) UpdateTrainingPath(
+	ctx context.Context,
+	tx qrm.DB,
+	path *resqualifications.TrainingPath,
+) error {
+	tTrainingPath := table.FivenetQualificationsTrainingPaths
+	stmt := tTrainingPath.
+		UPDATE(
+			tTrainingPath.Grade,
+			tTrainingPath.Name,
+			tTrainingPath.Description,
+		).
+		SET(
+			path.Grade,
+			path.GetName(),
+			path.Description,
+		).
+		WHERE(mysql.AND(
+			tTrainingPath.ID.EQ(mysql.Int64(path.GetId())),
+			tTrainingPath.DeletedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+func (s *Store) DeleteTrainingPath(ctx context.Context, tx qrm.DB, trainingPathId int64) error {
+	tTrainingPath := table.FivenetQualificationsTrainingPaths
+	stmt := tTrainingPath.
+		UPDATE().
+		SET(
+			tTrainingPath.DeletedAt.SET(mysql.CURRENT_TIMESTAMP()),
+		).
+		WHERE(mysql.AND(
+			tTrainingPath.ID.EQ(mysql.Int64(trainingPathId)),
+			tTrainingPath.DeletedAt.IS_NULL(),
+		)).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+// SetTrainingPathSteps replaces the path's steps, the order of the given steps is kept.
+func (s *Store) SetTrainingPathSteps(
+	ctx context.Context,
+	tx qrm.DB,
+	trainingPathId int64,
+	steps []*resqualifications.TrainingPathStep,
+) error {
+	tTrainingPathStep := table.FivenetQualificationsTrainingPathSteps
+
+	delStmt := tTrainingPathStep.
+		DELETE().
+		WHERE(tTrainingPathStep.TrainingPathID.EQ(mysql.Int64(trainingPathId)))
+	if _, err := delStmt.ExecContext(ctx, tx); err != nil {
+		return err
+	}
+
+	if len(steps) == 0 {
+		return nil
+	}
+
+	stmt := tTrainingPathStep.
+		INSERT(
+			tTrainingPathStep.TrainingPathID,
+			tTrainingPathStep.QualificationID,
+			tTrainingPathStep.SortOrder,
+		)
+	for i, step := range steps {
+		stmt = stmt.VALUES(
+			trainingPathId,
+			step.GetQualificationId(),
+			i,
+		)
+	}
+
+	_, err := stmt.ExecContext(ctx, tx)
+	return err
+}
+
+// GetUserQualificationStates returns which of the given qualifications the user has completed and
+// the status of their latest result per qualification.
+func (s *Store) GetUserQualificationStates(
+	ctx context.Context,
+	userId int32,
+	qualificationIds []int64,
+) (map[int64]bool, map[int64]resqualifications.ResultStatus, error) {
+	completed, err := s.ListCompletedQualifications(ctx, []int32{userId}, qualificationIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := map[int64]resqualifications.ResultStatus{}
+	if len(qualificationIds) == 0 {
+		return completed[userId], statuses, nil
+	}
+
+	ids := make([]mysql.Expression, 0, len(qualificationIds))
+	for _, id := range qualificationIds {
+		ids = append(ids, mysql.Int64(id))
+	}
+
+	tQualiResultLatest := table.FivenetQualificationsResults.AS("qualificationresult_latest")
+	stmt := tQualiResult.
+		SELECT(
+			tQualiResult.QualificationID.AS("qualification_id"),
+			tQualiResult.Status.AS("status"),
+		).
+		FROM(tQualiResult).
+		WHERE(mysql.AND(
+			tQualiResult.UserID.EQ(mysql.Int32(userId)),
+			tQualiResult.QualificationID.IN(ids...),
+			tQualiResult.DeletedAt.IS_NULL(),
+			tQualiResult.ID.EQ(mysql.IntExp(
+				tQualiResultLatest.
+					SELECT(mysql.MAX(tQualiResultLatest.ID)).
+					FROM(tQualiResultLatest).
+					WHERE(mysql.AND(
+						tQualiResultLatest.QualificationID.EQ(tQualiResult.QualificationID),
+						tQualiResultLatest.UserID.EQ(tQualiResult.UserID),
+						tQualiResultLatest.DeletedAt.IS_NULL(),
+					)),
+			)),
+		))
+
+	var dest []struct {
+		QualificationID int64 `alias:"qualification_id"`
+		Status          int32 `alias:"status"`
+	}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, nil, err
+		}
+	}
+
+	for _, result := range dest {
+		statuses[result.QualificationID] = resqualifications.ResultStatus(result.Status)
+	}
+
+	return completed[userId], statuses, nil
+}
+
+// ListCompletedQualifications returns which of the given qualifications each user has completed.
+func (s *Store) ListCompletedQualifications(
+	ctx context.Context,
+	userIds []int32,
+	qualificationIds []int64,
+) (map[int32]map[int64]bool, error) {
+	completed := map[int32]map[int64]bool{}
+	if len(userIds) == 0 || len(qualificationIds) == 0 {
+		return completed, nil
+	}
+
+	uIds := make([]mysql.Expression, 0, len(userIds))
+	for _, id := range userIds {
+		uIds = append(uIds, mysql.Int32(id))
+	}
+	qIds := make([]mysql.Expression, 0, len(qualificationIds))
+	for _, id := range qualificationIds {
+		qIds = append(qIds, mysql.Int64(id))
+	}
+
+	stmt := tQualiResultSuccess.
+		SELECT(
+			tQualiResultSuccess.UserID.AS("user_id"),
+			tQualiResultSuccess.QualificationID.AS("qualification_id"),
+		).
+		FROM(tQualiResultSuccess).
+		WHERE(mysql.AND(
+			tQualiResultSuccess.UserID.IN(uIds...),
+			tQualiResultSuccess.QualificationID.IN(qIds...),
+		))
+
+	var dest []struct {
+		UserID          int32 `alias:"user_id"`
+		QualificationID int64 `alias:"qualification_id"`
+	}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	for _, row := range dest {
+		if _, ok := completed[row.UserID]; !ok {
+			completed[row.UserID] = map[int64]bool{}
+		}
+		completed[row.UserID][row.QualificationID] = true
+	}
+
+	return completed, nil
+}
+
+// GetUserJobGrade returns the user's grade in the job, false if the user isn't part of the job.
+func (s *Store) GetUserJobGrade(
+	ctx context.Context,
+	userId int32,
+	job string,
+) (int32, bool, error) {
+	tUserJobs := table.FivenetUserJobs.AS("user_jobs")
+	stmt := tUserJobs.
+		SELECT(
+			tUserJobs.Grade.AS("grade"),
+		).
+		FROM(tUserJobs).
+		WHERE(mysql.AND(
+			tUserJobs.UserID.EQ(mysql.Int32(userId)),
+			tUserJobs.Job.EQ(mysql.String(job)),
+		)).
+		LIMIT(1)
+
+	var dest struct {
+		Grade *int32 `alias:"grade"`
+	}
+	if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return 0, false, err
+		}
+	}
+	if dest.Grade == nil {
+		return 0, false, nil
+	}
+
+	return *dest.Grade, true, nil
+}
+
+// ListTrainingMatrix lists the colleagues of the path's job, the progress is filled in by the
+// caller based on the completed qualifications.
+func (s *Store) ListTrainingMatrix(
+	ctx context.Context,
+	opts TrainingMatrixOptions,
+) (*pbqualifications.GetTrainingMatrixResponse, error) {
+	tUserJobs := table.FivenetUserJobs.AS("user_jobs")
+	tUser := table.FivenetUser.AS("user")
+
+	condition := tUserJobs.Job.EQ(mysql.String(opts.Path.GetJob()))
+	if search := dbutils.PrepareForLikeSearch(opts.Search); search != "" {
+		condition = condition.AND(
+			mysql.CONCAT(tUser.Firstname, mysql.String(" "), tUser.Lastname).
+				LIKE(mysql.String(search)),
+		)
+	}
+
+	if opts.PromotionReady {
+		qualificationIds := opts.Path.QualificationIDs()
+		if len(qualificationIds) == 0 {
+			pag, _ := opts.Pagination.GetResponseWithPageSize(0, TrainingMatrixPageSize)
+			return &pbqualifications.GetTrainingMatrixResponse{
+				Pagination: pag,
+				Rows:       []*resqualifications.TrainingMatrixRow{},
+			}, nil
+		}
+
+		ids := make([]mysql.Expression, 0, len(qualificationIds))
+		for _, id := range qualificationIds {
+			ids = append(ids, mysql.Int64(id))
+		}
+
+		tSuccess := table.FivenetQualificationsResultSuccessMap.AS("success")
+		completedCount := mysql.IntExp(
+			tSuccess.
+				SELECT(mysql.COUNT(tSuccess.QualificationID)).
+				FROM(tSuccess).
+				WHERE(mysql.AND(
+					tSuccess.UserID.EQ(tUserJobs.UserID),
+					tSuccess.QualificationID.IN(ids...),
+				)),
+		)
+		condition = condition.AND(completedCount.EQ(mysql.Int(int64(len(qualificationIds)))))
+		if opts.Path.Grade != nil {
+			condition = condition.AND(tUserJobs.Grade.LT(mysql.Int32(opts.Path.GetGrade())))
+		}
+	}
+
+	from := tUserJobs.
+		INNER_JOIN(tUser, tUser.ID.EQ(tUserJobs.UserID))
+
+	countStmt := tUserJobs.
+		SELECT(mysql.COUNT(tUserJobs.UserID).AS("data_count.total")).
+		FROM(from).
+		WHERE(condition)
+
+	var count database.DataCount
+	if err := countStmt.QueryContext(ctx, s.db, &count); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	pag, limit := opts.Pagination.GetResponseWithPageSize(count.Total, TrainingMatrixPageSize)
+	resp := &pbqualifications.GetTrainingMatrixResponse{
+		Pagination: pag,
+		Rows:       []*resqualifications.TrainingMatrixRow{},
+	}
+	if count.Total <= 0 {
+		return resp, nil
+	}
+
+	stmt := tUserJobs.
+		SELECT(
+			tUserJobs.UserID.AS("training_matrix_row.user_id"),
+			tUser.ID,
+			tUserJobs.Job.AS("user.job"),
+			tUserJobs.Grade.AS("user.job_grade"),
+			tUser.Firstname,
+			tUser.Lastname,
+			tUser.Dateofbirth,
+		).
+		FROM(from).
+		WHERE(condition).
+		ORDER_BY(
+			tUserJobs.Grade.DESC(),
+			tUser.Firstname.ASC(),
+			tUser.Lastname.ASC(),
+		).
+		OFFSET(opts.Pagination.GetOffset()).
+		LIMIT(limit)
+
+	if err := stmt.QueryContext(ctx, s.db, &resp.Rows); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package qualificationsstore
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	resqualifications "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/qualifications"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreGetTrainingPath(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`FROM fivenet_qualifications_training_paths AS training_path`,
+	)).
+		WithArgs(int64(3), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"training_path.id",
+			"training_path.job",
+			"training_path.grade",
+			"training_path.name",
+		}).AddRow(int64(3), "ambulance", int32(2), "Paramedic"))
+
+	stepsQuery := regexp.QuoteMeta(
+		`FROM fivenet_qualifications_training_path_steps AS training_path_step`,
+	) +
+		`(?s).*` + regexp.QuoteMeta(`qualification_short.deleted_at IS NULL`) +
+		`(?s).*` + regexp.QuoteMeta(`training_path_step.sort_order ASC`)
+	mock.ExpectQuery(stepsQuery).
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"training_path_step.training_path_id",
+			"training_path_step.qualification_id",
+			"training_path_step.order",
+			"qualification_short.id",
+			"qualification_short.abbreviation",
+		}).
+			AddRow(int64(3), int64(10), int32(0), int64(10), "FA").
+			AddRow(int64(3), int64(11), int32(1), int64(11), "EMT"))
+
+	path, err := store.GetTrainingPath(t.Context(), db, 3)
+	require.NoError(t, err)
+	require.NotNil(t, path)
+	assert.Equal(t, "Paramedic", path.GetName())
+	assert.Equal(t, []int64{10, 11}, path.QualificationIDs())
+	assert.Equal(t, "EMT", path.GetSteps()[1].GetQualification().GetAbbreviation())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreGetTrainingPathNotFound(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`FROM fivenet_qualifications_training_paths AS training_path`,
+	)).
+		WithArgs(int64(3), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"training_path.id"}))
+
+	path, err := store.GetTrainingPath(t.Context(), db, 3)
+	require.NoError(t, err)
+	assert.Nil(t, path)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreSetTrainingPathSteps(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM fivenet_qualifications_training_path_steps`)).
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_qualifications_training_path_steps`)).
+		WithArgs(int64(3), int64(11), 0, int64(3), int64(10), 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = store.SetTrainingPathSteps(t.Context(), db, 3, []*resqualifications.TrainingPathStep{
+		{QualificationId: 11},
+		{QualificationId: 10},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreListCompletedQualifications(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(testParams(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`FROM fivenet_qualifications_result_success_map AS qualification_result_success_map`,
+	)).
+		WithArgs(int32(1), int32(2), int64(10), int64(11)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "qualification_id"}).
+			AddRow(int32(1), int64(10)).
+			AddRow(int32(1), int64(11)).
+			AddRow(int32(2), int64(11)))
+
+	completed, err := store.ListCompletedQualifications(
+		t.Context(),
+		[]int32{1, 2},
+		[]int64{10, 11},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]map[int64]bool{
+		1: {10: true, 11: true},
+		2: {11: true},
+	}, completed)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	completed, err = store.ListCompletedQualifications(t.Context(), []int32{1}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, completed)
+}
@@ -0,0 +1,301 @@
+package searchstore
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/pkg/access"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Max. length of the title column in runes.
+const titleMaxLength = 255
+
+var tSearchIndex = table.FivenetSearchIndex
+
+type SearchQuery struct {
+	// Boolean mode fulltext search query, see dbutils.PrepareForFulltextSearch
+	Search string
+	Types  []search.SearchResultType
+	// Limits the entries of a type to the ones visible to the user, types without a filter
+	// aren't limited
+	Filters map[search.SearchResultType]*TypeFilter
+	Limit   int64
+}
+
+// TypeFilter limits the entries of a type to the ones visible to the user.
+type TypeFilter struct {
+	// Entries of these jobs are omitted
+	ExcludedJobs []string
+
+	// ID column of the target table, the condition passed to VisibleIDs refers to it
+	TargetID mysql.ColumnInteger
+	// Returns the targets visible to the user matching the condition (optional)
+	VisibleIDs func(condition mysql.BoolExpression) access.VisibilityQuery
+}
+
+// UpsertEntries inserts or replaces the given entries, the updated at time is always bumped so
+// entries that have been re-indexed aren't considered stale.
+func (s *Store) UpsertEntries(ctx context.Context, entries []*search.SearchIndexEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	stmt := tSearchIndex.
+		INSERT(
+			tSearchIndex.UpdatedAt,
+			tSearchIndex.Type,
+			tSearchIndex.TargetID,
+			tSearchIndex.TargetKey,
+			tSearchIndex.Job,
+			tSearchIndex.Title,
+			tSearchIndex.Content,
+		)
+
+	for _, entry := range entries {
+		stmt = stmt.VALUES(
+			now,
+			int32(entry.GetType()),
+			entry.GetTargetId(),
+			entry.GetTargetKey(),
+			entry.Job,
+			truncateRunes(entry.GetTitle(), titleMaxLength),
+			entry.GetContent(),
+		)
+	}
+
+	stmt = stmt.ON_DUPLICATE_KEY_UPDATE(
+		tSearchIndex.UpdatedAt.SET(mysql.RawTimestamp("VALUES(`updated_at`)")),
+		tSearchIndex.TargetID.SET(mysql.RawInt("VALUES(`target_id`)")),
+		tSearchIndex.Job.SET(mysql.RawString("VALUES(`job`)")),
+		tSearchIndex.Title.SET(mysql.RawString("VALUES(`title`)")),
+		tSearchIndex.Content.SET(mysql.RawString("VALUES(`content`)")),
+	)
+
+	_, err := stmt.ExecContext(ctx, s.db)
+	return err
+}
+
+func (s *Store) DeleteEntry(
+	ctx context.Context,
+	rType search.SearchResultType,
+	targetKey string,
+) error {
+	stmt := tSearchIndex.
+		DELETE().
+		WHERE(mysql.AND(
+			tSearchIndex.Type.EQ(mysql.Int32(int32(rType))),
+			tSearchIndex.TargetKey.EQ(mysql.String(targetKey)),
+		)).
+		LIMIT(1)
+
+	_, err := stmt.ExecContext(ctx, s.db)
+	return err
+}
+
+// DeleteStaleEntries deletes the entries of the type that haven't been updated since the given time.
+func (s *Store) DeleteStaleEntries(
+	ctx context.Context,
+	rType search.SearchResultType,
+	before time.Time,
+) (int64, error) {
+	stmt := tSearchIndex.
+		DELETE().
+		WHERE(mysql.AND(
+			tSearchIndex.Type.EQ(mysql.Int32(int32(rType))),
+			tSearchIndex.UpdatedAt.LT(mysql.TimestampT(before)),
+		))
+
+	res, err := stmt.ExecContext(ctx, s.db)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// Search returns the matching entries ordered by relevance, title matches are weighted higher.
+func (s *Store) Search(ctx context.Context, q SearchQuery) ([]*search.SearchIndexEntry, error) {
+	if len(q.Types) == 0 {
+		return []*search.SearchIndexEntry{}, nil
+	}
+
+	// The visibility queries of the accesses can't be combined into one statement (their common
+	// table expressions share the same names), so these types are searched separately
+	stmts := []mysql.Statement{}
+	types := []search.SearchResultType{}
+	for _, rType := range q.Types {
+		filter := q.Filters[rType]
+		if filter == nil || filter.VisibleIDs == nil {
+			types = append(types, rType)
+			continue
+		}
+
+		stmts = append(stmts, q.visibleStatement(rType, filter))
+	}
+	if len(types) > 0 {
+		stmts = append(stmts, q.statement(types))
+	}
+
+	entries := []*search.SearchIndexEntry{}
+	for _, stmt := range stmts {
+		dest := []*search.SearchIndexEntry{}
+		if err := stmt.QueryContext(ctx, s.db, &dest); err != nil {
+			if !errors.Is(err, qrm.ErrNoRows) {
+				return nil, err
+			}
+		}
+
+		entries = append(entries, dest...)
+	}
+
+	slices.SortStableFunc(entries, func(a, b *search.SearchIndexEntry) int {
+		return cmp.Or(
+			cmp.Compare(b.GetScore(), a.GetScore()),
+			b.GetUpdatedAt().AsTime().Compare(a.GetUpdatedAt().AsTime()),
+		)
+	})
+	if int64(len(entries)) > q.Limit {
+		entries = entries[:q.Limit]
+	}
+
+	return entries, nil
+}
+
+// statement searches the entries of the types, omitting the excluded jobs of the type filters.
+func (q SearchQuery) statement(types []search.SearchResultType) mysql.Statement {
+	tEntry := table.FivenetSearchIndex.AS("search_index_entry")
+
+	values := make([]mysql.Expression, 0, len(types))
+	for _, t := range types {
+		values = append(values, mysql.Int32(int32(t)))
+	}
+
+	return q.selectEntries(tEntry, tEntry, mysql.AND(
+		q.matchCondition(tEntry),
+		tEntry.Type.IN(values...),
+		q.jobsCondition(tEntry, types),
+	))
+}
+
+// visibleStatement searches the entries of the type limited to the targets visible to the user.
+func (q SearchQuery) visibleStatement(
+	rType search.SearchResultType,
+	filter *TypeFilter,
+) mysql.Statement {
+	tEntry := table.FivenetSearchIndex.AS("search_index_entry")
+	tMatch := table.FivenetSearchIndex.AS("search_index_match")
+
+	// Only check the visibility of the matching targets
+	visibleIDs := filter.VisibleIDs(filter.TargetID.IN(
+		tMatch.
+			SELECT(tMatch.TargetID).
+			FROM(tMatch).
+			WHERE(mysql.AND(
+				tMatch.Type.EQ(mysql.Int32(int32(rType))),
+				q.matchCondition(tMatch),
+			)),
+	))
+	visibleID := mysql.IntegerColumn("id").From(visibleIDs.Table)
+
+	var stmt mysql.Statement = q.selectEntries(
+		tEntry,
+		visibleIDs.Table.
+			INNER_JOIN(tEntry, mysql.AND(
+				tEntry.Type.EQ(mysql.Int32(int32(rType))),
+				tEntry.TargetID.EQ(visibleID),
+			)),
+		mysql.AND(
+			q.matchCondition(tEntry),
+			q.jobsCondition(tEntry, []search.SearchResultType{rType}),
+		),
+	)
+	if len(visibleIDs.CTEs) > 0 {
+		stmt = mysql.WITH(visibleIDs.CTEs...)(stmt)
+	}
+
+	return stmt
+}
+
+func (q SearchQuery) selectEntries(
+	tEntry *table.FivenetSearchIndexTable,
+	from mysql.ReadableTable,
+	condition mysql.BoolExpression,
+) mysql.SelectStatement {
+	searchStr := mysql.String(q.Search)
+	score := dbutils.MATCH_SCORE(tEntry.Title, searchStr).
+		MUL(mysql.Float(2)).
+		ADD(dbutils.MATCH_SCORE(tEntry.Content, searchStr))
+
+	return tEntry.
+		SELECT(
+			tEntry.Type,
+			tEntry.TargetID,
+			tEntry.TargetKey,
+			tEntry.Job,
+			tEntry.Title,
+			tEntry.Content,
+			tEntry.UpdatedAt,
+			score.AS("search_index_entry.score"),
+		).
+		FROM(from).
+		WHERE(condition).
+		ORDER_BY(
+			score.DESC(),
+			tEntry.UpdatedAt.DESC(),
+		).
+		LIMIT(q.Limit)
+}
+
+func (q SearchQuery) matchCondition(tEntry *table.FivenetSearchIndexTable) mysql.BoolExpression {
+	searchStr := mysql.String(q.Search)
+
+	return mysql.OR(
+		dbutils.MATCH(tEntry.Title, searchStr),
+		dbutils.MATCH(tEntry.Content, searchStr),
+	)
+}
+
+// jobsCondition omits the entries of the excluded jobs of the types' filters.
+func (q SearchQuery) jobsCondition(
+	tEntry *table.FivenetSearchIndexTable,
+	types []search.SearchResultType,
+) mysql.BoolExpression {
+	condition := mysql.Bool(true)
+	for _, rType := range types {
+		filter := q.Filters[rType]
+		if filter == nil || len(filter.ExcludedJobs) == 0 {
+			continue
+		}
+
+		jobs := make([]mysql.Expression, 0, len(filter.ExcludedJobs))
+		for _, job := range filter.ExcludedJobs {
+			jobs = append(jobs, mysql.String(job))
+		}
+
+		condition = condition.AND(mysql.OR(
+			tEntry.Type.NOT_EQ(mysql.Int32(int32(rType))),
+			tEntry.Job.IS_NULL(),
+			tEntry.Job.NOT_IN(jobs...),
+		))
+	}
+
+	return condition
+}
+
+func truncateRunes(s string, length int) string {
+	if utf8.RuneCountInString(s) <= length {
+		return s
+	}
+
+	return string([]rune(s)[:length])
+}
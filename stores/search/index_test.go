@@ -0,0 +1,190 @@
+package searchstore
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/pkg/access"
+	"github.com/fivenet-app/fivenet/v2026/pkg/config"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreUpsertEntries(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, CustomDB: &config.CustomDB{}})
+	entries := []*search.SearchIndexEntry{
+		{
+			Type:      search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT,
+			TargetId:  5,
+			TargetKey: "5",
+			Job:       new("police"),
+			Title:     "Report",
+			Content:   "Lorem ipsum",
+		},
+		{
+			Type:      search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE,
+			TargetKey: "ABC 123",
+			Title:     "ABC 123",
+		},
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO fivenet_search_index`)+`(?s).*`+
+		regexp.QuoteMeta(`ON DUPLICATE KEY UPDATE`)+`(?s).*`+
+		regexp.QuoteMeta("VALUES(`content`)")).
+		WithArgs(
+			sqlmock.AnyArg(), int32(1), int64(5), "5", entries[0].Job, "Report", "Lorem ipsum",
+			sqlmock.AnyArg(), int32(4), int64(0), "ABC 123", nil, "ABC 123", "",
+		).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+
+	require.NoError(t, store.UpsertEntries(t.Context(), entries))
+	// No entries, no query
+	require.NoError(t, store.UpsertEntries(t.Context(), nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreDeleteStaleEntries(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, CustomDB: &config.CustomDB{}})
+	before := time.Unix(100, 0).UTC()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM fivenet_search_index`)+`(?s).*`+
+		regexp.QuoteMeta(`fivenet_search_index.type = ?`)+`(?s).*`+
+		regexp.QuoteMeta(`fivenet_search_index.updated_at < TIMESTAMP(?)`)).
+		WithArgs(int32(3), before).
+		WillReturnResult(sqlmock.NewResult(0, 7))
+
+	deleted, err := store.DeleteStaleEntries(
+		t.Context(),
+		search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN,
+		before,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), deleted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreSearch(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, CustomDB: &config.CustomDB{}})
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_search_index AS search_index_entry`) + `(?s).*` +
+		regexp.QuoteMeta(`MATCH(search_index_entry.title) AGAINST (? IN BOOLEAN MODE)`) + `(?s).*` +
+		regexp.QuoteMeta(`search_index_entry.type IN (?, ?)`) + `(?s).*` +
+		regexp.QuoteMeta(`ORDER BY`)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"search_index_entry.type",
+			"search_index_entry.target_id",
+			"search_index_entry.target_key",
+			"search_index_entry.job",
+			"search_index_entry.title",
+			"search_index_entry.content",
+			"search_index_entry.updated_at",
+			"search_index_entry.score",
+		}).AddRow(2, 12, "12", "police", "Handbook", "Lorem ipsum", time.Unix(100, 0), 3.5))
+
+	entries, err := store.Search(t.Context(), SearchQuery{
+		Search: "+hand*",
+		Types: []search.SearchResultType{
+			search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT,
+			search.SearchResultType_SEARCH_RESULT_TYPE_WIKI_PAGE,
+		},
+		Limit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, search.SearchResultType_SEARCH_RESULT_TYPE_WIKI_PAGE, entries[0].GetType())
+	assert.Equal(t, int64(12), entries[0].GetTargetId())
+	assert.Equal(t, "police", entries[0].GetJob())
+	assert.InDelta(t, 3.5, entries[0].GetScore(), 0.001)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreSearchFilters(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, CustomDB: &config.CustomDB{}})
+	tDocument := table.FivenetDocuments
+
+	columns := []string{
+		"search_index_entry.type",
+		"search_index_entry.target_id",
+		"search_index_entry.target_key",
+		"search_index_entry.job",
+		"search_index_entry.title",
+		"search_index_entry.content",
+		"search_index_entry.updated_at",
+		"search_index_entry.score",
+	}
+
+	// Documents are limited to the visible documents matching the search
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents`) + `(?s).*` +
+		regexp.QuoteMeta(`fivenet_documents.id IN (`) + `(?s).*` +
+		regexp.QuoteMeta(`FROM fivenet_search_index AS search_index_match`) + `(?s).*` +
+		regexp.QuoteMeta(`INNER JOIN fivenet_search_index AS search_index_entry`) + `(?s).*` +
+		regexp.QuoteMeta(`search_index_entry.target_id = doc_ids.id`)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, 5, "5", "police", "Report", "Lorem ipsum", time.Unix(100, 0), 2.5))
+	// Citizens of the excluded jobs are omitted
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_search_index AS search_index_entry`) + `(?s).*` +
+		regexp.QuoteMeta(`search_index_entry.type IN (?)`) + `(?s).*` +
+		regexp.QuoteMeta(`search_index_entry.job NOT IN (?)`)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(3, 12, "12", "unemployed", "John Doe", "", time.Unix(200, 0), 4.0).
+			AddRow(3, 13, "13", "unemployed", "John Smith", "", time.Unix(100, 0), 1.0))
+
+	entries, err := store.Search(t.Context(), SearchQuery{
+		Search: "+jo*",
+		Types: []search.SearchResultType{
+			search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT,
+			search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN,
+		},
+		Filters: map[search.SearchResultType]*TypeFilter{
+			search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT: {
+				TargetID: tDocument.ID,
+				VisibleIDs: func(condition mysql.BoolExpression) access.VisibilityQuery {
+					stmt := tDocument.
+						SELECT(tDocument.ID.AS("id")).
+						FROM(tDocument).
+						WHERE(condition)
+					return access.VisibilityQuery{Table: stmt.AsTable("doc_ids"), Statement: stmt}
+				},
+			},
+			search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN: {
+				ExcludedJobs: []string{"police"},
+			},
+		},
+		Limit: 2,
+	})
+	require.NoError(t, err)
+	// Merged by score and limited
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(12), entries[0].GetTargetId())
+	assert.Equal(t, int64(5), entries[1].GetTargetId())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
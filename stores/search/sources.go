@@ -0,0 +1,266 @@
+package searchstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/common/content"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// sourceRow is the common shape of the rows selected from the indexed tables.
+type sourceRow struct {
+	ID          int64            `alias:"source.id"`
+	Key         string           `alias:"source.key"`
+	Job         *string          `alias:"source.job"`
+	Title       string           `alias:"source.title"`
+	Description *string          `alias:"source.description"`
+	Text        *string          `alias:"source.text"`
+	Content     *content.Content `alias:"source.content"`
+}
+
+// sourceQuery limits the selected rows either to the given IDs or to the next batch after the key.
+type sourceQuery struct {
+	ids   []int64
+	after string
+	limit int64
+}
+
+// LoadSourceEntries builds the index entries for the given entities. Entities that don't exist
+// (anymore) or shouldn't be indexed are omitted. Vehicles are only indexed by ListSourceEntries.
+func (s *Store) LoadSourceEntries(
+	ctx context.Context,
+	rType search.SearchResultType,
+	ids []int64,
+) ([]*search.SearchIndexEntry, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return s.sourceEntries(ctx, rType, sourceQuery{
+		ids:   ids,
+		limit: int64(len(ids)),
+	})
+}
+
+// ListSourceEntries returns the next batch of index entries, ordered by their target key (for
+// vehicles the plate, otherwise the ID), after the given target key.
+func (s *Store) ListSourceEntries(
+	ctx context.Context,
+	rType search.SearchResultType,
+	after string,
+	limit int64,
+) ([]*search.SearchIndexEntry, error) {
+	return s.sourceEntries(ctx, rType, sourceQuery{
+		after: after,
+		limit: limit,
+	})
+}
+
+func (s *Store) sourceEntries(
+	ctx context.Context,
+	rType search.SearchResultType,
+	q sourceQuery,
+) ([]*search.SearchIndexEntry, error) {
+	var stmt mysql.SelectStatement
+	switch rType {
+	case search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT:
+		stmt = s.documentsSource(q)
+
+	case search.SearchResultType_SEARCH_RESULT_TYPE_WIKI_PAGE:
+		stmt = s.wikiPagesSource(q)
+
+	case search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN:
+		stmt = s.citizensSource(q)
+
+	case search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE:
+		if len(q.ids) > 0 {
+			return nil, errors.New("vehicles can't be loaded by id")
+		}
+		stmt = s.vehiclesSource(q)
+
+	case search.SearchResultType_SEARCH_RESULT_TYPE_QUALIFICATION:
+		stmt = s.qualificationsSource(q)
+
+	default:
+		return nil, fmt.Errorf("unsupported search result type %s", rType)
+	}
+
+	rows := []*sourceRow{}
+	if err := stmt.QueryContext(ctx, s.db, &rows); err != nil {
+		if !errors.Is(err, qrm.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	entries := make([]*search.SearchIndexEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, row.toEntry(rType))
+	}
+
+	return entries, nil
+}
+
+func (r *sourceRow) toEntry(rType search.SearchResultType) *search.SearchIndexEntry {
+	parts := []string{}
+	if r.Description != nil && *r.Description != "" {
+		parts = append(parts, *r.Description)
+	}
+	if r.Text != nil && *r.Text != "" {
+		parts = append(parts, *r.Text)
+	}
+	if text := r.Content.Extract().GetText(); text != "" {
+		parts = append(parts, text)
+	}
+
+	key := r.Key
+	if key == "" {
+		key = strconv.FormatInt(r.ID, 10)
+	}
+
+	return &search.SearchIndexEntry{
+		Type:      rType,
+		TargetId:  r.ID,
+		TargetKey: key,
+		Job:       r.Job,
+		Title:     strings.TrimSpace(r.Title),
+		Content:   strings.Join(parts, "\n"),
+	}
+}
+
+// idCondition limits the rows to the given IDs or the IDs after the last (numeric) key.
+func (q sourceQuery) idCondition(col mysql.ColumnInteger) mysql.BoolExpression {
+	if len(q.ids) > 0 {
+		ids := make([]mysql.Expression, 0, len(q.ids))
+		for _, id := range q.ids {
+			ids = append(ids, mysql.Int64(id))
+		}
+		return col.IN(ids...)
+	}
+
+	// Invalid keys restart from the beginning
+	after, _ := strconv.ParseInt(q.after, 10, 64)
+	return col.GT(mysql.Int64(after))
+}
+
+func (s *Store) documentsSource(q sourceQuery) mysql.SelectStatement {
+	tDocument := table.FivenetDocuments.AS("document")
+
+	return tDocument.
+		SELECT(
+			tDocument.ID.AS("source.id"),
+			tDocument.CreatorJob.AS("source.job"),
+			tDocument.Title.AS("source.title"),
+			tDocument.ContentText.AS("source.text"),
+		).
+		FROM(tDocument).
+		WHERE(mysql.AND(
+			q.idCondition(tDocument.ID),
+			tDocument.DeletedAt.IS_NULL(),
+			tDocument.Draft.IS_FALSE(),
+		)).
+		ORDER_BY(tDocument.ID.ASC()).
+		LIMIT(q.limit)
+}
+
+func (s *Store) wikiPagesSource(q sourceQuery) mysql.SelectStatement {
+	tPage := table.FivenetWikiPages.AS("page")
+
+	return tPage.
+		SELECT(
+			tPage.ID.AS("source.id"),
+			tPage.Job.AS("source.job"),
+			tPage.Title.AS("source.title"),
+			tPage.Description.AS("source.description"),
+			tPage.Content.AS("source.content"),
+		).
+		FROM(tPage).
+		WHERE(mysql.AND(
+			q.idCondition(tPage.ID),
+			tPage.DeletedAt.IS_NULL(),
+			tPage.Draft.IS_FALSE(),
+		)).
+		ORDER_BY(tPage.ID.ASC()).
+		LIMIT(q.limit)
+}
+
+func (s *Store) citizensSource(q sourceQuery) mysql.SelectStatement {
+	tUser := table.FivenetUser.AS("user")
+
+	// Only the name is indexed. The other citizen fields (e.g., date of birth and the user props)
+	// are gated per user by the citizen list's "Fields" attribute, but an index entry is shared
+	// by all users: indexing them would expose them through the matches and the snippets to users
+	// without the field permission. They can be searched for in the citizen list instead.
+	return tUser.
+		SELECT(
+			tUser.ID.AS("source.id"),
+			tUser.Job.AS("source.job"),
+			mysql.CONCAT(tUser.Firstname, mysql.String(" "), tUser.Lastname).AS("source.title"),
+		).
+		FROM(tUser).
+		WHERE(mysql.AND(
+			q.idCondition(tUser.ID),
+			tUser.DeletedAt.IS_NULL(),
+		)).
+		ORDER_BY(tUser.ID.ASC()).
+		LIMIT(q.limit)
+}
+
+func (s *Store) vehiclesSource(q sourceQuery) mysql.SelectStatement {
+	tVehicle := table.FivenetOwnedVehicles.AS("vehicle")
+	tUser := table.FivenetUser.AS("user")
+
+	description := []mysql.Expression{
+		mysql.REPLACE(tVehicle.Type, mysql.String("_"), mysql.String(" ")),
+	}
+	if s.customDB.Columns.Vehicle.GetModel(tVehicle.Alias()) != nil {
+		description = append(description,
+			mysql.RawString("vehicle."+s.customDB.Columns.Vehicle.Model),
+		)
+	}
+
+	return tVehicle.
+		SELECT(
+			tVehicle.Plate.AS("source.key"),
+			tVehicle.Job.AS("source.job"),
+			tVehicle.Plate.AS("source.title"),
+			mysql.CONCAT_WS(mysql.String(" "), description...).AS("source.description"),
+			mysql.CONCAT_WS(mysql.String(" "), tUser.Firstname, tUser.Lastname).AS("source.text"),
+		).
+		FROM(
+			tVehicle.
+				LEFT_JOIN(tUser,
+					tUser.ID.EQ(tVehicle.UserID),
+				),
+		).
+		WHERE(tVehicle.Plate.GT(mysql.String(q.after))).
+		ORDER_BY(tVehicle.Plate.ASC()).
+		LIMIT(q.limit)
+}
+
+func (s *Store) qualificationsSource(q sourceQuery) mysql.SelectStatement {
+	tQuali := table.FivenetQualifications.AS("qualification")
+
+	return tQuali.
+		SELECT(
+			tQuali.ID.AS("source.id"),
+			tQuali.Job.AS("source.job"),
+			mysql.CONCAT(tQuali.Abbreviation, mysql.String(": "), tQuali.Title).AS("source.title"),
+			tQuali.Description.AS("source.description"),
+			tQuali.Content.AS("source.content"),
+		).
+		FROM(tQuali).
+		WHERE(mysql.AND(
+			q.idCondition(tQuali.ID),
+			tQuali.DeletedAt.IS_NULL(),
+			tQuali.Draft.IS_FALSE(),
+		)).
+		ORDER_BY(tQuali.ID.ASC()).
+		LIMIT(q.limit)
+}
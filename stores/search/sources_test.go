@@ -0,0 +1,117 @@
+package searchstore
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/pkg/config"
+	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreLoadSourceEntriesDocuments(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, CustomDB: &config.CustomDB{}})
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_documents AS document`) + `(?s).*` +
+		regexp.QuoteMeta(`document.id IN (?, ?)`) + `(?s).*` +
+		regexp.QuoteMeta(`document.deleted_at IS NULL`)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"source.id", "source.job", "source.title", "source.text",
+		}).AddRow(3, "police", " Incident report ", "Lorem ipsum"))
+
+	entries, err := store.LoadSourceEntries(
+		t.Context(),
+		search.SearchResultType_SEARCH_RESULT_TYPE_DOCUMENT,
+		[]int64{3, 4},
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "3", entries[0].GetTargetKey())
+	assert.Equal(t, "Incident report", entries[0].GetTitle())
+	assert.Equal(t, "Lorem ipsum", entries[0].GetContent())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = store.LoadSourceEntries(
+		t.Context(),
+		search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE,
+		[]int64{1},
+	)
+	require.Error(t, err)
+}
+
+func TestStoreLoadSourceEntriesCitizensOnlyName(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, CustomDB: &config.CustomDB{}})
+
+	// Date of birth and user props are gated by the citizen list's field attribute
+	query := store.(*Store).citizensSource(sourceQuery{ids: []int64{5}, limit: 1}).DebugSql()
+	assert.NotContains(t, strings.ToLower(query), "dateofbirth")
+	assert.NotContains(t, query, "fivenet_user_props")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_user AS user`) + `(?s).*` +
+		regexp.QuoteMeta(`user.id IN (?)`)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"source.id", "source.job", "source.title",
+		}).AddRow(5, "unemployed", "John Doe"))
+
+	entries, err := store.LoadSourceEntries(
+		t.Context(),
+		search.SearchResultType_SEARCH_RESULT_TYPE_CITIZEN,
+		[]int64{5},
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "John Doe", entries[0].GetTitle())
+	assert.Empty(t, entries[0].GetContent())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreListSourceEntriesVehicles(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(Params{DB: db, CustomDB: &config.CustomDB{
+		Columns: dbutils.CustomColumns{
+			Vehicle: dbutils.VehicleColumns{Model: "model"},
+		},
+	}})
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM fivenet_owned_vehicles AS vehicle`) + `(?s).*` +
+		regexp.QuoteMeta(`vehicle.plate > ?`) + `(?s).*` +
+		regexp.QuoteMeta(`ORDER BY vehicle.plate ASC`)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"source.key", "source.job", "source.title", "source.description", "source.text",
+		}).AddRow("XYZ 987", nil, "XYZ 987", "car adder", "John Doe"))
+
+	entries, err := store.ListSourceEntries(
+		t.Context(),
+		search.SearchResultType_SEARCH_RESULT_TYPE_VEHICLE,
+		"ABC 123",
+		100,
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "XYZ 987", entries[0].GetTargetKey())
+	assert.Equal(t, int64(0), entries[0].GetTargetId())
+	assert.False(t, entries[0].HasJob())
+	assert.Equal(t, "car adder\nJohn Doe", entries[0].GetContent())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
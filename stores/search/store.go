@@ -0,0 +1,53 @@
+package searchstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/search"
+	"github.com/fivenet-app/fivenet/v2026/pkg/config"
+	"go.uber.org/fx"
+)
+
+type IStore interface {
+	UpsertEntries(ctx context.Context, entries []*search.SearchIndexEntry) error
+	DeleteEntry(ctx context.Context, rType search.SearchResultType, targetKey string) error
+	DeleteStaleEntries(
+		ctx context.Context,
+		rType search.SearchResultType,
+		before time.Time,
+	) (int64, error)
+	Search(ctx context.Context, q SearchQuery) ([]*search.SearchIndexEntry, error)
+
+	LoadSourceEntries(
+		ctx context.Context,
+		rType search.SearchResultType,
+		ids []int64,
+	) ([]*search.SearchIndexEntry, error)
+	ListSourceEntries(
+		ctx context.Context,
+		rType search.SearchResultType,
+		after string,
+		limit int64,
+	) ([]*search.SearchIndexEntry, error)
+}
+
+type Params struct {
+	fx.In
+
+	DB       *sql.DB
+	CustomDB *config.CustomDB
+}
+
+type Store struct {
+	db       *sql.DB
+	customDB *config.CustomDB
+}
+
+func New(p Params) IStore {
+	return &Store{
+		db:       p.DB,
+		customDB: p.CustomDB,
+	}
+}
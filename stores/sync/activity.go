@@ -15,6 +15,7 @@ import (
 	pbsync "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/sync"
 	"github.com/fivenet-app/fivenet/v2026/pkg/dbutils"
 	"github.com/fivenet-app/fivenet/v2026/query/fivenet/table"
+	"github.com/fivenet-app/fivenet/v2026/services/centrum/dispatches"
 	"github.com/go-jet/jet/v2/mysql"
 	"github.com/go-jet/jet/v2/qrm"
 )
@@ -161,6 +162,26 @@ func (s *Store) AddDispatch(
 		return &pbsync.AddActivityResponse{}, nil
 	}
 
+	if req.GetTemplateCode() != "" {
+		// Templates are looked up by the dispatch's (first) job
+		//nolint:staticcheck // Use the old job field as the fallback for old plugins.
+		job := req.GetDispatch().GetJob()
+		if jobs := req.GetDispatch().GetJobs().GetJobStrings(); len(jobs) > 0 {
+			job = jobs[0]
+		}
+
+		tpl, err := s.dispatches.GetTemplateByCode(ctx, job, req.GetTemplateCode())
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to get dispatch template %q for job %s. %w",
+				req.GetTemplateCode(),
+				job,
+				err,
+			)
+		}
+		dispatches.ApplyTemplate(req.GetDispatch(), tpl)
+	}
+
 	dsp, err := s.dispatches.Create(ctx, req.GetDispatch())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dispatch. %w", err)
@@ -3,8 +3,11 @@ package syncstore
 import (
 	"context"
 	"testing"
+	"time"
 
+	livemaphistory "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/history"
 	livemapmarkers "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/livemap/markers"
+	permissionsattributes "github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/permissions/attributes"
 	"github.com/fivenet-app/fivenet/v2026/gen/go/proto/resources/timestamp"
 	pbsync "github.com/fivenet-app/fivenet/v2026/gen/go/proto/services/sync"
 	livemapstore "github.com/fivenet-app/fivenet/v2026/stores/livemap"
@@ -74,6 +77,39 @@ func (s *roundTripMarkerStore) ListDeletedMarkers(
 	return []*livemapmarkers.MarkerMarker{}, nil
 }
 
+func (s *roundTripMarkerStore) ListGeofenceMarkers(
+	_ context.Context,
+) ([]*livemapmarkers.MarkerMarker, error) {
+	return []*livemapmarkers.MarkerMarker{}, nil
+}
+
+func (s *roundTripMarkerStore) AddPositionSamples(
+	_ context.Context,
+	_ []*livemaphistory.PositionSample,
+) error {
+	return nil
+}
+
+func (s *roundTripMarkerStore) ListPositionSamples(
+	_ context.Context,
+	_ *int32,
+	_ *int64,
+	_ *permissionsattributes.JobGradeList,
+	_ time.Time,
+	_ time.Time,
+	_ int64,
+) ([]*livemaphistory.PositionSample, error) {
+	return []*livemaphistory.PositionSample{}, nil
+}
+
+func (s *roundTripMarkerStore) DeletePositionSamples(
+	_ context.Context,
+	_ time.Time,
+	_ int64,
+) (int64, error) {
+	return 0, nil
+}
+
 var _ livemapstore.IStore = (*roundTripMarkerStore)(nil)
 
 func cloneRoundTripMarker(marker *livemapmarkers.MarkerMarker) *livemapmarkers.MarkerMarker {
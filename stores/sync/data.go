@@ -320,16 +320,28 @@ func (s *Store) EndActiveJobTimeclocks(
 ) (*pbsync.EndActiveJobTimeclocksResponse, error) {
 	tTimeclock := table.FivenetJobTimeclock
 
+	condition := mysql.AND(
+		tTimeclock.StartTime.IS_NOT_NULL(),
+		tTimeclock.EndTime.IS_NULL(),
+	)
+	if req.Job != nil {
+		condition = condition.AND(tTimeclock.Job.EQ(mysql.String(req.GetJob())))
+	}
+	if len(req.GetUserIds()) > 0 {
+		ids := make([]mysql.Expression, len(req.GetUserIds()))
+		for i, userId := range req.GetUserIds() {
+			ids[i] = mysql.Int32(userId)
+		}
+		condition = condition.AND(tTimeclock.UserID.IN(ids...))
+	}
+
 	stmt := tTimeclock.
 		UPDATE().
 		SET(
 			tTimeclock.SpentTime.SET(mysql.RawFloat("COALESCE(`spent_time`, 0) + CAST((TIMESTAMPDIFF(SECOND, `start_time`, CURRENT_TIMESTAMP) / 3600) AS DECIMAL(10,2))")),
 			tTimeclock.EndTime.SET(mysql.CURRENT_TIMESTAMP()),
 		).
-		WHERE(mysql.AND(
-			tTimeclock.StartTime.IS_NOT_NULL(),
-			tTimeclock.EndTime.IS_NULL(),
-		))
+		WHERE(condition)
 
 	res, err := stmt.ExecContext(ctx, s.db)
 	if err != nil {
@@ -22,3 +22,21 @@ func TestEndActiveJobTimeclocks(t *testing.T) {
 	require.Equal(t, int64(3), resp.GetRowsAffected())
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestEndActiveJobTimeclocksFiltered(t *testing.T) {
+	t.Parallel()
+
+	store, mock := newTestStore(t)
+
+	mock.ExpectExec(`(?s)UPDATE fivenet_job_timeclock SET .*end_time = CURRENT_TIMESTAMP.*WHERE .*start_time IS NOT NULL.*end_time IS NULL.*job = \?.*user_id IN \(\?, \?\).*;`).
+		WithArgs("police", int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	resp, err := store.EndActiveJobTimeclocks(t.Context(), &pbsync.EndActiveJobTimeclocksRequest{
+		Job:     new("police"),
+		UserIds: []int32{1, 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resp.GetRowsAffected())
+	require.NoError(t, mock.ExpectationsWereMet())
+}